@@ -0,0 +1,187 @@
+// Package allowancecharge computes the allowances and charges of documents
+// and their lines, UBL AllowanceCharge, such as a volume discount on a line
+// or a freight charge on an invoice.
+//
+// An allowance or charge is either a fixed Amount or MultiplierFactorNumeric
+// percent of a BaseAmount. The base amount of a line allowance or charge
+// defaults to the quantity times the price of the line, the base amount of
+// a document allowance or charge to the sum of the line net amounts. Line
+// allowances and charges are part of the line net amount, document
+// allowances and charges make up AllowanceTotalAmount and ChargeTotalAmount.
+//
+// Allowance reason codes are from UNCL5189, charge reason codes from
+// UNCL7161, as restricted by EN 16931.
+//
+// The allowances and charges of every document and line are kept in
+// allowance_charges by the master_flag and master_id of what they apply to.
+package allowancecharge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+)
+
+// AllowanceCharge - an allowance, or a charge with ChargeIndicator
+type AllowanceCharge struct {
+	ChargeIndicator         bool
+	ReasonCode              string
+	MultiplierFactorNumeric float64 // percent of BaseAmount
+	BaseAmount              money.Amount
+	Amount                  money.Amount
+	TaxCategoryID           uint32
+}
+
+// allowanceReasonCodes - the UNCL5189 codes EN 16931 allows as allowance
+// reason code
+var allowanceReasonCodes = codeList(`41 42 60 62 63 64 65 66 67 68 70 71 88 95 100 102 103 104 105`)
+
+// chargeReasonCodes - the UNCL7161 codes EN 16931 allows as charge reason
+// code
+var chargeReasonCodes = codeList(`AA AAA AAC AAD AAE AAF AAH AAI AAS AAT AAV AAY AAZ ABA ABB ABC ABD ABF
+	ABK ABL ABN ABR ABS ABT ABU ACF ACG ACH ACI ACJ ACK ACL ACM ACS ADC ADE ADJ ADK ADL ADM ADN ADO ADP
+	ADQ ADR ADT ADW ADY ADZ AEA AEB AEC AED AEF AEH AEI AEJ AEK AEL AEM AEN AEO AEP AES AET AEU AEV AEW
+	AEX AEY AEZ AJ AU CA CAB CAD CAE CAF CAI CAJ CAK CAL CAM CAN CAO CAP CAQ CAR CAS CAT CAU CAV CAW
+	CAX CAY CAZ CD CG CS CT DAB DAC DAD DAF DAG DAH DAI DAJ DAK DAL DAM DAN DAO DAP DAQ DL EG EP ER FAA
+	FAB FAC FC FH FI GAA HAA HD HH IAA IAB ID IF IR IS KO L1 LA LAA LAB LF MAE MI ML NAA OA PA PAA PC
+	PL PRV RAB RAC RAD RAF RE RF RH RV SA SAA SAD SAE SAI SG SH SM SU TAB TAC TT TV V1 V2 WH XAA YY ZZZ`)
+
+func codeList(codes string) map[string]bool {
+	m := make(map[string]bool)
+	for _, code := range strings.Fields(codes) {
+		m[code] = true
+	}
+	return m
+}
+
+// hundred - the Amount 100
+var hundred = money.New(100, 0)
+
+// IsReasonCode - whether code is an allowance reason code, or a charge
+// reason code with chargeIndicator
+func IsReasonCode(chargeIndicator bool, code string) bool {
+	if chargeIndicator {
+		return chargeReasonCodes[code]
+	}
+	return allowanceReasonCodes[code]
+}
+
+// Calculate - set Amount to MultiplierFactorNumeric percent of BaseAmount,
+// and BaseAmount to base when it is not given. Without a percentage Amount
+// is kept. Amounts are rounded with policy.
+func (ac *AllowanceCharge) Calculate(base money.Amount, policy rounding.Policy) {
+	if ac.MultiplierFactorNumeric == 0 {
+		ac.Amount = policy.Round(ac.Amount)
+		return
+	}
+	if ac.BaseAmount == money.Zero {
+		ac.BaseAmount = base
+	}
+	ac.BaseAmount = policy.Round(ac.BaseAmount)
+	ac.Amount = policy.Round(ac.BaseAmount.Mul(money.FromFloat(ac.MultiplierFactorNumeric)).Div(hundred))
+}
+
+// LineBase - quantity times price divided by the price base quantity (1
+// when not given), rounded with policy, the amount of a line before its
+// allowances and charges and the base amount of their percentages
+func LineBase(quantity float64, priceAmount money.Amount, priceBaseQuantity float64, policy rounding.Policy) money.Amount {
+	baseQuantity := money.FromFloat(priceBaseQuantity)
+	if baseQuantity == money.Zero {
+		baseQuantity = money.New(1, 0)
+	}
+	return policy.Round(money.FromFloat(quantity).Mul(priceAmount).Div(baseQuantity))
+}
+
+// Totals - Calculate each of acs on base with policy and return the sum of
+// the allowances and the sum of the charges
+func Totals(acs []*AllowanceCharge, base money.Amount, policy rounding.Policy) (money.Amount, money.Amount) {
+	allowanceTotal, chargeTotal := money.Zero, money.Zero
+	for _, ac := range acs {
+		ac.Calculate(base, policy)
+		if ac.ChargeIndicator {
+			chargeTotal = chargeTotal.Add(ac.Amount)
+		} else {
+			allowanceTotal = allowanceTotal.Add(ac.Amount)
+		}
+	}
+	return allowanceTotal, chargeTotal
+}
+
+// Signed - Amount, negative for an allowance, what ac adds to the amount it
+// applies to
+func (ac *AllowanceCharge) Signed() money.Amount {
+	if ac.ChargeIndicator {
+		return ac.Amount
+	}
+	return ac.Amount.Neg()
+}
+
+// FromProto - the AllowanceCharge of an AllowanceChargeD whose amounts are
+// normalized
+func FromProto(d *commonproto.AllowanceChargeD) *AllowanceCharge {
+	ac := AllowanceCharge{
+		ChargeIndicator:         d.ChargeIndicator,
+		ReasonCode:              d.AllowanceChargeReasonCode,
+		MultiplierFactorNumeric: d.MultiplierFactorNumeric,
+		TaxCategoryID:           d.TaxCategoryId,
+	}
+	ac.BaseAmount, _ = money.Parse(d.BaseAmount)
+	ac.Amount, _ = money.Parse(d.Amount)
+	return &ac
+}
+
+// SetProto - set the amounts of an AllowanceChargeD to those of ac
+func (ac *AllowanceCharge) SetProto(d *commonproto.AllowanceChargeD) {
+	d.BaseAmount = ac.BaseAmount.String()
+	d.Amount = ac.Amount.String()
+}
+
+// FromProtos - the AllowanceCharges of AllowanceCharge protos, in the same
+// order
+func FromProtos(allowanceCharges []*commonproto.AllowanceCharge) []*AllowanceCharge {
+	acs := []*AllowanceCharge{}
+	for _, allowanceCharge := range allowanceCharges {
+		acs = append(acs, FromProto(allowanceCharge.AllowanceChargeD))
+	}
+	return acs
+}
+
+// SetProtos - set the amounts of AllowanceCharge protos to those of acs,
+// the FromProtos of them
+func SetProtos(allowanceCharges []*commonproto.AllowanceCharge, acs []*AllowanceCharge) {
+	for i, allowanceCharge := range allowanceCharges {
+		acs[i].SetProto(allowanceCharge.AllowanceChargeD)
+	}
+}
+
+// CalculateProtos - set the amounts of AllowanceCharge protos, with base
+// as the base amount of their percentages, rounded with policy, and return
+// the sum of the allowances and the sum of the charges
+func CalculateProtos(allowanceCharges []*commonproto.AllowanceCharge, base money.Amount, policy rounding.Policy) (money.Amount, money.Amount) {
+	acs := FromProtos(allowanceCharges)
+	allowanceTotal, chargeTotal := Totals(acs, base, policy)
+	SetProtos(allowanceCharges, acs)
+	return allowanceTotal, chargeTotal
+}
+
+// Validate - an error when a request has a reason code that is not from
+// the code list, or neither an amount nor a percentage
+func Validate(in *commonproto.CreateAllowanceChargeRequest) error {
+	kind := "allowance"
+	if in.ChargeIndicator {
+		kind = "charge"
+	}
+	if in.AllowanceChargeReasonCode != "" && !IsReasonCode(in.ChargeIndicator, in.AllowanceChargeReasonCode) {
+		return fmt.Errorf("allowancecharge: %s reason code %q is not in the code list", kind, in.AllowanceChargeReasonCode)
+	}
+	if in.MultiplierFactorNumeric < 0 {
+		return fmt.Errorf("allowancecharge: %s percentage %v is negative", kind, in.MultiplierFactorNumeric)
+	}
+	if in.MultiplierFactorNumeric == 0 && strings.TrimSpace(in.Amount) == "" {
+		return fmt.Errorf("allowancecharge: %s needs an amount or a percentage", kind)
+	}
+	return nil
+}
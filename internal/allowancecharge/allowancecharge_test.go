@@ -0,0 +1,73 @@
+package allowancecharge
+
+import (
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotals(t *testing.T) {
+	discount := &AllowanceCharge{ReasonCode: "95", MultiplierFactorNumeric: 2.5}
+	fixed := &AllowanceCharge{ReasonCode: "100", Amount: money.MustParse("10")}
+	freight := &AllowanceCharge{ChargeIndicator: true, ReasonCode: "FC", Amount: money.MustParse("25.005")}
+	handling := &AllowanceCharge{ChargeIndicator: true, MultiplierFactorNumeric: 10, BaseAmount: money.MustParse("50")}
+
+	allowanceTotal, chargeTotal := Totals([]*AllowanceCharge{discount, fixed, freight, handling}, money.MustParse("1234.50"), rounding.Default)
+	assert.Equal(t, "1234.50", discount.BaseAmount.String(), "they should be equal")
+	assert.Equal(t, "30.86", discount.Amount.String(), "they should be equal")
+	assert.Equal(t, "25.01", freight.Amount.String(), "they should be equal")
+	assert.Equal(t, "50.00", handling.BaseAmount.String(), "they should be equal")
+	assert.Equal(t, "40.86", allowanceTotal.String(), "they should be equal")
+	assert.Equal(t, "30.01", chargeTotal.String(), "they should be equal")
+	assert.Equal(t, "-30.86", discount.Signed().String(), "they should be equal")
+
+	discount = &AllowanceCharge{ReasonCode: "95", MultiplierFactorNumeric: 2.5}
+	allowanceTotal, _ = Totals([]*AllowanceCharge{discount}, money.MustParse("1234"), rounding.ForCurrency("JPY"))
+	assert.Equal(t, "31.00", allowanceTotal.String(), "they should be equal")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		in      *commonproto.CreateAllowanceChargeRequest
+		wantErr bool
+	}{
+		{in: &commonproto.CreateAllowanceChargeRequest{AllowanceChargeReasonCode: "95", MultiplierFactorNumeric: 5}},
+		{in: &commonproto.CreateAllowanceChargeRequest{ChargeIndicator: true, AllowanceChargeReasonCode: "FC", Amount: "25.00"}},
+		{in: &commonproto.CreateAllowanceChargeRequest{Amount: "25.00"}},
+		{in: &commonproto.CreateAllowanceChargeRequest{AllowanceChargeReasonCode: "FC", Amount: "25.00"}, wantErr: true},
+		{in: &commonproto.CreateAllowanceChargeRequest{ChargeIndicator: true, AllowanceChargeReasonCode: "95", Amount: "25.00"}, wantErr: true},
+		{in: &commonproto.CreateAllowanceChargeRequest{MultiplierFactorNumeric: -5}, wantErr: true},
+		{in: &commonproto.CreateAllowanceChargeRequest{AllowanceChargeReasonCode: "95"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		err := Validate(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in.AllowanceChargeReasonCode)
+			continue
+		}
+		assert.NoError(t, err, tt.in.AllowanceChargeReasonCode)
+	}
+}
+
+func TestLineBase(t *testing.T) {
+	assert.Equal(t, "30.75", LineBase(3, money.MustParse("10.25"), 0, rounding.Default).String(), "they should be equal")
+	assert.Equal(t, "17.50", LineBase(7, money.MustParse("25"), 10, rounding.Default).String(), "they should be equal")
+	assert.Equal(t, "0.33", LineBase(1, money.MustParse("1"), 3, rounding.Default).String(), "they should be equal")
+	assert.Equal(t, "31.00", LineBase(3, money.MustParse("10.25"), 0, rounding.ForCurrency("JPY")).String(), "they should be equal")
+}
+
+func TestCalculateProtos(t *testing.T) {
+	allowanceCharges := []*commonproto.AllowanceCharge{
+		{AllowanceChargeD: &commonproto.AllowanceChargeD{AllowanceChargeReasonCode: "95", MultiplierFactorNumeric: 10}},
+		{AllowanceChargeD: &commonproto.AllowanceChargeD{ChargeIndicator: true, AllowanceChargeReasonCode: "FC", Amount: "5"}},
+	}
+	allowanceTotal, chargeTotal := CalculateProtos(allowanceCharges, money.MustParse("200"), rounding.Default)
+	assert.Equal(t, "20.00", allowanceTotal.String(), "they should be equal")
+	assert.Equal(t, "5.00", chargeTotal.String(), "they should be equal")
+	assert.Equal(t, "200.00", allowanceCharges[0].AllowanceChargeD.BaseAmount, "they should be equal")
+	assert.Equal(t, "20.00", allowanceCharges[0].AllowanceChargeD.Amount, "they should be equal")
+	assert.Equal(t, "5.00", allowanceCharges[1].AllowanceChargeD.Amount, "they should be equal")
+}
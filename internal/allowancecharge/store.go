@@ -0,0 +1,173 @@
+package allowancecharge
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// insertAllowanceChargeSQL - insert AllowanceChargeSQL query
+const insertAllowanceChargeSQL = `insert into allowance_charges
+	  (uuid4,
+ac_id,
+charge_indicator,
+allowance_charge_reason_code,
+allowance_charge_reason,
+multiplier_factor_numeric,
+prepaid_indicator,
+sequence_numeric,
+amount,
+base_amount,
+per_unit_amount,
+currency_code,
+tax_category_id,
+tax_total_id,
+master_flag,
+master_id,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:ac_id,
+:charge_indicator,
+:allowance_charge_reason_code,
+:allowance_charge_reason,
+:multiplier_factor_numeric,
+:prepaid_indicator,
+:sequence_numeric,
+:amount,
+:base_amount,
+:per_unit_amount,
+:currency_code,
+:tax_category_id,
+:tax_total_id,
+:master_flag,
+:master_id,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// updateAllowanceChargeAmountsSQL - update the calculated amounts of an
+// AllowanceCharge
+const updateAllowanceChargeAmountsSQL = `update allowance_charges set amount = ?, base_amount = ?, updated_at = ? where id = ?;`
+
+// ProcessRequests - the AllowanceCharges of a document or line, master_id
+// is set when they are inserted
+func ProcessRequests(ctx context.Context, log *zap.Logger, masterFlag string, in []*commonproto.CreateAllowanceChargeRequest, userID string, userEmail string, requestID string) ([]*commonproto.AllowanceCharge, error) {
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = userID
+	crUpdUser.UpdatedByUserId = userID
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	allowanceCharges := []*commonproto.AllowanceCharge{}
+	for _, allowanceChargeRequest := range in {
+		err := Validate(allowanceChargeRequest)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+
+		allowanceChargeD := commonproto.AllowanceChargeD{}
+		allowanceChargeD.Uuid4, err = common.GetUUIDBytes()
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		allowanceChargeD.AcId = allowanceChargeRequest.AcId
+		allowanceChargeD.ChargeIndicator = allowanceChargeRequest.ChargeIndicator
+		allowanceChargeD.AllowanceChargeReasonCode = allowanceChargeRequest.AllowanceChargeReasonCode
+		allowanceChargeD.AllowanceChargeReason = allowanceChargeRequest.AllowanceChargeReason
+		allowanceChargeD.MultiplierFactorNumeric = allowanceChargeRequest.MultiplierFactorNumeric
+		allowanceChargeD.PrepaidIndicator = allowanceChargeRequest.PrepaidIndicator
+		allowanceChargeD.SequenceNumeric = allowanceChargeRequest.SequenceNumeric
+		allowanceChargeD.Amount = allowanceChargeRequest.Amount
+		allowanceChargeD.BaseAmount = allowanceChargeRequest.BaseAmount
+		allowanceChargeD.PerUnitAmount = allowanceChargeRequest.PerUnitAmount
+		allowanceChargeD.TaxCategoryId = allowanceChargeRequest.TaxCategoryId
+		allowanceChargeD.MasterFlag = masterFlag
+
+		allowanceCharge := commonproto.AllowanceCharge{AllowanceChargeD: &allowanceChargeD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+		allowanceCharges = append(allowanceCharges, &allowanceCharge)
+	}
+	return allowanceCharges, nil
+}
+
+// Insert - insert the AllowanceCharges of the document or line masterID
+// within its transaction, in currencyCode, the currency of the document
+func Insert(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, allowanceCharges []*commonproto.AllowanceCharge, masterID uint32, currencyCode string, userEmail string, requestID string) error {
+	for _, allowanceCharge := range allowanceCharges {
+		allowanceCharge.AllowanceChargeD.MasterId = masterID
+		allowanceCharge.AllowanceChargeD.CurrencyCode = currencyCode
+		crUpdTime := new(commonstruct.CrUpdTime)
+		crUpdTime.CreatedAt = common.TimestampToTime(allowanceCharge.CrUpdTime.CreatedAt)
+		crUpdTime.UpdatedAt = common.TimestampToTime(allowanceCharge.CrUpdTime.UpdatedAt)
+		allowanceChargeTmp := commonstruct.AllowanceCharge{AllowanceChargeD: allowanceCharge.AllowanceChargeD, CrUpdUser: allowanceCharge.CrUpdUser, CrUpdTime: crUpdTime}
+		res, err := tx.NamedExecContext(ctx, insertAllowanceChargeSQL, &allowanceChargeTmp)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
+		uID, err := res.LastInsertId()
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		allowanceCharge.AllowanceChargeD.Id = uint32(uID)
+		allowanceCharge.AllowanceChargeD.IdS, err = common.UUIDBytesToStr(allowanceCharge.AllowanceChargeD.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAmounts - keep the amounts calculated again for stored
+// AllowanceCharges within the transaction of their document or line
+func UpdateAmounts(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, allowanceCharges []*commonproto.AllowanceCharge, tn time.Time, userEmail string, requestID string) error {
+	for _, allowanceCharge := range allowanceCharges {
+		ad := allowanceCharge.AllowanceChargeD
+		_, err := tx.ExecContext(ctx, updateAllowanceChargeAmountsSQL, ad.Amount, ad.BaseAmount, tn, ad.Id)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Get - the stored AllowanceCharges of a document or line with normalized
+// amounts
+func Get(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, masterID uint32, userEmail string, requestID string) ([]*commonproto.AllowanceCharge, error) {
+	allowanceCharges, err := ubl.GetAllowanceCharges(ctx, dbService, masterFlag, masterID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	for _, allowanceCharge := range allowanceCharges {
+		if err := money.Normalize(allowanceCharge.AllowanceChargeD); err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+	}
+	return allowanceCharges, nil
+}
@@ -0,0 +1,198 @@
+// Package cii maps sc-ubl invoices and credit notes to and from UN/CEFACT
+// Cross Industry Invoice (CII) D16B XML, the second syntax EN 16931 allows
+// besides UBL.
+//
+// A CrossIndustryInvoice is built from the UBL Invoice or CreditNote the ubl
+// package builds, so both syntaxes carry the same header, line and tax
+// data. An imported CrossIndustryInvoice is mapped back onto a UBL Invoice
+// or CreditNote that the ubl package imports. Values the EN 16931 CII
+// binding has no element for, such as the pricing and payment exchange
+// rates or line tax totals, are not carried.
+package cii
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CII D16B namespaces
+const (
+	NamespaceRsm = "urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100"
+	NamespaceRam = "urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:100"
+	NamespaceQdt = "urn:un:unece:uncefact:data:standard:QualifiedDataType:100"
+	NamespaceUdt = "urn:un:unece:uncefact:data:standard:UnqualifiedDataType:100"
+)
+
+// GuidelineEN16931 - specification identifier (BT-24) of an invoice that
+// keeps EN 16931 without further restrictions
+const GuidelineEN16931 = "urn:cen.eu:en16931:2017"
+
+// DateFormat - value of the format attribute of the dates written, CCYYMMDD
+const DateFormat = "102"
+
+// dateLayout - layout of dates in DateFormat
+const dateLayout = "20060102"
+
+// Namespaces - xmlns attributes written on the document root
+type Namespaces struct {
+	XmlnsRsm string `xml:"xmlns:rsm,attr,omitempty"`
+	XmlnsRam string `xml:"xmlns:ram,attr,omitempty"`
+	XmlnsQdt string `xml:"xmlns:qdt,attr,omitempty"`
+	XmlnsUdt string `xml:"xmlns:udt,attr,omitempty"`
+}
+
+// NewNamespaces - namespaces of a CrossIndustryInvoice
+func NewNamespaces() Namespaces {
+	return Namespaces{XmlnsRsm: NamespaceRsm, XmlnsRam: NamespaceRam, XmlnsQdt: NamespaceQdt, XmlnsUdt: NamespaceUdt}
+}
+
+// Amount - udt:AmountType, currencyID is only written on tax totals
+type Amount struct {
+	Value      string `xml:",chardata"`
+	CurrencyID string `xml:"currencyID,attr,omitempty"`
+}
+
+// Quantity - udt:QuantityType with unitCode
+type Quantity struct {
+	Value    string `xml:",chardata"`
+	UnitCode string `xml:"unitCode,attr,omitempty"`
+}
+
+// ID - udt:IDType with schemeID
+type ID struct {
+	Value    string `xml:",chardata"`
+	SchemeID string `xml:"schemeID,attr,omitempty"`
+}
+
+// DateTime - udt:DateTimeType
+type DateTime struct {
+	DateTimeString DateString `xml:"udt:DateTimeString"`
+}
+
+// Date - udt:DateType
+type Date struct {
+	DateString DateString `xml:"udt:DateString"`
+}
+
+// DateString - date string with its format code
+type DateString struct {
+	Value  string `xml:",chardata"`
+	Format string `xml:"format,attr,omitempty"`
+}
+
+// Marshal - encode a CrossIndustryInvoice with an XML declaration
+func Marshal(doc *CrossIndustryInvoice) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// Unmarshal - decode a CrossIndustryInvoice
+func Unmarshal(data []byte, doc *CrossIndustryInvoice) error {
+	dec := xml.NewTokenDecoder(&prefixReader{dec: xml.NewDecoder(bytes.NewReader(data))})
+	return dec.Decode(doc)
+}
+
+// prefixReader rewrites namespaced tokens to the rsm:/ram:/qdt:/udt:
+// prefixed local names used in the struct tags of this package, so decoding
+// does not depend on the prefixes chosen by the sender.
+type prefixReader struct {
+	dec      *xml.Decoder
+	rootSeen bool
+}
+
+var prefixes = map[string]string{
+	NamespaceRsm: "rsm:",
+	NamespaceRam: "ram:",
+	NamespaceQdt: "qdt:",
+	NamespaceUdt: "udt:",
+}
+
+func (p *prefixReader) Token() (xml.Token, error) {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case xml.StartElement:
+		if !p.rootSeen {
+			p.rootSeen = true
+			if t.Name.Space != NamespaceRsm || t.Name.Local != "CrossIndustryInvoice" {
+				return nil, fmt.Errorf("cii: root element {%s}%s is not {%s}CrossIndustryInvoice", t.Name.Space, t.Name.Local, NamespaceRsm)
+			}
+		}
+		t.Name = rename(t.Name)
+		attrs := make([]xml.Attr, 0, len(t.Attr))
+		for _, a := range t.Attr {
+			if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+				continue
+			}
+			attrs = append(attrs, a)
+		}
+		t.Attr = attrs
+		return t, nil
+	case xml.EndElement:
+		t.Name = rename(t.Name)
+		return t, nil
+	}
+	return xml.CopyToken(tok), nil
+}
+
+func rename(n xml.Name) xml.Name {
+	if prefix, ok := prefixes[n.Space]; ok {
+		return xml.Name{Local: prefix + n.Local}
+	}
+	return n
+}
+
+// FormatDate - a UBL date (2006-01-02) as a CII date in DateFormat, empty
+// when the date is empty or not a UBL date
+func FormatDate(s string) string {
+	t, err := time.Parse("2006-01-02", strings.TrimSpace(s))
+	if err != nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+// ParseDate - a CII date string as a UBL date, empty when s is empty. Only
+// DateFormat is supported.
+func ParseDate(s DateString) (string, error) {
+	v := strings.TrimSpace(s.Value)
+	if v == "" {
+		return "", nil
+	}
+	if s.Format != "" && s.Format != DateFormat {
+		return "", fmt.Errorf("date format %q is not supported, want %q", s.Format, DateFormat)
+	}
+	t, err := time.Parse(dateLayout, v)
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q", v)
+	}
+	return t.Format("2006-01-02"), nil
+}
+
+func newDateTime(ublDate string) *DateTime {
+	d := FormatDate(ublDate)
+	if d == "" {
+		return nil
+	}
+	return &DateTime{DateTimeString: DateString{Value: d, Format: DateFormat}}
+}
+
+func newDate(ublDate string) *Date {
+	d := FormatDate(ublDate)
+	if d == "" {
+		return nil
+	}
+	return &Date{DateString: DateString{Value: d, Format: DateFormat}}
+}
@@ -0,0 +1,237 @@
+package cii
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/stretchr/testify/assert"
+)
+
+// testInvoice - an Invoice with one 1273.00 EUR line at 25% VAT
+func testInvoice() *ubl.Invoice {
+	vat := &ubl.TaxScheme{ID: "VAT"}
+	standardRate := &ubl.TaxCategory{ID: "S", Percent: "25.00", TaxScheme: vat}
+	return &ubl.Invoice{
+		ID:                   "TOSL108",
+		IssueDate:            "2009-12-15",
+		DueDate:              "2010-01-15",
+		InvoiceTypeCode:      "380",
+		Note:                 []string{"Ordered in our booth at the convention."},
+		DocumentCurrencyCode: "EUR",
+		OrderReference:       &ubl.OrderReference{ID: "123"},
+		AccountingSupplierParty: &ubl.SupplierParty{Party: &ubl.Party{
+			EndpointID:          &ubl.Identifier{Value: "7300010000001", SchemeID: "0088"},
+			PartyIdentification: []ubl.PartyIdentification{{ID: ubl.Identifier{Value: "5790000436101", SchemeID: "0088"}}},
+			PartyName:           []ubl.PartyName{{Name: "Salescompany ltd."}},
+			PostalAddress:       &ubl.Address{StreetName: "Main street", CityName: "Big city", PostalZone: "54321", Country: &ubl.Country{IdentificationCode: "DK"}},
+			PartyTaxScheme:      []ubl.PartyTaxScheme{{CompanyID: "DK12345678", TaxScheme: vat}},
+			PartyLegalEntity:    []ubl.PartyLegalEntity{{RegistrationName: "The Sellercompany Incorporated", CompanyID: "5402697509"}},
+		}},
+		AccountingCustomerParty: &ubl.CustomerParty{Party: &ubl.Party{
+			PartyName:        []ubl.PartyName{{Name: "Buyercompany ltd"}},
+			PartyLegalEntity: []ubl.PartyLegalEntity{{RegistrationName: "Buyercompany ltd"}},
+		}},
+		PaymentTerms: []ubl.PaymentTerms{{Note: "Penalty percentage 10% from due date"}},
+		TaxTotal: []ubl.TaxTotal{{
+			TaxAmount:   ubl.NewAmount("318.25", "EUR"),
+			TaxSubtotal: []ubl.TaxSubtotal{{TaxableAmount: ubl.NewAmount("1273", "EUR"), TaxAmount: ubl.NewAmount("318.25", "EUR"), TaxCategory: standardRate}},
+		}},
+		LegalMonetaryTotal: &ubl.MonetaryTotal{
+			LineExtensionAmount: ubl.NewAmount("1273", "EUR"),
+			TaxExclusiveAmount:  ubl.NewAmount("1273", "EUR"),
+			TaxInclusiveAmount:  ubl.NewAmount("1591.25", "EUR"),
+			PayableAmount:       ubl.NewAmount("1591.25", "EUR"),
+		},
+		InvoiceLine: []ubl.InvoiceLine{{
+			ID:                  "1",
+			InvoicedQuantity:    &ubl.Quantity{Value: "1", UnitCode: "EA"},
+			LineExtensionAmount: ubl.NewAmount("1273", "EUR"),
+			Item: &ubl.Item{
+				Name:                      "Labtop computer",
+				SellersItemIdentification: &ubl.ItemIdentification{ID: ubl.Identifier{Value: "JB007"}},
+				ClassifiedTaxCategory:     []ubl.TaxCategory{*standardRate},
+			},
+			Price: &ubl.Price{PriceAmount: ubl.NewAmount("1273", "EUR")},
+		}},
+	}
+}
+
+func TestFromInvoice(t *testing.T) {
+	ci, err := FromInvoice(testInvoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xmlStr := string(out)
+
+	assert.True(t, strings.HasPrefix(xmlStr, `<?xml version="1.0" encoding="UTF-8"?>`), "xml declaration")
+	assert.Contains(t, xmlStr, `<rsm:CrossIndustryInvoice xmlns:rsm="`+NamespaceRsm+`"`)
+	assert.Contains(t, xmlStr, `<ram:ID>`+GuidelineEN16931+`</ram:ID>`)
+	assert.Contains(t, xmlStr, `<ram:TypeCode>380</ram:TypeCode>`)
+	assert.Contains(t, xmlStr, `<udt:DateTimeString format="102">20091215</udt:DateTimeString>`)
+	assert.Contains(t, xmlStr, `<ram:SellerAssignedID>JB007</ram:SellerAssignedID>`)
+	assert.Contains(t, xmlStr, `<ram:BilledQuantity unitCode="EA">1</ram:BilledQuantity>`)
+	assert.Contains(t, xmlStr, `<ram:URIID schemeID="0088">7300010000001</ram:URIID>`)
+	assert.Contains(t, xmlStr, `<ram:ID schemeID="VA">DK12345678</ram:ID>`)
+	assert.Contains(t, xmlStr, `<ram:TaxTotalAmount currencyID="EUR">318.25</ram:TaxTotalAmount>`)
+	assert.Contains(t, xmlStr, `<ram:DuePayableAmount>1591.25</ram:DuePayableAmount>`)
+	assert.Contains(t, xmlStr, `<ram:IssuerAssignedID>123</ram:IssuerAssignedID>`)
+
+	// elements must follow the sequence of the CII schema
+	order := []string{"<rsm:ExchangedDocumentContext>", "<rsm:ExchangedDocument>", "<rsm:SupplyChainTradeTransaction>", "<ram:IncludedSupplyChainTradeLineItem>", "<ram:ApplicableHeaderTradeAgreement>", "<ram:SellerTradeParty>", "<ram:BuyerTradeParty>", "<ram:BuyerOrderReferencedDocument>", "<ram:ApplicableHeaderTradeDelivery>", "<ram:ApplicableHeaderTradeSettlement>", "<ram:InvoiceCurrencyCode>", "<ram:ApplicableTradeTax>", "<ram:SpecifiedTradePaymentTerms>", "<ram:SpecifiedTradeSettlementHeaderMonetarySummation>"}
+	last := 0
+	for _, elem := range order {
+		i := strings.Index(xmlStr[last:], elem)
+		if assert.True(t, i >= 0, "%s out of order", elem) {
+			last += i
+		}
+	}
+}
+
+func TestInvoiceRoundTrip(t *testing.T) {
+	ci, err := FromInvoice(testInvoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed CrossIndustryInvoice
+	if err := Unmarshal(out, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	inv, err := ToInvoice(&parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "TOSL108", inv.ID)
+	assert.Equal(t, "2009-12-15", inv.IssueDate)
+	assert.Equal(t, "2010-01-15", inv.DueDate)
+	assert.Equal(t, "380", inv.InvoiceTypeCode)
+	assert.Equal(t, "EUR", inv.DocumentCurrencyCode)
+	assert.Equal(t, []string{"Ordered in our booth at the convention."}, inv.Note)
+	assert.Equal(t, "123", inv.OrderReference.ID)
+	assert.Equal(t, "The Sellercompany Incorporated", inv.AccountingSupplierParty.Party.RegistrationName())
+	assert.Equal(t, "DK12345678", inv.AccountingSupplierParty.Party.VATNumber())
+	assert.Equal(t, "7300010000001", inv.AccountingSupplierParty.Party.EndpointValue())
+	assert.Equal(t, []ubl.PaymentTerms{{Note: "Penalty percentage 10% from due date"}}, inv.PaymentTerms)
+	if assert.Len(t, inv.TaxTotal, 1) {
+		assert.Equal(t, ubl.NewAmount("318.25", "EUR"), inv.TaxTotal[0].TaxAmount)
+		if assert.Len(t, inv.TaxTotal[0].TaxSubtotal, 1) {
+			st := inv.TaxTotal[0].TaxSubtotal[0]
+			assert.Equal(t, ubl.NewAmount("1273", "EUR"), st.TaxableAmount)
+			assert.Equal(t, "S", st.TaxCategory.ID)
+			assert.Equal(t, "VAT", st.TaxCategory.TaxScheme.ID)
+		}
+	}
+	assert.Equal(t, ubl.NewAmount("1591.25", "EUR"), inv.LegalMonetaryTotal.PayableAmount)
+	if assert.Len(t, inv.InvoiceLine, 1) {
+		l := inv.InvoiceLine[0]
+		assert.Equal(t, "1", l.ID)
+		assert.Equal(t, &ubl.Quantity{Value: "1", UnitCode: "EA"}, l.InvoicedQuantity)
+		assert.Equal(t, ubl.NewAmount("1273", "EUR"), l.LineExtensionAmount)
+		assert.Equal(t, "Labtop computer", l.Item.Name)
+		assert.Equal(t, "JB007", l.Item.SellersItemIdentification.ID.Value)
+		assert.Equal(t, "S", l.Item.ClassifiedTaxCategory[0].ID)
+		assert.Equal(t, ubl.NewAmount("1273", "EUR"), l.Price.PriceAmount)
+	}
+}
+
+func TestCreditNoteRoundTrip(t *testing.T) {
+	inv := testInvoice()
+	cn := &ubl.CreditNote{
+		ID:                      "CN-1",
+		IssueDate:               inv.IssueDate,
+		DocumentCurrencyCode:    inv.DocumentCurrencyCode,
+		BillingReference:        []ubl.BillingReference{{InvoiceDocumentReference: &ubl.DocumentReference{ID: "TOSL108"}}},
+		AccountingSupplierParty: inv.AccountingSupplierParty,
+		AccountingCustomerParty: inv.AccountingCustomerParty,
+		TaxTotal:                inv.TaxTotal,
+		LegalMonetaryTotal:      inv.LegalMonetaryTotal,
+		CreditNoteLine: []ubl.CreditNoteLine{{
+			ID:                  "1",
+			CreditedQuantity:    inv.InvoiceLine[0].InvoicedQuantity,
+			LineExtensionAmount: inv.InvoiceLine[0].LineExtensionAmount,
+			Item:                inv.InvoiceLine[0].Item,
+			Price:               inv.InvoiceLine[0].Price,
+		}},
+	}
+	ci, err := FromCreditNote(cn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, TypeCodeCreditNote, ci.TypeCode())
+
+	out, err := Marshal(ci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed CrossIndustryInvoice
+	if err := Unmarshal(out, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ToCreditNote(&parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "CN-1", got.ID)
+	assert.Equal(t, "381", got.CreditNoteTypeCode)
+	assert.Equal(t, "TOSL108", got.BillingReference[0].InvoiceDocumentReference.ID)
+	if assert.Len(t, got.CreditNoteLine, 1) {
+		assert.Equal(t, &ubl.Quantity{Value: "1", UnitCode: "EA"}, got.CreditNoteLine[0].CreditedQuantity)
+	}
+
+	_, err = ToInvoice(&parsed)
+	var fieldErrors ubl.FieldErrors
+	if assert.True(t, errors.As(err, &fieldErrors)) {
+		assert.Equal(t, "/rsm:CrossIndustryInvoice/rsm:ExchangedDocument/ram:TypeCode", fieldErrors[0].Path)
+	}
+}
+
+func TestToInvoiceErrors(t *testing.T) {
+	ci, err := FromInvoice(testInvoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ci.ExchangedDocument.IssueDateTime.DateTimeString.Format = "610"
+	ci.SupplyChainTradeTransaction.ApplicableHeaderTradeSettlement.SpecifiedTradePaymentTerms[0].DueDateDateTime.DateTimeString.Value = "15/01/2010"
+
+	_, err = ToInvoice(ci)
+	var fieldErrors ubl.FieldErrors
+	if assert.True(t, errors.As(err, &fieldErrors)) && assert.Len(t, fieldErrors, 2) {
+		assert.Equal(t, "/rsm:CrossIndustryInvoice/rsm:ExchangedDocument/ram:IssueDateTime/udt:DateTimeString", fieldErrors[0].Path)
+		assert.Equal(t, "/rsm:CrossIndustryInvoice/rsm:SupplyChainTradeTransaction/ram:ApplicableHeaderTradeSettlement/ram:SpecifiedTradePaymentTerms[1]/ram:DueDateDateTime/udt:DateTimeString", fieldErrors[1].Path)
+	}
+}
+
+func TestUnmarshalRoot(t *testing.T) {
+	var ci CrossIndustryInvoice
+	err := Unmarshal([]byte(`<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"/>`), &ci)
+	assert.ErrorContains(t, err, "is not {"+NamespaceRsm+"}CrossIndustryInvoice")
+
+	// prefixes chosen by the sender do not matter
+	err = Unmarshal([]byte(`<x:CrossIndustryInvoice xmlns:x="`+NamespaceRsm+`" xmlns:y="`+NamespaceRam+`"><x:ExchangedDocument><y:ID>INV-1</y:ID></x:ExchangedDocument></x:CrossIndustryInvoice>`), &ci)
+	assert.NoError(t, err)
+	assert.Equal(t, "INV-1", ci.ExchangedDocument.ID)
+}
+
+func TestDates(t *testing.T) {
+	assert.Equal(t, "20091215", FormatDate("2009-12-15"))
+	assert.Equal(t, "", FormatDate(""))
+
+	d, err := ParseDate(DateString{Value: "20091215", Format: DateFormat})
+	assert.NoError(t, err)
+	assert.Equal(t, "2009-12-15", d)
+
+	_, err = ParseDate(DateString{Value: "2009-12-15", Format: DateFormat})
+	assert.Error(t, err)
+	_, err = ParseDate(DateString{Value: "200912", Format: "610"})
+	assert.Error(t, err)
+}
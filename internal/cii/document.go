@@ -0,0 +1,238 @@
+package cii
+
+import "encoding/xml"
+
+// Elements of a CrossIndustryInvoice used by the EN 16931 CII binding.
+// Field order follows the sequence defined in the D16B schemas.
+
+// CrossIndustryInvoice - rsm:CrossIndustryInvoice
+type CrossIndustryInvoice struct {
+	XMLName xml.Name `xml:"rsm:CrossIndustryInvoice"`
+	Namespaces
+	ExchangedDocumentContext    *DocumentContext             `xml:"rsm:ExchangedDocumentContext"`
+	ExchangedDocument           *ExchangedDocument           `xml:"rsm:ExchangedDocument"`
+	SupplyChainTradeTransaction *SupplyChainTradeTransaction `xml:"rsm:SupplyChainTradeTransaction"`
+}
+
+// DocumentContext - rsm:ExchangedDocumentContext
+type DocumentContext struct {
+	BusinessProcessSpecifiedDocumentContextParameter *DocumentContextParameter `xml:"ram:BusinessProcessSpecifiedDocumentContextParameter,omitempty"`
+	GuidelineSpecifiedDocumentContextParameter       *DocumentContextParameter `xml:"ram:GuidelineSpecifiedDocumentContextParameter"`
+}
+
+// DocumentContextParameter - ram:DocumentContextParameterType
+type DocumentContextParameter struct {
+	ID string `xml:"ram:ID"`
+}
+
+// ExchangedDocument - rsm:ExchangedDocument
+type ExchangedDocument struct {
+	ID            string    `xml:"ram:ID"`
+	TypeCode      string    `xml:"ram:TypeCode"`
+	IssueDateTime *DateTime `xml:"ram:IssueDateTime"`
+	IncludedNote  []Note    `xml:"ram:IncludedNote,omitempty"`
+}
+
+// Note - ram:NoteType
+type Note struct {
+	Content string `xml:"ram:Content"`
+}
+
+// SupplyChainTradeTransaction - rsm:SupplyChainTradeTransaction
+type SupplyChainTradeTransaction struct {
+	IncludedSupplyChainTradeLineItem []LineItem             `xml:"ram:IncludedSupplyChainTradeLineItem"`
+	ApplicableHeaderTradeAgreement   *HeaderTradeAgreement  `xml:"ram:ApplicableHeaderTradeAgreement"`
+	ApplicableHeaderTradeDelivery    *HeaderTradeDelivery   `xml:"ram:ApplicableHeaderTradeDelivery"`
+	ApplicableHeaderTradeSettlement  *HeaderTradeSettlement `xml:"ram:ApplicableHeaderTradeSettlement"`
+}
+
+// LineItem - ram:IncludedSupplyChainTradeLineItem
+type LineItem struct {
+	AssociatedDocumentLineDocument *LineDocument        `xml:"ram:AssociatedDocumentLineDocument"`
+	SpecifiedTradeProduct          *TradeProduct        `xml:"ram:SpecifiedTradeProduct"`
+	SpecifiedLineTradeAgreement    *LineTradeAgreement  `xml:"ram:SpecifiedLineTradeAgreement"`
+	SpecifiedLineTradeDelivery     *LineTradeDelivery   `xml:"ram:SpecifiedLineTradeDelivery"`
+	SpecifiedLineTradeSettlement   *LineTradeSettlement `xml:"ram:SpecifiedLineTradeSettlement"`
+}
+
+// LineDocument - ram:AssociatedDocumentLineDocument
+type LineDocument struct {
+	LineID       string `xml:"ram:LineID"`
+	IncludedNote []Note `xml:"ram:IncludedNote,omitempty"`
+}
+
+// TradeProduct - ram:SpecifiedTradeProduct
+type TradeProduct struct {
+	GlobalID           *ID           `xml:"ram:GlobalID,omitempty"`
+	SellerAssignedID   string        `xml:"ram:SellerAssignedID,omitempty"`
+	BuyerAssignedID    string        `xml:"ram:BuyerAssignedID,omitempty"`
+	Name               string        `xml:"ram:Name"`
+	Description        string        `xml:"ram:Description,omitempty"`
+	OriginTradeCountry *TradeCountry `xml:"ram:OriginTradeCountry,omitempty"`
+}
+
+// TradeCountry - ram:TradeCountryType
+type TradeCountry struct {
+	ID string `xml:"ram:ID"`
+}
+
+// LineTradeAgreement - ram:SpecifiedLineTradeAgreement
+type LineTradeAgreement struct {
+	BuyerOrderReferencedDocument *ReferencedDocument `xml:"ram:BuyerOrderReferencedDocument,omitempty"`
+	NetPriceProductTradePrice    *TradePrice         `xml:"ram:NetPriceProductTradePrice"`
+}
+
+// TradePrice - ram:TradePriceType
+type TradePrice struct {
+	ChargeAmount  *Amount   `xml:"ram:ChargeAmount"`
+	BasisQuantity *Quantity `xml:"ram:BasisQuantity,omitempty"`
+}
+
+// LineTradeDelivery - ram:SpecifiedLineTradeDelivery
+type LineTradeDelivery struct {
+	BilledQuantity                    *Quantity           `xml:"ram:BilledQuantity"`
+	DespatchAdviceReferencedDocument  *ReferencedDocument `xml:"ram:DespatchAdviceReferencedDocument,omitempty"`
+	ReceivingAdviceReferencedDocument *ReferencedDocument `xml:"ram:ReceivingAdviceReferencedDocument,omitempty"`
+}
+
+// LineTradeSettlement - ram:SpecifiedLineTradeSettlement
+type LineTradeSettlement struct {
+	ApplicableTradeTax                            []TradeTax             `xml:"ram:ApplicableTradeTax"`
+	BillingSpecifiedPeriod                        *Period                `xml:"ram:BillingSpecifiedPeriod,omitempty"`
+	SpecifiedTradeSettlementLineMonetarySummation *LineMonetarySummation `xml:"ram:SpecifiedTradeSettlementLineMonetarySummation"`
+	InvoiceReferencedDocument                     *ReferencedDocument    `xml:"ram:InvoiceReferencedDocument,omitempty"`
+	ReceivableSpecifiedTradeAccountingAccount     *AccountingAccount     `xml:"ram:ReceivableSpecifiedTradeAccountingAccount,omitempty"`
+}
+
+// LineMonetarySummation - ram:SpecifiedTradeSettlementLineMonetarySummation
+type LineMonetarySummation struct {
+	LineTotalAmount *Amount `xml:"ram:LineTotalAmount"`
+}
+
+// ReferencedDocument - ram:ReferencedDocumentType
+type ReferencedDocument struct {
+	IssuerAssignedID string `xml:"ram:IssuerAssignedID,omitempty"`
+	LineID           string `xml:"ram:LineID,omitempty"`
+}
+
+// AccountingAccount - ram:TradeAccountingAccountType
+type AccountingAccount struct {
+	ID string `xml:"ram:ID"`
+}
+
+// Period - ram:SpecifiedPeriodType
+type Period struct {
+	StartDateTime *DateTime `xml:"ram:StartDateTime,omitempty"`
+	EndDateTime   *DateTime `xml:"ram:EndDateTime,omitempty"`
+}
+
+// TradeTax - ram:TradeTaxType
+type TradeTax struct {
+	CalculatedAmount      *Amount `xml:"ram:CalculatedAmount,omitempty"`
+	TypeCode              string  `xml:"ram:TypeCode"`
+	ExemptionReason       string  `xml:"ram:ExemptionReason,omitempty"`
+	BasisAmount           *Amount `xml:"ram:BasisAmount,omitempty"`
+	CategoryCode          string  `xml:"ram:CategoryCode"`
+	ExemptionReasonCode   string  `xml:"ram:ExemptionReasonCode,omitempty"`
+	TaxPointDate          *Date   `xml:"ram:TaxPointDate,omitempty"`
+	RateApplicablePercent string  `xml:"ram:RateApplicablePercent,omitempty"`
+}
+
+// HeaderTradeAgreement - ram:ApplicableHeaderTradeAgreement
+type HeaderTradeAgreement struct {
+	BuyerReference                    string              `xml:"ram:BuyerReference,omitempty"`
+	SellerTradeParty                  *TradeParty         `xml:"ram:SellerTradeParty"`
+	BuyerTradeParty                   *TradeParty         `xml:"ram:BuyerTradeParty"`
+	SellerTaxRepresentativeTradeParty *TradeParty         `xml:"ram:SellerTaxRepresentativeTradeParty,omitempty"`
+	BuyerOrderReferencedDocument      *ReferencedDocument `xml:"ram:BuyerOrderReferencedDocument,omitempty"`
+}
+
+// HeaderTradeDelivery - ram:ApplicableHeaderTradeDelivery
+type HeaderTradeDelivery struct {
+	DespatchAdviceReferencedDocument  *ReferencedDocument `xml:"ram:DespatchAdviceReferencedDocument,omitempty"`
+	ReceivingAdviceReferencedDocument *ReferencedDocument `xml:"ram:ReceivingAdviceReferencedDocument,omitempty"`
+}
+
+// HeaderTradeSettlement - ram:ApplicableHeaderTradeSettlement
+type HeaderTradeSettlement struct {
+	TaxCurrencyCode                                 string                   `xml:"ram:TaxCurrencyCode,omitempty"`
+	InvoiceCurrencyCode                             string                   `xml:"ram:InvoiceCurrencyCode"`
+	PayeeTradeParty                                 *TradeParty              `xml:"ram:PayeeTradeParty,omitempty"`
+	TaxApplicableTradeCurrencyExchange              *CurrencyExchange        `xml:"ram:TaxApplicableTradeCurrencyExchange,omitempty"`
+	ApplicableTradeTax                              []TradeTax               `xml:"ram:ApplicableTradeTax"`
+	BillingSpecifiedPeriod                          *Period                  `xml:"ram:BillingSpecifiedPeriod,omitempty"`
+	SpecifiedTradePaymentTerms                      []PaymentTerms           `xml:"ram:SpecifiedTradePaymentTerms,omitempty"`
+	SpecifiedTradeSettlementHeaderMonetarySummation *HeaderMonetarySummation `xml:"ram:SpecifiedTradeSettlementHeaderMonetarySummation"`
+	InvoiceReferencedDocument                       *ReferencedDocument      `xml:"ram:InvoiceReferencedDocument,omitempty"`
+	ReceivableSpecifiedTradeAccountingAccount       *AccountingAccount       `xml:"ram:ReceivableSpecifiedTradeAccountingAccount,omitempty"`
+}
+
+// CurrencyExchange - ram:TradeCurrencyExchangeType
+type CurrencyExchange struct {
+	SourceCurrencyCode     string    `xml:"ram:SourceCurrencyCode"`
+	SourceUnitBasisNumeric string    `xml:"ram:SourceUnitBasisNumeric,omitempty"`
+	TargetCurrencyCode     string    `xml:"ram:TargetCurrencyCode"`
+	TargetUnitBasisNumeric string    `xml:"ram:TargetUnitBasisNumeric,omitempty"`
+	MarketID               string    `xml:"ram:MarketID,omitempty"`
+	ConversionRate         string    `xml:"ram:ConversionRate"`
+	ConversionRateDateTime *DateTime `xml:"ram:ConversionRateDateTime,omitempty"`
+}
+
+// PaymentTerms - ram:SpecifiedTradePaymentTerms
+type PaymentTerms struct {
+	Description          string    `xml:"ram:Description,omitempty"`
+	DueDateDateTime      *DateTime `xml:"ram:DueDateDateTime,omitempty"`
+	PartialPaymentAmount *Amount   `xml:"ram:PartialPaymentAmount,omitempty"`
+}
+
+// HeaderMonetarySummation - ram:SpecifiedTradeSettlementHeaderMonetarySummation
+type HeaderMonetarySummation struct {
+	LineTotalAmount      *Amount  `xml:"ram:LineTotalAmount"`
+	ChargeTotalAmount    *Amount  `xml:"ram:ChargeTotalAmount,omitempty"`
+	AllowanceTotalAmount *Amount  `xml:"ram:AllowanceTotalAmount,omitempty"`
+	TaxBasisTotalAmount  *Amount  `xml:"ram:TaxBasisTotalAmount"`
+	TaxTotalAmount       []Amount `xml:"ram:TaxTotalAmount,omitempty"`
+	RoundingAmount       *Amount  `xml:"ram:RoundingAmount,omitempty"`
+	GrandTotalAmount     *Amount  `xml:"ram:GrandTotalAmount"`
+	TotalPrepaidAmount   *Amount  `xml:"ram:TotalPrepaidAmount,omitempty"`
+	DuePayableAmount     *Amount  `xml:"ram:DuePayableAmount"`
+}
+
+// TradeParty - ram:TradePartyType
+type TradeParty struct {
+	ID                         []ID                    `xml:"ram:ID,omitempty"`
+	GlobalID                   []ID                    `xml:"ram:GlobalID,omitempty"`
+	Name                       string                  `xml:"ram:Name,omitempty"`
+	SpecifiedLegalOrganization *LegalOrganization      `xml:"ram:SpecifiedLegalOrganization,omitempty"`
+	PostalTradeAddress         *TradeAddress           `xml:"ram:PostalTradeAddress,omitempty"`
+	URIUniversalCommunication  *UniversalCommunication `xml:"ram:URIUniversalCommunication,omitempty"`
+	SpecifiedTaxRegistration   []TaxRegistration       `xml:"ram:SpecifiedTaxRegistration,omitempty"`
+}
+
+// LegalOrganization - ram:LegalOrganizationType
+type LegalOrganization struct {
+	ID                  *ID    `xml:"ram:ID,omitempty"`
+	TradingBusinessName string `xml:"ram:TradingBusinessName,omitempty"`
+}
+
+// TradeAddress - ram:TradeAddressType
+type TradeAddress struct {
+	PostcodeCode           string `xml:"ram:PostcodeCode,omitempty"`
+	LineOne                string `xml:"ram:LineOne,omitempty"`
+	LineTwo                string `xml:"ram:LineTwo,omitempty"`
+	LineThree              string `xml:"ram:LineThree,omitempty"`
+	CityName               string `xml:"ram:CityName,omitempty"`
+	CountryID              string `xml:"ram:CountryID"`
+	CountrySubDivisionName string `xml:"ram:CountrySubDivisionName,omitempty"`
+}
+
+// UniversalCommunication - ram:UniversalCommunicationType
+type UniversalCommunication struct {
+	URIID *ID `xml:"ram:URIID"`
+}
+
+// TaxRegistration - ram:TaxRegistrationType, schemeID is VA for a VAT
+// identifier and FC for a tax registration identifier
+type TaxRegistration struct {
+	ID ID `xml:"ram:ID"`
+}
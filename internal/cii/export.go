@@ -0,0 +1,549 @@
+package cii
+
+import (
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+)
+
+// Default document type codes (UNCL1001) when the UBL document has none
+const (
+	TypeCodeInvoice    = "380"
+	TypeCodeCreditNote = "381"
+)
+
+// taxTypeVAT - ram:TypeCode of a tax without a tax scheme
+const taxTypeVAT = "VAT"
+
+// document - the parts of a UBL Invoice or CreditNote that CII carries
+type document struct {
+	customizationID   string
+	profileID         string
+	id                string
+	issueDate         string
+	dueDate           string
+	typeCode          string
+	notes             []string
+	taxPointDate      string
+	currencyCode      string
+	taxCurrencyCode   string
+	accountingCost    string
+	buyerReference    string
+	invoicePeriod     *ubl.Period
+	orderReference    *ubl.OrderReference
+	billingReference  []ubl.BillingReference
+	despatchReference []ubl.DocumentReference
+	receiptReference  []ubl.DocumentReference
+	seller            *ubl.Party
+	buyer             *ubl.Party
+	payee             *ubl.Party
+	taxRepresentative *ubl.Party
+	paymentTerms      []ubl.PaymentTerms
+	taxExchangeRate   *ubl.ExchangeRate
+	taxTotals         []ubl.TaxTotal
+	monetaryTotal     *ubl.MonetaryTotal
+	lines             []line
+}
+
+// line - the parts of an invoice or credit note line that CII carries
+type line struct {
+	id                    string
+	notes                 []string
+	quantity              *ubl.Quantity
+	lineExtensionAmount   *ubl.Amount
+	accountingCost        string
+	invoicePeriod         *ubl.Period
+	orderLineReference    []ubl.OrderLineReference
+	despatchLineReference []ubl.LineReference
+	receiptLineReference  []ubl.LineReference
+	billingReference      []ubl.BillingReference
+	item                  *ubl.Item
+	price                 *ubl.Price
+}
+
+// InvoiceFromSource - build a CrossIndustryInvoice from the same data as
+// ubl.InvoiceFromSource
+func InvoiceFromSource(src *ubl.InvoiceSource) (*CrossIndustryInvoice, error) {
+	inv, err := ubl.InvoiceFromSource(src)
+	if err != nil {
+		return nil, err
+	}
+	return FromInvoice(inv)
+}
+
+// CreditNoteFromSource - build a CrossIndustryInvoice with a credit note
+// type code from the same data as ubl.CreditNoteFromSource
+func CreditNoteFromSource(src *ubl.CreditNoteSource) (*CrossIndustryInvoice, error) {
+	cn, err := ubl.CreditNoteFromSource(src)
+	if err != nil {
+		return nil, err
+	}
+	return FromCreditNote(cn)
+}
+
+// FromInvoice - map a UBL Invoice onto a CrossIndustryInvoice
+func FromInvoice(inv *ubl.Invoice) (*CrossIndustryInvoice, error) {
+	if inv == nil {
+		return nil, errors.New("cii: invoice is required")
+	}
+	doc := document{
+		customizationID:   inv.CustomizationID,
+		profileID:         inv.ProfileID,
+		id:                inv.ID,
+		issueDate:         inv.IssueDate,
+		dueDate:           inv.DueDate,
+		typeCode:          inv.InvoiceTypeCode,
+		notes:             inv.Note,
+		taxPointDate:      inv.TaxPointDate,
+		currencyCode:      inv.DocumentCurrencyCode,
+		taxCurrencyCode:   inv.TaxCurrencyCode,
+		accountingCost:    inv.AccountingCost,
+		buyerReference:    inv.BuyerReference,
+		invoicePeriod:     inv.InvoicePeriod,
+		orderReference:    inv.OrderReference,
+		billingReference:  inv.BillingReference,
+		despatchReference: inv.DespatchDocumentReference,
+		receiptReference:  inv.ReceiptDocumentReference,
+		payee:             inv.PayeeParty,
+		taxRepresentative: inv.TaxRepresentativeParty,
+		paymentTerms:      inv.PaymentTerms,
+		taxExchangeRate:   inv.TaxExchangeRate,
+		taxTotals:         inv.TaxTotal,
+		monetaryTotal:     inv.LegalMonetaryTotal,
+	}
+	if doc.typeCode == "" {
+		doc.typeCode = TypeCodeInvoice
+	}
+	if inv.AccountingSupplierParty != nil {
+		doc.seller = inv.AccountingSupplierParty.Party
+	}
+	if inv.AccountingCustomerParty != nil {
+		doc.buyer = inv.AccountingCustomerParty.Party
+	}
+	for _, il := range inv.InvoiceLine {
+		doc.lines = append(doc.lines, line{
+			id:                    il.ID,
+			notes:                 il.Note,
+			quantity:              il.InvoicedQuantity,
+			lineExtensionAmount:   il.LineExtensionAmount,
+			accountingCost:        il.AccountingCost,
+			invoicePeriod:         il.InvoicePeriod,
+			orderLineReference:    il.OrderLineReference,
+			despatchLineReference: il.DespatchLineReference,
+			receiptLineReference:  il.ReceiptLineReference,
+			billingReference:      il.BillingReference,
+			item:                  il.Item,
+			price:                 il.Price,
+		})
+	}
+	return doc.crossIndustryInvoice(), nil
+}
+
+// FromCreditNote - map a UBL CreditNote onto a CrossIndustryInvoice
+func FromCreditNote(cn *ubl.CreditNote) (*CrossIndustryInvoice, error) {
+	if cn == nil {
+		return nil, errors.New("cii: credit note is required")
+	}
+	doc := document{
+		customizationID:   cn.CustomizationID,
+		profileID:         cn.ProfileID,
+		id:                cn.ID,
+		issueDate:         cn.IssueDate,
+		typeCode:          cn.CreditNoteTypeCode,
+		notes:             cn.Note,
+		taxPointDate:      cn.TaxPointDate,
+		currencyCode:      cn.DocumentCurrencyCode,
+		taxCurrencyCode:   cn.TaxCurrencyCode,
+		accountingCost:    cn.AccountingCost,
+		buyerReference:    cn.BuyerReference,
+		invoicePeriod:     cn.InvoicePeriod,
+		orderReference:    cn.OrderReference,
+		billingReference:  cn.BillingReference,
+		despatchReference: cn.DespatchDocumentReference,
+		receiptReference:  cn.ReceiptDocumentReference,
+		payee:             cn.PayeeParty,
+		taxRepresentative: cn.TaxRepresentativeParty,
+		paymentTerms:      cn.PaymentTerms,
+		taxExchangeRate:   cn.TaxExchangeRate,
+		taxTotals:         cn.TaxTotal,
+		monetaryTotal:     cn.LegalMonetaryTotal,
+	}
+	if doc.typeCode == "" {
+		doc.typeCode = TypeCodeCreditNote
+	}
+	if cn.AccountingSupplierParty != nil {
+		doc.seller = cn.AccountingSupplierParty.Party
+	}
+	if cn.AccountingCustomerParty != nil {
+		doc.buyer = cn.AccountingCustomerParty.Party
+	}
+	for _, cl := range cn.CreditNoteLine {
+		doc.lines = append(doc.lines, line{
+			id:                    cl.ID,
+			notes:                 cl.Note,
+			quantity:              cl.CreditedQuantity,
+			lineExtensionAmount:   cl.LineExtensionAmount,
+			accountingCost:        cl.AccountingCost,
+			invoicePeriod:         cl.InvoicePeriod,
+			orderLineReference:    cl.OrderLineReference,
+			despatchLineReference: cl.DespatchLineReference,
+			receiptLineReference:  cl.ReceiptLineReference,
+			billingReference:      cl.BillingReference,
+			item:                  cl.Item,
+			price:                 cl.Price,
+		})
+	}
+	return doc.crossIndustryInvoice(), nil
+}
+
+func (doc *document) crossIndustryInvoice() *CrossIndustryInvoice {
+	ci := CrossIndustryInvoice{Namespaces: NewNamespaces()}
+
+	guideline := doc.customizationID
+	if guideline == "" {
+		guideline = GuidelineEN16931
+	}
+	ci.ExchangedDocumentContext = &DocumentContext{GuidelineSpecifiedDocumentContextParameter: &DocumentContextParameter{ID: guideline}}
+	if doc.profileID != "" {
+		ci.ExchangedDocumentContext.BusinessProcessSpecifiedDocumentContextParameter = &DocumentContextParameter{ID: doc.profileID}
+	}
+
+	ci.ExchangedDocument = &ExchangedDocument{
+		ID:            doc.id,
+		TypeCode:      doc.typeCode,
+		IssueDateTime: newDateTime(doc.issueDate),
+		IncludedNote:  includedNotes(doc.notes),
+	}
+
+	tx := SupplyChainTradeTransaction{}
+	for _, l := range doc.lines {
+		tx.IncludedSupplyChainTradeLineItem = append(tx.IncludedSupplyChainTradeLineItem, l.lineItem())
+	}
+
+	tx.ApplicableHeaderTradeAgreement = &HeaderTradeAgreement{
+		BuyerReference:                    doc.buyerReference,
+		SellerTradeParty:                  tradeParty(doc.seller),
+		BuyerTradeParty:                   tradeParty(doc.buyer),
+		SellerTaxRepresentativeTradeParty: tradeParty(doc.taxRepresentative),
+	}
+	if doc.orderReference != nil && doc.orderReference.ID != "" {
+		tx.ApplicableHeaderTradeAgreement.BuyerOrderReferencedDocument = &ReferencedDocument{IssuerAssignedID: doc.orderReference.ID}
+	}
+
+	tx.ApplicableHeaderTradeDelivery = &HeaderTradeDelivery{
+		DespatchAdviceReferencedDocument:  documentReference(doc.despatchReference),
+		ReceivingAdviceReferencedDocument: documentReference(doc.receiptReference),
+	}
+
+	settlement := HeaderTradeSettlement{
+		TaxCurrencyCode:                           doc.taxCurrencyCode,
+		InvoiceCurrencyCode:                       doc.currencyCode,
+		PayeeTradeParty:                           tradeParty(doc.payee),
+		TaxApplicableTradeCurrencyExchange:        currencyExchange(doc.taxExchangeRate),
+		BillingSpecifiedPeriod:                    period(doc.invoicePeriod),
+		SpecifiedTradePaymentTerms:                paymentTerms(doc.paymentTerms, doc.dueDate),
+		InvoiceReferencedDocument:                 billingReference(doc.billingReference),
+		ReceivableSpecifiedTradeAccountingAccount: accountingAccount(doc.accountingCost),
+	}
+	summation := HeaderMonetarySummation{}
+	for _, tt := range doc.taxTotals {
+		if tt.TaxAmount != nil {
+			currencyID := tt.TaxAmount.CurrencyID
+			if currencyID == "" {
+				currencyID = doc.currencyCode
+			}
+			summation.TaxTotalAmount = append(summation.TaxTotalAmount, Amount{Value: tt.TaxAmount.Value, CurrencyID: currencyID})
+		}
+		for _, st := range tt.TaxSubtotal {
+			settlement.ApplicableTradeTax = append(settlement.ApplicableTradeTax, headerTradeTax(st, doc.taxPointDate))
+		}
+	}
+	if mt := doc.monetaryTotal; mt != nil {
+		summation.LineTotalAmount = amount(mt.LineExtensionAmount)
+		summation.ChargeTotalAmount = amount(mt.ChargeTotalAmount)
+		summation.AllowanceTotalAmount = amount(mt.AllowanceTotalAmount)
+		summation.TaxBasisTotalAmount = amount(mt.TaxExclusiveAmount)
+		summation.RoundingAmount = amount(mt.PayableRoundingAmount)
+		summation.GrandTotalAmount = amount(mt.TaxInclusiveAmount)
+		summation.TotalPrepaidAmount = amount(mt.PrepaidAmount)
+		summation.DuePayableAmount = amount(mt.PayableAmount)
+	}
+	settlement.SpecifiedTradeSettlementHeaderMonetarySummation = &summation
+	tx.ApplicableHeaderTradeSettlement = &settlement
+
+	ci.SupplyChainTradeTransaction = &tx
+	return &ci
+}
+
+// lineItem - map an invoice or credit note line
+func (l *line) lineItem() LineItem {
+	li := LineItem{}
+	li.AssociatedDocumentLineDocument = &LineDocument{LineID: l.id, IncludedNote: includedNotes(l.notes)}
+	li.SpecifiedTradeProduct = tradeProduct(l.item)
+
+	agreement := LineTradeAgreement{}
+	if len(l.orderLineReference) > 0 {
+		ref := l.orderLineReference[0]
+		agreement.BuyerOrderReferencedDocument = &ReferencedDocument{LineID: ref.LineID}
+		if ref.OrderReference != nil {
+			agreement.BuyerOrderReferencedDocument.IssuerAssignedID = ref.OrderReference.ID
+		}
+	}
+	if p := l.price; p != nil {
+		agreement.NetPriceProductTradePrice = &TradePrice{ChargeAmount: amount(p.PriceAmount), BasisQuantity: quantity(p.BaseQuantity)}
+	} else {
+		agreement.NetPriceProductTradePrice = &TradePrice{ChargeAmount: &Amount{Value: ubl.FormatAmount("0")}}
+	}
+	li.SpecifiedLineTradeAgreement = &agreement
+
+	li.SpecifiedLineTradeDelivery = &LineTradeDelivery{
+		BilledQuantity:                    quantity(l.quantity),
+		DespatchAdviceReferencedDocument:  lineReference(l.despatchLineReference),
+		ReceivingAdviceReferencedDocument: lineReference(l.receiptLineReference),
+	}
+
+	settlement := LineTradeSettlement{
+		BillingSpecifiedPeriod:                        period(l.invoicePeriod),
+		SpecifiedTradeSettlementLineMonetarySummation: &LineMonetarySummation{LineTotalAmount: amount(l.lineExtensionAmount)},
+		InvoiceReferencedDocument:                     billingReference(l.billingReference),
+		ReceivableSpecifiedTradeAccountingAccount:     accountingAccount(l.accountingCost),
+	}
+	if l.item != nil && len(l.item.ClassifiedTaxCategory) > 0 {
+		tc := l.item.ClassifiedTaxCategory[0]
+		settlement.ApplicableTradeTax = []TradeTax{{TypeCode: taxTypeCode(tc.TaxScheme), CategoryCode: tc.ID, RateApplicablePercent: tc.Percent}}
+	}
+	li.SpecifiedLineTradeSettlement = &settlement
+	return li
+}
+
+func includedNotes(notes []string) []Note {
+	var ns []Note
+	for _, n := range notes {
+		if n != "" {
+			ns = append(ns, Note{Content: n})
+		}
+	}
+	return ns
+}
+
+// amount - a UBL amount without its currency, CII only writes the
+// currency of tax totals
+func amount(a *ubl.Amount) *Amount {
+	if a == nil {
+		return nil
+	}
+	return &Amount{Value: a.Value}
+}
+
+func quantity(q *ubl.Quantity) *Quantity {
+	if q == nil {
+		return nil
+	}
+	return &Quantity{Value: q.Value, UnitCode: q.UnitCode}
+}
+
+func period(p *ubl.Period) *Period {
+	if p == nil {
+		return nil
+	}
+	start, end := newDateTime(p.StartDate), newDateTime(p.EndDate)
+	if start == nil && end == nil {
+		return nil
+	}
+	return &Period{StartDateTime: start, EndDateTime: end}
+}
+
+func accountingAccount(id string) *AccountingAccount {
+	if id == "" {
+		return nil
+	}
+	return &AccountingAccount{ID: id}
+}
+
+// documentReference - the first referenced document, CII references one
+func documentReference(refs []ubl.DocumentReference) *ReferencedDocument {
+	if len(refs) == 0 || refs[0].ID == "" {
+		return nil
+	}
+	return &ReferencedDocument{IssuerAssignedID: refs[0].ID}
+}
+
+// lineReference - the first referenced line with its document
+func lineReference(refs []ubl.LineReference) *ReferencedDocument {
+	if len(refs) == 0 || refs[0].LineID == "" {
+		return nil
+	}
+	rd := ReferencedDocument{LineID: refs[0].LineID}
+	if refs[0].DocumentReference != nil {
+		rd.IssuerAssignedID = refs[0].DocumentReference.ID
+	}
+	return &rd
+}
+
+// billingReference - the first referenced invoice (BT-25)
+func billingReference(refs []ubl.BillingReference) *ReferencedDocument {
+	for _, ref := range refs {
+		if dr := ref.InvoiceDocumentReference; dr != nil && dr.ID != "" {
+			return &ReferencedDocument{IssuerAssignedID: dr.ID}
+		}
+	}
+	return nil
+}
+
+func currencyExchange(er *ubl.ExchangeRate) *CurrencyExchange {
+	if er == nil {
+		return nil
+	}
+	return &CurrencyExchange{
+		SourceCurrencyCode:     er.SourceCurrencyCode,
+		SourceUnitBasisNumeric: er.SourceCurrencyBaseRate,
+		TargetCurrencyCode:     er.TargetCurrencyCode,
+		TargetUnitBasisNumeric: er.TargetCurrencyBaseRate,
+		MarketID:               er.ExchangeMarketID,
+		ConversionRate:         er.CalculationRate,
+		ConversionRateDateTime: newDateTime(er.Date),
+	}
+}
+
+// paymentTerms - map payment terms, the due date of an invoice goes on the
+// first payment terms or on payment terms of its own when there are none
+func paymentTerms(terms []ubl.PaymentTerms, dueDate string) []PaymentTerms {
+	var pts []PaymentTerms
+	for _, t := range terms {
+		pts = append(pts, PaymentTerms{Description: t.Note, DueDateDateTime: newDateTime(t.PaymentDueDate), PartialPaymentAmount: amount(t.Amount)})
+	}
+	if due := newDateTime(dueDate); due != nil {
+		if len(pts) == 0 {
+			pts = []PaymentTerms{{DueDateDateTime: due}}
+		} else if pts[0].DueDateDateTime == nil {
+			pts[0].DueDateDateTime = due
+		}
+	}
+	return pts
+}
+
+func taxTypeCode(ts *ubl.TaxScheme) string {
+	if ts == nil || ts.ID == "" {
+		return taxTypeVAT
+	}
+	return ts.ID
+}
+
+// headerTradeTax - map a VAT breakdown (BG-23)
+func headerTradeTax(st ubl.TaxSubtotal, taxPointDate string) TradeTax {
+	tax := TradeTax{
+		CalculatedAmount:      amount(st.TaxAmount),
+		TypeCode:              taxTypeVAT,
+		BasisAmount:           amount(st.TaxableAmount),
+		TaxPointDate:          newDate(taxPointDate),
+		RateApplicablePercent: st.Percent,
+	}
+	if tc := st.TaxCategory; tc != nil {
+		tax.TypeCode = taxTypeCode(tc.TaxScheme)
+		tax.ExemptionReason = tc.TaxExemptionReason
+		tax.CategoryCode = tc.ID
+		tax.ExemptionReasonCode = tc.TaxExemptionReasonCode
+		if tax.RateApplicablePercent == "" {
+			tax.RateApplicablePercent = tc.Percent
+		}
+	}
+	return tax
+}
+
+// tradeProduct - map an item, its standard identification is the global id
+func tradeProduct(it *ubl.Item) *TradeProduct {
+	if it == nil {
+		return &TradeProduct{}
+	}
+	tp := TradeProduct{Name: it.Name, Description: it.Description}
+	if ii := it.StandardItemIdentification; ii != nil && ii.ID.Value != "" {
+		tp.GlobalID = &ID{Value: ii.ID.Value, SchemeID: ii.ID.SchemeID}
+	}
+	if ii := it.SellersItemIdentification; ii != nil {
+		tp.SellerAssignedID = ii.ID.Value
+	}
+	if ii := it.BuyersItemIdentification; ii != nil {
+		tp.BuyerAssignedID = ii.ID.Value
+	}
+	if it.OriginCountry != nil && it.OriginCountry.IdentificationCode != "" {
+		tp.OriginTradeCountry = &TradeCountry{ID: it.OriginCountry.IdentificationCode}
+	}
+	return &tp
+}
+
+// tradeParty - map a party. The legal registration name is the party name
+// and a differing cac:PartyName the trading name; identifications with a
+// scheme are global ids.
+func tradeParty(p *ubl.Party) *TradeParty {
+	if p == nil {
+		return nil
+	}
+	tp := TradeParty{}
+	for _, pi := range p.PartyIdentification {
+		if pi.ID.Value == "" {
+			continue
+		}
+		if pi.ID.SchemeID != "" {
+			tp.GlobalID = append(tp.GlobalID, ID{Value: pi.ID.Value, SchemeID: pi.ID.SchemeID})
+		} else {
+			tp.ID = append(tp.ID, ID{Value: pi.ID.Value})
+		}
+	}
+	tp.Name = p.RegistrationName()
+	if tp.Name == "" {
+		tp.Name = p.Name()
+	}
+
+	lo := LegalOrganization{}
+	for _, ple := range p.PartyLegalEntity {
+		if ple.CompanyID != "" {
+			lo.ID = &ID{Value: ple.CompanyID}
+			break
+		}
+	}
+	if name := p.Name(); name != tp.Name {
+		lo.TradingBusinessName = name
+	}
+	if lo.ID != nil || lo.TradingBusinessName != "" {
+		tp.SpecifiedLegalOrganization = &lo
+	}
+
+	if a := p.PostalAddress; a != nil {
+		ta := TradeAddress{
+			PostcodeCode:           a.PostalZone,
+			LineOne:                a.StreetName,
+			LineTwo:                a.AdditionalStreetName,
+			CityName:               a.CityName,
+			CountrySubDivisionName: a.CountrySubentity,
+		}
+		if len(a.AddressLine) > 0 {
+			ta.LineThree = a.AddressLine[0].Line
+		}
+		if a.Country != nil {
+			ta.CountryID = a.Country.IdentificationCode
+		}
+		tp.PostalTradeAddress = &ta
+	}
+
+	if endpointID := p.EndpointValue(); endpointID != "" {
+		tp.URIUniversalCommunication = &UniversalCommunication{URIID: &ID{Value: endpointID, SchemeID: p.EndpointID.SchemeID}}
+	}
+
+	for _, pts := range p.PartyTaxScheme {
+		if pts.CompanyID == "" {
+			continue
+		}
+		schemeID := taxRegistrationFC
+		if taxTypeCode(pts.TaxScheme) == taxTypeVAT {
+			schemeID = taxRegistrationVA
+		}
+		tp.SpecifiedTaxRegistration = append(tp.SpecifiedTaxRegistration, TaxRegistration{ID: ID{Value: pts.CompanyID, SchemeID: schemeID}})
+	}
+	return &tp
+}
+
+// schemeIDs of ram:SpecifiedTaxRegistration
+const (
+	taxRegistrationVA = "VA"
+	taxRegistrationFC = "FC"
+)
@@ -0,0 +1,520 @@
+package cii
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+)
+
+// root - XPath of the document root
+const root = "/rsm:CrossIndustryInvoice"
+
+// creditNoteTypeCodes - the UNCL1001 codes of credit notes
+var creditNoteTypeCodes = map[string]bool{
+	"81": true, "83": true, "261": true, "262": true, "296": true, "308": true,
+	"381": true, "396": true, "420": true, "458": true, "532": true,
+}
+
+// IsCreditNote - whether typeCode is the type code of a credit note
+func IsCreditNote(typeCode string) bool {
+	return creditNoteTypeCodes[strings.TrimSpace(typeCode)]
+}
+
+// TypeCode - type code of a CrossIndustryInvoice, empty when it has none
+func (ci *CrossIndustryInvoice) TypeCode() string {
+	if ci.ExchangedDocument == nil {
+		return ""
+	}
+	return strings.TrimSpace(ci.ExchangedDocument.TypeCode)
+}
+
+// reader - maps CII values onto UBL values, collecting a ubl.FieldError
+// for every value that does not fit
+type reader struct {
+	errs         ubl.FieldErrors
+	currencyCode string
+}
+
+func (r *reader) fail(path string, format string, args ...interface{}) {
+	r.errs = append(r.errs, &ubl.FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *reader) result() error {
+	if len(r.errs) > 0 {
+		return r.errs
+	}
+	return nil
+}
+
+// date - a udt:DateTimeString as a UBL date
+func (r *reader) date(path string, dt *DateTime) string {
+	if dt == nil {
+		return ""
+	}
+	d, err := ParseDate(dt.DateTimeString)
+	if err != nil {
+		r.fail(path+"/udt:DateTimeString", "%s", err)
+	}
+	return d
+}
+
+func (r *reader) period(path string, p *Period) *ubl.Period {
+	if p == nil {
+		return nil
+	}
+	start := r.date(path+"/ram:StartDateTime", p.StartDateTime)
+	end := r.date(path+"/ram:EndDateTime", p.EndDateTime)
+	if start == "" && end == "" {
+		return nil
+	}
+	return &ubl.Period{StartDate: start, EndDate: end}
+}
+
+// ToInvoice - map a CrossIndustryInvoice onto a UBL Invoice for
+// ubl.ImportInvoice. CII values that cannot be mapped are returned as
+// ubl.FieldErrors located by their CII XPath.
+func ToInvoice(ci *CrossIndustryInvoice) (*ubl.Invoice, error) {
+	if IsCreditNote(ci.TypeCode()) {
+		return nil, ubl.FieldErrors{{Path: root + "/rsm:ExchangedDocument/ram:TypeCode", Message: fmt.Sprintf("type code %q is a credit note type code", ci.TypeCode())}}
+	}
+	r := reader{}
+	doc := r.document(ci)
+	if err := r.result(); err != nil {
+		return nil, err
+	}
+
+	inv := ubl.Invoice{Namespaces: ubl.NewNamespaces(ubl.NamespaceInvoice)}
+	inv.CustomizationID = doc.customizationID
+	inv.ProfileID = doc.profileID
+	inv.ID = doc.id
+	inv.IssueDate = doc.issueDate
+	inv.DueDate = doc.dueDate
+	inv.InvoiceTypeCode = doc.typeCode
+	inv.Note = doc.notes
+	inv.TaxPointDate = doc.taxPointDate
+	inv.DocumentCurrencyCode = doc.currencyCode
+	inv.TaxCurrencyCode = doc.taxCurrencyCode
+	inv.AccountingCost = doc.accountingCost
+	inv.BuyerReference = doc.buyerReference
+	inv.InvoicePeriod = doc.invoicePeriod
+	inv.OrderReference = doc.orderReference
+	inv.BillingReference = doc.billingReference
+	inv.DespatchDocumentReference = doc.despatchReference
+	inv.ReceiptDocumentReference = doc.receiptReference
+	inv.AccountingSupplierParty = &ubl.SupplierParty{Party: doc.seller}
+	inv.AccountingCustomerParty = &ubl.CustomerParty{Party: doc.buyer}
+	inv.PayeeParty = doc.payee
+	inv.TaxRepresentativeParty = doc.taxRepresentative
+	for _, pt := range doc.paymentTerms {
+		// the due date of an invoice is cbc:DueDate, not payment terms
+		if pt.PaymentDueDate == inv.DueDate {
+			pt.PaymentDueDate = ""
+			if pt == (ubl.PaymentTerms{}) {
+				continue
+			}
+		}
+		inv.PaymentTerms = append(inv.PaymentTerms, pt)
+	}
+	inv.TaxExchangeRate = doc.taxExchangeRate
+	inv.TaxTotal = doc.taxTotals
+	inv.LegalMonetaryTotal = doc.monetaryTotal
+	for _, l := range doc.lines {
+		inv.InvoiceLine = append(inv.InvoiceLine, ubl.InvoiceLine{
+			ID:                    l.id,
+			Note:                  l.notes,
+			InvoicedQuantity:      l.quantity,
+			LineExtensionAmount:   l.lineExtensionAmount,
+			AccountingCost:        l.accountingCost,
+			InvoicePeriod:         l.invoicePeriod,
+			OrderLineReference:    l.orderLineReference,
+			DespatchLineReference: l.despatchLineReference,
+			ReceiptLineReference:  l.receiptLineReference,
+			BillingReference:      l.billingReference,
+			Item:                  l.item,
+			Price:                 l.price,
+		})
+	}
+	return &inv, nil
+}
+
+// ToCreditNote - map a CrossIndustryInvoice with a credit note type code
+// onto a UBL CreditNote for ubl.ImportCreditNote
+func ToCreditNote(ci *CrossIndustryInvoice) (*ubl.CreditNote, error) {
+	if !IsCreditNote(ci.TypeCode()) {
+		return nil, ubl.FieldErrors{{Path: root + "/rsm:ExchangedDocument/ram:TypeCode", Message: fmt.Sprintf("type code %q is not a credit note type code", ci.TypeCode())}}
+	}
+	r := reader{}
+	doc := r.document(ci)
+	if err := r.result(); err != nil {
+		return nil, err
+	}
+
+	cn := ubl.CreditNote{Namespaces: ubl.NewNamespaces(ubl.NamespaceCreditNote)}
+	cn.CustomizationID = doc.customizationID
+	cn.ProfileID = doc.profileID
+	cn.ID = doc.id
+	cn.IssueDate = doc.issueDate
+	cn.TaxPointDate = doc.taxPointDate
+	cn.CreditNoteTypeCode = doc.typeCode
+	cn.Note = doc.notes
+	cn.DocumentCurrencyCode = doc.currencyCode
+	cn.TaxCurrencyCode = doc.taxCurrencyCode
+	cn.AccountingCost = doc.accountingCost
+	cn.BuyerReference = doc.buyerReference
+	cn.InvoicePeriod = doc.invoicePeriod
+	cn.OrderReference = doc.orderReference
+	cn.BillingReference = doc.billingReference
+	cn.DespatchDocumentReference = doc.despatchReference
+	cn.ReceiptDocumentReference = doc.receiptReference
+	cn.AccountingSupplierParty = &ubl.SupplierParty{Party: doc.seller}
+	cn.AccountingCustomerParty = &ubl.CustomerParty{Party: doc.buyer}
+	cn.PayeeParty = doc.payee
+	cn.TaxRepresentativeParty = doc.taxRepresentative
+	cn.PaymentTerms = doc.paymentTerms
+	cn.TaxExchangeRate = doc.taxExchangeRate
+	cn.TaxTotal = doc.taxTotals
+	cn.LegalMonetaryTotal = doc.monetaryTotal
+	for _, l := range doc.lines {
+		cn.CreditNoteLine = append(cn.CreditNoteLine, ubl.CreditNoteLine{
+			ID:                    l.id,
+			Note:                  l.notes,
+			CreditedQuantity:      l.quantity,
+			LineExtensionAmount:   l.lineExtensionAmount,
+			AccountingCost:        l.accountingCost,
+			InvoicePeriod:         l.invoicePeriod,
+			OrderLineReference:    l.orderLineReference,
+			DespatchLineReference: l.despatchLineReference,
+			ReceiptLineReference:  l.receiptLineReference,
+			BillingReference:      l.billingReference,
+			Item:                  l.item,
+			Price:                 l.price,
+		})
+	}
+	return &cn, nil
+}
+
+// document - read the parts of a CrossIndustryInvoice that UBL carries
+func (r *reader) document(ci *CrossIndustryInvoice) document {
+	doc := document{}
+	if dc := ci.ExchangedDocumentContext; dc != nil {
+		if p := dc.GuidelineSpecifiedDocumentContextParameter; p != nil {
+			doc.customizationID = strings.TrimSpace(p.ID)
+		}
+		if p := dc.BusinessProcessSpecifiedDocumentContextParameter; p != nil {
+			doc.profileID = strings.TrimSpace(p.ID)
+		}
+	}
+
+	if ed := ci.ExchangedDocument; ed == nil {
+		r.fail(root+"/rsm:ExchangedDocument", "is required")
+	} else {
+		path := root + "/rsm:ExchangedDocument"
+		doc.id = strings.TrimSpace(ed.ID)
+		doc.typeCode = strings.TrimSpace(ed.TypeCode)
+		doc.issueDate = r.date(path+"/ram:IssueDateTime", ed.IssueDateTime)
+		for _, n := range ed.IncludedNote {
+			doc.notes = append(doc.notes, n.Content)
+		}
+	}
+
+	tx := ci.SupplyChainTradeTransaction
+	if tx == nil {
+		r.fail(root+"/rsm:SupplyChainTradeTransaction", "is required")
+		return doc
+	}
+	txPath := root + "/rsm:SupplyChainTradeTransaction"
+
+	if a := tx.ApplicableHeaderTradeAgreement; a != nil {
+		doc.buyerReference = strings.TrimSpace(a.BuyerReference)
+		doc.seller = party(a.SellerTradeParty)
+		doc.buyer = party(a.BuyerTradeParty)
+		doc.taxRepresentative = party(a.SellerTaxRepresentativeTradeParty)
+		if ref := a.BuyerOrderReferencedDocument; ref != nil && ref.IssuerAssignedID != "" {
+			doc.orderReference = &ubl.OrderReference{ID: ref.IssuerAssignedID}
+		}
+	}
+
+	if d := tx.ApplicableHeaderTradeDelivery; d != nil {
+		doc.despatchReference = ublDocumentReference(d.DespatchAdviceReferencedDocument)
+		doc.receiptReference = ublDocumentReference(d.ReceivingAdviceReferencedDocument)
+	}
+
+	if s := tx.ApplicableHeaderTradeSettlement; s != nil {
+		path := txPath + "/ram:ApplicableHeaderTradeSettlement"
+		doc.currencyCode = strings.TrimSpace(s.InvoiceCurrencyCode)
+		r.currencyCode = doc.currencyCode
+		doc.taxCurrencyCode = strings.TrimSpace(s.TaxCurrencyCode)
+		doc.payee = party(s.PayeeTradeParty)
+		if ce := s.TaxApplicableTradeCurrencyExchange; ce != nil {
+			doc.taxExchangeRate = &ubl.ExchangeRate{
+				SourceCurrencyCode:     ce.SourceCurrencyCode,
+				SourceCurrencyBaseRate: ce.SourceUnitBasisNumeric,
+				TargetCurrencyCode:     ce.TargetCurrencyCode,
+				TargetCurrencyBaseRate: ce.TargetUnitBasisNumeric,
+				ExchangeMarketID:       ce.MarketID,
+				CalculationRate:        ce.ConversionRate,
+				Date:                   r.date(path+"/ram:TaxApplicableTradeCurrencyExchange/ram:ConversionRateDateTime", ce.ConversionRateDateTime),
+			}
+		}
+		doc.invoicePeriod = r.period(path+"/ram:BillingSpecifiedPeriod", s.BillingSpecifiedPeriod)
+		for i, pt := range s.SpecifiedTradePaymentTerms {
+			ptPath := fmt.Sprintf("%s/ram:SpecifiedTradePaymentTerms[%d]", path, i+1)
+			dueDate := r.date(ptPath+"/ram:DueDateDateTime", pt.DueDateDateTime)
+			if doc.dueDate == "" {
+				doc.dueDate = dueDate
+			}
+			doc.paymentTerms = append(doc.paymentTerms, ubl.PaymentTerms{Note: pt.Description, PaymentDueDate: dueDate, Amount: r.amount(pt.PartialPaymentAmount)})
+		}
+		if ref := s.InvoiceReferencedDocument; ref != nil && ref.IssuerAssignedID != "" {
+			doc.billingReference = []ubl.BillingReference{{InvoiceDocumentReference: &ubl.DocumentReference{ID: ref.IssuerAssignedID}}}
+		}
+		if aa := s.ReceivableSpecifiedTradeAccountingAccount; aa != nil {
+			doc.accountingCost = aa.ID
+		}
+		doc.taxTotals = r.taxTotals(path, s, doc.currencyCode, &doc.taxPointDate)
+		if ms := s.SpecifiedTradeSettlementHeaderMonetarySummation; ms != nil {
+			doc.monetaryTotal = &ubl.MonetaryTotal{
+				LineExtensionAmount:   r.amount(ms.LineTotalAmount),
+				TaxExclusiveAmount:    r.amount(ms.TaxBasisTotalAmount),
+				TaxInclusiveAmount:    r.amount(ms.GrandTotalAmount),
+				AllowanceTotalAmount:  r.amount(ms.AllowanceTotalAmount),
+				ChargeTotalAmount:     r.amount(ms.ChargeTotalAmount),
+				PrepaidAmount:         r.amount(ms.TotalPrepaidAmount),
+				PayableRoundingAmount: r.amount(ms.RoundingAmount),
+				PayableAmount:         r.amount(ms.DuePayableAmount),
+			}
+		}
+	}
+
+	for i := range tx.IncludedSupplyChainTradeLineItem {
+		path := fmt.Sprintf("%s/ram:IncludedSupplyChainTradeLineItem[%d]", txPath, i+1)
+		doc.lines = append(doc.lines, r.line(path, &tx.IncludedSupplyChainTradeLineItem[i]))
+	}
+	return doc
+}
+
+// taxTotals - the VAT breakdown as the cac:TaxTotal in the document
+// currency and a tax total amount in another currency as a cac:TaxTotal of
+// its own. The tax point date is taken from the first breakdown that has
+// one.
+func (r *reader) taxTotals(path string, s *HeaderTradeSettlement, currencyCode string, taxPointDate *string) []ubl.TaxTotal {
+	taxTotal := ubl.TaxTotal{}
+	var others []ubl.TaxTotal
+	if ms := s.SpecifiedTradeSettlementHeaderMonetarySummation; ms != nil {
+		for i, a := range ms.TaxTotalAmount {
+			if a.CurrencyID != "" && currencyCode != "" && a.CurrencyID != currencyCode {
+				others = append(others, ubl.TaxTotal{TaxAmount: r.amount(&ms.TaxTotalAmount[i])})
+				continue
+			}
+			taxTotal.TaxAmount = r.amount(&ms.TaxTotalAmount[i])
+		}
+	}
+	for i, tax := range s.ApplicableTradeTax {
+		taxPath := fmt.Sprintf("%s/ram:ApplicableTradeTax[%d]", path, i+1)
+		if tax.TaxPointDate != nil && *taxPointDate == "" {
+			d, err := ParseDate(tax.TaxPointDate.DateString)
+			if err != nil {
+				r.fail(taxPath+"/ram:TaxPointDate/udt:DateString", "%s", err)
+			}
+			*taxPointDate = d
+		}
+		taxTotal.TaxSubtotal = append(taxTotal.TaxSubtotal, ubl.TaxSubtotal{
+			TaxableAmount: r.amount(tax.BasisAmount),
+			TaxAmount:     r.amount(tax.CalculatedAmount),
+			Percent:       tax.RateApplicablePercent,
+			TaxCategory: &ubl.TaxCategory{
+				ID:                     strings.TrimSpace(tax.CategoryCode),
+				Percent:                tax.RateApplicablePercent,
+				TaxExemptionReasonCode: tax.ExemptionReasonCode,
+				TaxExemptionReason:     tax.ExemptionReason,
+				TaxScheme:              &ubl.TaxScheme{ID: taxTypeCodeOrVAT(tax.TypeCode)},
+			},
+		})
+	}
+	if taxTotal.TaxAmount == nil && len(taxTotal.TaxSubtotal) == 0 {
+		return others
+	}
+	return append([]ubl.TaxTotal{taxTotal}, others...)
+}
+
+// line - read an ram:IncludedSupplyChainTradeLineItem
+func (r *reader) line(path string, li *LineItem) line {
+	l := line{}
+	if ld := li.AssociatedDocumentLineDocument; ld != nil {
+		l.id = strings.TrimSpace(ld.LineID)
+		for _, n := range ld.IncludedNote {
+			l.notes = append(l.notes, n.Content)
+		}
+	}
+	l.item = item(li.SpecifiedTradeProduct)
+
+	if a := li.SpecifiedLineTradeAgreement; a != nil {
+		if ref := a.BuyerOrderReferencedDocument; ref != nil && ref.LineID != "" {
+			olr := ubl.OrderLineReference{LineID: ref.LineID}
+			if ref.IssuerAssignedID != "" {
+				olr.OrderReference = &ubl.OrderReference{ID: ref.IssuerAssignedID}
+			}
+			l.orderLineReference = []ubl.OrderLineReference{olr}
+		}
+		if p := a.NetPriceProductTradePrice; p != nil {
+			l.price = &ubl.Price{PriceAmount: r.amount(p.ChargeAmount), BaseQuantity: ublQuantity(p.BasisQuantity)}
+		}
+	}
+
+	if d := li.SpecifiedLineTradeDelivery; d != nil {
+		l.quantity = ublQuantity(d.BilledQuantity)
+		l.despatchLineReference = ublLineReference(d.DespatchAdviceReferencedDocument)
+		l.receiptLineReference = ublLineReference(d.ReceivingAdviceReferencedDocument)
+	}
+
+	if s := li.SpecifiedLineTradeSettlement; s != nil {
+		l.invoicePeriod = r.period(path+"/ram:SpecifiedLineTradeSettlement/ram:BillingSpecifiedPeriod", s.BillingSpecifiedPeriod)
+		if ms := s.SpecifiedTradeSettlementLineMonetarySummation; ms != nil {
+			l.lineExtensionAmount = r.amount(ms.LineTotalAmount)
+		}
+		if ref := s.InvoiceReferencedDocument; ref != nil && ref.IssuerAssignedID != "" {
+			l.billingReference = []ubl.BillingReference{{InvoiceDocumentReference: &ubl.DocumentReference{ID: ref.IssuerAssignedID}}}
+		}
+		if aa := s.ReceivableSpecifiedTradeAccountingAccount; aa != nil {
+			l.accountingCost = aa.ID
+		}
+		if len(s.ApplicableTradeTax) > 0 && l.item != nil {
+			tax := s.ApplicableTradeTax[0]
+			l.item.ClassifiedTaxCategory = []ubl.TaxCategory{{
+				ID:        strings.TrimSpace(tax.CategoryCode),
+				Percent:   tax.RateApplicablePercent,
+				TaxScheme: &ubl.TaxScheme{ID: taxTypeCodeOrVAT(tax.TypeCode)},
+			}}
+		}
+	}
+	return l
+}
+
+func taxTypeCodeOrVAT(typeCode string) string {
+	if typeCode = strings.TrimSpace(typeCode); typeCode != "" {
+		return typeCode
+	}
+	return taxTypeVAT
+}
+
+// amount - a CII amount as a UBL amount, in the invoice currency unless
+// the amount has a currency of its own
+func (r *reader) amount(a *Amount) *ubl.Amount {
+	if a == nil {
+		return nil
+	}
+	currencyID := a.CurrencyID
+	if currencyID == "" {
+		currencyID = r.currencyCode
+	}
+	return &ubl.Amount{Value: strings.TrimSpace(a.Value), CurrencyID: currencyID}
+}
+
+func ublQuantity(q *Quantity) *ubl.Quantity {
+	if q == nil {
+		return nil
+	}
+	return &ubl.Quantity{Value: strings.TrimSpace(q.Value), UnitCode: q.UnitCode}
+}
+
+func ublDocumentReference(ref *ReferencedDocument) []ubl.DocumentReference {
+	if ref == nil || ref.IssuerAssignedID == "" {
+		return nil
+	}
+	return []ubl.DocumentReference{{ID: ref.IssuerAssignedID}}
+}
+
+func ublLineReference(ref *ReferencedDocument) []ubl.LineReference {
+	if ref == nil || ref.LineID == "" {
+		return nil
+	}
+	lr := ubl.LineReference{LineID: ref.LineID}
+	if ref.IssuerAssignedID != "" {
+		lr.DocumentReference = &ubl.DocumentReference{ID: ref.IssuerAssignedID}
+	}
+	return []ubl.LineReference{lr}
+}
+
+// item - read an ram:SpecifiedTradeProduct
+func item(tp *TradeProduct) *ubl.Item {
+	if tp == nil {
+		return nil
+	}
+	it := ubl.Item{Name: strings.TrimSpace(tp.Name), Description: tp.Description}
+	if tp.GlobalID != nil && tp.GlobalID.Value != "" {
+		it.StandardItemIdentification = &ubl.ItemIdentification{ID: ubl.Identifier{Value: tp.GlobalID.Value, SchemeID: tp.GlobalID.SchemeID}}
+	}
+	if tp.SellerAssignedID != "" {
+		it.SellersItemIdentification = &ubl.ItemIdentification{ID: ubl.Identifier{Value: tp.SellerAssignedID}}
+	}
+	if tp.BuyerAssignedID != "" {
+		it.BuyersItemIdentification = &ubl.ItemIdentification{ID: ubl.Identifier{Value: tp.BuyerAssignedID}}
+	}
+	if tp.OriginTradeCountry != nil && tp.OriginTradeCountry.ID != "" {
+		it.OriginCountry = &ubl.Country{IdentificationCode: tp.OriginTradeCountry.ID}
+	}
+	return &it
+}
+
+// party - read an ram:TradePartyType, the reverse of tradeParty
+func party(tp *TradeParty) *ubl.Party {
+	if tp == nil {
+		return nil
+	}
+	p := ubl.Party{}
+	if tp.URIUniversalCommunication != nil && tp.URIUniversalCommunication.URIID != nil {
+		uri := tp.URIUniversalCommunication.URIID
+		p.EndpointID = &ubl.Identifier{Value: strings.TrimSpace(uri.Value), SchemeID: uri.SchemeID}
+	}
+	for _, id := range tp.ID {
+		p.PartyIdentification = append(p.PartyIdentification, ubl.PartyIdentification{ID: ubl.Identifier{Value: strings.TrimSpace(id.Value), SchemeID: id.SchemeID}})
+	}
+	for _, id := range tp.GlobalID {
+		p.PartyIdentification = append(p.PartyIdentification, ubl.PartyIdentification{ID: ubl.Identifier{Value: strings.TrimSpace(id.Value), SchemeID: id.SchemeID}})
+	}
+
+	name := strings.TrimSpace(tp.Name)
+	tradingName := name
+	ple := ubl.PartyLegalEntity{RegistrationName: name}
+	if lo := tp.SpecifiedLegalOrganization; lo != nil {
+		if lo.ID != nil {
+			ple.CompanyID = strings.TrimSpace(lo.ID.Value)
+		}
+		if n := strings.TrimSpace(lo.TradingBusinessName); n != "" {
+			tradingName = n
+		}
+	}
+	if tradingName != "" {
+		p.PartyName = []ubl.PartyName{{Name: tradingName}}
+	}
+	if ple.RegistrationName != "" || ple.CompanyID != "" {
+		p.PartyLegalEntity = []ubl.PartyLegalEntity{ple}
+	}
+
+	if ta := tp.PostalTradeAddress; ta != nil {
+		a := ubl.Address{
+			PostalZone:           ta.PostcodeCode,
+			StreetName:           ta.LineOne,
+			AdditionalStreetName: ta.LineTwo,
+			CityName:             ta.CityName,
+			CountrySubentity:     ta.CountrySubDivisionName,
+		}
+		if ta.LineThree != "" {
+			a.AddressLine = []ubl.AddressLine{{Line: ta.LineThree}}
+		}
+		if ta.CountryID != "" {
+			a.Country = &ubl.Country{IdentificationCode: strings.TrimSpace(ta.CountryID)}
+		}
+		p.PostalAddress = &a
+	}
+
+	for _, tr := range tp.SpecifiedTaxRegistration {
+		schemeID := taxTypeVAT
+		if tr.ID.SchemeID != taxRegistrationVA {
+			schemeID = tr.ID.SchemeID
+		}
+		p.PartyTaxScheme = append(p.PartyTaxScheme, ubl.PartyTaxScheme{CompanyID: strings.TrimSpace(tr.ID.Value), TaxScheme: &ubl.TaxScheme{ID: schemeID}})
+	}
+	return &p
+}
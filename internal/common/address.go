@@ -75,6 +75,42 @@ const insertAddressSQL = `insert into addresses
     :location_coord_lon,
     :note);`
 
+const selectAddressesSQL = `select 
+    id,
+    uuid4,
+    name1,
+    addr_list_agency_id,
+    addr_list_id,
+    addr_list_version_id,
+    address_type_code,
+    address_format_code,
+    postbox,
+    floor1,
+    room,
+    street_name,
+    additional_street_name,
+    block_name,
+    building_name,
+    building_number,
+    inhouse_mail,
+    department,
+    mark_attention,
+    mark_care,
+    plot_identification,
+    city_subdivision_name,
+    city_name,
+    postal_zone,
+    country_subentity,
+    country_subentity_code,
+    region,
+    district,
+    timezone_offset,
+    country_id_code,
+    country_name,
+    location_coord_lat,
+    location_coord_lon,
+    note from addresses`
+
 // CreateAddress - For Creating Address
 func CreateAddress(ctx context.Context, in *commonproto.Address, userEmail string, requestID string) (*commonproto.Address, error) {
 	var err error
@@ -141,3 +177,24 @@ func InsertAddress(ctx context.Context, tx *sqlx.Tx, addr *commonproto.Address,
 	addr.IdS = uuid4Str
 	return addr, nil
 }
+
+// GetAddress - Get Address By Primary key(Id)
+func GetAddress(ctx context.Context, db sqlx.QueryerContext, addressID uint32, userEmail string, requestID string) (*commonproto.Address, error) {
+	nselectAddressesSQL := selectAddressesSQL + ` where id = ?;`
+	row := db.QueryRowxContext(ctx, nselectAddressesSQL, addressID)
+	addr := commonproto.Address{}
+	err := row.StructScan(&addr)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	if len(addr.Uuid4) > 0 {
+		uuid4Str, err := UUIDBytesToStr(addr.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		addr.IdS = uuid4Str
+	}
+	return &addr, nil
+}
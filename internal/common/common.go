@@ -31,10 +31,19 @@ const Inactive = "Inactive"
 
 // Error - used for
 type Error struct {
-	ErrorCode      string `json:"error_code"`
-	ErrorMsg       string `json:"error_msg"`
-	HTTPStatusCode int    `json:"status"`
-	RequestID      string `json:"request_id"`
+	ErrorCode      string    `json:"error_code"`
+	ErrorMsg       string    `json:"error_msg"`
+	HTTPStatusCode int       `json:"status"`
+	RequestID      string    `json:"request_id"`
+	Problems       []Problem `json:"problems,omitempty"`
+}
+
+// Problem - a located fault in a submitted document
+type Problem struct {
+	Path    string `json:"path"`
+	Line    int    `json:"line,omitempty"`
+	Rule    string `json:"rule,omitempty"`
+	Message string `json:"message"`
 }
 
 // ParseURL - parses a url into a slice (GetPathParts) and
@@ -90,6 +99,28 @@ func RenderJSON(w http.ResponseWriter, data interface{}) {
 	}
 }
 
+// RenderXML - send XML response
+func RenderXML(w http.ResponseWriter, data []byte) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(data)
+	if err != nil {
+		log.Error("Error", zap.Error(err))
+		return
+	}
+}
+
+// RenderPDF - send PDF response
+func RenderPDF(w http.ResponseWriter, data []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(data)
+	if err != nil {
+		log.Error("Error", zap.Error(err))
+		return
+	}
+}
+
 // RenderErrorJSON - send error JSON response
 func RenderErrorJSON(w http.ResponseWriter, errorCode string, errorMsg string, httpStatusCode int, requestID string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -103,6 +134,20 @@ func RenderErrorJSON(w http.ResponseWriter, errorCode string, errorMsg string, h
 	}
 }
 
+// RenderProblemsJSON - send error JSON response listing the problems found
+// in a submitted document
+func RenderProblemsJSON(w http.ResponseWriter, errorCode string, errorMsg string, problems []Problem, httpStatusCode int, requestID string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusCode)
+	e := Error{ErrorCode: errorCode, ErrorMsg: errorMsg, HTTPStatusCode: httpStatusCode, RequestID: requestID, Problems: problems}
+	err := json.NewEncoder(w).Encode(e)
+	if err != nil {
+		log.Error("Error", zap.Error(err))
+		http.Error(w, err.Error(), 400)
+		return
+	}
+}
+
 // GetRequestID - used for RequestID generation
 func GetRequestID() string {
 	return xid.New().String()
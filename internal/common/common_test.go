@@ -1,6 +1,9 @@
 package common
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"testing"
@@ -607,3 +610,20 @@ func TestDecodeCursor(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderProblemsJSON(t *testing.T) {
+	problems := []Problem{{Path: "/Invoice/cbc:IssueDate", Line: 4, Message: "missing"}}
+	w := httptest.NewRecorder()
+	RenderProblemsJSON(w, "4003", "UBL document does not conform to the UBL 2.3 schema", problems, http.StatusUnprocessableEntity, "bks1m1g91jau4nkks2f0")
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("RenderProblemsJSON() status = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+	}
+	e := Error{}
+	if err := json.NewDecoder(w.Body).Decode(&e); err != nil {
+		t.Error(err)
+		return
+	}
+	if e.HTTPStatusCode != http.StatusUnprocessableEntity || !reflect.DeepEqual(e.Problems, problems) {
+		t.Errorf("RenderProblemsJSON() = %v", e)
+	}
+}
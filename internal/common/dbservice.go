@@ -88,34 +88,67 @@ func CreateDBService(log *zap.Logger, dbOpt *config.DBOptions) (*DBService, erro
 
 type execFunc func(*sqlx.Tx) error
 
-// InsUpd - Insert, Update to database
+type txContextKey struct{}
+
+// ContextWithTx - ctx whose InsUpd and Queryer run in tx, so that the
+// writes of several services are committed or rolled back together
+func ContextWithTx(ctx context.Context, tx *sqlx.Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// Queryer - the transaction of ctx, the database when there is none
+func (dbService *DBService) Queryer(ctx context.Context) sqlx.QueryerContext {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return dbService.DB
+}
+
+// InsUpd - Insert, Update to database. Within the transaction of a
+// ContextWithTx it is left to that transaction to commit or roll back.
+// The transaction is rolled back when ex returns an error, which InsUpd
+// returns, or panics, such as with a money.ErrOverflow, which InsUpd
+// panics with again.
 func (dbService *DBService) InsUpd(ctx context.Context, userEmail string, requestID string, ex execFunc) error {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return ex(tx)
+	}
+
 	tx, err := dbService.DB.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 		return err
 	}
 
+	done := false
+	defer func() {
+		if done {
+			return
+		}
+		r := recover()
+		dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Any("panic", r))
+		if err := tx.Rollback(); err != nil {
+			dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		}
+		panic(r)
+	}()
 	err = ex(tx)
+	done = true
 	if err != nil {
 		dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
-		err = tx.Rollback()
-		if err != nil {
-			dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
-			return err
+		if rbErr := tx.Rollback(); rbErr != nil {
+			dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(rbErr))
 		}
-		dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 		return err
 	}
 
 	err = tx.Commit()
 	if err != nil {
 		dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
-		err = tx.Rollback()
-		if err != nil {
-			dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
-			return err
+		if rbErr := tx.Rollback(); rbErr != nil && rbErr != sql.ErrTxDone {
+			dbService.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(rbErr))
 		}
+		return err
 	}
 
 	return nil
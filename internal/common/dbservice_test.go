@@ -0,0 +1,81 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestContextWithTx(t *testing.T) {
+	dbService := DBService{log: zap.NewNop()}
+	tx := &sqlx.Tx{}
+	ctx := ContextWithTx(context.Background(), tx)
+	assert.Equal(t, sqlx.QueryerContext(tx), dbService.Queryer(ctx))
+
+	// InsUpd joins the transaction of ctx instead of beginning one
+	var got *sqlx.Tx
+	err := dbService.InsUpd(ctx, "sprov300@gmail.com", "reqid", func(tx *sqlx.Tx) error {
+		got = tx
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Same(t, tx, got)
+}
+
+// txDriver - a database/sql driver that counts the commits and rollbacks
+// of its transactions
+type txDriver struct {
+	commits   int
+	rollbacks int
+}
+
+func (d *txDriver) Open(name string) (driver.Conn, error) { return txConn{d}, nil }
+
+type txConn struct{ d *txDriver }
+
+func (c txConn) Prepare(query string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c txConn) Close() error                              { return nil }
+func (c txConn) Begin() (driver.Tx, error)                 { return c, nil }
+func (c txConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c, nil
+}
+func (c txConn) Commit() error   { c.d.commits++; return nil }
+func (c txConn) Rollback() error { c.d.rollbacks++; return nil }
+
+func TestInsUpdRollback(t *testing.T) {
+	d := &txDriver{}
+	sql.Register("txdriver", d)
+	db, err := sqlx.Open("txdriver", "")
+	assert.Nil(t, err)
+	dbService := DBService{DB: db, log: zap.NewNop()}
+	ctx := context.Background()
+
+	err = dbService.InsUpd(ctx, "sprov300@gmail.com", "reqid", func(tx *sqlx.Tx) error {
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, d.commits)
+
+	// the error of ex is returned after the rollback
+	exErr := errors.New("ex")
+	err = dbService.InsUpd(ctx, "sprov300@gmail.com", "reqid", func(tx *sqlx.Tx) error {
+		return exErr
+	})
+	assert.Same(t, exErr, err)
+	assert.Equal(t, 1, d.rollbacks)
+
+	// a panic of ex rolls back and panics again
+	assert.PanicsWithValue(t, "ex", func() {
+		_ = dbService.InsUpd(ctx, "sprov300@gmail.com", "reqid", func(tx *sqlx.Tx) error {
+			panic("ex")
+		})
+	})
+	assert.Equal(t, 2, d.rollbacks)
+	assert.Equal(t, 1, d.commits)
+}
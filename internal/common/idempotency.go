@@ -0,0 +1,199 @@
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// IdempotencyKeyHeader - the header of the idempotency key of a create
+// request, a key such as a UUID the client chooses once per document and
+// sends again when it retries the request
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKeyTTL - how long the response of a request with an
+// idempotency key is returned to its retries
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyPendingTTL - how long a request with an idempotency key is
+// taken as in progress, after which a retry runs it again as when it had
+// failed
+const IdempotencyPendingTTL = 5 * time.Minute
+
+// idempotencyRecord - stored in Redis under the idempotency key of a
+// request, in progress until it has the response
+type idempotencyRecord struct {
+	RequestHash string `json:"request_hash"`
+	Done        bool   `json:"done"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+// GetIdempotencyKey - the Idempotency-Key header of r, key, the
+// idempotency_key of the request body, when the header is not given
+func GetIdempotencyKey(r *http.Request, key string) string {
+	if header := strings.TrimSpace(r.Header.Get(IdempotencyKeyHeader)); header != "" {
+		return header
+	}
+	return key
+}
+
+// IdempotencyInterceptor - a gRPC interceptor that runs a request with an
+// idempotency_key field set only once: a retry with the same key gets the
+// response of the first request instead of creating a duplicate. The key
+// is scoped to the method and the user_id of the request. A retry while
+// the first request is in progress fails with codes.Aborted, and a request
+// reusing a key with a different content with codes.InvalidArgument. A
+// request that fails is not kept, so that it can be retried.
+func IdempotencyInterceptor(log *zap.Logger, redisService RedisIntf) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		m, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		key := stringField(m, "idempotency_key")
+		if key == "" {
+			return handler(ctx, req)
+		}
+		userEmail := stringField(m, "user_email")
+		requestID := stringField(m, "request_id")
+
+		requestHash, err := idempotencyRequestHash(m)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		redisKey := "idempotency:" + info.FullMethod + ":" + stringField(m, "user_id") + ":" + key
+
+		record := idempotencyRecord{RequestHash: requestHash}
+		pending, err := json.Marshal(record)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		reserved, err := redisService.SetNX(redisKey, string(pending), IdempotencyPendingTTL)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		if !reserved {
+			return storedIdempotentResponse(redisService, redisKey, requestHash, info.FullMethod)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if err1 := redisService.Del(redisKey); err1 != nil {
+				log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err1))
+			}
+			return nil, err
+		}
+
+		respMessage, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		record.Done = true
+		record.Response, err = proto.Marshal(respMessage)
+		if err == nil {
+			var done []byte
+			done, err = json.Marshal(record)
+			if err == nil {
+				err = redisService.Set(redisKey, string(done), IdempotencyKeyTTL)
+			}
+		}
+		if err != nil {
+			// the request is done, its retries fail as in progress until
+			// the reservation expires
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		}
+		return resp, nil
+	}
+}
+
+// storedIdempotentResponse - the response stored under redisKey for a
+// request of the method with requestHash
+func storedIdempotentResponse(redisService RedisIntf, redisKey string, requestHash string, fullMethod string) (interface{}, error) {
+	value, err := redisService.Get(redisKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "idempotency: the request with the idempotency key is in progress")
+	}
+	record := idempotencyRecord{}
+	err = json.Unmarshal([]byte(value), &record)
+	if err != nil {
+		return nil, err
+	}
+	if record.RequestHash != requestHash {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency: the idempotency key is used for a different request")
+	}
+	if !record.Done {
+		return nil, status.Errorf(codes.Aborted, "idempotency: the request with the idempotency key is in progress")
+	}
+	resp, err := newMethodResponse(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(record.Response, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// newMethodResponse - a new response message of a gRPC method, such as
+// /invoice.v1.InvoiceService/CreateInvoice
+func newMethodResponse(fullMethod string) (proto.Message, error) {
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("idempotency: %s is not a method", fullMethod)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// idempotencyRequestHash - the hash of the content of a request, without
+// its idempotency_key and request_id which differ between retries
+func idempotencyRequestHash(m proto.Message) (string, error) {
+	c := proto.Clone(m)
+	r := c.ProtoReflect()
+	for _, name := range []protoreflect.Name{"idempotency_key", "request_id"} {
+		if fd := r.Descriptor().Fields().ByName(name); fd != nil {
+			r.Clear(fd)
+		}
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// stringField - the string field name of m, "" when it has none
+func stringField(m proto.Message, name protoreflect.Name) string {
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return r.Get(fd).String()
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeRedis - a RedisIntf on a map
+type fakeRedis map[string]string
+
+func (f fakeRedis) Get(key string) (string, error) {
+	value, ok := f[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	return value, nil
+}
+
+func (f fakeRedis) Set(key string, value interface{}, expiration time.Duration) error {
+	f[key] = value.(string)
+	return nil
+}
+
+func (f fakeRedis) SetNX(key string, value interface{}, expiration time.Duration) (bool, error) {
+	if _, ok := f[key]; ok {
+		return false, nil
+	}
+	f[key] = value.(string)
+	return true, nil
+}
+
+func (f fakeRedis) Del(key string) error {
+	delete(f, key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	interceptor := IdempotencyInterceptor(zap.NewNop(), fakeRedis{})
+	info := &grpc.UnaryServerInfo{FullMethod: "/tax.v1.TaxService/CreateTaxScheme"}
+
+	calls := 0
+	var handlerErr error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if handlerErr != nil {
+			return nil, handlerErr
+		}
+		taxScheme := taxproto.TaxScheme{TaxSchemeD: &taxproto.TaxSchemeD{Id: uint32(calls), TaxSchemeName: req.(*taxproto.CreateTaxSchemeRequest).TaxSchemeName}}
+		return &taxproto.CreateTaxSchemeResponse{TaxScheme: &taxScheme}, nil
+	}
+
+	form := taxproto.CreateTaxSchemeRequest{}
+	form.TaxSchemeName = "VAT"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	// without a key every request runs
+	_, err := interceptor(context.Background(), proto.Clone(&form), info, handler)
+	assert.Nil(t, err)
+	_, err = interceptor(context.Background(), proto.Clone(&form), info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+
+	// a retry gets the response of the first request
+	form.IdempotencyKey = "8d1c2a0e-6c11-4c3f-9a53-4f3c0b1e2d7a"
+	resp, err := interceptor(context.Background(), proto.Clone(&form), info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+	retry := proto.Clone(&form).(*taxproto.CreateTaxSchemeRequest)
+	retry.RequestId = "bks1m1g91jau4nkks2f1"
+	retryResp, err := interceptor(context.Background(), retry, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.True(t, proto.Equal(resp.(proto.Message), retryResp.(proto.Message)))
+
+	// the key with a different request
+	retry.TaxSchemeName = "GST"
+	_, err = interceptor(context.Background(), retry, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 3, calls)
+
+	// a request that fails can be retried
+	form.IdempotencyKey = "0f5e4b6a-2d3c-4e1f-8a7b-9c6d5e4f3a2b"
+	handlerErr = errors.New("tax scheme not created")
+	_, err = interceptor(context.Background(), proto.Clone(&form), info, handler)
+	assert.Equal(t, handlerErr, err)
+	handlerErr = nil
+	_, err = interceptor(context.Background(), proto.Clone(&form), info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, calls)
+}
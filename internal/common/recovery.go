@@ -0,0 +1,26 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor - a gRPC interceptor that turns a panic of a request,
+// such as money.ErrOverflow on amounts too large to hold, into a
+// codes.Internal error of that request instead of stopping the server
+func RecoveryInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("Error", zap.String("method", info.FullMethod), zap.Any("panic", r), zap.Stack("stack"))
+				resp, err = nil, status.Error(codes.Internal, fmt.Sprint(r))
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	interceptor := RecoveryInterceptor(zap.NewNop())
+	info := &grpc.UnaryServerInfo{FullMethod: "/invoice.v1.InvoiceService/CreateInvoice"}
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("money: amount out of range")
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	resp, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "created", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "created", resp)
+}
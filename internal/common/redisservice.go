@@ -13,6 +13,8 @@ import (
 type RedisIntf interface {
 	Get(key string) (string, error)
 	Set(key string, value interface{}, expiration time.Duration) error
+	SetNX(key string, value interface{}, expiration time.Duration) (bool, error)
+	Del(key string) error
 }
 
 // RedisService - Redis Pointer to redis
@@ -60,7 +62,29 @@ func (rds *RedisService) Get(key string) (string, error) {
 
 // Set - Call the Set method on the Redis client
 func (rds *RedisService) Set(key string, value interface{}, expiration time.Duration) error {
-	err := rds.RedisClient.Set(key, value, 0).Err()
+	err := rds.RedisClient.Set(key, value, expiration).Err()
+	if err != nil {
+		rds.log.Error("Error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// SetNX - Call the SetNX method on the Redis client, false when key is set
+func (rds *RedisService) SetNX(key string, value interface{}, expiration time.Duration) (bool, error) {
+	ok, err := rds.RedisClient.SetNX(key, value, expiration).Result()
+	if err != nil {
+		rds.log.Error("Error", zap.Error(err))
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// Del - Call the Del method on the Redis client
+func (rds *RedisService) Del(key string) error {
+	err := rds.RedisClient.Del(key).Err()
 	if err != nil {
 		rds.log.Error("Error", zap.Error(err))
 		return err
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
 	"github.com/unrolled/secure"
@@ -286,6 +287,16 @@ func GetUptraceConfig(log *zap.Logger, v *viper.Viper) (*UptraceOptions, error)
 	return &uptraceOpt, nil
 }
 
+// GetRoundingConfig -- read the rounding policies of currencies, such as
+// "CHF:2:commercial:0.05;JPY:0", and register them
+func GetRoundingConfig(log *zap.Logger, v *viper.Viper) error {
+	if err := rounding.Configure(v.GetString("SC_UBL_ROUNDING_POLICIES")); err != nil {
+		log.Error("Error", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // GetViper -- init viper
 func GetViper() (*viper.Viper, error) {
 	v := viper.New()
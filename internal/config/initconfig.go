@@ -7,7 +7,7 @@ import (
 	"go.uber.org/zap"
 )
 
-// GetConfigOpt -- Get db, redis, mailer, grpc, jwt, oauth, user, uptrace options
+// GetConfigOpt -- Get db, redis, mailer, grpc, jwt, oauth, user, uptrace options and register the rounding policies
 func GetConfigOpt(log *zap.Logger, v *viper.Viper) (*RedisOptions, *MailerOptions, *ServerOptions, *GrpcServerOptions, *OauthOptions, *UserOptions, *UptraceOptions) {
 	redisOpt, err := GetRedisConfig(log, v)
 	if err != nil {
@@ -51,5 +51,11 @@ func GetConfigOpt(log *zap.Logger, v *viper.Viper) (*RedisOptions, *MailerOption
 		os.Exit(1)
 	}
 
+	err = GetRoundingConfig(log, v)
+	if err != nil {
+		log.Error("Error", zap.Error(err))
+		os.Exit(1)
+	}
+
 	return redisOpt, mailerOpt, serverOpt, grpcServerOpt, oauthOpt, userOpt, uptraceOpt
 }
@@ -2,6 +2,7 @@ package invoicecontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	invoiceworkflows "github.com/cloudfresco/sc-ubl/internal/workflows/invoiceworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
@@ -64,6 +66,7 @@ func (cc *CreditNoteHeaderController) CreateCreditNoteHeader(w http.ResponseWrit
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := cc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.CreateCreditNoteHeaderWorkflow, &form, token, user, cc.log)
@@ -190,3 +193,277 @@ func (cc *CreditNoteHeaderController) GetCreditNoteLines(w http.ResponseWriter,
 	}
 	common.RenderJSON(w, creditNoteLines)
 }
+
+// ImportCreditNoteUBL - Create CreditNote from a UBL 2.3 CreditNote XML request body
+func (cc *CreditNoteHeaderController) ImportCreditNoteUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"creditnote:cud"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        invoiceworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ImportCreditNoteUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := cc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.ImportCreditNoteUBLWorkflow, &form, token, user, cc.log)
+	workflowClient := cc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var creditNote invoiceproto.ImportCreditNoteUBLResponse
+	err = workflowRun.Get(ctx, &creditNote)
+
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &creditNote)
+}
+
+// GetCreditNoteUBL - Get CreditNote as UBL 2.3 CreditNote XML, the profile
+// query parameter selects the PEPPOL BIS Billing 3.0 output
+func (cc *CreditNoteHeaderController) GetCreditNoteUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"creditnote:read"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	creditNoteUBL, err := cc.CreditNoteHeaderServiceClient.GetCreditNoteUBL(ctx, &invoiceproto.GetCreditNoteUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}, Profile: r.URL.Query().Get("profile")})
+	if err != nil {
+		cc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, creditNoteUBL.Ubl)
+}
+
+// GetCreditNoteCII - Get CreditNote as UN/CEFACT Cross Industry Invoice D16B
+// XML
+func (cc *CreditNoteHeaderController) GetCreditNoteCII(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"creditnote:read"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	creditNoteCII, err := cc.CreditNoteHeaderServiceClient.GetCreditNoteCII(ctx, &invoiceproto.GetCreditNoteCIIRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		cc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderXML(w, creditNoteCII.Cii)
+}
+
+// ImportCreditNoteCII - Create CreditNote from a UN/CEFACT Cross Industry
+// Invoice D16B XML request body
+func (cc *CreditNoteHeaderController) ImportCreditNoteCII(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"creditnote:cud"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "cii_" + uuid.New(),
+		TaskList:                        invoiceworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ciiBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ImportCreditNoteCIIRequest{}
+	form.Cii = ciiBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := cc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.ImportCreditNoteCIIWorkflow, &form, token, user, cc.log)
+	workflowClient := cc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var creditNote invoiceproto.ImportCreditNoteCIIResponse
+	err = workflowRun.Get(ctx, &creditNote)
+
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &creditNote)
+}
+
+// IssueCreditNote - Issue a draft credit note
+func (cc *CreditNoteHeaderController) IssueCreditNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.IssueCreditNote)
+}
+
+// SendCreditNote - Mark an issued credit note as sent
+func (cc *CreditNoteHeaderController) SendCreditNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.SendCreditNote)
+}
+
+// AcknowledgeCreditNote - Mark a credit note as acknowledged by the customer
+func (cc *CreditNoteHeaderController) AcknowledgeCreditNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.AcknowledgeCreditNote)
+}
+
+// MarkCreditNotePartiallyPaid - Mark a credit note as partially paid
+func (cc *CreditNoteHeaderController) MarkCreditNotePartiallyPaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.MarkCreditNotePartiallyPaid)
+}
+
+// MarkCreditNotePaid - Mark a credit note as paid
+func (cc *CreditNoteHeaderController) MarkCreditNotePaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.MarkCreditNotePaid)
+}
+
+// MarkCreditNoteDisputed - Mark a credit note as disputed, the body holds the reason
+func (cc *CreditNoteHeaderController) MarkCreditNoteDisputed(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.MarkCreditNoteDisputed)
+}
+
+// CancelCreditNote - Cancel a credit note, the body holds the reason
+func (cc *CreditNoteHeaderController) CancelCreditNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:cud", cc.CreditNoteHeaderServiceClient.CancelCreditNote)
+}
+
+// GetCreditNoteStatusTransitions - Get the status transitions of a credit note
+func (cc *CreditNoteHeaderController) GetCreditNoteStatusTransitions(w http.ResponseWriter, r *http.Request) {
+	getDocumentStatusTransitions(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:read", cc.CreditNoteHeaderServiceClient.GetCreditNoteStatusTransitions)
+}
+
+// ReviseCreditNote - Revise an issued credit note into its next version
+func (cc *CreditNoteHeaderController) ReviseCreditNote(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"creditnote:cud"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ReviseCreditNoteRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	if form.UpdateCreditNoteHeaderRequest == nil {
+		form.UpdateCreditNoteHeaderRequest = &invoiceproto.UpdateCreditNoteHeaderRequest{}
+	}
+	form.UpdateCreditNoteHeaderRequest.Id = r.PathValue("id")
+	form.UpdateCreditNoteHeaderRequest.UserId = user.UserId
+	form.UpdateCreditNoteHeaderRequest.UserEmail = user.Email
+	form.UpdateCreditNoteHeaderRequest.RequestId = user.RequestId
+
+	revision, err := cc.CreditNoteHeaderServiceClient.ReviseCreditNote(ctx, &form)
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, revision)
+}
+
+// GetCreditNoteVersions - Get the versions of a credit note
+func (cc *CreditNoteHeaderController) GetCreditNoteVersions(w http.ResponseWriter, r *http.Request) {
+	getDocumentVersions(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:read", cc.CreditNoteHeaderServiceClient.GetCreditNoteVersions)
+}
+
+// DiffCreditNoteVersions - Get the changes to a credit note between two versions
+func (cc *CreditNoteHeaderController) DiffCreditNoteVersions(w http.ResponseWriter, r *http.Request) {
+	diffDocumentVersions(w, r, cc.log, cc.ServerOpt, cc.UserServiceClient, "creditnote:read", cc.CreditNoteHeaderServiceClient.DiffCreditNoteVersions)
+}
+
+// UpdateCreditNoteLine - Update a line of a draft credit note
+func (cc *CreditNoteHeaderController) UpdateCreditNoteLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"creditnote:cud"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.UpdateCreditNoteLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = cc.CreditNoteHeaderServiceClient.UpdateCreditNoteLine(ctx, &form)
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeleteCreditNoteLine - Delete a line of a draft credit note
+func (cc *CreditNoteHeaderController) DeleteCreditNoteLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"creditnote:cud"}, cc.ServerOpt.Auth0Audience, cc.ServerOpt.Auth0Domain, cc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = cc.CreditNoteHeaderServiceClient.DeleteCreditNoteLine(ctx, &invoiceproto.DeleteCreditNoteLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		cc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
@@ -38,15 +38,15 @@ func TestCreateCreditNoteHeader(t *testing.T) {
 	creditNoteHeader.PricingExDate = "08/10/2022"
 	creditNoteHeader.PaymentExDate = "08/11/2022"
 	creditNoteHeader.PaymentAltExDate = "08/10/2022"
-	creditNoteHeader.ChargeTotalAmount = float64(0)
-	creditNoteHeader.PrepaidAmount = float64(0)
-	creditNoteHeader.PayableRoundingAmount = float64(0)
-	creditNoteHeader.PayableAmount = float64(20)
+	creditNoteHeader.ChargeTotalAmount = "0"
+	creditNoteHeader.PrepaidAmount = "0"
+	creditNoteHeader.PayableRoundingAmount = "0"
+	creditNoteHeader.PayableAmount = "20"
 
 	creditNoteLine := invoiceproto.CreateCreditNoteLineRequest{}
 	creditNoteLine.Note = "Ordered in our booth at the convention"
 	creditNoteLine.CreditedQuantity = float64(2)
-	creditNoteLine.LineExtensionAmount = float64(20)
+	creditNoteLine.LineExtensionAmount = "20"
 	creditNoteLine.TaxPointDate = "08/01/2022"
 	creditNoteLine.InvoicePeriodStartDate = "08/01/2022"
 	creditNoteLine.InvoicePeriodEndDate = "09/01/2022"
@@ -90,10 +90,10 @@ func TestUpdateCreditNoteHeader(t *testing.T) {
 	form := invoiceproto.UpdateCreditNoteHeaderRequest{}
 	form.Note = "Ordered"
 	form.TaxCurrencyCode = "GBP"
-	form.ChargeTotalAmount = float64(10)
-	form.PrepaidAmount = float64(20)
-	form.PayableRoundingAmount = float64(100)
-	form.PayableAmount = float64(200)
+	form.ChargeTotalAmount = "10"
+	form.PrepaidAmount = "20"
+	form.PayableRoundingAmount = "100"
+	form.PayableAmount = "200"
 
 	w := httptest.NewRecorder()
 
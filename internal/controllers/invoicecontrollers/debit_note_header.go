@@ -2,6 +2,7 @@ package invoicecontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	invoiceworkflows "github.com/cloudfresco/sc-ubl/internal/workflows/invoiceworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
@@ -63,6 +65,7 @@ func (dc *DebitNoteHeaderController) CreateDebitNoteHeader(w http.ResponseWriter
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := dc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.CreateDebitNoteHeaderWorkflow, &form, token, user, dc.log)
@@ -192,3 +195,208 @@ func (dc *DebitNoteHeaderController) GetDebitNoteLines(w http.ResponseWriter, r
 	}
 	common.RenderJSON(w, resp)
 }
+
+// ImportDebitNoteUBL - Create DebitNote from a UBL 2.3 DebitNote XML request body
+func (dc *DebitNoteHeaderController) ImportDebitNoteUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"debitnote:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        invoiceworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ImportDebitNoteUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := dc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.ImportDebitNoteUBLWorkflow, &form, token, user, dc.log)
+	workflowClient := dc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var debitNote invoiceproto.ImportDebitNoteUBLResponse
+	err = workflowRun.Get(ctx, &debitNote)
+
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &debitNote)
+}
+
+// GetDebitNoteUBL - Get DebitNote as UBL 2.3 DebitNote XML
+func (dc *DebitNoteHeaderController) GetDebitNoteUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"debitnote:read"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	debitNoteUBL, err := dc.DebitNoteHeaderServiceClient.GetDebitNoteUBL(ctx, &invoiceproto.GetDebitNoteUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		dc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, debitNoteUBL.Ubl)
+}
+
+// IssueDebitNote - Issue a draft debit note
+func (dc *DebitNoteHeaderController) IssueDebitNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.IssueDebitNote)
+}
+
+// SendDebitNote - Mark an issued debit note as sent
+func (dc *DebitNoteHeaderController) SendDebitNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.SendDebitNote)
+}
+
+// AcknowledgeDebitNote - Mark a debit note as acknowledged by the customer
+func (dc *DebitNoteHeaderController) AcknowledgeDebitNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.AcknowledgeDebitNote)
+}
+
+// MarkDebitNotePartiallyPaid - Mark a debit note as partially paid
+func (dc *DebitNoteHeaderController) MarkDebitNotePartiallyPaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.MarkDebitNotePartiallyPaid)
+}
+
+// MarkDebitNotePaid - Mark a debit note as paid
+func (dc *DebitNoteHeaderController) MarkDebitNotePaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.MarkDebitNotePaid)
+}
+
+// MarkDebitNoteDisputed - Mark a debit note as disputed, the body holds the reason
+func (dc *DebitNoteHeaderController) MarkDebitNoteDisputed(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.MarkDebitNoteDisputed)
+}
+
+// CancelDebitNote - Cancel a debit note, the body holds the reason
+func (dc *DebitNoteHeaderController) CancelDebitNote(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:cud", dc.DebitNoteHeaderServiceClient.CancelDebitNote)
+}
+
+// GetDebitNoteStatusTransitions - Get the status transitions of a debit note
+func (dc *DebitNoteHeaderController) GetDebitNoteStatusTransitions(w http.ResponseWriter, r *http.Request) {
+	getDocumentStatusTransitions(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:read", dc.DebitNoteHeaderServiceClient.GetDebitNoteStatusTransitions)
+}
+
+// ReviseDebitNote - Revise an issued debit note into its next version
+func (dc *DebitNoteHeaderController) ReviseDebitNote(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"debitnote:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ReviseDebitNoteRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	if form.UpdateDebitNoteHeaderRequest == nil {
+		form.UpdateDebitNoteHeaderRequest = &invoiceproto.UpdateDebitNoteHeaderRequest{}
+	}
+	form.UpdateDebitNoteHeaderRequest.Id = r.PathValue("id")
+	form.UpdateDebitNoteHeaderRequest.UserId = user.UserId
+	form.UpdateDebitNoteHeaderRequest.UserEmail = user.Email
+	form.UpdateDebitNoteHeaderRequest.RequestId = user.RequestId
+
+	revision, err := dc.DebitNoteHeaderServiceClient.ReviseDebitNote(ctx, &form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, revision)
+}
+
+// GetDebitNoteVersions - Get the versions of a debit note
+func (dc *DebitNoteHeaderController) GetDebitNoteVersions(w http.ResponseWriter, r *http.Request) {
+	getDocumentVersions(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:read", dc.DebitNoteHeaderServiceClient.GetDebitNoteVersions)
+}
+
+// DiffDebitNoteVersions - Get the changes to a debit note between two versions
+func (dc *DebitNoteHeaderController) DiffDebitNoteVersions(w http.ResponseWriter, r *http.Request) {
+	diffDocumentVersions(w, r, dc.log, dc.ServerOpt, dc.UserServiceClient, "debitnote:read", dc.DebitNoteHeaderServiceClient.DiffDebitNoteVersions)
+}
+
+// UpdateDebitNoteLine - Update a line of a draft debit note
+func (dc *DebitNoteHeaderController) UpdateDebitNoteLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"debitnote:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.UpdateDebitNoteLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = dc.DebitNoteHeaderServiceClient.UpdateDebitNoteLine(ctx, &form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeleteDebitNoteLine - Delete a line of a draft debit note
+func (dc *DebitNoteHeaderController) DeleteDebitNoteLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"debitnote:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = dc.DebitNoteHeaderServiceClient.DeleteDebitNoteLine(ctx, &invoiceproto.DeleteDebitNoteLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
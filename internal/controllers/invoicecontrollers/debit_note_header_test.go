@@ -37,15 +37,15 @@ func TestCreateDebitNoteHeader(t *testing.T) {
 	debitNoteHeader.PricingExDate = "11/25/2022"
 	debitNoteHeader.PaymentExDate = "11/25/2022"
 	debitNoteHeader.PaymentAltExDate = "11/25/2022"
-	debitNoteHeader.ChargeTotalAmount = float64(0)
-	debitNoteHeader.PrepaidAmount = float64(0)
-	debitNoteHeader.PayableRoundingAmount = float64(0)
-	debitNoteHeader.PayableAmount = float64(1250)
+	debitNoteHeader.ChargeTotalAmount = "0"
+	debitNoteHeader.PrepaidAmount = "0"
+	debitNoteHeader.PayableRoundingAmount = "0"
+	debitNoteHeader.PayableAmount = "1250"
 
 	debitNoteLine := invoiceproto.CreateDebitNoteLineRequest{}
 	debitNoteLine.Note = "Scratch on box"
 	debitNoteLine.DebitedQuantity = float64(1)
-	debitNoteLine.LineExtensionAmount = float64(1250)
+	debitNoteLine.LineExtensionAmount = "1250"
 	debitNoteLine.TaxPointDate = "11/25/2022"
 	debitNoteLine.AccountingCost = "BookingCode002"
 	debitNoteLine.ItemId = uint32(7)
@@ -88,10 +88,10 @@ func TestUpdateDebitNoteHeader(t *testing.T) {
 	form := invoiceproto.UpdateDebitNoteHeaderRequest{}
 	form.Note = "Ordered"
 	form.DocumentCurrencyCode = "EUR"
-	form.ChargeTotalAmount = float64(100)
-	form.PrepaidAmount = float64(60)
-	form.PayableRoundingAmount = float64(100)
-	form.PayableAmount = float64(300)
+	form.ChargeTotalAmount = "100"
+	form.PrepaidAmount = "60"
+	form.PayableRoundingAmount = "100"
+	form.PayableAmount = "300"
 
 	w := httptest.NewRecorder()
 
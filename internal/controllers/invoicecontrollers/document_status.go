@@ -0,0 +1,77 @@
+package invoicecontrollers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/config"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// transitionFunc - a status transition RPC of an invoice, credit note or
+// debit note, such as IssueInvoice
+type transitionFunc func(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*invoiceproto.TransitionDocumentStatusResponse, error)
+
+// statusTransitionsFunc - the status transitions history RPC of an
+// invoice, credit note or debit note
+type statusTransitionsFunc func(ctx context.Context, in *invoiceproto.GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*invoiceproto.GetDocumentStatusTransitionsResponse, error)
+
+// transitionDocumentStatus - move the document of the id path value to
+// another status with transition, for a user with the scope; the request
+// body, which may be empty, holds the reason
+func transitionDocumentStatus(w http.ResponseWriter, r *http.Request, log *zap.Logger, serverOpt *config.ServerOptions, userServiceClient partyproto.UserServiceClient, scope string, transition transitionFunc) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{scope}, serverOpt.Auth0Audience, serverOpt.Auth0Domain, userServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.TransitionDocumentStatusRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	documentStatusTransition, err := transition(ctx, &form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, documentStatusTransition)
+}
+
+// getDocumentStatusTransitions - the status transitions of the document of
+// the id path value, oldest first, for a user with the scope
+func getDocumentStatusTransitions(w http.ResponseWriter, r *http.Request, log *zap.Logger, serverOpt *config.ServerOptions, userServiceClient partyproto.UserServiceClient, scope string, statusTransitions statusTransitionsFunc) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{scope}, serverOpt.Auth0Audience, serverOpt.Auth0Domain, userServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	documentStatusTransitions, err := statusTransitions(ctx, &invoiceproto.GetDocumentStatusTransitionsRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, documentStatusTransitions)
+}
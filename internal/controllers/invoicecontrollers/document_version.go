@@ -0,0 +1,80 @@
+package invoicecontrollers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/config"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// documentVersionsFunc - the versions RPC of an invoice, credit note or
+// debit note, such as GetInvoiceVersions
+type documentVersionsFunc func(ctx context.Context, in *commonproto.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*commonproto.GetDocumentVersionsResponse, error)
+
+// diffDocumentVersionsFunc - the diff RPC of the versions of an invoice,
+// credit note or debit note, such as DiffInvoiceVersions
+type diffDocumentVersionsFunc func(ctx context.Context, in *commonproto.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*commonproto.DiffDocumentVersionsResponse, error)
+
+// getDocumentVersions - the versions of the document of the id path value,
+// oldest first, for a user with the scope
+func getDocumentVersions(w http.ResponseWriter, r *http.Request, log *zap.Logger, serverOpt *config.ServerOptions, userServiceClient partyproto.UserServiceClient, scope string, documentVersions documentVersionsFunc) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{scope}, serverOpt.Auth0Audience, serverOpt.Auth0Domain, userServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	versions, err := documentVersions(ctx, &commonproto.GetDocumentVersionsRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, versions)
+}
+
+// diffDocumentVersions - the changes to the document of the id path value
+// between the versions of the from and to query values, for a user with the
+// scope
+func diffDocumentVersions(w http.ResponseWriter, r *http.Request, log *zap.Logger, serverOpt *config.ServerOptions, userServiceClient partyproto.UserServiceClient, scope string, diffVersions diffDocumentVersionsFunc) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{scope}, serverOpt.Auth0Audience, serverOpt.Auth0Domain, userServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := commonproto.DiffDocumentVersionsRequest{}
+	form.Id = r.PathValue("id")
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	from, err := strconv.ParseUint(r.URL.Query().Get("from"), 10, 32)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	to, err := strconv.ParseUint(r.URL.Query().Get("to"), 10, 32)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.FromVersionNumber = uint32(from)
+	form.ToVersionNumber = uint32(to)
+
+	changes, err := diffVersions(ctx, &form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, changes)
+}
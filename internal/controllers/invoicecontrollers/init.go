@@ -105,10 +105,29 @@ func initCreditNoteHeaders(mux *http.ServeMux, serverOpt *config.ServerOptions,
 	mux.Handle("GET /v2.3/credit-notes", http.HandlerFunc(cc.Index))
 	mux.Handle("GET /v2.3/credit-notes/{id}", http.HandlerFunc(cc.Show))
 	mux.Handle("GET /v2.3/credit-notes/{id}/lines", http.HandlerFunc(cc.GetCreditNoteLines))
+	mux.Handle("GET /v2.3/credit-notes/{id}/status-transitions", http.HandlerFunc(cc.GetCreditNoteStatusTransitions))
+	mux.Handle("GET /v2.3/credit-notes/{id}/versions", http.HandlerFunc(cc.GetCreditNoteVersions))
+	mux.Handle("GET /v2.3/credit-notes/{id}/versions/diff", http.HandlerFunc(cc.DiffCreditNoteVersions))
+	mux.Handle("GET /v2.3/credit-notes/{id}/ubl", http.HandlerFunc(cc.GetCreditNoteUBL))
+	mux.Handle("GET /v2.3/credit-notes/{id}/cii", http.HandlerFunc(cc.GetCreditNoteCII))
 
 	mux.Handle("POST /v2.3/credit-notes", http.HandlerFunc(cc.CreateCreditNoteHeader))
+	mux.Handle("POST /v2.3/credit-notes/import", http.HandlerFunc(cc.ImportCreditNoteUBL))
+	mux.Handle("POST /v2.3/credit-notes/import/cii", http.HandlerFunc(cc.ImportCreditNoteCII))
+
+	mux.Handle("POST /v2.3/credit-notes/{id}/issue", http.HandlerFunc(cc.IssueCreditNote))
+	mux.Handle("POST /v2.3/credit-notes/{id}/send", http.HandlerFunc(cc.SendCreditNote))
+	mux.Handle("POST /v2.3/credit-notes/{id}/acknowledge", http.HandlerFunc(cc.AcknowledgeCreditNote))
+	mux.Handle("POST /v2.3/credit-notes/{id}/partially-paid", http.HandlerFunc(cc.MarkCreditNotePartiallyPaid))
+	mux.Handle("POST /v2.3/credit-notes/{id}/paid", http.HandlerFunc(cc.MarkCreditNotePaid))
+	mux.Handle("POST /v2.3/credit-notes/{id}/dispute", http.HandlerFunc(cc.MarkCreditNoteDisputed))
+	mux.Handle("POST /v2.3/credit-notes/{id}/cancel", http.HandlerFunc(cc.CancelCreditNote))
+	mux.Handle("POST /v2.3/credit-notes/{id}/revisions", http.HandlerFunc(cc.ReviseCreditNote))
 
 	mux.Handle("PUT /v2.3/credit-notes/{id}", http.HandlerFunc(cc.UpdateCreditNoteHeader))
+	mux.Handle("PUT /v2.3/credit-notes/lines/{id}", http.HandlerFunc(cc.UpdateCreditNoteLine))
+
+	mux.Handle("DELETE /v2.3/credit-notes/lines/{id}", http.HandlerFunc(cc.DeleteCreditNoteLine))
 }
 
 func initDebitNoteHeaders(mux *http.ServeMux, serverOpt *config.ServerOptions, log *zap.Logger, u partyproto.UserServiceClient, d invoiceproto.DebitNoteHeaderServiceClient, wfHelper common.WfHelper, workflowClient client.Client) {
@@ -117,10 +136,27 @@ func initDebitNoteHeaders(mux *http.ServeMux, serverOpt *config.ServerOptions, l
 	mux.Handle("GET /v2.3/debit-notes", http.HandlerFunc(dc.Index))
 	mux.Handle("GET /v2.3/debit-notes/{id}", http.HandlerFunc(dc.Show))
 	mux.Handle("GET /v2.3/debit-notes/{id}/lines", http.HandlerFunc(dc.GetDebitNoteLines))
+	mux.Handle("GET /v2.3/debit-notes/{id}/status-transitions", http.HandlerFunc(dc.GetDebitNoteStatusTransitions))
+	mux.Handle("GET /v2.3/debit-notes/{id}/versions", http.HandlerFunc(dc.GetDebitNoteVersions))
+	mux.Handle("GET /v2.3/debit-notes/{id}/versions/diff", http.HandlerFunc(dc.DiffDebitNoteVersions))
+	mux.Handle("GET /v2.3/debit-notes/{id}/ubl", http.HandlerFunc(dc.GetDebitNoteUBL))
 
 	mux.Handle("POST /v2.3/debit-notes", http.HandlerFunc(dc.CreateDebitNoteHeader))
+	mux.Handle("POST /v2.3/debit-notes/import", http.HandlerFunc(dc.ImportDebitNoteUBL))
+
+	mux.Handle("POST /v2.3/debit-notes/{id}/issue", http.HandlerFunc(dc.IssueDebitNote))
+	mux.Handle("POST /v2.3/debit-notes/{id}/send", http.HandlerFunc(dc.SendDebitNote))
+	mux.Handle("POST /v2.3/debit-notes/{id}/acknowledge", http.HandlerFunc(dc.AcknowledgeDebitNote))
+	mux.Handle("POST /v2.3/debit-notes/{id}/partially-paid", http.HandlerFunc(dc.MarkDebitNotePartiallyPaid))
+	mux.Handle("POST /v2.3/debit-notes/{id}/paid", http.HandlerFunc(dc.MarkDebitNotePaid))
+	mux.Handle("POST /v2.3/debit-notes/{id}/dispute", http.HandlerFunc(dc.MarkDebitNoteDisputed))
+	mux.Handle("POST /v2.3/debit-notes/{id}/cancel", http.HandlerFunc(dc.CancelDebitNote))
+	mux.Handle("POST /v2.3/debit-notes/{id}/revisions", http.HandlerFunc(dc.ReviseDebitNote))
 
 	mux.Handle("PUT /v2.3/debit-notes/{id}", http.HandlerFunc(dc.UpdateDebitNoteHeader))
+	mux.Handle("PUT /v2.3/debit-notes/lines/{id}", http.HandlerFunc(dc.UpdateDebitNoteLine))
+
+	mux.Handle("DELETE /v2.3/debit-notes/lines/{id}", http.HandlerFunc(dc.DeleteDebitNoteLine))
 }
 
 func initInvoiceHeaders(mux *http.ServeMux, serverOpt *config.ServerOptions, log *zap.Logger, u partyproto.UserServiceClient, i invoiceproto.InvoiceServiceClient, wfHelper common.WfHelper, workflowClient client.Client) {
@@ -129,8 +165,30 @@ func initInvoiceHeaders(mux *http.ServeMux, serverOpt *config.ServerOptions, log
 	mux.Handle("GET /v2.3/invoices", http.HandlerFunc(ic.Index))
 	mux.Handle("GET /v2.3/invoices/{id}", http.HandlerFunc(ic.Show))
 	mux.Handle("GET /v2.3/invoices/{id}/lines", http.HandlerFunc(ic.GetInvoiceLines))
+	mux.Handle("GET /v2.3/invoices/{id}/status-transitions", http.HandlerFunc(ic.GetInvoiceStatusTransitions))
+	mux.Handle("GET /v2.3/invoices/{id}/versions", http.HandlerFunc(ic.GetInvoiceVersions))
+	mux.Handle("GET /v2.3/invoices/{id}/versions/diff", http.HandlerFunc(ic.DiffInvoiceVersions))
+	mux.Handle("GET /v2.3/invoices/{id}/ubl", http.HandlerFunc(ic.GetInvoiceUBL))
+	mux.Handle("GET /v2.3/invoices/{id}/cii", http.HandlerFunc(ic.GetInvoiceCII))
+	mux.Handle("GET /v2.3/invoices/{id}/facturx", http.HandlerFunc(ic.GetInvoiceFacturX))
 
 	mux.Handle("POST /v2.3/invoices", http.HandlerFunc(ic.CreateInvoice))
+	mux.Handle("POST /v2.3/invoices/import", http.HandlerFunc(ic.ImportInvoiceUBL))
+	mux.Handle("POST /v2.3/invoices/import/cii", http.HandlerFunc(ic.ImportInvoiceCII))
+	mux.Handle("POST /v2.3/invoices/validate", http.HandlerFunc(ic.ValidateInvoice))
+	mux.Handle("POST /v2.3/ubl/validate", http.HandlerFunc(ic.ValidateDocument))
+
+	mux.Handle("POST /v2.3/invoices/{id}/issue", http.HandlerFunc(ic.IssueInvoice))
+	mux.Handle("POST /v2.3/invoices/{id}/send", http.HandlerFunc(ic.SendInvoice))
+	mux.Handle("POST /v2.3/invoices/{id}/acknowledge", http.HandlerFunc(ic.AcknowledgeInvoice))
+	mux.Handle("POST /v2.3/invoices/{id}/partially-paid", http.HandlerFunc(ic.MarkInvoicePartiallyPaid))
+	mux.Handle("POST /v2.3/invoices/{id}/paid", http.HandlerFunc(ic.MarkInvoicePaid))
+	mux.Handle("POST /v2.3/invoices/{id}/dispute", http.HandlerFunc(ic.MarkInvoiceDisputed))
+	mux.Handle("POST /v2.3/invoices/{id}/cancel", http.HandlerFunc(ic.CancelInvoice))
+	mux.Handle("POST /v2.3/invoices/{id}/revisions", http.HandlerFunc(ic.ReviseInvoice))
 
 	mux.Handle("PUT /v2.3/invoices/{id}", http.HandlerFunc(ic.UpdateInvoice))
+	mux.Handle("PUT /v2.3/invoices/lines/{id}", http.HandlerFunc(ic.UpdateInvoiceLine))
+
+	mux.Handle("DELETE /v2.3/invoices/lines/{id}", http.HandlerFunc(ic.DeleteInvoiceLine))
 }
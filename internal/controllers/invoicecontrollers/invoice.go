@@ -2,6 +2,7 @@ package invoicecontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"time"
 
@@ -10,12 +11,31 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	invoiceworkflows "github.com/cloudfresco/sc-ubl/internal/workflows/invoiceworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
 	"go.uber.org/zap"
 )
 
+// maxUBLSize - largest UBL document accepted by the import endpoints
+const maxUBLSize = 10 << 20
+
+// renderUBLError - send error JSON response, listing the problems of a UBL
+// document that does not conform to the UBL 2.3 schema or to the business
+// rules of a profile
+func renderUBLError(w http.ResponseWriter, errorCode string, err error, requestID string) {
+	if problems, ok := ublvalidate.Problems(err); ok {
+		if problems[0].Rule != "" {
+			common.RenderProblemsJSON(w, "4005", "UBL document does not conform to the business rules of its profile", problems, http.StatusUnprocessableEntity, requestID)
+			return
+		}
+		common.RenderProblemsJSON(w, "4003", "UBL document does not conform to the UBL 2.3 schema", problems, http.StatusUnprocessableEntity, requestID)
+		return
+	}
+	common.RenderErrorJSON(w, errorCode, err.Error(), 402, requestID)
+}
+
 // InvoiceHeaderController - Create InvoiceHeader Controller
 type InvoiceHeaderController struct {
 	log                  *zap.Logger
@@ -64,6 +84,7 @@ func (ic *InvoiceHeaderController) CreateInvoice(w http.ResponseWriter, r *http.
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := ic.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.CreateInvoiceWorkflow, &form, token, user, ic.log)
@@ -81,6 +102,58 @@ func (ic *InvoiceHeaderController) CreateInvoice(w http.ResponseWriter, r *http.
 	common.RenderJSON(w, invoice)
 }
 
+// ImportInvoiceUBL - Create Invoice from a UBL 2.3 Invoice XML request body
+func (ic *InvoiceHeaderController) ImportInvoiceUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"invoice:cud"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        invoiceworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ImportInvoiceUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := ic.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.ImportInvoiceUBLWorkflow, &form, token, user, ic.log)
+	workflowClient := ic.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var invoice invoiceproto.ImportInvoiceUBLResponse
+	err = workflowRun.Get(ctx, &invoice)
+
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &invoice)
+}
+
 // Index - list Invoice
 func (ic *InvoiceHeaderController) Index(w http.ResponseWriter, r *http.Request) {
 	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
@@ -189,3 +262,298 @@ func (ic *InvoiceHeaderController) GetInvoiceLines(w http.ResponseWriter, r *htt
 	}
 	common.RenderJSON(w, invoiceLines)
 }
+
+// GetInvoiceUBL - Get Invoice as UBL 2.3 Invoice XML, the profile query
+// parameter selects the PEPPOL BIS Billing 3.0 output
+func (ic *InvoiceHeaderController) GetInvoiceUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	invoiceUBL, err := ic.InvoiceServiceClient.GetInvoiceUBL(ctx, &invoiceproto.GetInvoiceUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}, Profile: r.URL.Query().Get("profile")})
+	if err != nil {
+		ic.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, invoiceUBL.Ubl)
+}
+
+// GetInvoiceCII - Get Invoice as UN/CEFACT Cross Industry Invoice D16B XML
+func (ic *InvoiceHeaderController) GetInvoiceCII(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	invoiceCII, err := ic.InvoiceServiceClient.GetInvoiceCII(ctx, &invoiceproto.GetInvoiceCIIRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		ic.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderXML(w, invoiceCII.Cii)
+}
+
+// GetInvoiceFacturX - Get Invoice as a Factur-X / ZUGFeRD PDF/A-3 with the
+// CII XML embedded, the profile query parameter selects the Factur-X profile
+func (ic *InvoiceHeaderController) GetInvoiceFacturX(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	invoiceFacturX, err := ic.InvoiceServiceClient.GetInvoiceFacturX(ctx, &invoiceproto.GetInvoiceFacturXRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}, Profile: r.URL.Query().Get("profile")})
+	if err != nil {
+		ic.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderPDF(w, invoiceFacturX.Pdf)
+}
+
+// ImportInvoiceCII - Create Invoice from a UN/CEFACT Cross Industry Invoice
+// D16B XML request body
+func (ic *InvoiceHeaderController) ImportInvoiceCII(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"invoice:cud"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "cii_" + uuid.New(),
+		TaskList:                        invoiceworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ciiBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ImportInvoiceCIIRequest{}
+	form.Cii = ciiBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := ic.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, invoiceworkflows.ImportInvoiceCIIWorkflow, &form, token, user, ic.log)
+	workflowClient := ic.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var invoice invoiceproto.ImportInvoiceCIIResponse
+	err = workflowRun.Get(ctx, &invoice)
+
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &invoice)
+}
+
+// ValidateDocument - check a UBL 2.3 XML request body against the UBL 2.3
+// schemas without storing it
+func (ic *InvoiceHeaderController) ValidateDocument(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	validation, err := ic.InvoiceServiceClient.ValidateDocument(ctx, &invoiceproto.ValidateDocumentRequest{Ubl: ublBytes, UserEmail: user.Email, RequestId: user.RequestId})
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, validation)
+}
+
+// ValidateInvoice - check a CreateInvoice request body against the EN 16931
+// business rules without storing it
+func (ic *InvoiceHeaderController) ValidateInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:read"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.CreateInvoiceRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	validation, err := ic.InvoiceServiceClient.ValidateInvoice(ctx, &invoiceproto.ValidateInvoiceRequest{Invoice: &form, UserId: user.UserId, UserEmail: user.Email, RequestId: user.RequestId})
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, validation)
+}
+
+// IssueInvoice - Issue an draft invoice
+func (ic *InvoiceHeaderController) IssueInvoice(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.IssueInvoice)
+}
+
+// SendInvoice - Mark an issued invoice as sent
+func (ic *InvoiceHeaderController) SendInvoice(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.SendInvoice)
+}
+
+// AcknowledgeInvoice - Mark an invoice as acknowledged by the customer
+func (ic *InvoiceHeaderController) AcknowledgeInvoice(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.AcknowledgeInvoice)
+}
+
+// MarkInvoicePartiallyPaid - Mark an invoice as partially paid
+func (ic *InvoiceHeaderController) MarkInvoicePartiallyPaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.MarkInvoicePartiallyPaid)
+}
+
+// MarkInvoicePaid - Mark an invoice as paid
+func (ic *InvoiceHeaderController) MarkInvoicePaid(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.MarkInvoicePaid)
+}
+
+// MarkInvoiceDisputed - Mark an invoice as disputed, the body holds the reason
+func (ic *InvoiceHeaderController) MarkInvoiceDisputed(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.MarkInvoiceDisputed)
+}
+
+// CancelInvoice - Cancel an invoice, the body holds the reason
+func (ic *InvoiceHeaderController) CancelInvoice(w http.ResponseWriter, r *http.Request) {
+	transitionDocumentStatus(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:cud", ic.InvoiceServiceClient.CancelInvoice)
+}
+
+// GetInvoiceStatusTransitions - Get the status transitions of an invoice
+func (ic *InvoiceHeaderController) GetInvoiceStatusTransitions(w http.ResponseWriter, r *http.Request) {
+	getDocumentStatusTransitions(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:read", ic.InvoiceServiceClient.GetInvoiceStatusTransitions)
+}
+
+// ReviseInvoice - Revise an issued invoice into its next version
+func (ic *InvoiceHeaderController) ReviseInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:cud"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.ReviseInvoiceRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	if form.UpdateInvoiceRequest == nil {
+		form.UpdateInvoiceRequest = &invoiceproto.UpdateInvoiceRequest{}
+	}
+	form.UpdateInvoiceRequest.Id = r.PathValue("id")
+	form.UpdateInvoiceRequest.UserId = user.UserId
+	form.UpdateInvoiceRequest.UserEmail = user.Email
+	form.UpdateInvoiceRequest.RequestId = user.RequestId
+
+	revision, err := ic.InvoiceServiceClient.ReviseInvoice(ctx, &form)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, revision)
+}
+
+// GetInvoiceVersions - Get the versions of an invoice
+func (ic *InvoiceHeaderController) GetInvoiceVersions(w http.ResponseWriter, r *http.Request) {
+	getDocumentVersions(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:read", ic.InvoiceServiceClient.GetInvoiceVersions)
+}
+
+// DiffInvoiceVersions - Get the changes to an invoice between two versions
+func (ic *InvoiceHeaderController) DiffInvoiceVersions(w http.ResponseWriter, r *http.Request) {
+	diffDocumentVersions(w, r, ic.log, ic.ServerOpt, ic.UserServiceClient, "invoice:read", ic.InvoiceServiceClient.DiffInvoiceVersions)
+}
+
+// UpdateInvoiceLine - Update a line of a draft invoice
+func (ic *InvoiceHeaderController) UpdateInvoiceLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:cud"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := invoiceproto.UpdateInvoiceLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = ic.InvoiceServiceClient.UpdateInvoiceLine(ctx, &form)
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeleteInvoiceLine - Delete a line of a draft invoice
+func (ic *InvoiceHeaderController) DeleteInvoiceLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"invoice:cud"}, ic.ServerOpt.Auth0Audience, ic.ServerOpt.Auth0Domain, ic.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = ic.InvoiceServiceClient.DeleteInvoiceLine(ctx, &invoiceproto.DeleteInvoiceLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		ic.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
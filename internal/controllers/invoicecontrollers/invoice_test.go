@@ -37,15 +37,15 @@ func TestCreateInvoice(t *testing.T) {
 	invoiceHeader.PricingExDate = "06/22/2022"
 	invoiceHeader.PaymentExDate = "06/23/2022"
 	invoiceHeader.PaymentAltExDate = "06/24/2022"
-	invoiceHeader.ChargeTotalAmount = float64(0)
-	invoiceHeader.PrepaidAmount = float64(0)
-	invoiceHeader.PayableRoundingAmount = float64(0)
-	invoiceHeader.PayableAmount = float64(200)
+	invoiceHeader.ChargeTotalAmount = "0"
+	invoiceHeader.PrepaidAmount = "0"
+	invoiceHeader.PayableRoundingAmount = "0"
+	invoiceHeader.PayableAmount = "200"
 
 	invoiceLine := invoiceproto.CreateInvoiceLineRequest{}
 	invoiceLine.Note = "Ordered in our booth at the convention."
 	invoiceLine.InvoicedQuantity = float64(1)
-	invoiceLine.LineExtensionAmount = float64(200)
+	invoiceLine.LineExtensionAmount = "200"
 	invoiceLine.TaxPointDate = "11/25/2022"
 	invoiceLine.AccountingCost = "Code002"
 	invoiceLine.ItemId = uint32(7)
@@ -92,10 +92,10 @@ func TestUpdateInvoice(t *testing.T) {
 	form := invoiceproto.UpdateInvoiceRequest{}
 	form.Note = "Ordered"
 	form.InvoiceTypeCode = "Sales"
-	form.ChargeTotalAmount = float64(200)
-	form.PrepaidAmount = float64(100)
-	form.PayableRoundingAmount = float64(150)
-	form.PayableAmount = float64(400)
+	form.ChargeTotalAmount = "200"
+	form.PrepaidAmount = "100"
+	form.PayableRoundingAmount = "150"
+	form.PayableAmount = "400"
 
 	data, _ := json.Marshal(&form)
 
@@ -108,6 +108,7 @@ func (cc *ConsignmentController) CreateConsignment(w http.ResponseWriter, r *htt
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := cc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.CreateConsignmentWorkflow, &form, token, user, cc.log)
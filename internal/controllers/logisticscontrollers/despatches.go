@@ -2,6 +2,7 @@ package logisticscontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"time"
 
@@ -10,12 +11,26 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	logisticsworkflows "github.com/cloudfresco/sc-ubl/internal/workflows/logisticsworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
 	"go.uber.org/zap"
 )
 
+// maxUBLSize - largest UBL document accepted by the import endpoints
+const maxUBLSize = 10 << 20
+
+// renderUBLError - send error JSON response, listing the problems of a UBL
+// document that does not conform to the UBL 2.3 schema
+func renderUBLError(w http.ResponseWriter, errorCode string, err error, requestID string) {
+	if problems, ok := ublvalidate.Problems(err); ok {
+		common.RenderProblemsJSON(w, "4003", "UBL document does not conform to the UBL 2.3 schema", problems, http.StatusUnprocessableEntity, requestID)
+		return
+	}
+	common.RenderErrorJSON(w, errorCode, err.Error(), 402, requestID)
+}
+
 // DespatchHeaderController - Create DespatchHeader Controller
 type DespatchHeaderController struct {
 	log                   *zap.Logger
@@ -64,6 +79,7 @@ func (dc *DespatchHeaderController) CreateDespatchHeader(w http.ResponseWriter,
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := dc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.CreateDespatchHeaderWorkflow, &form, token, user, dc.log)
@@ -189,3 +205,125 @@ func (dc *DespatchHeaderController) UpdateDespatchHeader(w http.ResponseWriter,
 	}
 	common.RenderJSON(w, response)
 }
+
+// ImportDespatchUBL - Create Despatch from a UBL 2.3 DespatchAdvice XML request body
+func (dc *DespatchHeaderController) ImportDespatchUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"despatch:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        logisticsworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := logisticsproto.ImportDespatchUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := dc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.ImportDespatchUBLWorkflow, &form, token, user, dc.log)
+	workflowClient := dc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var despatchHeader logisticsproto.ImportDespatchUBLResponse
+	err = workflowRun.Get(ctx, &despatchHeader)
+
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &despatchHeader)
+}
+
+// GetDespatchUBL - Get Despatch as UBL 2.3 DespatchAdvice XML
+func (dc *DespatchHeaderController) GetDespatchUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"despatch:read"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	despatchUBL, err := dc.DespatchServiceClient.GetDespatchUBL(ctx, &logisticsproto.GetDespatchUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		dc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, despatchUBL.Ubl)
+}
+
+// UpdateDespatchLine - Update a line of a despatch
+func (dc *DespatchHeaderController) UpdateDespatchLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"despatch:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := logisticsproto.UpdateDespatchLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = dc.DespatchServiceClient.UpdateDespatchLine(ctx, &form)
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeleteDespatchLine - Delete a line of a despatch
+func (dc *DespatchHeaderController) DeleteDespatchLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"despatch:cud"}, dc.ServerOpt.Auth0Audience, dc.ServerOpt.Auth0Domain, dc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = dc.DespatchServiceClient.DeleteDespatchLine(ctx, &logisticsproto.DeleteDespatchLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		dc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
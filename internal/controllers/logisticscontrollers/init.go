@@ -116,10 +116,15 @@ func initReceiptAdviceHeaders(mux *http.ServeMux, serverOpt *config.ServerOption
 	mux.Handle("GET /v2.3/receipt-advices", http.HandlerFunc(rc.Index))
 	mux.Handle("GET /v2.3/receipt-advices/{id}", http.HandlerFunc(rc.Show))
 	mux.Handle("GET /v2.3/receipt-advices/{id}/lines", http.HandlerFunc(rc.GetReceiptAdviceLines))
+	mux.Handle("GET /v2.3/receipt-advices/{id}/ubl", http.HandlerFunc(rc.GetReceiptAdviceUBL))
 
 	mux.Handle("POST /v2.3/receipt-advices", http.HandlerFunc(rc.CreateReceiptAdviceHeader))
+	mux.Handle("POST /v2.3/receipt-advices/import", http.HandlerFunc(rc.ImportReceiptAdviceUBL))
 
 	mux.Handle("PUT /v2.3/receipt-advices/{id}", http.HandlerFunc(rc.UpdateReceiptAdviceHeader))
+	mux.Handle("PUT /v2.3/receipt-advices/lines/{id}", http.HandlerFunc(rc.UpdateReceiptAdviceLine))
+
+	mux.Handle("DELETE /v2.3/receipt-advices/lines/{id}", http.HandlerFunc(rc.DeleteReceiptAdviceLine))
 }
 
 func initDespatches(mux *http.ServeMux, serverOpt *config.ServerOptions, log *zap.Logger, u partyproto.UserServiceClient, d logisticsproto.DespatchServiceClient, wfHelper common.WfHelper, workflowClient client.Client) {
@@ -128,10 +133,15 @@ func initDespatches(mux *http.ServeMux, serverOpt *config.ServerOptions, log *za
 	mux.Handle("GET /v2.3/despatches", http.HandlerFunc(dc.Index))
 	mux.Handle("GET /v2.3/despatches/{id}", http.HandlerFunc(dc.Show))
 	mux.Handle("GET /v2.3/despatches/{id}/lines", http.HandlerFunc(dc.GetDespatchLines))
+	mux.Handle("GET /v2.3/despatches/{id}/ubl", http.HandlerFunc(dc.GetDespatchUBL))
 
 	mux.Handle("POST /v2.3/despatches", http.HandlerFunc(dc.CreateDespatchHeader))
+	mux.Handle("POST /v2.3/despatches/import", http.HandlerFunc(dc.ImportDespatchUBL))
 
 	mux.Handle("PUT /v2.3/despatches/{id}", http.HandlerFunc(dc.UpdateDespatchHeader))
+	mux.Handle("PUT /v2.3/despatches/lines/{id}", http.HandlerFunc(dc.UpdateDespatchLine))
+
+	mux.Handle("DELETE /v2.3/despatches/lines/{id}", http.HandlerFunc(dc.DeleteDespatchLine))
 }
 
 func initShipments(mux *http.ServeMux, serverOpt *config.ServerOptions, log *zap.Logger, u partyproto.UserServiceClient, s logisticsproto.ShipmentServiceClient, wfHelper common.WfHelper, workflowClient client.Client) {
@@ -2,6 +2,7 @@ package logisticscontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	logisticsworkflows "github.com/cloudfresco/sc-ubl/internal/workflows/logisticsworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
@@ -64,6 +66,7 @@ func (rc *ReceiptAdviceHeaderController) CreateReceiptAdviceHeader(w http.Respon
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := rc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.CreateReceiptAdviceHeaderWorkflow, &form, token, user, rc.log)
@@ -189,3 +192,125 @@ func (rc *ReceiptAdviceHeaderController) UpdateReceiptAdviceHeader(w http.Respon
 	}
 	common.RenderJSON(w, response)
 }
+
+// ImportReceiptAdviceUBL - Create ReceiptAdvice from a UBL 2.3 ReceiptAdvice XML request body
+func (rc *ReceiptAdviceHeaderController) ImportReceiptAdviceUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"rcptadv:cud"}, rc.ServerOpt.Auth0Audience, rc.ServerOpt.Auth0Domain, rc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        logisticsworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := logisticsproto.ImportReceiptAdviceUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := rc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.ImportReceiptAdviceUBLWorkflow, &form, token, user, rc.log)
+	workflowClient := rc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var receiptAdviceHeader logisticsproto.ImportReceiptAdviceUBLResponse
+	err = workflowRun.Get(ctx, &receiptAdviceHeader)
+
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &receiptAdviceHeader)
+}
+
+// GetReceiptAdviceUBL - Get ReceiptAdvice as UBL 2.3 ReceiptAdvice XML
+func (rc *ReceiptAdviceHeaderController) GetReceiptAdviceUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"rcptadv:read"}, rc.ServerOpt.Auth0Audience, rc.ServerOpt.Auth0Domain, rc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	receiptAdviceUBL, err := rc.ReceiptAdviceHeaderServiceClient.GetReceiptAdviceUBL(ctx, &logisticsproto.GetReceiptAdviceUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		rc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, receiptAdviceUBL.Ubl)
+}
+
+// UpdateReceiptAdviceLine - Update a line of a receipt advice
+func (rc *ReceiptAdviceHeaderController) UpdateReceiptAdviceLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"rcptadv:cud"}, rc.ServerOpt.Auth0Audience, rc.ServerOpt.Auth0Domain, rc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := logisticsproto.UpdateReceiptAdviceLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = rc.ReceiptAdviceHeaderServiceClient.UpdateReceiptAdviceLine(ctx, &form)
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeleteReceiptAdviceLine - Delete a line of a receipt advice
+func (rc *ReceiptAdviceHeaderController) DeleteReceiptAdviceLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"rcptadv:cud"}, rc.ServerOpt.Auth0Audience, rc.ServerOpt.Auth0Domain, rc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = rc.ReceiptAdviceHeaderServiceClient.DeleteReceiptAdviceLine(ctx, &logisticsproto.DeleteReceiptAdviceLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		rc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
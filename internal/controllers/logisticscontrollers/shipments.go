@@ -66,6 +66,7 @@ func (sc *ShipmentController) CreateShipment(w http.ResponseWriter, r *http.Requ
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := sc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, logisticsworkflows.CreateShipmentWorkflow, &form, token, user, sc.log)
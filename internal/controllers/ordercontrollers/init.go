@@ -101,8 +101,19 @@ func initPurchaseOrders(mux *http.ServeMux, serverOpt *config.ServerOptions, log
 	mux.Handle("GET /v2.3/purchase-orders", http.HandlerFunc(po.Index))
 	mux.Handle("GET /v2.3/purchase-orders/{id}", http.HandlerFunc(po.Show))
 	mux.Handle("GET /v2.3/parties/{id}/lines", http.HandlerFunc(po.GetPurchaseOrderLines))
+	mux.Handle("GET /v2.3/purchase-orders/{id}/ubl", http.HandlerFunc(po.GetPurchaseOrderUBL))
+	mux.Handle("GET /v2.3/purchase-orders/{id}/versions", http.HandlerFunc(po.GetPurchaseOrderVersions))
+	mux.Handle("GET /v2.3/purchase-orders/{id}/versions/diff", http.HandlerFunc(po.DiffPurchaseOrderVersions))
 
 	mux.Handle("POST /v2.3/purchase-orders", http.HandlerFunc(po.CreatePurchaseOrderHeader))
+	mux.Handle("POST /v2.3/purchase-orders/import", http.HandlerFunc(po.ImportPurchaseOrderUBL))
+	mux.Handle("POST /v2.3/purchase-orders/order-responses", http.HandlerFunc(po.ImportOrderResponseUBL))
+	mux.Handle("POST /v2.3/purchase-orders/order-changes", http.HandlerFunc(po.ImportOrderChangeUBL))
+	mux.Handle("POST /v2.3/purchase-orders/{id}/issue", http.HandlerFunc(po.IssuePurchaseOrder))
+	mux.Handle("POST /v2.3/purchase-orders/{id}/revisions", http.HandlerFunc(po.RevisePurchaseOrder))
 
 	mux.Handle("PUT /v2.3/purchase-orders/{id}", http.HandlerFunc(po.UpdatePurchaseOrderHeader))
+	mux.Handle("PUT /v2.3/purchase-orders/lines/{id}", http.HandlerFunc(po.UpdatePurchaseOrderLine))
+
+	mux.Handle("DELETE /v2.3/purchase-orders/lines/{id}", http.HandlerFunc(po.DeletePurchaseOrderLine))
 }
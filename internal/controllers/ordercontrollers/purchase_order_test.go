@@ -30,8 +30,8 @@ func TestCreatePurchaseOrderHeader(t *testing.T) {
 	purchaseOrderHeader.ValidityPeriod = "02/22/2022"
 	purchaseOrderHeader.OrderTypeCode = "ABCFES"
 	purchaseOrderHeader.Note = "Sample"
-	purchaseOrderHeader.LineExtensionAmount = float64(100)
-	purchaseOrderHeader.PayableAmount = float64(100)
+	purchaseOrderHeader.LineExtensionAmount = "100"
+	purchaseOrderHeader.PayableAmount = "100"
 	purchaseOrderHeader.TaxExDate = "08/10/2022"
 	purchaseOrderHeader.PricingExDate = "08/10/2022"
 	purchaseOrderHeader.PaymentExDate = "08/11/2022"
@@ -41,10 +41,10 @@ func TestCreatePurchaseOrderHeader(t *testing.T) {
 	purchaseOrderLine.LineStatusCode = "ABCFES"
 	purchaseOrderLine.OriginatorPartyId = uint32(1)
 	purchaseOrderLine.Quantity = float64(100)
-	purchaseOrderLine.LineExtensionAmount = float64(100)
-	purchaseOrderLine.TotalTaxAmount = float64(17.5)
+	purchaseOrderLine.LineExtensionAmount = "100"
+	purchaseOrderLine.TotalTaxAmount = "17.5"
 	purchaseOrderLine.ItemId = uint32(7)
-	purchaseOrderLine.PriceAmount = float64(17.5)
+	purchaseOrderLine.PriceAmount = "17.5"
 	purchaseOrderLine.PriceBaseQuantity = float64(100)
 	purchaseOrderLine.PriceValidityPeriodStartDate = "02/22/2022"
 	purchaseOrderLine.PriceValidityPeriodEndDate = "02/27/2022"
@@ -2,7 +2,9 @@ package ordercontrollers
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
@@ -10,12 +12,26 @@ import (
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
 	"github.com/cloudfresco/sc-ubl/internal/workflows/orderworkflows"
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/client"
 	"go.uber.org/zap"
 )
 
+// maxUBLSize - largest UBL document accepted by the import endpoints
+const maxUBLSize = 10 << 20
+
+// renderUBLError - send error JSON response, listing the problems of a UBL
+// document that does not conform to the UBL 2.3 schema
+func renderUBLError(w http.ResponseWriter, errorCode string, err error, requestID string) {
+	if problems, ok := ublvalidate.Problems(err); ok {
+		common.RenderProblemsJSON(w, "4003", "UBL document does not conform to the UBL 2.3 schema", problems, http.StatusUnprocessableEntity, requestID)
+		return
+	}
+	common.RenderErrorJSON(w, errorCode, err.Error(), 402, requestID)
+}
+
 // PurchaseOrderHeaderController - Create PurchaseOrderHeader Controller
 type PurchaseOrderHeaderController struct {
 	log                              *zap.Logger
@@ -64,6 +80,7 @@ func (pc *PurchaseOrderHeaderController) CreatePurchaseOrderHeader(w http.Respon
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := pc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, orderworkflows.CreatePurchaseOrderHeaderWorkflow, &form, token, user, pc.log)
@@ -188,3 +205,341 @@ func (pc *PurchaseOrderHeaderController) UpdatePurchaseOrderHeader(w http.Respon
 	}
 	common.RenderJSON(w, response)
 }
+
+// ImportPurchaseOrderUBL - Create PurchaseOrder from a UBL 2.3 Order XML request body
+func (pc *PurchaseOrderHeaderController) ImportPurchaseOrderUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        orderworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := orderproto.ImportPurchaseOrderUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := pc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, orderworkflows.ImportPurchaseOrderUBLWorkflow, &form, token, user, pc.log)
+	workflowClient := pc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var purchaseOrderHeader orderproto.ImportPurchaseOrderUBLResponse
+	err = workflowRun.Get(ctx, &purchaseOrderHeader)
+
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &purchaseOrderHeader)
+}
+
+// ImportOrderResponseUBL - Record a UBL 2.3 OrderResponse XML request body on the PurchaseOrder it answers
+func (pc *PurchaseOrderHeaderController) ImportOrderResponseUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        orderworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := orderproto.ImportOrderResponseUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := pc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, orderworkflows.ImportOrderResponseUBLWorkflow, &form, token, user, pc.log)
+	workflowClient := pc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var purchaseOrder orderproto.ImportOrderResponseUBLResponse
+	err = workflowRun.Get(ctx, &purchaseOrder)
+
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &purchaseOrder)
+}
+
+// ImportOrderChangeUBL - Apply a UBL 2.3 OrderChange XML request body to the PurchaseOrder it changes
+func (pc *PurchaseOrderHeaderController) ImportOrderChangeUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, token, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              "ubl_" + uuid.New(),
+		TaskList:                        orderworkflows.ApplicationName,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+	}
+
+	ublBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUBLSize))
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		renderUBLError(w, "4002", err, user.RequestId)
+		return
+	}
+
+	form := orderproto.ImportOrderChangeUBLRequest{}
+	form.Ubl = ublBytes
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
+
+	wHelper := pc.wfHelper
+	result := wHelper.StartWorkflow(workflowOptions, orderworkflows.ImportOrderChangeUBLWorkflow, &form, token, user, pc.log)
+	workflowClient := pc.workflowClient
+	workflowRun := workflowClient.GetWorkflow(ctx, result.ID, result.RunID)
+	var purchaseOrder orderproto.ImportOrderChangeUBLResponse
+	err = workflowRun.Get(ctx, &purchaseOrder)
+
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4002", err.Error(), 402, user.RequestId)
+		return
+	}
+
+	common.RenderJSON(w, &purchaseOrder)
+}
+
+// GetPurchaseOrderUBL - Get PurchaseOrder as UBL 2.3 Order XML
+func (pc *PurchaseOrderHeaderController) GetPurchaseOrderUBL(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:read"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	purchaseOrderUBL, err := pc.PurchaseOrderHeaderServiceClient.GetPurchaseOrderUBL(ctx, &orderproto.GetPurchaseOrderUBLRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		pc.log.Error("Error",
+			zap.String("reqid", user.RequestId),
+			zap.Error(err))
+		renderUBLError(w, "1103", err, user.RequestId)
+		return
+	}
+	common.RenderXML(w, purchaseOrderUBL.Ubl)
+}
+
+// IssuePurchaseOrder - Issue a draft purchase order
+func (pc *PurchaseOrderHeaderController) IssuePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := orderproto.IssuePurchaseOrderRequest{}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	response, err := pc.PurchaseOrderHeaderServiceClient.IssuePurchaseOrder(ctx, &form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, response)
+}
+
+// RevisePurchaseOrder - Revise an issued purchase order into its next version
+func (pc *PurchaseOrderHeaderController) RevisePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := orderproto.RevisePurchaseOrderRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	if form.UpdatePurchaseOrderHeaderRequest == nil {
+		form.UpdatePurchaseOrderHeaderRequest = &orderproto.UpdatePurchaseOrderHeaderRequest{}
+	}
+	form.UpdatePurchaseOrderHeaderRequest.Id = r.PathValue("id")
+	form.UpdatePurchaseOrderHeaderRequest.UserId = user.UserId
+	form.UpdatePurchaseOrderHeaderRequest.UserEmail = user.Email
+	form.UpdatePurchaseOrderHeaderRequest.RequestId = user.RequestId
+
+	revision, err := pc.PurchaseOrderHeaderServiceClient.RevisePurchaseOrder(ctx, &form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, revision)
+}
+
+// GetPurchaseOrderVersions - Get the versions of a purchase order
+func (pc *PurchaseOrderHeaderController) GetPurchaseOrderVersions(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:read"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	versions, err := pc.PurchaseOrderHeaderServiceClient.GetPurchaseOrderVersions(ctx, &commonproto.GetDocumentVersionsRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, versions)
+}
+
+// DiffPurchaseOrderVersions - Get the changes to a purchase order between
+// the versions of the from and to query values
+func (pc *PurchaseOrderHeaderController) DiffPurchaseOrderVersions(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:read"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := commonproto.DiffDocumentVersionsRequest{}
+	form.Id = r.PathValue("id")
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+	from, err := strconv.ParseUint(r.URL.Query().Get("from"), 10, 32)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	to, err := strconv.ParseUint(r.URL.Query().Get("to"), 10, 32)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.FromVersionNumber = uint32(from)
+	form.ToVersionNumber = uint32(to)
+
+	changes, err := pc.PurchaseOrderHeaderServiceClient.DiffPurchaseOrderVersions(ctx, &form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "1103", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, changes)
+}
+
+// UpdatePurchaseOrderLine - Update a line of a draft purchase order
+func (pc *PurchaseOrderHeaderController) UpdatePurchaseOrderLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	form := orderproto.UpdatePurchaseOrderLineRequest{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	form.Id = r.PathValue("id")
+	form.UserId = user.UserId
+	form.UserEmail = user.Email
+	form.RequestId = user.RequestId
+
+	_, err = pc.PurchaseOrderHeaderServiceClient.UpdatePurchaseOrderLine(ctx, &form)
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4009", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Updated Successfully")
+}
+
+// DeletePurchaseOrderLine - Delete a line of a draft purchase order
+func (pc *PurchaseOrderHeaderController) DeletePurchaseOrderLine(w http.ResponseWriter, r *http.Request) {
+	ctx, user, _, err := common.GetContextAuthUser(w, r, []string{"po:cud"}, pc.ServerOpt.Auth0Audience, pc.ServerOpt.Auth0Domain, pc.UserServiceClient)
+	if err != nil {
+		common.RenderErrorJSON(w, "1001", err.Error(), 401, user.RequestId)
+		return
+	}
+
+	id := r.PathValue("id")
+
+	_, err = pc.PurchaseOrderHeaderServiceClient.DeletePurchaseOrderLine(ctx, &orderproto.DeletePurchaseOrderLineRequest{GetRequest: &commonproto.GetRequest{Id: id, UserEmail: user.Email, RequestId: user.RequestId}})
+	if err != nil {
+		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
+		return
+	}
+	common.RenderJSON(w, "Deleted Successfully")
+}
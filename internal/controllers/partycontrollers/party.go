@@ -89,6 +89,7 @@ func (pc *PartyController) CreateParty(w http.ResponseWriter, r *http.Request) {
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 	v := common.NewValidator()
 	v.IsStrLenBetMinMax("Party Name", form.PartyName, common.PartyNameLenMin, common.PartyNameLenMax)
 	v.IsStrLenBetMinMax("Party Description", form.PartyDesc, common.PartyDescLenMin, common.PartyDescLenMax)
@@ -124,7 +125,7 @@ func (pc *PartyController) CreateChild(w http.ResponseWriter, r *http.Request) {
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
-	party, err := pc.PartyServiceClient.CreateChild(ctx, &partyproto.CreateChildRequest{CreatePartyRequest: &form})
+	party, err := pc.PartyServiceClient.CreateChild(ctx, &partyproto.CreateChildRequest{CreatePartyRequest: &form, IdempotencyKey: common.GetIdempotencyKey(r, form.IdempotencyKey)})
 	if err != nil {
 		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
 		common.RenderErrorJSON(w, "4016", err.Error(), 402, user.RequestId)
@@ -308,6 +309,7 @@ func (pc *PartyController) CreatePartyContact(w http.ResponseWriter, r *http.Req
 	form.PartyId = uint32(pcid)
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 	partycontact, err := pc.PartyServiceClient.CreatePartyContact(ctx, &form)
 	if err != nil {
 		pc.log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
@@ -105,7 +105,7 @@ func TestCreateTaxCategory(t *testing.T) {
 	taxCategory.TaxCategoryName = "TaxCategory"
 	taxCategory.Percent = float32(20)
 	taxCategory.BaseUnitMeasure = "EUR"
-	taxCategory.PerUnitAmount = float64(10)
+	taxCategory.PerUnitAmount = "10"
 	taxCategory.TaxExemptionReasonCode = ""
 	taxCategory.TaxExemptionReason = ""
 	taxCategory.TierRange = ""
@@ -144,7 +144,7 @@ func TestUpdateTaxCategory(t *testing.T) {
 	taxCategory.TaxCategoryName = "TaxCategory1"
 	taxCategory.Percent = float32(10)
 	taxCategory.BaseUnitMeasure = "EUR"
-	taxCategory.PerUnitAmount = float64(10)
+	taxCategory.PerUnitAmount = "10"
 	taxCategory.TaxExemptionReasonCode = ""
 	taxCategory.TaxExemptionReason = ""
 
@@ -184,8 +184,8 @@ func TestCreateTaxTotal(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	taxTotal := taxproto.CreateTaxTotalRequest{}
-	taxTotal.TaxAmount = float64(17.5)
-	taxTotal.RoundingAmount = float64(18)
+	taxTotal.TaxAmount = "17.5"
+	taxTotal.RoundingAmount = "18"
 	taxTotal.TaxEvidenceIndicator = false
 	taxTotal.TaxIncludedIndicator = false
 	taxTotal.MasterFlag = "CNL"
@@ -221,8 +221,8 @@ func TestUpdateTaxTotal(t *testing.T) {
 	tokenString, email, backendServerAddr := LoginUser()
 
 	taxTotal := taxproto.UpdateTaxTotalRequest{}
-	taxTotal.TaxAmount = float64(18.5)
-	taxTotal.RoundingAmount = float64(19)
+	taxTotal.TaxAmount = "18.5"
+	taxTotal.RoundingAmount = "19"
 
 	w := httptest.NewRecorder()
 
@@ -260,13 +260,13 @@ func TestCreateTaxSubTotal(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	taxSubTotal := taxproto.CreateTaxSubTotalRequest{}
-	taxSubTotal.TaxableAmount = float64(100)
-	taxSubTotal.TaxAmount = float64(17.5)
+	taxSubTotal.TaxableAmount = "100"
+	taxSubTotal.TaxAmount = "17.5"
 	taxSubTotal.CalculationSequenceNumeric = uint32(0)
-	taxSubTotal.TransactionCurrencyTaxAmount = float64(0)
+	taxSubTotal.TransactionCurrencyTaxAmount = "0"
 	taxSubTotal.Percent = float32(10)
 	taxSubTotal.BaseUnitMeasure = "EUR"
-	taxSubTotal.PerUnitAmount = float64(10)
+	taxSubTotal.PerUnitAmount = "10"
 	taxSubTotal.TierRange = ""
 	taxSubTotal.TierRatePercent = float64(10)
 	taxSubTotal.TaxCategoryId = uint32(1)
@@ -301,13 +301,13 @@ func TestUpdateTaxSubTotal(t *testing.T) {
 	tokenString, email, backendServerAddr := LoginUser()
 
 	taxSubTotal := taxproto.UpdateTaxSubTotalRequest{}
-	taxSubTotal.TaxableAmount = float64(200)
-	taxSubTotal.TaxAmount = float64(18.5)
+	taxSubTotal.TaxableAmount = "200"
+	taxSubTotal.TaxAmount = "18.5"
 	taxSubTotal.CalculationSequenceNumeric = uint32(0)
-	taxSubTotal.TransactionCurrencyTaxAmount = float64(0)
+	taxSubTotal.TransactionCurrencyTaxAmount = "0"
 	taxSubTotal.Percent = float32(15)
 	taxSubTotal.BaseUnitMeasure = "EUR"
-	taxSubTotal.PerUnitAmount = float64(5)
+	taxSubTotal.PerUnitAmount = "5"
 
 	w := httptest.NewRecorder()
 
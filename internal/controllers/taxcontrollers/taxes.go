@@ -109,6 +109,7 @@ func (tc *TaxController) CreateTaxScheme(w http.ResponseWriter, r *http.Request)
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := tc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, taxworkflows.CreateTaxSchemeWorkflow, &form, token, user, tc.log)
@@ -198,6 +199,7 @@ func (tc *TaxController) CreateTaxSchemeJurisdiction(w http.ResponseWriter, r *h
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := tc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, taxworkflows.CreateTaxSchemeJurisdictionWorkflow, &form, token, user, tc.log)
@@ -287,6 +289,7 @@ func (tc *TaxController) CreateTaxCategory(w http.ResponseWriter, r *http.Reques
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := tc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, taxworkflows.CreateTaxCategoryWorkflow, &form, token, user, tc.log)
@@ -376,6 +379,7 @@ func (tc *TaxController) CreateTaxTotal(w http.ResponseWriter, r *http.Request)
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := tc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, taxworkflows.CreateTaxTotalWorkflow, &form, token, user, tc.log)
@@ -466,6 +470,7 @@ func (tc *TaxController) CreateTaxSubTotal(w http.ResponseWriter, r *http.Reques
 	form.UserId = user.UserId
 	form.UserEmail = user.Email
 	form.RequestId = user.RequestId
+	form.IdempotencyKey = common.GetIdempotencyKey(r, form.IdempotencyKey)
 
 	wHelper := tc.wfHelper
 	result := wHelper.StartWorkflow(workflowOptions, taxworkflows.CreateTaxSubTotalWorkflow, &form, token, user, tc.log)
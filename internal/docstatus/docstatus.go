@@ -0,0 +1,112 @@
+// Package docstatus holds the lifecycle of invoices, credit notes and debit
+// notes. A document is created as a Draft, the only status in which it can
+// be changed, and moves on only along the transitions below:
+//
+//	draft          -> issued, cancelled
+//	issued         -> sent, cancelled
+//	sent           -> acknowledged, partially_paid, paid, disputed, cancelled
+//	acknowledged   -> partially_paid, paid, disputed
+//	partially_paid -> paid, disputed
+//	disputed       -> acknowledged, partially_paid, paid, cancelled
+//
+// Paid and Cancelled are final. A document that is no longer a Draft is
+// not changed in place, it is revised into a new version. Purchase orders
+// are created as a Draft too and only move on to Issued.
+package docstatus
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Status - the lifecycle status of a document
+type Status string
+
+const (
+	// Draft - being prepared, it can still be changed
+	Draft Status = "draft"
+	// Issued - given its final content and number
+	Issued Status = "issued"
+	// Sent - sent to the customer
+	Sent Status = "sent"
+	// Acknowledged - the customer confirmed receipt
+	Acknowledged Status = "acknowledged"
+	// PartiallyPaid - a part of the amount due is paid
+	PartiallyPaid Status = "partially_paid"
+	// Paid - the amount due is paid
+	Paid Status = "paid"
+	// Disputed - the customer disputes the document
+	Disputed Status = "disputed"
+	// Cancelled - withdrawn, it is kept but no longer due
+	Cancelled Status = "cancelled"
+)
+
+// transitions - the statuses a document in a status can move to
+var transitions = map[Status][]Status{
+	Draft:         {Issued, Cancelled},
+	Issued:        {Sent, Cancelled},
+	Sent:          {Acknowledged, PartiallyPaid, Paid, Disputed, Cancelled},
+	Acknowledged:  {PartiallyPaid, Paid, Disputed},
+	PartiallyPaid: {Paid, Disputed},
+	Disputed:      {Acknowledged, PartiallyPaid, Paid, Cancelled},
+}
+
+// Parse - the Status of a name, Draft when it is empty
+func Parse(s string) (Status, error) {
+	status := Status(strings.ToLower(strings.TrimSpace(s)))
+	if status == "" {
+		return Draft, nil
+	}
+	if _, ok := transitions[status]; ok || status == Paid || status == Cancelled {
+		return status, nil
+	}
+	return "", fmt.Errorf("docstatus: unknown document status %q", s)
+}
+
+// CanTransition - whether a document can move from the status from to to
+func CanTransition(from Status, to Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition - an error when a document in the status from cannot move to
+// the status to
+func Transition(from Status, to Status) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("docstatus: a %s document cannot become %s", from, to)
+	}
+	return nil
+}
+
+// CheckEditable - an error when a document in the status s can no longer
+// be changed, which is any status but Draft
+func CheckEditable(s string) error {
+	status, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	if status != Draft {
+		return fmt.Errorf("docstatus: a %s document cannot be changed", status)
+	}
+	return nil
+}
+
+// CheckRevisable - an error when a document in the status s cannot be
+// revised: a Draft is changed in place and Paid and Cancelled are final
+func CheckRevisable(s string) error {
+	status, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	switch status {
+	case Draft:
+		return fmt.Errorf("docstatus: a draft document is updated, not revised")
+	case Paid, Cancelled:
+		return fmt.Errorf("docstatus: a %s document cannot be revised", status)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package docstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransition(t *testing.T) {
+	lifecycle := []Status{Draft, Issued, Sent, Acknowledged, PartiallyPaid, Paid}
+	for i := 1; i < len(lifecycle); i++ {
+		assert.NoError(t, Transition(lifecycle[i-1], lifecycle[i]), lifecycle[i])
+	}
+	assert.NoError(t, Transition(Sent, Disputed))
+	assert.NoError(t, Transition(Disputed, Cancelled))
+
+	assert.Error(t, Transition(Sent, Draft), "a sent document cannot be changed again")
+	assert.Error(t, Transition(Draft, Sent), "a document is issued before it is sent")
+	assert.Error(t, Transition(PartiallyPaid, Cancelled), "a paid document is not cancelled")
+	assert.Error(t, Transition(Paid, Disputed), "paid is final")
+	assert.Error(t, Transition(Cancelled, Draft), "cancelled is final")
+}
+
+func TestParse(t *testing.T) {
+	status, err := Parse("")
+	assert.NoError(t, err)
+	assert.Equal(t, Draft, status)
+	status, err = Parse("Partially_Paid")
+	assert.NoError(t, err)
+	assert.Equal(t, PartiallyPaid, status)
+	status, err = Parse("cancelled")
+	assert.NoError(t, err)
+	assert.Equal(t, Cancelled, status)
+	_, err = Parse("active")
+	assert.Error(t, err)
+}
+
+func TestCheckEditable(t *testing.T) {
+	assert.NoError(t, CheckEditable("draft"))
+	assert.NoError(t, CheckEditable(""))
+	assert.Error(t, CheckEditable("issued"))
+	assert.Error(t, CheckEditable("sent"))
+	assert.Error(t, CheckEditable("unknown"))
+}
+
+func TestCheckRevisable(t *testing.T) {
+	assert.NoError(t, CheckRevisable("issued"))
+	assert.NoError(t, CheckRevisable("disputed"))
+	assert.Error(t, CheckRevisable("draft"), "a draft is updated")
+	assert.Error(t, CheckRevisable(""), "a draft is updated")
+	assert.Error(t, CheckRevisable("paid"))
+	assert.Error(t, CheckRevisable("cancelled"))
+}
@@ -0,0 +1,124 @@
+// Package docversion holds the snapshots of the versions of a document, an
+// invoice, credit note, debit note or purchase order, and the differences
+// between two of them. A snapshot is the JSON of the header and the lines of
+// a document, with the field names of the protos, as it was when the
+// version was made; the diff of two snapshots lists each field that was
+// added, removed or changed by its path, such as lines[0].invoice_line_d.note.
+//
+// The versions are kept in document_versions by the master_flag and
+// master_id of their document, version 1 being the document as it was
+// issued and each revision adding the version that follows the last one.
+package docversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// snapshot - the header and the lines of a document
+type snapshot struct {
+	Header json.RawMessage   `json:"header"`
+	Lines  []json.RawMessage `json:"lines"`
+}
+
+// Change - a field of a document that differs between two versions, From
+// is empty for an added field and To for a removed one
+type Change struct {
+	Path string
+	From string
+	To   string
+}
+
+// Snapshot - the snapshot of a document with its header and lines
+func Snapshot(header proto.Message, lines []proto.Message) ([]byte, error) {
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	s := snapshot{Lines: []json.RawMessage{}}
+	var err error
+	s.Header, err = marshal.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		l, err := marshal.Marshal(line)
+		if err != nil {
+			return nil, err
+		}
+		s.Lines = append(s.Lines, l)
+	}
+
+	// protojson output is not stable, going through a map sorts the fields
+	// so that equal documents have equal snapshots
+	var v interface{}
+	b, err := json.Marshal(&s)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// Diff - the fields that differ between the snapshots from and to, by path
+func Diff(from []byte, to []byte) ([]Change, error) {
+	fromFields, err := fields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := fields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []Change{}
+	for path, f := range fromFields {
+		if t, ok := toFields[path]; !ok || t != f {
+			changes = append(changes, Change{Path: path, From: f, To: t})
+		}
+	}
+	for path, t := range toFields {
+		if _, ok := fromFields[path]; !ok {
+			changes = append(changes, Change{Path: path, To: t})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+// fields - the values of the leaves of a snapshot by path
+func fields(s []byte) (map[string]string, error) {
+	var v interface{}
+	if err := json.Unmarshal(s, &v); err != nil {
+		return nil, fmt.Errorf("docversion: invalid snapshot: %w", err)
+	}
+	m := map[string]string{}
+	flatten(m, "", v)
+	return m, nil
+}
+
+// flatten - add the leaves of v to m, by their path under prefix
+func flatten(m map[string]string, prefix string, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			flatten(m, k, e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			flatten(m, fmt.Sprintf("%s[%d]", prefix, i), e)
+		}
+	case string:
+		m[prefix] = v
+	case nil:
+	default:
+		b, _ := json.Marshal(v)
+		m[prefix] = string(b)
+	}
+}
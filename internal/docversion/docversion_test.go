@@ -0,0 +1,57 @@
+package docversion
+
+import (
+	"context"
+	"testing"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testSnapshot(t *testing.T, note string, payableAmount string, lineNotes ...string) []byte {
+	header := invoiceproto.InvoiceHeader{InvoiceHeaderD: &invoiceproto.InvoiceHeaderD{Id: 1, IhId: "INV-1", Note: note, PayableAmount: payableAmount}}
+	lines := []proto.Message{}
+	for _, lineNote := range lineNotes {
+		lines = append(lines, &invoiceproto.InvoiceLine{InvoiceLineD: &invoiceproto.InvoiceLineD{Note: lineNote, InvoicedQuantity: 2}})
+	}
+	s, err := Snapshot(&header, lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSnapshot(t *testing.T) {
+	s := testSnapshot(t, "sample", "35.00", "first")
+	assert.Equal(t, `{"header":{"invoice_header_d":{"id":1,"ih_id":"INV-1","note":"sample","payable_amount":"35.00"}},"lines":[{"invoice_line_d":{"invoiced_quantity":2,"note":"first"}}]}`, string(s))
+	assert.Equal(t, s, testSnapshot(t, "sample", "35.00", "first"), "equal documents have equal snapshots")
+}
+
+func TestDiff(t *testing.T) {
+	from := testSnapshot(t, "sample", "35.00", "first")
+	to := testSnapshot(t, "", "40.00", "first", "second")
+
+	changes, err := Diff(from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "header.invoice_header_d.note", From: "sample"},
+		{Path: "header.invoice_header_d.payable_amount", From: "35.00", To: "40.00"},
+		{Path: "lines[1].invoice_line_d.invoiced_quantity", To: "2"},
+		{Path: "lines[1].invoice_line_d.note", To: "second"},
+	}, changes)
+
+	changes, err = Diff(from, from)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	_, err = Diff(from, []byte("{"))
+	assert.Error(t, err)
+}
+
+func TestNewRevision(t *testing.T) {
+	// a revision without a reason is refused before its user is looked up
+	revision, err := NewRevision(context.Background(), nil, " ", "auth0|673ee1a719dd4000cd5a3832", "sprov300@gmail.com", "reqid")
+	assert.Nil(t, revision)
+	assert.EqualError(t, err, "docversion: a revision needs a reason")
+}
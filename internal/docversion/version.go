@@ -0,0 +1,222 @@
+package docversion
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// insertDocumentVersionSQL - insert DocumentVersionSQL query
+const insertDocumentVersionSQL = `insert into document_versions
+	  (uuid4,
+master_flag,
+master_id,
+version_number,
+previous_version_id,
+reason,
+snapshot,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:master_flag,
+:master_id,
+:version_number,
+:previous_version_id,
+:reason,
+:snapshot,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// selectDocumentVersionsSQL - select DocumentVersionsSQL query
+const selectDocumentVersionsSQL = `select
+id,
+uuid4,
+master_flag,
+master_id,
+version_number,
+previous_version_id,
+reason,
+snapshot,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at from document_versions`
+
+// selectLastDocumentVersionSQL - the last version of a document, locked
+// until the revision that follows it is kept
+const selectLastDocumentVersionSQL = `select id, version_number from document_versions where master_flag = ? and master_id = ? order by version_number desc limit 1 for update;`
+
+// Revision - a revision of an issued document, with the reason for it, the
+// user who makes it and, once it is kept, the version it made
+type Revision struct {
+	Reason  string
+	UserID  string
+	Version *commonproto.DocumentVersion
+}
+
+// NewRevision - a revision by the user userID, which needs a reason
+func NewRevision(ctx context.Context, userServiceClient partyproto.UserServiceClient, reason string, userID string, userEmail string, requestID string) (*Revision, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, errors.New("docversion: a revision needs a reason")
+	}
+	user, err := partyservice.GetUserWithNewContext(ctx, userID, userEmail, requestID, userServiceClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Revision{Reason: reason, UserID: user.Id}, nil
+}
+
+// InsertVersions - keep the snapshot after of a revised document as the
+// version that follows its last one. The first revision of a document also
+// keeps the snapshot before, the document as it was issued, as version 1.
+func InsertVersions(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, masterFlag string, masterID uint32, before []byte, after []byte, revision *Revision, tn time.Time, userEmail string, requestID string) error {
+	var previousVersionID, versionNumber uint32
+	err := tx.QueryRowxContext(ctx, selectLastDocumentVersionSQL, masterFlag, masterID).Scan(&previousVersionID, &versionNumber)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	if versionNumber == 0 {
+		first := commonproto.DocumentVersionD{MasterFlag: masterFlag, MasterId: masterID, VersionNumber: 1, Snapshot: string(before)}
+		documentVersion, err := insertDocumentVersion(ctx, tx, &first, revision.UserID, tn)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		previousVersionID = documentVersion.DocumentVersionD.Id
+		versionNumber = 1
+	}
+
+	next := commonproto.DocumentVersionD{MasterFlag: masterFlag, MasterId: masterID, VersionNumber: versionNumber + 1, PreviousVersionId: previousVersionID, Reason: revision.Reason, Snapshot: string(after)}
+	revision.Version, err = insertDocumentVersion(ctx, tx, &next, revision.UserID, tn)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// insertDocumentVersion - insert the version documentVersionD
+func insertDocumentVersion(ctx context.Context, tx *sqlx.Tx, documentVersionD *commonproto.DocumentVersionD, userID string, tn time.Time) (*commonproto.DocumentVersion, error) {
+	var err error
+	documentVersionD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = userID
+	crUpdUser.UpdatedByUserId = userID
+
+	crUpdTime := commonstruct.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	documentVersionTmp := commonstruct.DocumentVersion{DocumentVersionD: documentVersionD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+	res, err := tx.NamedExecContext(ctx, insertDocumentVersionSQL, &documentVersionTmp)
+	if err != nil {
+		return nil, err
+	}
+	uID, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	documentVersionD.Id = uint32(uID)
+	documentVersionD.IdS, err = common.UUIDBytesToStr(documentVersionD.Uuid4)
+	if err != nil {
+		return nil, err
+	}
+
+	documentVersion := commonproto.DocumentVersion{DocumentVersionD: documentVersionD, CrUpdUser: &crUpdUser, CrUpdTime: &commonproto.CrUpdTime{CreatedAt: common.TimeToTimestamp(tn), UpdatedAt: common.TimeToTimestamp(tn)}}
+	return &documentVersion, nil
+}
+
+// GetVersions - the versions of a document, oldest first
+func GetVersions(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, masterID uint32, userEmail string, requestID string) (*commonproto.GetDocumentVersionsResponse, error) {
+	documentVersions, err := selectDocumentVersions(ctx, dbService, selectDocumentVersionsSQL+` where master_flag = ? and master_id = ? and status_code = ? order by version_number;`, masterFlag, masterID, "active")
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &commonproto.GetDocumentVersionsResponse{DocumentVersions: documentVersions}, nil
+}
+
+// DiffVersions - the fields of a document that differ between the versions
+// in.FromVersionNumber and in.ToVersionNumber
+func DiffVersions(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, masterID uint32, in *commonproto.DiffDocumentVersionsRequest) (*commonproto.DiffDocumentVersionsResponse, error) {
+	documentVersions, err := selectDocumentVersions(ctx, dbService, selectDocumentVersionsSQL+` where master_flag = ? and master_id = ? and version_number in (?, ?) and status_code = ?;`, masterFlag, masterID, in.FromVersionNumber, in.ToVersionNumber, "active")
+	if err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	snapshots := map[uint32][]byte{}
+	for _, documentVersion := range documentVersions {
+		snapshots[documentVersion.DocumentVersionD.VersionNumber] = []byte(documentVersion.DocumentVersionD.Snapshot)
+	}
+	for _, versionNumber := range []uint32{in.FromVersionNumber, in.ToVersionNumber} {
+		if _, ok := snapshots[versionNumber]; !ok {
+			err = fmt.Errorf("docversion: version %d not found", versionNumber)
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	changes, err := Diff(snapshots[in.FromVersionNumber], snapshots[in.ToVersionNumber])
+	if err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	documentVersionChanges := []*commonproto.DocumentVersionChange{}
+	for _, change := range changes {
+		documentVersionChanges = append(documentVersionChanges, &commonproto.DocumentVersionChange{Path: change.Path, FromValue: change.From, ToValue: change.To})
+	}
+	return &commonproto.DiffDocumentVersionsResponse{DocumentVersionChanges: documentVersionChanges}, nil
+}
+
+// selectDocumentVersions - the versions the query selects
+func selectDocumentVersions(ctx context.Context, dbService *common.DBService, query string, args ...interface{}) ([]*commonproto.DocumentVersion, error) {
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	documentVersions := []*commonproto.DocumentVersion{}
+	for rows.Next() {
+		documentVersionTmp := commonstruct.DocumentVersion{}
+		err = rows.StructScan(&documentVersionTmp)
+		if err != nil {
+			return nil, err
+		}
+		documentVersionTmp.DocumentVersionD.IdS, err = common.UUIDBytesToStr(documentVersionTmp.DocumentVersionD.Uuid4)
+		if err != nil {
+			return nil, err
+		}
+
+		crUpdTime := new(commonproto.CrUpdTime)
+		crUpdTime.CreatedAt = common.TimeToTimestamp(documentVersionTmp.CrUpdTime.CreatedAt)
+		crUpdTime.UpdatedAt = common.TimeToTimestamp(documentVersionTmp.CrUpdTime.UpdatedAt)
+
+		documentVersions = append(documentVersions, &commonproto.DocumentVersion{DocumentVersionD: documentVersionTmp.DocumentVersionD, CrUpdUser: documentVersionTmp.CrUpdUser, CrUpdTime: crUpdTime})
+	}
+	return documentVersions, rows.Err()
+}
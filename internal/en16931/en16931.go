@@ -0,0 +1,196 @@
+// Package en16931 checks invoices against the core business rules of
+// EN 16931-1, the European semantic model of an electronic invoice: the BR-*
+// presence rules, the BR-CO-* calculation rules and the rules of the VAT
+// categories S, Z, E, AE, K, G and O.
+//
+// Rules about data the invoice tables do not hold, such as the VAT point
+// date code or line units of measure, are not checked. Amounts are compared
+// after rounding to two decimals.
+package en16931
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+)
+
+// VAT category codes (UNCL5305) of the EN 16931 VAT category rules
+const (
+	CategoryStandard       = "S"
+	CategoryZero           = "Z"
+	CategoryExempt         = "E"
+	CategoryReverseCharge  = "AE"
+	CategoryIntraCommunity = "K"
+	CategoryExport         = "G"
+	CategoryOutOfScope     = "O"
+)
+
+// Invoice - the parts of an invoice the business rules read, business terms
+// of EN 16931 in brackets
+type Invoice struct {
+	Number            string    // Invoice number (BT-1)
+	IssueDate         time.Time // Invoice issue date (BT-2)
+	TypeCode          string    // Invoice type code (BT-3)
+	CurrencyCode      string    // Invoice currency code (BT-5)
+	TaxCurrencyCode   string    // VAT accounting currency code (BT-6)
+	DueDate           time.Time // Payment due date (BT-9)
+	PaymentTerms      bool      // Payment terms (BT-20) are given
+	PeriodStart       time.Time // Invoicing period start date (BT-73)
+	PeriodEnd         time.Time // Invoicing period end date (BT-74)
+	Seller            *Party    // SELLER (BG-4)
+	Buyer             *Party    // BUYER (BG-7)
+	TaxRepresentative *Party    // SELLER TAX REPRESENTATIVE PARTY (BG-11)
+	AllowanceCharges  []AllowanceCharge
+	Totals            Totals // DOCUMENT TOTALS (BG-22)
+	VATBreakdown      []VATBreakdown
+	Lines             []Line
+}
+
+// Party - a seller, buyer or tax representative
+type Party struct {
+	Name                string   // Seller/Buyer name (BT-27/BT-44)
+	Identifier          string   // Seller/Buyer identifier (BT-29/BT-46)
+	LegalRegistrationID string   // legal registration identifier (BT-30/BT-47)
+	VATID               string   // VAT identifier (BT-31/BT-48/BT-63)
+	TaxRegistrationID   string   // Seller tax registration identifier (BT-32)
+	Address             *Address // postal address (BG-5/BG-8/BG-12)
+}
+
+// Address - a postal address
+type Address struct {
+	CountryCode string // country code (BT-40/BT-55/BT-69)
+}
+
+// Totals - DOCUMENT TOTALS (BG-22)
+type Totals struct {
+	LineExtensionAmount   money.Amount  // Sum of Invoice line net amount (BT-106)
+	AllowanceTotalAmount  money.Amount  // Sum of allowances on document level (BT-107)
+	ChargeTotalAmount     money.Amount  // Sum of charges on document level (BT-108)
+	TaxExclusiveAmount    money.Amount  // Invoice total amount without VAT (BT-109)
+	TaxAmount             money.Amount  // Invoice total VAT amount (BT-110)
+	TaxCurrencyTaxAmount  *money.Amount // Invoice total VAT amount in accounting currency (BT-111), nil when not given
+	TaxInclusiveAmount    money.Amount  // Invoice total amount with VAT (BT-112)
+	WithholdingTaxAmount  money.Amount  // Withheld tax amount, not part of EN 16931, zero when nothing is withheld
+	PrepaidAmount         money.Amount  // Paid amount (BT-113)
+	PayableRoundingAmount money.Amount  // Rounding amount (BT-114)
+	PayableAmount         money.Amount  // Amount due for payment (BT-115)
+}
+
+// AllowanceCharge - DOCUMENT LEVEL ALLOWANCES (BG-20) or CHARGES (BG-21),
+// INVOICE LINE ALLOWANCES (BG-27) or CHARGES (BG-28) without a VAT category
+type AllowanceCharge struct {
+	ChargeIndicator bool         // a charge rather than an allowance
+	Amount          money.Amount // allowance/charge amount (BT-92/BT-99/BT-136/BT-141)
+	ReasonCode      string       // allowance/charge reason code (BT-98/BT-105/BT-140/BT-145)
+	Reason          string       // allowance/charge reason (BT-97/BT-104/BT-139/BT-144)
+	VATCategory     string       // document level allowance/charge VAT category code (BT-95/BT-102)
+	VATRate         float64      // document level allowance/charge VAT rate (BT-96/BT-103)
+}
+
+// VATBreakdown - VAT BREAKDOWN (BG-23)
+type VATBreakdown struct {
+	Category            string       // VAT category code (BT-118)
+	Rate                float64      // VAT category rate (BT-119)
+	TaxableAmount       money.Amount // VAT category taxable amount (BT-116)
+	TaxAmount           money.Amount // VAT category tax amount (BT-117)
+	ExemptionReasonCode string       // VAT exemption reason code (BT-121)
+	ExemptionReason     string       // VAT exemption reason text (BT-120)
+}
+
+// Line - INVOICE LINE (BG-25)
+type Line struct {
+	ID               string       // Invoice line identifier (BT-126)
+	Quantity         float64      // Invoiced quantity (BT-129)
+	NetAmount        money.Amount // Invoice line net amount (BT-131)
+	PeriodStart      time.Time    // Invoice line period start date (BT-134)
+	PeriodEnd        time.Time    // Invoice line period end date (BT-135)
+	AllowanceCharges []AllowanceCharge
+	PriceAmount      money.Amount // Item net price (BT-146)
+	ItemName         string       // Item name (BT-153)
+	VATCategory      string       // Invoiced item VAT category code (BT-151)
+	VATRate          float64      // Invoiced item VAT rate (BT-152)
+}
+
+// Violation - a business rule the invoice breaks. Path names the field of
+// a CreateInvoiceRequest that holds the offending value, lines and VAT
+// breakdowns are counted from 1. Rule sets that check UBL documents, such
+// as package peppol, use the XPath of the offending element instead.
+type Violation struct {
+	Rule    string
+	Path    string
+	Message string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("%s %s: %s", v.Rule, v.Path, v.Message)
+}
+
+// Violations - the business rules an invoice breaks
+type Violations []*Violation
+
+func (v Violations) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, violation := range v {
+		msgs = append(msgs, violation.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validate - check inv against the EN 16931 business rules, nil when it
+// keeps all of them, Violations otherwise
+func Validate(inv *Invoice) error {
+	c := checker{inv: inv}
+	c.checkInvoice()
+	c.checkLines()
+	c.checkAllowanceCharges()
+	c.checkTotals()
+	c.checkVATBreakdown()
+	c.checkVATCategories()
+	if len(c.violations) == 0 {
+		return nil
+	}
+	return c.violations
+}
+
+// checker - collects the violations of one invoice
+type checker struct {
+	inv        *Invoice
+	violations Violations
+}
+
+func (c *checker) fail(rule string, path string, format string, args ...interface{}) {
+	c.violations = append(c.violations, &Violation{Rule: rule, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func linePath(i int, field string) string {
+	return fmt.Sprintf("invoice_lines[%d].%s", i+1, field)
+}
+
+func allowanceChargePath(i int, field string) string {
+	return fmt.Sprintf("allowance_charges[%d].%s", i+1, field)
+}
+
+func breakdownPath(i int, field string) string {
+	return fmt.Sprintf("tax_sub_totals[%d].%s", i+1, field)
+}
+
+// Round - amount rounded half away from zero to two decimals
+func Round(amount money.Amount) money.Amount {
+	return amount.Round(2)
+}
+
+// equal - whether two amounts agree to two decimals
+func equal(a money.Amount, b money.Amount) bool {
+	return Round(a) == Round(b)
+}
+
+// percentOf - rate percent of amount
+func percentOf(amount money.Amount, rate float64) money.Amount {
+	return amount.Mul(money.FromFloat(rate)).Div(money.New(100, 0))
+}
+
+func amount(a money.Amount) string {
+	return Round(a).Text(2)
+}
@@ -0,0 +1,299 @@
+package en16931
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTime(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// testInvoice - two standard rated lines at 25% and a zero rated line, with
+// totals and a VAT breakdown that keep every rule
+func testInvoice() *Invoice {
+	return &Invoice{
+		Number:       "TOSL108",
+		IssueDate:    testTime("2009-12-15"),
+		TypeCode:     "380",
+		CurrencyCode: "EUR",
+		DueDate:      testTime("2010-01-15"),
+		PeriodStart:  testTime("2009-11-01"),
+		PeriodEnd:    testTime("2009-11-30"),
+		Seller:       &Party{Name: "Salescompany ltd.", VATID: "DK12345678", Address: &Address{CountryCode: "DK"}},
+		Buyer:        &Party{Name: "Buyercompany ltd", Address: &Address{CountryCode: "DK"}},
+		Totals: Totals{
+			LineExtensionAmount: money.MustParse("1500"),
+			TaxExclusiveAmount:  money.MustParse("1500"),
+			TaxAmount:           money.MustParse("318.25"),
+			TaxInclusiveAmount:  money.MustParse("1818.25"),
+			PayableAmount:       money.MustParse("1818.25"),
+		},
+		VATBreakdown: []VATBreakdown{
+			{Category: CategoryStandard, Rate: 25, TaxableAmount: money.MustParse("1273"), TaxAmount: money.MustParse("318.25")},
+			{Category: CategoryZero, TaxableAmount: money.MustParse("227"), TaxAmount: money.MustParse("0")},
+		},
+		Lines: []Line{
+			{ID: "1", Quantity: 1, NetAmount: money.MustParse("1000"), PriceAmount: money.MustParse("1000"), ItemName: "Labtop computer", VATCategory: CategoryStandard, VATRate: 25},
+			{ID: "2", Quantity: 1, NetAmount: money.MustParse("273"), PriceAmount: money.MustParse("273"), ItemName: "Bag", VATCategory: CategoryStandard, VATRate: 25},
+			{ID: "3", Quantity: 1, NetAmount: money.MustParse("227"), PriceAmount: money.MustParse("227"), ItemName: "Book", VATCategory: CategoryZero},
+		},
+	}
+}
+
+// rules - the rule of each violation in err
+func rules(err error) []string {
+	var violations Violations
+	if !errors.As(err, &violations) {
+		return nil
+	}
+	ids := []string{}
+	for _, v := range violations {
+		ids = append(ids, v.Rule)
+	}
+	return ids
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(testInvoice()))
+}
+
+func TestValidateViolations(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(inv *Invoice)
+		rules  []string
+	}{
+		{
+			name:   "missing invoice number",
+			change: func(inv *Invoice) { inv.Number = "" },
+			rules:  []string{"BR-2"},
+		},
+		{
+			name:   "missing seller",
+			change: func(inv *Invoice) { inv.Seller = nil },
+			rules:  []string{"BR-6", "BR-S-2", "BR-Z-2"},
+		},
+		{
+			name:   "buyer without country code",
+			change: func(inv *Invoice) { inv.Buyer.Address.CountryCode = "" },
+			rules:  []string{"BR-11"},
+		},
+		{
+			name:   "seller without identifiers",
+			change: func(inv *Invoice) { inv.Seller.VATID = "" },
+			rules:  []string{"BR-CO-26", "BR-S-2", "BR-Z-2"},
+		},
+		{
+			name:   "VAT identifier without country prefix",
+			change: func(inv *Invoice) { inv.Seller.VATID = "12345678" },
+			rules:  []string{"BR-CO-9"},
+		},
+		{
+			name:   "no lines",
+			change: func(inv *Invoice) { inv.Lines = nil },
+			rules:  []string{"BR-16", "BR-CO-10", "BR-S-8", "BR-Z-8"},
+		},
+		{
+			name:   "period ends before it starts",
+			change: func(inv *Invoice) { inv.PeriodEnd = testTime("2009-10-31") },
+			rules:  []string{"BR-29"},
+		},
+		{
+			name:   "VAT accounting currency without the VAT amount in it",
+			change: func(inv *Invoice) { inv.TaxCurrencyCode = "SEK" },
+			rules:  []string{"BR-53"},
+		},
+		{
+			name:   "negative price",
+			change: func(inv *Invoice) { inv.Lines[0].PriceAmount = money.MustParse("-1") },
+			rules:  []string{"BR-27"},
+		},
+		{
+			name:   "line without VAT category",
+			change: func(inv *Invoice) { inv.Lines[1].VATCategory = "" },
+			rules:  []string{"BR-CO-4", "BR-S-8"},
+		},
+		{
+			name:   "payable amount unrelated to the lines",
+			change: func(inv *Invoice) { inv.Totals.PayableAmount = money.MustParse("200") },
+			rules:  []string{"BR-CO-16"},
+		},
+		{
+			name:   "withheld tax not taken off the payable amount",
+			change: func(inv *Invoice) { inv.Totals.WithholdingTaxAmount = money.MustParse("100") },
+			rules:  []string{"BR-CO-16"},
+		},
+		{
+			name: "withheld tax taken off the payable amount",
+			change: func(inv *Invoice) {
+				inv.Totals.WithholdingTaxAmount = money.MustParse("100")
+				inv.Totals.PayableAmount -= money.MustParse("100")
+			},
+		},
+		{
+			name:   "line total does not add up",
+			change: func(inv *Invoice) { inv.Totals.LineExtensionAmount = money.MustParse("1600") },
+			rules:  []string{"BR-CO-10", "BR-CO-13"},
+		},
+		{
+			name:   "total with VAT does not add up",
+			change: func(inv *Invoice) { inv.Totals.TaxInclusiveAmount = money.MustParse("1500") },
+			rules:  []string{"BR-CO-15", "BR-CO-16"},
+		},
+		{
+			name: "VAT amount of the breakdown",
+			change: func(inv *Invoice) {
+				inv.VATBreakdown[0].TaxAmount = money.MustParse("300")
+				inv.Totals.TaxAmount = money.MustParse("300")
+				inv.Totals.TaxInclusiveAmount = money.MustParse("1800")
+				inv.Totals.PayableAmount = money.MustParse("1800")
+			},
+			rules: []string{"BR-CO-17", "BR-S-9"},
+		},
+		{
+			name: "no VAT breakdown",
+			change: func(inv *Invoice) {
+				inv.VATBreakdown = nil
+				inv.Totals.TaxAmount = money.MustParse("0")
+				inv.Totals.TaxInclusiveAmount = money.MustParse("1500")
+				inv.Totals.PayableAmount = money.MustParse("1500")
+			},
+			rules: []string{"BR-CO-18", "BR-S-1", "BR-Z-1"},
+		},
+		{
+			name:   "standard rated line at 0%",
+			change: func(inv *Invoice) { inv.Lines[1].VATRate = 0 },
+			rules:  []string{"BR-S-5", "BR-S-8"},
+		},
+		{
+			name:   "zero rated line at 25%",
+			change: func(inv *Invoice) { inv.Lines[2].VATRate = 25 },
+			rules:  []string{"BR-Z-5"},
+		},
+		{
+			name:   "exemption reason on a standard rate",
+			change: func(inv *Invoice) { inv.VATBreakdown[0].ExemptionReason = "Exempt" },
+			rules:  []string{"BR-S-10"},
+		},
+		{
+			name: "two zero rated breakdowns",
+			change: func(inv *Invoice) {
+				inv.VATBreakdown[1].TaxableAmount = money.MustParse("200")
+				inv.VATBreakdown = append(inv.VATBreakdown, VATBreakdown{Category: CategoryZero, TaxableAmount: money.MustParse("27")})
+			},
+			rules: []string{"BR-Z-1", "BR-Z-8", "BR-Z-8"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := testInvoice()
+			tt.change(inv)
+			assert.Equal(t, tt.rules, rules(Validate(inv)))
+		})
+	}
+}
+
+func TestValidateExemptCategories(t *testing.T) {
+	inv := testInvoice()
+	inv.Lines[2].VATCategory = CategoryReverseCharge
+	inv.VATBreakdown[1].Category = CategoryReverseCharge
+	assert.Equal(t, []string{"BR-AE-2", "BR-AE-10"}, rules(Validate(inv)))
+
+	inv.Buyer.VATID = "SE123456789101"
+	inv.VATBreakdown[1].ExemptionReasonCode = "VATEX-EU-AE"
+	assert.NoError(t, Validate(inv))
+
+	inv.Lines[2].VATCategory = CategoryIntraCommunity
+	inv.VATBreakdown[1].Category = CategoryIntraCommunity
+	assert.NoError(t, Validate(inv))
+
+	inv.Buyer.VATID = ""
+	inv.Buyer.LegalRegistrationID = "5560000000"
+	assert.Equal(t, []string{"BR-K-2"}, rules(Validate(inv)), "a legal registration identifier is enough for reverse charge only")
+}
+
+func TestValidateTaxCurrency(t *testing.T) {
+	inv := testInvoice()
+	inv.TaxCurrencyCode = "DKK"
+	taxAmount := money.MustParse("2367.23")
+	inv.Totals.TaxCurrencyTaxAmount = &taxAmount
+	assert.NoError(t, Validate(inv))
+}
+
+func TestValidateOutOfScope(t *testing.T) {
+	inv := testInvoice()
+	inv.Seller.VATID = ""
+	inv.Seller.LegalRegistrationID = "5402697509"
+	inv.Lines = inv.Lines[2:]
+	inv.Lines[0].VATCategory = CategoryOutOfScope
+	inv.VATBreakdown = []VATBreakdown{{Category: CategoryOutOfScope, TaxableAmount: money.MustParse("227"), ExemptionReason: "Not subject to VAT"}}
+	inv.Totals = Totals{LineExtensionAmount: money.MustParse("227"), TaxExclusiveAmount: money.MustParse("227"), TaxInclusiveAmount: money.MustParse("227"), PayableAmount: money.MustParse("227")}
+	assert.NoError(t, Validate(inv))
+
+	inv.Buyer.VATID = "DK87654321"
+	inv.VATBreakdown = append(inv.VATBreakdown, VATBreakdown{Category: CategoryZero})
+	assert.Equal(t, []string{"BR-O-2", "BR-O-11"}, rules(Validate(inv)))
+}
+
+func TestValidateAllowanceCharges(t *testing.T) {
+	inv := testInvoice()
+	inv.AllowanceCharges = []AllowanceCharge{
+		{Amount: money.MustParse("100"), ReasonCode: "95", VATCategory: CategoryStandard, VATRate: 25},
+		{ChargeIndicator: true, Amount: money.MustParse("27"), Reason: "Freight", VATCategory: CategoryZero},
+	}
+	inv.Lines[0].AllowanceCharges = []AllowanceCharge{{Amount: money.MustParse("50"), ReasonCode: "95"}}
+	inv.Totals = Totals{
+		LineExtensionAmount:  money.MustParse("1500"),
+		AllowanceTotalAmount: money.MustParse("100"),
+		ChargeTotalAmount:    money.MustParse("27"),
+		TaxExclusiveAmount:   money.MustParse("1427"),
+		TaxAmount:            money.MustParse("293.25"),
+		TaxInclusiveAmount:   money.MustParse("1720.25"),
+		PayableAmount:        money.MustParse("1720.25"),
+	}
+	inv.VATBreakdown = []VATBreakdown{
+		{Category: CategoryStandard, Rate: 25, TaxableAmount: money.MustParse("1173"), TaxAmount: money.MustParse("293.25")},
+		{Category: CategoryZero, TaxableAmount: money.MustParse("254"), TaxAmount: money.MustParse("0")},
+	}
+	assert.NoError(t, Validate(inv))
+
+	inv.AllowanceCharges[0].VATCategory = ""
+	inv.AllowanceCharges[1].Reason = ""
+	inv.Lines[0].AllowanceCharges[0].ReasonCode = ""
+	assert.Equal(t, []string{"BR-32", "BR-38", "BR-42", "BR-S-8"}, rules(Validate(inv)))
+
+	inv = testInvoice()
+	inv.Totals.AllowanceTotalAmount = money.MustParse("10")
+	inv.Totals.TaxExclusiveAmount = money.MustParse("1490")
+	assert.Equal(t, []string{"BR-CO-11", "BR-CO-15"}, rules(Validate(inv)), "an allowance total needs document level allowances")
+}
+
+func TestValidateRounding(t *testing.T) {
+	inv := testInvoice()
+	inv.Totals.PayableRoundingAmount = money.MustParse("-0.25")
+	inv.Totals.PayableAmount = money.MustParse("1818")
+	assert.NoError(t, Validate(inv))
+
+	inv.Totals.PayableAmount = money.MustParse("1818.004")
+	assert.NoError(t, Validate(inv), "amounts are compared at two decimals")
+
+	inv.Totals.PayableAmount = money.MustParse("1818.01")
+	assert.Equal(t, []string{"BR-CO-16"}, rules(Validate(inv)))
+}
+
+func TestViolationsError(t *testing.T) {
+	inv := testInvoice()
+	inv.Totals.PayableAmount = money.MustParse("200")
+	assert.EqualError(t, Validate(inv), "BR-CO-16 payable_amount: amount due for payment 200.00 shall equal the invoice total amount with VAT minus the withheld tax and the paid amount plus the rounding amount 1818.25")
+
+	problems := Validate(inv).(Violations).ValidationProblems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "BR-CO-16", problems[0].Rule)
+	assert.Equal(t, "payable_amount", problems[0].Path)
+}
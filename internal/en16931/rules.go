@@ -0,0 +1,192 @@
+package en16931
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+)
+
+// vatIDPattern - a VAT identifier starts with the ISO 3166-1 alpha-2 code
+// of the issuing country, Greece uses EL
+var vatIDPattern = regexp.MustCompile(`^[A-Z]{2}`)
+
+// checkInvoice - BR-2 to BR-11, BR-16, BR-29, BR-53, BR-CO-9, BR-CO-25,
+// BR-CO-26
+func (c *checker) checkInvoice() {
+	inv := c.inv
+	if strings.TrimSpace(inv.Number) == "" {
+		c.fail("BR-2", "ih_id", "an invoice shall have an invoice number")
+	}
+	if inv.IssueDate.IsZero() {
+		c.fail("BR-3", "issue_date", "an invoice shall have an invoice issue date")
+	}
+	if strings.TrimSpace(inv.TypeCode) == "" {
+		c.fail("BR-4", "invoice_type_code", "an invoice shall have an invoice type code")
+	}
+	if strings.TrimSpace(inv.CurrencyCode) == "" {
+		c.fail("BR-5", "document_currency_code", "an invoice shall have an invoice currency code")
+	}
+	if strings.TrimSpace(inv.TaxCurrencyCode) != "" && inv.Totals.TaxCurrencyTaxAmount == nil {
+		c.fail("BR-53", "tax_currency_tax_amount", "an invoice with a VAT accounting currency code shall have the invoice total VAT amount in accounting currency")
+	}
+
+	if inv.Seller == nil || strings.TrimSpace(inv.Seller.Name) == "" {
+		c.fail("BR-6", "accounting_supplier_party_id", "an invoice shall contain the seller name")
+	}
+	if inv.Buyer == nil || strings.TrimSpace(inv.Buyer.Name) == "" {
+		c.fail("BR-7", "accounting_customer_party_id", "an invoice shall contain the buyer name")
+	}
+	if inv.Seller != nil {
+		if inv.Seller.Address == nil {
+			c.fail("BR-8", "accounting_supplier_party_id", "an invoice shall contain the seller postal address")
+		} else if strings.TrimSpace(inv.Seller.Address.CountryCode) == "" {
+			c.fail("BR-9", "accounting_supplier_party_id", "the seller postal address shall contain a seller country code")
+		}
+		if inv.Seller.Identifier == "" && inv.Seller.LegalRegistrationID == "" && inv.Seller.VATID == "" {
+			c.fail("BR-CO-26", "accounting_supplier_party_id", "an invoice shall contain the seller identifier, the seller legal registration identifier or the seller VAT identifier")
+		}
+	}
+	if inv.Buyer != nil {
+		if inv.Buyer.Address == nil {
+			c.fail("BR-10", "accounting_customer_party_id", "an invoice shall contain the buyer postal address")
+		} else if strings.TrimSpace(inv.Buyer.Address.CountryCode) == "" {
+			c.fail("BR-11", "accounting_customer_party_id", "the buyer postal address shall contain a buyer country code")
+		}
+	}
+	c.checkVATID(inv.Seller, "accounting_supplier_party_id", "seller")
+	c.checkVATID(inv.Buyer, "accounting_customer_party_id", "buyer")
+	c.checkVATID(inv.TaxRepresentative, "tax_representative_party_id", "seller tax representative")
+
+	if len(inv.Lines) == 0 {
+		c.fail("BR-16", "invoice_lines", "an invoice shall have at least one invoice line")
+	}
+	if !inv.PeriodStart.IsZero() && !inv.PeriodEnd.IsZero() && inv.PeriodEnd.Before(inv.PeriodStart) {
+		c.fail("BR-29", "invoice_period_end_date", "the invoicing period end date shall be later or equal to the invoicing period start date")
+	}
+	if inv.Totals.PayableAmount > money.Zero && inv.DueDate.IsZero() && !inv.PaymentTerms {
+		c.fail("BR-CO-25", "due_date", "an invoice with a positive amount due for payment shall have a payment due date or payment terms")
+	}
+}
+
+// checkVATID - BR-CO-9, the VAT identifier of p is prefixed with a country code
+func (c *checker) checkVATID(p *Party, path string, role string) {
+	if p == nil || p.VATID == "" || vatIDPattern.MatchString(p.VATID) {
+		return
+	}
+	c.fail("BR-CO-9", path, "the %s VAT identifier %q shall have a country code prefix", role, p.VATID)
+}
+
+// checkLines - BR-21, BR-25, BR-27, BR-30, BR-CO-4
+func (c *checker) checkLines() {
+	for i, line := range c.inv.Lines {
+		if strings.TrimSpace(line.ID) == "" {
+			c.fail("BR-21", linePath(i, "il_id"), "each invoice line shall have an invoice line identifier")
+		}
+		if strings.TrimSpace(line.ItemName) == "" {
+			c.fail("BR-25", linePath(i, "item_id"), "each invoice line shall contain the item name")
+		}
+		if line.PriceAmount < money.Zero {
+			c.fail("BR-27", linePath(i, "price_amount"), "the item net price shall not be negative")
+		}
+		if !line.PeriodStart.IsZero() && !line.PeriodEnd.IsZero() && line.PeriodEnd.Before(line.PeriodStart) {
+			c.fail("BR-30", linePath(i, "invoice_period_end_date"), "the invoice line period end date shall be later or equal to the invoice line period start date")
+		}
+		if strings.TrimSpace(line.VATCategory) == "" {
+			c.fail("BR-CO-4", linePath(i, "item_id"), "each invoice line shall be categorized with an invoiced item VAT category code")
+		}
+	}
+}
+
+// checkAllowanceCharges - BR-32, BR-33, BR-37, BR-38, BR-42, BR-44
+func (c *checker) checkAllowanceCharges() {
+	for i, ac := range c.inv.AllowanceCharges {
+		kind, category, reason := "allowance", "BR-32", "BR-33"
+		if ac.ChargeIndicator {
+			kind, category, reason = "charge", "BR-37", "BR-38"
+		}
+		if strings.TrimSpace(ac.VATCategory) == "" {
+			c.fail(category, allowanceChargePath(i, "tax_category_id"), "each document level %s shall have a document level %s VAT category code", kind, kind)
+		}
+		if strings.TrimSpace(ac.ReasonCode) == "" && strings.TrimSpace(ac.Reason) == "" {
+			c.fail(reason, allowanceChargePath(i, "allowance_charge_reason"), "each document level %s shall have a document level %s reason or reason code", kind, kind)
+		}
+	}
+	for i, line := range c.inv.Lines {
+		for j, ac := range line.AllowanceCharges {
+			kind, reason := "allowance", "BR-42"
+			if ac.ChargeIndicator {
+				kind, reason = "charge", "BR-44"
+			}
+			if strings.TrimSpace(ac.ReasonCode) == "" && strings.TrimSpace(ac.Reason) == "" {
+				c.fail(reason, linePath(i, allowanceChargePath(j, "allowance_charge_reason")), "each invoice line %s shall have an invoice line %s reason or reason code", kind, kind)
+			}
+		}
+	}
+}
+
+// checkTotals - BR-CO-10 to BR-CO-16
+func (c *checker) checkTotals() {
+	t := c.inv.Totals
+	lineTotal := money.Zero
+	for _, line := range c.inv.Lines {
+		lineTotal = lineTotal.Add(Round(line.NetAmount))
+	}
+	if !equal(t.LineExtensionAmount, lineTotal) {
+		c.fail("BR-CO-10", "line_extension_amount", "sum of invoice line net amount %s shall equal the sum of the invoice line net amounts %s", amount(t.LineExtensionAmount), amount(lineTotal))
+	}
+
+	allowanceTotal, chargeTotal := money.Zero, money.Zero
+	for _, ac := range c.inv.AllowanceCharges {
+		if ac.ChargeIndicator {
+			chargeTotal = chargeTotal.Add(Round(ac.Amount))
+		} else {
+			allowanceTotal = allowanceTotal.Add(Round(ac.Amount))
+		}
+	}
+	if !equal(t.AllowanceTotalAmount, allowanceTotal) {
+		c.fail("BR-CO-11", "allowance_total_amount", "sum of allowances on document level %s shall equal the sum of the document level allowance amounts %s", amount(t.AllowanceTotalAmount), amount(allowanceTotal))
+	}
+	if !equal(t.ChargeTotalAmount, chargeTotal) {
+		c.fail("BR-CO-12", "charge_total_amount", "sum of charges on document level %s shall equal the sum of the document level charge amounts %s", amount(t.ChargeTotalAmount), amount(chargeTotal))
+	}
+
+	taxExclusive := t.LineExtensionAmount.Sub(t.AllowanceTotalAmount).Add(t.ChargeTotalAmount)
+	if !equal(t.TaxExclusiveAmount, taxExclusive) {
+		c.fail("BR-CO-13", "tax_exclusive_amount", "invoice total amount without VAT %s shall equal the sum of invoice line net amounts minus allowances plus charges %s", amount(t.TaxExclusiveAmount), amount(taxExclusive))
+	}
+
+	vatTotal := money.Zero
+	for _, vb := range c.inv.VATBreakdown {
+		vatTotal = vatTotal.Add(Round(vb.TaxAmount))
+	}
+	if !equal(t.TaxAmount, vatTotal) {
+		c.fail("BR-CO-14", "tax_sub_totals", "invoice total VAT amount %s shall equal the sum of VAT category tax amounts %s", amount(t.TaxAmount), amount(vatTotal))
+	}
+
+	taxInclusive := t.TaxExclusiveAmount.Add(t.TaxAmount)
+	if !equal(t.TaxInclusiveAmount, taxInclusive) {
+		c.fail("BR-CO-15", "tax_inclusive_amount", "invoice total amount with VAT %s shall equal the invoice total amount without VAT plus the invoice total VAT amount %s", amount(t.TaxInclusiveAmount), amount(taxInclusive))
+	}
+
+	payable := t.TaxInclusiveAmount.Sub(t.WithholdingTaxAmount).Sub(t.PrepaidAmount).Add(t.PayableRoundingAmount)
+	if !equal(t.PayableAmount, payable) {
+		c.fail("BR-CO-16", "payable_amount", "amount due for payment %s shall equal the invoice total amount with VAT minus the withheld tax and the paid amount plus the rounding amount %s", amount(t.PayableAmount), amount(payable))
+	}
+}
+
+// checkVATBreakdown - BR-47, BR-CO-17, BR-CO-18
+func (c *checker) checkVATBreakdown() {
+	if len(c.inv.VATBreakdown) == 0 {
+		c.fail("BR-CO-18", "tax_sub_totals", "an invoice shall have at least one VAT breakdown")
+	}
+	for i, vb := range c.inv.VATBreakdown {
+		if strings.TrimSpace(vb.Category) == "" {
+			c.fail("BR-47", breakdownPath(i, "tax_category_id"), "each VAT breakdown shall be defined through a VAT category code")
+		}
+		tax := Round(percentOf(vb.TaxableAmount, vb.Rate))
+		if !equal(vb.TaxAmount, tax) {
+			c.fail("BR-CO-17", breakdownPath(i, "tax_amount"), "VAT category tax amount %s shall equal the VAT category taxable amount multiplied by the VAT category rate %s", amount(vb.TaxAmount), amount(tax))
+		}
+	}
+}
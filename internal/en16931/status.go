@@ -0,0 +1,39 @@
+package en16931
+
+import (
+	"errors"
+
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// GRPCError - err as returned by a gRPC service; Violations become an
+// InvalidArgument status carrying a ValidationProblem detail per violation,
+// any other error is returned unchanged
+func GRPCError(err error) error {
+	var violations Violations
+	if !errors.As(err, &violations) {
+		return err
+	}
+	st := status.New(codes.InvalidArgument, violations.Error())
+	details := make([]protoadapt.MessageV1, 0, len(violations))
+	for _, vp := range violations.ValidationProblems() {
+		details = append(details, vp)
+	}
+	st, detailErr := st.WithDetails(details...)
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// ValidationProblems - Violations as ValidationProblem messages
+func (v Violations) ValidationProblems() []*commonproto.ValidationProblem {
+	problems := make([]*commonproto.ValidationProblem, 0, len(v))
+	for _, violation := range v {
+		problems = append(problems, &commonproto.ValidationProblem{Path: violation.Path, Rule: violation.Rule, Message: violation.Message})
+	}
+	return problems
+}
@@ -0,0 +1,218 @@
+package en16931
+
+import (
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+)
+
+// vatCategory - what the BR-<code>-* rules require of a VAT category
+type vatCategory struct {
+	code string
+	name string
+	// perRate - a VAT breakdown per VAT rate instead of exactly one
+	perRate bool
+	// positiveRate - the VAT rate is greater than zero instead of zero
+	positiveRate bool
+	// taxRegistration - the seller tax registration identifier stands in
+	// for a seller VAT identifier
+	taxRegistration bool
+	// buyer - what the buyer needs, nil when nothing
+	buyer func(p *Party) bool
+	// buyerRequirement - buyer identifier the buyer check asks for
+	buyerRequirement string
+	// exempt - the VAT breakdown carries a VAT exemption reason instead of
+	// none
+	exempt bool
+}
+
+// vatCategories - the VAT categories with their own rules, outside the
+// scope of VAT (O) has rules of its own and is not listed
+var vatCategories = []vatCategory{
+	{code: CategoryStandard, name: "Standard rated", perRate: true, positiveRate: true, taxRegistration: true},
+	{code: CategoryZero, name: "Zero rated", taxRegistration: true},
+	{code: CategoryExempt, name: "Exempt from VAT", taxRegistration: true, exempt: true},
+	{code: CategoryReverseCharge, name: "Reverse charge", taxRegistration: true, exempt: true,
+		buyer:            func(p *Party) bool { return p.VATID != "" || p.LegalRegistrationID != "" },
+		buyerRequirement: "the buyer VAT identifier or the buyer legal registration identifier"},
+	{code: CategoryIntraCommunity, name: "Intra-community supply", exempt: true,
+		buyer:            func(p *Party) bool { return p.VATID != "" },
+		buyerRequirement: "the buyer VAT identifier"},
+	{code: CategoryExport, name: "Export outside the EU", exempt: true},
+}
+
+// checkVATCategories - BR-S, BR-Z, BR-E, BR-AE, BR-K and BR-G 1, 2, 5, 8, 9
+// and 10 and the BR-O rules
+func (c *checker) checkVATCategories() {
+	for _, vc := range vatCategories {
+		c.checkVATCategory(vc)
+	}
+	c.checkOutOfScope()
+}
+
+func (c *checker) checkVATCategory(vc vatCategory) {
+	inv := c.inv
+	rule := func(n string) string { return "BR-" + vc.code + "-" + n }
+
+	lines := []int{}
+	for i, line := range inv.Lines {
+		if line.VATCategory == vc.code {
+			lines = append(lines, i)
+		}
+	}
+	breakdowns := []int{}
+	for i, vb := range inv.VATBreakdown {
+		if vb.Category == vc.code {
+			breakdowns = append(breakdowns, i)
+		}
+	}
+	if len(lines) == 0 && len(breakdowns) == 0 {
+		return
+	}
+
+	if len(lines) > 0 && len(breakdowns) == 0 {
+		c.fail(rule("1"), "tax_sub_totals", "an invoice with an invoice line of VAT category %q shall contain a VAT breakdown of VAT category %q", vc.name, vc.name)
+	}
+	if !vc.perRate && len(breakdowns) > 1 {
+		c.fail(rule("1"), "tax_sub_totals", "an invoice shall contain exactly one VAT breakdown of VAT category %q", vc.name)
+	}
+
+	if len(lines) > 0 {
+		seller := inv.Seller != nil && (inv.Seller.VATID != "" || (vc.taxRegistration && inv.Seller.TaxRegistrationID != ""))
+		if !seller && (inv.TaxRepresentative == nil || inv.TaxRepresentative.VATID == "") {
+			requirement := "the seller VAT identifier or the seller tax representative VAT identifier"
+			if vc.taxRegistration {
+				requirement = "the seller VAT identifier, the seller tax registration identifier or the seller tax representative VAT identifier"
+			}
+			c.fail(rule("2"), "accounting_supplier_party_id", "an invoice with an invoice line of VAT category %q shall contain %s", vc.name, requirement)
+		}
+		if vc.buyer != nil && (inv.Buyer == nil || !vc.buyer(inv.Buyer)) {
+			c.fail(rule("2"), "accounting_customer_party_id", "an invoice with an invoice line of VAT category %q shall contain %s", vc.name, vc.buyerRequirement)
+		}
+	}
+
+	for _, i := range lines {
+		rate := inv.Lines[i].VATRate
+		if vc.positiveRate && rate <= 0 {
+			c.fail(rule("5"), linePath(i, "item_id"), "the invoiced item VAT rate of VAT category %q shall be greater than zero", vc.name)
+		}
+		if !vc.positiveRate && rate != 0 {
+			c.fail(rule("5"), linePath(i, "item_id"), "the invoiced item VAT rate of VAT category %q shall be 0", vc.name)
+		}
+	}
+
+	for _, i := range breakdowns {
+		vb := inv.VATBreakdown[i]
+		taxable := money.Zero
+		for _, l := range lines {
+			if !vc.perRate || inv.Lines[l].VATRate == vb.Rate {
+				taxable = taxable.Add(Round(inv.Lines[l].NetAmount))
+			}
+		}
+		for _, ac := range inv.AllowanceCharges {
+			if ac.VATCategory == vc.code && (!vc.perRate || ac.VATRate == vb.Rate) {
+				taxable = taxable.Add(signed(ac))
+			}
+		}
+		if !equal(vb.TaxableAmount, taxable) {
+			c.fail(rule("8"), breakdownPath(i, "taxable_amount"), "the VAT category taxable amount %s shall equal the sum of the invoice line net amounts minus the document level allowances plus the document level charges of VAT category %q%s %s", amount(vb.TaxableAmount), vc.name, rateSuffix(vc), amount(taxable))
+		}
+		if !vc.positiveRate && !equal(vb.TaxAmount, money.Zero) {
+			c.fail(rule("9"), breakdownPath(i, "tax_amount"), "the VAT category tax amount of VAT category %q shall be 0", vc.name)
+		}
+		if vc.positiveRate && !equal(vb.TaxAmount, percentOf(vb.TaxableAmount, vb.Rate)) {
+			c.fail(rule("9"), breakdownPath(i, "tax_amount"), "the VAT category tax amount %s shall equal the VAT category taxable amount multiplied by the VAT category rate %s", amount(vb.TaxAmount), amount(percentOf(vb.TaxableAmount, vb.Rate)))
+		}
+		hasReason := strings.TrimSpace(vb.ExemptionReasonCode) != "" || strings.TrimSpace(vb.ExemptionReason) != ""
+		if vc.exempt && !hasReason {
+			c.fail(rule("10"), breakdownPath(i, "tax_category_id"), "a VAT breakdown of VAT category %q shall have a VAT exemption reason code or text", vc.name)
+		}
+		if !vc.exempt && hasReason {
+			c.fail(rule("10"), breakdownPath(i, "tax_category_id"), "a VAT breakdown of VAT category %q shall not have a VAT exemption reason code or text", vc.name)
+		}
+	}
+}
+
+// signed - the rounded amount of a document level allowance or charge,
+// negative for an allowance
+func signed(ac AllowanceCharge) money.Amount {
+	if ac.ChargeIndicator {
+		return Round(ac.Amount)
+	}
+	return Round(ac.Amount).Neg()
+}
+
+func rateSuffix(vc vatCategory) string {
+	if !vc.perRate {
+		return ""
+	}
+	return " at the same VAT rate"
+}
+
+// checkOutOfScope - BR-O-1, 2, 5, 8, 9, 10 and 11
+func (c *checker) checkOutOfScope() {
+	inv := c.inv
+	name := "Not subject to VAT"
+
+	lines := []int{}
+	taxable := money.Zero
+	for i, line := range inv.Lines {
+		if line.VATCategory == CategoryOutOfScope {
+			lines = append(lines, i)
+			taxable = taxable.Add(Round(line.NetAmount))
+		}
+	}
+	for _, ac := range inv.AllowanceCharges {
+		if ac.VATCategory == CategoryOutOfScope {
+			taxable = taxable.Add(signed(ac))
+		}
+	}
+	breakdowns := []int{}
+	for i, vb := range inv.VATBreakdown {
+		if vb.Category == CategoryOutOfScope {
+			breakdowns = append(breakdowns, i)
+		}
+	}
+	if len(lines) == 0 && len(breakdowns) == 0 {
+		return
+	}
+
+	if len(breakdowns) != 1 {
+		c.fail("BR-O-1", "tax_sub_totals", "an invoice with an invoice line of VAT category %q shall contain exactly one VAT breakdown of VAT category %q", name, name)
+	}
+	if len(lines) > 0 {
+		if inv.Seller != nil && inv.Seller.VATID != "" {
+			c.fail("BR-O-2", "accounting_supplier_party_id", "an invoice with an invoice line of VAT category %q shall not contain the seller VAT identifier", name)
+		}
+		if inv.TaxRepresentative != nil && inv.TaxRepresentative.VATID != "" {
+			c.fail("BR-O-2", "tax_representative_party_id", "an invoice with an invoice line of VAT category %q shall not contain the seller tax representative VAT identifier", name)
+		}
+		if inv.Buyer != nil && inv.Buyer.VATID != "" {
+			c.fail("BR-O-2", "accounting_customer_party_id", "an invoice with an invoice line of VAT category %q shall not contain the buyer VAT identifier", name)
+		}
+	}
+	for _, i := range lines {
+		if inv.Lines[i].VATRate != 0 {
+			c.fail("BR-O-5", linePath(i, "item_id"), "an invoice line of VAT category %q shall not contain an invoiced item VAT rate", name)
+		}
+	}
+	for _, i := range breakdowns {
+		vb := inv.VATBreakdown[i]
+		if !equal(vb.TaxableAmount, taxable) {
+			c.fail("BR-O-8", breakdownPath(i, "taxable_amount"), "the VAT category taxable amount %s shall equal the sum of the invoice line net amounts minus the document level allowances plus the document level charges of VAT category %q %s", amount(vb.TaxableAmount), name, amount(taxable))
+		}
+		if !equal(vb.TaxAmount, money.Zero) {
+			c.fail("BR-O-9", breakdownPath(i, "tax_amount"), "the VAT category tax amount of VAT category %q shall be 0", name)
+		}
+		if strings.TrimSpace(vb.ExemptionReasonCode) == "" && strings.TrimSpace(vb.ExemptionReason) == "" {
+			c.fail("BR-O-10", breakdownPath(i, "tax_category_id"), "a VAT breakdown of VAT category %q shall have a VAT exemption reason code or text", name)
+		}
+	}
+	if len(breakdowns) > 0 {
+		for i, vb := range inv.VATBreakdown {
+			if vb.Category != CategoryOutOfScope {
+				c.fail("BR-O-11", breakdownPath(i, "tax_category_id"), "an invoice with a VAT breakdown of VAT category %q shall not contain other VAT breakdowns", name)
+			}
+		}
+	}
+}
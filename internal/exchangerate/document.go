@@ -0,0 +1,131 @@
+package exchangerate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Exchange rate prefixes of the fields of document headers, such as
+// tax_ex_calculation_rate
+const (
+	TaxExchange        = "tax"
+	PricingExchange    = "pricing"
+	PaymentExchange    = "payment"
+	PaymentAltExchange = "payment_alt"
+)
+
+// Fill - fill the exchange rate prefix of m, a document header with the
+// fields <prefix>_ex_source_currency_code, <prefix>_ex_calculation_rate and
+// so on, with the rate of date. The source currency defaults to the
+// document_currency_code of m and the target currency to its
+// <prefix>_currency_code. An exchange rate with a calculation rate, without
+// a target currency, to the same currency or without a stored rate is left
+// as it is.
+func Fill(m proto.Message, prefix string, date time.Time, lookup Lookup) error {
+	r := m.ProtoReflect()
+	field := func(name string) protoreflect.FieldDescriptor {
+		return r.Descriptor().Fields().ByName(protoreflect.Name(name))
+	}
+	getString := func(name string) string {
+		if fd := field(name); fd != nil {
+			return r.Get(fd).String()
+		}
+		return ""
+	}
+	set := func(name string, v protoreflect.Value) {
+		if fd := field(name); fd != nil {
+			r.Set(fd, v)
+		}
+	}
+
+	ex := prefix + "_ex_"
+	calculationRate := field(ex + "calculation_rate")
+	if calculationRate == nil || r.Get(calculationRate).Float() != 0 {
+		return nil
+	}
+	source := getString(ex + "source_currency_code")
+	if source == "" {
+		source = getString("document_currency_code")
+	}
+	target := getString(ex + "target_currency_code")
+	if target == "" {
+		target = getString(prefix + "_currency_code")
+	}
+	if source == "" || target == "" || source == target {
+		return nil
+	}
+	var marketID uint32
+	if fd := field(ex + "exchange_market_id"); fd != nil {
+		marketID = uint32(r.Get(fd).Uint())
+	}
+
+	exchange, err := Find(lookup, source, target, marketID, date)
+	if errors.Is(err, ErrNoRate) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	set(ex+"source_currency_code", protoreflect.ValueOfString(exchange.SourceCurrencyCode))
+	set(ex+"source_currency_base_rate", protoreflect.ValueOfString("1"))
+	set(ex+"target_currency_code", protoreflect.ValueOfString(exchange.TargetCurrencyCode))
+	set(ex+"target_currency_base_rate", protoreflect.ValueOfString("1"))
+	set(ex+"exchange_market_id", protoreflect.ValueOfUint32(exchange.ExchangeMarketID))
+	r.Set(calculationRate, protoreflect.ValueOfFloat64(exchange.CalculationRate))
+	set(ex+"mathematic_operator_code", protoreflect.ValueOfString(exchange.MathematicOperatorCode))
+	return nil
+}
+
+// FillAll - Fill each exchange rate of m with a date in dates, by prefix
+func FillAll(m proto.Message, dates map[string]time.Time, lookup Lookup) error {
+	for _, prefix := range []string{TaxExchange, PricingExchange, PaymentExchange, PaymentAltExchange} {
+		date, ok := dates[prefix]
+		if !ok {
+			continue
+		}
+		if err := Fill(m, prefix, date, lookup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get - the Exchange from source to target of the exchange rate prefix of
+// m, a document header, the inverse of its rate when it is from target to
+// source; nil when m has no such calculation rate
+func Get(m proto.Message, prefix string, source string, target string) *Exchange {
+	r := m.ProtoReflect()
+	field := func(name string) protoreflect.FieldDescriptor {
+		return r.Descriptor().Fields().ByName(protoreflect.Name(prefix + "_ex_" + name))
+	}
+	calculationRate, sourceCode, targetCode := field("calculation_rate"), field("source_currency_code"), field("target_currency_code")
+	if calculationRate == nil || sourceCode == nil || targetCode == nil || r.Get(calculationRate).Float() <= 0 {
+		return nil
+	}
+	exchange := Exchange{CalculationRate: r.Get(calculationRate).Float(), MathematicOperatorCode: Multiply}
+	if fd := field("exchange_market_id"); fd != nil {
+		exchange.ExchangeMarketID = uint32(r.Get(fd).Uint())
+	}
+	if fd := field("mathematic_operator_code"); fd != nil && strings.EqualFold(r.Get(fd).String(), Divide) {
+		exchange.MathematicOperatorCode = Divide
+	}
+	source, target = strings.ToUpper(source), strings.ToUpper(target)
+	from, to := strings.ToUpper(r.Get(sourceCode).String()), strings.ToUpper(r.Get(targetCode).String())
+	switch {
+	case from == source && to == target:
+	case from == target && to == source:
+		if exchange.MathematicOperatorCode == Divide {
+			exchange.MathematicOperatorCode = Multiply
+		} else {
+			exchange.MathematicOperatorCode = Divide
+		}
+	default:
+		return nil
+	}
+	exchange.SourceCurrencyCode, exchange.TargetCurrencyCode = source, target
+	return &exchange
+}
@@ -0,0 +1,123 @@
+package exchangerate
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ecbDateLayouts - the date layouts of the daily and the historical CSV
+// files of the European Central Bank
+var ecbDateLayouts = []string{"2006-01-02", "2 January 2006", "02 January 2006"}
+
+// ParseCSV - the Rates from sourceCurrencyCode of a CSV file with a header
+// row "Date, USD, JPY, ..." and a row per date of the rates in the currency
+// of each column, as eurofxref.csv and eurofxref-hist.csv of the European
+// Central Bank. Empty and "N/A" rates are skipped.
+func ParseCSV(r io.Reader, sourceCurrencyCode string, marketID uint32) ([]Rate, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("exchangerate: csv header: %w", err)
+	}
+	if len(header) == 0 || !strings.EqualFold(strings.TrimSpace(header[0]), "Date") {
+		return nil, fmt.Errorf("exchangerate: csv header does not start with Date")
+	}
+	rates := []Rate{}
+	for row := 2; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("exchangerate: csv row %d: %w", row, err)
+		}
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		date, err := parseECBDate(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("exchangerate: csv row %d: %w", row, err)
+		}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			rate, ok, err := parseECBRate(sourceCurrencyCode, header[i], record[i], marketID, date)
+			if err != nil {
+				return nil, fmt.Errorf("exchangerate: csv row %d: %w", row, err)
+			}
+			if ok {
+				rates = append(rates, rate)
+			}
+		}
+	}
+	return rates, nil
+}
+
+// ecbEnvelope - the gesmes:Envelope of eurofxref-daily.xml and
+// eurofxref-hist.xml, a Cube per date with a Cube per currency
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseXML - the Rates from sourceCurrencyCode of an XML file in the
+// format of eurofxref-daily.xml of the European Central Bank
+func ParseXML(r io.Reader, sourceCurrencyCode string, marketID uint32) ([]Rate, error) {
+	envelope := ecbEnvelope{}
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("exchangerate: xml: %w", err)
+	}
+	rates := []Rate{}
+	for _, day := range envelope.Days {
+		date, err := parseECBDate(day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("exchangerate: xml: %w", err)
+		}
+		for _, dayRate := range day.Rates {
+			rate, ok, err := parseECBRate(sourceCurrencyCode, dayRate.Currency, dayRate.Rate, marketID, date)
+			if err != nil {
+				return nil, fmt.Errorf("exchangerate: xml: %w", err)
+			}
+			if ok {
+				rates = append(rates, rate)
+			}
+		}
+	}
+	return rates, nil
+}
+
+func parseECBDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range ecbDateLayouts {
+		if date, err := time.Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("date %q is not in a known format", s)
+}
+
+func parseECBRate(sourceCurrencyCode string, currency string, value string, marketID uint32, date time.Time) (Rate, bool, error) {
+	currency, value = strings.TrimSpace(currency), strings.TrimSpace(value)
+	if currency == "" || value == "" || strings.EqualFold(value, "N/A") {
+		return Rate{}, false, nil
+	}
+	calculationRate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return Rate{}, false, fmt.Errorf("%s rate %q is not a number", currency, value)
+	}
+	rate := Rate{SourceCurrencyCode: strings.ToUpper(sourceCurrencyCode), TargetCurrencyCode: strings.ToUpper(currency), ExchangeMarketID: marketID, CalculationRate: calculationRate, Date: date}
+	if err := rate.Validate(); err != nil {
+		return Rate{}, false, err
+	}
+	return rate, true, nil
+}
@@ -0,0 +1,131 @@
+// Package exchangerate converts amounts between currencies with stored
+// exchange rates, and fills the exchange rates of documents, UBL
+// TaxExchangeRate, PricingExchangeRate, PaymentExchangeRate and
+// PaymentAlternativeExchangeRate.
+//
+// A Rate is the number of units of its target currency one unit of its
+// source currency buys on a date, as central banks publish them. A rate
+// from a currency to another is found directly, or as the inverse of the
+// rate the other way round, which is then divided by rather than multiplied
+// with. The rate of a date is the most recent one on or before it, at most
+// MaxAge old, as rates are not published on weekends and holidays.
+//
+// Rates are loaded from files in the format of the euro foreign exchange
+// reference rates of the European Central Bank, see ParseCSV and ParseXML.
+package exchangerate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+)
+
+// Mathematic operator codes, how the calculation rate of an exchange is
+// applied to an amount in its source currency
+const (
+	Multiply = "multiply"
+	Divide   = "divide"
+)
+
+// MaxAge - how much older than the date of an exchange its rate may be
+const MaxAge = 7 * 24 * time.Hour
+
+// Rate - CalculationRate units of TargetCurrencyCode for one unit of
+// SourceCurrencyCode on Date, in ExchangeMarketID, 0 when not given
+type Rate struct {
+	SourceCurrencyCode string
+	TargetCurrencyCode string
+	ExchangeMarketID   uint32
+	CalculationRate    float64
+	Date               time.Time
+}
+
+// Validate - an error when r does not have two different three letter
+// currency codes and a positive rate
+func (r *Rate) Validate() error {
+	if !isCurrencyCode(r.SourceCurrencyCode) {
+		return fmt.Errorf("exchangerate: source currency code %q is not a three letter code", r.SourceCurrencyCode)
+	}
+	if !isCurrencyCode(r.TargetCurrencyCode) {
+		return fmt.Errorf("exchangerate: target currency code %q is not a three letter code", r.TargetCurrencyCode)
+	}
+	if r.SourceCurrencyCode == r.TargetCurrencyCode {
+		return fmt.Errorf("exchangerate: source and target currency are both %s", r.SourceCurrencyCode)
+	}
+	if r.CalculationRate <= 0 {
+		return fmt.Errorf("exchangerate: %s to %s rate %v is not positive", r.SourceCurrencyCode, r.TargetCurrencyCode, r.CalculationRate)
+	}
+	return nil
+}
+
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrNoRate - there is no rate between two currencies on a date
+var ErrNoRate = errors.New("exchangerate: no exchange rate")
+
+// Lookup - the most recent Rate from source to target on or before date and
+// at most MaxAge old, in marketID or, with 0, in any market; nil when there
+// is none
+type Lookup func(source string, target string, marketID uint32, date time.Time) (*Rate, error)
+
+// Exchange - how an amount in SourceCurrencyCode is converted to
+// TargetCurrencyCode
+type Exchange struct {
+	SourceCurrencyCode     string
+	TargetCurrencyCode     string
+	ExchangeMarketID       uint32
+	CalculationRate        float64
+	MathematicOperatorCode string
+	Date                   time.Time
+}
+
+// Find - the Exchange from source to target on date, with the rate from
+// source to target or else the inverse of the rate from target to source,
+// ErrNoRate when there is neither
+func Find(lookup Lookup, source string, target string, marketID uint32, date time.Time) (*Exchange, error) {
+	source, target = strings.ToUpper(source), strings.ToUpper(target)
+	if source == target {
+		return &Exchange{SourceCurrencyCode: source, TargetCurrencyCode: target, ExchangeMarketID: marketID, CalculationRate: 1, MathematicOperatorCode: Multiply, Date: date}, nil
+	}
+	operator := Multiply
+	rate, err := lookup(source, target, marketID, date)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		operator = Divide
+		rate, err = lookup(target, source, marketID, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rate == nil {
+		return nil, fmt.Errorf("%w from %s to %s on %s", ErrNoRate, source, target, date.Format("2006-01-02"))
+	}
+	return &Exchange{SourceCurrencyCode: source, TargetCurrencyCode: target, ExchangeMarketID: rate.ExchangeMarketID, CalculationRate: rate.CalculationRate, MathematicOperatorCode: operator, Date: rate.Date}, nil
+}
+
+// Convert - amount in the source currency of e in its target currency,
+// rounded with the rounding.Policy of the target currency
+func (e *Exchange) Convert(amount money.Amount) money.Amount {
+	policy := rounding.ForCurrency(e.TargetCurrencyCode)
+	rate := money.FromFloat(e.CalculationRate)
+	if e.MathematicOperatorCode == Divide {
+		return policy.Round(amount.Div(rate))
+	}
+	return policy.Round(amount.Mul(rate))
+}
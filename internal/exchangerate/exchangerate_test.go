@@ -0,0 +1,148 @@
+package exchangerate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+// testLookup - a Lookup of rates, without the market and age rules of the
+// database
+func testLookup(rates []Rate) Lookup {
+	return func(source string, target string, marketID uint32, day time.Time) (*Rate, error) {
+		var found *Rate
+		for i, r := range rates {
+			if r.SourceCurrencyCode == source && r.TargetCurrencyCode == target && !r.Date.After(day) && (found == nil || r.Date.After(found.Date)) {
+				found = &rates[i]
+			}
+		}
+		return found, nil
+	}
+}
+
+const ecbCSV = `Date, USD, JPY, BGN, CYP,
+17 October 2024, 1.0844, 162.24, 1.9558, N/A,
+`
+
+const ecbHistCSV = `Date,USD,JPY,
+2024-10-17,1.0844,162.24,
+2024-10-16,1.0882,162.73,
+`
+
+const ecbXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2024-10-17'>
+			<Cube currency='USD' rate='1.0844'/>
+			<Cube currency='JPY' rate='162.24'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestParseCSV(t *testing.T) {
+	rates, err := ParseCSV(strings.NewReader(ecbCSV), "EUR", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []Rate{
+		{SourceCurrencyCode: "EUR", TargetCurrencyCode: "USD", ExchangeMarketID: 1, CalculationRate: 1.0844, Date: date("2024-10-17")},
+		{SourceCurrencyCode: "EUR", TargetCurrencyCode: "JPY", ExchangeMarketID: 1, CalculationRate: 162.24, Date: date("2024-10-17")},
+		{SourceCurrencyCode: "EUR", TargetCurrencyCode: "BGN", ExchangeMarketID: 1, CalculationRate: 1.9558, Date: date("2024-10-17")},
+	}, rates)
+
+	rates, err = ParseCSV(strings.NewReader(ecbHistCSV), "EUR", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(rates), "they should be equal")
+	assert.Equal(t, date("2024-10-16"), rates[3].Date, "they should be equal")
+
+	_, err = ParseCSV(strings.NewReader("Date,USD\n2024-10-17,one\n"), "EUR", 0)
+	assert.Error(t, err)
+	_, err = ParseCSV(strings.NewReader("Day,USD\n"), "EUR", 0)
+	assert.Error(t, err)
+}
+
+func TestParseXML(t *testing.T) {
+	rates, err := ParseXML(strings.NewReader(ecbXML), "EUR", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []Rate{
+		{SourceCurrencyCode: "EUR", TargetCurrencyCode: "USD", CalculationRate: 1.0844, Date: date("2024-10-17")},
+		{SourceCurrencyCode: "EUR", TargetCurrencyCode: "JPY", CalculationRate: 162.24, Date: date("2024-10-17")},
+	}, rates)
+}
+
+func TestFind(t *testing.T) {
+	rates, _ := ParseCSV(strings.NewReader(ecbHistCSV), "EUR", 0)
+	lookup := testLookup(rates)
+
+	exchange, err := Find(lookup, "EUR", "USD", 0, date("2024-10-19"))
+	assert.NoError(t, err)
+	assert.Equal(t, Multiply, exchange.MathematicOperatorCode, "they should be equal")
+	assert.Equal(t, 1.0844, exchange.CalculationRate, "they should be equal")
+	assert.Equal(t, "1084.40", exchange.Convert(money.MustParse("1000")).String(), "they should be equal")
+
+	exchange, err = Find(lookup, "jpy", "eur", 0, date("2024-10-16"))
+	assert.NoError(t, err)
+	assert.Equal(t, Divide, exchange.MathematicOperatorCode, "they should be equal")
+	assert.Equal(t, "61.45", exchange.Convert(money.MustParse("10000")).String(), "they should be equal")
+
+	exchange, err = Find(lookup, "EUR", "JPY", 0, date("2024-10-16"))
+	assert.NoError(t, err)
+	assert.Equal(t, "16273.00", exchange.Convert(money.MustParse("100")).String(), "they should be equal")
+
+	_, err = Find(lookup, "EUR", "USD", 0, date("2024-10-15"))
+	assert.Error(t, err)
+	_, err = Find(lookup, "EUR", "GBP", 0, date("2024-10-17"))
+	assert.ErrorIs(t, err, ErrNoRate)
+}
+
+func TestFill(t *testing.T) {
+	rates, _ := ParseCSV(strings.NewReader(ecbHistCSV), "EUR", 0)
+	lookup := testLookup(rates)
+
+	hd := invoiceproto.InvoiceHeaderD{DocumentCurrencyCode: "EUR", TaxCurrencyCode: "USD", PaymentCurrencyCode: "EUR", PricingExTargetCurrencyCode: "JPY", PricingExCalculationRate: 160, PaymentAltExSourceCurrencyCode: "USD", PaymentAltExTargetCurrencyCode: "EUR"}
+	day := date("2024-10-17")
+	assert.NoError(t, FillAll(&hd, map[string]time.Time{TaxExchange: day, PricingExchange: day, PaymentExchange: day, PaymentAltExchange: day}, lookup))
+	assert.Equal(t, "EUR", hd.TaxExSourceCurrencyCode, "they should be equal")
+	assert.Equal(t, "USD", hd.TaxExTargetCurrencyCode, "they should be equal")
+	assert.Equal(t, "1", hd.TaxExSourceCurrencyBaseRate, "they should be equal")
+	assert.Equal(t, 1.0844, hd.TaxExCalculationRate, "they should be equal")
+	assert.Equal(t, Multiply, hd.TaxExMathematicOperatorCode, "they should be equal")
+	assert.Equal(t, 160.0, hd.PricingExCalculationRate, "they should be equal")
+	assert.Equal(t, "", hd.PricingExMathematicOperatorCode, "they should be equal")
+	assert.Equal(t, 0.0, hd.PaymentExCalculationRate, "they should be equal")
+	assert.Equal(t, 1.0844, hd.PaymentAltExCalculationRate, "they should be equal")
+	assert.Equal(t, Divide, hd.PaymentAltExMathematicOperatorCode, "they should be equal")
+
+	hd = invoiceproto.InvoiceHeaderD{DocumentCurrencyCode: "EUR", TaxCurrencyCode: "GBP"}
+	assert.NoError(t, Fill(&hd, TaxExchange, day, lookup))
+	assert.Equal(t, "", hd.TaxExTargetCurrencyCode, "they should be equal")
+
+	failing := func(string, string, uint32, time.Time) (*Rate, error) { return nil, errors.New("connection refused") }
+	hd = invoiceproto.InvoiceHeaderD{DocumentCurrencyCode: "EUR", TaxCurrencyCode: "USD"}
+	assert.Error(t, Fill(&hd, TaxExchange, day, failing))
+}
+
+func TestGet(t *testing.T) {
+	hd := invoiceproto.InvoiceHeaderD{TaxExSourceCurrencyCode: "EUR", TaxExTargetCurrencyCode: "DKK", TaxExCalculationRate: 7.4382, TaxExMathematicOperatorCode: Multiply}
+	exchange := Get(&hd, TaxExchange, "EUR", "DKK")
+	assert.Equal(t, "2367.21", exchange.Convert(money.MustParse("318.25")).String(), "they should be equal")
+
+	exchange = Get(&hd, TaxExchange, "dkk", "eur")
+	assert.Equal(t, Divide, exchange.MathematicOperatorCode, "they should be equal")
+	assert.Equal(t, "318.25", exchange.Convert(money.MustParse("2367.21")).String(), "they should be equal")
+
+	assert.Nil(t, Get(&hd, TaxExchange, "EUR", "SEK"))
+	assert.Nil(t, Get(&hd, PricingExchange, "EUR", "DKK"))
+}
@@ -0,0 +1,218 @@
+// Package facturx renders invoices as Factur-X / ZUGFeRD hybrid files: a
+// human-readable PDF/A-3b with the CII invoice embedded as factur-x.xml.
+//
+// The visible invoice is laid out from the full CrossIndustryInvoice, the
+// embedded XML carries what the selected Factur-X profile allows. The PDF
+// uses the bundled DejaVu Sans Mono font (see fonts/LICENSE), so text is
+// limited to the characters of WinAnsiEncoding.
+package facturx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/cii"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+)
+
+// FileName - name of the embedded CII invoice
+const FileName = "factur-x.xml"
+
+// Factur-X profiles
+const (
+	// ProfileMinimum - header totals only, not an invoice on its own
+	ProfileMinimum = "minimum"
+	// ProfileBasic - EN 16931 subset without line details
+	ProfileBasic = "basic"
+	// ProfileEN16931 - the EN 16931 core invoice, the default
+	ProfileEN16931 = "en16931"
+	// ProfileExtended - EN 16931 with the Factur-X extensions
+	ProfileExtended = "extended"
+)
+
+// profile - guideline identifier (BT-24), XMP conformance level and
+// relationship of the embedded file to the PDF for a Factur-X profile
+type profile struct {
+	guideline        string
+	conformanceLevel string
+	relationship     string
+}
+
+var profiles = map[string]profile{
+	ProfileMinimum:  {guideline: "urn:factur-x.eu:1p0:minimum", conformanceLevel: "MINIMUM", relationship: "Data"},
+	ProfileBasic:    {guideline: "urn:cen.eu:en16931:2017#compliant#urn:factur-x.eu:1p0:basic", conformanceLevel: "BASIC", relationship: "Alternative"},
+	ProfileEN16931:  {guideline: cii.GuidelineEN16931, conformanceLevel: "EN 16931", relationship: "Alternative"},
+	ProfileExtended: {guideline: "urn:cen.eu:en16931:2017#conformant#urn:factur-x.eu:1p0:extended", conformanceLevel: "EXTENDED", relationship: "Alternative"},
+}
+
+// ParseProfile - the Factur-X profile named s, ProfileEN16931 when s is
+// empty
+func ParseProfile(s string) (string, error) {
+	p := strings.ToLower(strings.TrimSpace(s))
+	p = strings.NewReplacer(" ", "", "-", "", "_", "").Replace(p)
+	if p == "" {
+		return ProfileEN16931, nil
+	}
+	if _, ok := profiles[p]; ok {
+		return p, nil
+	}
+	return "", fmt.Errorf("facturx: unknown profile %q, want %q, %q, %q or %q", s, ProfileMinimum, ProfileBasic, ProfileEN16931, ProfileExtended)
+}
+
+// InvoiceFromSource - Factur-X PDF of an invoice in the given profile
+func InvoiceFromSource(src *ubl.InvoiceSource, profileName string, created time.Time) ([]byte, error) {
+	ci, err := cii.InvoiceFromSource(src)
+	if err != nil {
+		return nil, err
+	}
+	return Render(ci, profileName, created)
+}
+
+// Render - Factur-X PDF of ci in the given profile, created at created. ci
+// is reduced to the profile before it is embedded.
+func Render(ci *cii.CrossIndustryInvoice, profileName string, created time.Time) ([]byte, error) {
+	p, ok := profiles[profileName]
+	if !ok {
+		return nil, fmt.Errorf("facturx: unknown profile %q", profileName)
+	}
+	if ci.ExchangedDocument == nil || ci.SupplyChainTradeTransaction == nil {
+		return nil, fmt.Errorf("facturx: invoice has no ExchangedDocument or SupplyChainTradeTransaction")
+	}
+
+	pages := paginate(layout(ci, p.conformanceLevel))
+
+	restrict(ci, profileName)
+	if ci.ExchangedDocumentContext == nil {
+		ci.ExchangedDocumentContext = &cii.DocumentContext{}
+	}
+	ci.ExchangedDocumentContext.GuidelineSpecifiedDocumentContextParameter = &cii.DocumentContextParameter{ID: p.guideline}
+	xmlBytes, err := cii.Marshal(ci)
+	if err != nil {
+		return nil, err
+	}
+
+	xmp, err := metadata(documentTitle(ci)+" "+ci.ExchangedDocument.ID, p.conformanceLevel, created)
+	if err != nil {
+		return nil, err
+	}
+	return writePDF(pages, xmlBytes, xmp, p.relationship, created)
+}
+
+// restrict - drop the parts of ci the profile has no element for
+func restrict(ci *cii.CrossIndustryInvoice, profileName string) {
+	tx := ci.SupplyChainTradeTransaction
+	switch profileName {
+	case ProfileBasic:
+		for i := range tx.IncludedSupplyChainTradeLineItem {
+			li := &tx.IncludedSupplyChainTradeLineItem[i]
+			if tp := li.SpecifiedTradeProduct; tp != nil {
+				li.SpecifiedTradeProduct = &cii.TradeProduct{GlobalID: tp.GlobalID, Name: tp.Name}
+			}
+			if a := li.SpecifiedLineTradeAgreement; a != nil {
+				a.BuyerOrderReferencedDocument = nil
+			}
+			if d := li.SpecifiedLineTradeDelivery; d != nil {
+				d.DespatchAdviceReferencedDocument = nil
+				d.ReceivingAdviceReferencedDocument = nil
+			}
+			if s := li.SpecifiedLineTradeSettlement; s != nil {
+				s.InvoiceReferencedDocument = nil
+				s.ReceivableSpecifiedTradeAccountingAccount = nil
+			}
+		}
+	case ProfileMinimum:
+		ci.ExchangedDocument.IncludedNote = nil
+		minimum := cii.SupplyChainTradeTransaction{ApplicableHeaderTradeDelivery: &cii.HeaderTradeDelivery{}}
+		if a := tx.ApplicableHeaderTradeAgreement; a != nil {
+			minimum.ApplicableHeaderTradeAgreement = &cii.HeaderTradeAgreement{
+				BuyerReference:               a.BuyerReference,
+				SellerTradeParty:             minimumParty(a.SellerTradeParty, true),
+				BuyerTradeParty:              minimumParty(a.BuyerTradeParty, false),
+				BuyerOrderReferencedDocument: a.BuyerOrderReferencedDocument,
+			}
+		}
+		if s := tx.ApplicableHeaderTradeSettlement; s != nil {
+			minimum.ApplicableHeaderTradeSettlement = &cii.HeaderTradeSettlement{InvoiceCurrencyCode: s.InvoiceCurrencyCode}
+			if ms := s.SpecifiedTradeSettlementHeaderMonetarySummation; ms != nil {
+				minimum.ApplicableHeaderTradeSettlement.SpecifiedTradeSettlementHeaderMonetarySummation = &cii.HeaderMonetarySummation{
+					TaxBasisTotalAmount: ms.TaxBasisTotalAmount,
+					TaxTotalAmount:      ms.TaxTotalAmount,
+					GrandTotalAmount:    ms.GrandTotalAmount,
+					DuePayableAmount:    ms.DuePayableAmount,
+				}
+			}
+		}
+		ci.SupplyChainTradeTransaction = &minimum
+	}
+}
+
+// minimumParty - the name and legal registration of a party, with the
+// country and tax registrations for the seller
+func minimumParty(tp *cii.TradeParty, seller bool) *cii.TradeParty {
+	if tp == nil {
+		return nil
+	}
+	p := cii.TradeParty{Name: tp.Name}
+	if lo := tp.SpecifiedLegalOrganization; lo != nil && lo.ID != nil {
+		p.SpecifiedLegalOrganization = &cii.LegalOrganization{ID: lo.ID}
+	}
+	if seller {
+		if pa := tp.PostalTradeAddress; pa != nil {
+			p.PostalTradeAddress = &cii.TradeAddress{CountryID: pa.CountryID}
+		}
+		p.SpecifiedTaxRegistration = tp.SpecifiedTaxRegistration
+	}
+	return &p
+}
+
+// writePDF - PDF/A-3b file with the pages of text and the CII invoice
+// attached as FileName
+func writePDF(pages [][]textLine, xmlBytes []byte, xmp []byte, relationship string, created time.Time) ([]byte, error) {
+	w := newPDFWriter()
+	catalog := w.reserve()
+	pagesNum := w.reserve()
+	font := w.reserve()
+	fontDescriptor := w.reserve()
+	fontStream := w.reserve()
+	outputProfile := w.reserve()
+	metadataStream := w.reserve()
+	embeddedFile := w.reserve()
+	fileSpec := w.reserve()
+
+	date := pdfString(pdfDate(created))
+	w.object(catalog, fmt.Sprintf("<</Type /Catalog /Pages %s /Metadata %s /OutputIntents [<</Type /OutputIntent /S /GTS_PDFA1 /OutputConditionIdentifier (sRGB) /Info (sRGB) /DestOutputProfile %s>>] /Names <</EmbeddedFiles <</Names [%s %s]>>>> /AF [%s]>>",
+		ref(pagesNum), ref(metadataStream), ref(outputProfile), pdfString(FileName), ref(fileSpec), ref(fileSpec)))
+
+	widths := strings.TrimSpace(strings.Repeat(fmt.Sprintf("%d ", glyphWidth), 256-32))
+	w.object(font, fmt.Sprintf("<</Type /Font /Subtype /TrueType /BaseFont /%s /FirstChar 32 /LastChar 255 /Widths [%s] /Encoding /WinAnsiEncoding /FontDescriptor %s>>", fontName, widths, ref(fontDescriptor)))
+	w.object(fontDescriptor, fmt.Sprintf("<</Type /FontDescriptor /FontName /%s /Flags 33 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %s>>",
+		fontName, fontBBox[0], fontBBox[1], fontBBox[2], fontBBox[3], fontAscent, fontDescent, capHeight, ref(fontStream)))
+	if err := w.stream(fontStream, fmt.Sprintf(" /Length1 %d", len(fontFile)), fontFile, true); err != nil {
+		return nil, err
+	}
+	if err := w.stream(outputProfile, " /N 3", srgbProfile, true); err != nil {
+		return nil, err
+	}
+	if err := w.stream(metadataStream, " /Type /Metadata /Subtype /XML", xmp, false); err != nil {
+		return nil, err
+	}
+	if err := w.stream(embeddedFile, fmt.Sprintf(" /Type /EmbeddedFile /Subtype /text#2Fxml /Params <</ModDate %s /Size %d>>", date, len(xmlBytes)), xmlBytes, true); err != nil {
+		return nil, err
+	}
+	w.object(fileSpec, fmt.Sprintf("<</Type /Filespec /F %s /UF %s /Desc (Factur-X invoice) /AFRelationship /%s /EF <</F %s /UF %s>>>>",
+		pdfString(FileName), pdfString(FileName), relationship, ref(embeddedFile), ref(embeddedFile)))
+
+	kids := []string{}
+	for _, lines := range pages {
+		page := w.reserve()
+		contents := w.reserve()
+		kids = append(kids, ref(page))
+		w.object(page, fmt.Sprintf("<</Type /Page /Parent %s /MediaBox [0 0 %d %d] /Resources <</Font <</F1 %s>>>> /Contents %s>>", ref(pagesNum), pageWidth, pageHeight, ref(font), ref(contents)))
+		if err := w.stream(contents, "", pageContent(lines), true); err != nil {
+			return nil, err
+		}
+	}
+	w.object(pagesNum, fmt.Sprintf("<</Type /Pages /Kids [%s] /Count %d>>", strings.Join(kids, " "), len(kids)))
+	return w.finish(catalog), nil
+}
@@ -0,0 +1,232 @@
+package facturx
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/cii"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCreated = time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+
+// testInvoice - a CII invoice with one 1273.00 EUR line at 25% VAT
+func testInvoice(t *testing.T) *cii.CrossIndustryInvoice {
+	vat := &ubl.TaxScheme{ID: "VAT"}
+	standardRate := &ubl.TaxCategory{ID: "S", Percent: "25.00", TaxScheme: vat}
+	inv := &ubl.Invoice{
+		ID:                   "TOSL108",
+		IssueDate:            "2009-12-15",
+		DueDate:              "2010-01-15",
+		InvoiceTypeCode:      "380",
+		Note:                 []string{"Ordered in our booth at the convention (stand 3)."},
+		DocumentCurrencyCode: "EUR",
+		OrderReference:       &ubl.OrderReference{ID: "123"},
+		AccountingSupplierParty: &ubl.SupplierParty{Party: &ubl.Party{
+			PartyName:        []ubl.PartyName{{Name: "Salescompany ltd."}},
+			PostalAddress:    &ubl.Address{StreetName: "Main street", CityName: "Big city", PostalZone: "54321", Country: &ubl.Country{IdentificationCode: "DK"}},
+			PartyTaxScheme:   []ubl.PartyTaxScheme{{CompanyID: "DK12345678", TaxScheme: vat}},
+			PartyLegalEntity: []ubl.PartyLegalEntity{{RegistrationName: "The Sellercompany Incorporated", CompanyID: "5402697509"}},
+		}},
+		AccountingCustomerParty: &ubl.CustomerParty{Party: &ubl.Party{
+			PartyLegalEntity: []ubl.PartyLegalEntity{{RegistrationName: "Buyercompany ltd"}},
+		}},
+		PaymentTerms: []ubl.PaymentTerms{{Note: "Penalty percentage 10% from due date"}},
+		TaxTotal: []ubl.TaxTotal{{
+			TaxAmount:   ubl.NewAmount("318.25", "EUR"),
+			TaxSubtotal: []ubl.TaxSubtotal{{TaxableAmount: ubl.NewAmount("1273", "EUR"), TaxAmount: ubl.NewAmount("318.25", "EUR"), TaxCategory: standardRate}},
+		}},
+		LegalMonetaryTotal: &ubl.MonetaryTotal{
+			LineExtensionAmount: ubl.NewAmount("1273", "EUR"),
+			TaxExclusiveAmount:  ubl.NewAmount("1273", "EUR"),
+			TaxInclusiveAmount:  ubl.NewAmount("1591.25", "EUR"),
+			PayableAmount:       ubl.NewAmount("1591.25", "EUR"),
+		},
+		InvoiceLine: []ubl.InvoiceLine{{
+			ID:                  "1",
+			InvoicedQuantity:    &ubl.Quantity{Value: "1", UnitCode: "EA"},
+			LineExtensionAmount: ubl.NewAmount("1273", "EUR"),
+			Item: &ubl.Item{
+				Name:                      "Labtop computer",
+				Description:               "Processor: Intel Core 2 Duo SU9400 LV (1.4GHz). RAM: 3MB.",
+				SellersItemIdentification: &ubl.ItemIdentification{ID: ubl.Identifier{Value: "JB007"}},
+				ClassifiedTaxCategory:     []ubl.TaxCategory{*standardRate},
+			},
+			Price: &ubl.Price{PriceAmount: ubl.NewAmount("1273", "EUR")},
+		}},
+	}
+	ci, err := cii.FromInvoice(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ci
+}
+
+var objectRe = regexp.MustCompile(`(?s)(\d+) 0 obj\n(<<.*?>>)\nstream\n`)
+
+// streams - the decoded streams of a PDF by the dictionary they start with
+func streams(t *testing.T, pdf []byte) map[string][]byte {
+	out := map[string][]byte{}
+	for _, m := range objectRe.FindAllSubmatchIndex(pdf, -1) {
+		dict := string(pdf[m[4]:m[5]])
+		length, err := strconv.Atoi(regexp.MustCompile(`/Length (\d+)>>$`).FindStringSubmatch(dict)[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := pdf[m[1] : m[1]+length]
+		if strings.Contains(dict, "/FlateDecode") {
+			zr, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data, err = io.ReadAll(zr); err != nil {
+				t.Fatal(err)
+			}
+		}
+		out[dict] = data
+	}
+	return out
+}
+
+func stream(t *testing.T, pdf []byte, dictPart string) string {
+	for dict, data := range streams(t, pdf) {
+		if strings.Contains(dict, dictPart) {
+			return string(data)
+		}
+	}
+	t.Fatalf("no stream with %s", dictPart)
+	return ""
+}
+
+func TestRender(t *testing.T) {
+	pdf, err := Render(testInvoice(t), ProfileEN16931, testCreated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(pdf)
+	assert.True(t, strings.HasPrefix(s, "%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"), "binary PDF header")
+	assert.True(t, strings.HasSuffix(s, "%%EOF\n"))
+	assert.Contains(t, s, "/OutputIntents [<</Type /OutputIntent /S /GTS_PDFA1")
+	assert.Contains(t, s, "/Names <</EmbeddedFiles <</Names [(factur-x.xml) ")
+	assert.Contains(t, s, "/Subtype /text#2Fxml /Params <</ModDate (D:20240131100000+00'00') ")
+	assert.Contains(t, s, "/AFRelationship /Alternative")
+	assert.Contains(t, s, "/BaseFont /DejaVuSansMono")
+
+	// every cross reference entry points at its object
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(s)
+	if assert.Len(t, xref, 2) {
+		start, _ := strconv.Atoi(xref[1])
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(s[start:], -1)
+		assert.True(t, len(entries) > 10)
+		for i, e := range entries {
+			off, _ := strconv.Atoi(e[1])
+			assert.True(t, strings.HasPrefix(s[off:], fmt.Sprintf("%d 0 obj\n", i+1)), "object %d", i+1)
+		}
+	}
+
+	xmp := stream(t, pdf, "/Type /Metadata")
+	assert.Contains(t, xmp, "<pdfaid:part>3</pdfaid:part>")
+	assert.Contains(t, xmp, "<pdfaid:conformance>B</pdfaid:conformance>")
+	assert.Contains(t, xmp, "<fx:DocumentFileName>factur-x.xml</fx:DocumentFileName>")
+	assert.Contains(t, xmp, "<fx:ConformanceLevel>EN 16931</fx:ConformanceLevel>")
+	assert.Contains(t, xmp, "<xmp:CreateDate>2024-01-31T10:00:00Z</xmp:CreateDate>")
+
+	embedded := stream(t, pdf, "/Type /EmbeddedFile")
+	var ci cii.CrossIndustryInvoice
+	if assert.NoError(t, cii.Unmarshal([]byte(embedded), &ci)) {
+		assert.Equal(t, cii.GuidelineEN16931, ci.ExchangedDocumentContext.GuidelineSpecifiedDocumentContextParameter.ID)
+		assert.Equal(t, "TOSL108", ci.ExchangedDocument.ID)
+		assert.Len(t, ci.SupplyChainTradeTransaction.IncludedSupplyChainTradeLineItem, 1)
+	}
+	assert.Contains(t, s, fmt.Sprintf("/Size %d>>", len(embedded)))
+
+	content := stream(t, pdf, "<< /Filter")
+	assert.Contains(t, content, "(Invoice) Tj")
+	assert.Contains(t, content, "(Number                 TOSL108) Tj")
+	assert.Contains(t, content, "(Due date               2010-01-15) Tj")
+	assert.Contains(t, content, `Ordered in our booth at the convention \(stand 3\).`)
+	assert.Contains(t, content, "Labtop computer")
+	assert.Contains(t, content, "1591.25 EUR) Tj")
+}
+
+func TestRenderProfiles(t *testing.T) {
+	pdf, err := Render(testInvoice(t), ProfileMinimum, testCreated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(pdf), "/AFRelationship /Data")
+	assert.Contains(t, stream(t, pdf, "/Type /Metadata"), "<fx:ConformanceLevel>MINIMUM</fx:ConformanceLevel>")
+	// the visible invoice keeps the lines
+	assert.Contains(t, stream(t, pdf, "<< /Filter"), "Labtop computer")
+
+	var ci cii.CrossIndustryInvoice
+	if assert.NoError(t, cii.Unmarshal([]byte(stream(t, pdf, "/Type /EmbeddedFile")), &ci)) {
+		assert.Equal(t, "urn:factur-x.eu:1p0:minimum", ci.ExchangedDocumentContext.GuidelineSpecifiedDocumentContextParameter.ID)
+		tx := ci.SupplyChainTradeTransaction
+		assert.Empty(t, tx.IncludedSupplyChainTradeLineItem)
+		assert.Empty(t, ci.ExchangedDocument.IncludedNote)
+		assert.Empty(t, tx.ApplicableHeaderTradeAgreement.SellerTradeParty.PostalTradeAddress.LineOne)
+		assert.Equal(t, "DK", tx.ApplicableHeaderTradeAgreement.SellerTradeParty.PostalTradeAddress.CountryID)
+		assert.Equal(t, "1591.25", tx.ApplicableHeaderTradeSettlement.SpecifiedTradeSettlementHeaderMonetarySummation.DuePayableAmount.Value)
+		assert.Nil(t, tx.ApplicableHeaderTradeSettlement.SpecifiedTradeSettlementHeaderMonetarySummation.LineTotalAmount)
+		assert.Empty(t, tx.ApplicableHeaderTradeSettlement.ApplicableTradeTax)
+	}
+
+	pdf, err = Render(testInvoice(t), ProfileBasic, testCreated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NoError(t, cii.Unmarshal([]byte(stream(t, pdf, "/Type /EmbeddedFile")), &ci)) {
+		tp := ci.SupplyChainTradeTransaction.IncludedSupplyChainTradeLineItem[0].SpecifiedTradeProduct
+		assert.Equal(t, "Labtop computer", tp.Name)
+		assert.Empty(t, tp.SellerAssignedID)
+		assert.Empty(t, tp.Description)
+	}
+
+	_, err = Render(testInvoice(t), "xrechnung", testCreated)
+	assert.Error(t, err)
+}
+
+func TestParseProfile(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ProfileEN16931},
+		{in: "MINIMUM", want: ProfileMinimum},
+		{in: "basic", want: ProfileBasic},
+		{in: "EN 16931", want: ProfileEN16931},
+		{in: "en16931", want: ProfileEN16931},
+		{in: "Extended", want: ProfileExtended},
+		{in: "basic-wl", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseProfile(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestWinAnsi(t *testing.T) {
+	assert.Equal(t, []byte("Caf\xe9 \x80 5 \x96 ?"), winAnsi("Café € 5 – 漢"))
+	assert.Equal(t, `(a\(b\)\\)`, pdfString(`a(b)\`))
+}
+
+func TestSRGBProfile(t *testing.T) {
+	assert.Equal(t, "acsp", string(srgbProfile[36:40]))
+	assert.Equal(t, "mntr", string(srgbProfile[12:16]))
+	assert.Equal(t, len(srgbProfile), int(srgbProfile[0])<<24|int(srgbProfile[1])<<16|int(srgbProfile[2])<<8|int(srgbProfile[3]))
+}
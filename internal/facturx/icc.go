@@ -0,0 +1,108 @@
+package facturx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// srgbProfile - ICC v2 display profile with sRGB primaries adapted to D50
+// and a 2.2 gamma, the output intent of the PDF/A file
+var srgbProfile = newRGBProfile("sRGB", [3][3]float64{
+	{0.4361, 0.2225, 0.0139},
+	{0.3851, 0.7169, 0.0971},
+	{0.1431, 0.0606, 0.7141},
+}, 2.2)
+
+// d50 - PCS illuminant
+var d50 = [3]float64{0.9642, 1.0, 0.8249}
+
+// newRGBProfile - matrix/TRC ICC v2 monitor profile with the given
+// colorant XYZ values and one gamma for all channels
+func newRGBProfile(description string, colorants [3][3]float64, gamma float64) []byte {
+	type tag struct {
+		sig  string
+		data []byte
+	}
+	curve := curveType(gamma)
+	tags := []tag{
+		{"desc", descType(description)},
+		{"cprt", textType("No copyright, use freely")},
+		{"wtpt", xyzType(d50)},
+		{"rXYZ", xyzType(colorants[0])},
+		{"gXYZ", xyzType(colorants[1])},
+		{"bXYZ", xyzType(colorants[2])},
+		{"rTRC", curve},
+		{"gTRC", curve},
+		{"bTRC", curve},
+	}
+
+	var body bytes.Buffer
+	offset := 128 + 4 + 12*len(tags)
+	table := make([]byte, 0, 4+12*len(tags))
+	table = binary.BigEndian.AppendUint32(table, uint32(len(tags)))
+	for _, t := range tags {
+		table = append(table, t.sig...)
+		table = binary.BigEndian.AppendUint32(table, uint32(offset+body.Len()))
+		table = binary.BigEndian.AppendUint32(table, uint32(len(t.data)))
+		body.Write(t.data)
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+
+	header := make([]byte, 128)
+	binary.BigEndian.PutUint32(header[0:], uint32(128+len(table)+body.Len()))
+	binary.BigEndian.PutUint32(header[8:], 0x02100000)
+	copy(header[12:], "mntr")
+	copy(header[16:], "RGB ")
+	copy(header[20:], "XYZ ")
+	for i, v := range []uint16{2024, 1, 1, 0, 0, 0} {
+		binary.BigEndian.PutUint16(header[24+2*i:], v)
+	}
+	copy(header[36:], "acsp")
+	for i, v := range d50 {
+		binary.BigEndian.PutUint32(header[68+4*i:], s15Fixed16(v))
+	}
+
+	profile := append(header, table...)
+	return append(profile, body.Bytes()...)
+}
+
+func s15Fixed16(v float64) uint32 {
+	return uint32(int32(math.Round(v * 65536)))
+}
+
+func xyzType(xyz [3]float64) []byte {
+	b := append([]byte("XYZ "), 0, 0, 0, 0)
+	for _, v := range xyz {
+		b = binary.BigEndian.AppendUint32(b, s15Fixed16(v))
+	}
+	return b
+}
+
+func curveType(gamma float64) []byte {
+	b := append([]byte("curv"), 0, 0, 0, 0)
+	b = binary.BigEndian.AppendUint32(b, 1)
+	return binary.BigEndian.AppendUint16(b, uint16(math.Round(gamma*256)))
+}
+
+func textType(s string) []byte {
+	b := append([]byte("text"), 0, 0, 0, 0)
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+// descType - ICC v2 textDescriptionType with an ASCII description and no
+// Unicode or ScriptCode variants
+func descType(s string) []byte {
+	b := append([]byte("desc"), 0, 0, 0, 0)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)+1))
+	b = append(b, s...)
+	b = append(b, 0)
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = append(b, 0)
+	return append(b, make([]byte, 67)...)
+}
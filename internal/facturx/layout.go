@@ -0,0 +1,395 @@
+package facturx
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/cii"
+)
+
+// A4 page in points, text starts margin points from the page edges
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 50
+)
+
+// Text sizes in points. At bodySize a line holds columns characters of the
+// fixed pitch font.
+const (
+	titleSize   = 16
+	headingSize = 11
+	bodySize    = 9
+	columns     = 90
+	labelWidth  = 22
+)
+
+// textLine - a line of text, an empty text is a blank line
+type textLine struct {
+	size float64
+	text string
+}
+
+// leading - distance from this line to the next
+func (l textLine) leading() float64 {
+	return l.size * 1.4
+}
+
+// document - text lines of the visible invoice
+type document struct {
+	lines []textLine
+}
+
+func (d *document) title(s string) {
+	d.lines = append(d.lines, textLine{size: titleSize, text: s})
+}
+
+func (d *document) heading(s string) {
+	d.blank()
+	d.lines = append(d.lines, textLine{size: headingSize, text: s})
+}
+
+func (d *document) blank() {
+	d.lines = append(d.lines, textLine{size: bodySize})
+}
+
+// text - s wrapped at columns characters
+func (d *document) text(s string) {
+	for _, l := range wrap(s, columns) {
+		d.lines = append(d.lines, textLine{size: bodySize, text: l})
+	}
+}
+
+// row - a preformatted line, spacing is kept
+func (d *document) row(s string) {
+	d.lines = append(d.lines, textLine{size: bodySize, text: s})
+}
+
+// field - a labelled value, left out when the value is empty. A long value
+// continues below itself.
+func (d *document) field(label string, value string) {
+	if value = strings.TrimSpace(value); value == "" {
+		return
+	}
+	for i, l := range wrap(value, columns-labelWidth-1) {
+		if i > 0 {
+			label = ""
+		}
+		d.row(fmt.Sprintf("%-*s %s", labelWidth, label, l))
+	}
+}
+
+// layout - text lines of the visible invoice
+func layout(ci *cii.CrossIndustryInvoice, conformanceLevel string) []textLine {
+	d := &document{}
+	ed := ci.ExchangedDocument
+	tx := ci.SupplyChainTradeTransaction
+	a := tx.ApplicableHeaderTradeAgreement
+	if a == nil {
+		a = &cii.HeaderTradeAgreement{}
+	}
+	s := tx.ApplicableHeaderTradeSettlement
+	if s == nil {
+		s = &cii.HeaderTradeSettlement{}
+	}
+
+	d.title(documentTitle(ci))
+	d.blank()
+	d.field("Number", ed.ID)
+	d.field("Issue date", dateTime(ed.IssueDateTime))
+	for _, pt := range s.SpecifiedTradePaymentTerms {
+		if due := dateTime(pt.DueDateDateTime); due != "" {
+			d.field("Due date", due)
+			break
+		}
+	}
+	d.field("Buyer reference", a.BuyerReference)
+	if ref := a.BuyerOrderReferencedDocument; ref != nil {
+		d.field("Order reference", ref.IssuerAssignedID)
+	}
+	if ref := s.InvoiceReferencedDocument; ref != nil {
+		d.field("Preceding invoice", ref.IssuerAssignedID)
+	}
+	d.field("Invoice period", period(s.BillingSpecifiedPeriod))
+	d.field("Currency", s.InvoiceCurrencyCode)
+
+	party(d, "Seller", a.SellerTradeParty)
+	party(d, "Buyer", a.BuyerTradeParty)
+	party(d, "Seller tax representative", a.SellerTaxRepresentativeTradeParty)
+	party(d, "Payee", s.PayeeTradeParty)
+
+	if len(tx.IncludedSupplyChainTradeLineItem) > 0 {
+		d.heading("Lines")
+		d.row(lineRow("#", "Item", "Quantity", "Unit", "Net price", "VAT", "Amount"))
+		d.row(strings.Repeat("-", columns))
+		for _, li := range tx.IncludedSupplyChainTradeLineItem {
+			lineItem(d, &li)
+		}
+	}
+
+	if len(s.ApplicableTradeTax) > 0 {
+		d.heading("VAT breakdown")
+		d.row(fmt.Sprintf("%-10s %8s %20s %20s", "Category", "Rate", "Taxable amount", "VAT amount"))
+		for _, tax := range s.ApplicableTradeTax {
+			d.row(fmt.Sprintf("%-10s %8s %20s %20s", tax.CategoryCode, tax.RateApplicablePercent, amount(tax.BasisAmount), amount(tax.CalculatedAmount)))
+			if reason := strings.TrimSpace(tax.ExemptionReasonCode + " " + tax.ExemptionReason); reason != "" {
+				d.text("  Exemption: " + reason)
+			}
+		}
+	}
+
+	if ms := s.SpecifiedTradeSettlementHeaderMonetarySummation; ms != nil {
+		d.heading("Totals")
+		total := func(label string, a *cii.Amount) {
+			if v := amount(a); v != "" {
+				d.row(fmt.Sprintf("%-40s %20s %s", label, v, s.InvoiceCurrencyCode))
+			}
+		}
+		total("Sum of line amounts", ms.LineTotalAmount)
+		total("Allowances", ms.AllowanceTotalAmount)
+		total("Charges", ms.ChargeTotalAmount)
+		total("Total without VAT", ms.TaxBasisTotalAmount)
+		for _, t := range ms.TaxTotalAmount {
+			currency := t.CurrencyID
+			if currency == "" {
+				currency = s.InvoiceCurrencyCode
+			}
+			d.row(fmt.Sprintf("%-40s %20s %s", "VAT total", strings.TrimSpace(t.Value), currency))
+		}
+		total("Total with VAT", ms.GrandTotalAmount)
+		total("Rounding", ms.RoundingAmount)
+		total("Paid in advance", ms.TotalPrepaidAmount)
+		total("Amount due", ms.DuePayableAmount)
+	}
+
+	if len(s.SpecifiedTradePaymentTerms) > 0 {
+		d.heading("Payment terms")
+		for _, pt := range s.SpecifiedTradePaymentTerms {
+			d.text(pt.Description)
+			if pa := amount(pt.PartialPaymentAmount); pa != "" {
+				d.field("Partial payment", pa+" "+s.InvoiceCurrencyCode)
+			}
+		}
+	}
+
+	if len(ed.IncludedNote) > 0 {
+		d.heading("Notes")
+		for _, n := range ed.IncludedNote {
+			d.text(n.Content)
+		}
+	}
+
+	d.blank()
+	d.text(fmt.Sprintf("Factur-X %s: the structured invoice data is embedded in this file as %s.", conformanceLevel, FileName))
+	return d.lines
+}
+
+// documentTitle - name of the document by its type code
+func documentTitle(ci *cii.CrossIndustryInvoice) string {
+	switch code := ci.TypeCode(); {
+	case cii.IsCreditNote(code):
+		return "Credit note"
+	case code == "384":
+		return "Corrected invoice"
+	case code == "386":
+		return "Prepayment invoice"
+	case code == "389":
+		return "Self-billed invoice"
+	}
+	return "Invoice"
+}
+
+// party - name, address and identifiers of a party
+func party(d *document, label string, tp *cii.TradeParty) {
+	if tp == nil {
+		return
+	}
+	d.heading(label)
+	d.text(tp.Name)
+	if lo := tp.SpecifiedLegalOrganization; lo != nil && lo.TradingBusinessName != "" && lo.TradingBusinessName != tp.Name {
+		d.text(lo.TradingBusinessName)
+	}
+	if pa := tp.PostalTradeAddress; pa != nil {
+		for _, l := range []string{pa.LineOne, pa.LineTwo, pa.LineThree, strings.TrimSpace(pa.PostcodeCode + " " + pa.CityName), pa.CountrySubDivisionName, pa.CountryID} {
+			if l != "" {
+				d.text(l)
+			}
+		}
+	}
+	for _, tr := range tp.SpecifiedTaxRegistration {
+		if tr.ID.SchemeID == "VA" {
+			d.field("VAT identifier", tr.ID.Value)
+		} else {
+			d.field("Tax registration", tr.ID.Value)
+		}
+	}
+	if lo := tp.SpecifiedLegalOrganization; lo != nil && lo.ID != nil {
+		d.field("Legal registration", lo.ID.Value)
+	}
+	if uc := tp.URIUniversalCommunication; uc != nil && uc.URIID != nil {
+		d.field("Electronic address", uc.URIID.Value)
+	}
+}
+
+// Widths of the line table columns
+const (
+	colNumber   = 4
+	colItem     = 30
+	colQuantity = 10
+	colUnit     = 4
+	colPrice    = 12
+	colVAT      = 8
+	colAmount   = 14
+)
+
+func lineRow(number string, item string, quantity string, unit string, price string, vat string, total string) string {
+	return fmt.Sprintf("%-*s %-*s %*s %-*s %*s %*s %*s", colNumber, number, colItem, item, colQuantity, quantity, colUnit, unit, colPrice, price, colVAT, vat, colAmount, total)
+}
+
+// lineItem - table row of a line, the item name, description and period
+// continue in the item column
+func lineItem(d *document, li *cii.LineItem) {
+	var number, quantity, unit, price, vat, total string
+	var item []string
+	if ld := li.AssociatedDocumentLineDocument; ld != nil {
+		number = ld.LineID
+	}
+	if tp := li.SpecifiedTradeProduct; tp != nil {
+		item = wrap(tp.Name, colItem)
+		if tp.Description != "" {
+			item = append(item, wrap(tp.Description, colItem)...)
+		}
+	}
+	if a := li.SpecifiedLineTradeAgreement; a != nil && a.NetPriceProductTradePrice != nil {
+		price = amount(a.NetPriceProductTradePrice.ChargeAmount)
+	}
+	if dl := li.SpecifiedLineTradeDelivery; dl != nil && dl.BilledQuantity != nil {
+		quantity = strings.TrimSpace(dl.BilledQuantity.Value)
+		unit = dl.BilledQuantity.UnitCode
+	}
+	if s := li.SpecifiedLineTradeSettlement; s != nil {
+		if len(s.ApplicableTradeTax) > 0 {
+			vat = strings.TrimSpace(s.ApplicableTradeTax[0].CategoryCode + " " + s.ApplicableTradeTax[0].RateApplicablePercent)
+		}
+		if ms := s.SpecifiedTradeSettlementLineMonetarySummation; ms != nil {
+			total = amount(ms.LineTotalAmount)
+		}
+		if p := period(s.BillingSpecifiedPeriod); p != "" {
+			item = append(item, wrap(p, colItem)...)
+		}
+	}
+	if len(item) == 0 {
+		item = []string{""}
+	}
+	d.row(lineRow(number, item[0], quantity, unit, price, vat, total))
+	for _, l := range item[1:] {
+		d.row(lineRow("", l, "", "", "", "", ""))
+	}
+	if ld := li.AssociatedDocumentLineDocument; ld != nil {
+		for _, n := range ld.IncludedNote {
+			for _, l := range wrap(n.Content, colItem) {
+				d.row(lineRow("", l, "", "", "", "", ""))
+			}
+		}
+	}
+}
+
+func amount(a *cii.Amount) string {
+	if a == nil {
+		return ""
+	}
+	return strings.TrimSpace(a.Value)
+}
+
+// dateTime - a CII date as shown on the invoice, the CII value itself when
+// it is not in a supported format
+func dateTime(dt *cii.DateTime) string {
+	if dt == nil {
+		return ""
+	}
+	d, err := cii.ParseDate(dt.DateTimeString)
+	if err != nil {
+		return dt.DateTimeString.Value
+	}
+	return d
+}
+
+func period(p *cii.Period) string {
+	if p == nil {
+		return ""
+	}
+	start, end := dateTime(p.StartDateTime), dateTime(p.EndDateTime)
+	if start == "" && end == "" {
+		return ""
+	}
+	return strings.TrimSpace(start + " - " + end)
+}
+
+// wrap - s broken into lines of at most width characters, at spaces where
+// possible
+func wrap(s string, width int) []string {
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		words := strings.Fields(para)
+		line := ""
+		for _, word := range words {
+			for len([]rune(word)) > width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				r := []rune(word)
+				lines = append(lines, string(r[:width]))
+				word = string(r[width:])
+			}
+			switch {
+			case line == "":
+				line = word
+			case len([]rune(line))+1+len([]rune(word)) <= width:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// paginate - lines split into pages that fit between the margins
+func paginate(lines []textLine) [][]textLine {
+	pages := [][]textLine{}
+	page := []textLine{}
+	used := 0.0
+	for _, l := range lines {
+		if used+l.leading() > pageHeight-2*margin && len(page) > 0 {
+			pages = append(pages, page)
+			page = []textLine{}
+			used = 0
+			if l.text == "" {
+				continue
+			}
+		}
+		page = append(page, l)
+		used += l.leading()
+	}
+	return append(pages, page)
+}
+
+// pageContent - content stream of a page
+func pageContent(lines []textLine) []byte {
+	var buf bytes.Buffer
+	y := float64(pageHeight - margin)
+	for _, l := range lines {
+		y -= l.leading()
+		if l.text == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "BT /F1 %g Tf %d %.2f Td %s Tj ET\n", l.size, margin, y, pdfString(l.text))
+	}
+	return buf.Bytes()
+}
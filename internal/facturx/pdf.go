@@ -0,0 +1,151 @@
+package facturx
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/md5"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:embed fonts/DejaVuSansMono.ttf
+var fontFile []byte
+
+// fontName - BaseFont of the embedded font
+const fontName = "DejaVuSansMono"
+
+// Metrics of DejaVu Sans Mono in 1/1000 text space units. Every glyph of
+// the font has the same advance width.
+const (
+	glyphWidth  = 602
+	fontAscent  = 928
+	fontDescent = -236
+	capHeight   = 729
+)
+
+// fontBBox - bounding box of the embedded font in 1/1000 text space units
+var fontBBox = [4]int{-559, -375, 718, 1028}
+
+// pdfWriter - writes a PDF 1.7 file object by object. Objects are numbered
+// in the order they are added, starting at 1.
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func newPDFWriter() *pdfWriter {
+	w := &pdfWriter{}
+	// the comment with bytes above 127 marks the file as binary (PDF/A 6.1.2)
+	w.buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	return w
+}
+
+// reserve - number of the next object, so objects can refer to objects
+// written later
+func (w *pdfWriter) reserve() int {
+	w.offsets = append(w.offsets, -1)
+	return len(w.offsets)
+}
+
+// object - write object num with its dictionary or value
+func (w *pdfWriter) object(num int, value string) {
+	w.offsets[num-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", num, value)
+}
+
+// stream - write object num as a stream, Flate compressed when compress is
+// set. dict holds the entries besides Length and Filter.
+func (w *pdfWriter) stream(num int, dict string, data []byte, compress bool) error {
+	if compress {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		data = z.Bytes()
+		dict += " /Filter /FlateDecode"
+	}
+	w.offsets[num-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n<<%s /Length %d>>\nstream\n", num, dict, len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+	return nil
+}
+
+// finish - write the cross reference table and the trailer. The file
+// identifier is derived from the content so equal input gives equal output.
+func (w *pdfWriter) finish(root int) []byte {
+	id := fmt.Sprintf("%x", md5.Sum(w.buf.Bytes()))
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, off := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<</Size %d /Root %d 0 R /ID [<%s> <%s>]>>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, root, id, id, xref)
+	return w.buf.Bytes()
+}
+
+// ref - indirect reference to object num
+func ref(num int) string {
+	return fmt.Sprintf("%d 0 R", num)
+}
+
+// pdfDate - t as a PDF date string
+func pdfDate(t time.Time) string {
+	_, offset := t.Zone()
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("D:%s%c%02d'%02d'", t.Format("20060102150405"), sign, offset/3600, offset%3600/60)
+}
+
+// pdfString - s as a PDF literal string in WinAnsiEncoding
+func pdfString(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range winAnsi(s) {
+		switch c {
+		case '(', ')', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// winAnsiExtra - characters WinAnsiEncoding places in 0x80-0x9F
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// winAnsi - s in WinAnsiEncoding, characters the encoding lacks become '?'
+// and control characters a space
+func winAnsi(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 0x20:
+			out = append(out, ' ')
+		case r < 0x7f, r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		default:
+			if c, ok := winAnsiExtra[r]; ok {
+				out = append(out, c)
+			} else {
+				out = append(out, '?')
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,248 @@
+// Package money holds exact decimal amounts. An Amount is a fixed point
+// decimal with Scale decimal places kept in an int64, so sums of amounts do
+// not drift the way float64 sums do.
+//
+// Amounts travel in the protos as decimal strings such as "1273.50" and are
+// stored in DECIMAL columns. Every amount has its currency code alongside
+// it: the currency_code of the row that holds it, such as a line, an
+// allowance or charge, a tax total or a payment, and a column of its own
+// for the amounts of a row in another currency, such as the payable
+// alternative amount of a document or the transaction currency tax amount
+// of a tax sub total.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale - decimal places of an Amount
+const Scale = 6
+
+// unit - the Amount of 1
+const unit = 1_000_000
+
+// Amount - a decimal value times 10^Scale
+type Amount int64
+
+// Zero - the Amount 0
+const Zero Amount = 0
+
+// ErrOverflow - the result of an operation on Amounts does not fit in an
+// Amount; New, Add, Sub, Mul, Div, Neg, Abs and Sum panic with an error that wraps it rather
+// than return a wrapped around value
+var ErrOverflow = errors.New("money: amount out of range")
+
+// New - the Amount units/10^places, e.g. New(12345, 2) is 123.45
+func New(units int64, places int) Amount {
+	if places > Scale {
+		return fromBig(roundQuo(big.NewInt(units), pow10(places-Scale)), "New")
+	}
+	return fromBig(new(big.Int).Mul(big.NewInt(units), pow10(Scale-places)), "New")
+}
+
+// Parse - the Amount of a decimal string with an optional sign, an empty
+// string is Zero. Digits beyond Scale decimal places are rounded half away
+// from zero.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Zero, nil
+	}
+	digits := s
+	neg := false
+	switch digits[0] {
+	case '-':
+		neg = true
+		digits = digits[1:]
+	case '+':
+		digits = digits[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Zero, fmt.Errorf("money: invalid decimal %q", s)
+	}
+	v, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		v = new(big.Int)
+	}
+	if places := len(fracPart); places > Scale {
+		v = roundQuo(v, pow10(places-Scale))
+	} else {
+		v.Mul(v, pow10(Scale-places))
+	}
+	if neg {
+		v.Neg(v)
+	}
+	if !v.IsInt64() {
+		return Zero, fmt.Errorf("money: decimal %q out of range", s)
+	}
+	return Amount(v.Int64()), nil
+}
+
+// MustParse - the Amount of a decimal string, panics when s is not one
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// FromFloat - the Amount of the shortest decimal that is f, for the
+// quantities and rates that are still float64
+func FromFloat(f float64) Amount {
+	a, err := Parse(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Zero
+	}
+	return a
+}
+
+// Float64 - a as the nearest float64
+func (a Amount) Float64() float64 {
+	return float64(a) / unit
+}
+
+// String - a with at least two decimal places and no trailing zeros
+// beyond them, such as "1273.00" or "0.125"
+func (a Amount) String() string {
+	s := a.Text(Scale)
+	s = strings.TrimRight(s, "0")
+	if i := strings.IndexByte(s, '.'); len(s)-i-1 < 2 {
+		s += strings.Repeat("0", 2-(len(s)-i-1))
+	}
+	return s
+}
+
+// Text - a with exactly places decimal places, rounded half away from zero
+func (a Amount) Text(places int) string {
+	r := a.Round(places)
+	neg := r < 0
+	u := uint64(r)
+	if neg {
+		u = uint64(-r)
+	}
+	s := strconv.FormatUint(u, 10)
+	if len(s) <= Scale {
+		s = strings.Repeat("0", Scale-len(s)+1) + s
+	}
+	s = s[:len(s)-Scale] + "." + s[len(s)-Scale:len(s)-Scale+places]
+	s = strings.TrimSuffix(s, ".")
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// Round - a rounded half away from zero to places decimal places
+func (a Amount) Round(places int) Amount {
+	if places >= Scale {
+		return a
+	}
+	step := int64(math.Pow10(Scale - places))
+	q, r := int64(a)/step, int64(a)%step
+	if 2*abs(r) >= step {
+		if a < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return Amount(q * step)
+}
+
+// Add - a plus b
+func (a Amount) Add(b Amount) Amount {
+	return fromBig(new(big.Int).Add(big.NewInt(int64(a)), big.NewInt(int64(b))), "Add")
+}
+
+// Sub - a minus b
+func (a Amount) Sub(b Amount) Amount {
+	return fromBig(new(big.Int).Sub(big.NewInt(int64(a)), big.NewInt(int64(b))), "Sub")
+}
+
+// Mul - a times b rounded half away from zero to Scale decimal places
+func (a Amount) Mul(b Amount) Amount {
+	p := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	return fromBig(roundQuo(p, big.NewInt(unit)), "Mul")
+}
+
+// Div - a divided by b rounded half away from zero to Scale decimal places,
+// Zero when b is Zero
+func (a Amount) Div(b Amount) Amount {
+	if b == 0 {
+		return Zero
+	}
+	n := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(unit))
+	return fromBig(roundQuo(n, big.NewInt(int64(b))), "Div")
+}
+
+// Neg - -a
+func (a Amount) Neg() Amount {
+	return fromBig(new(big.Int).Neg(big.NewInt(int64(a))), "Neg")
+}
+
+// Abs - a without its sign
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// Sum - the sum of amounts
+func Sum(amounts ...Amount) Amount {
+	s := new(big.Int)
+	for _, a := range amounts {
+		s.Add(s, big.NewInt(int64(a)))
+	}
+	return fromBig(s, "Sum")
+}
+
+// fromBig - the Amount of v, the result of op; panics with ErrOverflow
+// when v does not fit
+func fromBig(v *big.Int, op string) Amount {
+	if !v.IsInt64() {
+		panic(fmt.Errorf("%w: %s gives %s", ErrOverflow, op, new(big.Rat).SetFrac(v, big.NewInt(unit)).FloatString(Scale)))
+	}
+	return Amount(v.Int64())
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundQuo - n/d rounded half away from zero
+func roundQuo(n *big.Int, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	r.Abs(r).Lsh(r, 1)
+	if r.Cmp(new(big.Int).Abs(d)) >= 0 {
+		if n.Sign()*d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
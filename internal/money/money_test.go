@@ -0,0 +1,119 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: "", want: Zero},
+		{in: "1273.5", want: 1273500000},
+		{in: "-0.01", want: -10000},
+		{in: "+7", want: 7000000},
+		{in: ".5", want: 500000},
+		{in: "200.0000", want: 200000000},
+		{in: "0.0000005", want: 1},
+		{in: "-0.0000005", want: -1},
+		{in: "0.00000049", want: 0},
+		{in: "1,5", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "1273.00", MustParse("1273").String())
+	assert.Equal(t, "0.125", MustParse("0.1250").String())
+	assert.Equal(t, "-0.50", MustParse("-.5").String())
+	assert.Equal(t, "0.00", Zero.String())
+	assert.Equal(t, "0.000001", Amount(1).String())
+	assert.Equal(t, "12.35", MustParse("12.345").Text(2))
+	assert.Equal(t, "-12.35", MustParse("-12.345").Text(2))
+	assert.Equal(t, "13", MustParse("12.5").Text(0))
+	assert.Equal(t, "1.50", New(150, 2).String())
+}
+
+func TestArithmetic(t *testing.T) {
+	// 0.1 + 0.2 is exact
+	assert.Equal(t, MustParse("0.3"), MustParse("0.1").Add(MustParse("0.2")))
+	tenCents := MustParse("0.10")
+	sum := Zero
+	for i := 0; i < 1000; i++ {
+		sum = sum.Add(tenCents)
+	}
+	assert.Equal(t, "100.00", sum.String())
+	assert.Equal(t, "-0.10", MustParse("0.2").Sub(MustParse("0.3")).String())
+
+	assert.Equal(t, "30.015", MustParse("3").Mul(MustParse("10.005")).String())
+	assert.Equal(t, "30.02", MustParse("3").Mul(MustParse("10.005")).Round(2).String())
+	assert.Equal(t, "-30.02", MustParse("-3").Mul(MustParse("10.005")).Round(2).String())
+	assert.Equal(t, "0.333333", MustParse("1").Div(MustParse("3")).String())
+	assert.Equal(t, "0.666667", MustParse("2").Div(MustParse("3")).String())
+	assert.Equal(t, Zero, MustParse("2").Div(Zero))
+	assert.Equal(t, "1.10", FromFloat(1.1).String())
+	assert.Equal(t, MustParse("6"), Sum(MustParse("1"), MustParse("2"), MustParse("3")))
+}
+
+func TestOverflow(t *testing.T) {
+	largest := MustParse("9223372036854.775807")
+	assert.Equal(t, largest, largest.Mul(MustParse("1")))
+	assert.Equal(t, largest, Sum(largest, MustParse("-1"), MustParse("1")))
+	assert.Equal(t, largest, largest.Sub(MustParse("1")).Add(MustParse("1")))
+	assert.Equal(t, MustParse("4611686018427.387904"), largest.Div(MustParse("2")))
+	assert.Equal(t, MustParse("9223372036854"), New(9223372036854, 0))
+
+	overflows := func(f func()) (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+		f()
+		return nil
+	}
+	for _, f := range []func(){
+		func() { largest.Mul(MustParse("1.000001")) },
+		func() { MustParse("10000000").Mul(MustParse("1000000")) },
+		func() { largest.Div(MustParse("0.999999")) },
+		func() { largest.Neg().Div(MustParse("0.5")) },
+		func() { Sum(largest, MustParse("0.000001")) },
+		func() { largest.Add(MustParse("0.000001")) },
+		func() { largest.Neg().Sub(MustParse("0.000002")) },
+		func() { largest.Neg().Sub(MustParse("0.000001")).Neg() },
+		func() { New(9223372036855, 0) },
+	} {
+		err := overflows(f)
+		assert.True(t, errors.Is(err, ErrOverflow), "%v", err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	in := invoiceproto.CreateInvoiceRequest{PayableAmount: "100"}
+	in.InvoiceLines = []*invoiceproto.CreateInvoiceLineRequest{{PriceAmount: "17.5", InvoicedQuantity: 2}, {PriceAmount: "0.1250"}}
+	assert.NoError(t, Normalize(&in))
+	assert.Equal(t, "100.00", in.PayableAmount)
+	assert.Equal(t, "0.00", in.PrepaidAmount)
+	assert.Equal(t, "17.50", in.InvoiceLines[0].PriceAmount)
+	assert.Equal(t, "0.00", in.InvoiceLines[0].LineExtensionAmount)
+	assert.Equal(t, "0.125", in.InvoiceLines[1].PriceAmount)
+
+	in.InvoiceLines[1].PriceAmount = "12,5"
+	assert.EqualError(t, Normalize(&in), `invoice_lines[2].price_amount: money: invalid decimal "12,5"`)
+}
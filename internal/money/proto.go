@@ -0,0 +1,54 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Normalize - set every amount of m, a string field whose name ends in
+// "amount", to its canonical String, in m and in the messages it holds. An
+// empty amount becomes "0.00". Requests are normalized before their amounts
+// reach a DECIMAL column, rows after they are read from one, so "100",
+// "100.0" and "100.0000" all come back as "100.00". The error names the
+// first invalid amount by its path, such as invoice_lines[2].price_amount.
+func Normalize(m proto.Message) error {
+	if m == nil {
+		return nil
+	}
+	return normalize(m.ProtoReflect(), "")
+}
+
+func normalize(m protoreflect.Message, prefix string) error {
+	if !m.IsValid() {
+		return nil
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && strings.HasSuffix(string(fd.Name()), "amount"):
+			a, err := Parse(m.Get(fd).String())
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			m.Set(fd, protoreflect.ValueOfString(a.String()))
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				if err := normalize(list.Get(j).Message(), fmt.Sprintf("%s[%d].", name, j+1)); err != nil {
+					return err
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind && m.Has(fd):
+			if err := normalize(m.Get(fd).Message(), name+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
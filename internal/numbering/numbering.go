@@ -0,0 +1,226 @@
+// Package numbering allocates the numbers of invoices, credit notes, debit
+// notes, purchase orders, despatch advices and receipt advices, IhId,
+// CnhId, DnhId, PohId, DesphId and RcpthId, from gap-free sequences.
+//
+// A party has a sequence per document type, with a pattern such as
+// "INV-{YYYY}-{seq:6}", and the sequence restarts at 1 in each fiscal
+// year. A number is allocated in the transaction that issues its document,
+// or inserts it for a despatch or receipt advice, which are not issued, so
+// a draft or a document that is not stored does not use up a number: the
+// numbers of a fiscal year have no gaps. A number that is allocated but is
+// not to be used is voided, which keeps an audit of it with its reason.
+//
+// A party without a sequence for a document type numbers its documents
+// itself, and a document created with a number of its own, as an imported
+// one, keeps it. A party with a sequence cannot number its documents
+// itself. Either way a number is used only once per party and document
+// type, which the unique index of the number of the headers of each
+// document type holds too.
+package numbering
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Document - a document type that is numbered, with the table, the number
+// column and the column of the numbering party of its headers
+type Document struct {
+	Type        string
+	Table       string
+	IDColumn    string
+	PartyColumn string
+}
+
+// The numbered documents, numbered by their supplier, buyer, despatching
+// or receiving party
+var (
+	Invoice       = Document{Type: "invoice", Table: "invoice_headers", IDColumn: "ih_id", PartyColumn: "accounting_supplier_party_id"}
+	CreditNote    = Document{Type: "credit_note", Table: "credit_note_headers", IDColumn: "cnh_id", PartyColumn: "accounting_supplier_party_id"}
+	DebitNote     = Document{Type: "debit_note", Table: "debit_note_headers", IDColumn: "dnh_id", PartyColumn: "accounting_supplier_party_id"}
+	PurchaseOrder = Document{Type: "purchase_order", Table: "purchase_order_headers", IDColumn: "poh_id", PartyColumn: "buyer_customer_party_id"}
+	Despatch      = Document{Type: "despatch", Table: "despatch_headers", IDColumn: "desph_id", PartyColumn: "despatch_supplier_party_id"}
+	ReceiptAdvice = Document{Type: "receipt_advice", Table: "receipt_advice_headers", IDColumn: "rcpth_id", PartyColumn: "delivery_customer_party_id"}
+)
+
+var documents = []Document{Invoice, CreditNote, DebitNote, PurchaseOrder, Despatch, ReceiptAdvice}
+
+// DocumentOf - the Document of a document type
+func DocumentOf(documentType string) (Document, error) {
+	for _, doc := range documents {
+		if doc.Type == documentType {
+			return doc, nil
+		}
+	}
+	return Document{}, fmt.Errorf("numbering: unknown document type %q", documentType)
+}
+
+var (
+	// ErrNumberUsed - the number is already used by a document of the party
+	// or voided
+	ErrNumberUsed = errors.New("numbering: document number is already used")
+	// ErrNotAllocated - the number was not allocated from the sequence
+	ErrNotAllocated = errors.New("numbering: document number is not allocated")
+	// ErrSequenceNumbered - the party numbers the document type with a
+	// sequence, a document cannot have a number of its own
+	ErrSequenceNumbered = errors.New("numbering: documents of the party are numbered by its sequence")
+)
+
+// mysqlErrDupEntry - the MySQL error of a duplicate key
+const mysqlErrDupEntry = 1062
+
+// NumberIndex - the name of the unique index of the numbering party and
+// the number of the headers of a document type
+func (doc Document) NumberIndex() string {
+	return doc.Table + "_party_number"
+}
+
+// UsedError - err as ErrNumberUsed when it is the duplicate key error of
+// the NumberIndex of doc, raised by a document stored with documentNumber
+// at the same time, else err
+func UsedError(doc Document, err error, documentNumber string) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDupEntry && strings.Contains(mysqlErr.Message, doc.NumberIndex()) {
+		return fmt.Errorf("%w: %s", ErrNumberUsed, documentNumber)
+	}
+	return err
+}
+
+// patternToken - a {...} placeholder of a pattern
+var patternToken = regexp.MustCompile(`\{[^{}]*\}`)
+
+// seqToken - the number placeholder, {seq} or {seq:N} with a width N
+var seqToken = regexp.MustCompile(`^\{seq(?::([1-9][0-9]?))?\}$`)
+
+// ValidatePattern - an error when a pattern does not have exactly one
+// number placeholder {seq} or {seq:N}, zero padded to N digits, and at
+// least one year placeholder {YYYY} or {YY}, or has other placeholders.
+// The year tells apart the numbers of the fiscal years of a sequence.
+func ValidatePattern(pattern string) error {
+	seqs, years := 0, 0
+	for _, token := range patternToken.FindAllString(pattern, -1) {
+		switch {
+		case token == "{YYYY}" || token == "{YY}":
+			years++
+		case seqToken.MatchString(token):
+			seqs++
+		default:
+			return fmt.Errorf("numbering: unknown placeholder %s in pattern %q", token, pattern)
+		}
+	}
+	if seqs != 1 {
+		return fmt.Errorf("numbering: pattern %q does not have one {seq} placeholder", pattern)
+	}
+	if years == 0 {
+		return fmt.Errorf("numbering: pattern %q does not have a {YYYY} or {YY} placeholder", pattern)
+	}
+	return nil
+}
+
+// Format - the document number of a pattern for the number of a fiscal
+// year. A number with more digits than the width of {seq:N} is not cut.
+func Format(pattern string, fiscalYear int, number uint32) (string, error) {
+	if err := ValidatePattern(pattern); err != nil {
+		return "", err
+	}
+	return patternToken.ReplaceAllStringFunc(pattern, func(token string) string {
+		switch token {
+		case "{YYYY}":
+			return fmt.Sprintf("%04d", fiscalYear)
+		case "{YY}":
+			return fmt.Sprintf("%02d", fiscalYear%100)
+		}
+		width, _ := strconv.Atoi(seqToken.FindStringSubmatch(token)[1])
+		return fmt.Sprintf("%0*d", width, number)
+	}), nil
+}
+
+// Parse - the fiscal year and the number of a document number of a
+// pattern, the reverse of Format. A year of {YY} is taken as in the 2000s.
+func Parse(pattern string, documentNumber string) (int, uint32, error) {
+	if err := ValidatePattern(pattern); err != nil {
+		return 0, 0, err
+	}
+	var expr strings.Builder
+	var groups []string
+	expr.WriteString("^")
+	last := 0
+	for _, loc := range patternToken.FindAllStringIndex(pattern, -1) {
+		expr.WriteString(regexp.QuoteMeta(pattern[last:loc[0]]))
+		token := pattern[loc[0]:loc[1]]
+		switch token {
+		case "{YYYY}":
+			expr.WriteString(`([0-9]{4})`)
+		case "{YY}":
+			expr.WriteString(`([0-9]{2})`)
+		default:
+			width := seqToken.FindStringSubmatch(token)[1]
+			if width == "" {
+				width = "1"
+			}
+			expr.WriteString(`([0-9]{` + width + `,})`)
+			token = "{seq}"
+		}
+		groups = append(groups, token)
+		last = loc[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(pattern[last:]) + "$")
+
+	match := regexp.MustCompile(expr.String()).FindStringSubmatch(documentNumber)
+	if match == nil {
+		return 0, 0, fmt.Errorf("numbering: document number %q does not match pattern %q", documentNumber, pattern)
+	}
+	fiscalYear := -1
+	var number uint32
+	for i, token := range groups {
+		value := match[i+1]
+		year := 0
+		switch token {
+		case "{YYYY}":
+			year, _ = strconv.Atoi(value)
+		case "{YY}":
+			year, _ = strconv.Atoi(value)
+			year += 2000
+		default:
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return 0, 0, fmt.Errorf("numbering: document number %q: %w", documentNumber, err)
+			}
+			number = uint32(n)
+			continue
+		}
+		if fiscalYear != -1 && fiscalYear != year {
+			return 0, 0, fmt.Errorf("numbering: document number %q has two years", documentNumber)
+		}
+		fiscalYear = year
+	}
+	return fiscalYear, number, nil
+}
+
+// FiscalYear - the fiscal year of a date, for fiscal years starting on the
+// first of the month startMonth, 1 to 12, 0 taken as 1. A fiscal year is
+// the calendar year it starts in.
+func FiscalYear(date time.Time, startMonth uint32) int {
+	if startMonth == 0 {
+		startMonth = 1
+	}
+	if uint32(date.Month()) < startMonth {
+		return date.Year() - 1
+	}
+	return date.Year()
+}
+
+// ValidateStartMonth - an error when the start month of fiscal years is not
+// 1 to 12, or 0 for January
+func ValidateStartMonth(startMonth uint32) error {
+	if startMonth > 12 {
+		return fmt.Errorf("numbering: fiscal year start month %d is not 1 to 12", startMonth)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package numbering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePattern(t *testing.T) {
+	for _, pattern := range []string{"INV-{YYYY}-{seq:6}", "{YY}/{seq}", "CN{YYYY}{seq:4}"} {
+		assert.NoError(t, ValidatePattern(pattern), pattern)
+	}
+	for _, pattern := range []string{"INV-{seq:6}", "INV-{YYYY}", "{YYYY}-{seq}-{seq}", "{YYYY}-{seq:0}", "{YYYY}-{MM}-{seq}"} {
+		assert.Error(t, ValidatePattern(pattern), pattern)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		fiscalYear int
+		number     uint32
+		want       string
+	}{
+		{"INV-{YYYY}-{seq:6}", 2026, 1, "INV-2026-000001"},
+		{"INV-{YYYY}-{seq:6}", 2026, 1234567, "INV-2026-1234567"},
+		{"{YY}/{seq}", 2026, 42, "26/42"},
+		{"PO.{YYYY}.{seq:3}", 2009, 7, "PO.2009.007"},
+	}
+	for _, tt := range tests {
+		got, err := Format(tt.pattern, tt.fiscalYear, tt.number)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+
+		fiscalYear, number, err := Parse(tt.pattern, got)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.fiscalYear, fiscalYear)
+		assert.Equal(t, tt.number, number)
+	}
+
+	_, err := Format("INV-{seq:6}", 2026, 1)
+	assert.Error(t, err)
+}
+
+func TestParse(t *testing.T) {
+	for _, documentNumber := range []string{"INV-2026-00001", "INV-2026-000001-A", "CN-2026-000001", "INV-26-000001"} {
+		_, _, err := Parse("INV-{YYYY}-{seq:6}", documentNumber)
+		assert.Error(t, err, documentNumber)
+	}
+	_, _, err := Parse("{YYYY}-{YY}-{seq}", "2026-25-1")
+	assert.Error(t, err)
+}
+
+func TestFiscalYear(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	assert.Equal(t, 2026, FiscalYear(date("2026-01-01"), 0))
+	assert.Equal(t, 2026, FiscalYear(date("2026-12-31"), 1))
+	assert.Equal(t, 2025, FiscalYear(date("2026-03-31"), 4))
+	assert.Equal(t, 2026, FiscalYear(date("2026-04-01"), 4))
+	assert.Error(t, ValidateStartMonth(13))
+}
+
+func TestDocumentOf(t *testing.T) {
+	doc, err := DocumentOf("credit_note")
+	assert.NoError(t, err)
+	assert.Equal(t, "cnh_id", doc.IDColumn)
+	_, err = DocumentOf("quotation")
+	assert.Error(t, err)
+}
+
+func TestUsedError(t *testing.T) {
+	dup := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry '7-INV-2026-000001' for key 'invoice_headers.invoice_headers_party_number'"}
+	err := UsedError(Invoice, dup, "INV-2026-000001")
+	assert.ErrorIs(t, err, ErrNumberUsed)
+	assert.Contains(t, err.Error(), "INV-2026-000001")
+
+	other := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'PRIMARY'"}
+	assert.Equal(t, error(other), UsedError(Invoice, other, "INV-2026-000001"), "a duplicate of another key is not a used number")
+	assert.Equal(t, error(dup), UsedError(CreditNote, dup, "INV-2026-000001"), "the index of another document type")
+	assert.Nil(t, UsedError(Invoice, nil, "INV-2026-000001"))
+}
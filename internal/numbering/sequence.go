@@ -0,0 +1,200 @@
+package numbering
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Sequence - the numbering of a document type of a party
+type Sequence struct {
+	ID                   uint32
+	Pattern              string
+	FiscalYearStartMonth uint32
+}
+
+// lockSequenceSQL - the active sequence of a party and document type,
+// locked until the end of the transaction, so that the numbers of a
+// sequence are allocated one at a time
+const lockSequenceSQL = `select id, pattern, fiscal_year_start_month from document_number_sequences where party_id = ? and document_type = ? and status_code = ? for update;`
+
+const selectLastNumberSQL = `select last_number from document_number_counters where sequence_id = ? and fiscal_year = ? for update;`
+
+const insertCounterSQL = `insert into document_number_counters
+  (sequence_id,
+  fiscal_year,
+  last_number,
+  created_at,
+  updated_at)
+  values (?, ?, ?, ?, ?);`
+
+const updateCounterSQL = `update document_number_counters set
+  last_number = ?,
+  updated_at = ? where sequence_id = ? and fiscal_year = ?;`
+
+// selectVoidedNumberSQL - a voided number of a party and document type
+const selectVoidedNumberSQL = `select count(*) from voided_document_numbers where party_id = ? and document_type = ? and document_number = ?;`
+
+// LockSequence - the active Sequence of a document type of a party, locked
+// in tx, sql.ErrNoRows when there is none
+func LockSequence(ctx context.Context, tx *sqlx.Tx, doc Document, partyID uint32) (*Sequence, error) {
+	seq := Sequence{}
+	err := tx.QueryRowxContext(ctx, lockSequenceSQL, partyID, doc.Type, "active").Scan(&seq.ID, &seq.Pattern, &seq.FiscalYearStartMonth)
+	if err != nil {
+		return nil, err
+	}
+	return &seq, nil
+}
+
+// SetLastNumber - set the last allocated number of a fiscal year of a
+// sequence locked in tx
+func SetLastNumber(ctx context.Context, tx *sqlx.Tx, seq *Sequence, fiscalYear int, lastNumber uint32) error {
+	tn := time.Now().UTC().Truncate(time.Second)
+	_, err := LastNumber(ctx, tx, seq, fiscalYear)
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = tx.ExecContext(ctx, insertCounterSQL, seq.ID, fiscalYear, lastNumber, tn, tn)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, updateCounterSQL, lastNumber, tn, seq.ID, fiscalYear)
+	return err
+}
+
+// LastNumber - the last allocated number of a fiscal year of a sequence
+// locked in tx, sql.ErrNoRows before the first one
+func LastNumber(ctx context.Context, tx *sqlx.Tx, seq *Sequence, fiscalYear int) (uint32, error) {
+	var lastNumber uint32
+	err := tx.QueryRowxContext(ctx, selectLastNumberSQL, seq.ID, fiscalYear).Scan(&lastNumber)
+	if err != nil {
+		return 0, err
+	}
+	return lastNumber, nil
+}
+
+// Next - allocate in tx the next number of the fiscal year of date of a
+// sequence locked in tx
+func Next(ctx context.Context, tx *sqlx.Tx, seq *Sequence, date time.Time) (string, error) {
+	fiscalYear := FiscalYear(date, seq.FiscalYearStartMonth)
+	lastNumber, err := LastNumber(ctx, tx, seq, fiscalYear)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	documentNumber, err := Format(seq.Pattern, fiscalYear, lastNumber+1)
+	if err != nil {
+		return "", err
+	}
+	err = SetLastNumber(ctx, tx, seq, fiscalYear, lastNumber+1)
+	if err != nil {
+		return "", err
+	}
+	return documentNumber, nil
+}
+
+// Check - an error when a new document of a party cannot have the number
+// of its own documentNumber, in the transaction that inserts it: a party
+// with a sequence has its documents numbered by it, ErrSequenceNumbered,
+// and a number used by an active document of the party or voided is
+// refused with ErrNumberUsed. A document without a number of its own is
+// numbered by Assign.
+func Check(ctx context.Context, tx *sqlx.Tx, doc Document, partyID uint32, documentNumber string) error {
+	if documentNumber == "" {
+		return nil
+	}
+	_, err := LockSequence(ctx, tx, doc, partyID)
+	if err == nil {
+		return fmt.Errorf("%w: %s", ErrSequenceNumbered, documentNumber)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	used, err := IsUsed(ctx, tx, doc, partyID, documentNumber)
+	if err != nil {
+		return err
+	}
+	if used {
+		return fmt.Errorf("%w: %s", ErrNumberUsed, documentNumber)
+	}
+	return nil
+}
+
+// Assign - the number of a document of a party dated date, in the
+// transaction that issues it, or inserts it for a document that is not
+// issued. A document with a number of its own, checked with Check, keeps
+// it. Otherwise the next number of the sequence of the party is allocated,
+// if it has one, passing over the numbers used by documents numbered before
+// the sequence was set up, so that they cannot block the sequence.
+func Assign(ctx context.Context, tx *sqlx.Tx, doc Document, partyID uint32, documentNumber string, date time.Time) (string, error) {
+	if documentNumber != "" {
+		return documentNumber, nil
+	}
+	seq, err := LockSequence(ctx, tx, doc, partyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	for {
+		documentNumber, err = Next(ctx, tx, seq, date)
+		if err != nil {
+			return "", err
+		}
+		used, err := IsUsed(ctx, tx, doc, partyID, documentNumber)
+		if err != nil {
+			return "", err
+		}
+		if !used {
+			return documentNumber, nil
+		}
+	}
+}
+
+// IsUsed - whether a number is used by an active document of a party or
+// voided
+func IsUsed(ctx context.Context, tx *sqlx.Tx, doc Document, partyID uint32, documentNumber string) (bool, error) {
+	selectUsedSQL := `select count(*) from ` + doc.Table + ` where ` + doc.IDColumn + ` = ? and ` + doc.PartyColumn + ` = ? and status_code = ?;`
+	var count int
+	err := tx.QueryRowxContext(ctx, selectUsedSQL, documentNumber, partyID, "active").Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return true, nil
+	}
+	err = tx.QueryRowxContext(ctx, selectVoidedNumberSQL, partyID, doc.Type, documentNumber).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CheckVoidable - an error when a number is not allocated from a sequence
+// locked in tx, or is used by an active document of the party or voided.
+// It returns the fiscal year of the number.
+func CheckVoidable(ctx context.Context, tx *sqlx.Tx, doc Document, partyID uint32, seq *Sequence, documentNumber string) (int, error) {
+	fiscalYear, number, err := Parse(seq.Pattern, documentNumber)
+	if err != nil {
+		return 0, err
+	}
+	lastNumber, err := LastNumber(ctx, tx, seq, fiscalYear)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	if number == 0 || number > lastNumber {
+		return 0, fmt.Errorf("%w: %s", ErrNotAllocated, documentNumber)
+	}
+	used, err := IsUsed(ctx, tx, doc, partyID, documentNumber)
+	if err != nil {
+		return 0, err
+	}
+	if used {
+		return 0, fmt.Errorf("%w: %s", ErrNumberUsed, documentNumber)
+	}
+	return fiscalYear, nil
+}
@@ -0,0 +1,165 @@
+// Package peppol checks UBL documents built with the PEPPOL BIS Billing 3.0
+// profile against the PEPPOL-EN16931-R* rules PEPPOL adds to EN 16931, the
+// electronic address scheme code list (PEPPOL-EN16931-CL008) and the
+// allowed document type codes (PEPPOL-EN16931-P0100, P0101).
+//
+// The core EN 16931 rules are kept by the invoice services when a document
+// is created and are not checked again here. Violations are located by the
+// XPath of the offending element.
+package peppol
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+)
+
+// profileIDPattern - business process identifiers of PEPPOL BIS Billing
+var profileIDPattern = regexp.MustCompile(`^urn:fdc:peppol\.eu:2017:poacc:billing:[0-9]{2}:1\.0$`)
+
+// invoiceTypeCodes - the UNCL1001 codes PEPPOL allows as invoice type code
+var invoiceTypeCodes = codeList(`71 80 82 84 102 130 202 203 204 211 218 219 295 325 326 331 380 382 383
+	384 385 386 387 388 389 390 393 394 395 456 457 527 553 575 623 633 751 780 817 870 875 876 877 935`)
+
+// creditNoteTypeCodes - the UNCL1001 codes PEPPOL allows as credit note
+// type code
+var creditNoteTypeCodes = codeList(`81 83 261 262 296 308 381 396 420 458 532`)
+
+func codeList(codes string) map[string]bool {
+	m := make(map[string]bool)
+	for _, code := range strings.Fields(codes) {
+		m[code] = true
+	}
+	return m
+}
+
+// document - the parts of an Invoice or CreditNote the rules read
+type document struct {
+	root            string
+	customizationID string
+	profileID       string
+	typeCode        string
+	typeCodeElement string
+	typeCodes       map[string]bool
+	typeCodeRule    string
+	notes           []string
+	currencyCode    string
+	taxCurrencyCode string
+	buyerReference  string
+	orderReference  *ubl.OrderReference
+	invoicePeriod   *ubl.Period
+	seller          *ubl.Party
+	buyer           *ubl.Party
+	taxTotals       []ubl.TaxTotal
+	lineElement     string
+	quantityElement string
+	lines           []line
+}
+
+// line - the parts of an invoice or credit note line the rules read
+type line struct {
+	quantity            *ubl.Quantity
+	lineExtensionAmount *ubl.Amount
+	invoicePeriod       *ubl.Period
+	price               *ubl.Price
+	allowanceCharges    []ubl.AllowanceCharge
+}
+
+// ValidateInvoice - check a PEPPOL BIS Billing 3.0 Invoice, nil when it
+// keeps all the rules, en16931.Violations otherwise
+func ValidateInvoice(inv *ubl.Invoice) error {
+	doc := document{
+		root:            "/Invoice",
+		customizationID: inv.CustomizationID,
+		profileID:       inv.ProfileID,
+		typeCode:        inv.InvoiceTypeCode,
+		typeCodeElement: "cbc:InvoiceTypeCode",
+		typeCodes:       invoiceTypeCodes,
+		typeCodeRule:    "PEPPOL-EN16931-P0100",
+		notes:           inv.Note,
+		currencyCode:    inv.DocumentCurrencyCode,
+		taxCurrencyCode: inv.TaxCurrencyCode,
+		buyerReference:  inv.BuyerReference,
+		orderReference:  inv.OrderReference,
+		invoicePeriod:   inv.InvoicePeriod,
+		taxTotals:       inv.TaxTotal,
+		lineElement:     "cac:InvoiceLine",
+		quantityElement: "cbc:InvoicedQuantity",
+	}
+	if inv.AccountingSupplierParty != nil {
+		doc.seller = inv.AccountingSupplierParty.Party
+	}
+	if inv.AccountingCustomerParty != nil {
+		doc.buyer = inv.AccountingCustomerParty.Party
+	}
+	for _, il := range inv.InvoiceLine {
+		doc.lines = append(doc.lines, line{quantity: il.InvoicedQuantity, lineExtensionAmount: il.LineExtensionAmount, invoicePeriod: il.InvoicePeriod, price: il.Price, allowanceCharges: il.AllowanceCharge})
+	}
+	return doc.validate()
+}
+
+// ValidateCreditNote - check a PEPPOL BIS Billing 3.0 CreditNote, nil when
+// it keeps all the rules, en16931.Violations otherwise
+func ValidateCreditNote(cn *ubl.CreditNote) error {
+	doc := document{
+		root:            "/CreditNote",
+		customizationID: cn.CustomizationID,
+		profileID:       cn.ProfileID,
+		typeCode:        cn.CreditNoteTypeCode,
+		typeCodeElement: "cbc:CreditNoteTypeCode",
+		typeCodes:       creditNoteTypeCodes,
+		typeCodeRule:    "PEPPOL-EN16931-P0101",
+		notes:           cn.Note,
+		currencyCode:    cn.DocumentCurrencyCode,
+		taxCurrencyCode: cn.TaxCurrencyCode,
+		buyerReference:  cn.BuyerReference,
+		orderReference:  cn.OrderReference,
+		invoicePeriod:   cn.InvoicePeriod,
+		taxTotals:       cn.TaxTotal,
+		lineElement:     "cac:CreditNoteLine",
+		quantityElement: "cbc:CreditedQuantity",
+	}
+	if cn.AccountingSupplierParty != nil {
+		doc.seller = cn.AccountingSupplierParty.Party
+	}
+	if cn.AccountingCustomerParty != nil {
+		doc.buyer = cn.AccountingCustomerParty.Party
+	}
+	for _, cl := range cn.CreditNoteLine {
+		doc.lines = append(doc.lines, line{quantity: cl.CreditedQuantity, lineExtensionAmount: cl.LineExtensionAmount, invoicePeriod: cl.InvoicePeriod, price: cl.Price, allowanceCharges: cl.AllowanceCharge})
+	}
+	return doc.validate()
+}
+
+func (doc *document) validate() error {
+	c := checker{doc: doc}
+	c.checkDocument()
+	c.checkParties()
+	c.checkTaxTotals()
+	c.checkLines()
+	if len(c.violations) == 0 {
+		return nil
+	}
+	return c.violations
+}
+
+// checker - collects the violations of one document
+type checker struct {
+	doc        *document
+	violations en16931.Violations
+}
+
+func (c *checker) fail(rule string, path string, format string, args ...interface{}) {
+	c.violations = append(c.violations, &en16931.Violation{Rule: rule, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) path(elements ...string) string {
+	return c.doc.root + "/" + strings.Join(elements, "/")
+}
+
+func (c *checker) linePath(i int, elements ...string) string {
+	return c.path(append([]string{fmt.Sprintf("%s[%d]", c.doc.lineElement, i+1)}, elements...)...)
+}
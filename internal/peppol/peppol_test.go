@@ -0,0 +1,233 @@
+package peppol
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/stretchr/testify/assert"
+)
+
+func testParty(endpointID string, schemeID string) *ubl.Party {
+	return &ubl.Party{EndpointID: &ubl.Identifier{Value: endpointID, SchemeID: schemeID}}
+}
+
+// testInvoice - an Invoice with one line that keeps the PEPPOL rules
+func testInvoice() *ubl.Invoice {
+	return &ubl.Invoice{
+		CustomizationID:         ubl.PeppolCustomizationID,
+		ProfileID:               ubl.PeppolProfileID,
+		ID:                      "Snippet1",
+		IssueDate:               "2017-11-13",
+		InvoiceTypeCode:         "380",
+		Note:                    []string{"Please note our new phone number 33 44 55 66"},
+		DocumentCurrencyCode:    "EUR",
+		BuyerReference:          "0150abc",
+		InvoicePeriod:           &ubl.Period{StartDate: "2017-11-01", EndDate: "2017-11-30"},
+		AccountingSupplierParty: &ubl.SupplierParty{Party: testParty("9482348239847239874", "0088")},
+		AccountingCustomerParty: &ubl.CustomerParty{Party: testParty("FR23342", "0002")},
+		TaxTotal: []ubl.TaxTotal{{
+			TaxAmount:   ubl.NewAmount("1225", "EUR"),
+			TaxSubtotal: []ubl.TaxSubtotal{{TaxableAmount: ubl.NewAmount("4900", "EUR"), TaxAmount: ubl.NewAmount("1225", "EUR")}},
+		}},
+		InvoiceLine: []ubl.InvoiceLine{{
+			ID:                  "1",
+			InvoicedQuantity:    &ubl.Quantity{Value: "7", UnitCode: "DAY"},
+			LineExtensionAmount: ubl.NewAmount("2800", "EUR"),
+			InvoicePeriod:       &ubl.Period{StartDate: "2017-11-01", EndDate: "2017-11-07"},
+			Price:               &ubl.Price{PriceAmount: ubl.NewAmount("400", "EUR")},
+		}},
+	}
+}
+
+// rules - the rule of each violation in err
+func rules(err error) []string {
+	var violations en16931.Violations
+	if !errors.As(err, &violations) {
+		return nil
+	}
+	ids := []string{}
+	for _, v := range violations {
+		ids = append(ids, v.Rule)
+	}
+	return ids
+}
+
+func TestValidateInvoice(t *testing.T) {
+	assert.NoError(t, ValidateInvoice(testInvoice()))
+}
+
+func TestValidateInvoiceViolations(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(inv *ubl.Invoice)
+		rules  []string
+	}{
+		{
+			name:   "no business process",
+			change: func(inv *ubl.Invoice) { inv.ProfileID = "" },
+			rules:  []string{"PEPPOL-EN16931-R001"},
+		},
+		{
+			name:   "business process in another format",
+			change: func(inv *ubl.Invoice) { inv.ProfileID = "billing" },
+			rules:  []string{"PEPPOL-EN16931-R007"},
+		},
+		{
+			name:   "plain EN 16931 specification identifier",
+			change: func(inv *ubl.Invoice) { inv.CustomizationID = "urn:cen.eu:en16931:2017" },
+			rules:  []string{"PEPPOL-EN16931-R004"},
+		},
+		{
+			name:   "two notes",
+			change: func(inv *ubl.Invoice) { inv.Note = append(inv.Note, "Second note") },
+			rules:  []string{"PEPPOL-EN16931-R002"},
+		},
+		{
+			name:   "no buyer reference",
+			change: func(inv *ubl.Invoice) { inv.BuyerReference = "" },
+			rules:  []string{"PEPPOL-EN16931-R003"},
+		},
+		{
+			name:   "tax currency equal to the invoice currency",
+			change: func(inv *ubl.Invoice) { inv.TaxCurrencyCode = "EUR" },
+			rules:  []string{"PEPPOL-EN16931-R005", "PEPPOL-EN16931-R054"},
+		},
+		{
+			name:   "type code outside the PEPPOL list",
+			change: func(inv *ubl.Invoice) { inv.InvoiceTypeCode = "SalesInvoice" },
+			rules:  []string{"PEPPOL-EN16931-P0100"},
+		},
+		{
+			name:   "seller without electronic address",
+			change: func(inv *ubl.Invoice) { inv.AccountingSupplierParty.Party.EndpointID = nil },
+			rules:  []string{"PEPPOL-EN16931-R020"},
+		},
+		{
+			name:   "buyer without party",
+			change: func(inv *ubl.Invoice) { inv.AccountingCustomerParty.Party = nil },
+			rules:  []string{"PEPPOL-EN16931-R010"},
+		},
+		{
+			name:   "electronic address scheme outside the EAS list",
+			change: func(inv *ubl.Invoice) { inv.AccountingCustomerParty.Party.EndpointID.SchemeID = "GLN" },
+			rules:  []string{"PEPPOL-EN16931-CL008"},
+		},
+		{
+			name: "two tax totals with subtotals",
+			change: func(inv *ubl.Invoice) {
+				inv.TaxTotal = append(inv.TaxTotal, inv.TaxTotal[0])
+			},
+			rules: []string{"PEPPOL-EN16931-R053"},
+		},
+		{
+			name: "tax total in the tax currency",
+			change: func(inv *ubl.Invoice) {
+				inv.TaxCurrencyCode = "SEK"
+				inv.TaxTotal = append(inv.TaxTotal, ubl.TaxTotal{TaxAmount: ubl.NewAmount("13000", "SEK")})
+			},
+			rules: []string{},
+		},
+		{
+			name:   "line period before the invoice period",
+			change: func(inv *ubl.Invoice) { inv.InvoiceLine[0].InvoicePeriod.StartDate = "2017-10-31" },
+			rules:  []string{"PEPPOL-EN16931-R110"},
+		},
+		{
+			name:   "line period after the invoice period",
+			change: func(inv *ubl.Invoice) { inv.InvoiceLine[0].InvoicePeriod.EndDate = "2017-12-01" },
+			rules:  []string{"PEPPOL-EN16931-R111"},
+		},
+		{
+			name:   "line amount does not add up",
+			change: func(inv *ubl.Invoice) { inv.InvoiceLine[0].LineExtensionAmount = ubl.NewAmount("2800.03", "EUR") },
+			rules:  []string{"PEPPOL-EN16931-R120"},
+		},
+		{
+			name: "line allowances and charges",
+			change: func(inv *ubl.Invoice) {
+				inv.InvoiceLine[0].LineExtensionAmount = ubl.NewAmount("2570", "EUR")
+				inv.InvoiceLine[0].AllowanceCharge = []ubl.AllowanceCharge{
+					{AllowanceChargeReasonCode: "95", Amount: ubl.NewAmount("280", "EUR")},
+					{ChargeIndicator: true, AllowanceChargeReasonCode: "FC", Amount: ubl.NewAmount("50", "EUR")},
+				}
+			},
+			rules: []string{},
+		},
+		{
+			name: "price per base quantity",
+			change: func(inv *ubl.Invoice) {
+				inv.InvoiceLine[0].Price = &ubl.Price{PriceAmount: ubl.NewAmount("800", "EUR"), BaseQuantity: &ubl.Quantity{Value: "2", UnitCode: "DAY"}}
+			},
+			rules: []string{},
+		},
+		{
+			name: "zero base quantity",
+			change: func(inv *ubl.Invoice) {
+				inv.InvoiceLine[0].Price.BaseQuantity = &ubl.Quantity{Value: "0"}
+			},
+			rules: []string{"PEPPOL-EN16931-R121"},
+		},
+		{
+			name: "base quantity in another unit",
+			change: func(inv *ubl.Invoice) {
+				inv.InvoiceLine[0].Price.BaseQuantity = &ubl.Quantity{Value: "1", UnitCode: "HUR"}
+			},
+			rules: []string{"PEPPOL-EN16931-R130"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := testInvoice()
+			tt.change(inv)
+			got := rules(ValidateInvoice(inv))
+			if len(tt.rules) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.rules, got)
+		})
+	}
+}
+
+func TestValidateInvoicePaths(t *testing.T) {
+	inv := testInvoice()
+	inv.AccountingSupplierParty.Party.EndpointID = nil
+	inv.InvoiceLine[0].LineExtensionAmount = ubl.NewAmount("100", "EUR")
+
+	var violations en16931.Violations
+	assert.True(t, errors.As(ValidateInvoice(inv), &violations))
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "/Invoice/cac:AccountingSupplierParty/cac:Party/cbc:EndpointID", violations[0].Path)
+	assert.Equal(t, "/Invoice/cac:InvoiceLine[1]/cbc:LineExtensionAmount", violations[1].Path)
+}
+
+func TestValidateCreditNote(t *testing.T) {
+	inv := testInvoice()
+	cn := &ubl.CreditNote{
+		CustomizationID:         inv.CustomizationID,
+		ProfileID:               inv.ProfileID,
+		ID:                      "CN-1",
+		IssueDate:               inv.IssueDate,
+		CreditNoteTypeCode:      "381",
+		DocumentCurrencyCode:    inv.DocumentCurrencyCode,
+		OrderReference:          &ubl.OrderReference{ID: "PO-1"},
+		AccountingSupplierParty: inv.AccountingSupplierParty,
+		AccountingCustomerParty: inv.AccountingCustomerParty,
+		TaxTotal:                inv.TaxTotal,
+		CreditNoteLine: []ubl.CreditNoteLine{{
+			ID:                  "1",
+			CreditedQuantity:    &ubl.Quantity{Value: "2"},
+			LineExtensionAmount: ubl.NewAmount("800", "EUR"),
+			Price:               &ubl.Price{PriceAmount: ubl.NewAmount("400", "EUR")},
+		}},
+	}
+	assert.NoError(t, ValidateCreditNote(cn))
+
+	cn.CreditNoteTypeCode = "380"
+	cn.CreditNoteLine[0].LineExtensionAmount = ubl.NewAmount("400", "EUR")
+	err := ValidateCreditNote(cn)
+	assert.Equal(t, []string{"PEPPOL-EN16931-P0101", "PEPPOL-EN16931-R120"}, rules(err))
+	assert.Contains(t, err.Error(), "/CreditNote/cac:CreditNoteLine[1]/cbc:LineExtensionAmount")
+}
@@ -0,0 +1,142 @@
+package peppol
+
+import (
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+)
+
+// lineAmountSlack - the difference PEPPOL-EN16931-R120 allows between the
+// line net amount and its calculation
+var lineAmountSlack = money.New(2, 2)
+
+// checkDocument - PEPPOL-EN16931-R001 to R005, R007 and P0100/P0101
+func (c *checker) checkDocument() {
+	doc := c.doc
+	if doc.profileID == "" {
+		c.fail("PEPPOL-EN16931-R001", c.path("cbc:ProfileID"), "business process MUST be provided")
+	} else if !profileIDPattern.MatchString(doc.profileID) {
+		c.fail("PEPPOL-EN16931-R007", c.path("cbc:ProfileID"), "business process %q MUST be in the format 'urn:fdc:peppol.eu:2017:poacc:billing:NN:1.0'", doc.profileID)
+	}
+	if doc.customizationID != ubl.PeppolCustomizationID {
+		c.fail("PEPPOL-EN16931-R004", c.path("cbc:CustomizationID"), "specification identifier MUST have the value '%s'", ubl.PeppolCustomizationID)
+	}
+	if len(doc.notes) > 1 {
+		c.fail("PEPPOL-EN16931-R002", c.path("cbc:Note"), "no more than one note is allowed on document level")
+	}
+	if doc.buyerReference == "" && (doc.orderReference == nil || doc.orderReference.ID == "") {
+		c.fail("PEPPOL-EN16931-R003", c.path("cbc:BuyerReference"), "a buyer reference or purchase order reference MUST be provided")
+	}
+	if doc.taxCurrencyCode != "" && doc.taxCurrencyCode == doc.currencyCode {
+		c.fail("PEPPOL-EN16931-R005", c.path("cbc:TaxCurrencyCode"), "VAT accounting currency code MUST be different from invoice currency code when provided")
+	}
+	if !doc.typeCodes[doc.typeCode] {
+		c.fail(doc.typeCodeRule, c.path(doc.typeCodeElement), "type code %q is not allowed by PEPPOL BIS Billing 3.0", doc.typeCode)
+	}
+}
+
+// checkParties - PEPPOL-EN16931-R010, R020 and CL008
+func (c *checker) checkParties() {
+	c.checkEndpoint(c.doc.seller, "cac:AccountingSupplierParty", "PEPPOL-EN16931-R020", "seller")
+	c.checkEndpoint(c.doc.buyer, "cac:AccountingCustomerParty", "PEPPOL-EN16931-R010", "buyer")
+}
+
+func (c *checker) checkEndpoint(p *ubl.Party, element string, rule string, role string) {
+	path := c.path(element, "cac:Party", "cbc:EndpointID")
+	if p == nil || p.EndpointID == nil || p.EndpointID.Value == "" {
+		c.fail(rule, path, "%s electronic address MUST be provided", role)
+		return
+	}
+	if !ubl.IsEASCode(p.EndpointID.SchemeID) {
+		c.fail("PEPPOL-EN16931-CL008", path+"/@schemeID", "electronic address scheme %q MUST be from the Electronic Address Scheme (EAS) code list", p.EndpointID.SchemeID)
+	}
+}
+
+// checkTaxTotals - PEPPOL-EN16931-R053 and R054
+func (c *checker) checkTaxTotals() {
+	withSubtotals, withoutSubtotals := 0, 0
+	for _, tt := range c.doc.taxTotals {
+		if len(tt.TaxSubtotal) > 0 {
+			withSubtotals++
+		} else {
+			withoutSubtotals++
+		}
+	}
+	if withSubtotals > 1 {
+		c.fail("PEPPOL-EN16931-R053", c.path("cac:TaxTotal"), "only one tax total with tax subtotals MUST be provided")
+	}
+	want := 0
+	if c.doc.taxCurrencyCode != "" {
+		want = 1
+	}
+	if withoutSubtotals != want {
+		c.fail("PEPPOL-EN16931-R054", c.path("cac:TaxTotal"), "only one tax total without tax subtotals MUST be provided when tax currency code is provided, none otherwise")
+	}
+}
+
+// checkLines - PEPPOL-EN16931-R110, R111, R120, R121 and R130
+func (c *checker) checkLines() {
+	period := c.doc.invoicePeriod
+	for i, l := range c.doc.lines {
+		if period != nil && l.invoicePeriod != nil {
+			if period.StartDate != "" && l.invoicePeriod.StartDate != "" && l.invoicePeriod.StartDate < period.StartDate {
+				c.fail("PEPPOL-EN16931-R110", c.linePath(i, "cac:InvoicePeriod", "cbc:StartDate"), "start date of line period MUST be within invoice period")
+			}
+			if period.EndDate != "" && l.invoicePeriod.EndDate != "" && l.invoicePeriod.EndDate > period.EndDate {
+				c.fail("PEPPOL-EN16931-R111", c.linePath(i, "cac:InvoicePeriod", "cbc:EndDate"), "end date of line period MUST be within invoice period")
+			}
+		}
+		c.checkLineAmount(i, l)
+	}
+}
+
+func (c *checker) checkLineAmount(i int, l line) {
+	if l.price == nil || l.price.PriceAmount == nil || l.quantity == nil || l.lineExtensionAmount == nil {
+		return
+	}
+	baseQuantity := money.New(1, 0)
+	if bq := l.price.BaseQuantity; bq != nil {
+		v, err := money.Parse(bq.Value)
+		if err != nil {
+			return
+		}
+		if v <= money.Zero {
+			c.fail("PEPPOL-EN16931-R121", c.linePath(i, "cac:Price", "cbc:BaseQuantity"), "base quantity MUST be a positive number above zero")
+			return
+		}
+		if bq.UnitCode != "" && bq.UnitCode != l.quantity.UnitCode {
+			c.fail("PEPPOL-EN16931-R130", c.linePath(i, "cac:Price", "cbc:BaseQuantity", "@unitCode"), "unit code of price base quantity MUST be same as %s", c.doc.quantityElement)
+		}
+		baseQuantity = v
+	}
+	quantity, err := money.Parse(l.quantity.Value)
+	if err != nil {
+		return
+	}
+	priceAmount, err := money.Parse(l.price.PriceAmount.Value)
+	if err != nil {
+		return
+	}
+	lineAmount, err := money.Parse(l.lineExtensionAmount.Value)
+	if err != nil {
+		return
+	}
+	want := en16931.Round(quantity.Mul(priceAmount).Div(baseQuantity))
+	for _, ac := range l.allowanceCharges {
+		if ac.Amount == nil {
+			continue
+		}
+		amount, err := money.Parse(ac.Amount.Value)
+		if err != nil {
+			return
+		}
+		if ac.ChargeIndicator {
+			want = want.Add(amount)
+		} else {
+			want = want.Sub(amount)
+		}
+	}
+	if en16931.Round(lineAmount).Sub(want).Abs() > lineAmountSlack {
+		c.fail("PEPPOL-EN16931-R120", c.linePath(i, "cbc:LineExtensionAmount"), "line net amount %s MUST equal the quantity multiplied by the item net price divided by the price base quantity, minus the line allowances plus the line charges %s", l.lineExtensionAmount.Value, want.Text(2))
+	}
+}
@@ -184,6 +184,8 @@ type CrUpdUser struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// status_code - the record status, active until the row is deleted; the
+	// lifecycle of a document is its document_status_code
 	StatusCode      string `protobuf:"bytes,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
 	CreatedByUserId string `protobuf:"bytes,2,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
 	UpdatedByUserId string `protobuf:"bytes,3,opt,name=updated_by_user_id,json=updatedByUserId,proto3" json:"updated_by_user_id,omitempty"`
@@ -1242,30 +1244,30 @@ func (x *LocationT) GetValidityPeriodEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
-type Role struct {
+type AllowanceCharge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AllowanceChargeD *AllowanceChargeD `protobuf:"bytes,1,opt,name=allowance_charge_d,json=allowanceChargeD,proto3" json:"allowance_charge_d,omitempty"`
+	CrUpdUser        *CrUpdUser        `protobuf:"bytes,2,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime        *CrUpdTime        `protobuf:"bytes,3,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
+func (x *AllowanceCharge) Reset() {
+	*x = AllowanceCharge{}
 	mi := &file_common_v1_common_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Role) String() string {
+func (x *AllowanceCharge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*AllowanceCharge) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
+func (x *AllowanceCharge) ProtoReflect() protoreflect.Message {
 	mi := &file_common_v1_common_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1277,60 +1279,71 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllowanceCharge.ProtoReflect.Descriptor instead.
+func (*AllowanceCharge) Descriptor() ([]byte, []int) {
 	return file_common_v1_common_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Role) GetId() string {
+func (x *AllowanceCharge) GetAllowanceChargeD() *AllowanceChargeD {
 	if x != nil {
-		return x.Id
+		return x.AllowanceChargeD
 	}
-	return ""
+	return nil
 }
 
-func (x *Role) GetName() string {
+func (x *AllowanceCharge) GetCrUpdUser() *CrUpdUser {
 	if x != nil {
-		return x.Name
+		return x.CrUpdUser
 	}
-	return ""
+	return nil
 }
 
-func (x *Role) GetDescription() string {
+func (x *AllowanceCharge) GetCrUpdTime() *CrUpdTime {
 	if x != nil {
-		return x.Description
+		return x.CrUpdTime
 	}
-	return ""
+	return nil
 }
 
-type CreateRole struct {
+type AllowanceChargeD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserId         string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-}
-
-func (x *CreateRole) Reset() {
-	*x = CreateRole{}
+	Id                        uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid4                     []byte  `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
+	IdS                       string  `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
+	AcId                      string  `protobuf:"bytes,4,opt,name=ac_id,json=acId,proto3" json:"ac_id,omitempty"`
+	ChargeIndicator           bool    `protobuf:"varint,5,opt,name=charge_indicator,json=chargeIndicator,proto3" json:"charge_indicator,omitempty"`
+	AllowanceChargeReasonCode string  `protobuf:"bytes,6,opt,name=allowance_charge_reason_code,json=allowanceChargeReasonCode,proto3" json:"allowance_charge_reason_code,omitempty"`
+	AllowanceChargeReason     string  `protobuf:"bytes,7,opt,name=allowance_charge_reason,json=allowanceChargeReason,proto3" json:"allowance_charge_reason,omitempty"`
+	MultiplierFactorNumeric   float64 `protobuf:"fixed64,8,opt,name=multiplier_factor_numeric,json=multiplierFactorNumeric,proto3" json:"multiplier_factor_numeric,omitempty"`
+	PrepaidIndicator          bool    `protobuf:"varint,9,opt,name=prepaid_indicator,json=prepaidIndicator,proto3" json:"prepaid_indicator,omitempty"`
+	SequenceNumeric           uint32  `protobuf:"varint,10,opt,name=sequence_numeric,json=sequenceNumeric,proto3" json:"sequence_numeric,omitempty"`
+	Amount                    string  `protobuf:"bytes,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	BaseAmount                string  `protobuf:"bytes,12,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	PerUnitAmount             string  `protobuf:"bytes,13,opt,name=per_unit_amount,json=perUnitAmount,proto3" json:"per_unit_amount,omitempty"`
+	TaxCategoryId             uint32  `protobuf:"varint,14,opt,name=tax_category_id,json=taxCategoryId,proto3" json:"tax_category_id,omitempty"`
+	TaxTotalId                uint32  `protobuf:"varint,15,opt,name=tax_total_id,json=taxTotalId,proto3" json:"tax_total_id,omitempty"`
+	MasterFlag                string  `protobuf:"bytes,16,opt,name=master_flag,json=masterFlag,proto3" json:"master_flag,omitempty"`
+	MasterId                  uint32  `protobuf:"varint,17,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	CurrencyCode              string  `protobuf:"bytes,18,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+}
+
+func (x *AllowanceChargeD) Reset() {
+	*x = AllowanceChargeD{}
 	mi := &file_common_v1_common_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRole) String() string {
+func (x *AllowanceChargeD) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRole) ProtoMessage() {}
+func (*AllowanceChargeD) ProtoMessage() {}
 
-func (x *CreateRole) ProtoReflect() protoreflect.Message {
+func (x *AllowanceChargeD) ProtoReflect() protoreflect.Message {
 	mi := &file_common_v1_common_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1342,172 +1355,173 @@ func (x *CreateRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRole.ProtoReflect.Descriptor instead.
-func (*CreateRole) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllowanceChargeD.ProtoReflect.Descriptor instead.
+func (*AllowanceChargeD) Descriptor() ([]byte, []int) {
 	return file_common_v1_common_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateRole) GetName() string {
+func (x *AllowanceChargeD) GetId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateRole) GetDescription() string {
+func (x *AllowanceChargeD) GetUuid4() []byte {
 	if x != nil {
-		return x.Description
+		return x.Uuid4
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRole) GetAuth0Domain() string {
+func (x *AllowanceChargeD) GetIdS() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.IdS
 	}
 	return ""
 }
 
-func (x *CreateRole) GetAuth0MgmtToken() string {
+func (x *AllowanceChargeD) GetAcId() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.AcId
 	}
 	return ""
 }
 
-func (x *CreateRole) GetUserId() string {
+func (x *AllowanceChargeD) GetChargeIndicator() bool {
 	if x != nil {
-		return x.UserId
+		return x.ChargeIndicator
 	}
-	return ""
+	return false
 }
 
-func (x *CreateRole) GetUserEmail() string {
+func (x *AllowanceChargeD) GetAllowanceChargeReasonCode() string {
 	if x != nil {
-		return x.UserEmail
+		return x.AllowanceChargeReasonCode
 	}
 	return ""
 }
 
-func (x *CreateRole) GetRequestId() string {
+func (x *AllowanceChargeD) GetAllowanceChargeReason() string {
 	if x != nil {
-		return x.RequestId
+		return x.AllowanceChargeReason
 	}
 	return ""
 }
 
-type GetRole struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail      string `protobuf:"bytes,5,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+func (x *AllowanceChargeD) GetMultiplierFactorNumeric() float64 {
+	if x != nil {
+		return x.MultiplierFactorNumeric
+	}
+	return 0
 }
 
-func (x *GetRole) Reset() {
-	*x = GetRole{}
-	mi := &file_common_v1_common_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AllowanceChargeD) GetPrepaidIndicator() bool {
+	if x != nil {
+		return x.PrepaidIndicator
+	}
+	return false
 }
 
-func (x *GetRole) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AllowanceChargeD) GetSequenceNumeric() uint32 {
+	if x != nil {
+		return x.SequenceNumeric
+	}
+	return 0
 }
 
-func (*GetRole) ProtoMessage() {}
-
-func (x *GetRole) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[16]
+func (x *AllowanceChargeD) GetAmount() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Amount
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetRole.ProtoReflect.Descriptor instead.
-func (*GetRole) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{16}
+func (x *AllowanceChargeD) GetBaseAmount() string {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return ""
 }
 
-func (x *GetRole) GetRoleId() string {
+func (x *AllowanceChargeD) GetPerUnitAmount() string {
 	if x != nil {
-		return x.RoleId
+		return x.PerUnitAmount
 	}
 	return ""
 }
 
-func (x *GetRole) GetUserId() string {
+func (x *AllowanceChargeD) GetTaxCategoryId() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.TaxCategoryId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRole) GetAuth0Domain() string {
+func (x *AllowanceChargeD) GetTaxTotalId() uint32 {
 	if x != nil {
-		return x.Auth0Domain
+		return x.TaxTotalId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRole) GetAuth0MgmtToken() string {
+func (x *AllowanceChargeD) GetMasterFlag() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.MasterFlag
 	}
 	return ""
 }
 
-func (x *GetRole) GetUserEmail() string {
+func (x *AllowanceChargeD) GetMasterId() uint32 {
 	if x != nil {
-		return x.UserEmail
+		return x.MasterId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRole) GetRequestId() string {
+func (x *AllowanceChargeD) GetCurrencyCode() string {
 	if x != nil {
-		return x.RequestId
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-type GetRoles struct {
+type CreateAllowanceChargeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth0Domain    string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,2,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-}
-
-func (x *GetRoles) Reset() {
-	*x = GetRoles{}
-	mi := &file_common_v1_common_proto_msgTypes[17]
+	AcId                      string  `protobuf:"bytes,1,opt,name=ac_id,json=acId,proto3" json:"ac_id,omitempty"`
+	ChargeIndicator           bool    `protobuf:"varint,2,opt,name=charge_indicator,json=chargeIndicator,proto3" json:"charge_indicator,omitempty"`
+	AllowanceChargeReasonCode string  `protobuf:"bytes,3,opt,name=allowance_charge_reason_code,json=allowanceChargeReasonCode,proto3" json:"allowance_charge_reason_code,omitempty"`
+	AllowanceChargeReason     string  `protobuf:"bytes,4,opt,name=allowance_charge_reason,json=allowanceChargeReason,proto3" json:"allowance_charge_reason,omitempty"`
+	MultiplierFactorNumeric   float64 `protobuf:"fixed64,5,opt,name=multiplier_factor_numeric,json=multiplierFactorNumeric,proto3" json:"multiplier_factor_numeric,omitempty"`
+	PrepaidIndicator          bool    `protobuf:"varint,6,opt,name=prepaid_indicator,json=prepaidIndicator,proto3" json:"prepaid_indicator,omitempty"`
+	SequenceNumeric           uint32  `protobuf:"varint,7,opt,name=sequence_numeric,json=sequenceNumeric,proto3" json:"sequence_numeric,omitempty"`
+	Amount                    string  `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	BaseAmount                string  `protobuf:"bytes,9,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	PerUnitAmount             string  `protobuf:"bytes,10,opt,name=per_unit_amount,json=perUnitAmount,proto3" json:"per_unit_amount,omitempty"`
+	TaxCategoryId             uint32  `protobuf:"varint,11,opt,name=tax_category_id,json=taxCategoryId,proto3" json:"tax_category_id,omitempty"`
+	UserId                    string  `protobuf:"bytes,12,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                 string  `protobuf:"bytes,13,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                 string  `protobuf:"bytes,14,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *CreateAllowanceChargeRequest) Reset() {
+	*x = CreateAllowanceChargeRequest{}
+	mi := &file_common_v1_common_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoles) String() string {
+func (x *CreateAllowanceChargeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoles) ProtoMessage() {}
+func (*CreateAllowanceChargeRequest) ProtoMessage() {}
 
-func (x *GetRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[17]
+func (x *CreateAllowanceChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,152 +1532,134 @@ func (x *GetRoles) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoles.ProtoReflect.Descriptor instead.
-func (*GetRoles) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateAllowanceChargeRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllowanceChargeRequest) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetRoles) GetAuth0Domain() string {
+func (x *CreateAllowanceChargeRequest) GetAcId() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.AcId
 	}
 	return ""
 }
 
-func (x *GetRoles) GetAuth0MgmtToken() string {
+func (x *CreateAllowanceChargeRequest) GetChargeIndicator() bool {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.ChargeIndicator
 	}
-	return ""
+	return false
 }
 
-func (x *GetRoles) GetUserId() string {
+func (x *CreateAllowanceChargeRequest) GetAllowanceChargeReasonCode() string {
 	if x != nil {
-		return x.UserId
+		return x.AllowanceChargeReasonCode
 	}
 	return ""
 }
 
-func (x *GetRoles) GetUserEmail() string {
+func (x *CreateAllowanceChargeRequest) GetAllowanceChargeReason() string {
 	if x != nil {
-		return x.UserEmail
+		return x.AllowanceChargeReason
 	}
 	return ""
 }
 
-func (x *GetRoles) GetRequestId() string {
+func (x *CreateAllowanceChargeRequest) GetMultiplierFactorNumeric() float64 {
 	if x != nil {
-		return x.RequestId
+		return x.MultiplierFactorNumeric
 	}
-	return ""
-}
-
-type DeleteRole struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,2,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,3,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	return 0
 }
 
-func (x *DeleteRole) Reset() {
-	*x = DeleteRole{}
-	mi := &file_common_v1_common_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateAllowanceChargeRequest) GetPrepaidIndicator() bool {
+	if x != nil {
+		return x.PrepaidIndicator
+	}
+	return false
 }
 
-func (x *DeleteRole) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateAllowanceChargeRequest) GetSequenceNumeric() uint32 {
+	if x != nil {
+		return x.SequenceNumeric
+	}
+	return 0
 }
 
-func (*DeleteRole) ProtoMessage() {}
-
-func (x *DeleteRole) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[18]
+func (x *CreateAllowanceChargeRequest) GetAmount() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Amount
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteRole.ProtoReflect.Descriptor instead.
-func (*DeleteRole) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{18}
+func (x *CreateAllowanceChargeRequest) GetBaseAmount() string {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return ""
 }
 
-func (x *DeleteRole) GetRoleId() string {
+func (x *CreateAllowanceChargeRequest) GetPerUnitAmount() string {
 	if x != nil {
-		return x.RoleId
+		return x.PerUnitAmount
 	}
 	return ""
 }
 
-func (x *DeleteRole) GetAuth0Domain() string {
+func (x *CreateAllowanceChargeRequest) GetTaxCategoryId() uint32 {
 	if x != nil {
-		return x.Auth0Domain
+		return x.TaxCategoryId
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteRole) GetAuth0MgmtToken() string {
+func (x *CreateAllowanceChargeRequest) GetUserId() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteRole) GetUserEmail() string {
+func (x *CreateAllowanceChargeRequest) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *DeleteRole) GetRequestId() string {
+func (x *CreateAllowanceChargeRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type UpdateRole struct {
+type Role struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,4,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,5,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *UpdateRole) Reset() {
-	*x = UpdateRole{}
-	mi := &file_common_v1_common_proto_msgTypes[19]
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_common_v1_common_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRole) String() string {
+func (x *Role) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRole) ProtoMessage() {}
+func (*Role) ProtoMessage() {}
 
-func (x *UpdateRole) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[19]
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,90 +1670,965 @@ func (x *UpdateRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRole.ProtoReflect.Descriptor instead.
-func (*UpdateRole) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateRole) GetRoleId() string {
+func (x *Role) GetId() string {
 	if x != nil {
-		return x.RoleId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetName() string {
+func (x *Role) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetDescription() string {
+func (x *Role) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetAuth0Domain() string {
-	if x != nil {
-		return x.Auth0Domain
+type CreateRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserId         string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *CreateRole) Reset() {
+	*x = CreateRole{}
+	mi := &file_common_v1_common_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRole) ProtoMessage() {}
+
+func (x *CreateRole) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRole.ProtoReflect.Descriptor instead.
+func (*CreateRole) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRole) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *CreateRole) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *CreateRole) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRole) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *CreateRole) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail      string `protobuf:"bytes,5,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *GetRole) Reset() {
+	*x = GetRole{}
+	mi := &file_common_v1_common_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRole) ProtoMessage() {}
+
+func (x *GetRole) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRole.ProtoReflect.Descriptor instead.
+func (*GetRole) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRole) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *GetRole) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRole) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *GetRole) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *GetRole) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetRole) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetRoles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth0Domain    string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,2,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *GetRoles) Reset() {
+	*x = GetRoles{}
+	mi := &file_common_v1_common_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoles) ProtoMessage() {}
+
+func (x *GetRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoles.ProtoReflect.Descriptor instead.
+func (*GetRoles) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRoles) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *GetRoles) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *GetRoles) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRoles) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetRoles) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type DeleteRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,2,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,3,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *DeleteRole) Reset() {
+	*x = DeleteRole{}
+	mi := &file_common_v1_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRole) ProtoMessage() {}
+
+func (x *DeleteRole) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRole.ProtoReflect.Descriptor instead.
+func (*DeleteRole) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteRole) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *DeleteRole) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *DeleteRole) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *DeleteRole) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *DeleteRole) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type UpdateRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,4,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,5,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *UpdateRole) Reset() {
+	*x = UpdateRole{}
+	mi := &file_common_v1_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRole) ProtoMessage() {}
+
+func (x *UpdateRole) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRole.ProtoReflect.Descriptor instead.
+func (*UpdateRole) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateRole) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *UpdateRole) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RolePermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName           string `protobuf:"bytes,1,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	Description              string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceServerName       string `protobuf:"bytes,3,opt,name=resource_server_name,json=resourceServerName,proto3" json:"resource_server_name,omitempty"`
+	ResourceServerIdentifier string `protobuf:"bytes,4,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
+	RoleId                   string `protobuf:"bytes,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId                   string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RolePermission) Reset() {
+	*x = RolePermission{}
+	mi := &file_common_v1_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePermission) ProtoMessage() {}
+
+func (x *RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolePermission.ProtoReflect.Descriptor instead.
+func (*RolePermission) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RolePermission) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *RolePermission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RolePermission) GetResourceServerName() string {
+	if x != nil {
+		return x.ResourceServerName
+	}
+	return ""
+}
+
+func (x *RolePermission) GetResourceServerIdentifier() string {
+	if x != nil {
+		return x.ResourceServerIdentifier
+	}
+	return ""
+}
+
+func (x *RolePermission) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RolePermission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RolePermission) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *RolePermission) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AddPermisionsToRoles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceServerIdentifier string `protobuf:"bytes,1,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
+	PermissionName           string `protobuf:"bytes,2,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	RoleId                   string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId                   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Auth0Domain              string `protobuf:"bytes,5,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken           string `protobuf:"bytes,6,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *AddPermisionsToRoles) Reset() {
+	*x = AddPermisionsToRoles{}
+	mi := &file_common_v1_common_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPermisionsToRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPermisionsToRoles) ProtoMessage() {}
+
+func (x *AddPermisionsToRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPermisionsToRoles.ProtoReflect.Descriptor instead.
+func (*AddPermisionsToRoles) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddPermisionsToRoles) GetResourceServerIdentifier() string {
+	if x != nil {
+		return x.ResourceServerIdentifier
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *AddPermisionsToRoles) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RemoveRolePermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceServerIdentifier string `protobuf:"bytes,1,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
+	PermissionName           string `protobuf:"bytes,2,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	RoleId                   string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId                   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Auth0Domain              string `protobuf:"bytes,5,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken           string `protobuf:"bytes,6,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RemoveRolePermission) Reset() {
+	*x = RemoveRolePermission{}
+	mi := &file_common_v1_common_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRolePermission) ProtoMessage() {}
+
+func (x *RemoveRolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRolePermission.ProtoReflect.Descriptor instead.
+func (*RemoveRolePermission) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveRolePermission) GetResourceServerIdentifier() string {
+	if x != nil {
+		return x.ResourceServerIdentifier
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *RemoveRolePermission) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetRolePermissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail      string `protobuf:"bytes,5,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *GetRolePermissions) Reset() {
+	*x = GetRolePermissions{}
+	mi := &file_common_v1_common_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolePermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolePermissions) ProtoMessage() {}
+
+func (x *GetRolePermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolePermissions.ProtoReflect.Descriptor instead.
+func (*GetRolePermissions) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRolePermissions) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *GetRolePermissions) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRolePermissions) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *GetRolePermissions) GetAuth0MgmtToken() string {
+	if x != nil {
+		return x.Auth0MgmtToken
+	}
+	return ""
+}
+
+func (x *GetRolePermissions) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetRolePermissions) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AssignRolesToUsers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	AssignToUserId string `protobuf:"bytes,2,opt,name=assign_to_user_id,json=assignToUserId,proto3" json:"assign_to_user_id,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,4,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,5,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *AssignRolesToUsers) Reset() {
+	*x = AssignRolesToUsers{}
+	mi := &file_common_v1_common_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRolesToUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesToUsers) ProtoMessage() {}
+
+func (x *AssignRolesToUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesToUsers.ProtoReflect.Descriptor instead.
+func (*AssignRolesToUsers) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssignRolesToUsers) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetAuth0MgmtToken() string {
+func (x *AssignRolesToUsers) GetAssignToUserId() string {
+	if x != nil {
+		return x.AssignToUserId
+	}
+	return ""
+}
+
+func (x *AssignRolesToUsers) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignRolesToUsers) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *AssignRolesToUsers) GetAuth0MgmtToken() string {
 	if x != nil {
 		return x.Auth0MgmtToken
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetUserEmail() string {
+func (x *AssignRolesToUsers) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *UpdateRole) GetRequestId() string {
+func (x *AssignRolesToUsers) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type RolePermission struct {
+type ViewUserRoles struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PermissionName           string `protobuf:"bytes,1,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
-	Description              string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ResourceServerName       string `protobuf:"bytes,3,opt,name=resource_server_name,json=resourceServerName,proto3" json:"resource_server_name,omitempty"`
-	ResourceServerIdentifier string `protobuf:"bytes,4,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
-	RoleId                   string `protobuf:"bytes,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	UserId                   string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Auth0Domain    string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string `protobuf:"bytes,2,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *RolePermission) Reset() {
-	*x = RolePermission{}
-	mi := &file_common_v1_common_proto_msgTypes[20]
+func (x *ViewUserRoles) Reset() {
+	*x = ViewUserRoles{}
+	mi := &file_common_v1_common_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RolePermission) String() string {
+func (x *ViewUserRoles) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RolePermission) ProtoMessage() {}
+func (*ViewUserRoles) ProtoMessage() {}
 
-func (x *RolePermission) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[20]
+func (x *ViewUserRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,97 +2639,75 @@ func (x *RolePermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RolePermission.ProtoReflect.Descriptor instead.
-func (*RolePermission) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *RolePermission) GetPermissionName() string {
-	if x != nil {
-		return x.PermissionName
-	}
-	return ""
-}
-
-func (x *RolePermission) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *RolePermission) GetResourceServerName() string {
-	if x != nil {
-		return x.ResourceServerName
-	}
-	return ""
+// Deprecated: Use ViewUserRoles.ProtoReflect.Descriptor instead.
+func (*ViewUserRoles) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RolePermission) GetResourceServerIdentifier() string {
+func (x *ViewUserRoles) GetAuth0Domain() string {
 	if x != nil {
-		return x.ResourceServerIdentifier
+		return x.Auth0Domain
 	}
 	return ""
 }
 
-func (x *RolePermission) GetRoleId() string {
+func (x *ViewUserRoles) GetAuth0MgmtToken() string {
 	if x != nil {
-		return x.RoleId
+		return x.Auth0MgmtToken
 	}
 	return ""
 }
 
-func (x *RolePermission) GetUserId() string {
+func (x *ViewUserRoles) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RolePermission) GetUserEmail() string {
+func (x *ViewUserRoles) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *RolePermission) GetRequestId() string {
+func (x *ViewUserRoles) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type AddPermisionsToRoles struct {
+type AddAPIPermission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceServerIdentifier string `protobuf:"bytes,1,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
-	PermissionName           string `protobuf:"bytes,2,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
-	RoleId                   string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	UserId                   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Auth0Domain              string `protobuf:"bytes,5,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken           string `protobuf:"bytes,6,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Permissions    []*Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Auth0Domain    string        `protobuf:"bytes,2,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0MgmtToken string        `protobuf:"bytes,3,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
+	Auth0ApiId     string        `protobuf:"bytes,4,opt,name=auth0_api_id,json=auth0ApiId,proto3" json:"auth0_api_id,omitempty"`
+	UserId         string        `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string        `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string        `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *AddPermisionsToRoles) Reset() {
-	*x = AddPermisionsToRoles{}
-	mi := &file_common_v1_common_proto_msgTypes[21]
+func (x *AddAPIPermission) Reset() {
+	*x = AddAPIPermission{}
+	mi := &file_common_v1_common_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPermisionsToRoles) String() string {
+func (x *AddAPIPermission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPermisionsToRoles) ProtoMessage() {}
+func (*AddAPIPermission) ProtoMessage() {}
 
-func (x *AddPermisionsToRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[21]
+func (x *AddAPIPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,97 +2718,84 @@ func (x *AddPermisionsToRoles) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPermisionsToRoles.ProtoReflect.Descriptor instead.
-func (*AddPermisionsToRoles) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *AddPermisionsToRoles) GetResourceServerIdentifier() string {
-	if x != nil {
-		return x.ResourceServerIdentifier
-	}
-	return ""
+// Deprecated: Use AddAPIPermission.ProtoReflect.Descriptor instead.
+func (*AddAPIPermission) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddPermisionsToRoles) GetPermissionName() string {
+func (x *AddAPIPermission) GetPermissions() []*Permission {
 	if x != nil {
-		return x.PermissionName
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (x *AddPermisionsToRoles) GetRoleId() string {
+func (x *AddAPIPermission) GetAuth0Domain() string {
 	if x != nil {
-		return x.RoleId
+		return x.Auth0Domain
 	}
 	return ""
 }
 
-func (x *AddPermisionsToRoles) GetUserId() string {
+func (x *AddAPIPermission) GetAuth0MgmtToken() string {
 	if x != nil {
-		return x.UserId
+		return x.Auth0MgmtToken
 	}
 	return ""
 }
 
-func (x *AddPermisionsToRoles) GetAuth0Domain() string {
+func (x *AddAPIPermission) GetAuth0ApiId() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.Auth0ApiId
 	}
 	return ""
 }
 
-func (x *AddPermisionsToRoles) GetAuth0MgmtToken() string {
+func (x *AddAPIPermission) GetUserId() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddPermisionsToRoles) GetUserEmail() string {
+func (x *AddAPIPermission) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *AddPermisionsToRoles) GetRequestId() string {
+func (x *AddAPIPermission) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type RemoveRolePermission struct {
+type Permission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceServerIdentifier string `protobuf:"bytes,1,opt,name=resource_server_identifier,json=resourceServerIdentifier,proto3" json:"resource_server_identifier,omitempty"`
-	PermissionName           string `protobuf:"bytes,2,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
-	RoleId                   string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	UserId                   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Auth0Domain              string `protobuf:"bytes,5,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken           string `protobuf:"bytes,6,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail                string `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PermissionName        string `protobuf:"bytes,1,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	PermissionDescription string `protobuf:"bytes,2,opt,name=permission_description,json=permissionDescription,proto3" json:"permission_description,omitempty"`
 }
 
-func (x *RemoveRolePermission) Reset() {
-	*x = RemoveRolePermission{}
-	mi := &file_common_v1_common_proto_msgTypes[22]
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_common_v1_common_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRolePermission) String() string {
+func (x *Permission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRolePermission) ProtoMessage() {}
+func (*Permission) ProtoMessage() {}
 
-func (x *RemoveRolePermission) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[22]
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1970,95 +2806,119 @@ func (x *RemoveRolePermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRolePermission.ProtoReflect.Descriptor instead.
-func (*RemoveRolePermission) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoveRolePermission) GetResourceServerIdentifier() string {
+func (x *Permission) GetPermissionName() string {
 	if x != nil {
-		return x.ResourceServerIdentifier
+		return x.PermissionName
 	}
 	return ""
 }
 
-func (x *RemoveRolePermission) GetPermissionName() string {
+func (x *Permission) GetPermissionDescription() string {
 	if x != nil {
-		return x.PermissionName
+		return x.PermissionDescription
 	}
 	return ""
 }
 
-func (x *RemoveRolePermission) GetRoleId() string {
-	if x != nil {
-		return x.RoleId
-	}
-	return ""
+type ValidationProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Line    int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Rule    string `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *RemoveRolePermission) GetUserId() string {
+func (x *ValidationProblem) Reset() {
+	*x = ValidationProblem{}
+	mi := &file_common_v1_common_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationProblem) ProtoMessage() {}
+
+func (x *ValidationProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[31]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RemoveRolePermission) GetAuth0Domain() string {
+// Deprecated: Use ValidationProblem.ProtoReflect.Descriptor instead.
+func (*ValidationProblem) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ValidationProblem) GetPath() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.Path
 	}
 	return ""
 }
 
-func (x *RemoveRolePermission) GetAuth0MgmtToken() string {
+func (x *ValidationProblem) GetLine() int32 {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoveRolePermission) GetUserEmail() string {
+func (x *ValidationProblem) GetMessage() string {
 	if x != nil {
-		return x.UserEmail
+		return x.Message
 	}
 	return ""
 }
 
-func (x *RemoveRolePermission) GetRequestId() string {
+func (x *ValidationProblem) GetRule() string {
 	if x != nil {
-		return x.RequestId
+		return x.Rule
 	}
 	return ""
 }
-
-type GetRolePermissions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,3,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,4,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail      string `protobuf:"bytes,5,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+
+type DocumentVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentVersionD *DocumentVersionD `protobuf:"bytes,1,opt,name=document_version_d,json=documentVersionD,proto3" json:"document_version_d,omitempty"`
+	CrUpdUser        *CrUpdUser        `protobuf:"bytes,2,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime        *CrUpdTime        `protobuf:"bytes,3,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
 }
 
-func (x *GetRolePermissions) Reset() {
-	*x = GetRolePermissions{}
-	mi := &file_common_v1_common_proto_msgTypes[23]
+func (x *DocumentVersion) Reset() {
+	*x = DocumentVersion{}
+	mi := &file_common_v1_common_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRolePermissions) String() string {
+func (x *DocumentVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolePermissions) ProtoMessage() {}
+func (*DocumentVersion) ProtoMessage() {}
 
-func (x *GetRolePermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[23]
+func (x *DocumentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,82 +2929,63 @@ func (x *GetRolePermissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolePermissions.ProtoReflect.Descriptor instead.
-func (*GetRolePermissions) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetRolePermissions) GetRoleId() string {
-	if x != nil {
-		return x.RoleId
-	}
-	return ""
-}
-
-func (x *GetRolePermissions) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetRolePermissions) GetAuth0Domain() string {
-	if x != nil {
-		return x.Auth0Domain
-	}
-	return ""
+// Deprecated: Use DocumentVersion.ProtoReflect.Descriptor instead.
+func (*DocumentVersion) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetRolePermissions) GetAuth0MgmtToken() string {
+func (x *DocumentVersion) GetDocumentVersionD() *DocumentVersionD {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.DocumentVersionD
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRolePermissions) GetUserEmail() string {
+func (x *DocumentVersion) GetCrUpdUser() *CrUpdUser {
 	if x != nil {
-		return x.UserEmail
+		return x.CrUpdUser
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRolePermissions) GetRequestId() string {
+func (x *DocumentVersion) GetCrUpdTime() *CrUpdTime {
 	if x != nil {
-		return x.RequestId
+		return x.CrUpdTime
 	}
-	return ""
+	return nil
 }
 
-type AssignRolesToUsers struct {
+type DocumentVersionD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoleId         string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	AssignToUserId string `protobuf:"bytes,2,opt,name=assign_to_user_id,json=assignToUserId,proto3" json:"assign_to_user_id,omitempty"`
-	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Auth0Domain    string `protobuf:"bytes,4,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,5,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserEmail      string `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Id                uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid4             []byte `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
+	IdS               string `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
+	MasterFlag        string `protobuf:"bytes,4,opt,name=master_flag,json=masterFlag,proto3" json:"master_flag,omitempty"`
+	MasterId          uint32 `protobuf:"varint,5,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	VersionNumber     uint32 `protobuf:"varint,6,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	PreviousVersionId uint32 `protobuf:"varint,7,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	Reason            string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Snapshot          string `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *AssignRolesToUsers) Reset() {
-	*x = AssignRolesToUsers{}
-	mi := &file_common_v1_common_proto_msgTypes[24]
+func (x *DocumentVersionD) Reset() {
+	*x = DocumentVersionD{}
+	mi := &file_common_v1_common_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRolesToUsers) String() string {
+func (x *DocumentVersionD) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRolesToUsers) ProtoMessage() {}
+func (*DocumentVersionD) ProtoMessage() {}
 
-func (x *AssignRolesToUsers) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[24]
+func (x *DocumentVersionD) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,87 +2996,99 @@ func (x *AssignRolesToUsers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRolesToUsers.ProtoReflect.Descriptor instead.
-func (*AssignRolesToUsers) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DocumentVersionD.ProtoReflect.Descriptor instead.
+func (*DocumentVersionD) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *AssignRolesToUsers) GetRoleId() string {
+func (x *DocumentVersionD) GetId() uint32 {
 	if x != nil {
-		return x.RoleId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AssignRolesToUsers) GetAssignToUserId() string {
+func (x *DocumentVersionD) GetUuid4() []byte {
 	if x != nil {
-		return x.AssignToUserId
+		return x.Uuid4
 	}
-	return ""
+	return nil
 }
 
-func (x *AssignRolesToUsers) GetUserId() string {
+func (x *DocumentVersionD) GetIdS() string {
 	if x != nil {
-		return x.UserId
+		return x.IdS
 	}
 	return ""
 }
 
-func (x *AssignRolesToUsers) GetAuth0Domain() string {
+func (x *DocumentVersionD) GetMasterFlag() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.MasterFlag
 	}
 	return ""
 }
 
-func (x *AssignRolesToUsers) GetAuth0MgmtToken() string {
+func (x *DocumentVersionD) GetMasterId() uint32 {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.MasterId
 	}
-	return ""
+	return 0
 }
 
-func (x *AssignRolesToUsers) GetUserEmail() string {
+func (x *DocumentVersionD) GetVersionNumber() uint32 {
 	if x != nil {
-		return x.UserEmail
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *DocumentVersionD) GetPreviousVersionId() uint32 {
+	if x != nil {
+		return x.PreviousVersionId
+	}
+	return 0
+}
+
+func (x *DocumentVersionD) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AssignRolesToUsers) GetRequestId() string {
+func (x *DocumentVersionD) GetSnapshot() string {
 	if x != nil {
-		return x.RequestId
+		return x.Snapshot
 	}
 	return ""
 }
 
-type ViewUserRoles struct {
+type DocumentVersionChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth0Domain    string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string `protobuf:"bytes,2,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail      string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	FromValue string `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	ToValue   string `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
 }
 
-func (x *ViewUserRoles) Reset() {
-	*x = ViewUserRoles{}
-	mi := &file_common_v1_common_proto_msgTypes[25]
+func (x *DocumentVersionChange) Reset() {
+	*x = DocumentVersionChange{}
+	mi := &file_common_v1_common_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ViewUserRoles) String() string {
+func (x *DocumentVersionChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ViewUserRoles) ProtoMessage() {}
+func (*DocumentVersionChange) ProtoMessage() {}
 
-func (x *ViewUserRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[25]
+func (x *DocumentVersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,75 +3099,100 @@ func (x *ViewUserRoles) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ViewUserRoles.ProtoReflect.Descriptor instead.
-func (*ViewUserRoles) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DocumentVersionChange.ProtoReflect.Descriptor instead.
+func (*DocumentVersionChange) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ViewUserRoles) GetAuth0Domain() string {
+func (x *DocumentVersionChange) GetPath() string {
 	if x != nil {
-		return x.Auth0Domain
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ViewUserRoles) GetAuth0MgmtToken() string {
+func (x *DocumentVersionChange) GetFromValue() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.FromValue
 	}
 	return ""
 }
 
-func (x *ViewUserRoles) GetUserId() string {
+func (x *DocumentVersionChange) GetToValue() string {
 	if x != nil {
-		return x.UserId
+		return x.ToValue
 	}
 	return ""
 }
 
-func (x *ViewUserRoles) GetUserEmail() string {
+type GetDocumentVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRequest *GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+}
+
+func (x *GetDocumentVersionsRequest) Reset() {
+	*x = GetDocumentVersionsRequest{}
+	mi := &file_common_v1_common_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentVersionsRequest) ProtoMessage() {}
+
+func (x *GetDocumentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[35]
 	if x != nil {
-		return x.UserEmail
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ViewUserRoles) GetRequestId() string {
+// Deprecated: Use GetDocumentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDocumentVersionsRequest) GetGetRequest() *GetRequest {
 	if x != nil {
-		return x.RequestId
+		return x.GetRequest
 	}
-	return ""
+	return nil
 }
 
-type AddAPIPermission struct {
+type GetDocumentVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Permissions    []*Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Auth0Domain    string        `protobuf:"bytes,2,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0MgmtToken string        `protobuf:"bytes,3,opt,name=auth0_mgmt_token,json=auth0MgmtToken,proto3" json:"auth0_mgmt_token,omitempty"`
-	Auth0ApiId     string        `protobuf:"bytes,4,opt,name=auth0_api_id,json=auth0ApiId,proto3" json:"auth0_api_id,omitempty"`
-	UserId         string        `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail      string        `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId      string        `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	DocumentVersions []*DocumentVersion `protobuf:"bytes,1,rep,name=document_versions,json=documentVersions,proto3" json:"document_versions,omitempty"`
 }
 
-func (x *AddAPIPermission) Reset() {
-	*x = AddAPIPermission{}
-	mi := &file_common_v1_common_proto_msgTypes[26]
+func (x *GetDocumentVersionsResponse) Reset() {
+	*x = GetDocumentVersionsResponse{}
+	mi := &file_common_v1_common_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAPIPermission) String() string {
+func (x *GetDocumentVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAPIPermission) ProtoMessage() {}
+func (*GetDocumentVersionsResponse) ProtoMessage() {}
 
-func (x *AddAPIPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[26]
+func (x *GetDocumentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,84 +3203,118 @@ func (x *AddAPIPermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAPIPermission.ProtoReflect.Descriptor instead.
-func (*AddAPIPermission) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetDocumentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AddAPIPermission) GetPermissions() []*Permission {
+func (x *GetDocumentVersionsResponse) GetDocumentVersions() []*DocumentVersion {
 	if x != nil {
-		return x.Permissions
+		return x.DocumentVersions
 	}
 	return nil
 }
 
-func (x *AddAPIPermission) GetAuth0Domain() string {
+type DiffDocumentVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersionNumber uint32 `protobuf:"varint,2,opt,name=from_version_number,json=fromVersionNumber,proto3" json:"from_version_number,omitempty"`
+	ToVersionNumber   uint32 `protobuf:"varint,3,opt,name=to_version_number,json=toVersionNumber,proto3" json:"to_version_number,omitempty"`
+	UserEmail         string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId         string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *DiffDocumentVersionsRequest) Reset() {
+	*x = DiffDocumentVersionsRequest{}
+	mi := &file_common_v1_common_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffDocumentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffDocumentVersionsRequest) ProtoMessage() {}
+
+func (x *DiffDocumentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[37]
 	if x != nil {
-		return x.Auth0Domain
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddAPIPermission) GetAuth0MgmtToken() string {
+// Deprecated: Use DiffDocumentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffDocumentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DiffDocumentVersionsRequest) GetId() string {
 	if x != nil {
-		return x.Auth0MgmtToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddAPIPermission) GetAuth0ApiId() string {
+func (x *DiffDocumentVersionsRequest) GetFromVersionNumber() uint32 {
 	if x != nil {
-		return x.Auth0ApiId
+		return x.FromVersionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *AddAPIPermission) GetUserId() string {
+func (x *DiffDocumentVersionsRequest) GetToVersionNumber() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.ToVersionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *AddAPIPermission) GetUserEmail() string {
+func (x *DiffDocumentVersionsRequest) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *AddAPIPermission) GetRequestId() string {
+func (x *DiffDocumentVersionsRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type Permission struct {
+type DiffDocumentVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PermissionName        string `protobuf:"bytes,1,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
-	PermissionDescription string `protobuf:"bytes,2,opt,name=permission_description,json=permissionDescription,proto3" json:"permission_description,omitempty"`
+	DocumentVersionChanges []*DocumentVersionChange `protobuf:"bytes,1,rep,name=document_version_changes,json=documentVersionChanges,proto3" json:"document_version_changes,omitempty"`
 }
 
-func (x *Permission) Reset() {
-	*x = Permission{}
-	mi := &file_common_v1_common_proto_msgTypes[27]
+func (x *DiffDocumentVersionsResponse) Reset() {
+	*x = DiffDocumentVersionsResponse{}
+	mi := &file_common_v1_common_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Permission) String() string {
+func (x *DiffDocumentVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Permission) ProtoMessage() {}
+func (*DiffDocumentVersionsResponse) ProtoMessage() {}
 
-func (x *Permission) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[27]
+func (x *DiffDocumentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,23 +3325,16 @@ func (x *Permission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
-func (*Permission) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *Permission) GetPermissionName() string {
-	if x != nil {
-		return x.PermissionName
-	}
-	return ""
+// Deprecated: Use DiffDocumentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffDocumentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Permission) GetPermissionDescription() string {
+func (x *DiffDocumentVersionsResponse) GetDocumentVersionChanges() []*DocumentVersionChange {
 	if x != nil {
-		return x.PermissionDescription
+		return x.DocumentVersionChanges
 	}
-	return ""
+	return nil
 }
 
 var File_common_v1_common_proto protoreflect.FileDescriptor
@@ -2684,204 +3589,372 @@ var file_common_v1_common_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
 	0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74,
-	0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10,
-	0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xc6, 0x01,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x44, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x44, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63,
+	0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55,
+	0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xa6, 0x05, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04,
+	0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12,
+	0x13, 0x0a, 0x05, 0x61, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x3f, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x61, 0x78,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x04, 0x0a, 0x1c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x61,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x63, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x55,
+	0x6e, 0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x78,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x74, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61,
 	0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28,
 	0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d,
-	0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f,
-	0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75,
-	0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68,
-	0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61,
-	0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45,
+	0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0xc6, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68,
+	0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67,
+	0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a,
+	0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67,
+	0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xbb, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45,
 	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0xbb, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x22, 0xba, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68,
+	0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xba, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d,
+	0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd1, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74,
+	0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
 	0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0xba, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f,
-	0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xba,
-	0x02, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d,
-	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xfc, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68,
+	0x30, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x56, 0x69, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d,
+	0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x50,
+	0x49, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68,
 	0x30, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30,
-	0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22,
-	0xfc, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x54,
-	0x6f, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x29, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f,
-	0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb3,
-	0x01, 0x0a, 0x0d, 0x56, 0x69, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d, 0x67, 0x6d,
-	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65,
+	0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x30, 0x41, 0x70,
+	0x69, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x52, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63,
+	0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75,
+	0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x94,
+	0x02, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x65, 0x0a, 0x15, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x54, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x66, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x44,
+	0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65,
 	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
 	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x50, 0x49, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x6d,
-	0x67, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x61, 0x75, 0x74, 0x68, 0x30, 0x4d, 0x67, 0x6d, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x20, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x30, 0x41, 0x70, 0x69, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x15, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x72, 0x65, 0x73, 0x63, 0x6f,
-	0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x1c, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x72, 0x65, 0x73, 0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62,
+	0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2896,55 +3969,75 @@ func file_common_v1_common_proto_rawDescGZIP() []byte {
 	return file_common_v1_common_proto_rawDescData
 }
 
-var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_common_v1_common_proto_goTypes = []any{
-	(*GetByIdRequest)(nil),        // 0: common.v1.GetByIdRequest
-	(*GetRequest)(nil),            // 1: common.v1.GetRequest
-	(*Empty)(nil),                 // 2: common.v1.Empty
-	(*CrUpdUser)(nil),             // 3: common.v1.CrUpdUser
-	(*CrUpdTime)(nil),             // 4: common.v1.CrUpdTime
-	(*PartyInfo)(nil),             // 5: common.v1.PartyInfo
-	(*PartyLegalEntity)(nil),      // 6: common.v1.PartyLegalEntity
-	(*PartyLegalEntityD)(nil),     // 7: common.v1.PartyLegalEntityD
-	(*PartyLegalEntityT)(nil),     // 8: common.v1.PartyLegalEntityT
-	(*TaxSchemeInfo)(nil),         // 9: common.v1.TaxSchemeInfo
-	(*Address)(nil),               // 10: common.v1.Address
-	(*Location)(nil),              // 11: common.v1.Location
-	(*LocationD)(nil),             // 12: common.v1.LocationD
-	(*LocationT)(nil),             // 13: common.v1.LocationT
-	(*Role)(nil),                  // 14: common.v1.Role
-	(*CreateRole)(nil),            // 15: common.v1.CreateRole
-	(*GetRole)(nil),               // 16: common.v1.GetRole
-	(*GetRoles)(nil),              // 17: common.v1.GetRoles
-	(*DeleteRole)(nil),            // 18: common.v1.DeleteRole
-	(*UpdateRole)(nil),            // 19: common.v1.UpdateRole
-	(*RolePermission)(nil),        // 20: common.v1.RolePermission
-	(*AddPermisionsToRoles)(nil),  // 21: common.v1.AddPermisionsToRoles
-	(*RemoveRolePermission)(nil),  // 22: common.v1.RemoveRolePermission
-	(*GetRolePermissions)(nil),    // 23: common.v1.GetRolePermissions
-	(*AssignRolesToUsers)(nil),    // 24: common.v1.AssignRolesToUsers
-	(*ViewUserRoles)(nil),         // 25: common.v1.ViewUserRoles
-	(*AddAPIPermission)(nil),      // 26: common.v1.AddAPIPermission
-	(*Permission)(nil),            // 27: common.v1.Permission
-	(*timestamppb.Timestamp)(nil), // 28: google.protobuf.Timestamp
+	(*GetByIdRequest)(nil),               // 0: common.v1.GetByIdRequest
+	(*GetRequest)(nil),                   // 1: common.v1.GetRequest
+	(*Empty)(nil),                        // 2: common.v1.Empty
+	(*CrUpdUser)(nil),                    // 3: common.v1.CrUpdUser
+	(*CrUpdTime)(nil),                    // 4: common.v1.CrUpdTime
+	(*PartyInfo)(nil),                    // 5: common.v1.PartyInfo
+	(*PartyLegalEntity)(nil),             // 6: common.v1.PartyLegalEntity
+	(*PartyLegalEntityD)(nil),            // 7: common.v1.PartyLegalEntityD
+	(*PartyLegalEntityT)(nil),            // 8: common.v1.PartyLegalEntityT
+	(*TaxSchemeInfo)(nil),                // 9: common.v1.TaxSchemeInfo
+	(*Address)(nil),                      // 10: common.v1.Address
+	(*Location)(nil),                     // 11: common.v1.Location
+	(*LocationD)(nil),                    // 12: common.v1.LocationD
+	(*LocationT)(nil),                    // 13: common.v1.LocationT
+	(*AllowanceCharge)(nil),              // 14: common.v1.AllowanceCharge
+	(*AllowanceChargeD)(nil),             // 15: common.v1.AllowanceChargeD
+	(*CreateAllowanceChargeRequest)(nil), // 16: common.v1.CreateAllowanceChargeRequest
+	(*Role)(nil),                         // 17: common.v1.Role
+	(*CreateRole)(nil),                   // 18: common.v1.CreateRole
+	(*GetRole)(nil),                      // 19: common.v1.GetRole
+	(*GetRoles)(nil),                     // 20: common.v1.GetRoles
+	(*DeleteRole)(nil),                   // 21: common.v1.DeleteRole
+	(*UpdateRole)(nil),                   // 22: common.v1.UpdateRole
+	(*RolePermission)(nil),               // 23: common.v1.RolePermission
+	(*AddPermisionsToRoles)(nil),         // 24: common.v1.AddPermisionsToRoles
+	(*RemoveRolePermission)(nil),         // 25: common.v1.RemoveRolePermission
+	(*GetRolePermissions)(nil),           // 26: common.v1.GetRolePermissions
+	(*AssignRolesToUsers)(nil),           // 27: common.v1.AssignRolesToUsers
+	(*ViewUserRoles)(nil),                // 28: common.v1.ViewUserRoles
+	(*AddAPIPermission)(nil),             // 29: common.v1.AddAPIPermission
+	(*Permission)(nil),                   // 30: common.v1.Permission
+	(*ValidationProblem)(nil),            // 31: common.v1.ValidationProblem
+	(*DocumentVersion)(nil),              // 32: common.v1.DocumentVersion
+	(*DocumentVersionD)(nil),             // 33: common.v1.DocumentVersionD
+	(*DocumentVersionChange)(nil),        // 34: common.v1.DocumentVersionChange
+	(*GetDocumentVersionsRequest)(nil),   // 35: common.v1.GetDocumentVersionsRequest
+	(*GetDocumentVersionsResponse)(nil),  // 36: common.v1.GetDocumentVersionsResponse
+	(*DiffDocumentVersionsRequest)(nil),  // 37: common.v1.DiffDocumentVersionsRequest
+	(*DiffDocumentVersionsResponse)(nil), // 38: common.v1.DiffDocumentVersionsResponse
+	(*timestamppb.Timestamp)(nil),        // 39: google.protobuf.Timestamp
 }
 var file_common_v1_common_proto_depIdxs = []int32{
-	28, // 0: common.v1.CrUpdTime.created_at:type_name -> google.protobuf.Timestamp
-	28, // 1: common.v1.CrUpdTime.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 0: common.v1.CrUpdTime.created_at:type_name -> google.protobuf.Timestamp
+	39, // 1: common.v1.CrUpdTime.updated_at:type_name -> google.protobuf.Timestamp
 	7,  // 2: common.v1.PartyLegalEntity.party_legal_entity_d:type_name -> common.v1.PartyLegalEntityD
 	8,  // 3: common.v1.PartyLegalEntity.party_legal_entity_t:type_name -> common.v1.PartyLegalEntityT
-	28, // 4: common.v1.PartyLegalEntityT.registration_date:type_name -> google.protobuf.Timestamp
-	28, // 5: common.v1.PartyLegalEntityT.registration_expiration_date:type_name -> google.protobuf.Timestamp
+	39, // 4: common.v1.PartyLegalEntityT.registration_date:type_name -> google.protobuf.Timestamp
+	39, // 5: common.v1.PartyLegalEntityT.registration_expiration_date:type_name -> google.protobuf.Timestamp
 	12, // 6: common.v1.Location.location_d:type_name -> common.v1.LocationD
 	13, // 7: common.v1.Location.location_t:type_name -> common.v1.LocationT
-	28, // 8: common.v1.LocationT.validity_period_start_date:type_name -> google.protobuf.Timestamp
-	28, // 9: common.v1.LocationT.validity_period_end_date:type_name -> google.protobuf.Timestamp
-	27, // 10: common.v1.AddAPIPermission.permissions:type_name -> common.v1.Permission
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	39, // 8: common.v1.LocationT.validity_period_start_date:type_name -> google.protobuf.Timestamp
+	39, // 9: common.v1.LocationT.validity_period_end_date:type_name -> google.protobuf.Timestamp
+	15, // 10: common.v1.AllowanceCharge.allowance_charge_d:type_name -> common.v1.AllowanceChargeD
+	3,  // 11: common.v1.AllowanceCharge.cr_upd_user:type_name -> common.v1.CrUpdUser
+	4,  // 12: common.v1.AllowanceCharge.cr_upd_time:type_name -> common.v1.CrUpdTime
+	30, // 13: common.v1.AddAPIPermission.permissions:type_name -> common.v1.Permission
+	33, // 14: common.v1.DocumentVersion.document_version_d:type_name -> common.v1.DocumentVersionD
+	3,  // 15: common.v1.DocumentVersion.cr_upd_user:type_name -> common.v1.CrUpdUser
+	4,  // 16: common.v1.DocumentVersion.cr_upd_time:type_name -> common.v1.CrUpdTime
+	1,  // 17: common.v1.GetDocumentVersionsRequest.get_request:type_name -> common.v1.GetRequest
+	32, // 18: common.v1.GetDocumentVersionsResponse.document_versions:type_name -> common.v1.DocumentVersion
+	34, // 19: common.v1.DiffDocumentVersionsResponse.document_version_changes:type_name -> common.v1.DocumentVersionChange
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_common_proto_init() }
@@ -2958,7 +4051,7 @@ func file_common_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_v1_common_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1874,46 +1874,129 @@ var _ interface {
 	ErrorName() string
 } = LocationTValidationError{}
 
-// Validate checks the field values on Role with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Role) Validate() error {
+// Validate checks the field values on AllowanceCharge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AllowanceCharge) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Role with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RoleMultiError, or nil if none found.
-func (m *Role) ValidateAll() error {
+// ValidateAll checks the field values on AllowanceCharge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllowanceChargeMultiError, or nil if none found.
+func (m *AllowanceCharge) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Role) validate(all bool) error {
+func (m *AllowanceCharge) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetAllowanceChargeD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "AllowanceChargeD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "AllowanceChargeD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAllowanceChargeD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AllowanceChargeValidationError{
+				field:  "AllowanceChargeD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AllowanceChargeValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Description
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AllowanceChargeValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AllowanceChargeValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RoleMultiError(errors)
+		return AllowanceChargeMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleMultiError is an error wrapping multiple validation errors returned by
-// Role.ValidateAll() if the designated constraints aren't met.
-type RoleMultiError []error
+// AllowanceChargeMultiError is an error wrapping multiple validation errors
+// returned by AllowanceCharge.ValidateAll() if the designated constraints
+// aren't met.
+type AllowanceChargeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleMultiError) Error() string {
+func (m AllowanceChargeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1922,11 +2005,11 @@ func (m RoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleMultiError) AllErrors() []error { return m }
+func (m AllowanceChargeMultiError) AllErrors() []error { return m }
 
-// RoleValidationError is the validation error returned by Role.Validate if the
-// designated constraints aren't met.
-type RoleValidationError struct {
+// AllowanceChargeValidationError is the validation error returned by
+// AllowanceCharge.Validate if the designated constraints aren't met.
+type AllowanceChargeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1934,22 +2017,22 @@ type RoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleValidationError) Field() string { return e.field }
+func (e AllowanceChargeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleValidationError) Reason() string { return e.reason }
+func (e AllowanceChargeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleValidationError) Cause() error { return e.cause }
+func (e AllowanceChargeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleValidationError) Key() bool { return e.key }
+func (e AllowanceChargeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+func (e AllowanceChargeValidationError) ErrorName() string { return "AllowanceChargeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RoleValidationError) Error() string {
+func (e AllowanceChargeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1961,14 +2044,14 @@ func (e RoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRole.%s: %s%s",
+		"invalid %sAllowanceCharge.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleValidationError{}
+var _ error = AllowanceChargeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1976,57 +2059,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleValidationError{}
+} = AllowanceChargeValidationError{}
 
-// Validate checks the field values on CreateRole with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CreateRole) Validate() error {
+// Validate checks the field values on AllowanceChargeD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AllowanceChargeD) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRole with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateRoleMultiError, or
-// nil if none found.
-func (m *CreateRole) ValidateAll() error {
+// ValidateAll checks the field values on AllowanceChargeD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllowanceChargeDMultiError, or nil if none found.
+func (m *AllowanceChargeD) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRole) validate(all bool) error {
+func (m *AllowanceChargeD) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
-	// no validation rules for Description
+	// no validation rules for Uuid4
 
-	// no validation rules for Auth0Domain
+	// no validation rules for IdS
 
-	// no validation rules for Auth0MgmtToken
+	// no validation rules for AcId
 
-	// no validation rules for UserId
+	// no validation rules for ChargeIndicator
 
-	// no validation rules for UserEmail
+	// no validation rules for AllowanceChargeReasonCode
 
-	// no validation rules for RequestId
+	// no validation rules for AllowanceChargeReason
+
+	// no validation rules for MultiplierFactorNumeric
+
+	// no validation rules for PrepaidIndicator
+
+	// no validation rules for SequenceNumeric
+
+	// no validation rules for Amount
+
+	// no validation rules for BaseAmount
+
+	// no validation rules for PerUnitAmount
+
+	// no validation rules for TaxCategoryId
+
+	// no validation rules for TaxTotalId
+
+	// no validation rules for MasterFlag
+
+	// no validation rules for MasterId
+
+	// no validation rules for CurrencyCode
 
 	if len(errors) > 0 {
-		return CreateRoleMultiError(errors)
+		return AllowanceChargeDMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleMultiError is an error wrapping multiple validation errors
-// returned by CreateRole.ValidateAll() if the designated constraints aren't met.
-type CreateRoleMultiError []error
+// AllowanceChargeDMultiError is an error wrapping multiple validation errors
+// returned by AllowanceChargeD.ValidateAll() if the designated constraints
+// aren't met.
+type AllowanceChargeDMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleMultiError) Error() string {
+func (m AllowanceChargeDMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2035,11 +2141,11 @@ func (m CreateRoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleMultiError) AllErrors() []error { return m }
+func (m AllowanceChargeDMultiError) AllErrors() []error { return m }
 
-// CreateRoleValidationError is the validation error returned by
-// CreateRole.Validate if the designated constraints aren't met.
-type CreateRoleValidationError struct {
+// AllowanceChargeDValidationError is the validation error returned by
+// AllowanceChargeD.Validate if the designated constraints aren't met.
+type AllowanceChargeDValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2047,22 +2153,22 @@ type CreateRoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleValidationError) Field() string { return e.field }
+func (e AllowanceChargeDValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleValidationError) Reason() string { return e.reason }
+func (e AllowanceChargeDValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleValidationError) Cause() error { return e.cause }
+func (e AllowanceChargeDValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleValidationError) Key() bool { return e.key }
+func (e AllowanceChargeDValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleValidationError) ErrorName() string { return "CreateRoleValidationError" }
+func (e AllowanceChargeDValidationError) ErrorName() string { return "AllowanceChargeDValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleValidationError) Error() string {
+func (e AllowanceChargeDValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2074,14 +2180,14 @@ func (e CreateRoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRole.%s: %s%s",
+		"invalid %sAllowanceChargeD.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleValidationError{}
+var _ error = AllowanceChargeDValidationError{}
 
 var _ interface {
 	Field() string
@@ -2089,54 +2195,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleValidationError{}
+} = AllowanceChargeDValidationError{}
 
-// Validate checks the field values on GetRole with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetRole) Validate() error {
+// Validate checks the field values on CreateAllowanceChargeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAllowanceChargeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRole with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GetRoleMultiError, or nil if none found.
-func (m *GetRole) ValidateAll() error {
+// ValidateAll checks the field values on CreateAllowanceChargeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAllowanceChargeRequestMultiError, or nil if none found.
+func (m *CreateAllowanceChargeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRole) validate(all bool) error {
+func (m *CreateAllowanceChargeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
+	// no validation rules for AcId
 
-	// no validation rules for UserId
+	// no validation rules for ChargeIndicator
 
-	// no validation rules for Auth0Domain
+	// no validation rules for AllowanceChargeReasonCode
 
-	// no validation rules for Auth0MgmtToken
+	// no validation rules for AllowanceChargeReason
+
+	// no validation rules for MultiplierFactorNumeric
+
+	// no validation rules for PrepaidIndicator
+
+	// no validation rules for SequenceNumeric
+
+	// no validation rules for Amount
+
+	// no validation rules for BaseAmount
+
+	// no validation rules for PerUnitAmount
+
+	// no validation rules for TaxCategoryId
+
+	// no validation rules for UserId
 
 	// no validation rules for UserEmail
 
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return GetRoleMultiError(errors)
+		return CreateAllowanceChargeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleMultiError is an error wrapping multiple validation errors returned
-// by GetRole.ValidateAll() if the designated constraints aren't met.
-type GetRoleMultiError []error
+// CreateAllowanceChargeRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAllowanceChargeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAllowanceChargeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleMultiError) Error() string {
+func (m CreateAllowanceChargeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2145,11 +2269,12 @@ func (m GetRoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleMultiError) AllErrors() []error { return m }
+func (m CreateAllowanceChargeRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleValidationError is the validation error returned by GetRole.Validate
-// if the designated constraints aren't met.
-type GetRoleValidationError struct {
+// CreateAllowanceChargeRequestValidationError is the validation error returned
+// by CreateAllowanceChargeRequest.Validate if the designated constraints
+// aren't met.
+type CreateAllowanceChargeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2157,22 +2282,24 @@ type GetRoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleValidationError) Field() string { return e.field }
+func (e CreateAllowanceChargeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleValidationError) Reason() string { return e.reason }
+func (e CreateAllowanceChargeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleValidationError) Cause() error { return e.cause }
+func (e CreateAllowanceChargeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleValidationError) Key() bool { return e.key }
+func (e CreateAllowanceChargeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleValidationError) ErrorName() string { return "GetRoleValidationError" }
+func (e CreateAllowanceChargeRequestValidationError) ErrorName() string {
+	return "CreateAllowanceChargeRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRoleValidationError) Error() string {
+func (e CreateAllowanceChargeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2184,14 +2311,14 @@ func (e GetRoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRole.%s: %s%s",
+		"invalid %sCreateAllowanceChargeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleValidationError{}
+var _ error = CreateAllowanceChargeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2199,53 +2326,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleValidationError{}
+} = CreateAllowanceChargeRequestValidationError{}
 
-// Validate checks the field values on GetRoles with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetRoles) Validate() error {
+// Validate checks the field values on Role with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Role) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoles with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetRolesMultiError, or nil
-// if none found.
-func (m *GetRoles) ValidateAll() error {
+// ValidateAll checks the field values on Role with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoleMultiError, or nil if none found.
+func (m *Role) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoles) validate(all bool) error {
+func (m *Role) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Auth0Domain
-
-	// no validation rules for Auth0MgmtToken
-
-	// no validation rules for UserId
+	// no validation rules for Id
 
-	// no validation rules for UserEmail
+	// no validation rules for Name
 
-	// no validation rules for RequestId
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return GetRolesMultiError(errors)
+		return RoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRolesMultiError is an error wrapping multiple validation errors returned
-// by GetRoles.ValidateAll() if the designated constraints aren't met.
-type GetRolesMultiError []error
+// RoleMultiError is an error wrapping multiple validation errors returned by
+// Role.ValidateAll() if the designated constraints aren't met.
+type RoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRolesMultiError) Error() string {
+func (m RoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2254,11 +2376,11 @@ func (m GetRolesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRolesMultiError) AllErrors() []error { return m }
+func (m RoleMultiError) AllErrors() []error { return m }
 
-// GetRolesValidationError is the validation error returned by
-// GetRoles.Validate if the designated constraints aren't met.
-type GetRolesValidationError struct {
+// RoleValidationError is the validation error returned by Role.Validate if the
+// designated constraints aren't met.
+type RoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2266,22 +2388,22 @@ type GetRolesValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRolesValidationError) Field() string { return e.field }
+func (e RoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRolesValidationError) Reason() string { return e.reason }
+func (e RoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRolesValidationError) Cause() error { return e.cause }
+func (e RoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRolesValidationError) Key() bool { return e.key }
+func (e RoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRolesValidationError) ErrorName() string { return "GetRolesValidationError" }
+func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRolesValidationError) Error() string {
+func (e RoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2293,14 +2415,14 @@ func (e GetRolesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoles.%s: %s%s",
+		"invalid %sRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRolesValidationError{}
+var _ error = RoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2308,53 +2430,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRolesValidationError{}
+} = RoleValidationError{}
 
-// Validate checks the field values on DeleteRole with the rules defined in the
+// Validate checks the field values on CreateRole with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeleteRole) Validate() error {
+func (m *CreateRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRole with the rules defined in
+// ValidateAll checks the field values on CreateRole with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRoleMultiError, or
+// result is a list of violation errors wrapped in CreateRoleMultiError, or
 // nil if none found.
-func (m *DeleteRole) ValidateAll() error {
+func (m *CreateRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRole) validate(all bool) error {
+func (m *CreateRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
+	// no validation rules for Name
+
+	// no validation rules for Description
 
 	// no validation rules for Auth0Domain
 
 	// no validation rules for Auth0MgmtToken
 
+	// no validation rules for UserId
+
 	// no validation rules for UserEmail
 
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return DeleteRoleMultiError(errors)
+		return CreateRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleMultiError is an error wrapping multiple validation errors
-// returned by DeleteRole.ValidateAll() if the designated constraints aren't met.
-type DeleteRoleMultiError []error
+// CreateRoleMultiError is an error wrapping multiple validation errors
+// returned by CreateRole.ValidateAll() if the designated constraints aren't met.
+type CreateRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleMultiError) Error() string {
+func (m CreateRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2363,11 +2489,11 @@ func (m DeleteRoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleMultiError) AllErrors() []error { return m }
+func (m CreateRoleMultiError) AllErrors() []error { return m }
 
-// DeleteRoleValidationError is the validation error returned by
-// DeleteRole.Validate if the designated constraints aren't met.
-type DeleteRoleValidationError struct {
+// CreateRoleValidationError is the validation error returned by
+// CreateRole.Validate if the designated constraints aren't met.
+type CreateRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2375,22 +2501,22 @@ type DeleteRoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleValidationError) Field() string { return e.field }
+func (e CreateRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleValidationError) Reason() string { return e.reason }
+func (e CreateRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleValidationError) Cause() error { return e.cause }
+func (e CreateRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleValidationError) Key() bool { return e.key }
+func (e CreateRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleValidationError) ErrorName() string { return "DeleteRoleValidationError" }
+func (e CreateRoleValidationError) ErrorName() string { return "CreateRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleValidationError) Error() string {
+func (e CreateRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2402,14 +2528,14 @@ func (e DeleteRoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRole.%s: %s%s",
+		"invalid %sCreateRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleValidationError{}
+var _ error = CreateRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2417,24 +2543,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleValidationError{}
+} = CreateRoleValidationError{}
 
-// Validate checks the field values on UpdateRole with the rules defined in the
+// Validate checks the field values on GetRole with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UpdateRole) Validate() error {
+func (m *GetRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRole with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UpdateRoleMultiError, or
-// nil if none found.
-func (m *UpdateRole) ValidateAll() error {
+// ValidateAll checks the field values on GetRole with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GetRoleMultiError, or nil if none found.
+func (m *GetRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRole) validate(all bool) error {
+func (m *GetRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2443,9 +2568,7 @@ func (m *UpdateRole) validate(all bool) error {
 
 	// no validation rules for RoleId
 
-	// no validation rules for Name
-
-	// no validation rules for Description
+	// no validation rules for UserId
 
 	// no validation rules for Auth0Domain
 
@@ -2456,18 +2579,18 @@ func (m *UpdateRole) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return UpdateRoleMultiError(errors)
+		return GetRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleMultiError is an error wrapping multiple validation errors
-// returned by UpdateRole.ValidateAll() if the designated constraints aren't met.
-type UpdateRoleMultiError []error
+// GetRoleMultiError is an error wrapping multiple validation errors returned
+// by GetRole.ValidateAll() if the designated constraints aren't met.
+type GetRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleMultiError) Error() string {
+func (m GetRoleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2476,11 +2599,11 @@ func (m UpdateRoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleMultiError) AllErrors() []error { return m }
+func (m GetRoleMultiError) AllErrors() []error { return m }
 
-// UpdateRoleValidationError is the validation error returned by
-// UpdateRole.Validate if the designated constraints aren't met.
-type UpdateRoleValidationError struct {
+// GetRoleValidationError is the validation error returned by GetRole.Validate
+// if the designated constraints aren't met.
+type GetRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2488,22 +2611,22 @@ type UpdateRoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleValidationError) Field() string { return e.field }
+func (e GetRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleValidationError) Reason() string { return e.reason }
+func (e GetRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleValidationError) Cause() error { return e.cause }
+func (e GetRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleValidationError) Key() bool { return e.key }
+func (e GetRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleValidationError) ErrorName() string { return "UpdateRoleValidationError" }
+func (e GetRoleValidationError) ErrorName() string { return "GetRoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleValidationError) Error() string {
+func (e GetRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2515,14 +2638,14 @@ func (e UpdateRoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRole.%s: %s%s",
+		"invalid %sGetRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleValidationError{}
+var _ error = GetRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2530,60 +2653,1216 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleValidationError{}
+} = GetRoleValidationError{}
 
-// Validate checks the field values on RolePermission with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GetRoles with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *RolePermission) Validate() error {
+func (m *GetRoles) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RolePermission with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RolePermissionMultiError,
-// or nil if none found.
-func (m *RolePermission) ValidateAll() error {
+// ValidateAll checks the field values on GetRoles with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRolesMultiError, or nil
+// if none found.
+func (m *GetRoles) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RolePermission) validate(all bool) error {
+func (m *GetRoles) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PermissionName
+	// no validation rules for Auth0Domain
 
-	// no validation rules for Description
+	// no validation rules for Auth0MgmtToken
 
-	// no validation rules for ResourceServerName
+	// no validation rules for UserId
 
-	// no validation rules for ResourceServerIdentifier
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetRolesMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRolesMultiError is an error wrapping multiple validation errors returned
+// by GetRoles.ValidateAll() if the designated constraints aren't met.
+type GetRolesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRolesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRolesMultiError) AllErrors() []error { return m }
+
+// GetRolesValidationError is the validation error returned by
+// GetRoles.Validate if the designated constraints aren't met.
+type GetRolesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRolesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRolesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRolesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRolesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRolesValidationError) ErrorName() string { return "GetRolesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRolesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRolesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRolesValidationError{}
+
+// Validate checks the field values on DeleteRole with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRole) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRoleMultiError, or
+// nil if none found.
+func (m *DeleteRole) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRole) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	// no validation rules for RoleId
 
-	// no validation rules for UserId
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return DeleteRoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleMultiError is an error wrapping multiple validation errors
+// returned by DeleteRole.ValidateAll() if the designated constraints aren't met.
+type DeleteRoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleMultiError) AllErrors() []error { return m }
+
+// DeleteRoleValidationError is the validation error returned by
+// DeleteRole.Validate if the designated constraints aren't met.
+type DeleteRoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleValidationError) ErrorName() string { return "DeleteRoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleValidationError{}
+
+// Validate checks the field values on UpdateRole with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateRole) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateRoleMultiError, or
+// nil if none found.
+func (m *UpdateRole) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRole) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return UpdateRoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleMultiError is an error wrapping multiple validation errors
+// returned by UpdateRole.ValidateAll() if the designated constraints aren't met.
+type UpdateRoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleMultiError) AllErrors() []error { return m }
+
+// UpdateRoleValidationError is the validation error returned by
+// UpdateRole.Validate if the designated constraints aren't met.
+type UpdateRoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleValidationError) ErrorName() string { return "UpdateRoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleValidationError{}
+
+// Validate checks the field values on RolePermission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolePermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolePermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolePermissionMultiError,
+// or nil if none found.
+func (m *RolePermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolePermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PermissionName
+
+	// no validation rules for Description
+
+	// no validation rules for ResourceServerName
+
+	// no validation rules for ResourceServerIdentifier
+
+	// no validation rules for RoleId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return RolePermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolePermissionMultiError is an error wrapping multiple validation errors
+// returned by RolePermission.ValidateAll() if the designated constraints
+// aren't met.
+type RolePermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolePermissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolePermissionMultiError) AllErrors() []error { return m }
+
+// RolePermissionValidationError is the validation error returned by
+// RolePermission.Validate if the designated constraints aren't met.
+type RolePermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolePermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolePermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolePermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolePermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolePermissionValidationError) ErrorName() string { return "RolePermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolePermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolePermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolePermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolePermissionValidationError{}
+
+// Validate checks the field values on AddPermisionsToRoles with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddPermisionsToRoles) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPermisionsToRoles with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPermisionsToRolesMultiError, or nil if none found.
+func (m *AddPermisionsToRoles) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPermisionsToRoles) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceServerIdentifier
+
+	// no validation rules for PermissionName
+
+	// no validation rules for RoleId
+
+	// no validation rules for UserId
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return AddPermisionsToRolesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddPermisionsToRolesMultiError is an error wrapping multiple validation
+// errors returned by AddPermisionsToRoles.ValidateAll() if the designated
+// constraints aren't met.
+type AddPermisionsToRolesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddPermisionsToRolesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPermisionsToRolesMultiError) AllErrors() []error { return m }
+
+// AddPermisionsToRolesValidationError is the validation error returned by
+// AddPermisionsToRoles.Validate if the designated constraints aren't met.
+type AddPermisionsToRolesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPermisionsToRolesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPermisionsToRolesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPermisionsToRolesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPermisionsToRolesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPermisionsToRolesValidationError) ErrorName() string {
+	return "AddPermisionsToRolesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPermisionsToRolesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPermisionsToRoles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddPermisionsToRolesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPermisionsToRolesValidationError{}
+
+// Validate checks the field values on RemoveRolePermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveRolePermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveRolePermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveRolePermissionMultiError, or nil if none found.
+func (m *RemoveRolePermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveRolePermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceServerIdentifier
+
+	// no validation rules for PermissionName
+
+	// no validation rules for RoleId
+
+	// no validation rules for UserId
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return RemoveRolePermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveRolePermissionMultiError is an error wrapping multiple validation
+// errors returned by RemoveRolePermission.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveRolePermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveRolePermissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveRolePermissionMultiError) AllErrors() []error { return m }
+
+// RemoveRolePermissionValidationError is the validation error returned by
+// RemoveRolePermission.Validate if the designated constraints aren't met.
+type RemoveRolePermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveRolePermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveRolePermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveRolePermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveRolePermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveRolePermissionValidationError) ErrorName() string {
+	return "RemoveRolePermissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveRolePermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveRolePermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveRolePermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveRolePermissionValidationError{}
+
+// Validate checks the field values on GetRolePermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRolePermissions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRolePermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRolePermissionsMultiError, or nil if none found.
+func (m *GetRolePermissions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRolePermissions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for UserId
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetRolePermissionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRolePermissionsMultiError is an error wrapping multiple validation errors
+// returned by GetRolePermissions.ValidateAll() if the designated constraints
+// aren't met.
+type GetRolePermissionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRolePermissionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRolePermissionsMultiError) AllErrors() []error { return m }
+
+// GetRolePermissionsValidationError is the validation error returned by
+// GetRolePermissions.Validate if the designated constraints aren't met.
+type GetRolePermissionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRolePermissionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRolePermissionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRolePermissionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRolePermissionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRolePermissionsValidationError) ErrorName() string {
+	return "GetRolePermissionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRolePermissionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRolePermissions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRolePermissionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRolePermissionsValidationError{}
+
+// Validate checks the field values on AssignRolesToUsers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignRolesToUsers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignRolesToUsers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignRolesToUsersMultiError, or nil if none found.
+func (m *AssignRolesToUsers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignRolesToUsers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for AssignToUserId
+
+	// no validation rules for UserId
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return AssignRolesToUsersMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignRolesToUsersMultiError is an error wrapping multiple validation errors
+// returned by AssignRolesToUsers.ValidateAll() if the designated constraints
+// aren't met.
+type AssignRolesToUsersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignRolesToUsersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignRolesToUsersMultiError) AllErrors() []error { return m }
+
+// AssignRolesToUsersValidationError is the validation error returned by
+// AssignRolesToUsers.Validate if the designated constraints aren't met.
+type AssignRolesToUsersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignRolesToUsersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignRolesToUsersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignRolesToUsersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignRolesToUsersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignRolesToUsersValidationError) ErrorName() string {
+	return "AssignRolesToUsersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignRolesToUsersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignRolesToUsers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignRolesToUsersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignRolesToUsersValidationError{}
+
+// Validate checks the field values on ViewUserRoles with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ViewUserRoles) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ViewUserRoles with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewUserRolesMultiError, or
+// nil if none found.
+func (m *ViewUserRoles) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ViewUserRoles) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ViewUserRolesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ViewUserRolesMultiError is an error wrapping multiple validation errors
+// returned by ViewUserRoles.ValidateAll() if the designated constraints
+// aren't met.
+type ViewUserRolesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ViewUserRolesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ViewUserRolesMultiError) AllErrors() []error { return m }
+
+// ViewUserRolesValidationError is the validation error returned by
+// ViewUserRoles.Validate if the designated constraints aren't met.
+type ViewUserRolesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ViewUserRolesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ViewUserRolesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ViewUserRolesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ViewUserRolesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ViewUserRolesValidationError) ErrorName() string { return "ViewUserRolesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ViewUserRolesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sViewUserRoles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ViewUserRolesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ViewUserRolesValidationError{}
+
+// Validate checks the field values on AddAPIPermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAPIPermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAPIPermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAPIPermissionMultiError, or nil if none found.
+func (m *AddAPIPermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAPIPermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAPIPermissionValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAPIPermissionValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAPIPermissionValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Auth0Domain
+
+	// no validation rules for Auth0MgmtToken
+
+	// no validation rules for Auth0ApiId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return AddAPIPermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAPIPermissionMultiError is an error wrapping multiple validation errors
+// returned by AddAPIPermission.ValidateAll() if the designated constraints
+// aren't met.
+type AddAPIPermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAPIPermissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAPIPermissionMultiError) AllErrors() []error { return m }
+
+// AddAPIPermissionValidationError is the validation error returned by
+// AddAPIPermission.Validate if the designated constraints aren't met.
+type AddAPIPermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAPIPermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAPIPermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAPIPermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAPIPermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAPIPermissionValidationError) ErrorName() string { return "AddAPIPermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAPIPermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAPIPermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAPIPermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAPIPermissionValidationError{}
+
+// Validate checks the field values on Permission with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Permission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Permission with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PermissionMultiError, or
+// nil if none found.
+func (m *Permission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Permission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
-	// no validation rules for UserEmail
+	// no validation rules for PermissionName
 
-	// no validation rules for RequestId
+	// no validation rules for PermissionDescription
 
 	if len(errors) > 0 {
-		return RolePermissionMultiError(errors)
+		return PermissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// RolePermissionMultiError is an error wrapping multiple validation errors
-// returned by RolePermission.ValidateAll() if the designated constraints
-// aren't met.
-type RolePermissionMultiError []error
+// PermissionMultiError is an error wrapping multiple validation errors
+// returned by Permission.ValidateAll() if the designated constraints aren't met.
+type PermissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RolePermissionMultiError) Error() string {
+func (m PermissionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2592,11 +3871,11 @@ func (m RolePermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RolePermissionMultiError) AllErrors() []error { return m }
+func (m PermissionMultiError) AllErrors() []error { return m }
 
-// RolePermissionValidationError is the validation error returned by
-// RolePermission.Validate if the designated constraints aren't met.
-type RolePermissionValidationError struct {
+// PermissionValidationError is the validation error returned by
+// Permission.Validate if the designated constraints aren't met.
+type PermissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2604,22 +3883,22 @@ type RolePermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e RolePermissionValidationError) Field() string { return e.field }
+func (e PermissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RolePermissionValidationError) Reason() string { return e.reason }
+func (e PermissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RolePermissionValidationError) Cause() error { return e.cause }
+func (e PermissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RolePermissionValidationError) Key() bool { return e.key }
+func (e PermissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RolePermissionValidationError) ErrorName() string { return "RolePermissionValidationError" }
+func (e PermissionValidationError) ErrorName() string { return "PermissionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RolePermissionValidationError) Error() string {
+func (e PermissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2631,14 +3910,14 @@ func (e RolePermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRolePermission.%s: %s%s",
+		"invalid %sPermission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RolePermissionValidationError{}
+var _ error = PermissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2646,60 +3925,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RolePermissionValidationError{}
+} = PermissionValidationError{}
 
-// Validate checks the field values on AddPermisionsToRoles with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddPermisionsToRoles) Validate() error {
+// Validate checks the field values on ValidationProblem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidationProblem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddPermisionsToRoles with the rules
+// ValidateAll checks the field values on ValidationProblem with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddPermisionsToRolesMultiError, or nil if none found.
-func (m *AddPermisionsToRoles) ValidateAll() error {
+// ValidationProblemMultiError, or nil if none found.
+func (m *ValidationProblem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddPermisionsToRoles) validate(all bool) error {
+func (m *ValidationProblem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceServerIdentifier
-
-	// no validation rules for PermissionName
-
-	// no validation rules for RoleId
-
-	// no validation rules for UserId
-
-	// no validation rules for Auth0Domain
+	// no validation rules for Path
 
-	// no validation rules for Auth0MgmtToken
+	// no validation rules for Line
 
-	// no validation rules for UserEmail
+	// no validation rules for Message
 
-	// no validation rules for RequestId
+	// no validation rules for Rule
 
 	if len(errors) > 0 {
-		return AddPermisionsToRolesMultiError(errors)
+		return ValidationProblemMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddPermisionsToRolesMultiError is an error wrapping multiple validation
-// errors returned by AddPermisionsToRoles.ValidateAll() if the designated
-// constraints aren't met.
-type AddPermisionsToRolesMultiError []error
+// ValidationProblemMultiError is an error wrapping multiple validation errors
+// returned by ValidationProblem.ValidateAll() if the designated constraints
+// aren't met.
+type ValidationProblemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddPermisionsToRolesMultiError) Error() string {
+func (m ValidationProblemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2708,11 +3979,11 @@ func (m AddPermisionsToRolesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddPermisionsToRolesMultiError) AllErrors() []error { return m }
+func (m ValidationProblemMultiError) AllErrors() []error { return m }
 
-// AddPermisionsToRolesValidationError is the validation error returned by
-// AddPermisionsToRoles.Validate if the designated constraints aren't met.
-type AddPermisionsToRolesValidationError struct {
+// ValidationProblemValidationError is the validation error returned by
+// ValidationProblem.Validate if the designated constraints aren't met.
+type ValidationProblemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2720,24 +3991,24 @@ type AddPermisionsToRolesValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddPermisionsToRolesValidationError) Field() string { return e.field }
+func (e ValidationProblemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddPermisionsToRolesValidationError) Reason() string { return e.reason }
+func (e ValidationProblemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddPermisionsToRolesValidationError) Cause() error { return e.cause }
+func (e ValidationProblemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddPermisionsToRolesValidationError) Key() bool { return e.key }
+func (e ValidationProblemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddPermisionsToRolesValidationError) ErrorName() string {
-	return "AddPermisionsToRolesValidationError"
+func (e ValidationProblemValidationError) ErrorName() string {
+	return "ValidationProblemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddPermisionsToRolesValidationError) Error() string {
+func (e ValidationProblemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2749,14 +4020,14 @@ func (e AddPermisionsToRolesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddPermisionsToRoles.%s: %s%s",
+		"invalid %sValidationProblem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddPermisionsToRolesValidationError{}
+var _ error = ValidationProblemValidationError{}
 
 var _ interface {
 	Field() string
@@ -2764,60 +4035,131 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddPermisionsToRolesValidationError{}
+} = ValidationProblemValidationError{}
 
-// Validate checks the field values on RemoveRolePermission with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveRolePermission) Validate() error {
+// Validate checks the field values on DocumentVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DocumentVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveRolePermission with the rules
+// ValidateAll checks the field values on DocumentVersion with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveRolePermissionMultiError, or nil if none found.
-func (m *RemoveRolePermission) ValidateAll() error {
+// DocumentVersionMultiError, or nil if none found.
+func (m *DocumentVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveRolePermission) validate(all bool) error {
+func (m *DocumentVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceServerIdentifier
-
-	// no validation rules for PermissionName
-
-	// no validation rules for RoleId
-
-	// no validation rules for UserId
-
-	// no validation rules for Auth0Domain
-
-	// no validation rules for Auth0MgmtToken
+	if all {
+		switch v := interface{}(m.GetDocumentVersionD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "DocumentVersionD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "DocumentVersionD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentVersionD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentVersionValidationError{
+				field:  "DocumentVersionD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for UserEmail
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentVersionValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for RequestId
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentVersionValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentVersionValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RemoveRolePermissionMultiError(errors)
+		return DocumentVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveRolePermissionMultiError is an error wrapping multiple validation
-// errors returned by RemoveRolePermission.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveRolePermissionMultiError []error
+// DocumentVersionMultiError is an error wrapping multiple validation errors
+// returned by DocumentVersion.ValidateAll() if the designated constraints
+// aren't met.
+type DocumentVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveRolePermissionMultiError) Error() string {
+func (m DocumentVersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2826,11 +4168,11 @@ func (m RemoveRolePermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveRolePermissionMultiError) AllErrors() []error { return m }
+func (m DocumentVersionMultiError) AllErrors() []error { return m }
 
-// RemoveRolePermissionValidationError is the validation error returned by
-// RemoveRolePermission.Validate if the designated constraints aren't met.
-type RemoveRolePermissionValidationError struct {
+// DocumentVersionValidationError is the validation error returned by
+// DocumentVersion.Validate if the designated constraints aren't met.
+type DocumentVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2838,24 +4180,22 @@ type RemoveRolePermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveRolePermissionValidationError) Field() string { return e.field }
+func (e DocumentVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveRolePermissionValidationError) Reason() string { return e.reason }
+func (e DocumentVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveRolePermissionValidationError) Cause() error { return e.cause }
+func (e DocumentVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveRolePermissionValidationError) Key() bool { return e.key }
+func (e DocumentVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveRolePermissionValidationError) ErrorName() string {
-	return "RemoveRolePermissionValidationError"
-}
+func (e DocumentVersionValidationError) ErrorName() string { return "DocumentVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveRolePermissionValidationError) Error() string {
+func (e DocumentVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2867,14 +4207,14 @@ func (e RemoveRolePermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveRolePermission.%s: %s%s",
+		"invalid %sDocumentVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveRolePermissionValidationError{}
+var _ error = DocumentVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2882,56 +4222,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveRolePermissionValidationError{}
+} = DocumentVersionValidationError{}
 
-// Validate checks the field values on GetRolePermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRolePermissions) Validate() error {
+// Validate checks the field values on DocumentVersionD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DocumentVersionD) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRolePermissions with the rules
+// ValidateAll checks the field values on DocumentVersionD with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRolePermissionsMultiError, or nil if none found.
-func (m *GetRolePermissions) ValidateAll() error {
+// DocumentVersionDMultiError, or nil if none found.
+func (m *DocumentVersionD) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRolePermissions) validate(all bool) error {
+func (m *DocumentVersionD) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
+	// no validation rules for Id
 
-	// no validation rules for UserId
+	// no validation rules for Uuid4
 
-	// no validation rules for Auth0Domain
+	// no validation rules for IdS
 
-	// no validation rules for Auth0MgmtToken
+	// no validation rules for MasterFlag
 
-	// no validation rules for UserEmail
+	// no validation rules for MasterId
 
-	// no validation rules for RequestId
+	// no validation rules for VersionNumber
+
+	// no validation rules for PreviousVersionId
+
+	// no validation rules for Reason
+
+	// no validation rules for Snapshot
 
 	if len(errors) > 0 {
-		return GetRolePermissionsMultiError(errors)
+		return DocumentVersionDMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRolePermissionsMultiError is an error wrapping multiple validation errors
-// returned by GetRolePermissions.ValidateAll() if the designated constraints
+// DocumentVersionDMultiError is an error wrapping multiple validation errors
+// returned by DocumentVersionD.ValidateAll() if the designated constraints
 // aren't met.
-type GetRolePermissionsMultiError []error
+type DocumentVersionDMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRolePermissionsMultiError) Error() string {
+func (m DocumentVersionDMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2940,11 +4286,11 @@ func (m GetRolePermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRolePermissionsMultiError) AllErrors() []error { return m }
+func (m DocumentVersionDMultiError) AllErrors() []error { return m }
 
-// GetRolePermissionsValidationError is the validation error returned by
-// GetRolePermissions.Validate if the designated constraints aren't met.
-type GetRolePermissionsValidationError struct {
+// DocumentVersionDValidationError is the validation error returned by
+// DocumentVersionD.Validate if the designated constraints aren't met.
+type DocumentVersionDValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2952,24 +4298,22 @@ type GetRolePermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRolePermissionsValidationError) Field() string { return e.field }
+func (e DocumentVersionDValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRolePermissionsValidationError) Reason() string { return e.reason }
+func (e DocumentVersionDValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRolePermissionsValidationError) Cause() error { return e.cause }
+func (e DocumentVersionDValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRolePermissionsValidationError) Key() bool { return e.key }
+func (e DocumentVersionDValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRolePermissionsValidationError) ErrorName() string {
-	return "GetRolePermissionsValidationError"
-}
+func (e DocumentVersionDValidationError) ErrorName() string { return "DocumentVersionDValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRolePermissionsValidationError) Error() string {
+func (e DocumentVersionDValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2981,14 +4325,14 @@ func (e GetRolePermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRolePermissions.%s: %s%s",
+		"invalid %sDocumentVersionD.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRolePermissionsValidationError{}
+var _ error = DocumentVersionDValidationError{}
 
 var _ interface {
 	Field() string
@@ -2996,58 +4340,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRolePermissionsValidationError{}
+} = DocumentVersionDValidationError{}
 
-// Validate checks the field values on AssignRolesToUsers with the rules
+// Validate checks the field values on DocumentVersionChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AssignRolesToUsers) Validate() error {
+func (m *DocumentVersionChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AssignRolesToUsers with the rules
+// ValidateAll checks the field values on DocumentVersionChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AssignRolesToUsersMultiError, or nil if none found.
-func (m *AssignRolesToUsers) ValidateAll() error {
+// DocumentVersionChangeMultiError, or nil if none found.
+func (m *DocumentVersionChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AssignRolesToUsers) validate(all bool) error {
+func (m *DocumentVersionChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
-
-	// no validation rules for AssignToUserId
-
-	// no validation rules for UserId
-
-	// no validation rules for Auth0Domain
-
-	// no validation rules for Auth0MgmtToken
+	// no validation rules for Path
 
-	// no validation rules for UserEmail
+	// no validation rules for FromValue
 
-	// no validation rules for RequestId
+	// no validation rules for ToValue
 
 	if len(errors) > 0 {
-		return AssignRolesToUsersMultiError(errors)
+		return DocumentVersionChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AssignRolesToUsersMultiError is an error wrapping multiple validation errors
-// returned by AssignRolesToUsers.ValidateAll() if the designated constraints
-// aren't met.
-type AssignRolesToUsersMultiError []error
+// DocumentVersionChangeMultiError is an error wrapping multiple validation
+// errors returned by DocumentVersionChange.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentVersionChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AssignRolesToUsersMultiError) Error() string {
+func (m DocumentVersionChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3056,11 +4392,11 @@ func (m AssignRolesToUsersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AssignRolesToUsersMultiError) AllErrors() []error { return m }
+func (m DocumentVersionChangeMultiError) AllErrors() []error { return m }
 
-// AssignRolesToUsersValidationError is the validation error returned by
-// AssignRolesToUsers.Validate if the designated constraints aren't met.
-type AssignRolesToUsersValidationError struct {
+// DocumentVersionChangeValidationError is the validation error returned by
+// DocumentVersionChange.Validate if the designated constraints aren't met.
+type DocumentVersionChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3068,24 +4404,24 @@ type AssignRolesToUsersValidationError struct {
 }
 
 // Field function returns field value.
-func (e AssignRolesToUsersValidationError) Field() string { return e.field }
+func (e DocumentVersionChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AssignRolesToUsersValidationError) Reason() string { return e.reason }
+func (e DocumentVersionChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AssignRolesToUsersValidationError) Cause() error { return e.cause }
+func (e DocumentVersionChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AssignRolesToUsersValidationError) Key() bool { return e.key }
+func (e DocumentVersionChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AssignRolesToUsersValidationError) ErrorName() string {
-	return "AssignRolesToUsersValidationError"
+func (e DocumentVersionChangeValidationError) ErrorName() string {
+	return "DocumentVersionChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AssignRolesToUsersValidationError) Error() string {
+func (e DocumentVersionChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3097,14 +4433,14 @@ func (e AssignRolesToUsersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAssignRolesToUsers.%s: %s%s",
+		"invalid %sDocumentVersionChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AssignRolesToUsersValidationError{}
+var _ error = DocumentVersionChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3112,54 +4448,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AssignRolesToUsersValidationError{}
+} = DocumentVersionChangeValidationError{}
 
-// Validate checks the field values on ViewUserRoles with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ViewUserRoles) Validate() error {
+// Validate checks the field values on GetDocumentVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDocumentVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ViewUserRoles with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ViewUserRolesMultiError, or
-// nil if none found.
-func (m *ViewUserRoles) ValidateAll() error {
+// ValidateAll checks the field values on GetDocumentVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDocumentVersionsRequestMultiError, or nil if none found.
+func (m *GetDocumentVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ViewUserRoles) validate(all bool) error {
+func (m *GetDocumentVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Auth0Domain
-
-	// no validation rules for Auth0MgmtToken
-
-	// no validation rules for UserId
-
-	// no validation rules for UserEmail
-
-	// no validation rules for RequestId
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDocumentVersionsRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDocumentVersionsRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDocumentVersionsRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ViewUserRolesMultiError(errors)
+		return GetDocumentVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ViewUserRolesMultiError is an error wrapping multiple validation errors
-// returned by ViewUserRoles.ValidateAll() if the designated constraints
-// aren't met.
-type ViewUserRolesMultiError []error
+// GetDocumentVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDocumentVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDocumentVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ViewUserRolesMultiError) Error() string {
+func (m GetDocumentVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3168,11 +4523,11 @@ func (m ViewUserRolesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ViewUserRolesMultiError) AllErrors() []error { return m }
+func (m GetDocumentVersionsRequestMultiError) AllErrors() []error { return m }
 
-// ViewUserRolesValidationError is the validation error returned by
-// ViewUserRoles.Validate if the designated constraints aren't met.
-type ViewUserRolesValidationError struct {
+// GetDocumentVersionsRequestValidationError is the validation error returned
+// by GetDocumentVersionsRequest.Validate if the designated constraints aren't met.
+type GetDocumentVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3180,22 +4535,24 @@ type ViewUserRolesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ViewUserRolesValidationError) Field() string { return e.field }
+func (e GetDocumentVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ViewUserRolesValidationError) Reason() string { return e.reason }
+func (e GetDocumentVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ViewUserRolesValidationError) Cause() error { return e.cause }
+func (e GetDocumentVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ViewUserRolesValidationError) Key() bool { return e.key }
+func (e GetDocumentVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ViewUserRolesValidationError) ErrorName() string { return "ViewUserRolesValidationError" }
+func (e GetDocumentVersionsRequestValidationError) ErrorName() string {
+	return "GetDocumentVersionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ViewUserRolesValidationError) Error() string {
+func (e GetDocumentVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3207,14 +4564,14 @@ func (e ViewUserRolesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sViewUserRoles.%s: %s%s",
+		"invalid %sGetDocumentVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ViewUserRolesValidationError{}
+var _ error = GetDocumentVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3222,47 +4579,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ViewUserRolesValidationError{}
+} = GetDocumentVersionsRequestValidationError{}
 
-// Validate checks the field values on AddAPIPermission with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddAPIPermission) Validate() error {
+// Validate checks the field values on GetDocumentVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDocumentVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddAPIPermission with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDocumentVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddAPIPermissionMultiError, or nil if none found.
-func (m *AddAPIPermission) ValidateAll() error {
+// GetDocumentVersionsResponseMultiError, or nil if none found.
+func (m *GetDocumentVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddAPIPermission) validate(all bool) error {
+func (m *GetDocumentVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPermissions() {
+	for idx, item := range m.GetDocumentVersions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAPIPermissionValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
+					errors = append(errors, GetDocumentVersionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentVersions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAPIPermissionValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
+					errors = append(errors, GetDocumentVersionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentVersions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3270,8 +4627,8 @@ func (m *AddAPIPermission) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAPIPermissionValidationError{
-					field:  fmt.Sprintf("Permissions[%v]", idx),
+				return GetDocumentVersionsResponseValidationError{
+					field:  fmt.Sprintf("DocumentVersions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3280,32 +4637,133 @@ func (m *AddAPIPermission) validate(all bool) error {
 
 	}
 
-	// no validation rules for Auth0Domain
+	if len(errors) > 0 {
+		return GetDocumentVersionsResponseMultiError(errors)
+	}
 
-	// no validation rules for Auth0MgmtToken
+	return nil
+}
 
-	// no validation rules for Auth0ApiId
+// GetDocumentVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDocumentVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDocumentVersionsResponseMultiError []error
 
-	// no validation rules for UserId
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentVersionsResponseMultiError) AllErrors() []error { return m }
+
+// GetDocumentVersionsResponseValidationError is the validation error returned
+// by GetDocumentVersionsResponse.Validate if the designated constraints
+// aren't met.
+type GetDocumentVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentVersionsResponseValidationError) ErrorName() string {
+	return "GetDocumentVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentVersionsResponseValidationError{}
+
+// Validate checks the field values on DiffDocumentVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffDocumentVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffDocumentVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffDocumentVersionsRequestMultiError, or nil if none found.
+func (m *DiffDocumentVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffDocumentVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FromVersionNumber
+
+	// no validation rules for ToVersionNumber
 
 	// no validation rules for UserEmail
 
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return AddAPIPermissionMultiError(errors)
+		return DiffDocumentVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddAPIPermissionMultiError is an error wrapping multiple validation errors
-// returned by AddAPIPermission.ValidateAll() if the designated constraints
-// aren't met.
-type AddAPIPermissionMultiError []error
+// DiffDocumentVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiffDocumentVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiffDocumentVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddAPIPermissionMultiError) Error() string {
+func (m DiffDocumentVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3314,11 +4772,12 @@ func (m AddAPIPermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddAPIPermissionMultiError) AllErrors() []error { return m }
+func (m DiffDocumentVersionsRequestMultiError) AllErrors() []error { return m }
 
-// AddAPIPermissionValidationError is the validation error returned by
-// AddAPIPermission.Validate if the designated constraints aren't met.
-type AddAPIPermissionValidationError struct {
+// DiffDocumentVersionsRequestValidationError is the validation error returned
+// by DiffDocumentVersionsRequest.Validate if the designated constraints
+// aren't met.
+type DiffDocumentVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3326,22 +4785,24 @@ type AddAPIPermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddAPIPermissionValidationError) Field() string { return e.field }
+func (e DiffDocumentVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddAPIPermissionValidationError) Reason() string { return e.reason }
+func (e DiffDocumentVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddAPIPermissionValidationError) Cause() error { return e.cause }
+func (e DiffDocumentVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddAPIPermissionValidationError) Key() bool { return e.key }
+func (e DiffDocumentVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddAPIPermissionValidationError) ErrorName() string { return "AddAPIPermissionValidationError" }
+func (e DiffDocumentVersionsRequestValidationError) ErrorName() string {
+	return "DiffDocumentVersionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddAPIPermissionValidationError) Error() string {
+func (e DiffDocumentVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3353,14 +4814,14 @@ func (e AddAPIPermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddAPIPermission.%s: %s%s",
+		"invalid %sDiffDocumentVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddAPIPermissionValidationError{}
+var _ error = DiffDocumentVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3368,47 +4829,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddAPIPermissionValidationError{}
+} = DiffDocumentVersionsRequestValidationError{}
 
-// Validate checks the field values on Permission with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Permission) Validate() error {
+// Validate checks the field values on DiffDocumentVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffDocumentVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Permission with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PermissionMultiError, or
-// nil if none found.
-func (m *Permission) ValidateAll() error {
+// ValidateAll checks the field values on DiffDocumentVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffDocumentVersionsResponseMultiError, or nil if none found.
+func (m *DiffDocumentVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Permission) validate(all bool) error {
+func (m *DiffDocumentVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PermissionName
+	for idx, item := range m.GetDocumentVersionChanges() {
+		_, _ = idx, item
 
-	// no validation rules for PermissionDescription
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffDocumentVersionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentVersionChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffDocumentVersionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentVersionChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffDocumentVersionsResponseValidationError{
+					field:  fmt.Sprintf("DocumentVersionChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return PermissionMultiError(errors)
+		return DiffDocumentVersionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionMultiError is an error wrapping multiple validation errors
-// returned by Permission.ValidateAll() if the designated constraints aren't met.
-type PermissionMultiError []error
+// DiffDocumentVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffDocumentVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffDocumentVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionMultiError) Error() string {
+func (m DiffDocumentVersionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3417,11 +4909,12 @@ func (m PermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionMultiError) AllErrors() []error { return m }
+func (m DiffDocumentVersionsResponseMultiError) AllErrors() []error { return m }
 
-// PermissionValidationError is the validation error returned by
-// Permission.Validate if the designated constraints aren't met.
-type PermissionValidationError struct {
+// DiffDocumentVersionsResponseValidationError is the validation error returned
+// by DiffDocumentVersionsResponse.Validate if the designated constraints
+// aren't met.
+type DiffDocumentVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3429,22 +4922,24 @@ type PermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionValidationError) Field() string { return e.field }
+func (e DiffDocumentVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionValidationError) Reason() string { return e.reason }
+func (e DiffDocumentVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionValidationError) Cause() error { return e.cause }
+func (e DiffDocumentVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionValidationError) Key() bool { return e.key }
+func (e DiffDocumentVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionValidationError) ErrorName() string { return "PermissionValidationError" }
+func (e DiffDocumentVersionsResponseValidationError) ErrorName() string {
+	return "DiffDocumentVersionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PermissionValidationError) Error() string {
+func (e DiffDocumentVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3456,14 +4951,14 @@ func (e PermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermission.%s: %s%s",
+		"invalid %sDiffDocumentVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionValidationError{}
+var _ error = DiffDocumentVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3471,4 +4966,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionValidationError{}
+} = DiffDocumentVersionsResponseValidationError{}
@@ -27,10 +27,11 @@ type CreditNoteHeader struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditNoteHeaderD *CreditNoteHeaderD `protobuf:"bytes,1,opt,name=credit_note_header_d,json=creditNoteHeaderD,proto3" json:"credit_note_header_d,omitempty"`
-	CreditNoteHeaderT *CreditNoteHeaderT `protobuf:"bytes,2,opt,name=credit_note_header_t,json=creditNoteHeaderT,proto3" json:"credit_note_header_t,omitempty"`
-	CrUpdUser         *v1.CrUpdUser      `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
-	CrUpdTime         *v1.CrUpdTime      `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	CreditNoteHeaderD *CreditNoteHeaderD    `protobuf:"bytes,1,opt,name=credit_note_header_d,json=creditNoteHeaderD,proto3" json:"credit_note_header_d,omitempty"`
+	CreditNoteHeaderT *CreditNoteHeaderT    `protobuf:"bytes,2,opt,name=credit_note_header_t,json=creditNoteHeaderT,proto3" json:"credit_note_header_t,omitempty"`
+	CrUpdUser         *v1.CrUpdUser         `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime         *v1.CrUpdTime         `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	AllowanceCharges  []*v1.AllowanceCharge `protobuf:"bytes,5,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
 }
 
 func (x *CreditNoteHeader) Reset() {
@@ -91,6 +92,13 @@ func (x *CreditNoteHeader) GetCrUpdTime() *v1.CrUpdTime {
 	return nil
 }
 
+func (x *CreditNoteHeader) GetAllowanceCharges() []*v1.AllowanceCharge {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
 type CreditNoteHeaderD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -152,16 +160,22 @@ type CreditNoteHeaderD struct {
 	PaymentAltExExchangeMarketId       uint32  `protobuf:"varint,54,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
 	PaymentAltExCalculationRate        float64 `protobuf:"fixed64,55,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
 	PaymentAltExMathematicOperatorCode string  `protobuf:"bytes,56,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
-	LineExtensionAmount                float64 `protobuf:"fixed64,57,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxExclusiveAmount                 float64 `protobuf:"fixed64,58,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
-	TaxInclusiveAmount                 float64 `protobuf:"fixed64,59,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
-	AllowanceTotalAmount               float64 `protobuf:"fixed64,60,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
-	ChargeTotalAmount                  float64 `protobuf:"fixed64,61,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	WithholdingTaxTotalAmount          float64 `protobuf:"fixed64,62,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
-	PrepaidAmount                      float64 `protobuf:"fixed64,63,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount              float64 `protobuf:"fixed64,64,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount                      float64 `protobuf:"fixed64,65,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	PayableAlternativeAmount           float64 `protobuf:"fixed64,66,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	LineExtensionAmount                string  `protobuf:"bytes,57,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxExclusiveAmount                 string  `protobuf:"bytes,58,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
+	TaxInclusiveAmount                 string  `protobuf:"bytes,59,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
+	AllowanceTotalAmount               string  `protobuf:"bytes,60,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
+	ChargeTotalAmount                  string  `protobuf:"bytes,61,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	WithholdingTaxTotalAmount          string  `protobuf:"bytes,62,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
+	PrepaidAmount                      string  `protobuf:"bytes,63,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount              string  `protobuf:"bytes,64,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount                      string  `protobuf:"bytes,65,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAlternativeAmount           string  `protobuf:"bytes,66,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	BuyerReference                     string  `protobuf:"bytes,67,opt,name=buyer_reference,json=buyerReference,proto3" json:"buyer_reference,omitempty"`
+	// document_status_code - where the document is in its lifecycle, draft,
+	// issued, sent, acknowledged, partially_paid, paid, disputed or cancelled;
+	// the record status of its row is cr_upd_user.status_code
+	DocumentStatusCode             string `protobuf:"bytes,68,opt,name=document_status_code,json=documentStatusCode,proto3" json:"document_status_code,omitempty"`
+	PayableAlternativeCurrencyCode string `protobuf:"bytes,69,opt,name=payable_alternative_currency_code,json=payableAlternativeCurrencyCode,proto3" json:"payable_alternative_currency_code,omitempty"`
 }
 
 func (x *CreditNoteHeaderD) Reset() {
@@ -586,74 +600,95 @@ func (x *CreditNoteHeaderD) GetPaymentAltExMathematicOperatorCode() string {
 	return ""
 }
 
-func (x *CreditNoteHeaderD) GetLineExtensionAmount() float64 {
+func (x *CreditNoteHeaderD) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetTaxExclusiveAmount() float64 {
+func (x *CreditNoteHeaderD) GetTaxExclusiveAmount() string {
 	if x != nil {
 		return x.TaxExclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetTaxInclusiveAmount() float64 {
+func (x *CreditNoteHeaderD) GetTaxInclusiveAmount() string {
 	if x != nil {
 		return x.TaxInclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetAllowanceTotalAmount() float64 {
+func (x *CreditNoteHeaderD) GetAllowanceTotalAmount() string {
 	if x != nil {
 		return x.AllowanceTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetChargeTotalAmount() float64 {
+func (x *CreditNoteHeaderD) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetWithholdingTaxTotalAmount() float64 {
+func (x *CreditNoteHeaderD) GetWithholdingTaxTotalAmount() string {
 	if x != nil {
 		return x.WithholdingTaxTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetPrepaidAmount() float64 {
+func (x *CreditNoteHeaderD) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetPayableRoundingAmount() float64 {
+func (x *CreditNoteHeaderD) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetPayableAmount() float64 {
+func (x *CreditNoteHeaderD) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreditNoteHeaderD) GetPayableAlternativeAmount() float64 {
+func (x *CreditNoteHeaderD) GetPayableAlternativeAmount() string {
 	if x != nil {
 		return x.PayableAlternativeAmount
 	}
-	return 0
+	return ""
+}
+
+func (x *CreditNoteHeaderD) GetBuyerReference() string {
+	if x != nil {
+		return x.BuyerReference
+	}
+	return ""
+}
+
+func (x *CreditNoteHeaderD) GetDocumentStatusCode() string {
+	if x != nil {
+		return x.DocumentStatusCode
+	}
+	return ""
+}
+
+func (x *CreditNoteHeaderD) GetPayableAlternativeCurrencyCode() string {
+	if x != nil {
+		return x.PayableAlternativeCurrencyCode
+	}
+	return ""
 }
 
 type CreditNoteHeaderT struct {
@@ -770,82 +805,86 @@ type CreateCreditNoteHeaderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CnhId                              string                         `protobuf:"bytes,1,opt,name=cnh_id,json=cnhId,proto3" json:"cnh_id,omitempty"`
-	IssueDate                          string                         `protobuf:"bytes,2,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
-	DueDate                            string                         `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	TaxPointDate                       string                         `protobuf:"bytes,4,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
-	CreditNoteTypeCode                 string                         `protobuf:"bytes,5,opt,name=credit_note_type_code,json=creditNoteTypeCode,proto3" json:"credit_note_type_code,omitempty"`
-	Note                               string                         `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
-	DocumentCurrencyCode               string                         `protobuf:"bytes,7,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
-	TaxCurrencyCode                    string                         `protobuf:"bytes,8,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
-	PricingCurrencyCode                string                         `protobuf:"bytes,9,opt,name=pricing_currency_code,json=pricingCurrencyCode,proto3" json:"pricing_currency_code,omitempty"`
-	PaymentCurrencyCode                string                         `protobuf:"bytes,10,opt,name=payment_currency_code,json=paymentCurrencyCode,proto3" json:"payment_currency_code,omitempty"`
-	PaymentAltCurrencyCode             string                         `protobuf:"bytes,11,opt,name=payment_alt_currency_code,json=paymentAltCurrencyCode,proto3" json:"payment_alt_currency_code,omitempty"`
-	AccountingCostCode                 string                         `protobuf:"bytes,12,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost                     string                         `protobuf:"bytes,13,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	LineCountNumeric                   uint32                         `protobuf:"varint,14,opt,name=line_count_numeric,json=lineCountNumeric,proto3" json:"line_count_numeric,omitempty"`
-	InvoicePeriodStartDate             string                         `protobuf:"bytes,15,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
-	InvoicePeriodEndDate               string                         `protobuf:"bytes,16,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
-	DiscrepancyResponse                string                         `protobuf:"bytes,17,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	OrderId                            uint32                         `protobuf:"varint,18,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	BillingId                          uint32                         `protobuf:"varint,19,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	DespatchId                         uint32                         `protobuf:"varint,20,opt,name=despatch_id,json=despatchId,proto3" json:"despatch_id,omitempty"`
-	ReceiptId                          uint32                         `protobuf:"varint,21,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
-	ContractId                         uint32                         `protobuf:"varint,22,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	StatementId                        uint32                         `protobuf:"varint,24,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
-	Signature                          string                         `protobuf:"bytes,27,opt,name=signature,proto3" json:"signature,omitempty"`
-	AccountingSupplierPartyId          uint32                         `protobuf:"varint,28,opt,name=accounting_supplier_party_id,json=accountingSupplierPartyId,proto3" json:"accounting_supplier_party_id,omitempty"`
-	AccountingCustomerPartyId          uint32                         `protobuf:"varint,29,opt,name=accounting_customer_party_id,json=accountingCustomerPartyId,proto3" json:"accounting_customer_party_id,omitempty"`
-	PayeePartyId                       uint32                         `protobuf:"varint,30,opt,name=payee_party_id,json=payeePartyId,proto3" json:"payee_party_id,omitempty"`
-	BuyerCustomerPartyId               uint32                         `protobuf:"varint,31,opt,name=buyer_customer_party_id,json=buyerCustomerPartyId,proto3" json:"buyer_customer_party_id,omitempty"`
-	SellerSupplierPartyId              uint32                         `protobuf:"varint,32,opt,name=seller_supplier_party_id,json=sellerSupplierPartyId,proto3" json:"seller_supplier_party_id,omitempty"`
-	TaxRepresentativePartyId           uint32                         `protobuf:"varint,33,opt,name=tax_representative_party_id,json=taxRepresentativePartyId,proto3" json:"tax_representative_party_id,omitempty"`
-	TaxExSourceCurrencyCode            string                         `protobuf:"bytes,34,opt,name=tax_ex_source_currency_code,json=taxExSourceCurrencyCode,proto3" json:"tax_ex_source_currency_code,omitempty"`
-	TaxExSourceCurrencyBaseRate        string                         `protobuf:"bytes,35,opt,name=tax_ex_source_currency_base_rate,json=taxExSourceCurrencyBaseRate,proto3" json:"tax_ex_source_currency_base_rate,omitempty"`
-	TaxExTargetCurrencyCode            string                         `protobuf:"bytes,36,opt,name=tax_ex_target_currency_code,json=taxExTargetCurrencyCode,proto3" json:"tax_ex_target_currency_code,omitempty"`
-	TaxExTargetCurrencyBaseRate        string                         `protobuf:"bytes,37,opt,name=tax_ex_target_currency_base_rate,json=taxExTargetCurrencyBaseRate,proto3" json:"tax_ex_target_currency_base_rate,omitempty"`
-	TaxExExchangeMarketId              uint32                         `protobuf:"varint,38,opt,name=tax_ex_exchange_market_id,json=taxExExchangeMarketId,proto3" json:"tax_ex_exchange_market_id,omitempty"`
-	TaxExCalculationRate               float64                        `protobuf:"fixed64,39,opt,name=tax_ex_calculation_rate,json=taxExCalculationRate,proto3" json:"tax_ex_calculation_rate,omitempty"`
-	TaxExMathematicOperatorCode        string                         `protobuf:"bytes,40,opt,name=tax_ex_mathematic_operator_code,json=taxExMathematicOperatorCode,proto3" json:"tax_ex_mathematic_operator_code,omitempty"`
-	TaxExDate                          string                         `protobuf:"bytes,41,opt,name=tax_ex_date,json=taxExDate,proto3" json:"tax_ex_date,omitempty"`
-	PricingExSourceCurrencyCode        string                         `protobuf:"bytes,42,opt,name=pricing_ex_source_currency_code,json=pricingExSourceCurrencyCode,proto3" json:"pricing_ex_source_currency_code,omitempty"`
-	PricingExSourceCurrencyBaseRate    string                         `protobuf:"bytes,43,opt,name=pricing_ex_source_currency_base_rate,json=pricingExSourceCurrencyBaseRate,proto3" json:"pricing_ex_source_currency_base_rate,omitempty"`
-	PricingExTargetCurrencyCode        string                         `protobuf:"bytes,44,opt,name=pricing_ex_target_currency_code,json=pricingExTargetCurrencyCode,proto3" json:"pricing_ex_target_currency_code,omitempty"`
-	PricingExTargetCurrencyBaseRate    string                         `protobuf:"bytes,45,opt,name=pricing_ex_target_currency_base_rate,json=pricingExTargetCurrencyBaseRate,proto3" json:"pricing_ex_target_currency_base_rate,omitempty"`
-	PricingExExchangeMarketId          uint32                         `protobuf:"varint,46,opt,name=pricing_ex_exchange_market_id,json=pricingExExchangeMarketId,proto3" json:"pricing_ex_exchange_market_id,omitempty"`
-	PricingExCalculationRate           float64                        `protobuf:"fixed64,47,opt,name=pricing_ex_calculation_rate,json=pricingExCalculationRate,proto3" json:"pricing_ex_calculation_rate,omitempty"`
-	PricingExMathematicOperatorCode    string                         `protobuf:"bytes,48,opt,name=pricing_ex_mathematic_operator_code,json=pricingExMathematicOperatorCode,proto3" json:"pricing_ex_mathematic_operator_code,omitempty"`
-	PricingExDate                      string                         `protobuf:"bytes,49,opt,name=pricing_ex_date,json=pricingExDate,proto3" json:"pricing_ex_date,omitempty"`
-	PaymentExSourceCurrencyCode        string                         `protobuf:"bytes,50,opt,name=payment_ex_source_currency_code,json=paymentExSourceCurrencyCode,proto3" json:"payment_ex_source_currency_code,omitempty"`
-	PaymentExSourceCurrencyBaseRate    string                         `protobuf:"bytes,51,opt,name=payment_ex_source_currency_base_rate,json=paymentExSourceCurrencyBaseRate,proto3" json:"payment_ex_source_currency_base_rate,omitempty"`
-	PaymentExTargetCurrencyCode        string                         `protobuf:"bytes,52,opt,name=payment_ex_target_currency_code,json=paymentExTargetCurrencyCode,proto3" json:"payment_ex_target_currency_code,omitempty"`
-	PaymentExTargetCurrencyBaseRate    string                         `protobuf:"bytes,53,opt,name=payment_ex_target_currency_base_rate,json=paymentExTargetCurrencyBaseRate,proto3" json:"payment_ex_target_currency_base_rate,omitempty"`
-	PaymentExExchangeMarketId          uint32                         `protobuf:"varint,54,opt,name=payment_ex_exchange_market_id,json=paymentExExchangeMarketId,proto3" json:"payment_ex_exchange_market_id,omitempty"`
-	PaymentExCalculationRate           float64                        `protobuf:"fixed64,55,opt,name=payment_ex_calculation_rate,json=paymentExCalculationRate,proto3" json:"payment_ex_calculation_rate,omitempty"`
-	PaymentExMathematicOperatorCode    string                         `protobuf:"bytes,56,opt,name=payment_ex_mathematic_operator_code,json=paymentExMathematicOperatorCode,proto3" json:"payment_ex_mathematic_operator_code,omitempty"`
-	PaymentExDate                      string                         `protobuf:"bytes,57,opt,name=payment_ex_date,json=paymentExDate,proto3" json:"payment_ex_date,omitempty"`
-	PaymentAltExSourceCurrencyCode     string                         `protobuf:"bytes,58,opt,name=payment_alt_ex_source_currency_code,json=paymentAltExSourceCurrencyCode,proto3" json:"payment_alt_ex_source_currency_code,omitempty"`
-	PaymentAltExSourceCurrencyBaseRate string                         `protobuf:"bytes,59,opt,name=payment_alt_ex_source_currency_base_rate,json=paymentAltExSourceCurrencyBaseRate,proto3" json:"payment_alt_ex_source_currency_base_rate,omitempty"`
-	PaymentAltExTargetCurrencyCode     string                         `protobuf:"bytes,60,opt,name=payment_alt_ex_target_currency_code,json=paymentAltExTargetCurrencyCode,proto3" json:"payment_alt_ex_target_currency_code,omitempty"`
-	PaymentAltExTargetCurrencyBaseRate string                         `protobuf:"bytes,61,opt,name=payment_alt_ex_target_currency_base_rate,json=paymentAltExTargetCurrencyBaseRate,proto3" json:"payment_alt_ex_target_currency_base_rate,omitempty"`
-	PaymentAltExExchangeMarketId       uint32                         `protobuf:"varint,62,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
-	PaymentAltExCalculationRate        float64                        `protobuf:"fixed64,63,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
-	PaymentAltExMathematicOperatorCode string                         `protobuf:"bytes,64,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
-	PaymentAltExDate                   string                         `protobuf:"bytes,65,opt,name=payment_alt_ex_date,json=paymentAltExDate,proto3" json:"payment_alt_ex_date,omitempty"`
-	LineExtensionAmount                float64                        `protobuf:"fixed64,66,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxExclusiveAmount                 float64                        `protobuf:"fixed64,67,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
-	TaxInclusiveAmount                 float64                        `protobuf:"fixed64,68,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
-	AllowanceTotalAmount               float64                        `protobuf:"fixed64,69,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
-	ChargeTotalAmount                  float64                        `protobuf:"fixed64,70,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	WithholdingTaxTotalAmount          float64                        `protobuf:"fixed64,71,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
-	PrepaidAmount                      float64                        `protobuf:"fixed64,72,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount              float64                        `protobuf:"fixed64,73,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount                      float64                        `protobuf:"fixed64,74,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	PayableAlternativeAmount           float64                        `protobuf:"fixed64,75,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
-	UserId                             string                         `protobuf:"bytes,76,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail                          string                         `protobuf:"bytes,77,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                          string                         `protobuf:"bytes,78,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	CreditNoteLines                    []*CreateCreditNoteLineRequest `protobuf:"bytes,79,rep,name=credit_note_lines,json=creditNoteLines,proto3" json:"credit_note_lines,omitempty"`
+	CnhId                              string                             `protobuf:"bytes,1,opt,name=cnh_id,json=cnhId,proto3" json:"cnh_id,omitempty"`
+	IssueDate                          string                             `protobuf:"bytes,2,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	DueDate                            string                             `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	TaxPointDate                       string                             `protobuf:"bytes,4,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
+	CreditNoteTypeCode                 string                             `protobuf:"bytes,5,opt,name=credit_note_type_code,json=creditNoteTypeCode,proto3" json:"credit_note_type_code,omitempty"`
+	Note                               string                             `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	DocumentCurrencyCode               string                             `protobuf:"bytes,7,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
+	TaxCurrencyCode                    string                             `protobuf:"bytes,8,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
+	PricingCurrencyCode                string                             `protobuf:"bytes,9,opt,name=pricing_currency_code,json=pricingCurrencyCode,proto3" json:"pricing_currency_code,omitempty"`
+	PaymentCurrencyCode                string                             `protobuf:"bytes,10,opt,name=payment_currency_code,json=paymentCurrencyCode,proto3" json:"payment_currency_code,omitempty"`
+	PaymentAltCurrencyCode             string                             `protobuf:"bytes,11,opt,name=payment_alt_currency_code,json=paymentAltCurrencyCode,proto3" json:"payment_alt_currency_code,omitempty"`
+	AccountingCostCode                 string                             `protobuf:"bytes,12,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost                     string                             `protobuf:"bytes,13,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	LineCountNumeric                   uint32                             `protobuf:"varint,14,opt,name=line_count_numeric,json=lineCountNumeric,proto3" json:"line_count_numeric,omitempty"`
+	InvoicePeriodStartDate             string                             `protobuf:"bytes,15,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
+	InvoicePeriodEndDate               string                             `protobuf:"bytes,16,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
+	DiscrepancyResponse                string                             `protobuf:"bytes,17,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	OrderId                            uint32                             `protobuf:"varint,18,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BillingId                          uint32                             `protobuf:"varint,19,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	DespatchId                         uint32                             `protobuf:"varint,20,opt,name=despatch_id,json=despatchId,proto3" json:"despatch_id,omitempty"`
+	ReceiptId                          uint32                             `protobuf:"varint,21,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	ContractId                         uint32                             `protobuf:"varint,22,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	StatementId                        uint32                             `protobuf:"varint,24,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	Signature                          string                             `protobuf:"bytes,27,opt,name=signature,proto3" json:"signature,omitempty"`
+	AccountingSupplierPartyId          uint32                             `protobuf:"varint,28,opt,name=accounting_supplier_party_id,json=accountingSupplierPartyId,proto3" json:"accounting_supplier_party_id,omitempty"`
+	AccountingCustomerPartyId          uint32                             `protobuf:"varint,29,opt,name=accounting_customer_party_id,json=accountingCustomerPartyId,proto3" json:"accounting_customer_party_id,omitempty"`
+	PayeePartyId                       uint32                             `protobuf:"varint,30,opt,name=payee_party_id,json=payeePartyId,proto3" json:"payee_party_id,omitempty"`
+	BuyerCustomerPartyId               uint32                             `protobuf:"varint,31,opt,name=buyer_customer_party_id,json=buyerCustomerPartyId,proto3" json:"buyer_customer_party_id,omitempty"`
+	SellerSupplierPartyId              uint32                             `protobuf:"varint,32,opt,name=seller_supplier_party_id,json=sellerSupplierPartyId,proto3" json:"seller_supplier_party_id,omitempty"`
+	TaxRepresentativePartyId           uint32                             `protobuf:"varint,33,opt,name=tax_representative_party_id,json=taxRepresentativePartyId,proto3" json:"tax_representative_party_id,omitempty"`
+	TaxExSourceCurrencyCode            string                             `protobuf:"bytes,34,opt,name=tax_ex_source_currency_code,json=taxExSourceCurrencyCode,proto3" json:"tax_ex_source_currency_code,omitempty"`
+	TaxExSourceCurrencyBaseRate        string                             `protobuf:"bytes,35,opt,name=tax_ex_source_currency_base_rate,json=taxExSourceCurrencyBaseRate,proto3" json:"tax_ex_source_currency_base_rate,omitempty"`
+	TaxExTargetCurrencyCode            string                             `protobuf:"bytes,36,opt,name=tax_ex_target_currency_code,json=taxExTargetCurrencyCode,proto3" json:"tax_ex_target_currency_code,omitempty"`
+	TaxExTargetCurrencyBaseRate        string                             `protobuf:"bytes,37,opt,name=tax_ex_target_currency_base_rate,json=taxExTargetCurrencyBaseRate,proto3" json:"tax_ex_target_currency_base_rate,omitempty"`
+	TaxExExchangeMarketId              uint32                             `protobuf:"varint,38,opt,name=tax_ex_exchange_market_id,json=taxExExchangeMarketId,proto3" json:"tax_ex_exchange_market_id,omitempty"`
+	TaxExCalculationRate               float64                            `protobuf:"fixed64,39,opt,name=tax_ex_calculation_rate,json=taxExCalculationRate,proto3" json:"tax_ex_calculation_rate,omitempty"`
+	TaxExMathematicOperatorCode        string                             `protobuf:"bytes,40,opt,name=tax_ex_mathematic_operator_code,json=taxExMathematicOperatorCode,proto3" json:"tax_ex_mathematic_operator_code,omitempty"`
+	TaxExDate                          string                             `protobuf:"bytes,41,opt,name=tax_ex_date,json=taxExDate,proto3" json:"tax_ex_date,omitempty"`
+	PricingExSourceCurrencyCode        string                             `protobuf:"bytes,42,opt,name=pricing_ex_source_currency_code,json=pricingExSourceCurrencyCode,proto3" json:"pricing_ex_source_currency_code,omitempty"`
+	PricingExSourceCurrencyBaseRate    string                             `protobuf:"bytes,43,opt,name=pricing_ex_source_currency_base_rate,json=pricingExSourceCurrencyBaseRate,proto3" json:"pricing_ex_source_currency_base_rate,omitempty"`
+	PricingExTargetCurrencyCode        string                             `protobuf:"bytes,44,opt,name=pricing_ex_target_currency_code,json=pricingExTargetCurrencyCode,proto3" json:"pricing_ex_target_currency_code,omitempty"`
+	PricingExTargetCurrencyBaseRate    string                             `protobuf:"bytes,45,opt,name=pricing_ex_target_currency_base_rate,json=pricingExTargetCurrencyBaseRate,proto3" json:"pricing_ex_target_currency_base_rate,omitempty"`
+	PricingExExchangeMarketId          uint32                             `protobuf:"varint,46,opt,name=pricing_ex_exchange_market_id,json=pricingExExchangeMarketId,proto3" json:"pricing_ex_exchange_market_id,omitempty"`
+	PricingExCalculationRate           float64                            `protobuf:"fixed64,47,opt,name=pricing_ex_calculation_rate,json=pricingExCalculationRate,proto3" json:"pricing_ex_calculation_rate,omitempty"`
+	PricingExMathematicOperatorCode    string                             `protobuf:"bytes,48,opt,name=pricing_ex_mathematic_operator_code,json=pricingExMathematicOperatorCode,proto3" json:"pricing_ex_mathematic_operator_code,omitempty"`
+	PricingExDate                      string                             `protobuf:"bytes,49,opt,name=pricing_ex_date,json=pricingExDate,proto3" json:"pricing_ex_date,omitempty"`
+	PaymentExSourceCurrencyCode        string                             `protobuf:"bytes,50,opt,name=payment_ex_source_currency_code,json=paymentExSourceCurrencyCode,proto3" json:"payment_ex_source_currency_code,omitempty"`
+	PaymentExSourceCurrencyBaseRate    string                             `protobuf:"bytes,51,opt,name=payment_ex_source_currency_base_rate,json=paymentExSourceCurrencyBaseRate,proto3" json:"payment_ex_source_currency_base_rate,omitempty"`
+	PaymentExTargetCurrencyCode        string                             `protobuf:"bytes,52,opt,name=payment_ex_target_currency_code,json=paymentExTargetCurrencyCode,proto3" json:"payment_ex_target_currency_code,omitempty"`
+	PaymentExTargetCurrencyBaseRate    string                             `protobuf:"bytes,53,opt,name=payment_ex_target_currency_base_rate,json=paymentExTargetCurrencyBaseRate,proto3" json:"payment_ex_target_currency_base_rate,omitempty"`
+	PaymentExExchangeMarketId          uint32                             `protobuf:"varint,54,opt,name=payment_ex_exchange_market_id,json=paymentExExchangeMarketId,proto3" json:"payment_ex_exchange_market_id,omitempty"`
+	PaymentExCalculationRate           float64                            `protobuf:"fixed64,55,opt,name=payment_ex_calculation_rate,json=paymentExCalculationRate,proto3" json:"payment_ex_calculation_rate,omitempty"`
+	PaymentExMathematicOperatorCode    string                             `protobuf:"bytes,56,opt,name=payment_ex_mathematic_operator_code,json=paymentExMathematicOperatorCode,proto3" json:"payment_ex_mathematic_operator_code,omitempty"`
+	PaymentExDate                      string                             `protobuf:"bytes,57,opt,name=payment_ex_date,json=paymentExDate,proto3" json:"payment_ex_date,omitempty"`
+	PaymentAltExSourceCurrencyCode     string                             `protobuf:"bytes,58,opt,name=payment_alt_ex_source_currency_code,json=paymentAltExSourceCurrencyCode,proto3" json:"payment_alt_ex_source_currency_code,omitempty"`
+	PaymentAltExSourceCurrencyBaseRate string                             `protobuf:"bytes,59,opt,name=payment_alt_ex_source_currency_base_rate,json=paymentAltExSourceCurrencyBaseRate,proto3" json:"payment_alt_ex_source_currency_base_rate,omitempty"`
+	PaymentAltExTargetCurrencyCode     string                             `protobuf:"bytes,60,opt,name=payment_alt_ex_target_currency_code,json=paymentAltExTargetCurrencyCode,proto3" json:"payment_alt_ex_target_currency_code,omitempty"`
+	PaymentAltExTargetCurrencyBaseRate string                             `protobuf:"bytes,61,opt,name=payment_alt_ex_target_currency_base_rate,json=paymentAltExTargetCurrencyBaseRate,proto3" json:"payment_alt_ex_target_currency_base_rate,omitempty"`
+	PaymentAltExExchangeMarketId       uint32                             `protobuf:"varint,62,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
+	PaymentAltExCalculationRate        float64                            `protobuf:"fixed64,63,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
+	PaymentAltExMathematicOperatorCode string                             `protobuf:"bytes,64,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
+	PaymentAltExDate                   string                             `protobuf:"bytes,65,opt,name=payment_alt_ex_date,json=paymentAltExDate,proto3" json:"payment_alt_ex_date,omitempty"`
+	LineExtensionAmount                string                             `protobuf:"bytes,66,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxExclusiveAmount                 string                             `protobuf:"bytes,67,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
+	TaxInclusiveAmount                 string                             `protobuf:"bytes,68,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
+	AllowanceTotalAmount               string                             `protobuf:"bytes,69,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
+	ChargeTotalAmount                  string                             `protobuf:"bytes,70,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	WithholdingTaxTotalAmount          string                             `protobuf:"bytes,71,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
+	PrepaidAmount                      string                             `protobuf:"bytes,72,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount              string                             `protobuf:"bytes,73,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount                      string                             `protobuf:"bytes,74,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAlternativeAmount           string                             `protobuf:"bytes,75,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	UserId                             string                             `protobuf:"bytes,76,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                          string                             `protobuf:"bytes,77,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                          string                             `protobuf:"bytes,78,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreditNoteLines                    []*CreateCreditNoteLineRequest     `protobuf:"bytes,79,rep,name=credit_note_lines,json=creditNoteLines,proto3" json:"credit_note_lines,omitempty"`
+	BuyerReference                     string                             `protobuf:"bytes,80,opt,name=buyer_reference,json=buyerReference,proto3" json:"buyer_reference,omitempty"`
+	AllowanceCharges                   []*v1.CreateAllowanceChargeRequest `protobuf:"bytes,81,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+	IdempotencyKey                     string                             `protobuf:"bytes,82,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	PayableAlternativeCurrencyCode     string                             `protobuf:"bytes,83,opt,name=payable_alternative_currency_code,json=payableAlternativeCurrencyCode,proto3" json:"payable_alternative_currency_code,omitempty"`
 }
 
 func (x *CreateCreditNoteHeaderRequest) Reset() {
@@ -1312,74 +1351,74 @@ func (x *CreateCreditNoteHeaderRequest) GetPaymentAltExDate() string {
 	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetLineExtensionAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetTaxExclusiveAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetTaxExclusiveAmount() string {
 	if x != nil {
 		return x.TaxExclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetTaxInclusiveAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetTaxInclusiveAmount() string {
 	if x != nil {
 		return x.TaxInclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetAllowanceTotalAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetAllowanceTotalAmount() string {
 	if x != nil {
 		return x.AllowanceTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetChargeTotalAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetWithholdingTaxTotalAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetWithholdingTaxTotalAmount() string {
 	if x != nil {
 		return x.WithholdingTaxTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetPrepaidAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetPayableRoundingAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetPayableAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteHeaderRequest) GetPayableAlternativeAmount() float64 {
+func (x *CreateCreditNoteHeaderRequest) GetPayableAlternativeAmount() string {
 	if x != nil {
 		return x.PayableAlternativeAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreateCreditNoteHeaderRequest) GetUserId() string {
@@ -1410,6 +1449,34 @@ func (x *CreateCreditNoteHeaderRequest) GetCreditNoteLines() []*CreateCreditNote
 	return nil
 }
 
+func (x *CreateCreditNoteHeaderRequest) GetBuyerReference() string {
+	if x != nil {
+		return x.BuyerReference
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteHeaderRequest) GetAllowanceCharges() []*v1.CreateAllowanceChargeRequest {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+func (x *CreateCreditNoteHeaderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteHeaderRequest) GetPayableAlternativeCurrencyCode() string {
+	if x != nil {
+		return x.PayableAlternativeCurrencyCode
+	}
+	return ""
+}
+
 type CreateCreditNoteHeaderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1460,16 +1527,16 @@ type UpdateCreditNoteHeaderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Note                  string  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
-	TaxCurrencyCode       string  `protobuf:"bytes,2,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
-	ChargeTotalAmount     float64 `protobuf:"fixed64,3,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	PrepaidAmount         float64 `protobuf:"fixed64,4,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount float64 `protobuf:"fixed64,5,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount         float64 `protobuf:"fixed64,6,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	Id                    string  `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
-	UserId                string  `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail             string  `protobuf:"bytes,9,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId             string  `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Note                  string `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	TaxCurrencyCode       string `protobuf:"bytes,2,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
+	ChargeTotalAmount     string `protobuf:"bytes,3,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	PrepaidAmount         string `protobuf:"bytes,4,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount string `protobuf:"bytes,5,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount         string `protobuf:"bytes,6,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	Id                    string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                string `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail             string `protobuf:"bytes,9,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId             string `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
 func (x *UpdateCreditNoteHeaderRequest) Reset() {
@@ -1516,32 +1583,32 @@ func (x *UpdateCreditNoteHeaderRequest) GetTaxCurrencyCode() string {
 	return ""
 }
 
-func (x *UpdateCreditNoteHeaderRequest) GetChargeTotalAmount() float64 {
+func (x *UpdateCreditNoteHeaderRequest) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCreditNoteHeaderRequest) GetPrepaidAmount() float64 {
+func (x *UpdateCreditNoteHeaderRequest) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCreditNoteHeaderRequest) GetPayableRoundingAmount() float64 {
+func (x *UpdateCreditNoteHeaderRequest) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCreditNoteHeaderRequest) GetPayableAmount() float64 {
+func (x *UpdateCreditNoteHeaderRequest) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *UpdateCreditNoteHeaderRequest) GetId() string {
@@ -1608,28 +1675,29 @@ func (*UpdateCreditNoteHeaderResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{6}
 }
 
-type GetCreditNoteHeaderRequest struct {
+type ReviseCreditNoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+	UpdateCreditNoteHeaderRequest *UpdateCreditNoteHeaderRequest `protobuf:"bytes,1,opt,name=update_credit_note_header_request,json=updateCreditNoteHeaderRequest,proto3" json:"update_credit_note_header_request,omitempty"`
+	Reason                        string                         `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetCreditNoteHeaderRequest) Reset() {
-	*x = GetCreditNoteHeaderRequest{}
+func (x *ReviseCreditNoteRequest) Reset() {
+	*x = ReviseCreditNoteRequest{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeaderRequest) String() string {
+func (x *ReviseCreditNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeaderRequest) ProtoMessage() {}
+func (*ReviseCreditNoteRequest) ProtoMessage() {}
 
-func (x *GetCreditNoteHeaderRequest) ProtoReflect() protoreflect.Message {
+func (x *ReviseCreditNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1641,40 +1709,47 @@ func (x *GetCreditNoteHeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeaderRequest.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeaderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviseCreditNoteRequest.ProtoReflect.Descriptor instead.
+func (*ReviseCreditNoteRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCreditNoteHeaderRequest) GetGetRequest() *v1.GetRequest {
+func (x *ReviseCreditNoteRequest) GetUpdateCreditNoteHeaderRequest() *UpdateCreditNoteHeaderRequest {
 	if x != nil {
-		return x.GetRequest
+		return x.UpdateCreditNoteHeaderRequest
 	}
 	return nil
 }
 
-type GetCreditNoteHeaderResponse struct {
+func (x *ReviseCreditNoteRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviseCreditNoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditNoteHeader *CreditNoteHeader `protobuf:"bytes,1,opt,name=credit_note_header,json=creditNoteHeader,proto3" json:"credit_note_header,omitempty"`
+	DocumentVersion *v1.DocumentVersion `protobuf:"bytes,1,opt,name=document_version,json=documentVersion,proto3" json:"document_version,omitempty"`
 }
 
-func (x *GetCreditNoteHeaderResponse) Reset() {
-	*x = GetCreditNoteHeaderResponse{}
+func (x *ReviseCreditNoteResponse) Reset() {
+	*x = ReviseCreditNoteResponse{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeaderResponse) String() string {
+func (x *ReviseCreditNoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeaderResponse) ProtoMessage() {}
+func (*ReviseCreditNoteResponse) ProtoMessage() {}
 
-func (x *GetCreditNoteHeaderResponse) ProtoReflect() protoreflect.Message {
+func (x *ReviseCreditNoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1686,40 +1761,40 @@ func (x *GetCreditNoteHeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeaderResponse.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeaderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviseCreditNoteResponse.ProtoReflect.Descriptor instead.
+func (*ReviseCreditNoteResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCreditNoteHeaderResponse) GetCreditNoteHeader() *CreditNoteHeader {
+func (x *ReviseCreditNoteResponse) GetDocumentVersion() *v1.DocumentVersion {
 	if x != nil {
-		return x.CreditNoteHeader
+		return x.DocumentVersion
 	}
 	return nil
 }
 
-type GetCreditNoteHeaderByPkRequest struct {
+type GetCreditNoteHeaderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetByIdRequest *v1.GetByIdRequest `protobuf:"bytes,1,opt,name=get_by_id_request,json=getByIdRequest,proto3" json:"get_by_id_request,omitempty"`
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
 }
 
-func (x *GetCreditNoteHeaderByPkRequest) Reset() {
-	*x = GetCreditNoteHeaderByPkRequest{}
+func (x *GetCreditNoteHeaderRequest) Reset() {
+	*x = GetCreditNoteHeaderRequest{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeaderByPkRequest) String() string {
+func (x *GetCreditNoteHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeaderByPkRequest) ProtoMessage() {}
+func (*GetCreditNoteHeaderRequest) ProtoMessage() {}
 
-func (x *GetCreditNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCreditNoteHeaderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1731,19 +1806,19 @@ func (x *GetCreditNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeaderByPkRequest.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeaderByPkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCreditNoteHeaderRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeaderRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCreditNoteHeaderByPkRequest) GetGetByIdRequest() *v1.GetByIdRequest {
+func (x *GetCreditNoteHeaderRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
-		return x.GetByIdRequest
+		return x.GetRequest
 	}
 	return nil
 }
 
-type GetCreditNoteHeaderByPkResponse struct {
+type GetCreditNoteHeaderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1751,20 +1826,20 @@ type GetCreditNoteHeaderByPkResponse struct {
 	CreditNoteHeader *CreditNoteHeader `protobuf:"bytes,1,opt,name=credit_note_header,json=creditNoteHeader,proto3" json:"credit_note_header,omitempty"`
 }
 
-func (x *GetCreditNoteHeaderByPkResponse) Reset() {
-	*x = GetCreditNoteHeaderByPkResponse{}
+func (x *GetCreditNoteHeaderResponse) Reset() {
+	*x = GetCreditNoteHeaderResponse{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeaderByPkResponse) String() string {
+func (x *GetCreditNoteHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeaderByPkResponse) ProtoMessage() {}
+func (*GetCreditNoteHeaderResponse) ProtoMessage() {}
 
-func (x *GetCreditNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCreditNoteHeaderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1776,43 +1851,41 @@ func (x *GetCreditNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeaderByPkResponse.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeaderByPkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCreditNoteHeaderResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeaderResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCreditNoteHeaderByPkResponse) GetCreditNoteHeader() *CreditNoteHeader {
+func (x *GetCreditNoteHeaderResponse) GetCreditNoteHeader() *CreditNoteHeader {
 	if x != nil {
 		return x.CreditNoteHeader
 	}
 	return nil
 }
 
-type GetCreditNoteHeadersRequest struct {
+type GetCreditNoteUBLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit      string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
-	UserEmail  string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId  string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+	Profile    string         `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *GetCreditNoteHeadersRequest) Reset() {
-	*x = GetCreditNoteHeadersRequest{}
+func (x *GetCreditNoteUBLRequest) Reset() {
+	*x = GetCreditNoteUBLRequest{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeadersRequest) String() string {
+func (x *GetCreditNoteUBLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeadersRequest) ProtoMessage() {}
+func (*GetCreditNoteUBLRequest) ProtoMessage() {}
 
-func (x *GetCreditNoteHeadersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCreditNoteUBLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,62 +1897,47 @@ func (x *GetCreditNoteHeadersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeadersRequest.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeadersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCreditNoteUBLRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteUBLRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetCreditNoteHeadersRequest) GetLimit() string {
-	if x != nil {
-		return x.Limit
-	}
-	return ""
-}
-
-func (x *GetCreditNoteHeadersRequest) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-func (x *GetCreditNoteHeadersRequest) GetUserEmail() string {
+func (x *GetCreditNoteUBLRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
-		return x.UserEmail
+		return x.GetRequest
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCreditNoteHeadersRequest) GetRequestId() string {
+func (x *GetCreditNoteUBLRequest) GetProfile() string {
 	if x != nil {
-		return x.RequestId
+		return x.Profile
 	}
 	return ""
 }
 
-type GetCreditNoteHeadersResponse struct {
+type GetCreditNoteUBLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditNoteHeaders []*CreditNoteHeader `protobuf:"bytes,1,rep,name=credit_note_headers,json=creditNoteHeaders,proto3" json:"credit_note_headers,omitempty"`
-	NextCursor        string              `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Ubl []byte `protobuf:"bytes,1,opt,name=ubl,proto3" json:"ubl,omitempty"`
 }
 
-func (x *GetCreditNoteHeadersResponse) Reset() {
-	*x = GetCreditNoteHeadersResponse{}
+func (x *GetCreditNoteUBLResponse) Reset() {
+	*x = GetCreditNoteUBLResponse{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteHeadersResponse) String() string {
+func (x *GetCreditNoteUBLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteHeadersResponse) ProtoMessage() {}
+func (*GetCreditNoteUBLResponse) ProtoMessage() {}
 
-func (x *GetCreditNoteHeadersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCreditNoteUBLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,50 +1949,44 @@ func (x *GetCreditNoteHeadersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteHeadersResponse.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteHeadersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCreditNoteUBLResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteUBLResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetCreditNoteHeadersResponse) GetCreditNoteHeaders() []*CreditNoteHeader {
+func (x *GetCreditNoteUBLResponse) GetUbl() []byte {
 	if x != nil {
-		return x.CreditNoteHeaders
+		return x.Ubl
 	}
 	return nil
 }
 
-func (x *GetCreditNoteHeadersResponse) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-type CreditNoteLine struct {
+type ImportCreditNoteUBLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditNoteLineD *CreditNoteLineD `protobuf:"bytes,1,opt,name=credit_note_line_d,json=creditNoteLineD,proto3" json:"credit_note_line_d,omitempty"`
-	CreditNoteLineT *CreditNoteLineT `protobuf:"bytes,2,opt,name=credit_note_line_t,json=creditNoteLineT,proto3" json:"credit_note_line_t,omitempty"`
-	CrUpdUser       *v1.CrUpdUser    `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
-	CrUpdTime       *v1.CrUpdTime    `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	Ubl            []byte `protobuf:"bytes,1,opt,name=ubl,proto3" json:"ubl,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *CreditNoteLine) Reset() {
-	*x = CreditNoteLine{}
+func (x *ImportCreditNoteUBLRequest) Reset() {
+	*x = ImportCreditNoteUBLRequest{}
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreditNoteLine) String() string {
+func (x *ImportCreditNoteUBLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreditNoteLine) ProtoMessage() {}
+func (*ImportCreditNoteUBLRequest) ProtoMessage() {}
 
-func (x *CreditNoteLine) ProtoReflect() protoreflect.Message {
+func (x *ImportCreditNoteUBLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_creditnote_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1946,63 +1998,616 @@ func (x *CreditNoteLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreditNoteLine.ProtoReflect.Descriptor instead.
-func (*CreditNoteLine) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportCreditNoteUBLRequest.ProtoReflect.Descriptor instead.
+func (*ImportCreditNoteUBLRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreditNoteLine) GetCreditNoteLineD() *CreditNoteLineD {
+func (x *ImportCreditNoteUBLRequest) GetUbl() []byte {
 	if x != nil {
-		return x.CreditNoteLineD
+		return x.Ubl
 	}
 	return nil
 }
 
-func (x *CreditNoteLine) GetCreditNoteLineT() *CreditNoteLineT {
+func (x *ImportCreditNoteUBLRequest) GetUserId() string {
 	if x != nil {
-		return x.CreditNoteLineT
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreditNoteLine) GetCrUpdUser() *v1.CrUpdUser {
+func (x *ImportCreditNoteUBLRequest) GetUserEmail() string {
 	if x != nil {
-		return x.CrUpdUser
+		return x.UserEmail
 	}
-	return nil
+	return ""
 }
 
-func (x *CreditNoteLine) GetCrUpdTime() *v1.CrUpdTime {
+func (x *ImportCreditNoteUBLRequest) GetRequestId() string {
 	if x != nil {
-		return x.CrUpdTime
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-type CreditNoteLineD struct {
+func (x *ImportCreditNoteUBLRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ImportCreditNoteUBLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid4                   []byte  `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
-	IdS                     string  `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
-	CnlId                   string  `protobuf:"bytes,4,opt,name=cnl_id,json=cnlId,proto3" json:"cnl_id,omitempty"`
-	Note                    string  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
-	CreditedQuantity        float64 `protobuf:"fixed64,6,opt,name=credited_quantity,json=creditedQuantity,proto3" json:"credited_quantity,omitempty"`
-	LineExtensionAmount     float64 `protobuf:"fixed64,7,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	AccountingCostCode      string  `protobuf:"bytes,8,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost          string  `protobuf:"bytes,9,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	PaymentPurposeCode      string  `protobuf:"bytes,10,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
-	FreeOfChargeIndicator   bool    `protobuf:"varint,11,opt,name=free_of_charge_indicator,json=freeOfChargeIndicator,proto3" json:"free_of_charge_indicator,omitempty"`
-	DiscrepancyResponse     string  `protobuf:"bytes,12,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	OrderLineId             uint32  `protobuf:"varint,13,opt,name=order_line_id,json=orderLineId,proto3" json:"order_line_id,omitempty"`
-	DespatchLineId          uint32  `protobuf:"varint,14,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
-	ReceiptLineId           uint32  `protobuf:"varint,15,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
-	BillingId               uint32  `protobuf:"varint,16,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	OriginatorPartyId       uint32  `protobuf:"varint,17,opt,name=originator_party_id,json=originatorPartyId,proto3" json:"originator_party_id,omitempty"`
-	ItemId                  uint32  `protobuf:"varint,18,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	PriceAmount             float64 `protobuf:"fixed64,19,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	CreditNoteHeader *CreditNoteHeader `protobuf:"bytes,1,opt,name=credit_note_header,json=creditNoteHeader,proto3" json:"credit_note_header,omitempty"`
+}
+
+func (x *ImportCreditNoteUBLResponse) Reset() {
+	*x = ImportCreditNoteUBLResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCreditNoteUBLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCreditNoteUBLResponse) ProtoMessage() {}
+
+func (x *ImportCreditNoteUBLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCreditNoteUBLResponse.ProtoReflect.Descriptor instead.
+func (*ImportCreditNoteUBLResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportCreditNoteUBLResponse) GetCreditNoteHeader() *CreditNoteHeader {
+	if x != nil {
+		return x.CreditNoteHeader
+	}
+	return nil
+}
+
+type GetCreditNoteCIIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+}
+
+func (x *GetCreditNoteCIIRequest) Reset() {
+	*x = GetCreditNoteCIIRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteCIIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteCIIRequest) ProtoMessage() {}
+
+func (x *GetCreditNoteCIIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteCIIRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteCIIRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCreditNoteCIIRequest) GetGetRequest() *v1.GetRequest {
+	if x != nil {
+		return x.GetRequest
+	}
+	return nil
+}
+
+type GetCreditNoteCIIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cii []byte `protobuf:"bytes,1,opt,name=cii,proto3" json:"cii,omitempty"`
+}
+
+func (x *GetCreditNoteCIIResponse) Reset() {
+	*x = GetCreditNoteCIIResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteCIIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteCIIResponse) ProtoMessage() {}
+
+func (x *GetCreditNoteCIIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteCIIResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteCIIResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCreditNoteCIIResponse) GetCii() []byte {
+	if x != nil {
+		return x.Cii
+	}
+	return nil
+}
+
+type ImportCreditNoteCIIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cii            []byte `protobuf:"bytes,1,opt,name=cii,proto3" json:"cii,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ImportCreditNoteCIIRequest) Reset() {
+	*x = ImportCreditNoteCIIRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCreditNoteCIIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCreditNoteCIIRequest) ProtoMessage() {}
+
+func (x *ImportCreditNoteCIIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCreditNoteCIIRequest.ProtoReflect.Descriptor instead.
+func (*ImportCreditNoteCIIRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportCreditNoteCIIRequest) GetCii() []byte {
+	if x != nil {
+		return x.Cii
+	}
+	return nil
+}
+
+func (x *ImportCreditNoteCIIRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportCreditNoteCIIRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *ImportCreditNoteCIIRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ImportCreditNoteCIIRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ImportCreditNoteCIIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteHeader *CreditNoteHeader `protobuf:"bytes,1,opt,name=credit_note_header,json=creditNoteHeader,proto3" json:"credit_note_header,omitempty"`
+}
+
+func (x *ImportCreditNoteCIIResponse) Reset() {
+	*x = ImportCreditNoteCIIResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCreditNoteCIIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCreditNoteCIIResponse) ProtoMessage() {}
+
+func (x *ImportCreditNoteCIIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCreditNoteCIIResponse.ProtoReflect.Descriptor instead.
+func (*ImportCreditNoteCIIResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportCreditNoteCIIResponse) GetCreditNoteHeader() *CreditNoteHeader {
+	if x != nil {
+		return x.CreditNoteHeader
+	}
+	return nil
+}
+
+type GetCreditNoteHeaderByPkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetByIdRequest *v1.GetByIdRequest `protobuf:"bytes,1,opt,name=get_by_id_request,json=getByIdRequest,proto3" json:"get_by_id_request,omitempty"`
+}
+
+func (x *GetCreditNoteHeaderByPkRequest) Reset() {
+	*x = GetCreditNoteHeaderByPkRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteHeaderByPkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteHeaderByPkRequest) ProtoMessage() {}
+
+func (x *GetCreditNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteHeaderByPkRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeaderByPkRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCreditNoteHeaderByPkRequest) GetGetByIdRequest() *v1.GetByIdRequest {
+	if x != nil {
+		return x.GetByIdRequest
+	}
+	return nil
+}
+
+type GetCreditNoteHeaderByPkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteHeader *CreditNoteHeader `protobuf:"bytes,1,opt,name=credit_note_header,json=creditNoteHeader,proto3" json:"credit_note_header,omitempty"`
+}
+
+func (x *GetCreditNoteHeaderByPkResponse) Reset() {
+	*x = GetCreditNoteHeaderByPkResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteHeaderByPkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteHeaderByPkResponse) ProtoMessage() {}
+
+func (x *GetCreditNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteHeaderByPkResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeaderByPkResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCreditNoteHeaderByPkResponse) GetCreditNoteHeader() *CreditNoteHeader {
+	if x != nil {
+		return x.CreditNoteHeader
+	}
+	return nil
+}
+
+type GetCreditNoteHeadersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit      string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	UserEmail  string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId  string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *GetCreditNoteHeadersRequest) Reset() {
+	*x = GetCreditNoteHeadersRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteHeadersRequest) ProtoMessage() {}
+
+func (x *GetCreditNoteHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteHeadersRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCreditNoteHeadersRequest) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *GetCreditNoteHeadersRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCreditNoteHeadersRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetCreditNoteHeadersRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetCreditNoteHeadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteHeaders []*CreditNoteHeader `protobuf:"bytes,1,rep,name=credit_note_headers,json=creditNoteHeaders,proto3" json:"credit_note_headers,omitempty"`
+	NextCursor        string              `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetCreditNoteHeadersResponse) Reset() {
+	*x = GetCreditNoteHeadersResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteHeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteHeadersResponse) ProtoMessage() {}
+
+func (x *GetCreditNoteHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteHeadersResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCreditNoteHeadersResponse) GetCreditNoteHeaders() []*CreditNoteHeader {
+	if x != nil {
+		return x.CreditNoteHeaders
+	}
+	return nil
+}
+
+func (x *GetCreditNoteHeadersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreditNoteLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteLineD  *CreditNoteLineD      `protobuf:"bytes,1,opt,name=credit_note_line_d,json=creditNoteLineD,proto3" json:"credit_note_line_d,omitempty"`
+	CreditNoteLineT  *CreditNoteLineT      `protobuf:"bytes,2,opt,name=credit_note_line_t,json=creditNoteLineT,proto3" json:"credit_note_line_t,omitempty"`
+	CrUpdUser        *v1.CrUpdUser         `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime        *v1.CrUpdTime         `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	AllowanceCharges []*v1.AllowanceCharge `protobuf:"bytes,5,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+}
+
+func (x *CreditNoteLine) Reset() {
+	*x = CreditNoteLine{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditNoteLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditNoteLine) ProtoMessage() {}
+
+func (x *CreditNoteLine) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditNoteLine.ProtoReflect.Descriptor instead.
+func (*CreditNoteLine) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreditNoteLine) GetCreditNoteLineD() *CreditNoteLineD {
+	if x != nil {
+		return x.CreditNoteLineD
+	}
+	return nil
+}
+
+func (x *CreditNoteLine) GetCreditNoteLineT() *CreditNoteLineT {
+	if x != nil {
+		return x.CreditNoteLineT
+	}
+	return nil
+}
+
+func (x *CreditNoteLine) GetCrUpdUser() *v1.CrUpdUser {
+	if x != nil {
+		return x.CrUpdUser
+	}
+	return nil
+}
+
+func (x *CreditNoteLine) GetCrUpdTime() *v1.CrUpdTime {
+	if x != nil {
+		return x.CrUpdTime
+	}
+	return nil
+}
+
+func (x *CreditNoteLine) GetAllowanceCharges() []*v1.AllowanceCharge {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+type CreditNoteLineD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid4                   []byte  `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
+	IdS                     string  `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
+	CnlId                   string  `protobuf:"bytes,4,opt,name=cnl_id,json=cnlId,proto3" json:"cnl_id,omitempty"`
+	Note                    string  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreditedQuantity        float64 `protobuf:"fixed64,6,opt,name=credited_quantity,json=creditedQuantity,proto3" json:"credited_quantity,omitempty"`
+	LineExtensionAmount     string  `protobuf:"bytes,7,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	AccountingCostCode      string  `protobuf:"bytes,8,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost          string  `protobuf:"bytes,9,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	PaymentPurposeCode      string  `protobuf:"bytes,10,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
+	FreeOfChargeIndicator   bool    `protobuf:"varint,11,opt,name=free_of_charge_indicator,json=freeOfChargeIndicator,proto3" json:"free_of_charge_indicator,omitempty"`
+	DiscrepancyResponse     string  `protobuf:"bytes,12,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	OrderLineId             uint32  `protobuf:"varint,13,opt,name=order_line_id,json=orderLineId,proto3" json:"order_line_id,omitempty"`
+	DespatchLineId          uint32  `protobuf:"varint,14,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
+	ReceiptLineId           uint32  `protobuf:"varint,15,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
+	BillingId               uint32  `protobuf:"varint,16,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	OriginatorPartyId       uint32  `protobuf:"varint,17,opt,name=originator_party_id,json=originatorPartyId,proto3" json:"originator_party_id,omitempty"`
+	ItemId                  uint32  `protobuf:"varint,18,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PriceAmount             string  `protobuf:"bytes,19,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
 	PriceBaseQuantity       float64 `protobuf:"fixed64,20,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
 	PriceChangeReason       string  `protobuf:"bytes,21,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
 	PriceTypeCode           string  `protobuf:"bytes,22,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
@@ -2010,11 +2615,13 @@ type CreditNoteLineD struct {
 	OrderableUnitFactorRate float64 `protobuf:"fixed64,24,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
 	PriceListId             uint32  `protobuf:"varint,25,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
 	CreditNoteHeaderId      uint32  `protobuf:"varint,26,opt,name=credit_note_header_id,json=creditNoteHeaderId,proto3" json:"credit_note_header_id,omitempty"`
+	CurrencyCode            string  `protobuf:"bytes,27,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	UnitCode                string  `protobuf:"bytes,28,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
 }
 
 func (x *CreditNoteLineD) Reset() {
 	*x = CreditNoteLineD{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[14]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2026,7 +2633,7 @@ func (x *CreditNoteLineD) String() string {
 func (*CreditNoteLineD) ProtoMessage() {}
 
 func (x *CreditNoteLineD) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[14]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2039,7 +2646,7 @@ func (x *CreditNoteLineD) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditNoteLineD.ProtoReflect.Descriptor instead.
 func (*CreditNoteLineD) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{14}
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreditNoteLineD) GetId() uint32 {
@@ -2084,11 +2691,11 @@ func (x *CreditNoteLineD) GetCreditedQuantity() float64 {
 	return 0
 }
 
-func (x *CreditNoteLineD) GetLineExtensionAmount() float64 {
+func (x *CreditNoteLineD) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreditNoteLineD) GetAccountingCostCode() string {
@@ -2168,11 +2775,11 @@ func (x *CreditNoteLineD) GetItemId() uint32 {
 	return 0
 }
 
-func (x *CreditNoteLineD) GetPriceAmount() float64 {
+func (x *CreditNoteLineD) GetPriceAmount() string {
 	if x != nil {
 		return x.PriceAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreditNoteLineD) GetPriceBaseQuantity() float64 {
@@ -2224,6 +2831,20 @@ func (x *CreditNoteLineD) GetCreditNoteHeaderId() uint32 {
 	return 0
 }
 
+func (x *CreditNoteLineD) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreditNoteLineD) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
 type CreditNoteLineT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2238,7 +2859,7 @@ type CreditNoteLineT struct {
 
 func (x *CreditNoteLineT) Reset() {
 	*x = CreditNoteLineT{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[15]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2250,7 +2871,7 @@ func (x *CreditNoteLineT) String() string {
 func (*CreditNoteLineT) ProtoMessage() {}
 
 func (x *CreditNoteLineT) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[15]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,7 +2884,7 @@ func (x *CreditNoteLineT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditNoteLineT.ProtoReflect.Descriptor instead.
 func (*CreditNoteLineT) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{15}
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreditNoteLineT) GetTaxPointDate() *timestamppb.Timestamp {
@@ -2306,42 +2927,45 @@ type CreateCreditNoteLineRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CnlId                        string  `protobuf:"bytes,1,opt,name=cnl_id,json=cnlId,proto3" json:"cnl_id,omitempty"`
-	Note                         string  `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
-	CreditedQuantity             float64 `protobuf:"fixed64,3,opt,name=credited_quantity,json=creditedQuantity,proto3" json:"credited_quantity,omitempty"`
-	LineExtensionAmount          float64 `protobuf:"fixed64,4,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxPointDate                 string  `protobuf:"bytes,5,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
-	AccountingCostCode           string  `protobuf:"bytes,6,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost               string  `protobuf:"bytes,7,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	PaymentPurposeCode           string  `protobuf:"bytes,8,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
-	FreeOfChargeIndicator        bool    `protobuf:"varint,9,opt,name=free_of_charge_indicator,json=freeOfChargeIndicator,proto3" json:"free_of_charge_indicator,omitempty"`
-	InvoicePeriodStartDate       string  `protobuf:"bytes,10,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
-	InvoicePeriodEndDate         string  `protobuf:"bytes,31,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
-	DiscrepancyResponse          string  `protobuf:"bytes,11,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	OrderLineId                  uint32  `protobuf:"varint,12,opt,name=order_line_id,json=orderLineId,proto3" json:"order_line_id,omitempty"`
-	DespatchLineId               uint32  `protobuf:"varint,13,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
-	ReceiptLineId                uint32  `protobuf:"varint,14,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
-	BillingId                    uint32  `protobuf:"varint,15,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	OriginatorPartyId            uint32  `protobuf:"varint,16,opt,name=originator_party_id,json=originatorPartyId,proto3" json:"originator_party_id,omitempty"`
-	ItemId                       uint32  `protobuf:"varint,17,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	PriceAmount                  float64 `protobuf:"fixed64,18,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
-	PriceBaseQuantity            float64 `protobuf:"fixed64,19,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
-	PriceChangeReason            string  `protobuf:"bytes,20,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
-	PriceTypeCode                string  `protobuf:"bytes,21,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
-	PriceType                    string  `protobuf:"bytes,22,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
-	OrderableUnitFactorRate      float64 `protobuf:"fixed64,23,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
-	PriceValidityPeriodStartDate string  `protobuf:"bytes,24,opt,name=price_validity_period_start_date,json=priceValidityPeriodStartDate,proto3" json:"price_validity_period_start_date,omitempty"`
-	PriceValidityPeriodEndDate   string  `protobuf:"bytes,25,opt,name=price_validity_period_end_date,json=priceValidityPeriodEndDate,proto3" json:"price_validity_period_end_date,omitempty"`
-	PriceListId                  uint32  `protobuf:"varint,26,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
-	CreditNoteHeaderId           uint32  `protobuf:"varint,27,opt,name=credit_note_header_id,json=creditNoteHeaderId,proto3" json:"credit_note_header_id,omitempty"`
-	UserId                       string  `protobuf:"bytes,28,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail                    string  `protobuf:"bytes,29,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                    string  `protobuf:"bytes,30,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CnlId                        string                             `protobuf:"bytes,1,opt,name=cnl_id,json=cnlId,proto3" json:"cnl_id,omitempty"`
+	Note                         string                             `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	CreditedQuantity             float64                            `protobuf:"fixed64,3,opt,name=credited_quantity,json=creditedQuantity,proto3" json:"credited_quantity,omitempty"`
+	LineExtensionAmount          string                             `protobuf:"bytes,4,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxPointDate                 string                             `protobuf:"bytes,5,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
+	AccountingCostCode           string                             `protobuf:"bytes,6,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost               string                             `protobuf:"bytes,7,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	PaymentPurposeCode           string                             `protobuf:"bytes,8,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
+	FreeOfChargeIndicator        bool                               `protobuf:"varint,9,opt,name=free_of_charge_indicator,json=freeOfChargeIndicator,proto3" json:"free_of_charge_indicator,omitempty"`
+	InvoicePeriodStartDate       string                             `protobuf:"bytes,10,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
+	InvoicePeriodEndDate         string                             `protobuf:"bytes,31,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
+	DiscrepancyResponse          string                             `protobuf:"bytes,11,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	OrderLineId                  uint32                             `protobuf:"varint,12,opt,name=order_line_id,json=orderLineId,proto3" json:"order_line_id,omitempty"`
+	DespatchLineId               uint32                             `protobuf:"varint,13,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
+	ReceiptLineId                uint32                             `protobuf:"varint,14,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
+	BillingId                    uint32                             `protobuf:"varint,15,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	OriginatorPartyId            uint32                             `protobuf:"varint,16,opt,name=originator_party_id,json=originatorPartyId,proto3" json:"originator_party_id,omitempty"`
+	ItemId                       uint32                             `protobuf:"varint,17,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PriceAmount                  string                             `protobuf:"bytes,18,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	PriceBaseQuantity            float64                            `protobuf:"fixed64,19,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
+	PriceChangeReason            string                             `protobuf:"bytes,20,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
+	PriceTypeCode                string                             `protobuf:"bytes,21,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
+	PriceType                    string                             `protobuf:"bytes,22,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
+	OrderableUnitFactorRate      float64                            `protobuf:"fixed64,23,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
+	PriceValidityPeriodStartDate string                             `protobuf:"bytes,24,opt,name=price_validity_period_start_date,json=priceValidityPeriodStartDate,proto3" json:"price_validity_period_start_date,omitempty"`
+	PriceValidityPeriodEndDate   string                             `protobuf:"bytes,25,opt,name=price_validity_period_end_date,json=priceValidityPeriodEndDate,proto3" json:"price_validity_period_end_date,omitempty"`
+	PriceListId                  uint32                             `protobuf:"varint,26,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CreditNoteHeaderId           uint32                             `protobuf:"varint,27,opt,name=credit_note_header_id,json=creditNoteHeaderId,proto3" json:"credit_note_header_id,omitempty"`
+	UserId                       string                             `protobuf:"bytes,28,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                    string                             `protobuf:"bytes,29,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                    string                             `protobuf:"bytes,30,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	AllowanceCharges             []*v1.CreateAllowanceChargeRequest `protobuf:"bytes,32,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+	IdempotencyKey               string                             `protobuf:"bytes,33,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UnitCode                     string                             `protobuf:"bytes,34,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
 }
 
 func (x *CreateCreditNoteLineRequest) Reset() {
 	*x = CreateCreditNoteLineRequest{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[16]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2353,7 +2977,7 @@ func (x *CreateCreditNoteLineRequest) String() string {
 func (*CreateCreditNoteLineRequest) ProtoMessage() {}
 
 func (x *CreateCreditNoteLineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[16]
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,7 +2990,7 @@ func (x *CreateCreditNoteLineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCreditNoteLineRequest.ProtoReflect.Descriptor instead.
 func (*CreateCreditNoteLineRequest) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{16}
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateCreditNoteLineRequest) GetCnlId() string {
@@ -2390,11 +3014,11 @@ func (x *CreateCreditNoteLineRequest) GetCreditedQuantity() float64 {
 	return 0
 }
 
-func (x *CreateCreditNoteLineRequest) GetLineExtensionAmount() float64 {
+func (x *CreateCreditNoteLineRequest) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreateCreditNoteLineRequest) GetTaxPointDate() string {
@@ -2453,162 +3077,433 @@ func (x *CreateCreditNoteLineRequest) GetDiscrepancyResponse() string {
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetOrderLineId() uint32 {
+func (x *CreateCreditNoteLineRequest) GetOrderLineId() uint32 {
+	if x != nil {
+		return x.OrderLineId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetDespatchLineId() uint32 {
+	if x != nil {
+		return x.DespatchLineId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetReceiptLineId() uint32 {
+	if x != nil {
+		return x.ReceiptLineId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetBillingId() uint32 {
+	if x != nil {
+		return x.BillingId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetOriginatorPartyId() uint32 {
+	if x != nil {
+		return x.OriginatorPartyId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetItemId() uint32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceAmount() string {
+	if x != nil {
+		return x.PriceAmount
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceBaseQuantity() float64 {
+	if x != nil {
+		return x.PriceBaseQuantity
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceChangeReason() string {
+	if x != nil {
+		return x.PriceChangeReason
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceTypeCode() string {
+	if x != nil {
+		return x.PriceTypeCode
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetOrderableUnitFactorRate() float64 {
+	if x != nil {
+		return x.OrderableUnitFactorRate
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceValidityPeriodStartDate() string {
+	if x != nil {
+		return x.PriceValidityPeriodStartDate
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceValidityPeriodEndDate() string {
+	if x != nil {
+		return x.PriceValidityPeriodEndDate
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetPriceListId() uint32 {
+	if x != nil {
+		return x.PriceListId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetCreditNoteHeaderId() uint32 {
+	if x != nil {
+		return x.CreditNoteHeaderId
+	}
+	return 0
+}
+
+func (x *CreateCreditNoteLineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetAllowanceCharges() []*v1.CreateAllowanceChargeRequest {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+func (x *CreateCreditNoteLineRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateCreditNoteLineRequest) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
+type CreateCreditNoteLineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteLine *CreditNoteLine `protobuf:"bytes,1,opt,name=credit_note_line,json=creditNoteLine,proto3" json:"credit_note_line,omitempty"`
+}
+
+func (x *CreateCreditNoteLineResponse) Reset() {
+	*x = CreateCreditNoteLineResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCreditNoteLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditNoteLineResponse) ProtoMessage() {}
+
+func (x *CreateCreditNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*CreateCreditNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateCreditNoteLineResponse) GetCreditNoteLine() *CreditNoteLine {
+	if x != nil {
+		return x.CreditNoteLine
+	}
+	return nil
+}
+
+type GetCreditNoteLinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+}
+
+func (x *GetCreditNoteLinesRequest) Reset() {
+	*x = GetCreditNoteLinesRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditNoteLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditNoteLinesRequest) ProtoMessage() {}
+
+func (x *GetCreditNoteLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditNoteLinesRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteLinesRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCreditNoteLinesRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
-		return x.OrderLineId
+		return x.GetRequest
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCreditNoteLineRequest) GetDespatchLineId() uint32 {
-	if x != nil {
-		return x.DespatchLineId
-	}
-	return 0
+type GetCreditNoteLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditNoteLines []*CreditNoteLine `protobuf:"bytes,1,rep,name=credit_note_lines,json=creditNoteLines,proto3" json:"credit_note_lines,omitempty"`
 }
 
-func (x *CreateCreditNoteLineRequest) GetReceiptLineId() uint32 {
-	if x != nil {
-		return x.ReceiptLineId
-	}
-	return 0
+func (x *GetCreditNoteLinesResponse) Reset() {
+	*x = GetCreditNoteLinesResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCreditNoteLineRequest) GetBillingId() uint32 {
-	if x != nil {
-		return x.BillingId
-	}
-	return 0
+func (x *GetCreditNoteLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateCreditNoteLineRequest) GetOriginatorPartyId() uint32 {
+func (*GetCreditNoteLinesResponse) ProtoMessage() {}
+
+func (x *GetCreditNoteLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[29]
 	if x != nil {
-		return x.OriginatorPartyId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCreditNoteLineRequest) GetItemId() uint32 {
-	if x != nil {
-		return x.ItemId
-	}
-	return 0
+// Deprecated: Use GetCreditNoteLinesResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditNoteLinesResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceAmount() float64 {
+func (x *GetCreditNoteLinesResponse) GetCreditNoteLines() []*CreditNoteLine {
 	if x != nil {
-		return x.PriceAmount
+		return x.CreditNoteLines
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceBaseQuantity() float64 {
-	if x != nil {
-		return x.PriceBaseQuantity
-	}
-	return 0
+type UpdateCreditNoteLineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Note                string  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	CreditedQuantity    float64 `protobuf:"fixed64,2,opt,name=credited_quantity,json=creditedQuantity,proto3" json:"credited_quantity,omitempty"`
+	LineExtensionAmount string  `protobuf:"bytes,3,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	PriceAmount         string  `protobuf:"bytes,4,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	PriceBaseQuantity   float64 `protobuf:"fixed64,5,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
+	AccountingCost      string  `protobuf:"bytes,6,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	Id                  string  `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	UserId              string  `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail           string  `protobuf:"bytes,9,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId           string  `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *UpdateCreditNoteLineRequest) Reset() {
+	*x = UpdateCreditNoteLineRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceChangeReason() string {
-	if x != nil {
-		return x.PriceChangeReason
-	}
-	return ""
+func (x *UpdateCreditNoteLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceTypeCode() string {
+func (*UpdateCreditNoteLineRequest) ProtoMessage() {}
+
+func (x *UpdateCreditNoteLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[30]
 	if x != nil {
-		return x.PriceTypeCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceType() string {
+// Deprecated: Use UpdateCreditNoteLineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCreditNoteLineRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateCreditNoteLineRequest) GetNote() string {
 	if x != nil {
-		return x.PriceType
+		return x.Note
 	}
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetOrderableUnitFactorRate() float64 {
+func (x *UpdateCreditNoteLineRequest) GetCreditedQuantity() float64 {
 	if x != nil {
-		return x.OrderableUnitFactorRate
+		return x.CreditedQuantity
 	}
 	return 0
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceValidityPeriodStartDate() string {
+func (x *UpdateCreditNoteLineRequest) GetLineExtensionAmount() string {
 	if x != nil {
-		return x.PriceValidityPeriodStartDate
+		return x.LineExtensionAmount
 	}
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceValidityPeriodEndDate() string {
+func (x *UpdateCreditNoteLineRequest) GetPriceAmount() string {
 	if x != nil {
-		return x.PriceValidityPeriodEndDate
+		return x.PriceAmount
 	}
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetPriceListId() uint32 {
+func (x *UpdateCreditNoteLineRequest) GetPriceBaseQuantity() float64 {
 	if x != nil {
-		return x.PriceListId
+		return x.PriceBaseQuantity
 	}
 	return 0
 }
 
-func (x *CreateCreditNoteLineRequest) GetCreditNoteHeaderId() uint32 {
+func (x *UpdateCreditNoteLineRequest) GetAccountingCost() string {
 	if x != nil {
-		return x.CreditNoteHeaderId
+		return x.AccountingCost
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetUserId() string {
+func (x *UpdateCreditNoteLineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCreditNoteLineRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetUserEmail() string {
+func (x *UpdateCreditNoteLineRequest) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *CreateCreditNoteLineRequest) GetRequestId() string {
+func (x *UpdateCreditNoteLineRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type CreateCreditNoteLineResponse struct {
+type UpdateCreditNoteLineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreditNoteLine *CreditNoteLine `protobuf:"bytes,1,opt,name=credit_note_line,json=creditNoteLine,proto3" json:"credit_note_line,omitempty"`
 }
 
-func (x *CreateCreditNoteLineResponse) Reset() {
-	*x = CreateCreditNoteLineResponse{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[17]
+func (x *UpdateCreditNoteLineResponse) Reset() {
+	*x = UpdateCreditNoteLineResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCreditNoteLineResponse) String() string {
+func (x *UpdateCreditNoteLineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCreditNoteLineResponse) ProtoMessage() {}
+func (*UpdateCreditNoteLineResponse) ProtoMessage() {}
 
-func (x *CreateCreditNoteLineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[17]
+func (x *UpdateCreditNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2619,19 +3514,12 @@ func (x *CreateCreditNoteLineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCreditNoteLineResponse.ProtoReflect.Descriptor instead.
-func (*CreateCreditNoteLineResponse) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *CreateCreditNoteLineResponse) GetCreditNoteLine() *CreditNoteLine {
-	if x != nil {
-		return x.CreditNoteLine
-	}
-	return nil
+// Deprecated: Use UpdateCreditNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCreditNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{31}
 }
 
-type GetCreditNoteLinesRequest struct {
+type DeleteCreditNoteLineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2639,21 +3527,21 @@ type GetCreditNoteLinesRequest struct {
 	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
 }
 
-func (x *GetCreditNoteLinesRequest) Reset() {
-	*x = GetCreditNoteLinesRequest{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[18]
+func (x *DeleteCreditNoteLineRequest) Reset() {
+	*x = DeleteCreditNoteLineRequest{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteLinesRequest) String() string {
+func (x *DeleteCreditNoteLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteLinesRequest) ProtoMessage() {}
+func (*DeleteCreditNoteLineRequest) ProtoMessage() {}
 
-func (x *GetCreditNoteLinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[18]
+func (x *DeleteCreditNoteLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2664,41 +3552,39 @@ func (x *GetCreditNoteLinesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteLinesRequest.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteLinesRequest) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteCreditNoteLineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCreditNoteLineRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetCreditNoteLinesRequest) GetGetRequest() *v1.GetRequest {
+func (x *DeleteCreditNoteLineRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
 		return x.GetRequest
 	}
 	return nil
 }
 
-type GetCreditNoteLinesResponse struct {
+type DeleteCreditNoteLineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreditNoteLines []*CreditNoteLine `protobuf:"bytes,1,rep,name=credit_note_lines,json=creditNoteLines,proto3" json:"credit_note_lines,omitempty"`
 }
 
-func (x *GetCreditNoteLinesResponse) Reset() {
-	*x = GetCreditNoteLinesResponse{}
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[19]
+func (x *DeleteCreditNoteLineResponse) Reset() {
+	*x = DeleteCreditNoteLineResponse{}
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditNoteLinesResponse) String() string {
+func (x *DeleteCreditNoteLineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditNoteLinesResponse) ProtoMessage() {}
+func (*DeleteCreditNoteLineResponse) ProtoMessage() {}
 
-func (x *GetCreditNoteLinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_creditnote_proto_msgTypes[19]
+func (x *DeleteCreditNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_creditnote_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,16 +3595,9 @@ func (x *GetCreditNoteLinesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditNoteLinesResponse.ProtoReflect.Descriptor instead.
-func (*GetCreditNoteLinesResponse) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetCreditNoteLinesResponse) GetCreditNoteLines() []*CreditNoteLine {
-	if x != nil {
-		return x.CreditNoteLines
-	}
-	return nil
+// Deprecated: Use DeleteCreditNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCreditNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_creditnote_proto_rawDescGZIP(), []int{33}
 }
 
 var File_invoice_v1_creditnote_proto protoreflect.FileDescriptor
@@ -2730,257 +3609,274 @@ var file_invoice_v1_creditnote_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x44, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x12, 0x4e, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x54, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70,
-	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a,
-	0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0xfe, 0x1c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69,
-	0x64, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12,
-	0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69,
-	0x64, 0x53, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x63, 0x6e, 0x68, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
-	0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
-	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x69,
-	0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x31,
-	0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69,
-	0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a,
-	0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53,
-	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3f,
-	0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x18,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61,
-	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x75, 0x79, 0x65, 0x72, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18,
-	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
-	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61,
-	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x61, 0x78, 0x52,
-	0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x72,
-	0x74, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61,
-	0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78,
-	0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
-	0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65,
-	0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38,
-	0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x15, 0x74, 0x61, 0x78, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x78, 0x5f,
-	0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x74, 0x61, 0x78, 0x45, 0x78,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x4d,
-	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x74, 0x6f, 0x1a, 0x1f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x44, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x12, 0x4e, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x54, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75,
+	0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x22, 0xa4, 0x1e,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12, 0x15, 0x0a, 0x06,
+	0x63, 0x6e, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6e,
+	0x68, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x78,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x69,
+	0x63, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x6c, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x14, 0x62, 0x75, 0x79, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x3d, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x61, 0x78, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3c,
+	0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20,
+	0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78,
+	0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f,
+	0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x74, 0x61, 0x78,
+	0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x74, 0x61, 0x78, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
 	0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
-	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70,
-	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69,
-	0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72,
+	0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f,
+	0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72,
 	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x26, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f,
-	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45,
-	0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f,
-	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45,
-	0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x6d,
-	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70,
-	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44,
-	0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69,
+	0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x19, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x18, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d,
+	0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a,
+	0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
 	0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x18, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45,
-	0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x32,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74,
-	0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41,
-	0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a,
-	0x21, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x27,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d,
-	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65,
+	0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78,
+	0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65,
 	0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3a, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
-	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x3b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69,
-	0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69,
-	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x40, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x41, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x42, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61,
-	0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74,
+	0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54,
+	0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74,
+	0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x35, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45,
+	0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x21, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x36, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f,
+	0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x27, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74,
+	0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x74, 0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74,
+	0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x19, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x40, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x41, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x42,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x44, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x21, 0x70, 0x61, 0x79,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x45,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x80, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
 	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73,
 	0x73, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
@@ -3020,7 +3916,7 @@ var file_invoice_v1_creditnote_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c,
-	0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x22, 0xee, 0x20, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x22, 0xe1, 0x22, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x68,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6e, 0x68, 0x49, 0x64,
@@ -3242,36 +4138,36 @@ var file_invoice_v1_creditnote_proto_rawDesc = []byte{
 	0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
 	0x41, 0x6c, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e,
 	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x42, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78,
+	0x6e, 0x74, 0x18, 0x42, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78,
 	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a,
 	0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61, 0x78,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x78,
 	0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
 	0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x44, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x44, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74,
 	0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74,
 	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x45, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61,
 	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67,
 	0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x46,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61,
 	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68, 0x68,
 	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x47, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x77,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x77,
 	0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x74,
 	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x48, 0x20, 0x01, 0x28, 0x01,
+	0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
 	0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x49, 0x20, 0x01, 0x28, 0x01,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
 	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c,
 	0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x4b, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
 	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
 	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d,
@@ -3283,352 +4179,604 @@ var file_invoice_v1_creditnote_proto_rawDesc = []byte{
 	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
-	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x52, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x49, 0x0a, 0x21, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x53, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x6c, 0x0a, 0x1e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xfc, 0x02, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x78, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xfc, 0x02, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x67, 0x65,
-	0x74, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0e, 0x67, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x6d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f,
-	0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
-	0x92, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
-	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x73, 0x0a, 0x21, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x1d, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x69, 0x73, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x62, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75,
+	0x62, 0x6c, 0x22, 0xae, 0x01, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x62, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x75, 0x62, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x69, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x51,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x43,
+	0x49, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x43, 0x49, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x69, 0x22,
+	0xae, 0x01, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x69,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x69, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x11, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
-	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44,
-	0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
-	0x44, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
-	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x34, 0x0a, 0x0b, 0x63,
-	0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55,
-	0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72,
-	0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8b, 0x08, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75,
-	0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64,
-	0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x64, 0x53, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6e, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6e,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a,
-	0x18, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
-	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x66, 0x72, 0x65, 0x65, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x64,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
-	0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63,
-	0x68, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75,
-	0x6e, 0x69, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x55, 0x6e, 0x69, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22,
-	0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74,
-	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc1, 0x03, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x78,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xd9, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x44, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x44, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x52, 0x0f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x34,
+	0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x22, 0xcd, 0x08, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a,
+	0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53,
+	0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6e, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x65,
+	0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x66, 0x72, 0x65,
+	0x65, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x15, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0xc1, 0x03, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x61, 0x78,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x19, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x51, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74,
-	0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x19, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x51, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x14, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1c, 0x70, 0x72, 0x69,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1a, 0x70, 0x72, 0x69,
 	0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x1e, 0x70, 0x72, 0x69,
+	0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xf5, 0x0b, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6e, 0x6c, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x78,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6f,
+	0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x66, 0x72, 0x65, 0x65, 0x4f, 0x66,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e, 0x65,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x1a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x70, 0x72, 0x69,
 	0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1a, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd9, 0x0a, 0x0a, 0x1b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6e, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6e, 0x6c, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x64, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x22, 0xf5, 0x02, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
-	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
 	0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
 	0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
-	0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72,
-	0x70, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x65, 0x65,
-	0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x66, 0x72, 0x65, 0x65,
-	0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x39, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x17,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e,
-	0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65,
-	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69,
-	0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b,
-	0x0a, 0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74,
-	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69,
-	0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
-	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x53, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x32, 0x92, 0x06, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xcc, 0x14, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50,
-	0x6b, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
 	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79,
-	0x50, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e,
 	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e,
 	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
-	0x72, 0x65, 0x73, 0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62, 0x6c, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x12, 0x23,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c,
+	0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x43, 0x49, 0x49, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x43, 0x49, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x66, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x49,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x6c, 0x79, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6f, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x12, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x72, 0x65, 0x73, 0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62, 0x6c, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65,
+	0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3643,86 +4791,156 @@ func file_invoice_v1_creditnote_proto_rawDescGZIP() []byte {
 	return file_invoice_v1_creditnote_proto_rawDescData
 }
 
-var file_invoice_v1_creditnote_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_invoice_v1_creditnote_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_invoice_v1_creditnote_proto_goTypes = []any{
-	(*CreditNoteHeader)(nil),                // 0: invoice.v1.CreditNoteHeader
-	(*CreditNoteHeaderD)(nil),               // 1: invoice.v1.CreditNoteHeaderD
-	(*CreditNoteHeaderT)(nil),               // 2: invoice.v1.CreditNoteHeaderT
-	(*CreateCreditNoteHeaderRequest)(nil),   // 3: invoice.v1.CreateCreditNoteHeaderRequest
-	(*CreateCreditNoteHeaderResponse)(nil),  // 4: invoice.v1.CreateCreditNoteHeaderResponse
-	(*UpdateCreditNoteHeaderRequest)(nil),   // 5: invoice.v1.UpdateCreditNoteHeaderRequest
-	(*UpdateCreditNoteHeaderResponse)(nil),  // 6: invoice.v1.UpdateCreditNoteHeaderResponse
-	(*GetCreditNoteHeaderRequest)(nil),      // 7: invoice.v1.GetCreditNoteHeaderRequest
-	(*GetCreditNoteHeaderResponse)(nil),     // 8: invoice.v1.GetCreditNoteHeaderResponse
-	(*GetCreditNoteHeaderByPkRequest)(nil),  // 9: invoice.v1.GetCreditNoteHeaderByPkRequest
-	(*GetCreditNoteHeaderByPkResponse)(nil), // 10: invoice.v1.GetCreditNoteHeaderByPkResponse
-	(*GetCreditNoteHeadersRequest)(nil),     // 11: invoice.v1.GetCreditNoteHeadersRequest
-	(*GetCreditNoteHeadersResponse)(nil),    // 12: invoice.v1.GetCreditNoteHeadersResponse
-	(*CreditNoteLine)(nil),                  // 13: invoice.v1.CreditNoteLine
-	(*CreditNoteLineD)(nil),                 // 14: invoice.v1.CreditNoteLineD
-	(*CreditNoteLineT)(nil),                 // 15: invoice.v1.CreditNoteLineT
-	(*CreateCreditNoteLineRequest)(nil),     // 16: invoice.v1.CreateCreditNoteLineRequest
-	(*CreateCreditNoteLineResponse)(nil),    // 17: invoice.v1.CreateCreditNoteLineResponse
-	(*GetCreditNoteLinesRequest)(nil),       // 18: invoice.v1.GetCreditNoteLinesRequest
-	(*GetCreditNoteLinesResponse)(nil),      // 19: invoice.v1.GetCreditNoteLinesResponse
-	(*v1.CrUpdUser)(nil),                    // 20: common.v1.CrUpdUser
-	(*v1.CrUpdTime)(nil),                    // 21: common.v1.CrUpdTime
-	(*timestamppb.Timestamp)(nil),           // 22: google.protobuf.Timestamp
-	(*v1.GetRequest)(nil),                   // 23: common.v1.GetRequest
-	(*v1.GetByIdRequest)(nil),               // 24: common.v1.GetByIdRequest
+	(*CreditNoteHeader)(nil),                     // 0: invoice.v1.CreditNoteHeader
+	(*CreditNoteHeaderD)(nil),                    // 1: invoice.v1.CreditNoteHeaderD
+	(*CreditNoteHeaderT)(nil),                    // 2: invoice.v1.CreditNoteHeaderT
+	(*CreateCreditNoteHeaderRequest)(nil),        // 3: invoice.v1.CreateCreditNoteHeaderRequest
+	(*CreateCreditNoteHeaderResponse)(nil),       // 4: invoice.v1.CreateCreditNoteHeaderResponse
+	(*UpdateCreditNoteHeaderRequest)(nil),        // 5: invoice.v1.UpdateCreditNoteHeaderRequest
+	(*UpdateCreditNoteHeaderResponse)(nil),       // 6: invoice.v1.UpdateCreditNoteHeaderResponse
+	(*ReviseCreditNoteRequest)(nil),              // 7: invoice.v1.ReviseCreditNoteRequest
+	(*ReviseCreditNoteResponse)(nil),             // 8: invoice.v1.ReviseCreditNoteResponse
+	(*GetCreditNoteHeaderRequest)(nil),           // 9: invoice.v1.GetCreditNoteHeaderRequest
+	(*GetCreditNoteHeaderResponse)(nil),          // 10: invoice.v1.GetCreditNoteHeaderResponse
+	(*GetCreditNoteUBLRequest)(nil),              // 11: invoice.v1.GetCreditNoteUBLRequest
+	(*GetCreditNoteUBLResponse)(nil),             // 12: invoice.v1.GetCreditNoteUBLResponse
+	(*ImportCreditNoteUBLRequest)(nil),           // 13: invoice.v1.ImportCreditNoteUBLRequest
+	(*ImportCreditNoteUBLResponse)(nil),          // 14: invoice.v1.ImportCreditNoteUBLResponse
+	(*GetCreditNoteCIIRequest)(nil),              // 15: invoice.v1.GetCreditNoteCIIRequest
+	(*GetCreditNoteCIIResponse)(nil),             // 16: invoice.v1.GetCreditNoteCIIResponse
+	(*ImportCreditNoteCIIRequest)(nil),           // 17: invoice.v1.ImportCreditNoteCIIRequest
+	(*ImportCreditNoteCIIResponse)(nil),          // 18: invoice.v1.ImportCreditNoteCIIResponse
+	(*GetCreditNoteHeaderByPkRequest)(nil),       // 19: invoice.v1.GetCreditNoteHeaderByPkRequest
+	(*GetCreditNoteHeaderByPkResponse)(nil),      // 20: invoice.v1.GetCreditNoteHeaderByPkResponse
+	(*GetCreditNoteHeadersRequest)(nil),          // 21: invoice.v1.GetCreditNoteHeadersRequest
+	(*GetCreditNoteHeadersResponse)(nil),         // 22: invoice.v1.GetCreditNoteHeadersResponse
+	(*CreditNoteLine)(nil),                       // 23: invoice.v1.CreditNoteLine
+	(*CreditNoteLineD)(nil),                      // 24: invoice.v1.CreditNoteLineD
+	(*CreditNoteLineT)(nil),                      // 25: invoice.v1.CreditNoteLineT
+	(*CreateCreditNoteLineRequest)(nil),          // 26: invoice.v1.CreateCreditNoteLineRequest
+	(*CreateCreditNoteLineResponse)(nil),         // 27: invoice.v1.CreateCreditNoteLineResponse
+	(*GetCreditNoteLinesRequest)(nil),            // 28: invoice.v1.GetCreditNoteLinesRequest
+	(*GetCreditNoteLinesResponse)(nil),           // 29: invoice.v1.GetCreditNoteLinesResponse
+	(*UpdateCreditNoteLineRequest)(nil),          // 30: invoice.v1.UpdateCreditNoteLineRequest
+	(*UpdateCreditNoteLineResponse)(nil),         // 31: invoice.v1.UpdateCreditNoteLineResponse
+	(*DeleteCreditNoteLineRequest)(nil),          // 32: invoice.v1.DeleteCreditNoteLineRequest
+	(*DeleteCreditNoteLineResponse)(nil),         // 33: invoice.v1.DeleteCreditNoteLineResponse
+	(*v1.CrUpdUser)(nil),                         // 34: common.v1.CrUpdUser
+	(*v1.CrUpdTime)(nil),                         // 35: common.v1.CrUpdTime
+	(*v1.AllowanceCharge)(nil),                   // 36: common.v1.AllowanceCharge
+	(*timestamppb.Timestamp)(nil),                // 37: google.protobuf.Timestamp
+	(*v1.CreateAllowanceChargeRequest)(nil),      // 38: common.v1.CreateAllowanceChargeRequest
+	(*v1.DocumentVersion)(nil),                   // 39: common.v1.DocumentVersion
+	(*v1.GetRequest)(nil),                        // 40: common.v1.GetRequest
+	(*v1.GetByIdRequest)(nil),                    // 41: common.v1.GetByIdRequest
+	(*TransitionDocumentStatusRequest)(nil),      // 42: invoice.v1.TransitionDocumentStatusRequest
+	(*GetDocumentStatusTransitionsRequest)(nil),  // 43: invoice.v1.GetDocumentStatusTransitionsRequest
+	(*v1.GetDocumentVersionsRequest)(nil),        // 44: common.v1.GetDocumentVersionsRequest
+	(*v1.DiffDocumentVersionsRequest)(nil),       // 45: common.v1.DiffDocumentVersionsRequest
+	(*TransitionDocumentStatusResponse)(nil),     // 46: invoice.v1.TransitionDocumentStatusResponse
+	(*GetDocumentStatusTransitionsResponse)(nil), // 47: invoice.v1.GetDocumentStatusTransitionsResponse
+	(*v1.GetDocumentVersionsResponse)(nil),       // 48: common.v1.GetDocumentVersionsResponse
+	(*v1.DiffDocumentVersionsResponse)(nil),      // 49: common.v1.DiffDocumentVersionsResponse
 }
 var file_invoice_v1_creditnote_proto_depIdxs = []int32{
 	1,  // 0: invoice.v1.CreditNoteHeader.credit_note_header_d:type_name -> invoice.v1.CreditNoteHeaderD
 	2,  // 1: invoice.v1.CreditNoteHeader.credit_note_header_t:type_name -> invoice.v1.CreditNoteHeaderT
-	20, // 2: invoice.v1.CreditNoteHeader.cr_upd_user:type_name -> common.v1.CrUpdUser
-	21, // 3: invoice.v1.CreditNoteHeader.cr_upd_time:type_name -> common.v1.CrUpdTime
-	22, // 4: invoice.v1.CreditNoteHeaderT.issue_date:type_name -> google.protobuf.Timestamp
-	22, // 5: invoice.v1.CreditNoteHeaderT.due_date:type_name -> google.protobuf.Timestamp
-	22, // 6: invoice.v1.CreditNoteHeaderT.tax_point_date:type_name -> google.protobuf.Timestamp
-	22, // 7: invoice.v1.CreditNoteHeaderT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
-	22, // 8: invoice.v1.CreditNoteHeaderT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
-	22, // 9: invoice.v1.CreditNoteHeaderT.tax_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 10: invoice.v1.CreditNoteHeaderT.pricing_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 11: invoice.v1.CreditNoteHeaderT.payment_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 12: invoice.v1.CreditNoteHeaderT.payment_alt_ex_date:type_name -> google.protobuf.Timestamp
-	16, // 13: invoice.v1.CreateCreditNoteHeaderRequest.credit_note_lines:type_name -> invoice.v1.CreateCreditNoteLineRequest
-	0,  // 14: invoice.v1.CreateCreditNoteHeaderResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
-	23, // 15: invoice.v1.GetCreditNoteHeaderRequest.get_request:type_name -> common.v1.GetRequest
-	0,  // 16: invoice.v1.GetCreditNoteHeaderResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
-	24, // 17: invoice.v1.GetCreditNoteHeaderByPkRequest.get_by_id_request:type_name -> common.v1.GetByIdRequest
-	0,  // 18: invoice.v1.GetCreditNoteHeaderByPkResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
-	0,  // 19: invoice.v1.GetCreditNoteHeadersResponse.credit_note_headers:type_name -> invoice.v1.CreditNoteHeader
-	14, // 20: invoice.v1.CreditNoteLine.credit_note_line_d:type_name -> invoice.v1.CreditNoteLineD
-	15, // 21: invoice.v1.CreditNoteLine.credit_note_line_t:type_name -> invoice.v1.CreditNoteLineT
-	20, // 22: invoice.v1.CreditNoteLine.cr_upd_user:type_name -> common.v1.CrUpdUser
-	21, // 23: invoice.v1.CreditNoteLine.cr_upd_time:type_name -> common.v1.CrUpdTime
-	22, // 24: invoice.v1.CreditNoteLineT.tax_point_date:type_name -> google.protobuf.Timestamp
-	22, // 25: invoice.v1.CreditNoteLineT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
-	22, // 26: invoice.v1.CreditNoteLineT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
-	22, // 27: invoice.v1.CreditNoteLineT.price_validity_period_start_date:type_name -> google.protobuf.Timestamp
-	22, // 28: invoice.v1.CreditNoteLineT.price_validity_period_end_date:type_name -> google.protobuf.Timestamp
-	13, // 29: invoice.v1.CreateCreditNoteLineResponse.credit_note_line:type_name -> invoice.v1.CreditNoteLine
-	23, // 30: invoice.v1.GetCreditNoteLinesRequest.get_request:type_name -> common.v1.GetRequest
-	13, // 31: invoice.v1.GetCreditNoteLinesResponse.credit_note_lines:type_name -> invoice.v1.CreditNoteLine
-	3,  // 32: invoice.v1.CreditNoteHeaderService.CreateCreditNoteHeader:input_type -> invoice.v1.CreateCreditNoteHeaderRequest
-	11, // 33: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaders:input_type -> invoice.v1.GetCreditNoteHeadersRequest
-	7,  // 34: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeader:input_type -> invoice.v1.GetCreditNoteHeaderRequest
-	9,  // 35: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaderByPk:input_type -> invoice.v1.GetCreditNoteHeaderByPkRequest
-	16, // 36: invoice.v1.CreditNoteHeaderService.CreateCreditNoteLine:input_type -> invoice.v1.CreateCreditNoteLineRequest
-	18, // 37: invoice.v1.CreditNoteHeaderService.GetCreditNoteLines:input_type -> invoice.v1.GetCreditNoteLinesRequest
-	5,  // 38: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteHeader:input_type -> invoice.v1.UpdateCreditNoteHeaderRequest
-	4,  // 39: invoice.v1.CreditNoteHeaderService.CreateCreditNoteHeader:output_type -> invoice.v1.CreateCreditNoteHeaderResponse
-	12, // 40: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaders:output_type -> invoice.v1.GetCreditNoteHeadersResponse
-	8,  // 41: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeader:output_type -> invoice.v1.GetCreditNoteHeaderResponse
-	10, // 42: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaderByPk:output_type -> invoice.v1.GetCreditNoteHeaderByPkResponse
-	17, // 43: invoice.v1.CreditNoteHeaderService.CreateCreditNoteLine:output_type -> invoice.v1.CreateCreditNoteLineResponse
-	19, // 44: invoice.v1.CreditNoteHeaderService.GetCreditNoteLines:output_type -> invoice.v1.GetCreditNoteLinesResponse
-	6,  // 45: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteHeader:output_type -> invoice.v1.UpdateCreditNoteHeaderResponse
-	39, // [39:46] is the sub-list for method output_type
-	32, // [32:39] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	34, // 2: invoice.v1.CreditNoteHeader.cr_upd_user:type_name -> common.v1.CrUpdUser
+	35, // 3: invoice.v1.CreditNoteHeader.cr_upd_time:type_name -> common.v1.CrUpdTime
+	36, // 4: invoice.v1.CreditNoteHeader.allowance_charges:type_name -> common.v1.AllowanceCharge
+	37, // 5: invoice.v1.CreditNoteHeaderT.issue_date:type_name -> google.protobuf.Timestamp
+	37, // 6: invoice.v1.CreditNoteHeaderT.due_date:type_name -> google.protobuf.Timestamp
+	37, // 7: invoice.v1.CreditNoteHeaderT.tax_point_date:type_name -> google.protobuf.Timestamp
+	37, // 8: invoice.v1.CreditNoteHeaderT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
+	37, // 9: invoice.v1.CreditNoteHeaderT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
+	37, // 10: invoice.v1.CreditNoteHeaderT.tax_ex_date:type_name -> google.protobuf.Timestamp
+	37, // 11: invoice.v1.CreditNoteHeaderT.pricing_ex_date:type_name -> google.protobuf.Timestamp
+	37, // 12: invoice.v1.CreditNoteHeaderT.payment_ex_date:type_name -> google.protobuf.Timestamp
+	37, // 13: invoice.v1.CreditNoteHeaderT.payment_alt_ex_date:type_name -> google.protobuf.Timestamp
+	26, // 14: invoice.v1.CreateCreditNoteHeaderRequest.credit_note_lines:type_name -> invoice.v1.CreateCreditNoteLineRequest
+	38, // 15: invoice.v1.CreateCreditNoteHeaderRequest.allowance_charges:type_name -> common.v1.CreateAllowanceChargeRequest
+	0,  // 16: invoice.v1.CreateCreditNoteHeaderResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
+	5,  // 17: invoice.v1.ReviseCreditNoteRequest.update_credit_note_header_request:type_name -> invoice.v1.UpdateCreditNoteHeaderRequest
+	39, // 18: invoice.v1.ReviseCreditNoteResponse.document_version:type_name -> common.v1.DocumentVersion
+	40, // 19: invoice.v1.GetCreditNoteHeaderRequest.get_request:type_name -> common.v1.GetRequest
+	0,  // 20: invoice.v1.GetCreditNoteHeaderResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
+	40, // 21: invoice.v1.GetCreditNoteUBLRequest.get_request:type_name -> common.v1.GetRequest
+	0,  // 22: invoice.v1.ImportCreditNoteUBLResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
+	40, // 23: invoice.v1.GetCreditNoteCIIRequest.get_request:type_name -> common.v1.GetRequest
+	0,  // 24: invoice.v1.ImportCreditNoteCIIResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
+	41, // 25: invoice.v1.GetCreditNoteHeaderByPkRequest.get_by_id_request:type_name -> common.v1.GetByIdRequest
+	0,  // 26: invoice.v1.GetCreditNoteHeaderByPkResponse.credit_note_header:type_name -> invoice.v1.CreditNoteHeader
+	0,  // 27: invoice.v1.GetCreditNoteHeadersResponse.credit_note_headers:type_name -> invoice.v1.CreditNoteHeader
+	24, // 28: invoice.v1.CreditNoteLine.credit_note_line_d:type_name -> invoice.v1.CreditNoteLineD
+	25, // 29: invoice.v1.CreditNoteLine.credit_note_line_t:type_name -> invoice.v1.CreditNoteLineT
+	34, // 30: invoice.v1.CreditNoteLine.cr_upd_user:type_name -> common.v1.CrUpdUser
+	35, // 31: invoice.v1.CreditNoteLine.cr_upd_time:type_name -> common.v1.CrUpdTime
+	36, // 32: invoice.v1.CreditNoteLine.allowance_charges:type_name -> common.v1.AllowanceCharge
+	37, // 33: invoice.v1.CreditNoteLineT.tax_point_date:type_name -> google.protobuf.Timestamp
+	37, // 34: invoice.v1.CreditNoteLineT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
+	37, // 35: invoice.v1.CreditNoteLineT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
+	37, // 36: invoice.v1.CreditNoteLineT.price_validity_period_start_date:type_name -> google.protobuf.Timestamp
+	37, // 37: invoice.v1.CreditNoteLineT.price_validity_period_end_date:type_name -> google.protobuf.Timestamp
+	38, // 38: invoice.v1.CreateCreditNoteLineRequest.allowance_charges:type_name -> common.v1.CreateAllowanceChargeRequest
+	23, // 39: invoice.v1.CreateCreditNoteLineResponse.credit_note_line:type_name -> invoice.v1.CreditNoteLine
+	40, // 40: invoice.v1.GetCreditNoteLinesRequest.get_request:type_name -> common.v1.GetRequest
+	23, // 41: invoice.v1.GetCreditNoteLinesResponse.credit_note_lines:type_name -> invoice.v1.CreditNoteLine
+	40, // 42: invoice.v1.DeleteCreditNoteLineRequest.get_request:type_name -> common.v1.GetRequest
+	3,  // 43: invoice.v1.CreditNoteHeaderService.CreateCreditNoteHeader:input_type -> invoice.v1.CreateCreditNoteHeaderRequest
+	21, // 44: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaders:input_type -> invoice.v1.GetCreditNoteHeadersRequest
+	9,  // 45: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeader:input_type -> invoice.v1.GetCreditNoteHeaderRequest
+	19, // 46: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaderByPk:input_type -> invoice.v1.GetCreditNoteHeaderByPkRequest
+	26, // 47: invoice.v1.CreditNoteHeaderService.CreateCreditNoteLine:input_type -> invoice.v1.CreateCreditNoteLineRequest
+	28, // 48: invoice.v1.CreditNoteHeaderService.GetCreditNoteLines:input_type -> invoice.v1.GetCreditNoteLinesRequest
+	30, // 49: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteLine:input_type -> invoice.v1.UpdateCreditNoteLineRequest
+	32, // 50: invoice.v1.CreditNoteHeaderService.DeleteCreditNoteLine:input_type -> invoice.v1.DeleteCreditNoteLineRequest
+	5,  // 51: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteHeader:input_type -> invoice.v1.UpdateCreditNoteHeaderRequest
+	11, // 52: invoice.v1.CreditNoteHeaderService.GetCreditNoteUBL:input_type -> invoice.v1.GetCreditNoteUBLRequest
+	13, // 53: invoice.v1.CreditNoteHeaderService.ImportCreditNoteUBL:input_type -> invoice.v1.ImportCreditNoteUBLRequest
+	15, // 54: invoice.v1.CreditNoteHeaderService.GetCreditNoteCII:input_type -> invoice.v1.GetCreditNoteCIIRequest
+	17, // 55: invoice.v1.CreditNoteHeaderService.ImportCreditNoteCII:input_type -> invoice.v1.ImportCreditNoteCIIRequest
+	42, // 56: invoice.v1.CreditNoteHeaderService.IssueCreditNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 57: invoice.v1.CreditNoteHeaderService.SendCreditNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 58: invoice.v1.CreditNoteHeaderService.AcknowledgeCreditNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 59: invoice.v1.CreditNoteHeaderService.MarkCreditNotePartiallyPaid:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 60: invoice.v1.CreditNoteHeaderService.MarkCreditNotePaid:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 61: invoice.v1.CreditNoteHeaderService.MarkCreditNoteDisputed:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	42, // 62: invoice.v1.CreditNoteHeaderService.CancelCreditNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	43, // 63: invoice.v1.CreditNoteHeaderService.GetCreditNoteStatusTransitions:input_type -> invoice.v1.GetDocumentStatusTransitionsRequest
+	7,  // 64: invoice.v1.CreditNoteHeaderService.ReviseCreditNote:input_type -> invoice.v1.ReviseCreditNoteRequest
+	44, // 65: invoice.v1.CreditNoteHeaderService.GetCreditNoteVersions:input_type -> common.v1.GetDocumentVersionsRequest
+	45, // 66: invoice.v1.CreditNoteHeaderService.DiffCreditNoteVersions:input_type -> common.v1.DiffDocumentVersionsRequest
+	4,  // 67: invoice.v1.CreditNoteHeaderService.CreateCreditNoteHeader:output_type -> invoice.v1.CreateCreditNoteHeaderResponse
+	22, // 68: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaders:output_type -> invoice.v1.GetCreditNoteHeadersResponse
+	10, // 69: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeader:output_type -> invoice.v1.GetCreditNoteHeaderResponse
+	20, // 70: invoice.v1.CreditNoteHeaderService.GetCreditNoteHeaderByPk:output_type -> invoice.v1.GetCreditNoteHeaderByPkResponse
+	27, // 71: invoice.v1.CreditNoteHeaderService.CreateCreditNoteLine:output_type -> invoice.v1.CreateCreditNoteLineResponse
+	29, // 72: invoice.v1.CreditNoteHeaderService.GetCreditNoteLines:output_type -> invoice.v1.GetCreditNoteLinesResponse
+	31, // 73: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteLine:output_type -> invoice.v1.UpdateCreditNoteLineResponse
+	33, // 74: invoice.v1.CreditNoteHeaderService.DeleteCreditNoteLine:output_type -> invoice.v1.DeleteCreditNoteLineResponse
+	6,  // 75: invoice.v1.CreditNoteHeaderService.UpdateCreditNoteHeader:output_type -> invoice.v1.UpdateCreditNoteHeaderResponse
+	12, // 76: invoice.v1.CreditNoteHeaderService.GetCreditNoteUBL:output_type -> invoice.v1.GetCreditNoteUBLResponse
+	14, // 77: invoice.v1.CreditNoteHeaderService.ImportCreditNoteUBL:output_type -> invoice.v1.ImportCreditNoteUBLResponse
+	16, // 78: invoice.v1.CreditNoteHeaderService.GetCreditNoteCII:output_type -> invoice.v1.GetCreditNoteCIIResponse
+	18, // 79: invoice.v1.CreditNoteHeaderService.ImportCreditNoteCII:output_type -> invoice.v1.ImportCreditNoteCIIResponse
+	46, // 80: invoice.v1.CreditNoteHeaderService.IssueCreditNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 81: invoice.v1.CreditNoteHeaderService.SendCreditNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 82: invoice.v1.CreditNoteHeaderService.AcknowledgeCreditNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 83: invoice.v1.CreditNoteHeaderService.MarkCreditNotePartiallyPaid:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 84: invoice.v1.CreditNoteHeaderService.MarkCreditNotePaid:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 85: invoice.v1.CreditNoteHeaderService.MarkCreditNoteDisputed:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	46, // 86: invoice.v1.CreditNoteHeaderService.CancelCreditNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	47, // 87: invoice.v1.CreditNoteHeaderService.GetCreditNoteStatusTransitions:output_type -> invoice.v1.GetDocumentStatusTransitionsResponse
+	8,  // 88: invoice.v1.CreditNoteHeaderService.ReviseCreditNote:output_type -> invoice.v1.ReviseCreditNoteResponse
+	48, // 89: invoice.v1.CreditNoteHeaderService.GetCreditNoteVersions:output_type -> common.v1.GetDocumentVersionsResponse
+	49, // 90: invoice.v1.CreditNoteHeaderService.DiffCreditNoteVersions:output_type -> common.v1.DiffDocumentVersionsResponse
+	67, // [67:91] is the sub-list for method output_type
+	43, // [43:67] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_invoice_v1_creditnote_proto_init() }
@@ -3730,13 +4948,14 @@ func file_invoice_v1_creditnote_proto_init() {
 	if File_invoice_v1_creditnote_proto != nil {
 		return
 	}
+	file_invoice_v1_documentstatus_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_invoice_v1_creditnote_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
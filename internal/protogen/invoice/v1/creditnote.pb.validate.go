@@ -173,6 +173,40 @@ func (m *CreditNoteHeader) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreditNoteHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreditNoteHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreditNoteHeaderValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreditNoteHeaderMultiError(errors)
 	}
@@ -405,6 +439,12 @@ func (m *CreditNoteHeaderD) validate(all bool) error {
 
 	// no validation rules for PayableAlternativeAmount
 
+	// no validation rules for BuyerReference
+
+	// no validation rules for DocumentStatusCode
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return CreditNoteHeaderDMultiError(errors)
 	}
@@ -1054,6 +1094,46 @@ func (m *CreateCreditNoteHeaderRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for BuyerReference
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCreditNoteHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCreditNoteHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCreditNoteHeaderRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return CreateCreditNoteHeaderRequestMultiError(errors)
 	}
@@ -1493,22 +1573,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateCreditNoteHeaderResponseValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeaderRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReviseCreditNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeaderRequest) Validate() error {
+func (m *ReviseCreditNoteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeaderRequest with the
+// ValidateAll checks the field values on ReviseCreditNoteRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeaderRequestMultiError, or nil if none found.
-func (m *GetCreditNoteHeaderRequest) ValidateAll() error {
+// ReviseCreditNoteRequestMultiError, or nil if none found.
+func (m *ReviseCreditNoteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeaderRequest) validate(all bool) error {
+func (m *ReviseCreditNoteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1516,48 +1596,50 @@ func (m *GetCreditNoteHeaderRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetGetRequest()).(type) {
+		switch v := interface{}(m.GetUpdateCreditNoteHeaderRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, ReviseCreditNoteRequestValidationError{
+					field:  "UpdateCreditNoteHeaderRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, ReviseCreditNoteRequestValidationError{
+					field:  "UpdateCreditNoteHeaderRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdateCreditNoteHeaderRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCreditNoteHeaderRequestValidationError{
-				field:  "GetRequest",
+			return ReviseCreditNoteRequestValidationError{
+				field:  "UpdateCreditNoteHeaderRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return GetCreditNoteHeaderRequestMultiError(errors)
+		return ReviseCreditNoteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeaderRequestMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeaderRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetCreditNoteHeaderRequestMultiError []error
+// ReviseCreditNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by ReviseCreditNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReviseCreditNoteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeaderRequestMultiError) Error() string {
+func (m ReviseCreditNoteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1566,11 +1648,11 @@ func (m GetCreditNoteHeaderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeaderRequestMultiError) AllErrors() []error { return m }
+func (m ReviseCreditNoteRequestMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeaderRequestValidationError is the validation error returned
-// by GetCreditNoteHeaderRequest.Validate if the designated constraints aren't met.
-type GetCreditNoteHeaderRequestValidationError struct {
+// ReviseCreditNoteRequestValidationError is the validation error returned by
+// ReviseCreditNoteRequest.Validate if the designated constraints aren't met.
+type ReviseCreditNoteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1578,24 +1660,24 @@ type GetCreditNoteHeaderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeaderRequestValidationError) Field() string { return e.field }
+func (e ReviseCreditNoteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeaderRequestValidationError) Reason() string { return e.reason }
+func (e ReviseCreditNoteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeaderRequestValidationError) Cause() error { return e.cause }
+func (e ReviseCreditNoteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeaderRequestValidationError) Key() bool { return e.key }
+func (e ReviseCreditNoteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeaderRequestValidationError) ErrorName() string {
-	return "GetCreditNoteHeaderRequestValidationError"
+func (e ReviseCreditNoteRequestValidationError) ErrorName() string {
+	return "ReviseCreditNoteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeaderRequestValidationError) Error() string {
+func (e ReviseCreditNoteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1607,14 +1689,14 @@ func (e GetCreditNoteHeaderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeaderRequest.%s: %s%s",
+		"invalid %sReviseCreditNoteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeaderRequestValidationError{}
+var _ error = ReviseCreditNoteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1622,24 +1704,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeaderRequestValidationError{}
+} = ReviseCreditNoteRequestValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeaderResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReviseCreditNoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeaderResponse) Validate() error {
+func (m *ReviseCreditNoteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeaderResponse with the
+// ValidateAll checks the field values on ReviseCreditNoteResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeaderResponseMultiError, or nil if none found.
-func (m *GetCreditNoteHeaderResponse) ValidateAll() error {
+// ReviseCreditNoteResponseMultiError, or nil if none found.
+func (m *ReviseCreditNoteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeaderResponse) validate(all bool) error {
+func (m *ReviseCreditNoteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1647,28 +1729,28 @@ func (m *GetCreditNoteHeaderResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCreditNoteHeader()).(type) {
+		switch v := interface{}(m.GetDocumentVersion()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderResponseValidationError{
-					field:  "CreditNoteHeader",
+				errors = append(errors, ReviseCreditNoteResponseValidationError{
+					field:  "DocumentVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderResponseValidationError{
-					field:  "CreditNoteHeader",
+				errors = append(errors, ReviseCreditNoteResponseValidationError{
+					field:  "DocumentVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreditNoteHeader()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDocumentVersion()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCreditNoteHeaderResponseValidationError{
-				field:  "CreditNoteHeader",
+			return ReviseCreditNoteResponseValidationError{
+				field:  "DocumentVersion",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1676,19 +1758,19 @@ func (m *GetCreditNoteHeaderResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCreditNoteHeaderResponseMultiError(errors)
+		return ReviseCreditNoteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeaderResponseMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeaderResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetCreditNoteHeaderResponseMultiError []error
+// ReviseCreditNoteResponseMultiError is an error wrapping multiple validation
+// errors returned by ReviseCreditNoteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReviseCreditNoteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeaderResponseMultiError) Error() string {
+func (m ReviseCreditNoteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1697,12 +1779,11 @@ func (m GetCreditNoteHeaderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeaderResponseMultiError) AllErrors() []error { return m }
+func (m ReviseCreditNoteResponseMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeaderResponseValidationError is the validation error returned
-// by GetCreditNoteHeaderResponse.Validate if the designated constraints
-// aren't met.
-type GetCreditNoteHeaderResponseValidationError struct {
+// ReviseCreditNoteResponseValidationError is the validation error returned by
+// ReviseCreditNoteResponse.Validate if the designated constraints aren't met.
+type ReviseCreditNoteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1710,24 +1791,24 @@ type GetCreditNoteHeaderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeaderResponseValidationError) Field() string { return e.field }
+func (e ReviseCreditNoteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeaderResponseValidationError) Reason() string { return e.reason }
+func (e ReviseCreditNoteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeaderResponseValidationError) Cause() error { return e.cause }
+func (e ReviseCreditNoteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeaderResponseValidationError) Key() bool { return e.key }
+func (e ReviseCreditNoteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeaderResponseValidationError) ErrorName() string {
-	return "GetCreditNoteHeaderResponseValidationError"
+func (e ReviseCreditNoteResponseValidationError) ErrorName() string {
+	return "ReviseCreditNoteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeaderResponseValidationError) Error() string {
+func (e ReviseCreditNoteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1739,14 +1820,14 @@ func (e GetCreditNoteHeaderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeaderResponse.%s: %s%s",
+		"invalid %sReviseCreditNoteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeaderResponseValidationError{}
+var _ error = ReviseCreditNoteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1754,24 +1835,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeaderResponseValidationError{}
+} = ReviseCreditNoteResponseValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeaderByPkRequest with the
+// Validate checks the field values on GetCreditNoteHeaderRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeaderByPkRequest) Validate() error {
+func (m *GetCreditNoteHeaderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeaderByPkRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeaderByPkRequestMultiError, or nil if none found.
-func (m *GetCreditNoteHeaderByPkRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetCreditNoteHeaderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeaderRequestMultiError, or nil if none found.
+func (m *GetCreditNoteHeaderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeaderByPkRequest) validate(all bool) error {
+func (m *GetCreditNoteHeaderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1779,28 +1860,28 @@ func (m *GetCreditNoteHeaderByPkRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetGetByIdRequest()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderByPkRequestValidationError{
-					field:  "GetByIdRequest",
+				errors = append(errors, GetCreditNoteHeaderRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderByPkRequestValidationError{
-					field:  "GetByIdRequest",
+				errors = append(errors, GetCreditNoteHeaderRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCreditNoteHeaderByPkRequestValidationError{
-				field:  "GetByIdRequest",
+			return GetCreditNoteHeaderRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1808,19 +1889,19 @@ func (m *GetCreditNoteHeaderByPkRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCreditNoteHeaderByPkRequestMultiError(errors)
+		return GetCreditNoteHeaderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeaderByPkRequestMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeaderByPkRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetCreditNoteHeaderByPkRequestMultiError []error
+// GetCreditNoteHeaderRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeaderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCreditNoteHeaderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeaderByPkRequestMultiError) Error() string {
+func (m GetCreditNoteHeaderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1829,12 +1910,11 @@ func (m GetCreditNoteHeaderByPkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeaderByPkRequestMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteHeaderRequestMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeaderByPkRequestValidationError is the validation error
-// returned by GetCreditNoteHeaderByPkRequest.Validate if the designated
-// constraints aren't met.
-type GetCreditNoteHeaderByPkRequestValidationError struct {
+// GetCreditNoteHeaderRequestValidationError is the validation error returned
+// by GetCreditNoteHeaderRequest.Validate if the designated constraints aren't met.
+type GetCreditNoteHeaderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1842,24 +1922,24 @@ type GetCreditNoteHeaderByPkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeaderByPkRequestValidationError) Field() string { return e.field }
+func (e GetCreditNoteHeaderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeaderByPkRequestValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteHeaderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeaderByPkRequestValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteHeaderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeaderByPkRequestValidationError) Key() bool { return e.key }
+func (e GetCreditNoteHeaderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeaderByPkRequestValidationError) ErrorName() string {
-	return "GetCreditNoteHeaderByPkRequestValidationError"
+func (e GetCreditNoteHeaderRequestValidationError) ErrorName() string {
+	return "GetCreditNoteHeaderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeaderByPkRequestValidationError) Error() string {
+func (e GetCreditNoteHeaderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1871,14 +1951,14 @@ func (e GetCreditNoteHeaderByPkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeaderByPkRequest.%s: %s%s",
+		"invalid %sGetCreditNoteHeaderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeaderByPkRequestValidationError{}
+var _ error = GetCreditNoteHeaderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1886,24 +1966,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeaderByPkRequestValidationError{}
+} = GetCreditNoteHeaderRequestValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeaderByPkResponse with the
+// Validate checks the field values on GetCreditNoteHeaderResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeaderByPkResponse) Validate() error {
+func (m *GetCreditNoteHeaderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeaderByPkResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeaderByPkResponseMultiError, or nil if none found.
-func (m *GetCreditNoteHeaderByPkResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetCreditNoteHeaderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeaderResponseMultiError, or nil if none found.
+func (m *GetCreditNoteHeaderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
+func (m *GetCreditNoteHeaderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1914,7 +1994,7 @@ func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
 		switch v := interface{}(m.GetCreditNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderByPkResponseValidationError{
+				errors = append(errors, GetCreditNoteHeaderResponseValidationError{
 					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1922,7 +2002,7 @@ func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCreditNoteHeaderByPkResponseValidationError{
+				errors = append(errors, GetCreditNoteHeaderResponseValidationError{
 					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1931,7 +2011,7 @@ func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreditNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCreditNoteHeaderByPkResponseValidationError{
+			return GetCreditNoteHeaderResponseValidationError{
 				field:  "CreditNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1940,19 +2020,19 @@ func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCreditNoteHeaderByPkResponseMultiError(errors)
+		return GetCreditNoteHeaderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeaderByPkResponseMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeaderByPkResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetCreditNoteHeaderByPkResponseMultiError []error
+// GetCreditNoteHeaderResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeaderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCreditNoteHeaderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeaderByPkResponseMultiError) Error() string {
+func (m GetCreditNoteHeaderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1961,12 +2041,12 @@ func (m GetCreditNoteHeaderByPkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeaderByPkResponseMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteHeaderResponseMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeaderByPkResponseValidationError is the validation error
-// returned by GetCreditNoteHeaderByPkResponse.Validate if the designated
-// constraints aren't met.
-type GetCreditNoteHeaderByPkResponseValidationError struct {
+// GetCreditNoteHeaderResponseValidationError is the validation error returned
+// by GetCreditNoteHeaderResponse.Validate if the designated constraints
+// aren't met.
+type GetCreditNoteHeaderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1974,24 +2054,24 @@ type GetCreditNoteHeaderByPkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeaderByPkResponseValidationError) Field() string { return e.field }
+func (e GetCreditNoteHeaderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeaderByPkResponseValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteHeaderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeaderByPkResponseValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteHeaderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeaderByPkResponseValidationError) Key() bool { return e.key }
+func (e GetCreditNoteHeaderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeaderByPkResponseValidationError) ErrorName() string {
-	return "GetCreditNoteHeaderByPkResponseValidationError"
+func (e GetCreditNoteHeaderResponseValidationError) ErrorName() string {
+	return "GetCreditNoteHeaderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeaderByPkResponseValidationError) Error() string {
+func (e GetCreditNoteHeaderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2003,14 +2083,14 @@ func (e GetCreditNoteHeaderByPkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeaderByPkResponse.%s: %s%s",
+		"invalid %sGetCreditNoteHeaderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeaderByPkResponseValidationError{}
+var _ error = GetCreditNoteHeaderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2018,52 +2098,179 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeaderByPkResponseValidationError{}
+} = GetCreditNoteHeaderResponseValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeadersRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCreditNoteUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeadersRequest) Validate() error {
+func (m *GetCreditNoteUBLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeadersRequest with the
+// ValidateAll checks the field values on GetCreditNoteUBLRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeadersRequestMultiError, or nil if none found.
-func (m *GetCreditNoteHeadersRequest) ValidateAll() error {
+// GetCreditNoteUBLRequestMultiError, or nil if none found.
+func (m *GetCreditNoteUBLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeadersRequest) validate(all bool) error {
+func (m *GetCreditNoteUBLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCreditNoteUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCreditNoteUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCreditNoteUBLRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for NextCursor
+	// no validation rules for Profile
 
-	// no validation rules for UserEmail
+	if len(errors) > 0 {
+		return GetCreditNoteUBLRequestMultiError(errors)
+	}
 
-	// no validation rules for RequestId
+	return nil
+}
+
+// GetCreditNoteUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCreditNoteUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCreditNoteUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteUBLRequestMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteUBLRequestValidationError is the validation error returned by
+// GetCreditNoteUBLRequest.Validate if the designated constraints aren't met.
+type GetCreditNoteUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteUBLRequestValidationError) ErrorName() string {
+	return "GetCreditNoteUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteUBLRequestValidationError{}
+
+// Validate checks the field values on GetCreditNoteUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteUBLResponseMultiError, or nil if none found.
+func (m *GetCreditNoteUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
 
 	if len(errors) > 0 {
-		return GetCreditNoteHeadersRequestMultiError(errors)
+		return GetCreditNoteUBLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeadersRequestMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeadersRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetCreditNoteHeadersRequestMultiError []error
+// GetCreditNoteUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCreditNoteUBLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCreditNoteUBLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeadersRequestMultiError) Error() string {
+func (m GetCreditNoteUBLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2072,12 +2279,11 @@ func (m GetCreditNoteHeadersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeadersRequestMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteUBLResponseMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeadersRequestValidationError is the validation error returned
-// by GetCreditNoteHeadersRequest.Validate if the designated constraints
-// aren't met.
-type GetCreditNoteHeadersRequestValidationError struct {
+// GetCreditNoteUBLResponseValidationError is the validation error returned by
+// GetCreditNoteUBLResponse.Validate if the designated constraints aren't met.
+type GetCreditNoteUBLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2085,24 +2291,24 @@ type GetCreditNoteHeadersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeadersRequestValidationError) Field() string { return e.field }
+func (e GetCreditNoteUBLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeadersRequestValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteUBLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeadersRequestValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteUBLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeadersRequestValidationError) Key() bool { return e.key }
+func (e GetCreditNoteUBLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeadersRequestValidationError) ErrorName() string {
-	return "GetCreditNoteHeadersRequestValidationError"
+func (e GetCreditNoteUBLResponseValidationError) ErrorName() string {
+	return "GetCreditNoteUBLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeadersRequestValidationError) Error() string {
+func (e GetCreditNoteUBLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2114,14 +2320,14 @@ func (e GetCreditNoteHeadersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeadersRequest.%s: %s%s",
+		"invalid %sGetCreditNoteUBLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeadersRequestValidationError{}
+var _ error = GetCreditNoteUBLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2129,80 +2335,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeadersRequestValidationError{}
+} = GetCreditNoteUBLResponseValidationError{}
 
-// Validate checks the field values on GetCreditNoteHeadersResponse with the
+// Validate checks the field values on ImportCreditNoteUBLRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteHeadersResponse) Validate() error {
+func (m *ImportCreditNoteUBLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteHeadersResponse with the
+// ValidateAll checks the field values on ImportCreditNoteUBLRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteHeadersResponseMultiError, or nil if none found.
-func (m *GetCreditNoteHeadersResponse) ValidateAll() error {
+// ImportCreditNoteUBLRequestMultiError, or nil if none found.
+func (m *ImportCreditNoteUBLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteHeadersResponse) validate(all bool) error {
+func (m *ImportCreditNoteUBLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCreditNoteHeaders() {
-		_, _ = idx, item
+	// no validation rules for Ubl
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCreditNoteHeadersResponseValidationError{
-						field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCreditNoteHeadersResponseValidationError{
-						field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetCreditNoteHeadersResponseValidationError{
-					field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for UserId
 
-	}
+	// no validation rules for UserEmail
 
-	// no validation rules for NextCursor
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
 
 	if len(errors) > 0 {
-		return GetCreditNoteHeadersResponseMultiError(errors)
+		return ImportCreditNoteUBLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteHeadersResponseMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteHeadersResponse.ValidateAll() if
+// ImportCreditNoteUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportCreditNoteUBLRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetCreditNoteHeadersResponseMultiError []error
+type ImportCreditNoteUBLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteHeadersResponseMultiError) Error() string {
+func (m ImportCreditNoteUBLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2211,12 +2391,11 @@ func (m GetCreditNoteHeadersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteHeadersResponseMultiError) AllErrors() []error { return m }
+func (m ImportCreditNoteUBLRequestMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteHeadersResponseValidationError is the validation error returned
-// by GetCreditNoteHeadersResponse.Validate if the designated constraints
-// aren't met.
-type GetCreditNoteHeadersResponseValidationError struct {
+// ImportCreditNoteUBLRequestValidationError is the validation error returned
+// by ImportCreditNoteUBLRequest.Validate if the designated constraints aren't met.
+type ImportCreditNoteUBLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2224,24 +2403,24 @@ type GetCreditNoteHeadersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteHeadersResponseValidationError) Field() string { return e.field }
+func (e ImportCreditNoteUBLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteHeadersResponseValidationError) Reason() string { return e.reason }
+func (e ImportCreditNoteUBLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteHeadersResponseValidationError) Cause() error { return e.cause }
+func (e ImportCreditNoteUBLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteHeadersResponseValidationError) Key() bool { return e.key }
+func (e ImportCreditNoteUBLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteHeadersResponseValidationError) ErrorName() string {
-	return "GetCreditNoteHeadersResponseValidationError"
+func (e ImportCreditNoteUBLRequestValidationError) ErrorName() string {
+	return "ImportCreditNoteUBLRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteHeadersResponseValidationError) Error() string {
+func (e ImportCreditNoteUBLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2253,14 +2432,14 @@ func (e GetCreditNoteHeadersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteHeadersResponse.%s: %s%s",
+		"invalid %sImportCreditNoteUBLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteHeadersResponseValidationError{}
+var _ error = ImportCreditNoteUBLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2268,24 +2447,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteHeadersResponseValidationError{}
+} = ImportCreditNoteUBLRequestValidationError{}
 
-// Validate checks the field values on CreditNoteLine with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CreditNoteLine) Validate() error {
+// Validate checks the field values on ImportCreditNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCreditNoteUBLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreditNoteLine with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreditNoteLineMultiError,
-// or nil if none found.
-func (m *CreditNoteLine) ValidateAll() error {
+// ValidateAll checks the field values on ImportCreditNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCreditNoteUBLResponseMultiError, or nil if none found.
+func (m *ImportCreditNoteUBLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreditNoteLine) validate(all bool) error {
+func (m *ImportCreditNoteUBLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2293,115 +2472,160 @@ func (m *CreditNoteLine) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCreditNoteLineD()).(type) {
+		switch v := interface{}(m.GetCreditNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CreditNoteLineD",
+				errors = append(errors, ImportCreditNoteUBLResponseValidationError{
+					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CreditNoteLineD",
+				errors = append(errors, ImportCreditNoteUBLResponseValidationError{
+					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreditNoteLineD()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreditNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreditNoteLineValidationError{
-				field:  "CreditNoteLineD",
+			return ImportCreditNoteUBLResponseValidationError{
+				field:  "CreditNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetCreditNoteLineT()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CreditNoteLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CreditNoteLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreditNoteLineT()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreditNoteLineValidationError{
-				field:  "CreditNoteLineT",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return ImportCreditNoteUBLResponseMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetCrUpdUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreditNoteLineValidationError{
-				field:  "CrUpdUser",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// ImportCreditNoteUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportCreditNoteUBLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportCreditNoteUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCreditNoteUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCreditNoteUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportCreditNoteUBLResponseValidationError is the validation error returned
+// by ImportCreditNoteUBLResponse.Validate if the designated constraints
+// aren't met.
+type ImportCreditNoteUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCreditNoteUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCreditNoteUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCreditNoteUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCreditNoteUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCreditNoteUBLResponseValidationError) ErrorName() string {
+	return "ImportCreditNoteUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCreditNoteUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCreditNoteUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCreditNoteUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCreditNoteUBLResponseValidationError{}
+
+// Validate checks the field values on GetCreditNoteCIIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteCIIRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteCIIRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteCIIRequestMultiError, or nil if none found.
+func (m *GetCreditNoteCIIRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteCIIRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CrUpdTime",
+				errors = append(errors, GetCreditNoteCIIRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineValidationError{
-					field:  "CrUpdTime",
+				errors = append(errors, GetCreditNoteCIIRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreditNoteLineValidationError{
-				field:  "CrUpdTime",
+			return GetCreditNoteCIIRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2409,19 +2633,19 @@ func (m *CreditNoteLine) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreditNoteLineMultiError(errors)
+		return GetCreditNoteCIIRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreditNoteLineMultiError is an error wrapping multiple validation errors
-// returned by CreditNoteLine.ValidateAll() if the designated constraints
-// aren't met.
-type CreditNoteLineMultiError []error
+// GetCreditNoteCIIRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCreditNoteCIIRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCreditNoteCIIRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreditNoteLineMultiError) Error() string {
+func (m GetCreditNoteCIIRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2430,11 +2654,11 @@ func (m CreditNoteLineMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreditNoteLineMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteCIIRequestMultiError) AllErrors() []error { return m }
 
-// CreditNoteLineValidationError is the validation error returned by
-// CreditNoteLine.Validate if the designated constraints aren't met.
-type CreditNoteLineValidationError struct {
+// GetCreditNoteCIIRequestValidationError is the validation error returned by
+// GetCreditNoteCIIRequest.Validate if the designated constraints aren't met.
+type GetCreditNoteCIIRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2442,22 +2666,24 @@ type CreditNoteLineValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreditNoteLineValidationError) Field() string { return e.field }
+func (e GetCreditNoteCIIRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreditNoteLineValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteCIIRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreditNoteLineValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteCIIRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreditNoteLineValidationError) Key() bool { return e.key }
+func (e GetCreditNoteCIIRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreditNoteLineValidationError) ErrorName() string { return "CreditNoteLineValidationError" }
+func (e GetCreditNoteCIIRequestValidationError) ErrorName() string {
+	return "GetCreditNoteCIIRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreditNoteLineValidationError) Error() string {
+func (e GetCreditNoteCIIRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2469,14 +2695,14 @@ func (e CreditNoteLineValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreditNoteLine.%s: %s%s",
+		"invalid %sGetCreditNoteCIIRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreditNoteLineValidationError{}
+var _ error = GetCreditNoteCIIRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2484,96 +2710,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreditNoteLineValidationError{}
+} = GetCreditNoteCIIRequestValidationError{}
 
-// Validate checks the field values on CreditNoteLineD with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreditNoteLineD) Validate() error {
+// Validate checks the field values on GetCreditNoteCIIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteCIIResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreditNoteLineD with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCreditNoteCIIResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreditNoteLineDMultiError, or nil if none found.
-func (m *CreditNoteLineD) ValidateAll() error {
+// GetCreditNoteCIIResponseMultiError, or nil if none found.
+func (m *GetCreditNoteCIIResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreditNoteLineD) validate(all bool) error {
+func (m *GetCreditNoteCIIResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Uuid4
-
-	// no validation rules for IdS
-
-	// no validation rules for CnlId
-
-	// no validation rules for Note
-
-	// no validation rules for CreditedQuantity
-
-	// no validation rules for LineExtensionAmount
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for PaymentPurposeCode
-
-	// no validation rules for FreeOfChargeIndicator
-
-	// no validation rules for DiscrepancyResponse
-
-	// no validation rules for OrderLineId
-
-	// no validation rules for DespatchLineId
-
-	// no validation rules for ReceiptLineId
-
-	// no validation rules for BillingId
-
-	// no validation rules for OriginatorPartyId
-
-	// no validation rules for ItemId
-
-	// no validation rules for PriceAmount
-
-	// no validation rules for PriceBaseQuantity
-
-	// no validation rules for PriceChangeReason
-
-	// no validation rules for PriceTypeCode
-
-	// no validation rules for PriceType
-
-	// no validation rules for OrderableUnitFactorRate
-
-	// no validation rules for PriceListId
-
-	// no validation rules for CreditNoteHeaderId
+	// no validation rules for Cii
 
 	if len(errors) > 0 {
-		return CreditNoteLineDMultiError(errors)
+		return GetCreditNoteCIIResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreditNoteLineDMultiError is an error wrapping multiple validation errors
-// returned by CreditNoteLineD.ValidateAll() if the designated constraints
-// aren't met.
-type CreditNoteLineDMultiError []error
+// GetCreditNoteCIIResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCreditNoteCIIResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCreditNoteCIIResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreditNoteLineDMultiError) Error() string {
+func (m GetCreditNoteCIIResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2582,11 +2758,11 @@ func (m CreditNoteLineDMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreditNoteLineDMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteCIIResponseMultiError) AllErrors() []error { return m }
 
-// CreditNoteLineDValidationError is the validation error returned by
-// CreditNoteLineD.Validate if the designated constraints aren't met.
-type CreditNoteLineDValidationError struct {
+// GetCreditNoteCIIResponseValidationError is the validation error returned by
+// GetCreditNoteCIIResponse.Validate if the designated constraints aren't met.
+type GetCreditNoteCIIResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2594,22 +2770,24 @@ type CreditNoteLineDValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreditNoteLineDValidationError) Field() string { return e.field }
+func (e GetCreditNoteCIIResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreditNoteLineDValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteCIIResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreditNoteLineDValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteCIIResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreditNoteLineDValidationError) Key() bool { return e.key }
+func (e GetCreditNoteCIIResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreditNoteLineDValidationError) ErrorName() string { return "CreditNoteLineDValidationError" }
+func (e GetCreditNoteCIIResponseValidationError) ErrorName() string {
+	return "GetCreditNoteCIIResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreditNoteLineDValidationError) Error() string {
+func (e GetCreditNoteCIIResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2621,14 +2799,14 @@ func (e CreditNoteLineDValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreditNoteLineD.%s: %s%s",
+		"invalid %sGetCreditNoteCIIResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreditNoteLineDValidationError{}
+var _ error = GetCreditNoteCIIResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2636,169 +2814,1797 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreditNoteLineDValidationError{}
+} = GetCreditNoteCIIResponseValidationError{}
 
-// Validate checks the field values on CreditNoteLineT with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreditNoteLineT) Validate() error {
+// Validate checks the field values on ImportCreditNoteCIIRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCreditNoteCIIRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreditNoteLineT with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportCreditNoteCIIRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreditNoteLineTMultiError, or nil if none found.
-func (m *CreditNoteLineT) ValidateAll() error {
+// ImportCreditNoteCIIRequestMultiError, or nil if none found.
+func (m *ImportCreditNoteCIIRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreditNoteLineT) validate(all bool) error {
+func (m *ImportCreditNoteCIIRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTaxPointDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "TaxPointDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "TaxPointDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreditNoteLineTValidationError{
-				field:  "TaxPointDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Cii
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportCreditNoteCIIRequestMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetInvoicePeriodStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "InvoicePeriodStartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "InvoicePeriodStartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInvoicePeriodStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreditNoteLineTValidationError{
-				field:  "InvoicePeriodStartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// ImportCreditNoteCIIRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportCreditNoteCIIRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ImportCreditNoteCIIRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCreditNoteCIIRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCreditNoteCIIRequestMultiError) AllErrors() []error { return m }
+
+// ImportCreditNoteCIIRequestValidationError is the validation error returned
+// by ImportCreditNoteCIIRequest.Validate if the designated constraints aren't met.
+type ImportCreditNoteCIIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCreditNoteCIIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCreditNoteCIIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCreditNoteCIIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCreditNoteCIIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCreditNoteCIIRequestValidationError) ErrorName() string {
+	return "ImportCreditNoteCIIRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCreditNoteCIIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCreditNoteCIIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCreditNoteCIIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCreditNoteCIIRequestValidationError{}
+
+// Validate checks the field values on ImportCreditNoteCIIResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCreditNoteCIIResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCreditNoteCIIResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCreditNoteCIIResponseMultiError, or nil if none found.
+func (m *ImportCreditNoteCIIResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCreditNoteCIIResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetInvoicePeriodEndDate()).(type) {
+		switch v := interface{}(m.GetCreditNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "InvoicePeriodEndDate",
+				errors = append(errors, ImportCreditNoteCIIResponseValidationError{
+					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "InvoicePeriodEndDate",
+				errors = append(errors, ImportCreditNoteCIIResponseValidationError{
+					field:  "CreditNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetInvoicePeriodEndDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreditNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreditNoteLineTValidationError{
-				field:  "InvoicePeriodEndDate",
+			return ImportCreditNoteCIIResponseValidationError{
+				field:  "CreditNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return ImportCreditNoteCIIResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCreditNoteCIIResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportCreditNoteCIIResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportCreditNoteCIIResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCreditNoteCIIResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCreditNoteCIIResponseMultiError) AllErrors() []error { return m }
+
+// ImportCreditNoteCIIResponseValidationError is the validation error returned
+// by ImportCreditNoteCIIResponse.Validate if the designated constraints
+// aren't met.
+type ImportCreditNoteCIIResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCreditNoteCIIResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCreditNoteCIIResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCreditNoteCIIResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCreditNoteCIIResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCreditNoteCIIResponseValidationError) ErrorName() string {
+	return "ImportCreditNoteCIIResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCreditNoteCIIResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCreditNoteCIIResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCreditNoteCIIResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCreditNoteCIIResponseValidationError{}
+
+// Validate checks the field values on GetCreditNoteHeaderByPkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteHeaderByPkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteHeaderByPkRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeaderByPkRequestMultiError, or nil if none found.
+func (m *GetCreditNoteHeaderByPkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteHeaderByPkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
+		switch v := interface{}(m.GetGetByIdRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
+				errors = append(errors, GetCreditNoteHeaderByPkRequestValidationError{
+					field:  "GetByIdRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
+				errors = append(errors, GetCreditNoteHeaderByPkRequestValidationError{
+					field:  "GetByIdRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreditNoteLineTValidationError{
-				field:  "PriceValidityPeriodStartDate",
+			return GetCreditNoteHeaderByPkRequestValidationError{
+				field:  "GetByIdRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreditNoteLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(errors) > 0 {
+		return GetCreditNoteHeaderByPkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditNoteHeaderByPkRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeaderByPkRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetCreditNoteHeaderByPkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteHeaderByPkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteHeaderByPkRequestMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteHeaderByPkRequestValidationError is the validation error
+// returned by GetCreditNoteHeaderByPkRequest.Validate if the designated
+// constraints aren't met.
+type GetCreditNoteHeaderByPkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteHeaderByPkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteHeaderByPkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteHeaderByPkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteHeaderByPkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteHeaderByPkRequestValidationError) ErrorName() string {
+	return "GetCreditNoteHeaderByPkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteHeaderByPkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteHeaderByPkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteHeaderByPkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteHeaderByPkRequestValidationError{}
+
+// Validate checks the field values on GetCreditNoteHeaderByPkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteHeaderByPkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteHeaderByPkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeaderByPkResponseMultiError, or nil if none found.
+func (m *GetCreditNoteHeaderByPkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteHeaderByPkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreditNoteHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCreditNoteHeaderByPkResponseValidationError{
+					field:  "CreditNoteHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCreditNoteHeaderByPkResponseValidationError{
+					field:  "CreditNoteHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreditNoteHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCreditNoteHeaderByPkResponseValidationError{
+				field:  "CreditNoteHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCreditNoteHeaderByPkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditNoteHeaderByPkResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeaderByPkResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetCreditNoteHeaderByPkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteHeaderByPkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteHeaderByPkResponseMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteHeaderByPkResponseValidationError is the validation error
+// returned by GetCreditNoteHeaderByPkResponse.Validate if the designated
+// constraints aren't met.
+type GetCreditNoteHeaderByPkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteHeaderByPkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteHeaderByPkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteHeaderByPkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteHeaderByPkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteHeaderByPkResponseValidationError) ErrorName() string {
+	return "GetCreditNoteHeaderByPkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteHeaderByPkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteHeaderByPkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteHeaderByPkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteHeaderByPkResponseValidationError{}
+
+// Validate checks the field values on GetCreditNoteHeadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteHeadersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteHeadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeadersRequestMultiError, or nil if none found.
+func (m *GetCreditNoteHeadersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteHeadersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetCreditNoteHeadersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditNoteHeadersRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeadersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCreditNoteHeadersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteHeadersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteHeadersRequestMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteHeadersRequestValidationError is the validation error returned
+// by GetCreditNoteHeadersRequest.Validate if the designated constraints
+// aren't met.
+type GetCreditNoteHeadersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteHeadersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteHeadersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteHeadersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteHeadersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteHeadersRequestValidationError) ErrorName() string {
+	return "GetCreditNoteHeadersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteHeadersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteHeadersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteHeadersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteHeadersRequestValidationError{}
+
+// Validate checks the field values on GetCreditNoteHeadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteHeadersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteHeadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteHeadersResponseMultiError, or nil if none found.
+func (m *GetCreditNoteHeadersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteHeadersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCreditNoteHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCreditNoteHeadersResponseValidationError{
+						field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCreditNoteHeadersResponseValidationError{
+						field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCreditNoteHeadersResponseValidationError{
+					field:  fmt.Sprintf("CreditNoteHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetCreditNoteHeadersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditNoteHeadersResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteHeadersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCreditNoteHeadersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteHeadersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteHeadersResponseMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteHeadersResponseValidationError is the validation error returned
+// by GetCreditNoteHeadersResponse.Validate if the designated constraints
+// aren't met.
+type GetCreditNoteHeadersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteHeadersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteHeadersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteHeadersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteHeadersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteHeadersResponseValidationError) ErrorName() string {
+	return "GetCreditNoteHeadersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteHeadersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteHeadersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteHeadersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteHeadersResponseValidationError{}
+
+// Validate checks the field values on CreditNoteLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreditNoteLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreditNoteLine with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreditNoteLineMultiError,
+// or nil if none found.
+func (m *CreditNoteLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreditNoteLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreditNoteLineD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CreditNoteLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CreditNoteLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreditNoteLineD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineValidationError{
+				field:  "CreditNoteLineD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreditNoteLineT()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CreditNoteLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CreditNoteLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreditNoteLineT()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineValidationError{
+				field:  "CreditNoteLineT",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreditNoteLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreditNoteLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreditNoteLineValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreditNoteLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditNoteLineMultiError is an error wrapping multiple validation errors
+// returned by CreditNoteLine.ValidateAll() if the designated constraints
+// aren't met.
+type CreditNoteLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditNoteLineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditNoteLineMultiError) AllErrors() []error { return m }
+
+// CreditNoteLineValidationError is the validation error returned by
+// CreditNoteLine.Validate if the designated constraints aren't met.
+type CreditNoteLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditNoteLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditNoteLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditNoteLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditNoteLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditNoteLineValidationError) ErrorName() string { return "CreditNoteLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditNoteLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreditNoteLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditNoteLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditNoteLineValidationError{}
+
+// Validate checks the field values on CreditNoteLineD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreditNoteLineD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreditNoteLineD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreditNoteLineDMultiError, or nil if none found.
+func (m *CreditNoteLineD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreditNoteLineD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for CnlId
+
+	// no validation rules for Note
+
+	// no validation rules for CreditedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for FreeOfChargeIndicator
+
+	// no validation rules for DiscrepancyResponse
+
+	// no validation rules for OrderLineId
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for OriginatorPartyId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for CreditNoteHeaderId
+
+	// no validation rules for CurrencyCode
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return CreditNoteLineDMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditNoteLineDMultiError is an error wrapping multiple validation errors
+// returned by CreditNoteLineD.ValidateAll() if the designated constraints
+// aren't met.
+type CreditNoteLineDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditNoteLineDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditNoteLineDMultiError) AllErrors() []error { return m }
+
+// CreditNoteLineDValidationError is the validation error returned by
+// CreditNoteLineD.Validate if the designated constraints aren't met.
+type CreditNoteLineDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditNoteLineDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditNoteLineDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditNoteLineDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditNoteLineDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditNoteLineDValidationError) ErrorName() string { return "CreditNoteLineDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditNoteLineDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreditNoteLineD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditNoteLineDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditNoteLineDValidationError{}
+
+// Validate checks the field values on CreditNoteLineT with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreditNoteLineT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreditNoteLineT with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreditNoteLineTMultiError, or nil if none found.
+func (m *CreditNoteLineT) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreditNoteLineT) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTaxPointDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineTValidationError{
+				field:  "TaxPointDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvoicePeriodStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "InvoicePeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "InvoicePeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoicePeriodStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineTValidationError{
+				field:  "InvoicePeriodStartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvoicePeriodEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "InvoicePeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "InvoicePeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoicePeriodEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineTValidationError{
+				field:  "InvoicePeriodEndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineTValidationError{
+				field:  "PriceValidityPeriodStartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditNoteLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditNoteLineTValidationError{
+				field:  "PriceValidityPeriodEndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreditNoteLineTMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditNoteLineTMultiError is an error wrapping multiple validation errors
+// returned by CreditNoteLineT.ValidateAll() if the designated constraints
+// aren't met.
+type CreditNoteLineTMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditNoteLineTMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditNoteLineTMultiError) AllErrors() []error { return m }
+
+// CreditNoteLineTValidationError is the validation error returned by
+// CreditNoteLineT.Validate if the designated constraints aren't met.
+type CreditNoteLineTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditNoteLineTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditNoteLineTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditNoteLineTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditNoteLineTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditNoteLineTValidationError) ErrorName() string { return "CreditNoteLineTValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditNoteLineTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreditNoteLineT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditNoteLineTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditNoteLineTValidationError{}
+
+// Validate checks the field values on CreateCreditNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCreditNoteLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCreditNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCreditNoteLineRequestMultiError, or nil if none found.
+func (m *CreateCreditNoteLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCreditNoteLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CnlId
+
+	// no validation rules for Note
+
+	// no validation rules for CreditedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for TaxPointDate
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for FreeOfChargeIndicator
+
+	// no validation rules for InvoicePeriodStartDate
+
+	// no validation rules for InvoicePeriodEndDate
+
+	// no validation rules for DiscrepancyResponse
+
+	// no validation rules for OrderLineId
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for OriginatorPartyId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceValidityPeriodStartDate
+
+	// no validation rules for PriceValidityPeriodEndDate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for CreditNoteHeaderId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCreditNoteLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCreditNoteLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCreditNoteLineRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return CreateCreditNoteLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCreditNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCreditNoteLineRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCreditNoteLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCreditNoteLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCreditNoteLineRequestMultiError) AllErrors() []error { return m }
+
+// CreateCreditNoteLineRequestValidationError is the validation error returned
+// by CreateCreditNoteLineRequest.Validate if the designated constraints
+// aren't met.
+type CreateCreditNoteLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCreditNoteLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCreditNoteLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCreditNoteLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCreditNoteLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCreditNoteLineRequestValidationError) ErrorName() string {
+	return "CreateCreditNoteLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCreditNoteLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCreditNoteLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCreditNoteLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCreditNoteLineRequestValidationError{}
+
+// Validate checks the field values on CreateCreditNoteLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCreditNoteLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCreditNoteLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCreditNoteLineResponseMultiError, or nil if none found.
+func (m *CreateCreditNoteLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCreditNoteLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreditNoteLine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCreditNoteLineResponseValidationError{
+					field:  "CreditNoteLine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCreditNoteLineResponseValidationError{
+					field:  "CreditNoteLine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreditNoteLine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCreditNoteLineResponseValidationError{
+				field:  "CreditNoteLine",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCreditNoteLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCreditNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateCreditNoteLineResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCreditNoteLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCreditNoteLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCreditNoteLineResponseMultiError) AllErrors() []error { return m }
+
+// CreateCreditNoteLineResponseValidationError is the validation error returned
+// by CreateCreditNoteLineResponse.Validate if the designated constraints
+// aren't met.
+type CreateCreditNoteLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCreditNoteLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCreditNoteLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCreditNoteLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCreditNoteLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCreditNoteLineResponseValidationError) ErrorName() string {
+	return "CreateCreditNoteLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCreditNoteLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCreditNoteLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCreditNoteLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCreditNoteLineResponseValidationError{}
+
+// Validate checks the field values on GetCreditNoteLinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteLinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteLinesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteLinesRequestMultiError, or nil if none found.
+func (m *GetCreditNoteLinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteLinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCreditNoteLinesRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCreditNoteLinesRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreditNoteLineTValidationError{
-				field:  "PriceValidityPeriodEndDate",
+			return GetCreditNoteLinesRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2806,19 +4612,155 @@ func (m *CreditNoteLineT) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreditNoteLineTMultiError(errors)
+		return GetCreditNoteLinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditNoteLinesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCreditNoteLinesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCreditNoteLinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditNoteLinesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditNoteLinesRequestMultiError) AllErrors() []error { return m }
+
+// GetCreditNoteLinesRequestValidationError is the validation error returned by
+// GetCreditNoteLinesRequest.Validate if the designated constraints aren't met.
+type GetCreditNoteLinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditNoteLinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditNoteLinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditNoteLinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditNoteLinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditNoteLinesRequestValidationError) ErrorName() string {
+	return "GetCreditNoteLinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditNoteLinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditNoteLinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditNoteLinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditNoteLinesRequestValidationError{}
+
+// Validate checks the field values on GetCreditNoteLinesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditNoteLinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditNoteLinesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditNoteLinesResponseMultiError, or nil if none found.
+func (m *GetCreditNoteLinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditNoteLinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCreditNoteLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCreditNoteLinesResponseValidationError{
+						field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCreditNoteLinesResponseValidationError{
+						field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCreditNoteLinesResponseValidationError{
+					field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCreditNoteLinesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreditNoteLineTMultiError is an error wrapping multiple validation errors
-// returned by CreditNoteLineT.ValidateAll() if the designated constraints
-// aren't met.
-type CreditNoteLineTMultiError []error
+// GetCreditNoteLinesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCreditNoteLinesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCreditNoteLinesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreditNoteLineTMultiError) Error() string {
+func (m GetCreditNoteLinesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2827,11 +4769,11 @@ func (m CreditNoteLineTMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreditNoteLineTMultiError) AllErrors() []error { return m }
+func (m GetCreditNoteLinesResponseMultiError) AllErrors() []error { return m }
 
-// CreditNoteLineTValidationError is the validation error returned by
-// CreditNoteLineT.Validate if the designated constraints aren't met.
-type CreditNoteLineTValidationError struct {
+// GetCreditNoteLinesResponseValidationError is the validation error returned
+// by GetCreditNoteLinesResponse.Validate if the designated constraints aren't met.
+type GetCreditNoteLinesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2839,22 +4781,24 @@ type CreditNoteLineTValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreditNoteLineTValidationError) Field() string { return e.field }
+func (e GetCreditNoteLinesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreditNoteLineTValidationError) Reason() string { return e.reason }
+func (e GetCreditNoteLinesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreditNoteLineTValidationError) Cause() error { return e.cause }
+func (e GetCreditNoteLinesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreditNoteLineTValidationError) Key() bool { return e.key }
+func (e GetCreditNoteLinesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreditNoteLineTValidationError) ErrorName() string { return "CreditNoteLineTValidationError" }
+func (e GetCreditNoteLinesResponseValidationError) ErrorName() string {
+	return "GetCreditNoteLinesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreditNoteLineTValidationError) Error() string {
+func (e GetCreditNoteLinesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2866,14 +4810,14 @@ func (e CreditNoteLineTValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreditNoteLineT.%s: %s%s",
+		"invalid %sGetCreditNoteLinesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreditNoteLineTValidationError{}
+var _ error = GetCreditNoteLinesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2881,85 +4825,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreditNoteLineTValidationError{}
+} = GetCreditNoteLinesResponseValidationError{}
 
-// Validate checks the field values on CreateCreditNoteLineRequest with the
+// Validate checks the field values on UpdateCreditNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCreditNoteLineRequest) Validate() error {
+func (m *UpdateCreditNoteLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCreditNoteLineRequest with the
+// ValidateAll checks the field values on UpdateCreditNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCreditNoteLineRequestMultiError, or nil if none found.
-func (m *CreateCreditNoteLineRequest) ValidateAll() error {
+// UpdateCreditNoteLineRequestMultiError, or nil if none found.
+func (m *UpdateCreditNoteLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCreditNoteLineRequest) validate(all bool) error {
+func (m *UpdateCreditNoteLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CnlId
-
 	// no validation rules for Note
 
 	// no validation rules for CreditedQuantity
 
 	// no validation rules for LineExtensionAmount
 
-	// no validation rules for TaxPointDate
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for PaymentPurposeCode
-
-	// no validation rules for FreeOfChargeIndicator
-
-	// no validation rules for InvoicePeriodStartDate
-
-	// no validation rules for InvoicePeriodEndDate
-
-	// no validation rules for DiscrepancyResponse
-
-	// no validation rules for OrderLineId
-
-	// no validation rules for DespatchLineId
-
-	// no validation rules for ReceiptLineId
-
-	// no validation rules for BillingId
-
-	// no validation rules for OriginatorPartyId
-
-	// no validation rules for ItemId
-
 	// no validation rules for PriceAmount
 
 	// no validation rules for PriceBaseQuantity
 
-	// no validation rules for PriceChangeReason
-
-	// no validation rules for PriceTypeCode
-
-	// no validation rules for PriceType
-
-	// no validation rules for OrderableUnitFactorRate
-
-	// no validation rules for PriceValidityPeriodStartDate
-
-	// no validation rules for PriceValidityPeriodEndDate
-
-	// no validation rules for PriceListId
+	// no validation rules for AccountingCost
 
-	// no validation rules for CreditNoteHeaderId
+	// no validation rules for Id
 
 	// no validation rules for UserId
 
@@ -2968,19 +4870,19 @@ func (m *CreateCreditNoteLineRequest) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return CreateCreditNoteLineRequestMultiError(errors)
+		return UpdateCreditNoteLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCreditNoteLineRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateCreditNoteLineRequest.ValidateAll() if
+// UpdateCreditNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateCreditNoteLineRequest.ValidateAll() if
 // the designated constraints aren't met.
-type CreateCreditNoteLineRequestMultiError []error
+type UpdateCreditNoteLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCreditNoteLineRequestMultiError) Error() string {
+func (m UpdateCreditNoteLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2989,12 +4891,12 @@ func (m CreateCreditNoteLineRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCreditNoteLineRequestMultiError) AllErrors() []error { return m }
+func (m UpdateCreditNoteLineRequestMultiError) AllErrors() []error { return m }
 
-// CreateCreditNoteLineRequestValidationError is the validation error returned
-// by CreateCreditNoteLineRequest.Validate if the designated constraints
+// UpdateCreditNoteLineRequestValidationError is the validation error returned
+// by UpdateCreditNoteLineRequest.Validate if the designated constraints
 // aren't met.
-type CreateCreditNoteLineRequestValidationError struct {
+type UpdateCreditNoteLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3002,24 +4904,24 @@ type CreateCreditNoteLineRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCreditNoteLineRequestValidationError) Field() string { return e.field }
+func (e UpdateCreditNoteLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCreditNoteLineRequestValidationError) Reason() string { return e.reason }
+func (e UpdateCreditNoteLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCreditNoteLineRequestValidationError) Cause() error { return e.cause }
+func (e UpdateCreditNoteLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCreditNoteLineRequestValidationError) Key() bool { return e.key }
+func (e UpdateCreditNoteLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCreditNoteLineRequestValidationError) ErrorName() string {
-	return "CreateCreditNoteLineRequestValidationError"
+func (e UpdateCreditNoteLineRequestValidationError) ErrorName() string {
+	return "UpdateCreditNoteLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCreditNoteLineRequestValidationError) Error() string {
+func (e UpdateCreditNoteLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3031,14 +4933,14 @@ func (e CreateCreditNoteLineRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCreditNoteLineRequest.%s: %s%s",
+		"invalid %sUpdateCreditNoteLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCreditNoteLineRequestValidationError{}
+var _ error = UpdateCreditNoteLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3046,73 +4948,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCreditNoteLineRequestValidationError{}
+} = UpdateCreditNoteLineRequestValidationError{}
 
-// Validate checks the field values on CreateCreditNoteLineResponse with the
+// Validate checks the field values on UpdateCreditNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCreditNoteLineResponse) Validate() error {
+func (m *UpdateCreditNoteLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCreditNoteLineResponse with the
+// ValidateAll checks the field values on UpdateCreditNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCreditNoteLineResponseMultiError, or nil if none found.
-func (m *CreateCreditNoteLineResponse) ValidateAll() error {
+// UpdateCreditNoteLineResponseMultiError, or nil if none found.
+func (m *UpdateCreditNoteLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCreditNoteLineResponse) validate(all bool) error {
+func (m *UpdateCreditNoteLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCreditNoteLine()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateCreditNoteLineResponseValidationError{
-					field:  "CreditNoteLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateCreditNoteLineResponseValidationError{
-					field:  "CreditNoteLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreditNoteLine()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateCreditNoteLineResponseValidationError{
-				field:  "CreditNoteLine",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateCreditNoteLineResponseMultiError(errors)
+		return UpdateCreditNoteLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCreditNoteLineResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateCreditNoteLineResponse.ValidateAll() if
+// UpdateCreditNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateCreditNoteLineResponse.ValidateAll() if
 // the designated constraints aren't met.
-type CreateCreditNoteLineResponseMultiError []error
+type UpdateCreditNoteLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCreditNoteLineResponseMultiError) Error() string {
+func (m UpdateCreditNoteLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3121,12 +4994,12 @@ func (m CreateCreditNoteLineResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCreditNoteLineResponseMultiError) AllErrors() []error { return m }
+func (m UpdateCreditNoteLineResponseMultiError) AllErrors() []error { return m }
 
-// CreateCreditNoteLineResponseValidationError is the validation error returned
-// by CreateCreditNoteLineResponse.Validate if the designated constraints
+// UpdateCreditNoteLineResponseValidationError is the validation error returned
+// by UpdateCreditNoteLineResponse.Validate if the designated constraints
 // aren't met.
-type CreateCreditNoteLineResponseValidationError struct {
+type UpdateCreditNoteLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3134,24 +5007,24 @@ type CreateCreditNoteLineResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCreditNoteLineResponseValidationError) Field() string { return e.field }
+func (e UpdateCreditNoteLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCreditNoteLineResponseValidationError) Reason() string { return e.reason }
+func (e UpdateCreditNoteLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCreditNoteLineResponseValidationError) Cause() error { return e.cause }
+func (e UpdateCreditNoteLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCreditNoteLineResponseValidationError) Key() bool { return e.key }
+func (e UpdateCreditNoteLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCreditNoteLineResponseValidationError) ErrorName() string {
-	return "CreateCreditNoteLineResponseValidationError"
+func (e UpdateCreditNoteLineResponseValidationError) ErrorName() string {
+	return "UpdateCreditNoteLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCreditNoteLineResponseValidationError) Error() string {
+func (e UpdateCreditNoteLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3163,14 +5036,14 @@ func (e CreateCreditNoteLineResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCreditNoteLineResponse.%s: %s%s",
+		"invalid %sUpdateCreditNoteLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCreditNoteLineResponseValidationError{}
+var _ error = UpdateCreditNoteLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3178,24 +5051,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCreditNoteLineResponseValidationError{}
+} = UpdateCreditNoteLineResponseValidationError{}
 
-// Validate checks the field values on GetCreditNoteLinesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteCreditNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteLinesRequest) Validate() error {
+func (m *DeleteCreditNoteLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteLinesRequest with the
+// ValidateAll checks the field values on DeleteCreditNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteLinesRequestMultiError, or nil if none found.
-func (m *GetCreditNoteLinesRequest) ValidateAll() error {
+// DeleteCreditNoteLineRequestMultiError, or nil if none found.
+func (m *DeleteCreditNoteLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteLinesRequest) validate(all bool) error {
+func (m *DeleteCreditNoteLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3206,7 +5079,7 @@ func (m *GetCreditNoteLinesRequest) validate(all bool) error {
 		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCreditNoteLinesRequestValidationError{
+				errors = append(errors, DeleteCreditNoteLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3214,7 +5087,7 @@ func (m *GetCreditNoteLinesRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCreditNoteLinesRequestValidationError{
+				errors = append(errors, DeleteCreditNoteLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3223,7 +5096,7 @@ func (m *GetCreditNoteLinesRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCreditNoteLinesRequestValidationError{
+			return DeleteCreditNoteLineRequestValidationError{
 				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3232,19 +5105,19 @@ func (m *GetCreditNoteLinesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCreditNoteLinesRequestMultiError(errors)
+		return DeleteCreditNoteLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteLinesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCreditNoteLinesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetCreditNoteLinesRequestMultiError []error
+// DeleteCreditNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCreditNoteLineRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCreditNoteLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteLinesRequestMultiError) Error() string {
+func (m DeleteCreditNoteLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3253,11 +5126,12 @@ func (m GetCreditNoteLinesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteLinesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteCreditNoteLineRequestMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteLinesRequestValidationError is the validation error returned by
-// GetCreditNoteLinesRequest.Validate if the designated constraints aren't met.
-type GetCreditNoteLinesRequestValidationError struct {
+// DeleteCreditNoteLineRequestValidationError is the validation error returned
+// by DeleteCreditNoteLineRequest.Validate if the designated constraints
+// aren't met.
+type DeleteCreditNoteLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3265,24 +5139,24 @@ type GetCreditNoteLinesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteLinesRequestValidationError) Field() string { return e.field }
+func (e DeleteCreditNoteLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteLinesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteCreditNoteLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteLinesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteCreditNoteLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteLinesRequestValidationError) Key() bool { return e.key }
+func (e DeleteCreditNoteLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteLinesRequestValidationError) ErrorName() string {
-	return "GetCreditNoteLinesRequestValidationError"
+func (e DeleteCreditNoteLineRequestValidationError) ErrorName() string {
+	return "DeleteCreditNoteLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteLinesRequestValidationError) Error() string {
+func (e DeleteCreditNoteLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3294,14 +5168,14 @@ func (e GetCreditNoteLinesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteLinesRequest.%s: %s%s",
+		"invalid %sDeleteCreditNoteLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteLinesRequestValidationError{}
+var _ error = DeleteCreditNoteLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3309,78 +5183,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteLinesRequestValidationError{}
+} = DeleteCreditNoteLineRequestValidationError{}
 
-// Validate checks the field values on GetCreditNoteLinesResponse with the
+// Validate checks the field values on DeleteCreditNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCreditNoteLinesResponse) Validate() error {
+func (m *DeleteCreditNoteLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCreditNoteLinesResponse with the
+// ValidateAll checks the field values on DeleteCreditNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCreditNoteLinesResponseMultiError, or nil if none found.
-func (m *GetCreditNoteLinesResponse) ValidateAll() error {
+// DeleteCreditNoteLineResponseMultiError, or nil if none found.
+func (m *DeleteCreditNoteLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCreditNoteLinesResponse) validate(all bool) error {
+func (m *DeleteCreditNoteLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCreditNoteLines() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCreditNoteLinesResponseValidationError{
-						field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCreditNoteLinesResponseValidationError{
-						field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetCreditNoteLinesResponseValidationError{
-					field:  fmt.Sprintf("CreditNoteLines[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetCreditNoteLinesResponseMultiError(errors)
+		return DeleteCreditNoteLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCreditNoteLinesResponseMultiError is an error wrapping multiple
-// validation errors returned by GetCreditNoteLinesResponse.ValidateAll() if
+// DeleteCreditNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteCreditNoteLineResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetCreditNoteLinesResponseMultiError []error
+type DeleteCreditNoteLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCreditNoteLinesResponseMultiError) Error() string {
+func (m DeleteCreditNoteLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3389,11 +5229,12 @@ func (m GetCreditNoteLinesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCreditNoteLinesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteCreditNoteLineResponseMultiError) AllErrors() []error { return m }
 
-// GetCreditNoteLinesResponseValidationError is the validation error returned
-// by GetCreditNoteLinesResponse.Validate if the designated constraints aren't met.
-type GetCreditNoteLinesResponseValidationError struct {
+// DeleteCreditNoteLineResponseValidationError is the validation error returned
+// by DeleteCreditNoteLineResponse.Validate if the designated constraints
+// aren't met.
+type DeleteCreditNoteLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3401,24 +5242,24 @@ type GetCreditNoteLinesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCreditNoteLinesResponseValidationError) Field() string { return e.field }
+func (e DeleteCreditNoteLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCreditNoteLinesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteCreditNoteLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCreditNoteLinesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteCreditNoteLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCreditNoteLinesResponseValidationError) Key() bool { return e.key }
+func (e DeleteCreditNoteLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCreditNoteLinesResponseValidationError) ErrorName() string {
-	return "GetCreditNoteLinesResponseValidationError"
+func (e DeleteCreditNoteLineResponseValidationError) ErrorName() string {
+	return "DeleteCreditNoteLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCreditNoteLinesResponseValidationError) Error() string {
+func (e DeleteCreditNoteLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3430,14 +5271,14 @@ func (e GetCreditNoteLinesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCreditNoteLinesResponse.%s: %s%s",
+		"invalid %sDeleteCreditNoteLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCreditNoteLinesResponseValidationError{}
+var _ error = DeleteCreditNoteLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3445,4 +5286,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCreditNoteLinesResponseValidationError{}
+} = DeleteCreditNoteLineResponseValidationError{}
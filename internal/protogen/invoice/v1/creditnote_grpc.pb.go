@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	v1 "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,13 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CreditNoteHeaderService_CreateCreditNoteHeader_FullMethodName  = "/invoice.v1.CreditNoteHeaderService/CreateCreditNoteHeader"
-	CreditNoteHeaderService_GetCreditNoteHeaders_FullMethodName    = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeaders"
-	CreditNoteHeaderService_GetCreditNoteHeader_FullMethodName     = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeader"
-	CreditNoteHeaderService_GetCreditNoteHeaderByPk_FullMethodName = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeaderByPk"
-	CreditNoteHeaderService_CreateCreditNoteLine_FullMethodName    = "/invoice.v1.CreditNoteHeaderService/CreateCreditNoteLine"
-	CreditNoteHeaderService_GetCreditNoteLines_FullMethodName      = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteLines"
-	CreditNoteHeaderService_UpdateCreditNoteHeader_FullMethodName  = "/invoice.v1.CreditNoteHeaderService/UpdateCreditNoteHeader"
+	CreditNoteHeaderService_CreateCreditNoteHeader_FullMethodName         = "/invoice.v1.CreditNoteHeaderService/CreateCreditNoteHeader"
+	CreditNoteHeaderService_GetCreditNoteHeaders_FullMethodName           = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeaders"
+	CreditNoteHeaderService_GetCreditNoteHeader_FullMethodName            = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeader"
+	CreditNoteHeaderService_GetCreditNoteHeaderByPk_FullMethodName        = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteHeaderByPk"
+	CreditNoteHeaderService_CreateCreditNoteLine_FullMethodName           = "/invoice.v1.CreditNoteHeaderService/CreateCreditNoteLine"
+	CreditNoteHeaderService_GetCreditNoteLines_FullMethodName             = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteLines"
+	CreditNoteHeaderService_UpdateCreditNoteLine_FullMethodName           = "/invoice.v1.CreditNoteHeaderService/UpdateCreditNoteLine"
+	CreditNoteHeaderService_DeleteCreditNoteLine_FullMethodName           = "/invoice.v1.CreditNoteHeaderService/DeleteCreditNoteLine"
+	CreditNoteHeaderService_UpdateCreditNoteHeader_FullMethodName         = "/invoice.v1.CreditNoteHeaderService/UpdateCreditNoteHeader"
+	CreditNoteHeaderService_GetCreditNoteUBL_FullMethodName               = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteUBL"
+	CreditNoteHeaderService_ImportCreditNoteUBL_FullMethodName            = "/invoice.v1.CreditNoteHeaderService/ImportCreditNoteUBL"
+	CreditNoteHeaderService_GetCreditNoteCII_FullMethodName               = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteCII"
+	CreditNoteHeaderService_ImportCreditNoteCII_FullMethodName            = "/invoice.v1.CreditNoteHeaderService/ImportCreditNoteCII"
+	CreditNoteHeaderService_IssueCreditNote_FullMethodName                = "/invoice.v1.CreditNoteHeaderService/IssueCreditNote"
+	CreditNoteHeaderService_SendCreditNote_FullMethodName                 = "/invoice.v1.CreditNoteHeaderService/SendCreditNote"
+	CreditNoteHeaderService_AcknowledgeCreditNote_FullMethodName          = "/invoice.v1.CreditNoteHeaderService/AcknowledgeCreditNote"
+	CreditNoteHeaderService_MarkCreditNotePartiallyPaid_FullMethodName    = "/invoice.v1.CreditNoteHeaderService/MarkCreditNotePartiallyPaid"
+	CreditNoteHeaderService_MarkCreditNotePaid_FullMethodName             = "/invoice.v1.CreditNoteHeaderService/MarkCreditNotePaid"
+	CreditNoteHeaderService_MarkCreditNoteDisputed_FullMethodName         = "/invoice.v1.CreditNoteHeaderService/MarkCreditNoteDisputed"
+	CreditNoteHeaderService_CancelCreditNote_FullMethodName               = "/invoice.v1.CreditNoteHeaderService/CancelCreditNote"
+	CreditNoteHeaderService_GetCreditNoteStatusTransitions_FullMethodName = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteStatusTransitions"
+	CreditNoteHeaderService_ReviseCreditNote_FullMethodName               = "/invoice.v1.CreditNoteHeaderService/ReviseCreditNote"
+	CreditNoteHeaderService_GetCreditNoteVersions_FullMethodName          = "/invoice.v1.CreditNoteHeaderService/GetCreditNoteVersions"
+	CreditNoteHeaderService_DiffCreditNoteVersions_FullMethodName         = "/invoice.v1.CreditNoteHeaderService/DiffCreditNoteVersions"
 )
 
 // CreditNoteHeaderServiceClient is the client API for CreditNoteHeaderService service.
@@ -40,7 +58,24 @@ type CreditNoteHeaderServiceClient interface {
 	GetCreditNoteHeaderByPk(ctx context.Context, in *GetCreditNoteHeaderByPkRequest, opts ...grpc.CallOption) (*GetCreditNoteHeaderByPkResponse, error)
 	CreateCreditNoteLine(ctx context.Context, in *CreateCreditNoteLineRequest, opts ...grpc.CallOption) (*CreateCreditNoteLineResponse, error)
 	GetCreditNoteLines(ctx context.Context, in *GetCreditNoteLinesRequest, opts ...grpc.CallOption) (*GetCreditNoteLinesResponse, error)
+	UpdateCreditNoteLine(ctx context.Context, in *UpdateCreditNoteLineRequest, opts ...grpc.CallOption) (*UpdateCreditNoteLineResponse, error)
+	DeleteCreditNoteLine(ctx context.Context, in *DeleteCreditNoteLineRequest, opts ...grpc.CallOption) (*DeleteCreditNoteLineResponse, error)
 	UpdateCreditNoteHeader(ctx context.Context, in *UpdateCreditNoteHeaderRequest, opts ...grpc.CallOption) (*UpdateCreditNoteHeaderResponse, error)
+	GetCreditNoteUBL(ctx context.Context, in *GetCreditNoteUBLRequest, opts ...grpc.CallOption) (*GetCreditNoteUBLResponse, error)
+	ImportCreditNoteUBL(ctx context.Context, in *ImportCreditNoteUBLRequest, opts ...grpc.CallOption) (*ImportCreditNoteUBLResponse, error)
+	GetCreditNoteCII(ctx context.Context, in *GetCreditNoteCIIRequest, opts ...grpc.CallOption) (*GetCreditNoteCIIResponse, error)
+	ImportCreditNoteCII(ctx context.Context, in *ImportCreditNoteCIIRequest, opts ...grpc.CallOption) (*ImportCreditNoteCIIResponse, error)
+	IssueCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	SendCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNotePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNotePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNoteDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	CancelCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	GetCreditNoteStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseCreditNote(ctx context.Context, in *ReviseCreditNoteRequest, opts ...grpc.CallOption) (*ReviseCreditNoteResponse, error)
+	GetCreditNoteVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error)
+	DiffCreditNoteVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error)
 }
 
 type creditNoteHeaderServiceClient struct {
@@ -111,6 +146,26 @@ func (c *creditNoteHeaderServiceClient) GetCreditNoteLines(ctx context.Context,
 	return out, nil
 }
 
+func (c *creditNoteHeaderServiceClient) UpdateCreditNoteLine(ctx context.Context, in *UpdateCreditNoteLineRequest, opts ...grpc.CallOption) (*UpdateCreditNoteLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCreditNoteLineResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_UpdateCreditNoteLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) DeleteCreditNoteLine(ctx context.Context, in *DeleteCreditNoteLineRequest, opts ...grpc.CallOption) (*DeleteCreditNoteLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCreditNoteLineResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_DeleteCreditNoteLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creditNoteHeaderServiceClient) UpdateCreditNoteHeader(ctx context.Context, in *UpdateCreditNoteHeaderRequest, opts ...grpc.CallOption) (*UpdateCreditNoteHeaderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCreditNoteHeaderResponse)
@@ -121,6 +176,156 @@ func (c *creditNoteHeaderServiceClient) UpdateCreditNoteHeader(ctx context.Conte
 	return out, nil
 }
 
+func (c *creditNoteHeaderServiceClient) GetCreditNoteUBL(ctx context.Context, in *GetCreditNoteUBLRequest, opts ...grpc.CallOption) (*GetCreditNoteUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreditNoteUBLResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_GetCreditNoteUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) ImportCreditNoteUBL(ctx context.Context, in *ImportCreditNoteUBLRequest, opts ...grpc.CallOption) (*ImportCreditNoteUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCreditNoteUBLResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_ImportCreditNoteUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) GetCreditNoteCII(ctx context.Context, in *GetCreditNoteCIIRequest, opts ...grpc.CallOption) (*GetCreditNoteCIIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreditNoteCIIResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_GetCreditNoteCII_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) ImportCreditNoteCII(ctx context.Context, in *ImportCreditNoteCIIRequest, opts ...grpc.CallOption) (*ImportCreditNoteCIIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCreditNoteCIIResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_ImportCreditNoteCII_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) IssueCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_IssueCreditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) SendCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_SendCreditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) AcknowledgeCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_AcknowledgeCreditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) MarkCreditNotePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_MarkCreditNotePartiallyPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) MarkCreditNotePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_MarkCreditNotePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) MarkCreditNoteDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_MarkCreditNoteDisputed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) CancelCreditNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_CancelCreditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) GetCreditNoteStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_GetCreditNoteStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) ReviseCreditNote(ctx context.Context, in *ReviseCreditNoteRequest, opts ...grpc.CallOption) (*ReviseCreditNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviseCreditNoteResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_ReviseCreditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) GetCreditNoteVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_GetCreditNoteVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditNoteHeaderServiceClient) DiffCreditNoteVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DiffDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, CreditNoteHeaderService_DiffCreditNoteVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CreditNoteHeaderServiceServer is the server API for CreditNoteHeaderService service.
 // All implementations must embed UnimplementedCreditNoteHeaderServiceServer
 // for forward compatibility.
@@ -133,7 +338,24 @@ type CreditNoteHeaderServiceServer interface {
 	GetCreditNoteHeaderByPk(context.Context, *GetCreditNoteHeaderByPkRequest) (*GetCreditNoteHeaderByPkResponse, error)
 	CreateCreditNoteLine(context.Context, *CreateCreditNoteLineRequest) (*CreateCreditNoteLineResponse, error)
 	GetCreditNoteLines(context.Context, *GetCreditNoteLinesRequest) (*GetCreditNoteLinesResponse, error)
+	UpdateCreditNoteLine(context.Context, *UpdateCreditNoteLineRequest) (*UpdateCreditNoteLineResponse, error)
+	DeleteCreditNoteLine(context.Context, *DeleteCreditNoteLineRequest) (*DeleteCreditNoteLineResponse, error)
 	UpdateCreditNoteHeader(context.Context, *UpdateCreditNoteHeaderRequest) (*UpdateCreditNoteHeaderResponse, error)
+	GetCreditNoteUBL(context.Context, *GetCreditNoteUBLRequest) (*GetCreditNoteUBLResponse, error)
+	ImportCreditNoteUBL(context.Context, *ImportCreditNoteUBLRequest) (*ImportCreditNoteUBLResponse, error)
+	GetCreditNoteCII(context.Context, *GetCreditNoteCIIRequest) (*GetCreditNoteCIIResponse, error)
+	ImportCreditNoteCII(context.Context, *ImportCreditNoteCIIRequest) (*ImportCreditNoteCIIResponse, error)
+	IssueCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	SendCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNotePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNotePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkCreditNoteDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	CancelCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	GetCreditNoteStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseCreditNote(context.Context, *ReviseCreditNoteRequest) (*ReviseCreditNoteResponse, error)
+	GetCreditNoteVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error)
+	DiffCreditNoteVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error)
 	mustEmbedUnimplementedCreditNoteHeaderServiceServer()
 }
 
@@ -162,9 +384,60 @@ func (UnimplementedCreditNoteHeaderServiceServer) CreateCreditNoteLine(context.C
 func (UnimplementedCreditNoteHeaderServiceServer) GetCreditNoteLines(context.Context, *GetCreditNoteLinesRequest) (*GetCreditNoteLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNoteLines not implemented")
 }
+func (UnimplementedCreditNoteHeaderServiceServer) UpdateCreditNoteLine(context.Context, *UpdateCreditNoteLineRequest) (*UpdateCreditNoteLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditNoteLine not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) DeleteCreditNoteLine(context.Context, *DeleteCreditNoteLineRequest) (*DeleteCreditNoteLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCreditNoteLine not implemented")
+}
 func (UnimplementedCreditNoteHeaderServiceServer) UpdateCreditNoteHeader(context.Context, *UpdateCreditNoteHeaderRequest) (*UpdateCreditNoteHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditNoteHeader not implemented")
 }
+func (UnimplementedCreditNoteHeaderServiceServer) GetCreditNoteUBL(context.Context, *GetCreditNoteUBLRequest) (*GetCreditNoteUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNoteUBL not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) ImportCreditNoteUBL(context.Context, *ImportCreditNoteUBLRequest) (*ImportCreditNoteUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCreditNoteUBL not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) GetCreditNoteCII(context.Context, *GetCreditNoteCIIRequest) (*GetCreditNoteCIIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNoteCII not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) ImportCreditNoteCII(context.Context, *ImportCreditNoteCIIRequest) (*ImportCreditNoteCIIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCreditNoteCII not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) IssueCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCreditNote not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) SendCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCreditNote not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) AcknowledgeCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeCreditNote not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) MarkCreditNotePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkCreditNotePartiallyPaid not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) MarkCreditNotePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkCreditNotePaid not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) MarkCreditNoteDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkCreditNoteDisputed not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) CancelCreditNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCreditNote not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) GetCreditNoteStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNoteStatusTransitions not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) ReviseCreditNote(context.Context, *ReviseCreditNoteRequest) (*ReviseCreditNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviseCreditNote not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) GetCreditNoteVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNoteVersions not implemented")
+}
+func (UnimplementedCreditNoteHeaderServiceServer) DiffCreditNoteVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCreditNoteVersions not implemented")
+}
 func (UnimplementedCreditNoteHeaderServiceServer) mustEmbedUnimplementedCreditNoteHeaderServiceServer() {
 }
 func (UnimplementedCreditNoteHeaderServiceServer) testEmbeddedByValue() {}
@@ -295,6 +568,42 @@ func _CreditNoteHeaderService_GetCreditNoteLines_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreditNoteHeaderService_UpdateCreditNoteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCreditNoteLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).UpdateCreditNoteLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_UpdateCreditNoteLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).UpdateCreditNoteLine(ctx, req.(*UpdateCreditNoteLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_DeleteCreditNoteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCreditNoteLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).DeleteCreditNoteLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_DeleteCreditNoteLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).DeleteCreditNoteLine(ctx, req.(*DeleteCreditNoteLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CreditNoteHeaderService_UpdateCreditNoteHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCreditNoteHeaderRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +622,276 @@ func _CreditNoteHeaderService_UpdateCreditNoteHeader_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreditNoteHeaderService_GetCreditNoteUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditNoteUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_GetCreditNoteUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteUBL(ctx, req.(*GetCreditNoteUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_ImportCreditNoteUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCreditNoteUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).ImportCreditNoteUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_ImportCreditNoteUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).ImportCreditNoteUBL(ctx, req.(*ImportCreditNoteUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_GetCreditNoteCII_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditNoteCIIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteCII(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_GetCreditNoteCII_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteCII(ctx, req.(*GetCreditNoteCIIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_ImportCreditNoteCII_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCreditNoteCIIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).ImportCreditNoteCII(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_ImportCreditNoteCII_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).ImportCreditNoteCII(ctx, req.(*ImportCreditNoteCIIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_IssueCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).IssueCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_IssueCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).IssueCreditNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_SendCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).SendCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_SendCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).SendCreditNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_AcknowledgeCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).AcknowledgeCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_AcknowledgeCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).AcknowledgeCreditNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_MarkCreditNotePartiallyPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNotePartiallyPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_MarkCreditNotePartiallyPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNotePartiallyPaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_MarkCreditNotePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNotePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_MarkCreditNotePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNotePaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_MarkCreditNoteDisputed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNoteDisputed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_MarkCreditNoteDisputed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).MarkCreditNoteDisputed(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_CancelCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).CancelCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_CancelCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).CancelCreditNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_GetCreditNoteStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_GetCreditNoteStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteStatusTransitions(ctx, req.(*GetDocumentStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_ReviseCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviseCreditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).ReviseCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_ReviseCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).ReviseCreditNote(ctx, req.(*ReviseCreditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_GetCreditNoteVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_GetCreditNoteVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).GetCreditNoteVersions(ctx, req.(*v1.GetDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditNoteHeaderService_DiffCreditNoteVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DiffDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditNoteHeaderServiceServer).DiffCreditNoteVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditNoteHeaderService_DiffCreditNoteVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditNoteHeaderServiceServer).DiffCreditNoteVersions(ctx, req.(*v1.DiffDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CreditNoteHeaderService_ServiceDesc is the grpc.ServiceDesc for CreditNoteHeaderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,10 +923,78 @@ var CreditNoteHeaderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCreditNoteLines",
 			Handler:    _CreditNoteHeaderService_GetCreditNoteLines_Handler,
 		},
+		{
+			MethodName: "UpdateCreditNoteLine",
+			Handler:    _CreditNoteHeaderService_UpdateCreditNoteLine_Handler,
+		},
+		{
+			MethodName: "DeleteCreditNoteLine",
+			Handler:    _CreditNoteHeaderService_DeleteCreditNoteLine_Handler,
+		},
 		{
 			MethodName: "UpdateCreditNoteHeader",
 			Handler:    _CreditNoteHeaderService_UpdateCreditNoteHeader_Handler,
 		},
+		{
+			MethodName: "GetCreditNoteUBL",
+			Handler:    _CreditNoteHeaderService_GetCreditNoteUBL_Handler,
+		},
+		{
+			MethodName: "ImportCreditNoteUBL",
+			Handler:    _CreditNoteHeaderService_ImportCreditNoteUBL_Handler,
+		},
+		{
+			MethodName: "GetCreditNoteCII",
+			Handler:    _CreditNoteHeaderService_GetCreditNoteCII_Handler,
+		},
+		{
+			MethodName: "ImportCreditNoteCII",
+			Handler:    _CreditNoteHeaderService_ImportCreditNoteCII_Handler,
+		},
+		{
+			MethodName: "IssueCreditNote",
+			Handler:    _CreditNoteHeaderService_IssueCreditNote_Handler,
+		},
+		{
+			MethodName: "SendCreditNote",
+			Handler:    _CreditNoteHeaderService_SendCreditNote_Handler,
+		},
+		{
+			MethodName: "AcknowledgeCreditNote",
+			Handler:    _CreditNoteHeaderService_AcknowledgeCreditNote_Handler,
+		},
+		{
+			MethodName: "MarkCreditNotePartiallyPaid",
+			Handler:    _CreditNoteHeaderService_MarkCreditNotePartiallyPaid_Handler,
+		},
+		{
+			MethodName: "MarkCreditNotePaid",
+			Handler:    _CreditNoteHeaderService_MarkCreditNotePaid_Handler,
+		},
+		{
+			MethodName: "MarkCreditNoteDisputed",
+			Handler:    _CreditNoteHeaderService_MarkCreditNoteDisputed_Handler,
+		},
+		{
+			MethodName: "CancelCreditNote",
+			Handler:    _CreditNoteHeaderService_CancelCreditNote_Handler,
+		},
+		{
+			MethodName: "GetCreditNoteStatusTransitions",
+			Handler:    _CreditNoteHeaderService_GetCreditNoteStatusTransitions_Handler,
+		},
+		{
+			MethodName: "ReviseCreditNote",
+			Handler:    _CreditNoteHeaderService_ReviseCreditNote_Handler,
+		},
+		{
+			MethodName: "GetCreditNoteVersions",
+			Handler:    _CreditNoteHeaderService_GetCreditNoteVersions_Handler,
+		},
+		{
+			MethodName: "DiffCreditNoteVersions",
+			Handler:    _CreditNoteHeaderService_DiffCreditNoteVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoice/v1/creditnote.proto",
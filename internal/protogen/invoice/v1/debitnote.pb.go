@@ -27,10 +27,11 @@ type DebitNoteHeader struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebitNoteHeaderD *DebitNoteHeaderD `protobuf:"bytes,1,opt,name=debit_note_header_d,json=debitNoteHeaderD,proto3" json:"debit_note_header_d,omitempty"`
-	DebitNoteHeaderT *DebitNoteHeaderT `protobuf:"bytes,2,opt,name=debit_note_header_t,json=debitNoteHeaderT,proto3" json:"debit_note_header_t,omitempty"`
-	CrUpdUser        *v1.CrUpdUser     `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
-	CrUpdTime        *v1.CrUpdTime     `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	DebitNoteHeaderD *DebitNoteHeaderD     `protobuf:"bytes,1,opt,name=debit_note_header_d,json=debitNoteHeaderD,proto3" json:"debit_note_header_d,omitempty"`
+	DebitNoteHeaderT *DebitNoteHeaderT     `protobuf:"bytes,2,opt,name=debit_note_header_t,json=debitNoteHeaderT,proto3" json:"debit_note_header_t,omitempty"`
+	CrUpdUser        *v1.CrUpdUser         `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime        *v1.CrUpdTime         `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	AllowanceCharges []*v1.AllowanceCharge `protobuf:"bytes,5,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
 }
 
 func (x *DebitNoteHeader) Reset() {
@@ -91,6 +92,13 @@ func (x *DebitNoteHeader) GetCrUpdTime() *v1.CrUpdTime {
 	return nil
 }
 
+func (x *DebitNoteHeader) GetAllowanceCharges() []*v1.AllowanceCharge {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
 type DebitNoteHeaderD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -150,16 +158,21 @@ type DebitNoteHeaderD struct {
 	PaymentAltExExchangeMarketId       uint32  `protobuf:"varint,52,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
 	PaymentAltExCalculationRate        float64 `protobuf:"fixed64,53,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
 	PaymentAltExMathematicOperatorCode string  `protobuf:"bytes,54,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
-	LineExtensionAmount                float64 `protobuf:"fixed64,55,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxExclusiveAmount                 float64 `protobuf:"fixed64,56,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
-	TaxInclusiveAmount                 float64 `protobuf:"fixed64,57,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
-	AllowanceTotalAmount               float64 `protobuf:"fixed64,58,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
-	ChargeTotalAmount                  float64 `protobuf:"fixed64,59,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	WithholdingTaxTotalAmount          float64 `protobuf:"fixed64,60,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
-	PrepaidAmount                      float64 `protobuf:"fixed64,61,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount              float64 `protobuf:"fixed64,62,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount                      float64 `protobuf:"fixed64,63,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	PayableAlternativeAmount           float64 `protobuf:"fixed64,64,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	LineExtensionAmount                string  `protobuf:"bytes,55,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxExclusiveAmount                 string  `protobuf:"bytes,56,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
+	TaxInclusiveAmount                 string  `protobuf:"bytes,57,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
+	AllowanceTotalAmount               string  `protobuf:"bytes,58,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
+	ChargeTotalAmount                  string  `protobuf:"bytes,59,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	WithholdingTaxTotalAmount          string  `protobuf:"bytes,60,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
+	PrepaidAmount                      string  `protobuf:"bytes,61,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount              string  `protobuf:"bytes,62,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount                      string  `protobuf:"bytes,63,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAlternativeAmount           string  `protobuf:"bytes,64,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	// document_status_code - where the document is in its lifecycle, draft,
+	// issued, sent, acknowledged, partially_paid, paid, disputed or cancelled;
+	// the record status of its row is cr_upd_user.status_code
+	DocumentStatusCode             string `protobuf:"bytes,65,opt,name=document_status_code,json=documentStatusCode,proto3" json:"document_status_code,omitempty"`
+	PayableAlternativeCurrencyCode string `protobuf:"bytes,66,opt,name=payable_alternative_currency_code,json=payableAlternativeCurrencyCode,proto3" json:"payable_alternative_currency_code,omitempty"`
 }
 
 func (x *DebitNoteHeaderD) Reset() {
@@ -570,74 +583,88 @@ func (x *DebitNoteHeaderD) GetPaymentAltExMathematicOperatorCode() string {
 	return ""
 }
 
-func (x *DebitNoteHeaderD) GetLineExtensionAmount() float64 {
+func (x *DebitNoteHeaderD) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetTaxExclusiveAmount() float64 {
+func (x *DebitNoteHeaderD) GetTaxExclusiveAmount() string {
 	if x != nil {
 		return x.TaxExclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetTaxInclusiveAmount() float64 {
+func (x *DebitNoteHeaderD) GetTaxInclusiveAmount() string {
 	if x != nil {
 		return x.TaxInclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetAllowanceTotalAmount() float64 {
+func (x *DebitNoteHeaderD) GetAllowanceTotalAmount() string {
 	if x != nil {
 		return x.AllowanceTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetChargeTotalAmount() float64 {
+func (x *DebitNoteHeaderD) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetWithholdingTaxTotalAmount() float64 {
+func (x *DebitNoteHeaderD) GetWithholdingTaxTotalAmount() string {
 	if x != nil {
 		return x.WithholdingTaxTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetPrepaidAmount() float64 {
+func (x *DebitNoteHeaderD) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetPayableRoundingAmount() float64 {
+func (x *DebitNoteHeaderD) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetPayableAmount() float64 {
+func (x *DebitNoteHeaderD) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *DebitNoteHeaderD) GetPayableAlternativeAmount() float64 {
+func (x *DebitNoteHeaderD) GetPayableAlternativeAmount() string {
 	if x != nil {
 		return x.PayableAlternativeAmount
 	}
-	return 0
+	return ""
+}
+
+func (x *DebitNoteHeaderD) GetDocumentStatusCode() string {
+	if x != nil {
+		return x.DocumentStatusCode
+	}
+	return ""
+}
+
+func (x *DebitNoteHeaderD) GetPayableAlternativeCurrencyCode() string {
+	if x != nil {
+		return x.PayableAlternativeCurrencyCode
+	}
+	return ""
 }
 
 type DebitNoteHeaderT struct {
@@ -746,79 +773,82 @@ type CreateDebitNoteHeaderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DnhId                              string                        `protobuf:"bytes,1,opt,name=dnh_id,json=dnhId,proto3" json:"dnh_id,omitempty"`
-	IssueDate                          string                        `protobuf:"bytes,2,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
-	Note                               string                        `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
-	TaxPointDate                       string                        `protobuf:"bytes,4,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
-	DocumentCurrencyCode               string                        `protobuf:"bytes,5,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
-	TaxCurrencyCode                    string                        `protobuf:"bytes,6,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
-	PricingCurrencyCode                string                        `protobuf:"bytes,7,opt,name=pricing_currency_code,json=pricingCurrencyCode,proto3" json:"pricing_currency_code,omitempty"`
-	PaymentCurrencyCode                string                        `protobuf:"bytes,8,opt,name=payment_currency_code,json=paymentCurrencyCode,proto3" json:"payment_currency_code,omitempty"`
-	PaymentAltCurrencyCode             string                        `protobuf:"bytes,9,opt,name=payment_alt_currency_code,json=paymentAltCurrencyCode,proto3" json:"payment_alt_currency_code,omitempty"`
-	AccountingCostCode                 string                        `protobuf:"bytes,10,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost                     string                        `protobuf:"bytes,11,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	LineCountNumeric                   uint32                        `protobuf:"varint,12,opt,name=line_count_numeric,json=lineCountNumeric,proto3" json:"line_count_numeric,omitempty"`
-	InvoicePeriodStartDate             string                        `protobuf:"bytes,13,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
-	InvoicePeriodEndDate               string                        `protobuf:"bytes,73,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
-	DiscrepancyResponse                string                        `protobuf:"bytes,14,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	OrderId                            uint32                        `protobuf:"varint,15,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	BillingId                          uint32                        `protobuf:"varint,16,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	DespatchId                         uint32                        `protobuf:"varint,17,opt,name=despatch_id,json=despatchId,proto3" json:"despatch_id,omitempty"`
-	ReceiptId                          uint32                        `protobuf:"varint,18,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
-	StatementId                        uint32                        `protobuf:"varint,19,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
-	ContractId                         uint32                        `protobuf:"varint,20,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	AccountingSupplierPartyId          uint32                        `protobuf:"varint,22,opt,name=accounting_supplier_party_id,json=accountingSupplierPartyId,proto3" json:"accounting_supplier_party_id,omitempty"`
-	AccountingCustomerPartyId          uint32                        `protobuf:"varint,23,opt,name=accounting_customer_party_id,json=accountingCustomerPartyId,proto3" json:"accounting_customer_party_id,omitempty"`
-	PayeePartyId                       uint32                        `protobuf:"varint,24,opt,name=payee_party_id,json=payeePartyId,proto3" json:"payee_party_id,omitempty"`
-	BuyerCustomerPartyId               uint32                        `protobuf:"varint,25,opt,name=buyer_customer_party_id,json=buyerCustomerPartyId,proto3" json:"buyer_customer_party_id,omitempty"`
-	SellerSupplierPartyId              uint32                        `protobuf:"varint,26,opt,name=seller_supplier_party_id,json=sellerSupplierPartyId,proto3" json:"seller_supplier_party_id,omitempty"`
-	TaxRepresentativePartyId           uint32                        `protobuf:"varint,27,opt,name=tax_representative_party_id,json=taxRepresentativePartyId,proto3" json:"tax_representative_party_id,omitempty"`
-	TaxExSourceCurrencyCode            string                        `protobuf:"bytes,28,opt,name=tax_ex_source_currency_code,json=taxExSourceCurrencyCode,proto3" json:"tax_ex_source_currency_code,omitempty"`
-	TaxExSourceCurrencyBaseRate        string                        `protobuf:"bytes,29,opt,name=tax_ex_source_currency_base_rate,json=taxExSourceCurrencyBaseRate,proto3" json:"tax_ex_source_currency_base_rate,omitempty"`
-	TaxExTargetCurrencyCode            string                        `protobuf:"bytes,30,opt,name=tax_ex_target_currency_code,json=taxExTargetCurrencyCode,proto3" json:"tax_ex_target_currency_code,omitempty"`
-	TaxExTargetCurrencyBaseRate        string                        `protobuf:"bytes,31,opt,name=tax_ex_target_currency_base_rate,json=taxExTargetCurrencyBaseRate,proto3" json:"tax_ex_target_currency_base_rate,omitempty"`
-	TaxExExchangeMarketId              uint32                        `protobuf:"varint,32,opt,name=tax_ex_exchange_market_id,json=taxExExchangeMarketId,proto3" json:"tax_ex_exchange_market_id,omitempty"`
-	TaxExCalculationRate               float64                       `protobuf:"fixed64,33,opt,name=tax_ex_calculation_rate,json=taxExCalculationRate,proto3" json:"tax_ex_calculation_rate,omitempty"`
-	TaxExMathematicOperatorCode        string                        `protobuf:"bytes,34,opt,name=tax_ex_mathematic_operator_code,json=taxExMathematicOperatorCode,proto3" json:"tax_ex_mathematic_operator_code,omitempty"`
-	TaxExDate                          string                        `protobuf:"bytes,35,opt,name=tax_ex_date,json=taxExDate,proto3" json:"tax_ex_date,omitempty"`
-	PricingExSourceCurrencyCode        string                        `protobuf:"bytes,36,opt,name=pricing_ex_source_currency_code,json=pricingExSourceCurrencyCode,proto3" json:"pricing_ex_source_currency_code,omitempty"`
-	PricingExSourceCurrencyBaseRate    string                        `protobuf:"bytes,37,opt,name=pricing_ex_source_currency_base_rate,json=pricingExSourceCurrencyBaseRate,proto3" json:"pricing_ex_source_currency_base_rate,omitempty"`
-	PricingExTargetCurrencyCode        string                        `protobuf:"bytes,38,opt,name=pricing_ex_target_currency_code,json=pricingExTargetCurrencyCode,proto3" json:"pricing_ex_target_currency_code,omitempty"`
-	PricingExTargetCurrencyBaseRate    string                        `protobuf:"bytes,39,opt,name=pricing_ex_target_currency_base_rate,json=pricingExTargetCurrencyBaseRate,proto3" json:"pricing_ex_target_currency_base_rate,omitempty"`
-	PricingExExchangeMarketId          uint32                        `protobuf:"varint,40,opt,name=pricing_ex_exchange_market_id,json=pricingExExchangeMarketId,proto3" json:"pricing_ex_exchange_market_id,omitempty"`
-	PricingExCalculationRate           float64                       `protobuf:"fixed64,41,opt,name=pricing_ex_calculation_rate,json=pricingExCalculationRate,proto3" json:"pricing_ex_calculation_rate,omitempty"`
-	PricingExMathematicOperatorCode    string                        `protobuf:"bytes,42,opt,name=pricing_ex_mathematic_operator_code,json=pricingExMathematicOperatorCode,proto3" json:"pricing_ex_mathematic_operator_code,omitempty"`
-	PricingExDate                      string                        `protobuf:"bytes,43,opt,name=pricing_ex_date,json=pricingExDate,proto3" json:"pricing_ex_date,omitempty"`
-	PaymentExSourceCurrencyCode        string                        `protobuf:"bytes,44,opt,name=payment_ex_source_currency_code,json=paymentExSourceCurrencyCode,proto3" json:"payment_ex_source_currency_code,omitempty"`
-	PaymentExSourceCurrencyBaseRate    string                        `protobuf:"bytes,45,opt,name=payment_ex_source_currency_base_rate,json=paymentExSourceCurrencyBaseRate,proto3" json:"payment_ex_source_currency_base_rate,omitempty"`
-	PaymentExTargetCurrencyCode        string                        `protobuf:"bytes,46,opt,name=payment_ex_target_currency_code,json=paymentExTargetCurrencyCode,proto3" json:"payment_ex_target_currency_code,omitempty"`
-	PaymentExTargetCurrencyBaseRate    string                        `protobuf:"bytes,47,opt,name=payment_ex_target_currency_base_rate,json=paymentExTargetCurrencyBaseRate,proto3" json:"payment_ex_target_currency_base_rate,omitempty"`
-	PaymentExExchangeMarketId          uint32                        `protobuf:"varint,48,opt,name=payment_ex_exchange_market_id,json=paymentExExchangeMarketId,proto3" json:"payment_ex_exchange_market_id,omitempty"`
-	PaymentExCalculationRate           float64                       `protobuf:"fixed64,49,opt,name=payment_ex_calculation_rate,json=paymentExCalculationRate,proto3" json:"payment_ex_calculation_rate,omitempty"`
-	PaymentExMathematicOperatorCode    string                        `protobuf:"bytes,50,opt,name=payment_ex_mathematic_operator_code,json=paymentExMathematicOperatorCode,proto3" json:"payment_ex_mathematic_operator_code,omitempty"`
-	PaymentExDate                      string                        `protobuf:"bytes,51,opt,name=payment_ex_date,json=paymentExDate,proto3" json:"payment_ex_date,omitempty"`
-	PaymentAltExSourceCurrencyCode     string                        `protobuf:"bytes,52,opt,name=payment_alt_ex_source_currency_code,json=paymentAltExSourceCurrencyCode,proto3" json:"payment_alt_ex_source_currency_code,omitempty"`
-	PaymentAltExSourceCurrencyBaseRate string                        `protobuf:"bytes,53,opt,name=payment_alt_ex_source_currency_base_rate,json=paymentAltExSourceCurrencyBaseRate,proto3" json:"payment_alt_ex_source_currency_base_rate,omitempty"`
-	PaymentAltExTargetCurrencyCode     string                        `protobuf:"bytes,54,opt,name=payment_alt_ex_target_currency_code,json=paymentAltExTargetCurrencyCode,proto3" json:"payment_alt_ex_target_currency_code,omitempty"`
-	PaymentAltExTargetCurrencyBaseRate string                        `protobuf:"bytes,55,opt,name=payment_alt_ex_target_currency_base_rate,json=paymentAltExTargetCurrencyBaseRate,proto3" json:"payment_alt_ex_target_currency_base_rate,omitempty"`
-	PaymentAltExExchangeMarketId       uint32                        `protobuf:"varint,56,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
-	PaymentAltExCalculationRate        float64                       `protobuf:"fixed64,57,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
-	PaymentAltExMathematicOperatorCode string                        `protobuf:"bytes,58,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
-	PaymentAltExDate                   string                        `protobuf:"bytes,59,opt,name=payment_alt_ex_date,json=paymentAltExDate,proto3" json:"payment_alt_ex_date,omitempty"`
-	LineExtensionAmount                float64                       `protobuf:"fixed64,60,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxExclusiveAmount                 float64                       `protobuf:"fixed64,61,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
-	TaxInclusiveAmount                 float64                       `protobuf:"fixed64,62,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
-	AllowanceTotalAmount               float64                       `protobuf:"fixed64,63,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
-	ChargeTotalAmount                  float64                       `protobuf:"fixed64,64,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	WithholdingTaxTotalAmount          float64                       `protobuf:"fixed64,65,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
-	PrepaidAmount                      float64                       `protobuf:"fixed64,66,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount              float64                       `protobuf:"fixed64,67,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount                      float64                       `protobuf:"fixed64,68,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	PayableAlternativeAmount           float64                       `protobuf:"fixed64,69,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
-	UserId                             string                        `protobuf:"bytes,70,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail                          string                        `protobuf:"bytes,71,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                          string                        `protobuf:"bytes,72,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	DebitNoteLines                     []*CreateDebitNoteLineRequest `protobuf:"bytes,74,rep,name=debit_note_lines,json=debitNoteLines,proto3" json:"debit_note_lines,omitempty"`
+	DnhId                              string                             `protobuf:"bytes,1,opt,name=dnh_id,json=dnhId,proto3" json:"dnh_id,omitempty"`
+	IssueDate                          string                             `protobuf:"bytes,2,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	Note                               string                             `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	TaxPointDate                       string                             `protobuf:"bytes,4,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
+	DocumentCurrencyCode               string                             `protobuf:"bytes,5,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
+	TaxCurrencyCode                    string                             `protobuf:"bytes,6,opt,name=tax_currency_code,json=taxCurrencyCode,proto3" json:"tax_currency_code,omitempty"`
+	PricingCurrencyCode                string                             `protobuf:"bytes,7,opt,name=pricing_currency_code,json=pricingCurrencyCode,proto3" json:"pricing_currency_code,omitempty"`
+	PaymentCurrencyCode                string                             `protobuf:"bytes,8,opt,name=payment_currency_code,json=paymentCurrencyCode,proto3" json:"payment_currency_code,omitempty"`
+	PaymentAltCurrencyCode             string                             `protobuf:"bytes,9,opt,name=payment_alt_currency_code,json=paymentAltCurrencyCode,proto3" json:"payment_alt_currency_code,omitempty"`
+	AccountingCostCode                 string                             `protobuf:"bytes,10,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost                     string                             `protobuf:"bytes,11,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	LineCountNumeric                   uint32                             `protobuf:"varint,12,opt,name=line_count_numeric,json=lineCountNumeric,proto3" json:"line_count_numeric,omitempty"`
+	InvoicePeriodStartDate             string                             `protobuf:"bytes,13,opt,name=invoice_period_start_date,json=invoicePeriodStartDate,proto3" json:"invoice_period_start_date,omitempty"`
+	InvoicePeriodEndDate               string                             `protobuf:"bytes,73,opt,name=invoice_period_end_date,json=invoicePeriodEndDate,proto3" json:"invoice_period_end_date,omitempty"`
+	DiscrepancyResponse                string                             `protobuf:"bytes,14,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	OrderId                            uint32                             `protobuf:"varint,15,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BillingId                          uint32                             `protobuf:"varint,16,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	DespatchId                         uint32                             `protobuf:"varint,17,opt,name=despatch_id,json=despatchId,proto3" json:"despatch_id,omitempty"`
+	ReceiptId                          uint32                             `protobuf:"varint,18,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	StatementId                        uint32                             `protobuf:"varint,19,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	ContractId                         uint32                             `protobuf:"varint,20,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	AccountingSupplierPartyId          uint32                             `protobuf:"varint,22,opt,name=accounting_supplier_party_id,json=accountingSupplierPartyId,proto3" json:"accounting_supplier_party_id,omitempty"`
+	AccountingCustomerPartyId          uint32                             `protobuf:"varint,23,opt,name=accounting_customer_party_id,json=accountingCustomerPartyId,proto3" json:"accounting_customer_party_id,omitempty"`
+	PayeePartyId                       uint32                             `protobuf:"varint,24,opt,name=payee_party_id,json=payeePartyId,proto3" json:"payee_party_id,omitempty"`
+	BuyerCustomerPartyId               uint32                             `protobuf:"varint,25,opt,name=buyer_customer_party_id,json=buyerCustomerPartyId,proto3" json:"buyer_customer_party_id,omitempty"`
+	SellerSupplierPartyId              uint32                             `protobuf:"varint,26,opt,name=seller_supplier_party_id,json=sellerSupplierPartyId,proto3" json:"seller_supplier_party_id,omitempty"`
+	TaxRepresentativePartyId           uint32                             `protobuf:"varint,27,opt,name=tax_representative_party_id,json=taxRepresentativePartyId,proto3" json:"tax_representative_party_id,omitempty"`
+	TaxExSourceCurrencyCode            string                             `protobuf:"bytes,28,opt,name=tax_ex_source_currency_code,json=taxExSourceCurrencyCode,proto3" json:"tax_ex_source_currency_code,omitempty"`
+	TaxExSourceCurrencyBaseRate        string                             `protobuf:"bytes,29,opt,name=tax_ex_source_currency_base_rate,json=taxExSourceCurrencyBaseRate,proto3" json:"tax_ex_source_currency_base_rate,omitempty"`
+	TaxExTargetCurrencyCode            string                             `protobuf:"bytes,30,opt,name=tax_ex_target_currency_code,json=taxExTargetCurrencyCode,proto3" json:"tax_ex_target_currency_code,omitempty"`
+	TaxExTargetCurrencyBaseRate        string                             `protobuf:"bytes,31,opt,name=tax_ex_target_currency_base_rate,json=taxExTargetCurrencyBaseRate,proto3" json:"tax_ex_target_currency_base_rate,omitempty"`
+	TaxExExchangeMarketId              uint32                             `protobuf:"varint,32,opt,name=tax_ex_exchange_market_id,json=taxExExchangeMarketId,proto3" json:"tax_ex_exchange_market_id,omitempty"`
+	TaxExCalculationRate               float64                            `protobuf:"fixed64,33,opt,name=tax_ex_calculation_rate,json=taxExCalculationRate,proto3" json:"tax_ex_calculation_rate,omitempty"`
+	TaxExMathematicOperatorCode        string                             `protobuf:"bytes,34,opt,name=tax_ex_mathematic_operator_code,json=taxExMathematicOperatorCode,proto3" json:"tax_ex_mathematic_operator_code,omitempty"`
+	TaxExDate                          string                             `protobuf:"bytes,35,opt,name=tax_ex_date,json=taxExDate,proto3" json:"tax_ex_date,omitempty"`
+	PricingExSourceCurrencyCode        string                             `protobuf:"bytes,36,opt,name=pricing_ex_source_currency_code,json=pricingExSourceCurrencyCode,proto3" json:"pricing_ex_source_currency_code,omitempty"`
+	PricingExSourceCurrencyBaseRate    string                             `protobuf:"bytes,37,opt,name=pricing_ex_source_currency_base_rate,json=pricingExSourceCurrencyBaseRate,proto3" json:"pricing_ex_source_currency_base_rate,omitempty"`
+	PricingExTargetCurrencyCode        string                             `protobuf:"bytes,38,opt,name=pricing_ex_target_currency_code,json=pricingExTargetCurrencyCode,proto3" json:"pricing_ex_target_currency_code,omitempty"`
+	PricingExTargetCurrencyBaseRate    string                             `protobuf:"bytes,39,opt,name=pricing_ex_target_currency_base_rate,json=pricingExTargetCurrencyBaseRate,proto3" json:"pricing_ex_target_currency_base_rate,omitempty"`
+	PricingExExchangeMarketId          uint32                             `protobuf:"varint,40,opt,name=pricing_ex_exchange_market_id,json=pricingExExchangeMarketId,proto3" json:"pricing_ex_exchange_market_id,omitempty"`
+	PricingExCalculationRate           float64                            `protobuf:"fixed64,41,opt,name=pricing_ex_calculation_rate,json=pricingExCalculationRate,proto3" json:"pricing_ex_calculation_rate,omitempty"`
+	PricingExMathematicOperatorCode    string                             `protobuf:"bytes,42,opt,name=pricing_ex_mathematic_operator_code,json=pricingExMathematicOperatorCode,proto3" json:"pricing_ex_mathematic_operator_code,omitempty"`
+	PricingExDate                      string                             `protobuf:"bytes,43,opt,name=pricing_ex_date,json=pricingExDate,proto3" json:"pricing_ex_date,omitempty"`
+	PaymentExSourceCurrencyCode        string                             `protobuf:"bytes,44,opt,name=payment_ex_source_currency_code,json=paymentExSourceCurrencyCode,proto3" json:"payment_ex_source_currency_code,omitempty"`
+	PaymentExSourceCurrencyBaseRate    string                             `protobuf:"bytes,45,opt,name=payment_ex_source_currency_base_rate,json=paymentExSourceCurrencyBaseRate,proto3" json:"payment_ex_source_currency_base_rate,omitempty"`
+	PaymentExTargetCurrencyCode        string                             `protobuf:"bytes,46,opt,name=payment_ex_target_currency_code,json=paymentExTargetCurrencyCode,proto3" json:"payment_ex_target_currency_code,omitempty"`
+	PaymentExTargetCurrencyBaseRate    string                             `protobuf:"bytes,47,opt,name=payment_ex_target_currency_base_rate,json=paymentExTargetCurrencyBaseRate,proto3" json:"payment_ex_target_currency_base_rate,omitempty"`
+	PaymentExExchangeMarketId          uint32                             `protobuf:"varint,48,opt,name=payment_ex_exchange_market_id,json=paymentExExchangeMarketId,proto3" json:"payment_ex_exchange_market_id,omitempty"`
+	PaymentExCalculationRate           float64                            `protobuf:"fixed64,49,opt,name=payment_ex_calculation_rate,json=paymentExCalculationRate,proto3" json:"payment_ex_calculation_rate,omitempty"`
+	PaymentExMathematicOperatorCode    string                             `protobuf:"bytes,50,opt,name=payment_ex_mathematic_operator_code,json=paymentExMathematicOperatorCode,proto3" json:"payment_ex_mathematic_operator_code,omitempty"`
+	PaymentExDate                      string                             `protobuf:"bytes,51,opt,name=payment_ex_date,json=paymentExDate,proto3" json:"payment_ex_date,omitempty"`
+	PaymentAltExSourceCurrencyCode     string                             `protobuf:"bytes,52,opt,name=payment_alt_ex_source_currency_code,json=paymentAltExSourceCurrencyCode,proto3" json:"payment_alt_ex_source_currency_code,omitempty"`
+	PaymentAltExSourceCurrencyBaseRate string                             `protobuf:"bytes,53,opt,name=payment_alt_ex_source_currency_base_rate,json=paymentAltExSourceCurrencyBaseRate,proto3" json:"payment_alt_ex_source_currency_base_rate,omitempty"`
+	PaymentAltExTargetCurrencyCode     string                             `protobuf:"bytes,54,opt,name=payment_alt_ex_target_currency_code,json=paymentAltExTargetCurrencyCode,proto3" json:"payment_alt_ex_target_currency_code,omitempty"`
+	PaymentAltExTargetCurrencyBaseRate string                             `protobuf:"bytes,55,opt,name=payment_alt_ex_target_currency_base_rate,json=paymentAltExTargetCurrencyBaseRate,proto3" json:"payment_alt_ex_target_currency_base_rate,omitempty"`
+	PaymentAltExExchangeMarketId       uint32                             `protobuf:"varint,56,opt,name=payment_alt_ex_exchange_market_id,json=paymentAltExExchangeMarketId,proto3" json:"payment_alt_ex_exchange_market_id,omitempty"`
+	PaymentAltExCalculationRate        float64                            `protobuf:"fixed64,57,opt,name=payment_alt_ex_calculation_rate,json=paymentAltExCalculationRate,proto3" json:"payment_alt_ex_calculation_rate,omitempty"`
+	PaymentAltExMathematicOperatorCode string                             `protobuf:"bytes,58,opt,name=payment_alt_ex_mathematic_operator_code,json=paymentAltExMathematicOperatorCode,proto3" json:"payment_alt_ex_mathematic_operator_code,omitempty"`
+	PaymentAltExDate                   string                             `protobuf:"bytes,59,opt,name=payment_alt_ex_date,json=paymentAltExDate,proto3" json:"payment_alt_ex_date,omitempty"`
+	LineExtensionAmount                string                             `protobuf:"bytes,60,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxExclusiveAmount                 string                             `protobuf:"bytes,61,opt,name=tax_exclusive_amount,json=taxExclusiveAmount,proto3" json:"tax_exclusive_amount,omitempty"`
+	TaxInclusiveAmount                 string                             `protobuf:"bytes,62,opt,name=tax_inclusive_amount,json=taxInclusiveAmount,proto3" json:"tax_inclusive_amount,omitempty"`
+	AllowanceTotalAmount               string                             `protobuf:"bytes,63,opt,name=allowance_total_amount,json=allowanceTotalAmount,proto3" json:"allowance_total_amount,omitempty"`
+	ChargeTotalAmount                  string                             `protobuf:"bytes,64,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	WithholdingTaxTotalAmount          string                             `protobuf:"bytes,65,opt,name=withholding_tax_total_amount,json=withholdingTaxTotalAmount,proto3" json:"withholding_tax_total_amount,omitempty"`
+	PrepaidAmount                      string                             `protobuf:"bytes,66,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount              string                             `protobuf:"bytes,67,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount                      string                             `protobuf:"bytes,68,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAlternativeAmount           string                             `protobuf:"bytes,69,opt,name=payable_alternative_amount,json=payableAlternativeAmount,proto3" json:"payable_alternative_amount,omitempty"`
+	UserId                             string                             `protobuf:"bytes,70,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                          string                             `protobuf:"bytes,71,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                          string                             `protobuf:"bytes,72,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	DebitNoteLines                     []*CreateDebitNoteLineRequest      `protobuf:"bytes,74,rep,name=debit_note_lines,json=debitNoteLines,proto3" json:"debit_note_lines,omitempty"`
+	AllowanceCharges                   []*v1.CreateAllowanceChargeRequest `protobuf:"bytes,75,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+	IdempotencyKey                     string                             `protobuf:"bytes,76,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	PayableAlternativeCurrencyCode     string                             `protobuf:"bytes,77,opt,name=payable_alternative_currency_code,json=payableAlternativeCurrencyCode,proto3" json:"payable_alternative_currency_code,omitempty"`
 }
 
 func (x *CreateDebitNoteHeaderRequest) Reset() {
@@ -1264,74 +1294,74 @@ func (x *CreateDebitNoteHeaderRequest) GetPaymentAltExDate() string {
 	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetLineExtensionAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetTaxExclusiveAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetTaxExclusiveAmount() string {
 	if x != nil {
 		return x.TaxExclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetTaxInclusiveAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetTaxInclusiveAmount() string {
 	if x != nil {
 		return x.TaxInclusiveAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetAllowanceTotalAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetAllowanceTotalAmount() string {
 	if x != nil {
 		return x.AllowanceTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetChargeTotalAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetWithholdingTaxTotalAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetWithholdingTaxTotalAmount() string {
 	if x != nil {
 		return x.WithholdingTaxTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetPrepaidAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetPayableRoundingAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetPayableAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteHeaderRequest) GetPayableAlternativeAmount() float64 {
+func (x *CreateDebitNoteHeaderRequest) GetPayableAlternativeAmount() string {
 	if x != nil {
 		return x.PayableAlternativeAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreateDebitNoteHeaderRequest) GetUserId() string {
@@ -1362,6 +1392,27 @@ func (x *CreateDebitNoteHeaderRequest) GetDebitNoteLines() []*CreateDebitNoteLin
 	return nil
 }
 
+func (x *CreateDebitNoteHeaderRequest) GetAllowanceCharges() []*v1.CreateAllowanceChargeRequest {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+func (x *CreateDebitNoteHeaderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteHeaderRequest) GetPayableAlternativeCurrencyCode() string {
+	if x != nil {
+		return x.PayableAlternativeCurrencyCode
+	}
+	return ""
+}
+
 type CreateDebitNoteHeaderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1412,17 +1463,17 @@ type UpdateDebitNoteHeaderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Note                  string  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
-	DocumentCurrencyCode  string  `protobuf:"bytes,2,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
-	AccountingCost        string  `protobuf:"bytes,3,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	ChargeTotalAmount     float64 `protobuf:"fixed64,4,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
-	PrepaidAmount         float64 `protobuf:"fixed64,5,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
-	PayableRoundingAmount float64 `protobuf:"fixed64,6,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
-	PayableAmount         float64 `protobuf:"fixed64,7,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
-	Id                    string  `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
-	UserId                string  `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail             string  `protobuf:"bytes,10,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId             string  `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Note                  string `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	DocumentCurrencyCode  string `protobuf:"bytes,2,opt,name=document_currency_code,json=documentCurrencyCode,proto3" json:"document_currency_code,omitempty"`
+	AccountingCost        string `protobuf:"bytes,3,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	ChargeTotalAmount     string `protobuf:"bytes,4,opt,name=charge_total_amount,json=chargeTotalAmount,proto3" json:"charge_total_amount,omitempty"`
+	PrepaidAmount         string `protobuf:"bytes,5,opt,name=prepaid_amount,json=prepaidAmount,proto3" json:"prepaid_amount,omitempty"`
+	PayableRoundingAmount string `protobuf:"bytes,6,opt,name=payable_rounding_amount,json=payableRoundingAmount,proto3" json:"payable_rounding_amount,omitempty"`
+	PayableAmount         string `protobuf:"bytes,7,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	Id                    string `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                string `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail             string `protobuf:"bytes,10,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId             string `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
 func (x *UpdateDebitNoteHeaderRequest) Reset() {
@@ -1476,32 +1527,32 @@ func (x *UpdateDebitNoteHeaderRequest) GetAccountingCost() string {
 	return ""
 }
 
-func (x *UpdateDebitNoteHeaderRequest) GetChargeTotalAmount() float64 {
+func (x *UpdateDebitNoteHeaderRequest) GetChargeTotalAmount() string {
 	if x != nil {
 		return x.ChargeTotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDebitNoteHeaderRequest) GetPrepaidAmount() float64 {
+func (x *UpdateDebitNoteHeaderRequest) GetPrepaidAmount() string {
 	if x != nil {
 		return x.PrepaidAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDebitNoteHeaderRequest) GetPayableRoundingAmount() float64 {
+func (x *UpdateDebitNoteHeaderRequest) GetPayableRoundingAmount() string {
 	if x != nil {
 		return x.PayableRoundingAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDebitNoteHeaderRequest) GetPayableAmount() float64 {
+func (x *UpdateDebitNoteHeaderRequest) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *UpdateDebitNoteHeaderRequest) GetId() string {
@@ -1568,28 +1619,29 @@ func (*UpdateDebitNoteHeaderResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{6}
 }
 
-type GetDebitNoteHeaderRequest struct {
+type ReviseDebitNoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+	UpdateDebitNoteHeaderRequest *UpdateDebitNoteHeaderRequest `protobuf:"bytes,1,opt,name=update_debit_note_header_request,json=updateDebitNoteHeaderRequest,proto3" json:"update_debit_note_header_request,omitempty"`
+	Reason                       string                        `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetDebitNoteHeaderRequest) Reset() {
-	*x = GetDebitNoteHeaderRequest{}
+func (x *ReviseDebitNoteRequest) Reset() {
+	*x = ReviseDebitNoteRequest{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeaderRequest) String() string {
+func (x *ReviseDebitNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeaderRequest) ProtoMessage() {}
+func (*ReviseDebitNoteRequest) ProtoMessage() {}
 
-func (x *GetDebitNoteHeaderRequest) ProtoReflect() protoreflect.Message {
+func (x *ReviseDebitNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1601,40 +1653,47 @@ func (x *GetDebitNoteHeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeaderRequest.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeaderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviseDebitNoteRequest.ProtoReflect.Descriptor instead.
+func (*ReviseDebitNoteRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetDebitNoteHeaderRequest) GetGetRequest() *v1.GetRequest {
+func (x *ReviseDebitNoteRequest) GetUpdateDebitNoteHeaderRequest() *UpdateDebitNoteHeaderRequest {
 	if x != nil {
-		return x.GetRequest
+		return x.UpdateDebitNoteHeaderRequest
 	}
 	return nil
 }
 
-type GetDebitNoteHeaderResponse struct {
+func (x *ReviseDebitNoteRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviseDebitNoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebitNoteHeader *DebitNoteHeader `protobuf:"bytes,1,opt,name=debit_note_header,json=debitNoteHeader,proto3" json:"debit_note_header,omitempty"`
+	DocumentVersion *v1.DocumentVersion `protobuf:"bytes,1,opt,name=document_version,json=documentVersion,proto3" json:"document_version,omitempty"`
 }
 
-func (x *GetDebitNoteHeaderResponse) Reset() {
-	*x = GetDebitNoteHeaderResponse{}
+func (x *ReviseDebitNoteResponse) Reset() {
+	*x = ReviseDebitNoteResponse{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeaderResponse) String() string {
+func (x *ReviseDebitNoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeaderResponse) ProtoMessage() {}
+func (*ReviseDebitNoteResponse) ProtoMessage() {}
 
-func (x *GetDebitNoteHeaderResponse) ProtoReflect() protoreflect.Message {
+func (x *ReviseDebitNoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1646,40 +1705,40 @@ func (x *GetDebitNoteHeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeaderResponse.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeaderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviseDebitNoteResponse.ProtoReflect.Descriptor instead.
+func (*ReviseDebitNoteResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetDebitNoteHeaderResponse) GetDebitNoteHeader() *DebitNoteHeader {
+func (x *ReviseDebitNoteResponse) GetDocumentVersion() *v1.DocumentVersion {
 	if x != nil {
-		return x.DebitNoteHeader
+		return x.DocumentVersion
 	}
 	return nil
 }
 
-type GetDebitNoteHeaderByPkRequest struct {
+type GetDebitNoteHeaderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetByIdRequest *v1.GetByIdRequest `protobuf:"bytes,1,opt,name=get_by_id_request,json=getByIdRequest,proto3" json:"get_by_id_request,omitempty"`
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
 }
 
-func (x *GetDebitNoteHeaderByPkRequest) Reset() {
-	*x = GetDebitNoteHeaderByPkRequest{}
+func (x *GetDebitNoteHeaderRequest) Reset() {
+	*x = GetDebitNoteHeaderRequest{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeaderByPkRequest) String() string {
+func (x *GetDebitNoteHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeaderByPkRequest) ProtoMessage() {}
+func (*GetDebitNoteHeaderRequest) ProtoMessage() {}
 
-func (x *GetDebitNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDebitNoteHeaderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,19 +1750,19 @@ func (x *GetDebitNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeaderByPkRequest.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeaderByPkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebitNoteHeaderRequest.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeaderRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetDebitNoteHeaderByPkRequest) GetGetByIdRequest() *v1.GetByIdRequest {
+func (x *GetDebitNoteHeaderRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
-		return x.GetByIdRequest
+		return x.GetRequest
 	}
 	return nil
 }
 
-type GetDebitNoteHeaderByPkResponse struct {
+type GetDebitNoteHeaderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1711,20 +1770,20 @@ type GetDebitNoteHeaderByPkResponse struct {
 	DebitNoteHeader *DebitNoteHeader `protobuf:"bytes,1,opt,name=debit_note_header,json=debitNoteHeader,proto3" json:"debit_note_header,omitempty"`
 }
 
-func (x *GetDebitNoteHeaderByPkResponse) Reset() {
-	*x = GetDebitNoteHeaderByPkResponse{}
+func (x *GetDebitNoteHeaderResponse) Reset() {
+	*x = GetDebitNoteHeaderResponse{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeaderByPkResponse) String() string {
+func (x *GetDebitNoteHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeaderByPkResponse) ProtoMessage() {}
+func (*GetDebitNoteHeaderResponse) ProtoMessage() {}
 
-func (x *GetDebitNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDebitNoteHeaderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1736,43 +1795,40 @@ func (x *GetDebitNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeaderByPkResponse.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeaderByPkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebitNoteHeaderResponse.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeaderResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetDebitNoteHeaderByPkResponse) GetDebitNoteHeader() *DebitNoteHeader {
+func (x *GetDebitNoteHeaderResponse) GetDebitNoteHeader() *DebitNoteHeader {
 	if x != nil {
 		return x.DebitNoteHeader
 	}
 	return nil
 }
 
-type GetDebitNoteHeadersRequest struct {
+type GetDebitNoteUBLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit      string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
-	UserEmail  string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId  string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
 }
 
-func (x *GetDebitNoteHeadersRequest) Reset() {
-	*x = GetDebitNoteHeadersRequest{}
+func (x *GetDebitNoteUBLRequest) Reset() {
+	*x = GetDebitNoteUBLRequest{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeadersRequest) String() string {
+func (x *GetDebitNoteUBLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeadersRequest) ProtoMessage() {}
+func (*GetDebitNoteUBLRequest) ProtoMessage() {}
 
-func (x *GetDebitNoteHeadersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDebitNoteUBLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,62 +1840,40 @@ func (x *GetDebitNoteHeadersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeadersRequest.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeadersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebitNoteUBLRequest.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteUBLRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetDebitNoteHeadersRequest) GetLimit() string {
-	if x != nil {
-		return x.Limit
-	}
-	return ""
-}
-
-func (x *GetDebitNoteHeadersRequest) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-func (x *GetDebitNoteHeadersRequest) GetUserEmail() string {
-	if x != nil {
-		return x.UserEmail
-	}
-	return ""
-}
-
-func (x *GetDebitNoteHeadersRequest) GetRequestId() string {
+func (x *GetDebitNoteUBLRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
-		return x.RequestId
+		return x.GetRequest
 	}
-	return ""
+	return nil
 }
 
-type GetDebitNoteHeadersResponse struct {
+type GetDebitNoteUBLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebitNoteHeaders []*DebitNoteHeader `protobuf:"bytes,1,rep,name=debit_note_headers,json=debitNoteHeaders,proto3" json:"debit_note_headers,omitempty"`
-	NextCursor       string             `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Ubl []byte `protobuf:"bytes,1,opt,name=ubl,proto3" json:"ubl,omitempty"`
 }
 
-func (x *GetDebitNoteHeadersResponse) Reset() {
-	*x = GetDebitNoteHeadersResponse{}
+func (x *GetDebitNoteUBLResponse) Reset() {
+	*x = GetDebitNoteUBLResponse{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteHeadersResponse) String() string {
+func (x *GetDebitNoteUBLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteHeadersResponse) ProtoMessage() {}
+func (*GetDebitNoteUBLResponse) ProtoMessage() {}
 
-func (x *GetDebitNoteHeadersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDebitNoteUBLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1851,50 +1885,44 @@ func (x *GetDebitNoteHeadersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteHeadersResponse.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteHeadersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebitNoteUBLResponse.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteUBLResponse) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetDebitNoteHeadersResponse) GetDebitNoteHeaders() []*DebitNoteHeader {
+func (x *GetDebitNoteUBLResponse) GetUbl() []byte {
 	if x != nil {
-		return x.DebitNoteHeaders
+		return x.Ubl
 	}
 	return nil
 }
 
-func (x *GetDebitNoteHeadersResponse) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-type DebitNoteLine struct {
+type ImportDebitNoteUBLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebitNoteLineD *DebitNoteLineD `protobuf:"bytes,1,opt,name=debit_note_line_d,json=debitNoteLineD,proto3" json:"debit_note_line_d,omitempty"`
-	DebitNoteLineT *DebitNoteLineT `protobuf:"bytes,2,opt,name=debit_note_line_t,json=debitNoteLineT,proto3" json:"debit_note_line_t,omitempty"`
-	CrUpdUser      *v1.CrUpdUser   `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
-	CrUpdTime      *v1.CrUpdTime   `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	Ubl            []byte `protobuf:"bytes,1,opt,name=ubl,proto3" json:"ubl,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail      string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId      string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *DebitNoteLine) Reset() {
-	*x = DebitNoteLine{}
+func (x *ImportDebitNoteUBLRequest) Reset() {
+	*x = ImportDebitNoteUBLRequest{}
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebitNoteLine) String() string {
+func (x *ImportDebitNoteUBLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebitNoteLine) ProtoMessage() {}
+func (*ImportDebitNoteUBLRequest) ProtoMessage() {}
 
-func (x *DebitNoteLine) ProtoReflect() protoreflect.Message {
+func (x *ImportDebitNoteUBLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invoice_v1_debitnote_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1906,72 +1934,415 @@ func (x *DebitNoteLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebitNoteLine.ProtoReflect.Descriptor instead.
-func (*DebitNoteLine) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportDebitNoteUBLRequest.ProtoReflect.Descriptor instead.
+func (*ImportDebitNoteUBLRequest) Descriptor() ([]byte, []int) {
 	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DebitNoteLine) GetDebitNoteLineD() *DebitNoteLineD {
+func (x *ImportDebitNoteUBLRequest) GetUbl() []byte {
 	if x != nil {
-		return x.DebitNoteLineD
+		return x.Ubl
 	}
 	return nil
 }
 
-func (x *DebitNoteLine) GetDebitNoteLineT() *DebitNoteLineT {
+func (x *ImportDebitNoteUBLRequest) GetUserId() string {
 	if x != nil {
-		return x.DebitNoteLineT
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *DebitNoteLine) GetCrUpdUser() *v1.CrUpdUser {
+func (x *ImportDebitNoteUBLRequest) GetUserEmail() string {
 	if x != nil {
-		return x.CrUpdUser
+		return x.UserEmail
 	}
-	return nil
+	return ""
 }
 
-func (x *DebitNoteLine) GetCrUpdTime() *v1.CrUpdTime {
+func (x *ImportDebitNoteUBLRequest) GetRequestId() string {
 	if x != nil {
-		return x.CrUpdTime
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-type DebitNoteLineD struct {
+func (x *ImportDebitNoteUBLRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ImportDebitNoteUBLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid4                   []byte  `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
-	IdS                     string  `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
-	DnlId                   string  `protobuf:"bytes,4,opt,name=dnl_id,json=dnlId,proto3" json:"dnl_id,omitempty"`
-	Note                    string  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
-	DebitedQuantity         float64 `protobuf:"fixed64,6,opt,name=debited_quantity,json=debitedQuantity,proto3" json:"debited_quantity,omitempty"`
-	LineExtensionAmount     float64 `protobuf:"fixed64,7,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	AccountingCostCode      string  `protobuf:"bytes,8,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost          string  `protobuf:"bytes,9,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	PaymentPurposeCode      string  `protobuf:"bytes,10,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
-	DiscrepancyResponse     string  `protobuf:"bytes,11,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	DespatchLineId          uint32  `protobuf:"varint,12,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
-	ReceiptLineId           uint32  `protobuf:"varint,13,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
-	BillingId               uint32  `protobuf:"varint,14,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	ItemId                  uint32  `protobuf:"varint,15,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	PriceAmount             float64 `protobuf:"fixed64,16,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
-	PriceBaseQuantity       float64 `protobuf:"fixed64,17,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
-	PriceChangeReason       string  `protobuf:"bytes,18,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
-	PriceTypeCode           string  `protobuf:"bytes,19,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
-	PriceType               string  `protobuf:"bytes,20,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
-	OrderableUnitFactorRate float64 `protobuf:"fixed64,21,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
-	PriceListId             uint32  `protobuf:"varint,22,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
-	DebitNoteHeaderId       uint32  `protobuf:"varint,23,opt,name=debit_note_header_id,json=debitNoteHeaderId,proto3" json:"debit_note_header_id,omitempty"`
+	DebitNoteHeader *DebitNoteHeader `protobuf:"bytes,1,opt,name=debit_note_header,json=debitNoteHeader,proto3" json:"debit_note_header,omitempty"`
+}
+
+func (x *ImportDebitNoteUBLResponse) Reset() {
+	*x = ImportDebitNoteUBLResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDebitNoteUBLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDebitNoteUBLResponse) ProtoMessage() {}
+
+func (x *ImportDebitNoteUBLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDebitNoteUBLResponse.ProtoReflect.Descriptor instead.
+func (*ImportDebitNoteUBLResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportDebitNoteUBLResponse) GetDebitNoteHeader() *DebitNoteHeader {
+	if x != nil {
+		return x.DebitNoteHeader
+	}
+	return nil
+}
+
+type GetDebitNoteHeaderByPkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetByIdRequest *v1.GetByIdRequest `protobuf:"bytes,1,opt,name=get_by_id_request,json=getByIdRequest,proto3" json:"get_by_id_request,omitempty"`
+}
+
+func (x *GetDebitNoteHeaderByPkRequest) Reset() {
+	*x = GetDebitNoteHeaderByPkRequest{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteHeaderByPkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteHeaderByPkRequest) ProtoMessage() {}
+
+func (x *GetDebitNoteHeaderByPkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteHeaderByPkRequest.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeaderByPkRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDebitNoteHeaderByPkRequest) GetGetByIdRequest() *v1.GetByIdRequest {
+	if x != nil {
+		return x.GetByIdRequest
+	}
+	return nil
+}
+
+type GetDebitNoteHeaderByPkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DebitNoteHeader *DebitNoteHeader `protobuf:"bytes,1,opt,name=debit_note_header,json=debitNoteHeader,proto3" json:"debit_note_header,omitempty"`
+}
+
+func (x *GetDebitNoteHeaderByPkResponse) Reset() {
+	*x = GetDebitNoteHeaderByPkResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteHeaderByPkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteHeaderByPkResponse) ProtoMessage() {}
+
+func (x *GetDebitNoteHeaderByPkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteHeaderByPkResponse.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeaderByPkResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDebitNoteHeaderByPkResponse) GetDebitNoteHeader() *DebitNoteHeader {
+	if x != nil {
+		return x.DebitNoteHeader
+	}
+	return nil
+}
+
+type GetDebitNoteHeadersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit      string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	UserEmail  string `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId  string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *GetDebitNoteHeadersRequest) Reset() {
+	*x = GetDebitNoteHeadersRequest{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteHeadersRequest) ProtoMessage() {}
+
+func (x *GetDebitNoteHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteHeadersRequest.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDebitNoteHeadersRequest) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *GetDebitNoteHeadersRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetDebitNoteHeadersRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *GetDebitNoteHeadersRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetDebitNoteHeadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DebitNoteHeaders []*DebitNoteHeader `protobuf:"bytes,1,rep,name=debit_note_headers,json=debitNoteHeaders,proto3" json:"debit_note_headers,omitempty"`
+	NextCursor       string             `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetDebitNoteHeadersResponse) Reset() {
+	*x = GetDebitNoteHeadersResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteHeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteHeadersResponse) ProtoMessage() {}
+
+func (x *GetDebitNoteHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteHeadersResponse.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDebitNoteHeadersResponse) GetDebitNoteHeaders() []*DebitNoteHeader {
+	if x != nil {
+		return x.DebitNoteHeaders
+	}
+	return nil
+}
+
+func (x *GetDebitNoteHeadersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DebitNoteLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DebitNoteLineD   *DebitNoteLineD       `protobuf:"bytes,1,opt,name=debit_note_line_d,json=debitNoteLineD,proto3" json:"debit_note_line_d,omitempty"`
+	DebitNoteLineT   *DebitNoteLineT       `protobuf:"bytes,2,opt,name=debit_note_line_t,json=debitNoteLineT,proto3" json:"debit_note_line_t,omitempty"`
+	CrUpdUser        *v1.CrUpdUser         `protobuf:"bytes,3,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime        *v1.CrUpdTime         `protobuf:"bytes,4,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+	AllowanceCharges []*v1.AllowanceCharge `protobuf:"bytes,5,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+}
+
+func (x *DebitNoteLine) Reset() {
+	*x = DebitNoteLine{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitNoteLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitNoteLine) ProtoMessage() {}
+
+func (x *DebitNoteLine) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitNoteLine.ProtoReflect.Descriptor instead.
+func (*DebitNoteLine) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DebitNoteLine) GetDebitNoteLineD() *DebitNoteLineD {
+	if x != nil {
+		return x.DebitNoteLineD
+	}
+	return nil
+}
+
+func (x *DebitNoteLine) GetDebitNoteLineT() *DebitNoteLineT {
+	if x != nil {
+		return x.DebitNoteLineT
+	}
+	return nil
+}
+
+func (x *DebitNoteLine) GetCrUpdUser() *v1.CrUpdUser {
+	if x != nil {
+		return x.CrUpdUser
+	}
+	return nil
+}
+
+func (x *DebitNoteLine) GetCrUpdTime() *v1.CrUpdTime {
+	if x != nil {
+		return x.CrUpdTime
+	}
+	return nil
+}
+
+func (x *DebitNoteLine) GetAllowanceCharges() []*v1.AllowanceCharge {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+type DebitNoteLineD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid4                   []byte  `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
+	IdS                     string  `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
+	DnlId                   string  `protobuf:"bytes,4,opt,name=dnl_id,json=dnlId,proto3" json:"dnl_id,omitempty"`
+	Note                    string  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	DebitedQuantity         float64 `protobuf:"fixed64,6,opt,name=debited_quantity,json=debitedQuantity,proto3" json:"debited_quantity,omitempty"`
+	LineExtensionAmount     string  `protobuf:"bytes,7,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	AccountingCostCode      string  `protobuf:"bytes,8,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost          string  `protobuf:"bytes,9,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	PaymentPurposeCode      string  `protobuf:"bytes,10,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
+	DiscrepancyResponse     string  `protobuf:"bytes,11,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	DespatchLineId          uint32  `protobuf:"varint,12,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
+	ReceiptLineId           uint32  `protobuf:"varint,13,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
+	BillingId               uint32  `protobuf:"varint,14,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	ItemId                  uint32  `protobuf:"varint,15,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PriceAmount             string  `protobuf:"bytes,16,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	PriceBaseQuantity       float64 `protobuf:"fixed64,17,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
+	PriceChangeReason       string  `protobuf:"bytes,18,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
+	PriceTypeCode           string  `protobuf:"bytes,19,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
+	PriceType               string  `protobuf:"bytes,20,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
+	OrderableUnitFactorRate float64 `protobuf:"fixed64,21,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
+	PriceListId             uint32  `protobuf:"varint,22,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	DebitNoteHeaderId       uint32  `protobuf:"varint,23,opt,name=debit_note_header_id,json=debitNoteHeaderId,proto3" json:"debit_note_header_id,omitempty"`
+	CurrencyCode            string  `protobuf:"bytes,24,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	UnitCode                string  `protobuf:"bytes,25,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
 }
 
 func (x *DebitNoteLineD) Reset() {
 	*x = DebitNoteLineD{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[14]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1983,7 +2354,7 @@ func (x *DebitNoteLineD) String() string {
 func (*DebitNoteLineD) ProtoMessage() {}
 
 func (x *DebitNoteLineD) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[14]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,7 +2367,7 @@ func (x *DebitNoteLineD) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitNoteLineD.ProtoReflect.Descriptor instead.
 func (*DebitNoteLineD) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{14}
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DebitNoteLineD) GetId() uint32 {
@@ -2041,11 +2412,11 @@ func (x *DebitNoteLineD) GetDebitedQuantity() float64 {
 	return 0
 }
 
-func (x *DebitNoteLineD) GetLineExtensionAmount() float64 {
+func (x *DebitNoteLineD) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *DebitNoteLineD) GetAccountingCostCode() string {
@@ -2104,11 +2475,11 @@ func (x *DebitNoteLineD) GetItemId() uint32 {
 	return 0
 }
 
-func (x *DebitNoteLineD) GetPriceAmount() float64 {
+func (x *DebitNoteLineD) GetPriceAmount() string {
 	if x != nil {
 		return x.PriceAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *DebitNoteLineD) GetPriceBaseQuantity() float64 {
@@ -2160,6 +2531,20 @@ func (x *DebitNoteLineD) GetDebitNoteHeaderId() uint32 {
 	return 0
 }
 
+func (x *DebitNoteLineD) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *DebitNoteLineD) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
 type DebitNoteLineT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2172,7 +2557,7 @@ type DebitNoteLineT struct {
 
 func (x *DebitNoteLineT) Reset() {
 	*x = DebitNoteLineT{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[15]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2184,7 +2569,7 @@ func (x *DebitNoteLineT) String() string {
 func (*DebitNoteLineT) ProtoMessage() {}
 
 func (x *DebitNoteLineT) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[15]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2197,7 +2582,7 @@ func (x *DebitNoteLineT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitNoteLineT.ProtoReflect.Descriptor instead.
 func (*DebitNoteLineT) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{15}
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DebitNoteLineT) GetTaxPointDate() *timestamppb.Timestamp {
@@ -2226,37 +2611,40 @@ type CreateDebitNoteLineRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DnlId                        string  `protobuf:"bytes,1,opt,name=dnl_id,json=dnlId,proto3" json:"dnl_id,omitempty"`
-	Note                         string  `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
-	DebitedQuantity              float64 `protobuf:"fixed64,3,opt,name=debited_quantity,json=debitedQuantity,proto3" json:"debited_quantity,omitempty"`
-	LineExtensionAmount          float64 `protobuf:"fixed64,4,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
-	TaxPointDate                 string  `protobuf:"bytes,5,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
-	AccountingCostCode           string  `protobuf:"bytes,6,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
-	AccountingCost               string  `protobuf:"bytes,7,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
-	PaymentPurposeCode           string  `protobuf:"bytes,8,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
-	DiscrepancyResponse          string  `protobuf:"bytes,9,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
-	DespatchLineId               uint32  `protobuf:"varint,10,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
-	ReceiptLineId                uint32  `protobuf:"varint,11,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
-	BillingId                    uint32  `protobuf:"varint,12,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
-	ItemId                       uint32  `protobuf:"varint,13,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	PriceAmount                  float64 `protobuf:"fixed64,14,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
-	PriceBaseQuantity            float64 `protobuf:"fixed64,15,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
-	PriceChangeReason            string  `protobuf:"bytes,16,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
-	PriceTypeCode                string  `protobuf:"bytes,17,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
-	PriceType                    string  `protobuf:"bytes,18,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
-	OrderableUnitFactorRate      float64 `protobuf:"fixed64,19,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
-	PriceValidityPeriodStartDate string  `protobuf:"bytes,20,opt,name=price_validity_period_start_date,json=priceValidityPeriodStartDate,proto3" json:"price_validity_period_start_date,omitempty"`
-	PriceValidityPeriodEndDate   string  `protobuf:"bytes,21,opt,name=price_validity_period_end_date,json=priceValidityPeriodEndDate,proto3" json:"price_validity_period_end_date,omitempty"`
-	PriceListId                  uint32  `protobuf:"varint,22,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
-	DebitNoteHeaderId            uint32  `protobuf:"varint,23,opt,name=debit_note_header_id,json=debitNoteHeaderId,proto3" json:"debit_note_header_id,omitempty"`
-	UserId                       string  `protobuf:"bytes,24,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail                    string  `protobuf:"bytes,25,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	RequestId                    string  `protobuf:"bytes,26,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	DnlId                        string                             `protobuf:"bytes,1,opt,name=dnl_id,json=dnlId,proto3" json:"dnl_id,omitempty"`
+	Note                         string                             `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	DebitedQuantity              float64                            `protobuf:"fixed64,3,opt,name=debited_quantity,json=debitedQuantity,proto3" json:"debited_quantity,omitempty"`
+	LineExtensionAmount          string                             `protobuf:"bytes,4,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	TaxPointDate                 string                             `protobuf:"bytes,5,opt,name=tax_point_date,json=taxPointDate,proto3" json:"tax_point_date,omitempty"`
+	AccountingCostCode           string                             `protobuf:"bytes,6,opt,name=accounting_cost_code,json=accountingCostCode,proto3" json:"accounting_cost_code,omitempty"`
+	AccountingCost               string                             `protobuf:"bytes,7,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	PaymentPurposeCode           string                             `protobuf:"bytes,8,opt,name=payment_purpose_code,json=paymentPurposeCode,proto3" json:"payment_purpose_code,omitempty"`
+	DiscrepancyResponse          string                             `protobuf:"bytes,9,opt,name=discrepancy_response,json=discrepancyResponse,proto3" json:"discrepancy_response,omitempty"`
+	DespatchLineId               uint32                             `protobuf:"varint,10,opt,name=despatch_line_id,json=despatchLineId,proto3" json:"despatch_line_id,omitempty"`
+	ReceiptLineId                uint32                             `protobuf:"varint,11,opt,name=receipt_line_id,json=receiptLineId,proto3" json:"receipt_line_id,omitempty"`
+	BillingId                    uint32                             `protobuf:"varint,12,opt,name=billing_id,json=billingId,proto3" json:"billing_id,omitempty"`
+	ItemId                       uint32                             `protobuf:"varint,13,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PriceAmount                  string                             `protobuf:"bytes,14,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	PriceBaseQuantity            float64                            `protobuf:"fixed64,15,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
+	PriceChangeReason            string                             `protobuf:"bytes,16,opt,name=price_change_reason,json=priceChangeReason,proto3" json:"price_change_reason,omitempty"`
+	PriceTypeCode                string                             `protobuf:"bytes,17,opt,name=price_type_code,json=priceTypeCode,proto3" json:"price_type_code,omitempty"`
+	PriceType                    string                             `protobuf:"bytes,18,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty"`
+	OrderableUnitFactorRate      float64                            `protobuf:"fixed64,19,opt,name=orderable_unit_factor_rate,json=orderableUnitFactorRate,proto3" json:"orderable_unit_factor_rate,omitempty"`
+	PriceValidityPeriodStartDate string                             `protobuf:"bytes,20,opt,name=price_validity_period_start_date,json=priceValidityPeriodStartDate,proto3" json:"price_validity_period_start_date,omitempty"`
+	PriceValidityPeriodEndDate   string                             `protobuf:"bytes,21,opt,name=price_validity_period_end_date,json=priceValidityPeriodEndDate,proto3" json:"price_validity_period_end_date,omitempty"`
+	PriceListId                  uint32                             `protobuf:"varint,22,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	DebitNoteHeaderId            uint32                             `protobuf:"varint,23,opt,name=debit_note_header_id,json=debitNoteHeaderId,proto3" json:"debit_note_header_id,omitempty"`
+	UserId                       string                             `protobuf:"bytes,24,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail                    string                             `protobuf:"bytes,25,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId                    string                             `protobuf:"bytes,26,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	AllowanceCharges             []*v1.CreateAllowanceChargeRequest `protobuf:"bytes,27,rep,name=allowance_charges,json=allowanceCharges,proto3" json:"allowance_charges,omitempty"`
+	IdempotencyKey               string                             `protobuf:"bytes,28,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UnitCode                     string                             `protobuf:"bytes,29,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
 }
 
 func (x *CreateDebitNoteLineRequest) Reset() {
 	*x = CreateDebitNoteLineRequest{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[16]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2268,7 +2656,7 @@ func (x *CreateDebitNoteLineRequest) String() string {
 func (*CreateDebitNoteLineRequest) ProtoMessage() {}
 
 func (x *CreateDebitNoteLineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[16]
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,7 +2669,7 @@ func (x *CreateDebitNoteLineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDebitNoteLineRequest.ProtoReflect.Descriptor instead.
 func (*CreateDebitNoteLineRequest) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{16}
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateDebitNoteLineRequest) GetDnlId() string {
@@ -2305,11 +2693,11 @@ func (x *CreateDebitNoteLineRequest) GetDebitedQuantity() float64 {
 	return 0
 }
 
-func (x *CreateDebitNoteLineRequest) GetLineExtensionAmount() float64 {
+func (x *CreateDebitNoteLineRequest) GetLineExtensionAmount() string {
 	if x != nil {
 		return x.LineExtensionAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreateDebitNoteLineRequest) GetTaxPointDate() string {
@@ -2368,127 +2756,398 @@ func (x *CreateDebitNoteLineRequest) GetBillingId() uint32 {
 	return 0
 }
 
-func (x *CreateDebitNoteLineRequest) GetItemId() uint32 {
-	if x != nil {
-		return x.ItemId
-	}
-	return 0
+func (x *CreateDebitNoteLineRequest) GetItemId() uint32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceAmount() string {
+	if x != nil {
+		return x.PriceAmount
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceBaseQuantity() float64 {
+	if x != nil {
+		return x.PriceBaseQuantity
+	}
+	return 0
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceChangeReason() string {
+	if x != nil {
+		return x.PriceChangeReason
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceTypeCode() string {
+	if x != nil {
+		return x.PriceTypeCode
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetOrderableUnitFactorRate() float64 {
+	if x != nil {
+		return x.OrderableUnitFactorRate
+	}
+	return 0
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceValidityPeriodStartDate() string {
+	if x != nil {
+		return x.PriceValidityPeriodStartDate
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceValidityPeriodEndDate() string {
+	if x != nil {
+		return x.PriceValidityPeriodEndDate
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetPriceListId() uint32 {
+	if x != nil {
+		return x.PriceListId
+	}
+	return 0
+}
+
+func (x *CreateDebitNoteLineRequest) GetDebitNoteHeaderId() uint32 {
+	if x != nil {
+		return x.DebitNoteHeaderId
+	}
+	return 0
+}
+
+func (x *CreateDebitNoteLineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetAllowanceCharges() []*v1.CreateAllowanceChargeRequest {
+	if x != nil {
+		return x.AllowanceCharges
+	}
+	return nil
+}
+
+func (x *CreateDebitNoteLineRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateDebitNoteLineRequest) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
+type CreateDebitNoteLineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DebitNoteLine *DebitNoteLine `protobuf:"bytes,1,opt,name=debit_note_line,json=debitNoteLine,proto3" json:"debit_note_line,omitempty"`
+}
+
+func (x *CreateDebitNoteLineResponse) Reset() {
+	*x = CreateDebitNoteLineResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebitNoteLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebitNoteLineResponse) ProtoMessage() {}
+
+func (x *CreateDebitNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebitNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*CreateDebitNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateDebitNoteLineResponse) GetDebitNoteLine() *DebitNoteLine {
+	if x != nil {
+		return x.DebitNoteLine
+	}
+	return nil
+}
+
+type GetDebitNoteLinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+}
+
+func (x *GetDebitNoteLinesRequest) Reset() {
+	*x = GetDebitNoteLinesRequest{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteLinesRequest) ProtoMessage() {}
+
+func (x *GetDebitNoteLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteLinesRequest.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteLinesRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDebitNoteLinesRequest) GetGetRequest() *v1.GetRequest {
+	if x != nil {
+		return x.GetRequest
+	}
+	return nil
+}
+
+type GetDebitNoteLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DebitNoteLines []*DebitNoteLine `protobuf:"bytes,1,rep,name=debit_note_lines,json=debitNoteLines,proto3" json:"debit_note_lines,omitempty"`
+}
+
+func (x *GetDebitNoteLinesResponse) Reset() {
+	*x = GetDebitNoteLinesResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebitNoteLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebitNoteLinesResponse) ProtoMessage() {}
+
+func (x *GetDebitNoteLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebitNoteLinesResponse.ProtoReflect.Descriptor instead.
+func (*GetDebitNoteLinesResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceAmount() float64 {
+func (x *GetDebitNoteLinesResponse) GetDebitNoteLines() []*DebitNoteLine {
 	if x != nil {
-		return x.PriceAmount
+		return x.DebitNoteLines
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceBaseQuantity() float64 {
-	if x != nil {
-		return x.PriceBaseQuantity
-	}
-	return 0
+type UpdateDebitNoteLineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Note                string  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	DebitedQuantity     float64 `protobuf:"fixed64,2,opt,name=debited_quantity,json=debitedQuantity,proto3" json:"debited_quantity,omitempty"`
+	LineExtensionAmount string  `protobuf:"bytes,3,opt,name=line_extension_amount,json=lineExtensionAmount,proto3" json:"line_extension_amount,omitempty"`
+	PriceAmount         string  `protobuf:"bytes,4,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	PriceBaseQuantity   float64 `protobuf:"fixed64,5,opt,name=price_base_quantity,json=priceBaseQuantity,proto3" json:"price_base_quantity,omitempty"`
+	AccountingCost      string  `protobuf:"bytes,6,opt,name=accounting_cost,json=accountingCost,proto3" json:"accounting_cost,omitempty"`
+	Id                  string  `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	UserId              string  `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail           string  `protobuf:"bytes,9,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId           string  `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *UpdateDebitNoteLineRequest) Reset() {
+	*x = UpdateDebitNoteLineRequest{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceChangeReason() string {
-	if x != nil {
-		return x.PriceChangeReason
-	}
-	return ""
+func (x *UpdateDebitNoteLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceTypeCode() string {
+func (*UpdateDebitNoteLineRequest) ProtoMessage() {}
+
+func (x *UpdateDebitNoteLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[26]
 	if x != nil {
-		return x.PriceTypeCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceType() string {
+// Deprecated: Use UpdateDebitNoteLineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDebitNoteLineRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateDebitNoteLineRequest) GetNote() string {
 	if x != nil {
-		return x.PriceType
+		return x.Note
 	}
 	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetOrderableUnitFactorRate() float64 {
+func (x *UpdateDebitNoteLineRequest) GetDebitedQuantity() float64 {
 	if x != nil {
-		return x.OrderableUnitFactorRate
+		return x.DebitedQuantity
 	}
 	return 0
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceValidityPeriodStartDate() string {
+func (x *UpdateDebitNoteLineRequest) GetLineExtensionAmount() string {
 	if x != nil {
-		return x.PriceValidityPeriodStartDate
+		return x.LineExtensionAmount
 	}
 	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceValidityPeriodEndDate() string {
+func (x *UpdateDebitNoteLineRequest) GetPriceAmount() string {
 	if x != nil {
-		return x.PriceValidityPeriodEndDate
+		return x.PriceAmount
 	}
 	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetPriceListId() uint32 {
+func (x *UpdateDebitNoteLineRequest) GetPriceBaseQuantity() float64 {
 	if x != nil {
-		return x.PriceListId
+		return x.PriceBaseQuantity
 	}
 	return 0
 }
 
-func (x *CreateDebitNoteLineRequest) GetDebitNoteHeaderId() uint32 {
+func (x *UpdateDebitNoteLineRequest) GetAccountingCost() string {
 	if x != nil {
-		return x.DebitNoteHeaderId
+		return x.AccountingCost
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetUserId() string {
+func (x *UpdateDebitNoteLineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDebitNoteLineRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetUserEmail() string {
+func (x *UpdateDebitNoteLineRequest) GetUserEmail() string {
 	if x != nil {
 		return x.UserEmail
 	}
 	return ""
 }
 
-func (x *CreateDebitNoteLineRequest) GetRequestId() string {
+func (x *UpdateDebitNoteLineRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-type CreateDebitNoteLineResponse struct {
+type UpdateDebitNoteLineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DebitNoteLine *DebitNoteLine `protobuf:"bytes,1,opt,name=debit_note_line,json=debitNoteLine,proto3" json:"debit_note_line,omitempty"`
 }
 
-func (x *CreateDebitNoteLineResponse) Reset() {
-	*x = CreateDebitNoteLineResponse{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[17]
+func (x *UpdateDebitNoteLineResponse) Reset() {
+	*x = UpdateDebitNoteLineResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDebitNoteLineResponse) String() string {
+func (x *UpdateDebitNoteLineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDebitNoteLineResponse) ProtoMessage() {}
+func (*UpdateDebitNoteLineResponse) ProtoMessage() {}
 
-func (x *CreateDebitNoteLineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[17]
+func (x *UpdateDebitNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,19 +3158,12 @@ func (x *CreateDebitNoteLineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDebitNoteLineResponse.ProtoReflect.Descriptor instead.
-func (*CreateDebitNoteLineResponse) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *CreateDebitNoteLineResponse) GetDebitNoteLine() *DebitNoteLine {
-	if x != nil {
-		return x.DebitNoteLine
-	}
-	return nil
+// Deprecated: Use UpdateDebitNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDebitNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{27}
 }
 
-type GetDebitNoteLinesRequest struct {
+type DeleteDebitNoteLineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2519,21 +3171,21 @@ type GetDebitNoteLinesRequest struct {
 	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
 }
 
-func (x *GetDebitNoteLinesRequest) Reset() {
-	*x = GetDebitNoteLinesRequest{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[18]
+func (x *DeleteDebitNoteLineRequest) Reset() {
+	*x = DeleteDebitNoteLineRequest{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteLinesRequest) String() string {
+func (x *DeleteDebitNoteLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteLinesRequest) ProtoMessage() {}
+func (*DeleteDebitNoteLineRequest) ProtoMessage() {}
 
-func (x *GetDebitNoteLinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[18]
+func (x *DeleteDebitNoteLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,41 +3196,39 @@ func (x *GetDebitNoteLinesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteLinesRequest.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteLinesRequest) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteDebitNoteLineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDebitNoteLineRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetDebitNoteLinesRequest) GetGetRequest() *v1.GetRequest {
+func (x *DeleteDebitNoteLineRequest) GetGetRequest() *v1.GetRequest {
 	if x != nil {
 		return x.GetRequest
 	}
 	return nil
 }
 
-type GetDebitNoteLinesResponse struct {
+type DeleteDebitNoteLineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DebitNoteLines []*DebitNoteLine `protobuf:"bytes,1,rep,name=debit_note_lines,json=debitNoteLines,proto3" json:"debit_note_lines,omitempty"`
 }
 
-func (x *GetDebitNoteLinesResponse) Reset() {
-	*x = GetDebitNoteLinesResponse{}
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[19]
+func (x *DeleteDebitNoteLineResponse) Reset() {
+	*x = DeleteDebitNoteLineResponse{}
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDebitNoteLinesResponse) String() string {
+func (x *DeleteDebitNoteLineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDebitNoteLinesResponse) ProtoMessage() {}
+func (*DeleteDebitNoteLineResponse) ProtoMessage() {}
 
-func (x *GetDebitNoteLinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invoice_v1_debitnote_proto_msgTypes[19]
+func (x *DeleteDebitNoteLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_debitnote_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,16 +3239,9 @@ func (x *GetDebitNoteLinesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDebitNoteLinesResponse.ProtoReflect.Descriptor instead.
-func (*GetDebitNoteLinesResponse) Descriptor() ([]byte, []int) {
-	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetDebitNoteLinesResponse) GetDebitNoteLines() []*DebitNoteLine {
-	if x != nil {
-		return x.DebitNoteLines
-	}
-	return nil
+// Deprecated: Use DeleteDebitNoteLineResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDebitNoteLineResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_debitnote_proto_rawDescGZIP(), []int{29}
 }
 
 var File_invoice_v1_debitnote_proto protoreflect.FileDescriptor
@@ -2610,852 +3253,1073 @@ var file_invoice_v1_debitnote_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e,
-	0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44,
-	0x52, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x44, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x52, 0x10, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12,
-	0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xac, 0x1c, 0x0a, 0x10,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x68,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x68, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61,
-	0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39,
-	0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72,
-	0x69, 0x63, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
-	0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72,
-	0x74, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61,
-	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x65, 0x65, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70,
-	0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x62,
-	0x75, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x75,
-	0x79, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79,
-	0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x19,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x74,
-	0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x18, 0x74, 0x61, 0x78, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61,
-	0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f,
-	0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a,
-	0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x74, 0x61, 0x78, 0x45, 0x78, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x35,
-	0x0a, 0x17, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x14, 0x74, 0x61, 0x78, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f,
-	0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
-	0x74, 0x61, 0x78, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70,
-	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x22,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69,
-	0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
-	0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x25,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
-	0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x72,
-	0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x72,
-	0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
-	0x67, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x28, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x4d, 0x61,
-	0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40,
-	0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x2d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x2e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x4c, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61,
-	0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
-	0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a,
-	0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f,
-	0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a,
-	0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x21, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x1f,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x35, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c,
-	0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x53, 0x0a, 0x27, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c,
-	0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x36, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45,
-	0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x37, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74,
-	0x61, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x38, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61, 0x78, 0x45, 0x78,
-	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a,
-	0x14, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61, 0x78,
-	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3b, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x77, 0x69, 0x74,
-	0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69,
-	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
-	0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a,
-	0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15,
-	0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70,
-	0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a,
-	0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x40, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x18, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc8, 0x04, 0x0a, 0x10, 0x44,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12,
-	0x39, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61,
-	0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
-	0x74, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x19,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x16, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x14, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x61, 0x78, 0x45, 0x78, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
-	0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45,
-	0x78, 0x44, 0x61, 0x74, 0x65, 0x22, 0xfe, 0x1f, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x68, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
-	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63,
-	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d,
-	0x65, 0x72, 0x69, 0x63, 0x12, 0x39, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x35, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
-	0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79,
-	0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x35, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x14, 0x62, 0x75, 0x79, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50,
-	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
-	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x3d, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1b,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x61, 0x78, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3c,
-	0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20,
-	0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78,
-	0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f,
-	0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x74, 0x61, 0x78,
-	0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x14, 0x74, 0x61, 0x78, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78,
-	0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x22, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1e, 0x0a, 0x0b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x23,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x78, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
-	0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
-	0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x25, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f,
-	0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70,
-	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72,
-	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
-	0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69,
-	0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x19, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70,
-	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x18, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72,
-	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
-	0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63,
-	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x2b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x33, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74,
-	0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54,
-	0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74,
-	0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x37, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45,
-	0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x21, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x38, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x1c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f,
-	0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x27, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x41, 0x6c, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69,
-	0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30,
-	0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x61,
-	0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
-	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
-	0x74, 0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3f, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x40, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68,
-	0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x41, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19,
-	0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x42, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x44, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x45, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f,
-	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x4a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74,
-	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x22, 0xae, 0x03, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70,
-	0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x70, 0x61,
-	0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79,
-	0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e,
-	0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x65,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x44, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74,
-	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x22, 0x91, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f,
-	0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x22, 0x89, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
-	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74,
-	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x62,
-	0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54,
-	0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54,
+	0x6f, 0x1a, 0x1f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xe0, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f,
+	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x44, 0x52, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x44, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x52, 0x10,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54,
 	0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55,
 	0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64,
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf9, 0x06, 0x0a,
-	0x0e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x6c, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c,
-	0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x65, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x22, 0xa9, 0x1d, 0x0a, 0x10, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75,
+	0x69, 0x64, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34,
+	0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x69, 0x64, 0x53, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x14,
+	0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a,
+	0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3f,
+	0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x75, 0x79, 0x65, 0x72, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45, 0x78,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61,
+	0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
+	0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65,
+	0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38,
+	0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x15, 0x74, 0x61, 0x78, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x78, 0x5f,
+	0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x74, 0x61, 0x78, 0x45, 0x78,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x4d,
+	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x24, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45,
+	0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45,
+	0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x6d,
+	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44,
+	0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x18, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74,
+	0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a,
+	0x21, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x27,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x6d,
+	0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x38, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x40, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x41, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x21, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0xc8, 0x04, 0x0a, 0x10, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x17, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x61, 0x78, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x72, 0x69,
+	0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a,
+	0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x49, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74,
+	0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x21, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x64, 0x6e, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x6e, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74,
 	0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a,
-	0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64,
-	0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65,
-	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69,
-	0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x65, 0x62, 0x69, 0x74,
-	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x62,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x40, 0x0a, 0x0e, 0x74,
-	0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a,
-	0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x5e, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x22, 0xd5, 0x08, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x6e, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61,
-	0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x39, 0x0a, 0x19, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
 	0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73,
 	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70,
-	0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
-	0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
-	0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
-	0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74,
-	0x65, 0x12, 0x46, 0x0a, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
-	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x1b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x64, 0x65, 0x62, 0x69,
-	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0d, 0x64, 0x65,
-	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3f,
+	0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x3f, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x65, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x75, 0x79, 0x65, 0x72, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x18, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x15, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x61, 0x78, 0x45,
+	0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74,
+	0x61, 0x78, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x61,
+	0x78, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x20, 0x74, 0x61, 0x78, 0x5f,
+	0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x0a, 0x19, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x74, 0x61, 0x78, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x74, 0x61, 0x78, 0x45,
+	0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x44, 0x0a, 0x1f, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x74, 0x61, 0x78, 0x45, 0x78,
+	0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x78,
+	0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x26,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x40, 0x0a, 0x1d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78,
+	0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f,
+	0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a,
+	0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x1f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x4d, 0x0a, 0x24, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x40, 0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78,
+	0x5f, 0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c,
+	0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x74, 0x45, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x23, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x28, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x47,
+	0x0a, 0x21, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78,
+	0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a,
+	0x27, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x5f,
+	0x6d, 0x61, 0x74, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x4d, 0x61, 0x74, 0x68,
+	0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c,
+	0x74, 0x5f, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x45, 0x78, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x40, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x1c, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x68, 0x6f, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x42, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x44, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x45, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x61, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x47, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x10, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x4a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x54, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x18, 0x4b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x21,
+	0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x68, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69,
+	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x22, 0xae, 0x03, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17,
+	0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70,
+	0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x69, 0x73, 0x65, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x70,
+	0x0a, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x1c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x60, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
-	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10,
-	0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x65, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x62, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x62, 0x6c, 0x22, 0xad, 0x01, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x62, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x62, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x11, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x91,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x10, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xd2,
+	0x02, 0x0a, 0x0d, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x52, 0x0e,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x12, 0x34,
+	0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x22, 0xbb, 0x07, 0x0a, 0x0e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04,
+	0x69, 0x64, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12,
+	0x15, 0x0a, 0x06, 0x64, 0x6e, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x6e, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x14, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x54, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x61, 0x78, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1c, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x1e, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1a,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xf1, 0x09, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x6c, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x78,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x17, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x60,
+	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
-	0x65, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
-	0x73, 0x32, 0xfc, 0x05, 0x0a, 0x16, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x65, 0x52, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x22, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xd6, 0x12, 0x0a, 0x16, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x12,
+	0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
 	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
-	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50,
-	0x6b, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x50, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62,
-	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12,
-	0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x66, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74,
 	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
-	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6c, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f,
-	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x72, 0x65, 0x73, 0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62,
-	0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x67, 0x65, 0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x6c, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62,
+	0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42,
+	0x4c, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55,
+	0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c,
+	0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x55, 0x42, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d,
+	0x53, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1a, 0x4d,
+	0x61, 0x72, 0x6b, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x22,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x15, 0x44, 0x69, 0x66, 0x66, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x72, 0x65, 0x73,
+	0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3470,83 +4334,143 @@ func file_invoice_v1_debitnote_proto_rawDescGZIP() []byte {
 	return file_invoice_v1_debitnote_proto_rawDescData
 }
 
-var file_invoice_v1_debitnote_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_invoice_v1_debitnote_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_invoice_v1_debitnote_proto_goTypes = []any{
-	(*DebitNoteHeader)(nil),                // 0: invoice.v1.DebitNoteHeader
-	(*DebitNoteHeaderD)(nil),               // 1: invoice.v1.DebitNoteHeaderD
-	(*DebitNoteHeaderT)(nil),               // 2: invoice.v1.DebitNoteHeaderT
-	(*CreateDebitNoteHeaderRequest)(nil),   // 3: invoice.v1.CreateDebitNoteHeaderRequest
-	(*CreateDebitNoteHeaderResponse)(nil),  // 4: invoice.v1.CreateDebitNoteHeaderResponse
-	(*UpdateDebitNoteHeaderRequest)(nil),   // 5: invoice.v1.UpdateDebitNoteHeaderRequest
-	(*UpdateDebitNoteHeaderResponse)(nil),  // 6: invoice.v1.UpdateDebitNoteHeaderResponse
-	(*GetDebitNoteHeaderRequest)(nil),      // 7: invoice.v1.GetDebitNoteHeaderRequest
-	(*GetDebitNoteHeaderResponse)(nil),     // 8: invoice.v1.GetDebitNoteHeaderResponse
-	(*GetDebitNoteHeaderByPkRequest)(nil),  // 9: invoice.v1.GetDebitNoteHeaderByPkRequest
-	(*GetDebitNoteHeaderByPkResponse)(nil), // 10: invoice.v1.GetDebitNoteHeaderByPkResponse
-	(*GetDebitNoteHeadersRequest)(nil),     // 11: invoice.v1.GetDebitNoteHeadersRequest
-	(*GetDebitNoteHeadersResponse)(nil),    // 12: invoice.v1.GetDebitNoteHeadersResponse
-	(*DebitNoteLine)(nil),                  // 13: invoice.v1.DebitNoteLine
-	(*DebitNoteLineD)(nil),                 // 14: invoice.v1.DebitNoteLineD
-	(*DebitNoteLineT)(nil),                 // 15: invoice.v1.DebitNoteLineT
-	(*CreateDebitNoteLineRequest)(nil),     // 16: invoice.v1.CreateDebitNoteLineRequest
-	(*CreateDebitNoteLineResponse)(nil),    // 17: invoice.v1.CreateDebitNoteLineResponse
-	(*GetDebitNoteLinesRequest)(nil),       // 18: invoice.v1.GetDebitNoteLinesRequest
-	(*GetDebitNoteLinesResponse)(nil),      // 19: invoice.v1.GetDebitNoteLinesResponse
-	(*v1.CrUpdUser)(nil),                   // 20: common.v1.CrUpdUser
-	(*v1.CrUpdTime)(nil),                   // 21: common.v1.CrUpdTime
-	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
-	(*v1.GetRequest)(nil),                  // 23: common.v1.GetRequest
-	(*v1.GetByIdRequest)(nil),              // 24: common.v1.GetByIdRequest
+	(*DebitNoteHeader)(nil),                      // 0: invoice.v1.DebitNoteHeader
+	(*DebitNoteHeaderD)(nil),                     // 1: invoice.v1.DebitNoteHeaderD
+	(*DebitNoteHeaderT)(nil),                     // 2: invoice.v1.DebitNoteHeaderT
+	(*CreateDebitNoteHeaderRequest)(nil),         // 3: invoice.v1.CreateDebitNoteHeaderRequest
+	(*CreateDebitNoteHeaderResponse)(nil),        // 4: invoice.v1.CreateDebitNoteHeaderResponse
+	(*UpdateDebitNoteHeaderRequest)(nil),         // 5: invoice.v1.UpdateDebitNoteHeaderRequest
+	(*UpdateDebitNoteHeaderResponse)(nil),        // 6: invoice.v1.UpdateDebitNoteHeaderResponse
+	(*ReviseDebitNoteRequest)(nil),               // 7: invoice.v1.ReviseDebitNoteRequest
+	(*ReviseDebitNoteResponse)(nil),              // 8: invoice.v1.ReviseDebitNoteResponse
+	(*GetDebitNoteHeaderRequest)(nil),            // 9: invoice.v1.GetDebitNoteHeaderRequest
+	(*GetDebitNoteHeaderResponse)(nil),           // 10: invoice.v1.GetDebitNoteHeaderResponse
+	(*GetDebitNoteUBLRequest)(nil),               // 11: invoice.v1.GetDebitNoteUBLRequest
+	(*GetDebitNoteUBLResponse)(nil),              // 12: invoice.v1.GetDebitNoteUBLResponse
+	(*ImportDebitNoteUBLRequest)(nil),            // 13: invoice.v1.ImportDebitNoteUBLRequest
+	(*ImportDebitNoteUBLResponse)(nil),           // 14: invoice.v1.ImportDebitNoteUBLResponse
+	(*GetDebitNoteHeaderByPkRequest)(nil),        // 15: invoice.v1.GetDebitNoteHeaderByPkRequest
+	(*GetDebitNoteHeaderByPkResponse)(nil),       // 16: invoice.v1.GetDebitNoteHeaderByPkResponse
+	(*GetDebitNoteHeadersRequest)(nil),           // 17: invoice.v1.GetDebitNoteHeadersRequest
+	(*GetDebitNoteHeadersResponse)(nil),          // 18: invoice.v1.GetDebitNoteHeadersResponse
+	(*DebitNoteLine)(nil),                        // 19: invoice.v1.DebitNoteLine
+	(*DebitNoteLineD)(nil),                       // 20: invoice.v1.DebitNoteLineD
+	(*DebitNoteLineT)(nil),                       // 21: invoice.v1.DebitNoteLineT
+	(*CreateDebitNoteLineRequest)(nil),           // 22: invoice.v1.CreateDebitNoteLineRequest
+	(*CreateDebitNoteLineResponse)(nil),          // 23: invoice.v1.CreateDebitNoteLineResponse
+	(*GetDebitNoteLinesRequest)(nil),             // 24: invoice.v1.GetDebitNoteLinesRequest
+	(*GetDebitNoteLinesResponse)(nil),            // 25: invoice.v1.GetDebitNoteLinesResponse
+	(*UpdateDebitNoteLineRequest)(nil),           // 26: invoice.v1.UpdateDebitNoteLineRequest
+	(*UpdateDebitNoteLineResponse)(nil),          // 27: invoice.v1.UpdateDebitNoteLineResponse
+	(*DeleteDebitNoteLineRequest)(nil),           // 28: invoice.v1.DeleteDebitNoteLineRequest
+	(*DeleteDebitNoteLineResponse)(nil),          // 29: invoice.v1.DeleteDebitNoteLineResponse
+	(*v1.CrUpdUser)(nil),                         // 30: common.v1.CrUpdUser
+	(*v1.CrUpdTime)(nil),                         // 31: common.v1.CrUpdTime
+	(*v1.AllowanceCharge)(nil),                   // 32: common.v1.AllowanceCharge
+	(*timestamppb.Timestamp)(nil),                // 33: google.protobuf.Timestamp
+	(*v1.CreateAllowanceChargeRequest)(nil),      // 34: common.v1.CreateAllowanceChargeRequest
+	(*v1.DocumentVersion)(nil),                   // 35: common.v1.DocumentVersion
+	(*v1.GetRequest)(nil),                        // 36: common.v1.GetRequest
+	(*v1.GetByIdRequest)(nil),                    // 37: common.v1.GetByIdRequest
+	(*TransitionDocumentStatusRequest)(nil),      // 38: invoice.v1.TransitionDocumentStatusRequest
+	(*GetDocumentStatusTransitionsRequest)(nil),  // 39: invoice.v1.GetDocumentStatusTransitionsRequest
+	(*v1.GetDocumentVersionsRequest)(nil),        // 40: common.v1.GetDocumentVersionsRequest
+	(*v1.DiffDocumentVersionsRequest)(nil),       // 41: common.v1.DiffDocumentVersionsRequest
+	(*TransitionDocumentStatusResponse)(nil),     // 42: invoice.v1.TransitionDocumentStatusResponse
+	(*GetDocumentStatusTransitionsResponse)(nil), // 43: invoice.v1.GetDocumentStatusTransitionsResponse
+	(*v1.GetDocumentVersionsResponse)(nil),       // 44: common.v1.GetDocumentVersionsResponse
+	(*v1.DiffDocumentVersionsResponse)(nil),      // 45: common.v1.DiffDocumentVersionsResponse
 }
 var file_invoice_v1_debitnote_proto_depIdxs = []int32{
 	1,  // 0: invoice.v1.DebitNoteHeader.debit_note_header_d:type_name -> invoice.v1.DebitNoteHeaderD
 	2,  // 1: invoice.v1.DebitNoteHeader.debit_note_header_t:type_name -> invoice.v1.DebitNoteHeaderT
-	20, // 2: invoice.v1.DebitNoteHeader.cr_upd_user:type_name -> common.v1.CrUpdUser
-	21, // 3: invoice.v1.DebitNoteHeader.cr_upd_time:type_name -> common.v1.CrUpdTime
-	22, // 4: invoice.v1.DebitNoteHeaderT.issue_date:type_name -> google.protobuf.Timestamp
-	22, // 5: invoice.v1.DebitNoteHeaderT.tax_point_date:type_name -> google.protobuf.Timestamp
-	22, // 6: invoice.v1.DebitNoteHeaderT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
-	22, // 7: invoice.v1.DebitNoteHeaderT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
-	22, // 8: invoice.v1.DebitNoteHeaderT.tax_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 9: invoice.v1.DebitNoteHeaderT.pricing_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 10: invoice.v1.DebitNoteHeaderT.payment_ex_date:type_name -> google.protobuf.Timestamp
-	22, // 11: invoice.v1.DebitNoteHeaderT.payment_alt_ex_date:type_name -> google.protobuf.Timestamp
-	16, // 12: invoice.v1.CreateDebitNoteHeaderRequest.debit_note_lines:type_name -> invoice.v1.CreateDebitNoteLineRequest
-	0,  // 13: invoice.v1.CreateDebitNoteHeaderResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
-	23, // 14: invoice.v1.GetDebitNoteHeaderRequest.get_request:type_name -> common.v1.GetRequest
-	0,  // 15: invoice.v1.GetDebitNoteHeaderResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
-	24, // 16: invoice.v1.GetDebitNoteHeaderByPkRequest.get_by_id_request:type_name -> common.v1.GetByIdRequest
-	0,  // 17: invoice.v1.GetDebitNoteHeaderByPkResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
-	0,  // 18: invoice.v1.GetDebitNoteHeadersResponse.debit_note_headers:type_name -> invoice.v1.DebitNoteHeader
-	14, // 19: invoice.v1.DebitNoteLine.debit_note_line_d:type_name -> invoice.v1.DebitNoteLineD
-	15, // 20: invoice.v1.DebitNoteLine.debit_note_line_t:type_name -> invoice.v1.DebitNoteLineT
-	20, // 21: invoice.v1.DebitNoteLine.cr_upd_user:type_name -> common.v1.CrUpdUser
-	21, // 22: invoice.v1.DebitNoteLine.cr_upd_time:type_name -> common.v1.CrUpdTime
-	22, // 23: invoice.v1.DebitNoteLineT.tax_point_date:type_name -> google.protobuf.Timestamp
-	22, // 24: invoice.v1.DebitNoteLineT.price_validity_period_start_date:type_name -> google.protobuf.Timestamp
-	22, // 25: invoice.v1.DebitNoteLineT.price_validity_period_end_date:type_name -> google.protobuf.Timestamp
-	13, // 26: invoice.v1.CreateDebitNoteLineResponse.debit_note_line:type_name -> invoice.v1.DebitNoteLine
-	23, // 27: invoice.v1.GetDebitNoteLinesRequest.get_request:type_name -> common.v1.GetRequest
-	13, // 28: invoice.v1.GetDebitNoteLinesResponse.debit_note_lines:type_name -> invoice.v1.DebitNoteLine
-	3,  // 29: invoice.v1.DebitNoteHeaderService.CreateDebitNoteHeader:input_type -> invoice.v1.CreateDebitNoteHeaderRequest
-	11, // 30: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaders:input_type -> invoice.v1.GetDebitNoteHeadersRequest
-	7,  // 31: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeader:input_type -> invoice.v1.GetDebitNoteHeaderRequest
-	9,  // 32: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaderByPk:input_type -> invoice.v1.GetDebitNoteHeaderByPkRequest
-	16, // 33: invoice.v1.DebitNoteHeaderService.CreateDebitNoteLine:input_type -> invoice.v1.CreateDebitNoteLineRequest
-	18, // 34: invoice.v1.DebitNoteHeaderService.GetDebitNoteLines:input_type -> invoice.v1.GetDebitNoteLinesRequest
-	5,  // 35: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteHeader:input_type -> invoice.v1.UpdateDebitNoteHeaderRequest
-	4,  // 36: invoice.v1.DebitNoteHeaderService.CreateDebitNoteHeader:output_type -> invoice.v1.CreateDebitNoteHeaderResponse
-	12, // 37: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaders:output_type -> invoice.v1.GetDebitNoteHeadersResponse
-	8,  // 38: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeader:output_type -> invoice.v1.GetDebitNoteHeaderResponse
-	10, // 39: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaderByPk:output_type -> invoice.v1.GetDebitNoteHeaderByPkResponse
-	17, // 40: invoice.v1.DebitNoteHeaderService.CreateDebitNoteLine:output_type -> invoice.v1.CreateDebitNoteLineResponse
-	19, // 41: invoice.v1.DebitNoteHeaderService.GetDebitNoteLines:output_type -> invoice.v1.GetDebitNoteLinesResponse
-	6,  // 42: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteHeader:output_type -> invoice.v1.UpdateDebitNoteHeaderResponse
-	36, // [36:43] is the sub-list for method output_type
-	29, // [29:36] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	30, // 2: invoice.v1.DebitNoteHeader.cr_upd_user:type_name -> common.v1.CrUpdUser
+	31, // 3: invoice.v1.DebitNoteHeader.cr_upd_time:type_name -> common.v1.CrUpdTime
+	32, // 4: invoice.v1.DebitNoteHeader.allowance_charges:type_name -> common.v1.AllowanceCharge
+	33, // 5: invoice.v1.DebitNoteHeaderT.issue_date:type_name -> google.protobuf.Timestamp
+	33, // 6: invoice.v1.DebitNoteHeaderT.tax_point_date:type_name -> google.protobuf.Timestamp
+	33, // 7: invoice.v1.DebitNoteHeaderT.invoice_period_start_date:type_name -> google.protobuf.Timestamp
+	33, // 8: invoice.v1.DebitNoteHeaderT.invoice_period_end_date:type_name -> google.protobuf.Timestamp
+	33, // 9: invoice.v1.DebitNoteHeaderT.tax_ex_date:type_name -> google.protobuf.Timestamp
+	33, // 10: invoice.v1.DebitNoteHeaderT.pricing_ex_date:type_name -> google.protobuf.Timestamp
+	33, // 11: invoice.v1.DebitNoteHeaderT.payment_ex_date:type_name -> google.protobuf.Timestamp
+	33, // 12: invoice.v1.DebitNoteHeaderT.payment_alt_ex_date:type_name -> google.protobuf.Timestamp
+	22, // 13: invoice.v1.CreateDebitNoteHeaderRequest.debit_note_lines:type_name -> invoice.v1.CreateDebitNoteLineRequest
+	34, // 14: invoice.v1.CreateDebitNoteHeaderRequest.allowance_charges:type_name -> common.v1.CreateAllowanceChargeRequest
+	0,  // 15: invoice.v1.CreateDebitNoteHeaderResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
+	5,  // 16: invoice.v1.ReviseDebitNoteRequest.update_debit_note_header_request:type_name -> invoice.v1.UpdateDebitNoteHeaderRequest
+	35, // 17: invoice.v1.ReviseDebitNoteResponse.document_version:type_name -> common.v1.DocumentVersion
+	36, // 18: invoice.v1.GetDebitNoteHeaderRequest.get_request:type_name -> common.v1.GetRequest
+	0,  // 19: invoice.v1.GetDebitNoteHeaderResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
+	36, // 20: invoice.v1.GetDebitNoteUBLRequest.get_request:type_name -> common.v1.GetRequest
+	0,  // 21: invoice.v1.ImportDebitNoteUBLResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
+	37, // 22: invoice.v1.GetDebitNoteHeaderByPkRequest.get_by_id_request:type_name -> common.v1.GetByIdRequest
+	0,  // 23: invoice.v1.GetDebitNoteHeaderByPkResponse.debit_note_header:type_name -> invoice.v1.DebitNoteHeader
+	0,  // 24: invoice.v1.GetDebitNoteHeadersResponse.debit_note_headers:type_name -> invoice.v1.DebitNoteHeader
+	20, // 25: invoice.v1.DebitNoteLine.debit_note_line_d:type_name -> invoice.v1.DebitNoteLineD
+	21, // 26: invoice.v1.DebitNoteLine.debit_note_line_t:type_name -> invoice.v1.DebitNoteLineT
+	30, // 27: invoice.v1.DebitNoteLine.cr_upd_user:type_name -> common.v1.CrUpdUser
+	31, // 28: invoice.v1.DebitNoteLine.cr_upd_time:type_name -> common.v1.CrUpdTime
+	32, // 29: invoice.v1.DebitNoteLine.allowance_charges:type_name -> common.v1.AllowanceCharge
+	33, // 30: invoice.v1.DebitNoteLineT.tax_point_date:type_name -> google.protobuf.Timestamp
+	33, // 31: invoice.v1.DebitNoteLineT.price_validity_period_start_date:type_name -> google.protobuf.Timestamp
+	33, // 32: invoice.v1.DebitNoteLineT.price_validity_period_end_date:type_name -> google.protobuf.Timestamp
+	34, // 33: invoice.v1.CreateDebitNoteLineRequest.allowance_charges:type_name -> common.v1.CreateAllowanceChargeRequest
+	19, // 34: invoice.v1.CreateDebitNoteLineResponse.debit_note_line:type_name -> invoice.v1.DebitNoteLine
+	36, // 35: invoice.v1.GetDebitNoteLinesRequest.get_request:type_name -> common.v1.GetRequest
+	19, // 36: invoice.v1.GetDebitNoteLinesResponse.debit_note_lines:type_name -> invoice.v1.DebitNoteLine
+	36, // 37: invoice.v1.DeleteDebitNoteLineRequest.get_request:type_name -> common.v1.GetRequest
+	3,  // 38: invoice.v1.DebitNoteHeaderService.CreateDebitNoteHeader:input_type -> invoice.v1.CreateDebitNoteHeaderRequest
+	17, // 39: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaders:input_type -> invoice.v1.GetDebitNoteHeadersRequest
+	9,  // 40: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeader:input_type -> invoice.v1.GetDebitNoteHeaderRequest
+	15, // 41: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaderByPk:input_type -> invoice.v1.GetDebitNoteHeaderByPkRequest
+	22, // 42: invoice.v1.DebitNoteHeaderService.CreateDebitNoteLine:input_type -> invoice.v1.CreateDebitNoteLineRequest
+	24, // 43: invoice.v1.DebitNoteHeaderService.GetDebitNoteLines:input_type -> invoice.v1.GetDebitNoteLinesRequest
+	26, // 44: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteLine:input_type -> invoice.v1.UpdateDebitNoteLineRequest
+	28, // 45: invoice.v1.DebitNoteHeaderService.DeleteDebitNoteLine:input_type -> invoice.v1.DeleteDebitNoteLineRequest
+	5,  // 46: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteHeader:input_type -> invoice.v1.UpdateDebitNoteHeaderRequest
+	11, // 47: invoice.v1.DebitNoteHeaderService.GetDebitNoteUBL:input_type -> invoice.v1.GetDebitNoteUBLRequest
+	13, // 48: invoice.v1.DebitNoteHeaderService.ImportDebitNoteUBL:input_type -> invoice.v1.ImportDebitNoteUBLRequest
+	38, // 49: invoice.v1.DebitNoteHeaderService.IssueDebitNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 50: invoice.v1.DebitNoteHeaderService.SendDebitNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 51: invoice.v1.DebitNoteHeaderService.AcknowledgeDebitNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 52: invoice.v1.DebitNoteHeaderService.MarkDebitNotePartiallyPaid:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 53: invoice.v1.DebitNoteHeaderService.MarkDebitNotePaid:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 54: invoice.v1.DebitNoteHeaderService.MarkDebitNoteDisputed:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	38, // 55: invoice.v1.DebitNoteHeaderService.CancelDebitNote:input_type -> invoice.v1.TransitionDocumentStatusRequest
+	39, // 56: invoice.v1.DebitNoteHeaderService.GetDebitNoteStatusTransitions:input_type -> invoice.v1.GetDocumentStatusTransitionsRequest
+	7,  // 57: invoice.v1.DebitNoteHeaderService.ReviseDebitNote:input_type -> invoice.v1.ReviseDebitNoteRequest
+	40, // 58: invoice.v1.DebitNoteHeaderService.GetDebitNoteVersions:input_type -> common.v1.GetDocumentVersionsRequest
+	41, // 59: invoice.v1.DebitNoteHeaderService.DiffDebitNoteVersions:input_type -> common.v1.DiffDocumentVersionsRequest
+	4,  // 60: invoice.v1.DebitNoteHeaderService.CreateDebitNoteHeader:output_type -> invoice.v1.CreateDebitNoteHeaderResponse
+	18, // 61: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaders:output_type -> invoice.v1.GetDebitNoteHeadersResponse
+	10, // 62: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeader:output_type -> invoice.v1.GetDebitNoteHeaderResponse
+	16, // 63: invoice.v1.DebitNoteHeaderService.GetDebitNoteHeaderByPk:output_type -> invoice.v1.GetDebitNoteHeaderByPkResponse
+	23, // 64: invoice.v1.DebitNoteHeaderService.CreateDebitNoteLine:output_type -> invoice.v1.CreateDebitNoteLineResponse
+	25, // 65: invoice.v1.DebitNoteHeaderService.GetDebitNoteLines:output_type -> invoice.v1.GetDebitNoteLinesResponse
+	27, // 66: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteLine:output_type -> invoice.v1.UpdateDebitNoteLineResponse
+	29, // 67: invoice.v1.DebitNoteHeaderService.DeleteDebitNoteLine:output_type -> invoice.v1.DeleteDebitNoteLineResponse
+	6,  // 68: invoice.v1.DebitNoteHeaderService.UpdateDebitNoteHeader:output_type -> invoice.v1.UpdateDebitNoteHeaderResponse
+	12, // 69: invoice.v1.DebitNoteHeaderService.GetDebitNoteUBL:output_type -> invoice.v1.GetDebitNoteUBLResponse
+	14, // 70: invoice.v1.DebitNoteHeaderService.ImportDebitNoteUBL:output_type -> invoice.v1.ImportDebitNoteUBLResponse
+	42, // 71: invoice.v1.DebitNoteHeaderService.IssueDebitNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 72: invoice.v1.DebitNoteHeaderService.SendDebitNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 73: invoice.v1.DebitNoteHeaderService.AcknowledgeDebitNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 74: invoice.v1.DebitNoteHeaderService.MarkDebitNotePartiallyPaid:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 75: invoice.v1.DebitNoteHeaderService.MarkDebitNotePaid:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 76: invoice.v1.DebitNoteHeaderService.MarkDebitNoteDisputed:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	42, // 77: invoice.v1.DebitNoteHeaderService.CancelDebitNote:output_type -> invoice.v1.TransitionDocumentStatusResponse
+	43, // 78: invoice.v1.DebitNoteHeaderService.GetDebitNoteStatusTransitions:output_type -> invoice.v1.GetDocumentStatusTransitionsResponse
+	8,  // 79: invoice.v1.DebitNoteHeaderService.ReviseDebitNote:output_type -> invoice.v1.ReviseDebitNoteResponse
+	44, // 80: invoice.v1.DebitNoteHeaderService.GetDebitNoteVersions:output_type -> common.v1.GetDocumentVersionsResponse
+	45, // 81: invoice.v1.DebitNoteHeaderService.DiffDebitNoteVersions:output_type -> common.v1.DiffDocumentVersionsResponse
+	60, // [60:82] is the sub-list for method output_type
+	38, // [38:60] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_invoice_v1_debitnote_proto_init() }
@@ -3554,13 +4478,14 @@ func file_invoice_v1_debitnote_proto_init() {
 	if File_invoice_v1_debitnote_proto != nil {
 		return
 	}
+	file_invoice_v1_documentstatus_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_invoice_v1_debitnote_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
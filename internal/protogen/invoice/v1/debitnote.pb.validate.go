@@ -173,6 +173,40 @@ func (m *DebitNoteHeader) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DebitNoteHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DebitNoteHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DebitNoteHeaderValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DebitNoteHeaderMultiError(errors)
 	}
@@ -401,6 +435,10 @@ func (m *DebitNoteHeaderD) validate(all bool) error {
 
 	// no validation rules for PayableAlternativeAmount
 
+	// no validation rules for DocumentStatusCode
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return DebitNoteHeaderDMultiError(errors)
 	}
@@ -1011,6 +1049,44 @@ func (m *CreateDebitNoteHeaderRequest) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateDebitNoteHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateDebitNoteHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateDebitNoteHeaderRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return CreateDebitNoteHeaderRequestMultiError(errors)
 	}
@@ -1452,22 +1528,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateDebitNoteHeaderResponseValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeaderRequest with the rules
+// Validate checks the field values on ReviseDebitNoteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeaderRequest) Validate() error {
+func (m *ReviseDebitNoteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeaderRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReviseDebitNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeaderRequestMultiError, or nil if none found.
-func (m *GetDebitNoteHeaderRequest) ValidateAll() error {
+// ReviseDebitNoteRequestMultiError, or nil if none found.
+func (m *ReviseDebitNoteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeaderRequest) validate(all bool) error {
+func (m *ReviseDebitNoteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1475,48 +1551,50 @@ func (m *GetDebitNoteHeaderRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetGetRequest()).(type) {
+		switch v := interface{}(m.GetUpdateDebitNoteHeaderRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, ReviseDebitNoteRequestValidationError{
+					field:  "UpdateDebitNoteHeaderRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, ReviseDebitNoteRequestValidationError{
+					field:  "UpdateDebitNoteHeaderRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdateDebitNoteHeaderRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetDebitNoteHeaderRequestValidationError{
-				field:  "GetRequest",
+			return ReviseDebitNoteRequestValidationError{
+				field:  "UpdateDebitNoteHeaderRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return GetDebitNoteHeaderRequestMultiError(errors)
+		return ReviseDebitNoteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeaderRequestMultiError is an error wrapping multiple validation
-// errors returned by GetDebitNoteHeaderRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetDebitNoteHeaderRequestMultiError []error
+// ReviseDebitNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by ReviseDebitNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReviseDebitNoteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeaderRequestMultiError) Error() string {
+func (m ReviseDebitNoteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1525,11 +1603,11 @@ func (m GetDebitNoteHeaderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeaderRequestMultiError) AllErrors() []error { return m }
+func (m ReviseDebitNoteRequestMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeaderRequestValidationError is the validation error returned by
-// GetDebitNoteHeaderRequest.Validate if the designated constraints aren't met.
-type GetDebitNoteHeaderRequestValidationError struct {
+// ReviseDebitNoteRequestValidationError is the validation error returned by
+// ReviseDebitNoteRequest.Validate if the designated constraints aren't met.
+type ReviseDebitNoteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1537,24 +1615,24 @@ type GetDebitNoteHeaderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeaderRequestValidationError) Field() string { return e.field }
+func (e ReviseDebitNoteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeaderRequestValidationError) Reason() string { return e.reason }
+func (e ReviseDebitNoteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeaderRequestValidationError) Cause() error { return e.cause }
+func (e ReviseDebitNoteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeaderRequestValidationError) Key() bool { return e.key }
+func (e ReviseDebitNoteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeaderRequestValidationError) ErrorName() string {
-	return "GetDebitNoteHeaderRequestValidationError"
+func (e ReviseDebitNoteRequestValidationError) ErrorName() string {
+	return "ReviseDebitNoteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeaderRequestValidationError) Error() string {
+func (e ReviseDebitNoteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1566,14 +1644,14 @@ func (e GetDebitNoteHeaderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeaderRequest.%s: %s%s",
+		"invalid %sReviseDebitNoteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeaderRequestValidationError{}
+var _ error = ReviseDebitNoteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1581,24 +1659,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeaderRequestValidationError{}
+} = ReviseDebitNoteRequestValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeaderResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReviseDebitNoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeaderResponse) Validate() error {
+func (m *ReviseDebitNoteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeaderResponse with the
+// ValidateAll checks the field values on ReviseDebitNoteResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeaderResponseMultiError, or nil if none found.
-func (m *GetDebitNoteHeaderResponse) ValidateAll() error {
+// ReviseDebitNoteResponseMultiError, or nil if none found.
+func (m *ReviseDebitNoteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeaderResponse) validate(all bool) error {
+func (m *ReviseDebitNoteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1606,28 +1684,28 @@ func (m *GetDebitNoteHeaderResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDebitNoteHeader()).(type) {
+		switch v := interface{}(m.GetDocumentVersion()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderResponseValidationError{
-					field:  "DebitNoteHeader",
+				errors = append(errors, ReviseDebitNoteResponseValidationError{
+					field:  "DocumentVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderResponseValidationError{
-					field:  "DebitNoteHeader",
+				errors = append(errors, ReviseDebitNoteResponseValidationError{
+					field:  "DocumentVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDebitNoteHeader()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDocumentVersion()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetDebitNoteHeaderResponseValidationError{
-				field:  "DebitNoteHeader",
+			return ReviseDebitNoteResponseValidationError{
+				field:  "DocumentVersion",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1635,19 +1713,19 @@ func (m *GetDebitNoteHeaderResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDebitNoteHeaderResponseMultiError(errors)
+		return ReviseDebitNoteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeaderResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDebitNoteHeaderResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDebitNoteHeaderResponseMultiError []error
+// ReviseDebitNoteResponseMultiError is an error wrapping multiple validation
+// errors returned by ReviseDebitNoteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReviseDebitNoteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeaderResponseMultiError) Error() string {
+func (m ReviseDebitNoteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1656,11 +1734,11 @@ func (m GetDebitNoteHeaderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeaderResponseMultiError) AllErrors() []error { return m }
+func (m ReviseDebitNoteResponseMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeaderResponseValidationError is the validation error returned
-// by GetDebitNoteHeaderResponse.Validate if the designated constraints aren't met.
-type GetDebitNoteHeaderResponseValidationError struct {
+// ReviseDebitNoteResponseValidationError is the validation error returned by
+// ReviseDebitNoteResponse.Validate if the designated constraints aren't met.
+type ReviseDebitNoteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1668,24 +1746,24 @@ type GetDebitNoteHeaderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeaderResponseValidationError) Field() string { return e.field }
+func (e ReviseDebitNoteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeaderResponseValidationError) Reason() string { return e.reason }
+func (e ReviseDebitNoteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeaderResponseValidationError) Cause() error { return e.cause }
+func (e ReviseDebitNoteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeaderResponseValidationError) Key() bool { return e.key }
+func (e ReviseDebitNoteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeaderResponseValidationError) ErrorName() string {
-	return "GetDebitNoteHeaderResponseValidationError"
+func (e ReviseDebitNoteResponseValidationError) ErrorName() string {
+	return "ReviseDebitNoteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeaderResponseValidationError) Error() string {
+func (e ReviseDebitNoteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1697,14 +1775,14 @@ func (e GetDebitNoteHeaderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeaderResponse.%s: %s%s",
+		"invalid %sReviseDebitNoteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeaderResponseValidationError{}
+var _ error = ReviseDebitNoteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1712,24 +1790,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeaderResponseValidationError{}
+} = ReviseDebitNoteResponseValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeaderByPkRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetDebitNoteHeaderRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeaderByPkRequest) Validate() error {
+func (m *GetDebitNoteHeaderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeaderByPkRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeaderByPkRequestMultiError, or nil if none found.
-func (m *GetDebitNoteHeaderByPkRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetDebitNoteHeaderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeaderRequestMultiError, or nil if none found.
+func (m *GetDebitNoteHeaderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeaderByPkRequest) validate(all bool) error {
+func (m *GetDebitNoteHeaderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1737,28 +1815,28 @@ func (m *GetDebitNoteHeaderByPkRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetGetByIdRequest()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderByPkRequestValidationError{
-					field:  "GetByIdRequest",
+				errors = append(errors, GetDebitNoteHeaderRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderByPkRequestValidationError{
-					field:  "GetByIdRequest",
+				errors = append(errors, GetDebitNoteHeaderRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetDebitNoteHeaderByPkRequestValidationError{
-				field:  "GetByIdRequest",
+			return GetDebitNoteHeaderRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1766,19 +1844,19 @@ func (m *GetDebitNoteHeaderByPkRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDebitNoteHeaderByPkRequestMultiError(errors)
+		return GetDebitNoteHeaderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeaderByPkRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDebitNoteHeaderByPkRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetDebitNoteHeaderByPkRequestMultiError []error
+// GetDebitNoteHeaderRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDebitNoteHeaderRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDebitNoteHeaderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeaderByPkRequestMultiError) Error() string {
+func (m GetDebitNoteHeaderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1787,12 +1865,11 @@ func (m GetDebitNoteHeaderByPkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeaderByPkRequestMultiError) AllErrors() []error { return m }
+func (m GetDebitNoteHeaderRequestMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeaderByPkRequestValidationError is the validation error
-// returned by GetDebitNoteHeaderByPkRequest.Validate if the designated
-// constraints aren't met.
-type GetDebitNoteHeaderByPkRequestValidationError struct {
+// GetDebitNoteHeaderRequestValidationError is the validation error returned by
+// GetDebitNoteHeaderRequest.Validate if the designated constraints aren't met.
+type GetDebitNoteHeaderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1800,24 +1877,24 @@ type GetDebitNoteHeaderByPkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeaderByPkRequestValidationError) Field() string { return e.field }
+func (e GetDebitNoteHeaderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeaderByPkRequestValidationError) Reason() string { return e.reason }
+func (e GetDebitNoteHeaderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeaderByPkRequestValidationError) Cause() error { return e.cause }
+func (e GetDebitNoteHeaderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeaderByPkRequestValidationError) Key() bool { return e.key }
+func (e GetDebitNoteHeaderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeaderByPkRequestValidationError) ErrorName() string {
-	return "GetDebitNoteHeaderByPkRequestValidationError"
+func (e GetDebitNoteHeaderRequestValidationError) ErrorName() string {
+	return "GetDebitNoteHeaderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeaderByPkRequestValidationError) Error() string {
+func (e GetDebitNoteHeaderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1829,14 +1906,14 @@ func (e GetDebitNoteHeaderByPkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeaderByPkRequest.%s: %s%s",
+		"invalid %sGetDebitNoteHeaderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeaderByPkRequestValidationError{}
+var _ error = GetDebitNoteHeaderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1844,24 +1921,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeaderByPkRequestValidationError{}
+} = GetDebitNoteHeaderRequestValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeaderByPkResponse with the
+// Validate checks the field values on GetDebitNoteHeaderResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeaderByPkResponse) Validate() error {
+func (m *GetDebitNoteHeaderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeaderByPkResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeaderByPkResponseMultiError, or nil if none found.
-func (m *GetDebitNoteHeaderByPkResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetDebitNoteHeaderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeaderResponseMultiError, or nil if none found.
+func (m *GetDebitNoteHeaderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
+func (m *GetDebitNoteHeaderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1872,7 +1949,7 @@ func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
 		switch v := interface{}(m.GetDebitNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderByPkResponseValidationError{
+				errors = append(errors, GetDebitNoteHeaderResponseValidationError{
 					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1880,7 +1957,7 @@ func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDebitNoteHeaderByPkResponseValidationError{
+				errors = append(errors, GetDebitNoteHeaderResponseValidationError{
 					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1889,7 +1966,7 @@ func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetDebitNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetDebitNoteHeaderByPkResponseValidationError{
+			return GetDebitNoteHeaderResponseValidationError{
 				field:  "DebitNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1898,19 +1975,19 @@ func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDebitNoteHeaderByPkResponseMultiError(errors)
+		return GetDebitNoteHeaderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeaderByPkResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDebitNoteHeaderByPkResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetDebitNoteHeaderByPkResponseMultiError []error
+// GetDebitNoteHeaderResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDebitNoteHeaderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDebitNoteHeaderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeaderByPkResponseMultiError) Error() string {
+func (m GetDebitNoteHeaderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1919,12 +1996,11 @@ func (m GetDebitNoteHeaderByPkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeaderByPkResponseMultiError) AllErrors() []error { return m }
+func (m GetDebitNoteHeaderResponseMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeaderByPkResponseValidationError is the validation error
-// returned by GetDebitNoteHeaderByPkResponse.Validate if the designated
-// constraints aren't met.
-type GetDebitNoteHeaderByPkResponseValidationError struct {
+// GetDebitNoteHeaderResponseValidationError is the validation error returned
+// by GetDebitNoteHeaderResponse.Validate if the designated constraints aren't met.
+type GetDebitNoteHeaderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1932,24 +2008,24 @@ type GetDebitNoteHeaderByPkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeaderByPkResponseValidationError) Field() string { return e.field }
+func (e GetDebitNoteHeaderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeaderByPkResponseValidationError) Reason() string { return e.reason }
+func (e GetDebitNoteHeaderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeaderByPkResponseValidationError) Cause() error { return e.cause }
+func (e GetDebitNoteHeaderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeaderByPkResponseValidationError) Key() bool { return e.key }
+func (e GetDebitNoteHeaderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeaderByPkResponseValidationError) ErrorName() string {
-	return "GetDebitNoteHeaderByPkResponseValidationError"
+func (e GetDebitNoteHeaderResponseValidationError) ErrorName() string {
+	return "GetDebitNoteHeaderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeaderByPkResponseValidationError) Error() string {
+func (e GetDebitNoteHeaderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1961,14 +2037,14 @@ func (e GetDebitNoteHeaderByPkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeaderByPkResponse.%s: %s%s",
+		"invalid %sGetDebitNoteHeaderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeaderByPkResponseValidationError{}
+var _ error = GetDebitNoteHeaderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1976,52 +2052,177 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeaderByPkResponseValidationError{}
+} = GetDebitNoteHeaderResponseValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeadersRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetDebitNoteUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeadersRequest) Validate() error {
+func (m *GetDebitNoteUBLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeadersRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDebitNoteUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeadersRequestMultiError, or nil if none found.
-func (m *GetDebitNoteHeadersRequest) ValidateAll() error {
+// GetDebitNoteUBLRequestMultiError, or nil if none found.
+func (m *GetDebitNoteUBLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeadersRequest) validate(all bool) error {
+func (m *GetDebitNoteUBLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDebitNoteUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDebitNoteUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDebitNoteUBLRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for NextCursor
+	if len(errors) > 0 {
+		return GetDebitNoteUBLRequestMultiError(errors)
+	}
 
-	// no validation rules for UserEmail
+	return nil
+}
 
-	// no validation rules for RequestId
+// GetDebitNoteUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDebitNoteUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDebitNoteUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDebitNoteUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDebitNoteUBLRequestMultiError) AllErrors() []error { return m }
+
+// GetDebitNoteUBLRequestValidationError is the validation error returned by
+// GetDebitNoteUBLRequest.Validate if the designated constraints aren't met.
+type GetDebitNoteUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDebitNoteUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDebitNoteUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDebitNoteUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDebitNoteUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDebitNoteUBLRequestValidationError) ErrorName() string {
+	return "GetDebitNoteUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDebitNoteUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDebitNoteUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDebitNoteUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDebitNoteUBLRequestValidationError{}
+
+// Validate checks the field values on GetDebitNoteUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDebitNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteUBLResponseMultiError, or nil if none found.
+func (m *GetDebitNoteUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDebitNoteUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
 
 	if len(errors) > 0 {
-		return GetDebitNoteHeadersRequestMultiError(errors)
+		return GetDebitNoteUBLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeadersRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDebitNoteHeadersRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDebitNoteHeadersRequestMultiError []error
+// GetDebitNoteUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDebitNoteUBLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDebitNoteUBLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeadersRequestMultiError) Error() string {
+func (m GetDebitNoteUBLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2030,11 +2231,11 @@ func (m GetDebitNoteHeadersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeadersRequestMultiError) AllErrors() []error { return m }
+func (m GetDebitNoteUBLResponseMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeadersRequestValidationError is the validation error returned
-// by GetDebitNoteHeadersRequest.Validate if the designated constraints aren't met.
-type GetDebitNoteHeadersRequestValidationError struct {
+// GetDebitNoteUBLResponseValidationError is the validation error returned by
+// GetDebitNoteUBLResponse.Validate if the designated constraints aren't met.
+type GetDebitNoteUBLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2042,24 +2243,24 @@ type GetDebitNoteHeadersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeadersRequestValidationError) Field() string { return e.field }
+func (e GetDebitNoteUBLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeadersRequestValidationError) Reason() string { return e.reason }
+func (e GetDebitNoteUBLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeadersRequestValidationError) Cause() error { return e.cause }
+func (e GetDebitNoteUBLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeadersRequestValidationError) Key() bool { return e.key }
+func (e GetDebitNoteUBLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeadersRequestValidationError) ErrorName() string {
-	return "GetDebitNoteHeadersRequestValidationError"
+func (e GetDebitNoteUBLResponseValidationError) ErrorName() string {
+	return "GetDebitNoteUBLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeadersRequestValidationError) Error() string {
+func (e GetDebitNoteUBLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2071,14 +2272,14 @@ func (e GetDebitNoteHeadersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeadersRequest.%s: %s%s",
+		"invalid %sGetDebitNoteUBLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeadersRequestValidationError{}
+var _ error = GetDebitNoteUBLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2086,80 +2287,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeadersRequestValidationError{}
+} = GetDebitNoteUBLResponseValidationError{}
 
-// Validate checks the field values on GetDebitNoteHeadersResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ImportDebitNoteUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteHeadersResponse) Validate() error {
+func (m *ImportDebitNoteUBLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteHeadersResponse with the
+// ValidateAll checks the field values on ImportDebitNoteUBLRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteHeadersResponseMultiError, or nil if none found.
-func (m *GetDebitNoteHeadersResponse) ValidateAll() error {
+// ImportDebitNoteUBLRequestMultiError, or nil if none found.
+func (m *ImportDebitNoteUBLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteHeadersResponse) validate(all bool) error {
+func (m *ImportDebitNoteUBLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDebitNoteHeaders() {
-		_, _ = idx, item
+	// no validation rules for Ubl
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDebitNoteHeadersResponseValidationError{
-						field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDebitNoteHeadersResponseValidationError{
-						field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetDebitNoteHeadersResponseValidationError{
-					field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for UserId
 
-	}
+	// no validation rules for UserEmail
 
-	// no validation rules for NextCursor
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
 
 	if len(errors) > 0 {
-		return GetDebitNoteHeadersResponseMultiError(errors)
+		return ImportDebitNoteUBLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteHeadersResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDebitNoteHeadersResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDebitNoteHeadersResponseMultiError []error
+// ImportDebitNoteUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportDebitNoteUBLRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportDebitNoteUBLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteHeadersResponseMultiError) Error() string {
+func (m ImportDebitNoteUBLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2168,12 +2343,11 @@ func (m GetDebitNoteHeadersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteHeadersResponseMultiError) AllErrors() []error { return m }
+func (m ImportDebitNoteUBLRequestMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteHeadersResponseValidationError is the validation error returned
-// by GetDebitNoteHeadersResponse.Validate if the designated constraints
-// aren't met.
-type GetDebitNoteHeadersResponseValidationError struct {
+// ImportDebitNoteUBLRequestValidationError is the validation error returned by
+// ImportDebitNoteUBLRequest.Validate if the designated constraints aren't met.
+type ImportDebitNoteUBLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2181,24 +2355,24 @@ type GetDebitNoteHeadersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteHeadersResponseValidationError) Field() string { return e.field }
+func (e ImportDebitNoteUBLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteHeadersResponseValidationError) Reason() string { return e.reason }
+func (e ImportDebitNoteUBLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteHeadersResponseValidationError) Cause() error { return e.cause }
+func (e ImportDebitNoteUBLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteHeadersResponseValidationError) Key() bool { return e.key }
+func (e ImportDebitNoteUBLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteHeadersResponseValidationError) ErrorName() string {
-	return "GetDebitNoteHeadersResponseValidationError"
+func (e ImportDebitNoteUBLRequestValidationError) ErrorName() string {
+	return "ImportDebitNoteUBLRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteHeadersResponseValidationError) Error() string {
+func (e ImportDebitNoteUBLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2210,14 +2384,14 @@ func (e GetDebitNoteHeadersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteHeadersResponse.%s: %s%s",
+		"invalid %sImportDebitNoteUBLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteHeadersResponseValidationError{}
+var _ error = ImportDebitNoteUBLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2225,24 +2399,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteHeadersResponseValidationError{}
+} = ImportDebitNoteUBLRequestValidationError{}
 
-// Validate checks the field values on DebitNoteLine with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DebitNoteLine) Validate() error {
+// Validate checks the field values on ImportDebitNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportDebitNoteUBLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DebitNoteLine with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DebitNoteLineMultiError, or
-// nil if none found.
-func (m *DebitNoteLine) ValidateAll() error {
+// ValidateAll checks the field values on ImportDebitNoteUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportDebitNoteUBLResponseMultiError, or nil if none found.
+func (m *ImportDebitNoteUBLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DebitNoteLine) validate(all bool) error {
+func (m *ImportDebitNoteUBLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2250,115 +2424,28 @@ func (m *DebitNoteLine) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDebitNoteLineD()).(type) {
+		switch v := interface{}(m.GetDebitNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "DebitNoteLineD",
+				errors = append(errors, ImportDebitNoteUBLResponseValidationError{
+					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "DebitNoteLineD",
+				errors = append(errors, ImportDebitNoteUBLResponseValidationError{
+					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDebitNoteLineD()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDebitNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DebitNoteLineValidationError{
-				field:  "DebitNoteLineD",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetDebitNoteLineT()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "DebitNoteLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "DebitNoteLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDebitNoteLineT()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DebitNoteLineValidationError{
-				field:  "DebitNoteLineT",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCrUpdUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DebitNoteLineValidationError{
-				field:  "CrUpdUser",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCrUpdTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "CrUpdTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineValidationError{
-					field:  "CrUpdTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DebitNoteLineValidationError{
-				field:  "CrUpdTime",
+			return ImportDebitNoteUBLResponseValidationError{
+				field:  "DebitNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2366,19 +2453,19 @@ func (m *DebitNoteLine) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DebitNoteLineMultiError(errors)
+		return ImportDebitNoteUBLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DebitNoteLineMultiError is an error wrapping multiple validation errors
-// returned by DebitNoteLine.ValidateAll() if the designated constraints
-// aren't met.
-type DebitNoteLineMultiError []error
+// ImportDebitNoteUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportDebitNoteUBLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportDebitNoteUBLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DebitNoteLineMultiError) Error() string {
+func (m ImportDebitNoteUBLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2387,11 +2474,11 @@ func (m DebitNoteLineMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DebitNoteLineMultiError) AllErrors() []error { return m }
+func (m ImportDebitNoteUBLResponseMultiError) AllErrors() []error { return m }
 
-// DebitNoteLineValidationError is the validation error returned by
-// DebitNoteLine.Validate if the designated constraints aren't met.
-type DebitNoteLineValidationError struct {
+// ImportDebitNoteUBLResponseValidationError is the validation error returned
+// by ImportDebitNoteUBLResponse.Validate if the designated constraints aren't met.
+type ImportDebitNoteUBLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2399,22 +2486,24 @@ type DebitNoteLineValidationError struct {
 }
 
 // Field function returns field value.
-func (e DebitNoteLineValidationError) Field() string { return e.field }
+func (e ImportDebitNoteUBLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DebitNoteLineValidationError) Reason() string { return e.reason }
+func (e ImportDebitNoteUBLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DebitNoteLineValidationError) Cause() error { return e.cause }
+func (e ImportDebitNoteUBLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DebitNoteLineValidationError) Key() bool { return e.key }
+func (e ImportDebitNoteUBLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DebitNoteLineValidationError) ErrorName() string { return "DebitNoteLineValidationError" }
+func (e ImportDebitNoteUBLResponseValidationError) ErrorName() string {
+	return "ImportDebitNoteUBLResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DebitNoteLineValidationError) Error() string {
+func (e ImportDebitNoteUBLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2426,14 +2515,14 @@ func (e DebitNoteLineValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDebitNoteLine.%s: %s%s",
+		"invalid %sImportDebitNoteUBLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DebitNoteLineValidationError{}
+var _ error = ImportDebitNoteUBLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2441,90 +2530,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DebitNoteLineValidationError{}
+} = ImportDebitNoteUBLResponseValidationError{}
 
-// Validate checks the field values on DebitNoteLineD with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DebitNoteLineD) Validate() error {
+// Validate checks the field values on GetDebitNoteHeaderByPkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteHeaderByPkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DebitNoteLineD with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DebitNoteLineDMultiError,
-// or nil if none found.
-func (m *DebitNoteLineD) ValidateAll() error {
+// ValidateAll checks the field values on GetDebitNoteHeaderByPkRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeaderByPkRequestMultiError, or nil if none found.
+func (m *GetDebitNoteHeaderByPkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DebitNoteLineD) validate(all bool) error {
+func (m *GetDebitNoteHeaderByPkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Uuid4
-
-	// no validation rules for IdS
-
-	// no validation rules for DnlId
-
-	// no validation rules for Note
-
-	// no validation rules for DebitedQuantity
-
-	// no validation rules for LineExtensionAmount
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for PaymentPurposeCode
-
-	// no validation rules for DiscrepancyResponse
-
-	// no validation rules for DespatchLineId
-
-	// no validation rules for ReceiptLineId
-
-	// no validation rules for BillingId
-
-	// no validation rules for ItemId
-
-	// no validation rules for PriceAmount
-
-	// no validation rules for PriceBaseQuantity
-
-	// no validation rules for PriceChangeReason
-
-	// no validation rules for PriceTypeCode
-
-	// no validation rules for PriceType
-
-	// no validation rules for OrderableUnitFactorRate
-
-	// no validation rules for PriceListId
-
-	// no validation rules for DebitNoteHeaderId
+	if all {
+		switch v := interface{}(m.GetGetByIdRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDebitNoteHeaderByPkRequestValidationError{
+					field:  "GetByIdRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDebitNoteHeaderByPkRequestValidationError{
+					field:  "GetByIdRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDebitNoteHeaderByPkRequestValidationError{
+				field:  "GetByIdRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DebitNoteLineDMultiError(errors)
+		return GetDebitNoteHeaderByPkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DebitNoteLineDMultiError is an error wrapping multiple validation errors
-// returned by DebitNoteLineD.ValidateAll() if the designated constraints
-// aren't met.
-type DebitNoteLineDMultiError []error
+// GetDebitNoteHeaderByPkRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDebitNoteHeaderByPkRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetDebitNoteHeaderByPkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DebitNoteLineDMultiError) Error() string {
+func (m GetDebitNoteHeaderByPkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2533,11 +2605,12 @@ func (m DebitNoteLineDMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DebitNoteLineDMultiError) AllErrors() []error { return m }
+func (m GetDebitNoteHeaderByPkRequestMultiError) AllErrors() []error { return m }
 
-// DebitNoteLineDValidationError is the validation error returned by
-// DebitNoteLineD.Validate if the designated constraints aren't met.
-type DebitNoteLineDValidationError struct {
+// GetDebitNoteHeaderByPkRequestValidationError is the validation error
+// returned by GetDebitNoteHeaderByPkRequest.Validate if the designated
+// constraints aren't met.
+type GetDebitNoteHeaderByPkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2545,22 +2618,24 @@ type DebitNoteLineDValidationError struct {
 }
 
 // Field function returns field value.
-func (e DebitNoteLineDValidationError) Field() string { return e.field }
+func (e GetDebitNoteHeaderByPkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DebitNoteLineDValidationError) Reason() string { return e.reason }
+func (e GetDebitNoteHeaderByPkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DebitNoteLineDValidationError) Cause() error { return e.cause }
+func (e GetDebitNoteHeaderByPkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DebitNoteLineDValidationError) Key() bool { return e.key }
+func (e GetDebitNoteHeaderByPkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DebitNoteLineDValidationError) ErrorName() string { return "DebitNoteLineDValidationError" }
+func (e GetDebitNoteHeaderByPkRequestValidationError) ErrorName() string {
+	return "GetDebitNoteHeaderByPkRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DebitNoteLineDValidationError) Error() string {
+func (e GetDebitNoteHeaderByPkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2572,14 +2647,14 @@ func (e DebitNoteLineDValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDebitNoteLineD.%s: %s%s",
+		"invalid %sGetDebitNoteHeaderByPkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DebitNoteLineDValidationError{}
+var _ error = GetDebitNoteHeaderByPkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2587,24 +2662,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DebitNoteLineDValidationError{}
+} = GetDebitNoteHeaderByPkRequestValidationError{}
 
-// Validate checks the field values on DebitNoteLineT with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DebitNoteLineT) Validate() error {
+// Validate checks the field values on GetDebitNoteHeaderByPkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteHeaderByPkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DebitNoteLineT with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DebitNoteLineTMultiError,
-// or nil if none found.
-func (m *DebitNoteLineT) ValidateAll() error {
+// ValidateAll checks the field values on GetDebitNoteHeaderByPkResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeaderByPkResponseMultiError, or nil if none found.
+func (m *GetDebitNoteHeaderByPkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DebitNoteLineT) validate(all bool) error {
+func (m *GetDebitNoteHeaderByPkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2612,86 +2687,1320 @@ func (m *DebitNoteLineT) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTaxPointDate()).(type) {
+		switch v := interface{}(m.GetDebitNoteHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "TaxPointDate",
+				errors = append(errors, GetDebitNoteHeaderByPkResponseValidationError{
+					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "TaxPointDate",
+				errors = append(errors, GetDebitNoteHeaderByPkResponseValidationError{
+					field:  "DebitNoteHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDebitNoteHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DebitNoteLineTValidationError{
-				field:  "TaxPointDate",
+			return GetDebitNoteHeaderByPkResponseValidationError{
+				field:  "DebitNoteHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(errors) > 0 {
+		return GetDebitNoteHeaderByPkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDebitNoteHeaderByPkResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDebitNoteHeaderByPkResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetDebitNoteHeaderByPkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDebitNoteHeaderByPkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDebitNoteHeaderByPkResponseMultiError) AllErrors() []error { return m }
+
+// GetDebitNoteHeaderByPkResponseValidationError is the validation error
+// returned by GetDebitNoteHeaderByPkResponse.Validate if the designated
+// constraints aren't met.
+type GetDebitNoteHeaderByPkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDebitNoteHeaderByPkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDebitNoteHeaderByPkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDebitNoteHeaderByPkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDebitNoteHeaderByPkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDebitNoteHeaderByPkResponseValidationError) ErrorName() string {
+	return "GetDebitNoteHeaderByPkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDebitNoteHeaderByPkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDebitNoteHeaderByPkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDebitNoteHeaderByPkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDebitNoteHeaderByPkResponseValidationError{}
+
+// Validate checks the field values on GetDebitNoteHeadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteHeadersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDebitNoteHeadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeadersRequestMultiError, or nil if none found.
+func (m *GetDebitNoteHeadersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDebitNoteHeadersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetDebitNoteHeadersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDebitNoteHeadersRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDebitNoteHeadersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDebitNoteHeadersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDebitNoteHeadersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDebitNoteHeadersRequestMultiError) AllErrors() []error { return m }
+
+// GetDebitNoteHeadersRequestValidationError is the validation error returned
+// by GetDebitNoteHeadersRequest.Validate if the designated constraints aren't met.
+type GetDebitNoteHeadersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDebitNoteHeadersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDebitNoteHeadersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDebitNoteHeadersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDebitNoteHeadersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDebitNoteHeadersRequestValidationError) ErrorName() string {
+	return "GetDebitNoteHeadersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDebitNoteHeadersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDebitNoteHeadersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDebitNoteHeadersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDebitNoteHeadersRequestValidationError{}
+
+// Validate checks the field values on GetDebitNoteHeadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteHeadersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDebitNoteHeadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteHeadersResponseMultiError, or nil if none found.
+func (m *GetDebitNoteHeadersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDebitNoteHeadersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDebitNoteHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDebitNoteHeadersResponseValidationError{
+						field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDebitNoteHeadersResponseValidationError{
+						field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDebitNoteHeadersResponseValidationError{
+					field:  fmt.Sprintf("DebitNoteHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetDebitNoteHeadersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDebitNoteHeadersResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDebitNoteHeadersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDebitNoteHeadersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDebitNoteHeadersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDebitNoteHeadersResponseMultiError) AllErrors() []error { return m }
+
+// GetDebitNoteHeadersResponseValidationError is the validation error returned
+// by GetDebitNoteHeadersResponse.Validate if the designated constraints
+// aren't met.
+type GetDebitNoteHeadersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDebitNoteHeadersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDebitNoteHeadersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDebitNoteHeadersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDebitNoteHeadersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDebitNoteHeadersResponseValidationError) ErrorName() string {
+	return "GetDebitNoteHeadersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDebitNoteHeadersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDebitNoteHeadersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDebitNoteHeadersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDebitNoteHeadersResponseValidationError{}
+
+// Validate checks the field values on DebitNoteLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DebitNoteLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebitNoteLine with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DebitNoteLineMultiError, or
+// nil if none found.
+func (m *DebitNoteLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebitNoteLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDebitNoteLineD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "DebitNoteLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "DebitNoteLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDebitNoteLineD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineValidationError{
+				field:  "DebitNoteLineD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDebitNoteLineT()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "DebitNoteLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "DebitNoteLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDebitNoteLineT()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineValidationError{
+				field:  "DebitNoteLineT",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DebitNoteLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DebitNoteLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DebitNoteLineValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DebitNoteLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebitNoteLineMultiError is an error wrapping multiple validation errors
+// returned by DebitNoteLine.ValidateAll() if the designated constraints
+// aren't met.
+type DebitNoteLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebitNoteLineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebitNoteLineMultiError) AllErrors() []error { return m }
+
+// DebitNoteLineValidationError is the validation error returned by
+// DebitNoteLine.Validate if the designated constraints aren't met.
+type DebitNoteLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebitNoteLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebitNoteLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebitNoteLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebitNoteLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebitNoteLineValidationError) ErrorName() string { return "DebitNoteLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DebitNoteLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebitNoteLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebitNoteLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebitNoteLineValidationError{}
+
+// Validate checks the field values on DebitNoteLineD with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DebitNoteLineD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebitNoteLineD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DebitNoteLineDMultiError,
+// or nil if none found.
+func (m *DebitNoteLineD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebitNoteLineD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for DnlId
+
+	// no validation rules for Note
+
+	// no validation rules for DebitedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for DiscrepancyResponse
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for DebitNoteHeaderId
+
+	// no validation rules for CurrencyCode
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return DebitNoteLineDMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebitNoteLineDMultiError is an error wrapping multiple validation errors
+// returned by DebitNoteLineD.ValidateAll() if the designated constraints
+// aren't met.
+type DebitNoteLineDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebitNoteLineDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebitNoteLineDMultiError) AllErrors() []error { return m }
+
+// DebitNoteLineDValidationError is the validation error returned by
+// DebitNoteLineD.Validate if the designated constraints aren't met.
+type DebitNoteLineDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebitNoteLineDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebitNoteLineDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebitNoteLineDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebitNoteLineDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebitNoteLineDValidationError) ErrorName() string { return "DebitNoteLineDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DebitNoteLineDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebitNoteLineD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebitNoteLineDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebitNoteLineDValidationError{}
+
+// Validate checks the field values on DebitNoteLineT with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DebitNoteLineT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebitNoteLineT with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DebitNoteLineTMultiError,
+// or nil if none found.
+func (m *DebitNoteLineT) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebitNoteLineT) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTaxPointDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineTValidationError{
+				field:  "TaxPointDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineTValidationError{
+				field:  "PriceValidityPeriodStartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitNoteLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitNoteLineTValidationError{
+				field:  "PriceValidityPeriodEndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DebitNoteLineTMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebitNoteLineTMultiError is an error wrapping multiple validation errors
+// returned by DebitNoteLineT.ValidateAll() if the designated constraints
+// aren't met.
+type DebitNoteLineTMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebitNoteLineTMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebitNoteLineTMultiError) AllErrors() []error { return m }
+
+// DebitNoteLineTValidationError is the validation error returned by
+// DebitNoteLineT.Validate if the designated constraints aren't met.
+type DebitNoteLineTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebitNoteLineTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebitNoteLineTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebitNoteLineTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebitNoteLineTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebitNoteLineTValidationError) ErrorName() string { return "DebitNoteLineTValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DebitNoteLineTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebitNoteLineT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebitNoteLineTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebitNoteLineTValidationError{}
+
+// Validate checks the field values on CreateDebitNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDebitNoteLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDebitNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDebitNoteLineRequestMultiError, or nil if none found.
+func (m *CreateDebitNoteLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDebitNoteLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DnlId
+
+	// no validation rules for Note
+
+	// no validation rules for DebitedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for TaxPointDate
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for DiscrepancyResponse
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceValidityPeriodStartDate
+
+	// no validation rules for PriceValidityPeriodEndDate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for DebitNoteHeaderId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateDebitNoteLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateDebitNoteLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
+				return CreateDebitNoteLineRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return CreateDebitNoteLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDebitNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateDebitNoteLineRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDebitNoteLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDebitNoteLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDebitNoteLineRequestMultiError) AllErrors() []error { return m }
+
+// CreateDebitNoteLineRequestValidationError is the validation error returned
+// by CreateDebitNoteLineRequest.Validate if the designated constraints aren't met.
+type CreateDebitNoteLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDebitNoteLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDebitNoteLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDebitNoteLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDebitNoteLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDebitNoteLineRequestValidationError) ErrorName() string {
+	return "CreateDebitNoteLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDebitNoteLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDebitNoteLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDebitNoteLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDebitNoteLineRequestValidationError{}
+
+// Validate checks the field values on CreateDebitNoteLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDebitNoteLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDebitNoteLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDebitNoteLineResponseMultiError, or nil if none found.
+func (m *CreateDebitNoteLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDebitNoteLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDebitNoteLine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDebitNoteLineResponseValidationError{
+					field:  "DebitNoteLine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDebitNoteLineResponseValidationError{
+					field:  "DebitNoteLine",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDebitNoteLine()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DebitNoteLineTValidationError{
-				field:  "PriceValidityPeriodStartDate",
+			return CreateDebitNoteLineResponseValidationError{
+				field:  "DebitNoteLine",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return CreateDebitNoteLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDebitNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDebitNoteLineResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDebitNoteLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDebitNoteLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDebitNoteLineResponseMultiError) AllErrors() []error { return m }
+
+// CreateDebitNoteLineResponseValidationError is the validation error returned
+// by CreateDebitNoteLineResponse.Validate if the designated constraints
+// aren't met.
+type CreateDebitNoteLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDebitNoteLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDebitNoteLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDebitNoteLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDebitNoteLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDebitNoteLineResponseValidationError) ErrorName() string {
+	return "CreateDebitNoteLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDebitNoteLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDebitNoteLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDebitNoteLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDebitNoteLineResponseValidationError{}
+
+// Validate checks the field values on GetDebitNoteLinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteLinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDebitNoteLinesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteLinesRequestMultiError, or nil if none found.
+func (m *GetDebitNoteLinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDebitNoteLinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
+				errors = append(errors, GetDebitNoteLinesRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DebitNoteLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
+				errors = append(errors, GetDebitNoteLinesRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DebitNoteLineTValidationError{
-				field:  "PriceValidityPeriodEndDate",
+			return GetDebitNoteLinesRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2699,19 +4008,155 @@ func (m *DebitNoteLineT) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DebitNoteLineTMultiError(errors)
+		return GetDebitNoteLinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDebitNoteLinesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDebitNoteLinesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDebitNoteLinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDebitNoteLinesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDebitNoteLinesRequestMultiError) AllErrors() []error { return m }
+
+// GetDebitNoteLinesRequestValidationError is the validation error returned by
+// GetDebitNoteLinesRequest.Validate if the designated constraints aren't met.
+type GetDebitNoteLinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDebitNoteLinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDebitNoteLinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDebitNoteLinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDebitNoteLinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDebitNoteLinesRequestValidationError) ErrorName() string {
+	return "GetDebitNoteLinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDebitNoteLinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDebitNoteLinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDebitNoteLinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDebitNoteLinesRequestValidationError{}
+
+// Validate checks the field values on GetDebitNoteLinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDebitNoteLinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDebitNoteLinesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDebitNoteLinesResponseMultiError, or nil if none found.
+func (m *GetDebitNoteLinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDebitNoteLinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDebitNoteLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDebitNoteLinesResponseValidationError{
+						field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDebitNoteLinesResponseValidationError{
+						field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDebitNoteLinesResponseValidationError{
+					field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDebitNoteLinesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DebitNoteLineTMultiError is an error wrapping multiple validation errors
-// returned by DebitNoteLineT.ValidateAll() if the designated constraints
-// aren't met.
-type DebitNoteLineTMultiError []error
+// GetDebitNoteLinesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDebitNoteLinesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetDebitNoteLinesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DebitNoteLineTMultiError) Error() string {
+func (m GetDebitNoteLinesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2720,11 +4165,11 @@ func (m DebitNoteLineTMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DebitNoteLineTMultiError) AllErrors() []error { return m }
+func (m GetDebitNoteLinesResponseMultiError) AllErrors() []error { return m }
 
-// DebitNoteLineTValidationError is the validation error returned by
-// DebitNoteLineT.Validate if the designated constraints aren't met.
-type DebitNoteLineTValidationError struct {
+// GetDebitNoteLinesResponseValidationError is the validation error returned by
+// GetDebitNoteLinesResponse.Validate if the designated constraints aren't met.
+type GetDebitNoteLinesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2732,22 +4177,24 @@ type DebitNoteLineTValidationError struct {
 }
 
 // Field function returns field value.
-func (e DebitNoteLineTValidationError) Field() string { return e.field }
+func (e GetDebitNoteLinesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DebitNoteLineTValidationError) Reason() string { return e.reason }
+func (e GetDebitNoteLinesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DebitNoteLineTValidationError) Cause() error { return e.cause }
+func (e GetDebitNoteLinesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DebitNoteLineTValidationError) Key() bool { return e.key }
+func (e GetDebitNoteLinesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DebitNoteLineTValidationError) ErrorName() string { return "DebitNoteLineTValidationError" }
+func (e GetDebitNoteLinesResponseValidationError) ErrorName() string {
+	return "GetDebitNoteLinesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DebitNoteLineTValidationError) Error() string {
+func (e GetDebitNoteLinesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2759,14 +4206,14 @@ func (e DebitNoteLineTValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDebitNoteLineT.%s: %s%s",
+		"invalid %sGetDebitNoteLinesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DebitNoteLineTValidationError{}
+var _ error = GetDebitNoteLinesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2774,75 +4221,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DebitNoteLineTValidationError{}
+} = GetDebitNoteLinesResponseValidationError{}
 
-// Validate checks the field values on CreateDebitNoteLineRequest with the
+// Validate checks the field values on UpdateDebitNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDebitNoteLineRequest) Validate() error {
+func (m *UpdateDebitNoteLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDebitNoteLineRequest with the
+// ValidateAll checks the field values on UpdateDebitNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDebitNoteLineRequestMultiError, or nil if none found.
-func (m *CreateDebitNoteLineRequest) ValidateAll() error {
+// UpdateDebitNoteLineRequestMultiError, or nil if none found.
+func (m *UpdateDebitNoteLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDebitNoteLineRequest) validate(all bool) error {
+func (m *UpdateDebitNoteLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DnlId
-
 	// no validation rules for Note
 
 	// no validation rules for DebitedQuantity
 
 	// no validation rules for LineExtensionAmount
 
-	// no validation rules for TaxPointDate
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for PaymentPurposeCode
-
-	// no validation rules for DiscrepancyResponse
-
-	// no validation rules for DespatchLineId
-
-	// no validation rules for ReceiptLineId
-
-	// no validation rules for BillingId
-
-	// no validation rules for ItemId
-
 	// no validation rules for PriceAmount
 
 	// no validation rules for PriceBaseQuantity
 
-	// no validation rules for PriceChangeReason
-
-	// no validation rules for PriceTypeCode
-
-	// no validation rules for PriceType
-
-	// no validation rules for OrderableUnitFactorRate
-
-	// no validation rules for PriceValidityPeriodStartDate
-
-	// no validation rules for PriceValidityPeriodEndDate
-
-	// no validation rules for PriceListId
+	// no validation rules for AccountingCost
 
-	// no validation rules for DebitNoteHeaderId
+	// no validation rules for Id
 
 	// no validation rules for UserId
 
@@ -2851,19 +4266,19 @@ func (m *CreateDebitNoteLineRequest) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return CreateDebitNoteLineRequestMultiError(errors)
+		return UpdateDebitNoteLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDebitNoteLineRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateDebitNoteLineRequest.ValidateAll() if
+// UpdateDebitNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateDebitNoteLineRequest.ValidateAll() if
 // the designated constraints aren't met.
-type CreateDebitNoteLineRequestMultiError []error
+type UpdateDebitNoteLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDebitNoteLineRequestMultiError) Error() string {
+func (m UpdateDebitNoteLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2872,11 +4287,11 @@ func (m CreateDebitNoteLineRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDebitNoteLineRequestMultiError) AllErrors() []error { return m }
+func (m UpdateDebitNoteLineRequestMultiError) AllErrors() []error { return m }
 
-// CreateDebitNoteLineRequestValidationError is the validation error returned
-// by CreateDebitNoteLineRequest.Validate if the designated constraints aren't met.
-type CreateDebitNoteLineRequestValidationError struct {
+// UpdateDebitNoteLineRequestValidationError is the validation error returned
+// by UpdateDebitNoteLineRequest.Validate if the designated constraints aren't met.
+type UpdateDebitNoteLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2884,24 +4299,24 @@ type CreateDebitNoteLineRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDebitNoteLineRequestValidationError) Field() string { return e.field }
+func (e UpdateDebitNoteLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDebitNoteLineRequestValidationError) Reason() string { return e.reason }
+func (e UpdateDebitNoteLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDebitNoteLineRequestValidationError) Cause() error { return e.cause }
+func (e UpdateDebitNoteLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDebitNoteLineRequestValidationError) Key() bool { return e.key }
+func (e UpdateDebitNoteLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDebitNoteLineRequestValidationError) ErrorName() string {
-	return "CreateDebitNoteLineRequestValidationError"
+func (e UpdateDebitNoteLineRequestValidationError) ErrorName() string {
+	return "UpdateDebitNoteLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDebitNoteLineRequestValidationError) Error() string {
+func (e UpdateDebitNoteLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2913,14 +4328,14 @@ func (e CreateDebitNoteLineRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDebitNoteLineRequest.%s: %s%s",
+		"invalid %sUpdateDebitNoteLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDebitNoteLineRequestValidationError{}
+var _ error = UpdateDebitNoteLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2928,73 +4343,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDebitNoteLineRequestValidationError{}
+} = UpdateDebitNoteLineRequestValidationError{}
 
-// Validate checks the field values on CreateDebitNoteLineResponse with the
+// Validate checks the field values on UpdateDebitNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDebitNoteLineResponse) Validate() error {
+func (m *UpdateDebitNoteLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDebitNoteLineResponse with the
+// ValidateAll checks the field values on UpdateDebitNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDebitNoteLineResponseMultiError, or nil if none found.
-func (m *CreateDebitNoteLineResponse) ValidateAll() error {
+// UpdateDebitNoteLineResponseMultiError, or nil if none found.
+func (m *UpdateDebitNoteLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDebitNoteLineResponse) validate(all bool) error {
+func (m *UpdateDebitNoteLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDebitNoteLine()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDebitNoteLineResponseValidationError{
-					field:  "DebitNoteLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDebitNoteLineResponseValidationError{
-					field:  "DebitNoteLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDebitNoteLine()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateDebitNoteLineResponseValidationError{
-				field:  "DebitNoteLine",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateDebitNoteLineResponseMultiError(errors)
+		return UpdateDebitNoteLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDebitNoteLineResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDebitNoteLineResponse.ValidateAll() if
+// UpdateDebitNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDebitNoteLineResponse.ValidateAll() if
 // the designated constraints aren't met.
-type CreateDebitNoteLineResponseMultiError []error
+type UpdateDebitNoteLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDebitNoteLineResponseMultiError) Error() string {
+func (m UpdateDebitNoteLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3003,12 +4389,12 @@ func (m CreateDebitNoteLineResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDebitNoteLineResponseMultiError) AllErrors() []error { return m }
+func (m UpdateDebitNoteLineResponseMultiError) AllErrors() []error { return m }
 
-// CreateDebitNoteLineResponseValidationError is the validation error returned
-// by CreateDebitNoteLineResponse.Validate if the designated constraints
+// UpdateDebitNoteLineResponseValidationError is the validation error returned
+// by UpdateDebitNoteLineResponse.Validate if the designated constraints
 // aren't met.
-type CreateDebitNoteLineResponseValidationError struct {
+type UpdateDebitNoteLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3016,24 +4402,24 @@ type CreateDebitNoteLineResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDebitNoteLineResponseValidationError) Field() string { return e.field }
+func (e UpdateDebitNoteLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDebitNoteLineResponseValidationError) Reason() string { return e.reason }
+func (e UpdateDebitNoteLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDebitNoteLineResponseValidationError) Cause() error { return e.cause }
+func (e UpdateDebitNoteLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDebitNoteLineResponseValidationError) Key() bool { return e.key }
+func (e UpdateDebitNoteLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDebitNoteLineResponseValidationError) ErrorName() string {
-	return "CreateDebitNoteLineResponseValidationError"
+func (e UpdateDebitNoteLineResponseValidationError) ErrorName() string {
+	return "UpdateDebitNoteLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDebitNoteLineResponseValidationError) Error() string {
+func (e UpdateDebitNoteLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3045,14 +4431,14 @@ func (e CreateDebitNoteLineResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDebitNoteLineResponse.%s: %s%s",
+		"invalid %sUpdateDebitNoteLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDebitNoteLineResponseValidationError{}
+var _ error = UpdateDebitNoteLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3060,24 +4446,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDebitNoteLineResponseValidationError{}
+} = UpdateDebitNoteLineResponseValidationError{}
 
-// Validate checks the field values on GetDebitNoteLinesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteDebitNoteLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteLinesRequest) Validate() error {
+func (m *DeleteDebitNoteLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteLinesRequest with the
+// ValidateAll checks the field values on DeleteDebitNoteLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteLinesRequestMultiError, or nil if none found.
-func (m *GetDebitNoteLinesRequest) ValidateAll() error {
+// DeleteDebitNoteLineRequestMultiError, or nil if none found.
+func (m *DeleteDebitNoteLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteLinesRequest) validate(all bool) error {
+func (m *DeleteDebitNoteLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3088,7 +4474,7 @@ func (m *GetDebitNoteLinesRequest) validate(all bool) error {
 		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDebitNoteLinesRequestValidationError{
+				errors = append(errors, DeleteDebitNoteLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3096,7 +4482,7 @@ func (m *GetDebitNoteLinesRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDebitNoteLinesRequestValidationError{
+				errors = append(errors, DeleteDebitNoteLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3105,7 +4491,7 @@ func (m *GetDebitNoteLinesRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetDebitNoteLinesRequestValidationError{
+			return DeleteDebitNoteLineRequestValidationError{
 				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3114,19 +4500,19 @@ func (m *GetDebitNoteLinesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDebitNoteLinesRequestMultiError(errors)
+		return DeleteDebitNoteLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteLinesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetDebitNoteLinesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetDebitNoteLinesRequestMultiError []error
+// DeleteDebitNoteLineRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteDebitNoteLineRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDebitNoteLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteLinesRequestMultiError) Error() string {
+func (m DeleteDebitNoteLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3135,11 +4521,11 @@ func (m GetDebitNoteLinesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteLinesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteDebitNoteLineRequestMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteLinesRequestValidationError is the validation error returned by
-// GetDebitNoteLinesRequest.Validate if the designated constraints aren't met.
-type GetDebitNoteLinesRequestValidationError struct {
+// DeleteDebitNoteLineRequestValidationError is the validation error returned
+// by DeleteDebitNoteLineRequest.Validate if the designated constraints aren't met.
+type DeleteDebitNoteLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3147,24 +4533,24 @@ type GetDebitNoteLinesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteLinesRequestValidationError) Field() string { return e.field }
+func (e DeleteDebitNoteLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteLinesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteDebitNoteLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteLinesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteDebitNoteLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteLinesRequestValidationError) Key() bool { return e.key }
+func (e DeleteDebitNoteLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteLinesRequestValidationError) ErrorName() string {
-	return "GetDebitNoteLinesRequestValidationError"
+func (e DeleteDebitNoteLineRequestValidationError) ErrorName() string {
+	return "DeleteDebitNoteLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteLinesRequestValidationError) Error() string {
+func (e DeleteDebitNoteLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3176,14 +4562,14 @@ func (e GetDebitNoteLinesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteLinesRequest.%s: %s%s",
+		"invalid %sDeleteDebitNoteLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteLinesRequestValidationError{}
+var _ error = DeleteDebitNoteLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3191,78 +4577,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteLinesRequestValidationError{}
+} = DeleteDebitNoteLineRequestValidationError{}
 
-// Validate checks the field values on GetDebitNoteLinesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteDebitNoteLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDebitNoteLinesResponse) Validate() error {
+func (m *DeleteDebitNoteLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDebitNoteLinesResponse with the
+// ValidateAll checks the field values on DeleteDebitNoteLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDebitNoteLinesResponseMultiError, or nil if none found.
-func (m *GetDebitNoteLinesResponse) ValidateAll() error {
+// DeleteDebitNoteLineResponseMultiError, or nil if none found.
+func (m *DeleteDebitNoteLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDebitNoteLinesResponse) validate(all bool) error {
+func (m *DeleteDebitNoteLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDebitNoteLines() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDebitNoteLinesResponseValidationError{
-						field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDebitNoteLinesResponseValidationError{
-						field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetDebitNoteLinesResponseValidationError{
-					field:  fmt.Sprintf("DebitNoteLines[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetDebitNoteLinesResponseMultiError(errors)
+		return DeleteDebitNoteLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDebitNoteLinesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetDebitNoteLinesResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetDebitNoteLinesResponseMultiError []error
+// DeleteDebitNoteLineResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDebitNoteLineResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDebitNoteLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDebitNoteLinesResponseMultiError) Error() string {
+func (m DeleteDebitNoteLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3271,11 +4623,12 @@ func (m GetDebitNoteLinesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDebitNoteLinesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteDebitNoteLineResponseMultiError) AllErrors() []error { return m }
 
-// GetDebitNoteLinesResponseValidationError is the validation error returned by
-// GetDebitNoteLinesResponse.Validate if the designated constraints aren't met.
-type GetDebitNoteLinesResponseValidationError struct {
+// DeleteDebitNoteLineResponseValidationError is the validation error returned
+// by DeleteDebitNoteLineResponse.Validate if the designated constraints
+// aren't met.
+type DeleteDebitNoteLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3283,24 +4636,24 @@ type GetDebitNoteLinesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDebitNoteLinesResponseValidationError) Field() string { return e.field }
+func (e DeleteDebitNoteLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDebitNoteLinesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteDebitNoteLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDebitNoteLinesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteDebitNoteLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDebitNoteLinesResponseValidationError) Key() bool { return e.key }
+func (e DeleteDebitNoteLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDebitNoteLinesResponseValidationError) ErrorName() string {
-	return "GetDebitNoteLinesResponseValidationError"
+func (e DeleteDebitNoteLineResponseValidationError) ErrorName() string {
+	return "DeleteDebitNoteLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDebitNoteLinesResponseValidationError) Error() string {
+func (e DeleteDebitNoteLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3312,14 +4665,14 @@ func (e GetDebitNoteLinesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDebitNoteLinesResponse.%s: %s%s",
+		"invalid %sDeleteDebitNoteLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDebitNoteLinesResponseValidationError{}
+var _ error = DeleteDebitNoteLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3327,4 +4680,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDebitNoteLinesResponseValidationError{}
+} = DeleteDebitNoteLineResponseValidationError{}
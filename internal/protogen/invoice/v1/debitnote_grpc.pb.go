@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	v1 "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,13 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DebitNoteHeaderService_CreateDebitNoteHeader_FullMethodName  = "/invoice.v1.DebitNoteHeaderService/CreateDebitNoteHeader"
-	DebitNoteHeaderService_GetDebitNoteHeaders_FullMethodName    = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeaders"
-	DebitNoteHeaderService_GetDebitNoteHeader_FullMethodName     = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeader"
-	DebitNoteHeaderService_GetDebitNoteHeaderByPk_FullMethodName = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeaderByPk"
-	DebitNoteHeaderService_CreateDebitNoteLine_FullMethodName    = "/invoice.v1.DebitNoteHeaderService/CreateDebitNoteLine"
-	DebitNoteHeaderService_GetDebitNoteLines_FullMethodName      = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteLines"
-	DebitNoteHeaderService_UpdateDebitNoteHeader_FullMethodName  = "/invoice.v1.DebitNoteHeaderService/UpdateDebitNoteHeader"
+	DebitNoteHeaderService_CreateDebitNoteHeader_FullMethodName         = "/invoice.v1.DebitNoteHeaderService/CreateDebitNoteHeader"
+	DebitNoteHeaderService_GetDebitNoteHeaders_FullMethodName           = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeaders"
+	DebitNoteHeaderService_GetDebitNoteHeader_FullMethodName            = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeader"
+	DebitNoteHeaderService_GetDebitNoteHeaderByPk_FullMethodName        = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteHeaderByPk"
+	DebitNoteHeaderService_CreateDebitNoteLine_FullMethodName           = "/invoice.v1.DebitNoteHeaderService/CreateDebitNoteLine"
+	DebitNoteHeaderService_GetDebitNoteLines_FullMethodName             = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteLines"
+	DebitNoteHeaderService_UpdateDebitNoteLine_FullMethodName           = "/invoice.v1.DebitNoteHeaderService/UpdateDebitNoteLine"
+	DebitNoteHeaderService_DeleteDebitNoteLine_FullMethodName           = "/invoice.v1.DebitNoteHeaderService/DeleteDebitNoteLine"
+	DebitNoteHeaderService_UpdateDebitNoteHeader_FullMethodName         = "/invoice.v1.DebitNoteHeaderService/UpdateDebitNoteHeader"
+	DebitNoteHeaderService_GetDebitNoteUBL_FullMethodName               = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteUBL"
+	DebitNoteHeaderService_ImportDebitNoteUBL_FullMethodName            = "/invoice.v1.DebitNoteHeaderService/ImportDebitNoteUBL"
+	DebitNoteHeaderService_IssueDebitNote_FullMethodName                = "/invoice.v1.DebitNoteHeaderService/IssueDebitNote"
+	DebitNoteHeaderService_SendDebitNote_FullMethodName                 = "/invoice.v1.DebitNoteHeaderService/SendDebitNote"
+	DebitNoteHeaderService_AcknowledgeDebitNote_FullMethodName          = "/invoice.v1.DebitNoteHeaderService/AcknowledgeDebitNote"
+	DebitNoteHeaderService_MarkDebitNotePartiallyPaid_FullMethodName    = "/invoice.v1.DebitNoteHeaderService/MarkDebitNotePartiallyPaid"
+	DebitNoteHeaderService_MarkDebitNotePaid_FullMethodName             = "/invoice.v1.DebitNoteHeaderService/MarkDebitNotePaid"
+	DebitNoteHeaderService_MarkDebitNoteDisputed_FullMethodName         = "/invoice.v1.DebitNoteHeaderService/MarkDebitNoteDisputed"
+	DebitNoteHeaderService_CancelDebitNote_FullMethodName               = "/invoice.v1.DebitNoteHeaderService/CancelDebitNote"
+	DebitNoteHeaderService_GetDebitNoteStatusTransitions_FullMethodName = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteStatusTransitions"
+	DebitNoteHeaderService_ReviseDebitNote_FullMethodName               = "/invoice.v1.DebitNoteHeaderService/ReviseDebitNote"
+	DebitNoteHeaderService_GetDebitNoteVersions_FullMethodName          = "/invoice.v1.DebitNoteHeaderService/GetDebitNoteVersions"
+	DebitNoteHeaderService_DiffDebitNoteVersions_FullMethodName         = "/invoice.v1.DebitNoteHeaderService/DiffDebitNoteVersions"
 )
 
 // DebitNoteHeaderServiceClient is the client API for DebitNoteHeaderService service.
@@ -40,7 +56,22 @@ type DebitNoteHeaderServiceClient interface {
 	GetDebitNoteHeaderByPk(ctx context.Context, in *GetDebitNoteHeaderByPkRequest, opts ...grpc.CallOption) (*GetDebitNoteHeaderByPkResponse, error)
 	CreateDebitNoteLine(ctx context.Context, in *CreateDebitNoteLineRequest, opts ...grpc.CallOption) (*CreateDebitNoteLineResponse, error)
 	GetDebitNoteLines(ctx context.Context, in *GetDebitNoteLinesRequest, opts ...grpc.CallOption) (*GetDebitNoteLinesResponse, error)
+	UpdateDebitNoteLine(ctx context.Context, in *UpdateDebitNoteLineRequest, opts ...grpc.CallOption) (*UpdateDebitNoteLineResponse, error)
+	DeleteDebitNoteLine(ctx context.Context, in *DeleteDebitNoteLineRequest, opts ...grpc.CallOption) (*DeleteDebitNoteLineResponse, error)
 	UpdateDebitNoteHeader(ctx context.Context, in *UpdateDebitNoteHeaderRequest, opts ...grpc.CallOption) (*UpdateDebitNoteHeaderResponse, error)
+	GetDebitNoteUBL(ctx context.Context, in *GetDebitNoteUBLRequest, opts ...grpc.CallOption) (*GetDebitNoteUBLResponse, error)
+	ImportDebitNoteUBL(ctx context.Context, in *ImportDebitNoteUBLRequest, opts ...grpc.CallOption) (*ImportDebitNoteUBLResponse, error)
+	IssueDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	SendDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNotePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNotePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNoteDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	CancelDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	GetDebitNoteStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseDebitNote(ctx context.Context, in *ReviseDebitNoteRequest, opts ...grpc.CallOption) (*ReviseDebitNoteResponse, error)
+	GetDebitNoteVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error)
+	DiffDebitNoteVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error)
 }
 
 type debitNoteHeaderServiceClient struct {
@@ -111,6 +142,26 @@ func (c *debitNoteHeaderServiceClient) GetDebitNoteLines(ctx context.Context, in
 	return out, nil
 }
 
+func (c *debitNoteHeaderServiceClient) UpdateDebitNoteLine(ctx context.Context, in *UpdateDebitNoteLineRequest, opts ...grpc.CallOption) (*UpdateDebitNoteLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDebitNoteLineResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_UpdateDebitNoteLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) DeleteDebitNoteLine(ctx context.Context, in *DeleteDebitNoteLineRequest, opts ...grpc.CallOption) (*DeleteDebitNoteLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDebitNoteLineResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_DeleteDebitNoteLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debitNoteHeaderServiceClient) UpdateDebitNoteHeader(ctx context.Context, in *UpdateDebitNoteHeaderRequest, opts ...grpc.CallOption) (*UpdateDebitNoteHeaderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateDebitNoteHeaderResponse)
@@ -121,6 +172,136 @@ func (c *debitNoteHeaderServiceClient) UpdateDebitNoteHeader(ctx context.Context
 	return out, nil
 }
 
+func (c *debitNoteHeaderServiceClient) GetDebitNoteUBL(ctx context.Context, in *GetDebitNoteUBLRequest, opts ...grpc.CallOption) (*GetDebitNoteUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebitNoteUBLResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_GetDebitNoteUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) ImportDebitNoteUBL(ctx context.Context, in *ImportDebitNoteUBLRequest, opts ...grpc.CallOption) (*ImportDebitNoteUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDebitNoteUBLResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_ImportDebitNoteUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) IssueDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_IssueDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) SendDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_SendDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) AcknowledgeDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_AcknowledgeDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) MarkDebitNotePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_MarkDebitNotePartiallyPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) MarkDebitNotePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_MarkDebitNotePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) MarkDebitNoteDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_MarkDebitNoteDisputed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) CancelDebitNote(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_CancelDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) GetDebitNoteStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_GetDebitNoteStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) ReviseDebitNote(ctx context.Context, in *ReviseDebitNoteRequest, opts ...grpc.CallOption) (*ReviseDebitNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviseDebitNoteResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_ReviseDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) GetDebitNoteVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_GetDebitNoteVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debitNoteHeaderServiceClient) DiffDebitNoteVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DiffDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, DebitNoteHeaderService_DiffDebitNoteVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebitNoteHeaderServiceServer is the server API for DebitNoteHeaderService service.
 // All implementations must embed UnimplementedDebitNoteHeaderServiceServer
 // for forward compatibility.
@@ -133,7 +314,22 @@ type DebitNoteHeaderServiceServer interface {
 	GetDebitNoteHeaderByPk(context.Context, *GetDebitNoteHeaderByPkRequest) (*GetDebitNoteHeaderByPkResponse, error)
 	CreateDebitNoteLine(context.Context, *CreateDebitNoteLineRequest) (*CreateDebitNoteLineResponse, error)
 	GetDebitNoteLines(context.Context, *GetDebitNoteLinesRequest) (*GetDebitNoteLinesResponse, error)
+	UpdateDebitNoteLine(context.Context, *UpdateDebitNoteLineRequest) (*UpdateDebitNoteLineResponse, error)
+	DeleteDebitNoteLine(context.Context, *DeleteDebitNoteLineRequest) (*DeleteDebitNoteLineResponse, error)
 	UpdateDebitNoteHeader(context.Context, *UpdateDebitNoteHeaderRequest) (*UpdateDebitNoteHeaderResponse, error)
+	GetDebitNoteUBL(context.Context, *GetDebitNoteUBLRequest) (*GetDebitNoteUBLResponse, error)
+	ImportDebitNoteUBL(context.Context, *ImportDebitNoteUBLRequest) (*ImportDebitNoteUBLResponse, error)
+	IssueDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	SendDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNotePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNotePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkDebitNoteDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	CancelDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	GetDebitNoteStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseDebitNote(context.Context, *ReviseDebitNoteRequest) (*ReviseDebitNoteResponse, error)
+	GetDebitNoteVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error)
+	DiffDebitNoteVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error)
 	mustEmbedUnimplementedDebitNoteHeaderServiceServer()
 }
 
@@ -162,9 +358,54 @@ func (UnimplementedDebitNoteHeaderServiceServer) CreateDebitNoteLine(context.Con
 func (UnimplementedDebitNoteHeaderServiceServer) GetDebitNoteLines(context.Context, *GetDebitNoteLinesRequest) (*GetDebitNoteLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDebitNoteLines not implemented")
 }
+func (UnimplementedDebitNoteHeaderServiceServer) UpdateDebitNoteLine(context.Context, *UpdateDebitNoteLineRequest) (*UpdateDebitNoteLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDebitNoteLine not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) DeleteDebitNoteLine(context.Context, *DeleteDebitNoteLineRequest) (*DeleteDebitNoteLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDebitNoteLine not implemented")
+}
 func (UnimplementedDebitNoteHeaderServiceServer) UpdateDebitNoteHeader(context.Context, *UpdateDebitNoteHeaderRequest) (*UpdateDebitNoteHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDebitNoteHeader not implemented")
 }
+func (UnimplementedDebitNoteHeaderServiceServer) GetDebitNoteUBL(context.Context, *GetDebitNoteUBLRequest) (*GetDebitNoteUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebitNoteUBL not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) ImportDebitNoteUBL(context.Context, *ImportDebitNoteUBLRequest) (*ImportDebitNoteUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDebitNoteUBL not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) IssueDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueDebitNote not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) SendDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDebitNote not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) AcknowledgeDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeDebitNote not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) MarkDebitNotePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDebitNotePartiallyPaid not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) MarkDebitNotePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDebitNotePaid not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) MarkDebitNoteDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDebitNoteDisputed not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) CancelDebitNote(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDebitNote not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) GetDebitNoteStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebitNoteStatusTransitions not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) ReviseDebitNote(context.Context, *ReviseDebitNoteRequest) (*ReviseDebitNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviseDebitNote not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) GetDebitNoteVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebitNoteVersions not implemented")
+}
+func (UnimplementedDebitNoteHeaderServiceServer) DiffDebitNoteVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffDebitNoteVersions not implemented")
+}
 func (UnimplementedDebitNoteHeaderServiceServer) mustEmbedUnimplementedDebitNoteHeaderServiceServer() {
 }
 func (UnimplementedDebitNoteHeaderServiceServer) testEmbeddedByValue() {}
@@ -295,6 +536,42 @@ func _DebitNoteHeaderService_GetDebitNoteLines_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebitNoteHeaderService_UpdateDebitNoteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDebitNoteLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).UpdateDebitNoteLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_UpdateDebitNoteLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).UpdateDebitNoteLine(ctx, req.(*UpdateDebitNoteLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_DeleteDebitNoteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDebitNoteLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).DeleteDebitNoteLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_DeleteDebitNoteLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).DeleteDebitNoteLine(ctx, req.(*DeleteDebitNoteLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DebitNoteHeaderService_UpdateDebitNoteHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateDebitNoteHeaderRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +590,240 @@ func _DebitNoteHeaderService_UpdateDebitNoteHeader_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebitNoteHeaderService_GetDebitNoteUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebitNoteUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_GetDebitNoteUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteUBL(ctx, req.(*GetDebitNoteUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_ImportDebitNoteUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDebitNoteUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).ImportDebitNoteUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_ImportDebitNoteUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).ImportDebitNoteUBL(ctx, req.(*ImportDebitNoteUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_IssueDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).IssueDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_IssueDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).IssueDebitNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_SendDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).SendDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_SendDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).SendDebitNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_AcknowledgeDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).AcknowledgeDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_AcknowledgeDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).AcknowledgeDebitNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_MarkDebitNotePartiallyPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNotePartiallyPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_MarkDebitNotePartiallyPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNotePartiallyPaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_MarkDebitNotePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNotePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_MarkDebitNotePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNotePaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_MarkDebitNoteDisputed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNoteDisputed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_MarkDebitNoteDisputed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).MarkDebitNoteDisputed(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_CancelDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).CancelDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_CancelDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).CancelDebitNote(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_GetDebitNoteStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_GetDebitNoteStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteStatusTransitions(ctx, req.(*GetDocumentStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_ReviseDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviseDebitNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).ReviseDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_ReviseDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).ReviseDebitNote(ctx, req.(*ReviseDebitNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_GetDebitNoteVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_GetDebitNoteVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).GetDebitNoteVersions(ctx, req.(*v1.GetDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebitNoteHeaderService_DiffDebitNoteVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DiffDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebitNoteHeaderServiceServer).DiffDebitNoteVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebitNoteHeaderService_DiffDebitNoteVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebitNoteHeaderServiceServer).DiffDebitNoteVersions(ctx, req.(*v1.DiffDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DebitNoteHeaderService_ServiceDesc is the grpc.ServiceDesc for DebitNoteHeaderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,10 +855,70 @@ var DebitNoteHeaderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDebitNoteLines",
 			Handler:    _DebitNoteHeaderService_GetDebitNoteLines_Handler,
 		},
+		{
+			MethodName: "UpdateDebitNoteLine",
+			Handler:    _DebitNoteHeaderService_UpdateDebitNoteLine_Handler,
+		},
+		{
+			MethodName: "DeleteDebitNoteLine",
+			Handler:    _DebitNoteHeaderService_DeleteDebitNoteLine_Handler,
+		},
 		{
 			MethodName: "UpdateDebitNoteHeader",
 			Handler:    _DebitNoteHeaderService_UpdateDebitNoteHeader_Handler,
 		},
+		{
+			MethodName: "GetDebitNoteUBL",
+			Handler:    _DebitNoteHeaderService_GetDebitNoteUBL_Handler,
+		},
+		{
+			MethodName: "ImportDebitNoteUBL",
+			Handler:    _DebitNoteHeaderService_ImportDebitNoteUBL_Handler,
+		},
+		{
+			MethodName: "IssueDebitNote",
+			Handler:    _DebitNoteHeaderService_IssueDebitNote_Handler,
+		},
+		{
+			MethodName: "SendDebitNote",
+			Handler:    _DebitNoteHeaderService_SendDebitNote_Handler,
+		},
+		{
+			MethodName: "AcknowledgeDebitNote",
+			Handler:    _DebitNoteHeaderService_AcknowledgeDebitNote_Handler,
+		},
+		{
+			MethodName: "MarkDebitNotePartiallyPaid",
+			Handler:    _DebitNoteHeaderService_MarkDebitNotePartiallyPaid_Handler,
+		},
+		{
+			MethodName: "MarkDebitNotePaid",
+			Handler:    _DebitNoteHeaderService_MarkDebitNotePaid_Handler,
+		},
+		{
+			MethodName: "MarkDebitNoteDisputed",
+			Handler:    _DebitNoteHeaderService_MarkDebitNoteDisputed_Handler,
+		},
+		{
+			MethodName: "CancelDebitNote",
+			Handler:    _DebitNoteHeaderService_CancelDebitNote_Handler,
+		},
+		{
+			MethodName: "GetDebitNoteStatusTransitions",
+			Handler:    _DebitNoteHeaderService_GetDebitNoteStatusTransitions_Handler,
+		},
+		{
+			MethodName: "ReviseDebitNote",
+			Handler:    _DebitNoteHeaderService_ReviseDebitNote_Handler,
+		},
+		{
+			MethodName: "GetDebitNoteVersions",
+			Handler:    _DebitNoteHeaderService_GetDebitNoteVersions_Handler,
+		},
+		{
+			MethodName: "DiffDebitNoteVersions",
+			Handler:    _DebitNoteHeaderService_DiffDebitNoteVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoice/v1/debitnote.proto",
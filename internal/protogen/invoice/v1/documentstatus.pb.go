@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: invoice/v1/documentstatus.proto
+
+package v1
+
+import (
+	v1 "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DocumentStatusTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentStatusTransitionD *DocumentStatusTransitionD `protobuf:"bytes,1,opt,name=document_status_transition_d,json=documentStatusTransitionD,proto3" json:"document_status_transition_d,omitempty"`
+	CrUpdUser                 *v1.CrUpdUser              `protobuf:"bytes,2,opt,name=cr_upd_user,json=crUpdUser,proto3" json:"cr_upd_user,omitempty"`
+	CrUpdTime                 *v1.CrUpdTime              `protobuf:"bytes,3,opt,name=cr_upd_time,json=crUpdTime,proto3" json:"cr_upd_time,omitempty"`
+}
+
+func (x *DocumentStatusTransition) Reset() {
+	*x = DocumentStatusTransition{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentStatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentStatusTransition) ProtoMessage() {}
+
+func (x *DocumentStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentStatusTransition.ProtoReflect.Descriptor instead.
+func (*DocumentStatusTransition) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DocumentStatusTransition) GetDocumentStatusTransitionD() *DocumentStatusTransitionD {
+	if x != nil {
+		return x.DocumentStatusTransitionD
+	}
+	return nil
+}
+
+func (x *DocumentStatusTransition) GetCrUpdUser() *v1.CrUpdUser {
+	if x != nil {
+		return x.CrUpdUser
+	}
+	return nil
+}
+
+func (x *DocumentStatusTransition) GetCrUpdTime() *v1.CrUpdTime {
+	if x != nil {
+		return x.CrUpdTime
+	}
+	return nil
+}
+
+type DocumentStatusTransitionD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid4          []byte `protobuf:"bytes,2,opt,name=uuid4,proto3" json:"uuid4,omitempty"`
+	IdS            string `protobuf:"bytes,3,opt,name=id_s,json=idS,proto3" json:"id_s,omitempty"`
+	MasterFlag     string `protobuf:"bytes,4,opt,name=master_flag,json=masterFlag,proto3" json:"master_flag,omitempty"`
+	MasterId       uint32 `protobuf:"varint,5,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	FromStatusCode string `protobuf:"bytes,6,opt,name=from_status_code,json=fromStatusCode,proto3" json:"from_status_code,omitempty"`
+	ToStatusCode   string `protobuf:"bytes,7,opt,name=to_status_code,json=toStatusCode,proto3" json:"to_status_code,omitempty"`
+	Reason         string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DocumentStatusTransitionD) Reset() {
+	*x = DocumentStatusTransitionD{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentStatusTransitionD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentStatusTransitionD) ProtoMessage() {}
+
+func (x *DocumentStatusTransitionD) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentStatusTransitionD.ProtoReflect.Descriptor instead.
+func (*DocumentStatusTransitionD) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DocumentStatusTransitionD) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentStatusTransitionD) GetUuid4() []byte {
+	if x != nil {
+		return x.Uuid4
+	}
+	return nil
+}
+
+func (x *DocumentStatusTransitionD) GetIdS() string {
+	if x != nil {
+		return x.IdS
+	}
+	return ""
+}
+
+func (x *DocumentStatusTransitionD) GetMasterFlag() string {
+	if x != nil {
+		return x.MasterFlag
+	}
+	return ""
+}
+
+func (x *DocumentStatusTransitionD) GetMasterId() uint32 {
+	if x != nil {
+		return x.MasterId
+	}
+	return 0
+}
+
+func (x *DocumentStatusTransitionD) GetFromStatusCode() string {
+	if x != nil {
+		return x.FromStatusCode
+	}
+	return ""
+}
+
+func (x *DocumentStatusTransitionD) GetToStatusCode() string {
+	if x != nil {
+		return x.ToStatusCode
+	}
+	return ""
+}
+
+func (x *DocumentStatusTransitionD) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TransitionDocumentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestId string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *TransitionDocumentStatusRequest) Reset() {
+	*x = TransitionDocumentStatusRequest{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionDocumentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionDocumentStatusRequest) ProtoMessage() {}
+
+func (x *TransitionDocumentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionDocumentStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransitionDocumentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransitionDocumentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionDocumentStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransitionDocumentStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TransitionDocumentStatusRequest) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *TransitionDocumentStatusRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type TransitionDocumentStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentStatusTransition *DocumentStatusTransition `protobuf:"bytes,1,opt,name=document_status_transition,json=documentStatusTransition,proto3" json:"document_status_transition,omitempty"`
+}
+
+func (x *TransitionDocumentStatusResponse) Reset() {
+	*x = TransitionDocumentStatusResponse{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionDocumentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionDocumentStatusResponse) ProtoMessage() {}
+
+func (x *TransitionDocumentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionDocumentStatusResponse.ProtoReflect.Descriptor instead.
+func (*TransitionDocumentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransitionDocumentStatusResponse) GetDocumentStatusTransition() *DocumentStatusTransition {
+	if x != nil {
+		return x.DocumentStatusTransition
+	}
+	return nil
+}
+
+type GetDocumentStatusTransitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRequest *v1.GetRequest `protobuf:"bytes,1,opt,name=get_request,json=getRequest,proto3" json:"get_request,omitempty"`
+}
+
+func (x *GetDocumentStatusTransitionsRequest) Reset() {
+	*x = GetDocumentStatusTransitionsRequest{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentStatusTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentStatusTransitionsRequest) ProtoMessage() {}
+
+func (x *GetDocumentStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDocumentStatusTransitionsRequest) GetGetRequest() *v1.GetRequest {
+	if x != nil {
+		return x.GetRequest
+	}
+	return nil
+}
+
+type GetDocumentStatusTransitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentStatusTransitions []*DocumentStatusTransition `protobuf:"bytes,1,rep,name=document_status_transitions,json=documentStatusTransitions,proto3" json:"document_status_transitions,omitempty"`
+}
+
+func (x *GetDocumentStatusTransitionsResponse) Reset() {
+	*x = GetDocumentStatusTransitionsResponse{}
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentStatusTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentStatusTransitionsResponse) ProtoMessage() {}
+
+func (x *GetDocumentStatusTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoice_v1_documentstatus_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentStatusTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_invoice_v1_documentstatus_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDocumentStatusTransitionsResponse) GetDocumentStatusTransitions() []*DocumentStatusTransition {
+	if x != nil {
+		return x.DocumentStatusTransitions
+	}
+	return nil
+}
+
+var File_invoice_v1_documentstatus_proto protoreflect.FileDescriptor
+
+var file_invoice_v1_documentstatus_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x1c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x52,
+	0x19, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72,
+	0x5f, 0x75, 0x70, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x55, 0x70,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x63, 0x72, 0x55, 0x70, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x34, 0x0a, 0x0b, 0x63, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x55, 0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x55,
+	0x70, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x19, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x34, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64,
+	0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x53, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x1f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1a, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x5d, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c,
+	0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x19, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x3c, 0x5a,
+	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x72, 0x65, 0x73, 0x63, 0x6f, 0x2f, 0x73, 0x63, 0x2d, 0x75, 0x62, 0x6c, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e,
+	0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_invoice_v1_documentstatus_proto_rawDescOnce sync.Once
+	file_invoice_v1_documentstatus_proto_rawDescData = file_invoice_v1_documentstatus_proto_rawDesc
+)
+
+func file_invoice_v1_documentstatus_proto_rawDescGZIP() []byte {
+	file_invoice_v1_documentstatus_proto_rawDescOnce.Do(func() {
+		file_invoice_v1_documentstatus_proto_rawDescData = protoimpl.X.CompressGZIP(file_invoice_v1_documentstatus_proto_rawDescData)
+	})
+	return file_invoice_v1_documentstatus_proto_rawDescData
+}
+
+var file_invoice_v1_documentstatus_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_invoice_v1_documentstatus_proto_goTypes = []any{
+	(*DocumentStatusTransition)(nil),             // 0: invoice.v1.DocumentStatusTransition
+	(*DocumentStatusTransitionD)(nil),            // 1: invoice.v1.DocumentStatusTransitionD
+	(*TransitionDocumentStatusRequest)(nil),      // 2: invoice.v1.TransitionDocumentStatusRequest
+	(*TransitionDocumentStatusResponse)(nil),     // 3: invoice.v1.TransitionDocumentStatusResponse
+	(*GetDocumentStatusTransitionsRequest)(nil),  // 4: invoice.v1.GetDocumentStatusTransitionsRequest
+	(*GetDocumentStatusTransitionsResponse)(nil), // 5: invoice.v1.GetDocumentStatusTransitionsResponse
+	(*v1.CrUpdUser)(nil),                         // 6: common.v1.CrUpdUser
+	(*v1.CrUpdTime)(nil),                         // 7: common.v1.CrUpdTime
+	(*v1.GetRequest)(nil),                        // 8: common.v1.GetRequest
+}
+var file_invoice_v1_documentstatus_proto_depIdxs = []int32{
+	1, // 0: invoice.v1.DocumentStatusTransition.document_status_transition_d:type_name -> invoice.v1.DocumentStatusTransitionD
+	6, // 1: invoice.v1.DocumentStatusTransition.cr_upd_user:type_name -> common.v1.CrUpdUser
+	7, // 2: invoice.v1.DocumentStatusTransition.cr_upd_time:type_name -> common.v1.CrUpdTime
+	0, // 3: invoice.v1.TransitionDocumentStatusResponse.document_status_transition:type_name -> invoice.v1.DocumentStatusTransition
+	8, // 4: invoice.v1.GetDocumentStatusTransitionsRequest.get_request:type_name -> common.v1.GetRequest
+	0, // 5: invoice.v1.GetDocumentStatusTransitionsResponse.document_status_transitions:type_name -> invoice.v1.DocumentStatusTransition
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_invoice_v1_documentstatus_proto_init() }
+func file_invoice_v1_documentstatus_proto_init() {
+	if File_invoice_v1_documentstatus_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_invoice_v1_documentstatus_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_invoice_v1_documentstatus_proto_goTypes,
+		DependencyIndexes: file_invoice_v1_documentstatus_proto_depIdxs,
+		MessageInfos:      file_invoice_v1_documentstatus_proto_msgTypes,
+	}.Build()
+	File_invoice_v1_documentstatus_proto = out.File
+	file_invoice_v1_documentstatus_proto_rawDesc = nil
+	file_invoice_v1_documentstatus_proto_goTypes = nil
+	file_invoice_v1_documentstatus_proto_depIdxs = nil
+}
@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: invoice/v1/documentstatus.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DocumentStatusTransition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentStatusTransition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentStatusTransition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentStatusTransitionMultiError, or nil if none found.
+func (m *DocumentStatusTransition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentStatusTransition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentStatusTransitionD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "DocumentStatusTransitionD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "DocumentStatusTransitionD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentStatusTransitionD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentStatusTransitionValidationError{
+				field:  "DocumentStatusTransitionD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentStatusTransitionValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentStatusTransitionValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentStatusTransitionValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentStatusTransitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentStatusTransitionMultiError is an error wrapping multiple validation
+// errors returned by DocumentStatusTransition.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentStatusTransitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentStatusTransitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentStatusTransitionMultiError) AllErrors() []error { return m }
+
+// DocumentStatusTransitionValidationError is the validation error returned by
+// DocumentStatusTransition.Validate if the designated constraints aren't met.
+type DocumentStatusTransitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentStatusTransitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentStatusTransitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentStatusTransitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentStatusTransitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentStatusTransitionValidationError) ErrorName() string {
+	return "DocumentStatusTransitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentStatusTransitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentStatusTransition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentStatusTransitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentStatusTransitionValidationError{}
+
+// Validate checks the field values on DocumentStatusTransitionD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentStatusTransitionD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentStatusTransitionD with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentStatusTransitionDMultiError, or nil if none found.
+func (m *DocumentStatusTransitionD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentStatusTransitionD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for MasterFlag
+
+	// no validation rules for MasterId
+
+	// no validation rules for FromStatusCode
+
+	// no validation rules for ToStatusCode
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DocumentStatusTransitionDMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentStatusTransitionDMultiError is an error wrapping multiple validation
+// errors returned by DocumentStatusTransitionD.ValidateAll() if the
+// designated constraints aren't met.
+type DocumentStatusTransitionDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentStatusTransitionDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentStatusTransitionDMultiError) AllErrors() []error { return m }
+
+// DocumentStatusTransitionDValidationError is the validation error returned by
+// DocumentStatusTransitionD.Validate if the designated constraints aren't met.
+type DocumentStatusTransitionDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentStatusTransitionDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentStatusTransitionDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentStatusTransitionDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentStatusTransitionDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentStatusTransitionDValidationError) ErrorName() string {
+	return "DocumentStatusTransitionDValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentStatusTransitionDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentStatusTransitionD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentStatusTransitionDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentStatusTransitionDValidationError{}
+
+// Validate checks the field values on TransitionDocumentStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransitionDocumentStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransitionDocumentStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TransitionDocumentStatusRequestMultiError, or nil if none found.
+func (m *TransitionDocumentStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransitionDocumentStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return TransitionDocumentStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransitionDocumentStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by TransitionDocumentStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type TransitionDocumentStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransitionDocumentStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransitionDocumentStatusRequestMultiError) AllErrors() []error { return m }
+
+// TransitionDocumentStatusRequestValidationError is the validation error
+// returned by TransitionDocumentStatusRequest.Validate if the designated
+// constraints aren't met.
+type TransitionDocumentStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransitionDocumentStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransitionDocumentStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransitionDocumentStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransitionDocumentStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransitionDocumentStatusRequestValidationError) ErrorName() string {
+	return "TransitionDocumentStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransitionDocumentStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransitionDocumentStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransitionDocumentStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransitionDocumentStatusRequestValidationError{}
+
+// Validate checks the field values on TransitionDocumentStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *TransitionDocumentStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransitionDocumentStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TransitionDocumentStatusResponseMultiError, or nil if none found.
+func (m *TransitionDocumentStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransitionDocumentStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentStatusTransition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransitionDocumentStatusResponseValidationError{
+					field:  "DocumentStatusTransition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransitionDocumentStatusResponseValidationError{
+					field:  "DocumentStatusTransition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentStatusTransition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransitionDocumentStatusResponseValidationError{
+				field:  "DocumentStatusTransition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransitionDocumentStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransitionDocumentStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// TransitionDocumentStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransitionDocumentStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransitionDocumentStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransitionDocumentStatusResponseMultiError) AllErrors() []error { return m }
+
+// TransitionDocumentStatusResponseValidationError is the validation error
+// returned by TransitionDocumentStatusResponse.Validate if the designated
+// constraints aren't met.
+type TransitionDocumentStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransitionDocumentStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransitionDocumentStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransitionDocumentStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransitionDocumentStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransitionDocumentStatusResponseValidationError) ErrorName() string {
+	return "TransitionDocumentStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransitionDocumentStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransitionDocumentStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransitionDocumentStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransitionDocumentStatusResponseValidationError{}
+
+// Validate checks the field values on GetDocumentStatusTransitionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDocumentStatusTransitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentStatusTransitionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDocumentStatusTransitionsRequestMultiError, or nil if none found.
+func (m *GetDocumentStatusTransitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentStatusTransitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDocumentStatusTransitionsRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDocumentStatusTransitionsRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDocumentStatusTransitionsRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDocumentStatusTransitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentStatusTransitionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentStatusTransitionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentStatusTransitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentStatusTransitionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentStatusTransitionsRequestMultiError) AllErrors() []error { return m }
+
+// GetDocumentStatusTransitionsRequestValidationError is the validation error
+// returned by GetDocumentStatusTransitionsRequest.Validate if the designated
+// constraints aren't met.
+type GetDocumentStatusTransitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentStatusTransitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentStatusTransitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentStatusTransitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentStatusTransitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentStatusTransitionsRequestValidationError) ErrorName() string {
+	return "GetDocumentStatusTransitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentStatusTransitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentStatusTransitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentStatusTransitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentStatusTransitionsRequestValidationError{}
+
+// Validate checks the field values on GetDocumentStatusTransitionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetDocumentStatusTransitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentStatusTransitionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDocumentStatusTransitionsResponseMultiError, or nil if none found.
+func (m *GetDocumentStatusTransitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentStatusTransitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocumentStatusTransitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDocumentStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentStatusTransitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDocumentStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("DocumentStatusTransitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDocumentStatusTransitionsResponseValidationError{
+					field:  fmt.Sprintf("DocumentStatusTransitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDocumentStatusTransitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentStatusTransitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentStatusTransitionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentStatusTransitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentStatusTransitionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentStatusTransitionsResponseMultiError) AllErrors() []error { return m }
+
+// GetDocumentStatusTransitionsResponseValidationError is the validation error
+// returned by GetDocumentStatusTransitionsResponse.Validate if the designated
+// constraints aren't met.
+type GetDocumentStatusTransitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentStatusTransitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentStatusTransitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentStatusTransitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentStatusTransitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentStatusTransitionsResponseValidationError) ErrorName() string {
+	return "GetDocumentStatusTransitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentStatusTransitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentStatusTransitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentStatusTransitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentStatusTransitionsResponseValidationError{}
@@ -173,6 +173,74 @@ func (m *InvoiceHeader) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvoiceHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvoiceHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvoiceHeaderValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrepayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvoiceHeaderValidationError{
+						field:  fmt.Sprintf("Prepayments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvoiceHeaderValidationError{
+						field:  fmt.Sprintf("Prepayments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvoiceHeaderValidationError{
+					field:  fmt.Sprintf("Prepayments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return InvoiceHeaderMultiError(errors)
 	}
@@ -401,6 +469,12 @@ func (m *InvoiceHeaderD) validate(all bool) error {
 
 	// no validation rules for PayableAlternativeAmount
 
+	// no validation rules for BuyerReference
+
+	// no validation rules for DocumentStatusCode
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return InvoiceHeaderDMultiError(errors)
 	}
@@ -1042,6 +1116,152 @@ func (m *CreateInvoiceRequest) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetTaxSubTotals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("TaxSubTotals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("TaxSubTotals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInvoiceRequestValidationError{
+					field:  fmt.Sprintf("TaxSubTotals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BuyerReference
+
+	// no validation rules for RejectMismatchedTotals
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInvoiceRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TaxCurrencyTaxAmount
+
+	for idx, item := range m.GetWithholdingTaxSubTotals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("WithholdingTaxSubTotals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("WithholdingTaxSubTotals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInvoiceRequestValidationError{
+					field:  fmt.Sprintf("WithholdingTaxSubTotals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrepayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("Prepayments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateInvoiceRequestValidationError{
+						field:  fmt.Sprintf("Prepayments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInvoiceRequestValidationError{
+					field:  fmt.Sprintf("Prepayments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return CreateInvoiceRequestMultiError(errors)
 	}
@@ -1122,22 +1342,22 @@ var _ interface {
 	ErrorName() string
 } = CreateInvoiceRequestValidationError{}
 
-// Validate checks the field values on CreateInvoiceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateInvoiceResponse) Validate() error {
+// Validate checks the field values on InvoicePrepayment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InvoicePrepayment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateInvoiceResponse with the rules
+// ValidateAll checks the field values on InvoicePrepayment with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateInvoiceResponseMultiError, or nil if none found.
-func (m *CreateInvoiceResponse) ValidateAll() error {
+// InvoicePrepaymentMultiError, or nil if none found.
+func (m *InvoicePrepayment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateInvoiceResponse) validate(all bool) error {
+func (m *InvoicePrepayment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1145,28 +1365,86 @@ func (m *CreateInvoiceResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetInvoiceHeader()).(type) {
+		switch v := interface{}(m.GetInvoicePrepaymentD()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateInvoiceResponseValidationError{
-					field:  "InvoiceHeader",
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "InvoicePrepaymentD",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateInvoiceResponseValidationError{
-					field:  "InvoiceHeader",
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "InvoicePrepaymentD",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvoicePrepaymentD()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateInvoiceResponseValidationError{
-				field:  "InvoiceHeader",
+			return InvoicePrepaymentValidationError{
+				field:  "InvoicePrepaymentD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoicePrepaymentValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoicePrepaymentValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoicePrepaymentValidationError{
+				field:  "CrUpdTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1174,19 +1452,19 @@ func (m *CreateInvoiceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateInvoiceResponseMultiError(errors)
+		return InvoicePrepaymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateInvoiceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateInvoiceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateInvoiceResponseMultiError []error
+// InvoicePrepaymentMultiError is an error wrapping multiple validation errors
+// returned by InvoicePrepayment.ValidateAll() if the designated constraints
+// aren't met.
+type InvoicePrepaymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateInvoiceResponseMultiError) Error() string {
+func (m InvoicePrepaymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1195,11 +1473,11 @@ func (m CreateInvoiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateInvoiceResponseMultiError) AllErrors() []error { return m }
+func (m InvoicePrepaymentMultiError) AllErrors() []error { return m }
 
-// CreateInvoiceResponseValidationError is the validation error returned by
-// CreateInvoiceResponse.Validate if the designated constraints aren't met.
-type CreateInvoiceResponseValidationError struct {
+// InvoicePrepaymentValidationError is the validation error returned by
+// InvoicePrepayment.Validate if the designated constraints aren't met.
+type InvoicePrepaymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1207,24 +1485,24 @@ type CreateInvoiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateInvoiceResponseValidationError) Field() string { return e.field }
+func (e InvoicePrepaymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateInvoiceResponseValidationError) Reason() string { return e.reason }
+func (e InvoicePrepaymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateInvoiceResponseValidationError) Cause() error { return e.cause }
+func (e InvoicePrepaymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateInvoiceResponseValidationError) Key() bool { return e.key }
+func (e InvoicePrepaymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateInvoiceResponseValidationError) ErrorName() string {
-	return "CreateInvoiceResponseValidationError"
+func (e InvoicePrepaymentValidationError) ErrorName() string {
+	return "InvoicePrepaymentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateInvoiceResponseValidationError) Error() string {
+func (e InvoicePrepaymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1236,14 +1514,14 @@ func (e CreateInvoiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateInvoiceResponse.%s: %s%s",
+		"invalid %sInvoicePrepayment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateInvoiceResponseValidationError{}
+var _ error = InvoicePrepaymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -1251,66 +1529,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateInvoiceResponseValidationError{}
+} = InvoicePrepaymentValidationError{}
 
-// Validate checks the field values on UpdateInvoiceRequest with the rules
+// Validate checks the field values on InvoicePrepaymentD with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateInvoiceRequest) Validate() error {
+func (m *InvoicePrepaymentD) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateInvoiceRequest with the rules
+// ValidateAll checks the field values on InvoicePrepaymentD with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateInvoiceRequestMultiError, or nil if none found.
-func (m *UpdateInvoiceRequest) ValidateAll() error {
+// InvoicePrepaymentDMultiError, or nil if none found.
+func (m *InvoicePrepaymentD) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateInvoiceRequest) validate(all bool) error {
+func (m *InvoicePrepaymentD) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Note
-
-	// no validation rules for InvoiceTypeCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for ChargeTotalAmount
-
-	// no validation rules for PrepaidAmount
+	// no validation rules for Id
 
-	// no validation rules for PayableRoundingAmount
+	// no validation rules for Uuid4
 
-	// no validation rules for PayableAmount
+	// no validation rules for IdS
 
-	// no validation rules for Id
+	// no validation rules for InvoiceHeaderId
 
-	// no validation rules for UserId
+	// no validation rules for PrepaymentInvoiceHeaderId
 
-	// no validation rules for UserEmail
+	// no validation rules for PrepaidAmount
 
-	// no validation rules for RequestId
+	// no validation rules for CurrencyCode
 
 	if len(errors) > 0 {
-		return UpdateInvoiceRequestMultiError(errors)
+		return InvoicePrepaymentDMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateInvoiceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateInvoiceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateInvoiceRequestMultiError []error
+// InvoicePrepaymentDMultiError is an error wrapping multiple validation errors
+// returned by InvoicePrepaymentD.ValidateAll() if the designated constraints
+// aren't met.
+type InvoicePrepaymentDMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateInvoiceRequestMultiError) Error() string {
+func (m InvoicePrepaymentDMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1319,11 +1589,11 @@ func (m UpdateInvoiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateInvoiceRequestMultiError) AllErrors() []error { return m }
+func (m InvoicePrepaymentDMultiError) AllErrors() []error { return m }
 
-// UpdateInvoiceRequestValidationError is the validation error returned by
-// UpdateInvoiceRequest.Validate if the designated constraints aren't met.
-type UpdateInvoiceRequestValidationError struct {
+// InvoicePrepaymentDValidationError is the validation error returned by
+// InvoicePrepaymentD.Validate if the designated constraints aren't met.
+type InvoicePrepaymentDValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1331,24 +1601,24 @@ type UpdateInvoiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateInvoiceRequestValidationError) Field() string { return e.field }
+func (e InvoicePrepaymentDValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateInvoiceRequestValidationError) Reason() string { return e.reason }
+func (e InvoicePrepaymentDValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateInvoiceRequestValidationError) Cause() error { return e.cause }
+func (e InvoicePrepaymentDValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateInvoiceRequestValidationError) Key() bool { return e.key }
+func (e InvoicePrepaymentDValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateInvoiceRequestValidationError) ErrorName() string {
-	return "UpdateInvoiceRequestValidationError"
+func (e InvoicePrepaymentDValidationError) ErrorName() string {
+	return "InvoicePrepaymentDValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateInvoiceRequestValidationError) Error() string {
+func (e InvoicePrepaymentDValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1360,14 +1630,14 @@ func (e UpdateInvoiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateInvoiceRequest.%s: %s%s",
+		"invalid %sInvoicePrepaymentD.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateInvoiceRequestValidationError{}
+var _ error = InvoicePrepaymentDValidationError{}
 
 var _ interface {
 	Field() string
@@ -1375,44 +1645,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateInvoiceRequestValidationError{}
+} = InvoicePrepaymentDValidationError{}
 
-// Validate checks the field values on UpdateInvoiceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateInvoicePrepaymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateInvoiceResponse) Validate() error {
+func (m *CreateInvoicePrepaymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateInvoiceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateInvoiceResponseMultiError, or nil if none found.
-func (m *UpdateInvoiceResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateInvoicePrepaymentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateInvoicePrepaymentRequestMultiError, or nil if none found.
+func (m *CreateInvoicePrepaymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateInvoiceResponse) validate(all bool) error {
+func (m *CreateInvoicePrepaymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PrepaymentInvoiceId
+
+	// no validation rules for PrepaidAmount
+
 	if len(errors) > 0 {
-		return UpdateInvoiceResponseMultiError(errors)
+		return CreateInvoicePrepaymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateInvoiceResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateInvoiceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateInvoiceResponseMultiError []error
+// CreateInvoicePrepaymentRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateInvoicePrepaymentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateInvoicePrepaymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateInvoiceResponseMultiError) Error() string {
+func (m CreateInvoicePrepaymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1421,11 +1695,12 @@ func (m UpdateInvoiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateInvoiceResponseMultiError) AllErrors() []error { return m }
+func (m CreateInvoicePrepaymentRequestMultiError) AllErrors() []error { return m }
 
-// UpdateInvoiceResponseValidationError is the validation error returned by
-// UpdateInvoiceResponse.Validate if the designated constraints aren't met.
-type UpdateInvoiceResponseValidationError struct {
+// CreateInvoicePrepaymentRequestValidationError is the validation error
+// returned by CreateInvoicePrepaymentRequest.Validate if the designated
+// constraints aren't met.
+type CreateInvoicePrepaymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1433,24 +1708,24 @@ type UpdateInvoiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateInvoiceResponseValidationError) Field() string { return e.field }
+func (e CreateInvoicePrepaymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateInvoiceResponseValidationError) Reason() string { return e.reason }
+func (e CreateInvoicePrepaymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateInvoiceResponseValidationError) Cause() error { return e.cause }
+func (e CreateInvoicePrepaymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateInvoiceResponseValidationError) Key() bool { return e.key }
+func (e CreateInvoicePrepaymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateInvoiceResponseValidationError) ErrorName() string {
-	return "UpdateInvoiceResponseValidationError"
+func (e CreateInvoicePrepaymentRequestValidationError) ErrorName() string {
+	return "CreateInvoicePrepaymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateInvoiceResponseValidationError) Error() string {
+func (e CreateInvoicePrepaymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1462,14 +1737,14 @@ func (e UpdateInvoiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateInvoiceResponse.%s: %s%s",
+		"invalid %sCreateInvoicePrepaymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateInvoiceResponseValidationError{}
+var _ error = CreateInvoicePrepaymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1477,24 +1752,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateInvoiceResponseValidationError{}
+} = CreateInvoicePrepaymentRequestValidationError{}
 
-// Validate checks the field values on GetInvoiceRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceRequest) Validate() error {
+// Validate checks the field values on CreateInvoiceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInvoiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceRequest with the rules
+// ValidateAll checks the field values on CreateInvoiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceRequestMultiError, or nil if none found.
-func (m *GetInvoiceRequest) ValidateAll() error {
+// CreateInvoiceResponseMultiError, or nil if none found.
+func (m *CreateInvoiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceRequest) validate(all bool) error {
+func (m *CreateInvoiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1502,28 +1777,28 @@ func (m *GetInvoiceRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetGetRequest()).(type) {
+		switch v := interface{}(m.GetInvoiceHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetInvoiceRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, CreateInvoiceResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetInvoiceRequestValidationError{
-					field:  "GetRequest",
+				errors = append(errors, CreateInvoiceResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetInvoiceRequestValidationError{
-				field:  "GetRequest",
+			return CreateInvoiceResponseValidationError{
+				field:  "InvoiceHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1531,19 +1806,19 @@ func (m *GetInvoiceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetInvoiceRequestMultiError(errors)
+		return CreateInvoiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceRequestMultiError is an error wrapping multiple validation errors
-// returned by GetInvoiceRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetInvoiceRequestMultiError []error
+// CreateInvoiceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInvoiceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInvoiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceRequestMultiError) Error() string {
+func (m CreateInvoiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1552,11 +1827,11 @@ func (m GetInvoiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceRequestMultiError) AllErrors() []error { return m }
+func (m CreateInvoiceResponseMultiError) AllErrors() []error { return m }
 
-// GetInvoiceRequestValidationError is the validation error returned by
-// GetInvoiceRequest.Validate if the designated constraints aren't met.
-type GetInvoiceRequestValidationError struct {
+// CreateInvoiceResponseValidationError is the validation error returned by
+// CreateInvoiceResponse.Validate if the designated constraints aren't met.
+type CreateInvoiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1564,24 +1839,24 @@ type GetInvoiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceRequestValidationError) Field() string { return e.field }
+func (e CreateInvoiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceRequestValidationError) Reason() string { return e.reason }
+func (e CreateInvoiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceRequestValidationError) Cause() error { return e.cause }
+func (e CreateInvoiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceRequestValidationError) Key() bool { return e.key }
+func (e CreateInvoiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceRequestValidationError) ErrorName() string {
-	return "GetInvoiceRequestValidationError"
+func (e CreateInvoiceResponseValidationError) ErrorName() string {
+	return "CreateInvoiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceRequestValidationError) Error() string {
+func (e CreateInvoiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1593,14 +1868,14 @@ func (e GetInvoiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceRequest.%s: %s%s",
+		"invalid %sCreateInvoiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceRequestValidationError{}
+var _ error = CreateInvoiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1608,73 +1883,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceRequestValidationError{}
+} = CreateInvoiceResponseValidationError{}
 
-// Validate checks the field values on GetInvoiceResponse with the rules
+// Validate checks the field values on UpdateInvoiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceResponse) Validate() error {
+func (m *UpdateInvoiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceResponse with the rules
+// ValidateAll checks the field values on UpdateInvoiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceResponseMultiError, or nil if none found.
-func (m *GetInvoiceResponse) ValidateAll() error {
+// UpdateInvoiceRequestMultiError, or nil if none found.
+func (m *UpdateInvoiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceResponse) validate(all bool) error {
+func (m *UpdateInvoiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetInvoiceHeader()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetInvoiceResponseValidationError{
-					field:  "InvoiceHeader",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetInvoiceResponseValidationError{
-					field:  "InvoiceHeader",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetInvoiceResponseValidationError{
-				field:  "InvoiceHeader",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Note
+
+	// no validation rules for InvoiceTypeCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for ChargeTotalAmount
+
+	// no validation rules for PrepaidAmount
+
+	// no validation rules for PayableRoundingAmount
+
+	// no validation rules for PayableAmount
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for RejectMismatchedTotals
 
 	if len(errors) > 0 {
-		return GetInvoiceResponseMultiError(errors)
+		return UpdateInvoiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceResponseMultiError is an error wrapping multiple validation errors
-// returned by GetInvoiceResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetInvoiceResponseMultiError []error
+// UpdateInvoiceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateInvoiceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateInvoiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceResponseMultiError) Error() string {
+func (m UpdateInvoiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1683,11 +1953,11 @@ func (m GetInvoiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceResponseMultiError) AllErrors() []error { return m }
+func (m UpdateInvoiceRequestMultiError) AllErrors() []error { return m }
 
-// GetInvoiceResponseValidationError is the validation error returned by
-// GetInvoiceResponse.Validate if the designated constraints aren't met.
-type GetInvoiceResponseValidationError struct {
+// UpdateInvoiceRequestValidationError is the validation error returned by
+// UpdateInvoiceRequest.Validate if the designated constraints aren't met.
+type UpdateInvoiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1695,24 +1965,24 @@ type GetInvoiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceResponseValidationError) Field() string { return e.field }
+func (e UpdateInvoiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceResponseValidationError) Reason() string { return e.reason }
+func (e UpdateInvoiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceResponseValidationError) Cause() error { return e.cause }
+func (e UpdateInvoiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceResponseValidationError) Key() bool { return e.key }
+func (e UpdateInvoiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceResponseValidationError) ErrorName() string {
-	return "GetInvoiceResponseValidationError"
+func (e UpdateInvoiceRequestValidationError) ErrorName() string {
+	return "UpdateInvoiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceResponseValidationError) Error() string {
+func (e UpdateInvoiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1724,14 +1994,14 @@ func (e GetInvoiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceResponse.%s: %s%s",
+		"invalid %sUpdateInvoiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceResponseValidationError{}
+var _ error = UpdateInvoiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1739,73 +2009,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceResponseValidationError{}
+} = UpdateInvoiceRequestValidationError{}
 
-// Validate checks the field values on GetInvoiceByPkRequest with the rules
+// Validate checks the field values on UpdateInvoiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceByPkRequest) Validate() error {
+func (m *UpdateInvoiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceByPkRequest with the rules
+// ValidateAll checks the field values on UpdateInvoiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceByPkRequestMultiError, or nil if none found.
-func (m *GetInvoiceByPkRequest) ValidateAll() error {
+// UpdateInvoiceResponseMultiError, or nil if none found.
+func (m *UpdateInvoiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceByPkRequest) validate(all bool) error {
+func (m *UpdateInvoiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetGetByIdRequest()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetInvoiceByPkRequestValidationError{
-					field:  "GetByIdRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetInvoiceByPkRequestValidationError{
-					field:  "GetByIdRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetInvoiceByPkRequestValidationError{
-				field:  "GetByIdRequest",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetInvoiceByPkRequestMultiError(errors)
+		return UpdateInvoiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceByPkRequestMultiError is an error wrapping multiple validation
-// errors returned by GetInvoiceByPkRequest.ValidateAll() if the designated
+// UpdateInvoiceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateInvoiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetInvoiceByPkRequestMultiError []error
+type UpdateInvoiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceByPkRequestMultiError) Error() string {
+func (m UpdateInvoiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1814,11 +2055,11 @@ func (m GetInvoiceByPkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceByPkRequestMultiError) AllErrors() []error { return m }
+func (m UpdateInvoiceResponseMultiError) AllErrors() []error { return m }
 
-// GetInvoiceByPkRequestValidationError is the validation error returned by
-// GetInvoiceByPkRequest.Validate if the designated constraints aren't met.
-type GetInvoiceByPkRequestValidationError struct {
+// UpdateInvoiceResponseValidationError is the validation error returned by
+// UpdateInvoiceResponse.Validate if the designated constraints aren't met.
+type UpdateInvoiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1826,24 +2067,24 @@ type GetInvoiceByPkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceByPkRequestValidationError) Field() string { return e.field }
+func (e UpdateInvoiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceByPkRequestValidationError) Reason() string { return e.reason }
+func (e UpdateInvoiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceByPkRequestValidationError) Cause() error { return e.cause }
+func (e UpdateInvoiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceByPkRequestValidationError) Key() bool { return e.key }
+func (e UpdateInvoiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceByPkRequestValidationError) ErrorName() string {
-	return "GetInvoiceByPkRequestValidationError"
+func (e UpdateInvoiceResponseValidationError) ErrorName() string {
+	return "UpdateInvoiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceByPkRequestValidationError) Error() string {
+func (e UpdateInvoiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1855,14 +2096,14 @@ func (e GetInvoiceByPkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceByPkRequest.%s: %s%s",
+		"invalid %sUpdateInvoiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceByPkRequestValidationError{}
+var _ error = UpdateInvoiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1870,24 +2111,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceByPkRequestValidationError{}
+} = UpdateInvoiceResponseValidationError{}
 
-// Validate checks the field values on GetInvoiceByPkResponse with the rules
+// Validate checks the field values on ReviseInvoiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceByPkResponse) Validate() error {
+func (m *ReviseInvoiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceByPkResponse with the rules
+// ValidateAll checks the field values on ReviseInvoiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceByPkResponseMultiError, or nil if none found.
-func (m *GetInvoiceByPkResponse) ValidateAll() error {
+// ReviseInvoiceRequestMultiError, or nil if none found.
+func (m *ReviseInvoiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceByPkResponse) validate(all bool) error {
+func (m *ReviseInvoiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1895,48 +2136,50 @@ func (m *GetInvoiceByPkResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetInvoiceHeader()).(type) {
+		switch v := interface{}(m.GetUpdateInvoiceRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetInvoiceByPkResponseValidationError{
-					field:  "InvoiceHeader",
+				errors = append(errors, ReviseInvoiceRequestValidationError{
+					field:  "UpdateInvoiceRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetInvoiceByPkResponseValidationError{
-					field:  "InvoiceHeader",
+				errors = append(errors, ReviseInvoiceRequestValidationError{
+					field:  "UpdateInvoiceRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdateInvoiceRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetInvoiceByPkResponseValidationError{
-				field:  "InvoiceHeader",
+			return ReviseInvoiceRequestValidationError{
+				field:  "UpdateInvoiceRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return GetInvoiceByPkResponseMultiError(errors)
+		return ReviseInvoiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceByPkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetInvoiceByPkResponse.ValidateAll() if the designated
+// ReviseInvoiceRequestMultiError is an error wrapping multiple validation
+// errors returned by ReviseInvoiceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetInvoiceByPkResponseMultiError []error
+type ReviseInvoiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceByPkResponseMultiError) Error() string {
+func (m ReviseInvoiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1945,11 +2188,11 @@ func (m GetInvoiceByPkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceByPkResponseMultiError) AllErrors() []error { return m }
+func (m ReviseInvoiceRequestMultiError) AllErrors() []error { return m }
 
-// GetInvoiceByPkResponseValidationError is the validation error returned by
-// GetInvoiceByPkResponse.Validate if the designated constraints aren't met.
-type GetInvoiceByPkResponseValidationError struct {
+// ReviseInvoiceRequestValidationError is the validation error returned by
+// ReviseInvoiceRequest.Validate if the designated constraints aren't met.
+type ReviseInvoiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1957,24 +2200,24 @@ type GetInvoiceByPkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceByPkResponseValidationError) Field() string { return e.field }
+func (e ReviseInvoiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceByPkResponseValidationError) Reason() string { return e.reason }
+func (e ReviseInvoiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceByPkResponseValidationError) Cause() error { return e.cause }
+func (e ReviseInvoiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceByPkResponseValidationError) Key() bool { return e.key }
+func (e ReviseInvoiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceByPkResponseValidationError) ErrorName() string {
-	return "GetInvoiceByPkResponseValidationError"
+func (e ReviseInvoiceRequestValidationError) ErrorName() string {
+	return "ReviseInvoiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceByPkResponseValidationError) Error() string {
+func (e ReviseInvoiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1986,14 +2229,14 @@ func (e GetInvoiceByPkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceByPkResponse.%s: %s%s",
+		"invalid %sReviseInvoiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceByPkResponseValidationError{}
+var _ error = ReviseInvoiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2001,52 +2244,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceByPkResponseValidationError{}
+} = ReviseInvoiceRequestValidationError{}
 
-// Validate checks the field values on GetInvoicesRequest with the rules
+// Validate checks the field values on ReviseInvoiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoicesRequest) Validate() error {
+func (m *ReviseInvoiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoicesRequest with the rules
+// ValidateAll checks the field values on ReviseInvoiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoicesRequestMultiError, or nil if none found.
-func (m *GetInvoicesRequest) ValidateAll() error {
+// ReviseInvoiceResponseMultiError, or nil if none found.
+func (m *ReviseInvoiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoicesRequest) validate(all bool) error {
+func (m *ReviseInvoiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
-
-	// no validation rules for NextCursor
-
-	// no validation rules for UserEmail
-
-	// no validation rules for RequestId
+	if all {
+		switch v := interface{}(m.GetDocumentVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviseInvoiceResponseValidationError{
+					field:  "DocumentVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviseInvoiceResponseValidationError{
+					field:  "DocumentVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviseInvoiceResponseValidationError{
+				field:  "DocumentVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetInvoicesRequestMultiError(errors)
+		return ReviseInvoiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoicesRequestMultiError is an error wrapping multiple validation errors
-// returned by GetInvoicesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetInvoicesRequestMultiError []error
+// ReviseInvoiceResponseMultiError is an error wrapping multiple validation
+// errors returned by ReviseInvoiceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReviseInvoiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoicesRequestMultiError) Error() string {
+func (m ReviseInvoiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2055,11 +2319,11 @@ func (m GetInvoicesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoicesRequestMultiError) AllErrors() []error { return m }
+func (m ReviseInvoiceResponseMultiError) AllErrors() []error { return m }
 
-// GetInvoicesRequestValidationError is the validation error returned by
-// GetInvoicesRequest.Validate if the designated constraints aren't met.
-type GetInvoicesRequestValidationError struct {
+// ReviseInvoiceResponseValidationError is the validation error returned by
+// ReviseInvoiceResponse.Validate if the designated constraints aren't met.
+type ReviseInvoiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2067,24 +2331,24 @@ type GetInvoicesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoicesRequestValidationError) Field() string { return e.field }
+func (e ReviseInvoiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoicesRequestValidationError) Reason() string { return e.reason }
+func (e ReviseInvoiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoicesRequestValidationError) Cause() error { return e.cause }
+func (e ReviseInvoiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoicesRequestValidationError) Key() bool { return e.key }
+func (e ReviseInvoiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoicesRequestValidationError) ErrorName() string {
-	return "GetInvoicesRequestValidationError"
+func (e ReviseInvoiceResponseValidationError) ErrorName() string {
+	return "ReviseInvoiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoicesRequestValidationError) Error() string {
+func (e ReviseInvoiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2096,14 +2360,14 @@ func (e GetInvoicesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoicesRequest.%s: %s%s",
+		"invalid %sReviseInvoiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoicesRequestValidationError{}
+var _ error = ReviseInvoiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2111,80 +2375,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoicesRequestValidationError{}
+} = ReviseInvoiceResponseValidationError{}
 
-// Validate checks the field values on GetInvoicesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoicesResponse) Validate() error {
+// Validate checks the field values on GetInvoiceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoicesResponse with the rules
+// ValidateAll checks the field values on GetInvoiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoicesResponseMultiError, or nil if none found.
-func (m *GetInvoicesResponse) ValidateAll() error {
+// GetInvoiceRequestMultiError, or nil if none found.
+func (m *GetInvoiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoicesResponse) validate(all bool) error {
+func (m *GetInvoiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvoiceHeaders() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetInvoicesResponseValidationError{
-						field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetInvoicesResponseValidationError{
-						field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvoiceRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetInvoicesResponseValidationError{
-					field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
+				errors = append(errors, GetInvoiceRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvoiceRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextCursor
-
 	if len(errors) > 0 {
-		return GetInvoicesResponseMultiError(errors)
+		return GetInvoiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoicesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetInvoicesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetInvoicesResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoicesResponseMultiError) Error() string {
+// GetInvoiceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetInvoiceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetInvoiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2193,11 +2450,11 @@ func (m GetInvoicesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoicesResponseMultiError) AllErrors() []error { return m }
+func (m GetInvoiceRequestMultiError) AllErrors() []error { return m }
 
-// GetInvoicesResponseValidationError is the validation error returned by
-// GetInvoicesResponse.Validate if the designated constraints aren't met.
-type GetInvoicesResponseValidationError struct {
+// GetInvoiceRequestValidationError is the validation error returned by
+// GetInvoiceRequest.Validate if the designated constraints aren't met.
+type GetInvoiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2205,24 +2462,24 @@ type GetInvoicesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoicesResponseValidationError) Field() string { return e.field }
+func (e GetInvoiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoicesResponseValidationError) Reason() string { return e.reason }
+func (e GetInvoiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoicesResponseValidationError) Cause() error { return e.cause }
+func (e GetInvoiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoicesResponseValidationError) Key() bool { return e.key }
+func (e GetInvoiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoicesResponseValidationError) ErrorName() string {
-	return "GetInvoicesResponseValidationError"
+func (e GetInvoiceRequestValidationError) ErrorName() string {
+	return "GetInvoiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoicesResponseValidationError) Error() string {
+func (e GetInvoiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2234,14 +2491,14 @@ func (e GetInvoicesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoicesResponse.%s: %s%s",
+		"invalid %sGetInvoiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoicesResponseValidationError{}
+var _ error = GetInvoiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2249,24 +2506,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoicesResponseValidationError{}
+} = GetInvoiceRequestValidationError{}
 
-// Validate checks the field values on InvoiceLine with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InvoiceLine) Validate() error {
+// Validate checks the field values on GetInvoiceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InvoiceLine with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InvoiceLineMultiError, or
-// nil if none found.
-func (m *InvoiceLine) ValidateAll() error {
+// ValidateAll checks the field values on GetInvoiceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceResponseMultiError, or nil if none found.
+func (m *GetInvoiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InvoiceLine) validate(all bool) error {
+func (m *GetInvoiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2274,134 +2531,181 @@ func (m *InvoiceLine) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetInvoiceLineD()).(type) {
+		switch v := interface{}(m.GetInvoiceHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "InvoiceLineD",
+				errors = append(errors, GetInvoiceResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "InvoiceLineD",
+				errors = append(errors, GetInvoiceResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetInvoiceLineD()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvoiceLineValidationError{
-				field:  "InvoiceLineD",
+			return GetInvoiceResponseValidationError{
+				field:  "InvoiceHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetInvoiceLineT()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "InvoiceLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "InvoiceLineT",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInvoiceLineT()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InvoiceLineValidationError{
-				field:  "InvoiceLineT",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return GetInvoiceResponseMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetCrUpdUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "CrUpdUser",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InvoiceLineValidationError{
-				field:  "CrUpdUser",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// GetInvoiceResponseMultiError is an error wrapping multiple validation errors
+// returned by GetInvoiceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetInvoiceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceResponseMultiError) AllErrors() []error { return m }
+
+// GetInvoiceResponseValidationError is the validation error returned by
+// GetInvoiceResponse.Validate if the designated constraints aren't met.
+type GetInvoiceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceResponseValidationError) ErrorName() string {
+	return "GetInvoiceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceResponseValidationError{}
+
+// Validate checks the field values on GetInvoiceUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceUBLRequestMultiError, or nil if none found.
+func (m *GetInvoiceUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "CrUpdTime",
+				errors = append(errors, GetInvoiceUBLRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineValidationError{
-					field:  "CrUpdTime",
+				errors = append(errors, GetInvoiceUBLRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvoiceLineValidationError{
-				field:  "CrUpdTime",
+			return GetInvoiceUBLRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Profile
+
 	if len(errors) > 0 {
-		return InvoiceLineMultiError(errors)
+		return GetInvoiceUBLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvoiceLineMultiError is an error wrapping multiple validation errors
-// returned by InvoiceLine.ValidateAll() if the designated constraints aren't met.
-type InvoiceLineMultiError []error
+// GetInvoiceUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceUBLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvoiceLineMultiError) Error() string {
+func (m GetInvoiceUBLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2410,11 +2714,11 @@ func (m InvoiceLineMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvoiceLineMultiError) AllErrors() []error { return m }
+func (m GetInvoiceUBLRequestMultiError) AllErrors() []error { return m }
 
-// InvoiceLineValidationError is the validation error returned by
-// InvoiceLine.Validate if the designated constraints aren't met.
-type InvoiceLineValidationError struct {
+// GetInvoiceUBLRequestValidationError is the validation error returned by
+// GetInvoiceUBLRequest.Validate if the designated constraints aren't met.
+type GetInvoiceUBLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2422,22 +2726,24 @@ type InvoiceLineValidationError struct {
 }
 
 // Field function returns field value.
-func (e InvoiceLineValidationError) Field() string { return e.field }
+func (e GetInvoiceUBLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvoiceLineValidationError) Reason() string { return e.reason }
+func (e GetInvoiceUBLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvoiceLineValidationError) Cause() error { return e.cause }
+func (e GetInvoiceUBLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvoiceLineValidationError) Key() bool { return e.key }
+func (e GetInvoiceUBLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvoiceLineValidationError) ErrorName() string { return "InvoiceLineValidationError" }
+func (e GetInvoiceUBLRequestValidationError) ErrorName() string {
+	return "GetInvoiceUBLRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InvoiceLineValidationError) Error() string {
+func (e GetInvoiceUBLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2449,14 +2755,14 @@ func (e InvoiceLineValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvoiceLine.%s: %s%s",
+		"invalid %sGetInvoiceUBLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvoiceLineValidationError{}
+var _ error = GetInvoiceUBLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2464,93 +2770,158 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvoiceLineValidationError{}
+} = GetInvoiceUBLRequestValidationError{}
 
-// Validate checks the field values on InvoiceLineD with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InvoiceLineD) Validate() error {
+// Validate checks the field values on GetInvoiceUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceUBLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InvoiceLineD with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InvoiceLineDMultiError, or
-// nil if none found.
-func (m *InvoiceLineD) ValidateAll() error {
+// ValidateAll checks the field values on GetInvoiceUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceUBLResponseMultiError, or nil if none found.
+func (m *GetInvoiceUBLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InvoiceLineD) validate(all bool) error {
+func (m *GetInvoiceUBLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Ubl
 
-	// no validation rules for Uuid4
+	if len(errors) > 0 {
+		return GetInvoiceUBLResponseMultiError(errors)
+	}
 
-	// no validation rules for IdS
+	return nil
+}
 
-	// no validation rules for IlId
+// GetInvoiceUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceUBLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceUBLResponseMultiError []error
 
-	// no validation rules for Note
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for InvoicedQuantity
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceUBLResponseMultiError) AllErrors() []error { return m }
 
-	// no validation rules for LineExtensionAmount
+// GetInvoiceUBLResponseValidationError is the validation error returned by
+// GetInvoiceUBLResponse.Validate if the designated constraints aren't met.
+type GetInvoiceUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for AccountingCostCode
+// Field function returns field value.
+func (e GetInvoiceUBLResponseValidationError) Field() string { return e.field }
 
-	// no validation rules for AccountingCost
+// Reason function returns reason value.
+func (e GetInvoiceUBLResponseValidationError) Reason() string { return e.reason }
 
-	// no validation rules for PaymentPurposeCode
+// Cause function returns cause value.
+func (e GetInvoiceUBLResponseValidationError) Cause() error { return e.cause }
 
-	// no validation rules for FreeOfChargeIndicator
+// Key function returns key value.
+func (e GetInvoiceUBLResponseValidationError) Key() bool { return e.key }
 
-	// no validation rules for OrderLineId
+// ErrorName returns error name.
+func (e GetInvoiceUBLResponseValidationError) ErrorName() string {
+	return "GetInvoiceUBLResponseValidationError"
+}
 
-	// no validation rules for DespatchLineId
+// Error satisfies the builtin error interface
+func (e GetInvoiceUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for ReceiptLineId
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for BillingId
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for OriginatorPartyId
+var _ error = GetInvoiceUBLResponseValidationError{}
 
-	// no validation rules for ItemId
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceUBLResponseValidationError{}
 
-	// no validation rules for PriceAmount
+// Validate checks the field values on ImportInvoiceUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportInvoiceUBLRequest) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for PriceBaseQuantity
+// ValidateAll checks the field values on ImportInvoiceUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportInvoiceUBLRequestMultiError, or nil if none found.
+func (m *ImportInvoiceUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for PriceChangeReason
+func (m *ImportInvoiceUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for PriceTypeCode
+	var errors []error
 
-	// no validation rules for PriceType
+	// no validation rules for Ubl
 
-	// no validation rules for OrderableUnitFactorRate
+	// no validation rules for UserId
 
-	// no validation rules for PriceListId
+	// no validation rules for UserEmail
 
-	// no validation rules for InvoiceHeaderId
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
 
 	if len(errors) > 0 {
-		return InvoiceLineDMultiError(errors)
+		return ImportInvoiceUBLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvoiceLineDMultiError is an error wrapping multiple validation errors
-// returned by InvoiceLineD.ValidateAll() if the designated constraints aren't met.
-type InvoiceLineDMultiError []error
+// ImportInvoiceUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportInvoiceUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportInvoiceUBLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvoiceLineDMultiError) Error() string {
+func (m ImportInvoiceUBLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2559,11 +2930,11 @@ func (m InvoiceLineDMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvoiceLineDMultiError) AllErrors() []error { return m }
+func (m ImportInvoiceUBLRequestMultiError) AllErrors() []error { return m }
 
-// InvoiceLineDValidationError is the validation error returned by
-// InvoiceLineD.Validate if the designated constraints aren't met.
-type InvoiceLineDValidationError struct {
+// ImportInvoiceUBLRequestValidationError is the validation error returned by
+// ImportInvoiceUBLRequest.Validate if the designated constraints aren't met.
+type ImportInvoiceUBLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2571,22 +2942,24 @@ type InvoiceLineDValidationError struct {
 }
 
 // Field function returns field value.
-func (e InvoiceLineDValidationError) Field() string { return e.field }
+func (e ImportInvoiceUBLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvoiceLineDValidationError) Reason() string { return e.reason }
+func (e ImportInvoiceUBLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvoiceLineDValidationError) Cause() error { return e.cause }
+func (e ImportInvoiceUBLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvoiceLineDValidationError) Key() bool { return e.key }
+func (e ImportInvoiceUBLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvoiceLineDValidationError) ErrorName() string { return "InvoiceLineDValidationError" }
+func (e ImportInvoiceUBLRequestValidationError) ErrorName() string {
+	return "ImportInvoiceUBLRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InvoiceLineDValidationError) Error() string {
+func (e ImportInvoiceUBLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2598,14 +2971,14 @@ func (e InvoiceLineDValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvoiceLineD.%s: %s%s",
+		"invalid %sImportInvoiceUBLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvoiceLineDValidationError{}
+var _ error = ImportInvoiceUBLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2613,24 +2986,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvoiceLineDValidationError{}
+} = ImportInvoiceUBLRequestValidationError{}
 
-// Validate checks the field values on InvoiceLineT with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InvoiceLineT) Validate() error {
+// Validate checks the field values on ImportInvoiceUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportInvoiceUBLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InvoiceLineT with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InvoiceLineTMultiError, or
-// nil if none found.
-func (m *InvoiceLineT) ValidateAll() error {
+// ValidateAll checks the field values on ImportInvoiceUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportInvoiceUBLResponseMultiError, or nil if none found.
+func (m *ImportInvoiceUBLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InvoiceLineT) validate(all bool) error {
+func (m *ImportInvoiceUBLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2638,163 +3011,3040 @@ func (m *InvoiceLineT) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTaxPointDate()).(type) {
+		switch v := interface{}(m.GetInvoiceHeader()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "TaxPointDate",
+				errors = append(errors, ImportInvoiceUBLResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "TaxPointDate",
+				errors = append(errors, ImportInvoiceUBLResponseValidationError{
+					field:  "InvoiceHeader",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvoiceLineTValidationError{
-				field:  "TaxPointDate",
+			return ImportInvoiceUBLResponseValidationError{
+				field:  "InvoiceHeader",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return ImportInvoiceUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportInvoiceUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportInvoiceUBLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportInvoiceUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportInvoiceUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportInvoiceUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportInvoiceUBLResponseValidationError is the validation error returned by
+// ImportInvoiceUBLResponse.Validate if the designated constraints aren't met.
+type ImportInvoiceUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportInvoiceUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportInvoiceUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportInvoiceUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportInvoiceUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportInvoiceUBLResponseValidationError) ErrorName() string {
+	return "ImportInvoiceUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportInvoiceUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportInvoiceUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportInvoiceUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportInvoiceUBLResponseValidationError{}
+
+// Validate checks the field values on GetInvoiceCIIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceCIIRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceCIIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceCIIRequestMultiError, or nil if none found.
+func (m *GetInvoiceCIIRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceCIIRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetInvoicePeriodStartDate()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "InvoicePeriodStartDate",
+				errors = append(errors, GetInvoiceCIIRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "InvoicePeriodStartDate",
+				errors = append(errors, GetInvoiceCIIRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetInvoicePeriodStartDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvoiceLineTValidationError{
-				field:  "InvoicePeriodStartDate",
+			return GetInvoiceCIIRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetInvoicePeriodEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "InvoicePeriodEndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "InvoicePeriodEndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInvoicePeriodEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InvoiceLineTValidationError{
-				field:  "InvoicePeriodEndDate",
+	if len(errors) > 0 {
+		return GetInvoiceCIIRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceCIIRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceCIIRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceCIIRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceCIIRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceCIIRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoiceCIIRequestValidationError is the validation error returned by
+// GetInvoiceCIIRequest.Validate if the designated constraints aren't met.
+type GetInvoiceCIIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceCIIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceCIIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceCIIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceCIIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceCIIRequestValidationError) ErrorName() string {
+	return "GetInvoiceCIIRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceCIIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceCIIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceCIIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceCIIRequestValidationError{}
+
+// Validate checks the field values on GetInvoiceCIIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceCIIResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceCIIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceCIIResponseMultiError, or nil if none found.
+func (m *GetInvoiceCIIResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceCIIResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cii
+
+	if len(errors) > 0 {
+		return GetInvoiceCIIResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceCIIResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceCIIResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceCIIResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceCIIResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceCIIResponseMultiError) AllErrors() []error { return m }
+
+// GetInvoiceCIIResponseValidationError is the validation error returned by
+// GetInvoiceCIIResponse.Validate if the designated constraints aren't met.
+type GetInvoiceCIIResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceCIIResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceCIIResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceCIIResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceCIIResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceCIIResponseValidationError) ErrorName() string {
+	return "GetInvoiceCIIResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceCIIResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceCIIResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceCIIResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceCIIResponseValidationError{}
+
+// Validate checks the field values on ImportInvoiceCIIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportInvoiceCIIRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportInvoiceCIIRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportInvoiceCIIRequestMultiError, or nil if none found.
+func (m *ImportInvoiceCIIRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportInvoiceCIIRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cii
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportInvoiceCIIRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportInvoiceCIIRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportInvoiceCIIRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportInvoiceCIIRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportInvoiceCIIRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportInvoiceCIIRequestMultiError) AllErrors() []error { return m }
+
+// ImportInvoiceCIIRequestValidationError is the validation error returned by
+// ImportInvoiceCIIRequest.Validate if the designated constraints aren't met.
+type ImportInvoiceCIIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportInvoiceCIIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportInvoiceCIIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportInvoiceCIIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportInvoiceCIIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportInvoiceCIIRequestValidationError) ErrorName() string {
+	return "ImportInvoiceCIIRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportInvoiceCIIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportInvoiceCIIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportInvoiceCIIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportInvoiceCIIRequestValidationError{}
+
+// Validate checks the field values on ImportInvoiceCIIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportInvoiceCIIResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportInvoiceCIIResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportInvoiceCIIResponseMultiError, or nil if none found.
+func (m *ImportInvoiceCIIResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportInvoiceCIIResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoiceHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportInvoiceCIIResponseValidationError{
+					field:  "InvoiceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportInvoiceCIIResponseValidationError{
+					field:  "InvoiceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportInvoiceCIIResponseValidationError{
+				field:  "InvoiceHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportInvoiceCIIResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportInvoiceCIIResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportInvoiceCIIResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportInvoiceCIIResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportInvoiceCIIResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportInvoiceCIIResponseMultiError) AllErrors() []error { return m }
+
+// ImportInvoiceCIIResponseValidationError is the validation error returned by
+// ImportInvoiceCIIResponse.Validate if the designated constraints aren't met.
+type ImportInvoiceCIIResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportInvoiceCIIResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportInvoiceCIIResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportInvoiceCIIResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportInvoiceCIIResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportInvoiceCIIResponseValidationError) ErrorName() string {
+	return "ImportInvoiceCIIResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportInvoiceCIIResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportInvoiceCIIResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportInvoiceCIIResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportInvoiceCIIResponseValidationError{}
+
+// Validate checks the field values on GetInvoiceFacturXRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceFacturXRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceFacturXRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceFacturXRequestMultiError, or nil if none found.
+func (m *GetInvoiceFacturXRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceFacturXRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvoiceFacturXRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInvoiceFacturXRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvoiceFacturXRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Profile
+
+	if len(errors) > 0 {
+		return GetInvoiceFacturXRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceFacturXRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceFacturXRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceFacturXRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceFacturXRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceFacturXRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoiceFacturXRequestValidationError is the validation error returned by
+// GetInvoiceFacturXRequest.Validate if the designated constraints aren't met.
+type GetInvoiceFacturXRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceFacturXRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceFacturXRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceFacturXRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceFacturXRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceFacturXRequestValidationError) ErrorName() string {
+	return "GetInvoiceFacturXRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceFacturXRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceFacturXRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceFacturXRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceFacturXRequestValidationError{}
+
+// Validate checks the field values on GetInvoiceFacturXResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceFacturXResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceFacturXResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceFacturXResponseMultiError, or nil if none found.
+func (m *GetInvoiceFacturXResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceFacturXResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pdf
+
+	if len(errors) > 0 {
+		return GetInvoiceFacturXResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceFacturXResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceFacturXResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetInvoiceFacturXResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceFacturXResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceFacturXResponseMultiError) AllErrors() []error { return m }
+
+// GetInvoiceFacturXResponseValidationError is the validation error returned by
+// GetInvoiceFacturXResponse.Validate if the designated constraints aren't met.
+type GetInvoiceFacturXResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceFacturXResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceFacturXResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceFacturXResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceFacturXResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceFacturXResponseValidationError) ErrorName() string {
+	return "GetInvoiceFacturXResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceFacturXResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceFacturXResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceFacturXResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceFacturXResponseValidationError{}
+
+// Validate checks the field values on ValidateDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateDocumentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateDocumentRequestMultiError, or nil if none found.
+func (m *ValidateDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ValidateDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateDocumentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateDocumentRequestMultiError) AllErrors() []error { return m }
+
+// ValidateDocumentRequestValidationError is the validation error returned by
+// ValidateDocumentRequest.Validate if the designated constraints aren't met.
+type ValidateDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateDocumentRequestValidationError) ErrorName() string {
+	return "ValidateDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateDocumentRequestValidationError{}
+
+// Validate checks the field values on ValidateDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateDocumentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateDocumentResponseMultiError, or nil if none found.
+func (m *ValidateDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for DocumentType
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateDocumentResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateDocumentResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateDocumentResponseValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateDocumentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateDocumentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateDocumentResponseMultiError) AllErrors() []error { return m }
+
+// ValidateDocumentResponseValidationError is the validation error returned by
+// ValidateDocumentResponse.Validate if the designated constraints aren't met.
+type ValidateDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateDocumentResponseValidationError) ErrorName() string {
+	return "ValidateDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateDocumentResponseValidationError{}
+
+// Validate checks the field values on ValidateInvoiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateInvoiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateInvoiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateInvoiceRequestMultiError, or nil if none found.
+func (m *ValidateInvoiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateInvoiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateInvoiceRequestValidationError{
+					field:  "Invoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateInvoiceRequestValidationError{
+					field:  "Invoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateInvoiceRequestValidationError{
+				field:  "Invoice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ValidateInvoiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateInvoiceRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateInvoiceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateInvoiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateInvoiceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateInvoiceRequestMultiError) AllErrors() []error { return m }
+
+// ValidateInvoiceRequestValidationError is the validation error returned by
+// ValidateInvoiceRequest.Validate if the designated constraints aren't met.
+type ValidateInvoiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateInvoiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateInvoiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateInvoiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateInvoiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateInvoiceRequestValidationError) ErrorName() string {
+	return "ValidateInvoiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateInvoiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateInvoiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateInvoiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateInvoiceRequestValidationError{}
+
+// Validate checks the field values on ValidateInvoiceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateInvoiceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateInvoiceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateInvoiceResponseMultiError, or nil if none found.
+func (m *ValidateInvoiceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateInvoiceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateInvoiceResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateInvoiceResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateInvoiceResponseValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateInvoiceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateInvoiceResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateInvoiceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateInvoiceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateInvoiceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateInvoiceResponseMultiError) AllErrors() []error { return m }
+
+// ValidateInvoiceResponseValidationError is the validation error returned by
+// ValidateInvoiceResponse.Validate if the designated constraints aren't met.
+type ValidateInvoiceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateInvoiceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateInvoiceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateInvoiceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateInvoiceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateInvoiceResponseValidationError) ErrorName() string {
+	return "ValidateInvoiceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateInvoiceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateInvoiceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateInvoiceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateInvoiceResponseValidationError{}
+
+// Validate checks the field values on GetInvoiceByPkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceByPkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceByPkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceByPkRequestMultiError, or nil if none found.
+func (m *GetInvoiceByPkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceByPkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetByIdRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvoiceByPkRequestValidationError{
+					field:  "GetByIdRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInvoiceByPkRequestValidationError{
+					field:  "GetByIdRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetByIdRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvoiceByPkRequestValidationError{
+				field:  "GetByIdRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInvoiceByPkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceByPkRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceByPkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceByPkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceByPkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceByPkRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoiceByPkRequestValidationError is the validation error returned by
+// GetInvoiceByPkRequest.Validate if the designated constraints aren't met.
+type GetInvoiceByPkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceByPkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceByPkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceByPkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceByPkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceByPkRequestValidationError) ErrorName() string {
+	return "GetInvoiceByPkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceByPkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceByPkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceByPkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceByPkRequestValidationError{}
+
+// Validate checks the field values on GetInvoiceByPkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceByPkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceByPkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceByPkResponseMultiError, or nil if none found.
+func (m *GetInvoiceByPkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceByPkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoiceHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvoiceByPkResponseValidationError{
+					field:  "InvoiceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInvoiceByPkResponseValidationError{
+					field:  "InvoiceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoiceHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvoiceByPkResponseValidationError{
+				field:  "InvoiceHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInvoiceByPkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceByPkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceByPkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceByPkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceByPkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceByPkResponseMultiError) AllErrors() []error { return m }
+
+// GetInvoiceByPkResponseValidationError is the validation error returned by
+// GetInvoiceByPkResponse.Validate if the designated constraints aren't met.
+type GetInvoiceByPkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceByPkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceByPkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceByPkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceByPkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceByPkResponseValidationError) ErrorName() string {
+	return "GetInvoiceByPkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceByPkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceByPkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceByPkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceByPkResponseValidationError{}
+
+// Validate checks the field values on GetInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoicesRequestMultiError, or nil if none found.
+func (m *GetInvoicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetInvoicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoicesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetInvoicesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetInvoicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoicesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoicesRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoicesRequestValidationError is the validation error returned by
+// GetInvoicesRequest.Validate if the designated constraints aren't met.
+type GetInvoicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoicesRequestValidationError) ErrorName() string {
+	return "GetInvoicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoicesRequestValidationError{}
+
+// Validate checks the field values on GetInvoicesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoicesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoicesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoicesResponseMultiError, or nil if none found.
+func (m *GetInvoicesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoicesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvoiceHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetInvoicesResponseValidationError{
+						field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetInvoicesResponseValidationError{
+						field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetInvoicesResponseValidationError{
+					field:  fmt.Sprintf("InvoiceHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetInvoicesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoicesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoicesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoicesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoicesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoicesResponseMultiError) AllErrors() []error { return m }
+
+// GetInvoicesResponseValidationError is the validation error returned by
+// GetInvoicesResponse.Validate if the designated constraints aren't met.
+type GetInvoicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoicesResponseValidationError) ErrorName() string {
+	return "GetInvoicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoicesResponseValidationError{}
+
+// Validate checks the field values on InvoiceLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvoiceLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvoiceLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvoiceLineMultiError, or
+// nil if none found.
+func (m *InvoiceLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvoiceLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoiceLineD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "InvoiceLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "InvoiceLineD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoiceLineD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineValidationError{
+				field:  "InvoiceLineD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvoiceLineT()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "InvoiceLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "InvoiceLineT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoiceLineT()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineValidationError{
+				field:  "InvoiceLineT",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvoiceLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvoiceLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvoiceLineValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InvoiceLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceLineMultiError is an error wrapping multiple validation errors
+// returned by InvoiceLine.ValidateAll() if the designated constraints aren't met.
+type InvoiceLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceLineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceLineMultiError) AllErrors() []error { return m }
+
+// InvoiceLineValidationError is the validation error returned by
+// InvoiceLine.Validate if the designated constraints aren't met.
+type InvoiceLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceLineValidationError) ErrorName() string { return "InvoiceLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoiceLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceLineValidationError{}
+
+// Validate checks the field values on InvoiceLineD with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvoiceLineD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvoiceLineD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvoiceLineDMultiError, or
+// nil if none found.
+func (m *InvoiceLineD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvoiceLineD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for IlId
+
+	// no validation rules for Note
+
+	// no validation rules for InvoicedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for FreeOfChargeIndicator
+
+	// no validation rules for OrderLineId
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for OriginatorPartyId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for InvoiceHeaderId
+
+	// no validation rules for CurrencyCode
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return InvoiceLineDMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceLineDMultiError is an error wrapping multiple validation errors
+// returned by InvoiceLineD.ValidateAll() if the designated constraints aren't met.
+type InvoiceLineDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceLineDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceLineDMultiError) AllErrors() []error { return m }
+
+// InvoiceLineDValidationError is the validation error returned by
+// InvoiceLineD.Validate if the designated constraints aren't met.
+type InvoiceLineDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceLineDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceLineDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceLineDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceLineDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceLineDValidationError) ErrorName() string { return "InvoiceLineDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceLineDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoiceLineD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceLineDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceLineDValidationError{}
+
+// Validate checks the field values on InvoiceLineT with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvoiceLineT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvoiceLineT with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvoiceLineTMultiError, or
+// nil if none found.
+func (m *InvoiceLineT) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvoiceLineT) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTaxPointDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "TaxPointDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTaxPointDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineTValidationError{
+				field:  "TaxPointDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvoicePeriodStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "InvoicePeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "InvoicePeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoicePeriodStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineTValidationError{
+				field:  "InvoicePeriodStartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvoicePeriodEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "InvoicePeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "InvoicePeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoicePeriodEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineTValidationError{
+				field:  "InvoicePeriodEndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "PriceValidityPeriodStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineTValidationError{
+				field:  "PriceValidityPeriodStartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceLineTValidationError{
+					field:  "PriceValidityPeriodEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceLineTValidationError{
+				field:  "PriceValidityPeriodEndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InvoiceLineTMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceLineTMultiError is an error wrapping multiple validation errors
+// returned by InvoiceLineT.ValidateAll() if the designated constraints aren't met.
+type InvoiceLineTMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceLineTMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceLineTMultiError) AllErrors() []error { return m }
+
+// InvoiceLineTValidationError is the validation error returned by
+// InvoiceLineT.Validate if the designated constraints aren't met.
+type InvoiceLineTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceLineTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceLineTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceLineTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceLineTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceLineTValidationError) ErrorName() string { return "InvoiceLineTValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceLineTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoiceLineT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceLineTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceLineTValidationError{}
+
+// Validate checks the field values on CreateInvoiceLineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInvoiceLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInvoiceLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInvoiceLineRequestMultiError, or nil if none found.
+func (m *CreateInvoiceLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInvoiceLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IlId
+
+	// no validation rules for Note
+
+	// no validation rules for InvoicedQuantity
+
+	// no validation rules for LineExtensionAmount
+
+	// no validation rules for TaxPointDate
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for PaymentPurposeCode
+
+	// no validation rules for FreeOfChargeIndicator
+
+	// no validation rules for InvoicePeriodStartDate
+
+	// no validation rules for InvoicePeriodEndDate
+
+	// no validation rules for OrderLineId
+
+	// no validation rules for DespatchLineId
+
+	// no validation rules for ReceiptLineId
+
+	// no validation rules for BillingId
+
+	// no validation rules for OriginatorPartyId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for PriceChangeReason
+
+	// no validation rules for PriceTypeCode
+
+	// no validation rules for PriceType
+
+	// no validation rules for OrderableUnitFactorRate
+
+	// no validation rules for PriceValidityPeriodStartDate
+
+	// no validation rules for PriceValidityPeriodEndDate
+
+	// no validation rules for PriceListId
+
+	// no validation rules for InvoiceHeaderId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInvoiceLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateInvoiceLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInvoiceLineRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for UnitCode
+
+	if len(errors) > 0 {
+		return CreateInvoiceLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInvoiceLineRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateInvoiceLineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInvoiceLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInvoiceLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInvoiceLineRequestMultiError) AllErrors() []error { return m }
+
+// CreateInvoiceLineRequestValidationError is the validation error returned by
+// CreateInvoiceLineRequest.Validate if the designated constraints aren't met.
+type CreateInvoiceLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInvoiceLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInvoiceLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInvoiceLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInvoiceLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInvoiceLineRequestValidationError) ErrorName() string {
+	return "CreateInvoiceLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInvoiceLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInvoiceLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInvoiceLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInvoiceLineRequestValidationError{}
+
+// Validate checks the field values on CreateInvoiceLineResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInvoiceLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInvoiceLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInvoiceLineResponseMultiError, or nil if none found.
+func (m *CreateInvoiceLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInvoiceLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvoiceLine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInvoiceLineResponseValidationError{
+					field:  "InvoiceLine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInvoiceLineResponseValidationError{
+					field:  "InvoiceLine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvoiceLine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInvoiceLineResponseValidationError{
+				field:  "InvoiceLine",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return CreateInvoiceLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInvoiceLineResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInvoiceLineResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateInvoiceLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInvoiceLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInvoiceLineResponseMultiError) AllErrors() []error { return m }
+
+// CreateInvoiceLineResponseValidationError is the validation error returned by
+// CreateInvoiceLineResponse.Validate if the designated constraints aren't met.
+type CreateInvoiceLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInvoiceLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInvoiceLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInvoiceLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInvoiceLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInvoiceLineResponseValidationError) ErrorName() string {
+	return "CreateInvoiceLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInvoiceLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInvoiceLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInvoiceLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInvoiceLineResponseValidationError{}
+
+// Validate checks the field values on GetInvoiceLinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceLinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceLinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceLinesRequestMultiError, or nil if none found.
+func (m *GetInvoiceLinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceLinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodStartDate()).(type) {
+		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
+				errors = append(errors, GetInvoiceLinesRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "PriceValidityPeriodStartDate",
+				errors = append(errors, GetInvoiceLinesRequestValidationError{
+					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodStartDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvoiceLineTValidationError{
-				field:  "PriceValidityPeriodStartDate",
+			return GetInvoiceLinesRequestValidationError{
+				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetPriceValidityPeriodEndDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(errors) > 0 {
+		return GetInvoiceLinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvoiceLinesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceLinesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceLinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvoiceLinesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvoiceLinesRequestMultiError) AllErrors() []error { return m }
+
+// GetInvoiceLinesRequestValidationError is the validation error returned by
+// GetInvoiceLinesRequest.Validate if the designated constraints aren't met.
+type GetInvoiceLinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvoiceLinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvoiceLinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvoiceLinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvoiceLinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvoiceLinesRequestValidationError) ErrorName() string {
+	return "GetInvoiceLinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvoiceLinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvoiceLinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvoiceLinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvoiceLinesRequestValidationError{}
+
+// Validate checks the field values on GetInvoiceLinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvoiceLinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvoiceLinesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvoiceLinesResponseMultiError, or nil if none found.
+func (m *GetInvoiceLinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvoiceLinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvoiceLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetInvoiceLinesResponseValidationError{
+						field:  fmt.Sprintf("InvoiceLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetInvoiceLinesResponseValidationError{
+						field:  fmt.Sprintf("InvoiceLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvoiceLineTValidationError{
-					field:  "PriceValidityPeriodEndDate",
+				return GetInvoiceLinesResponseValidationError{
+					field:  fmt.Sprintf("InvoiceLines[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPriceValidityPeriodEndDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InvoiceLineTValidationError{
-				field:  "PriceValidityPeriodEndDate",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return InvoiceLineTMultiError(errors)
+		return GetInvoiceLinesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvoiceLineTMultiError is an error wrapping multiple validation errors
-// returned by InvoiceLineT.ValidateAll() if the designated constraints aren't met.
-type InvoiceLineTMultiError []error
+// GetInvoiceLinesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInvoiceLinesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInvoiceLinesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvoiceLineTMultiError) Error() string {
+func (m GetInvoiceLinesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2803,11 +6053,11 @@ func (m InvoiceLineTMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvoiceLineTMultiError) AllErrors() []error { return m }
+func (m GetInvoiceLinesResponseMultiError) AllErrors() []error { return m }
 
-// InvoiceLineTValidationError is the validation error returned by
-// InvoiceLineT.Validate if the designated constraints aren't met.
-type InvoiceLineTValidationError struct {
+// GetInvoiceLinesResponseValidationError is the validation error returned by
+// GetInvoiceLinesResponse.Validate if the designated constraints aren't met.
+type GetInvoiceLinesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2815,22 +6065,24 @@ type InvoiceLineTValidationError struct {
 }
 
 // Field function returns field value.
-func (e InvoiceLineTValidationError) Field() string { return e.field }
+func (e GetInvoiceLinesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvoiceLineTValidationError) Reason() string { return e.reason }
+func (e GetInvoiceLinesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvoiceLineTValidationError) Cause() error { return e.cause }
+func (e GetInvoiceLinesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvoiceLineTValidationError) Key() bool { return e.key }
+func (e GetInvoiceLinesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvoiceLineTValidationError) ErrorName() string { return "InvoiceLineTValidationError" }
+func (e GetInvoiceLinesResponseValidationError) ErrorName() string {
+	return "GetInvoiceLinesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InvoiceLineTValidationError) Error() string {
+func (e GetInvoiceLinesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2842,14 +6094,14 @@ func (e InvoiceLineTValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvoiceLineT.%s: %s%s",
+		"invalid %sGetInvoiceLinesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvoiceLineTValidationError{}
+var _ error = GetInvoiceLinesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2857,83 +6109,41 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvoiceLineTValidationError{}
+} = GetInvoiceLinesResponseValidationError{}
 
-// Validate checks the field values on CreateInvoiceLineRequest with the rules
+// Validate checks the field values on UpdateInvoiceLineRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateInvoiceLineRequest) Validate() error {
+func (m *UpdateInvoiceLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateInvoiceLineRequest with the
+// ValidateAll checks the field values on UpdateInvoiceLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateInvoiceLineRequestMultiError, or nil if none found.
-func (m *CreateInvoiceLineRequest) ValidateAll() error {
+// UpdateInvoiceLineRequestMultiError, or nil if none found.
+func (m *UpdateInvoiceLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateInvoiceLineRequest) validate(all bool) error {
+func (m *UpdateInvoiceLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IlId
-
 	// no validation rules for Note
 
 	// no validation rules for InvoicedQuantity
 
-	// no validation rules for LineExtensionAmount
-
-	// no validation rules for TaxPointDate
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for PaymentPurposeCode
-
-	// no validation rules for FreeOfChargeIndicator
-
-	// no validation rules for InvoicePeriodStartDate
-
-	// no validation rules for InvoicePeriodEndDate
-
-	// no validation rules for OrderLineId
-
-	// no validation rules for DespatchLineId
-
-	// no validation rules for ReceiptLineId
-
-	// no validation rules for BillingId
-
-	// no validation rules for OriginatorPartyId
-
-	// no validation rules for ItemId
-
 	// no validation rules for PriceAmount
 
 	// no validation rules for PriceBaseQuantity
 
-	// no validation rules for PriceChangeReason
-
-	// no validation rules for PriceTypeCode
-
-	// no validation rules for PriceType
-
-	// no validation rules for OrderableUnitFactorRate
-
-	// no validation rules for PriceValidityPeriodStartDate
-
-	// no validation rules for PriceValidityPeriodEndDate
-
-	// no validation rules for PriceListId
+	// no validation rules for AccountingCost
 
-	// no validation rules for InvoiceHeaderId
+	// no validation rules for Id
 
 	// no validation rules for UserId
 
@@ -2942,19 +6152,19 @@ func (m *CreateInvoiceLineRequest) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return CreateInvoiceLineRequestMultiError(errors)
+		return UpdateInvoiceLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateInvoiceLineRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateInvoiceLineRequest.ValidateAll() if the designated
+// UpdateInvoiceLineRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateInvoiceLineRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateInvoiceLineRequestMultiError []error
+type UpdateInvoiceLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateInvoiceLineRequestMultiError) Error() string {
+func (m UpdateInvoiceLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2963,11 +6173,11 @@ func (m CreateInvoiceLineRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateInvoiceLineRequestMultiError) AllErrors() []error { return m }
+func (m UpdateInvoiceLineRequestMultiError) AllErrors() []error { return m }
 
-// CreateInvoiceLineRequestValidationError is the validation error returned by
-// CreateInvoiceLineRequest.Validate if the designated constraints aren't met.
-type CreateInvoiceLineRequestValidationError struct {
+// UpdateInvoiceLineRequestValidationError is the validation error returned by
+// UpdateInvoiceLineRequest.Validate if the designated constraints aren't met.
+type UpdateInvoiceLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2975,24 +6185,24 @@ type CreateInvoiceLineRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateInvoiceLineRequestValidationError) Field() string { return e.field }
+func (e UpdateInvoiceLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateInvoiceLineRequestValidationError) Reason() string { return e.reason }
+func (e UpdateInvoiceLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateInvoiceLineRequestValidationError) Cause() error { return e.cause }
+func (e UpdateInvoiceLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateInvoiceLineRequestValidationError) Key() bool { return e.key }
+func (e UpdateInvoiceLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateInvoiceLineRequestValidationError) ErrorName() string {
-	return "CreateInvoiceLineRequestValidationError"
+func (e UpdateInvoiceLineRequestValidationError) ErrorName() string {
+	return "UpdateInvoiceLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateInvoiceLineRequestValidationError) Error() string {
+func (e UpdateInvoiceLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3004,14 +6214,14 @@ func (e CreateInvoiceLineRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateInvoiceLineRequest.%s: %s%s",
+		"invalid %sUpdateInvoiceLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateInvoiceLineRequestValidationError{}
+var _ error = UpdateInvoiceLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3019,73 +6229,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateInvoiceLineRequestValidationError{}
+} = UpdateInvoiceLineRequestValidationError{}
 
-// Validate checks the field values on CreateInvoiceLineResponse with the rules
+// Validate checks the field values on UpdateInvoiceLineResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateInvoiceLineResponse) Validate() error {
+func (m *UpdateInvoiceLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateInvoiceLineResponse with the
+// ValidateAll checks the field values on UpdateInvoiceLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateInvoiceLineResponseMultiError, or nil if none found.
-func (m *CreateInvoiceLineResponse) ValidateAll() error {
+// UpdateInvoiceLineResponseMultiError, or nil if none found.
+func (m *UpdateInvoiceLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateInvoiceLineResponse) validate(all bool) error {
+func (m *UpdateInvoiceLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetInvoiceLine()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateInvoiceLineResponseValidationError{
-					field:  "InvoiceLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateInvoiceLineResponseValidationError{
-					field:  "InvoiceLine",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInvoiceLine()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateInvoiceLineResponseValidationError{
-				field:  "InvoiceLine",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateInvoiceLineResponseMultiError(errors)
+		return UpdateInvoiceLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateInvoiceLineResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateInvoiceLineResponse.ValidateAll() if the
+// UpdateInvoiceLineResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateInvoiceLineResponse.ValidateAll() if the
 // designated constraints aren't met.
-type CreateInvoiceLineResponseMultiError []error
+type UpdateInvoiceLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateInvoiceLineResponseMultiError) Error() string {
+func (m UpdateInvoiceLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3094,11 +6275,11 @@ func (m CreateInvoiceLineResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateInvoiceLineResponseMultiError) AllErrors() []error { return m }
+func (m UpdateInvoiceLineResponseMultiError) AllErrors() []error { return m }
 
-// CreateInvoiceLineResponseValidationError is the validation error returned by
-// CreateInvoiceLineResponse.Validate if the designated constraints aren't met.
-type CreateInvoiceLineResponseValidationError struct {
+// UpdateInvoiceLineResponseValidationError is the validation error returned by
+// UpdateInvoiceLineResponse.Validate if the designated constraints aren't met.
+type UpdateInvoiceLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3106,24 +6287,24 @@ type CreateInvoiceLineResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateInvoiceLineResponseValidationError) Field() string { return e.field }
+func (e UpdateInvoiceLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateInvoiceLineResponseValidationError) Reason() string { return e.reason }
+func (e UpdateInvoiceLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateInvoiceLineResponseValidationError) Cause() error { return e.cause }
+func (e UpdateInvoiceLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateInvoiceLineResponseValidationError) Key() bool { return e.key }
+func (e UpdateInvoiceLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateInvoiceLineResponseValidationError) ErrorName() string {
-	return "CreateInvoiceLineResponseValidationError"
+func (e UpdateInvoiceLineResponseValidationError) ErrorName() string {
+	return "UpdateInvoiceLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateInvoiceLineResponseValidationError) Error() string {
+func (e UpdateInvoiceLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3135,14 +6316,14 @@ func (e CreateInvoiceLineResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateInvoiceLineResponse.%s: %s%s",
+		"invalid %sUpdateInvoiceLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateInvoiceLineResponseValidationError{}
+var _ error = UpdateInvoiceLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3150,24 +6331,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateInvoiceLineResponseValidationError{}
+} = UpdateInvoiceLineResponseValidationError{}
 
-// Validate checks the field values on GetInvoiceLinesRequest with the rules
+// Validate checks the field values on DeleteInvoiceLineRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceLinesRequest) Validate() error {
+func (m *DeleteInvoiceLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceLinesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteInvoiceLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceLinesRequestMultiError, or nil if none found.
-func (m *GetInvoiceLinesRequest) ValidateAll() error {
+// DeleteInvoiceLineRequestMultiError, or nil if none found.
+func (m *DeleteInvoiceLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceLinesRequest) validate(all bool) error {
+func (m *DeleteInvoiceLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3178,7 +6359,7 @@ func (m *GetInvoiceLinesRequest) validate(all bool) error {
 		switch v := interface{}(m.GetGetRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetInvoiceLinesRequestValidationError{
+				errors = append(errors, DeleteInvoiceLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3186,7 +6367,7 @@ func (m *GetInvoiceLinesRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetInvoiceLinesRequestValidationError{
+				errors = append(errors, DeleteInvoiceLineRequestValidationError{
 					field:  "GetRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3195,7 +6376,7 @@ func (m *GetInvoiceLinesRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetInvoiceLinesRequestValidationError{
+			return DeleteInvoiceLineRequestValidationError{
 				field:  "GetRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3204,19 +6385,19 @@ func (m *GetInvoiceLinesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetInvoiceLinesRequestMultiError(errors)
+		return DeleteInvoiceLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceLinesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetInvoiceLinesRequest.ValidateAll() if the designated
+// DeleteInvoiceLineRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteInvoiceLineRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetInvoiceLinesRequestMultiError []error
+type DeleteInvoiceLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceLinesRequestMultiError) Error() string {
+func (m DeleteInvoiceLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3225,11 +6406,11 @@ func (m GetInvoiceLinesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceLinesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteInvoiceLineRequestMultiError) AllErrors() []error { return m }
 
-// GetInvoiceLinesRequestValidationError is the validation error returned by
-// GetInvoiceLinesRequest.Validate if the designated constraints aren't met.
-type GetInvoiceLinesRequestValidationError struct {
+// DeleteInvoiceLineRequestValidationError is the validation error returned by
+// DeleteInvoiceLineRequest.Validate if the designated constraints aren't met.
+type DeleteInvoiceLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3237,24 +6418,24 @@ type GetInvoiceLinesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceLinesRequestValidationError) Field() string { return e.field }
+func (e DeleteInvoiceLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceLinesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteInvoiceLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceLinesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteInvoiceLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceLinesRequestValidationError) Key() bool { return e.key }
+func (e DeleteInvoiceLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceLinesRequestValidationError) ErrorName() string {
-	return "GetInvoiceLinesRequestValidationError"
+func (e DeleteInvoiceLineRequestValidationError) ErrorName() string {
+	return "DeleteInvoiceLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceLinesRequestValidationError) Error() string {
+func (e DeleteInvoiceLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3266,14 +6447,14 @@ func (e GetInvoiceLinesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceLinesRequest.%s: %s%s",
+		"invalid %sDeleteInvoiceLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceLinesRequestValidationError{}
+var _ error = DeleteInvoiceLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3281,78 +6462,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceLinesRequestValidationError{}
+} = DeleteInvoiceLineRequestValidationError{}
 
-// Validate checks the field values on GetInvoiceLinesResponse with the rules
+// Validate checks the field values on DeleteInvoiceLineResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetInvoiceLinesResponse) Validate() error {
+func (m *DeleteInvoiceLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetInvoiceLinesResponse with the
+// ValidateAll checks the field values on DeleteInvoiceLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetInvoiceLinesResponseMultiError, or nil if none found.
-func (m *GetInvoiceLinesResponse) ValidateAll() error {
+// DeleteInvoiceLineResponseMultiError, or nil if none found.
+func (m *DeleteInvoiceLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetInvoiceLinesResponse) validate(all bool) error {
+func (m *DeleteInvoiceLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvoiceLines() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetInvoiceLinesResponseValidationError{
-						field:  fmt.Sprintf("InvoiceLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetInvoiceLinesResponseValidationError{
-						field:  fmt.Sprintf("InvoiceLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetInvoiceLinesResponseValidationError{
-					field:  fmt.Sprintf("InvoiceLines[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetInvoiceLinesResponseMultiError(errors)
+		return DeleteInvoiceLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetInvoiceLinesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetInvoiceLinesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetInvoiceLinesResponseMultiError []error
+// DeleteInvoiceLineResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteInvoiceLineResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteInvoiceLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetInvoiceLinesResponseMultiError) Error() string {
+func (m DeleteInvoiceLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3361,11 +6508,11 @@ func (m GetInvoiceLinesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetInvoiceLinesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteInvoiceLineResponseMultiError) AllErrors() []error { return m }
 
-// GetInvoiceLinesResponseValidationError is the validation error returned by
-// GetInvoiceLinesResponse.Validate if the designated constraints aren't met.
-type GetInvoiceLinesResponseValidationError struct {
+// DeleteInvoiceLineResponseValidationError is the validation error returned by
+// DeleteInvoiceLineResponse.Validate if the designated constraints aren't met.
+type DeleteInvoiceLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3373,24 +6520,24 @@ type GetInvoiceLinesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetInvoiceLinesResponseValidationError) Field() string { return e.field }
+func (e DeleteInvoiceLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetInvoiceLinesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteInvoiceLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetInvoiceLinesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteInvoiceLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetInvoiceLinesResponseValidationError) Key() bool { return e.key }
+func (e DeleteInvoiceLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetInvoiceLinesResponseValidationError) ErrorName() string {
-	return "GetInvoiceLinesResponseValidationError"
+func (e DeleteInvoiceLineResponseValidationError) ErrorName() string {
+	return "DeleteInvoiceLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetInvoiceLinesResponseValidationError) Error() string {
+func (e DeleteInvoiceLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3402,14 +6549,14 @@ func (e GetInvoiceLinesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetInvoiceLinesResponse.%s: %s%s",
+		"invalid %sDeleteInvoiceLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetInvoiceLinesResponseValidationError{}
+var _ error = DeleteInvoiceLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3417,7 +6564,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetInvoiceLinesResponseValidationError{}
+} = DeleteInvoiceLineResponseValidationError{}
 
 // Validate checks the field values on InvoiceLines with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
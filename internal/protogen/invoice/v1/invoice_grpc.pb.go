@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	v1 "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,13 +20,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InvoiceService_CreateInvoice_FullMethodName     = "/invoice.v1.InvoiceService/CreateInvoice"
-	InvoiceService_GetInvoices_FullMethodName       = "/invoice.v1.InvoiceService/GetInvoices"
-	InvoiceService_GetInvoice_FullMethodName        = "/invoice.v1.InvoiceService/GetInvoice"
-	InvoiceService_GetInvoiceByPk_FullMethodName    = "/invoice.v1.InvoiceService/GetInvoiceByPk"
-	InvoiceService_CreateInvoiceLine_FullMethodName = "/invoice.v1.InvoiceService/CreateInvoiceLine"
-	InvoiceService_GetInvoiceLines_FullMethodName   = "/invoice.v1.InvoiceService/GetInvoiceLines"
-	InvoiceService_UpdateInvoice_FullMethodName     = "/invoice.v1.InvoiceService/UpdateInvoice"
+	InvoiceService_CreateInvoice_FullMethodName               = "/invoice.v1.InvoiceService/CreateInvoice"
+	InvoiceService_GetInvoices_FullMethodName                 = "/invoice.v1.InvoiceService/GetInvoices"
+	InvoiceService_GetInvoice_FullMethodName                  = "/invoice.v1.InvoiceService/GetInvoice"
+	InvoiceService_GetInvoiceByPk_FullMethodName              = "/invoice.v1.InvoiceService/GetInvoiceByPk"
+	InvoiceService_CreateInvoiceLine_FullMethodName           = "/invoice.v1.InvoiceService/CreateInvoiceLine"
+	InvoiceService_GetInvoiceLines_FullMethodName             = "/invoice.v1.InvoiceService/GetInvoiceLines"
+	InvoiceService_UpdateInvoiceLine_FullMethodName           = "/invoice.v1.InvoiceService/UpdateInvoiceLine"
+	InvoiceService_DeleteInvoiceLine_FullMethodName           = "/invoice.v1.InvoiceService/DeleteInvoiceLine"
+	InvoiceService_UpdateInvoice_FullMethodName               = "/invoice.v1.InvoiceService/UpdateInvoice"
+	InvoiceService_GetInvoiceUBL_FullMethodName               = "/invoice.v1.InvoiceService/GetInvoiceUBL"
+	InvoiceService_ImportInvoiceUBL_FullMethodName            = "/invoice.v1.InvoiceService/ImportInvoiceUBL"
+	InvoiceService_GetInvoiceCII_FullMethodName               = "/invoice.v1.InvoiceService/GetInvoiceCII"
+	InvoiceService_ImportInvoiceCII_FullMethodName            = "/invoice.v1.InvoiceService/ImportInvoiceCII"
+	InvoiceService_GetInvoiceFacturX_FullMethodName           = "/invoice.v1.InvoiceService/GetInvoiceFacturX"
+	InvoiceService_ValidateDocument_FullMethodName            = "/invoice.v1.InvoiceService/ValidateDocument"
+	InvoiceService_ValidateInvoice_FullMethodName             = "/invoice.v1.InvoiceService/ValidateInvoice"
+	InvoiceService_IssueInvoice_FullMethodName                = "/invoice.v1.InvoiceService/IssueInvoice"
+	InvoiceService_SendInvoice_FullMethodName                 = "/invoice.v1.InvoiceService/SendInvoice"
+	InvoiceService_AcknowledgeInvoice_FullMethodName          = "/invoice.v1.InvoiceService/AcknowledgeInvoice"
+	InvoiceService_MarkInvoicePartiallyPaid_FullMethodName    = "/invoice.v1.InvoiceService/MarkInvoicePartiallyPaid"
+	InvoiceService_MarkInvoicePaid_FullMethodName             = "/invoice.v1.InvoiceService/MarkInvoicePaid"
+	InvoiceService_MarkInvoiceDisputed_FullMethodName         = "/invoice.v1.InvoiceService/MarkInvoiceDisputed"
+	InvoiceService_CancelInvoice_FullMethodName               = "/invoice.v1.InvoiceService/CancelInvoice"
+	InvoiceService_GetInvoiceStatusTransitions_FullMethodName = "/invoice.v1.InvoiceService/GetInvoiceStatusTransitions"
+	InvoiceService_ReviseInvoice_FullMethodName               = "/invoice.v1.InvoiceService/ReviseInvoice"
+	InvoiceService_GetInvoiceVersions_FullMethodName          = "/invoice.v1.InvoiceService/GetInvoiceVersions"
+	InvoiceService_DiffInvoiceVersions_FullMethodName         = "/invoice.v1.InvoiceService/DiffInvoiceVersions"
 )
 
 // InvoiceServiceClient is the client API for InvoiceService service.
@@ -40,7 +61,27 @@ type InvoiceServiceClient interface {
 	GetInvoiceByPk(ctx context.Context, in *GetInvoiceByPkRequest, opts ...grpc.CallOption) (*GetInvoiceByPkResponse, error)
 	CreateInvoiceLine(ctx context.Context, in *CreateInvoiceLineRequest, opts ...grpc.CallOption) (*CreateInvoiceLineResponse, error)
 	GetInvoiceLines(ctx context.Context, in *GetInvoiceLinesRequest, opts ...grpc.CallOption) (*GetInvoiceLinesResponse, error)
+	UpdateInvoiceLine(ctx context.Context, in *UpdateInvoiceLineRequest, opts ...grpc.CallOption) (*UpdateInvoiceLineResponse, error)
+	DeleteInvoiceLine(ctx context.Context, in *DeleteInvoiceLineRequest, opts ...grpc.CallOption) (*DeleteInvoiceLineResponse, error)
 	UpdateInvoice(ctx context.Context, in *UpdateInvoiceRequest, opts ...grpc.CallOption) (*UpdateInvoiceResponse, error)
+	GetInvoiceUBL(ctx context.Context, in *GetInvoiceUBLRequest, opts ...grpc.CallOption) (*GetInvoiceUBLResponse, error)
+	ImportInvoiceUBL(ctx context.Context, in *ImportInvoiceUBLRequest, opts ...grpc.CallOption) (*ImportInvoiceUBLResponse, error)
+	GetInvoiceCII(ctx context.Context, in *GetInvoiceCIIRequest, opts ...grpc.CallOption) (*GetInvoiceCIIResponse, error)
+	ImportInvoiceCII(ctx context.Context, in *ImportInvoiceCIIRequest, opts ...grpc.CallOption) (*ImportInvoiceCIIResponse, error)
+	GetInvoiceFacturX(ctx context.Context, in *GetInvoiceFacturXRequest, opts ...grpc.CallOption) (*GetInvoiceFacturXResponse, error)
+	ValidateDocument(ctx context.Context, in *ValidateDocumentRequest, opts ...grpc.CallOption) (*ValidateDocumentResponse, error)
+	ValidateInvoice(ctx context.Context, in *ValidateInvoiceRequest, opts ...grpc.CallOption) (*ValidateInvoiceResponse, error)
+	IssueInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	SendInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkInvoicePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkInvoicePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	MarkInvoiceDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	CancelInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error)
+	GetInvoiceStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseInvoice(ctx context.Context, in *ReviseInvoiceRequest, opts ...grpc.CallOption) (*ReviseInvoiceResponse, error)
+	GetInvoiceVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error)
+	DiffInvoiceVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error)
 }
 
 type invoiceServiceClient struct {
@@ -111,6 +152,26 @@ func (c *invoiceServiceClient) GetInvoiceLines(ctx context.Context, in *GetInvoi
 	return out, nil
 }
 
+func (c *invoiceServiceClient) UpdateInvoiceLine(ctx context.Context, in *UpdateInvoiceLineRequest, opts ...grpc.CallOption) (*UpdateInvoiceLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInvoiceLineResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateInvoiceLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteInvoiceLine(ctx context.Context, in *DeleteInvoiceLineRequest, opts ...grpc.CallOption) (*DeleteInvoiceLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInvoiceLineResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteInvoiceLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *invoiceServiceClient) UpdateInvoice(ctx context.Context, in *UpdateInvoiceRequest, opts ...grpc.CallOption) (*UpdateInvoiceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateInvoiceResponse)
@@ -121,6 +182,186 @@ func (c *invoiceServiceClient) UpdateInvoice(ctx context.Context, in *UpdateInvo
 	return out, nil
 }
 
+func (c *invoiceServiceClient) GetInvoiceUBL(ctx context.Context, in *GetInvoiceUBLRequest, opts ...grpc.CallOption) (*GetInvoiceUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceUBLResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ImportInvoiceUBL(ctx context.Context, in *ImportInvoiceUBLRequest, opts ...grpc.CallOption) (*ImportInvoiceUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportInvoiceUBLResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ImportInvoiceUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceCII(ctx context.Context, in *GetInvoiceCIIRequest, opts ...grpc.CallOption) (*GetInvoiceCIIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceCIIResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceCII_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ImportInvoiceCII(ctx context.Context, in *ImportInvoiceCIIRequest, opts ...grpc.CallOption) (*ImportInvoiceCIIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportInvoiceCIIResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ImportInvoiceCII_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceFacturX(ctx context.Context, in *GetInvoiceFacturXRequest, opts ...grpc.CallOption) (*GetInvoiceFacturXResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceFacturXResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceFacturX_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ValidateDocument(ctx context.Context, in *ValidateDocumentRequest, opts ...grpc.CallOption) (*ValidateDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateDocumentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ValidateDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ValidateInvoice(ctx context.Context, in *ValidateInvoiceRequest, opts ...grpc.CallOption) (*ValidateInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ValidateInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) IssueInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_IssueInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SendInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_SendInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) AcknowledgeInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_AcknowledgeInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkInvoicePartiallyPaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkInvoicePartiallyPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkInvoicePaid(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkInvoicePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkInvoiceDisputed(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkInvoiceDisputed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CancelInvoice(ctx context.Context, in *TransitionDocumentStatusRequest, opts ...grpc.CallOption) (*TransitionDocumentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStatusResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CancelInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceStatusTransitions(ctx context.Context, in *GetDocumentStatusTransitionsRequest, opts ...grpc.CallOption) (*GetDocumentStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ReviseInvoice(ctx context.Context, in *ReviseInvoiceRequest, opts ...grpc.CallOption) (*ReviseInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviseInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ReviseInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DiffInvoiceVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DiffDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DiffInvoiceVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoiceServiceServer is the server API for InvoiceService service.
 // All implementations must embed UnimplementedInvoiceServiceServer
 // for forward compatibility.
@@ -133,7 +374,27 @@ type InvoiceServiceServer interface {
 	GetInvoiceByPk(context.Context, *GetInvoiceByPkRequest) (*GetInvoiceByPkResponse, error)
 	CreateInvoiceLine(context.Context, *CreateInvoiceLineRequest) (*CreateInvoiceLineResponse, error)
 	GetInvoiceLines(context.Context, *GetInvoiceLinesRequest) (*GetInvoiceLinesResponse, error)
+	UpdateInvoiceLine(context.Context, *UpdateInvoiceLineRequest) (*UpdateInvoiceLineResponse, error)
+	DeleteInvoiceLine(context.Context, *DeleteInvoiceLineRequest) (*DeleteInvoiceLineResponse, error)
 	UpdateInvoice(context.Context, *UpdateInvoiceRequest) (*UpdateInvoiceResponse, error)
+	GetInvoiceUBL(context.Context, *GetInvoiceUBLRequest) (*GetInvoiceUBLResponse, error)
+	ImportInvoiceUBL(context.Context, *ImportInvoiceUBLRequest) (*ImportInvoiceUBLResponse, error)
+	GetInvoiceCII(context.Context, *GetInvoiceCIIRequest) (*GetInvoiceCIIResponse, error)
+	ImportInvoiceCII(context.Context, *ImportInvoiceCIIRequest) (*ImportInvoiceCIIResponse, error)
+	GetInvoiceFacturX(context.Context, *GetInvoiceFacturXRequest) (*GetInvoiceFacturXResponse, error)
+	ValidateDocument(context.Context, *ValidateDocumentRequest) (*ValidateDocumentResponse, error)
+	ValidateInvoice(context.Context, *ValidateInvoiceRequest) (*ValidateInvoiceResponse, error)
+	IssueInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	SendInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	AcknowledgeInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkInvoicePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkInvoicePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	MarkInvoiceDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	CancelInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error)
+	GetInvoiceStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error)
+	ReviseInvoice(context.Context, *ReviseInvoiceRequest) (*ReviseInvoiceResponse, error)
+	GetInvoiceVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error)
+	DiffInvoiceVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error)
 	mustEmbedUnimplementedInvoiceServiceServer()
 }
 
@@ -162,9 +423,69 @@ func (UnimplementedInvoiceServiceServer) CreateInvoiceLine(context.Context, *Cre
 func (UnimplementedInvoiceServiceServer) GetInvoiceLines(context.Context, *GetInvoiceLinesRequest) (*GetInvoiceLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceLines not implemented")
 }
+func (UnimplementedInvoiceServiceServer) UpdateInvoiceLine(context.Context, *UpdateInvoiceLineRequest) (*UpdateInvoiceLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvoiceLine not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteInvoiceLine(context.Context, *DeleteInvoiceLineRequest) (*DeleteInvoiceLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvoiceLine not implemented")
+}
 func (UnimplementedInvoiceServiceServer) UpdateInvoice(context.Context, *UpdateInvoiceRequest) (*UpdateInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvoice not implemented")
 }
+func (UnimplementedInvoiceServiceServer) GetInvoiceUBL(context.Context, *GetInvoiceUBLRequest) (*GetInvoiceUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceUBL not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ImportInvoiceUBL(context.Context, *ImportInvoiceUBLRequest) (*ImportInvoiceUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInvoiceUBL not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceCII(context.Context, *GetInvoiceCIIRequest) (*GetInvoiceCIIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceCII not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ImportInvoiceCII(context.Context, *ImportInvoiceCIIRequest) (*ImportInvoiceCIIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInvoiceCII not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceFacturX(context.Context, *GetInvoiceFacturXRequest) (*GetInvoiceFacturXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceFacturX not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ValidateDocument(context.Context, *ValidateDocumentRequest) (*ValidateDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateDocument not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ValidateInvoice(context.Context, *ValidateInvoiceRequest) (*ValidateInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) IssueInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SendInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) AcknowledgeInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkInvoicePartiallyPaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInvoicePartiallyPaid not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkInvoicePaid(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInvoicePaid not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkInvoiceDisputed(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInvoiceDisputed not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CancelInvoice(context.Context, *TransitionDocumentStatusRequest) (*TransitionDocumentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceStatusTransitions(context.Context, *GetDocumentStatusTransitionsRequest) (*GetDocumentStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceStatusTransitions not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ReviseInvoice(context.Context, *ReviseInvoiceRequest) (*ReviseInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviseInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceVersions not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DiffInvoiceVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffInvoiceVersions not implemented")
+}
 func (UnimplementedInvoiceServiceServer) mustEmbedUnimplementedInvoiceServiceServer() {}
 func (UnimplementedInvoiceServiceServer) testEmbeddedByValue()                        {}
 
@@ -294,6 +615,42 @@ func _InvoiceService_GetInvoiceLines_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvoiceService_UpdateInvoiceLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInvoiceLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateInvoiceLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateInvoiceLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateInvoiceLine(ctx, req.(*UpdateInvoiceLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteInvoiceLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvoiceLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteInvoiceLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteInvoiceLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteInvoiceLine(ctx, req.(*DeleteInvoiceLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InvoiceService_UpdateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateInvoiceRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +669,330 @@ func _InvoiceService_UpdateInvoice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvoiceService_GetInvoiceUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceUBL(ctx, req.(*GetInvoiceUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ImportInvoiceUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInvoiceUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ImportInvoiceUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ImportInvoiceUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ImportInvoiceUBL(ctx, req.(*ImportInvoiceUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceCII_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceCIIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceCII(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceCII_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceCII(ctx, req.(*GetInvoiceCIIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ImportInvoiceCII_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInvoiceCIIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ImportInvoiceCII(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ImportInvoiceCII_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ImportInvoiceCII(ctx, req.(*ImportInvoiceCIIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceFacturX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceFacturXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceFacturX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceFacturX_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceFacturX(ctx, req.(*GetInvoiceFacturXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ValidateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ValidateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ValidateDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ValidateDocument(ctx, req.(*ValidateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ValidateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ValidateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ValidateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ValidateInvoice(ctx, req.(*ValidateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_IssueInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).IssueInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_IssueInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).IssueInvoice(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SendInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SendInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SendInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SendInvoice(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_AcknowledgeInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).AcknowledgeInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_AcknowledgeInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).AcknowledgeInvoice(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkInvoicePartiallyPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkInvoicePartiallyPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkInvoicePartiallyPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkInvoicePartiallyPaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkInvoicePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkInvoicePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkInvoicePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkInvoicePaid(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkInvoiceDisputed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkInvoiceDisputed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkInvoiceDisputed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkInvoiceDisputed(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CancelInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CancelInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CancelInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CancelInvoice(ctx, req.(*TransitionDocumentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceStatusTransitions(ctx, req.(*GetDocumentStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ReviseInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviseInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ReviseInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ReviseInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ReviseInvoice(ctx, req.(*ReviseInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceVersions(ctx, req.(*v1.GetDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DiffInvoiceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DiffDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DiffInvoiceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DiffInvoiceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DiffInvoiceVersions(ctx, req.(*v1.DiffDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InvoiceService_ServiceDesc is the grpc.ServiceDesc for InvoiceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,10 +1024,90 @@ var InvoiceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInvoiceLines",
 			Handler:    _InvoiceService_GetInvoiceLines_Handler,
 		},
+		{
+			MethodName: "UpdateInvoiceLine",
+			Handler:    _InvoiceService_UpdateInvoiceLine_Handler,
+		},
+		{
+			MethodName: "DeleteInvoiceLine",
+			Handler:    _InvoiceService_DeleteInvoiceLine_Handler,
+		},
 		{
 			MethodName: "UpdateInvoice",
 			Handler:    _InvoiceService_UpdateInvoice_Handler,
 		},
+		{
+			MethodName: "GetInvoiceUBL",
+			Handler:    _InvoiceService_GetInvoiceUBL_Handler,
+		},
+		{
+			MethodName: "ImportInvoiceUBL",
+			Handler:    _InvoiceService_ImportInvoiceUBL_Handler,
+		},
+		{
+			MethodName: "GetInvoiceCII",
+			Handler:    _InvoiceService_GetInvoiceCII_Handler,
+		},
+		{
+			MethodName: "ImportInvoiceCII",
+			Handler:    _InvoiceService_ImportInvoiceCII_Handler,
+		},
+		{
+			MethodName: "GetInvoiceFacturX",
+			Handler:    _InvoiceService_GetInvoiceFacturX_Handler,
+		},
+		{
+			MethodName: "ValidateDocument",
+			Handler:    _InvoiceService_ValidateDocument_Handler,
+		},
+		{
+			MethodName: "ValidateInvoice",
+			Handler:    _InvoiceService_ValidateInvoice_Handler,
+		},
+		{
+			MethodName: "IssueInvoice",
+			Handler:    _InvoiceService_IssueInvoice_Handler,
+		},
+		{
+			MethodName: "SendInvoice",
+			Handler:    _InvoiceService_SendInvoice_Handler,
+		},
+		{
+			MethodName: "AcknowledgeInvoice",
+			Handler:    _InvoiceService_AcknowledgeInvoice_Handler,
+		},
+		{
+			MethodName: "MarkInvoicePartiallyPaid",
+			Handler:    _InvoiceService_MarkInvoicePartiallyPaid_Handler,
+		},
+		{
+			MethodName: "MarkInvoicePaid",
+			Handler:    _InvoiceService_MarkInvoicePaid_Handler,
+		},
+		{
+			MethodName: "MarkInvoiceDisputed",
+			Handler:    _InvoiceService_MarkInvoiceDisputed_Handler,
+		},
+		{
+			MethodName: "CancelInvoice",
+			Handler:    _InvoiceService_CancelInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoiceStatusTransitions",
+			Handler:    _InvoiceService_GetInvoiceStatusTransitions_Handler,
+		},
+		{
+			MethodName: "ReviseInvoice",
+			Handler:    _InvoiceService_ReviseInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoiceVersions",
+			Handler:    _InvoiceService_GetInvoiceVersions_Handler,
+		},
+		{
+			MethodName: "DiffInvoiceVersions",
+			Handler:    _InvoiceService_DiffInvoiceVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoice/v1/invoice.proto",
@@ -606,6 +606,8 @@ func (m *CreateItemRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemRequestMultiError(errors)
 	}
@@ -1414,6 +1416,8 @@ func (m *CreateItemPropertyRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemPropertyRequestMultiError(errors)
 	}
@@ -1777,6 +1781,8 @@ func (m *CreateItemPropertyGroupRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemPropertyGroupRequestMultiError(errors)
 	}
@@ -2144,6 +2150,8 @@ func (m *CreateItemDimensionRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemDimensionRequestMultiError(errors)
 	}
@@ -2511,6 +2519,8 @@ func (m *CreateItemCommodityClassificationRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemCommodityClassificationRequestMultiError(errors)
 	}
@@ -2882,6 +2892,8 @@ func (m *CreateItemCertificateRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemCertificateRequestMultiError(errors)
 	}
@@ -3657,6 +3669,8 @@ func (m *CreateItemInstanceRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateItemInstanceRequestMultiError(errors)
 	}
@@ -694,6 +694,8 @@ func (m *CreateConsignmentRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateConsignmentRequestMultiError(errors)
 	}
@@ -598,6 +598,8 @@ func (m *CreateDespatchHeaderRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateDespatchHeaderRequestMultiError(errors)
 	}
@@ -1957,6 +1959,8 @@ func (m *CreateDespatchLineRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateDespatchLineRequestMultiError(errors)
 	}
@@ -2435,33 +2439,43 @@ var _ interface {
 	ErrorName() string
 } = GetDespatchLinesResponseValidationError{}
 
-// Validate checks the field values on UpdateDespatchHeaderRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateDespatchLineRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDespatchHeaderRequest) Validate() error {
+func (m *UpdateDespatchLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDespatchHeaderRequest with the
+// ValidateAll checks the field values on UpdateDespatchLineRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDespatchHeaderRequestMultiError, or nil if none found.
-func (m *UpdateDespatchHeaderRequest) ValidateAll() error {
+// UpdateDespatchLineRequestMultiError, or nil if none found.
+func (m *UpdateDespatchLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDespatchHeaderRequest) validate(all bool) error {
+func (m *UpdateDespatchLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DocumentStatusCode
+	// no validation rules for Note
 
-	// no validation rules for DespatchAdviceTypeCode
+	// no validation rules for LineStatusCode
 
-	// no validation rules for Note
+	// no validation rules for DeliveredQuantity
+
+	// no validation rules for BackorderQuantity
+
+	// no validation rules for BackorderReason
+
+	// no validation rules for OutstandingQuantity
+
+	// no validation rules for OutstandingReason
+
+	// no validation rules for OversupplyQuantity
 
 	// no validation rules for Id
 
@@ -2472,19 +2486,121 @@ func (m *UpdateDespatchHeaderRequest) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return UpdateDespatchHeaderRequestMultiError(errors)
+		return UpdateDespatchLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDespatchHeaderRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateDespatchHeaderRequest.ValidateAll() if
+// UpdateDespatchLineRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDespatchLineRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateDespatchLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDespatchLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDespatchLineRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDespatchLineRequestValidationError is the validation error returned by
+// UpdateDespatchLineRequest.Validate if the designated constraints aren't met.
+type UpdateDespatchLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDespatchLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDespatchLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDespatchLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDespatchLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDespatchLineRequestValidationError) ErrorName() string {
+	return "UpdateDespatchLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDespatchLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDespatchLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDespatchLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDespatchLineRequestValidationError{}
+
+// Validate checks the field values on UpdateDespatchLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDespatchLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDespatchLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDespatchLineResponseMultiError, or nil if none found.
+func (m *UpdateDespatchLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDespatchLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateDespatchLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDespatchLineResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDespatchLineResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateDespatchHeaderRequestMultiError []error
+type UpdateDespatchLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDespatchHeaderRequestMultiError) Error() string {
+func (m UpdateDespatchLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2493,12 +2609,11 @@ func (m UpdateDespatchHeaderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDespatchHeaderRequestMultiError) AllErrors() []error { return m }
+func (m UpdateDespatchLineResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDespatchHeaderRequestValidationError is the validation error returned
-// by UpdateDespatchHeaderRequest.Validate if the designated constraints
-// aren't met.
-type UpdateDespatchHeaderRequestValidationError struct {
+// UpdateDespatchLineResponseValidationError is the validation error returned
+// by UpdateDespatchLineResponse.Validate if the designated constraints aren't met.
+type UpdateDespatchLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2506,24 +2621,24 @@ type UpdateDespatchHeaderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDespatchHeaderRequestValidationError) Field() string { return e.field }
+func (e UpdateDespatchLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDespatchHeaderRequestValidationError) Reason() string { return e.reason }
+func (e UpdateDespatchLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDespatchHeaderRequestValidationError) Cause() error { return e.cause }
+func (e UpdateDespatchLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDespatchHeaderRequestValidationError) Key() bool { return e.key }
+func (e UpdateDespatchLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDespatchHeaderRequestValidationError) ErrorName() string {
-	return "UpdateDespatchHeaderRequestValidationError"
+func (e UpdateDespatchLineResponseValidationError) ErrorName() string {
+	return "UpdateDespatchLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDespatchHeaderRequestValidationError) Error() string {
+func (e UpdateDespatchLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2535,14 +2650,14 @@ func (e UpdateDespatchHeaderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDespatchHeaderRequest.%s: %s%s",
+		"invalid %sUpdateDespatchLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDespatchHeaderRequestValidationError{}
+var _ error = UpdateDespatchLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2550,24 +2665,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDespatchHeaderRequestValidationError{}
+} = UpdateDespatchLineResponseValidationError{}
 
-// Validate checks the field values on UpdateDespatchHeaderResponse with the
+// Validate checks the field values on DeleteDespatchLineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDespatchLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDespatchLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDespatchLineRequestMultiError, or nil if none found.
+func (m *DeleteDespatchLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDespatchLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteDespatchLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteDespatchLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteDespatchLineRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteDespatchLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDespatchLineRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDespatchLineRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteDespatchLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDespatchLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDespatchLineRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDespatchLineRequestValidationError is the validation error returned by
+// DeleteDespatchLineRequest.Validate if the designated constraints aren't met.
+type DeleteDespatchLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDespatchLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDespatchLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDespatchLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDespatchLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDespatchLineRequestValidationError) ErrorName() string {
+	return "DeleteDespatchLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDespatchLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDespatchLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDespatchLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDespatchLineRequestValidationError{}
+
+// Validate checks the field values on DeleteDespatchLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDespatchHeaderResponse) Validate() error {
+func (m *DeleteDespatchLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDespatchHeaderResponse with the
+// ValidateAll checks the field values on DeleteDespatchLineResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDespatchHeaderResponseMultiError, or nil if none found.
-func (m *UpdateDespatchHeaderResponse) ValidateAll() error {
+// DeleteDespatchLineResponseMultiError, or nil if none found.
+func (m *DeleteDespatchLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDespatchHeaderResponse) validate(all bool) error {
+func (m *DeleteDespatchLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2575,19 +2821,19 @@ func (m *UpdateDespatchHeaderResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UpdateDespatchHeaderResponseMultiError(errors)
+		return DeleteDespatchLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDespatchHeaderResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateDespatchHeaderResponse.ValidateAll() if
+// DeleteDespatchLineResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDespatchLineResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateDespatchHeaderResponseMultiError []error
+type DeleteDespatchLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDespatchHeaderResponseMultiError) Error() string {
+func (m DeleteDespatchLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2596,12 +2842,11 @@ func (m UpdateDespatchHeaderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDespatchHeaderResponseMultiError) AllErrors() []error { return m }
+func (m DeleteDespatchLineResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDespatchHeaderResponseValidationError is the validation error returned
-// by UpdateDespatchHeaderResponse.Validate if the designated constraints
-// aren't met.
-type UpdateDespatchHeaderResponseValidationError struct {
+// DeleteDespatchLineResponseValidationError is the validation error returned
+// by DeleteDespatchLineResponse.Validate if the designated constraints aren't met.
+type DeleteDespatchLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2609,24 +2854,24 @@ type UpdateDespatchHeaderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDespatchHeaderResponseValidationError) Field() string { return e.field }
+func (e DeleteDespatchLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDespatchHeaderResponseValidationError) Reason() string { return e.reason }
+func (e DeleteDespatchLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDespatchHeaderResponseValidationError) Cause() error { return e.cause }
+func (e DeleteDespatchLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDespatchHeaderResponseValidationError) Key() bool { return e.key }
+func (e DeleteDespatchLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDespatchHeaderResponseValidationError) ErrorName() string {
-	return "UpdateDespatchHeaderResponseValidationError"
+func (e DeleteDespatchLineResponseValidationError) ErrorName() string {
+	return "DeleteDespatchLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDespatchHeaderResponseValidationError) Error() string {
+func (e DeleteDespatchLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2638,14 +2883,14 @@ func (e UpdateDespatchHeaderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDespatchHeaderResponse.%s: %s%s",
+		"invalid %sDeleteDespatchLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDespatchHeaderResponseValidationError{}
+var _ error = DeleteDespatchLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2653,4 +2898,702 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDespatchHeaderResponseValidationError{}
+} = DeleteDespatchLineResponseValidationError{}
+
+// Validate checks the field values on UpdateDespatchHeaderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDespatchHeaderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDespatchHeaderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDespatchHeaderRequestMultiError, or nil if none found.
+func (m *UpdateDespatchHeaderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDespatchHeaderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DocumentStatusCode
+
+	// no validation rules for DespatchAdviceTypeCode
+
+	// no validation rules for Note
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return UpdateDespatchHeaderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDespatchHeaderRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateDespatchHeaderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDespatchHeaderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDespatchHeaderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDespatchHeaderRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDespatchHeaderRequestValidationError is the validation error returned
+// by UpdateDespatchHeaderRequest.Validate if the designated constraints
+// aren't met.
+type UpdateDespatchHeaderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDespatchHeaderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDespatchHeaderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDespatchHeaderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDespatchHeaderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDespatchHeaderRequestValidationError) ErrorName() string {
+	return "UpdateDespatchHeaderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDespatchHeaderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDespatchHeaderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDespatchHeaderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDespatchHeaderRequestValidationError{}
+
+// Validate checks the field values on UpdateDespatchHeaderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDespatchHeaderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDespatchHeaderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDespatchHeaderResponseMultiError, or nil if none found.
+func (m *UpdateDespatchHeaderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDespatchHeaderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateDespatchHeaderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDespatchHeaderResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDespatchHeaderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDespatchHeaderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDespatchHeaderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDespatchHeaderResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDespatchHeaderResponseValidationError is the validation error returned
+// by UpdateDespatchHeaderResponse.Validate if the designated constraints
+// aren't met.
+type UpdateDespatchHeaderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDespatchHeaderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDespatchHeaderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDespatchHeaderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDespatchHeaderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDespatchHeaderResponseValidationError) ErrorName() string {
+	return "UpdateDespatchHeaderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDespatchHeaderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDespatchHeaderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDespatchHeaderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDespatchHeaderResponseValidationError{}
+
+// Validate checks the field values on GetDespatchUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDespatchUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDespatchUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDespatchUBLRequestMultiError, or nil if none found.
+func (m *GetDespatchUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDespatchUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDespatchUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDespatchUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDespatchUBLRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDespatchUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDespatchUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDespatchUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDespatchUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDespatchUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDespatchUBLRequestMultiError) AllErrors() []error { return m }
+
+// GetDespatchUBLRequestValidationError is the validation error returned by
+// GetDespatchUBLRequest.Validate if the designated constraints aren't met.
+type GetDespatchUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDespatchUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDespatchUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDespatchUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDespatchUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDespatchUBLRequestValidationError) ErrorName() string {
+	return "GetDespatchUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDespatchUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDespatchUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDespatchUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDespatchUBLRequestValidationError{}
+
+// Validate checks the field values on GetDespatchUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDespatchUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDespatchUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDespatchUBLResponseMultiError, or nil if none found.
+func (m *GetDespatchUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDespatchUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	if len(errors) > 0 {
+		return GetDespatchUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDespatchUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDespatchUBLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDespatchUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDespatchUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDespatchUBLResponseMultiError) AllErrors() []error { return m }
+
+// GetDespatchUBLResponseValidationError is the validation error returned by
+// GetDespatchUBLResponse.Validate if the designated constraints aren't met.
+type GetDespatchUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDespatchUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDespatchUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDespatchUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDespatchUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDespatchUBLResponseValidationError) ErrorName() string {
+	return "GetDespatchUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDespatchUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDespatchUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDespatchUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDespatchUBLResponseValidationError{}
+
+// Validate checks the field values on ImportDespatchUBLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportDespatchUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportDespatchUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportDespatchUBLRequestMultiError, or nil if none found.
+func (m *ImportDespatchUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportDespatchUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportDespatchUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportDespatchUBLRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportDespatchUBLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportDespatchUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportDespatchUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportDespatchUBLRequestMultiError) AllErrors() []error { return m }
+
+// ImportDespatchUBLRequestValidationError is the validation error returned by
+// ImportDespatchUBLRequest.Validate if the designated constraints aren't met.
+type ImportDespatchUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportDespatchUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportDespatchUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportDespatchUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportDespatchUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportDespatchUBLRequestValidationError) ErrorName() string {
+	return "ImportDespatchUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportDespatchUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportDespatchUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportDespatchUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportDespatchUBLRequestValidationError{}
+
+// Validate checks the field values on ImportDespatchUBLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportDespatchUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportDespatchUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportDespatchUBLResponseMultiError, or nil if none found.
+func (m *ImportDespatchUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportDespatchUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDespatchHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportDespatchUBLResponseValidationError{
+					field:  "DespatchHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportDespatchUBLResponseValidationError{
+					field:  "DespatchHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDespatchHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportDespatchUBLResponseValidationError{
+				field:  "DespatchHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportDespatchUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportDespatchUBLResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportDespatchUBLResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ImportDespatchUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportDespatchUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportDespatchUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportDespatchUBLResponseValidationError is the validation error returned by
+// ImportDespatchUBLResponse.Validate if the designated constraints aren't met.
+type ImportDespatchUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportDespatchUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportDespatchUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportDespatchUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportDespatchUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportDespatchUBLResponseValidationError) ErrorName() string {
+	return "ImportDespatchUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportDespatchUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportDespatchUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportDespatchUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportDespatchUBLResponseValidationError{}
@@ -25,7 +25,11 @@ const (
 	DespatchService_GetDespatchHeaderByPk_FullMethodName = "/logistics.v1.DespatchService/GetDespatchHeaderByPk"
 	DespatchService_CreateDespatchLine_FullMethodName    = "/logistics.v1.DespatchService/CreateDespatchLine"
 	DespatchService_GetDespatchLines_FullMethodName      = "/logistics.v1.DespatchService/GetDespatchLines"
+	DespatchService_UpdateDespatchLine_FullMethodName    = "/logistics.v1.DespatchService/UpdateDespatchLine"
+	DespatchService_DeleteDespatchLine_FullMethodName    = "/logistics.v1.DespatchService/DeleteDespatchLine"
 	DespatchService_UpdateDespatchHeader_FullMethodName  = "/logistics.v1.DespatchService/UpdateDespatchHeader"
+	DespatchService_GetDespatchUBL_FullMethodName        = "/logistics.v1.DespatchService/GetDespatchUBL"
+	DespatchService_ImportDespatchUBL_FullMethodName     = "/logistics.v1.DespatchService/ImportDespatchUBL"
 )
 
 // DespatchServiceClient is the client API for DespatchService service.
@@ -40,7 +44,11 @@ type DespatchServiceClient interface {
 	GetDespatchHeaderByPk(ctx context.Context, in *GetDespatchHeaderByPkRequest, opts ...grpc.CallOption) (*GetDespatchHeaderByPkResponse, error)
 	CreateDespatchLine(ctx context.Context, in *CreateDespatchLineRequest, opts ...grpc.CallOption) (*CreateDespatchLineResponse, error)
 	GetDespatchLines(ctx context.Context, in *GetDespatchLinesRequest, opts ...grpc.CallOption) (*GetDespatchLinesResponse, error)
+	UpdateDespatchLine(ctx context.Context, in *UpdateDespatchLineRequest, opts ...grpc.CallOption) (*UpdateDespatchLineResponse, error)
+	DeleteDespatchLine(ctx context.Context, in *DeleteDespatchLineRequest, opts ...grpc.CallOption) (*DeleteDespatchLineResponse, error)
 	UpdateDespatchHeader(ctx context.Context, in *UpdateDespatchHeaderRequest, opts ...grpc.CallOption) (*UpdateDespatchHeaderResponse, error)
+	GetDespatchUBL(ctx context.Context, in *GetDespatchUBLRequest, opts ...grpc.CallOption) (*GetDespatchUBLResponse, error)
+	ImportDespatchUBL(ctx context.Context, in *ImportDespatchUBLRequest, opts ...grpc.CallOption) (*ImportDespatchUBLResponse, error)
 }
 
 type despatchServiceClient struct {
@@ -111,6 +119,26 @@ func (c *despatchServiceClient) GetDespatchLines(ctx context.Context, in *GetDes
 	return out, nil
 }
 
+func (c *despatchServiceClient) UpdateDespatchLine(ctx context.Context, in *UpdateDespatchLineRequest, opts ...grpc.CallOption) (*UpdateDespatchLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDespatchLineResponse)
+	err := c.cc.Invoke(ctx, DespatchService_UpdateDespatchLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *despatchServiceClient) DeleteDespatchLine(ctx context.Context, in *DeleteDespatchLineRequest, opts ...grpc.CallOption) (*DeleteDespatchLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDespatchLineResponse)
+	err := c.cc.Invoke(ctx, DespatchService_DeleteDespatchLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *despatchServiceClient) UpdateDespatchHeader(ctx context.Context, in *UpdateDespatchHeaderRequest, opts ...grpc.CallOption) (*UpdateDespatchHeaderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateDespatchHeaderResponse)
@@ -121,6 +149,26 @@ func (c *despatchServiceClient) UpdateDespatchHeader(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *despatchServiceClient) GetDespatchUBL(ctx context.Context, in *GetDespatchUBLRequest, opts ...grpc.CallOption) (*GetDespatchUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDespatchUBLResponse)
+	err := c.cc.Invoke(ctx, DespatchService_GetDespatchUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *despatchServiceClient) ImportDespatchUBL(ctx context.Context, in *ImportDespatchUBLRequest, opts ...grpc.CallOption) (*ImportDespatchUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDespatchUBLResponse)
+	err := c.cc.Invoke(ctx, DespatchService_ImportDespatchUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DespatchServiceServer is the server API for DespatchService service.
 // All implementations must embed UnimplementedDespatchServiceServer
 // for forward compatibility.
@@ -133,7 +181,11 @@ type DespatchServiceServer interface {
 	GetDespatchHeaderByPk(context.Context, *GetDespatchHeaderByPkRequest) (*GetDespatchHeaderByPkResponse, error)
 	CreateDespatchLine(context.Context, *CreateDespatchLineRequest) (*CreateDespatchLineResponse, error)
 	GetDespatchLines(context.Context, *GetDespatchLinesRequest) (*GetDespatchLinesResponse, error)
+	UpdateDespatchLine(context.Context, *UpdateDespatchLineRequest) (*UpdateDespatchLineResponse, error)
+	DeleteDespatchLine(context.Context, *DeleteDespatchLineRequest) (*DeleteDespatchLineResponse, error)
 	UpdateDespatchHeader(context.Context, *UpdateDespatchHeaderRequest) (*UpdateDespatchHeaderResponse, error)
+	GetDespatchUBL(context.Context, *GetDespatchUBLRequest) (*GetDespatchUBLResponse, error)
+	ImportDespatchUBL(context.Context, *ImportDespatchUBLRequest) (*ImportDespatchUBLResponse, error)
 	mustEmbedUnimplementedDespatchServiceServer()
 }
 
@@ -162,9 +214,21 @@ func (UnimplementedDespatchServiceServer) CreateDespatchLine(context.Context, *C
 func (UnimplementedDespatchServiceServer) GetDespatchLines(context.Context, *GetDespatchLinesRequest) (*GetDespatchLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDespatchLines not implemented")
 }
+func (UnimplementedDespatchServiceServer) UpdateDespatchLine(context.Context, *UpdateDespatchLineRequest) (*UpdateDespatchLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDespatchLine not implemented")
+}
+func (UnimplementedDespatchServiceServer) DeleteDespatchLine(context.Context, *DeleteDespatchLineRequest) (*DeleteDespatchLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDespatchLine not implemented")
+}
 func (UnimplementedDespatchServiceServer) UpdateDespatchHeader(context.Context, *UpdateDespatchHeaderRequest) (*UpdateDespatchHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDespatchHeader not implemented")
 }
+func (UnimplementedDespatchServiceServer) GetDespatchUBL(context.Context, *GetDespatchUBLRequest) (*GetDespatchUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDespatchUBL not implemented")
+}
+func (UnimplementedDespatchServiceServer) ImportDespatchUBL(context.Context, *ImportDespatchUBLRequest) (*ImportDespatchUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDespatchUBL not implemented")
+}
 func (UnimplementedDespatchServiceServer) mustEmbedUnimplementedDespatchServiceServer() {}
 func (UnimplementedDespatchServiceServer) testEmbeddedByValue()                         {}
 
@@ -294,6 +358,42 @@ func _DespatchService_GetDespatchLines_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DespatchService_UpdateDespatchLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDespatchLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DespatchServiceServer).UpdateDespatchLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DespatchService_UpdateDespatchLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DespatchServiceServer).UpdateDespatchLine(ctx, req.(*UpdateDespatchLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DespatchService_DeleteDespatchLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDespatchLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DespatchServiceServer).DeleteDespatchLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DespatchService_DeleteDespatchLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DespatchServiceServer).DeleteDespatchLine(ctx, req.(*DeleteDespatchLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DespatchService_UpdateDespatchHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateDespatchHeaderRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +412,42 @@ func _DespatchService_UpdateDespatchHeader_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DespatchService_GetDespatchUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDespatchUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DespatchServiceServer).GetDespatchUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DespatchService_GetDespatchUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DespatchServiceServer).GetDespatchUBL(ctx, req.(*GetDespatchUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DespatchService_ImportDespatchUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDespatchUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DespatchServiceServer).ImportDespatchUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DespatchService_ImportDespatchUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DespatchServiceServer).ImportDespatchUBL(ctx, req.(*ImportDespatchUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DespatchService_ServiceDesc is the grpc.ServiceDesc for DespatchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,10 +479,26 @@ var DespatchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDespatchLines",
 			Handler:    _DespatchService_GetDespatchLines_Handler,
 		},
+		{
+			MethodName: "UpdateDespatchLine",
+			Handler:    _DespatchService_UpdateDespatchLine_Handler,
+		},
+		{
+			MethodName: "DeleteDespatchLine",
+			Handler:    _DespatchService_DeleteDespatchLine_Handler,
+		},
 		{
 			MethodName: "UpdateDespatchHeader",
 			Handler:    _DespatchService_UpdateDespatchHeader_Handler,
 		},
+		{
+			MethodName: "GetDespatchUBL",
+			Handler:    _DespatchService_GetDespatchUBL_Handler,
+		},
+		{
+			MethodName: "ImportDespatchUBL",
+			Handler:    _DespatchService_ImportDespatchUBL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logistics/v1/despatch.proto",
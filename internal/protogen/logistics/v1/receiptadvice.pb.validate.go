@@ -303,6 +303,8 @@ func (m *ReceiptAdviceHeaderD) validate(all bool) error {
 
 	// no validation rules for ShipmentId
 
+	// no validation rules for DocumentStatusCode
+
 	if len(errors) > 0 {
 		return ReceiptAdviceHeaderDMultiError(errors)
 	}
@@ -601,6 +603,10 @@ func (m *CreateReceiptAdviceHeaderRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for DocumentStatusCode
+
 	if len(errors) > 0 {
 		return CreateReceiptAdviceHeaderRequestMultiError(errors)
 	}
@@ -2158,6 +2164,8 @@ func (m *CreateReceiptAdviceLineRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateReceiptAdviceLineRequestMultiError(errors)
 	}
@@ -2640,34 +2648,45 @@ var _ interface {
 	ErrorName() string
 } = GetReceiptAdviceLinesResponseValidationError{}
 
-// Validate checks the field values on UpdateReceiptAdviceHeaderRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateReceiptAdviceHeaderRequest) Validate() error {
+// Validate checks the field values on UpdateReceiptAdviceLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReceiptAdviceLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReceiptAdviceHeaderRequest with
+// ValidateAll checks the field values on UpdateReceiptAdviceLineRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// UpdateReceiptAdviceHeaderRequestMultiError, or nil if none found.
-func (m *UpdateReceiptAdviceHeaderRequest) ValidateAll() error {
+// UpdateReceiptAdviceLineRequestMultiError, or nil if none found.
+func (m *UpdateReceiptAdviceLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReceiptAdviceHeaderRequest) validate(all bool) error {
+func (m *UpdateReceiptAdviceLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReceiptAdviceTypeCode
-
 	// no validation rules for Note
 
-	// no validation rules for LineCountNumeric
+	// no validation rules for ReceivedQuantity
+
+	// no validation rules for ShortQuantity
+
+	// no validation rules for ShortageActionCode
+
+	// no validation rules for RejectedQuantity
+
+	// no validation rules for RejectReasonCode
+
+	// no validation rules for RejectReason
+
+	// no validation rules for RejectActionCode
+
+	// no validation rules for OversupplyQuantity
 
 	// no validation rules for Id
 
@@ -2678,20 +2697,19 @@ func (m *UpdateReceiptAdviceHeaderRequest) validate(all bool) error {
 	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return UpdateReceiptAdviceHeaderRequestMultiError(errors)
+		return UpdateReceiptAdviceLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReceiptAdviceHeaderRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateReceiptAdviceHeaderRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateReceiptAdviceHeaderRequestMultiError []error
+// UpdateReceiptAdviceLineRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateReceiptAdviceLineRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateReceiptAdviceLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReceiptAdviceHeaderRequestMultiError) Error() string {
+func (m UpdateReceiptAdviceLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2700,12 +2718,12 @@ func (m UpdateReceiptAdviceHeaderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReceiptAdviceHeaderRequestMultiError) AllErrors() []error { return m }
+func (m UpdateReceiptAdviceLineRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReceiptAdviceHeaderRequestValidationError is the validation error
-// returned by UpdateReceiptAdviceHeaderRequest.Validate if the designated
+// UpdateReceiptAdviceLineRequestValidationError is the validation error
+// returned by UpdateReceiptAdviceLineRequest.Validate if the designated
 // constraints aren't met.
-type UpdateReceiptAdviceHeaderRequestValidationError struct {
+type UpdateReceiptAdviceLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2713,24 +2731,24 @@ type UpdateReceiptAdviceHeaderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReceiptAdviceHeaderRequestValidationError) Field() string { return e.field }
+func (e UpdateReceiptAdviceLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReceiptAdviceHeaderRequestValidationError) Reason() string { return e.reason }
+func (e UpdateReceiptAdviceLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReceiptAdviceHeaderRequestValidationError) Cause() error { return e.cause }
+func (e UpdateReceiptAdviceLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReceiptAdviceHeaderRequestValidationError) Key() bool { return e.key }
+func (e UpdateReceiptAdviceLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReceiptAdviceHeaderRequestValidationError) ErrorName() string {
-	return "UpdateReceiptAdviceHeaderRequestValidationError"
+func (e UpdateReceiptAdviceLineRequestValidationError) ErrorName() string {
+	return "UpdateReceiptAdviceLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReceiptAdviceHeaderRequestValidationError) Error() string {
+func (e UpdateReceiptAdviceLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2742,14 +2760,14 @@ func (e UpdateReceiptAdviceHeaderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReceiptAdviceHeaderRequest.%s: %s%s",
+		"invalid %sUpdateReceiptAdviceLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReceiptAdviceHeaderRequestValidationError{}
+var _ error = UpdateReceiptAdviceLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2757,25 +2775,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReceiptAdviceHeaderRequestValidationError{}
+} = UpdateReceiptAdviceLineRequestValidationError{}
 
-// Validate checks the field values on UpdateReceiptAdviceHeaderResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateReceiptAdviceHeaderResponse) Validate() error {
+// Validate checks the field values on UpdateReceiptAdviceLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReceiptAdviceLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReceiptAdviceHeaderResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateReceiptAdviceHeaderResponseMultiError, or nil if none found.
-func (m *UpdateReceiptAdviceHeaderResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateReceiptAdviceLineResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateReceiptAdviceLineResponseMultiError, or nil if none found.
+func (m *UpdateReceiptAdviceLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReceiptAdviceHeaderResponse) validate(all bool) error {
+func (m *UpdateReceiptAdviceLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2783,20 +2800,151 @@ func (m *UpdateReceiptAdviceHeaderResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UpdateReceiptAdviceHeaderResponseMultiError(errors)
+		return UpdateReceiptAdviceLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReceiptAdviceHeaderResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateReceiptAdviceHeaderResponse.ValidateAll() if the designated
+// UpdateReceiptAdviceLineResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateReceiptAdviceLineResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateReceiptAdviceLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReceiptAdviceLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReceiptAdviceLineResponseMultiError) AllErrors() []error { return m }
+
+// UpdateReceiptAdviceLineResponseValidationError is the validation error
+// returned by UpdateReceiptAdviceLineResponse.Validate if the designated
 // constraints aren't met.
-type UpdateReceiptAdviceHeaderResponseMultiError []error
+type UpdateReceiptAdviceLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReceiptAdviceLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReceiptAdviceLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReceiptAdviceLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReceiptAdviceLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReceiptAdviceLineResponseValidationError) ErrorName() string {
+	return "UpdateReceiptAdviceLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReceiptAdviceLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReceiptAdviceLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReceiptAdviceLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReceiptAdviceLineResponseValidationError{}
+
+// Validate checks the field values on DeleteReceiptAdviceLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReceiptAdviceLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReceiptAdviceLineRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteReceiptAdviceLineRequestMultiError, or nil if none found.
+func (m *DeleteReceiptAdviceLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReceiptAdviceLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReceiptAdviceLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReceiptAdviceLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReceiptAdviceLineRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteReceiptAdviceLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReceiptAdviceLineRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteReceiptAdviceLineRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteReceiptAdviceLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReceiptAdviceHeaderResponseMultiError) Error() string {
+func (m DeleteReceiptAdviceLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2805,12 +2953,12 @@ func (m UpdateReceiptAdviceHeaderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReceiptAdviceHeaderResponseMultiError) AllErrors() []error { return m }
+func (m DeleteReceiptAdviceLineRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReceiptAdviceHeaderResponseValidationError is the validation error
-// returned by UpdateReceiptAdviceHeaderResponse.Validate if the designated
+// DeleteReceiptAdviceLineRequestValidationError is the validation error
+// returned by DeleteReceiptAdviceLineRequest.Validate if the designated
 // constraints aren't met.
-type UpdateReceiptAdviceHeaderResponseValidationError struct {
+type DeleteReceiptAdviceLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2818,24 +2966,24 @@ type UpdateReceiptAdviceHeaderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReceiptAdviceHeaderResponseValidationError) Field() string { return e.field }
+func (e DeleteReceiptAdviceLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReceiptAdviceHeaderResponseValidationError) Reason() string { return e.reason }
+func (e DeleteReceiptAdviceLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReceiptAdviceHeaderResponseValidationError) Cause() error { return e.cause }
+func (e DeleteReceiptAdviceLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReceiptAdviceHeaderResponseValidationError) Key() bool { return e.key }
+func (e DeleteReceiptAdviceLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReceiptAdviceHeaderResponseValidationError) ErrorName() string {
-	return "UpdateReceiptAdviceHeaderResponseValidationError"
+func (e DeleteReceiptAdviceLineRequestValidationError) ErrorName() string {
+	return "DeleteReceiptAdviceLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReceiptAdviceHeaderResponseValidationError) Error() string {
+func (e DeleteReceiptAdviceLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2847,14 +2995,14 @@ func (e UpdateReceiptAdviceHeaderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReceiptAdviceHeaderResponse.%s: %s%s",
+		"invalid %sDeleteReceiptAdviceLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReceiptAdviceHeaderResponseValidationError{}
+var _ error = DeleteReceiptAdviceLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2862,4 +3010,814 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReceiptAdviceHeaderResponseValidationError{}
+} = DeleteReceiptAdviceLineRequestValidationError{}
+
+// Validate checks the field values on DeleteReceiptAdviceLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReceiptAdviceLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReceiptAdviceLineResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteReceiptAdviceLineResponseMultiError, or nil if none found.
+func (m *DeleteReceiptAdviceLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReceiptAdviceLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReceiptAdviceLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReceiptAdviceLineResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteReceiptAdviceLineResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteReceiptAdviceLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReceiptAdviceLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReceiptAdviceLineResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReceiptAdviceLineResponseValidationError is the validation error
+// returned by DeleteReceiptAdviceLineResponse.Validate if the designated
+// constraints aren't met.
+type DeleteReceiptAdviceLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReceiptAdviceLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReceiptAdviceLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReceiptAdviceLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReceiptAdviceLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReceiptAdviceLineResponseValidationError) ErrorName() string {
+	return "DeleteReceiptAdviceLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReceiptAdviceLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReceiptAdviceLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReceiptAdviceLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReceiptAdviceLineResponseValidationError{}
+
+// Validate checks the field values on UpdateReceiptAdviceHeaderRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateReceiptAdviceHeaderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReceiptAdviceHeaderRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateReceiptAdviceHeaderRequestMultiError, or nil if none found.
+func (m *UpdateReceiptAdviceHeaderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReceiptAdviceHeaderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReceiptAdviceTypeCode
+
+	// no validation rules for Note
+
+	// no validation rules for LineCountNumeric
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for DocumentStatusCode
+
+	if len(errors) > 0 {
+		return UpdateReceiptAdviceHeaderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReceiptAdviceHeaderRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateReceiptAdviceHeaderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReceiptAdviceHeaderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReceiptAdviceHeaderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReceiptAdviceHeaderRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReceiptAdviceHeaderRequestValidationError is the validation error
+// returned by UpdateReceiptAdviceHeaderRequest.Validate if the designated
+// constraints aren't met.
+type UpdateReceiptAdviceHeaderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReceiptAdviceHeaderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReceiptAdviceHeaderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReceiptAdviceHeaderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReceiptAdviceHeaderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReceiptAdviceHeaderRequestValidationError) ErrorName() string {
+	return "UpdateReceiptAdviceHeaderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReceiptAdviceHeaderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReceiptAdviceHeaderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReceiptAdviceHeaderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReceiptAdviceHeaderRequestValidationError{}
+
+// Validate checks the field values on UpdateReceiptAdviceHeaderResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateReceiptAdviceHeaderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReceiptAdviceHeaderResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateReceiptAdviceHeaderResponseMultiError, or nil if none found.
+func (m *UpdateReceiptAdviceHeaderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReceiptAdviceHeaderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateReceiptAdviceHeaderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReceiptAdviceHeaderResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateReceiptAdviceHeaderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReceiptAdviceHeaderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReceiptAdviceHeaderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReceiptAdviceHeaderResponseMultiError) AllErrors() []error { return m }
+
+// UpdateReceiptAdviceHeaderResponseValidationError is the validation error
+// returned by UpdateReceiptAdviceHeaderResponse.Validate if the designated
+// constraints aren't met.
+type UpdateReceiptAdviceHeaderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReceiptAdviceHeaderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReceiptAdviceHeaderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReceiptAdviceHeaderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReceiptAdviceHeaderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReceiptAdviceHeaderResponseValidationError) ErrorName() string {
+	return "UpdateReceiptAdviceHeaderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReceiptAdviceHeaderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReceiptAdviceHeaderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReceiptAdviceHeaderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReceiptAdviceHeaderResponseValidationError{}
+
+// Validate checks the field values on GetReceiptAdviceUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReceiptAdviceUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReceiptAdviceUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReceiptAdviceUBLRequestMultiError, or nil if none found.
+func (m *GetReceiptAdviceUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReceiptAdviceUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReceiptAdviceUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReceiptAdviceUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReceiptAdviceUBLRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReceiptAdviceUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReceiptAdviceUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by GetReceiptAdviceUBLRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetReceiptAdviceUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReceiptAdviceUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReceiptAdviceUBLRequestMultiError) AllErrors() []error { return m }
+
+// GetReceiptAdviceUBLRequestValidationError is the validation error returned
+// by GetReceiptAdviceUBLRequest.Validate if the designated constraints aren't met.
+type GetReceiptAdviceUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReceiptAdviceUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReceiptAdviceUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReceiptAdviceUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReceiptAdviceUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReceiptAdviceUBLRequestValidationError) ErrorName() string {
+	return "GetReceiptAdviceUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReceiptAdviceUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReceiptAdviceUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReceiptAdviceUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReceiptAdviceUBLRequestValidationError{}
+
+// Validate checks the field values on GetReceiptAdviceUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReceiptAdviceUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReceiptAdviceUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReceiptAdviceUBLResponseMultiError, or nil if none found.
+func (m *GetReceiptAdviceUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReceiptAdviceUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	if len(errors) > 0 {
+		return GetReceiptAdviceUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReceiptAdviceUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReceiptAdviceUBLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetReceiptAdviceUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReceiptAdviceUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReceiptAdviceUBLResponseMultiError) AllErrors() []error { return m }
+
+// GetReceiptAdviceUBLResponseValidationError is the validation error returned
+// by GetReceiptAdviceUBLResponse.Validate if the designated constraints
+// aren't met.
+type GetReceiptAdviceUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReceiptAdviceUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReceiptAdviceUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReceiptAdviceUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReceiptAdviceUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReceiptAdviceUBLResponseValidationError) ErrorName() string {
+	return "GetReceiptAdviceUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReceiptAdviceUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReceiptAdviceUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReceiptAdviceUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReceiptAdviceUBLResponseValidationError{}
+
+// Validate checks the field values on ImportReceiptAdviceUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportReceiptAdviceUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportReceiptAdviceUBLRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportReceiptAdviceUBLRequestMultiError, or nil if none found.
+func (m *ImportReceiptAdviceUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportReceiptAdviceUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportReceiptAdviceUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportReceiptAdviceUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportReceiptAdviceUBLRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ImportReceiptAdviceUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportReceiptAdviceUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportReceiptAdviceUBLRequestMultiError) AllErrors() []error { return m }
+
+// ImportReceiptAdviceUBLRequestValidationError is the validation error
+// returned by ImportReceiptAdviceUBLRequest.Validate if the designated
+// constraints aren't met.
+type ImportReceiptAdviceUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReceiptAdviceUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReceiptAdviceUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReceiptAdviceUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReceiptAdviceUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReceiptAdviceUBLRequestValidationError) ErrorName() string {
+	return "ImportReceiptAdviceUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportReceiptAdviceUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReceiptAdviceUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReceiptAdviceUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReceiptAdviceUBLRequestValidationError{}
+
+// Validate checks the field values on ImportReceiptAdviceUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportReceiptAdviceUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportReceiptAdviceUBLResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportReceiptAdviceUBLResponseMultiError, or nil if none found.
+func (m *ImportReceiptAdviceUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportReceiptAdviceUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReceiptAdviceHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportReceiptAdviceUBLResponseValidationError{
+					field:  "ReceiptAdviceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportReceiptAdviceUBLResponseValidationError{
+					field:  "ReceiptAdviceHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceiptAdviceHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportReceiptAdviceUBLResponseValidationError{
+				field:  "ReceiptAdviceHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportReceiptAdviceUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportReceiptAdviceUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportReceiptAdviceUBLResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ImportReceiptAdviceUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportReceiptAdviceUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportReceiptAdviceUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportReceiptAdviceUBLResponseValidationError is the validation error
+// returned by ImportReceiptAdviceUBLResponse.Validate if the designated
+// constraints aren't met.
+type ImportReceiptAdviceUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReceiptAdviceUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReceiptAdviceUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReceiptAdviceUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReceiptAdviceUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReceiptAdviceUBLResponseValidationError) ErrorName() string {
+	return "ImportReceiptAdviceUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportReceiptAdviceUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReceiptAdviceUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReceiptAdviceUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReceiptAdviceUBLResponseValidationError{}
@@ -25,7 +25,11 @@ const (
 	ReceiptAdviceHeaderService_GetReceiptAdviceHeaderByPk_FullMethodName = "/logistics.v1.ReceiptAdviceHeaderService/GetReceiptAdviceHeaderByPk"
 	ReceiptAdviceHeaderService_CreateReceiptAdviceLine_FullMethodName    = "/logistics.v1.ReceiptAdviceHeaderService/CreateReceiptAdviceLine"
 	ReceiptAdviceHeaderService_GetReceiptAdviceLines_FullMethodName      = "/logistics.v1.ReceiptAdviceHeaderService/GetReceiptAdviceLines"
+	ReceiptAdviceHeaderService_UpdateReceiptAdviceLine_FullMethodName    = "/logistics.v1.ReceiptAdviceHeaderService/UpdateReceiptAdviceLine"
+	ReceiptAdviceHeaderService_DeleteReceiptAdviceLine_FullMethodName    = "/logistics.v1.ReceiptAdviceHeaderService/DeleteReceiptAdviceLine"
 	ReceiptAdviceHeaderService_UpdateReceiptAdviceHeader_FullMethodName  = "/logistics.v1.ReceiptAdviceHeaderService/UpdateReceiptAdviceHeader"
+	ReceiptAdviceHeaderService_GetReceiptAdviceUBL_FullMethodName        = "/logistics.v1.ReceiptAdviceHeaderService/GetReceiptAdviceUBL"
+	ReceiptAdviceHeaderService_ImportReceiptAdviceUBL_FullMethodName     = "/logistics.v1.ReceiptAdviceHeaderService/ImportReceiptAdviceUBL"
 )
 
 // ReceiptAdviceHeaderServiceClient is the client API for ReceiptAdviceHeaderService service.
@@ -40,7 +44,11 @@ type ReceiptAdviceHeaderServiceClient interface {
 	GetReceiptAdviceHeaderByPk(ctx context.Context, in *GetReceiptAdviceHeaderByPkRequest, opts ...grpc.CallOption) (*GetReceiptAdviceHeaderByPkResponse, error)
 	CreateReceiptAdviceLine(ctx context.Context, in *CreateReceiptAdviceLineRequest, opts ...grpc.CallOption) (*CreateReceiptAdviceLineResponse, error)
 	GetReceiptAdviceLines(ctx context.Context, in *GetReceiptAdviceLinesRequest, opts ...grpc.CallOption) (*GetReceiptAdviceLinesResponse, error)
+	UpdateReceiptAdviceLine(ctx context.Context, in *UpdateReceiptAdviceLineRequest, opts ...grpc.CallOption) (*UpdateReceiptAdviceLineResponse, error)
+	DeleteReceiptAdviceLine(ctx context.Context, in *DeleteReceiptAdviceLineRequest, opts ...grpc.CallOption) (*DeleteReceiptAdviceLineResponse, error)
 	UpdateReceiptAdviceHeader(ctx context.Context, in *UpdateReceiptAdviceHeaderRequest, opts ...grpc.CallOption) (*UpdateReceiptAdviceHeaderResponse, error)
+	GetReceiptAdviceUBL(ctx context.Context, in *GetReceiptAdviceUBLRequest, opts ...grpc.CallOption) (*GetReceiptAdviceUBLResponse, error)
+	ImportReceiptAdviceUBL(ctx context.Context, in *ImportReceiptAdviceUBLRequest, opts ...grpc.CallOption) (*ImportReceiptAdviceUBLResponse, error)
 }
 
 type receiptAdviceHeaderServiceClient struct {
@@ -111,6 +119,26 @@ func (c *receiptAdviceHeaderServiceClient) GetReceiptAdviceLines(ctx context.Con
 	return out, nil
 }
 
+func (c *receiptAdviceHeaderServiceClient) UpdateReceiptAdviceLine(ctx context.Context, in *UpdateReceiptAdviceLineRequest, opts ...grpc.CallOption) (*UpdateReceiptAdviceLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReceiptAdviceLineResponse)
+	err := c.cc.Invoke(ctx, ReceiptAdviceHeaderService_UpdateReceiptAdviceLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptAdviceHeaderServiceClient) DeleteReceiptAdviceLine(ctx context.Context, in *DeleteReceiptAdviceLineRequest, opts ...grpc.CallOption) (*DeleteReceiptAdviceLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReceiptAdviceLineResponse)
+	err := c.cc.Invoke(ctx, ReceiptAdviceHeaderService_DeleteReceiptAdviceLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *receiptAdviceHeaderServiceClient) UpdateReceiptAdviceHeader(ctx context.Context, in *UpdateReceiptAdviceHeaderRequest, opts ...grpc.CallOption) (*UpdateReceiptAdviceHeaderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateReceiptAdviceHeaderResponse)
@@ -121,6 +149,26 @@ func (c *receiptAdviceHeaderServiceClient) UpdateReceiptAdviceHeader(ctx context
 	return out, nil
 }
 
+func (c *receiptAdviceHeaderServiceClient) GetReceiptAdviceUBL(ctx context.Context, in *GetReceiptAdviceUBLRequest, opts ...grpc.CallOption) (*GetReceiptAdviceUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptAdviceUBLResponse)
+	err := c.cc.Invoke(ctx, ReceiptAdviceHeaderService_GetReceiptAdviceUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptAdviceHeaderServiceClient) ImportReceiptAdviceUBL(ctx context.Context, in *ImportReceiptAdviceUBLRequest, opts ...grpc.CallOption) (*ImportReceiptAdviceUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportReceiptAdviceUBLResponse)
+	err := c.cc.Invoke(ctx, ReceiptAdviceHeaderService_ImportReceiptAdviceUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReceiptAdviceHeaderServiceServer is the server API for ReceiptAdviceHeaderService service.
 // All implementations must embed UnimplementedReceiptAdviceHeaderServiceServer
 // for forward compatibility.
@@ -133,7 +181,11 @@ type ReceiptAdviceHeaderServiceServer interface {
 	GetReceiptAdviceHeaderByPk(context.Context, *GetReceiptAdviceHeaderByPkRequest) (*GetReceiptAdviceHeaderByPkResponse, error)
 	CreateReceiptAdviceLine(context.Context, *CreateReceiptAdviceLineRequest) (*CreateReceiptAdviceLineResponse, error)
 	GetReceiptAdviceLines(context.Context, *GetReceiptAdviceLinesRequest) (*GetReceiptAdviceLinesResponse, error)
+	UpdateReceiptAdviceLine(context.Context, *UpdateReceiptAdviceLineRequest) (*UpdateReceiptAdviceLineResponse, error)
+	DeleteReceiptAdviceLine(context.Context, *DeleteReceiptAdviceLineRequest) (*DeleteReceiptAdviceLineResponse, error)
 	UpdateReceiptAdviceHeader(context.Context, *UpdateReceiptAdviceHeaderRequest) (*UpdateReceiptAdviceHeaderResponse, error)
+	GetReceiptAdviceUBL(context.Context, *GetReceiptAdviceUBLRequest) (*GetReceiptAdviceUBLResponse, error)
+	ImportReceiptAdviceUBL(context.Context, *ImportReceiptAdviceUBLRequest) (*ImportReceiptAdviceUBLResponse, error)
 	mustEmbedUnimplementedReceiptAdviceHeaderServiceServer()
 }
 
@@ -162,9 +214,21 @@ func (UnimplementedReceiptAdviceHeaderServiceServer) CreateReceiptAdviceLine(con
 func (UnimplementedReceiptAdviceHeaderServiceServer) GetReceiptAdviceLines(context.Context, *GetReceiptAdviceLinesRequest) (*GetReceiptAdviceLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptAdviceLines not implemented")
 }
+func (UnimplementedReceiptAdviceHeaderServiceServer) UpdateReceiptAdviceLine(context.Context, *UpdateReceiptAdviceLineRequest) (*UpdateReceiptAdviceLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReceiptAdviceLine not implemented")
+}
+func (UnimplementedReceiptAdviceHeaderServiceServer) DeleteReceiptAdviceLine(context.Context, *DeleteReceiptAdviceLineRequest) (*DeleteReceiptAdviceLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReceiptAdviceLine not implemented")
+}
 func (UnimplementedReceiptAdviceHeaderServiceServer) UpdateReceiptAdviceHeader(context.Context, *UpdateReceiptAdviceHeaderRequest) (*UpdateReceiptAdviceHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReceiptAdviceHeader not implemented")
 }
+func (UnimplementedReceiptAdviceHeaderServiceServer) GetReceiptAdviceUBL(context.Context, *GetReceiptAdviceUBLRequest) (*GetReceiptAdviceUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptAdviceUBL not implemented")
+}
+func (UnimplementedReceiptAdviceHeaderServiceServer) ImportReceiptAdviceUBL(context.Context, *ImportReceiptAdviceUBLRequest) (*ImportReceiptAdviceUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportReceiptAdviceUBL not implemented")
+}
 func (UnimplementedReceiptAdviceHeaderServiceServer) mustEmbedUnimplementedReceiptAdviceHeaderServiceServer() {
 }
 func (UnimplementedReceiptAdviceHeaderServiceServer) testEmbeddedByValue() {}
@@ -295,6 +359,42 @@ func _ReceiptAdviceHeaderService_GetReceiptAdviceLines_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReceiptAdviceHeaderService_UpdateReceiptAdviceLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReceiptAdviceLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptAdviceHeaderServiceServer).UpdateReceiptAdviceLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptAdviceHeaderService_UpdateReceiptAdviceLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptAdviceHeaderServiceServer).UpdateReceiptAdviceLine(ctx, req.(*UpdateReceiptAdviceLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptAdviceHeaderService_DeleteReceiptAdviceLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReceiptAdviceLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptAdviceHeaderServiceServer).DeleteReceiptAdviceLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptAdviceHeaderService_DeleteReceiptAdviceLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptAdviceHeaderServiceServer).DeleteReceiptAdviceLine(ctx, req.(*DeleteReceiptAdviceLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReceiptAdviceHeaderService_UpdateReceiptAdviceHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateReceiptAdviceHeaderRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +413,42 @@ func _ReceiptAdviceHeaderService_UpdateReceiptAdviceHeader_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReceiptAdviceHeaderService_GetReceiptAdviceUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptAdviceUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptAdviceHeaderServiceServer).GetReceiptAdviceUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptAdviceHeaderService_GetReceiptAdviceUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptAdviceHeaderServiceServer).GetReceiptAdviceUBL(ctx, req.(*GetReceiptAdviceUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptAdviceHeaderService_ImportReceiptAdviceUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportReceiptAdviceUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptAdviceHeaderServiceServer).ImportReceiptAdviceUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptAdviceHeaderService_ImportReceiptAdviceUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptAdviceHeaderServiceServer).ImportReceiptAdviceUBL(ctx, req.(*ImportReceiptAdviceUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReceiptAdviceHeaderService_ServiceDesc is the grpc.ServiceDesc for ReceiptAdviceHeaderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,10 +480,26 @@ var ReceiptAdviceHeaderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReceiptAdviceLines",
 			Handler:    _ReceiptAdviceHeaderService_GetReceiptAdviceLines_Handler,
 		},
+		{
+			MethodName: "UpdateReceiptAdviceLine",
+			Handler:    _ReceiptAdviceHeaderService_UpdateReceiptAdviceLine_Handler,
+		},
+		{
+			MethodName: "DeleteReceiptAdviceLine",
+			Handler:    _ReceiptAdviceHeaderService_DeleteReceiptAdviceLine_Handler,
+		},
 		{
 			MethodName: "UpdateReceiptAdviceHeader",
 			Handler:    _ReceiptAdviceHeaderService_UpdateReceiptAdviceHeader_Handler,
 		},
+		{
+			MethodName: "GetReceiptAdviceUBL",
+			Handler:    _ReceiptAdviceHeaderService_GetReceiptAdviceUBL_Handler,
+		},
+		{
+			MethodName: "ImportReceiptAdviceUBL",
+			Handler:    _ReceiptAdviceHeaderService_ImportReceiptAdviceUBL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logistics/v1/receiptadvice.proto",
@@ -952,6 +952,8 @@ func (m *CreateDeliveryRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateDeliveryRequestMultiError(errors)
 	}
@@ -1544,6 +1546,8 @@ func (m *CreateDeliveryTermRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateDeliveryTermRequestMultiError(errors)
 	}
@@ -2482,6 +2486,8 @@ func (m *CreateDespatchRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateDespatchRequestMultiError(errors)
 	}
@@ -3236,6 +3242,8 @@ func (m *CreateShipmentRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateShipmentRequestMultiError(errors)
 	}
@@ -3625,6 +3633,8 @@ func (m *CreateAllowanceChargeRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateAllowanceChargeRequestMultiError(errors)
 	}
@@ -4382,6 +4392,8 @@ func (m *CreateBillOfLadingRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateBillOfLadingRequestMultiError(errors)
 	}
@@ -5126,6 +5138,8 @@ func (m *CreateWaybillRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateWaybillRequestMultiError(errors)
 	}
@@ -173,6 +173,40 @@ func (m *PurchaseOrderHeader) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurchaseOrderHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurchaseOrderHeaderValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurchaseOrderHeaderValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PurchaseOrderHeaderMultiError(errors)
 	}
@@ -385,6 +419,14 @@ func (m *PurchaseOrderHeaderD) validate(all bool) error {
 
 	// no validation rules for PayableAlternativeAmount
 
+	// no validation rules for OrderResponseCode
+
+	// no validation rules for SequenceNumberId
+
+	// no validation rules for DocumentStatusCode
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return PurchaseOrderHeaderDMultiError(errors)
 	}
@@ -889,6 +931,46 @@ func (m *CreatePurchaseOrderHeaderRequest) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePurchaseOrderHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePurchaseOrderHeaderRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePurchaseOrderHeaderRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RejectMismatchedTotals
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for PayableAlternativeCurrencyCode
+
 	if len(errors) > 0 {
 		return CreatePurchaseOrderHeaderRequestMultiError(errors)
 	}
@@ -2025,6 +2107,40 @@ func (m *PurchaseOrderLine) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurchaseOrderLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurchaseOrderLineValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurchaseOrderLineValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PurchaseOrderLineMultiError(errors)
 	}
@@ -2189,6 +2305,10 @@ func (m *PurchaseOrderLineD) validate(all bool) error {
 
 	// no validation rules for PurchaseOrderHeaderId
 
+	// no validation rules for CurrencyCode
+
+	// no validation rules for UnitCode
+
 	if len(errors) > 0 {
 		return PurchaseOrderLineDMultiError(errors)
 	}
@@ -2517,6 +2637,44 @@ func (m *CreatePurchaseOrderLineRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	for idx, item := range m.GetAllowanceCharges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePurchaseOrderLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePurchaseOrderLineRequestValidationError{
+						field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePurchaseOrderLineRequestValidationError{
+					field:  fmt.Sprintf("AllowanceCharges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for UnitCode
+
 	if len(errors) > 0 {
 		return CreatePurchaseOrderLineRequestMultiError(errors)
 	}
@@ -2999,76 +3157,60 @@ var _ interface {
 	ErrorName() string
 } = GetPurchaseOrderLinesResponseValidationError{}
 
-// Validate checks the field values on PurchaseOrderLines with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdatePurchaseOrderLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PurchaseOrderLines) Validate() error {
+func (m *UpdatePurchaseOrderLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PurchaseOrderLines with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PurchaseOrderLinesMultiError, or nil if none found.
-func (m *PurchaseOrderLines) ValidateAll() error {
+// ValidateAll checks the field values on UpdatePurchaseOrderLineRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdatePurchaseOrderLineRequestMultiError, or nil if none found.
+func (m *UpdatePurchaseOrderLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PurchaseOrderLines) validate(all bool) error {
+func (m *UpdatePurchaseOrderLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPurchaseOrderLines() {
-		_, _ = idx, item
+	// no validation rules for Note
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PurchaseOrderLinesValidationError{
-						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PurchaseOrderLinesValidationError{
-						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PurchaseOrderLinesValidationError{
-					field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Quantity
 
-	}
+	// no validation rules for PriceAmount
+
+	// no validation rules for PriceBaseQuantity
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return PurchaseOrderLinesMultiError(errors)
+		return UpdatePurchaseOrderLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PurchaseOrderLinesMultiError is an error wrapping multiple validation errors
-// returned by PurchaseOrderLines.ValidateAll() if the designated constraints
-// aren't met.
-type PurchaseOrderLinesMultiError []error
+// UpdatePurchaseOrderLineRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdatePurchaseOrderLineRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdatePurchaseOrderLineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PurchaseOrderLinesMultiError) Error() string {
+func (m UpdatePurchaseOrderLineRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3077,11 +3219,12 @@ func (m PurchaseOrderLinesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PurchaseOrderLinesMultiError) AllErrors() []error { return m }
+func (m UpdatePurchaseOrderLineRequestMultiError) AllErrors() []error { return m }
 
-// PurchaseOrderLinesValidationError is the validation error returned by
-// PurchaseOrderLines.Validate if the designated constraints aren't met.
-type PurchaseOrderLinesValidationError struct {
+// UpdatePurchaseOrderLineRequestValidationError is the validation error
+// returned by UpdatePurchaseOrderLineRequest.Validate if the designated
+// constraints aren't met.
+type UpdatePurchaseOrderLineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3089,24 +3232,24 @@ type PurchaseOrderLinesValidationError struct {
 }
 
 // Field function returns field value.
-func (e PurchaseOrderLinesValidationError) Field() string { return e.field }
+func (e UpdatePurchaseOrderLineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PurchaseOrderLinesValidationError) Reason() string { return e.reason }
+func (e UpdatePurchaseOrderLineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PurchaseOrderLinesValidationError) Cause() error { return e.cause }
+func (e UpdatePurchaseOrderLineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PurchaseOrderLinesValidationError) Key() bool { return e.key }
+func (e UpdatePurchaseOrderLineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PurchaseOrderLinesValidationError) ErrorName() string {
-	return "PurchaseOrderLinesValidationError"
+func (e UpdatePurchaseOrderLineRequestValidationError) ErrorName() string {
+	return "UpdatePurchaseOrderLineRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PurchaseOrderLinesValidationError) Error() string {
+func (e UpdatePurchaseOrderLineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3118,14 +3261,14 @@ func (e PurchaseOrderLinesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPurchaseOrderLines.%s: %s%s",
+		"invalid %sUpdatePurchaseOrderLineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PurchaseOrderLinesValidationError{}
+var _ error = UpdatePurchaseOrderLineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3133,70 +3276,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PurchaseOrderLinesValidationError{}
+} = UpdatePurchaseOrderLineRequestValidationError{}
 
-// Validate checks the field values on UpdatePurchaseOrderHeaderRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdatePurchaseOrderHeaderRequest) Validate() error {
+// Validate checks the field values on UpdatePurchaseOrderLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePurchaseOrderLineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePurchaseOrderHeaderRequest with
+// ValidateAll checks the field values on UpdatePurchaseOrderLineResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// UpdatePurchaseOrderHeaderRequestMultiError, or nil if none found.
-func (m *UpdatePurchaseOrderHeaderRequest) ValidateAll() error {
+// UpdatePurchaseOrderLineResponseMultiError, or nil if none found.
+func (m *UpdatePurchaseOrderLineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePurchaseOrderHeaderRequest) validate(all bool) error {
+func (m *UpdatePurchaseOrderLineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderTypeCode
-
-	// no validation rules for Note
-
-	// no validation rules for RequestedInvoiceCurrencyCode
-
-	// no validation rules for DocumentCurrencyCode
-
-	// no validation rules for PricingCurrencyCode
-
-	// no validation rules for TaxCurrencyCode
-
-	// no validation rules for AccountingCostCode
-
-	// no validation rules for AccountingCost
-
-	// no validation rules for Id
-
-	// no validation rules for UserId
-
-	// no validation rules for UserEmail
-
-	// no validation rules for RequestId
-
 	if len(errors) > 0 {
-		return UpdatePurchaseOrderHeaderRequestMultiError(errors)
+		return UpdatePurchaseOrderLineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePurchaseOrderHeaderRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdatePurchaseOrderHeaderRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePurchaseOrderHeaderRequestMultiError []error
+// UpdatePurchaseOrderLineResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdatePurchaseOrderLineResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdatePurchaseOrderLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePurchaseOrderHeaderRequestMultiError) Error() string {
+func (m UpdatePurchaseOrderLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3205,12 +3322,12 @@ func (m UpdatePurchaseOrderHeaderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePurchaseOrderHeaderRequestMultiError) AllErrors() []error { return m }
+func (m UpdatePurchaseOrderLineResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePurchaseOrderHeaderRequestValidationError is the validation error
-// returned by UpdatePurchaseOrderHeaderRequest.Validate if the designated
+// UpdatePurchaseOrderLineResponseValidationError is the validation error
+// returned by UpdatePurchaseOrderLineResponse.Validate if the designated
 // constraints aren't met.
-type UpdatePurchaseOrderHeaderRequestValidationError struct {
+type UpdatePurchaseOrderLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3218,24 +3335,24 @@ type UpdatePurchaseOrderHeaderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePurchaseOrderHeaderRequestValidationError) Field() string { return e.field }
+func (e UpdatePurchaseOrderLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePurchaseOrderHeaderRequestValidationError) Reason() string { return e.reason }
+func (e UpdatePurchaseOrderLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePurchaseOrderHeaderRequestValidationError) Cause() error { return e.cause }
+func (e UpdatePurchaseOrderLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePurchaseOrderHeaderRequestValidationError) Key() bool { return e.key }
+func (e UpdatePurchaseOrderLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePurchaseOrderHeaderRequestValidationError) ErrorName() string {
-	return "UpdatePurchaseOrderHeaderRequestValidationError"
+func (e UpdatePurchaseOrderLineResponseValidationError) ErrorName() string {
+	return "UpdatePurchaseOrderLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePurchaseOrderHeaderRequestValidationError) Error() string {
+func (e UpdatePurchaseOrderLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3247,14 +3364,14 @@ func (e UpdatePurchaseOrderHeaderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePurchaseOrderHeaderRequest.%s: %s%s",
+		"invalid %sUpdatePurchaseOrderLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePurchaseOrderHeaderRequestValidationError{}
+var _ error = UpdatePurchaseOrderLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3262,46 +3379,176 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePurchaseOrderHeaderRequestValidationError{}
+} = UpdatePurchaseOrderLineResponseValidationError{}
 
-// Validate checks the field values on UpdatePurchaseOrderHeaderResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdatePurchaseOrderHeaderResponse) Validate() error {
+// Validate checks the field values on DeletePurchaseOrderLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePurchaseOrderLineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePurchaseOrderHeaderResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdatePurchaseOrderHeaderResponseMultiError, or nil if none found.
-func (m *UpdatePurchaseOrderHeaderResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeletePurchaseOrderLineRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeletePurchaseOrderLineRequestMultiError, or nil if none found.
+func (m *DeletePurchaseOrderLineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePurchaseOrderHeaderResponse) validate(all bool) error {
+func (m *DeletePurchaseOrderLineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletePurchaseOrderLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletePurchaseOrderLineRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletePurchaseOrderLineRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return UpdatePurchaseOrderHeaderResponseMultiError(errors)
+		return DeletePurchaseOrderLineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePurchaseOrderHeaderResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdatePurchaseOrderHeaderResponse.ValidateAll() if the designated
+// DeletePurchaseOrderLineRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePurchaseOrderLineRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeletePurchaseOrderLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePurchaseOrderLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePurchaseOrderLineRequestMultiError) AllErrors() []error { return m }
+
+// DeletePurchaseOrderLineRequestValidationError is the validation error
+// returned by DeletePurchaseOrderLineRequest.Validate if the designated
 // constraints aren't met.
-type UpdatePurchaseOrderHeaderResponseMultiError []error
+type DeletePurchaseOrderLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePurchaseOrderLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePurchaseOrderLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePurchaseOrderLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePurchaseOrderLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePurchaseOrderLineRequestValidationError) ErrorName() string {
+	return "DeletePurchaseOrderLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePurchaseOrderLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePurchaseOrderLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePurchaseOrderLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePurchaseOrderLineRequestValidationError{}
+
+// Validate checks the field values on DeletePurchaseOrderLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePurchaseOrderLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePurchaseOrderLineResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeletePurchaseOrderLineResponseMultiError, or nil if none found.
+func (m *DeletePurchaseOrderLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePurchaseOrderLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePurchaseOrderLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePurchaseOrderLineResponseMultiError is an error wrapping multiple
+// validation errors returned by DeletePurchaseOrderLineResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeletePurchaseOrderLineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePurchaseOrderHeaderResponseMultiError) Error() string {
+func (m DeletePurchaseOrderLineResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3310,12 +3557,12 @@ func (m UpdatePurchaseOrderHeaderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePurchaseOrderHeaderResponseMultiError) AllErrors() []error { return m }
+func (m DeletePurchaseOrderLineResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePurchaseOrderHeaderResponseValidationError is the validation error
-// returned by UpdatePurchaseOrderHeaderResponse.Validate if the designated
+// DeletePurchaseOrderLineResponseValidationError is the validation error
+// returned by DeletePurchaseOrderLineResponse.Validate if the designated
 // constraints aren't met.
-type UpdatePurchaseOrderHeaderResponseValidationError struct {
+type DeletePurchaseOrderLineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3323,24 +3570,24 @@ type UpdatePurchaseOrderHeaderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePurchaseOrderHeaderResponseValidationError) Field() string { return e.field }
+func (e DeletePurchaseOrderLineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePurchaseOrderHeaderResponseValidationError) Reason() string { return e.reason }
+func (e DeletePurchaseOrderLineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePurchaseOrderHeaderResponseValidationError) Cause() error { return e.cause }
+func (e DeletePurchaseOrderLineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePurchaseOrderHeaderResponseValidationError) Key() bool { return e.key }
+func (e DeletePurchaseOrderLineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePurchaseOrderHeaderResponseValidationError) ErrorName() string {
-	return "UpdatePurchaseOrderHeaderResponseValidationError"
+func (e DeletePurchaseOrderLineResponseValidationError) ErrorName() string {
+	return "DeletePurchaseOrderLineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePurchaseOrderHeaderResponseValidationError) Error() string {
+func (e DeletePurchaseOrderLineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3352,14 +3599,14 @@ func (e UpdatePurchaseOrderHeaderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePurchaseOrderHeaderResponse.%s: %s%s",
+		"invalid %sDeletePurchaseOrderLineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePurchaseOrderHeaderResponseValidationError{}
+var _ error = DeletePurchaseOrderLineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3367,4 +3614,1890 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePurchaseOrderHeaderResponseValidationError{}
+} = DeletePurchaseOrderLineResponseValidationError{}
+
+// Validate checks the field values on PurchaseOrderLines with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurchaseOrderLines) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchaseOrderLines with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurchaseOrderLinesMultiError, or nil if none found.
+func (m *PurchaseOrderLines) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchaseOrderLines) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPurchaseOrderLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PurchaseOrderLinesValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PurchaseOrderLinesValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PurchaseOrderLinesValidationError{
+					field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PurchaseOrderLinesMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchaseOrderLinesMultiError is an error wrapping multiple validation errors
+// returned by PurchaseOrderLines.ValidateAll() if the designated constraints
+// aren't met.
+type PurchaseOrderLinesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchaseOrderLinesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchaseOrderLinesMultiError) AllErrors() []error { return m }
+
+// PurchaseOrderLinesValidationError is the validation error returned by
+// PurchaseOrderLines.Validate if the designated constraints aren't met.
+type PurchaseOrderLinesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchaseOrderLinesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchaseOrderLinesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchaseOrderLinesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchaseOrderLinesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchaseOrderLinesValidationError) ErrorName() string {
+	return "PurchaseOrderLinesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurchaseOrderLinesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchaseOrderLines.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchaseOrderLinesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchaseOrderLinesValidationError{}
+
+// Validate checks the field values on UpdatePurchaseOrderHeaderRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdatePurchaseOrderHeaderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePurchaseOrderHeaderRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdatePurchaseOrderHeaderRequestMultiError, or nil if none found.
+func (m *UpdatePurchaseOrderHeaderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePurchaseOrderHeaderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderTypeCode
+
+	// no validation rules for Note
+
+	// no validation rules for RequestedInvoiceCurrencyCode
+
+	// no validation rules for DocumentCurrencyCode
+
+	// no validation rules for PricingCurrencyCode
+
+	// no validation rules for TaxCurrencyCode
+
+	// no validation rules for AccountingCostCode
+
+	// no validation rules for AccountingCost
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return UpdatePurchaseOrderHeaderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePurchaseOrderHeaderRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdatePurchaseOrderHeaderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePurchaseOrderHeaderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePurchaseOrderHeaderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePurchaseOrderHeaderRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePurchaseOrderHeaderRequestValidationError is the validation error
+// returned by UpdatePurchaseOrderHeaderRequest.Validate if the designated
+// constraints aren't met.
+type UpdatePurchaseOrderHeaderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePurchaseOrderHeaderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePurchaseOrderHeaderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePurchaseOrderHeaderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePurchaseOrderHeaderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePurchaseOrderHeaderRequestValidationError) ErrorName() string {
+	return "UpdatePurchaseOrderHeaderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePurchaseOrderHeaderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePurchaseOrderHeaderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePurchaseOrderHeaderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePurchaseOrderHeaderRequestValidationError{}
+
+// Validate checks the field values on UpdatePurchaseOrderHeaderResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdatePurchaseOrderHeaderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePurchaseOrderHeaderResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdatePurchaseOrderHeaderResponseMultiError, or nil if none found.
+func (m *UpdatePurchaseOrderHeaderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePurchaseOrderHeaderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdatePurchaseOrderHeaderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePurchaseOrderHeaderResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdatePurchaseOrderHeaderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePurchaseOrderHeaderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePurchaseOrderHeaderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePurchaseOrderHeaderResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePurchaseOrderHeaderResponseValidationError is the validation error
+// returned by UpdatePurchaseOrderHeaderResponse.Validate if the designated
+// constraints aren't met.
+type UpdatePurchaseOrderHeaderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePurchaseOrderHeaderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePurchaseOrderHeaderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePurchaseOrderHeaderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePurchaseOrderHeaderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePurchaseOrderHeaderResponseValidationError) ErrorName() string {
+	return "UpdatePurchaseOrderHeaderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePurchaseOrderHeaderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePurchaseOrderHeaderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePurchaseOrderHeaderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePurchaseOrderHeaderResponseValidationError{}
+
+// Validate checks the field values on IssuePurchaseOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssuePurchaseOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssuePurchaseOrderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssuePurchaseOrderRequestMultiError, or nil if none found.
+func (m *IssuePurchaseOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssuePurchaseOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return IssuePurchaseOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssuePurchaseOrderRequestMultiError is an error wrapping multiple validation
+// errors returned by IssuePurchaseOrderRequest.ValidateAll() if the
+// designated constraints aren't met.
+type IssuePurchaseOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssuePurchaseOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssuePurchaseOrderRequestMultiError) AllErrors() []error { return m }
+
+// IssuePurchaseOrderRequestValidationError is the validation error returned by
+// IssuePurchaseOrderRequest.Validate if the designated constraints aren't met.
+type IssuePurchaseOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssuePurchaseOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssuePurchaseOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssuePurchaseOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssuePurchaseOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssuePurchaseOrderRequestValidationError) ErrorName() string {
+	return "IssuePurchaseOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssuePurchaseOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssuePurchaseOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssuePurchaseOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssuePurchaseOrderRequestValidationError{}
+
+// Validate checks the field values on IssuePurchaseOrderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssuePurchaseOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssuePurchaseOrderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssuePurchaseOrderResponseMultiError, or nil if none found.
+func (m *IssuePurchaseOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssuePurchaseOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return IssuePurchaseOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssuePurchaseOrderResponseMultiError is an error wrapping multiple
+// validation errors returned by IssuePurchaseOrderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IssuePurchaseOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssuePurchaseOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssuePurchaseOrderResponseMultiError) AllErrors() []error { return m }
+
+// IssuePurchaseOrderResponseValidationError is the validation error returned
+// by IssuePurchaseOrderResponse.Validate if the designated constraints aren't met.
+type IssuePurchaseOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssuePurchaseOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssuePurchaseOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssuePurchaseOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssuePurchaseOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssuePurchaseOrderResponseValidationError) ErrorName() string {
+	return "IssuePurchaseOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssuePurchaseOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssuePurchaseOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssuePurchaseOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssuePurchaseOrderResponseValidationError{}
+
+// Validate checks the field values on RevisePurchaseOrderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevisePurchaseOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevisePurchaseOrderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevisePurchaseOrderRequestMultiError, or nil if none found.
+func (m *RevisePurchaseOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevisePurchaseOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUpdatePurchaseOrderHeaderRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisePurchaseOrderRequestValidationError{
+					field:  "UpdatePurchaseOrderHeaderRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisePurchaseOrderRequestValidationError{
+					field:  "UpdatePurchaseOrderHeaderRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatePurchaseOrderHeaderRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisePurchaseOrderRequestValidationError{
+				field:  "UpdatePurchaseOrderHeaderRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RevisePurchaseOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevisePurchaseOrderRequestMultiError is an error wrapping multiple
+// validation errors returned by RevisePurchaseOrderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevisePurchaseOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevisePurchaseOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevisePurchaseOrderRequestMultiError) AllErrors() []error { return m }
+
+// RevisePurchaseOrderRequestValidationError is the validation error returned
+// by RevisePurchaseOrderRequest.Validate if the designated constraints aren't met.
+type RevisePurchaseOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevisePurchaseOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevisePurchaseOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevisePurchaseOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevisePurchaseOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevisePurchaseOrderRequestValidationError) ErrorName() string {
+	return "RevisePurchaseOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevisePurchaseOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevisePurchaseOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevisePurchaseOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevisePurchaseOrderRequestValidationError{}
+
+// Validate checks the field values on RevisePurchaseOrderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevisePurchaseOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevisePurchaseOrderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevisePurchaseOrderResponseMultiError, or nil if none found.
+func (m *RevisePurchaseOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevisePurchaseOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisePurchaseOrderResponseValidationError{
+					field:  "DocumentVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisePurchaseOrderResponseValidationError{
+					field:  "DocumentVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisePurchaseOrderResponseValidationError{
+				field:  "DocumentVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevisePurchaseOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevisePurchaseOrderResponseMultiError is an error wrapping multiple
+// validation errors returned by RevisePurchaseOrderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevisePurchaseOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevisePurchaseOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevisePurchaseOrderResponseMultiError) AllErrors() []error { return m }
+
+// RevisePurchaseOrderResponseValidationError is the validation error returned
+// by RevisePurchaseOrderResponse.Validate if the designated constraints
+// aren't met.
+type RevisePurchaseOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevisePurchaseOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevisePurchaseOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevisePurchaseOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevisePurchaseOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevisePurchaseOrderResponseValidationError) ErrorName() string {
+	return "RevisePurchaseOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevisePurchaseOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevisePurchaseOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevisePurchaseOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevisePurchaseOrderResponseValidationError{}
+
+// Validate checks the field values on GetPurchaseOrderUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPurchaseOrderUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPurchaseOrderUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPurchaseOrderUBLRequestMultiError, or nil if none found.
+func (m *GetPurchaseOrderUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPurchaseOrderUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPurchaseOrderUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPurchaseOrderUBLRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPurchaseOrderUBLRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPurchaseOrderUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPurchaseOrderUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPurchaseOrderUBLRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetPurchaseOrderUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPurchaseOrderUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPurchaseOrderUBLRequestMultiError) AllErrors() []error { return m }
+
+// GetPurchaseOrderUBLRequestValidationError is the validation error returned
+// by GetPurchaseOrderUBLRequest.Validate if the designated constraints aren't met.
+type GetPurchaseOrderUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPurchaseOrderUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPurchaseOrderUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPurchaseOrderUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPurchaseOrderUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPurchaseOrderUBLRequestValidationError) ErrorName() string {
+	return "GetPurchaseOrderUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPurchaseOrderUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPurchaseOrderUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPurchaseOrderUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPurchaseOrderUBLRequestValidationError{}
+
+// Validate checks the field values on GetPurchaseOrderUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPurchaseOrderUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPurchaseOrderUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPurchaseOrderUBLResponseMultiError, or nil if none found.
+func (m *GetPurchaseOrderUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPurchaseOrderUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	if len(errors) > 0 {
+		return GetPurchaseOrderUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPurchaseOrderUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPurchaseOrderUBLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetPurchaseOrderUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPurchaseOrderUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPurchaseOrderUBLResponseMultiError) AllErrors() []error { return m }
+
+// GetPurchaseOrderUBLResponseValidationError is the validation error returned
+// by GetPurchaseOrderUBLResponse.Validate if the designated constraints
+// aren't met.
+type GetPurchaseOrderUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPurchaseOrderUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPurchaseOrderUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPurchaseOrderUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPurchaseOrderUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPurchaseOrderUBLResponseValidationError) ErrorName() string {
+	return "GetPurchaseOrderUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPurchaseOrderUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPurchaseOrderUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPurchaseOrderUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPurchaseOrderUBLResponseValidationError{}
+
+// Validate checks the field values on ImportPurchaseOrderUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportPurchaseOrderUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportPurchaseOrderUBLRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportPurchaseOrderUBLRequestMultiError, or nil if none found.
+func (m *ImportPurchaseOrderUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportPurchaseOrderUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportPurchaseOrderUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportPurchaseOrderUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportPurchaseOrderUBLRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ImportPurchaseOrderUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportPurchaseOrderUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportPurchaseOrderUBLRequestMultiError) AllErrors() []error { return m }
+
+// ImportPurchaseOrderUBLRequestValidationError is the validation error
+// returned by ImportPurchaseOrderUBLRequest.Validate if the designated
+// constraints aren't met.
+type ImportPurchaseOrderUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportPurchaseOrderUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportPurchaseOrderUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportPurchaseOrderUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportPurchaseOrderUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportPurchaseOrderUBLRequestValidationError) ErrorName() string {
+	return "ImportPurchaseOrderUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportPurchaseOrderUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportPurchaseOrderUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportPurchaseOrderUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportPurchaseOrderUBLRequestValidationError{}
+
+// Validate checks the field values on ImportPurchaseOrderUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportPurchaseOrderUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportPurchaseOrderUBLResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportPurchaseOrderUBLResponseMultiError, or nil if none found.
+func (m *ImportPurchaseOrderUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportPurchaseOrderUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPurchaseOrderHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportPurchaseOrderUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportPurchaseOrderUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurchaseOrderHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportPurchaseOrderUBLResponseValidationError{
+				field:  "PurchaseOrderHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportPurchaseOrderUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportPurchaseOrderUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportPurchaseOrderUBLResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ImportPurchaseOrderUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportPurchaseOrderUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportPurchaseOrderUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportPurchaseOrderUBLResponseValidationError is the validation error
+// returned by ImportPurchaseOrderUBLResponse.Validate if the designated
+// constraints aren't met.
+type ImportPurchaseOrderUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportPurchaseOrderUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportPurchaseOrderUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportPurchaseOrderUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportPurchaseOrderUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportPurchaseOrderUBLResponseValidationError) ErrorName() string {
+	return "ImportPurchaseOrderUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportPurchaseOrderUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportPurchaseOrderUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportPurchaseOrderUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportPurchaseOrderUBLResponseValidationError{}
+
+// Validate checks the field values on ImportOrderResponseUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrderResponseUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrderResponseUBLRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportOrderResponseUBLRequestMultiError, or nil if none found.
+func (m *ImportOrderResponseUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrderResponseUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportOrderResponseUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrderResponseUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportOrderResponseUBLRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ImportOrderResponseUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrderResponseUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrderResponseUBLRequestMultiError) AllErrors() []error { return m }
+
+// ImportOrderResponseUBLRequestValidationError is the validation error
+// returned by ImportOrderResponseUBLRequest.Validate if the designated
+// constraints aren't met.
+type ImportOrderResponseUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrderResponseUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrderResponseUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrderResponseUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrderResponseUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrderResponseUBLRequestValidationError) ErrorName() string {
+	return "ImportOrderResponseUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrderResponseUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrderResponseUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrderResponseUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrderResponseUBLRequestValidationError{}
+
+// Validate checks the field values on ImportOrderResponseUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrderResponseUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrderResponseUBLResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImportOrderResponseUBLResponseMultiError, or nil if none found.
+func (m *ImportOrderResponseUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrderResponseUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPurchaseOrderHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportOrderResponseUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportOrderResponseUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurchaseOrderHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportOrderResponseUBLResponseValidationError{
+				field:  "PurchaseOrderHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPurchaseOrderLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrderResponseUBLResponseValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrderResponseUBLResponseValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOrderResponseUBLResponseValidationError{
+					field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportOrderResponseUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrderResponseUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportOrderResponseUBLResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ImportOrderResponseUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrderResponseUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrderResponseUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportOrderResponseUBLResponseValidationError is the validation error
+// returned by ImportOrderResponseUBLResponse.Validate if the designated
+// constraints aren't met.
+type ImportOrderResponseUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrderResponseUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrderResponseUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrderResponseUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrderResponseUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrderResponseUBLResponseValidationError) ErrorName() string {
+	return "ImportOrderResponseUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrderResponseUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrderResponseUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrderResponseUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrderResponseUBLResponseValidationError{}
+
+// Validate checks the field values on ImportOrderChangeUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrderChangeUBLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrderChangeUBLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOrderChangeUBLRequestMultiError, or nil if none found.
+func (m *ImportOrderChangeUBLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrderChangeUBLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ubl
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return ImportOrderChangeUBLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrderChangeUBLRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportOrderChangeUBLRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ImportOrderChangeUBLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrderChangeUBLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrderChangeUBLRequestMultiError) AllErrors() []error { return m }
+
+// ImportOrderChangeUBLRequestValidationError is the validation error returned
+// by ImportOrderChangeUBLRequest.Validate if the designated constraints
+// aren't met.
+type ImportOrderChangeUBLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrderChangeUBLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrderChangeUBLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrderChangeUBLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrderChangeUBLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrderChangeUBLRequestValidationError) ErrorName() string {
+	return "ImportOrderChangeUBLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrderChangeUBLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrderChangeUBLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrderChangeUBLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrderChangeUBLRequestValidationError{}
+
+// Validate checks the field values on ImportOrderChangeUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrderChangeUBLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrderChangeUBLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOrderChangeUBLResponseMultiError, or nil if none found.
+func (m *ImportOrderChangeUBLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrderChangeUBLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPurchaseOrderHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportOrderChangeUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportOrderChangeUBLResponseValidationError{
+					field:  "PurchaseOrderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurchaseOrderHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportOrderChangeUBLResponseValidationError{
+				field:  "PurchaseOrderHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPurchaseOrderLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrderChangeUBLResponseValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrderChangeUBLResponseValidationError{
+						field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOrderChangeUBLResponseValidationError{
+					field:  fmt.Sprintf("PurchaseOrderLines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportOrderChangeUBLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrderChangeUBLResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportOrderChangeUBLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportOrderChangeUBLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrderChangeUBLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrderChangeUBLResponseMultiError) AllErrors() []error { return m }
+
+// ImportOrderChangeUBLResponseValidationError is the validation error returned
+// by ImportOrderChangeUBLResponse.Validate if the designated constraints
+// aren't met.
+type ImportOrderChangeUBLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrderChangeUBLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrderChangeUBLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrderChangeUBLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrderChangeUBLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrderChangeUBLResponseValidationError) ErrorName() string {
+	return "ImportOrderChangeUBLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrderChangeUBLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrderChangeUBLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrderChangeUBLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrderChangeUBLResponseValidationError{}
@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	v1 "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,7 +26,17 @@ const (
 	PurchaseOrderHeaderService_GetPurchaseOrderHeaderByPk_FullMethodName = "/order.v1.PurchaseOrderHeaderService/GetPurchaseOrderHeaderByPk"
 	PurchaseOrderHeaderService_CreatePurchaseOrderLine_FullMethodName    = "/order.v1.PurchaseOrderHeaderService/CreatePurchaseOrderLine"
 	PurchaseOrderHeaderService_GetPurchaseOrderLines_FullMethodName      = "/order.v1.PurchaseOrderHeaderService/GetPurchaseOrderLines"
+	PurchaseOrderHeaderService_UpdatePurchaseOrderLine_FullMethodName    = "/order.v1.PurchaseOrderHeaderService/UpdatePurchaseOrderLine"
+	PurchaseOrderHeaderService_DeletePurchaseOrderLine_FullMethodName    = "/order.v1.PurchaseOrderHeaderService/DeletePurchaseOrderLine"
 	PurchaseOrderHeaderService_UpdatePurchaseOrderHeader_FullMethodName  = "/order.v1.PurchaseOrderHeaderService/UpdatePurchaseOrderHeader"
+	PurchaseOrderHeaderService_IssuePurchaseOrder_FullMethodName         = "/order.v1.PurchaseOrderHeaderService/IssuePurchaseOrder"
+	PurchaseOrderHeaderService_RevisePurchaseOrder_FullMethodName        = "/order.v1.PurchaseOrderHeaderService/RevisePurchaseOrder"
+	PurchaseOrderHeaderService_GetPurchaseOrderVersions_FullMethodName   = "/order.v1.PurchaseOrderHeaderService/GetPurchaseOrderVersions"
+	PurchaseOrderHeaderService_DiffPurchaseOrderVersions_FullMethodName  = "/order.v1.PurchaseOrderHeaderService/DiffPurchaseOrderVersions"
+	PurchaseOrderHeaderService_GetPurchaseOrderUBL_FullMethodName        = "/order.v1.PurchaseOrderHeaderService/GetPurchaseOrderUBL"
+	PurchaseOrderHeaderService_ImportPurchaseOrderUBL_FullMethodName     = "/order.v1.PurchaseOrderHeaderService/ImportPurchaseOrderUBL"
+	PurchaseOrderHeaderService_ImportOrderResponseUBL_FullMethodName     = "/order.v1.PurchaseOrderHeaderService/ImportOrderResponseUBL"
+	PurchaseOrderHeaderService_ImportOrderChangeUBL_FullMethodName       = "/order.v1.PurchaseOrderHeaderService/ImportOrderChangeUBL"
 )
 
 // PurchaseOrderHeaderServiceClient is the client API for PurchaseOrderHeaderService service.
@@ -40,7 +51,17 @@ type PurchaseOrderHeaderServiceClient interface {
 	GetPurchaseOrderHeaderByPk(ctx context.Context, in *GetPurchaseOrderHeaderByPkRequest, opts ...grpc.CallOption) (*GetPurchaseOrderHeaderByPkResponse, error)
 	CreatePurchaseOrderLine(ctx context.Context, in *CreatePurchaseOrderLineRequest, opts ...grpc.CallOption) (*CreatePurchaseOrderLineResponse, error)
 	GetPurchaseOrderLines(ctx context.Context, in *GetPurchaseOrderLinesRequest, opts ...grpc.CallOption) (*GetPurchaseOrderLinesResponse, error)
+	UpdatePurchaseOrderLine(ctx context.Context, in *UpdatePurchaseOrderLineRequest, opts ...grpc.CallOption) (*UpdatePurchaseOrderLineResponse, error)
+	DeletePurchaseOrderLine(ctx context.Context, in *DeletePurchaseOrderLineRequest, opts ...grpc.CallOption) (*DeletePurchaseOrderLineResponse, error)
 	UpdatePurchaseOrderHeader(ctx context.Context, in *UpdatePurchaseOrderHeaderRequest, opts ...grpc.CallOption) (*UpdatePurchaseOrderHeaderResponse, error)
+	IssuePurchaseOrder(ctx context.Context, in *IssuePurchaseOrderRequest, opts ...grpc.CallOption) (*IssuePurchaseOrderResponse, error)
+	RevisePurchaseOrder(ctx context.Context, in *RevisePurchaseOrderRequest, opts ...grpc.CallOption) (*RevisePurchaseOrderResponse, error)
+	GetPurchaseOrderVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error)
+	DiffPurchaseOrderVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error)
+	GetPurchaseOrderUBL(ctx context.Context, in *GetPurchaseOrderUBLRequest, opts ...grpc.CallOption) (*GetPurchaseOrderUBLResponse, error)
+	ImportPurchaseOrderUBL(ctx context.Context, in *ImportPurchaseOrderUBLRequest, opts ...grpc.CallOption) (*ImportPurchaseOrderUBLResponse, error)
+	ImportOrderResponseUBL(ctx context.Context, in *ImportOrderResponseUBLRequest, opts ...grpc.CallOption) (*ImportOrderResponseUBLResponse, error)
+	ImportOrderChangeUBL(ctx context.Context, in *ImportOrderChangeUBLRequest, opts ...grpc.CallOption) (*ImportOrderChangeUBLResponse, error)
 }
 
 type purchaseOrderHeaderServiceClient struct {
@@ -111,6 +132,26 @@ func (c *purchaseOrderHeaderServiceClient) GetPurchaseOrderLines(ctx context.Con
 	return out, nil
 }
 
+func (c *purchaseOrderHeaderServiceClient) UpdatePurchaseOrderLine(ctx context.Context, in *UpdatePurchaseOrderLineRequest, opts ...grpc.CallOption) (*UpdatePurchaseOrderLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePurchaseOrderLineResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_UpdatePurchaseOrderLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) DeletePurchaseOrderLine(ctx context.Context, in *DeletePurchaseOrderLineRequest, opts ...grpc.CallOption) (*DeletePurchaseOrderLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePurchaseOrderLineResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_DeletePurchaseOrderLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *purchaseOrderHeaderServiceClient) UpdatePurchaseOrderHeader(ctx context.Context, in *UpdatePurchaseOrderHeaderRequest, opts ...grpc.CallOption) (*UpdatePurchaseOrderHeaderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdatePurchaseOrderHeaderResponse)
@@ -121,6 +162,86 @@ func (c *purchaseOrderHeaderServiceClient) UpdatePurchaseOrderHeader(ctx context
 	return out, nil
 }
 
+func (c *purchaseOrderHeaderServiceClient) IssuePurchaseOrder(ctx context.Context, in *IssuePurchaseOrderRequest, opts ...grpc.CallOption) (*IssuePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssuePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_IssuePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) RevisePurchaseOrder(ctx context.Context, in *RevisePurchaseOrderRequest, opts ...grpc.CallOption) (*RevisePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_RevisePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) GetPurchaseOrderVersions(ctx context.Context, in *v1.GetDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.GetDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_GetPurchaseOrderVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) DiffPurchaseOrderVersions(ctx context.Context, in *v1.DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*v1.DiffDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DiffDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_DiffPurchaseOrderVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) GetPurchaseOrderUBL(ctx context.Context, in *GetPurchaseOrderUBLRequest, opts ...grpc.CallOption) (*GetPurchaseOrderUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPurchaseOrderUBLResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_GetPurchaseOrderUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) ImportPurchaseOrderUBL(ctx context.Context, in *ImportPurchaseOrderUBLRequest, opts ...grpc.CallOption) (*ImportPurchaseOrderUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportPurchaseOrderUBLResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_ImportPurchaseOrderUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) ImportOrderResponseUBL(ctx context.Context, in *ImportOrderResponseUBLRequest, opts ...grpc.CallOption) (*ImportOrderResponseUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOrderResponseUBLResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_ImportOrderResponseUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchaseOrderHeaderServiceClient) ImportOrderChangeUBL(ctx context.Context, in *ImportOrderChangeUBLRequest, opts ...grpc.CallOption) (*ImportOrderChangeUBLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOrderChangeUBLResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderHeaderService_ImportOrderChangeUBL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PurchaseOrderHeaderServiceServer is the server API for PurchaseOrderHeaderService service.
 // All implementations must embed UnimplementedPurchaseOrderHeaderServiceServer
 // for forward compatibility.
@@ -133,7 +254,17 @@ type PurchaseOrderHeaderServiceServer interface {
 	GetPurchaseOrderHeaderByPk(context.Context, *GetPurchaseOrderHeaderByPkRequest) (*GetPurchaseOrderHeaderByPkResponse, error)
 	CreatePurchaseOrderLine(context.Context, *CreatePurchaseOrderLineRequest) (*CreatePurchaseOrderLineResponse, error)
 	GetPurchaseOrderLines(context.Context, *GetPurchaseOrderLinesRequest) (*GetPurchaseOrderLinesResponse, error)
+	UpdatePurchaseOrderLine(context.Context, *UpdatePurchaseOrderLineRequest) (*UpdatePurchaseOrderLineResponse, error)
+	DeletePurchaseOrderLine(context.Context, *DeletePurchaseOrderLineRequest) (*DeletePurchaseOrderLineResponse, error)
 	UpdatePurchaseOrderHeader(context.Context, *UpdatePurchaseOrderHeaderRequest) (*UpdatePurchaseOrderHeaderResponse, error)
+	IssuePurchaseOrder(context.Context, *IssuePurchaseOrderRequest) (*IssuePurchaseOrderResponse, error)
+	RevisePurchaseOrder(context.Context, *RevisePurchaseOrderRequest) (*RevisePurchaseOrderResponse, error)
+	GetPurchaseOrderVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error)
+	DiffPurchaseOrderVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error)
+	GetPurchaseOrderUBL(context.Context, *GetPurchaseOrderUBLRequest) (*GetPurchaseOrderUBLResponse, error)
+	ImportPurchaseOrderUBL(context.Context, *ImportPurchaseOrderUBLRequest) (*ImportPurchaseOrderUBLResponse, error)
+	ImportOrderResponseUBL(context.Context, *ImportOrderResponseUBLRequest) (*ImportOrderResponseUBLResponse, error)
+	ImportOrderChangeUBL(context.Context, *ImportOrderChangeUBLRequest) (*ImportOrderChangeUBLResponse, error)
 	mustEmbedUnimplementedPurchaseOrderHeaderServiceServer()
 }
 
@@ -162,9 +293,39 @@ func (UnimplementedPurchaseOrderHeaderServiceServer) CreatePurchaseOrderLine(con
 func (UnimplementedPurchaseOrderHeaderServiceServer) GetPurchaseOrderLines(context.Context, *GetPurchaseOrderLinesRequest) (*GetPurchaseOrderLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrderLines not implemented")
 }
+func (UnimplementedPurchaseOrderHeaderServiceServer) UpdatePurchaseOrderLine(context.Context, *UpdatePurchaseOrderLineRequest) (*UpdatePurchaseOrderLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchaseOrderLine not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) DeletePurchaseOrderLine(context.Context, *DeletePurchaseOrderLineRequest) (*DeletePurchaseOrderLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePurchaseOrderLine not implemented")
+}
 func (UnimplementedPurchaseOrderHeaderServiceServer) UpdatePurchaseOrderHeader(context.Context, *UpdatePurchaseOrderHeaderRequest) (*UpdatePurchaseOrderHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchaseOrderHeader not implemented")
 }
+func (UnimplementedPurchaseOrderHeaderServiceServer) IssuePurchaseOrder(context.Context, *IssuePurchaseOrderRequest) (*IssuePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuePurchaseOrder not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) RevisePurchaseOrder(context.Context, *RevisePurchaseOrderRequest) (*RevisePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisePurchaseOrder not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) GetPurchaseOrderVersions(context.Context, *v1.GetDocumentVersionsRequest) (*v1.GetDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrderVersions not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) DiffPurchaseOrderVersions(context.Context, *v1.DiffDocumentVersionsRequest) (*v1.DiffDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPurchaseOrderVersions not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) GetPurchaseOrderUBL(context.Context, *GetPurchaseOrderUBLRequest) (*GetPurchaseOrderUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrderUBL not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) ImportPurchaseOrderUBL(context.Context, *ImportPurchaseOrderUBLRequest) (*ImportPurchaseOrderUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPurchaseOrderUBL not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) ImportOrderResponseUBL(context.Context, *ImportOrderResponseUBLRequest) (*ImportOrderResponseUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrderResponseUBL not implemented")
+}
+func (UnimplementedPurchaseOrderHeaderServiceServer) ImportOrderChangeUBL(context.Context, *ImportOrderChangeUBLRequest) (*ImportOrderChangeUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrderChangeUBL not implemented")
+}
 func (UnimplementedPurchaseOrderHeaderServiceServer) mustEmbedUnimplementedPurchaseOrderHeaderServiceServer() {
 }
 func (UnimplementedPurchaseOrderHeaderServiceServer) testEmbeddedByValue() {}
@@ -295,6 +456,42 @@ func _PurchaseOrderHeaderService_GetPurchaseOrderLines_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PurchaseOrderHeaderService_UpdatePurchaseOrderLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePurchaseOrderLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).UpdatePurchaseOrderLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_UpdatePurchaseOrderLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).UpdatePurchaseOrderLine(ctx, req.(*UpdatePurchaseOrderLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_DeletePurchaseOrderLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePurchaseOrderLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).DeletePurchaseOrderLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_DeletePurchaseOrderLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).DeletePurchaseOrderLine(ctx, req.(*DeletePurchaseOrderLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PurchaseOrderHeaderService_UpdatePurchaseOrderHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePurchaseOrderHeaderRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +510,150 @@ func _PurchaseOrderHeaderService_UpdatePurchaseOrderHeader_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PurchaseOrderHeaderService_IssuePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).IssuePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_IssuePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).IssuePurchaseOrder(ctx, req.(*IssuePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_RevisePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).RevisePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_RevisePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).RevisePurchaseOrder(ctx, req.(*RevisePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_GetPurchaseOrderVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).GetPurchaseOrderVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_GetPurchaseOrderVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).GetPurchaseOrderVersions(ctx, req.(*v1.GetDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_DiffPurchaseOrderVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DiffDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).DiffPurchaseOrderVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_DiffPurchaseOrderVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).DiffPurchaseOrderVersions(ctx, req.(*v1.DiffDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_GetPurchaseOrderUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).GetPurchaseOrderUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_GetPurchaseOrderUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).GetPurchaseOrderUBL(ctx, req.(*GetPurchaseOrderUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_ImportPurchaseOrderUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPurchaseOrderUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportPurchaseOrderUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_ImportPurchaseOrderUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportPurchaseOrderUBL(ctx, req.(*ImportPurchaseOrderUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_ImportOrderResponseUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrderResponseUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportOrderResponseUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_ImportOrderResponseUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportOrderResponseUBL(ctx, req.(*ImportOrderResponseUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchaseOrderHeaderService_ImportOrderChangeUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrderChangeUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportOrderChangeUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderHeaderService_ImportOrderChangeUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderHeaderServiceServer).ImportOrderChangeUBL(ctx, req.(*ImportOrderChangeUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PurchaseOrderHeaderService_ServiceDesc is the grpc.ServiceDesc for PurchaseOrderHeaderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,10 +685,50 @@ var PurchaseOrderHeaderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPurchaseOrderLines",
 			Handler:    _PurchaseOrderHeaderService_GetPurchaseOrderLines_Handler,
 		},
+		{
+			MethodName: "UpdatePurchaseOrderLine",
+			Handler:    _PurchaseOrderHeaderService_UpdatePurchaseOrderLine_Handler,
+		},
+		{
+			MethodName: "DeletePurchaseOrderLine",
+			Handler:    _PurchaseOrderHeaderService_DeletePurchaseOrderLine_Handler,
+		},
 		{
 			MethodName: "UpdatePurchaseOrderHeader",
 			Handler:    _PurchaseOrderHeaderService_UpdatePurchaseOrderHeader_Handler,
 		},
+		{
+			MethodName: "IssuePurchaseOrder",
+			Handler:    _PurchaseOrderHeaderService_IssuePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "RevisePurchaseOrder",
+			Handler:    _PurchaseOrderHeaderService_RevisePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrderVersions",
+			Handler:    _PurchaseOrderHeaderService_GetPurchaseOrderVersions_Handler,
+		},
+		{
+			MethodName: "DiffPurchaseOrderVersions",
+			Handler:    _PurchaseOrderHeaderService_DiffPurchaseOrderVersions_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrderUBL",
+			Handler:    _PurchaseOrderHeaderService_GetPurchaseOrderUBL_Handler,
+		},
+		{
+			MethodName: "ImportPurchaseOrderUBL",
+			Handler:    _PurchaseOrderHeaderService_ImportPurchaseOrderUBL_Handler,
+		},
+		{
+			MethodName: "ImportOrderResponseUBL",
+			Handler:    _PurchaseOrderHeaderService_ImportOrderResponseUBL_Handler,
+		},
+		{
+			MethodName: "ImportOrderChangeUBL",
+			Handler:    _PurchaseOrderHeaderService_ImportOrderChangeUBL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/v1/purchaseorder.proto",
@@ -603,6 +603,8 @@ func (m *CreateFinancialInstitutionRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateFinancialInstitutionRequestMultiError(errors)
 	}
@@ -2198,6 +2200,8 @@ func (m *CreateFinancialInstitutionBranchRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateFinancialInstitutionBranchRequestMultiError(errors)
 	}
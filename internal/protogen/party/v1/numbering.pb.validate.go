@@ -0,0 +1,2154 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: party/v1/numbering.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DocumentNumberSequence with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentNumberSequence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentNumberSequence with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentNumberSequenceMultiError, or nil if none found.
+func (m *DocumentNumberSequence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentNumberSequence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentNumberSequenceD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "DocumentNumberSequenceD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "DocumentNumberSequenceD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentNumberSequenceD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentNumberSequenceValidationError{
+				field:  "DocumentNumberSequenceD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentNumberSequenceValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentNumberSequenceValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentNumberSequenceValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentNumberSequenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentNumberSequenceMultiError is an error wrapping multiple validation
+// errors returned by DocumentNumberSequence.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentNumberSequenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentNumberSequenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentNumberSequenceMultiError) AllErrors() []error { return m }
+
+// DocumentNumberSequenceValidationError is the validation error returned by
+// DocumentNumberSequence.Validate if the designated constraints aren't met.
+type DocumentNumberSequenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentNumberSequenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentNumberSequenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentNumberSequenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentNumberSequenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentNumberSequenceValidationError) ErrorName() string {
+	return "DocumentNumberSequenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentNumberSequenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentNumberSequence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentNumberSequenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentNumberSequenceValidationError{}
+
+// Validate checks the field values on DocumentNumberSequenceD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentNumberSequenceD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentNumberSequenceD with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentNumberSequenceDMultiError, or nil if none found.
+func (m *DocumentNumberSequenceD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentNumberSequenceD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for PartyId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for Pattern
+
+	// no validation rules for FiscalYearStartMonth
+
+	if len(errors) > 0 {
+		return DocumentNumberSequenceDMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentNumberSequenceDMultiError is an error wrapping multiple validation
+// errors returned by DocumentNumberSequenceD.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentNumberSequenceDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentNumberSequenceDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentNumberSequenceDMultiError) AllErrors() []error { return m }
+
+// DocumentNumberSequenceDValidationError is the validation error returned by
+// DocumentNumberSequenceD.Validate if the designated constraints aren't met.
+type DocumentNumberSequenceDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentNumberSequenceDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentNumberSequenceDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentNumberSequenceDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentNumberSequenceDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentNumberSequenceDValidationError) ErrorName() string {
+	return "DocumentNumberSequenceDValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentNumberSequenceDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentNumberSequenceD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentNumberSequenceDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentNumberSequenceDValidationError{}
+
+// Validate checks the field values on VoidedDocumentNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidedDocumentNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidedDocumentNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidedDocumentNumberMultiError, or nil if none found.
+func (m *VoidedDocumentNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidedDocumentNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVoidedDocumentNumberD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "VoidedDocumentNumberD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "VoidedDocumentNumberD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVoidedDocumentNumberD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoidedDocumentNumberValidationError{
+				field:  "VoidedDocumentNumberD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoidedDocumentNumberValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoidedDocumentNumberValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoidedDocumentNumberValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VoidedDocumentNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidedDocumentNumberMultiError is an error wrapping multiple validation
+// errors returned by VoidedDocumentNumber.ValidateAll() if the designated
+// constraints aren't met.
+type VoidedDocumentNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidedDocumentNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidedDocumentNumberMultiError) AllErrors() []error { return m }
+
+// VoidedDocumentNumberValidationError is the validation error returned by
+// VoidedDocumentNumber.Validate if the designated constraints aren't met.
+type VoidedDocumentNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidedDocumentNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidedDocumentNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidedDocumentNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidedDocumentNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidedDocumentNumberValidationError) ErrorName() string {
+	return "VoidedDocumentNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidedDocumentNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidedDocumentNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidedDocumentNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidedDocumentNumberValidationError{}
+
+// Validate checks the field values on VoidedDocumentNumberD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidedDocumentNumberD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidedDocumentNumberD with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidedDocumentNumberDMultiError, or nil if none found.
+func (m *VoidedDocumentNumberD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidedDocumentNumberD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for SequenceId
+
+	// no validation rules for PartyId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for FiscalYear
+
+	// no validation rules for DocumentNumber
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return VoidedDocumentNumberDMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidedDocumentNumberDMultiError is an error wrapping multiple validation
+// errors returned by VoidedDocumentNumberD.ValidateAll() if the designated
+// constraints aren't met.
+type VoidedDocumentNumberDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidedDocumentNumberDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidedDocumentNumberDMultiError) AllErrors() []error { return m }
+
+// VoidedDocumentNumberDValidationError is the validation error returned by
+// VoidedDocumentNumberD.Validate if the designated constraints aren't met.
+type VoidedDocumentNumberDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidedDocumentNumberDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidedDocumentNumberDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidedDocumentNumberDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidedDocumentNumberDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidedDocumentNumberDValidationError) ErrorName() string {
+	return "VoidedDocumentNumberDValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidedDocumentNumberDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidedDocumentNumberD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidedDocumentNumberDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidedDocumentNumberDValidationError{}
+
+// Validate checks the field values on CreateDocumentNumberSequenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateDocumentNumberSequenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDocumentNumberSequenceRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateDocumentNumberSequenceRequestMultiError, or nil if none found.
+func (m *CreateDocumentNumberSequenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDocumentNumberSequenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartyId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for Pattern
+
+	// no validation rules for FiscalYearStartMonth
+
+	// no validation rules for FiscalYear
+
+	// no validation rules for NextNumber
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return CreateDocumentNumberSequenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDocumentNumberSequenceRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateDocumentNumberSequenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateDocumentNumberSequenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDocumentNumberSequenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDocumentNumberSequenceRequestMultiError) AllErrors() []error { return m }
+
+// CreateDocumentNumberSequenceRequestValidationError is the validation error
+// returned by CreateDocumentNumberSequenceRequest.Validate if the designated
+// constraints aren't met.
+type CreateDocumentNumberSequenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDocumentNumberSequenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDocumentNumberSequenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDocumentNumberSequenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDocumentNumberSequenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDocumentNumberSequenceRequestValidationError) ErrorName() string {
+	return "CreateDocumentNumberSequenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDocumentNumberSequenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDocumentNumberSequenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDocumentNumberSequenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDocumentNumberSequenceRequestValidationError{}
+
+// Validate checks the field values on CreateDocumentNumberSequenceResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateDocumentNumberSequenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDocumentNumberSequenceResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateDocumentNumberSequenceResponseMultiError, or nil if none found.
+func (m *CreateDocumentNumberSequenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDocumentNumberSequenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentNumberSequence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDocumentNumberSequenceResponseValidationError{
+					field:  "DocumentNumberSequence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDocumentNumberSequenceResponseValidationError{
+					field:  "DocumentNumberSequence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentNumberSequence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDocumentNumberSequenceResponseValidationError{
+				field:  "DocumentNumberSequence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDocumentNumberSequenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDocumentNumberSequenceResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateDocumentNumberSequenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateDocumentNumberSequenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDocumentNumberSequenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDocumentNumberSequenceResponseMultiError) AllErrors() []error { return m }
+
+// CreateDocumentNumberSequenceResponseValidationError is the validation error
+// returned by CreateDocumentNumberSequenceResponse.Validate if the designated
+// constraints aren't met.
+type CreateDocumentNumberSequenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDocumentNumberSequenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDocumentNumberSequenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDocumentNumberSequenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDocumentNumberSequenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDocumentNumberSequenceResponseValidationError) ErrorName() string {
+	return "CreateDocumentNumberSequenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDocumentNumberSequenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDocumentNumberSequenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDocumentNumberSequenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDocumentNumberSequenceResponseValidationError{}
+
+// Validate checks the field values on GetDocumentNumberSequencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDocumentNumberSequencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentNumberSequencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDocumentNumberSequencesRequestMultiError, or nil if none found.
+func (m *GetDocumentNumberSequencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentNumberSequencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetDocumentNumberSequencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentNumberSequencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentNumberSequencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentNumberSequencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentNumberSequencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentNumberSequencesRequestMultiError) AllErrors() []error { return m }
+
+// GetDocumentNumberSequencesRequestValidationError is the validation error
+// returned by GetDocumentNumberSequencesRequest.Validate if the designated
+// constraints aren't met.
+type GetDocumentNumberSequencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentNumberSequencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentNumberSequencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentNumberSequencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentNumberSequencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentNumberSequencesRequestValidationError) ErrorName() string {
+	return "GetDocumentNumberSequencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentNumberSequencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentNumberSequencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentNumberSequencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentNumberSequencesRequestValidationError{}
+
+// Validate checks the field values on GetDocumentNumberSequencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDocumentNumberSequencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentNumberSequencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDocumentNumberSequencesResponseMultiError, or nil if none found.
+func (m *GetDocumentNumberSequencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentNumberSequencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocumentNumberSequences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDocumentNumberSequencesResponseValidationError{
+						field:  fmt.Sprintf("DocumentNumberSequences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDocumentNumberSequencesResponseValidationError{
+						field:  fmt.Sprintf("DocumentNumberSequences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDocumentNumberSequencesResponseValidationError{
+					field:  fmt.Sprintf("DocumentNumberSequences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetDocumentNumberSequencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentNumberSequencesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentNumberSequencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentNumberSequencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentNumberSequencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentNumberSequencesResponseMultiError) AllErrors() []error { return m }
+
+// GetDocumentNumberSequencesResponseValidationError is the validation error
+// returned by GetDocumentNumberSequencesResponse.Validate if the designated
+// constraints aren't met.
+type GetDocumentNumberSequencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentNumberSequencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentNumberSequencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentNumberSequencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentNumberSequencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentNumberSequencesResponseValidationError) ErrorName() string {
+	return "GetDocumentNumberSequencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentNumberSequencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentNumberSequencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentNumberSequencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentNumberSequencesResponseValidationError{}
+
+// Validate checks the field values on GetDocumentNumberSequenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDocumentNumberSequenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentNumberSequenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDocumentNumberSequenceRequestMultiError, or nil if none found.
+func (m *GetDocumentNumberSequenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentNumberSequenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDocumentNumberSequenceRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDocumentNumberSequenceRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDocumentNumberSequenceRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDocumentNumberSequenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentNumberSequenceRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentNumberSequenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentNumberSequenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentNumberSequenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentNumberSequenceRequestMultiError) AllErrors() []error { return m }
+
+// GetDocumentNumberSequenceRequestValidationError is the validation error
+// returned by GetDocumentNumberSequenceRequest.Validate if the designated
+// constraints aren't met.
+type GetDocumentNumberSequenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentNumberSequenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentNumberSequenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentNumberSequenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentNumberSequenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentNumberSequenceRequestValidationError) ErrorName() string {
+	return "GetDocumentNumberSequenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentNumberSequenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentNumberSequenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentNumberSequenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentNumberSequenceRequestValidationError{}
+
+// Validate checks the field values on GetDocumentNumberSequenceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDocumentNumberSequenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentNumberSequenceResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDocumentNumberSequenceResponseMultiError, or nil if none found.
+func (m *GetDocumentNumberSequenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentNumberSequenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocumentNumberSequence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDocumentNumberSequenceResponseValidationError{
+					field:  "DocumentNumberSequence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDocumentNumberSequenceResponseValidationError{
+					field:  "DocumentNumberSequence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocumentNumberSequence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDocumentNumberSequenceResponseValidationError{
+				field:  "DocumentNumberSequence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDocumentNumberSequenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentNumberSequenceResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDocumentNumberSequenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDocumentNumberSequenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentNumberSequenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentNumberSequenceResponseMultiError) AllErrors() []error { return m }
+
+// GetDocumentNumberSequenceResponseValidationError is the validation error
+// returned by GetDocumentNumberSequenceResponse.Validate if the designated
+// constraints aren't met.
+type GetDocumentNumberSequenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentNumberSequenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentNumberSequenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentNumberSequenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentNumberSequenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentNumberSequenceResponseValidationError) ErrorName() string {
+	return "GetDocumentNumberSequenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentNumberSequenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentNumberSequenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentNumberSequenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentNumberSequenceResponseValidationError{}
+
+// Validate checks the field values on UpdateDocumentNumberSequenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateDocumentNumberSequenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDocumentNumberSequenceRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDocumentNumberSequenceRequestMultiError, or nil if none found.
+func (m *UpdateDocumentNumberSequenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDocumentNumberSequenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pattern
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return UpdateDocumentNumberSequenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDocumentNumberSequenceRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDocumentNumberSequenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDocumentNumberSequenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDocumentNumberSequenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDocumentNumberSequenceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDocumentNumberSequenceRequestValidationError is the validation error
+// returned by UpdateDocumentNumberSequenceRequest.Validate if the designated
+// constraints aren't met.
+type UpdateDocumentNumberSequenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDocumentNumberSequenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDocumentNumberSequenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDocumentNumberSequenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDocumentNumberSequenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDocumentNumberSequenceRequestValidationError) ErrorName() string {
+	return "UpdateDocumentNumberSequenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDocumentNumberSequenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDocumentNumberSequenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDocumentNumberSequenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDocumentNumberSequenceRequestValidationError{}
+
+// Validate checks the field values on UpdateDocumentNumberSequenceResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateDocumentNumberSequenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDocumentNumberSequenceResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDocumentNumberSequenceResponseMultiError, or nil if none found.
+func (m *UpdateDocumentNumberSequenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDocumentNumberSequenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateDocumentNumberSequenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDocumentNumberSequenceResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDocumentNumberSequenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDocumentNumberSequenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDocumentNumberSequenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDocumentNumberSequenceResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDocumentNumberSequenceResponseValidationError is the validation error
+// returned by UpdateDocumentNumberSequenceResponse.Validate if the designated
+// constraints aren't met.
+type UpdateDocumentNumberSequenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDocumentNumberSequenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDocumentNumberSequenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDocumentNumberSequenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDocumentNumberSequenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDocumentNumberSequenceResponseValidationError) ErrorName() string {
+	return "UpdateDocumentNumberSequenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDocumentNumberSequenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDocumentNumberSequenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDocumentNumberSequenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDocumentNumberSequenceResponseValidationError{}
+
+// Validate checks the field values on VoidDocumentNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidDocumentNumberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidDocumentNumberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidDocumentNumberRequestMultiError, or nil if none found.
+func (m *VoidDocumentNumberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidDocumentNumberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartyId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for DocumentNumber
+
+	// no validation rules for Reason
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return VoidDocumentNumberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidDocumentNumberRequestMultiError is an error wrapping multiple validation
+// errors returned by VoidDocumentNumberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VoidDocumentNumberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidDocumentNumberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidDocumentNumberRequestMultiError) AllErrors() []error { return m }
+
+// VoidDocumentNumberRequestValidationError is the validation error returned by
+// VoidDocumentNumberRequest.Validate if the designated constraints aren't met.
+type VoidDocumentNumberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidDocumentNumberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidDocumentNumberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidDocumentNumberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidDocumentNumberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidDocumentNumberRequestValidationError) ErrorName() string {
+	return "VoidDocumentNumberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidDocumentNumberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidDocumentNumberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidDocumentNumberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidDocumentNumberRequestValidationError{}
+
+// Validate checks the field values on VoidDocumentNumberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VoidDocumentNumberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidDocumentNumberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidDocumentNumberResponseMultiError, or nil if none found.
+func (m *VoidDocumentNumberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidDocumentNumberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVoidedDocumentNumber()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoidDocumentNumberResponseValidationError{
+					field:  "VoidedDocumentNumber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoidDocumentNumberResponseValidationError{
+					field:  "VoidedDocumentNumber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVoidedDocumentNumber()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoidDocumentNumberResponseValidationError{
+				field:  "VoidedDocumentNumber",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VoidDocumentNumberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoidDocumentNumberResponseMultiError is an error wrapping multiple
+// validation errors returned by VoidDocumentNumberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type VoidDocumentNumberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidDocumentNumberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidDocumentNumberResponseMultiError) AllErrors() []error { return m }
+
+// VoidDocumentNumberResponseValidationError is the validation error returned
+// by VoidDocumentNumberResponse.Validate if the designated constraints aren't met.
+type VoidDocumentNumberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidDocumentNumberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidDocumentNumberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidDocumentNumberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidDocumentNumberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidDocumentNumberResponseValidationError) ErrorName() string {
+	return "VoidDocumentNumberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoidDocumentNumberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidDocumentNumberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidDocumentNumberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidDocumentNumberResponseValidationError{}
+
+// Validate checks the field values on GetVoidedDocumentNumbersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVoidedDocumentNumbersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVoidedDocumentNumbersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetVoidedDocumentNumbersRequestMultiError, or nil if none found.
+func (m *GetVoidedDocumentNumbersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVoidedDocumentNumbersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartyId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetVoidedDocumentNumbersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVoidedDocumentNumbersRequestMultiError is an error wrapping multiple
+// validation errors returned by GetVoidedDocumentNumbersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetVoidedDocumentNumbersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVoidedDocumentNumbersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVoidedDocumentNumbersRequestMultiError) AllErrors() []error { return m }
+
+// GetVoidedDocumentNumbersRequestValidationError is the validation error
+// returned by GetVoidedDocumentNumbersRequest.Validate if the designated
+// constraints aren't met.
+type GetVoidedDocumentNumbersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVoidedDocumentNumbersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVoidedDocumentNumbersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVoidedDocumentNumbersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVoidedDocumentNumbersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVoidedDocumentNumbersRequestValidationError) ErrorName() string {
+	return "GetVoidedDocumentNumbersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVoidedDocumentNumbersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVoidedDocumentNumbersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVoidedDocumentNumbersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVoidedDocumentNumbersRequestValidationError{}
+
+// Validate checks the field values on GetVoidedDocumentNumbersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetVoidedDocumentNumbersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVoidedDocumentNumbersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetVoidedDocumentNumbersResponseMultiError, or nil if none found.
+func (m *GetVoidedDocumentNumbersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVoidedDocumentNumbersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVoidedDocumentNumbers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVoidedDocumentNumbersResponseValidationError{
+						field:  fmt.Sprintf("VoidedDocumentNumbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVoidedDocumentNumbersResponseValidationError{
+						field:  fmt.Sprintf("VoidedDocumentNumbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVoidedDocumentNumbersResponseValidationError{
+					field:  fmt.Sprintf("VoidedDocumentNumbers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetVoidedDocumentNumbersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVoidedDocumentNumbersResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetVoidedDocumentNumbersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetVoidedDocumentNumbersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVoidedDocumentNumbersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVoidedDocumentNumbersResponseMultiError) AllErrors() []error { return m }
+
+// GetVoidedDocumentNumbersResponseValidationError is the validation error
+// returned by GetVoidedDocumentNumbersResponse.Validate if the designated
+// constraints aren't met.
+type GetVoidedDocumentNumbersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVoidedDocumentNumbersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVoidedDocumentNumbersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVoidedDocumentNumbersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVoidedDocumentNumbersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVoidedDocumentNumbersResponseValidationError) ErrorName() string {
+	return "GetVoidedDocumentNumbersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVoidedDocumentNumbersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVoidedDocumentNumbersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVoidedDocumentNumbersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVoidedDocumentNumbersResponseValidationError{}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: party/v1/numbering.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DocumentNumberingService_CreateDocumentNumberSequence_FullMethodName = "/party.v1.DocumentNumberingService/CreateDocumentNumberSequence"
+	DocumentNumberingService_GetDocumentNumberSequences_FullMethodName   = "/party.v1.DocumentNumberingService/GetDocumentNumberSequences"
+	DocumentNumberingService_GetDocumentNumberSequence_FullMethodName    = "/party.v1.DocumentNumberingService/GetDocumentNumberSequence"
+	DocumentNumberingService_UpdateDocumentNumberSequence_FullMethodName = "/party.v1.DocumentNumberingService/UpdateDocumentNumberSequence"
+	DocumentNumberingService_VoidDocumentNumber_FullMethodName           = "/party.v1.DocumentNumberingService/VoidDocumentNumber"
+	DocumentNumberingService_GetVoidedDocumentNumbers_FullMethodName     = "/party.v1.DocumentNumberingService/GetVoidedDocumentNumbers"
+)
+
+// DocumentNumberingServiceClient is the client API for DocumentNumberingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The DocumentNumberingService service definition.
+type DocumentNumberingServiceClient interface {
+	CreateDocumentNumberSequence(ctx context.Context, in *CreateDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*CreateDocumentNumberSequenceResponse, error)
+	GetDocumentNumberSequences(ctx context.Context, in *GetDocumentNumberSequencesRequest, opts ...grpc.CallOption) (*GetDocumentNumberSequencesResponse, error)
+	GetDocumentNumberSequence(ctx context.Context, in *GetDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*GetDocumentNumberSequenceResponse, error)
+	UpdateDocumentNumberSequence(ctx context.Context, in *UpdateDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*UpdateDocumentNumberSequenceResponse, error)
+	VoidDocumentNumber(ctx context.Context, in *VoidDocumentNumberRequest, opts ...grpc.CallOption) (*VoidDocumentNumberResponse, error)
+	GetVoidedDocumentNumbers(ctx context.Context, in *GetVoidedDocumentNumbersRequest, opts ...grpc.CallOption) (*GetVoidedDocumentNumbersResponse, error)
+}
+
+type documentNumberingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDocumentNumberingServiceClient(cc grpc.ClientConnInterface) DocumentNumberingServiceClient {
+	return &documentNumberingServiceClient{cc}
+}
+
+func (c *documentNumberingServiceClient) CreateDocumentNumberSequence(ctx context.Context, in *CreateDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*CreateDocumentNumberSequenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDocumentNumberSequenceResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_CreateDocumentNumberSequence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentNumberingServiceClient) GetDocumentNumberSequences(ctx context.Context, in *GetDocumentNumberSequencesRequest, opts ...grpc.CallOption) (*GetDocumentNumberSequencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentNumberSequencesResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_GetDocumentNumberSequences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentNumberingServiceClient) GetDocumentNumberSequence(ctx context.Context, in *GetDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*GetDocumentNumberSequenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentNumberSequenceResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_GetDocumentNumberSequence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentNumberingServiceClient) UpdateDocumentNumberSequence(ctx context.Context, in *UpdateDocumentNumberSequenceRequest, opts ...grpc.CallOption) (*UpdateDocumentNumberSequenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDocumentNumberSequenceResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_UpdateDocumentNumberSequence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentNumberingServiceClient) VoidDocumentNumber(ctx context.Context, in *VoidDocumentNumberRequest, opts ...grpc.CallOption) (*VoidDocumentNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidDocumentNumberResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_VoidDocumentNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentNumberingServiceClient) GetVoidedDocumentNumbers(ctx context.Context, in *GetVoidedDocumentNumbersRequest, opts ...grpc.CallOption) (*GetVoidedDocumentNumbersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVoidedDocumentNumbersResponse)
+	err := c.cc.Invoke(ctx, DocumentNumberingService_GetVoidedDocumentNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DocumentNumberingServiceServer is the server API for DocumentNumberingService service.
+// All implementations must embed UnimplementedDocumentNumberingServiceServer
+// for forward compatibility.
+//
+// The DocumentNumberingService service definition.
+type DocumentNumberingServiceServer interface {
+	CreateDocumentNumberSequence(context.Context, *CreateDocumentNumberSequenceRequest) (*CreateDocumentNumberSequenceResponse, error)
+	GetDocumentNumberSequences(context.Context, *GetDocumentNumberSequencesRequest) (*GetDocumentNumberSequencesResponse, error)
+	GetDocumentNumberSequence(context.Context, *GetDocumentNumberSequenceRequest) (*GetDocumentNumberSequenceResponse, error)
+	UpdateDocumentNumberSequence(context.Context, *UpdateDocumentNumberSequenceRequest) (*UpdateDocumentNumberSequenceResponse, error)
+	VoidDocumentNumber(context.Context, *VoidDocumentNumberRequest) (*VoidDocumentNumberResponse, error)
+	GetVoidedDocumentNumbers(context.Context, *GetVoidedDocumentNumbersRequest) (*GetVoidedDocumentNumbersResponse, error)
+	mustEmbedUnimplementedDocumentNumberingServiceServer()
+}
+
+// UnimplementedDocumentNumberingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDocumentNumberingServiceServer struct{}
+
+func (UnimplementedDocumentNumberingServiceServer) CreateDocumentNumberSequence(context.Context, *CreateDocumentNumberSequenceRequest) (*CreateDocumentNumberSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocumentNumberSequence not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) GetDocumentNumberSequences(context.Context, *GetDocumentNumberSequencesRequest) (*GetDocumentNumberSequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentNumberSequences not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) GetDocumentNumberSequence(context.Context, *GetDocumentNumberSequenceRequest) (*GetDocumentNumberSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentNumberSequence not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) UpdateDocumentNumberSequence(context.Context, *UpdateDocumentNumberSequenceRequest) (*UpdateDocumentNumberSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentNumberSequence not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) VoidDocumentNumber(context.Context, *VoidDocumentNumberRequest) (*VoidDocumentNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidDocumentNumber not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) GetVoidedDocumentNumbers(context.Context, *GetVoidedDocumentNumbersRequest) (*GetVoidedDocumentNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoidedDocumentNumbers not implemented")
+}
+func (UnimplementedDocumentNumberingServiceServer) mustEmbedUnimplementedDocumentNumberingServiceServer() {
+}
+func (UnimplementedDocumentNumberingServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDocumentNumberingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DocumentNumberingServiceServer will
+// result in compilation errors.
+type UnsafeDocumentNumberingServiceServer interface {
+	mustEmbedUnimplementedDocumentNumberingServiceServer()
+}
+
+func RegisterDocumentNumberingServiceServer(s grpc.ServiceRegistrar, srv DocumentNumberingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDocumentNumberingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DocumentNumberingService_ServiceDesc, srv)
+}
+
+func _DocumentNumberingService_CreateDocumentNumberSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentNumberSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).CreateDocumentNumberSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_CreateDocumentNumberSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).CreateDocumentNumberSequence(ctx, req.(*CreateDocumentNumberSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentNumberingService_GetDocumentNumberSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentNumberSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).GetDocumentNumberSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_GetDocumentNumberSequences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).GetDocumentNumberSequences(ctx, req.(*GetDocumentNumberSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentNumberingService_GetDocumentNumberSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentNumberSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).GetDocumentNumberSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_GetDocumentNumberSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).GetDocumentNumberSequence(ctx, req.(*GetDocumentNumberSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentNumberingService_UpdateDocumentNumberSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentNumberSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).UpdateDocumentNumberSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_UpdateDocumentNumberSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).UpdateDocumentNumberSequence(ctx, req.(*UpdateDocumentNumberSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentNumberingService_VoidDocumentNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidDocumentNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).VoidDocumentNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_VoidDocumentNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).VoidDocumentNumber(ctx, req.(*VoidDocumentNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentNumberingService_GetVoidedDocumentNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoidedDocumentNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentNumberingServiceServer).GetVoidedDocumentNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentNumberingService_GetVoidedDocumentNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentNumberingServiceServer).GetVoidedDocumentNumbers(ctx, req.(*GetVoidedDocumentNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DocumentNumberingService_ServiceDesc is the grpc.ServiceDesc for DocumentNumberingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DocumentNumberingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "party.v1.DocumentNumberingService",
+	HandlerType: (*DocumentNumberingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDocumentNumberSequence",
+			Handler:    _DocumentNumberingService_CreateDocumentNumberSequence_Handler,
+		},
+		{
+			MethodName: "GetDocumentNumberSequences",
+			Handler:    _DocumentNumberingService_GetDocumentNumberSequences_Handler,
+		},
+		{
+			MethodName: "GetDocumentNumberSequence",
+			Handler:    _DocumentNumberingService_GetDocumentNumberSequence_Handler,
+		},
+		{
+			MethodName: "UpdateDocumentNumberSequence",
+			Handler:    _DocumentNumberingService_UpdateDocumentNumberSequence_Handler,
+		},
+		{
+			MethodName: "VoidDocumentNumber",
+			Handler:    _DocumentNumberingService_VoidDocumentNumber_Handler,
+		},
+		{
+			MethodName: "GetVoidedDocumentNumbers",
+			Handler:    _DocumentNumberingService_GetVoidedDocumentNumbers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "party/v1/numbering.proto",
+}
@@ -592,6 +592,8 @@ func (m *CreatePartyRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePartyRequestMultiError(errors)
 	}
@@ -854,6 +856,8 @@ func (m *CreateChildRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateChildRequestMultiError(errors)
 	}
@@ -3353,6 +3357,8 @@ func (m *CreatePartyContactRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePartyContactRequestMultiError(errors)
 	}
@@ -5619,6 +5625,8 @@ func (m *CreatePartySocialProfileRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePartySocialProfileRequestMultiError(errors)
 	}
@@ -6521,6 +6529,8 @@ func (m *CreatePartyCorporateJurisdictionRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePartyCorporateJurisdictionRequestMultiError(errors)
 	}
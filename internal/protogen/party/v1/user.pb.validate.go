@@ -2009,6 +2009,8 @@ func (m *CreateRoleRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateRoleRequestMultiError(errors)
 	}
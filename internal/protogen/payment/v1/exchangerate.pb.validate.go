@@ -0,0 +1,2180 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: payment/v1/exchangerate.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExchangeRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeRateMultiError, or
+// nil if none found.
+func (m *ExchangeRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExchangeRateD()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "ExchangeRateD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "ExchangeRateD",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExchangeRateD()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "ExchangeRateD",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExchangeRateT()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "ExchangeRateT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "ExchangeRateT",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExchangeRateT()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "ExchangeRateT",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CrUpdUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "CrUpdUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrUpdTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CrUpdTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrUpdTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "CrUpdTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExchangeRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeRateMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRate.ValidateAll() if the designated constraints aren't met.
+type ExchangeRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeRateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeRateMultiError) AllErrors() []error { return m }
+
+// ExchangeRateValidationError is the validation error returned by
+// ExchangeRate.Validate if the designated constraints aren't met.
+type ExchangeRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeRateValidationError) ErrorName() string { return "ExchangeRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExchangeRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeRateValidationError{}
+
+// Validate checks the field values on ExchangeRateD with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRateD) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRateD with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeRateDMultiError, or
+// nil if none found.
+func (m *ExchangeRateD) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRateD) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid4
+
+	// no validation rules for IdS
+
+	// no validation rules for SourceCurrencyCode
+
+	// no validation rules for TargetCurrencyCode
+
+	// no validation rules for ExchangeMarketId
+
+	// no validation rules for CalculationRate
+
+	if len(errors) > 0 {
+		return ExchangeRateDMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeRateDMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRateD.ValidateAll() if the designated constraints
+// aren't met.
+type ExchangeRateDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeRateDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeRateDMultiError) AllErrors() []error { return m }
+
+// ExchangeRateDValidationError is the validation error returned by
+// ExchangeRateD.Validate if the designated constraints aren't met.
+type ExchangeRateDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeRateDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeRateDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeRateDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeRateDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeRateDValidationError) ErrorName() string { return "ExchangeRateDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExchangeRateDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeRateD.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeRateDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeRateDValidationError{}
+
+// Validate checks the field values on ExchangeRateT with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRateT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRateT with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeRateTMultiError, or
+// nil if none found.
+func (m *ExchangeRateT) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRateT) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRateDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateTValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateTValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateTValidationError{
+				field:  "RateDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExchangeRateTMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeRateTMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRateT.ValidateAll() if the designated constraints
+// aren't met.
+type ExchangeRateTMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeRateTMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeRateTMultiError) AllErrors() []error { return m }
+
+// ExchangeRateTValidationError is the validation error returned by
+// ExchangeRateT.Validate if the designated constraints aren't met.
+type ExchangeRateTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeRateTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeRateTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeRateTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeRateTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeRateTValidationError) ErrorName() string { return "ExchangeRateTValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExchangeRateTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeRateT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeRateTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeRateTValidationError{}
+
+// Validate checks the field values on CreateExchangeRateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateExchangeRateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExchangeRateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateExchangeRateRequestMultiError, or nil if none found.
+func (m *CreateExchangeRateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExchangeRateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceCurrencyCode
+
+	// no validation rules for TargetCurrencyCode
+
+	// no validation rules for ExchangeMarketId
+
+	// no validation rules for CalculationRate
+
+	// no validation rules for RateDate
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	// no validation rules for IdempotencyKey
+
+	if len(errors) > 0 {
+		return CreateExchangeRateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExchangeRateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateExchangeRateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateExchangeRateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExchangeRateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExchangeRateRequestMultiError) AllErrors() []error { return m }
+
+// CreateExchangeRateRequestValidationError is the validation error returned by
+// CreateExchangeRateRequest.Validate if the designated constraints aren't met.
+type CreateExchangeRateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExchangeRateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExchangeRateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExchangeRateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExchangeRateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExchangeRateRequestValidationError) ErrorName() string {
+	return "CreateExchangeRateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExchangeRateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExchangeRateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExchangeRateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExchangeRateRequestValidationError{}
+
+// Validate checks the field values on CreateExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateExchangeRateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateExchangeRateResponseMultiError, or nil if none found.
+func (m *CreateExchangeRateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExchangeRateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExchangeRate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExchangeRateResponseValidationError{
+					field:  "ExchangeRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExchangeRateResponseValidationError{
+					field:  "ExchangeRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExchangeRate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExchangeRateResponseValidationError{
+				field:  "ExchangeRate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExchangeRateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExchangeRateResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateExchangeRateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateExchangeRateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExchangeRateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExchangeRateResponseMultiError) AllErrors() []error { return m }
+
+// CreateExchangeRateResponseValidationError is the validation error returned
+// by CreateExchangeRateResponse.Validate if the designated constraints aren't met.
+type CreateExchangeRateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExchangeRateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExchangeRateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExchangeRateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExchangeRateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExchangeRateResponseValidationError) ErrorName() string {
+	return "CreateExchangeRateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExchangeRateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExchangeRateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExchangeRateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExchangeRateResponseValidationError{}
+
+// Validate checks the field values on GetExchangeRatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExchangeRatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExchangeRatesRequestMultiError, or nil if none found.
+func (m *GetExchangeRatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExchangeRatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for NextCursor
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return GetExchangeRatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExchangeRatesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExchangeRatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExchangeRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExchangeRatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExchangeRatesRequestMultiError) AllErrors() []error { return m }
+
+// GetExchangeRatesRequestValidationError is the validation error returned by
+// GetExchangeRatesRequest.Validate if the designated constraints aren't met.
+type GetExchangeRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExchangeRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExchangeRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExchangeRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExchangeRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExchangeRatesRequestValidationError) ErrorName() string {
+	return "GetExchangeRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExchangeRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExchangeRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExchangeRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExchangeRatesRequestValidationError{}
+
+// Validate checks the field values on GetExchangeRatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExchangeRatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExchangeRatesResponseMultiError, or nil if none found.
+func (m *GetExchangeRatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExchangeRatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExchangeRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetExchangeRatesResponseValidationError{
+						field:  fmt.Sprintf("ExchangeRates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetExchangeRatesResponseValidationError{
+						field:  fmt.Sprintf("ExchangeRates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExchangeRatesResponseValidationError{
+					field:  fmt.Sprintf("ExchangeRates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetExchangeRatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExchangeRatesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetExchangeRatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetExchangeRatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExchangeRatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExchangeRatesResponseMultiError) AllErrors() []error { return m }
+
+// GetExchangeRatesResponseValidationError is the validation error returned by
+// GetExchangeRatesResponse.Validate if the designated constraints aren't met.
+type GetExchangeRatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExchangeRatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExchangeRatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExchangeRatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExchangeRatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExchangeRatesResponseValidationError) ErrorName() string {
+	return "GetExchangeRatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExchangeRatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExchangeRatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExchangeRatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExchangeRatesResponseValidationError{}
+
+// Validate checks the field values on GetExchangeRateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExchangeRateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExchangeRateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExchangeRateRequestMultiError, or nil if none found.
+func (m *GetExchangeRateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExchangeRateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExchangeRateRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExchangeRateRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExchangeRateRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetExchangeRateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExchangeRateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExchangeRateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExchangeRateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExchangeRateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExchangeRateRequestMultiError) AllErrors() []error { return m }
+
+// GetExchangeRateRequestValidationError is the validation error returned by
+// GetExchangeRateRequest.Validate if the designated constraints aren't met.
+type GetExchangeRateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExchangeRateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExchangeRateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExchangeRateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExchangeRateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExchangeRateRequestValidationError) ErrorName() string {
+	return "GetExchangeRateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExchangeRateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExchangeRateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExchangeRateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExchangeRateRequestValidationError{}
+
+// Validate checks the field values on GetExchangeRateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExchangeRateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExchangeRateResponseMultiError, or nil if none found.
+func (m *GetExchangeRateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExchangeRateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExchangeRate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExchangeRateResponseValidationError{
+					field:  "ExchangeRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExchangeRateResponseValidationError{
+					field:  "ExchangeRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExchangeRate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExchangeRateResponseValidationError{
+				field:  "ExchangeRate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetExchangeRateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExchangeRateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetExchangeRateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetExchangeRateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExchangeRateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExchangeRateResponseMultiError) AllErrors() []error { return m }
+
+// GetExchangeRateResponseValidationError is the validation error returned by
+// GetExchangeRateResponse.Validate if the designated constraints aren't met.
+type GetExchangeRateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExchangeRateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExchangeRateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExchangeRateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExchangeRateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExchangeRateResponseValidationError) ErrorName() string {
+	return "GetExchangeRateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExchangeRateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExchangeRateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExchangeRateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExchangeRateResponseValidationError{}
+
+// Validate checks the field values on UpdateExchangeRateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateExchangeRateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateExchangeRateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateExchangeRateRequestMultiError, or nil if none found.
+func (m *UpdateExchangeRateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateExchangeRateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CalculationRate
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return UpdateExchangeRateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateExchangeRateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateExchangeRateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateExchangeRateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateExchangeRateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateExchangeRateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateExchangeRateRequestValidationError is the validation error returned by
+// UpdateExchangeRateRequest.Validate if the designated constraints aren't met.
+type UpdateExchangeRateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExchangeRateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExchangeRateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExchangeRateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExchangeRateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExchangeRateRequestValidationError) ErrorName() string {
+	return "UpdateExchangeRateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExchangeRateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExchangeRateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExchangeRateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExchangeRateRequestValidationError{}
+
+// Validate checks the field values on UpdateExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateExchangeRateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateExchangeRateResponseMultiError, or nil if none found.
+func (m *UpdateExchangeRateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateExchangeRateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateExchangeRateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateExchangeRateResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateExchangeRateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateExchangeRateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateExchangeRateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateExchangeRateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateExchangeRateResponseValidationError is the validation error returned
+// by UpdateExchangeRateResponse.Validate if the designated constraints aren't met.
+type UpdateExchangeRateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExchangeRateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExchangeRateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExchangeRateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExchangeRateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExchangeRateResponseValidationError) ErrorName() string {
+	return "UpdateExchangeRateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExchangeRateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExchangeRateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExchangeRateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExchangeRateResponseValidationError{}
+
+// Validate checks the field values on DeleteExchangeRateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteExchangeRateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExchangeRateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteExchangeRateRequestMultiError, or nil if none found.
+func (m *DeleteExchangeRateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExchangeRateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteExchangeRateRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteExchangeRateRequestValidationError{
+					field:  "GetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteExchangeRateRequestValidationError{
+				field:  "GetRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteExchangeRateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExchangeRateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteExchangeRateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteExchangeRateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExchangeRateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExchangeRateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteExchangeRateRequestValidationError is the validation error returned by
+// DeleteExchangeRateRequest.Validate if the designated constraints aren't met.
+type DeleteExchangeRateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExchangeRateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExchangeRateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExchangeRateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExchangeRateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExchangeRateRequestValidationError) ErrorName() string {
+	return "DeleteExchangeRateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExchangeRateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExchangeRateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExchangeRateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExchangeRateRequestValidationError{}
+
+// Validate checks the field values on DeleteExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteExchangeRateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteExchangeRateResponseMultiError, or nil if none found.
+func (m *DeleteExchangeRateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExchangeRateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteExchangeRateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExchangeRateResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteExchangeRateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteExchangeRateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExchangeRateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExchangeRateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteExchangeRateResponseValidationError is the validation error returned
+// by DeleteExchangeRateResponse.Validate if the designated constraints aren't met.
+type DeleteExchangeRateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExchangeRateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExchangeRateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExchangeRateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExchangeRateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExchangeRateResponseValidationError) ErrorName() string {
+	return "DeleteExchangeRateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExchangeRateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExchangeRateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExchangeRateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExchangeRateResponseValidationError{}
+
+// Validate checks the field values on ImportExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportExchangeRatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportExchangeRatesRequestMultiError, or nil if none found.
+func (m *ImportExchangeRatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportExchangeRatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Format
+
+	// no validation rules for SourceCurrencyCode
+
+	// no validation rules for ExchangeMarketId
+
+	// no validation rules for UserId
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ImportExchangeRatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportExchangeRatesRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportExchangeRatesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ImportExchangeRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportExchangeRatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportExchangeRatesRequestMultiError) AllErrors() []error { return m }
+
+// ImportExchangeRatesRequestValidationError is the validation error returned
+// by ImportExchangeRatesRequest.Validate if the designated constraints aren't met.
+type ImportExchangeRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExchangeRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExchangeRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExchangeRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExchangeRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExchangeRatesRequestValidationError) ErrorName() string {
+	return "ImportExchangeRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExchangeRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExchangeRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExchangeRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExchangeRatesRequestValidationError{}
+
+// Validate checks the field values on ImportExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportExchangeRatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportExchangeRatesResponseMultiError, or nil if none found.
+func (m *ImportExchangeRatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportExchangeRatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RateCount
+
+	if len(errors) > 0 {
+		return ImportExchangeRatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportExchangeRatesResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportExchangeRatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportExchangeRatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportExchangeRatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportExchangeRatesResponseMultiError) AllErrors() []error { return m }
+
+// ImportExchangeRatesResponseValidationError is the validation error returned
+// by ImportExchangeRatesResponse.Validate if the designated constraints
+// aren't met.
+type ImportExchangeRatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExchangeRatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExchangeRatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExchangeRatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExchangeRatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExchangeRatesResponseValidationError) ErrorName() string {
+	return "ImportExchangeRatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExchangeRatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExchangeRatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExchangeRatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExchangeRatesResponseValidationError{}
+
+// Validate checks the field values on ConvertAmountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertAmountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertAmountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertAmountRequestMultiError, or nil if none found.
+func (m *ConvertAmountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertAmountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for SourceCurrencyCode
+
+	// no validation rules for TargetCurrencyCode
+
+	// no validation rules for ExchangeMarketId
+
+	// no validation rules for RateDate
+
+	// no validation rules for UserEmail
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ConvertAmountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertAmountRequestMultiError is an error wrapping multiple validation
+// errors returned by ConvertAmountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertAmountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertAmountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertAmountRequestMultiError) AllErrors() []error { return m }
+
+// ConvertAmountRequestValidationError is the validation error returned by
+// ConvertAmountRequest.Validate if the designated constraints aren't met.
+type ConvertAmountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertAmountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertAmountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertAmountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertAmountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertAmountRequestValidationError) ErrorName() string {
+	return "ConvertAmountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertAmountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertAmountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertAmountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertAmountRequestValidationError{}
+
+// Validate checks the field values on ConvertAmountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertAmountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertAmountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertAmountResponseMultiError, or nil if none found.
+func (m *ConvertAmountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertAmountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for CalculationRate
+
+	// no validation rules for MathematicOperatorCode
+
+	// no validation rules for ExchangeMarketId
+
+	if all {
+		switch v := interface{}(m.GetRateDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConvertAmountResponseValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConvertAmountResponseValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConvertAmountResponseValidationError{
+				field:  "RateDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CurrencyCode
+
+	if len(errors) > 0 {
+		return ConvertAmountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertAmountResponseMultiError is an error wrapping multiple validation
+// errors returned by ConvertAmountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertAmountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertAmountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertAmountResponseMultiError) AllErrors() []error { return m }
+
+// ConvertAmountResponseValidationError is the validation error returned by
+// ConvertAmountResponse.Validate if the designated constraints aren't met.
+type ConvertAmountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertAmountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertAmountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertAmountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertAmountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertAmountResponseValidationError) ErrorName() string {
+	return "ConvertAmountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertAmountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertAmountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertAmountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertAmountResponseValidationError{}
@@ -285,6 +285,8 @@ func (m *PaymentD) validate(all bool) error {
 
 	// no validation rules for PaymentMeanId
 
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return PaymentDMultiError(errors)
 	}
@@ -559,6 +561,10 @@ func (m *CreatePaymentRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return CreatePaymentRequestMultiError(errors)
 	}
@@ -1105,6 +1111,12 @@ func (m *PaymentTermD) validate(all bool) error {
 
 	// no validation rules for ExchangeRate
 
+	// no validation rules for MasterFlag
+
+	// no validation rules for MasterId
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return PaymentTermDMultiError(errors)
 	}
@@ -1581,6 +1593,14 @@ func (m *CreatePaymentTermRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for MasterFlag
+
+	// no validation rules for MasterId
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return CreatePaymentTermRequestMultiError(errors)
 	}
@@ -2348,6 +2368,8 @@ func (m *CreatePaymentMeanRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePaymentMeanRequestMultiError(errors)
 	}
@@ -2853,6 +2875,8 @@ func (m *PaymentMandateD) validate(all bool) error {
 
 	// no validation rules for Clause
 
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return PaymentMandateDMultiError(errors)
 	}
@@ -3199,6 +3223,10 @@ func (m *CreatePaymentMandateRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return CreatePaymentMandateRequestMultiError(errors)
 	}
@@ -3587,6 +3615,8 @@ func (m *CreatePaymentMandateClauseRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePaymentMandateClauseRequestMultiError(errors)
 	}
@@ -4136,6 +4166,8 @@ func (m *CreatePaymentMandateClauseContentRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreatePaymentMandateClauseContentRequestMultiError(errors)
 	}
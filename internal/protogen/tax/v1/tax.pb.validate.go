@@ -767,6 +767,8 @@ func (m *TaxSchemeD) validate(all bool) error {
 
 	// no validation rules for CurrencyCode
 
+	// no validation rules for RoundingLevel
+
 	if len(errors) > 0 {
 		return TaxSchemeDMultiError(errors)
 	}
@@ -880,6 +882,10 @@ func (m *CreateTaxSchemeRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for RoundingLevel
+
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateTaxSchemeRequestMultiError(errors)
 	}
@@ -1127,6 +1133,8 @@ func (m *UpdateTaxSchemeRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for RoundingLevel
+
 	if len(errors) > 0 {
 		return UpdateTaxSchemeRequestMultiError(errors)
 	}
@@ -1543,6 +1551,12 @@ func (m *TaxCategoryD) validate(all bool) error {
 
 	// no validation rules for TaxSchemeId
 
+	// no validation rules for CalculationSequenceNumeric
+
+	// no validation rules for WithholdingIndicator
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return TaxCategoryDMultiError(errors)
 	}
@@ -1668,6 +1682,14 @@ func (m *CreateTaxCategoryRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for CalculationSequenceNumeric
+
+	// no validation rules for WithholdingIndicator
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return CreateTaxCategoryRequestMultiError(errors)
 	}
@@ -1921,6 +1943,8 @@ func (m *UpdateTaxCategoryRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for CurrencyCode
+
 	if len(errors) > 0 {
 		return UpdateTaxCategoryRequestMultiError(errors)
 	}
@@ -2788,6 +2812,8 @@ func (m *CreateTaxSchemeJurisdictionRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateTaxSchemeJurisdictionRequestMultiError(errors)
 	}
@@ -3454,6 +3480,10 @@ func (m *TaxTotalD) validate(all bool) error {
 
 	// no validation rules for MasterId
 
+	// no validation rules for CurrencyCode
+
+	// no validation rules for WithholdingIndicator
+
 	if len(errors) > 0 {
 		return TaxTotalDMultiError(errors)
 	}
@@ -3573,6 +3603,12 @@ func (m *CreateTaxTotalRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for CurrencyCode
+
+	// no validation rules for WithholdingIndicator
+
+	// no validation rules for IdempotencyKey
+
 	if len(errors) > 0 {
 		return CreateTaxTotalRequestMultiError(errors)
 	}
@@ -4498,6 +4534,10 @@ func (m *TaxSubTotalD) validate(all bool) error {
 
 	// no validation rules for TaxTotalId
 
+	// no validation rules for CurrencyCode
+
+	// no validation rules for TransactionCurrencyCode
+
 	if len(errors) > 0 {
 		return TaxSubTotalDMultiError(errors)
 	}
@@ -4625,6 +4665,12 @@ func (m *CreateTaxSubTotalRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for CurrencyCode
+
+	// no validation rules for TransactionCurrencyCode
+
 	if len(errors) > 0 {
 		return CreateTaxSubTotalRequestMultiError(errors)
 	}
@@ -4880,6 +4926,10 @@ func (m *UpdateTaxSubTotalRequest) validate(all bool) error {
 
 	// no validation rules for RequestId
 
+	// no validation rules for CurrencyCode
+
+	// no validation rules for TransactionCurrencyCode
+
 	if len(errors) > 0 {
 		return UpdateTaxSubTotalRequestMultiError(errors)
 	}
@@ -0,0 +1,193 @@
+// Package rounding holds the rounding policies of amounts: the decimal
+// places of each ISO 4217 currency, how a half is rounded, and the smallest
+// amount due in cash, such as 0.05 for the Swiss 5 rappen.
+//
+// A Policy rounds every amount of a document in its currency. The amount
+// due is rounded to the CashIncrement of the policy when it has one, the
+// difference is the PayableRoundingAmount of the document.
+//
+// Whether the tax of a tax category is rounded per line or once on the
+// document total is the Level of its tax scheme.
+package rounding
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+)
+
+// Mode - how an amount half way between two rounded amounts is rounded
+type Mode string
+
+const (
+	// HalfUp - a half is rounded towards positive infinity, -2.5 to -2
+	HalfUp Mode = "half_up"
+	// HalfEven - a half is rounded to the even neighbour, 2.5 to 2
+	HalfEven Mode = "half_even"
+	// Commercial - a half is rounded away from zero, -2.5 to -3
+	Commercial Mode = "commercial"
+)
+
+// Level - where the tax of a tax category is rounded
+type Level string
+
+const (
+	// Document - the tax of the taxable amount of all lines, rounded once
+	Document Level = "document"
+	// Line - the sum of the tax of each line, rounded per line
+	Line Level = "line"
+)
+
+// Policy - the rounding of the amounts of a currency
+type Policy struct {
+	Places        int
+	Mode          Mode
+	CashIncrement money.Amount // smallest amount due, zero when it is 10^-Places
+}
+
+// Default - the Policy of a currency with two decimal places, which is
+// most of them
+var Default = Policy{Places: 2, Mode: Commercial}
+
+// currencyPlaces - the ISO 4217 minor units of the currencies without two
+var currencyPlaces = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// policies - the policies of currencies that differ from their minor
+// units, set by Register
+var (
+	policies = map[string]Policy{
+		"CHF": {Places: 2, Mode: Commercial, CashIncrement: money.New(5, 2)},
+	}
+	mu sync.RWMutex
+)
+
+// ForCurrency - the Policy of an ISO 4217 currency code, Default for an
+// empty or unknown one
+func ForCurrency(currencyCode string) Policy {
+	code := strings.ToUpper(strings.TrimSpace(currencyCode))
+	mu.RLock()
+	p, ok := policies[code]
+	mu.RUnlock()
+	if ok {
+		return p
+	}
+	if places, ok := currencyPlaces[code]; ok {
+		return Policy{Places: places, Mode: Commercial}
+	}
+	return Default
+}
+
+// Register - set the Policy of a currency
+func Register(currencyCode string, p Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+	policies[strings.ToUpper(strings.TrimSpace(currencyCode))] = p
+}
+
+// Configure - Register the policies of a list separated by ";", each
+// "currency:places[:mode[:cash increment]]" such as
+// "CHF:2:commercial:0.05;JPY:0". An empty list changes nothing.
+func Configure(list string) error {
+	for _, s := range strings.Split(list, ";") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		fields := strings.Split(strings.TrimSpace(s), ":")
+		invalid := fmt.Errorf("rounding: invalid policy %q", s)
+		if len(fields) < 2 || len(fields) > 4 || strings.TrimSpace(fields[0]) == "" {
+			return invalid
+		}
+		places, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil || places < 0 || places > money.Scale {
+			return invalid
+		}
+		p := Policy{Places: places, Mode: Commercial}
+		if len(fields) > 2 {
+			if p.Mode, err = ParseMode(fields[2]); err != nil {
+				return err
+			}
+		}
+		if len(fields) > 3 {
+			if p.CashIncrement, err = money.Parse(fields[3]); err != nil || p.CashIncrement < 0 {
+				return invalid
+			}
+		}
+		Register(fields[0], p)
+	}
+	return nil
+}
+
+// ParseMode - the Mode of a name, Commercial when it is empty
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return Commercial, nil
+	case HalfUp, HalfEven, Commercial:
+		return m, nil
+	}
+	return "", fmt.Errorf("rounding: unknown rounding mode %q", s)
+}
+
+// ParseLevel - the Level of a name, Document when it is empty
+func ParseLevel(s string) (Level, error) {
+	switch l := Level(strings.ToLower(strings.TrimSpace(s))); l {
+	case "":
+		return Document, nil
+	case Document, Line:
+		return l, nil
+	}
+	return "", fmt.Errorf("rounding: unknown rounding level %q", s)
+}
+
+// Round - a rounded to the decimal places of p
+func (p Policy) Round(a money.Amount) money.Amount {
+	if p.Places >= money.Scale || p.Places < 0 {
+		return a
+	}
+	return roundTo(a, money.Amount(math.Pow10(money.Scale-p.Places)), p.Mode)
+}
+
+// RoundPayable - the amount due of a, rounded to the cash increment of p
+// or, without one, to its decimal places
+func (p Policy) RoundPayable(a money.Amount) money.Amount {
+	if p.CashIncrement <= money.Zero {
+		return p.Round(a)
+	}
+	return p.Round(roundTo(a, p.CashIncrement, p.Mode))
+}
+
+// roundTo - a rounded to a multiple of step with mode
+func roundTo(a money.Amount, step money.Amount, mode Mode) money.Amount {
+	q, r := a/step, a%step
+	away := false
+	switch twice := 2 * r.Abs(); {
+	case twice > step:
+		away = true
+	case twice == step:
+		switch mode {
+		case HalfUp:
+			away = a > 0
+		case HalfEven:
+			away = q%2 != 0
+		default:
+			away = true
+		}
+	}
+	if away {
+		if a < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q * step
+}
@@ -0,0 +1,62 @@
+package rounding
+
+import (
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_Round(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		amount string
+		want   string
+	}{
+		{policy: Default, amount: "2.345", want: "2.35"},
+		{policy: Default, amount: "-2.345", want: "-2.35"},
+		{policy: Policy{Places: 2, Mode: HalfUp}, amount: "2.345", want: "2.35"},
+		{policy: Policy{Places: 2, Mode: HalfUp}, amount: "-2.345", want: "-2.34"},
+		{policy: Policy{Places: 2, Mode: HalfEven}, amount: "2.345", want: "2.34"},
+		{policy: Policy{Places: 2, Mode: HalfEven}, amount: "2.355", want: "2.36"},
+		{policy: Policy{Places: 2, Mode: HalfEven}, amount: "2.3451", want: "2.35"},
+		{policy: ForCurrency("JPY"), amount: "1234.5", want: "1235.00"},
+		{policy: ForCurrency("jpy"), amount: "1234.49", want: "1234.00"},
+		{policy: ForCurrency("KWD"), amount: "1.2345", want: "1.235"},
+		{policy: ForCurrency(""), amount: "1.005", want: "1.01"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.policy.Round(money.MustParse(tt.amount)).String(), tt.amount)
+	}
+}
+
+func TestPolicy_RoundPayable(t *testing.T) {
+	chf := ForCurrency("CHF")
+	assert.Equal(t, "10.25", chf.Round(money.MustParse("10.2549")).String())
+	assert.Equal(t, "10.25", chf.RoundPayable(money.MustParse("10.27")).String())
+	assert.Equal(t, "10.30", chf.RoundPayable(money.MustParse("10.275")).String())
+	assert.Equal(t, "-10.30", chf.RoundPayable(money.MustParse("-10.28")).String())
+	assert.Equal(t, "10.28", ForCurrency("EUR").RoundPayable(money.MustParse("10.275")).String())
+}
+
+func TestConfigure(t *testing.T) {
+	assert.NoError(t, Configure("SEK:2:half_even:1; XTS:0"))
+	assert.Equal(t, Policy{Places: 2, Mode: HalfEven, CashIncrement: money.New(1, 0)}, ForCurrency("SEK"))
+	assert.Equal(t, Policy{Places: 0, Mode: Commercial}, ForCurrency("XTS"))
+	assert.Equal(t, "12.00", ForCurrency("SEK").RoundPayable(money.MustParse("12.49")).String())
+
+	for _, list := range []string{"SEK", "SEK:two", "SEK:2:up", "SEK:2:half_up:-1", ":2", "SEK:9"} {
+		assert.Error(t, Configure(list), list)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("")
+	assert.NoError(t, err)
+	assert.Equal(t, Document, level)
+	level, err = ParseLevel("Line")
+	assert.NoError(t, err)
+	assert.Equal(t, Line, level)
+	_, err = ParseLevel("invoice")
+	assert.Error(t, err)
+}
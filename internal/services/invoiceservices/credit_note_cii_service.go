@@ -0,0 +1,67 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/cii"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"go.uber.org/zap"
+)
+
+// GetCreditNoteCII - Get CreditNote as UN/CEFACT Cross Industry Invoice D16B
+// XML with a credit note type code, built from the same data as
+// GetCreditNoteUBL
+func (cs *CreditNoteHeaderService) GetCreditNoteCII(ctx context.Context, inReq *invoiceproto.GetCreditNoteCIIRequest) (*invoiceproto.GetCreditNoteCIIResponse, error) {
+	in := inReq.GetRequest
+	src, err := cs.getCreditNoteSource(ctx, &invoiceproto.GetCreditNoteUBLRequest{GetRequest: in})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	crossIndustryInvoice, err := cii.CreditNoteFromSource(src)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ciiBytes, err := cii.Marshal(crossIndustryInvoice)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	creditNoteCIIResponse := invoiceproto.GetCreditNoteCIIResponse{}
+	creditNoteCIIResponse.Cii = ciiBytes
+	return &creditNoteCIIResponse, nil
+}
+
+// ImportCreditNoteCII - Create CreditNote from a UN/CEFACT Cross Industry
+// Invoice D16B XML document with a credit note type code. CII values that
+// cannot be mapped are reported as ubl.FieldErrors located by their CII
+// XPath, parties and items that cannot be resolved by the XPath of the
+// equivalent UBL element.
+func (cs *CreditNoteHeaderService) ImportCreditNoteCII(ctx context.Context, in *invoiceproto.ImportCreditNoteCIIRequest) (*invoiceproto.ImportCreditNoteCIIResponse, error) {
+	crossIndustryInvoice := cii.CrossIndustryInvoice{}
+	err := cii.Unmarshal(in.Cii, &crossIndustryInvoice)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	creditNote, err := cii.ToCreditNote(&crossIndustryInvoice)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	creditNoteHeader, err := cs.importCreditNote(ctx, creditNote, in.UserId, in.UserEmail, in.RequestId)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importCreditNoteCIIResponse := invoiceproto.ImportCreditNoteCIIResponse{}
+	importCreditNoteCIIResponse.CreditNoteHeader = creditNoteHeader
+	return &importCreditNoteCIIResponse, nil
+}
@@ -2,14 +2,20 @@ package invoiceservices
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -19,6 +25,7 @@ const insertCreditNoteLineSQL = `insert into credit_note_lines
 cnl_id,
 note,
 credited_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -32,6 +39,7 @@ billing_id,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -53,6 +61,7 @@ updated_at)
 :cnl_id,
 :note,
 :credited_quantity,
+:unit_code,
 :line_extension_amount,
 :accounting_cost_code,
 :accounting_cost,
@@ -66,6 +75,7 @@ updated_at)
 :originator_party_id,
 :item_id,
 :price_amount,
+:currency_code,
 :price_base_quantity,
 :price_change_reason,
 :price_type_code,
@@ -90,6 +100,7 @@ uuid4,
 cnl_id,
 note,
 credited_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -103,6 +114,7 @@ billing_id,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -121,13 +133,32 @@ updated_by_user_id,
 created_at,
 updated_at from credit_note_lines`
 
-// CreateCreditNoteLine - Create CreditNoteLine
+// CreateCreditNoteLine - Create CreditNoteLine of a draft credit note
 func (cs *CreditNoteHeaderService) CreateCreditNoteLine(ctx context.Context, in *invoiceproto.CreateCreditNoteLineRequest) (*invoiceproto.CreateCreditNoteLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	currencyCode := ""
+	if in.CreditNoteHeaderId != 0 {
+		getByIDRequest := commonproto.GetByIdRequest{Id: in.CreditNoteHeaderId, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		creditNoteHeaderResponse, err := cs.GetCreditNoteHeaderByPk(ctx, &invoiceproto.GetCreditNoteHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		if err = docstatus.CheckEditable(creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.DocumentStatusCode); err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		currencyCode = creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.DocumentCurrencyCode
+	}
 	creditNoteLine, err := cs.ProcessCreditNoteLineRequest(ctx, in)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
+	creditNoteLine.CreditNoteLineD.CurrencyCode = currencyCode
 
 	err = cs.insertCreditNoteLine(ctx, insertCreditNoteLineSQL, creditNoteLine, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
@@ -200,6 +231,10 @@ func (cs *CreditNoteHeaderService) ProcessCreditNoteLineRequest(ctx context.Cont
 	creditNoteLineD.CnlId = in.CnlId
 	creditNoteLineD.Note = in.Note
 	creditNoteLineD.CreditedQuantity = in.CreditedQuantity
+	creditNoteLineD.UnitCode = in.UnitCode
+	if creditNoteLineD.UnitCode == "" {
+		creditNoteLineD.UnitCode = ubl.UnitCodeOne
+	}
 	creditNoteLineD.LineExtensionAmount = in.LineExtensionAmount
 	creditNoteLineD.AccountingCostCode = in.AccountingCostCode
 	creditNoteLineD.AccountingCost = in.AccountingCost
@@ -239,7 +274,7 @@ func (cs *CreditNoteHeaderService) ProcessCreditNoteLineRequest(ctx context.Cont
 
 	creditNoteLine := invoiceproto.CreditNoteLine{CreditNoteLineD: &creditNoteLineD, CreditNoteLineT: &creditNoteLineT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
-	err = cs.insertCreditNoteLine(ctx, insertCreditNoteLineSQL, &creditNoteLine, in.GetUserEmail(), in.GetRequestId())
+	creditNoteLine.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, cs.log, ubl.MasterFlagCreditNoteLine, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -256,6 +291,13 @@ func (cs *CreditNoteHeaderService) insertCreditNoteLine(ctx context.Context, ins
 		return err
 	}
 	err = cs.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		if creditNoteLine.CreditNoteLineD.CreditNoteHeaderId != 0 {
+			err := lockDraftCreditNote(ctx, cs.log, tx, creditNoteLine.CreditNoteLineD.CreditNoteHeaderId, userEmail, requestID)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
 		res, err := tx.NamedExecContext(ctx, insertCreditNoteLineSQL, creditNoteLineTmp)
 		if err != nil {
 			cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
@@ -274,6 +316,12 @@ func (cs *CreditNoteHeaderService) insertCreditNoteLine(ctx context.Context, ins
 			return err
 		}
 		creditNoteLine.CreditNoteLineD.IdS = uuid4Str
+
+		err = allowancecharge.Insert(ctx, cs.log, tx, creditNoteLine.AllowanceCharges, creditNoteLine.CreditNoteLineD.Id, creditNoteLine.CreditNoteLineD.CurrencyCode, userEmail, requestID)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
 		return nil
 	})
 
@@ -321,7 +369,7 @@ func (cs *CreditNoteHeaderService) GetCreditNoteLines(ctx context.Context, inReq
 	creditNoteLines := []*invoiceproto.CreditNoteLine{}
 
 	nselectCreditNoteLinesSQL := selectCreditNoteLinesSQL + ` where credit_note_header_id = ? and status_code = ?;`
-	rows, err := cs.DBService.DB.QueryxContext(ctx, nselectCreditNoteLinesSQL, creditNoteHeader.CreditNoteHeaderD.Id, "active")
+	rows, err := cs.DBService.Queryer(ctx).QueryxContext(ctx, nselectCreditNoteLinesSQL, creditNoteHeader.CreditNoteHeaderD.Id, "active")
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -359,6 +407,11 @@ func (cs *CreditNoteHeaderService) getCreditNoteLineStruct(ctx context.Context,
 	creditNoteLineT.PriceValidityPeriodStartDate = common.TimeToTimestamp(creditNoteLineTmp.CreditNoteLineT.PriceValidityPeriodStartDate)
 	creditNoteLineT.PriceValidityPeriodEndDate = common.TimeToTimestamp(creditNoteLineTmp.CreditNoteLineT.PriceValidityPeriodEndDate)
 
+	if err := money.Normalize(creditNoteLineTmp.CreditNoteLineD); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(creditNoteLineTmp.CreditNoteLineD.Uuid4)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -373,5 +426,274 @@ func (cs *CreditNoteHeaderService) getCreditNoteLineStruct(ctx context.Context,
 
 	creditNoteLine := invoiceproto.CreditNoteLine{CreditNoteLineD: creditNoteLineTmp.CreditNoteLineD, CreditNoteLineT: creditNoteLineT, CrUpdUser: creditNoteLineTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	creditNoteLine.AllowanceCharges, err = allowancecharge.Get(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteLine, creditNoteLine.CreditNoteLineD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &creditNoteLine, nil
 }
+
+// selectCreditNoteLineHeaderIDSQL - the credit note of a CreditNoteLine
+const selectCreditNoteLineHeaderIDSQL = `select credit_note_header_id from credit_note_lines where uuid4 = ? and status_code = ?;`
+
+// updateCreditNoteLineSQL - update CreditNoteLineSQL query
+const updateCreditNoteLineSQL = `update credit_note_lines set 
+note = ?, 
+credited_quantity = ?, 
+line_extension_amount = ?, 
+accounting_cost = ?, 
+price_amount = ?, 
+price_base_quantity = ?, 
+updated_at = ? where id = ?;`
+
+// deleteCreditNoteLineSQL - delete CreditNoteLineSQL query
+const deleteCreditNoteLineSQL = `update credit_note_lines set 
+status_code = ?, 
+updated_at = ? where id = ?;`
+
+// updateCreditNoteTotalsSQL - update the line count and monetary totals of
+// a credit note
+const updateCreditNoteTotalsSQL = `update credit_note_headers set 
+line_count_numeric = ?, 
+line_extension_amount = ?, 
+allowance_total_amount = ?, 
+charge_total_amount = ?, 
+tax_exclusive_amount = ?, 
+tax_inclusive_amount = ?, 
+withholding_tax_total_amount = ?, 
+payable_rounding_amount = ?,
+payable_amount = ?, updated_at = ? where id = ?;`
+
+// UpdateCreditNoteLine - Update CreditNoteLine of a draft credit note. The
+// line keeps the line extension amount it is given, the amounts of its
+// allowances and charges, the TaxTotals and the line count and monetary
+// totals of the credit note are calculated again and kept in the same
+// transaction, which locks the credit note before it reads it.
+func (cs *CreditNoteHeaderService) UpdateCreditNoteLine(ctx context.Context, in *invoiceproto.UpdateCreditNoteLineRequest) (*invoiceproto.UpdateCreditNoteLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err := cs.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		creditNoteHeader, creditNoteLines, i, err := cs.getDraftCreditNoteOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		creditNoteLine := creditNoteLines[i]
+		ld := creditNoteLine.CreditNoteLineD
+		ld.Note = in.Note
+		ld.CreditedQuantity = in.CreditedQuantity
+		ld.LineExtensionAmount = in.LineExtensionAmount
+		ld.PriceAmount = in.PriceAmount
+		ld.PriceBaseQuantity = in.PriceBaseQuantity
+		ld.AccountingCost = in.AccountingCost
+
+		taxTotals, err := cs.calculateCreditNoteLineTotals(ctx, creditNoteHeader, creditNoteLines, in.UserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, updateCreditNoteLineSQL,
+			ld.Note,
+			ld.CreditedQuantity,
+			ld.LineExtensionAmount,
+			ld.AccountingCost,
+			ld.PriceAmount,
+			ld.PriceBaseQuantity,
+			tn,
+			ld.Id)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		err = allowancecharge.UpdateAmounts(ctx, cs.log, tx, creditNoteLine.AllowanceCharges, tn, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateCreditNoteTotals(ctx, cs.log, tx, creditNoteHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateCreditNoteLineResponse{}, nil
+}
+
+// DeleteCreditNoteLine - Delete CreditNoteLine of a draft credit note, the
+// TaxTotals and the line count and monetary totals of the credit note are
+// calculated again without it and kept in the same transaction
+func (cs *CreditNoteHeaderService) DeleteCreditNoteLine(ctx context.Context, inReq *invoiceproto.DeleteCreditNoteLineRequest) (*invoiceproto.DeleteCreditNoteLineResponse, error) {
+	in := inReq.GetRequest
+	err := cs.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		creditNoteHeader, creditNoteLines, i, err := cs.getDraftCreditNoteOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		creditNoteLineID := creditNoteLines[i].CreditNoteLineD.Id
+		creditNoteLines = append(creditNoteLines[:i], creditNoteLines[i+1:]...)
+		taxTotals, err := cs.calculateCreditNoteLineTotals(ctx, creditNoteHeader, creditNoteLines, creditNoteHeader.CrUpdUser.UpdatedByUserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, deleteCreditNoteLineSQL, "inactive", tn, creditNoteLineID)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateCreditNoteTotals(ctx, cs.log, tx, creditNoteHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.DeleteCreditNoteLineResponse{}, nil
+}
+
+// getDraftCreditNoteOfLine - the credit note of the CreditNoteLine
+// creditNoteLineID, locked with lockDraftCreditNote within tx, the transaction of
+// ctx, so that it is still a draft, with its lines and the index of the
+// line among them, read after the lock
+func (cs *CreditNoteHeaderService) getDraftCreditNoteOfLine(ctx context.Context, tx *sqlx.Tx, creditNoteLineID string, userEmail string, requestID string) (*invoiceproto.CreditNoteHeader, []*invoiceproto.CreditNoteLine, int, error) {
+	uuid4byte, err := common.UUIDStrToBytes(creditNoteLineID)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	var creditNoteHeaderID uint32
+	err = tx.QueryRowxContext(ctx, selectCreditNoteLineHeaderIDSQL, uuid4byte, "active").Scan(&creditNoteHeaderID)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	err = lockDraftCreditNote(ctx, cs.log, tx, creditNoteHeaderID, userEmail, requestID)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+
+	getByIDRequest := commonproto.GetByIdRequest{Id: creditNoteHeaderID, UserEmail: userEmail, RequestId: requestID}
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeaderByPk(ctx, &invoiceproto.GetCreditNoteHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	creditNoteHeader := creditNoteHeaderResponse.CreditNoteHeader
+
+	getRequest := commonproto.GetRequest{Id: creditNoteHeader.CreditNoteHeaderD.IdS, UserEmail: userEmail, RequestId: requestID}
+	creditNoteLinesResponse, err := cs.GetCreditNoteLines(ctx, &invoiceproto.GetCreditNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	for i, creditNoteLine := range creditNoteLinesResponse.CreditNoteLines {
+		if creditNoteLine.CreditNoteLineD.IdS == creditNoteLineID {
+			return creditNoteHeader, creditNoteLinesResponse.CreditNoteLines, i, nil
+		}
+	}
+	return nil, nil, 0, sql.ErrNoRows
+}
+
+// calculateCreditNoteLineTotals - generate the TaxTotals of the credit note
+// again from creditNoteLines, its lines after a line is changed, and set the
+// amounts of the allowances and charges of the lines and of the credit note
+// and its line count and monetary totals to the ones calculated with them
+func (cs *CreditNoteHeaderService) calculateCreditNoteLineTotals(ctx context.Context, creditNoteHeader *invoiceproto.CreditNoteHeader, creditNoteLines []*invoiceproto.CreditNoteLine, userID string, userEmail string, requestID string) (*documentTaxTotals, error) {
+	hd := creditNoteHeader.CreditNoteHeaderD
+	policy := rounding.ForCurrency(hd.DocumentCurrencyCode)
+	lineExtensionAmounts := []money.Amount{}
+	taxLines := []TaxLine{}
+	for _, creditNoteLine := range creditNoteLines {
+		ld := creditNoteLine.CreditNoteLineD
+		allowancecharge.CalculateProtos(creditNoteLine.AllowanceCharges, allowancecharge.LineBase(ld.CreditedQuantity, parseAmount(ld.PriceAmount), ld.PriceBaseQuantity, policy), policy)
+		lineExtensionAmounts = append(lineExtensionAmounts, parseAmount(ld.LineExtensionAmount))
+		taxLines = append(taxLines, TaxLine{ItemID: ld.ItemId, Quantity: ld.CreditedQuantity, LineExtensionAmount: parseAmount(ld.LineExtensionAmount)})
+	}
+	totals := noteTotals(hd.DocumentCurrencyCode, lineExtensionAmounts, creditNoteHeader.AllowanceCharges, hd.AllowanceTotalAmount, hd.ChargeTotalAmount, hd.WithholdingTaxTotalAmount, hd.PrepaidAmount, money.Zero)
+	allowancecharge.SetProtos(creditNoteHeader.AllowanceCharges, totals.AllowanceCharges)
+	taxLines = append(taxLines, allowanceChargeTaxLines(totals.AllowanceCharges)...)
+
+	taxTotals, err := generateDocumentTaxTotals(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, hd, hd.DocumentCurrencyCode, hd.TaxCurrencyCode, taxLines, userID, userEmail, requestID)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	hd.WithholdingTaxTotalAmount = withholdingTaxTotalAmount(taxTotals.WithholdingTaxTotal, policy)
+	totals.TaxAmount = taxTotals.taxAmount()
+	totals.WithholdingTaxAmount = parseAmount(hd.WithholdingTaxTotalAmount)
+	totals.CalculateDocument()
+
+	hd.LineCountNumeric = uint32(len(creditNoteLines))
+	hd.LineExtensionAmount = totals.LineExtensionAmount.String()
+	hd.AllowanceTotalAmount = totals.AllowanceTotalAmount.String()
+	hd.ChargeTotalAmount = totals.ChargeTotalAmount.String()
+	hd.TaxExclusiveAmount = totals.TaxExclusiveAmount.String()
+	hd.TaxInclusiveAmount = totals.TaxInclusiveAmount.String()
+	hd.PayableRoundingAmount = totals.PayableRoundingAmount.String()
+	hd.PayableAmount = totals.PayableAmount.String()
+	return taxTotals, nil
+}
+
+// selectCreditNoteStatusForUpdateSQL - lock a credit note until the end of the
+// transaction and get its document status
+const selectCreditNoteStatusForUpdateSQL = `select document_status_code from credit_note_headers where id = ? for update;`
+
+// lockDraftCreditNote - lock the credit note creditNoteHeaderID until the
+// end of the transaction of a change to its lines and check that it is
+// still a draft, as it may have been issued since it was read
+func lockDraftCreditNote(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, creditNoteHeaderID uint32, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectCreditNoteStatusForUpdateSQL, creditNoteHeaderID).Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = docstatus.CheckEditable(documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// updateCreditNoteTotals - keep the line count and monetary totals of a
+// credit note, the amounts of its allowances and charges and taxTotals, its
+// TaxTotals, within the transaction of the change to its lines
+func updateCreditNoteTotals(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, creditNoteHeader *invoiceproto.CreditNoteHeader, taxTotals *documentTaxTotals, tn time.Time, userEmail string, requestID string) error {
+	hd := creditNoteHeader.CreditNoteHeaderD
+	_, err := tx.ExecContext(ctx, updateCreditNoteTotalsSQL,
+		hd.LineCountNumeric,
+		hd.LineExtensionAmount,
+		hd.AllowanceTotalAmount,
+		hd.ChargeTotalAmount,
+		hd.TaxExclusiveAmount,
+		hd.TaxInclusiveAmount,
+		hd.WithholdingTaxTotalAmount,
+		hd.PayableRoundingAmount,
+		hd.PayableAmount,
+		tn,
+		hd.Id)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = allowancecharge.UpdateAmounts(ctx, log, tx, creditNoteHeader.AllowanceCharges, tn, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return replaceTaxTotals(ctx, log, tx, ubl.MasterFlagCreditNoteHeader, hd.Id, hd.DocumentCurrencyCode, taxTotals, tn, userEmail, requestID)
+}
@@ -4,13 +4,21 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -46,6 +54,7 @@ payment_currency_code,
 payment_alt_currency_code,
 accounting_cost_code,
 accounting_cost,
+buyer_reference,
 line_count_numeric,
 discrepancy_response,
 order_id,
@@ -99,6 +108,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 due_date,
 tax_point_date,
@@ -108,6 +118,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -124,6 +135,7 @@ updated_at)
 :payment_alt_currency_code,
 :accounting_cost_code,
 :accounting_cost,
+:buyer_reference,
 :line_count_numeric,
 :discrepancy_response,
 :order_id,
@@ -177,6 +189,7 @@ updated_at)
 :payable_rounding_amount,
 :payable_amount,
 :payable_alternative_amount,
+:payable_alternative_currency_code,
 :issue_date,
 :due_date,
 :tax_point_date,
@@ -186,6 +199,7 @@ updated_at)
 :pricing_ex_date,
 :payment_ex_date,
 :payment_alt_ex_date,
+:document_status_code,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -205,6 +219,7 @@ payment_currency_code,
 payment_alt_currency_code,
 accounting_cost_code,
 accounting_cost,
+buyer_reference,
 line_count_numeric,
 discrepancy_response,
 order_id,
@@ -258,6 +273,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 due_date,
 tax_point_date,
@@ -267,6 +283,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -282,8 +299,15 @@ prepaid_amount = ?,
 payable_rounding_amount = ?,
 payable_amount = ?, updated_at = ? where uuid4 = ?;`
 
-// CreateCreditNoteHeader - Create CreditNoteHeader
+// CreateCreditNoteHeader - Create CreditNoteHeader, with a TaxTotal and
+// TaxSubTotals generated from the tax categories of the items of its lines,
+// and with a tax currency a second TaxTotal with the total tax amount
+// converted with the tax exchange rate
 func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, in *invoiceproto.CreateCreditNoteHeaderRequest) (*invoiceproto.CreateCreditNoteHeaderResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, cs.UserServiceClient)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -364,6 +388,7 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 	creditNoteHeaderD.PaymentAltCurrencyCode = in.PaymentAltCurrencyCode
 	creditNoteHeaderD.AccountingCostCode = in.AccountingCostCode
 	creditNoteHeaderD.AccountingCost = in.AccountingCost
+	creditNoteHeaderD.BuyerReference = in.BuyerReference
 	creditNoteHeaderD.LineCountNumeric = in.LineCountNumeric
 	creditNoteHeaderD.DiscrepancyResponse = in.DiscrepancyResponse
 	creditNoteHeaderD.OrderId = in.OrderId
@@ -401,7 +426,7 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 	creditNoteHeaderD.PaymentExCalculationRate = in.PaymentExCalculationRate
 	creditNoteHeaderD.PaymentExMathematicOperatorCode = in.PaymentExMathematicOperatorCode
 	creditNoteHeaderD.PaymentAltExSourceCurrencyCode = in.PaymentAltExSourceCurrencyCode
-	creditNoteHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyCode
+	creditNoteHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyBaseRate
 	creditNoteHeaderD.PaymentAltExTargetCurrencyCode = in.PaymentAltExTargetCurrencyCode
 	creditNoteHeaderD.PaymentAltExTargetCurrencyBaseRate = in.PaymentAltExTargetCurrencyBaseRate
 	creditNoteHeaderD.PaymentAltExExchangeMarketId = in.PaymentAltExExchangeMarketId
@@ -417,6 +442,11 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 	creditNoteHeaderD.PayableRoundingAmount = in.PayableRoundingAmount
 	creditNoteHeaderD.PayableAmount = in.PayableAmount
 	creditNoteHeaderD.PayableAlternativeAmount = in.PayableAlternativeAmount
+	creditNoteHeaderD.PayableAlternativeCurrencyCode = in.PayableAlternativeCurrencyCode
+	if creditNoteHeaderD.PayableAlternativeCurrencyCode == "" {
+		creditNoteHeaderD.PayableAlternativeCurrencyCode = creditNoteHeaderD.PaymentAltCurrencyCode
+	}
+	creditNoteHeaderD.DocumentStatusCode = string(docstatus.Draft)
 
 	creditNoteHeaderT := invoiceproto.CreditNoteHeaderT{}
 	creditNoteHeaderT.IssueDate = common.TimeToTimestamp(issueDate.UTC().Truncate(time.Second))
@@ -440,6 +470,12 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 
 	creditNoteHeader := invoiceproto.CreditNoteHeader{CreditNoteHeaderD: &creditNoteHeaderD, CreditNoteHeaderT: &creditNoteHeaderT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	creditNoteHeader.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, cs.log, ubl.MasterFlagCreditNoteHeader, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	creditNoteLines := []*invoiceproto.CreditNoteLine{}
 	// we will do for loop on lines which is comes from client form
 	for _, line := range in.CreditNoteLines {
@@ -451,11 +487,46 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 			return nil, err
 		}
+		creditNoteLine.CreditNoteLineD.CurrencyCode = creditNoteHeaderD.DocumentCurrencyCode
 
 		creditNoteLines = append(creditNoteLines, creditNoteLine)
 	}
 
-	err = cs.insertCreditNoteHeader(ctx, insertCreditNoteHeaderSQL, &creditNoteHeader, insertCreditNoteLineSQL, creditNoteLines, in.GetUserEmail(), in.GetRequestId())
+	policy := rounding.ForCurrency(creditNoteHeaderD.DocumentCurrencyCode)
+	taxLines := []TaxLine{}
+	lineExtensionAmount := money.Zero
+	for _, creditNoteLine := range creditNoteLines {
+		ld := creditNoteLine.CreditNoteLineD
+		allowancecharge.CalculateProtos(creditNoteLine.AllowanceCharges, allowancecharge.LineBase(ld.CreditedQuantity, parseAmount(ld.PriceAmount), ld.PriceBaseQuantity, policy), policy)
+		taxLines = append(taxLines, TaxLine{ItemID: ld.ItemId, Quantity: ld.CreditedQuantity, LineExtensionAmount: parseAmount(ld.LineExtensionAmount)})
+		lineExtensionAmount = lineExtensionAmount.Add(parseAmount(ld.LineExtensionAmount))
+	}
+	if len(creditNoteHeader.AllowanceCharges) > 0 {
+		acs := allowancecharge.FromProtos(creditNoteHeader.AllowanceCharges)
+		allowanceTotal, chargeTotal := allowancecharge.Totals(acs, lineExtensionAmount, policy)
+		allowancecharge.SetProtos(creditNoteHeader.AllowanceCharges, acs)
+		creditNoteHeaderD.AllowanceTotalAmount = allowanceTotal.String()
+		creditNoteHeaderD.ChargeTotalAmount = chargeTotal.String()
+		taxLines = append(taxLines, allowanceChargeTaxLines(acs)...)
+	}
+	taxTotal, taxSubTotals, err := generateTaxTotal(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, taxLines, false, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	withholdingTaxTotal, withholdingTaxSubTotals, err := processWithholdingTaxTotal(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, nil, taxLines, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	creditNoteHeaderD.WithholdingTaxTotalAmount = withholdingTaxTotalAmount(withholdingTaxTotal, policy)
+	taxCurrencyTaxTotal, err := processTaxCurrencyTaxTotal(ctx, cs.log, &creditNoteHeaderD, creditNoteHeaderD.DocumentCurrencyCode, creditNoteHeaderD.TaxCurrencyCode, "", taxTotal, taxSubTotals, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = cs.insertCreditNoteHeader(ctx, insertCreditNoteHeaderSQL, &creditNoteHeader, insertCreditNoteLineSQL, creditNoteLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, withholdingTaxTotal, withholdingTaxSubTotals, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -466,15 +537,22 @@ func (cs *CreditNoteHeaderService) CreateCreditNoteHeader(ctx context.Context, i
 	return &creditNoteHeaderResponse, nil
 }
 
-func (cs *CreditNoteHeaderService) insertCreditNoteHeader(ctx context.Context, insertCreditNoteHeaderSQL string, creditNoteHeader *invoiceproto.CreditNoteHeader, insertCreditNoteLineSQL string, creditNoteLines []*invoiceproto.CreditNoteLine, userEmail string, requestID string) error {
+func (cs *CreditNoteHeaderService) insertCreditNoteHeader(ctx context.Context, insertCreditNoteHeaderSQL string, creditNoteHeader *invoiceproto.CreditNoteHeader, insertCreditNoteLineSQL string, creditNoteLines []*invoiceproto.CreditNoteLine, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, taxCurrencyTaxTotal *taxproto.TaxTotal, withholdingTaxTotal *taxproto.TaxTotal, withholdingTaxSubTotals []*taxproto.TaxSubTotal, userEmail string, requestID string) error {
 	creditNoteHeaderTmp, err := cs.crCreditNoteHeaderStruct(ctx, creditNoteHeader, userEmail, requestID)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 		return err
 	}
 	err = cs.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		err := numbering.Check(ctx, tx, numbering.CreditNote, creditNoteHeader.CreditNoteHeaderD.AccountingSupplierPartyId, creditNoteHeader.CreditNoteHeaderD.CnhId)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		res, err := tx.NamedExecContext(ctx, insertCreditNoteHeaderSQL, creditNoteHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.CreditNote, err, creditNoteHeader.CreditNoteHeaderD.CnhId)
 			cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -492,6 +570,12 @@ func (cs *CreditNoteHeaderService) insertCreditNoteHeader(ctx context.Context, i
 		}
 		creditNoteHeader.CreditNoteHeaderD.IdS = uuid4Str
 
+		err = allowancecharge.Insert(ctx, cs.log, tx, creditNoteHeader.AllowanceCharges, creditNoteHeader.CreditNoteHeaderD.Id, creditNoteHeader.CreditNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		for _, creditNoteLine := range creditNoteLines {
 			creditNoteLine.CreditNoteLineD.CreditNoteHeaderId = creditNoteHeader.CreditNoteHeaderD.Id
 			creditNoteLineTmp, err := cs.crCreditNoteLineStruct(ctx, creditNoteLine, userEmail, requestID)
@@ -499,12 +583,54 @@ func (cs *CreditNoteHeaderService) insertCreditNoteHeader(ctx context.Context, i
 				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
-			_, err = tx.NamedExecContext(ctx, insertCreditNoteLineSQL, creditNoteLineTmp)
+			res, err := tx.NamedExecContext(ctx, insertCreditNoteLineSQL, creditNoteLineTmp)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			uID, err := res.LastInsertId()
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+			creditNoteLine.CreditNoteLineD.Id = uint32(uID)
+			creditNoteLine.CreditNoteLineD.IdS, err = common.UUIDBytesToStr(creditNoteLine.CreditNoteLineD.Uuid4)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			err = allowancecharge.Insert(ctx, cs.log, tx, creditNoteLine.AllowanceCharges, creditNoteLine.CreditNoteLineD.Id, creditNoteLine.CreditNoteLineD.CurrencyCode, userEmail, requestID)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+		}
+
+		if len(taxSubTotals) > 0 {
+			err = insertTaxTotal(ctx, cs.log, tx, taxTotal, taxSubTotals, creditNoteHeader.CreditNoteHeaderD.Id, creditNoteHeader.CreditNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if taxCurrencyTaxTotal != nil {
+			err = insertTaxTotal(ctx, cs.log, tx, taxCurrencyTaxTotal, nil, creditNoteHeader.CreditNoteHeaderD.Id, creditNoteHeader.CreditNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
 			if err != nil {
 				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
+		}
 
+		if withholdingTaxTotal != nil {
+			err = insertTaxTotal(ctx, cs.log, tx, withholdingTaxTotal, withholdingTaxSubTotals, creditNoteHeader.CreditNoteHeaderD.Id, creditNoteHeader.CreditNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
 		}
 
 		return nil
@@ -558,7 +684,7 @@ func (cs *CreditNoteHeaderService) GetCreditNoteHeaders(ctx context.Context, in
 
 	nselectCreditNoteHeadersSQL := selectCreditNoteHeadersSQL + ` where ` + query
 
-	rows, err := cs.DBService.DB.QueryxContext(ctx, nselectCreditNoteHeadersSQL, "active")
+	rows, err := cs.DBService.Queryer(ctx).QueryxContext(ctx, nselectCreditNoteHeadersSQL, "active")
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -606,7 +732,7 @@ func (cs *CreditNoteHeaderService) GetCreditNoteHeader(ctx context.Context, inRe
 	}
 
 	nselectCreditNoteHeadersSQL := selectCreditNoteHeadersSQL + ` where uuid4 = ? and status_code = ?;`
-	row := cs.DBService.DB.QueryRowxContext(ctx, nselectCreditNoteHeadersSQL, uuid4byte, "active")
+	row := cs.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectCreditNoteHeadersSQL, uuid4byte, "active")
 	creditNoteHeaderTmp := invoicestruct.CreditNoteHeader{}
 	err = row.StructScan(&creditNoteHeaderTmp)
 	if err != nil {
@@ -630,7 +756,7 @@ func (cs *CreditNoteHeaderService) GetCreditNoteHeaderByPk(ctx context.Context,
 	in := inReq.GetByIdRequest
 
 	nselectCreditNoteHeadersSQL := selectCreditNoteHeadersSQL + ` where id = ? and status_code = ?;`
-	row := cs.DBService.DB.QueryRowxContext(ctx, nselectCreditNoteHeadersSQL, in.Id, "active")
+	row := cs.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectCreditNoteHeadersSQL, in.Id, "active")
 	creditNoteHeaderTmp := invoicestruct.CreditNoteHeader{}
 	err := row.StructScan(&creditNoteHeaderTmp)
 	if err != nil {
@@ -665,6 +791,11 @@ func (cs *CreditNoteHeaderService) getCreditNoteHeaderStruct(ctx context.Context
 	creditNoteHeaderT.PaymentExDate = common.TimeToTimestamp(creditNoteHeaderTmp.CreditNoteHeaderT.PaymentExDate)
 	creditNoteHeaderT.PaymentAltExDate = common.TimeToTimestamp(creditNoteHeaderTmp.CreditNoteHeaderT.PaymentAltExDate)
 
+	if err := money.Normalize(creditNoteHeaderTmp.CreditNoteHeaderD); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(creditNoteHeaderTmp.CreditNoteHeaderD.Uuid4)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -679,34 +810,107 @@ func (cs *CreditNoteHeaderService) getCreditNoteHeaderStruct(ctx context.Context
 
 	creditNoteHeader := invoiceproto.CreditNoteHeader{CreditNoteHeaderD: creditNoteHeaderTmp.CreditNoteHeaderD, CreditNoteHeaderT: creditNoteHeaderT, CrUpdUser: creditNoteHeaderTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	creditNoteHeader.AllowanceCharges, err = allowancecharge.Get(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, creditNoteHeader.CreditNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &creditNoteHeader, nil
 }
 
-// UpdateCreditNoteHeader - Update CreditNoteHeader
+// UpdateCreditNoteHeader - Update CreditNoteHeader, which must still be a draft,
+// an issued credit note is revised with ReviseCreditNote
 func (cs *CreditNoteHeaderService) UpdateCreditNoteHeader(ctx context.Context, in *invoiceproto.UpdateCreditNoteHeaderRequest) (*invoiceproto.UpdateCreditNoteHeaderResponse, error) {
+	err := cs.updateCreditNoteHeader(ctx, in, nil)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateCreditNoteHeaderResponse{}, nil
+}
+
+// updateCreditNoteHeader - update a draft credit note as UpdateCreditNoteHeader does,
+// or, with a revision, an issued one, keeping the revised credit note as its
+// next version. The credit note is locked with lockCreditNoteHeader before
+// it is read, so it is read, checked and updated within one transaction.
+func (cs *CreditNoteHeaderService) updateCreditNoteHeader(ctx context.Context, in *invoiceproto.UpdateCreditNoteHeaderRequest, revision *docversion.Revision) error {
+	if err := money.Normalize(in); err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return err
+	}
 	db := cs.DBService.DB
 	tn := common.GetTimeDetails()
 
 	uuid4byte, err := common.UUIDStrToBytes(in.Id)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	stmt, err := db.PreparexContext(ctx, updateCreditNoteHeaderSQL)
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = cs.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		err := lockCreditNoteHeader(ctx, cs.log, tx, uuid4byte, revision, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: &getRequest})
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		creditNoteHeader := creditNoteHeaderResponse.CreditNoteHeader
+
+		creditNoteLines := []*invoiceproto.CreditNoteLine{}
+		var before []byte
+		if revision != nil {
+			creditNoteLinesResponse, err := cs.GetCreditNoteLines(ctx, &invoiceproto.GetCreditNoteLinesRequest{GetRequest: &getRequest})
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			creditNoteLines = creditNoteLinesResponse.CreditNoteLines
+			before, err = creditNoteSnapshot(creditNoteHeader, creditNoteLines)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		hd := creditNoteHeader.CreditNoteHeaderD
+		hd.Note = in.Note
+		hd.TaxCurrencyCode = in.TaxCurrencyCode
+		hd.ChargeTotalAmount = in.ChargeTotalAmount
+		hd.PrepaidAmount = in.PrepaidAmount
+		hd.PayableRoundingAmount = in.PayableRoundingAmount
+		hd.PayableAmount = in.PayableAmount
+		creditNoteHeader.CrUpdTime.UpdatedAt = common.TimeToTimestamp(tn)
+
+		var after []byte
+		if revision != nil {
+			after, err = creditNoteSnapshot(creditNoteHeader, creditNoteLines)
+			if err != nil {
+				cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
 		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
-			in.Note,
-			in.TaxCurrencyCode,
-			in.ChargeTotalAmount,
-			in.PrepaidAmount,
-			in.PayableRoundingAmount,
-			in.PayableAmount,
+			hd.Note,
+			hd.TaxCurrencyCode,
+			hd.ChargeTotalAmount,
+			hd.PrepaidAmount,
+			hd.PayableRoundingAmount,
+			hd.PayableAmount,
 			tn,
 			uuid4byte)
 		if err != nil {
@@ -718,19 +922,49 @@ func (cs *CreditNoteHeaderService) UpdateCreditNoteHeader(ctx context.Context, i
 			}
 			return err
 		}
+		if revision != nil {
+			return docversion.InsertVersions(ctx, cs.log, tx, ubl.MasterFlagCreditNoteHeader, hd.Id, before, after, revision, tn, in.GetUserEmail(), in.GetRequestId())
+		}
 		return nil
 	})
 
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = stmt.Close()
 	if err != nil {
 		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
-	return &invoiceproto.UpdateCreditNoteHeaderResponse{}, nil
+	return nil
+}
+
+// selectCreditNoteHeaderStatusByUUIDForUpdateSQL - lock a credit note until the end of the
+// transaction and get its document status
+const selectCreditNoteHeaderStatusByUUIDForUpdateSQL = `select document_status_code from credit_note_headers where uuid4 = ? and status_code = ? for update;`
+
+// lockCreditNoteHeader - lock the credit note uuid4byte until the end of the transaction of
+// its update, before it is read, and check that it is still a draft or,
+// with a revision, that it can be revised, as its status may have changed
+// since the update was asked for
+func lockCreditNoteHeader(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, uuid4byte []byte, revision *docversion.Revision, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectCreditNoteHeaderStatusByUUIDForUpdateSQL, uuid4byte, "active").Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	if revision == nil {
+		err = docstatus.CheckEditable(documentStatusCode)
+	} else {
+		err = docstatus.CheckRevisable(documentStatusCode)
+	}
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
 }
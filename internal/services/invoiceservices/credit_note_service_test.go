@@ -24,19 +24,19 @@ func TestCreditNoteHeaderService_GetCreditNoteHeaders(t *testing.T) {
 
 	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
 	creditNoteHeaders := []*invoiceproto.CreditNoteHeader{}
-	creditNoteHeader, err := GetCreditNoteHeader(uint32(1), []byte{88, 185, 127, 200, 184, 142, 72, 33, 146, 248, 145, 38, 92, 77, 194, 252}, "58b97fc8-b88e-4821-92f8-91265c4dc2fc", "2005-06-25T10:04:26Z", "2005-06-25T10:04:26Z", "2005-06-21T10:04:26Z", "sample", "GBP", "2005-06-12T10:04:26Z", "2005-06-13T10:04:26Z", "2005-06-14T10:04:26Z", "2005-06-15T10:04:26Z", "2005-06-16T10:04:26Z", "2005-06-17T10:04:26Z", float64(0), float64(0), float64(0), float64(107.5), "2005-06-23T10:04:26Z", "2005-06-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteHeader, err := GetCreditNoteHeader(uint32(1), []byte{88, 185, 127, 200, 184, 142, 72, 33, 146, 248, 145, 38, 92, 77, 194, 252}, "58b97fc8-b88e-4821-92f8-91265c4dc2fc", "2005-06-25T10:04:26Z", "2005-06-25T10:04:26Z", "2005-06-21T10:04:26Z", "sample", "GBP", "2005-06-12T10:04:26Z", "2005-06-13T10:04:26Z", "2005-06-14T10:04:26Z", "2005-06-15T10:04:26Z", "2005-06-16T10:04:26Z", "2005-06-17T10:04:26Z", "0.00", "0.00", "0.00", "107.50", "2005-06-23T10:04:26Z", "2005-06-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", float64(100), float64(1000), float64(0.3), float64(729), "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", "100.00", "1000.00", "0.30", "729.00", "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	creditNoteHeader3, err := GetCreditNoteHeader(uint32(3), []byte{131, 155, 98, 222, 250, 229, 76, 183, 170, 88, 60, 60, 224, 188, 139, 9}, "839b62de-fae5-4cb7-aa58-3c3ce0bc8b09", "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "", "GBP", "2011-06-01T10:04:26Z", "2011-06-02T10:04:26Z", "2011-06-03T10:04:26Z", "2011-06-04T10:04:26Z", "2011-06-05T10:04:26Z", "2011-06-06T10:04:26Z", float64(0), float64(0), float64(0), float64(20), "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteHeader3, err := GetCreditNoteHeader(uint32(3), []byte{131, 155, 98, 222, 250, 229, 76, 183, 170, 88, 60, 60, 224, 188, 139, 9}, "839b62de-fae5-4cb7-aa58-3c3ce0bc8b09", "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "", "GBP", "2011-06-01T10:04:26Z", "2011-06-02T10:04:26Z", "2011-06-03T10:04:26Z", "2011-06-04T10:04:26Z", "2011-06-05T10:04:26Z", "2011-06-06T10:04:26Z", "0.00", "0.00", "0.00", "20.00", "2011-06-01T10:04:26Z", "2011-06-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -86,7 +86,7 @@ func TestCreditNoteHeaderService_GetCreditNoteHeaders(t *testing.T) {
 		creditNoteHeaderResult := creditNoteHeadersResp.CreditNoteHeaders[2]
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.Note, "sample", "they should be equal")
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.TaxCurrencyCode, "GBP", "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, float64(107.5), "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, "107.50", "they should be equal")
 	}
 }
 
@@ -101,7 +101,7 @@ func TestCreditNoteHeaderService_GetCreditNoteHeader(t *testing.T) {
 
 	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
 
-	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", float64(100), float64(1000), float64(0.3), float64(729), "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", "100.00", "1000.00", "0.30", "729.00", "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -150,10 +150,10 @@ func TestCreditNoteHeaderService_GetCreditNoteHeader(t *testing.T) {
 		creditNoteHeaderResult := creditNoteHeadersResp.CreditNoteHeader
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.Note, "Ordered in our booth at the convention", "they should be equal")
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.TaxCurrencyCode, "EUR", "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.ChargeTotalAmount, float64(100), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PrepaidAmount, float64(1000), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableRoundingAmount, float64(0.3), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, float64(729), "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.ChargeTotalAmount, "100.00", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PrepaidAmount, "1000.00", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableRoundingAmount, "0.30", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, "729.00", "they should be equal")
 	}
 }
 
@@ -168,7 +168,7 @@ func TestCreditNoteHeaderService_GetCreditNoteHeaderByPk(t *testing.T) {
 
 	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
 
-	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", float64(100), float64(1000), float64(0.3), float64(729), "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteHeader2, err := GetCreditNoteHeader(uint32(2), []byte{240, 33, 191, 102, 37, 200, 71, 166, 168, 247, 97, 102, 197, 81, 160, 23}, "f021bf66-25c8-47a6-a8f7-6166c551a017", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "2009-12-15T10:04:26Z", "Ordered in our booth at the convention", "EUR", "2009-11-02T10:04:26Z", "2009-11-03T10:04:26Z", "2009-11-04T10:04:26Z", "2009-11-05T10:04:26Z", "2009-11-06T10:04:26Z", "2009-11-07T10:04:26Z", "100.00", "1000.00", "0.30", "729.00", "2009-11-01T10:04:26Z", "2009-11-01T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -218,10 +218,10 @@ func TestCreditNoteHeaderService_GetCreditNoteHeaderByPk(t *testing.T) {
 		creditNoteHeaderResult := creditNoteHeadersResp.CreditNoteHeader
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.Note, "Ordered in our booth at the convention", "they should be equal")
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.TaxCurrencyCode, "EUR", "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.ChargeTotalAmount, float64(100), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PrepaidAmount, float64(1000), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableRoundingAmount, float64(0.3), "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, float64(729), "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.ChargeTotalAmount, "100.00", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PrepaidAmount, "1000.00", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableRoundingAmount, "0.30", "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, "729.00", "they should be equal")
 	}
 }
 
@@ -236,7 +236,7 @@ func TestCreditNoteHeaderService_GetCreditNoteLines(t *testing.T) {
 
 	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
 	creditNoteLines := []*invoiceproto.CreditNoteLine{}
-	creditNoteLine, err := GetCreditNoteLine(uint32(1), []byte{246, 110, 121, 136, 32, 189, 68, 63, 131, 39, 244, 234, 209, 15, 27, 75}, "f66e7988-20bd-443f-8327-f4ead10f1b4b", "as agreed on phone, the invoice should have been cancelled earlier, apologies", float64(100), float64(100), "2005-06-21T10:04:26Z", uint32(1), "2005-06-12T10:04:26Z", "2005-06-13T10:04:26Z", "2005-06-18T10:04:26Z", "2005-06-19T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	creditNoteLine, err := GetCreditNoteLine(uint32(1), []byte{246, 110, 121, 136, 32, 189, 68, 63, 131, 39, 244, 234, 209, 15, 27, 75}, "f66e7988-20bd-443f-8327-f4ead10f1b4b", "as agreed on phone, the invoice should have been cancelled earlier, apologies", float64(100), "100.00", "2005-06-21T10:04:26Z", uint32(1), "2005-06-12T10:04:26Z", "2005-06-13T10:04:26Z", "2005-06-18T10:04:26Z", "2005-06-19T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 	}
@@ -286,7 +286,7 @@ func TestCreditNoteHeaderService_GetCreditNoteLines(t *testing.T) {
 		creditNoteLineResult := creditNoteLinesResp.CreditNoteLines[0]
 		assert.Equal(t, creditNoteLineResult.CreditNoteLineD.Note, "as agreed on phone, the invoice should have been cancelled earlier, apologies", "they should be equal")
 		assert.Equal(t, creditNoteLineResult.CreditNoteLineD.CreditedQuantity, float64(100), "they should be equal")
-		assert.Equal(t, creditNoteLineResult.CreditNoteLineD.LineExtensionAmount, float64(100), "they should be equal")
+		assert.Equal(t, creditNoteLineResult.CreditNoteLineD.LineExtensionAmount, "100.00", "they should be equal")
 	}
 }
 
@@ -312,10 +312,10 @@ func TestCreditNoteHeaderService_CreateCreditNoteHeader(t *testing.T) {
 	creditNoteHeader.PricingExDate = "08/10/2022"
 	creditNoteHeader.PaymentExDate = "08/11/2022"
 	creditNoteHeader.PaymentAltExDate = "08/10/2022"
-	creditNoteHeader.ChargeTotalAmount = float64(0)
-	creditNoteHeader.PrepaidAmount = float64(0)
-	creditNoteHeader.PayableRoundingAmount = float64(0)
-	creditNoteHeader.PayableAmount = float64(20)
+	creditNoteHeader.ChargeTotalAmount = "0"
+	creditNoteHeader.PrepaidAmount = "0"
+	creditNoteHeader.PayableRoundingAmount = "0"
+	creditNoteHeader.PayableAmount = "20"
 	creditNoteHeader.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	creditNoteHeader.UserEmail = "sprov300@gmail.com"
 	creditNoteHeader.RequestId = "bks1m1g91jau4nkks2f0"
@@ -323,7 +323,7 @@ func TestCreditNoteHeaderService_CreateCreditNoteHeader(t *testing.T) {
 	creditNoteLine := invoiceproto.CreateCreditNoteLineRequest{}
 	creditNoteLine.Note = "Ordered in our booth at the convention"
 	creditNoteLine.CreditedQuantity = float64(2)
-	creditNoteLine.LineExtensionAmount = float64(20)
+	creditNoteLine.LineExtensionAmount = "20"
 	creditNoteLine.TaxPointDate = "08/01/2022"
 	creditNoteLine.InvoicePeriodStartDate = "08/01/2022"
 	creditNoteLine.InvoicePeriodEndDate = "09/01/2022"
@@ -366,11 +366,11 @@ func TestCreditNoteHeaderService_CreateCreditNoteHeader(t *testing.T) {
 		creditNoteHeaderResult := creditNoteHeadersResp.CreditNoteHeader
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.Note, "Ordered in our booth at the convention", "they should be equal")
 		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.TaxCurrencyCode, "GBP", "they should be equal")
-		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, float64(20), "they should be equal")
+		assert.Equal(t, creditNoteHeaderResult.CreditNoteHeaderD.PayableAmount, "20.00", "they should be equal")
 	}
 }
 
-func GetCreditNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string, dueDate string, taxPointDate string, note string, taxCurrencyCode string, invoicePeriodStartDate string, invoicePeriodEndDate string, taxExDate string, pricingExDate string, paymentExDate string, paymentAltExDate string, chargeTotalAmount float64, prepaidAmount float64, payableRoundingAmount float64, payableAmount float64, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.CreditNoteHeader, error) {
+func GetCreditNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string, dueDate string, taxPointDate string, note string, taxCurrencyCode string, invoicePeriodStartDate string, invoicePeriodEndDate string, taxExDate string, pricingExDate string, paymentExDate string, paymentAltExDate string, chargeTotalAmount string, prepaidAmount string, payableRoundingAmount string, payableAmount string, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.CreditNoteHeader, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -436,6 +436,7 @@ func GetCreditNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string,
 	creditNoteHeaderD.PrepaidAmount = prepaidAmount
 	creditNoteHeaderD.PayableRoundingAmount = payableRoundingAmount
 	creditNoteHeaderD.PayableAmount = payableAmount
+	creditNoteHeaderD.DocumentStatusCode = "draft"
 
 	creditNoteHeaderT := invoiceproto.CreditNoteHeaderT{}
 	creditNoteHeaderT.IssueDate = issueDate1
@@ -462,7 +463,7 @@ func GetCreditNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string,
 	return &creditNoteHeader, nil
 }
 
-func GetCreditNoteLine(id uint32, uuid4 []byte, idS string, note string, creditedQuantity float64, lineExtensionAmount float64, taxPointDate string, itemId uint32, invoicePeriodStartDate string, invoicePeriodEndDate string, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, creditNoteHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.CreditNoteLine, error) {
+func GetCreditNoteLine(id uint32, uuid4 []byte, idS string, note string, creditedQuantity float64, lineExtensionAmount string, taxPointDate string, itemId uint32, invoicePeriodStartDate string, invoicePeriodEndDate string, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, creditNoteHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.CreditNoteLine, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -543,10 +544,10 @@ func TestCreditNoteHeaderService_UpdateCreditNoteHeader(t *testing.T) {
 	form := invoiceproto.UpdateCreditNoteHeaderRequest{}
 	form.Note = "Ordered"
 	form.TaxCurrencyCode = "GBP"
-	form.ChargeTotalAmount = float64(10)
-	form.PrepaidAmount = float64(20)
-	form.PayableRoundingAmount = float64(100)
-	form.PayableAmount = float64(200)
+	form.ChargeTotalAmount = "10"
+	form.PrepaidAmount = "20"
+	form.PayableRoundingAmount = "100"
+	form.PayableAmount = "200"
 	form.Id = "58b97fc8-b88e-4821-92f8-91265c4dc2fc"
 	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	form.UserEmail = "sprov300@gmail.com"
@@ -585,3 +586,290 @@ func TestCreditNoteHeaderService_UpdateCreditNoteHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestCreditNoteHeaderService_GetCreditNoteUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.GetCreditNoteUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "f021bf66-25c8-47a6-a8f7-6166c551a017"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetCreditNoteUBLRequest
+	}
+	tests := []struct {
+		cs      *CreditNoteHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			cs: creditNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		creditNoteUBLResp, err := tt.cs.GetCreditNoteUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CreditNoteHeaderService.GetCreditNoteUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, creditNoteUBLResp)
+		ublStr := string(creditNoteUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>f021bf66-25c8-47a6-a8f7-6166c551a017</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2009-12-15</cbc:IssueDate>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:Note>Ordered in our booth at the convention</cbc:Note>`, "they should be equal")
+	}
+}
+
+func TestCreditNoteHeaderService_ImportCreditNoteUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>CN-IMPORT-1</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:AccountingSupplierParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>Consortial</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>IYT Corporation</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingCustomerParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="EUR">100.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+  <cac:CreditNoteLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:CreditedQuantity>1</cbc:CreditedQuantity>
+    <cbc:LineExtensionAmount currencyID="EUR">100.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Not in the catalogue</cbc:Name></cac:Item>
+  </cac:CreditNoteLine>
+</CreditNote>`
+
+	form := invoiceproto.ImportCreditNoteUBLRequest{}
+	form.Ubl = []byte(ublDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ImportCreditNoteUBLRequest
+	}
+	tests := []struct {
+		cs      *CreditNoteHeaderService
+		args    args
+		wantErr string
+	}{
+		{
+			cs: creditNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "/CreditNote/cac:CreditNoteLine[1]/cac:Item",
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.cs.ImportCreditNoteUBL(tt.args.ctx, tt.args.in)
+		if err == nil {
+			t.Errorf("CreditNoteHeaderService.ImportCreditNoteUBL() error = nil, want %v", tt.wantErr)
+			return
+		}
+		assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+	}
+}
+
+func TestCreditNoteHeaderService_GetCreditNoteCII(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.GetCreditNoteCIIRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "f021bf66-25c8-47a6-a8f7-6166c551a017"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetCreditNoteCIIRequest
+	}
+	tests := []struct {
+		cs      *CreditNoteHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			cs: creditNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		creditNoteCIIResp, err := tt.cs.GetCreditNoteCII(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CreditNoteHeaderService.GetCreditNoteCII() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, creditNoteCIIResp)
+		ciiStr := string(creditNoteCIIResp.Cii)
+		assert.Contains(t, ciiStr, `<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100"`, "they should be equal")
+		assert.Contains(t, ciiStr, `<udt:DateTimeString format="102">20091215</udt:DateTimeString>`, "they should be equal")
+		assert.Contains(t, ciiStr, `<ram:Content>Ordered in our booth at the convention</ram:Content>`, "they should be equal")
+	}
+}
+
+func TestCreditNoteHeaderService_ImportCreditNoteCII(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	ciiDoc := `<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100" xmlns:ram="urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:100" xmlns:udt="urn:un:unece:uncefact:data:standard:UnqualifiedDataType:100">
+  <rsm:ExchangedDocument>
+    <ram:ID>CN-IMPORT-1</ram:ID>
+    <ram:TypeCode>381</ram:TypeCode>
+    <ram:IssueDateTime><udt:DateTimeString format="102">20240131</udt:DateTimeString></ram:IssueDateTime>
+  </rsm:ExchangedDocument>
+  <rsm:SupplyChainTradeTransaction>
+    <ram:IncludedSupplyChainTradeLineItem>
+      <ram:AssociatedDocumentLineDocument><ram:LineID>1</ram:LineID></ram:AssociatedDocumentLineDocument>
+      <ram:SpecifiedTradeProduct><ram:Name>Not in the catalogue</ram:Name></ram:SpecifiedTradeProduct>
+      <ram:SpecifiedLineTradeDelivery><ram:BilledQuantity>1</ram:BilledQuantity></ram:SpecifiedLineTradeDelivery>
+      <ram:SpecifiedLineTradeSettlement><ram:SpecifiedTradeSettlementLineMonetarySummation><ram:LineTotalAmount>100.00</ram:LineTotalAmount></ram:SpecifiedTradeSettlementLineMonetarySummation></ram:SpecifiedLineTradeSettlement>
+    </ram:IncludedSupplyChainTradeLineItem>
+    <ram:ApplicableHeaderTradeAgreement>
+      <ram:SellerTradeParty><ram:Name>Consortial</ram:Name></ram:SellerTradeParty>
+      <ram:BuyerTradeParty><ram:Name>IYT Corporation</ram:Name></ram:BuyerTradeParty>
+    </ram:ApplicableHeaderTradeAgreement>
+    <ram:ApplicableHeaderTradeDelivery/>
+    <ram:ApplicableHeaderTradeSettlement>
+      <ram:InvoiceCurrencyCode>EUR</ram:InvoiceCurrencyCode>
+      <ram:SpecifiedTradeSettlementHeaderMonetarySummation><ram:DuePayableAmount>100.00</ram:DuePayableAmount></ram:SpecifiedTradeSettlementHeaderMonetarySummation>
+    </ram:ApplicableHeaderTradeSettlement>
+  </rsm:SupplyChainTradeTransaction>
+</rsm:CrossIndustryInvoice>`
+
+	form := invoiceproto.ImportCreditNoteCIIRequest{}
+	form.Cii = []byte(ciiDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ImportCreditNoteCIIRequest
+	}
+	tests := []struct {
+		cs      *CreditNoteHeaderService
+		args    args
+		wantErr string
+	}{
+		{
+			cs: creditNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "/CreditNote/cac:CreditNoteLine[1]/cac:Item",
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.cs.ImportCreditNoteCII(tt.args.ctx, tt.args.in)
+		if err == nil {
+			t.Errorf("CreditNoteHeaderService.ImportCreditNoteCII() error = nil, want %v", tt.wantErr)
+			return
+		}
+		assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+	}
+}
+
+func TestCreditNoteHeaderService_UpdateCreditNoteLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.UpdateCreditNoteLineRequest{}
+	form.Note = "sample updated"
+	form.CreditedQuantity = float64(10)
+	form.LineExtensionAmount = "25.00"
+	form.PriceAmount = "2.50"
+	form.PriceBaseQuantity = float64(1)
+	form.Id = "f66e7988-20bd-443f-8327-f4ead10f1b4b"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = creditNoteHeaderService.UpdateCreditNoteLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "58b97fc8-b88e-4821-92f8-91265c4dc2fc", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	creditNoteLinesResponse, err := creditNoteHeaderService.GetCreditNoteLines(ctx, &invoiceproto.GetCreditNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	creditNoteLineResult := creditNoteLinesResponse.CreditNoteLines[0]
+	assert.Equal(t, creditNoteLineResult.CreditNoteLineD.Note, "sample updated", "they should be equal")
+	assert.Equal(t, creditNoteLineResult.CreditNoteLineD.LineExtensionAmount, "25.00", "they should be equal")
+
+	creditNoteHeaderResponse, err := creditNoteHeaderService.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.LineCountNumeric, uint32(1), "they should be equal")
+	assert.Equal(t, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.LineExtensionAmount, "25.00", "they should be equal")
+
+	_, err = creditNoteHeaderService.DeleteCreditNoteLine(ctx, &invoiceproto.DeleteCreditNoteLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	creditNoteHeaderResponse, err = creditNoteHeaderService.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.LineCountNumeric, uint32(0), "they should be equal")
+	assert.Equal(t, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.LineExtensionAmount, "0.00", "they should be equal")
+}
@@ -0,0 +1,80 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// IssueCreditNote - move a draft credit note with lines to issued, from then on it cannot be changed.
+// Without a number of its own it is numbered from the sequence of its supplier.
+func (cs *CreditNoteHeaderService) IssueCreditNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Issued)
+}
+
+// SendCreditNote - move an issued credit note to sent
+func (cs *CreditNoteHeaderService) SendCreditNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Sent)
+}
+
+// AcknowledgeCreditNote - move a sent or disputed credit note to acknowledged
+func (cs *CreditNoteHeaderService) AcknowledgeCreditNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Acknowledged)
+}
+
+// MarkCreditNotePartiallyPaid - move a credit note that is due to partially paid
+func (cs *CreditNoteHeaderService) MarkCreditNotePartiallyPaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.PartiallyPaid)
+}
+
+// MarkCreditNotePaid - move a credit note that is due to paid
+func (cs *CreditNoteHeaderService) MarkCreditNotePaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Paid)
+}
+
+// MarkCreditNoteDisputed - move a credit note that is due to disputed, with the reason
+func (cs *CreditNoteHeaderService) MarkCreditNoteDisputed(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Disputed)
+}
+
+// CancelCreditNote - cancel a credit note that is not paid, not even in part, with the reason
+func (cs *CreditNoteHeaderService) CancelCreditNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return cs.transitionCreditNote(ctx, in, docstatus.Cancelled)
+}
+
+// transitionCreditNote - move the credit note in.Id to the status to
+func (cs *CreditNoteHeaderService) transitionCreditNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest, to docstatus.Status) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	creditNoteLinesResponse, err := cs.GetCreditNoteLines(ctx, &invoiceproto.GetCreditNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	hd := creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD
+	doc := statusDocument{MasterFlag: ubl.MasterFlagCreditNoteHeader, ID: hd.Id, DocumentID: hd.CnhId, Numbering: numbering.CreditNote, PartyID: hd.AccountingSupplierPartyId, IssueDate: common.TimestampToTime(creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderT.IssueDate), StatusCode: hd.DocumentStatusCode, LineCount: len(creditNoteLinesResponse.CreditNoteLines)}
+	return transitionDocumentStatus(ctx, cs.log, cs.DBService, cs.UserServiceClient, &doc, to, in)
+}
+
+// GetCreditNoteStatusTransitions - Get the transitions of the status of a credit note,
+// oldest first
+func (cs *CreditNoteHeaderService) GetCreditNoteStatusTransitions(ctx context.Context, inReq *invoiceproto.GetDocumentStatusTransitionsRequest) (*invoiceproto.GetDocumentStatusTransitionsResponse, error) {
+	in := inReq.GetRequest
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: in})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return getDocumentStatusTransitions(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
@@ -0,0 +1,177 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/peppol"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// GetCreditNoteUBL - Get CreditNote as UBL 2.3 CreditNote XML, in the PEPPOL
+// BIS Billing 3.0 profile when asked for
+func (cs *CreditNoteHeaderService) GetCreditNoteUBL(ctx context.Context, inReq *invoiceproto.GetCreditNoteUBLRequest) (*invoiceproto.GetCreditNoteUBLResponse, error) {
+	in := inReq.GetRequest
+	profile, err := ubl.ParseProfile(inReq.Profile)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	src, err := cs.getCreditNoteSource(ctx, inReq)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	src.Profile = profile
+
+	creditNote, err := ubl.CreditNoteFromSource(src)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	if profile == ubl.ProfilePeppolBIS3 {
+		err = peppol.ValidateCreditNote(creditNote)
+		if err != nil {
+			cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, en16931.GRPCError(err)
+		}
+	}
+
+	ublBytes, err := ubl.Marshal(creditNote)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	creditNoteUBLResponse := invoiceproto.GetCreditNoteUBLResponse{}
+	creditNoteUBLResponse.Ubl = ublBytes
+	return &creditNoteUBLResponse, nil
+}
+
+// getCreditNoteSource - Get CreditNote with the parties, items, taxes,
+// payment terms and references needed to build a UBL document
+func (cs *CreditNoteHeaderService) getCreditNoteSource(ctx context.Context, inReq *invoiceproto.GetCreditNoteUBLRequest) (*ubl.CreditNoteSource, error) {
+	in := inReq.GetRequest
+	form := invoiceproto.GetCreditNoteHeaderRequest{}
+	form.GetRequest = in
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	creditNoteHeader := creditNoteHeaderResponse.CreditNoteHeader
+	hd := creditNoteHeader.CreditNoteHeaderD
+
+	linesForm := invoiceproto.GetCreditNoteLinesRequest{}
+	linesForm.GetRequest = in
+	creditNoteLinesResponse, err := cs.GetCreditNoteLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	creditNoteLines := creditNoteLinesResponse.CreditNoteLines
+
+	keys := ubl.DocumentKeys{
+		HeaderMasterFlag: ubl.MasterFlagCreditNoteHeader,
+		HeaderID:         hd.Id,
+		LineMasterFlag:   ubl.MasterFlagCreditNoteLine,
+		PartyIDs:         []uint32{hd.AccountingSupplierPartyId, hd.AccountingCustomerPartyId, hd.PayeePartyId, hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId, hd.TaxRepresentativePartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder:    {hd.OrderId},
+			ubl.ReferenceDespatch: {hd.DespatchId},
+			ubl.ReferenceReceipt:  {hd.ReceiptId},
+			ubl.ReferenceInvoice:  {hd.BillingId},
+		},
+	}
+	for _, creditNoteLine := range creditNoteLines {
+		ld := creditNoteLine.CreditNoteLineD
+		keys.LineIDs = append(keys.LineIDs, ld.Id)
+		keys.PartyIDs = append(keys.PartyIDs, ld.OriginatorPartyId)
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+		keys.References[ubl.ReferenceOrderLine] = append(keys.References[ubl.ReferenceOrderLine], ld.OrderLineId)
+		keys.References[ubl.ReferenceDespatchLine] = append(keys.References[ubl.ReferenceDespatchLine], ld.DespatchLineId)
+		keys.References[ubl.ReferenceReceiptLine] = append(keys.References[ubl.ReferenceReceiptLine], ld.ReceiptLineId)
+		keys.References[ubl.ReferenceInvoice] = append(keys.References[ubl.ReferenceInvoice], ld.BillingId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, cs.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.CreditNoteSource{CreditNoteHeader: creditNoteHeader, CreditNoteLines: creditNoteLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportCreditNoteUBL - Create CreditNote from a UBL 2.3 CreditNote XML
+// document. Unmappable elements are reported as ubl.FieldErrors located by
+// XPath.
+func (cs *CreditNoteHeaderService) ImportCreditNoteUBL(ctx context.Context, in *invoiceproto.ImportCreditNoteUBLRequest) (*invoiceproto.ImportCreditNoteUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	creditNote := ubl.CreditNote{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceCreditNote, &creditNote)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	creditNoteHeader, err := cs.importCreditNote(ctx, &creditNote, in.UserId, in.UserEmail, in.RequestId)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importCreditNoteUBLResponse := invoiceproto.ImportCreditNoteUBLResponse{}
+	importCreditNoteUBLResponse.CreditNoteHeader = creditNoteHeader
+	return &importCreditNoteUBLResponse, nil
+}
+
+// importCreditNote - Create CreditNote and its payment terms from a UBL
+// CreditNote, resolving parties and items by their identifiers. The
+// parties it creates, the credit note and its payment terms are written in
+// one transaction.
+func (cs *CreditNoteHeaderService) importCreditNote(ctx context.Context, creditNote *ubl.CreditNote, userID string, userEmail string, requestID string) (*invoiceproto.CreditNoteHeader, error) {
+	var creditNoteHeader *invoiceproto.CreditNoteHeader
+	err := cs.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(cs.log, cs.DBService, cs.RedisService, cs.UserServiceClient, userID, userEmail, requestID, creditNote.IssueDate)
+		creditNoteImport, err := ubl.ImportCreditNote(ctx, creditNote, resolver)
+		if err != nil {
+			return err
+		}
+
+		form := creditNoteImport.CreditNote
+		form.UserId = userID
+		form.UserEmail = userEmail
+		form.RequestId = requestID
+		creditNoteHeaderResponse, err := cs.CreateCreditNoteHeader(ctx, form)
+		if err != nil {
+			return err
+		}
+		creditNoteHeader = creditNoteHeaderResponse.CreditNoteHeader
+
+		paymentService := paymentservice.NewPaymentService(cs.log, cs.DBService, cs.RedisService, cs.UserServiceClient)
+		return paymentService.CreateUBLPaymentTerms(ctx, creditNoteImport.PaymentTerms, ubl.MasterFlagCreditNoteHeader, creditNoteHeader.CreditNoteHeaderD.Id, userID, userEmail, requestID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return creditNoteHeader, nil
+}
@@ -0,0 +1,68 @@
+package invoiceservices
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReviseCreditNote - Revise an issued credit note with the changes UpdateCreditNoteHeader makes to a
+// draft one, giving the reason. The revised credit note is kept as its next
+// version, after the version it was issued with.
+func (cs *CreditNoteHeaderService) ReviseCreditNote(ctx context.Context, in *invoiceproto.ReviseCreditNoteRequest) (*invoiceproto.ReviseCreditNoteResponse, error) {
+	updateRequest := in.UpdateCreditNoteHeaderRequest
+	if updateRequest == nil {
+		err := errors.New("docversion: a revision needs the changes it makes")
+		cs.log.Error("Error", zap.Error(err))
+		return nil, err
+	}
+	revision, err := docversion.NewRevision(ctx, cs.UserServiceClient, in.Reason, updateRequest.UserId, updateRequest.UserEmail, updateRequest.RequestId)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err = cs.updateCreditNoteHeader(ctx, updateRequest, revision)
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.ReviseCreditNoteResponse{DocumentVersion: revision.Version}, nil
+}
+
+// GetCreditNoteVersions - Get the versions of a credit note, oldest first
+func (cs *CreditNoteHeaderService) GetCreditNoteVersions(ctx context.Context, inReq *commonproto.GetDocumentVersionsRequest) (*commonproto.GetDocumentVersionsResponse, error) {
+	in := inReq.GetRequest
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: in})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.GetVersions(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
+
+// DiffCreditNoteVersions - Get the fields of a credit note that differ between two
+// of its versions
+func (cs *CreditNoteHeaderService) DiffCreditNoteVersions(ctx context.Context, in *commonproto.DiffDocumentVersionsRequest) (*commonproto.DiffDocumentVersionsResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	creditNoteHeaderResponse, err := cs.GetCreditNoteHeader(ctx, &invoiceproto.GetCreditNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		cs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.DiffVersions(ctx, cs.log, cs.DBService, ubl.MasterFlagCreditNoteHeader, creditNoteHeaderResponse.CreditNoteHeader.CreditNoteHeaderD.Id, in)
+}
+
+// creditNoteSnapshot - the docversion snapshot of a credit note
+func creditNoteSnapshot(creditNoteHeader *invoiceproto.CreditNoteHeader, creditNoteLines []*invoiceproto.CreditNoteLine) ([]byte, error) {
+	lines := []proto.Message{}
+	for _, creditNoteLine := range creditNoteLines {
+		lines = append(lines, creditNoteLine)
+	}
+	return docversion.Snapshot(creditNoteHeader, lines)
+}
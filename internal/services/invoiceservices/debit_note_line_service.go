@@ -2,14 +2,20 @@ package invoiceservices
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -19,6 +25,7 @@ const insertDebitNoteLineSQL = `insert into debit_note_lines
 dnl_id,
 note,
 debited_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -29,6 +36,7 @@ receipt_line_id,
 billing_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -48,6 +56,7 @@ updated_at)
 :dnl_id,
 :note,
 :debited_quantity,
+:unit_code,
 :line_extension_amount,
 :accounting_cost_code,
 :accounting_cost,
@@ -58,6 +67,7 @@ updated_at)
 :billing_id,
 :item_id,
 :price_amount,
+:currency_code,
 :price_base_quantity,
 :price_change_reason,
 :price_type_code,
@@ -80,6 +90,7 @@ uuid4,
 dnl_id,
 note,
 debited_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -90,6 +101,7 @@ receipt_line_id,
 billing_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -106,13 +118,32 @@ updated_by_user_id,
 created_at,
 updated_at from debit_note_lines`
 
-// CreateDebitNoteLine - Create DebitNoteLine
+// CreateDebitNoteLine - Create DebitNoteLine of a draft debit note
 func (ds *DebitNoteHeaderService) CreateDebitNoteLine(ctx context.Context, in *invoiceproto.CreateDebitNoteLineRequest) (*invoiceproto.CreateDebitNoteLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	currencyCode := ""
+	if in.DebitNoteHeaderId != 0 {
+		getByIDRequest := commonproto.GetByIdRequest{Id: in.DebitNoteHeaderId, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		debitNoteHeaderResponse, err := ds.GetDebitNoteHeaderByPk(ctx, &invoiceproto.GetDebitNoteHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		if err = docstatus.CheckEditable(debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.DocumentStatusCode); err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		currencyCode = debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.DocumentCurrencyCode
+	}
 	debitNoteLine, err := ds.ProcessDebitNoteLineRequest(ctx, in)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
+	debitNoteLine.DebitNoteLineD.CurrencyCode = currencyCode
 
 	err = ds.insertDebitNoteLine(ctx, insertDebitNoteLineSQL, debitNoteLine, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
@@ -175,6 +206,10 @@ func (ds *DebitNoteHeaderService) ProcessDebitNoteLineRequest(ctx context.Contex
 	debitNoteLineD.DnlId = in.DnlId
 	debitNoteLineD.Note = in.Note
 	debitNoteLineD.DebitedQuantity = in.DebitedQuantity
+	debitNoteLineD.UnitCode = in.UnitCode
+	if debitNoteLineD.UnitCode == "" {
+		debitNoteLineD.UnitCode = ubl.UnitCodeOne
+	}
 	debitNoteLineD.LineExtensionAmount = in.LineExtensionAmount
 	debitNoteLineD.AccountingCostCode = in.AccountingCostCode
 	debitNoteLineD.AccountingCost = in.AccountingCost
@@ -209,6 +244,12 @@ func (ds *DebitNoteHeaderService) ProcessDebitNoteLineRequest(ctx context.Contex
 
 	debitNoteLine := invoiceproto.DebitNoteLine{DebitNoteLineD: &debitNoteLineD, DebitNoteLineT: &debitNoteLineT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	debitNoteLine.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, ds.log, ubl.MasterFlagDebitNoteLine, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &debitNoteLine, nil
 }
 
@@ -220,6 +261,13 @@ func (ds *DebitNoteHeaderService) insertDebitNoteLine(ctx context.Context, inser
 		return err
 	}
 	err = ds.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		if debitNoteLine.DebitNoteLineD.DebitNoteHeaderId != 0 {
+			err := lockDraftDebitNote(ctx, ds.log, tx, debitNoteLine.DebitNoteLineD.DebitNoteHeaderId, userEmail, requestID)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
 		res, err := tx.NamedExecContext(ctx, insertDebitNoteLineSQL, debitNoteLineTmp)
 		if err != nil {
 			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
@@ -238,6 +286,12 @@ func (ds *DebitNoteHeaderService) insertDebitNoteLine(ctx context.Context, inser
 			return err
 		}
 		debitNoteLine.DebitNoteLineD.IdS = uuid4Str
+
+		err = allowancecharge.Insert(ctx, ds.log, tx, debitNoteLine.AllowanceCharges, debitNoteLine.DebitNoteLineD.Id, debitNoteLine.DebitNoteLineD.CurrencyCode, userEmail, requestID)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
 		return nil
 	})
 
@@ -283,7 +337,7 @@ func (ds *DebitNoteHeaderService) GetDebitNoteLines(ctx context.Context, inReq *
 	debitNoteLines := []*invoiceproto.DebitNoteLine{}
 
 	nselectDebitNoteLinesSQL := selectDebitNoteLinesSQL + ` where debit_note_header_id = ? and status_code = ?;`
-	rows, err := ds.DBService.DB.QueryxContext(ctx, nselectDebitNoteLinesSQL, debitNoteHeader.DebitNoteHeaderD.Id, "active")
+	rows, err := ds.DBService.Queryer(ctx).QueryxContext(ctx, nselectDebitNoteLinesSQL, debitNoteHeader.DebitNoteHeaderD.Id, "active")
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -317,6 +371,11 @@ func (ds *DebitNoteHeaderService) getDebitNoteLineStruct(ctx context.Context, in
 	debitNoteLineT.PriceValidityPeriodStartDate = common.TimeToTimestamp(debitNoteLineTmp.DebitNoteLineT.PriceValidityPeriodStartDate)
 	debitNoteLineT.PriceValidityPeriodEndDate = common.TimeToTimestamp(debitNoteLineTmp.DebitNoteLineT.PriceValidityPeriodEndDate)
 
+	if err := money.Normalize(debitNoteLineTmp.DebitNoteLineD); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(debitNoteLineTmp.DebitNoteLineD.Uuid4)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -331,5 +390,274 @@ func (ds *DebitNoteHeaderService) getDebitNoteLineStruct(ctx context.Context, in
 
 	debitNoteLine := invoiceproto.DebitNoteLine{DebitNoteLineD: debitNoteLineTmp.DebitNoteLineD, DebitNoteLineT: debitNoteLineT, CrUpdUser: debitNoteLineTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	debitNoteLine.AllowanceCharges, err = allowancecharge.Get(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteLine, debitNoteLine.DebitNoteLineD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &debitNoteLine, nil
 }
+
+// selectDebitNoteLineHeaderIDSQL - the debit note of a DebitNoteLine
+const selectDebitNoteLineHeaderIDSQL = `select debit_note_header_id from debit_note_lines where uuid4 = ? and status_code = ?;`
+
+// updateDebitNoteLineSQL - update DebitNoteLineSQL query
+const updateDebitNoteLineSQL = `update debit_note_lines set 
+note = ?, 
+debited_quantity = ?, 
+line_extension_amount = ?, 
+accounting_cost = ?, 
+price_amount = ?, 
+price_base_quantity = ?, 
+updated_at = ? where id = ?;`
+
+// deleteDebitNoteLineSQL - delete DebitNoteLineSQL query
+const deleteDebitNoteLineSQL = `update debit_note_lines set 
+status_code = ?, 
+updated_at = ? where id = ?;`
+
+// updateDebitNoteTotalsSQL - update the line count and monetary totals of
+// a debit note
+const updateDebitNoteTotalsSQL = `update debit_note_headers set 
+line_count_numeric = ?, 
+line_extension_amount = ?, 
+allowance_total_amount = ?, 
+charge_total_amount = ?, 
+tax_exclusive_amount = ?, 
+tax_inclusive_amount = ?, 
+withholding_tax_total_amount = ?, 
+payable_rounding_amount = ?,
+payable_amount = ?, updated_at = ? where id = ?;`
+
+// UpdateDebitNoteLine - Update DebitNoteLine of a draft debit note. The
+// line keeps the line extension amount it is given, the amounts of its
+// allowances and charges, the TaxTotals and the line count and monetary
+// totals of the debit note are calculated again and kept in the same
+// transaction, which locks the debit note before it reads it.
+func (ds *DebitNoteHeaderService) UpdateDebitNoteLine(ctx context.Context, in *invoiceproto.UpdateDebitNoteLineRequest) (*invoiceproto.UpdateDebitNoteLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err := ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		debitNoteHeader, debitNoteLines, i, err := ds.getDraftDebitNoteOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		debitNoteLine := debitNoteLines[i]
+		ld := debitNoteLine.DebitNoteLineD
+		ld.Note = in.Note
+		ld.DebitedQuantity = in.DebitedQuantity
+		ld.LineExtensionAmount = in.LineExtensionAmount
+		ld.PriceAmount = in.PriceAmount
+		ld.PriceBaseQuantity = in.PriceBaseQuantity
+		ld.AccountingCost = in.AccountingCost
+
+		taxTotals, err := ds.calculateDebitNoteLineTotals(ctx, debitNoteHeader, debitNoteLines, in.UserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, updateDebitNoteLineSQL,
+			ld.Note,
+			ld.DebitedQuantity,
+			ld.LineExtensionAmount,
+			ld.AccountingCost,
+			ld.PriceAmount,
+			ld.PriceBaseQuantity,
+			tn,
+			ld.Id)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		err = allowancecharge.UpdateAmounts(ctx, ds.log, tx, debitNoteLine.AllowanceCharges, tn, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateDebitNoteTotals(ctx, ds.log, tx, debitNoteHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateDebitNoteLineResponse{}, nil
+}
+
+// DeleteDebitNoteLine - Delete DebitNoteLine of a draft debit note, the
+// TaxTotals and the line count and monetary totals of the debit note are
+// calculated again without it and kept in the same transaction
+func (ds *DebitNoteHeaderService) DeleteDebitNoteLine(ctx context.Context, inReq *invoiceproto.DeleteDebitNoteLineRequest) (*invoiceproto.DeleteDebitNoteLineResponse, error) {
+	in := inReq.GetRequest
+	err := ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		debitNoteHeader, debitNoteLines, i, err := ds.getDraftDebitNoteOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		debitNoteLineID := debitNoteLines[i].DebitNoteLineD.Id
+		debitNoteLines = append(debitNoteLines[:i], debitNoteLines[i+1:]...)
+		taxTotals, err := ds.calculateDebitNoteLineTotals(ctx, debitNoteHeader, debitNoteLines, debitNoteHeader.CrUpdUser.UpdatedByUserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, deleteDebitNoteLineSQL, "inactive", tn, debitNoteLineID)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateDebitNoteTotals(ctx, ds.log, tx, debitNoteHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.DeleteDebitNoteLineResponse{}, nil
+}
+
+// getDraftDebitNoteOfLine - the debit note of the DebitNoteLine
+// debitNoteLineID, locked with lockDraftDebitNote within tx, the transaction of
+// ctx, so that it is still a draft, with its lines and the index of the
+// line among them, read after the lock
+func (ds *DebitNoteHeaderService) getDraftDebitNoteOfLine(ctx context.Context, tx *sqlx.Tx, debitNoteLineID string, userEmail string, requestID string) (*invoiceproto.DebitNoteHeader, []*invoiceproto.DebitNoteLine, int, error) {
+	uuid4byte, err := common.UUIDStrToBytes(debitNoteLineID)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	var debitNoteHeaderID uint32
+	err = tx.QueryRowxContext(ctx, selectDebitNoteLineHeaderIDSQL, uuid4byte, "active").Scan(&debitNoteHeaderID)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	err = lockDraftDebitNote(ctx, ds.log, tx, debitNoteHeaderID, userEmail, requestID)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+
+	getByIDRequest := commonproto.GetByIdRequest{Id: debitNoteHeaderID, UserEmail: userEmail, RequestId: requestID}
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeaderByPk(ctx, &invoiceproto.GetDebitNoteHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	debitNoteHeader := debitNoteHeaderResponse.DebitNoteHeader
+
+	getRequest := commonproto.GetRequest{Id: debitNoteHeader.DebitNoteHeaderD.IdS, UserEmail: userEmail, RequestId: requestID}
+	debitNoteLinesResponse, err := ds.GetDebitNoteLines(ctx, &invoiceproto.GetDebitNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	for i, debitNoteLine := range debitNoteLinesResponse.DebitNoteLines {
+		if debitNoteLine.DebitNoteLineD.IdS == debitNoteLineID {
+			return debitNoteHeader, debitNoteLinesResponse.DebitNoteLines, i, nil
+		}
+	}
+	return nil, nil, 0, sql.ErrNoRows
+}
+
+// calculateDebitNoteLineTotals - generate the TaxTotals of the debit note
+// again from debitNoteLines, its lines after a line is changed, and set the
+// amounts of the allowances and charges of the lines and of the debit note
+// and its line count and monetary totals to the ones calculated with them
+func (ds *DebitNoteHeaderService) calculateDebitNoteLineTotals(ctx context.Context, debitNoteHeader *invoiceproto.DebitNoteHeader, debitNoteLines []*invoiceproto.DebitNoteLine, userID string, userEmail string, requestID string) (*documentTaxTotals, error) {
+	hd := debitNoteHeader.DebitNoteHeaderD
+	policy := rounding.ForCurrency(hd.DocumentCurrencyCode)
+	lineExtensionAmounts := []money.Amount{}
+	taxLines := []TaxLine{}
+	for _, debitNoteLine := range debitNoteLines {
+		ld := debitNoteLine.DebitNoteLineD
+		allowancecharge.CalculateProtos(debitNoteLine.AllowanceCharges, allowancecharge.LineBase(ld.DebitedQuantity, parseAmount(ld.PriceAmount), ld.PriceBaseQuantity, policy), policy)
+		lineExtensionAmounts = append(lineExtensionAmounts, parseAmount(ld.LineExtensionAmount))
+		taxLines = append(taxLines, TaxLine{ItemID: ld.ItemId, Quantity: ld.DebitedQuantity, LineExtensionAmount: parseAmount(ld.LineExtensionAmount)})
+	}
+	totals := noteTotals(hd.DocumentCurrencyCode, lineExtensionAmounts, debitNoteHeader.AllowanceCharges, hd.AllowanceTotalAmount, hd.ChargeTotalAmount, hd.WithholdingTaxTotalAmount, hd.PrepaidAmount, money.Zero)
+	allowancecharge.SetProtos(debitNoteHeader.AllowanceCharges, totals.AllowanceCharges)
+	taxLines = append(taxLines, allowanceChargeTaxLines(totals.AllowanceCharges)...)
+
+	taxTotals, err := generateDocumentTaxTotals(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, hd, hd.DocumentCurrencyCode, hd.TaxCurrencyCode, taxLines, userID, userEmail, requestID)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	hd.WithholdingTaxTotalAmount = withholdingTaxTotalAmount(taxTotals.WithholdingTaxTotal, policy)
+	totals.TaxAmount = taxTotals.taxAmount()
+	totals.WithholdingTaxAmount = parseAmount(hd.WithholdingTaxTotalAmount)
+	totals.CalculateDocument()
+
+	hd.LineCountNumeric = uint32(len(debitNoteLines))
+	hd.LineExtensionAmount = totals.LineExtensionAmount.String()
+	hd.AllowanceTotalAmount = totals.AllowanceTotalAmount.String()
+	hd.ChargeTotalAmount = totals.ChargeTotalAmount.String()
+	hd.TaxExclusiveAmount = totals.TaxExclusiveAmount.String()
+	hd.TaxInclusiveAmount = totals.TaxInclusiveAmount.String()
+	hd.PayableRoundingAmount = totals.PayableRoundingAmount.String()
+	hd.PayableAmount = totals.PayableAmount.String()
+	return taxTotals, nil
+}
+
+// selectDebitNoteStatusForUpdateSQL - lock a debit note until the end of the
+// transaction and get its document status
+const selectDebitNoteStatusForUpdateSQL = `select document_status_code from debit_note_headers where id = ? for update;`
+
+// lockDraftDebitNote - lock the debit note debitNoteHeaderID until the end
+// of the transaction of a change to its lines and check that it is still a
+// draft, as it may have been issued since it was read
+func lockDraftDebitNote(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, debitNoteHeaderID uint32, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectDebitNoteStatusForUpdateSQL, debitNoteHeaderID).Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = docstatus.CheckEditable(documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// updateDebitNoteTotals - keep the line count and monetary totals of a
+// debit note, the amounts of its allowances and charges and taxTotals, its
+// TaxTotals, within the transaction of the change to its lines
+func updateDebitNoteTotals(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, debitNoteHeader *invoiceproto.DebitNoteHeader, taxTotals *documentTaxTotals, tn time.Time, userEmail string, requestID string) error {
+	hd := debitNoteHeader.DebitNoteHeaderD
+	_, err := tx.ExecContext(ctx, updateDebitNoteTotalsSQL,
+		hd.LineCountNumeric,
+		hd.LineExtensionAmount,
+		hd.AllowanceTotalAmount,
+		hd.ChargeTotalAmount,
+		hd.TaxExclusiveAmount,
+		hd.TaxInclusiveAmount,
+		hd.WithholdingTaxTotalAmount,
+		hd.PayableRoundingAmount,
+		hd.PayableAmount,
+		tn,
+		hd.Id)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = allowancecharge.UpdateAmounts(ctx, log, tx, debitNoteHeader.AllowanceCharges, tn, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return replaceTaxTotals(ctx, log, tx, ubl.MasterFlagDebitNoteHeader, hd.Id, hd.DocumentCurrencyCode, taxTotals, tn, userEmail, requestID)
+}
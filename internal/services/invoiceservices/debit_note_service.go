@@ -4,13 +4,21 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -97,6 +105,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 tax_point_date,
 invoice_period_start_date,
@@ -105,6 +114,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -172,6 +182,7 @@ updated_at)
 :payable_rounding_amount,
 :payable_amount,
 :payable_alternative_amount,
+:payable_alternative_currency_code,
 :issue_date,
 :tax_point_date,
 :invoice_period_start_date,
@@ -180,6 +191,7 @@ updated_at)
 :pricing_ex_date,
 :payment_ex_date,
 :payment_alt_ex_date,
+:document_status_code,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -250,6 +262,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 tax_point_date,
 invoice_period_start_date,
@@ -258,6 +271,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -274,8 +288,15 @@ prepaid_amount = ?,
 payable_rounding_amount = ?,
 payable_amount = ?, updated_at = ? where uuid4 = ?;`
 
-// CreateDebitNoteHeader - Create DebitNoteHeader
+// CreateDebitNoteHeader - Create DebitNoteHeader, with a TaxTotal and
+// TaxSubTotals generated from the tax categories of the items of its lines,
+// and with a tax currency a second TaxTotal with the total tax amount
+// converted with the tax exchange rate
 func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in *invoiceproto.CreateDebitNoteHeaderRequest) (*invoiceproto.CreateDebitNoteHeaderResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ds.UserServiceClient)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -385,7 +406,7 @@ func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in
 	debitNoteHeaderD.PaymentExCalculationRate = in.PaymentExCalculationRate
 	debitNoteHeaderD.PaymentExMathematicOperatorCode = in.PaymentExMathematicOperatorCode
 	debitNoteHeaderD.PaymentAltExSourceCurrencyCode = in.PaymentAltExSourceCurrencyCode
-	debitNoteHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyCode
+	debitNoteHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyBaseRate
 	debitNoteHeaderD.PaymentAltExTargetCurrencyCode = in.PaymentAltExTargetCurrencyCode
 	debitNoteHeaderD.PaymentAltExTargetCurrencyBaseRate = in.PaymentAltExTargetCurrencyBaseRate
 	debitNoteHeaderD.PaymentAltExExchangeMarketId = in.PaymentAltExExchangeMarketId
@@ -401,6 +422,11 @@ func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in
 	debitNoteHeaderD.PayableRoundingAmount = in.PayableRoundingAmount
 	debitNoteHeaderD.PayableAmount = in.PayableAmount
 	debitNoteHeaderD.PayableAlternativeAmount = in.PayableAlternativeAmount
+	debitNoteHeaderD.PayableAlternativeCurrencyCode = in.PayableAlternativeCurrencyCode
+	if debitNoteHeaderD.PayableAlternativeCurrencyCode == "" {
+		debitNoteHeaderD.PayableAlternativeCurrencyCode = debitNoteHeaderD.PaymentAltCurrencyCode
+	}
+	debitNoteHeaderD.DocumentStatusCode = string(docstatus.Draft)
 
 	debitNoteHeaderT := invoiceproto.DebitNoteHeaderT{}
 	debitNoteHeaderT.IssueDate = common.TimeToTimestamp(issueDate.UTC().Truncate(time.Second))
@@ -423,6 +449,12 @@ func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in
 
 	debitNoteHeader := invoiceproto.DebitNoteHeader{DebitNoteHeaderD: &debitNoteHeaderD, DebitNoteHeaderT: &debitNoteHeaderT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	debitNoteHeader.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, ds.log, ubl.MasterFlagDebitNoteHeader, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	debitNoteLines := []*invoiceproto.DebitNoteLine{}
 	// we will do for loop on lines which is comes from client form
 	for _, line := range in.DebitNoteLines {
@@ -434,11 +466,46 @@ func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in
 			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 			return nil, err
 		}
+		debitNoteLine.DebitNoteLineD.CurrencyCode = debitNoteHeaderD.DocumentCurrencyCode
 
 		debitNoteLines = append(debitNoteLines, debitNoteLine)
 	}
 
-	err = ds.insertDebitNoteHeader(ctx, insertDebitNoteHeaderSQL, &debitNoteHeader, insertDebitNoteLineSQL, debitNoteLines, in.GetUserEmail(), in.GetRequestId())
+	policy := rounding.ForCurrency(debitNoteHeaderD.DocumentCurrencyCode)
+	taxLines := []TaxLine{}
+	lineExtensionAmount := money.Zero
+	for _, debitNoteLine := range debitNoteLines {
+		ld := debitNoteLine.DebitNoteLineD
+		allowancecharge.CalculateProtos(debitNoteLine.AllowanceCharges, allowancecharge.LineBase(ld.DebitedQuantity, parseAmount(ld.PriceAmount), ld.PriceBaseQuantity, policy), policy)
+		taxLines = append(taxLines, TaxLine{ItemID: ld.ItemId, Quantity: ld.DebitedQuantity, LineExtensionAmount: parseAmount(ld.LineExtensionAmount)})
+		lineExtensionAmount = lineExtensionAmount.Add(parseAmount(ld.LineExtensionAmount))
+	}
+	if len(debitNoteHeader.AllowanceCharges) > 0 {
+		acs := allowancecharge.FromProtos(debitNoteHeader.AllowanceCharges)
+		allowanceTotal, chargeTotal := allowancecharge.Totals(acs, lineExtensionAmount, policy)
+		allowancecharge.SetProtos(debitNoteHeader.AllowanceCharges, acs)
+		debitNoteHeaderD.AllowanceTotalAmount = allowanceTotal.String()
+		debitNoteHeaderD.ChargeTotalAmount = chargeTotal.String()
+		taxLines = append(taxLines, allowanceChargeTaxLines(acs)...)
+	}
+	taxTotal, taxSubTotals, err := generateTaxTotal(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, taxLines, false, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	withholdingTaxTotal, withholdingTaxSubTotals, err := processWithholdingTaxTotal(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, nil, taxLines, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	debitNoteHeaderD.WithholdingTaxTotalAmount = withholdingTaxTotalAmount(withholdingTaxTotal, policy)
+	taxCurrencyTaxTotal, err := processTaxCurrencyTaxTotal(ctx, ds.log, &debitNoteHeaderD, debitNoteHeaderD.DocumentCurrencyCode, debitNoteHeaderD.TaxCurrencyCode, "", taxTotal, taxSubTotals, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ds.insertDebitNoteHeader(ctx, insertDebitNoteHeaderSQL, &debitNoteHeader, insertDebitNoteLineSQL, debitNoteLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, withholdingTaxTotal, withholdingTaxSubTotals, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -449,15 +516,22 @@ func (ds *DebitNoteHeaderService) CreateDebitNoteHeader(ctx context.Context, in
 	return &debitNoteHeaderResponse, nil
 }
 
-func (ds *DebitNoteHeaderService) insertDebitNoteHeader(ctx context.Context, insertDebitNoteHeaderSQL string, debitNoteHeader *invoiceproto.DebitNoteHeader, insertDebitNoteLineSQL string, debitNoteLines []*invoiceproto.DebitNoteLine, userEmail string, requestID string) error {
+func (ds *DebitNoteHeaderService) insertDebitNoteHeader(ctx context.Context, insertDebitNoteHeaderSQL string, debitNoteHeader *invoiceproto.DebitNoteHeader, insertDebitNoteLineSQL string, debitNoteLines []*invoiceproto.DebitNoteLine, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, taxCurrencyTaxTotal *taxproto.TaxTotal, withholdingTaxTotal *taxproto.TaxTotal, withholdingTaxSubTotals []*taxproto.TaxSubTotal, userEmail string, requestID string) error {
 	debitNoteHeaderTmp, err := ds.crDebitNoteHeaderStruct(ctx, debitNoteHeader, userEmail, requestID)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 		return err
 	}
 	err = ds.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		err := numbering.Check(ctx, tx, numbering.DebitNote, debitNoteHeader.DebitNoteHeaderD.AccountingSupplierPartyId, debitNoteHeader.DebitNoteHeaderD.DnhId)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		res, err := tx.NamedExecContext(ctx, insertDebitNoteHeaderSQL, debitNoteHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.DebitNote, err, debitNoteHeader.DebitNoteHeaderD.DnhId)
 			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -475,6 +549,12 @@ func (ds *DebitNoteHeaderService) insertDebitNoteHeader(ctx context.Context, ins
 		}
 		debitNoteHeader.DebitNoteHeaderD.IdS = uuid4Str
 
+		err = allowancecharge.Insert(ctx, ds.log, tx, debitNoteHeader.AllowanceCharges, debitNoteHeader.DebitNoteHeaderD.Id, debitNoteHeader.DebitNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		for _, debitNoteLine := range debitNoteLines {
 			debitNoteLine.DebitNoteLineD.DebitNoteHeaderId = debitNoteHeader.DebitNoteHeaderD.Id
 			debitNoteLineTmp, err := ds.crDebitNoteLineStruct(ctx, debitNoteLine, userEmail, requestID)
@@ -482,12 +562,55 @@ func (ds *DebitNoteHeaderService) insertDebitNoteHeader(ctx context.Context, ins
 				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
-			_, err = tx.NamedExecContext(ctx, insertDebitNoteLineSQL, debitNoteLineTmp)
+			res, err := tx.NamedExecContext(ctx, insertDebitNoteLineSQL, debitNoteLineTmp)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			uID, err := res.LastInsertId()
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+			debitNoteLine.DebitNoteLineD.Id = uint32(uID)
+			debitNoteLine.DebitNoteLineD.IdS, err = common.UUIDBytesToStr(debitNoteLine.DebitNoteLineD.Uuid4)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			err = allowancecharge.Insert(ctx, ds.log, tx, debitNoteLine.AllowanceCharges, debitNoteLine.DebitNoteLineD.Id, debitNoteLine.DebitNoteLineD.CurrencyCode, userEmail, requestID)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if len(taxSubTotals) > 0 {
+			err = insertTaxTotal(ctx, ds.log, tx, taxTotal, taxSubTotals, debitNoteHeader.DebitNoteHeaderD.Id, debitNoteHeader.DebitNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if taxCurrencyTaxTotal != nil {
+			err = insertTaxTotal(ctx, ds.log, tx, taxCurrencyTaxTotal, nil, debitNoteHeader.DebitNoteHeaderD.Id, debitNoteHeader.DebitNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if withholdingTaxTotal != nil {
+			err = insertTaxTotal(ctx, ds.log, tx, withholdingTaxTotal, withholdingTaxSubTotals, debitNoteHeader.DebitNoteHeaderD.Id, debitNoteHeader.DebitNoteHeaderD.DocumentCurrencyCode, userEmail, requestID)
 			if err != nil {
 				ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
 		}
+
 		return nil
 	})
 
@@ -537,7 +660,7 @@ func (ds *DebitNoteHeaderService) GetDebitNoteHeaders(ctx context.Context, in *i
 
 	nselectDebitNoteHeadersSQL := selectDebitNoteHeadersSQL + ` where ` + query
 
-	rows, err := ds.DBService.DB.QueryxContext(ctx, nselectDebitNoteHeadersSQL, "active")
+	rows, err := ds.DBService.Queryer(ctx).QueryxContext(ctx, nselectDebitNoteHeadersSQL, "active")
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -586,7 +709,7 @@ func (ds *DebitNoteHeaderService) GetDebitNoteHeader(ctx context.Context, inReq
 	}
 
 	nselectDebitNoteHeadersSQL := selectDebitNoteHeadersSQL + ` where uuid4 = ? and status_code = ?;`
-	row := ds.DBService.DB.QueryRowxContext(ctx, nselectDebitNoteHeadersSQL, uuid4byte, "active")
+	row := ds.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectDebitNoteHeadersSQL, uuid4byte, "active")
 	debitNoteHeaderTmp := invoicestruct.DebitNoteHeader{}
 	err = row.StructScan(&debitNoteHeaderTmp)
 	if err != nil {
@@ -610,7 +733,7 @@ func (ds *DebitNoteHeaderService) GetDebitNoteHeaderByPk(ctx context.Context, in
 	in := inReq.GetByIdRequest
 
 	nselectDebitNoteHeadersSQL := selectDebitNoteHeadersSQL + ` where id = ? and status_code = ?;`
-	row := ds.DBService.DB.QueryRowxContext(ctx, nselectDebitNoteHeadersSQL, in.Id, "active")
+	row := ds.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectDebitNoteHeadersSQL, in.Id, "active")
 	debitNoteHeaderTmp := invoicestruct.DebitNoteHeader{}
 	err := row.StructScan(&debitNoteHeaderTmp)
 	if err != nil {
@@ -644,6 +767,11 @@ func (ds *DebitNoteHeaderService) getDebitNoteHeaderStruct(ctx context.Context,
 	debitNoteHeaderT.PaymentExDate = common.TimeToTimestamp(debitNoteHeaderTmp.DebitNoteHeaderT.PaymentExDate)
 	debitNoteHeaderT.PaymentAltExDate = common.TimeToTimestamp(debitNoteHeaderTmp.DebitNoteHeaderT.PaymentAltExDate)
 
+	if err := money.Normalize(debitNoteHeaderTmp.DebitNoteHeaderD); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(debitNoteHeaderTmp.DebitNoteHeaderD.Uuid4)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -657,35 +785,109 @@ func (ds *DebitNoteHeaderService) getDebitNoteHeaderStruct(ctx context.Context,
 	crUpdTime.UpdatedAt = common.TimeToTimestamp(debitNoteHeaderTmp.CrUpdTime.UpdatedAt)
 
 	debitNoteHeader := invoiceproto.DebitNoteHeader{DebitNoteHeaderD: debitNoteHeaderTmp.DebitNoteHeaderD, DebitNoteHeaderT: debitNoteHeaderT, CrUpdUser: debitNoteHeaderTmp.CrUpdUser, CrUpdTime: crUpdTime}
+
+	debitNoteHeader.AllowanceCharges, err = allowancecharge.Get(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, debitNoteHeader.DebitNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	return &debitNoteHeader, nil
 }
 
-// UpdateDebitNoteHeader - Update DebitNoteHeader
+// UpdateDebitNoteHeader - Update DebitNoteHeader, which must still be a draft,
+// an issued debit note is revised with ReviseDebitNote
 func (ds *DebitNoteHeaderService) UpdateDebitNoteHeader(ctx context.Context, in *invoiceproto.UpdateDebitNoteHeaderRequest) (*invoiceproto.UpdateDebitNoteHeaderResponse, error) {
+	err := ds.updateDebitNoteHeader(ctx, in, nil)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateDebitNoteHeaderResponse{}, nil
+}
+
+// updateDebitNoteHeader - update a draft debit note as UpdateDebitNoteHeader does,
+// or, with a revision, an issued one, keeping the revised debit note as its
+// next version. The debit note is locked with lockDebitNoteHeader before it
+// is read, so it is read, checked and updated within one transaction.
+func (ds *DebitNoteHeaderService) updateDebitNoteHeader(ctx context.Context, in *invoiceproto.UpdateDebitNoteHeaderRequest, revision *docversion.Revision) error {
+	if err := money.Normalize(in); err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return err
+	}
 	db := ds.DBService.DB
 	tn := common.GetTimeDetails()
 
 	uuid4byte, err := common.UUIDStrToBytes(in.Id)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	stmt, err := db.PreparexContext(ctx, updateDebitNoteHeaderSQL)
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		err := lockDebitNoteHeader(ctx, ds.log, tx, uuid4byte, revision, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: &getRequest})
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		debitNoteHeader := debitNoteHeaderResponse.DebitNoteHeader
+
+		debitNoteLines := []*invoiceproto.DebitNoteLine{}
+		var before []byte
+		if revision != nil {
+			debitNoteLinesResponse, err := ds.GetDebitNoteLines(ctx, &invoiceproto.GetDebitNoteLinesRequest{GetRequest: &getRequest})
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			debitNoteLines = debitNoteLinesResponse.DebitNoteLines
+			before, err = debitNoteSnapshot(debitNoteHeader, debitNoteLines)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		hd := debitNoteHeader.DebitNoteHeaderD
+		hd.Note = in.Note
+		hd.DocumentCurrencyCode = in.DocumentCurrencyCode
+		hd.AccountingCost = in.AccountingCost
+		hd.ChargeTotalAmount = in.ChargeTotalAmount
+		hd.PrepaidAmount = in.PrepaidAmount
+		hd.PayableRoundingAmount = in.PayableRoundingAmount
+		hd.PayableAmount = in.PayableAmount
+		debitNoteHeader.CrUpdTime.UpdatedAt = common.TimeToTimestamp(tn)
+
+		var after []byte
+		if revision != nil {
+			after, err = debitNoteSnapshot(debitNoteHeader, debitNoteLines)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
 		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
-			in.Note,
-			in.DocumentCurrencyCode,
-			in.AccountingCost,
-			in.ChargeTotalAmount,
-			in.PrepaidAmount,
-			in.PayableRoundingAmount,
-			in.PayableAmount,
+			hd.Note,
+			hd.DocumentCurrencyCode,
+			hd.AccountingCost,
+			hd.ChargeTotalAmount,
+			hd.PrepaidAmount,
+			hd.PayableRoundingAmount,
+			hd.PayableAmount,
 			tn,
 			uuid4byte)
 		if err != nil {
@@ -697,19 +899,49 @@ func (ds *DebitNoteHeaderService) UpdateDebitNoteHeader(ctx context.Context, in
 			}
 			return err
 		}
+		if revision != nil {
+			return docversion.InsertVersions(ctx, ds.log, tx, ubl.MasterFlagDebitNoteHeader, hd.Id, before, after, revision, tn, in.GetUserEmail(), in.GetRequestId())
+		}
 		return nil
 	})
 
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = stmt.Close()
 	if err != nil {
 		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
-	return &invoiceproto.UpdateDebitNoteHeaderResponse{}, nil
+	return nil
+}
+
+// selectDebitNoteHeaderStatusByUUIDForUpdateSQL - lock a debit note until the end of the
+// transaction and get its document status
+const selectDebitNoteHeaderStatusByUUIDForUpdateSQL = `select document_status_code from debit_note_headers where uuid4 = ? and status_code = ? for update;`
+
+// lockDebitNoteHeader - lock the debit note uuid4byte until the end of the transaction of
+// its update, before it is read, and check that it is still a draft or,
+// with a revision, that it can be revised, as its status may have changed
+// since the update was asked for
+func lockDebitNoteHeader(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, uuid4byte []byte, revision *docversion.Revision, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectDebitNoteHeaderStatusByUUIDForUpdateSQL, uuid4byte, "active").Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	if revision == nil {
+		err = docstatus.CheckEditable(documentStatusCode)
+	} else {
+		err = docstatus.CheckRevisable(documentStatusCode)
+	}
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
 }
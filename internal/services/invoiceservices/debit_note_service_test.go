@@ -218,31 +218,31 @@ func TestDebitNoteHeaderService_GetDebitNoteLines(t *testing.T) {
 
 	debitNoteHeaderService := NewDebitNoteHeaderService(log, dbService, redisService, userServiceClient)
 	debitNoteLines := []*invoiceproto.DebitNoteLine{}
-	debitNoteLine, err := GetDebitNoteLine(uint32(1), []byte{120, 38, 247, 229, 52, 89, 75, 136, 181, 182, 33, 70, 213, 222, 113, 147}, "7826f7e5-3459-4b88-b5b6-2146d5de7193", "Scratch on box", float64(1), float64(1273), "2005-06-21T10:04:26Z", "BookingCode002", uint32(2), "2005-06-18T10:04:26Z", "2005-06-19T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	debitNoteLine, err := GetDebitNoteLine(uint32(1), []byte{120, 38, 247, 229, 52, 89, 75, 136, 181, 182, 33, 70, 213, 222, 113, 147}, "7826f7e5-3459-4b88-b5b6-2146d5de7193", "Scratch on box", float64(1), "1273.00", "2005-06-21T10:04:26Z", "BookingCode002", uint32(2), "2005-06-18T10:04:26Z", "2005-06-19T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	debitNoteLine2, err := GetDebitNoteLine(uint32(2), []byte{69, 8, 4, 218, 212, 211, 65, 69, 163, 166, 34, 158, 239, 225, 55, 161}, "450804da-d4d3-4145-a3a6-229eefe137a1", "Cover is slightly damaged", float64(1), float64(3.96), "2009-06-21T10:04:26Z", "", uint32(3), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	debitNoteLine2, err := GetDebitNoteLine(uint32(2), []byte{69, 8, 4, 218, 212, 211, 65, 69, 163, 166, 34, 158, 239, 225, 55, 161}, "450804da-d4d3-4145-a3a6-229eefe137a1", "Cover is slightly damaged", float64(1), "3.96", "2009-06-21T10:04:26Z", "", uint32(3), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	debitNoteLine3, err := GetDebitNoteLine(uint32(3), []byte{203, 32, 99, 24, 246, 126, 64, 139, 176, 96, 45, 126, 146, 29, 101, 220}, "cb206318-f67e-408b-b060-2d7e921d65dc", "", float64(2), float64(4.96), "2009-06-21T10:04:26Z", "", uint32(4), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	debitNoteLine3, err := GetDebitNoteLine(uint32(3), []byte{203, 32, 99, 24, 246, 126, 64, 139, 176, 96, 45, 126, 146, 29, 101, 220}, "cb206318-f67e-408b-b060-2d7e921d65dc", "", float64(2), "4.96", "2009-06-21T10:04:26Z", "", uint32(4), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	debitNoteLine4, err := GetDebitNoteLine(uint32(4), []byte{101, 12, 230, 48, 19, 229, 75, 231, 168, 85, 146, 245, 90, 166, 66, 39}, "650ce630-13e5-4be7-a855-92f55aa64227", "", float64(1), float64(25), "2009-06-21T10:04:26Z", "", uint32(5), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	debitNoteLine4, err := GetDebitNoteLine(uint32(4), []byte{101, 12, 230, 48, 19, 229, 75, 231, 168, 85, 146, 245, 90, 166, 66, 39}, "650ce630-13e5-4be7-a855-92f55aa64227", "", float64(1), "25.00", "2009-06-21T10:04:26Z", "", uint32(5), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	debitNoteLine5, err := GetDebitNoteLine(uint32(5), []byte{38, 172, 200, 60, 7, 154, 67, 6, 184, 161, 202, 251, 72, 254, 31, 205}, "26acc83c-079a-4306-b8a1-cafb48fe1fcd", "", float64(250), float64(187.5), "2009-06-21T10:04:26Z", "BookingCode002", uint32(6), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	debitNoteLine5, err := GetDebitNoteLine(uint32(5), []byte{38, 172, 200, 60, 7, 154, 67, 6, 184, 161, 202, 251, 72, 254, 31, 205}, "26acc83c-079a-4306-b8a1-cafb48fe1fcd", "", float64(250), "187.50", "2009-06-21T10:04:26Z", "BookingCode002", uint32(6), "2009-11-08T10:04:26Z", "2009-11-09T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -293,7 +293,7 @@ func TestDebitNoteHeaderService_GetDebitNoteLines(t *testing.T) {
 		debitNoteLineResult := debitNoteLinesResp.DebitNoteLines[0]
 		assert.Equal(t, debitNoteLineResult.DebitNoteLineD.Note, "Scratch on box", "they should be equal")
 		assert.Equal(t, debitNoteLineResult.DebitNoteLineD.DebitedQuantity, float64(1), "they should be equal")
-		assert.Equal(t, debitNoteLineResult.DebitNoteLineD.LineExtensionAmount, float64(1273), "they should be equal")
+		assert.Equal(t, debitNoteLineResult.DebitNoteLineD.LineExtensionAmount, "1273.00", "they should be equal")
 		assert.Equal(t, debitNoteLineResult.DebitNoteLineD.AccountingCost, "BookingCode002", "they should be equal")
 	}
 }
@@ -320,10 +320,10 @@ func TestDebitNoteHeaderService_CreateDebitNoteHeader(t *testing.T) {
 	debitNoteHeader.PricingExDate = "11/25/2022"
 	debitNoteHeader.PaymentExDate = "11/25/2022"
 	debitNoteHeader.PaymentAltExDate = "11/25/2022"
-	debitNoteHeader.ChargeTotalAmount = float64(0)
-	debitNoteHeader.PrepaidAmount = float64(0)
-	debitNoteHeader.PayableRoundingAmount = float64(0)
-	debitNoteHeader.PayableAmount = float64(1250)
+	debitNoteHeader.ChargeTotalAmount = "0"
+	debitNoteHeader.PrepaidAmount = "0"
+	debitNoteHeader.PayableRoundingAmount = "0"
+	debitNoteHeader.PayableAmount = "1250"
 	debitNoteHeader.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	debitNoteHeader.UserEmail = "sprov300@gmail.com"
 	debitNoteHeader.RequestId = "bks1m1g91jau4nkks2f0"
@@ -331,7 +331,7 @@ func TestDebitNoteHeaderService_CreateDebitNoteHeader(t *testing.T) {
 	debitNoteLine := invoiceproto.CreateDebitNoteLineRequest{}
 	debitNoteLine.Note = "Scratch on box"
 	debitNoteLine.DebitedQuantity = float64(1)
-	debitNoteLine.LineExtensionAmount = float64(1250)
+	debitNoteLine.LineExtensionAmount = "1250"
 	debitNoteLine.TaxPointDate = "11/25/2022"
 	debitNoteLine.AccountingCost = "BookingCode002"
 	debitNoteLine.ItemId = uint32(7)
@@ -435,6 +435,7 @@ func GetDebitNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string, n
 	debitNoteHeaderD.Note = note
 	debitNoteHeaderD.DocumentCurrencyCode = documentCurrencyCode
 	debitNoteHeaderD.AccountingCost = accountingCost
+	debitNoteHeaderD.DocumentStatusCode = "draft"
 
 	debitNoteHeaderT := invoiceproto.DebitNoteHeaderT{}
 	debitNoteHeaderT.IssueDate = issueDate1
@@ -460,7 +461,7 @@ func GetDebitNoteHeader(id uint32, uuid4 []byte, idS string, issueDate string, n
 	return &debitNoteHeader, nil
 }
 
-func GetDebitNoteLine(id uint32, uuid4 []byte, idS string, note string, debitedQuantity float64, lineExtensionAmount float64, taxPointDate string, accountingCost string, itemId uint32, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, debitNoteHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.DebitNoteLine, error) {
+func GetDebitNoteLine(id uint32, uuid4 []byte, idS string, note string, debitedQuantity float64, lineExtensionAmount string, taxPointDate string, accountingCost string, itemId uint32, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, debitNoteHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.DebitNoteLine, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -529,10 +530,10 @@ func TestDebitNoteHeaderService_UpdateDebitNoteHeader(t *testing.T) {
 	form := invoiceproto.UpdateDebitNoteHeaderRequest{}
 	form.Note = "Ordered"
 	form.DocumentCurrencyCode = "EUR"
-	form.ChargeTotalAmount = float64(100)
-	form.PrepaidAmount = float64(60)
-	form.PayableRoundingAmount = float64(100)
-	form.PayableAmount = float64(300)
+	form.ChargeTotalAmount = "100"
+	form.PrepaidAmount = "60"
+	form.PayableRoundingAmount = "100"
+	form.PayableAmount = "300"
 	form.Id = "930f8806-db24-4562-b8c7-72df75518355"
 	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	form.UserEmail = "sprov300@gmail.com"
@@ -571,3 +572,171 @@ func TestDebitNoteHeaderService_UpdateDebitNoteHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestDebitNoteHeaderService_GetDebitNoteUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	debitNoteHeaderService := NewDebitNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.GetDebitNoteUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "930f8806-db24-4562-b8c7-72df75518355"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetDebitNoteUBLRequest
+	}
+	tests := []struct {
+		ds      *DebitNoteHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			ds: debitNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		debitNoteUBLResp, err := tt.ds.GetDebitNoteUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DebitNoteHeaderService.GetDebitNoteUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, debitNoteUBLResp)
+		ublStr := string(debitNoteUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<DebitNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:DebitNote-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>930f8806-db24-4562-b8c7-72df75518355</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2005-12-15</cbc:IssueDate>`, "they should be equal")
+	}
+}
+
+func TestDebitNoteHeaderService_ImportDebitNoteUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	debitNoteHeaderService := NewDebitNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<DebitNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:DebitNote-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>DN-IMPORT-1</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:AccountingSupplierParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>Consortial</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>IYT Corporation</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingCustomerParty>
+  <cac:RequestedMonetaryTotal><cbc:PayableAmount currencyID="EUR">100.00</cbc:PayableAmount></cac:RequestedMonetaryTotal>
+  <cac:DebitNoteLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:DebitedQuantity>1</cbc:DebitedQuantity>
+    <cbc:LineExtensionAmount currencyID="EUR">100.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Not in the catalogue</cbc:Name></cac:Item>
+  </cac:DebitNoteLine>
+</DebitNote>`
+
+	form := invoiceproto.ImportDebitNoteUBLRequest{}
+	form.Ubl = []byte(ublDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ImportDebitNoteUBLRequest
+	}
+	tests := []struct {
+		ds      *DebitNoteHeaderService
+		args    args
+		wantErr string
+	}{
+		{
+			ds: debitNoteHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "/DebitNote/cac:DebitNoteLine[1]/cac:Item",
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.ds.ImportDebitNoteUBL(tt.args.ctx, tt.args.in)
+		if err == nil {
+			t.Errorf("DebitNoteHeaderService.ImportDebitNoteUBL() error = nil, want %v", tt.wantErr)
+			return
+		}
+		assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+	}
+}
+
+func TestDebitNoteHeaderService_UpdateDebitNoteLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	debitNoteHeaderService := NewDebitNoteHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.UpdateDebitNoteLineRequest{}
+	form.Note = "sample updated"
+	form.DebitedQuantity = float64(10)
+	form.LineExtensionAmount = "1000.00"
+	form.PriceAmount = "2.50"
+	form.PriceBaseQuantity = float64(1)
+	form.Id = "7826f7e5-3459-4b88-b5b6-2146d5de7193"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = debitNoteHeaderService.UpdateDebitNoteLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "930f8806-db24-4562-b8c7-72df75518355", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	debitNoteLinesResponse, err := debitNoteHeaderService.GetDebitNoteLines(ctx, &invoiceproto.GetDebitNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	debitNoteLineResult := debitNoteLinesResponse.DebitNoteLines[0]
+	assert.Equal(t, debitNoteLineResult.DebitNoteLineD.Note, "sample updated", "they should be equal")
+	assert.Equal(t, debitNoteLineResult.DebitNoteLineD.LineExtensionAmount, "1000.00", "they should be equal")
+
+	debitNoteHeaderResponse, err := debitNoteHeaderService.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.LineCountNumeric, uint32(5), "they should be equal")
+	assert.Equal(t, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.LineExtensionAmount, "1221.42", "they should be equal")
+
+	_, err = debitNoteHeaderService.DeleteDebitNoteLine(ctx, &invoiceproto.DeleteDebitNoteLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	debitNoteHeaderResponse, err = debitNoteHeaderService.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.LineCountNumeric, uint32(4), "they should be equal")
+	assert.Equal(t, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.LineExtensionAmount, "221.42", "they should be equal")
+}
@@ -0,0 +1,80 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// IssueDebitNote - move a draft debit note with lines to issued, from then on it cannot be changed.
+// Without a number of its own it is numbered from the sequence of its supplier.
+func (ds *DebitNoteHeaderService) IssueDebitNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Issued)
+}
+
+// SendDebitNote - move an issued debit note to sent
+func (ds *DebitNoteHeaderService) SendDebitNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Sent)
+}
+
+// AcknowledgeDebitNote - move a sent or disputed debit note to acknowledged
+func (ds *DebitNoteHeaderService) AcknowledgeDebitNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Acknowledged)
+}
+
+// MarkDebitNotePartiallyPaid - move a debit note that is due to partially paid
+func (ds *DebitNoteHeaderService) MarkDebitNotePartiallyPaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.PartiallyPaid)
+}
+
+// MarkDebitNotePaid - move a debit note that is due to paid
+func (ds *DebitNoteHeaderService) MarkDebitNotePaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Paid)
+}
+
+// MarkDebitNoteDisputed - move a debit note that is due to disputed, with the reason
+func (ds *DebitNoteHeaderService) MarkDebitNoteDisputed(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Disputed)
+}
+
+// CancelDebitNote - cancel a debit note that is not paid, not even in part, with the reason
+func (ds *DebitNoteHeaderService) CancelDebitNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return ds.transitionDebitNote(ctx, in, docstatus.Cancelled)
+}
+
+// transitionDebitNote - move the debit note in.Id to the status to
+func (ds *DebitNoteHeaderService) transitionDebitNote(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest, to docstatus.Status) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	debitNoteLinesResponse, err := ds.GetDebitNoteLines(ctx, &invoiceproto.GetDebitNoteLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	hd := debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD
+	doc := statusDocument{MasterFlag: ubl.MasterFlagDebitNoteHeader, ID: hd.Id, DocumentID: hd.DnhId, Numbering: numbering.DebitNote, PartyID: hd.AccountingSupplierPartyId, IssueDate: common.TimestampToTime(debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderT.IssueDate), StatusCode: hd.DocumentStatusCode, LineCount: len(debitNoteLinesResponse.DebitNoteLines)}
+	return transitionDocumentStatus(ctx, ds.log, ds.DBService, ds.UserServiceClient, &doc, to, in)
+}
+
+// GetDebitNoteStatusTransitions - Get the transitions of the status of a debit note,
+// oldest first
+func (ds *DebitNoteHeaderService) GetDebitNoteStatusTransitions(ctx context.Context, inReq *invoiceproto.GetDocumentStatusTransitionsRequest) (*invoiceproto.GetDocumentStatusTransitionsResponse, error) {
+	in := inReq.GetRequest
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: in})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return getDocumentStatusTransitions(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
@@ -0,0 +1,146 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// GetDebitNoteUBL - Get DebitNote as UBL 2.3 DebitNote XML
+func (ds *DebitNoteHeaderService) GetDebitNoteUBL(ctx context.Context, inReq *invoiceproto.GetDebitNoteUBLRequest) (*invoiceproto.GetDebitNoteUBLResponse, error) {
+	in := inReq.GetRequest
+	src, err := ds.getDebitNoteSource(ctx, inReq)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	debitNote, err := ubl.DebitNoteFromSource(src)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ublBytes, err := ubl.Marshal(debitNote)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	debitNoteUBLResponse := invoiceproto.GetDebitNoteUBLResponse{}
+	debitNoteUBLResponse.Ubl = ublBytes
+	return &debitNoteUBLResponse, nil
+}
+
+// getDebitNoteSource - Get DebitNote with the parties, items, taxes,
+// payment terms and references needed to build a UBL document
+func (ds *DebitNoteHeaderService) getDebitNoteSource(ctx context.Context, inReq *invoiceproto.GetDebitNoteUBLRequest) (*ubl.DebitNoteSource, error) {
+	in := inReq.GetRequest
+	form := invoiceproto.GetDebitNoteHeaderRequest{}
+	form.GetRequest = in
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	debitNoteHeader := debitNoteHeaderResponse.DebitNoteHeader
+	hd := debitNoteHeader.DebitNoteHeaderD
+
+	linesForm := invoiceproto.GetDebitNoteLinesRequest{}
+	linesForm.GetRequest = in
+	debitNoteLinesResponse, err := ds.GetDebitNoteLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	debitNoteLines := debitNoteLinesResponse.DebitNoteLines
+
+	keys := ubl.DocumentKeys{
+		HeaderMasterFlag: ubl.MasterFlagDebitNoteHeader,
+		HeaderID:         hd.Id,
+		LineMasterFlag:   ubl.MasterFlagDebitNoteLine,
+		PartyIDs:         []uint32{hd.AccountingSupplierPartyId, hd.AccountingCustomerPartyId, hd.PayeePartyId, hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId, hd.TaxRepresentativePartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder:    {hd.OrderId},
+			ubl.ReferenceDespatch: {hd.DespatchId},
+			ubl.ReferenceReceipt:  {hd.ReceiptId},
+			ubl.ReferenceInvoice:  {hd.BillingId},
+		},
+	}
+	for _, debitNoteLine := range debitNoteLines {
+		ld := debitNoteLine.DebitNoteLineD
+		keys.LineIDs = append(keys.LineIDs, ld.Id)
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+		keys.References[ubl.ReferenceDespatchLine] = append(keys.References[ubl.ReferenceDespatchLine], ld.DespatchLineId)
+		keys.References[ubl.ReferenceReceiptLine] = append(keys.References[ubl.ReferenceReceiptLine], ld.ReceiptLineId)
+		keys.References[ubl.ReferenceInvoice] = append(keys.References[ubl.ReferenceInvoice], ld.BillingId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, ds.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.DebitNoteSource{DebitNoteHeader: debitNoteHeader, DebitNoteLines: debitNoteLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportDebitNoteUBL - Create DebitNote from a UBL 2.3 DebitNote XML document.
+// Unmappable elements are reported as ubl.FieldErrors located by XPath.
+func (ds *DebitNoteHeaderService) ImportDebitNoteUBL(ctx context.Context, in *invoiceproto.ImportDebitNoteUBLRequest) (*invoiceproto.ImportDebitNoteUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	debitNote := ubl.DebitNote{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceDebitNote, &debitNote)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// the parties it creates, the debit note and its payment terms are
+	// written in one transaction
+	var debitNoteHeader *invoiceproto.DebitNoteHeader
+	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(ds.log, ds.DBService, ds.RedisService, ds.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, debitNote.IssueDate)
+		debitNoteImport, err := ubl.ImportDebitNote(ctx, &debitNote, resolver)
+		if err != nil {
+			return err
+		}
+
+		form := debitNoteImport.DebitNote
+		form.UserId = in.UserId
+		form.UserEmail = in.UserEmail
+		form.RequestId = in.RequestId
+		debitNoteHeaderResponse, err := ds.CreateDebitNoteHeader(ctx, form)
+		if err != nil {
+			return err
+		}
+		debitNoteHeader = debitNoteHeaderResponse.DebitNoteHeader
+
+		paymentService := paymentservice.NewPaymentService(ds.log, ds.DBService, ds.RedisService, ds.UserServiceClient)
+		return paymentService.CreateUBLPaymentTerms(ctx, debitNoteImport.PaymentTerms, ubl.MasterFlagDebitNoteHeader, debitNoteHeader.DebitNoteHeaderD.Id, in.UserId, in.UserEmail, in.RequestId)
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importDebitNoteUBLResponse := invoiceproto.ImportDebitNoteUBLResponse{}
+	importDebitNoteUBLResponse.DebitNoteHeader = debitNoteHeader
+	return &importDebitNoteUBLResponse, nil
+}
@@ -0,0 +1,68 @@
+package invoiceservices
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReviseDebitNote - Revise an issued debit note with the changes UpdateDebitNoteHeader makes to a
+// draft one, giving the reason. The revised debit note is kept as its next
+// version, after the version it was issued with.
+func (ds *DebitNoteHeaderService) ReviseDebitNote(ctx context.Context, in *invoiceproto.ReviseDebitNoteRequest) (*invoiceproto.ReviseDebitNoteResponse, error) {
+	updateRequest := in.UpdateDebitNoteHeaderRequest
+	if updateRequest == nil {
+		err := errors.New("docversion: a revision needs the changes it makes")
+		ds.log.Error("Error", zap.Error(err))
+		return nil, err
+	}
+	revision, err := docversion.NewRevision(ctx, ds.UserServiceClient, in.Reason, updateRequest.UserId, updateRequest.UserEmail, updateRequest.RequestId)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err = ds.updateDebitNoteHeader(ctx, updateRequest, revision)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.ReviseDebitNoteResponse{DocumentVersion: revision.Version}, nil
+}
+
+// GetDebitNoteVersions - Get the versions of a debit note, oldest first
+func (ds *DebitNoteHeaderService) GetDebitNoteVersions(ctx context.Context, inReq *commonproto.GetDocumentVersionsRequest) (*commonproto.GetDocumentVersionsResponse, error) {
+	in := inReq.GetRequest
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: in})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.GetVersions(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
+
+// DiffDebitNoteVersions - Get the fields of a debit note that differ between two
+// of its versions
+func (ds *DebitNoteHeaderService) DiffDebitNoteVersions(ctx context.Context, in *commonproto.DiffDocumentVersionsRequest) (*commonproto.DiffDocumentVersionsResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	debitNoteHeaderResponse, err := ds.GetDebitNoteHeader(ctx, &invoiceproto.GetDebitNoteHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.DiffVersions(ctx, ds.log, ds.DBService, ubl.MasterFlagDebitNoteHeader, debitNoteHeaderResponse.DebitNoteHeader.DebitNoteHeaderD.Id, in)
+}
+
+// debitNoteSnapshot - the docversion snapshot of a debit note
+func debitNoteSnapshot(debitNoteHeader *invoiceproto.DebitNoteHeader, debitNoteLines []*invoiceproto.DebitNoteLine) ([]byte, error) {
+	lines := []proto.Message{}
+	for _, debitNoteLine := range debitNoteLines {
+		lines = append(lines, debitNoteLine)
+	}
+	return docversion.Snapshot(debitNoteHeader, lines)
+}
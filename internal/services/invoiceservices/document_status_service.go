@@ -0,0 +1,273 @@
+package invoiceservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// insertDocumentStatusTransitionSQL - insert DocumentStatusTransitionSQL query
+const insertDocumentStatusTransitionSQL = `insert into document_status_transitions
+	  (uuid4,
+master_flag,
+master_id,
+from_status_code,
+to_status_code,
+reason,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:master_flag,
+:master_id,
+:from_status_code,
+:to_status_code,
+:reason,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// selectDocumentStatusTransitionsSQL - select DocumentStatusTransitionsSQL query
+const selectDocumentStatusTransitionsSQL = `select
+id,
+uuid4,
+master_flag,
+master_id,
+from_status_code,
+to_status_code,
+reason,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at from document_status_transitions where master_flag = ? and master_id = ? and status_code = ? order by id;`
+
+// updateDocumentStatusSQL - the update of the status of a document that is
+// still in the status it was read with, by master flag
+var updateDocumentStatusSQL = map[string]string{
+	ubl.MasterFlagInvoiceHeader:    `update invoice_headers set document_status_code = ?, updated_by_user_id = ?, updated_at = ? where id = ? and document_status_code = ?;`,
+	ubl.MasterFlagCreditNoteHeader: `update credit_note_headers set document_status_code = ?, updated_by_user_id = ?, updated_at = ? where id = ? and document_status_code = ?;`,
+	ubl.MasterFlagDebitNoteHeader:  `update debit_note_headers set document_status_code = ?, updated_by_user_id = ?, updated_at = ? where id = ? and document_status_code = ?;`,
+}
+
+// updateDocumentNumberSQL - the update of the number of a document that
+// is numbered when it is issued, by master flag
+var updateDocumentNumberSQL = map[string]string{
+	ubl.MasterFlagInvoiceHeader:    `update invoice_headers set ih_id = ? where id = ?;`,
+	ubl.MasterFlagCreditNoteHeader: `update credit_note_headers set cnh_id = ? where id = ?;`,
+	ubl.MasterFlagDebitNoteHeader:  `update debit_note_headers set dnh_id = ? where id = ?;`,
+}
+
+// statusDocument - an invoice, credit note or debit note as a transition
+// of its status sees it
+type statusDocument struct {
+	MasterFlag string
+	ID         uint32
+	DocumentID string // ih_id, cnh_id or dnh_id
+	Numbering  numbering.Document
+	PartyID    uint32 // the supplier, who numbers the document
+	IssueDate  time.Time
+	StatusCode string
+	LineCount  int
+}
+
+// checkDocumentTransition - an error when doc cannot move to the status to:
+// the lifecycle of the docstatus package does not allow it, it has no
+// lines to be issued, or it is disputed or cancelled without a reason
+func checkDocumentTransition(doc *statusDocument, to docstatus.Status, reason string) error {
+	from, err := docstatus.Parse(doc.StatusCode)
+	if err != nil {
+		return err
+	}
+	if err = docstatus.Transition(from, to); err != nil {
+		return fmt.Errorf("%w: %s", err, doc.DocumentID)
+	}
+	switch to {
+	case docstatus.Issued:
+		if doc.LineCount == 0 {
+			return fmt.Errorf("docstatus: %s has no lines and cannot be issued", doc.DocumentID)
+		}
+	case docstatus.Disputed, docstatus.Cancelled:
+		if strings.TrimSpace(reason) == "" {
+			return fmt.Errorf("docstatus: %s cannot become %s without a reason", doc.DocumentID, to)
+		}
+	}
+	return nil
+}
+
+// checkDocumentNumber - an error when a document to be issued has no
+// number, neither of its own nor from the sequence of its supplier
+func checkDocumentNumber(documentID string) error {
+	if strings.TrimSpace(documentID) == "" {
+		return fmt.Errorf("docstatus: a document without an id cannot be issued")
+	}
+	return nil
+}
+
+// transitionDocumentStatus - move doc to the status to when
+// checkDocumentTransition allows it, and record the transition. The
+// update fails when the status of doc changed since it was read. A
+// document that is issued without a number of its own gets the next number
+// of the sequence of its supplier in the same transaction.
+func transitionDocumentStatus(ctx context.Context, log *zap.Logger, dbService *common.DBService, userServiceClient partyproto.UserServiceClient, doc *statusDocument, to docstatus.Status, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	if err := checkDocumentTransition(doc, to, in.Reason); err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, userServiceClient)
+	if err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	documentStatusTransitionD := invoiceproto.DocumentStatusTransitionD{}
+	documentStatusTransitionD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	documentStatusTransitionD.MasterFlag = doc.MasterFlag
+	documentStatusTransitionD.MasterId = doc.ID
+	documentStatusTransitionD.FromStatusCode = doc.StatusCode
+	documentStatusTransitionD.ToStatusCode = string(to)
+	documentStatusTransitionD.Reason = in.Reason
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = user.Id
+	crUpdUser.UpdatedByUserId = user.Id
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	documentStatusTransition := invoiceproto.DocumentStatusTransition{DocumentStatusTransitionD: &documentStatusTransitionD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+
+	err = dbService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, updateDocumentStatusSQL[doc.MasterFlag], string(to), user.Id, ttime, doc.ID, doc.StatusCode)
+		if err != nil {
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		if rows != 1 {
+			err = fmt.Errorf("docstatus: %s is no longer %s", doc.DocumentID, doc.StatusCode)
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		if to == docstatus.Issued {
+			err = issueDocumentNumber(ctx, tx, doc)
+			if err != nil {
+				log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		documentStatusTransitionTmp := invoicestruct.DocumentStatusTransition{DocumentStatusTransitionD: &documentStatusTransitionD, CrUpdUser: &crUpdUser, CrUpdTime: crUpdTimeStruct(&crUpdTime)}
+		res, err = tx.NamedExecContext(ctx, insertDocumentStatusTransitionSQL, &documentStatusTransitionTmp)
+		if err != nil {
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		uID, err := res.LastInsertId()
+		if err != nil {
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		documentStatusTransitionD.Id = uint32(uID)
+		documentStatusTransitionD.IdS, err = common.UUIDBytesToStr(documentStatusTransitionD.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	return &invoiceproto.TransitionDocumentStatusResponse{DocumentStatusTransition: &documentStatusTransition}, nil
+}
+
+// issueDocumentNumber - number doc, being issued in tx, with its own
+// number or else the next number of the sequence of its supplier
+func issueDocumentNumber(ctx context.Context, tx *sqlx.Tx, doc *statusDocument) error {
+	documentID, err := numbering.Assign(ctx, tx, doc.Numbering, doc.PartyID, doc.DocumentID, doc.IssueDate)
+	if err != nil {
+		return err
+	}
+	if err = checkDocumentNumber(documentID); err != nil {
+		return err
+	}
+	if documentID == doc.DocumentID {
+		return nil
+	}
+	_, err = tx.ExecContext(ctx, updateDocumentNumberSQL[doc.MasterFlag], documentID, doc.ID)
+	if err != nil {
+		return numbering.UsedError(doc.Numbering, err, documentID)
+	}
+	doc.DocumentID = documentID
+	return nil
+}
+
+// getDocumentStatusTransitions - the transitions of the status of a
+// document, oldest first
+func getDocumentStatusTransitions(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, masterID uint32, userEmail string, requestID string) (*invoiceproto.GetDocumentStatusTransitionsResponse, error) {
+	rows, err := dbService.DB.QueryxContext(ctx, selectDocumentStatusTransitionsSQL, masterFlag, masterID, "active")
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	documentStatusTransitions := []*invoiceproto.DocumentStatusTransition{}
+	for rows.Next() {
+		documentStatusTransitionTmp := invoicestruct.DocumentStatusTransition{}
+		err = rows.StructScan(&documentStatusTransitionTmp)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		documentStatusTransitionTmp.DocumentStatusTransitionD.IdS, err = common.UUIDBytesToStr(documentStatusTransitionTmp.DocumentStatusTransitionD.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+
+		crUpdTime := new(commonproto.CrUpdTime)
+		crUpdTime.CreatedAt = common.TimeToTimestamp(documentStatusTransitionTmp.CrUpdTime.CreatedAt)
+		crUpdTime.UpdatedAt = common.TimeToTimestamp(documentStatusTransitionTmp.CrUpdTime.UpdatedAt)
+
+		documentStatusTransitions = append(documentStatusTransitions, &invoiceproto.DocumentStatusTransition{DocumentStatusTransitionD: documentStatusTransitionTmp.DocumentStatusTransitionD, CrUpdUser: documentStatusTransitionTmp.CrUpdUser, CrUpdTime: crUpdTime})
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.GetDocumentStatusTransitionsResponse{DocumentStatusTransitions: documentStatusTransitions}, nil
+}
@@ -0,0 +1,65 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/cii"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"go.uber.org/zap"
+)
+
+// GetInvoiceCII - Get Invoice as UN/CEFACT Cross Industry Invoice D16B XML,
+// built from the same data as GetInvoiceUBL
+func (is *InvoiceService) GetInvoiceCII(ctx context.Context, inReq *invoiceproto.GetInvoiceCIIRequest) (*invoiceproto.GetInvoiceCIIResponse, error) {
+	in := inReq.GetRequest
+	src, err := is.getInvoiceSource(ctx, &invoiceproto.GetInvoiceUBLRequest{GetRequest: in})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	crossIndustryInvoice, err := cii.InvoiceFromSource(src)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ciiBytes, err := cii.Marshal(crossIndustryInvoice)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	invoiceCIIResponse := invoiceproto.GetInvoiceCIIResponse{}
+	invoiceCIIResponse.Cii = ciiBytes
+	return &invoiceCIIResponse, nil
+}
+
+// ImportInvoiceCII - Create Invoice from a UN/CEFACT Cross Industry Invoice
+// D16B XML document. CII values that cannot be mapped are reported as
+// ubl.FieldErrors located by their CII XPath, parties and items that
+// cannot be resolved by the XPath of the equivalent UBL element.
+func (is *InvoiceService) ImportInvoiceCII(ctx context.Context, in *invoiceproto.ImportInvoiceCIIRequest) (*invoiceproto.ImportInvoiceCIIResponse, error) {
+	crossIndustryInvoice := cii.CrossIndustryInvoice{}
+	err := cii.Unmarshal(in.Cii, &crossIndustryInvoice)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	invoice, err := cii.ToInvoice(&crossIndustryInvoice)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	invoiceHeader, err := is.importInvoice(ctx, invoice, in.UserId, in.UserEmail, in.RequestId)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importInvoiceCIIResponse := invoiceproto.ImportInvoiceCIIResponse{}
+	importInvoiceCIIResponse.InvoiceHeader = invoiceHeader
+	return &importInvoiceCIIResponse, nil
+}
@@ -0,0 +1,38 @@
+package invoiceservices
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/facturx"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"go.uber.org/zap"
+)
+
+// GetInvoiceFacturX - Get Invoice as a Factur-X / ZUGFeRD PDF/A-3 with the
+// CII XML of GetInvoiceCII embedded as factur-x.xml, reduced to the
+// requested Factur-X profile (EN16931 when empty)
+func (is *InvoiceService) GetInvoiceFacturX(ctx context.Context, inReq *invoiceproto.GetInvoiceFacturXRequest) (*invoiceproto.GetInvoiceFacturXResponse, error) {
+	in := inReq.GetRequest
+	profile, err := facturx.ParseProfile(inReq.Profile)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	src, err := is.getInvoiceSource(ctx, &invoiceproto.GetInvoiceUBLRequest{GetRequest: in})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	pdf, err := facturx.InvoiceFromSource(src, profile, time.Now().UTC())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	invoiceFacturXResponse := invoiceproto.GetInvoiceFacturXResponse{}
+	invoiceFacturXResponse.Pdf = pdf
+	return &invoiceFacturXResponse, nil
+}
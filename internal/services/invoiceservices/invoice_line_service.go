@@ -2,14 +2,19 @@ package invoiceservices
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -19,6 +24,7 @@ const insertInvoiceLineSQL = `insert into invoice_lines
 il_id,
 note,
 invoiced_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -31,6 +37,7 @@ billing_id,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -53,6 +60,7 @@ updated_at)
 :il_id,
 :note,
 :invoiced_quantity,
+:unit_code,
 :line_extension_amount,
 :accounting_cost_code,
 :accounting_cost,
@@ -65,6 +73,7 @@ updated_at)
 :originator_party_id,
 :item_id,
 :price_amount,
+:currency_code,
 :price_base_quantity,
 :price_change_reason,
 :price_type_code,
@@ -89,6 +98,7 @@ uuid4,
 il_id,
 note,
 invoiced_quantity,
+unit_code,
 line_extension_amount,
 accounting_cost_code,
 accounting_cost,
@@ -101,6 +111,7 @@ billing_id,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -119,8 +130,16 @@ updated_by_user_id,
 created_at,
 updated_at from invoice_lines`
 
-// CreateInvoiceLine - Create InvoiceLine
+// CreateInvoiceLine - Create InvoiceLine of a draft invoice. Its line
+// extension amount is calculated as Totals does, the line count, TaxTotals
+// and monetary totals of the invoice are calculated again with it and kept
+// in the transaction of the insert, which locks the invoice before it reads
+// it.
 func (is *InvoiceService) CreateInvoiceLine(ctx context.Context, in *invoiceproto.CreateInvoiceLineRequest) (*invoiceproto.CreateInvoiceLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	invoiceLine, err := is.ProcessInvoiceLineRequest(ctx, in)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -199,6 +218,10 @@ func (is *InvoiceService) ProcessInvoiceLineRequest(ctx context.Context, in *inv
 	invoiceLineD.IlId = in.IlId
 	invoiceLineD.Note = in.Note
 	invoiceLineD.InvoicedQuantity = in.InvoicedQuantity
+	invoiceLineD.UnitCode = in.UnitCode
+	if invoiceLineD.UnitCode == "" {
+		invoiceLineD.UnitCode = ubl.UnitCodeOne
+	}
 	invoiceLineD.LineExtensionAmount = in.LineExtensionAmount
 	invoiceLineD.AccountingCostCode = in.AccountingCostCode
 	invoiceLineD.AccountingCost = in.AccountingCost
@@ -236,16 +259,47 @@ func (is *InvoiceService) ProcessInvoiceLineRequest(ctx context.Context, in *inv
 
 	invoiceLine := invoiceproto.InvoiceLine{InvoiceLineD: &invoiceLineD, InvoiceLineT: &invoiceLineT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	invoiceLine.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, is.log, ubl.MasterFlagInvoiceLine, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &invoiceLine, nil
 }
 
+// insertInvoiceLine - insert invoiceLine with its line extension amount
+// calculated. Within an invoice, which must still be a draft, it is
+// calculated with the invoice, whose line count, TaxTotals and monetary
+// totals are kept in the same transaction.
 func (is *InvoiceService) insertInvoiceLine(ctx context.Context, insertInvoiceLineSQL string, invoiceLine *invoiceproto.InvoiceLine, userEmail string, requestID string) error {
-	invoiceLineTmp, err := is.crInvoiceLineStruct(ctx, invoiceLine, userEmail, requestID)
-	if err != nil {
-		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
-		return err
-	}
-	err = is.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+	err := is.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		var invoiceHeader *invoiceproto.InvoiceHeader
+		var taxTotals *documentTaxTotals
+		if invoiceLine.InvoiceLineD.InvoiceHeaderId != 0 {
+			var invoiceLines []*invoiceproto.InvoiceLine
+			var err error
+			invoiceHeader, invoiceLines, err = is.getDraftInvoice(ctx, tx, invoiceLine.InvoiceLineD.InvoiceHeaderId, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+			invoiceLine.InvoiceLineD.CurrencyCode = invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode
+			taxTotals, err = is.calculateInvoiceLineTotals(ctx, invoiceHeader, append(invoiceLines, invoiceLine), invoiceLine.CrUpdUser.CreatedByUserId, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		} else {
+			calculateInvoiceLine(invoiceLine)
+		}
+
+		invoiceLineTmp, err := is.crInvoiceLineStruct(ctx, invoiceLine, userEmail, requestID)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
 		res, err := tx.NamedExecContext(ctx, insertInvoiceLineSQL, invoiceLineTmp)
 		if err != nil {
 			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
@@ -264,6 +318,15 @@ func (is *InvoiceService) insertInvoiceLine(ctx context.Context, insertInvoiceLi
 			return err
 		}
 		invoiceLine.InvoiceLineD.IdS = uuid4Str
+
+		err = allowancecharge.Insert(ctx, is.log, tx, invoiceLine.AllowanceCharges, invoiceLine.InvoiceLineD.Id, invoiceLine.InvoiceLineD.CurrencyCode, userEmail, requestID)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		if invoiceHeader != nil {
+			return updateInvoiceTotals(ctx, is.log, tx, invoiceHeader, taxTotals, common.GetTimeDetails(), userEmail, requestID)
+		}
 		return nil
 	})
 
@@ -312,7 +375,7 @@ func (is *InvoiceService) GetInvoiceLines(ctx context.Context, inReq *invoicepro
 	invoiceLines := []*invoiceproto.InvoiceLine{}
 
 	nselectInvoiceLinesSQL := selectInvoiceLinesSQL + ` where invoice_header_id = ? and status_code = ?;`
-	rows, err := is.DBService.DB.QueryxContext(ctx, nselectInvoiceLinesSQL, invoiceHeader.InvoiceHeaderD.Id, "active")
+	rows, err := is.DBService.Queryer(ctx).QueryxContext(ctx, nselectInvoiceLinesSQL, invoiceHeader.InvoiceHeaderD.Id, "active")
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -348,6 +411,11 @@ func (is *InvoiceService) getInvoiceLineStruct(ctx context.Context, in *commonpr
 	invoiceLineT.PriceValidityPeriodStartDate = common.TimeToTimestamp(invoiceLineTmp.InvoiceLineT.PriceValidityPeriodStartDate)
 	invoiceLineT.PriceValidityPeriodEndDate = common.TimeToTimestamp(invoiceLineTmp.InvoiceLineT.PriceValidityPeriodEndDate)
 
+	if err := money.Normalize(invoiceLineTmp.InvoiceLineD); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(invoiceLineTmp.InvoiceLineD.Uuid4)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -362,5 +430,264 @@ func (is *InvoiceService) getInvoiceLineStruct(ctx context.Context, in *commonpr
 
 	invoiceLine := invoiceproto.InvoiceLine{InvoiceLineD: invoiceLineTmp.InvoiceLineD, InvoiceLineT: invoiceLineT, CrUpdUser: invoiceLineTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	invoiceLine.AllowanceCharges, err = allowancecharge.Get(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceLine, invoiceLine.InvoiceLineD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &invoiceLine, nil
 }
+
+// selectInvoiceLineHeaderIDSQL - the invoice of an InvoiceLine
+const selectInvoiceLineHeaderIDSQL = `select invoice_header_id from invoice_lines where uuid4 = ? and status_code = ?;`
+
+// updateInvoiceLineSQL - update InvoiceLineSQL query
+const updateInvoiceLineSQL = `update invoice_lines set 
+note = ?, 
+invoiced_quantity = ?, 
+line_extension_amount = ?, 
+accounting_cost = ?, 
+price_amount = ?, 
+price_base_quantity = ?, 
+updated_at = ? where id = ?;`
+
+// deleteInvoiceLineSQL - delete InvoiceLineSQL query
+const deleteInvoiceLineSQL = `update invoice_lines set 
+status_code = ?, 
+updated_at = ? where id = ?;`
+
+// updateInvoiceTotalsSQL - update the line count and monetary totals of an
+// invoice
+const updateInvoiceTotalsSQL = `update invoice_headers set 
+line_count_numeric = ?, 
+line_extension_amount = ?, 
+allowance_total_amount = ?, 
+charge_total_amount = ?, 
+tax_exclusive_amount = ?, 
+tax_inclusive_amount = ?, 
+withholding_tax_total_amount = ?, 
+payable_rounding_amount = ?,
+payable_amount = ?, updated_at = ? where id = ?;`
+
+// UpdateInvoiceLine - Update InvoiceLine of a draft invoice. Its line
+// extension amount, the TaxTotals and the line count and monetary totals of
+// the invoice are calculated again and kept in the same transaction, which
+// locks the invoice before it reads it.
+func (is *InvoiceService) UpdateInvoiceLine(ctx context.Context, in *invoiceproto.UpdateInvoiceLineRequest) (*invoiceproto.UpdateInvoiceLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err := is.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		invoiceHeader, invoiceLines, i, err := is.getDraftInvoiceOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		invoiceLine := invoiceLines[i]
+		ld := invoiceLine.InvoiceLineD
+		ld.Note = in.Note
+		ld.InvoicedQuantity = in.InvoicedQuantity
+		ld.PriceAmount = in.PriceAmount
+		ld.PriceBaseQuantity = in.PriceBaseQuantity
+		ld.AccountingCost = in.AccountingCost
+
+		taxTotals, err := is.calculateInvoiceLineTotals(ctx, invoiceHeader, invoiceLines, in.UserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, updateInvoiceLineSQL,
+			ld.Note,
+			ld.InvoicedQuantity,
+			ld.LineExtensionAmount,
+			ld.AccountingCost,
+			ld.PriceAmount,
+			ld.PriceBaseQuantity,
+			tn,
+			ld.Id)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		err = allowancecharge.UpdateAmounts(ctx, is.log, tx, invoiceLine.AllowanceCharges, tn, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateInvoiceTotals(ctx, is.log, tx, invoiceHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateInvoiceLineResponse{}, nil
+}
+
+// DeleteInvoiceLine - Delete InvoiceLine of a draft invoice, the TaxTotals
+// and the line count and monetary totals of the invoice are calculated
+// again without it and kept in the same transaction
+func (is *InvoiceService) DeleteInvoiceLine(ctx context.Context, inReq *invoiceproto.DeleteInvoiceLineRequest) (*invoiceproto.DeleteInvoiceLineResponse, error) {
+	in := inReq.GetRequest
+	err := is.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		invoiceHeader, invoiceLines, i, err := is.getDraftInvoiceOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		invoiceLineID := invoiceLines[i].InvoiceLineD.Id
+		invoiceLines = append(invoiceLines[:i], invoiceLines[i+1:]...)
+		taxTotals, err := is.calculateInvoiceLineTotals(ctx, invoiceHeader, invoiceLines, invoiceHeader.CrUpdUser.UpdatedByUserId, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, deleteInvoiceLineSQL, "inactive", tn, invoiceLineID)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updateInvoiceTotals(ctx, is.log, tx, invoiceHeader, taxTotals, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.DeleteInvoiceLineResponse{}, nil
+}
+
+// getDraftInvoiceOfLine - the invoice of the InvoiceLine invoiceLineID,
+// read with getDraftInvoice, with its lines and the index of the line
+// among them
+func (is *InvoiceService) getDraftInvoiceOfLine(ctx context.Context, tx *sqlx.Tx, invoiceLineID string, userEmail string, requestID string) (*invoiceproto.InvoiceHeader, []*invoiceproto.InvoiceLine, int, error) {
+	uuid4byte, err := common.UUIDStrToBytes(invoiceLineID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	var invoiceHeaderID uint32
+	err = tx.QueryRowxContext(ctx, selectInvoiceLineHeaderIDSQL, uuid4byte, "active").Scan(&invoiceHeaderID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	invoiceHeader, invoiceLines, err := is.getDraftInvoice(ctx, tx, invoiceHeaderID, userEmail, requestID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	for i, invoiceLine := range invoiceLines {
+		if invoiceLine.InvoiceLineD.IdS == invoiceLineID {
+			return invoiceHeader, invoiceLines, i, nil
+		}
+	}
+	return nil, nil, 0, sql.ErrNoRows
+}
+
+// getDraftInvoice - the invoice invoiceHeaderID, locked with
+// lockDraftInvoice within tx, the transaction of ctx, so that it is still
+// a draft, with its lines, read after the lock
+func (is *InvoiceService) getDraftInvoice(ctx context.Context, tx *sqlx.Tx, invoiceHeaderID uint32, userEmail string, requestID string) (*invoiceproto.InvoiceHeader, []*invoiceproto.InvoiceLine, error) {
+	err := lockDraftInvoice(ctx, is.log, tx, invoiceHeaderID, userEmail, requestID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+
+	getByIDRequest := commonproto.GetByIdRequest{Id: invoiceHeaderID, UserEmail: userEmail, RequestId: requestID}
+	invoiceHeaderResponse, err := is.GetInvoiceByPk(ctx, &invoiceproto.GetInvoiceByPkRequest{GetByIdRequest: &getByIDRequest})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	invoiceHeader := invoiceHeaderResponse.InvoiceHeader
+
+	getRequest := commonproto.GetRequest{Id: invoiceHeader.InvoiceHeaderD.IdS, UserEmail: userEmail, RequestId: requestID}
+	invoiceLinesResponse, err := is.GetInvoiceLines(ctx, &invoiceproto.GetInvoiceLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	return invoiceHeader, invoiceLinesResponse.InvoiceLines, nil
+}
+
+// calculateInvoiceLineTotals - generate the TaxTotals of the invoice again
+// from invoiceLines, its lines after a line is changed, and set the line
+// extension amounts and the amounts of the allowances and charges of the
+// lines and of the invoice and its line count and monetary totals to the
+// ones calculated with them
+func (is *InvoiceService) calculateInvoiceLineTotals(ctx context.Context, invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine, userID string, userEmail string, requestID string) (*documentTaxTotals, error) {
+	hd := invoiceHeader.InvoiceHeaderD
+	taxTotals, err := generateDocumentTaxTotals(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, hd, hd.DocumentCurrencyCode, hd.TaxCurrencyCode, invoiceTaxLines(invoiceHeader, invoiceLines), userID, userEmail, requestID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	err = calculateInvoiceTotals(invoiceHeader, invoiceLines, taxTotals.TaxTotal, taxTotals.WithholdingTaxTotal, false)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	hd.LineCountNumeric = uint32(len(invoiceLines))
+	return taxTotals, nil
+}
+
+// selectInvoiceStatusForUpdateSQL - lock an invoice until the end of the
+// transaction and get its document status
+const selectInvoiceStatusForUpdateSQL = `select document_status_code from invoice_headers where id = ? for update;`
+
+// lockDraftInvoice - lock the invoice invoiceHeaderID until the end of the
+// transaction of a change to its lines and check that it is still a draft,
+// as it may have been issued since it was read
+func lockDraftInvoice(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, invoiceHeaderID uint32, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectInvoiceStatusForUpdateSQL, invoiceHeaderID).Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = docstatus.CheckEditable(documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// updateInvoiceTotals - keep the line count and monetary totals of an
+// invoice, the amounts of its allowances and charges and taxTotals, its
+// TaxTotals, within the transaction of the change to its lines
+func updateInvoiceTotals(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, invoiceHeader *invoiceproto.InvoiceHeader, taxTotals *documentTaxTotals, tn time.Time, userEmail string, requestID string) error {
+	hd := invoiceHeader.InvoiceHeaderD
+	_, err := tx.ExecContext(ctx, updateInvoiceTotalsSQL,
+		hd.LineCountNumeric,
+		hd.LineExtensionAmount,
+		hd.AllowanceTotalAmount,
+		hd.ChargeTotalAmount,
+		hd.TaxExclusiveAmount,
+		hd.TaxInclusiveAmount,
+		hd.WithholdingTaxTotalAmount,
+		hd.PayableRoundingAmount,
+		hd.PayableAmount,
+		tn,
+		hd.Id)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = allowancecharge.UpdateAmounts(ctx, log, tx, invoiceHeader.AllowanceCharges, tn, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return replaceTaxTotals(ctx, log, tx, ubl.MasterFlagInvoiceHeader, hd.Id, hd.DocumentCurrencyCode, taxTotals, tn, userEmail, requestID)
+}
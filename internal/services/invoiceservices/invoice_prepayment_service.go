@@ -0,0 +1,180 @@
+package invoiceservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// invoiceTypeCodePrepayment - UNTDID 1001 code of a prepayment invoice,
+// an invoice for an advance payment
+const invoiceTypeCodePrepayment = "386"
+
+// insertInvoicePrepaymentSQL - insert InvoicePrepaymentSQL query
+const insertInvoicePrepaymentSQL = `insert into invoice_prepayments
+	  (uuid4,
+invoice_header_id,
+prepayment_invoice_header_id,
+prepaid_amount,
+currency_code,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:invoice_header_id,
+:prepayment_invoice_header_id,
+:prepaid_amount,
+:currency_code,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// selectSettledPrepaidAmountSQL - the part of an advance payment invoice
+// settled by invoices
+const selectSettledPrepaidAmountSQL = `select coalesce(sum(prepaid_amount), 0) from invoice_prepayments where prepayment_invoice_header_id = ? and status_code = ?;`
+
+// processInvoicePrepaymentRequests - the InvoicePrepayments of an invoice
+// in the document currency of header, each settling a part of an advance
+// payment invoice, and their sum, the prepaid amount of the invoice. A
+// request without a prepaid amount settles what is left of the payable
+// amount of its advance payment invoice. invoice_header_id is set when the
+// invoice is inserted.
+func (is *InvoiceService) processInvoicePrepaymentRequests(ctx context.Context, header *invoiceproto.InvoiceHeaderD, in []*invoiceproto.CreateInvoicePrepaymentRequest, userID string, userEmail string, requestID string) ([]*invoiceproto.InvoicePrepayment, money.Amount, error) {
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = userID
+	crUpdUser.UpdatedByUserId = userID
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	prepaidAmount := money.Zero
+	settled := make(map[uint32]money.Amount)
+	invoicePrepayments := []*invoiceproto.InvoicePrepayment{}
+	for _, prepaymentRequest := range in {
+		getRequest := commonproto.GetRequest{Id: prepaymentRequest.PrepaymentInvoiceId, UserEmail: userEmail, RequestId: requestID}
+		invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, money.Zero, err
+		}
+		prepaymentHeader := invoiceResponse.InvoiceHeader.InvoiceHeaderD
+		if prepaymentHeader.InvoiceTypeCode != invoiceTypeCodePrepayment {
+			err = fmt.Errorf("prepayment: invoice %s is not an advance payment invoice", prepaymentHeader.IhId)
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, money.Zero, err
+		}
+		if !strings.EqualFold(prepaymentHeader.DocumentCurrencyCode, header.DocumentCurrencyCode) {
+			err = fmt.Errorf("prepayment: advance payment invoice %s is in %s, not %s", prepaymentHeader.IhId, prepaymentHeader.DocumentCurrencyCode, header.DocumentCurrencyCode)
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, money.Zero, err
+		}
+
+		if _, ok := settled[prepaymentHeader.Id]; !ok {
+			settled[prepaymentHeader.Id], err = is.getSettledPrepaidAmount(ctx, prepaymentHeader.Id, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return nil, money.Zero, err
+			}
+		}
+		amount, err := settlePrepayment(prepaymentHeader, settled[prepaymentHeader.Id], parseAmount(prepaymentRequest.PrepaidAmount))
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, money.Zero, err
+		}
+		settled[prepaymentHeader.Id] = settled[prepaymentHeader.Id].Add(amount)
+		prepaidAmount = prepaidAmount.Add(amount)
+
+		invoicePrepaymentD := invoiceproto.InvoicePrepaymentD{}
+		invoicePrepaymentD.Uuid4, err = common.GetUUIDBytes()
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, money.Zero, err
+		}
+		invoicePrepaymentD.PrepaymentInvoiceHeaderId = prepaymentHeader.Id
+		invoicePrepaymentD.PrepaidAmount = amount.String()
+		invoicePrepaymentD.CurrencyCode = header.DocumentCurrencyCode
+
+		invoicePrepayment := invoiceproto.InvoicePrepayment{InvoicePrepaymentD: &invoicePrepaymentD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+		invoicePrepayments = append(invoicePrepayments, &invoicePrepayment)
+	}
+	return invoicePrepayments, prepaidAmount, nil
+}
+
+// settlePrepayment - the amount an invoice settles of the advance payment
+// invoice prepayment of which settled is already settled: amount, or what
+// is left of its payable amount when amount is zero. An error when nothing
+// is left or amount is more than what is left.
+func settlePrepayment(prepayment *invoiceproto.InvoiceHeaderD, settled money.Amount, amount money.Amount) (money.Amount, error) {
+	left := parseAmount(prepayment.PayableAmount).Sub(settled)
+	if left <= money.Zero {
+		return money.Zero, fmt.Errorf("prepayment: advance payment invoice %s is settled", prepayment.IhId)
+	}
+	if amount == money.Zero {
+		return left, nil
+	}
+	if amount < money.Zero || amount > left {
+		return money.Zero, fmt.Errorf("prepayment: prepaid amount %s of advance payment invoice %s is not between 0 and the %s left to settle", amount.Text(2), prepayment.IhId, left.Text(2))
+	}
+	return amount, nil
+}
+
+// getSettledPrepaidAmount - the part of the advance payment invoice
+// prepaymentInvoiceHeaderID settled by stored invoices
+func (is *InvoiceService) getSettledPrepaidAmount(ctx context.Context, prepaymentInvoiceHeaderID uint32, userEmail string, requestID string) (money.Amount, error) {
+	var settled string
+	err := is.DBService.DB.QueryRowxContext(ctx, selectSettledPrepaidAmountSQL, prepaymentInvoiceHeaderID, "active").Scan(&settled)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return money.Zero, err
+	}
+	amount, err := money.Parse(settled)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return money.Zero, err
+	}
+	return amount, nil
+}
+
+// insertInvoicePrepayments - insert the InvoicePrepayments of the invoice
+// invoiceHeaderID within its transaction
+func insertInvoicePrepayments(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, invoicePrepayments []*invoiceproto.InvoicePrepayment, invoiceHeaderID uint32, userEmail string, requestID string) error {
+	for _, invoicePrepayment := range invoicePrepayments {
+		invoicePrepayment.InvoicePrepaymentD.InvoiceHeaderId = invoiceHeaderID
+		invoicePrepaymentTmp := invoicestruct.InvoicePrepayment{InvoicePrepaymentD: invoicePrepayment.InvoicePrepaymentD, CrUpdUser: invoicePrepayment.CrUpdUser, CrUpdTime: crUpdTimeStruct(invoicePrepayment.CrUpdTime)}
+		res, err := tx.NamedExecContext(ctx, insertInvoicePrepaymentSQL, &invoicePrepaymentTmp)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
+		uID, err := res.LastInsertId()
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		invoicePrepayment.InvoicePrepaymentD.Id = uint32(uID)
+		invoicePrepayment.InvoicePrepaymentD.IdS, err = common.UUIDBytesToStr(invoicePrepayment.InvoicePrepaymentD.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package invoiceservices
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// ValidateInvoice - check a CreateInvoiceRequest against the EN 16931
+// business rules without storing it, after its totals are calculated as
+// CreateInvoice does. An invoice that breaks rules is not an
+// error, the violations are listed in the response.
+func (is *InvoiceService) ValidateInvoice(ctx context.Context, in *invoiceproto.ValidateInvoiceRequest) (*invoiceproto.ValidateInvoiceResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	form := in.GetInvoice()
+	if form == nil {
+		form = &invoiceproto.CreateInvoiceRequest{}
+	}
+	form.UserId = in.UserId
+	form.UserEmail = in.UserEmail
+	form.RequestId = in.RequestId
+
+	invoiceHeader, invoiceLines, taxTotal, taxSubTotals, withholdingTaxTotal, _, err := is.processInvoiceRequest(ctx, form)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	validateInvoiceResponse := invoiceproto.ValidateInvoiceResponse{}
+	err = calculateInvoiceTotals(invoiceHeader, invoiceLines, taxTotal, withholdingTaxTotal, form.RejectMismatchedTotals)
+	if err == nil {
+		var taxCurrencyTaxTotal *taxproto.TaxTotal
+		taxCurrencyTaxTotal, err = processTaxCurrencyTaxTotal(ctx, is.log, invoiceHeader.InvoiceHeaderD, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, invoiceHeader.InvoiceHeaderD.TaxCurrencyCode, form.TaxCurrencyTaxAmount, taxTotal, taxSubTotals, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		err = is.checkInvoiceRules(ctx, invoiceHeader, invoiceLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, in.GetUserEmail(), in.GetRequestId())
+	}
+	if err != nil {
+		var violations en16931.Violations
+		if !errors.As(err, &violations) {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		validateInvoiceResponse.Problems = violations.ValidationProblems()
+		return &validateInvoiceResponse, nil
+	}
+
+	validateInvoiceResponse.Valid = true
+	return &validateInvoiceResponse, nil
+}
+
+// checkInvoiceRules - check an invoice against the EN 16931 business rules,
+// en16931.Violations when it breaks any
+func (is *InvoiceService) checkInvoiceRules(ctx context.Context, invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, taxCurrencyTaxTotal *taxproto.TaxTotal, userEmail string, requestID string) error {
+	invoice, err := is.getInvoiceRulesModel(ctx, invoiceHeader, invoiceLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, userEmail, requestID)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return en16931.Validate(invoice)
+}
+
+// getInvoiceRulesModel - the en16931.Invoice of an invoice, with the
+// parties, the VAT categories of the line items, of the allowances and
+// charges and of the VAT breakdown read from the database. taxCurrencyTaxTotal
+// is the TaxTotal in the tax currency, nil when there is none.
+func (is *InvoiceService) getInvoiceRulesModel(ctx context.Context, invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, taxCurrencyTaxTotal *taxproto.TaxTotal, userEmail string, requestID string) (*en16931.Invoice, error) {
+	hd := invoiceHeader.InvoiceHeaderD
+	ht := invoiceHeader.InvoiceHeaderT
+
+	parties, err := ubl.GetParties(ctx, is.DBService, userEmail, requestID, hd.AccountingSupplierPartyId, hd.AccountingCustomerPartyId, hd.TaxRepresentativePartyId)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+
+	itemIDs := []uint32{}
+	for _, invoiceLine := range invoiceLines {
+		itemIDs = append(itemIDs, invoiceLine.InvoiceLineD.ItemId)
+	}
+	items, err := ubl.GetItems(ctx, is.DBService, itemIDs...)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+
+	invoice := en16931.Invoice{}
+	invoice.Number = hd.IhId
+	invoice.IssueDate = common.TimestampToTime(ht.IssueDate)
+	invoice.TypeCode = hd.InvoiceTypeCode
+	invoice.CurrencyCode = hd.DocumentCurrencyCode
+	if hd.TaxCurrencyCode != hd.DocumentCurrencyCode {
+		invoice.TaxCurrencyCode = hd.TaxCurrencyCode
+	}
+	invoice.DueDate = common.TimestampToTime(ht.DueDate)
+	invoice.PeriodStart = common.TimestampToTime(ht.InvoicePeriodStartDate)
+	invoice.PeriodEnd = common.TimestampToTime(ht.InvoicePeriodEndDate)
+	invoice.Seller = rulesParty(parties[hd.AccountingSupplierPartyId])
+	invoice.Buyer = rulesParty(parties[hd.AccountingCustomerPartyId])
+	invoice.TaxRepresentative = rulesParty(parties[hd.TaxRepresentativePartyId])
+
+	invoice.Totals.LineExtensionAmount = parseAmount(hd.LineExtensionAmount)
+	invoice.Totals.AllowanceTotalAmount = parseAmount(hd.AllowanceTotalAmount)
+	invoice.Totals.ChargeTotalAmount = parseAmount(hd.ChargeTotalAmount)
+	invoice.Totals.TaxExclusiveAmount = parseAmount(hd.TaxExclusiveAmount)
+	invoice.Totals.TaxInclusiveAmount = parseAmount(hd.TaxInclusiveAmount)
+	invoice.Totals.WithholdingTaxAmount = parseAmount(hd.WithholdingTaxTotalAmount)
+	invoice.Totals.PrepaidAmount = parseAmount(hd.PrepaidAmount)
+	invoice.Totals.PayableRoundingAmount = parseAmount(hd.PayableRoundingAmount)
+	invoice.Totals.PayableAmount = parseAmount(hd.PayableAmount)
+	if taxTotal != nil {
+		invoice.Totals.TaxAmount = parseAmount(taxTotal.TaxTotalD.TaxAmount)
+	}
+	if taxCurrencyTaxTotal != nil {
+		taxAmount := parseAmount(taxCurrencyTaxTotal.TaxTotalD.TaxAmount)
+		invoice.Totals.TaxCurrencyTaxAmount = &taxAmount
+	}
+
+	for _, allowanceCharge := range invoiceHeader.AllowanceCharges {
+		ad := allowanceCharge.AllowanceChargeD
+		ac := rulesAllowanceCharge(ad)
+		if ad.TaxCategoryId != 0 {
+			taxCategory, _, err := ubl.GetTaxCategory(ctx, is.DBService, ad.TaxCategoryId)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return nil, err
+			}
+			if taxCategory != nil {
+				ac.VATCategory = taxCategory.TcId
+				ac.VATRate = percent(taxCategory.Percent)
+			}
+		}
+		invoice.AllowanceCharges = append(invoice.AllowanceCharges, ac)
+	}
+
+	for _, taxSubTotal := range taxSubTotals {
+		sd := taxSubTotal.TaxSubTotalD
+		taxCategory, _, err := ubl.GetTaxCategory(ctx, is.DBService, sd.TaxCategoryId)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		vatBreakdown := en16931.VATBreakdown{TaxableAmount: parseAmount(sd.TaxableAmount), TaxAmount: parseAmount(sd.TaxAmount), Rate: percent(sd.Percent)}
+		if taxCategory != nil {
+			vatBreakdown.Category = taxCategory.TcId
+			vatBreakdown.ExemptionReasonCode = taxCategory.TaxExemptionReasonCode
+			vatBreakdown.ExemptionReason = taxCategory.TaxExemptionReason
+			if sd.Percent == 0 {
+				vatBreakdown.Rate = percent(taxCategory.Percent)
+			}
+		}
+		invoice.VATBreakdown = append(invoice.VATBreakdown, vatBreakdown)
+	}
+
+	for _, invoiceLine := range invoiceLines {
+		ld := invoiceLine.InvoiceLineD
+		lt := invoiceLine.InvoiceLineT
+		line := en16931.Line{}
+		line.ID = ld.IlId
+		line.Quantity = ld.InvoicedQuantity
+		line.NetAmount = parseAmount(ld.LineExtensionAmount)
+		line.PriceAmount = parseAmount(ld.PriceAmount)
+		line.PeriodStart = common.TimestampToTime(lt.InvoicePeriodStartDate)
+		line.PeriodEnd = common.TimestampToTime(lt.InvoicePeriodEndDate)
+		if item := items[ld.ItemId]; item != nil {
+			line.ItemName = item.Item.ItemName
+			if item.TaxCategory != nil {
+				line.VATCategory = item.TaxCategory.TcId
+				line.VATRate = percent(item.TaxCategory.Percent)
+			}
+		}
+		for _, allowanceCharge := range invoiceLine.AllowanceCharges {
+			line.AllowanceCharges = append(line.AllowanceCharges, rulesAllowanceCharge(allowanceCharge.AllowanceChargeD))
+		}
+		invoice.Lines = append(invoice.Lines, line)
+	}
+	return &invoice, nil
+}
+
+// rulesAllowanceCharge - the en16931.AllowanceCharge of an allowance or
+// charge, without its VAT category
+func rulesAllowanceCharge(ad *commonproto.AllowanceChargeD) en16931.AllowanceCharge {
+	return en16931.AllowanceCharge{
+		ChargeIndicator: ad.ChargeIndicator,
+		Amount:          parseAmount(ad.Amount),
+		ReasonCode:      ad.AllowanceChargeReasonCode,
+		Reason:          ad.AllowanceChargeReason,
+	}
+}
+
+// rulesParty - the en16931.Party of a party, nil when the party is unknown.
+// tax_reference1 is the VAT identifier unless the party is registered for
+// another tax scheme.
+func rulesParty(src *ubl.PartySource) *en16931.Party {
+	if src == nil || src.Party == nil || src.Party.PartyD == nil {
+		return nil
+	}
+	pd := src.Party.PartyD
+	p := en16931.Party{Name: pd.PartyName}
+	if le := src.Party.PartyLegalEntityD; le != nil {
+		if p.Name == "" {
+			p.Name = le.RegistrationName
+		}
+		p.LegalRegistrationID = le.CompanyId
+	}
+	for _, pi := range src.Party.PartyIdentifications {
+		if pi.PartyIdentification != "" {
+			p.Identifier = pi.PartyIdentification
+			break
+		}
+	}
+	if src.TaxScheme == nil || src.TaxScheme.TsId == "" || src.TaxScheme.TsId == "VAT" {
+		p.VATID = pd.TaxReference1
+	} else {
+		p.TaxRegistrationID = pd.TaxReference1
+	}
+	if src.Address != nil {
+		p.Address = &en16931.Address{CountryCode: src.Address.CountryIdCode}
+	}
+	return &p
+}
+
+// percent - a float percent column without its float32 noise
+func percent(p float32) float64 {
+	return math.Round(float64(p)*1e6) / 1e6
+}
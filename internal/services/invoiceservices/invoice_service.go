@@ -6,14 +6,25 @@ import (
 	"os"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
 	"github.com/cloudfresco/sc-ubl/internal/config"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/exchangerate"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	invoicestruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"github.com/jmoiron/sqlx"
@@ -66,6 +77,8 @@ func StartInvoiceServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	creditNoteHeaderService := NewCreditNoteHeaderService(log, dbService, redisService, uc)
 	debitNoteService := NewDebitNoteHeaderService(log, dbService, redisService, uc)
@@ -100,6 +113,7 @@ payment_currency_code,
 payment_alt_currency_code,
 accounting_cost_code,
 accounting_cost,
+buyer_reference,
 line_count_numeric,
 order_id,
 billing_id,
@@ -151,6 +165,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 due_date,
 tax_point_date,
@@ -160,6 +175,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -176,6 +192,7 @@ updated_at)
 :payment_alt_currency_code,
 :accounting_cost_code,
 :accounting_cost,
+:buyer_reference,
 :line_count_numeric,
 :order_id,
 :billing_id,
@@ -227,6 +244,7 @@ updated_at)
 :payable_rounding_amount,
 :payable_amount,
 :payable_alternative_amount,
+:payable_alternative_currency_code,
 :issue_date,
 :due_date,
 :tax_point_date,
@@ -236,6 +254,7 @@ updated_at)
 :pricing_ex_date,
 :payment_ex_date,
 :payment_alt_ex_date,
+:document_status_code,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -255,6 +274,7 @@ payment_currency_code,
 payment_alt_currency_code,
 accounting_cost_code,
 accounting_cost,
+buyer_reference,
 line_count_numeric,
 order_id,
 billing_id,
@@ -306,6 +326,7 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 due_date,
 tax_point_date,
@@ -315,6 +336,7 @@ tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
 payment_alt_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -325,71 +347,130 @@ updated_at from invoice_headers`
 const updateInvoiceSQL = `update invoice_headers set 
 note = ?, 
 invoice_type_code = ?, 
+line_extension_amount = ?, 
+tax_exclusive_amount = ?, 
+tax_inclusive_amount = ?, 
 charge_total_amount = ?, 
 prepaid_amount = ?, 
 payable_rounding_amount = ?,
 payable_amount = ?, updated_at = ? where uuid4 = ?;`
 
-// CreateInvoice - Create Invoice
+// CreateInvoice - Create Invoice. The line extension amounts and monetary
+// totals are calculated from the lines, allowances, charges and taxes;
+// with RejectMismatchedTotals an invoice whose totals differ from the
+// calculated ones is rejected instead of corrected. An invoice with a tax
+// currency gets a second TaxTotal with the total tax amount in it,
+// TaxCurrencyTaxAmount or converted with the tax exchange rate. Withheld
+// taxes get a TaxTotal of their own, their amount is the
+// WithholdingTaxTotalAmount, which is taken off the amount due with the
+// prepaid amount.
 func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.CreateInvoiceRequest) (*invoiceproto.CreateInvoiceResponse, error) {
-	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, is.UserServiceClient)
+	if err := money.Normalize(in); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	invoiceHeader, invoiceLines, taxTotal, taxSubTotals, withholdingTaxTotal, withholdingTaxSubTotals, err := is.processInvoiceRequest(ctx, in)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
 
-	issueDate, err := time.Parse(common.Layout, in.IssueDate)
+	err = calculateInvoiceTotals(invoiceHeader, invoiceLines, taxTotal, withholdingTaxTotal, in.RejectMismatchedTotals)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, en16931.GRPCError(err)
+	}
+
+	taxCurrencyTaxTotal, err := processTaxCurrencyTaxTotal(ctx, is.log, invoiceHeader.InvoiceHeaderD, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, invoiceHeader.InvoiceHeaderD.TaxCurrencyCode, in.TaxCurrencyTaxAmount, taxTotal, taxSubTotals, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
 
-	dueDate, err := time.Parse(common.Layout, in.DueDate)
+	err = is.checkInvoiceRules(ctx, invoiceHeader, invoiceLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, en16931.GRPCError(err)
+	}
+
+	err = is.insertInvoiceHeader(ctx, insertInvoiceHeaderSQL, invoiceHeader, insertInvoiceLineSQL, invoiceLines, taxTotal, taxSubTotals, taxCurrencyTaxTotal, withholdingTaxTotal, withholdingTaxSubTotals, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
 
+	invoiceHeaderResponse := invoiceproto.CreateInvoiceResponse{}
+	invoiceHeaderResponse.InvoiceHeader = invoiceHeader
+	return &invoiceHeaderResponse, nil
+}
+
+// processInvoiceRequest - the InvoiceHeader, InvoiceLines, TaxTotal and
+// TaxSubTotals and withholding TaxTotal and TaxSubTotals of a
+// CreateInvoiceRequest, ready to be checked and inserted. Without
+// TaxSubTotals in the request they are generated from the tax categories
+// of the items of the lines. With Prepayments the prepaid amount is the
+// sum of the parts of the advance payment invoices they settle. Exchange
+// rates without a calculation rate are filled from the stored rates of
+// their dates.
+func (is *InvoiceService) processInvoiceRequest(ctx context.Context, in *invoiceproto.CreateInvoiceRequest) (*invoiceproto.InvoiceHeader, []*invoiceproto.InvoiceLine, *taxproto.TaxTotal, []*taxproto.TaxSubTotal, *taxproto.TaxTotal, []*taxproto.TaxSubTotal, error) {
+	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, is.UserServiceClient)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	issueDate, err := time.Parse(common.Layout, in.IssueDate)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	dueDate, err := time.Parse(common.Layout, in.DueDate)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
 	invoicePeriodStartDate, err := time.Parse(common.Layout, in.InvoicePeriodStartDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	invoicePeriodEndDate, err := time.Parse(common.Layout, in.InvoicePeriodEndDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	taxPointDate, err := time.Parse(common.Layout, in.TaxPointDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	taxExDate, err := time.Parse(common.Layout, in.TaxExDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	pricingExDate, err := time.Parse(common.Layout, in.PricingExDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	paymentExDate, err := time.Parse(common.Layout, in.PaymentExDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	paymentAltExDate, err := time.Parse(common.Layout, in.PaymentAltExDate)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	ttime := common.GetTimeDetails()
@@ -399,7 +480,7 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 	invoiceHeaderD.Uuid4, err = common.GetUUIDBytes()
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	invoiceHeaderD.IhId = in.IhId
@@ -412,6 +493,7 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 	invoiceHeaderD.PaymentAltCurrencyCode = in.PaymentAltCurrencyCode
 	invoiceHeaderD.AccountingCostCode = in.AccountingCostCode
 	invoiceHeaderD.AccountingCost = in.AccountingCost
+	invoiceHeaderD.BuyerReference = in.BuyerReference
 	invoiceHeaderD.LineCountNumeric = in.LineCountNumeric
 	invoiceHeaderD.OrderId = in.OrderId
 	invoiceHeaderD.BillingId = in.BillingId
@@ -450,7 +532,7 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 	invoiceHeaderD.PaymentExMathematicOperatorCode = in.PaymentExMathematicOperatorCode
 
 	invoiceHeaderD.PaymentAltExSourceCurrencyCode = in.PaymentAltExSourceCurrencyCode
-	invoiceHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyCode
+	invoiceHeaderD.PaymentAltExSourceCurrencyBaseRate = in.PaymentAltExSourceCurrencyBaseRate
 	invoiceHeaderD.PaymentAltExTargetCurrencyCode = in.PaymentAltExTargetCurrencyCode
 	invoiceHeaderD.PaymentAltExTargetCurrencyBaseRate = in.PaymentAltExTargetCurrencyBaseRate
 	invoiceHeaderD.PaymentAltExExchangeMarketId = in.PaymentAltExExchangeMarketId
@@ -467,6 +549,11 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 	invoiceHeaderD.PayableRoundingAmount = in.PayableRoundingAmount
 	invoiceHeaderD.PayableAmount = in.PayableAmount
 	invoiceHeaderD.PayableAlternativeAmount = in.PayableAlternativeAmount
+	invoiceHeaderD.PayableAlternativeCurrencyCode = in.PayableAlternativeCurrencyCode
+	if invoiceHeaderD.PayableAlternativeCurrencyCode == "" {
+		invoiceHeaderD.PayableAlternativeCurrencyCode = invoiceHeaderD.PaymentAltCurrencyCode
+	}
+	invoiceHeaderD.DocumentStatusCode = string(docstatus.Draft)
 
 	invoiceHeaderT := invoiceproto.InvoiceHeaderT{}
 	invoiceHeaderT.IssueDate = common.TimeToTimestamp(issueDate.UTC().Truncate(time.Second))
@@ -479,6 +566,13 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 	invoiceHeaderT.PaymentExDate = common.TimeToTimestamp(paymentExDate.UTC().Truncate(time.Second))
 	invoiceHeaderT.PaymentAltExDate = common.TimeToTimestamp(paymentAltExDate.UTC().Truncate(time.Second))
 
+	exchangeDates := map[string]time.Time{exchangerate.TaxExchange: taxExDate, exchangerate.PricingExchange: pricingExDate, exchangerate.PaymentExchange: paymentExDate, exchangerate.PaymentAltExchange: paymentAltExDate}
+	err = exchangerate.FillAll(&invoiceHeaderD, exchangeDates, paymentservice.ExchangeRateLookup(ctx, is.DBService))
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
 	crUpdUser := commonproto.CrUpdUser{}
 	crUpdUser.StatusCode = "active"
 	crUpdUser.CreatedByUserId = user.Id
@@ -490,6 +584,22 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 
 	invoiceHeader := invoiceproto.InvoiceHeader{InvoiceHeaderD: &invoiceHeaderD, InvoiceHeaderT: &invoiceHeaderT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	invoiceHeader.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, is.log, ubl.MasterFlagInvoiceHeader, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	if len(in.Prepayments) > 0 {
+		var prepaidAmount money.Amount
+		invoiceHeader.Prepayments, prepaidAmount, err = is.processInvoicePrepaymentRequests(ctx, &invoiceHeaderD, in.Prepayments, user.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, nil, nil, nil, nil, nil, err
+		}
+		invoiceHeaderD.PrepaidAmount = prepaidAmount.String()
+	}
+
 	invoiceLines := []*invoiceproto.InvoiceLine{}
 	// we will do for loop on lines which is comes from client form
 	for _, line := range in.InvoiceLines {
@@ -499,33 +609,53 @@ func (is *InvoiceService) CreateInvoice(ctx context.Context, in *invoiceproto.Cr
 		invoiceLine, err := is.ProcessInvoiceLineRequest(ctx, line)
 		if err != nil {
 			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-			return nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
+		invoiceLine.InvoiceLineD.CurrencyCode = invoiceHeaderD.DocumentCurrencyCode
 
 		invoiceLines = append(invoiceLines, invoiceLine)
 	}
 
-	err = is.insertInvoiceHeader(ctx, insertInvoiceHeaderSQL, &invoiceHeader, insertInvoiceLineSQL, invoiceLines, in.GetUserEmail(), in.GetRequestId())
+	policy := rounding.ForCurrency(invoiceHeaderD.DocumentCurrencyCode)
+	taxLines := invoiceTaxLines(&invoiceHeader, invoiceLines)
+	var taxTotal *taxproto.TaxTotal
+	var taxSubTotals []*taxproto.TaxSubTotal
+	if len(in.TaxSubTotals) > 0 {
+		taxTotal, taxSubTotals, err = processTaxSubTotalRequests(ctx, is.log, ubl.MasterFlagInvoiceHeader, in.TaxSubTotals, user.Id, in.GetUserEmail(), in.GetRequestId())
+	} else {
+		taxTotal, taxSubTotals, err = generateTaxTotal(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, taxLines, false, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
+	}
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	withholdingTaxTotal, withholdingTaxSubTotals, err := processWithholdingTaxTotal(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, in.WithholdingTaxSubTotals, taxLines, policy, user.Id, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
-	invoiceHeaderResponse := invoiceproto.CreateInvoiceResponse{}
-	invoiceHeaderResponse.InvoiceHeader = &invoiceHeader
-	return &invoiceHeaderResponse, nil
+	return &invoiceHeader, invoiceLines, taxTotal, taxSubTotals, withholdingTaxTotal, withholdingTaxSubTotals, nil
 }
 
 // insertInvoiceHeader - insertInvoiceHeader
-func (is *InvoiceService) insertInvoiceHeader(ctx context.Context, insertInvoiceHeaderSQL string, invoiceHeader *invoiceproto.InvoiceHeader, insertInvoiceLineSQL string, invoiceLines []*invoiceproto.InvoiceLine, userEmail string, requestID string) error {
+func (is *InvoiceService) insertInvoiceHeader(ctx context.Context, insertInvoiceHeaderSQL string, invoiceHeader *invoiceproto.InvoiceHeader, insertInvoiceLineSQL string, invoiceLines []*invoiceproto.InvoiceLine, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, taxCurrencyTaxTotal *taxproto.TaxTotal, withholdingTaxTotal *taxproto.TaxTotal, withholdingTaxSubTotals []*taxproto.TaxSubTotal, userEmail string, requestID string) error {
 	invoiceHeaderTmp, err := is.crInvoiceHeaderStruct(ctx, invoiceHeader, userEmail, requestID)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 		return err
 	}
 	err = is.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		err := numbering.Check(ctx, tx, numbering.Invoice, invoiceHeader.InvoiceHeaderD.AccountingSupplierPartyId, invoiceHeader.InvoiceHeaderD.IhId)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		res, err := tx.NamedExecContext(ctx, insertInvoiceHeaderSQL, invoiceHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.Invoice, err, invoiceHeader.InvoiceHeaderD.IhId)
 			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -543,6 +673,12 @@ func (is *InvoiceService) insertInvoiceHeader(ctx context.Context, insertInvoice
 		}
 		invoiceHeader.InvoiceHeaderD.IdS = uuid4Str
 
+		err = allowancecharge.Insert(ctx, is.log, tx, invoiceHeader.AllowanceCharges, invoiceHeader.InvoiceHeaderD.Id, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, userEmail, requestID)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		for _, invoiceLine := range invoiceLines {
 			invoiceLine.InvoiceLineD.InvoiceHeaderId = invoiceHeader.InvoiceHeaderD.Id
 			invoiceLineTmp, err := is.crInvoiceLineStruct(ctx, invoiceLine, userEmail, requestID)
@@ -550,13 +686,61 @@ func (is *InvoiceService) insertInvoiceHeader(ctx context.Context, insertInvoice
 				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
-			_, err = tx.NamedExecContext(ctx, insertInvoiceLineSQL, invoiceLineTmp)
+			res, err := tx.NamedExecContext(ctx, insertInvoiceLineSQL, invoiceLineTmp)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			uID, err := res.LastInsertId()
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+			invoiceLine.InvoiceLineD.Id = uint32(uID)
+			invoiceLine.InvoiceLineD.IdS, err = common.UUIDBytesToStr(invoiceLine.InvoiceLineD.Uuid4)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			err = allowancecharge.Insert(ctx, is.log, tx, invoiceLine.AllowanceCharges, invoiceLine.InvoiceLineD.Id, invoiceLine.InvoiceLineD.CurrencyCode, userEmail, requestID)
 			if err != nil {
 				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
 		}
 
+		if len(taxSubTotals) > 0 {
+			err = insertTaxTotal(ctx, is.log, tx, taxTotal, taxSubTotals, invoiceHeader.InvoiceHeaderD.Id, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if taxCurrencyTaxTotal != nil {
+			err = insertTaxTotal(ctx, is.log, tx, taxCurrencyTaxTotal, nil, invoiceHeader.InvoiceHeaderD.Id, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		if withholdingTaxTotal != nil {
+			err = insertTaxTotal(ctx, is.log, tx, withholdingTaxTotal, withholdingTaxSubTotals, invoiceHeader.InvoiceHeaderD.Id, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, userEmail, requestID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
+
+		err = insertInvoicePrepayments(ctx, is.log, tx, invoiceHeader.Prepayments, invoiceHeader.InvoiceHeaderD.Id, userEmail, requestID)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		return nil
 	})
 
@@ -608,7 +792,7 @@ func (is *InvoiceService) GetInvoices(ctx context.Context, in *invoiceproto.GetI
 
 	nselectInvoiceHeadersSQL := selectInvoiceHeadersSQL + ` where ` + query
 
-	rows, err := is.DBService.DB.QueryxContext(ctx, nselectInvoiceHeadersSQL, "active")
+	rows, err := is.DBService.Queryer(ctx).QueryxContext(ctx, nselectInvoiceHeadersSQL, "active")
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -656,7 +840,7 @@ func (is *InvoiceService) GetInvoice(ctx context.Context, inReq *invoiceproto.Ge
 	}
 
 	nselectInvoiceHeadersSQL := selectInvoiceHeadersSQL + ` where uuid4 = ? and status_code = ?;`
-	row := is.DBService.DB.QueryRowxContext(ctx, nselectInvoiceHeadersSQL, uuid4byte, "active")
+	row := is.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectInvoiceHeadersSQL, uuid4byte, "active")
 	invoiceHeaderTmp := invoicestruct.InvoiceHeader{}
 	err = row.StructScan(&invoiceHeaderTmp)
 	if err != nil {
@@ -678,7 +862,7 @@ func (is *InvoiceService) GetInvoice(ctx context.Context, inReq *invoiceproto.Ge
 func (is *InvoiceService) GetInvoiceByPk(ctx context.Context, inReq *invoiceproto.GetInvoiceByPkRequest) (*invoiceproto.GetInvoiceByPkResponse, error) {
 	in := inReq.GetByIdRequest
 	nselectInvoiceHeadersSQL := selectInvoiceHeadersSQL + ` where id = ? and status_code = ?;`
-	row := is.DBService.DB.QueryRowxContext(ctx, nselectInvoiceHeadersSQL, in.Id, "active")
+	row := is.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectInvoiceHeadersSQL, in.Id, "active")
 	invoiceHeaderTmp := invoicestruct.InvoiceHeader{}
 	err := row.StructScan(&invoiceHeaderTmp)
 	if err != nil {
@@ -712,6 +896,11 @@ func (is *InvoiceService) getInvoiceHeaderStruct(ctx context.Context, in *common
 	invoiceHeaderT.PaymentExDate = common.TimeToTimestamp(invoiceHeaderTmp.InvoiceHeaderT.PaymentExDate)
 	invoiceHeaderT.PaymentAltExDate = common.TimeToTimestamp(invoiceHeaderTmp.InvoiceHeaderT.PaymentAltExDate)
 
+	if err := money.Normalize(invoiceHeaderTmp.InvoiceHeaderD); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(invoiceHeaderTmp.InvoiceHeaderD.Uuid4)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -726,34 +915,135 @@ func (is *InvoiceService) getInvoiceHeaderStruct(ctx context.Context, in *common
 
 	invoiceHeader := invoiceproto.InvoiceHeader{InvoiceHeaderD: invoiceHeaderTmp.InvoiceHeaderD, InvoiceHeaderT: invoiceHeaderT, CrUpdUser: invoiceHeaderTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	invoiceHeader.AllowanceCharges, err = allowancecharge.Get(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, invoiceHeader.InvoiceHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &invoiceHeader, nil
 }
 
-// UpdateInvoice - Update Invoice
+// UpdateInvoice - Update Invoice. The monetary totals are calculated again
+// with the new prepaid amount and the stored allowances and charges, or the
+// new charge total for an invoice without any; with RejectMismatchedTotals
+// a rounding or payable amount that differs from the calculated one is
+// rejected instead of corrected. Only a draft invoice can be updated, an
+// issued one is revised with ReviseInvoice.
 func (is *InvoiceService) UpdateInvoice(ctx context.Context, in *invoiceproto.UpdateInvoiceRequest) (*invoiceproto.UpdateInvoiceResponse, error) {
+	err := is.updateInvoice(ctx, in, nil)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.UpdateInvoiceResponse{}, nil
+}
+
+// updateInvoice - update a draft invoice as UpdateInvoice does, or, with a
+// revision, an issued one, keeping the revised invoice as its next version.
+// The invoice is locked with lockInvoice before it is read, so it is read,
+// checked and updated within one transaction.
+func (is *InvoiceService) updateInvoice(ctx context.Context, in *invoiceproto.UpdateInvoiceRequest, revision *docversion.Revision) error {
+	if err := money.Normalize(in); err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return err
+	}
 	db := is.DBService.DB
 	tn := common.GetTimeDetails()
 
 	uuid4byte, err := common.UUIDStrToBytes(in.Id)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	stmt, err := db.PreparexContext(ctx, updateInvoiceSQL)
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = is.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		err := lockInvoice(ctx, is.log, tx, uuid4byte, revision, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		invoiceHeader := invoiceResponse.InvoiceHeader
+
+		invoiceLinesResponse, err := is.GetInvoiceLines(ctx, &invoiceproto.GetInvoiceLinesRequest{GetRequest: &getRequest})
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		invoiceLines := invoiceLinesResponse.InvoiceLines
+
+		var before []byte
+		if revision != nil {
+			before, err = invoiceSnapshot(invoiceHeader, invoiceLines)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		taxAmount, err := getTaxAmount(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, invoiceHeader.InvoiceHeaderD.Id, invoiceHeader.InvoiceHeaderD.DocumentCurrencyCode, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		hd := invoiceHeader.InvoiceHeaderD
+		hd.ChargeTotalAmount = in.ChargeTotalAmount
+		hd.PrepaidAmount = in.PrepaidAmount
+		totals := invoiceTotals(invoiceHeader, invoiceLines, taxAmount)
+		if in.RejectMismatchedTotals {
+			hd.PayableRoundingAmount = in.PayableRoundingAmount
+			hd.PayableAmount = in.PayableAmount
+			violations := totalsMismatches(hd, nil, totals)
+			if len(violations) > 0 {
+				is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(violations))
+				return en16931.GRPCError(violations)
+			}
+		}
+		hd.Note = in.Note
+		hd.InvoiceTypeCode = in.InvoiceTypeCode
+		hd.LineExtensionAmount = totals.LineExtensionAmount.String()
+		hd.TaxExclusiveAmount = totals.TaxExclusiveAmount.String()
+		hd.TaxInclusiveAmount = totals.TaxInclusiveAmount.String()
+		hd.ChargeTotalAmount = totals.ChargeTotalAmount.String()
+		hd.PrepaidAmount = totals.PrepaidAmount.String()
+		hd.PayableRoundingAmount = totals.PayableRoundingAmount.String()
+		hd.PayableAmount = totals.PayableAmount.String()
+		invoiceHeader.CrUpdTime.UpdatedAt = common.TimeToTimestamp(tn)
+
+		var after []byte
+		if revision != nil {
+			after, err = invoiceSnapshot(invoiceHeader, invoiceLines)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
 		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
-			in.Note,
-			in.InvoiceTypeCode,
-			in.ChargeTotalAmount,
-			in.PrepaidAmount,
-			in.PayableRoundingAmount,
-			in.PayableAmount,
+			hd.Note,
+			hd.InvoiceTypeCode,
+			hd.LineExtensionAmount,
+			hd.TaxExclusiveAmount,
+			hd.TaxInclusiveAmount,
+			hd.ChargeTotalAmount,
+			hd.PrepaidAmount,
+			hd.PayableRoundingAmount,
+			hd.PayableAmount,
 			tn,
 			uuid4byte)
 		if err != nil {
@@ -765,19 +1055,49 @@ func (is *InvoiceService) UpdateInvoice(ctx context.Context, in *invoiceproto.Up
 			}
 			return err
 		}
+		if revision != nil {
+			return docversion.InsertVersions(ctx, is.log, tx, ubl.MasterFlagInvoiceHeader, hd.Id, before, after, revision, tn, in.GetUserEmail(), in.GetRequestId())
+		}
 		return nil
 	})
 
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = stmt.Close()
 	if err != nil {
 		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
-	return &invoiceproto.UpdateInvoiceResponse{}, nil
+	return nil
+}
+
+// selectInvoiceStatusByUUIDForUpdateSQL - lock a invoice until the end of the
+// transaction and get its document status
+const selectInvoiceStatusByUUIDForUpdateSQL = `select document_status_code from invoice_headers where uuid4 = ? and status_code = ? for update;`
+
+// lockInvoice - lock the invoice uuid4byte until the end of the transaction of
+// its update, before it is read, and check that it is still a draft or,
+// with a revision, that it can be revised, as its status may have changed
+// since the update was asked for
+func lockInvoice(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, uuid4byte []byte, revision *docversion.Revision, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectInvoiceStatusByUUIDForUpdateSQL, uuid4byte, "active").Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	if revision == nil {
+		err = docstatus.CheckEditable(documentStatusCode)
+	} else {
+		err = docstatus.CheckRevisable(documentStatusCode)
+	}
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
 }
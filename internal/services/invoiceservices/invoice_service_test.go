@@ -2,15 +2,28 @@ package invoiceservices
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/exchangerate"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	itemproto "github.com/cloudfresco/sc-ubl/internal/protogen/item/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/cloudfresco/sc-ubl/test"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestInvoiceService_GetInvoices(t *testing.T) {
@@ -221,7 +234,7 @@ func TestInvoiceService_GetInvoiceLines(t *testing.T) {
 
 	ctx := LoginUser()
 	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
-	invoiceLine, err := GetInvoiceLine(uint32(1), []byte{97, 193, 98, 66, 182, 31, 71, 158, 185, 55, 14, 147, 71, 225, 142, 69}, "61c16242-b61f-479e-b937-0e9347e18e45", float64(100), float64(100), uint32(2), "2005-06-20T10:04:26Z", "2005-06-12T10:04:26Z", "2005-06-25T10:04:26Z", "2005-06-30T10:04:26Z", "2005-07-01T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	invoiceLine, err := GetInvoiceLine(uint32(1), []byte{97, 193, 98, 66, 182, 31, 71, 158, 185, 55, 14, 147, 71, 225, 142, 69}, "61c16242-b61f-479e-b937-0e9347e18e45", float64(100), "100.00", uint32(2), "2005-06-20T10:04:26Z", "2005-06-12T10:04:26Z", "2005-06-25T10:04:26Z", "2005-06-30T10:04:26Z", "2005-07-01T10:04:26Z", uint32(1), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 	}
@@ -270,7 +283,395 @@ func TestInvoiceService_GetInvoiceLines(t *testing.T) {
 		assert.NotNil(t, invoiceLinesResp)
 		invoiceLineResult := invoiceLinesResp.InvoiceLines[0]
 		assert.Equal(t, invoiceLineResult.InvoiceLineD.InvoicedQuantity, float64(100), "they should be equal")
-		assert.Equal(t, invoiceLineResult.InvoiceLineD.LineExtensionAmount, float64(100), "they should be equal")
+		assert.Equal(t, invoiceLineResult.InvoiceLineD.LineExtensionAmount, "100.00", "they should be equal")
+	}
+}
+
+func TestInvoiceService_GetInvoiceUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.GetInvoiceUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	form2 := invoiceproto.GetInvoiceUBLRequest{}
+	form2.GetRequest = &gform
+	form2.Profile = "peppol-bis-3"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetInvoiceUBLRequest
+	}
+	tests := []struct {
+		is      *InvoiceService
+		args    args
+		wantErr bool
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form2,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		invoiceUBLResp, err := tt.is.GetInvoiceUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("InvoiceService.GetInvoiceUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		if tt.wantErr {
+			assert.Contains(t, err.Error(), "PEPPOL-EN16931-P0100", "they should be equal")
+			continue
+		}
+		assert.NotNil(t, invoiceUBLResp)
+		ublStr := string(invoiceUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>834dd586-a1b8-4e3f-89cf-bfd25edd9a60</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2005-06-21</cbc:IssueDate>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:Note>sample</cbc:Note>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cac:InvoiceLine>`, "they should be equal")
+	}
+}
+
+func TestInvoiceService_ImportInvoiceUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>INV-IMPORT-1</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:AccountingSupplierParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>Consortial</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>IYT Corporation</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingCustomerParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="EUR">100.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+  <cac:InvoiceLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:InvoicedQuantity>1</cbc:InvoicedQuantity>
+    <cbc:LineExtensionAmount currencyID="EUR">100.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Not in the catalogue</cbc:Name></cac:Item>
+  </cac:InvoiceLine>
+</Invoice>`
+
+	form := invoiceproto.ImportInvoiceUBLRequest{}
+	form.Ubl = []byte(ublDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ImportInvoiceUBLRequest
+	}
+	tests := []struct {
+		is      *InvoiceService
+		args    args
+		wantErr string
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "/Invoice/cac:InvoiceLine[1]/cac:Item",
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.is.ImportInvoiceUBL(tt.args.ctx, tt.args.in)
+		if err == nil {
+			t.Errorf("InvoiceService.ImportInvoiceUBL() error = nil, want %v", tt.wantErr)
+			return
+		}
+		assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+	}
+}
+
+func TestInvoiceService_GetInvoiceCII(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.GetInvoiceCIIRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetInvoiceCIIRequest
+	}
+	tests := []struct {
+		is      *InvoiceService
+		args    args
+		wantErr bool
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		invoiceCIIResp, err := tt.is.GetInvoiceCII(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("InvoiceService.GetInvoiceCII() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, invoiceCIIResp)
+		ciiStr := string(invoiceCIIResp.Cii)
+		assert.Contains(t, ciiStr, `<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100"`, "they should be equal")
+		assert.Contains(t, ciiStr, `<udt:DateTimeString format="102">20050621</udt:DateTimeString>`, "they should be equal")
+		assert.Contains(t, ciiStr, `<ram:Content>sample</ram:Content>`, "they should be equal")
+		assert.Contains(t, ciiStr, `<ram:IncludedSupplyChainTradeLineItem>`, "they should be equal")
+	}
+}
+
+func TestInvoiceService_GetInvoiceFacturX(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	gform := commonproto.GetRequest{}
+	gform.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+
+	form := invoiceproto.GetInvoiceFacturXRequest{}
+	form.GetRequest = &gform
+
+	form1 := invoiceproto.GetInvoiceFacturXRequest{}
+	form1.GetRequest = &gform
+	form1.Profile = "MINIMUM"
+
+	form2 := invoiceproto.GetInvoiceFacturXRequest{}
+	form2.GetRequest = &gform
+	form2.Profile = "xrechnung"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.GetInvoiceFacturXRequest
+	}
+	tests := []struct {
+		is          *InvoiceService
+		args        args
+		wantProfile string
+		wantErr     bool
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantProfile: "<fx:ConformanceLevel>EN 16931</fx:ConformanceLevel>",
+			wantErr:     false,
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form1,
+			},
+			wantProfile: "<fx:ConformanceLevel>MINIMUM</fx:ConformanceLevel>",
+			wantErr:     false,
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form2,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		invoiceFacturXResp, err := tt.is.GetInvoiceFacturX(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("InvoiceService.GetInvoiceFacturX() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		if tt.wantErr {
+			continue
+		}
+		assert.NotNil(t, invoiceFacturXResp)
+		pdfStr := string(invoiceFacturXResp.Pdf)
+		assert.True(t, strings.HasPrefix(pdfStr, "%PDF-1.7"), "they should be equal")
+		assert.Contains(t, pdfStr, "/Names <</EmbeddedFiles <</Names [(factur-x.xml) ", "they should be equal")
+		assert.Contains(t, pdfStr, tt.wantProfile, "they should be equal")
+	}
+}
+
+func TestInvoiceService_ImportInvoiceCII(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	ciiDoc := `<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100" xmlns:ram="urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:100" xmlns:udt="urn:un:unece:uncefact:data:standard:UnqualifiedDataType:100">
+  <rsm:ExchangedDocument>
+    <ram:ID>INV-IMPORT-1</ram:ID>
+    <ram:TypeCode>380</ram:TypeCode>
+    <ram:IssueDateTime><udt:DateTimeString format="102">20240131</udt:DateTimeString></ram:IssueDateTime>
+  </rsm:ExchangedDocument>
+  <rsm:SupplyChainTradeTransaction>
+    <ram:IncludedSupplyChainTradeLineItem>
+      <ram:AssociatedDocumentLineDocument><ram:LineID>1</ram:LineID></ram:AssociatedDocumentLineDocument>
+      <ram:SpecifiedTradeProduct><ram:Name>Not in the catalogue</ram:Name></ram:SpecifiedTradeProduct>
+      <ram:SpecifiedLineTradeDelivery><ram:BilledQuantity>1</ram:BilledQuantity></ram:SpecifiedLineTradeDelivery>
+      <ram:SpecifiedLineTradeSettlement><ram:SpecifiedTradeSettlementLineMonetarySummation><ram:LineTotalAmount>100.00</ram:LineTotalAmount></ram:SpecifiedTradeSettlementLineMonetarySummation></ram:SpecifiedLineTradeSettlement>
+    </ram:IncludedSupplyChainTradeLineItem>
+    <ram:ApplicableHeaderTradeAgreement>
+      <ram:SellerTradeParty><ram:Name>Consortial</ram:Name></ram:SellerTradeParty>
+      <ram:BuyerTradeParty><ram:Name>IYT Corporation</ram:Name></ram:BuyerTradeParty>
+    </ram:ApplicableHeaderTradeAgreement>
+    <ram:ApplicableHeaderTradeDelivery/>
+    <ram:ApplicableHeaderTradeSettlement>
+      <ram:InvoiceCurrencyCode>EUR</ram:InvoiceCurrencyCode>
+      <ram:SpecifiedTradeSettlementHeaderMonetarySummation><ram:DuePayableAmount>100.00</ram:DuePayableAmount></ram:SpecifiedTradeSettlementHeaderMonetarySummation>
+    </ram:ApplicableHeaderTradeSettlement>
+  </rsm:SupplyChainTradeTransaction>
+</rsm:CrossIndustryInvoice>`
+
+	form := invoiceproto.ImportInvoiceCIIRequest{}
+	form.Cii = []byte(ciiDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	form2 := invoiceproto.ImportInvoiceCIIRequest{}
+	form2.Cii = []byte(strings.Replace(ciiDoc, "<ram:TypeCode>380</ram:TypeCode>", "<ram:TypeCode>381</ram:TypeCode>", 1))
+	form2.UserId = form.UserId
+	form2.UserEmail = form.UserEmail
+	form2.RequestId = form.RequestId
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ImportInvoiceCIIRequest
+	}
+	tests := []struct {
+		is      *InvoiceService
+		args    args
+		wantErr string
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "/Invoice/cac:InvoiceLine[1]/cac:Item",
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &form2,
+			},
+			wantErr: "/rsm:CrossIndustryInvoice/rsm:ExchangedDocument/ram:TypeCode",
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.is.ImportInvoiceCII(tt.args.ctx, tt.args.in)
+		if err == nil {
+			t.Errorf("InvoiceService.ImportInvoiceCII() error = nil, want %v", tt.wantErr)
+			return
+		}
+		assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+	}
+}
+
+func TestInvoiceService_ValidateDocument(t *testing.T) {
+	ctx := LoginUser()
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>INV-VALIDATE-1</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cac:AccountingSupplierParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>Consortial</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cac:PartyLegalEntity><cbc:RegistrationName>IYT Corporation</cbc:RegistrationName></cac:PartyLegalEntity></cac:Party></cac:AccountingCustomerParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount%s>100.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+  <cac:InvoiceLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:LineExtensionAmount currencyID="EUR">100.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Laptop</cbc:Name></cac:Item>
+  </cac:InvoiceLine>
+</Invoice>`
+
+	tests := []struct {
+		ubl      string
+		valid    bool
+		problems []*commonproto.ValidationProblem
+	}{
+		{
+			ubl:   fmt.Sprintf(ublDoc, ` currencyID="EUR"`),
+			valid: true,
+		},
+		{
+			ubl:      fmt.Sprintf(ublDoc, ""),
+			valid:    false,
+			problems: []*commonproto.ValidationProblem{{Path: "/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount", Line: 6, Message: "missing required attribute currencyID"}},
+		},
+	}
+	for _, tt := range tests {
+		validateDocumentResponse, err := invoiceService.ValidateDocument(ctx, &invoiceproto.ValidateDocumentRequest{Ubl: []byte(tt.ubl), UserEmail: "sprov300@gmail.com", RequestId: "bks1m1g91jau4nkks2f0"})
+		if err != nil {
+			t.Errorf("InvoiceService.ValidateDocument() error = %v", err)
+			return
+		}
+		assert.Equal(t, tt.valid, validateDocumentResponse.Valid, "they should be equal")
+		assert.Equal(t, "Invoice", validateDocumentResponse.DocumentType, "they should be equal")
+		assert.Equal(t, len(tt.problems), len(validateDocumentResponse.Problems), "they should be equal")
+		for i, problem := range tt.problems {
+			assert.Equal(t, problem.Path, validateDocumentResponse.Problems[i].Path, "they should be equal")
+			assert.Equal(t, problem.Line, validateDocumentResponse.Problems[i].Line, "they should be equal")
+			assert.Equal(t, problem.Message, validateDocumentResponse.Problems[i].Message, "they should be equal")
+		}
 	}
 }
 
@@ -286,6 +687,10 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
 
 	invoiceHeader := invoiceproto.CreateInvoiceRequest{}
+	invoiceHeader.IhId = "INV-2022-001"
+	invoiceHeader.DocumentCurrencyCode = "EUR"
+	invoiceHeader.AccountingSupplierPartyId = uint32(5)
+	invoiceHeader.AccountingCustomerPartyId = uint32(4)
 	invoiceHeader.IssueDate = "06/21/2022"
 	invoiceHeader.DueDate = "06/25/2022"
 	invoiceHeader.TaxPointDate = "07/25/2022"
@@ -297,21 +702,32 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 	invoiceHeader.PricingExDate = "06/22/2022"
 	invoiceHeader.PaymentExDate = "06/23/2022"
 	invoiceHeader.PaymentAltExDate = "06/24/2022"
-	invoiceHeader.ChargeTotalAmount = float64(0)
-	invoiceHeader.PrepaidAmount = float64(0)
-	invoiceHeader.PayableRoundingAmount = float64(0)
-	invoiceHeader.PayableAmount = float64(200)
+	invoiceHeader.LineExtensionAmount = "200"
+	invoiceHeader.TaxExclusiveAmount = "200"
+	invoiceHeader.TaxInclusiveAmount = "200"
+	invoiceHeader.ChargeTotalAmount = "0"
+	invoiceHeader.PrepaidAmount = "0"
+	invoiceHeader.PayableRoundingAmount = "0"
+	invoiceHeader.PayableAmount = "200"
 	invoiceHeader.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	invoiceHeader.UserEmail = "sprov300@gmail.com"
 	invoiceHeader.RequestId = "bks1m1g91jau4nkks2f0"
 
+	taxSubTotal := taxproto.CreateTaxSubTotalRequest{}
+	taxSubTotal.TaxableAmount = "200"
+	taxSubTotal.TaxAmount = "0"
+	taxSubTotal.TaxCategoryId = uint32(15)
+	invoiceHeader.TaxSubTotals = []*taxproto.CreateTaxSubTotalRequest{&taxSubTotal}
+
 	invoiceLine := invoiceproto.CreateInvoiceLineRequest{}
+	invoiceLine.IlId = "1"
 	invoiceLine.Note = "Ordered in our booth at the convention."
 	invoiceLine.InvoicedQuantity = float64(1)
-	invoiceLine.LineExtensionAmount = float64(200)
+	invoiceLine.LineExtensionAmount = "200"
+	invoiceLine.PriceAmount = "200"
 	invoiceLine.TaxPointDate = "11/25/2022"
 	invoiceLine.AccountingCost = "Code002"
-	invoiceLine.ItemId = uint32(7)
+	invoiceLine.ItemId = uint32(1)
 	invoiceLine.InvoicePeriodStartDate = "06/21/2022"
 	invoiceLine.InvoicePeriodEndDate = "07/01/2022"
 	invoiceLine.PriceValidityPeriodStartDate = "07/01/2022"
@@ -324,6 +740,16 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 	invoiceLines = append(invoiceLines, &invoiceLine)
 	invoiceHeader.InvoiceLines = invoiceLines
 
+	invoiceHeader2 := proto.Clone(&invoiceHeader).(*invoiceproto.CreateInvoiceRequest)
+	invoiceHeader2.IhId = "INV-2022-002"
+	invoiceHeader2.PayableAmount = "250"
+	invoiceHeader2.RejectMismatchedTotals = true
+
+	invoiceHeader3 := proto.Clone(&invoiceHeader).(*invoiceproto.CreateInvoiceRequest)
+	invoiceHeader3.IhId = "INV-2022-003"
+	invoiceHeader3.LineExtensionAmount = "199.99"
+	invoiceHeader3.PayableAmount = "250"
+
 	type args struct {
 		ctx context.Context
 		in  *invoiceproto.CreateInvoiceRequest
@@ -341,6 +767,22 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  invoiceHeader2,
+			},
+			wantErr: true,
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  invoiceHeader3,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		invoiceHeaderResp, err := tt.is.CreateInvoice(tt.args.ctx, tt.args.in)
@@ -348,10 +790,450 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 			t.Errorf("InvoiceService.CreateInvoice() error = %v, wantErr %v", err, tt.wantErr)
 			return
 		}
+		if tt.wantErr {
+			assert.Contains(t, err.Error(), "BR-CO-16", "they should be equal")
+			continue
+		}
 		assert.NotNil(t, invoiceHeaderResp)
 		invoiceHeaderResult := invoiceHeaderResp.InvoiceHeader
 		assert.Equal(t, invoiceHeaderResult.InvoiceHeaderD.Note, "sample", "they should be equal")
 		assert.Equal(t, invoiceHeaderResult.InvoiceHeaderD.InvoiceTypeCode, "SalesInvoice", "they should be equal")
+		assert.Equal(t, invoiceHeaderResult.InvoiceHeaderD.LineExtensionAmount, "200.00", "they should be equal")
+		assert.Equal(t, invoiceHeaderResult.InvoiceHeaderD.PayableAmount, "200.00", "they should be equal")
+	}
+
+	_, err = invoiceService.CreateInvoice(ctx, &invoiceHeader)
+	assert.True(t, errors.Is(err, numbering.ErrNumberUsed), "a number is used once per supplier")
+}
+
+func TestTotalsMismatches(t *testing.T) {
+	totals := Totals{
+		CurrencyCode: "CHF",
+		Lines:        []TotalsLine{{Quantity: 3, PriceAmount: money.MustParse("9.99")}},
+		TaxAmount:    money.MustParse("2.46"),
+	}
+	totals.Calculate()
+	invoiceLines := []*invoiceproto.InvoiceLine{{InvoiceLineD: &invoiceproto.InvoiceLineD{LineExtensionAmount: "29.97"}}}
+	hd := invoiceproto.InvoiceHeaderD{LineExtensionAmount: "29.97", TaxExclusiveAmount: "29.97", TaxInclusiveAmount: "32.43", PayableRoundingAmount: "0.02", PayableAmount: "32.45"}
+	assert.Equal(t, 0, len(totalsMismatches(&hd, invoiceLines, &totals)), "they should be equal")
+
+	invoiceLines[0].InvoiceLineD.LineExtensionAmount = "29.99"
+	hd.PayableRoundingAmount = "0"
+	hd.PayableAmount = "32.40"
+	rules := []string{}
+	for _, violation := range totalsMismatches(&hd, invoiceLines, &totals) {
+		rules = append(rules, violation.Rule)
+	}
+	assert.Equal(t, []string{"SC-UBL-CO-01", "SC-UBL-CO-02", "BR-CO-16"}, rules, "they should be equal")
+}
+
+func TestTotals_Calculate(t *testing.T) {
+	tests := []struct {
+		totals Totals
+		want   Totals
+	}{
+		{
+			totals: Totals{
+				Lines: []TotalsLine{{Quantity: 3, PriceAmount: money.MustParse("10.25"), PriceBaseQuantity: 1}, {Quantity: 7, PriceAmount: money.MustParse("25"), PriceBaseQuantity: 10}},
+				AllowanceCharges: []*allowancecharge.AllowanceCharge{
+					{Amount: money.MustParse("5")},
+					{ChargeIndicator: true, Amount: money.MustParse("2.5")},
+					{ChargeIndicator: true, Amount: money.MustParse("1")},
+				},
+				TaxAmount:     money.MustParse("8.5"),
+				PrepaidAmount: money.MustParse("10"),
+			},
+			want: Totals{
+				LineExtensionAmount:   money.MustParse("48.25"),
+				AllowanceTotalAmount:  money.MustParse("5"),
+				ChargeTotalAmount:     money.MustParse("3.5"),
+				TaxExclusiveAmount:    money.MustParse("46.75"),
+				TaxInclusiveAmount:    money.MustParse("55.25"),
+				PayableRoundingAmount: money.Zero,
+				PayableAmount:         money.MustParse("45.25"),
+			},
+		},
+		{
+			totals: Totals{
+				Lines: []TotalsLine{
+					{Quantity: 10, PriceAmount: money.MustParse("20"), AllowanceCharges: []*allowancecharge.AllowanceCharge{{ReasonCode: "95", MultiplierFactorNumeric: 10}}},
+					{Quantity: 1, PriceAmount: money.MustParse("100"), AllowanceCharges: []*allowancecharge.AllowanceCharge{{ChargeIndicator: true, ReasonCode: "FC", Amount: money.MustParse("15")}}},
+				},
+				AllowanceCharges: []*allowancecharge.AllowanceCharge{
+					{ReasonCode: "95", MultiplierFactorNumeric: 5},
+					{ChargeIndicator: true, ReasonCode: "FC", Amount: money.MustParse("20")},
+				},
+				TaxAmount: money.MustParse("59.30"),
+			},
+			want: Totals{
+				LineExtensionAmount:  money.MustParse("295"),
+				AllowanceTotalAmount: money.MustParse("14.75"),
+				ChargeTotalAmount:    money.MustParse("20"),
+				TaxExclusiveAmount:   money.MustParse("300.25"),
+				TaxInclusiveAmount:   money.MustParse("359.55"),
+				PayableAmount:        money.MustParse("359.55"),
+			},
+		},
+		{
+			totals: Totals{
+				Lines:                []TotalsLine{{Quantity: 10, PriceAmount: money.MustParse("100")}},
+				TaxAmount:            money.MustParse("160"),
+				WithholdingTaxAmount: money.MustParse("206.666667"),
+				PrepaidAmount:        money.MustParse("200"),
+			},
+			want: Totals{
+				LineExtensionAmount: money.MustParse("1000"),
+				TaxExclusiveAmount:  money.MustParse("1000"),
+				TaxInclusiveAmount:  money.MustParse("1160"),
+				PayableAmount:       money.MustParse("753.33"),
+			},
+		},
+		{
+			totals: Totals{
+				CurrencyCode: "CHF",
+				Lines:        []TotalsLine{{Quantity: 3, PriceAmount: money.MustParse("9.99")}},
+				TaxAmount:    money.MustParse("2.43"),
+			},
+			want: Totals{
+				LineExtensionAmount:   money.MustParse("29.97"),
+				TaxExclusiveAmount:    money.MustParse("29.97"),
+				TaxInclusiveAmount:    money.MustParse("32.40"),
+				PayableRoundingAmount: money.Zero,
+				PayableAmount:         money.MustParse("32.40"),
+			},
+		},
+		{
+			totals: Totals{
+				CurrencyCode: "CHF",
+				Lines:        []TotalsLine{{Quantity: 3, PriceAmount: money.MustParse("9.99")}},
+				TaxAmount:    money.MustParse("2.46"),
+			},
+			want: Totals{
+				LineExtensionAmount:   money.MustParse("29.97"),
+				TaxExclusiveAmount:    money.MustParse("29.97"),
+				TaxInclusiveAmount:    money.MustParse("32.43"),
+				PayableRoundingAmount: money.MustParse("0.02"),
+				PayableAmount:         money.MustParse("32.45"),
+			},
+		},
+		{
+			totals: Totals{
+				CurrencyCode:     "JPY",
+				Lines:            []TotalsLine{{Quantity: 3, PriceAmount: money.MustParse("333.5")}},
+				AllowanceCharges: []*allowancecharge.AllowanceCharge{{ReasonCode: "95", MultiplierFactorNumeric: 2.5}},
+				TaxAmount:        money.MustParse("97.55"),
+			},
+			want: Totals{
+				LineExtensionAmount:  money.MustParse("1001"),
+				AllowanceTotalAmount: money.MustParse("25"),
+				TaxExclusiveAmount:   money.MustParse("976"),
+				TaxInclusiveAmount:   money.MustParse("1074"),
+				PayableAmount:        money.MustParse("1074"),
+			},
+		},
+		{
+			totals: Totals{
+				Lines: []TotalsLine{{Quantity: -2, PriceAmount: money.MustParse("12.5")}},
+			},
+			want: Totals{
+				LineExtensionAmount: money.MustParse("-25"),
+				TaxExclusiveAmount:  money.MustParse("-25"),
+				TaxInclusiveAmount:  money.MustParse("-25"),
+				PayableAmount:       money.MustParse("-25"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt.totals.Calculate()
+		assert.Equal(t, tt.want.LineExtensionAmount, tt.totals.LineExtensionAmount, "they should be equal")
+		assert.Equal(t, tt.want.AllowanceTotalAmount, tt.totals.AllowanceTotalAmount, "they should be equal")
+		assert.Equal(t, tt.want.ChargeTotalAmount, tt.totals.ChargeTotalAmount, "they should be equal")
+		assert.Equal(t, tt.want.TaxExclusiveAmount, tt.totals.TaxExclusiveAmount, "they should be equal")
+		assert.Equal(t, tt.want.TaxInclusiveAmount, tt.totals.TaxInclusiveAmount, "they should be equal")
+		assert.Equal(t, tt.want.PayableRoundingAmount, tt.totals.PayableRoundingAmount, "they should be equal")
+		assert.Equal(t, tt.want.PayableAmount, tt.totals.PayableAmount, "they should be equal")
+	}
+}
+
+func TestNoteTotals(t *testing.T) {
+	totals := noteTotals("EUR", []money.Amount{money.MustParse("100"), money.MustParse("3.96")}, nil, "5.00", "", "", "10.00", money.MustParse("20.80"))
+	assert.Equal(t, money.MustParse("103.96"), totals.LineExtensionAmount, "the line extension amounts of a note are summed as they are")
+	assert.Equal(t, money.MustParse("5"), totals.AllowanceTotalAmount, "they should be equal")
+	assert.Equal(t, money.MustParse("98.96"), totals.TaxExclusiveAmount, "they should be equal")
+	assert.Equal(t, money.MustParse("119.76"), totals.TaxInclusiveAmount, "they should be equal")
+	assert.Equal(t, money.MustParse("109.76"), totals.PayableAmount, "they should be equal")
+
+	totals = noteTotals("EUR", nil, nil, "", "", "", "", money.Zero)
+	assert.Equal(t, money.Zero, totals.LineExtensionAmount, "a note without lines has no totals")
+	assert.Equal(t, money.Zero, totals.PayableAmount, "they should be equal")
+}
+
+func TestCalculateInvoiceLine(t *testing.T) {
+	invoiceLine := invoiceproto.InvoiceLine{InvoiceLineD: &invoiceproto.InvoiceLineD{InvoicedQuantity: 3, PriceAmount: "10.00", PriceBaseQuantity: 2, LineExtensionAmount: "99.00", CurrencyCode: "EUR"}}
+	invoiceLine.AllowanceCharges = []*commonproto.AllowanceCharge{{AllowanceChargeD: &commonproto.AllowanceChargeD{MultiplierFactorNumeric: 10}}}
+	calculateInvoiceLine(&invoiceLine)
+	assert.Equal(t, "13.50", invoiceLine.InvoiceLineD.LineExtensionAmount, "the line extension amount the client sends is not kept")
+	assert.Equal(t, "1.50", invoiceLine.AllowanceCharges[0].AllowanceChargeD.Amount, "they should be equal")
+}
+
+func TestTaxSubTotalRequests(t *testing.T) {
+	standardRate := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 20}
+	perUnit := &taxproto.TaxCategoryD{Id: 2, TcId: "S", BaseUnitMeasure: "LTR", PerUnitAmount: "0.50"}
+	items := map[uint32]*ubl.ItemSource{
+		1: {Item: &itemproto.ItemD{Id: 1}, TaxCategory: standardRate, TaxCategories: []*taxproto.TaxCategoryD{standardRate}},
+		2: {Item: &itemproto.ItemD{Id: 2}, TaxCategory: perUnit, TaxCategories: []*taxproto.TaxCategoryD{perUnit}},
+		3: {Item: &itemproto.ItemD{Id: 3}, TaxCategory: standardRate, TaxCategories: []*taxproto.TaxCategoryD{standardRate}},
+		4: {Item: &itemproto.ItemD{Id: 4}},
+	}
+	lines := []TaxLine{
+		{ItemID: 1, Quantity: 2, LineExtensionAmount: money.MustParse("100")},
+		{ItemID: 2, Quantity: 3, LineExtensionAmount: money.MustParse("30")},
+		{ItemID: 3, Quantity: 1, LineExtensionAmount: money.MustParse("23.47")},
+		{ItemID: 4, Quantity: 1, LineExtensionAmount: money.MustParse("10")},
+	}
+
+	taxSubTotalRequests, _, err := taxSubTotalRequests(lines, items, nil, nil, false, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(taxSubTotalRequests), "they should be equal")
+	assert.Equal(t, uint32(1), taxSubTotalRequests[0].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "123.47", taxSubTotalRequests[0].TaxableAmount, "they should be equal")
+	assert.Equal(t, "24.69", taxSubTotalRequests[0].TaxAmount, "they should be equal")
+	assert.Equal(t, float32(20), taxSubTotalRequests[0].Percent, "they should be equal")
+	assert.Equal(t, uint32(2), taxSubTotalRequests[1].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "30.00", taxSubTotalRequests[1].TaxableAmount, "they should be equal")
+	assert.Equal(t, "1.50", taxSubTotalRequests[1].TaxAmount, "they should be equal")
+	assert.Equal(t, "LTR", taxSubTotalRequests[1].BaseUnitMeasure, "they should be equal")
+}
+
+func TestTaxSubTotalRequests_AllowanceCharges(t *testing.T) {
+	standardRate := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 20}
+	reducedRate := &taxproto.TaxCategoryD{Id: 5, TcId: "AA", Percent: 10}
+	items := map[uint32]*ubl.ItemSource{
+		1: {Item: &itemproto.ItemD{Id: 1}, TaxCategory: standardRate, TaxCategories: []*taxproto.TaxCategoryD{standardRate}},
+	}
+	discount := &allowancecharge.AllowanceCharge{Amount: money.MustParse("15"), TaxCategoryID: 1}
+	freight := &allowancecharge.AllowanceCharge{ChargeIndicator: true, Amount: money.MustParse("30"), TaxCategoryID: 5}
+	lines := append([]TaxLine{{ItemID: 1, Quantity: 1, LineExtensionAmount: money.MustParse("100")}}, allowanceChargeTaxLines([]*allowancecharge.AllowanceCharge{discount, freight})...)
+
+	taxSubTotalRequests, _, err := taxSubTotalRequests(lines, items, map[uint32]*taxproto.TaxCategoryD{1: standardRate, 5: reducedRate}, nil, false, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(taxSubTotalRequests), "they should be equal")
+	assert.Equal(t, "85.00", taxSubTotalRequests[0].TaxableAmount, "they should be equal")
+	assert.Equal(t, "17.00", taxSubTotalRequests[0].TaxAmount, "they should be equal")
+	assert.Equal(t, uint32(5), taxSubTotalRequests[1].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "30.00", taxSubTotalRequests[1].TaxableAmount, "they should be equal")
+	assert.Equal(t, "3.00", taxSubTotalRequests[1].TaxAmount, "they should be equal")
+}
+
+func TestTaxSubTotalRequests_Withholding(t *testing.T) {
+	vat := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 16}
+	incomeTax := &taxproto.TaxCategoryD{Id: 2, TcId: "ISR", Percent: 10, WithholdingIndicator: true}
+	vatWithholding := &taxproto.TaxCategoryD{Id: 3, TcId: "IVA", Percent: 10.6667, WithholdingIndicator: true}
+	items := map[uint32]*ubl.ItemSource{
+		1: {Item: &itemproto.ItemD{Id: 1}, TaxCategory: vat, TaxCategories: []*taxproto.TaxCategoryD{vat, incomeTax, vatWithholding}},
+		2: {Item: &itemproto.ItemD{Id: 2}, TaxCategory: vat, TaxCategories: []*taxproto.TaxCategoryD{vat}},
+	}
+	lines := []TaxLine{
+		{ItemID: 1, Quantity: 1, LineExtensionAmount: money.MustParse("1000")},
+		{ItemID: 2, Quantity: 1, LineExtensionAmount: money.MustParse("500")},
+	}
+
+	vatRequests, _, err := taxSubTotalRequests(lines, items, nil, nil, false, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(vatRequests), "they should be equal")
+	assert.Equal(t, uint32(1), vatRequests[0].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "1500.00", vatRequests[0].TaxableAmount, "they should be equal")
+	assert.Equal(t, "240.00", vatRequests[0].TaxAmount, "they should be equal")
+
+	withholdingRequests, _, err := taxSubTotalRequests(lines, items, nil, nil, true, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(withholdingRequests), "they should be equal")
+	assert.Equal(t, uint32(2), withholdingRequests[0].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "1000.00", withholdingRequests[0].TaxableAmount, "the withholding is on the net amount of the line")
+	assert.Equal(t, "100.00", withholdingRequests[0].TaxAmount, "they should be equal")
+	assert.Equal(t, uint32(3), withholdingRequests[1].TaxCategoryId, "they should be equal")
+	assert.Equal(t, "106.67", withholdingRequests[1].TaxAmount, "they should be equal")
+
+	assert.Equal(t, "0.00", withholdingTaxTotalAmount(nil, rounding.Default), "they should be equal")
+	assert.Equal(t, "206.67", withholdingTaxTotalAmount(&taxproto.TaxTotal{TaxTotalD: &taxproto.TaxTotalD{TaxAmount: "206.666667"}}, rounding.Default), "they should be equal")
+	assert.Equal(t, "207.00", withholdingTaxTotalAmount(&taxproto.TaxTotal{TaxTotalD: &taxproto.TaxTotalD{TaxAmount: "206.666667"}}, rounding.ForCurrency("JPY")), "they should be equal")
+}
+
+func TestTaxSubTotalRequests_RoundingLevel(t *testing.T) {
+	lineRounded := &taxproto.TaxSchemeD{Id: 1, TsId: "VAT", RoundingLevel: string(rounding.Line)}
+	vat := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 10, TaxSchemeId: 1}
+	items := map[uint32]*ubl.ItemSource{
+		1: {Item: &itemproto.ItemD{Id: 1}, TaxCategory: vat, TaxCategories: []*taxproto.TaxCategoryD{vat}},
+	}
+	lines := []TaxLine{
+		{ItemID: 1, Quantity: 1, LineExtensionAmount: money.MustParse("1.05")},
+		{ItemID: 1, Quantity: 1, LineExtensionAmount: money.MustParse("1.05")},
+		{ItemID: 1, Quantity: 1, LineExtensionAmount: money.MustParse("1.05")},
+	}
+
+	documentRequests, roundingAmount, err := taxSubTotalRequests(lines, items, nil, nil, false, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.32", documentRequests[0].TaxAmount, "the tax of 3.15 is rounded once")
+	assert.Equal(t, "0.005", roundingAmount.String(), "they should be equal")
+
+	lineRequests, roundingAmount, err := taxSubTotalRequests(lines, items, nil, map[uint32]*taxproto.TaxSchemeD{1: lineRounded}, false, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.33", lineRequests[0].TaxAmount, "the tax of 1.05 is rounded on each line")
+	assert.Equal(t, "0.015", roundingAmount.String(), "they should be equal")
+}
+
+func TestSettlePrepayment(t *testing.T) {
+	prepayment := &invoiceproto.InvoiceHeaderD{IhId: "ADV-1", InvoiceTypeCode: invoiceTypeCodePrepayment, PayableAmount: "500.00"}
+
+	amount, err := settlePrepayment(prepayment, money.Zero, money.Zero)
+	assert.NoError(t, err)
+	assert.Equal(t, "500.00", amount.String(), "what is left is settled")
+
+	amount, err = settlePrepayment(prepayment, money.MustParse("300"), money.Zero)
+	assert.NoError(t, err)
+	assert.Equal(t, "200.00", amount.String(), "what is left is settled")
+
+	amount, err = settlePrepayment(prepayment, money.MustParse("300"), money.MustParse("150"))
+	assert.NoError(t, err)
+	assert.Equal(t, "150.00", amount.String(), "they should be equal")
+
+	_, err = settlePrepayment(prepayment, money.MustParse("300"), money.MustParse("250"))
+	assert.Error(t, err, "more than what is left")
+
+	_, err = settlePrepayment(prepayment, money.MustParse("500"), money.Zero)
+	assert.Error(t, err, "a settled advance payment invoice")
+}
+
+func TestTaxCurrencyTaxAmount(t *testing.T) {
+	taxSubTotals := func() []*taxproto.TaxSubTotal {
+		return []*taxproto.TaxSubTotal{
+			{TaxSubTotalD: &taxproto.TaxSubTotalD{TaxableAmount: "1273.00", TaxAmount: "318.25"}},
+			{TaxSubTotalD: &taxproto.TaxSubTotalD{TaxableAmount: "227.00", TaxAmount: "0.00", TransactionCurrencyTaxAmount: "0.00"}},
+		}
+	}
+	hd := invoiceproto.InvoiceHeaderD{DocumentCurrencyCode: "EUR", TaxCurrencyCode: "DKK", TaxExSourceCurrencyCode: "EUR", TaxExTargetCurrencyCode: "DKK", TaxExCalculationRate: 7.4382, TaxExMathematicOperatorCode: exchangerate.Multiply}
+	exchange := exchangerate.Get(&hd, exchangerate.TaxExchange, hd.DocumentCurrencyCode, hd.TaxCurrencyCode)
+
+	sts := taxSubTotals()
+	taxAmount, ok := taxCurrencyTaxAmount(sts, exchange, "0.00")
+	assert.True(t, ok)
+	assert.Equal(t, "2367.21", taxAmount, "they should be equal")
+	assert.Equal(t, "2367.21", sts[0].TaxSubTotalD.TransactionCurrencyTaxAmount, "they should be equal")
+	assert.Equal(t, "0.00", sts[1].TaxSubTotalD.TransactionCurrencyTaxAmount, "they should be equal")
+
+	sts = taxSubTotals()
+	taxAmount, ok = taxCurrencyTaxAmount(sts, nil, "2367.20")
+	assert.True(t, ok)
+	assert.Equal(t, "2367.20", taxAmount, "a given amount is kept")
+	assert.Equal(t, "", sts[0].TaxSubTotalD.TransactionCurrencyTaxAmount, "they should be equal")
+
+	_, ok = taxCurrencyTaxAmount(taxSubTotals(), nil, "")
+	assert.False(t, ok, "no tax amount without an exchange rate")
+
+	taxAmount, ok = taxCurrencyTaxAmount(taxSubTotals()[1:], nil, "")
+	assert.True(t, ok)
+	assert.Equal(t, "0.00", taxAmount, "a zero tax amount needs no exchange rate")
+}
+
+func TestProcessTaxCurrencyTaxTotal(t *testing.T) {
+	hd := invoiceproto.InvoiceHeaderD{DocumentCurrencyCode: "EUR", TaxCurrencyCode: "dkk", TaxExSourceCurrencyCode: "EUR", TaxExTargetCurrencyCode: "DKK", TaxExCalculationRate: 7.4382, TaxExMathematicOperatorCode: exchangerate.Multiply}
+	taxTotal := taxproto.TaxTotal{TaxTotalD: &taxproto.TaxTotalD{MasterFlag: ubl.MasterFlagInvoiceHeader, TaxAmount: "318.25"}}
+	taxSubTotals := []*taxproto.TaxSubTotal{{TaxSubTotalD: &taxproto.TaxSubTotalD{TaxableAmount: "1273.00", TaxAmount: "318.25"}}}
+
+	taxCurrencyTaxTotal, err := processTaxCurrencyTaxTotal(context.Background(), zap.NewNop(), &hd, hd.DocumentCurrencyCode, hd.TaxCurrencyCode, "", &taxTotal, taxSubTotals, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "2367.21", taxCurrencyTaxTotal.TaxTotalD.TaxAmount, "they should be equal")
+	assert.Equal(t, "DKK", taxCurrencyTaxTotal.TaxTotalD.CurrencyCode, "the tax total is in the tax currency")
+	assert.Equal(t, "2367.21", taxSubTotals[0].TaxSubTotalD.TransactionCurrencyTaxAmount, "they should be equal")
+	assert.Equal(t, "DKK", taxSubTotals[0].TaxSubTotalD.TransactionCurrencyCode, "the transaction currency tax amount has its currency code")
+
+	taxCurrencyTaxTotal, err = processTaxCurrencyTaxTotal(context.Background(), zap.NewNop(), &hd, hd.DocumentCurrencyCode, "EUR", "", &taxTotal, taxSubTotals, "", "")
+	assert.NoError(t, err)
+	assert.Nil(t, taxCurrencyTaxTotal, "no tax total in the document currency")
+}
+
+func TestInvoiceService_ValidateInvoice(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	invoiceHeader := invoiceproto.CreateInvoiceRequest{}
+	invoiceHeader.IhId = "INV-2022-003"
+	invoiceHeader.DocumentCurrencyCode = "EUR"
+	invoiceHeader.AccountingSupplierPartyId = uint32(5)
+	invoiceHeader.AccountingCustomerPartyId = uint32(4)
+	invoiceHeader.IssueDate = "06/21/2022"
+	invoiceHeader.DueDate = "06/25/2022"
+	invoiceHeader.InvoiceTypeCode = "SalesInvoice"
+	invoiceHeader.LineExtensionAmount = "200"
+	invoiceHeader.TaxExclusiveAmount = "200"
+	invoiceHeader.TaxInclusiveAmount = "200"
+	invoiceHeader.PayableAmount = "200"
+
+	taxSubTotal := taxproto.CreateTaxSubTotalRequest{}
+	taxSubTotal.TaxableAmount = "200"
+	taxSubTotal.TaxCategoryId = uint32(15)
+	invoiceHeader.TaxSubTotals = []*taxproto.CreateTaxSubTotalRequest{&taxSubTotal}
+
+	invoiceLine := invoiceproto.CreateInvoiceLineRequest{}
+	invoiceLine.IlId = "1"
+	invoiceLine.InvoicedQuantity = float64(1)
+	invoiceLine.LineExtensionAmount = "200"
+	invoiceLine.PriceAmount = "200"
+	invoiceLine.ItemId = uint32(1)
+	invoiceHeader.InvoiceLines = []*invoiceproto.CreateInvoiceLineRequest{&invoiceLine}
+
+	invoiceHeader2 := proto.Clone(&invoiceHeader).(*invoiceproto.CreateInvoiceRequest)
+	invoiceHeader2.PayableAmount = "250"
+	invoiceHeader2.TaxSubTotals[0].TaxableAmount = "150"
+
+	type args struct {
+		ctx context.Context
+		in  *invoiceproto.ValidateInvoiceRequest
+	}
+	tests := []struct {
+		is    *InvoiceService
+		args  args
+		valid bool
+		rules []string
+	}{
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &invoiceproto.ValidateInvoiceRequest{Invoice: &invoiceHeader, UserId: "auth0|673c75d516e8adb9e6ffc892", UserEmail: "sprov300@gmail.com", RequestId: "bks1m1g91jau4nkks2f0"},
+			},
+			valid: true,
+			rules: []string{},
+		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  &invoiceproto.ValidateInvoiceRequest{Invoice: invoiceHeader2, UserId: "auth0|673c75d516e8adb9e6ffc892", UserEmail: "sprov300@gmail.com", RequestId: "bks1m1g91jau4nkks2f0"},
+			},
+			valid: false,
+			rules: []string{"BR-CO-16", "BR-O-8"},
+		},
+	}
+	for _, tt := range tests {
+		validateInvoiceResp, err := tt.is.ValidateInvoice(tt.args.ctx, tt.args.in)
+		if err != nil {
+			t.Errorf("InvoiceService.ValidateInvoice() error = %v", err)
+			return
+		}
+		assert.Equal(t, tt.valid, validateInvoiceResp.Valid, "they should be equal")
+		rules := []string{}
+		for _, problem := range validateInvoiceResp.Problems {
+			rules = append(rules, problem.Rule)
+		}
+		assert.Equal(t, tt.rules, rules, "they should be equal")
 	}
 }
 
@@ -417,6 +1299,7 @@ func GetInvoiceHeader(id uint32, uuid4 []byte, idS string, issueDate string, due
 	invoiceHeaderD.IdS = idS
 	invoiceHeaderD.Note = note
 	invoiceHeaderD.InvoiceTypeCode = invoiceTypeCode
+	invoiceHeaderD.DocumentStatusCode = "draft"
 
 	invoiceHeaderT := invoiceproto.InvoiceHeaderT{}
 	invoiceHeaderT.IssueDate = issueDate1
@@ -443,7 +1326,7 @@ func GetInvoiceHeader(id uint32, uuid4 []byte, idS string, issueDate string, due
 	return &invoiceHeader, nil
 }
 
-func GetInvoiceLine(id uint32, uuid4 []byte, idS string, invoicedQuantity float64, lineExtensionAmount float64, itemId uint32, taxPointDate string, invoicePeriodStartDate string, invoicePeriodEndDate string, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, invoiceHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.InvoiceLine, error) {
+func GetInvoiceLine(id uint32, uuid4 []byte, idS string, invoicedQuantity float64, lineExtensionAmount string, itemId uint32, taxPointDate string, invoicePeriodStartDate string, invoicePeriodEndDate string, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, invoiceHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*invoiceproto.InvoiceLine, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -523,15 +1406,18 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 	form := invoiceproto.UpdateInvoiceRequest{}
 	form.Note = "Ordered"
 	form.InvoiceTypeCode = "Sales"
-	form.ChargeTotalAmount = float64(200)
-	form.PrepaidAmount = float64(100)
-	form.PayableRoundingAmount = float64(150)
-	form.PayableAmount = float64(400)
+	form.ChargeTotalAmount = "200"
+	form.PrepaidAmount = "100"
+	form.PayableRoundingAmount = "150"
+	form.PayableAmount = "400"
 	form.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
 	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	form.UserEmail = "sprov300@gmail.com"
 	form.RequestId = "bks1m1g91jau4nkks2f0"
 
+	form2 := proto.Clone(&form).(*invoiceproto.UpdateInvoiceRequest)
+	form2.RejectMismatchedTotals = true
+
 	updateResponse := invoiceproto.UpdateInvoiceResponse{}
 
 	type args struct {
@@ -553,6 +1439,14 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 			want:    &updateResponse,
 			wantErr: false,
 		},
+		{
+			is: invoiceService,
+			args: args{
+				ctx: ctx,
+				in:  form2,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		got, err := tt.is.UpdateInvoice(tt.args.ctx, tt.args.in)
@@ -560,8 +1454,228 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 			t.Errorf("InvoiceService.UpdateInvoice() error = %v, wantErr %v", err, tt.wantErr)
 			return
 		}
+		if tt.wantErr {
+			assert.Contains(t, err.Error(), "BR-CO-16", "they should be equal")
+			continue
+		}
 		if !reflect.DeepEqual(got, tt.want) {
 			t.Errorf("InvoiceService.UpdateInvoice() = %v, want %v", got, tt.want)
 		}
 	}
 }
+
+func TestInvoiceService_CancelInvoice(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.TransitionDocumentStatusRequest{}
+	form.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = invoiceService.CancelInvoice(ctx, &form)
+	assert.Error(t, err, "an invoice is not cancelled without a reason")
+
+	form.Reason = "Sent to the wrong customer"
+	transitionResponse, err := invoiceService.CancelInvoice(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	documentStatusTransitionD := transitionResponse.DocumentStatusTransition.DocumentStatusTransitionD
+	assert.Equal(t, "draft", documentStatusTransitionD.FromStatusCode, "they should be equal")
+	assert.Equal(t, "cancelled", documentStatusTransitionD.ToStatusCode, "they should be equal")
+
+	getRequest := commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}
+	transitionsResponse, err := invoiceService.GetInvoiceStatusTransitions(ctx, &invoiceproto.GetDocumentStatusTransitionsRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Len(t, transitionsResponse.DocumentStatusTransitions, 1, "they should be equal")
+	assert.Equal(t, form.Reason, transitionsResponse.DocumentStatusTransitions[0].DocumentStatusTransitionD.Reason, "they should be equal")
+
+	_, err = invoiceService.SendInvoice(ctx, &form)
+	assert.Error(t, err, "cancelled is final")
+
+	updateForm := invoiceproto.UpdateInvoiceRequest{Id: form.Id, ChargeTotalAmount: "200", PrepaidAmount: "100", UserId: form.UserId, UserEmail: form.UserEmail, RequestId: form.RequestId}
+	_, err = invoiceService.UpdateInvoice(ctx, &updateForm)
+	if assert.Error(t, err, "a cancelled invoice cannot be updated") {
+		assert.Contains(t, err.Error(), "cannot be changed", "they should be equal")
+	}
+}
+
+func TestInvoiceService_ReviseInvoice(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.ReviseInvoiceRequest{}
+	form.UpdateInvoiceRequest = &invoiceproto.UpdateInvoiceRequest{}
+	form.UpdateInvoiceRequest.Note = "sample revised"
+	form.UpdateInvoiceRequest.InvoiceTypeCode = "SalesInvoice"
+	form.UpdateInvoiceRequest.Id = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	form.UpdateInvoiceRequest.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UpdateInvoiceRequest.UserEmail = "sprov300@gmail.com"
+	form.UpdateInvoiceRequest.RequestId = "bks1m1g91jau4nkks2f0"
+	form.Reason = "Wrong note"
+
+	_, err = invoiceService.ReviseInvoice(ctx, &form)
+	if assert.Error(t, err, "a draft invoice is updated, not revised") {
+		assert.Contains(t, err.Error(), "not revised", "they should be equal")
+	}
+
+	_, err = dbService.DB.ExecContext(ctx, `update invoice_headers set document_status_code = ? where uuid4 = UNHEX(REPLACE(?,'-',''));`, "issued", form.UpdateInvoiceRequest.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	form.Reason = ""
+	_, err = invoiceService.ReviseInvoice(ctx, &form)
+	assert.Error(t, err, "a revision needs a reason")
+
+	form.Reason = "Wrong note"
+	reviseResponse, err := invoiceService.ReviseInvoice(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, uint32(2), reviseResponse.DocumentVersion.DocumentVersionD.VersionNumber, "they should be equal")
+	assert.Equal(t, form.Reason, reviseResponse.DocumentVersion.DocumentVersionD.Reason, "they should be equal")
+
+	getRequest := commonproto.GetRequest{Id: form.UpdateInvoiceRequest.Id, UserEmail: form.UpdateInvoiceRequest.UserEmail, RequestId: form.UpdateInvoiceRequest.RequestId}
+	versionsResponse, err := invoiceService.GetInvoiceVersions(ctx, &commonproto.GetDocumentVersionsRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Len(t, versionsResponse.DocumentVersions, 2, "they should be equal")
+
+	diffResponse, err := invoiceService.DiffInvoiceVersions(ctx, &commonproto.DiffDocumentVersionsRequest{Id: getRequest.Id, FromVersionNumber: 1, ToVersionNumber: 2, UserEmail: getRequest.UserEmail, RequestId: getRequest.RequestId})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Contains(t, diffResponse.DocumentVersionChanges, &commonproto.DocumentVersionChange{Path: "header.invoice_header_d.note", FromValue: "sample", ToValue: "sample revised"}, "they should be equal")
+
+	_, err = invoiceService.DiffInvoiceVersions(ctx, &commonproto.DiffDocumentVersionsRequest{Id: getRequest.Id, FromVersionNumber: 1, ToVersionNumber: 3, UserEmail: getRequest.UserEmail, RequestId: getRequest.RequestId})
+	assert.Error(t, err, "there is no version 3")
+}
+
+func TestInvoiceService_UpdateInvoiceLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	invoiceService := NewInvoiceService(log, dbService, redisService, userServiceClient)
+
+	form := invoiceproto.UpdateInvoiceLineRequest{}
+	form.Note = "sample updated"
+	form.InvoicedQuantity = float64(10)
+	form.PriceAmount = "2.50"
+	form.PriceBaseQuantity = float64(1)
+	form.Id = "61c16242-b61f-479e-b937-0e9347e18e45"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = invoiceService.UpdateInvoiceLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "834dd586-a1b8-4e3f-89cf-bfd25edd9a60", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	invoiceLinesResponse, err := invoiceService.GetInvoiceLines(ctx, &invoiceproto.GetInvoiceLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	invoiceLineResult := invoiceLinesResponse.InvoiceLines[0]
+	assert.Equal(t, invoiceLineResult.InvoiceLineD.Note, "sample updated", "they should be equal")
+	assert.Equal(t, invoiceLineResult.InvoiceLineD.LineExtensionAmount, "25.00", "they should be equal")
+
+	invoiceResponse, err := invoiceService.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, invoiceResponse.InvoiceHeader.InvoiceHeaderD.LineCountNumeric, uint32(1), "they should be equal")
+	assert.Equal(t, invoiceResponse.InvoiceHeader.InvoiceHeaderD.LineExtensionAmount, "25.00", "they should be equal")
+
+	_, err = dbService.DB.ExecContext(ctx, `update invoice_headers set document_status_code = ? where uuid4 = UNHEX(REPLACE(?,'-',''));`, "issued", getRequest.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	deleteRequest := invoiceproto.DeleteInvoiceLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}}
+	_, err = invoiceService.DeleteInvoiceLine(ctx, &deleteRequest)
+	assert.Error(t, err, "the lines of an issued invoice are not changed")
+
+	_, err = dbService.DB.ExecContext(ctx, `update invoice_headers set document_status_code = ? where uuid4 = UNHEX(REPLACE(?,'-',''));`, "draft", getRequest.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = invoiceService.DeleteInvoiceLine(ctx, &deleteRequest)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	invoiceResponse, err = invoiceService.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, invoiceResponse.InvoiceHeader.InvoiceHeaderD.LineCountNumeric, uint32(0), "they should be equal")
+	assert.Equal(t, invoiceResponse.InvoiceHeader.InvoiceHeaderD.LineExtensionAmount, "0.00", "they should be equal")
+
+	_, err = invoiceService.UpdateInvoiceLine(ctx, &form)
+	assert.Error(t, err, "a deleted line is not updated")
+}
+
+func TestCheckDocumentTransition(t *testing.T) {
+	doc := statusDocument{MasterFlag: ubl.MasterFlagInvoiceHeader, ID: 1, DocumentID: "INV-1", StatusCode: "draft", LineCount: 2}
+	assert.NoError(t, checkDocumentTransition(&doc, docstatus.Issued, ""))
+	assert.Error(t, checkDocumentTransition(&doc, docstatus.Sent, ""), "a draft is issued before it is sent")
+	assert.Error(t, checkDocumentTransition(&doc, docstatus.Cancelled, " "), "a cancellation needs a reason")
+	assert.NoError(t, checkDocumentTransition(&doc, docstatus.Cancelled, "Duplicate"))
+
+	empty := doc
+	empty.LineCount = 0
+	assert.Error(t, checkDocumentTransition(&empty, docstatus.Issued, ""), "an invoice without lines is not issued")
+	unnumbered := doc
+	unnumbered.DocumentID = ""
+	assert.NoError(t, checkDocumentTransition(&unnumbered, docstatus.Issued, ""), "an invoice is numbered when it is issued")
+	assert.Error(t, checkDocumentNumber(""), "an invoice without an id is not issued")
+	assert.NoError(t, checkDocumentNumber("INV-1"))
+
+	sent := doc
+	sent.StatusCode = "sent"
+	assert.NoError(t, checkDocumentTransition(&sent, docstatus.PartiallyPaid, ""))
+	assert.Error(t, checkDocumentTransition(&sent, docstatus.Disputed, ""), "a dispute needs a reason")
+	assert.NoError(t, checkDocumentTransition(&sent, docstatus.Disputed, "Quantity not delivered"))
+
+	unknown := doc
+	unknown.StatusCode = "active"
+	assert.Error(t, checkDocumentTransition(&unknown, docstatus.Issued, ""))
+}
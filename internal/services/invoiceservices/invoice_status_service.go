@@ -0,0 +1,80 @@
+package invoiceservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// IssueInvoice - move a draft invoice with lines to issued, from then on it cannot be changed.
+// Without a number of its own it is numbered from the sequence of its supplier.
+func (is *InvoiceService) IssueInvoice(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Issued)
+}
+
+// SendInvoice - move an issued invoice to sent
+func (is *InvoiceService) SendInvoice(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Sent)
+}
+
+// AcknowledgeInvoice - move a sent or disputed invoice to acknowledged
+func (is *InvoiceService) AcknowledgeInvoice(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Acknowledged)
+}
+
+// MarkInvoicePartiallyPaid - move an invoice that is due to partially paid
+func (is *InvoiceService) MarkInvoicePartiallyPaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.PartiallyPaid)
+}
+
+// MarkInvoicePaid - move an invoice that is due to paid
+func (is *InvoiceService) MarkInvoicePaid(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Paid)
+}
+
+// MarkInvoiceDisputed - move an invoice that is due to disputed, with the reason
+func (is *InvoiceService) MarkInvoiceDisputed(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Disputed)
+}
+
+// CancelInvoice - cancel an invoice that is not paid, not even in part, with the reason
+func (is *InvoiceService) CancelInvoice(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	return is.transitionInvoice(ctx, in, docstatus.Cancelled)
+}
+
+// transitionInvoice - move the invoice in.Id to the status to
+func (is *InvoiceService) transitionInvoice(ctx context.Context, in *invoiceproto.TransitionDocumentStatusRequest, to docstatus.Status) (*invoiceproto.TransitionDocumentStatusResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	invoiceLinesResponse, err := is.GetInvoiceLines(ctx, &invoiceproto.GetInvoiceLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	hd := invoiceResponse.InvoiceHeader.InvoiceHeaderD
+	doc := statusDocument{MasterFlag: ubl.MasterFlagInvoiceHeader, ID: hd.Id, DocumentID: hd.IhId, Numbering: numbering.Invoice, PartyID: hd.AccountingSupplierPartyId, IssueDate: common.TimestampToTime(invoiceResponse.InvoiceHeader.InvoiceHeaderT.IssueDate), StatusCode: hd.DocumentStatusCode, LineCount: len(invoiceLinesResponse.InvoiceLines)}
+	return transitionDocumentStatus(ctx, is.log, is.DBService, is.UserServiceClient, &doc, to, in)
+}
+
+// GetInvoiceStatusTransitions - Get the transitions of the status of an invoice,
+// oldest first
+func (is *InvoiceService) GetInvoiceStatusTransitions(ctx context.Context, inReq *invoiceproto.GetDocumentStatusTransitionsRequest) (*invoiceproto.GetDocumentStatusTransitionsResponse, error) {
+	in := inReq.GetRequest
+	invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: in})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return getDocumentStatusTransitions(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, invoiceResponse.InvoiceHeader.InvoiceHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
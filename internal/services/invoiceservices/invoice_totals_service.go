@@ -0,0 +1,275 @@
+package invoiceservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// TotalsLine - quantity, price and allowances and charges of a document
+// line, LineExtensionAmount is set by Totals.Calculate
+type TotalsLine struct {
+	Quantity            float64
+	PriceAmount         money.Amount
+	PriceBaseQuantity   float64
+	AllowanceCharges    []*allowancecharge.AllowanceCharge
+	LineExtensionAmount money.Amount
+}
+
+// Totals - the monetary totals of a document (UBL LegalMonetaryTotal,
+// EN 16931 BG-22). CurrencyCode, Lines, AllowanceCharges, TaxAmount,
+// WithholdingTaxAmount and PrepaidAmount are the inputs, the other amounts
+// are set by Calculate.
+type Totals struct {
+	CurrencyCode         string
+	Lines                []TotalsLine
+	AllowanceCharges     []*allowancecharge.AllowanceCharge // document level
+	TaxAmount            money.Amount
+	WithholdingTaxAmount money.Amount
+	PrepaidAmount        money.Amount
+
+	LineExtensionAmount   money.Amount
+	AllowanceTotalAmount  money.Amount
+	ChargeTotalAmount     money.Amount
+	TaxExclusiveAmount    money.Amount
+	TaxInclusiveAmount    money.Amount
+	PayableRoundingAmount money.Amount
+	PayableAmount         money.Amount
+}
+
+// Calculate - derive the line extension amount of each line as quantity
+// times price divided by the price base quantity (1 when not given), less
+// its allowances and plus its charges, and the document totals from the
+// lines, allowances, charges and tax. The base amount of a percentage
+// allowance or charge is quantity times price for a line and the sum of
+// the line extension amounts for the document. Withheld tax is not part of
+// TaxInclusiveAmount, it is taken off the amount due with the prepaid
+// amount. Every amount is rounded with the rounding.Policy of
+// CurrencyCode, the amount due to its cash increment, such as 0.05 for
+// CHF; PayableRoundingAmount holds what rounding the amount due adds to it.
+func (t *Totals) Calculate() {
+	policy := rounding.ForCurrency(t.CurrencyCode)
+	for i := range t.Lines {
+		l := &t.Lines[i]
+		gross := allowancecharge.LineBase(l.Quantity, l.PriceAmount, l.PriceBaseQuantity, policy)
+		allowanceTotal, chargeTotal := allowancecharge.Totals(l.AllowanceCharges, gross, policy)
+		l.LineExtensionAmount = gross.Sub(allowanceTotal).Add(chargeTotal)
+	}
+	t.CalculateDocument()
+}
+
+// CalculateDocument - derive the document totals as Calculate does, from
+// the LineExtensionAmount the lines already have; the lines of credit and
+// debit notes keep the one they are sent with
+func (t *Totals) CalculateDocument() {
+	policy := rounding.ForCurrency(t.CurrencyCode)
+	lineExtensionAmounts := make([]money.Amount, len(t.Lines))
+	for i, l := range t.Lines {
+		lineExtensionAmounts[i] = l.LineExtensionAmount
+	}
+	t.LineExtensionAmount = money.Sum(lineExtensionAmounts...)
+
+	t.AllowanceTotalAmount, t.ChargeTotalAmount = allowancecharge.Totals(t.AllowanceCharges, t.LineExtensionAmount, policy)
+
+	t.TaxExclusiveAmount = t.LineExtensionAmount.Sub(t.AllowanceTotalAmount).Add(t.ChargeTotalAmount)
+	t.TaxInclusiveAmount = t.TaxExclusiveAmount.Add(policy.Round(t.TaxAmount))
+
+	payable := t.TaxInclusiveAmount.Sub(policy.Round(t.WithholdingTaxAmount)).Sub(t.PrepaidAmount)
+	t.PayableAmount = policy.RoundPayable(payable)
+	t.PayableRoundingAmount = t.PayableAmount.Sub(payable)
+}
+
+// invoiceTotals - the Totals of an invoice, with the withholding tax and
+// prepaid amounts of its header and its documentAllowanceCharges. The
+// amounts of the invoice are normalized, so they parse without error.
+func invoiceTotals(invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine, taxAmount money.Amount) *Totals {
+	hd := invoiceHeader.InvoiceHeaderD
+	totals := Totals{CurrencyCode: hd.DocumentCurrencyCode, TaxAmount: taxAmount, WithholdingTaxAmount: parseAmount(hd.WithholdingTaxTotalAmount), PrepaidAmount: parseAmount(hd.PrepaidAmount)}
+	for _, invoiceLine := range invoiceLines {
+		totals.Lines = append(totals.Lines, invoiceTotalsLine(invoiceLine))
+	}
+	totals.AllowanceCharges = documentAllowanceCharges(invoiceHeader.AllowanceCharges, hd.AllowanceTotalAmount, hd.ChargeTotalAmount)
+	totals.Calculate()
+	return &totals
+}
+
+// invoiceTotalsLine - the TotalsLine of an invoice line
+func invoiceTotalsLine(invoiceLine *invoiceproto.InvoiceLine) TotalsLine {
+	ld := invoiceLine.InvoiceLineD
+	return TotalsLine{Quantity: ld.InvoicedQuantity, PriceAmount: parseAmount(ld.PriceAmount), PriceBaseQuantity: ld.PriceBaseQuantity, AllowanceCharges: allowancecharge.FromProtos(invoiceLine.AllowanceCharges)}
+}
+
+// calculateInvoiceLine - set the line extension amount and the amounts of
+// the allowances and charges of an invoice line that is not part of an
+// invoice to the ones Totals.Calculate derives for it
+func calculateInvoiceLine(invoiceLine *invoiceproto.InvoiceLine) {
+	totals := Totals{CurrencyCode: invoiceLine.InvoiceLineD.CurrencyCode, Lines: []TotalsLine{invoiceTotalsLine(invoiceLine)}}
+	totals.Calculate()
+	allowancecharge.SetProtos(invoiceLine.AllowanceCharges, totals.Lines[0].AllowanceCharges)
+	invoiceLine.InvoiceLineD.LineExtensionAmount = totals.Lines[0].LineExtensionAmount.String()
+}
+
+// noteTotals - the Totals of a credit or debit note, from the line
+// extension amounts of its lines, its allowances and charges on document
+// level, its withholding tax and prepaid amounts and taxAmount
+func noteTotals(currencyCode string, lineExtensionAmounts []money.Amount, allowanceCharges []*commonproto.AllowanceCharge, allowanceTotalAmount string, chargeTotalAmount string, withholdingTaxTotalAmount string, prepaidAmount string, taxAmount money.Amount) *Totals {
+	totals := Totals{CurrencyCode: currencyCode, TaxAmount: taxAmount, WithholdingTaxAmount: parseAmount(withholdingTaxTotalAmount), PrepaidAmount: parseAmount(prepaidAmount)}
+	for _, lineExtensionAmount := range lineExtensionAmounts {
+		totals.Lines = append(totals.Lines, TotalsLine{LineExtensionAmount: lineExtensionAmount})
+	}
+	totals.AllowanceCharges = documentAllowanceCharges(allowanceCharges, allowanceTotalAmount, chargeTotalAmount)
+	totals.CalculateDocument()
+	return &totals
+}
+
+// documentAllowanceCharges - the allowancecharge.AllowanceCharges of the
+// allowances and charges on document level of a document. A document
+// stored before allowances and charges were kept has none, its
+// allowanceTotalAmount and chargeTotalAmount are then taken as the only
+// allowance and charge.
+func documentAllowanceCharges(allowanceCharges []*commonproto.AllowanceCharge, allowanceTotalAmount string, chargeTotalAmount string) []*allowancecharge.AllowanceCharge {
+	acs := allowancecharge.FromProtos(allowanceCharges)
+	if len(allowanceCharges) == 0 {
+		if a := parseAmount(allowanceTotalAmount); a != money.Zero {
+			acs = append(acs, &allowancecharge.AllowanceCharge{Amount: a})
+		}
+		if c := parseAmount(chargeTotalAmount); c != money.Zero {
+			acs = append(acs, &allowancecharge.AllowanceCharge{ChargeIndicator: true, Amount: c})
+		}
+	}
+	return acs
+}
+
+// invoiceTaxLines - the TaxLines of an invoice, with the line extension
+// amounts Totals.Calculate derives for its lines, and its document
+// allowances and charges
+func invoiceTaxLines(invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine) []TaxLine {
+	totals := invoiceTotals(invoiceHeader, invoiceLines, money.Zero)
+	taxLines := []TaxLine{}
+	for i, invoiceLine := range invoiceLines {
+		taxLines = append(taxLines, TaxLine{ItemID: invoiceLine.InvoiceLineD.ItemId, Quantity: invoiceLine.InvoiceLineD.InvoicedQuantity, LineExtensionAmount: totals.Lines[i].LineExtensionAmount})
+	}
+	return append(taxLines, allowanceChargeTaxLines(totals.AllowanceCharges)...)
+}
+
+// allowanceChargeTaxLines - a TaxLine for each document allowance or
+// charge with a tax category, an allowance lowers the taxable amount of
+// its category
+func allowanceChargeTaxLines(acs []*allowancecharge.AllowanceCharge) []TaxLine {
+	taxLines := []TaxLine{}
+	for _, ac := range acs {
+		if ac.TaxCategoryID != 0 {
+			taxLines = append(taxLines, TaxLine{TaxCategoryID: ac.TaxCategoryID, LineExtensionAmount: ac.Signed()})
+		}
+	}
+	return taxLines
+}
+
+// parseAmount - the Amount of a normalized amount
+func parseAmount(s string) money.Amount {
+	a, _ := money.Parse(s)
+	return a
+}
+
+// calculateInvoiceTotals - set the amounts of the allowances and charges,
+// the line extension amounts and the monetary totals of an invoice to the
+// calculated ones, WithholdingTaxTotalAmount to the tax amount of
+// withholdingTaxTotal, nil when nothing is withheld. With reject the totals
+// are left unchanged and en16931.Violations list the amounts the client
+// sent that differ from the calculated ones.
+func calculateInvoiceTotals(invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine, taxTotal *taxproto.TaxTotal, withholdingTaxTotal *taxproto.TaxTotal, reject bool) error {
+	hd := invoiceHeader.InvoiceHeaderD
+	taxAmount := money.Zero
+	if taxTotal != nil {
+		taxAmount = parseAmount(taxTotal.TaxTotalD.TaxAmount)
+	}
+	hd.WithholdingTaxTotalAmount = withholdingTaxTotalAmount(withholdingTaxTotal, rounding.ForCurrency(hd.DocumentCurrencyCode))
+	totals := invoiceTotals(invoiceHeader, invoiceLines, taxAmount)
+	for i, invoiceLine := range invoiceLines {
+		allowancecharge.SetProtos(invoiceLine.AllowanceCharges, totals.Lines[i].AllowanceCharges)
+	}
+	allowancecharge.SetProtos(invoiceHeader.AllowanceCharges, totals.AllowanceCharges)
+
+	if reject {
+		violations := totalsMismatches(hd, invoiceLines, totals)
+		if len(violations) > 0 {
+			return violations
+		}
+		return nil
+	}
+
+	for i, invoiceLine := range invoiceLines {
+		invoiceLine.InvoiceLineD.LineExtensionAmount = totals.Lines[i].LineExtensionAmount.String()
+	}
+	hd.LineExtensionAmount = totals.LineExtensionAmount.String()
+	hd.AllowanceTotalAmount = totals.AllowanceTotalAmount.String()
+	hd.ChargeTotalAmount = totals.ChargeTotalAmount.String()
+	hd.TaxExclusiveAmount = totals.TaxExclusiveAmount.String()
+	hd.TaxInclusiveAmount = totals.TaxInclusiveAmount.String()
+	hd.PayableRoundingAmount = totals.PayableRoundingAmount.String()
+	hd.PayableAmount = totals.PayableAmount.String()
+	return nil
+}
+
+// rule IDs of the amounts Totals calculates that no rule of EN 16931
+// calculates: the line net amount, exactly, where PEPPOL-EN16931-R120
+// allows a slack, and the rounding amount that takes the amount due to the
+// cash increment of its currency
+const (
+	ruleLineNetAmount         = "SC-UBL-CO-01"
+	rulePayableRoundingAmount = "SC-UBL-CO-02"
+)
+
+// totalsMismatches - a violation of the rule that calculates each amount
+// of an invoice that differs from the calculated one
+func totalsMismatches(hd *invoiceproto.InvoiceHeaderD, invoiceLines []*invoiceproto.InvoiceLine, totals *Totals) en16931.Violations {
+	policy := rounding.ForCurrency(totals.CurrencyCode)
+	violations := en16931.Violations{}
+	mismatch := func(rule string, path string, name string, sent string, calculated money.Amount) {
+		if a := parseAmount(sent); policy.Round(a) != calculated {
+			violations = append(violations, &en16931.Violation{Rule: rule, Path: path, Message: fmt.Sprintf("%s %s does not equal the calculated %s", name, a.Text(2), calculated.Text(2))})
+		}
+	}
+	for i, invoiceLine := range invoiceLines {
+		mismatch(ruleLineNetAmount, fmt.Sprintf("invoice_lines[%d].line_extension_amount", i+1), "invoice line net amount", invoiceLine.InvoiceLineD.LineExtensionAmount, totals.Lines[i].LineExtensionAmount)
+	}
+	mismatch("BR-CO-10", "line_extension_amount", "sum of invoice line net amount", hd.LineExtensionAmount, totals.LineExtensionAmount)
+	mismatch("BR-CO-11", "allowance_total_amount", "sum of allowances on document level", hd.AllowanceTotalAmount, totals.AllowanceTotalAmount)
+	mismatch("BR-CO-12", "charge_total_amount", "sum of charges on document level", hd.ChargeTotalAmount, totals.ChargeTotalAmount)
+	mismatch("BR-CO-13", "tax_exclusive_amount", "invoice total amount without VAT", hd.TaxExclusiveAmount, totals.TaxExclusiveAmount)
+	mismatch("BR-CO-15", "tax_inclusive_amount", "invoice total amount with VAT", hd.TaxInclusiveAmount, totals.TaxInclusiveAmount)
+	mismatch(rulePayableRoundingAmount, "payable_rounding_amount", "rounding amount", hd.PayableRoundingAmount, totals.PayableRoundingAmount)
+	mismatch("BR-CO-16", "payable_amount", "amount due for payment", hd.PayableAmount, totals.PayableAmount)
+	return violations
+}
+
+// getTaxAmount - the tax amount of the TaxTotals of a stored document in
+// currencyCode, its document currency, without the TaxTotal in its tax
+// currency and the withheld tax. A TaxTotal stored without a currency code
+// is in the document currency.
+func getTaxAmount(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, masterID uint32, currencyCode string, userEmail string, requestID string) (money.Amount, error) {
+	taxTotals, err := ubl.GetTaxTotals(ctx, dbService, masterFlag, masterID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return money.Zero, err
+	}
+	taxAmount := money.Zero
+	for _, taxTotal := range taxTotals {
+		if (taxTotal.TaxTotal.CurrencyCode != "" && !strings.EqualFold(taxTotal.TaxTotal.CurrencyCode, currencyCode)) || taxTotal.TaxTotal.WithholdingIndicator {
+			continue
+		}
+		taxAmount = taxAmount.Add(parseAmount(taxTotal.TaxTotal.TaxAmount))
+	}
+	return taxAmount, nil
+}
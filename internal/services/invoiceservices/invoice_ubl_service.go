@@ -0,0 +1,200 @@
+package invoiceservices
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/peppol"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// GetInvoiceUBL - Get Invoice as UBL 2.3 Invoice XML, in the PEPPOL BIS
+// Billing 3.0 profile when asked for
+func (is *InvoiceService) GetInvoiceUBL(ctx context.Context, inReq *invoiceproto.GetInvoiceUBLRequest) (*invoiceproto.GetInvoiceUBLResponse, error) {
+	in := inReq.GetRequest
+	profile, err := ubl.ParseProfile(inReq.Profile)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	src, err := is.getInvoiceSource(ctx, inReq)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	src.Profile = profile
+
+	invoice, err := ubl.InvoiceFromSource(src)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	if profile == ubl.ProfilePeppolBIS3 {
+		err = peppol.ValidateInvoice(invoice)
+		if err != nil {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, en16931.GRPCError(err)
+		}
+	}
+
+	ublBytes, err := ubl.Marshal(invoice)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	invoiceUBLResponse := invoiceproto.GetInvoiceUBLResponse{}
+	invoiceUBLResponse.Ubl = ublBytes
+	return &invoiceUBLResponse, nil
+}
+
+// getInvoiceSource - Get Invoice with the parties, items, taxes, payment
+// terms and references needed to build a UBL document
+func (is *InvoiceService) getInvoiceSource(ctx context.Context, inReq *invoiceproto.GetInvoiceUBLRequest) (*ubl.InvoiceSource, error) {
+	in := inReq.GetRequest
+	form := invoiceproto.GetInvoiceRequest{}
+	form.GetRequest = in
+	invoiceResponse, err := is.GetInvoice(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	invoiceHeader := invoiceResponse.InvoiceHeader
+	hd := invoiceHeader.InvoiceHeaderD
+
+	linesForm := invoiceproto.GetInvoiceLinesRequest{}
+	linesForm.GetRequest = in
+	invoiceLinesResponse, err := is.GetInvoiceLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	invoiceLines := invoiceLinesResponse.InvoiceLines
+
+	keys := ubl.DocumentKeys{
+		HeaderMasterFlag: ubl.MasterFlagInvoiceHeader,
+		HeaderID:         hd.Id,
+		LineMasterFlag:   ubl.MasterFlagInvoiceLine,
+		PartyIDs:         []uint32{hd.AccountingSupplierPartyId, hd.AccountingCustomerPartyId, hd.PayeePartyId, hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId, hd.TaxRepresentativePartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder:    {hd.OrderId},
+			ubl.ReferenceDespatch: {hd.DespatchId},
+			ubl.ReferenceReceipt:  {hd.ReceiptId},
+			ubl.ReferenceInvoice:  {hd.BillingId},
+		},
+	}
+	for _, invoiceLine := range invoiceLines {
+		ld := invoiceLine.InvoiceLineD
+		keys.LineIDs = append(keys.LineIDs, ld.Id)
+		keys.PartyIDs = append(keys.PartyIDs, ld.OriginatorPartyId)
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+		keys.References[ubl.ReferenceOrderLine] = append(keys.References[ubl.ReferenceOrderLine], ld.OrderLineId)
+		keys.References[ubl.ReferenceDespatchLine] = append(keys.References[ubl.ReferenceDespatchLine], ld.DespatchLineId)
+		keys.References[ubl.ReferenceReceiptLine] = append(keys.References[ubl.ReferenceReceiptLine], ld.ReceiptLineId)
+		keys.References[ubl.ReferenceInvoice] = append(keys.References[ubl.ReferenceInvoice], ld.BillingId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, is.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.InvoiceSource{InvoiceHeader: invoiceHeader, InvoiceLines: invoiceLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportInvoiceUBL - Create Invoice from a UBL 2.3 Invoice XML document.
+// Unmappable elements are reported as ubl.FieldErrors located by XPath.
+func (is *InvoiceService) ImportInvoiceUBL(ctx context.Context, in *invoiceproto.ImportInvoiceUBLRequest) (*invoiceproto.ImportInvoiceUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	invoice := ubl.Invoice{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceInvoice, &invoice)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	invoiceHeader, err := is.importInvoice(ctx, &invoice, in.UserId, in.UserEmail, in.RequestId)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importInvoiceUBLResponse := invoiceproto.ImportInvoiceUBLResponse{}
+	importInvoiceUBLResponse.InvoiceHeader = invoiceHeader
+	return &importInvoiceUBLResponse, nil
+}
+
+// importInvoice - Create Invoice and its payment terms from a UBL Invoice,
+// resolving parties and items by their identifiers. The parties it
+// creates, the invoice and its payment terms are written in one
+// transaction.
+func (is *InvoiceService) importInvoice(ctx context.Context, invoice *ubl.Invoice, userID string, userEmail string, requestID string) (*invoiceproto.InvoiceHeader, error) {
+	var invoiceHeader *invoiceproto.InvoiceHeader
+	err := is.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(is.log, is.DBService, is.RedisService, is.UserServiceClient, userID, userEmail, requestID, invoice.IssueDate)
+		invoiceImport, err := ubl.ImportInvoice(ctx, invoice, resolver)
+		if err != nil {
+			return err
+		}
+
+		form := invoiceImport.Invoice
+		form.UserId = userID
+		form.UserEmail = userEmail
+		form.RequestId = requestID
+		// a received invoice is stored as sent, or not at all
+		form.RejectMismatchedTotals = true
+		invoiceResponse, err := is.CreateInvoice(ctx, form)
+		if err != nil {
+			return err
+		}
+		invoiceHeader = invoiceResponse.InvoiceHeader
+
+		paymentService := paymentservice.NewPaymentService(is.log, is.DBService, is.RedisService, is.UserServiceClient)
+		return paymentService.CreateUBLPaymentTerms(ctx, invoiceImport.PaymentTerms, ubl.MasterFlagInvoiceHeader, invoiceHeader.InvoiceHeaderD.Id, userID, userEmail, requestID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invoiceHeader, nil
+}
+
+// ValidateDocument - check a UBL 2.3 document against the bundled UBL 2.3
+// schemas. A document that does not conform is not an error, its problems
+// are listed in the response.
+func (is *InvoiceService) ValidateDocument(ctx context.Context, in *invoiceproto.ValidateDocumentRequest) (*invoiceproto.ValidateDocumentResponse, error) {
+	validateDocumentResponse := invoiceproto.ValidateDocumentResponse{}
+	documentType, err := ublvalidate.DocumentType(in.Ubl)
+	validateDocumentResponse.DocumentType = documentType
+	if err != nil {
+		var schemaErrors ublvalidate.SchemaErrors
+		if !errors.As(err, &schemaErrors) {
+			is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		validateDocumentResponse.Problems = schemaErrors.ValidationProblems()
+		return &validateDocumentResponse, nil
+	}
+
+	validateDocumentResponse.Valid = true
+	return &validateDocumentResponse, nil
+}
@@ -0,0 +1,68 @@
+package invoiceservices
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReviseInvoice - Revise an issued invoice with the changes UpdateInvoice makes to a
+// draft one, giving the reason. The revised invoice is kept as its next
+// version, after the version it was issued with.
+func (is *InvoiceService) ReviseInvoice(ctx context.Context, in *invoiceproto.ReviseInvoiceRequest) (*invoiceproto.ReviseInvoiceResponse, error) {
+	updateRequest := in.UpdateInvoiceRequest
+	if updateRequest == nil {
+		err := errors.New("docversion: a revision needs the changes it makes")
+		is.log.Error("Error", zap.Error(err))
+		return nil, err
+	}
+	revision, err := docversion.NewRevision(ctx, is.UserServiceClient, in.Reason, updateRequest.UserId, updateRequest.UserEmail, updateRequest.RequestId)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err = is.updateInvoice(ctx, updateRequest, revision)
+	if err != nil {
+		is.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &invoiceproto.ReviseInvoiceResponse{DocumentVersion: revision.Version}, nil
+}
+
+// GetInvoiceVersions - Get the versions of an invoice, oldest first
+func (is *InvoiceService) GetInvoiceVersions(ctx context.Context, inReq *commonproto.GetDocumentVersionsRequest) (*commonproto.GetDocumentVersionsResponse, error) {
+	in := inReq.GetRequest
+	invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: in})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.GetVersions(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, invoiceResponse.InvoiceHeader.InvoiceHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
+
+// DiffInvoiceVersions - Get the fields of an invoice that differ between two
+// of its versions
+func (is *InvoiceService) DiffInvoiceVersions(ctx context.Context, in *commonproto.DiffDocumentVersionsRequest) (*commonproto.DiffDocumentVersionsResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	invoiceResponse, err := is.GetInvoice(ctx, &invoiceproto.GetInvoiceRequest{GetRequest: &getRequest})
+	if err != nil {
+		is.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.DiffVersions(ctx, is.log, is.DBService, ubl.MasterFlagInvoiceHeader, invoiceResponse.InvoiceHeader.InvoiceHeaderD.Id, in)
+}
+
+// invoiceSnapshot - the docversion snapshot of an invoice
+func invoiceSnapshot(invoiceHeader *invoiceproto.InvoiceHeader, invoiceLines []*invoiceproto.InvoiceLine) ([]byte, error) {
+	lines := []proto.Message{}
+	for _, invoiceLine := range invoiceLines {
+		lines = append(lines, invoiceLine)
+	}
+	return docversion.Snapshot(invoiceHeader, lines)
+}
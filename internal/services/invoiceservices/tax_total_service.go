@@ -0,0 +1,533 @@
+package invoiceservices
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/exchangerate"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	taxstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/taxcalc"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// insertTaxTotalSQL - insert TaxTotalSQL query
+const insertTaxTotalSQL = `insert into tax_totals
+	  (uuid4,
+tax_amount,
+rounding_amount,
+tax_evidence_indicator,
+tax_included_indicator,
+master_flag,
+master_id,
+tax_category_id,
+currency_code,
+withholding_indicator,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:tax_amount,
+:rounding_amount,
+:tax_evidence_indicator,
+:tax_included_indicator,
+:master_flag,
+:master_id,
+:tax_category_id,
+:currency_code,
+:withholding_indicator,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// insertTaxSubTotalSQL - insert TaxSubTotalSQL query
+const insertTaxSubTotalSQL = `insert into tax_sub_totals
+	  (
+uuid4,
+taxable_amount,
+tax_amount,
+calculation_sequence_numeric,
+transaction_currency_tax_amount,
+percent,
+base_unit_measure,
+per_unit_amount,
+currency_code,
+transaction_currency_code,
+tier_range,
+tier_rate_percent,
+tax_category_id,
+tax_total_id,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:taxable_amount,
+:tax_amount,
+:calculation_sequence_numeric,
+:transaction_currency_tax_amount,
+:percent,
+:base_unit_measure,
+:per_unit_amount,
+:currency_code,
+:transaction_currency_code,
+:tier_range,
+:tier_rate_percent,
+:tax_category_id,
+:tax_total_id,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+// processTaxSubTotalRequests - the TaxTotal of a document with a TaxSubTotal
+// per request, its tax amount is the sum of theirs. master_id and
+// tax_total_id are set when the document is inserted.
+func processTaxSubTotalRequests(ctx context.Context, log *zap.Logger, masterFlag string, in []*taxproto.CreateTaxSubTotalRequest, userID string, userEmail string, requestID string) (*taxproto.TaxTotal, []*taxproto.TaxSubTotal, error) {
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = userID
+	crUpdUser.UpdatedByUserId = userID
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	var err error
+	taxTotalD := taxproto.TaxTotalD{}
+	taxTotalD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	taxTotalD.MasterFlag = masterFlag
+
+	taxAmount := money.Zero
+	taxSubTotals := []*taxproto.TaxSubTotal{}
+	for _, taxSubTotalRequest := range in {
+		taxSubTotalD := taxproto.TaxSubTotalD{}
+		taxSubTotalD.Uuid4, err = common.GetUUIDBytes()
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, nil, err
+		}
+		taxSubTotalD.TaxableAmount = taxSubTotalRequest.TaxableAmount
+		taxSubTotalD.TaxAmount = taxSubTotalRequest.TaxAmount
+		taxSubTotalD.CalculationSequenceNumeric = taxSubTotalRequest.CalculationSequenceNumeric
+		taxSubTotalD.TransactionCurrencyTaxAmount = taxSubTotalRequest.TransactionCurrencyTaxAmount
+		taxSubTotalD.Percent = taxSubTotalRequest.Percent
+		taxSubTotalD.BaseUnitMeasure = taxSubTotalRequest.BaseUnitMeasure
+		taxSubTotalD.PerUnitAmount = taxSubTotalRequest.PerUnitAmount
+		taxSubTotalD.CurrencyCode = taxSubTotalRequest.CurrencyCode
+		taxSubTotalD.TransactionCurrencyCode = taxSubTotalRequest.TransactionCurrencyCode
+		taxSubTotalD.TierRange = taxSubTotalRequest.TierRange
+		taxSubTotalD.TierRatePercent = taxSubTotalRequest.TierRatePercent
+		taxSubTotalD.TaxCategoryId = taxSubTotalRequest.TaxCategoryId
+		taxAmount = taxAmount.Add(parseAmount(taxSubTotalRequest.TaxAmount))
+
+		taxSubTotal := taxproto.TaxSubTotal{TaxSubTotalD: &taxSubTotalD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+		taxSubTotals = append(taxSubTotals, &taxSubTotal)
+	}
+
+	taxTotalD.TaxAmount = taxAmount.String()
+
+	taxTotal := taxproto.TaxTotal{TaxTotalD: &taxTotalD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+	return &taxTotal, taxSubTotals, nil
+}
+
+// processTaxCurrencyTaxTotal - the TaxTotal of a document in its tax
+// currency, EN 16931 BT-111, when taxCurrencyCode is not the document
+// currency. The TransactionCurrencyTaxAmount of each of the taxSubTotals is
+// its tax amount converted with the tax exchange rate of header, in the
+// tax currency, and the tax amount of the TaxTotal is taxAmount or, when not given, their sum.
+// The TaxTotal has no TaxSubTotals of its own; it is nil when its tax
+// amount is not known, without taxAmount and the tax exchange rate.
+func processTaxCurrencyTaxTotal(ctx context.Context, log *zap.Logger, header proto.Message, documentCurrencyCode string, taxCurrencyCode string, taxAmount string, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, userEmail string, requestID string) (*taxproto.TaxTotal, error) {
+	if taxCurrencyCode == "" || strings.EqualFold(taxCurrencyCode, documentCurrencyCode) || taxTotal == nil {
+		return nil, nil
+	}
+	exchange := exchangerate.Get(header, exchangerate.TaxExchange, documentCurrencyCode, taxCurrencyCode)
+	taxAmount, ok := taxCurrencyTaxAmount(taxSubTotals, exchange, taxAmount)
+	if !ok {
+		return nil, nil
+	}
+	for _, taxSubTotal := range taxSubTotals {
+		taxSubTotal.TaxSubTotalD.TransactionCurrencyCode = strings.ToUpper(taxCurrencyCode)
+	}
+
+	var err error
+	taxTotalD := taxproto.TaxTotalD{}
+	taxTotalD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	taxTotalD.TaxAmount = taxAmount
+	taxTotalD.MasterFlag = taxTotal.TaxTotalD.MasterFlag
+	taxTotalD.CurrencyCode = strings.ToUpper(taxCurrencyCode)
+
+	return &taxproto.TaxTotal{TaxTotalD: &taxTotalD, CrUpdUser: taxTotal.CrUpdUser, CrUpdTime: taxTotal.CrUpdTime}, nil
+}
+
+// taxCurrencyTaxAmount - fill the TransactionCurrencyTaxAmount of the
+// taxSubTotals without one with their tax amount converted with exchange,
+// and the total tax amount in the tax currency: taxAmount when given, the
+// sum of the TransactionCurrencyTaxAmounts otherwise. A zero amount is not
+// given, as amounts are normalized, but a zero tax amount needs no exchange
+// rate. false when the total is not known.
+func taxCurrencyTaxAmount(taxSubTotals []*taxproto.TaxSubTotal, exchange *exchangerate.Exchange, taxAmount string) (string, bool) {
+	total := money.Zero
+	known := len(taxSubTotals) > 0
+	for _, taxSubTotal := range taxSubTotals {
+		sd := taxSubTotal.TaxSubTotalD
+		amount := parseAmount(sd.TransactionCurrencyTaxAmount)
+		if amount == money.Zero && exchange != nil {
+			amount = exchange.Convert(parseAmount(sd.TaxAmount))
+		}
+		if amount == money.Zero && parseAmount(sd.TaxAmount) != money.Zero {
+			known = false
+			continue
+		}
+		sd.TransactionCurrencyTaxAmount = amount.String()
+		total = total.Add(amount)
+	}
+	if parseAmount(taxAmount) != money.Zero {
+		return parseAmount(taxAmount).String(), true
+	}
+	if !known {
+		return "", false
+	}
+	return total.String(), true
+}
+
+// TaxLine - the item, quantity and net amount of a document line, what the
+// line adds to the tax breakdown of the document. A document allowance or
+// charge has TaxCategoryID, its own tax category, instead of an item.
+type TaxLine struct {
+	ItemID              uint32
+	TaxCategoryID       uint32
+	Quantity            float64
+	LineExtensionAmount money.Amount
+}
+
+// generateTaxTotal - the TaxTotal and TaxSubTotals of a document computed
+// from the tax categories of the items of its lines and of its allowances
+// and charges, with tiered, per unit and compound taxes as the taxcalc
+// package describes, its amounts rounded with policy and the rounding
+// level of their tax schemes. RoundingAmount of the TaxTotal is what that
+// rounding added to its tax. With withholding it is the TaxTotal of the
+// withholding tax categories, else of the others.
+func generateTaxTotal(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, lines []TaxLine, withholding bool, policy rounding.Policy, userID string, userEmail string, requestID string) (*taxproto.TaxTotal, []*taxproto.TaxSubTotal, error) {
+	itemIDs := []uint32{}
+	taxCategories := make(map[uint32]*taxproto.TaxCategoryD)
+	taxSchemes := make(map[uint32]*taxproto.TaxSchemeD)
+	for _, line := range lines {
+		if line.TaxCategoryID == 0 {
+			itemIDs = append(itemIDs, line.ItemID)
+			continue
+		}
+		if _, ok := taxCategories[line.TaxCategoryID]; ok {
+			continue
+		}
+		tc, ts, err := ubl.GetTaxCategory(ctx, dbService, line.TaxCategoryID)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, nil, err
+		}
+		taxCategories[line.TaxCategoryID] = tc
+		if ts != nil {
+			taxSchemes[ts.Id] = ts
+		}
+	}
+	items, err := ubl.GetItems(ctx, dbService, itemIDs...)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	for _, item := range items {
+		for id, ts := range item.TaxSchemes {
+			taxSchemes[id] = ts
+		}
+	}
+	subTotalRequests, roundingAmount, err := taxSubTotalRequests(lines, items, taxCategories, taxSchemes, withholding, policy)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	taxTotal, taxSubTotals, err := processTaxSubTotalRequests(ctx, log, masterFlag, subTotalRequests, userID, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	taxTotal.TaxTotalD.RoundingAmount = roundingAmount.String()
+	taxTotal.TaxTotalD.WithholdingIndicator = withholding
+	return taxTotal, taxSubTotals, nil
+}
+
+// processWithholdingTaxTotal - the withholding TaxTotal and TaxSubTotals of
+// a document, with a TaxSubTotal per request or, without requests,
+// generated from the withholding tax categories of lines and rounded with
+// policy. Withheld tax is kept apart from the other taxes of the document:
+// it reduces the amount due, not the amount with tax. The TaxTotal is nil
+// when nothing is withheld.
+func processWithholdingTaxTotal(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, in []*taxproto.CreateTaxSubTotalRequest, lines []TaxLine, policy rounding.Policy, userID string, userEmail string, requestID string) (*taxproto.TaxTotal, []*taxproto.TaxSubTotal, error) {
+	var taxTotal *taxproto.TaxTotal
+	var taxSubTotals []*taxproto.TaxSubTotal
+	var err error
+	if len(in) > 0 {
+		taxTotal, taxSubTotals, err = processTaxSubTotalRequests(ctx, log, masterFlag, in, userID, userEmail, requestID)
+	} else {
+		taxTotal, taxSubTotals, err = generateTaxTotal(ctx, log, dbService, masterFlag, lines, true, policy, userID, userEmail, requestID)
+	}
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, err
+	}
+	if len(taxSubTotals) == 0 {
+		return nil, nil, nil
+	}
+	taxTotal.TaxTotalD.WithholdingIndicator = true
+	return taxTotal, taxSubTotals, nil
+}
+
+// withholdingTaxTotalAmount - the WithholdingTaxTotalAmount of a document,
+// the tax amount of its withholding TaxTotal rounded with policy, zero when
+// withholdingTaxTotal is nil
+func withholdingTaxTotalAmount(withholdingTaxTotal *taxproto.TaxTotal, policy rounding.Policy) string {
+	if withholdingTaxTotal == nil {
+		return money.Zero.String()
+	}
+	return policy.Round(parseAmount(withholdingTaxTotal.TaxTotalD.TaxAmount)).String()
+}
+
+// taxSubTotalRequests - a TaxSubTotal per tax category of the items of the
+// lines and of taxCategories, the categories of the lines with a
+// TaxCategoryID, as taxcalc.Calculate computes them with policy and the
+// rounding level of taxSchemes, and the sum of their rounding amounts. Only
+// the categories whose withholding indicator is withholding are taken,
+// lines without one are left out.
+func taxSubTotalRequests(lines []TaxLine, items map[uint32]*ubl.ItemSource, taxCategories map[uint32]*taxproto.TaxCategoryD, taxSchemes map[uint32]*taxproto.TaxSchemeD, withholding bool, policy rounding.Policy) ([]*taxproto.CreateTaxSubTotalRequest, money.Amount, error) {
+	categories := make(map[uint32]*taxcalc.Category)
+	taxLines := []taxcalc.Line{}
+	for _, line := range lines {
+		var lineTaxCategories []*taxproto.TaxCategoryD
+		if line.TaxCategoryID != 0 {
+			if tc := taxCategories[line.TaxCategoryID]; tc != nil {
+				lineTaxCategories = []*taxproto.TaxCategoryD{tc}
+			}
+		} else if item := items[line.ItemID]; item != nil {
+			lineTaxCategories = item.TaxCategories
+		}
+		taxLine := taxcalc.Line{Quantity: line.Quantity, NetAmount: line.LineExtensionAmount}
+		for _, tc := range lineTaxCategories {
+			if tc.WithholdingIndicator != withholding {
+				continue
+			}
+			if categories[tc.Id] == nil {
+				categories[tc.Id] = taxcalc.FromProto(tc, taxSchemes[tc.TaxSchemeId])
+			}
+			taxLine.Categories = append(taxLine.Categories, categories[tc.Id])
+		}
+		if len(taxLine.Categories) == 0 {
+			continue
+		}
+		taxLines = append(taxLines, taxLine)
+	}
+
+	subTotals, err := taxcalc.Calculate(taxLines, policy)
+	if err != nil {
+		return nil, money.Zero, err
+	}
+
+	roundingAmount := money.Zero
+	taxSubTotalRequests := []*taxproto.CreateTaxSubTotalRequest{}
+	for _, subTotal := range subTotals {
+		roundingAmount = roundingAmount.Add(subTotal.RoundingAmount)
+		c := subTotal.Category
+		taxSubTotalRequest := taxproto.CreateTaxSubTotalRequest{}
+		taxSubTotalRequest.TaxableAmount = subTotal.TaxableAmount.String()
+		taxSubTotalRequest.TaxAmount = subTotal.TaxAmount.String()
+		taxSubTotalRequest.CalculationSequenceNumeric = c.CalculationSequenceNumeric
+		taxSubTotalRequest.Percent = float32(c.Percent)
+		taxSubTotalRequest.BaseUnitMeasure = c.BaseUnitMeasure
+		taxSubTotalRequest.PerUnitAmount = c.PerUnitAmount.String()
+		taxSubTotalRequest.TierRange = c.TierRange
+		taxSubTotalRequest.TierRatePercent = c.TierRatePercent
+		taxSubTotalRequest.TaxCategoryId = c.ID
+		taxSubTotalRequests = append(taxSubTotalRequests, &taxSubTotalRequest)
+	}
+	return taxSubTotalRequests, roundingAmount, nil
+}
+
+// insertTaxTotal - insert the TaxTotal and TaxSubTotals of the document
+// masterID within its transaction. A TaxTotal or TaxSubTotal without a
+// currency code is in currencyCode, the currency of the document.
+func insertTaxTotal(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, taxTotal *taxproto.TaxTotal, taxSubTotals []*taxproto.TaxSubTotal, masterID uint32, currencyCode string, userEmail string, requestID string) error {
+	taxTotal.TaxTotalD.MasterId = masterID
+	if taxTotal.TaxTotalD.CurrencyCode == "" {
+		taxTotal.TaxTotalD.CurrencyCode = currencyCode
+	}
+	taxTotalTmp := taxstruct.TaxTotal{TaxTotalD: taxTotal.TaxTotalD, CrUpdUser: taxTotal.CrUpdUser, CrUpdTime: crUpdTimeStruct(taxTotal.CrUpdTime)}
+	res, err := tx.NamedExecContext(ctx, insertTaxTotalSQL, &taxTotalTmp)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+
+	uID, err := res.LastInsertId()
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	taxTotal.TaxTotalD.Id = uint32(uID)
+	taxTotal.TaxTotalD.IdS, err = common.UUIDBytesToStr(taxTotal.TaxTotalD.Uuid4)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+
+	for _, taxSubTotal := range taxSubTotals {
+		taxSubTotal.TaxSubTotalD.TaxTotalId = taxTotal.TaxTotalD.Id
+		if taxSubTotal.TaxSubTotalD.CurrencyCode == "" {
+			taxSubTotal.TaxSubTotalD.CurrencyCode = taxTotal.TaxTotalD.CurrencyCode
+		}
+		taxSubTotalTmp := taxstruct.TaxSubTotal{TaxSubTotalD: taxSubTotal.TaxSubTotalD, CrUpdUser: taxSubTotal.CrUpdUser, CrUpdTime: crUpdTimeStruct(taxSubTotal.CrUpdTime)}
+		res, err := tx.NamedExecContext(ctx, insertTaxSubTotalSQL, &taxSubTotalTmp)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
+		uID, err := res.LastInsertId()
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		taxSubTotal.TaxSubTotalD.Id = uint32(uID)
+		taxSubTotal.TaxSubTotalD.IdS, err = common.UUIDBytesToStr(taxSubTotal.TaxSubTotalD.Uuid4)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// deactivateTaxSubTotalsSQL - make the TaxSubTotals of the TaxTotals of a
+// document inactive
+const deactivateTaxSubTotalsSQL = `update tax_sub_totals set 
+status_code = ?, 
+updated_at = ? where tax_total_id in (select id from tax_totals where master_flag = ? and master_id = ? and status_code = ?);`
+
+// deactivateTaxTotalsSQL - make the TaxTotals of a document inactive
+const deactivateTaxTotalsSQL = `update tax_totals set 
+status_code = ?, 
+updated_at = ? where master_flag = ? and master_id = ? and status_code = ?;`
+
+// documentTaxTotals - the TaxTotal and TaxSubTotals of a document, its
+// TaxTotal in its tax currency and its withholding TaxTotal and
+// TaxSubTotals, nil when it has none
+type documentTaxTotals struct {
+	TaxTotal                *taxproto.TaxTotal
+	TaxSubTotals            []*taxproto.TaxSubTotal
+	TaxCurrencyTaxTotal     *taxproto.TaxTotal
+	WithholdingTaxTotal     *taxproto.TaxTotal
+	WithholdingTaxSubTotals []*taxproto.TaxSubTotal
+}
+
+// taxAmount - the tax amount of the TaxTotal in the document currency
+func (t *documentTaxTotals) taxAmount() money.Amount {
+	return parseAmount(t.TaxTotal.TaxTotalD.TaxAmount)
+}
+
+// generateDocumentTaxTotals - the documentTaxTotals of a stored document
+// generated again from lines, the TaxLines of its lines after one of them
+// is changed, as generateTaxTotal and processWithholdingTaxTotal generate
+// them for a new document. Its TaxTotal in the tax currency is converted
+// with the tax exchange rate of header.
+func generateDocumentTaxTotals(ctx context.Context, log *zap.Logger, dbService *common.DBService, masterFlag string, header proto.Message, documentCurrencyCode string, taxCurrencyCode string, lines []TaxLine, userID string, userEmail string, requestID string) (*documentTaxTotals, error) {
+	policy := rounding.ForCurrency(documentCurrencyCode)
+	taxTotals := documentTaxTotals{}
+	var err error
+	taxTotals.TaxTotal, taxTotals.TaxSubTotals, err = generateTaxTotal(ctx, log, dbService, masterFlag, lines, false, policy, userID, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	taxTotals.WithholdingTaxTotal, taxTotals.WithholdingTaxSubTotals, err = processWithholdingTaxTotal(ctx, log, dbService, masterFlag, nil, lines, policy, userID, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	taxTotals.TaxCurrencyTaxTotal, err = processTaxCurrencyTaxTotal(ctx, log, header, documentCurrencyCode, taxCurrencyCode, "", taxTotals.TaxTotal, taxTotals.TaxSubTotals, userEmail, requestID)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	return &taxTotals, nil
+}
+
+// replaceTaxTotals - make the stored TaxTotals and TaxSubTotals of the
+// document masterID inactive and insert taxTotals in their place, within
+// the transaction of the change to its lines
+func replaceTaxTotals(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, masterFlag string, masterID uint32, currencyCode string, taxTotals *documentTaxTotals, tn time.Time, userEmail string, requestID string) error {
+	_, err := tx.ExecContext(ctx, deactivateTaxSubTotalsSQL, "inactive", tn, masterFlag, masterID, "active")
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	_, err = tx.ExecContext(ctx, deactivateTaxTotalsSQL, "inactive", tn, masterFlag, masterID, "active")
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+
+	if len(taxTotals.TaxSubTotals) > 0 {
+		err = insertTaxTotal(ctx, log, tx, taxTotals.TaxTotal, taxTotals.TaxSubTotals, masterID, currencyCode, userEmail, requestID)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	if taxTotals.TaxCurrencyTaxTotal != nil {
+		err = insertTaxTotal(ctx, log, tx, taxTotals.TaxCurrencyTaxTotal, nil, masterID, currencyCode, userEmail, requestID)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	if taxTotals.WithholdingTaxTotal != nil {
+		err = insertTaxTotal(ctx, log, tx, taxTotals.WithholdingTaxTotal, taxTotals.WithholdingTaxSubTotals, masterID, currencyCode, userEmail, requestID)
+		if err != nil {
+			log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// crUpdTimeStruct - CrUpdTime for a servicestructs row
+func crUpdTimeStruct(crUpdTime *commonproto.CrUpdTime) *commonstruct.CrUpdTime {
+	crUpdTimeTmp := new(commonstruct.CrUpdTime)
+	crUpdTimeTmp.CreatedAt = common.TimestampToTime(crUpdTime.CreatedAt)
+	crUpdTimeTmp.UpdatedAt = common.TimestampToTime(crUpdTime.UpdatedAt)
+	return crUpdTimeTmp
+}
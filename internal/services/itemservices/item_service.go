@@ -66,6 +66,8 @@ func StartItemServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DBO
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	itemService := NewItemService(log, dbService, redisService, uc)
 
@@ -273,7 +275,15 @@ updated_at)
 :created_at,
 :updated_at);`
 
-// CreateItem - Create Item
+// insertItemTaxCategorySQL - insert ItemTaxCategorySQL query
+const insertItemTaxCategorySQL = `insert into item_tax_categories
+	  (item_id,
+tax_category_id)
+  values (?,
+?);`
+
+// CreateItem - Create Item. TaxCategoryIds are the tax categories that
+// apply to the item beside TaxCategoryId, such as an excise duty.
 func (is *ItemService) CreateItem(ctx context.Context, in *itemproto.CreateItemRequest) (*itemproto.CreateItemResponse, error) {
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, is.UserServiceClient)
 	if err != nil {
@@ -322,6 +332,7 @@ func (is *ItemService) CreateItem(ctx context.Context, in *itemproto.CreateItemR
 	crUpdTime.UpdatedAt = tn
 
 	item := itemproto.Item{ItemD: &itemD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+	item.TaxCategoryIds = in.TaxCategoryIds
 
 	err = is.insertItem(ctx, insertItemSQL, &item, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
@@ -361,6 +372,14 @@ func (is *ItemService) insertItem(ctx context.Context, insertItemSQL string, ite
 			return err
 		}
 		item.ItemD.IdS = uuid4Str
+
+		for _, taxCategoryID := range item.TaxCategoryIds {
+			_, err = tx.ExecContext(ctx, insertItemTaxCategorySQL, item.ItemD.Id, taxCategoryID)
+			if err != nil {
+				is.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
 		return nil
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
@@ -251,3 +252,115 @@ func (ds *DespatchService) getDespatchLineStruct(ctx context.Context, in *common
 
 	return &despatchLine, nil
 }
+
+// selectDespatchLineIDsSQL - the id of a DespatchLine and of its despatch
+const selectDespatchLineIDsSQL = `select id, despatch_header_id from despatch_lines where uuid4 = ? and status_code = ?;`
+
+// selectDespatchStatusForUpdateSQL - lock a despatch until the end of the
+// transaction and get its document status
+const selectDespatchStatusForUpdateSQL = `select document_status_code from despatch_headers where id = ? for update;`
+
+// updateDespatchLineSQL - update DespatchLineSQL query
+const updateDespatchLineSQL = `update despatch_lines set 
+  note = ?,
+  line_status_code = ?,
+  delivered_quantity = ?,
+  backorder_quantity = ?,
+  backorder_reason = ?,
+  outstanding_quantity = ?,
+  outstanding_reason = ?,
+  oversupply_quantity = ?,
+  updated_at = ? where id = ?;`
+
+// deleteDespatchLineSQL - delete DespatchLineSQL query
+const deleteDespatchLineSQL = `update despatch_lines set 
+  status_code = ?,
+  updated_at = ? where id = ?;`
+
+// updateDespatchLineCountSQL - set the line count of a despatch to the
+// number of its active lines
+const updateDespatchLineCountSQL = `update despatch_headers set 
+  line_count_numeric = (select count(*) from despatch_lines where despatch_header_id = ? and status_code = ?),
+  updated_at = ? where id = ?;`
+
+// UpdateDespatchLine - Update DespatchLine of a draft despatch, the line
+// count of the despatch is kept in the same transaction
+func (ds *DespatchService) UpdateDespatchLine(ctx context.Context, in *logisticsproto.UpdateDespatchLineRequest) (*logisticsproto.UpdateDespatchLineResponse, error) {
+	tn := common.GetTimeDetails()
+	err := ds.updateDespatchLine(ctx, in.Id, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx, despatchLineID uint32) error {
+		_, err := tx.ExecContext(ctx, updateDespatchLineSQL,
+			in.Note,
+			in.LineStatusCode,
+			in.DeliveredQuantity,
+			in.BackorderQuantity,
+			in.BackorderReason,
+			in.OutstandingQuantity,
+			in.OutstandingReason,
+			in.OversupplyQuantity,
+			tn,
+			despatchLineID)
+		return err
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &logisticsproto.UpdateDespatchLineResponse{}, nil
+}
+
+// DeleteDespatchLine - Delete DespatchLine of a draft despatch, the line
+// count of the despatch is kept in the same transaction
+func (ds *DespatchService) DeleteDespatchLine(ctx context.Context, inReq *logisticsproto.DeleteDespatchLineRequest) (*logisticsproto.DeleteDespatchLineResponse, error) {
+	in := inReq.GetRequest
+	tn := common.GetTimeDetails()
+	err := ds.updateDespatchLine(ctx, in.Id, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx, despatchLineID uint32) error {
+		_, err := tx.ExecContext(ctx, deleteDespatchLineSQL, "inactive", tn, despatchLineID)
+		return err
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &logisticsproto.DeleteDespatchLineResponse{}, nil
+}
+
+// updateDespatchLine - run the change fn to the active DespatchLine
+// despatchLineID and set the line count of its despatch again, in one
+// transaction. The despatch is locked for the transaction and must be a
+// draft, its status empty or draft.
+func (ds *DespatchService) updateDespatchLine(ctx context.Context, despatchLineID string, userEmail string, requestID string, fn func(tx *sqlx.Tx, despatchLineID uint32) error) error {
+	uuid4byte, err := common.UUIDStrToBytes(despatchLineID)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	tn := common.GetTimeDetails()
+	return ds.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		var id, despatchHeaderID uint32
+		err := tx.QueryRowxContext(ctx, selectDespatchLineIDsSQL, uuid4byte, "active").Scan(&id, &despatchHeaderID)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		var documentStatusCode string
+		err = tx.QueryRowxContext(ctx, selectDespatchStatusForUpdateSQL, despatchHeaderID).Scan(&documentStatusCode)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		if err = docstatus.CheckEditable(documentStatusCode); err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		if err = fn(tx, id); err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		_, err = tx.ExecContext(ctx, updateDespatchLineCountSQL, despatchHeaderID, "active", tn, despatchHeaderID)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
@@ -190,8 +191,21 @@ func (ds *DespatchService) insertDespatchHeader(ctx context.Context, insertDespa
 	}
 
 	err = ds.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		err := numbering.Check(ctx, tx, numbering.Despatch, despatchHeader.DespatchHeaderD.DespatchSupplierPartyId, despatchHeader.DespatchHeaderD.DesphId)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		desphID, err := numbering.Assign(ctx, tx, numbering.Despatch, despatchHeader.DespatchHeaderD.DespatchSupplierPartyId, despatchHeader.DespatchHeaderD.DesphId, despatchHeaderTmp.DespatchHeaderT.IssueDate)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		despatchHeader.DespatchHeaderD.DesphId = desphID
+
 		res, err := tx.NamedExecContext(ctx, insertDespatchHeaderSQL, despatchHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.Despatch, err, despatchHeader.DespatchHeaderD.DesphId)
 			ds.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -368,3 +368,174 @@ func TestDespatchService_UpdateDespatchHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestDespatchService_GetDespatchUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	despatchHeaderService := NewDespatchService(log, dbService, redisService, userServiceClient)
+
+	form := logisticsproto.GetDespatchUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "ff24413d-8517-41e2-9b49-1bb385919694"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *logisticsproto.GetDespatchUBLRequest
+	}
+	tests := []struct {
+		ds      *DespatchService
+		args    args
+		wantErr bool
+	}{
+		{
+			ds: despatchHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		despatchUBLResp, err := tt.ds.GetDespatchUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DespatchService.GetDespatchUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, despatchUBLResp)
+		ublStr := string(despatchUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<DespatchAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:DespatchAdvice-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>ff24413d-8517-41e2-9b49-1bb385919694</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2005-06-20</cbc:IssueDate>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:DespatchAdviceTypeCode>delivery</cbc:DespatchAdviceTypeCode>`, "they should be equal")
+	}
+}
+
+func TestDespatchService_ImportDespatchUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	despatchHeaderService := NewDespatchService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<DespatchAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:DespatchAdvice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>DA-1</cbc:ID>
+  <cbc:IssueDate>2005-06-20</cbc:IssueDate>
+  <cbc:DespatchAdviceTypeCode>delivery</cbc:DespatchAdviceTypeCode>
+  <cac:DespatchSupplierParty><cac:Party><cac:PartyName><cbc:Name>Salescompany ltd.</cbc:Name></cac:PartyName></cac:Party></cac:DespatchSupplierParty>
+  <cac:DeliveryCustomerParty><cac:Party><cac:PartyName><cbc:Name>Buyercompany ltd</cbc:Name></cac:PartyName></cac:Party></cac:DeliveryCustomerParty>
+  <cac:DespatchLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:DeliveredQuantity>90</cbc:DeliveredQuantity>
+    <cac:OrderLineReference><cbc:LineID>NA</cbc:LineID></cac:OrderLineReference>
+    <cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item>
+  </cac:DespatchLine>
+</DespatchAdvice>`
+
+	form := logisticsproto.ImportDespatchUBLRequest{}
+	form.Ubl = []byte(ublDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *logisticsproto.ImportDespatchUBLRequest
+	}
+	tests := []struct {
+		ds      *DespatchService
+		args    args
+		wantErr bool
+	}{
+		{
+			ds: despatchHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		despatchResp, err := tt.ds.ImportDespatchUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DespatchService.ImportDespatchUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, despatchResp)
+		despatchHeaderResult := despatchResp.DespatchHeader
+		assert.Equal(t, despatchHeaderResult.DespatchHeaderD.DesphId, "DA-1", "they should be equal")
+		assert.Equal(t, despatchHeaderResult.DespatchHeaderD.DespatchAdviceTypeCode, "delivery", "they should be equal")
+	}
+}
+
+func TestDespatchService_UpdateDespatchLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	despatchService := NewDespatchService(log, dbService, redisService, userServiceClient)
+
+	form := logisticsproto.UpdateDespatchLineRequest{}
+	form.Note = "sample updated"
+	form.LineStatusCode = "NoStatus"
+	form.DeliveredQuantity = float64(80)
+	form.BackorderQuantity = float64(20)
+	form.Id = "bd96e929-f6e8-46ab-932e-638e8ba52421"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = despatchService.UpdateDespatchLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "ff24413d-8517-41e2-9b49-1bb385919694", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	despatchLinesResponse, err := despatchService.GetDespatchLines(ctx, &logisticsproto.GetDespatchLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	despatchLineResult := despatchLinesResponse.DespatchLines[0]
+	assert.Equal(t, despatchLineResult.DespatchLineD.Note, "sample updated", "they should be equal")
+	assert.Equal(t, despatchLineResult.DespatchLineD.DeliveredQuantity, float64(80), "they should be equal")
+
+	despatchHeaderResponse, err := despatchService.GetDespatchHeader(ctx, &logisticsproto.GetDespatchHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, despatchHeaderResponse.DespatchHeader.DespatchHeaderD.LineCountNumeric, uint32(1), "they should be equal")
+
+	_, err = despatchService.DeleteDespatchLine(ctx, &logisticsproto.DeleteDespatchLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	despatchHeaderResponse, err = despatchService.GetDespatchHeader(ctx, &logisticsproto.GetDespatchHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, despatchHeaderResponse.DespatchHeader.DespatchHeaderD.LineCountNumeric, uint32(0), "they should be equal")
+
+	_, err = despatchService.UpdateDespatchLine(ctx, &form)
+	assert.Error(t, err, "a deleted line is not updated")
+}
@@ -0,0 +1,136 @@
+package logisticsservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// GetDespatchUBL - Get Despatch as UBL 2.3 DespatchAdvice XML
+func (ds *DespatchService) GetDespatchUBL(ctx context.Context, inReq *logisticsproto.GetDespatchUBLRequest) (*logisticsproto.GetDespatchUBLResponse, error) {
+	in := inReq.GetRequest
+	src, err := ds.getDespatchSource(ctx, inReq)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	despatchAdvice, err := ubl.DespatchAdviceFromSource(src)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ublBytes, err := ubl.Marshal(despatchAdvice)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	despatchUBLResponse := logisticsproto.GetDespatchUBLResponse{}
+	despatchUBLResponse.Ubl = ublBytes
+	return &despatchUBLResponse, nil
+}
+
+// getDespatchSource - Get Despatch with the parties, items and references
+// needed to build a UBL document
+func (ds *DespatchService) getDespatchSource(ctx context.Context, inReq *logisticsproto.GetDespatchUBLRequest) (*ubl.DespatchAdviceSource, error) {
+	in := inReq.GetRequest
+	form := logisticsproto.GetDespatchHeaderRequest{}
+	form.GetRequest = in
+	despatchHeaderResponse, err := ds.GetDespatchHeader(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	despatchHeader := despatchHeaderResponse.DespatchHeader
+	hd := despatchHeader.DespatchHeaderD
+
+	linesForm := logisticsproto.GetDespatchLinesRequest{}
+	linesForm.GetRequest = in
+	despatchLinesResponse, err := ds.GetDespatchLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	despatchLines := despatchLinesResponse.DespatchLines
+
+	keys := ubl.DocumentKeys{
+		HeaderID: hd.Id,
+		PartyIDs: []uint32{hd.DespatchSupplierPartyId, hd.DeliveryCustomerPartyId, hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId, hd.OriginatorCustomerPartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder:    {hd.OrderId},
+			ubl.ReferenceShipment: {hd.ShipmentId},
+		},
+	}
+	for _, despatchLine := range despatchLines {
+		ld := despatchLine.DespatchLineD
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+		keys.References[ubl.ReferenceOrderLine] = append(keys.References[ubl.ReferenceOrderLine], ld.OrderLineId)
+		keys.References[ubl.ReferenceShipment] = append(keys.References[ubl.ReferenceShipment], ld.ShipmentId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, ds.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.DespatchAdviceSource{DespatchHeader: despatchHeader, DespatchLines: despatchLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportDespatchUBL - Create Despatch from a UBL 2.3 DespatchAdvice XML
+// document. Unmappable elements are reported as ubl.FieldErrors located by
+// XPath.
+func (ds *DespatchService) ImportDespatchUBL(ctx context.Context, in *logisticsproto.ImportDespatchUBLRequest) (*logisticsproto.ImportDespatchUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	despatchAdvice := ubl.DespatchAdvice{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceDespatchAdvice, &despatchAdvice)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// the parties it creates and the despatch are written in one transaction
+	var despatchHeader *logisticsproto.DespatchHeader
+	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(ds.log, ds.DBService, ds.RedisService, ds.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, despatchAdvice.IssueDate)
+		form, err := ubl.ImportDespatchAdvice(ctx, &despatchAdvice, resolver)
+		if err != nil {
+			return err
+		}
+
+		form.UserId = in.UserId
+		form.UserEmail = in.UserEmail
+		form.RequestId = in.RequestId
+		despatchHeaderResponse, err := ds.CreateDespatchHeader(ctx, form)
+		if err != nil {
+			return err
+		}
+		despatchHeader = despatchHeaderResponse.DespatchHeader
+		return nil
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importDespatchUBLResponse := logisticsproto.ImportDespatchUBLResponse{}
+	importDespatchUBLResponse.DespatchHeader = despatchHeader
+	return &importDespatchUBLResponse, nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
@@ -291,3 +292,120 @@ func (rs *ReceiptAdviceHeaderService) getReceiptAdviceLineStruct(ctx context.Con
 
 	return &receiptAdviceLine, nil
 }
+
+// selectReceiptAdviceLineIDsSQL - the id of a ReceiptAdviceLine and of its
+// receipt advice
+const selectReceiptAdviceLineIDsSQL = `select id, receipt_advice_header_id from receipt_advice_lines where uuid4 = ? and status_code = ?;`
+
+// selectReceiptAdviceStatusForUpdateSQL - lock a receipt advice until the
+// end of the transaction and get its document status
+const selectReceiptAdviceStatusForUpdateSQL = `select document_status_code from receipt_advice_headers where id = ? for update;`
+
+// updateReceiptAdviceLineSQL - update ReceiptAdviceLineSQL query
+const updateReceiptAdviceLineSQL = `update receipt_advice_lines set 
+  note = ?,
+  received_quantity = ?,
+  short_quantity = ?,
+  shortage_action_code = ?,
+  rejected_quantity = ?,
+  reject_reason_code = ?,
+  reject_reason = ?,
+  reject_action_code = ?,
+  oversupply_quantity = ?,
+  updated_at = ? where id = ?;`
+
+// deleteReceiptAdviceLineSQL - delete ReceiptAdviceLineSQL query
+const deleteReceiptAdviceLineSQL = `update receipt_advice_lines set 
+  status_code = ?,
+  updated_at = ? where id = ?;`
+
+// updateReceiptAdviceLineCountSQL - set the line count of a receipt advice
+// to the number of its active lines
+const updateReceiptAdviceLineCountSQL = `update receipt_advice_headers set 
+  line_count_numeric = (select count(*) from receipt_advice_lines where receipt_advice_header_id = ? and status_code = ?),
+  updated_at = ? where id = ?;`
+
+// UpdateReceiptAdviceLine - Update ReceiptAdviceLine of a draft receipt
+// advice, the line count of the receipt advice is kept in the same
+// transaction
+func (rs *ReceiptAdviceHeaderService) UpdateReceiptAdviceLine(ctx context.Context, in *logisticsproto.UpdateReceiptAdviceLineRequest) (*logisticsproto.UpdateReceiptAdviceLineResponse, error) {
+	tn := common.GetTimeDetails()
+	err := rs.updateReceiptAdviceLine(ctx, in.Id, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx, receiptAdviceLineID uint32) error {
+		_, err := tx.ExecContext(ctx, updateReceiptAdviceLineSQL,
+			in.Note,
+			in.ReceivedQuantity,
+			in.ShortQuantity,
+			in.ShortageActionCode,
+			in.RejectedQuantity,
+			in.RejectReasonCode,
+			in.RejectReason,
+			in.RejectActionCode,
+			in.OversupplyQuantity,
+			tn,
+			receiptAdviceLineID)
+		return err
+	})
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &logisticsproto.UpdateReceiptAdviceLineResponse{}, nil
+}
+
+// DeleteReceiptAdviceLine - Delete ReceiptAdviceLine of a draft receipt
+// advice, the line count of the receipt advice is kept in the same
+// transaction
+func (rs *ReceiptAdviceHeaderService) DeleteReceiptAdviceLine(ctx context.Context, inReq *logisticsproto.DeleteReceiptAdviceLineRequest) (*logisticsproto.DeleteReceiptAdviceLineResponse, error) {
+	in := inReq.GetRequest
+	tn := common.GetTimeDetails()
+	err := rs.updateReceiptAdviceLine(ctx, in.Id, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx, receiptAdviceLineID uint32) error {
+		_, err := tx.ExecContext(ctx, deleteReceiptAdviceLineSQL, "inactive", tn, receiptAdviceLineID)
+		return err
+	})
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &logisticsproto.DeleteReceiptAdviceLineResponse{}, nil
+}
+
+// updateReceiptAdviceLine - run the change fn to the active
+// ReceiptAdviceLine receiptAdviceLineID and set the line count of its
+// receipt advice again, in one transaction. The receipt advice is locked
+// for the transaction and must be a draft, its status empty or draft.
+func (rs *ReceiptAdviceHeaderService) updateReceiptAdviceLine(ctx context.Context, receiptAdviceLineID string, userEmail string, requestID string, fn func(tx *sqlx.Tx, receiptAdviceLineID uint32) error) error {
+	uuid4byte, err := common.UUIDStrToBytes(receiptAdviceLineID)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	tn := common.GetTimeDetails()
+	return rs.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		var id, receiptAdviceHeaderID uint32
+		err := tx.QueryRowxContext(ctx, selectReceiptAdviceLineIDsSQL, uuid4byte, "active").Scan(&id, &receiptAdviceHeaderID)
+		if err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		var documentStatusCode string
+		err = tx.QueryRowxContext(ctx, selectReceiptAdviceStatusForUpdateSQL, receiptAdviceHeaderID).Scan(&documentStatusCode)
+		if err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		if err = docstatus.CheckEditable(documentStatusCode); err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		if err = fn(tx, id); err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		_, err = tx.ExecContext(ctx, updateReceiptAdviceLineCountSQL, receiptAdviceHeaderID, "active", tn, receiptAdviceHeaderID)
+		if err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
@@ -38,6 +39,7 @@ const insertReceiptAdviceHeaderSQL = `insert into receipt_advice_headers
 	  ( 
     uuid4,
     rcpth_id,
+    document_status_code,
     receipt_advice_type_code,
     note,
     line_count_numeric,
@@ -56,6 +58,7 @@ const insertReceiptAdviceHeaderSQL = `insert into receipt_advice_headers
     updated_at)
   values (:uuid4,
 :rcpth_id,
+:document_status_code,
 :receipt_advice_type_code,
 :note,
 :line_count_numeric,
@@ -77,6 +80,7 @@ const selectReceiptAdviceHeadersSQL = `select
   id,
   uuid4,
   rcpth_id,
+  document_status_code,
   receipt_advice_type_code,
   note,
   line_count_numeric,
@@ -96,6 +100,7 @@ const selectReceiptAdviceHeadersSQL = `select
 
 // updateReceiptAdviceHeaderSQL - update ReceiptAdviceHeaderSQL query
 const updateReceiptAdviceHeaderSQL = `update receipt_advice_headers set 
+  document_status_code= ?,
   receipt_advice_type_code= ?,
   note= ?,
   line_count_numeric= ?,
@@ -126,6 +131,7 @@ func (rs *ReceiptAdviceHeaderService) CreateReceiptAdviceHeader(ctx context.Cont
 	}
 
 	receiptAdviceHeaderD.RcpthId = in.RcpthId
+	receiptAdviceHeaderD.DocumentStatusCode = in.DocumentStatusCode
 	receiptAdviceHeaderD.ReceiptAdviceTypeCode = in.ReceiptAdviceTypeCode
 	receiptAdviceHeaderD.Note = in.Note
 	receiptAdviceHeaderD.LineCountNumeric = in.LineCountNumeric
@@ -184,8 +190,21 @@ func (rs *ReceiptAdviceHeaderService) insertReceiptAdviceHeader(ctx context.Cont
 		return err
 	}
 	err = rs.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		err := numbering.Check(ctx, tx, numbering.ReceiptAdvice, receiptAdviceHeader.ReceiptAdviceHeaderD.DeliveryCustomerPartyId, receiptAdviceHeader.ReceiptAdviceHeaderD.RcpthId)
+		if err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		rcpthID, err := numbering.Assign(ctx, tx, numbering.ReceiptAdvice, receiptAdviceHeader.ReceiptAdviceHeaderD.DeliveryCustomerPartyId, receiptAdviceHeader.ReceiptAdviceHeaderD.RcpthId, receiptAdviceHeaderTmp.ReceiptAdviceHeaderT.IssueDate)
+		if err != nil {
+			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+		receiptAdviceHeader.ReceiptAdviceHeaderD.RcpthId = rcpthID
+
 		res, err := tx.NamedExecContext(ctx, insertReceiptAdviceHeaderSQL, receiptAdviceHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.ReceiptAdvice, err, receiptAdviceHeader.ReceiptAdviceHeaderD.RcpthId)
 			rs.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -393,6 +412,7 @@ func (rs *ReceiptAdviceHeaderService) UpdateReceiptAdviceHeader(ctx context.Cont
 
 	err = rs.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
 		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
+			in.DocumentStatusCode,
 			in.ReceiptAdviceTypeCode,
 			in.Note,
 			in.LineCountNumeric,
@@ -2,6 +2,7 @@ package logisticsservices
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -487,3 +488,176 @@ func TestReceiptAdviceHeaderService_UpdateReceiptAdviceHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestReceiptAdviceHeaderService_GetReceiptAdviceUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	receiptAdviceHeaderService := NewReceiptAdviceHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := logisticsproto.GetReceiptAdviceUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "234fd566-9451-4e3e-8318-4b713e688960"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *logisticsproto.GetReceiptAdviceUBLRequest
+	}
+	tests := []struct {
+		rs      *ReceiptAdviceHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			rs: receiptAdviceHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		receiptAdviceUBLResp, err := tt.rs.GetReceiptAdviceUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReceiptAdviceHeaderService.GetReceiptAdviceUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, receiptAdviceUBLResp)
+		ublStr := string(receiptAdviceUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<ReceiptAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:ReceiptAdvice-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>234fd566-9451-4e3e-8318-4b713e688960</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2019-07-23</cbc:IssueDate>`, "they should be equal")
+	}
+}
+
+func TestReceiptAdviceHeaderService_ImportReceiptAdviceUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	receiptAdviceHeaderService := NewReceiptAdviceHeaderService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<ReceiptAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:ReceiptAdvice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>RA-1</cbc:ID>
+  <cbc:IssueDate>2005-06-22</cbc:IssueDate>
+  <cac:DeliveryCustomerParty><cac:Party><cac:PartyName><cbc:Name>Buyercompany ltd</cbc:Name></cac:PartyName></cac:Party></cac:DeliveryCustomerParty>
+  <cac:DespatchSupplierParty><cac:Party><cac:PartyName><cbc:Name>Salescompany ltd.</cbc:Name></cac:PartyName></cac:Party></cac:DespatchSupplierParty>
+  <cac:ReceiptLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:ReceivedQuantity>%s</cbc:ReceivedQuantity>
+  </cac:ReceiptLine>
+</ReceiptAdvice>`
+
+	form := logisticsproto.ImportReceiptAdviceUBLRequest{}
+	form.Ubl = []byte(fmt.Sprintf(ublDoc, "85"))
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	form1 := logisticsproto.ImportReceiptAdviceUBLRequest{}
+	form1.Ubl = []byte(fmt.Sprintf(ublDoc, "2.5"))
+	form1.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form1.UserEmail = "sprov300@gmail.com"
+	form1.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *logisticsproto.ImportReceiptAdviceUBLRequest
+	}
+	tests := []struct {
+		rs      *ReceiptAdviceHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			rs: receiptAdviceHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+		{
+			rs: receiptAdviceHeaderService,
+			args: args{
+				ctx: ctx,
+				in:  &form1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		receiptAdviceResp, err := tt.rs.ImportReceiptAdviceUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReceiptAdviceHeaderService.ImportReceiptAdviceUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		if tt.wantErr {
+			continue
+		}
+		assert.NotNil(t, receiptAdviceResp)
+		receiptAdviceHeaderResult := receiptAdviceResp.ReceiptAdviceHeader
+		assert.Equal(t, receiptAdviceHeaderResult.ReceiptAdviceHeaderD.RcpthId, "RA-1", "they should be equal")
+	}
+}
+
+func TestReceiptAdviceHeaderService_UpdateReceiptAdviceLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	receiptAdviceHeaderService := NewReceiptAdviceHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := logisticsproto.UpdateReceiptAdviceLineRequest{}
+	form.Note = "sample updated"
+	form.ReceivedQuantity = uint32(95)
+	form.ShortQuantity = uint32(5)
+	form.Id = "7a3a7239-3417-488b-89cc-b0a185ce1d19"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = receiptAdviceHeaderService.UpdateReceiptAdviceLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "234fd566-9451-4e3e-8318-4b713e688960", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	receiptAdviceLinesResponse, err := receiptAdviceHeaderService.GetReceiptAdviceLines(ctx, &logisticsproto.GetReceiptAdviceLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	receiptAdviceLineResult := receiptAdviceLinesResponse.ReceiptAdviceLines[0]
+	assert.Equal(t, receiptAdviceLineResult.ReceiptAdviceLineD.Note, "sample updated", "they should be equal")
+	assert.Equal(t, receiptAdviceLineResult.ReceiptAdviceLineD.ReceivedQuantity, uint32(95), "they should be equal")
+	assert.Equal(t, receiptAdviceLineResult.ReceiptAdviceLineD.ShortQuantity, uint32(5), "they should be equal")
+
+	_, err = receiptAdviceHeaderService.DeleteReceiptAdviceLine(ctx, &logisticsproto.DeleteReceiptAdviceLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	receiptAdviceHeaderResponse, err := receiptAdviceHeaderService.GetReceiptAdviceHeader(ctx, &logisticsproto.GetReceiptAdviceHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, receiptAdviceHeaderResponse.ReceiptAdviceHeader.ReceiptAdviceHeaderD.LineCountNumeric, uint32(0), "they should be equal")
+}
@@ -0,0 +1,138 @@
+package logisticsservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// GetReceiptAdviceUBL - Get ReceiptAdvice as UBL 2.3 ReceiptAdvice XML
+func (rs *ReceiptAdviceHeaderService) GetReceiptAdviceUBL(ctx context.Context, inReq *logisticsproto.GetReceiptAdviceUBLRequest) (*logisticsproto.GetReceiptAdviceUBLResponse, error) {
+	in := inReq.GetRequest
+	src, err := rs.getReceiptAdviceSource(ctx, inReq)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	receiptAdvice, err := ubl.ReceiptAdviceFromSource(src)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ublBytes, err := ubl.Marshal(receiptAdvice)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	receiptAdviceUBLResponse := logisticsproto.GetReceiptAdviceUBLResponse{}
+	receiptAdviceUBLResponse.Ubl = ublBytes
+	return &receiptAdviceUBLResponse, nil
+}
+
+// getReceiptAdviceSource - Get ReceiptAdvice with the parties, items and
+// references needed to build a UBL document
+func (rs *ReceiptAdviceHeaderService) getReceiptAdviceSource(ctx context.Context, inReq *logisticsproto.GetReceiptAdviceUBLRequest) (*ubl.ReceiptAdviceSource, error) {
+	in := inReq.GetRequest
+	form := logisticsproto.GetReceiptAdviceHeaderRequest{}
+	form.GetRequest = in
+	receiptAdviceHeaderResponse, err := rs.GetReceiptAdviceHeader(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	receiptAdviceHeader := receiptAdviceHeaderResponse.ReceiptAdviceHeader
+	hd := receiptAdviceHeader.ReceiptAdviceHeaderD
+
+	linesForm := logisticsproto.GetReceiptAdviceLinesRequest{}
+	linesForm.GetRequest = in
+	receiptAdviceLinesResponse, err := rs.GetReceiptAdviceLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	receiptAdviceLines := receiptAdviceLinesResponse.ReceiptAdviceLines
+
+	keys := ubl.DocumentKeys{
+		HeaderID: hd.Id,
+		PartyIDs: []uint32{hd.DeliveryCustomerPartyId, hd.DespatchSupplierPartyId, hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder:    {hd.OrderId},
+			ubl.ReferenceDespatch: {hd.DespatchId},
+			ubl.ReferenceShipment: {hd.ShipmentId},
+		},
+	}
+	for _, receiptAdviceLine := range receiptAdviceLines {
+		ld := receiptAdviceLine.ReceiptAdviceLineD
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+		keys.References[ubl.ReferenceOrderLine] = append(keys.References[ubl.ReferenceOrderLine], ld.OrderLineId)
+		keys.References[ubl.ReferenceDespatchLine] = append(keys.References[ubl.ReferenceDespatchLine], ld.DespatchLineId)
+		keys.References[ubl.ReferenceShipment] = append(keys.References[ubl.ReferenceShipment], ld.ShipmentId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, rs.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.ReceiptAdviceSource{ReceiptAdviceHeader: receiptAdviceHeader, ReceiptAdviceLines: receiptAdviceLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportReceiptAdviceUBL - Create ReceiptAdvice from a UBL 2.3 ReceiptAdvice
+// XML document. Unmappable elements are reported as ubl.FieldErrors located
+// by XPath.
+func (rs *ReceiptAdviceHeaderService) ImportReceiptAdviceUBL(ctx context.Context, in *logisticsproto.ImportReceiptAdviceUBLRequest) (*logisticsproto.ImportReceiptAdviceUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	receiptAdvice := ubl.ReceiptAdvice{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceReceiptAdvice, &receiptAdvice)
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// the parties it creates and the receipt advice are written in one transaction
+	var receiptAdviceHeader *logisticsproto.ReceiptAdviceHeader
+	err = rs.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(rs.log, rs.DBService, rs.RedisService, rs.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, receiptAdvice.IssueDate)
+		form, err := ubl.ImportReceiptAdvice(ctx, &receiptAdvice, resolver)
+		if err != nil {
+			return err
+		}
+
+		form.UserId = in.UserId
+		form.UserEmail = in.UserEmail
+		form.RequestId = in.RequestId
+		receiptAdviceHeaderResponse, err := rs.CreateReceiptAdviceHeader(ctx, form)
+		if err != nil {
+			return err
+		}
+		receiptAdviceHeader = receiptAdviceHeaderResponse.ReceiptAdviceHeader
+		return nil
+	})
+	if err != nil {
+		rs.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importReceiptAdviceUBLResponse := logisticsproto.ImportReceiptAdviceUBLResponse{}
+	importReceiptAdviceUBLResponse.ReceiptAdviceHeader = receiptAdviceHeader
+	return &importReceiptAdviceUBLResponse, nil
+}
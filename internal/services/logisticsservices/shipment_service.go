@@ -66,6 +66,8 @@ func StartLogisticsServer(log *zap.Logger, isTest bool, pwd string, dbOpt *confi
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	shipmentService := NewShipmentService(log, dbService, redisService, uc)
 	despatchService := NewDespatchService(log, dbService, redisService, uc)
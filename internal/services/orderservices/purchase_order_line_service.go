@@ -2,14 +2,19 @@ package orderservices
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	orderstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/order/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -22,6 +27,7 @@ note,
 sales_order_id,
 line_status_code,
 quantity,
+unit_code,
 line_extension_amount,
 total_tax_amount,
 minimum_quantity,
@@ -37,6 +43,7 @@ warranty_information,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -58,6 +65,7 @@ updated_at)
 :sales_order_id,
 :line_status_code,
 :quantity,
+:unit_code,
 :line_extension_amount,
 :total_tax_amount,
 :minimum_quantity,
@@ -73,6 +81,7 @@ updated_at)
 :originator_party_id,
 :item_id,
 :price_amount,
+:currency_code,
 :price_base_quantity,
 :price_change_reason,
 :price_type_code,
@@ -97,6 +106,7 @@ note,
 sales_order_id,
 line_status_code,
 quantity,
+unit_code,
 line_extension_amount,
 total_tax_amount,
 minimum_quantity,
@@ -112,6 +122,7 @@ warranty_information,
 originator_party_id,
 item_id,
 price_amount,
+currency_code,
 price_base_quantity,
 price_change_reason,
 price_type_code,
@@ -127,13 +138,33 @@ updated_by_user_id,
 created_at,
 updated_at from purchase_order_lines`
 
-// CreatePurchaseOrderLine - Create PurchaseOrderLine
+// CreatePurchaseOrderLine - Create PurchaseOrderLine of a draft purchase
+// order
 func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderLine(ctx context.Context, in *orderproto.CreatePurchaseOrderLineRequest) (*orderproto.CreatePurchaseOrderLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	currencyCode := ""
+	if in.PurchaseOrderHeaderId != 0 {
+		getByIDRequest := commonproto.GetByIdRequest{Id: in.PurchaseOrderHeaderId, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeaderByPk(ctx, &orderproto.GetPurchaseOrderHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		if err = docstatus.CheckEditable(purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.DocumentStatusCode); err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		currencyCode = purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.DocumentCurrencyCode
+	}
 	purchaseOrderLine, err := ps.ProcessPurchaseOrderLineRequest(ctx, in)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
 	}
+	purchaseOrderLine.PurchaseOrderLineD.CurrencyCode = currencyCode
 
 	err = ps.insertPurchaseOrderLine(ctx, insertPurchaseOrderLineSQL, purchaseOrderLine, in.GetUserEmail(), in.GetRequestId())
 	if err != nil {
@@ -194,6 +225,10 @@ func (ps *PurchaseOrderHeaderService) ProcessPurchaseOrderLineRequest(ctx contex
 	purchaseOrderLineD.SalesOrderId = in.SalesOrderId
 	purchaseOrderLineD.LineStatusCode = in.LineStatusCode
 	purchaseOrderLineD.Quantity = in.Quantity
+	purchaseOrderLineD.UnitCode = in.UnitCode
+	if purchaseOrderLineD.UnitCode == "" {
+		purchaseOrderLineD.UnitCode = ubl.UnitCodeOne
+	}
 	purchaseOrderLineD.LineExtensionAmount = in.LineExtensionAmount
 	purchaseOrderLineD.TotalTaxAmount = in.TotalTaxAmount
 	purchaseOrderLineD.MinimumQuantity = in.MinimumQuantity
@@ -232,6 +267,12 @@ func (ps *PurchaseOrderHeaderService) ProcessPurchaseOrderLineRequest(ctx contex
 
 	purchaseOrderLine := orderproto.PurchaseOrderLine{PurchaseOrderLineD: &purchaseOrderLineD, PurchaseOrderLineT: &purchaseOrderLineT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	purchaseOrderLine.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, ps.log, ubl.MasterFlagPurchaseOrderLine, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &purchaseOrderLine, nil
 }
 
@@ -243,6 +284,13 @@ func (ps *PurchaseOrderHeaderService) insertPurchaseOrderLine(ctx context.Contex
 		return err
 	}
 	err = ps.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
+		if purchaseOrderLine.PurchaseOrderLineD.PurchaseOrderHeaderId != 0 {
+			err := lockDraftPurchaseOrder(ctx, ps.log, tx, purchaseOrderLine.PurchaseOrderLineD.PurchaseOrderHeaderId, userEmail, requestID)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+		}
 		res, err := tx.NamedExecContext(ctx, insertPurchaseOrderLineSQL, purchaseOrderLineTmp)
 		if err != nil {
 			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
@@ -261,6 +309,12 @@ func (ps *PurchaseOrderHeaderService) insertPurchaseOrderLine(ctx context.Contex
 			return err
 		}
 		purchaseOrderLine.PurchaseOrderLineD.IdS = uuid4Str
+
+		err = allowancecharge.Insert(ctx, ps.log, tx, purchaseOrderLine.AllowanceCharges, purchaseOrderLine.PurchaseOrderLineD.Id, purchaseOrderLine.PurchaseOrderLineD.CurrencyCode, userEmail, requestID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
 		return nil
 	})
 
@@ -307,7 +361,7 @@ func (ps *PurchaseOrderHeaderService) GetPurchaseOrderLines(ctx context.Context,
 	purchaseOrderLines := []*orderproto.PurchaseOrderLine{}
 
 	nselectPurchaseOrderLinesSQL := selectPurchaseOrderLinesSQL + ` where purchase_order_header_id = ? and status_code = ?;`
-	rows, err := ps.DBService.DB.QueryxContext(ctx, nselectPurchaseOrderLinesSQL, purchaseOrderHeader.PurchaseOrderHeaderD.Id, "active")
+	rows, err := ps.DBService.Queryer(ctx).QueryxContext(ctx, nselectPurchaseOrderLinesSQL, purchaseOrderHeader.PurchaseOrderHeaderD.Id, "active")
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -344,6 +398,11 @@ func (ps *PurchaseOrderHeaderService) getPurchaseOrderLineStruct(ctx context.Con
 	crUpdTime.CreatedAt = common.TimeToTimestamp(purchaseOrderLineTmp.CrUpdTime.CreatedAt)
 	crUpdTime.UpdatedAt = common.TimeToTimestamp(purchaseOrderLineTmp.CrUpdTime.UpdatedAt)
 
+	if err := money.Normalize(purchaseOrderLineTmp.PurchaseOrderLineD); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(purchaseOrderLineTmp.PurchaseOrderLineD.Uuid4)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -354,5 +413,236 @@ func (ps *PurchaseOrderHeaderService) getPurchaseOrderLineStruct(ctx context.Con
 
 	purchaseOrderLine := orderproto.PurchaseOrderLine{PurchaseOrderLineD: purchaseOrderLineTmp.PurchaseOrderLineD, PurchaseOrderLineT: purchaseOrderLineT, CrUpdUser: purchaseOrderLineTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	purchaseOrderLine.AllowanceCharges, err = allowancecharge.Get(ctx, ps.log, ps.DBService, ubl.MasterFlagPurchaseOrderLine, purchaseOrderLine.PurchaseOrderLineD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &purchaseOrderLine, nil
 }
+
+// selectPurchaseOrderLineHeaderIDSQL - the purchase order of a
+// PurchaseOrderLine
+const selectPurchaseOrderLineHeaderIDSQL = `select purchase_order_header_id from purchase_order_lines where uuid4 = ? and status_code = ?;`
+
+// updatePurchaseOrderLineSQL - update PurchaseOrderLineSQL query
+const updatePurchaseOrderLineSQL = `update purchase_order_lines set 
+note = ?, 
+quantity = ?, 
+line_extension_amount = ?, 
+accounting_cost = ?, 
+price_amount = ?, 
+price_base_quantity = ?, 
+updated_at = ? where id = ?;`
+
+// deletePurchaseOrderLineSQL - delete PurchaseOrderLineSQL query
+const deletePurchaseOrderLineSQL = `update purchase_order_lines set 
+status_code = ?, 
+updated_at = ? where id = ?;`
+
+// updatePurchaseOrderTotalsSQL - update the line count and monetary totals
+// of a purchase order
+const updatePurchaseOrderTotalsSQL = `update purchase_order_headers set 
+line_count_numeric = ?, 
+line_extension_amount = ?, 
+allowance_total_amount = ?, 
+charge_total_amount = ?, 
+tax_exclusive_amount = ?, 
+tax_inclusive_amount = ?, 
+withholding_tax_total_amount = ?, 
+payable_rounding_amount = ?,
+payable_amount = ?, updated_at = ? where id = ?;`
+
+// UpdatePurchaseOrderLine - Update PurchaseOrderLine of a draft purchase
+// order. The line extension amounts and the line count and monetary totals
+// of the order are calculated again, as setPurchaseOrderTotals does, and
+// kept in the same transaction, which locks the order before it reads it.
+func (ps *PurchaseOrderHeaderService) UpdatePurchaseOrderLine(ctx context.Context, in *orderproto.UpdatePurchaseOrderLineRequest) (*orderproto.UpdatePurchaseOrderLineResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err := ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		purchaseOrderHeader, purchaseOrderLines, i, err := ps.getDraftPurchaseOrderOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		purchaseOrderLine := purchaseOrderLines[i]
+		ld := purchaseOrderLine.PurchaseOrderLineD
+		ld.Note = in.Note
+		ld.Quantity = in.Quantity
+		ld.PriceAmount = in.PriceAmount
+		ld.PriceBaseQuantity = in.PriceBaseQuantity
+		ld.AccountingCost = in.AccountingCost
+
+		err = ps.calculatePurchaseOrderLineTotals(ctx, purchaseOrderHeader, purchaseOrderLines, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, updatePurchaseOrderLineSQL,
+			ld.Note,
+			ld.Quantity,
+			ld.LineExtensionAmount,
+			ld.AccountingCost,
+			ld.PriceAmount,
+			ld.PriceBaseQuantity,
+			tn,
+			ld.Id)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		err = allowancecharge.UpdateAmounts(ctx, ps.log, tx, purchaseOrderLine.AllowanceCharges, tn, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updatePurchaseOrderTotals(ctx, ps.log, tx, purchaseOrderHeader, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &orderproto.UpdatePurchaseOrderLineResponse{}, nil
+}
+
+// DeletePurchaseOrderLine - Delete PurchaseOrderLine of a draft purchase
+// order, the line count and monetary totals of the order are calculated
+// again without it and kept in the same transaction
+func (ps *PurchaseOrderHeaderService) DeletePurchaseOrderLine(ctx context.Context, inReq *orderproto.DeletePurchaseOrderLineRequest) (*orderproto.DeletePurchaseOrderLineResponse, error) {
+	in := inReq.GetRequest
+	err := ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		purchaseOrderHeader, purchaseOrderLines, i, err := ps.getDraftPurchaseOrderOfLine(ctx, tx, in.Id, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		purchaseOrderLineID := purchaseOrderLines[i].PurchaseOrderLineD.Id
+		purchaseOrderLines = append(purchaseOrderLines[:i], purchaseOrderLines[i+1:]...)
+		err = ps.calculatePurchaseOrderLineTotals(ctx, purchaseOrderHeader, purchaseOrderLines, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		tn := common.GetTimeDetails()
+		_, err = tx.ExecContext(ctx, deletePurchaseOrderLineSQL, "inactive", tn, purchaseOrderLineID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return updatePurchaseOrderTotals(ctx, ps.log, tx, purchaseOrderHeader, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &orderproto.DeletePurchaseOrderLineResponse{}, nil
+}
+
+// getDraftPurchaseOrderOfLine - the purchase order of the PurchaseOrderLine
+// purchaseOrderLineID, locked with lockDraftPurchaseOrder within tx, the
+// transaction of ctx, so that it is still a draft, with its lines and the
+// index of the line among them, read after the lock
+func (ps *PurchaseOrderHeaderService) getDraftPurchaseOrderOfLine(ctx context.Context, tx *sqlx.Tx, purchaseOrderLineID string, userEmail string, requestID string) (*orderproto.PurchaseOrderHeader, []*orderproto.PurchaseOrderLine, int, error) {
+	uuid4byte, err := common.UUIDStrToBytes(purchaseOrderLineID)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	var purchaseOrderHeaderID uint32
+	err = tx.QueryRowxContext(ctx, selectPurchaseOrderLineHeaderIDSQL, uuid4byte, "active").Scan(&purchaseOrderHeaderID)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	err = lockDraftPurchaseOrder(ctx, ps.log, tx, purchaseOrderHeaderID, userEmail, requestID)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+
+	getByIDRequest := commonproto.GetByIdRequest{Id: purchaseOrderHeaderID, UserEmail: userEmail, RequestId: requestID}
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeaderByPk(ctx, &orderproto.GetPurchaseOrderHeaderByPkRequest{GetByIdRequest: &getByIDRequest})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	purchaseOrderHeader := purchaseOrderHeaderResponse.PurchaseOrderHeader
+
+	getRequest := commonproto.GetRequest{Id: purchaseOrderHeader.PurchaseOrderHeaderD.IdS, UserEmail: userEmail, RequestId: requestID}
+	purchaseOrderLinesResponse, err := ps.GetPurchaseOrderLines(ctx, &orderproto.GetPurchaseOrderLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, nil, 0, err
+	}
+	for i, purchaseOrderLine := range purchaseOrderLinesResponse.PurchaseOrderLines {
+		if purchaseOrderLine.PurchaseOrderLineD.IdS == purchaseOrderLineID {
+			return purchaseOrderHeader, purchaseOrderLinesResponse.PurchaseOrderLines, i, nil
+		}
+	}
+	return nil, nil, 0, sql.ErrNoRows
+}
+
+// calculatePurchaseOrderLineTotals - calculate the totals of a purchase
+// order with purchaseOrderLines, its lines after a line is changed; its
+// LineCountNumeric becomes the number of those lines
+func (ps *PurchaseOrderHeaderService) calculatePurchaseOrderLineTotals(ctx context.Context, purchaseOrderHeader *orderproto.PurchaseOrderHeader, purchaseOrderLines []*orderproto.PurchaseOrderLine, userEmail string, requestID string) error {
+	purchaseOrderHeader.PurchaseOrderHeaderD.LineCountNumeric = 0
+	return ps.calculatePurchaseOrderTotals(ctx, purchaseOrderHeader, purchaseOrderLines, false, userEmail, requestID)
+}
+
+// selectPurchaseOrderStatusForUpdateSQL - lock a purchase order until the end of the
+// transaction and get its document status
+const selectPurchaseOrderStatusForUpdateSQL = `select document_status_code from purchase_order_headers where id = ? for update;`
+
+// lockDraftPurchaseOrder - lock the purchase order purchaseOrderHeaderID
+// until the end of the transaction of a change to its lines and check that
+// it is still a draft, as it may have been issued since it was read
+func lockDraftPurchaseOrder(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, purchaseOrderHeaderID uint32, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectPurchaseOrderStatusForUpdateSQL, purchaseOrderHeaderID).Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = docstatus.CheckEditable(documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// updatePurchaseOrderTotals - keep the line count and monetary totals of a
+// purchase order and the amounts of its allowances and charges within the
+// transaction of the change to its lines
+func updatePurchaseOrderTotals(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, purchaseOrderHeader *orderproto.PurchaseOrderHeader, tn time.Time, userEmail string, requestID string) error {
+	hd := purchaseOrderHeader.PurchaseOrderHeaderD
+	_, err := tx.ExecContext(ctx, updatePurchaseOrderTotalsSQL,
+		hd.LineCountNumeric,
+		hd.LineExtensionAmount,
+		hd.AllowanceTotalAmount,
+		hd.ChargeTotalAmount,
+		hd.TaxExclusiveAmount,
+		hd.TaxInclusiveAmount,
+		hd.WithholdingTaxTotalAmount,
+		hd.PayableRoundingAmount,
+		hd.PayableAmount,
+		tn,
+		hd.Id)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return allowancecharge.UpdateAmounts(ctx, log, tx, purchaseOrderHeader.AllowanceCharges, tn, userEmail, requestID)
+}
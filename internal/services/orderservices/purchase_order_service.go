@@ -6,14 +6,23 @@ import (
 	"os"
 	"time"
 
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
 	"github.com/cloudfresco/sc-ubl/internal/common"
 	"github.com/cloudfresco/sc-ubl/internal/config"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/exchangerate"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	orderstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/order/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"github.com/jmoiron/sqlx"
@@ -66,6 +75,8 @@ func StartOrderServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DB
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, uc)
 
@@ -138,11 +149,13 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
 issue_date,
 validity_period,
 tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -201,11 +214,13 @@ updated_at)
 :payable_rounding_amount,
 :payable_amount,
 :payable_alternative_amount,
+:payable_alternative_currency_code,
 :issue_date,
 :validity_period,
 :tax_ex_date,
 :pricing_ex_date,
 :payment_ex_date,
+:document_status_code,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -267,11 +282,15 @@ prepaid_amount,
 payable_rounding_amount,
 payable_amount,
 payable_alternative_amount,
+payable_alternative_currency_code,
+order_response_code,
+sequence_number_id,
 issue_date,
 validity_period,
 tax_ex_date,
 pricing_ex_date,
 payment_ex_date,
+document_status_code,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -290,8 +309,17 @@ const updatePurchaseOrderHeaderSQL = `update purchase_order_headers set
   accounting_cost= ?,
   updated_at = ? where uuid4 = ?;`
 
-// CreatePurchaseOrderHeader - Create PurchaseOrderHeader
+// CreatePurchaseOrderHeader - Create PurchaseOrderHeader. Exchange rates
+// without a calculation rate are filled from the stored rates of their dates.
+// The line extension amounts and monetary totals are calculated from the
+// lines; with RejectMismatchedTotals an order whose totals differ from the
+// calculated ones is rejected instead of corrected, as is one whose
+// LineCountNumeric is not the number of its lines.
 func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderHeader(ctx context.Context, in *orderproto.CreatePurchaseOrderHeaderRequest) (*orderproto.CreatePurchaseOrderHeaderResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ps.UserServiceClient)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -398,6 +426,11 @@ func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderHeader(ctx context.Cont
 	purchaseOrderHeaderD.PayableRoundingAmount = in.PayableRoundingAmount
 	purchaseOrderHeaderD.PayableAmount = in.PayableAmount
 	purchaseOrderHeaderD.PayableAlternativeAmount = in.PayableAlternativeAmount
+	purchaseOrderHeaderD.PayableAlternativeCurrencyCode = in.PayableAlternativeCurrencyCode
+	if purchaseOrderHeaderD.PayableAlternativeCurrencyCode == "" {
+		purchaseOrderHeaderD.PayableAlternativeCurrencyCode = purchaseOrderHeaderD.DocumentCurrencyCode
+	}
+	purchaseOrderHeaderD.DocumentStatusCode = string(docstatus.Draft)
 
 	purchaseOrderHeaderT := orderproto.PurchaseOrderHeaderT{}
 	purchaseOrderHeaderT.IssueDate = common.TimeToTimestamp(issueDate.UTC().Truncate(time.Second))
@@ -406,6 +439,13 @@ func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderHeader(ctx context.Cont
 	purchaseOrderHeaderT.PaymentExDate = common.TimeToTimestamp(paymentExDate.UTC().Truncate(time.Second))
 	purchaseOrderHeaderT.PricingExDate = common.TimeToTimestamp(pricingExDate.UTC().Truncate(time.Second))
 
+	exchangeDates := map[string]time.Time{exchangerate.TaxExchange: taxExDate, exchangerate.PricingExchange: pricingExDate, exchangerate.PaymentExchange: paymentExDate}
+	err = exchangerate.FillAll(&purchaseOrderHeaderD, exchangeDates, paymentservice.ExchangeRateLookup(ctx, ps.DBService))
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	crUpdUser := commonproto.CrUpdUser{}
 	crUpdUser.StatusCode = "active"
 	crUpdUser.CreatedByUserId = user.Id
@@ -417,6 +457,12 @@ func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderHeader(ctx context.Cont
 
 	purchaseOrderHeader := orderproto.PurchaseOrderHeader{PurchaseOrderHeaderD: &purchaseOrderHeaderD, PurchaseOrderHeaderT: &purchaseOrderHeaderT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
 
+	purchaseOrderHeader.AllowanceCharges, err = allowancecharge.ProcessRequests(ctx, ps.log, ubl.MasterFlagPurchaseOrderHeader, in.AllowanceCharges, user.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	purchaseOrderLines := []*orderproto.PurchaseOrderLine{}
 	// we will do for loop on lines which is comes from client form
 	for _, line := range in.PurchaseOrderLines {
@@ -429,8 +475,14 @@ func (ps *PurchaseOrderHeaderService) CreatePurchaseOrderHeader(ctx context.Cont
 			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 			return nil, err
 		}
+		purchaseOrderLine.PurchaseOrderLineD.CurrencyCode = purchaseOrderHeaderD.DocumentCurrencyCode
 		purchaseOrderLines = append(purchaseOrderLines, purchaseOrderLine)
 	}
+	err = ps.calculatePurchaseOrderTotals(ctx, &purchaseOrderHeader, purchaseOrderLines, in.RejectMismatchedTotals, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, en16931.GRPCError(err)
+	}
 
 	err = ps.insertPurchaseOrderHeader(ctx, insertPurchaseOrderHeaderSQL, &purchaseOrderHeader, insertPurchaseOrderLineSQL, purchaseOrderLines, in.GetUserEmail(), in.GetRequestId())
 
@@ -452,8 +504,15 @@ func (ps *PurchaseOrderHeaderService) insertPurchaseOrderHeader(ctx context.Cont
 	}
 	err = ps.DBService.InsUpd(ctx, userEmail, requestID, func(tx *sqlx.Tx) error {
 		// header creation
+		err := numbering.Check(ctx, tx, numbering.PurchaseOrder, purchaseOrderHeader.PurchaseOrderHeaderD.BuyerCustomerPartyId, purchaseOrderHeader.PurchaseOrderHeaderD.PohId)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		res, err := tx.NamedExecContext(ctx, insertPurchaseOrderHeaderSQL, purchaseOrderHeaderTmp)
 		if err != nil {
+			err = numbering.UsedError(numbering.PurchaseOrder, err, purchaseOrderHeader.PurchaseOrderHeaderD.PohId)
 			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 			return err
 		}
@@ -471,6 +530,12 @@ func (ps *PurchaseOrderHeaderService) insertPurchaseOrderHeader(ctx context.Cont
 		}
 		purchaseOrderHeader.PurchaseOrderHeaderD.IdS = uuid4Str
 
+		err = allowancecharge.Insert(ctx, ps.log, tx, purchaseOrderHeader.AllowanceCharges, purchaseOrderHeader.PurchaseOrderHeaderD.Id, purchaseOrderHeader.PurchaseOrderHeaderD.DocumentCurrencyCode, userEmail, requestID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return err
+		}
+
 		for _, purchaseOrderLine := range purchaseOrderLines {
 			purchaseOrderLine.PurchaseOrderLineD.PurchaseOrderHeaderId = purchaseOrderHeader.PurchaseOrderHeaderD.Id
 			purchaseOrderLineTmp, err := ps.CrPurchaseOrderLineStruct(ctx, purchaseOrderLine, userEmail, requestID)
@@ -478,7 +543,25 @@ func (ps *PurchaseOrderHeaderService) insertPurchaseOrderHeader(ctx context.Cont
 				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
 			}
-			_, err = tx.NamedExecContext(ctx, insertPurchaseOrderLineSQL, purchaseOrderLineTmp)
+			res, err := tx.NamedExecContext(ctx, insertPurchaseOrderLineSQL, purchaseOrderLineTmp)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			uID, err := res.LastInsertId()
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+			purchaseOrderLine.PurchaseOrderLineD.Id = uint32(uID)
+			purchaseOrderLine.PurchaseOrderLineD.IdS, err = common.UUIDBytesToStr(purchaseOrderLine.PurchaseOrderLineD.Uuid4)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+				return err
+			}
+
+			err = allowancecharge.Insert(ctx, ps.log, tx, purchaseOrderLine.AllowanceCharges, purchaseOrderLine.PurchaseOrderLineD.Id, purchaseOrderLine.PurchaseOrderLineD.CurrencyCode, userEmail, requestID)
 			if err != nil {
 				ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
 				return err
@@ -530,7 +613,7 @@ func (ps *PurchaseOrderHeaderService) GetPurchaseOrderHeaders(ctx context.Contex
 
 	nselectPurchaseOrderHeadersSQL := selectPurchaseOrderHeadersSQL + ` where ` + query
 
-	rows, err := ps.DBService.DB.QueryxContext(ctx, nselectPurchaseOrderHeadersSQL, "active")
+	rows, err := ps.DBService.Queryer(ctx).QueryxContext(ctx, nselectPurchaseOrderHeadersSQL, "active")
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
 		return nil, err
@@ -578,7 +661,7 @@ func (ps *PurchaseOrderHeaderService) GetPurchaseOrderHeader(ctx context.Context
 	}
 
 	nselectPurchaseOrderHeadersSQL := selectPurchaseOrderHeadersSQL + ` where uuid4 = ? and status_code = ?;`
-	row := ps.DBService.DB.QueryRowxContext(ctx, nselectPurchaseOrderHeadersSQL, uuid4byte, "active")
+	row := ps.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectPurchaseOrderHeadersSQL, uuid4byte, "active")
 	purchaseOrderHeaderTmp := orderstruct.PurchaseOrderHeader{}
 	err = row.StructScan(&purchaseOrderHeaderTmp)
 	if err != nil {
@@ -600,7 +683,7 @@ func (ps *PurchaseOrderHeaderService) GetPurchaseOrderHeader(ctx context.Context
 func (ps *PurchaseOrderHeaderService) GetPurchaseOrderHeaderByPk(ctx context.Context, inReq *orderproto.GetPurchaseOrderHeaderByPkRequest) (*orderproto.GetPurchaseOrderHeaderByPkResponse, error) {
 	in := inReq.GetByIdRequest
 	nselectPurchaseOrderHeadersSQL := selectPurchaseOrderHeadersSQL + ` where id = ? and status_code = ?;`
-	row := ps.DBService.DB.QueryRowxContext(ctx, nselectPurchaseOrderHeadersSQL, in.Id, "active")
+	row := ps.DBService.Queryer(ctx).QueryRowxContext(ctx, nselectPurchaseOrderHeadersSQL, in.Id, "active")
 	purchaseOrderHeaderTmp := orderstruct.PurchaseOrderHeader{}
 	err := row.StructScan(&purchaseOrderHeaderTmp)
 	if err != nil {
@@ -635,6 +718,11 @@ func (ps *PurchaseOrderHeaderService) getPurchaseOrderHeaderStruct(ctx context.C
 	crUpdTime.CreatedAt = common.TimeToTimestamp(purchaseOrderHeaderTmp.CrUpdTime.CreatedAt)
 	crUpdTime.UpdatedAt = common.TimeToTimestamp(purchaseOrderHeaderTmp.CrUpdTime.UpdatedAt)
 
+	if err := money.Normalize(purchaseOrderHeaderTmp.PurchaseOrderHeaderD); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(purchaseOrderHeaderTmp.PurchaseOrderHeaderD.Uuid4)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -645,36 +733,113 @@ func (ps *PurchaseOrderHeaderService) getPurchaseOrderHeaderStruct(ctx context.C
 
 	purchaseOrderHeader := orderproto.PurchaseOrderHeader{PurchaseOrderHeaderD: purchaseOrderHeaderTmp.PurchaseOrderHeaderD, PurchaseOrderHeaderT: purchaseOrderHeaderT, CrUpdUser: purchaseOrderHeaderTmp.CrUpdUser, CrUpdTime: crUpdTime}
 
+	purchaseOrderHeader.AllowanceCharges, err = allowancecharge.Get(ctx, ps.log, ps.DBService, ubl.MasterFlagPurchaseOrderHeader, purchaseOrderHeader.PurchaseOrderHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	return &purchaseOrderHeader, nil
 }
 
-// UpdatePurchaseOrderHeader - Update PurchaseOrderHeader
+// UpdatePurchaseOrderHeader - Update PurchaseOrderHeader, which must
+// still be a draft, an issued purchase order is revised with
+// RevisePurchaseOrder
 func (ps *PurchaseOrderHeaderService) UpdatePurchaseOrderHeader(ctx context.Context, in *orderproto.UpdatePurchaseOrderHeaderRequest) (*orderproto.UpdatePurchaseOrderHeaderResponse, error) {
+	err := ps.updatePurchaseOrderHeader(ctx, in, nil)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &orderproto.UpdatePurchaseOrderHeaderResponse{}, nil
+}
+
+// updatePurchaseOrderHeader - update a draft purchase order as
+// UpdatePurchaseOrderHeader does, or, with a revision, an issued one,
+// keeping the revised purchase order as its next version. The purchase
+// order is locked with lockPurchaseOrderHeader before it is read, so it is
+// read, checked and updated within one transaction.
+func (ps *PurchaseOrderHeaderService) updatePurchaseOrderHeader(ctx context.Context, in *orderproto.UpdatePurchaseOrderHeaderRequest, revision *docversion.Revision) error {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return err
+	}
 	db := ps.DBService.DB
 	tn := common.GetTimeDetails()
 
 	uuid4byte, err := common.UUIDStrToBytes(in.Id)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	stmt, err := db.PreparexContext(ctx, updatePurchaseOrderHeaderSQL)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		err := lockPurchaseOrderHeader(ctx, ps.log, tx, uuid4byte, revision, in.GetUserEmail(), in.GetRequestId())
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+		purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: &getRequest})
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		purchaseOrderHeader := purchaseOrderHeaderResponse.PurchaseOrderHeader
+
+		purchaseOrderLines := []*orderproto.PurchaseOrderLine{}
+		var before []byte
+		if revision != nil {
+			purchaseOrderLinesResponse, err := ps.GetPurchaseOrderLines(ctx, &orderproto.GetPurchaseOrderLinesRequest{GetRequest: &getRequest})
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			purchaseOrderLines = purchaseOrderLinesResponse.PurchaseOrderLines
+			before, err = purchaseOrderSnapshot(purchaseOrderHeader, purchaseOrderLines)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		hd := purchaseOrderHeader.PurchaseOrderHeaderD
+		hd.OrderTypeCode = in.OrderTypeCode
+		hd.Note = in.Note
+		hd.RequestedInvoiceCurrencyCode = in.RequestedInvoiceCurrencyCode
+		hd.DocumentCurrencyCode = in.DocumentCurrencyCode
+		hd.PricingCurrencyCode = in.PricingCurrencyCode
+		hd.TaxCurrencyCode = in.TaxCurrencyCode
+		hd.AccountingCostCode = in.AccountingCostCode
+		hd.AccountingCost = in.AccountingCost
+		purchaseOrderHeader.CrUpdTime.UpdatedAt = common.TimeToTimestamp(tn)
+
+		var after []byte
+		if revision != nil {
+			after, err = purchaseOrderSnapshot(purchaseOrderHeader, purchaseOrderLines)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
 		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
-			in.OrderTypeCode,
-			in.Note,
-			in.RequestedInvoiceCurrencyCode,
-			in.DocumentCurrencyCode,
-			in.PricingCurrencyCode,
-			in.TaxCurrencyCode,
-			in.AccountingCostCode,
-			in.AccountingCost,
+			hd.OrderTypeCode,
+			hd.Note,
+			hd.RequestedInvoiceCurrencyCode,
+			hd.DocumentCurrencyCode,
+			hd.PricingCurrencyCode,
+			hd.TaxCurrencyCode,
+			hd.AccountingCostCode,
+			hd.AccountingCost,
 			tn,
 			uuid4byte)
 		if err != nil {
@@ -686,18 +851,48 @@ func (ps *PurchaseOrderHeaderService) UpdatePurchaseOrderHeader(ctx context.Cont
 			}
 			return err
 		}
+		if revision != nil {
+			return docversion.InsertVersions(ctx, ps.log, tx, ubl.MasterFlagPurchaseOrderHeader, hd.Id, before, after, revision, tn, in.GetUserEmail(), in.GetRequestId())
+		}
 		return nil
 	})
 
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	err = stmt.Close()
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
-		return nil, err
+		return err
 	}
-	return &orderproto.UpdatePurchaseOrderHeaderResponse{}, nil
+	return nil
+}
+
+// selectPurchaseOrderHeaderStatusByUUIDForUpdateSQL - lock a purchase order until the end of the
+// transaction and get its document status
+const selectPurchaseOrderHeaderStatusByUUIDForUpdateSQL = `select document_status_code from purchase_order_headers where uuid4 = ? and status_code = ? for update;`
+
+// lockPurchaseOrderHeader - lock the purchase order uuid4byte until the end of the transaction of
+// its update, before it is read, and check that it is still a draft or,
+// with a revision, that it can be revised, as its status may have changed
+// since the update was asked for
+func lockPurchaseOrderHeader(ctx context.Context, log *zap.Logger, tx *sqlx.Tx, uuid4byte []byte, revision *docversion.Revision, userEmail string, requestID string) error {
+	var documentStatusCode string
+	err := tx.QueryRowxContext(ctx, selectPurchaseOrderHeaderStatusByUUIDForUpdateSQL, uuid4byte, "active").Scan(&documentStatusCode)
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	if revision == nil {
+		err = docstatus.CheckEditable(documentStatusCode)
+	} else {
+		err = docstatus.CheckRevisable(documentStatusCode)
+	}
+	if err != nil {
+		log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
 }
@@ -2,12 +2,17 @@ package orderservices
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	itemproto "github.com/cloudfresco/sc-ubl/internal/protogen/item/v1"
 	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
 	"github.com/cloudfresco/sc-ubl/test"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -25,13 +30,13 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeaders(t *testing.T) {
 	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
 	purchaseOrders := []*orderproto.PurchaseOrderHeader{}
 
-	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), float64(100), float64(100), "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), "100.00", "100.00", "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	purchaseOrderHeader2, err := GetPurchaseOrderHeader(uint32(2), []byte{139, 244, 179, 212, 149, 255, 72, 147, 172, 12, 131, 140, 90, 27, 25, 89}, "8bf4b3d4-95ff-4893-ac0c-838c5a1b1959", "2010-01-20T10:04:26Z", "2010-01-21T10:04:26Z", uint32(2), uint32(1), uint32(2), float64(6225), float64(6225), "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseOrderHeader2, err := GetPurchaseOrderHeader(uint32(2), []byte{139, 244, 179, 212, 149, 255, 72, 147, 172, 12, 131, 140, 90, 27, 25, 89}, "8bf4b3d4-95ff-4893-ac0c-838c5a1b1959", "2010-01-20T10:04:26Z", "2010-01-21T10:04:26Z", uint32(2), uint32(1), uint32(2), "6225.00", "6225.00", "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -81,8 +86,8 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeaders(t *testing.T) {
 		purchaseOrderHeaderResult := purchaseOrderHeadersResp.PurchaseOrderHeaders[0]
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.SellerSupplierPartyId, uint32(1), "they should be equal")
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.OriginatorCustomerPartyId, uint32(2), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, float64(6225), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, float64(6225), "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, "6225.00", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, "6225.00", "they should be equal")
 	}
 }
 
@@ -97,7 +102,7 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeader(t *testing.T) {
 
 	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
 
-	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), float64(100), float64(100), "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), "100.00", "100.00", "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -145,8 +150,8 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeader(t *testing.T) {
 		purchaseOrderHeaderResult := purchaseOrderHeaderResp.PurchaseOrderHeader
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.SellerSupplierPartyId, uint32(1), "they should be equal")
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.OriginatorCustomerPartyId, uint32(2), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, float64(100), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, float64(100), "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, "100.00", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, "100.00", "they should be equal")
 	}
 }
 
@@ -160,7 +165,7 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeaderByPk(t *testing.T) {
 	ctx := LoginUser()
 
 	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
-	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), float64(100), float64(100), "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseOrderHeader, err := GetPurchaseOrderHeader(uint32(1), []byte{65, 58, 64, 181, 95, 123, 64, 197, 187, 175, 214, 224, 37, 84, 63, 222}, "413a40b5-5f7b-40c5-bbaf-d6e025543fde", "2005-06-20T10:04:26Z", "2005-06-21T10:04:26Z", uint32(2), uint32(1), uint32(2), "100.00", "100.00", "2010-12-02T10:04:26Z", "2010-12-03T10:04:26Z", "2010-12-04T10:04:26Z", "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -210,8 +215,8 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderHeaderByPk(t *testing.T) {
 		purchaseOrderHeaderResult := purchaseOrderHeaderResp.PurchaseOrderHeader
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.SellerSupplierPartyId, uint32(1), "they should be equal")
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.OriginatorCustomerPartyId, uint32(2), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, float64(100), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, float64(100), "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, "100.00", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, "100.00", "they should be equal")
 	}
 }
 
@@ -227,12 +232,12 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderLines(t *testing.T) {
 	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
 	purchaseLines := []*orderproto.PurchaseOrderLine{}
 
-	purchaseLine1, err := GetPurchaseOrderLine(uint32(3), []byte{83, 33, 47, 15, 236, 76, 74, 8, 128, 194, 1, 217, 197, 110, 254, 19}, "53212f0f-ec4c-4a08-80c2-01d9c56efe13", float64(15), float64(225), float64(10), uint32(2), uint32(3), float64(15), float64(1), "2009-12-06T10:04:26Z", "2009-12-07T10:04:26Z", uint32(2), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseLine1, err := GetPurchaseOrderLine(uint32(3), []byte{83, 33, 47, 15, 236, 76, 74, 8, 128, 194, 1, 217, 197, 110, 254, 19}, "53212f0f-ec4c-4a08-80c2-01d9c56efe13", float64(15), "225.00", "10.00", uint32(2), uint32(3), "15.00", float64(1), "2009-12-06T10:04:26Z", "2009-12-07T10:04:26Z", uint32(2), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
 	}
-	purchaseLine2, err := GetPurchaseOrderLine(uint32(2), []byte{71, 103, 92, 161, 252, 5, 69, 81, 136, 203, 228, 82, 5, 98, 102, 148}, "47675ca1-fc05-4551-88cb-e45205626694", float64(120), float64(6000), float64(10), uint32(2), uint32(2), float64(50), float64(1), "2009-12-06T10:04:26Z", "2009-12-07T10:04:26Z", uint32(2), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
+	purchaseLine2, err := GetPurchaseOrderLine(uint32(2), []byte{71, 103, 92, 161, 252, 5, 69, 81, 136, 203, 228, 82, 5, 98, 102, 148}, "47675ca1-fc05-4551-88cb-e45205626694", float64(120), "6000.00", "10.00", uint32(2), uint32(2), "50.00", float64(1), "2009-12-06T10:04:26Z", "2009-12-07T10:04:26Z", uint32(2), "2019-07-23T10:04:26Z", "2019-07-23T10:04:26Z", "auth0|673c75d516e8adb9e6ffc892", "auth0|673c75d516e8adb9e6ffc892")
 	if err != nil {
 		t.Error(err)
 		return
@@ -282,9 +287,9 @@ func TestPurchaseOrderHeaderService_GetPurchaseOrderLines(t *testing.T) {
 		assert.NotNil(t, purchaseOrderLinesResp)
 		purchaseOrderLineResult := purchaseOrderLinesResp.PurchaseOrderLines[0]
 		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.Quantity, float64(120), "they should be equal")
-		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.LineExtensionAmount, float64(6000), "they should be equal")
-		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.TotalTaxAmount, float64(10), "they should be equal")
-		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.PriceAmount, float64(50), "they should be equal")
+		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.LineExtensionAmount, "6000.00", "they should be equal")
+		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.TotalTaxAmount, "10.00", "they should be equal")
+		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.PriceAmount, "50.00", "they should be equal")
 		assert.Equal(t, purchaseOrderLineResult.PurchaseOrderLineD.PriceBaseQuantity, float64(1), "they should be equal")
 	}
 }
@@ -305,8 +310,8 @@ func TestPurchaseOrderHeaderService_CreatePurchaseOrderHeader(t *testing.T) {
 	purchaseOrderHeader.ValidityPeriod = "02/22/2022"
 	purchaseOrderHeader.OrderTypeCode = "ABCFES"
 	purchaseOrderHeader.Note = "Sample"
-	purchaseOrderHeader.LineExtensionAmount = float64(100)
-	purchaseOrderHeader.PayableAmount = float64(100)
+	purchaseOrderHeader.LineExtensionAmount = "100"
+	purchaseOrderHeader.PayableAmount = "100"
 	purchaseOrderHeader.TaxExDate = "08/10/2022"
 	purchaseOrderHeader.PricingExDate = "08/10/2022"
 	purchaseOrderHeader.PaymentExDate = "08/11/2022"
@@ -319,10 +324,10 @@ func TestPurchaseOrderHeaderService_CreatePurchaseOrderHeader(t *testing.T) {
 	purchaseOrderLine.LineStatusCode = "ABCFES"
 	purchaseOrderLine.OriginatorPartyId = uint32(1)
 	purchaseOrderLine.Quantity = float64(100)
-	purchaseOrderLine.LineExtensionAmount = float64(100)
-	purchaseOrderLine.TotalTaxAmount = float64(17.5)
+	purchaseOrderLine.LineExtensionAmount = "100"
+	purchaseOrderLine.TotalTaxAmount = "17.5"
 	purchaseOrderLine.ItemId = uint32(7)
-	purchaseOrderLine.PriceAmount = float64(17.5)
+	purchaseOrderLine.PriceAmount = "17.5"
 	purchaseOrderLine.PriceBaseQuantity = float64(100)
 	purchaseOrderLine.PriceValidityPeriodStartDate = "02/22/2022"
 	purchaseOrderLine.PriceValidityPeriodEndDate = "02/27/2022"
@@ -361,12 +366,86 @@ func TestPurchaseOrderHeaderService_CreatePurchaseOrderHeader(t *testing.T) {
 		assert.NotNil(t, purchaseOrderHeaderResp)
 		purchaseOrderHeaderResult := purchaseOrderHeaderResp.PurchaseOrderHeader
 		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.OrderTypeCode, "ABCFES", "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, float64(100), "they should be equal")
-		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, float64(100), "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineExtensionAmount, "17.50", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.PayableAmount, "35.00", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.LineCountNumeric, uint32(1), "they should be equal")
 	}
 }
 
-func GetPurchaseOrderHeader(id uint32, uuid4 []byte, idS string, issueDate string, validityPeriod string, buyerCustomerPartyId uint32, sellerSupplierPartyId uint32, originatorCustomerPartyId uint32, lineExtensionAmount float64, payableAmount float64, taxExDate string, pricingExDate string, paymentExDate string, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*orderproto.PurchaseOrderHeader, error) {
+func TestSetPurchaseOrderTotals(t *testing.T) {
+	vat := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 21, TaxSchemeId: 1}
+	withholding := &taxproto.TaxCategoryD{Id: 2, TcId: "IRPF", Percent: 15, TaxSchemeId: 2, WithholdingIndicator: true}
+	src := &taxSource{
+		Items: map[uint32]*ubl.ItemSource{
+			1: {Item: &itemproto.ItemD{Id: 1}, TaxCategories: []*taxproto.TaxCategoryD{vat}},
+			2: {Item: &itemproto.ItemD{Id: 2}, TaxCategories: []*taxproto.TaxCategoryD{withholding}},
+		},
+		TaxCategories: map[uint32]*taxproto.TaxCategoryD{1: vat},
+	}
+	newOrder := func() (*orderproto.PurchaseOrderHeader, []*orderproto.PurchaseOrderLine) {
+		purchaseOrderHeader := &orderproto.PurchaseOrderHeader{
+			PurchaseOrderHeaderD: &orderproto.PurchaseOrderHeaderD{DocumentCurrencyCode: "EUR", LineExtensionAmount: "100.00", TaxInclusiveAmount: "100.00", PrepaidAmount: "20.00", PayableAmount: "100.00"},
+			AllowanceCharges: []*commonproto.AllowanceCharge{
+				{AllowanceChargeD: &commonproto.AllowanceChargeD{ChargeIndicator: true, Amount: "5.00", TaxCategoryId: 1}},
+			},
+		}
+		purchaseOrderLines := []*orderproto.PurchaseOrderLine{
+			{
+				PurchaseOrderLineD: &orderproto.PurchaseOrderLineD{ItemId: 1, Quantity: 10, PriceAmount: "12.50", PriceBaseQuantity: 1, LineExtensionAmount: "100.00"},
+				AllowanceCharges: []*commonproto.AllowanceCharge{
+					{AllowanceChargeD: &commonproto.AllowanceChargeD{MultiplierFactorNumeric: 10}},
+				},
+			},
+			{PurchaseOrderLineD: &orderproto.PurchaseOrderLineD{ItemId: 2, Quantity: 3, PriceAmount: "20.00", PriceBaseQuantity: 2, LineExtensionAmount: "30.00"}},
+		}
+		return purchaseOrderHeader, purchaseOrderLines
+	}
+
+	purchaseOrderHeader, purchaseOrderLines := newOrder()
+	err := setPurchaseOrderTotals(purchaseOrderHeader, purchaseOrderLines, src, false)
+	assert.NoError(t, err)
+	hd := purchaseOrderHeader.PurchaseOrderHeaderD
+	assert.Equal(t, "112.50", purchaseOrderLines[0].PurchaseOrderLineD.LineExtensionAmount, "125.00 less the 10% allowance")
+	assert.Equal(t, "12.50", purchaseOrderLines[0].AllowanceCharges[0].AllowanceChargeD.Amount, "they should be equal")
+	assert.Equal(t, "30.00", purchaseOrderLines[1].PurchaseOrderLineD.LineExtensionAmount, "3 times 20.00 per 2")
+	assert.Equal(t, "142.50", hd.LineExtensionAmount, "they should be equal")
+	assert.Equal(t, "0.00", hd.AllowanceTotalAmount, "they should be equal")
+	assert.Equal(t, "5.00", hd.ChargeTotalAmount, "they should be equal")
+	assert.Equal(t, "147.50", hd.TaxExclusiveAmount, "they should be equal")
+	assert.Equal(t, "172.18", hd.TaxInclusiveAmount, "21% of 117.50 is 24.68")
+	assert.Equal(t, "4.50", hd.WithholdingTaxTotalAmount, "15% of 30.00")
+	assert.Equal(t, "147.68", hd.PayableAmount, "less the withheld tax and the prepaid amount")
+	assert.Equal(t, "0.00", hd.PayableRoundingAmount, "they should be equal")
+	assert.Equal(t, uint32(2), hd.LineCountNumeric, "they should be equal")
+
+	purchaseOrderHeader, purchaseOrderLines = newOrder()
+	err = setPurchaseOrderTotals(purchaseOrderHeader, purchaseOrderLines, src, true)
+	var violations en16931.Violations
+	assert.ErrorAs(t, err, &violations)
+	paths := []string{}
+	for _, violation := range violations {
+		paths = append(paths, violation.Path)
+	}
+	assert.Equal(t, []string{"purchase_order_lines[1].line_extension_amount", "line_extension_amount", "charge_total_amount", "tax_exclusive_amount", "tax_inclusive_amount", "withholding_tax_total_amount", "payable_amount"}, paths, "they should be equal")
+	assert.Equal(t, "100.00", purchaseOrderHeader.PurchaseOrderHeaderD.PayableAmount, "a rejected order is left unchanged")
+
+	purchaseOrderHeader, purchaseOrderLines = newOrder()
+	purchaseOrderHeader.PurchaseOrderHeaderD.LineCountNumeric = 3
+	err = setPurchaseOrderTotals(purchaseOrderHeader, purchaseOrderLines, src, false)
+	assert.ErrorAs(t, err, &violations)
+	assert.Equal(t, "line_count_numeric", violations[0].Path, "they should be equal")
+
+	purchaseOrderHeader, purchaseOrderLines = newOrder()
+	purchaseOrderHeader.AllowanceCharges = nil
+	purchaseOrderLines[0].PurchaseOrderLineD.TotalTaxAmount = "10.00"
+	err = setPurchaseOrderTotals(purchaseOrderHeader, purchaseOrderLines, &taxSource{}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "142.50", purchaseOrderHeader.PurchaseOrderHeaderD.TaxExclusiveAmount, "they should be equal")
+	assert.Equal(t, "152.50", purchaseOrderHeader.PurchaseOrderHeaderD.TaxInclusiveAmount, "without tax categories the tax of the lines is taken")
+	assert.Equal(t, "132.50", purchaseOrderHeader.PurchaseOrderHeaderD.PayableAmount, "they should be equal")
+}
+
+func GetPurchaseOrderHeader(id uint32, uuid4 []byte, idS string, issueDate string, validityPeriod string, buyerCustomerPartyId uint32, sellerSupplierPartyId uint32, originatorCustomerPartyId uint32, lineExtensionAmount string, payableAmount string, taxExDate string, pricingExDate string, paymentExDate string, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*orderproto.PurchaseOrderHeader, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -411,6 +490,7 @@ func GetPurchaseOrderHeader(id uint32, uuid4 []byte, idS string, issueDate strin
 	purchaseOrderHeaderD.OriginatorCustomerPartyId = originatorCustomerPartyId
 	purchaseOrderHeaderD.LineExtensionAmount = lineExtensionAmount
 	purchaseOrderHeaderD.PayableAmount = payableAmount
+	purchaseOrderHeaderD.DocumentStatusCode = "draft"
 
 	purchaseOrderHeaderT := orderproto.PurchaseOrderHeaderT{}
 	purchaseOrderHeaderT.IssueDate = issueDate1
@@ -433,7 +513,7 @@ func GetPurchaseOrderHeader(id uint32, uuid4 []byte, idS string, issueDate strin
 	return &purchaseOrderHeader, nil
 }
 
-func GetPurchaseOrderLine(id uint32, uuid4 []byte, idS string, quantity float64, lineExtensionAmount float64, totalTaxAmount float64, originatorPartyId uint32, itemId uint32, priceAmount float64, priceBaseQuantity float64, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, purchaseOrderHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*orderproto.PurchaseOrderLine, error) {
+func GetPurchaseOrderLine(id uint32, uuid4 []byte, idS string, quantity float64, lineExtensionAmount string, totalTaxAmount string, originatorPartyId uint32, itemId uint32, priceAmount string, priceBaseQuantity float64, priceValidityPeriodStartDate string, priceValidityPeriodEndDate string, purchaseOrderHeaderId uint32, createdAt string, updatedAt string, createdByUserId string, updatedByUserId string) (*orderproto.PurchaseOrderLine, error) {
 	createdAt1, err := common.ConvertTimeToTimestamp(Layout, createdAt)
 	if err != nil {
 		log.Error("Error", zap.Error(err))
@@ -543,3 +623,278 @@ func TestPurchaseOrderHeaderService_UpdatePurchaseOrderHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestPurchaseOrderHeaderService_GetPurchaseOrderUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := orderproto.GetPurchaseOrderUBLRequest{}
+	gform := commonproto.GetRequest{}
+	gform.Id = "413a40b5-5f7b-40c5-bbaf-d6e025543fde"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	form.GetRequest = &gform
+
+	type args struct {
+		ctx context.Context
+		in  *orderproto.GetPurchaseOrderUBLRequest
+	}
+	tests := []struct {
+		ps      *PurchaseOrderHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			ps: purchaseOrderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		purchaseOrderUBLResp, err := tt.ps.GetPurchaseOrderUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("PurchaseOrderHeaderService.GetPurchaseOrderUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, purchaseOrderUBLResp)
+		ublStr := string(purchaseOrderUBLResp.Ubl)
+		assert.Contains(t, ublStr, `<Order xmlns="urn:oasis:names:specification:ubl:schema:xsd:Order-2"`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:UUID>413a40b5-5f7b-40c5-bbaf-d6e025543fde</cbc:UUID>`, "they should be equal")
+		assert.Contains(t, ublStr, `<cbc:IssueDate>2005-06-20</cbc:IssueDate>`, "they should be equal")
+	}
+}
+
+func TestPurchaseOrderHeaderService_ImportOrderResponseUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<OrderResponse xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderResponse-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>OR-1</cbc:ID>
+  <cbc:SalesOrderID>SO-1</cbc:SalesOrderID>
+  <cbc:IssueDate>2005-06-21</cbc:IssueDate>
+  <cbc:OrderResponseCode>AP</cbc:OrderResponseCode>
+  <cac:OrderReference><cbc:ID>413a40b5-5f7b-40c5-bbaf-d6e025543fde</cbc:ID></cac:OrderReference>
+  <cac:SellerSupplierParty><cac:Party><cac:PartyName><cbc:Name>Consortial</cbc:Name></cac:PartyName></cac:Party></cac:SellerSupplierParty>
+  <cac:BuyerCustomerParty><cac:Party><cac:PartyName><cbc:Name>IYT Corporation</cbc:Name></cac:PartyName></cac:Party></cac:BuyerCustomerParty>
+</OrderResponse>`
+
+	form := orderproto.ImportOrderResponseUBLRequest{}
+	form.Ubl = []byte(ublDoc)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *orderproto.ImportOrderResponseUBLRequest
+	}
+	tests := []struct {
+		ps      *PurchaseOrderHeaderService
+		args    args
+		wantErr bool
+	}{
+		{
+			ps: purchaseOrderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		orderResponseResp, err := tt.ps.ImportOrderResponseUBL(tt.args.ctx, tt.args.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("PurchaseOrderHeaderService.ImportOrderResponseUBL() error = %v, wantErr %v", err, tt.wantErr)
+			return
+		}
+		assert.NotNil(t, orderResponseResp)
+		purchaseOrderHeaderResult := orderResponseResp.PurchaseOrderHeader
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.OrderResponseCode, "AP", "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.SalesOrderId, "SO-1", "they should be equal")
+		for _, purchaseOrderLine := range orderResponseResp.PurchaseOrderLines {
+			assert.Equal(t, purchaseOrderLine.PurchaseOrderLineD.LineStatusCode, "Accepted", "they should be equal")
+		}
+	}
+}
+
+func TestPurchaseOrderHeaderService_ImportOrderChangeUBL(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
+
+	ublDoc := `<OrderChange xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderChange-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>OC-1</cbc:ID>
+  <cbc:IssueDate>2005-06-22</cbc:IssueDate>
+  <cbc:SequenceNumberID>%s</cbc:SequenceNumberID>
+  <cbc:Note>Second line cancelled</cbc:Note>
+  <cac:OrderReference><cbc:ID>413a40b5-5f7b-40c5-bbaf-d6e025543fde</cbc:ID></cac:OrderReference>
+  <cac:BuyerCustomerParty><cac:Party><cac:PartyName><cbc:Name>IYT Corporation</cbc:Name></cac:PartyName></cac:Party></cac:BuyerCustomerParty>
+  <cac:SellerSupplierParty><cac:Party><cac:PartyName><cbc:Name>Consortial</cbc:Name></cac:PartyName></cac:Party></cac:SellerSupplierParty>
+  <cac:OrderLine><cac:LineItem><cbc:ID>188c398a-ee29-4df1-9e74-96ff1df1cc4d</cbc:ID><cbc:LineStatusCode>2</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+</OrderChange>`
+
+	form := orderproto.ImportOrderChangeUBLRequest{}
+	form.Ubl = []byte(fmt.Sprintf(ublDoc, "1"))
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	form1 := orderproto.ImportOrderChangeUBLRequest{}
+	form1.Ubl = []byte(fmt.Sprintf(ublDoc, "0"))
+	form1.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form1.UserEmail = "sprov300@gmail.com"
+	form1.RequestId = "bks1m1g91jau4nkks2f0"
+
+	type args struct {
+		ctx context.Context
+		in  *orderproto.ImportOrderChangeUBLRequest
+	}
+	tests := []struct {
+		ps      *PurchaseOrderHeaderService
+		args    args
+		wantErr string
+	}{
+		{
+			ps: purchaseOrderService,
+			args: args{
+				ctx: ctx,
+				in:  &form,
+			},
+			wantErr: "",
+		},
+		{
+			ps: purchaseOrderService,
+			args: args{
+				ctx: ctx,
+				in:  &form1,
+			},
+			wantErr: "/OrderChange/cbc:SequenceNumberID",
+		},
+	}
+	for _, tt := range tests {
+		orderChangeResp, err := tt.ps.ImportOrderChangeUBL(tt.args.ctx, tt.args.in)
+		if tt.wantErr != "" {
+			if err == nil {
+				t.Errorf("PurchaseOrderHeaderService.ImportOrderChangeUBL() error = nil, want %v", tt.wantErr)
+				return
+			}
+			assert.Contains(t, err.Error(), tt.wantErr, "they should be equal")
+			continue
+		}
+		if err != nil {
+			t.Errorf("PurchaseOrderHeaderService.ImportOrderChangeUBL() error = %v", err)
+			return
+		}
+		purchaseOrderHeaderResult := orderChangeResp.PurchaseOrderHeader
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.SequenceNumberId, uint32(1), "they should be equal")
+		assert.Equal(t, purchaseOrderHeaderResult.PurchaseOrderHeaderD.Note, "Second line cancelled", "they should be equal")
+		for _, purchaseOrderLine := range orderChangeResp.PurchaseOrderLines {
+			if purchaseOrderLine.PurchaseOrderLineD.IdS == "188c398a-ee29-4df1-9e74-96ff1df1cc4d" {
+				assert.Equal(t, purchaseOrderLine.PurchaseOrderLineD.LineStatusCode, "Cancelled", "they should be equal")
+			}
+		}
+
+		getRequest := commonproto.GetRequest{Id: purchaseOrderHeaderResult.PurchaseOrderHeaderD.IdS, UserEmail: tt.args.in.UserEmail, RequestId: tt.args.in.RequestId}
+		versionsResponse, err := tt.ps.GetPurchaseOrderVersions(tt.args.ctx, &commonproto.GetDocumentVersionsRequest{GetRequest: &getRequest})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		assert.Len(t, versionsResponse.DocumentVersions, 2, "the order before and after the change")
+		assert.Equal(t, "OrderChange 1", versionsResponse.DocumentVersions[1].DocumentVersionD.Reason, "they should be equal")
+	}
+}
+
+func TestCheckPurchaseOrderIssue(t *testing.T) {
+	hd := orderproto.PurchaseOrderHeaderD{PohId: "PO-1", DocumentStatusCode: "draft"}
+	assert.NoError(t, checkPurchaseOrderIssue(&hd, 2))
+	assert.Error(t, checkPurchaseOrderIssue(&hd, 0), "a purchase order without lines is not issued")
+
+	unnumbered := orderproto.PurchaseOrderHeaderD{DocumentStatusCode: "draft"}
+	assert.NoError(t, checkPurchaseOrderIssue(&unnumbered, 2), "a purchase order is numbered when it is issued")
+	assert.Error(t, checkPurchaseOrderNumber(""), "a purchase order without an id is not issued")
+	assert.NoError(t, checkPurchaseOrderNumber("PO-1"))
+
+	issued := orderproto.PurchaseOrderHeaderD{PohId: "PO-1", DocumentStatusCode: "issued"}
+	assert.Error(t, checkPurchaseOrderIssue(&issued, 2), "an issued purchase order is not issued again")
+}
+
+func TestPurchaseOrderHeaderService_UpdatePurchaseOrderLine(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	purchaseOrderService := NewPurchaseOrderHeaderService(log, dbService, redisService, userServiceClient)
+
+	form := orderproto.UpdatePurchaseOrderLineRequest{}
+	form.Note = "sample updated"
+	form.Quantity = float64(10)
+	form.PriceAmount = "15.00"
+	form.PriceBaseQuantity = float64(1)
+	form.Id = "53212f0f-ec4c-4a08-80c2-01d9c56efe13"
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = purchaseOrderService.UpdatePurchaseOrderLine(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getRequest := commonproto.GetRequest{Id: "8bf4b3d4-95ff-4893-ac0c-838c5a1b1959", UserEmail: form.UserEmail, RequestId: form.RequestId}
+	purchaseOrderHeaderResponse, err := purchaseOrderService.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.LineCountNumeric, uint32(2), "they should be equal")
+	assert.Equal(t, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.LineExtensionAmount, "6150.00", "they should be equal")
+
+	_, err = purchaseOrderService.DeletePurchaseOrderLine(ctx, &orderproto.DeletePurchaseOrderLineRequest{GetRequest: &commonproto.GetRequest{Id: form.Id, UserEmail: form.UserEmail, RequestId: form.RequestId}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	purchaseOrderHeaderResponse, err = purchaseOrderService.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.LineCountNumeric, uint32(1), "they should be equal")
+	assert.Equal(t, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.LineExtensionAmount, "6000.00", "they should be equal")
+
+	_, err = dbService.DB.ExecContext(ctx, `update purchase_order_headers set document_status_code = ? where uuid4 = UNHEX(REPLACE(?,'-',''));`, "issued", getRequest.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	form.Id = "47675ca1-fc05-4551-88cb-e45205626694"
+	_, err = purchaseOrderService.UpdatePurchaseOrderLine(ctx, &form)
+	assert.Error(t, err, "the lines of an issued purchase order are not changed")
+}
@@ -0,0 +1,151 @@
+package orderservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docstatus"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// issuePurchaseOrderSQL - the update of the status of a purchase order
+// that is still in the status it was read with
+const issuePurchaseOrderSQL = `update purchase_order_headers set document_status_code = ?, updated_by_user_id = ?, updated_at = ? where id = ? and document_status_code = ?;`
+
+// updatePurchaseOrderNumberSQL - the update of the number of a purchase
+// order that is numbered when it is issued
+const updatePurchaseOrderNumberSQL = `update purchase_order_headers set poh_id = ? where id = ?;`
+
+// insertPurchaseOrderStatusTransitionSQL - insert the transition of the
+// status of a purchase order
+const insertPurchaseOrderStatusTransitionSQL = `insert into document_status_transitions
+	  (uuid4,
+master_flag,
+master_id,
+from_status_code,
+to_status_code,
+reason,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+// IssuePurchaseOrder - move a draft purchase order with lines to issued,
+// from then on it is revised with RevisePurchaseOrder and no longer
+// updated. Without a number of its own it is numbered from the sequence
+// of its buyer. The transition is kept with those of invoices and notes.
+func (ps *PurchaseOrderHeaderService) IssuePurchaseOrder(ctx context.Context, in *orderproto.IssuePurchaseOrderRequest) (*orderproto.IssuePurchaseOrderResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	purchaseOrderLinesResponse, err := ps.GetPurchaseOrderLines(ctx, &orderproto.GetPurchaseOrderLinesRequest{GetRequest: &getRequest})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	hd := purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD
+	issueDate := common.TimestampToTime(purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderT.IssueDate)
+	err = checkPurchaseOrderIssue(hd, len(purchaseOrderLinesResponse.PurchaseOrderLines))
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ps.UserServiceClient)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	uuid4, err := common.GetUUIDBytes()
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	tn := common.GetTimeDetails()
+
+	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, issuePurchaseOrderSQL, string(docstatus.Issued), user.Id, tn, hd.Id, hd.DocumentStatusCode)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		if rows != 1 {
+			err = fmt.Errorf("docstatus: %s is no longer %s", hd.PohId, hd.DocumentStatusCode)
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		pohID, err := numbering.Assign(ctx, tx, numbering.PurchaseOrder, hd.BuyerCustomerPartyId, hd.PohId, issueDate)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		if err = checkPurchaseOrderNumber(pohID); err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		if pohID != hd.PohId {
+			_, err = tx.ExecContext(ctx, updatePurchaseOrderNumberSQL, pohID, hd.Id)
+			if err != nil {
+				err = numbering.UsedError(numbering.PurchaseOrder, err, pohID)
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			hd.PohId = pohID
+		}
+		_, err = tx.ExecContext(ctx, insertPurchaseOrderStatusTransitionSQL, uuid4, ubl.MasterFlagPurchaseOrderHeader, hd.Id, hd.DocumentStatusCode, string(docstatus.Issued), "", "active", user.Id, user.Id, tn, tn)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &orderproto.IssuePurchaseOrderResponse{}, nil
+}
+
+// checkPurchaseOrderIssue - an error when a purchase order cannot be
+// issued: it is not a draft, or it has no lines
+func checkPurchaseOrderIssue(hd *orderproto.PurchaseOrderHeaderD, lineCount int) error {
+	from, err := docstatus.Parse(hd.DocumentStatusCode)
+	if err != nil {
+		return err
+	}
+	if err = docstatus.Transition(from, docstatus.Issued); err != nil {
+		return fmt.Errorf("%w: %s", err, hd.PohId)
+	}
+	if lineCount == 0 {
+		return fmt.Errorf("docstatus: %s has no lines and cannot be issued", hd.PohId)
+	}
+	return nil
+}
+
+// checkPurchaseOrderNumber - an error when a purchase order to be issued
+// has no number, neither of its own nor from the sequence of its buyer
+func checkPurchaseOrderNumber(pohID string) error {
+	if strings.TrimSpace(pohID) == "" {
+		return fmt.Errorf("docstatus: a purchase order without an id cannot be issued")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package orderservices
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudfresco/sc-ubl/internal/allowancecharge"
+	"github.com/cloudfresco/sc-ubl/internal/en16931"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"go.uber.org/zap"
+)
+
+// purchaseOrderTotals - the calculated line extension amounts and
+// monetary totals (UBL AnticipatedMonetaryTotal) of a purchase order
+type purchaseOrderTotals struct {
+	LineExtensionAmounts []money.Amount
+
+	LineExtensionAmount   money.Amount
+	AllowanceTotalAmount  money.Amount
+	ChargeTotalAmount     money.Amount
+	TaxExclusiveAmount    money.Amount
+	TaxInclusiveAmount    money.Amount
+	WithholdingTaxAmount  money.Amount
+	PayableRoundingAmount money.Amount
+	PayableAmount         money.Amount
+}
+
+// calculatePurchaseOrderTotals - set the amounts of the allowances and
+// charges, the line extension amounts, the monetary totals and the line
+// count of a purchase order to the calculated ones, see
+// setPurchaseOrderTotals
+func (ps *PurchaseOrderHeaderService) calculatePurchaseOrderTotals(ctx context.Context, purchaseOrderHeader *orderproto.PurchaseOrderHeader, purchaseOrderLines []*orderproto.PurchaseOrderLine, reject bool, userEmail string, requestID string) error {
+	src, err := ps.getTaxSource(ctx, purchaseOrderHeader, purchaseOrderLines, userEmail, requestID)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	err = setPurchaseOrderTotals(purchaseOrderHeader, purchaseOrderLines, src, reject)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// setPurchaseOrderTotals - calculate the line extension amount of each
+// line as quantity times price divided by the price base quantity (1 when
+// not given), less its allowances and plus its charges, and the totals of
+// the order from the lines, its allowances and charges and the tax of
+// their tax categories, as invoices do. An order without allowances and
+// charges takes AllowanceTotalAmount and ChargeTotalAmount it was sent
+// with as its only allowance and charge; one whose items and allowances
+// and charges have no tax category takes the sum of the TotalTaxAmount of
+// its lines as its tax. Withheld tax is not part of TaxInclusiveAmount, it
+// is taken off the amount due with the prepaid amount. LineCountNumeric is
+// set to the number of lines when it is not given.
+//
+// en16931.Violations are returned when LineCountNumeric is not the number
+// of lines and, with reject, when an amount the client sent differs from
+// the calculated one; the totals are then left unchanged.
+func setPurchaseOrderTotals(purchaseOrderHeader *orderproto.PurchaseOrderHeader, purchaseOrderLines []*orderproto.PurchaseOrderLine, src *taxSource, reject bool) error {
+	hd := purchaseOrderHeader.PurchaseOrderHeaderD
+	policy := rounding.ForCurrency(hd.DocumentCurrencyCode)
+	totals := purchaseOrderTotals{}
+
+	taxLines := []taxLine{}
+	sentTaxAmount := money.Zero
+	for _, purchaseOrderLine := range purchaseOrderLines {
+		ld := purchaseOrderLine.PurchaseOrderLineD
+		gross := allowancecharge.LineBase(ld.Quantity, parseAmount(ld.PriceAmount), ld.PriceBaseQuantity, policy)
+		allowanceTotal, chargeTotal := allowancecharge.CalculateProtos(purchaseOrderLine.AllowanceCharges, gross, policy)
+		lineExtensionAmount := gross.Sub(allowanceTotal).Add(chargeTotal)
+		totals.LineExtensionAmounts = append(totals.LineExtensionAmounts, lineExtensionAmount)
+		totals.LineExtensionAmount = totals.LineExtensionAmount.Add(lineExtensionAmount)
+		sentTaxAmount = sentTaxAmount.Add(parseAmount(ld.TotalTaxAmount))
+
+		tl := taxLine{Quantity: ld.Quantity, Amount: lineExtensionAmount}
+		if item := src.Items[ld.ItemId]; item != nil {
+			tl.TaxCategories = item.TaxCategories
+		}
+		taxLines = append(taxLines, tl)
+	}
+
+	if len(purchaseOrderHeader.AllowanceCharges) > 0 {
+		totals.AllowanceTotalAmount, totals.ChargeTotalAmount = allowancecharge.CalculateProtos(purchaseOrderHeader.AllowanceCharges, totals.LineExtensionAmount, policy)
+	} else {
+		totals.AllowanceTotalAmount = policy.Round(parseAmount(hd.AllowanceTotalAmount))
+		totals.ChargeTotalAmount = policy.Round(parseAmount(hd.ChargeTotalAmount))
+	}
+	for _, allowanceCharge := range purchaseOrderHeader.AllowanceCharges {
+		if tc := src.TaxCategories[allowanceCharge.AllowanceChargeD.TaxCategoryId]; tc != nil {
+			ac := allowancecharge.FromProto(allowanceCharge.AllowanceChargeD)
+			taxLines = append(taxLines, taxLine{Amount: ac.Signed(), TaxCategories: []*taxproto.TaxCategoryD{tc}})
+		}
+	}
+
+	taxAmount, ok, err := purchaseOrderTax(taxLines, src.TaxSchemes, false, policy)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		taxAmount = sentTaxAmount
+	}
+	totals.WithholdingTaxAmount, _, err = purchaseOrderTax(taxLines, src.TaxSchemes, true, policy)
+	if err != nil {
+		return err
+	}
+
+	totals.TaxExclusiveAmount = totals.LineExtensionAmount.Sub(totals.AllowanceTotalAmount).Add(totals.ChargeTotalAmount)
+	totals.TaxInclusiveAmount = totals.TaxExclusiveAmount.Add(policy.Round(taxAmount))
+	totals.WithholdingTaxAmount = policy.Round(totals.WithholdingTaxAmount)
+	payable := totals.TaxInclusiveAmount.Sub(totals.WithholdingTaxAmount).Sub(parseAmount(hd.PrepaidAmount))
+	totals.PayableAmount = policy.RoundPayable(payable)
+	totals.PayableRoundingAmount = totals.PayableAmount.Sub(payable)
+
+	violations := en16931.Violations{}
+	if hd.LineCountNumeric == 0 {
+		hd.LineCountNumeric = uint32(len(purchaseOrderLines))
+	} else if hd.LineCountNumeric != uint32(len(purchaseOrderLines)) {
+		violations = append(violations, &en16931.Violation{Rule: "line-count", Path: "line_count_numeric", Message: fmt.Sprintf("line count %d does not equal the %d lines of the order", hd.LineCountNumeric, len(purchaseOrderLines))})
+	}
+	if reject {
+		violations = append(violations, purchaseOrderTotalsMismatches(hd, purchaseOrderLines, &totals, policy)...)
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	for i, purchaseOrderLine := range purchaseOrderLines {
+		purchaseOrderLine.PurchaseOrderLineD.LineExtensionAmount = totals.LineExtensionAmounts[i].String()
+	}
+	hd.LineExtensionAmount = totals.LineExtensionAmount.String()
+	hd.AllowanceTotalAmount = totals.AllowanceTotalAmount.String()
+	hd.ChargeTotalAmount = totals.ChargeTotalAmount.String()
+	hd.TaxExclusiveAmount = totals.TaxExclusiveAmount.String()
+	hd.TaxInclusiveAmount = totals.TaxInclusiveAmount.String()
+	hd.WithholdingTaxTotalAmount = totals.WithholdingTaxAmount.String()
+	hd.PayableRoundingAmount = totals.PayableRoundingAmount.String()
+	hd.PayableAmount = totals.PayableAmount.String()
+	return nil
+}
+
+// purchaseOrderTotalsMismatches - a violation for each amount of a
+// purchase order that differs from the calculated one
+func purchaseOrderTotalsMismatches(hd *orderproto.PurchaseOrderHeaderD, purchaseOrderLines []*orderproto.PurchaseOrderLine, totals *purchaseOrderTotals, policy rounding.Policy) en16931.Violations {
+	violations := en16931.Violations{}
+	mismatch := func(path string, name string, sent string, calculated money.Amount) {
+		if a := parseAmount(sent); policy.Round(a) != calculated {
+			violations = append(violations, &en16931.Violation{Rule: "totals", Path: path, Message: fmt.Sprintf("%s %s does not equal the calculated %s", name, a.Text(2), calculated.Text(2))})
+		}
+	}
+	for i, purchaseOrderLine := range purchaseOrderLines {
+		mismatch(fmt.Sprintf("purchase_order_lines[%d].line_extension_amount", i+1), "order line net amount", purchaseOrderLine.PurchaseOrderLineD.LineExtensionAmount, totals.LineExtensionAmounts[i])
+	}
+	mismatch("line_extension_amount", "sum of order line net amount", hd.LineExtensionAmount, totals.LineExtensionAmount)
+	mismatch("allowance_total_amount", "sum of allowances on document level", hd.AllowanceTotalAmount, totals.AllowanceTotalAmount)
+	mismatch("charge_total_amount", "sum of charges on document level", hd.ChargeTotalAmount, totals.ChargeTotalAmount)
+	mismatch("tax_exclusive_amount", "order total amount without tax", hd.TaxExclusiveAmount, totals.TaxExclusiveAmount)
+	mismatch("tax_inclusive_amount", "order total amount with tax", hd.TaxInclusiveAmount, totals.TaxInclusiveAmount)
+	mismatch("withholding_tax_total_amount", "withheld tax", hd.WithholdingTaxTotalAmount, totals.WithholdingTaxAmount)
+	mismatch("payable_rounding_amount", "rounding amount", hd.PayableRoundingAmount, totals.PayableRoundingAmount)
+	mismatch("payable_amount", "amount due for payment", hd.PayableAmount, totals.PayableAmount)
+	return violations
+}
+
+// parseAmount - the Amount of a normalized amount
+func parseAmount(s string) money.Amount {
+	a, _ := money.Parse(s)
+	return a
+}
@@ -0,0 +1,527 @@
+package orderservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	paymentservice "github.com/cloudfresco/sc-ubl/internal/services/paymentservices"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// updateOrderResponseHeaderSQL - record the OrderResponseCode of a PurchaseOrderHeader
+const updateOrderResponseHeaderSQL = `update purchase_order_headers set
+  order_response_code = ?,
+  sales_order_id = if(? = '', sales_order_id, ?),
+  updated_at = ? where id = ?;`
+
+// updateOrderResponseLinesSQL - status of the lines an OrderResponse does not list
+const updateOrderResponseLinesSQL = `update purchase_order_lines set
+  line_status_code = ?,
+  updated_at = ? where purchase_order_header_id = ? and line_status_code != ? and status_code = ?;`
+
+// updateOrderResponseLineSQL - status of a line an OrderResponse lists
+const updateOrderResponseLineSQL = `update purchase_order_lines set
+  line_status_code = ?,
+  sales_order_id = if(? = '', sales_order_id, ?),
+  updated_at = ? where id = ? and purchase_order_header_id = ?;`
+
+// updateOrderChangeHeaderSQL - apply an OrderChange to a PurchaseOrderHeader
+const updateOrderChangeHeaderSQL = `update purchase_order_headers set
+  sales_order_id = if(? = '', sales_order_id, ?),
+  note = ?,
+  requested_invoice_currency_code = ?,
+  document_currency_code = ?,
+  pricing_currency_code = ?,
+  tax_currency_code = ?,
+  accounting_cost_code = ?,
+  accounting_cost = ?,
+  line_count_numeric = ?,
+  line_extension_amount = ?,
+  tax_exclusive_amount = ?,
+  tax_inclusive_amount = ?,
+  allowance_total_amount = ?,
+  charge_total_amount = ?,
+  withholding_tax_total_amount = ?,
+  prepaid_amount = ?,
+  payable_rounding_amount = ?,
+  payable_amount = ?,
+  payable_alternative_amount = ?,
+  payable_alternative_currency_code = ?,
+  sequence_number_id = ?,
+  order_response_code = '',
+  updated_at = ? where id = ?;`
+
+// updateOrderChangeLineSQL - apply a revised line of an OrderChange
+const updateOrderChangeLineSQL = `update purchase_order_lines set
+  substitution_status_code = ?,
+  note = ?,
+  sales_order_id = ?,
+  line_status_code = ?,
+  quantity = ?,
+  unit_code = ?,
+  line_extension_amount = ?,
+  total_tax_amount = ?,
+  minimum_quantity = ?,
+  maximum_quantity = ?,
+  minimum_backorder_quantity = ?,
+  maximum_backorder_quantity = ?,
+  inspection_method_code = ?,
+  partial_delivery_indicator = ?,
+  back_order_allowed_indicator = ?,
+  accounting_cost_code = ?,
+  accounting_cost = ?,
+  warranty_information = ?,
+  originator_party_id = ?,
+  item_id = ?,
+  price_amount = ?,
+  currency_code = ?,
+  price_base_quantity = ?,
+  price_change_reason = ?,
+  price_type_code = ?,
+  price_type = ?,
+  orderable_unit_factor_rate = ?,
+  price_validity_period_start_date = ?,
+  price_validity_period_end_date = ?,
+  updated_at = ? where id = ? and purchase_order_header_id = ?;`
+
+// updateOrderLineStatusSQL - set the line_status_code of a PurchaseOrderLine
+const updateOrderLineStatusSQL = `update purchase_order_lines set
+  line_status_code = ?,
+  updated_at = ? where id = ? and purchase_order_header_id = ?;`
+
+// GetPurchaseOrderUBL - Get PurchaseOrder as UBL 2.3 Order XML
+func (ps *PurchaseOrderHeaderService) GetPurchaseOrderUBL(ctx context.Context, inReq *orderproto.GetPurchaseOrderUBLRequest) (*orderproto.GetPurchaseOrderUBLResponse, error) {
+	in := inReq.GetRequest
+	src, err := ps.getOrderSource(ctx, inReq)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	order, err := ubl.OrderFromSource(src)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ublBytes, err := ubl.Marshal(order)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = ublvalidate.Validate(ublBytes)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	purchaseOrderUBLResponse := orderproto.GetPurchaseOrderUBLResponse{}
+	purchaseOrderUBLResponse.Ubl = ublBytes
+	return &purchaseOrderUBLResponse, nil
+}
+
+// getOrderSource - Get PurchaseOrder with the parties, items, taxes,
+// payment terms and references needed to build a UBL document
+func (ps *PurchaseOrderHeaderService) getOrderSource(ctx context.Context, inReq *orderproto.GetPurchaseOrderUBLRequest) (*ubl.OrderSource, error) {
+	in := inReq.GetRequest
+	form := orderproto.GetPurchaseOrderHeaderRequest{}
+	form.GetRequest = in
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeader(ctx, &form)
+	if err != nil {
+		return nil, err
+	}
+	purchaseOrderHeader := purchaseOrderHeaderResponse.PurchaseOrderHeader
+	hd := purchaseOrderHeader.PurchaseOrderHeaderD
+
+	linesForm := orderproto.GetPurchaseOrderLinesRequest{}
+	linesForm.GetRequest = in
+	purchaseOrderLinesResponse, err := ps.GetPurchaseOrderLines(ctx, &linesForm)
+	if err != nil {
+		return nil, err
+	}
+	purchaseOrderLines := purchaseOrderLinesResponse.PurchaseOrderLines
+
+	keys := ubl.DocumentKeys{
+		HeaderMasterFlag: ubl.MasterFlagPurchaseOrderHeader,
+		HeaderID:         hd.Id,
+		LineMasterFlag:   ubl.MasterFlagPurchaseOrderLine,
+		PartyIDs:         []uint32{hd.BuyerCustomerPartyId, hd.SellerSupplierPartyId, hd.OriginatorCustomerPartyId, hd.FreightForwarderPartyId, hd.AccountingCustomerPartyId},
+		References: map[string][]uint32{
+			ubl.ReferenceOrder: {hd.OrderId},
+		},
+	}
+	for _, purchaseOrderLine := range purchaseOrderLines {
+		ld := purchaseOrderLine.PurchaseOrderLineD
+		keys.LineIDs = append(keys.LineIDs, ld.Id)
+		keys.PartyIDs = append(keys.PartyIDs, ld.OriginatorPartyId)
+		keys.ItemIDs = append(keys.ItemIDs, ld.ItemId)
+	}
+	documentSource, err := ubl.GetDocumentSource(ctx, ps.DBService, in.GetUserEmail(), in.GetRequestId(), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ubl.OrderSource{PurchaseOrderHeader: purchaseOrderHeader, PurchaseOrderLines: purchaseOrderLines, DocumentSource: *documentSource}
+	return &src, nil
+}
+
+// ImportPurchaseOrderUBL - Create PurchaseOrder from a UBL 2.3 Order XML
+// document. Unmappable elements are reported as ubl.FieldErrors located by
+// XPath.
+func (ps *PurchaseOrderHeaderService) ImportPurchaseOrderUBL(ctx context.Context, in *orderproto.ImportPurchaseOrderUBLRequest) (*orderproto.ImportPurchaseOrderUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	order := ubl.Order{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceOrder, &order)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// the parties it creates, the purchase order and its payment terms are
+	// written in one transaction
+	var purchaseOrderHeader *orderproto.PurchaseOrderHeader
+	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		ctx := common.ContextWithTx(ctx, tx)
+		resolver := partyservice.NewUBLResolver(ps.log, ps.DBService, ps.RedisService, ps.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, order.IssueDate)
+		orderImport, err := ubl.ImportOrder(ctx, &order, resolver)
+		if err != nil {
+			return err
+		}
+
+		form := orderImport.Order
+		form.UserId = in.UserId
+		form.UserEmail = in.UserEmail
+		form.RequestId = in.RequestId
+		purchaseOrderHeaderResponse, err := ps.CreatePurchaseOrderHeader(ctx, form)
+		if err != nil {
+			return err
+		}
+		purchaseOrderHeader = purchaseOrderHeaderResponse.PurchaseOrderHeader
+
+		paymentService := paymentservice.NewPaymentService(ps.log, ps.DBService, ps.RedisService, ps.UserServiceClient)
+		return paymentService.CreateUBLPaymentTerms(ctx, orderImport.PaymentTerms, ubl.MasterFlagPurchaseOrderHeader, purchaseOrderHeader.PurchaseOrderHeaderD.Id, in.UserId, in.UserEmail, in.RequestId)
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importPurchaseOrderUBLResponse := orderproto.ImportPurchaseOrderUBLResponse{}
+	importPurchaseOrderUBLResponse.PurchaseOrderHeader = purchaseOrderHeader
+	return &importPurchaseOrderUBLResponse, nil
+}
+
+// ImportOrderResponseUBL - Record a UBL 2.3 OrderResponse of the supplier on
+// the PurchaseOrder it answers. Accepted and rejected orders set the status
+// of every line, a conditional acceptance accepts the lines it does not list.
+func (ps *PurchaseOrderHeaderService) ImportOrderResponseUBL(ctx context.Context, in *orderproto.ImportOrderResponseUBLRequest) (*orderproto.ImportOrderResponseUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	orderResponse := ubl.OrderResponse{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceOrderResponse, &orderResponse)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	resolver := partyservice.NewUBLResolver(ps.log, ps.DBService, ps.RedisService, ps.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, orderResponse.IssueDate)
+	orderResponseImport, err := ubl.ImportOrderResponse(ctx, &orderResponse, resolver)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	tn := common.GetTimeDetails()
+	orderID := orderResponseImport.PurchaseOrderHeaderID
+	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, updateOrderResponseHeaderSQL, orderResponseImport.OrderResponseCode, orderResponseImport.SalesOrderID, orderResponseImport.SalesOrderID, tn, orderID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		if lineStatus := ubl.OrderResponseLineStatus(orderResponseImport.OrderResponseCode); lineStatus != "" {
+			_, err = tx.ExecContext(ctx, updateOrderResponseLinesSQL, lineStatus, tn, orderID, ubl.LineStatusCancelled, "active")
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		for _, line := range orderResponseImport.Lines {
+			_, err = tx.ExecContext(ctx, updateOrderResponseLineSQL, line.LineStatusCode, line.SalesOrderID, line.SalesOrderID, tn, line.PurchaseOrderLineID, orderID)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	purchaseOrderHeader, purchaseOrderLines, err := ps.getPurchaseOrderWithLines(ctx, orderID, in.UserEmail, in.RequestId)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importOrderResponseUBLResponse := orderproto.ImportOrderResponseUBLResponse{}
+	importOrderResponseUBLResponse.PurchaseOrderHeader = purchaseOrderHeader
+	importOrderResponseUBLResponse.PurchaseOrderLines = purchaseOrderLines
+	return &importOrderResponseUBLResponse, nil
+}
+
+// ImportOrderChangeUBL - Apply a UBL 2.3 OrderChange to the PurchaseOrder it
+// changes. Its SequenceNumberID must follow the last applied change; the
+// order then awaits a new OrderResponse. The changed order is kept as its
+// next version.
+func (ps *PurchaseOrderHeaderService) ImportOrderChangeUBL(ctx context.Context, in *orderproto.ImportOrderChangeUBLRequest) (*orderproto.ImportOrderChangeUBLResponse, error) {
+	err := ublvalidate.Validate(in.Ubl)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, ublvalidate.GRPCError(err)
+	}
+
+	orderChange := ubl.OrderChange{}
+	err = ubl.Unmarshal(in.Ubl, ubl.NamespaceOrderChange, &orderChange)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	resolver := partyservice.NewUBLResolver(ps.log, ps.DBService, ps.RedisService, ps.UserServiceClient, in.UserId, in.UserEmail, in.RequestId, orderChange.IssueDate)
+	orderChangeImport, err := ubl.ImportOrderChange(ctx, &orderChange, resolver)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	orderID := orderChangeImport.PurchaseOrderHeaderID
+
+	form := orderproto.GetPurchaseOrderHeaderByPkRequest{}
+	form.GetByIdRequest = &commonproto.GetByIdRequest{Id: orderID, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeaderByPk(ctx, &form)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	sequenceNumberID := purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.SequenceNumberId
+	if orderChangeImport.SequenceNumberID <= sequenceNumberID {
+		err = ubl.FieldErrors{{Path: "/OrderChange/cbc:SequenceNumberID", Message: fmt.Sprintf("change %d was already applied, the last change is %d", orderChangeImport.SequenceNumberID, sequenceNumberID)}}
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// the order as it was before the change is kept as a version of it
+	purchaseOrderHeader, purchaseOrderLines, err := ps.getPurchaseOrderWithLines(ctx, orderID, in.UserEmail, in.RequestId)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	before, err := purchaseOrderSnapshot(purchaseOrderHeader, purchaseOrderLines)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	revision, err := docversion.NewRevision(ctx, ps.UserServiceClient, fmt.Sprintf("OrderChange %d", orderChangeImport.SequenceNumberID), in.UserId, in.UserEmail, in.RequestId)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	// added lines are built before the transaction as this looks up the user
+	addedLines := []*orderproto.PurchaseOrderLine{}
+	for _, lineChange := range orderChangeImport.Lines {
+		if lineChange.LineStatusCode != ubl.LineStatusAdded {
+			continue
+		}
+		line := lineChange.PurchaseOrderLine
+		line.PurchaseOrderHeaderId = orderID
+		line.UserId = in.UserId
+		line.UserEmail = in.UserEmail
+		line.RequestId = in.RequestId
+		purchaseOrderLine, err := ps.ProcessPurchaseOrderLineRequest(ctx, line)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		purchaseOrderLine.PurchaseOrderLineD.CurrencyCode = orderChangeImport.PurchaseOrderHeader.DocumentCurrencyCode
+		addedLines = append(addedLines, purchaseOrderLine)
+	}
+
+	tn := common.GetTimeDetails()
+	hd := orderChangeImport.PurchaseOrderHeader
+	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, updateOrderChangeHeaderSQL,
+			hd.SalesOrderId,
+			hd.SalesOrderId,
+			hd.Note,
+			hd.RequestedInvoiceCurrencyCode,
+			hd.DocumentCurrencyCode,
+			hd.PricingCurrencyCode,
+			hd.TaxCurrencyCode,
+			hd.AccountingCostCode,
+			hd.AccountingCost,
+			hd.LineCountNumeric,
+			hd.LineExtensionAmount,
+			hd.TaxExclusiveAmount,
+			hd.TaxInclusiveAmount,
+			hd.AllowanceTotalAmount,
+			hd.ChargeTotalAmount,
+			hd.WithholdingTaxTotalAmount,
+			hd.PrepaidAmount,
+			hd.PayableRoundingAmount,
+			hd.PayableAmount,
+			hd.PayableAlternativeAmount,
+			hd.PayableAlternativeCurrencyCode,
+			orderChangeImport.SequenceNumberID,
+			tn,
+			orderID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		for _, lineChange := range orderChangeImport.Lines {
+			switch lineChange.LineStatusCode {
+			case ubl.LineStatusRevised:
+				err = ps.updateOrderChangeLine(ctx, tx, lineChange, orderID, hd.DocumentCurrencyCode, tn)
+			case ubl.LineStatusCancelled:
+				_, err = tx.ExecContext(ctx, updateOrderLineStatusSQL, ubl.LineStatusCancelled, tn, lineChange.PurchaseOrderLineID, orderID)
+			}
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+
+		for _, purchaseOrderLine := range addedLines {
+			purchaseOrderLineTmp, err := ps.CrPurchaseOrderLineStruct(ctx, purchaseOrderLine, in.GetUserEmail(), in.GetRequestId())
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			_, err = tx.NamedExecContext(ctx, insertPurchaseOrderLineSQL, purchaseOrderLineTmp)
+			if err != nil {
+				ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	purchaseOrderHeader, purchaseOrderLines, err = ps.getPurchaseOrderWithLines(ctx, orderID, in.UserEmail, in.RequestId)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	after, err := purchaseOrderSnapshot(purchaseOrderHeader, purchaseOrderLines)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err = ps.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		return docversion.InsertVersions(ctx, ps.log, tx, ubl.MasterFlagPurchaseOrderHeader, orderID, before, after, revision, tn, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	importOrderChangeUBLResponse := orderproto.ImportOrderChangeUBLResponse{}
+	importOrderChangeUBLResponse.PurchaseOrderHeader = purchaseOrderHeader
+	importOrderChangeUBLResponse.PurchaseOrderLines = purchaseOrderLines
+	return &importOrderChangeUBLResponse, nil
+}
+
+// updateOrderChangeLine - apply a revised line of an OrderChange, its
+// amounts in currencyCode, the currency of the order
+func (ps *PurchaseOrderHeaderService) updateOrderChangeLine(ctx context.Context, tx *sqlx.Tx, lineChange *ubl.OrderLineChange, orderID uint32, currencyCode string, tn time.Time) error {
+	line := lineChange.PurchaseOrderLine
+	priceValidityPeriodStartDate, err := time.Parse(common.Layout, line.PriceValidityPeriodStartDate)
+	if err != nil {
+		return err
+	}
+	priceValidityPeriodEndDate, err := time.Parse(common.Layout, line.PriceValidityPeriodEndDate)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, updateOrderChangeLineSQL,
+		line.SubstitutionStatusCode,
+		line.Note,
+		line.SalesOrderId,
+		line.LineStatusCode,
+		line.Quantity,
+		line.UnitCode,
+		line.LineExtensionAmount,
+		line.TotalTaxAmount,
+		line.MinimumQuantity,
+		line.MaximumQuantity,
+		line.MinimumBackorderQuantity,
+		line.MaximumBackorderQuantity,
+		line.InspectionMethodCode,
+		line.PartialDeliveryIndicator,
+		line.BackOrderAllowedIndicator,
+		line.AccountingCostCode,
+		line.AccountingCost,
+		line.WarrantyInformation,
+		line.OriginatorPartyId,
+		line.ItemId,
+		line.PriceAmount,
+		currencyCode,
+		line.PriceBaseQuantity,
+		line.PriceChangeReason,
+		line.PriceTypeCode,
+		line.PriceType,
+		line.OrderableUnitFactorRate,
+		priceValidityPeriodStartDate.UTC().Truncate(time.Second),
+		priceValidityPeriodEndDate.UTC().Truncate(time.Second),
+		tn,
+		lineChange.PurchaseOrderLineID,
+		orderID)
+	return err
+}
+
+// getPurchaseOrderWithLines - Get PurchaseOrderHeader by id with its lines
+func (ps *PurchaseOrderHeaderService) getPurchaseOrderWithLines(ctx context.Context, orderID uint32, userEmail string, requestID string) (*orderproto.PurchaseOrderHeader, []*orderproto.PurchaseOrderLine, error) {
+	form := orderproto.GetPurchaseOrderHeaderByPkRequest{}
+	form.GetByIdRequest = &commonproto.GetByIdRequest{Id: orderID, UserEmail: userEmail, RequestId: requestID}
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeaderByPk(ctx, &form)
+	if err != nil {
+		return nil, nil, err
+	}
+	purchaseOrderHeader := purchaseOrderHeaderResponse.PurchaseOrderHeader
+
+	linesForm := orderproto.GetPurchaseOrderLinesRequest{}
+	linesForm.GetRequest = &commonproto.GetRequest{Id: purchaseOrderHeader.PurchaseOrderHeaderD.IdS, UserEmail: userEmail, RequestId: requestID}
+	purchaseOrderLinesResponse, err := ps.GetPurchaseOrderLines(ctx, &linesForm)
+	if err != nil {
+		return nil, nil, err
+	}
+	return purchaseOrderHeader, purchaseOrderLinesResponse.PurchaseOrderLines, nil
+}
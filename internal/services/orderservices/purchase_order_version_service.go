@@ -0,0 +1,70 @@
+package orderservices
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/docversion"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// RevisePurchaseOrder - Revise an issued purchase order with the changes
+// UpdatePurchaseOrderHeader makes to a draft one, giving the reason. The
+// revised purchase order is kept as its next version, after the version it
+// was issued with.
+func (ps *PurchaseOrderHeaderService) RevisePurchaseOrder(ctx context.Context, in *orderproto.RevisePurchaseOrderRequest) (*orderproto.RevisePurchaseOrderResponse, error) {
+	updateRequest := in.UpdatePurchaseOrderHeaderRequest
+	if updateRequest == nil {
+		err := errors.New("docversion: a revision needs the changes it makes")
+		ps.log.Error("Error", zap.Error(err))
+		return nil, err
+	}
+	revision, err := docversion.NewRevision(ctx, ps.UserServiceClient, in.Reason, updateRequest.UserId, updateRequest.UserEmail, updateRequest.RequestId)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	err = ps.updatePurchaseOrderHeader(ctx, updateRequest, revision)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", updateRequest.GetUserEmail()), zap.String("reqid", updateRequest.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &orderproto.RevisePurchaseOrderResponse{DocumentVersion: revision.Version}, nil
+}
+
+// GetPurchaseOrderVersions - Get the versions of a purchase order, oldest
+// first
+func (ps *PurchaseOrderHeaderService) GetPurchaseOrderVersions(ctx context.Context, inReq *commonproto.GetDocumentVersionsRequest) (*commonproto.GetDocumentVersionsResponse, error) {
+	in := inReq.GetRequest
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: in})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.GetVersions(ctx, ps.log, ps.DBService, ubl.MasterFlagPurchaseOrderHeader, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.Id, in.GetUserEmail(), in.GetRequestId())
+}
+
+// DiffPurchaseOrderVersions - Get the fields of a purchase order that
+// differ between two of its versions
+func (ps *PurchaseOrderHeaderService) DiffPurchaseOrderVersions(ctx context.Context, in *commonproto.DiffDocumentVersionsRequest) (*commonproto.DiffDocumentVersionsResponse, error) {
+	getRequest := commonproto.GetRequest{Id: in.Id, UserEmail: in.UserEmail, RequestId: in.RequestId}
+	purchaseOrderHeaderResponse, err := ps.GetPurchaseOrderHeader(ctx, &orderproto.GetPurchaseOrderHeaderRequest{GetRequest: &getRequest})
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return docversion.DiffVersions(ctx, ps.log, ps.DBService, ubl.MasterFlagPurchaseOrderHeader, purchaseOrderHeaderResponse.PurchaseOrderHeader.PurchaseOrderHeaderD.Id, in)
+}
+
+// purchaseOrderSnapshot - the docversion snapshot of a purchase order
+func purchaseOrderSnapshot(purchaseOrderHeader *orderproto.PurchaseOrderHeader, purchaseOrderLines []*orderproto.PurchaseOrderLine) ([]byte, error) {
+	lines := []proto.Message{}
+	for _, purchaseOrderLine := range purchaseOrderLines {
+		lines = append(lines, purchaseOrderLine)
+	}
+	return docversion.Snapshot(purchaseOrderHeader, lines)
+}
@@ -0,0 +1,101 @@
+package orderservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"github.com/cloudfresco/sc-ubl/internal/taxcalc"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// taxLine - the amount of a purchase order line or of an allowance or
+// charge of the order, and the tax categories it is taxed in
+type taxLine struct {
+	Quantity      float64
+	Amount        money.Amount
+	TaxCategories []*taxproto.TaxCategoryD
+}
+
+// taxSource - the tax categories of the items of the lines and of the
+// allowances and charges of a purchase order, and their tax schemes
+type taxSource struct {
+	Items         map[uint32]*ubl.ItemSource
+	TaxCategories map[uint32]*taxproto.TaxCategoryD
+	TaxSchemes    map[uint32]*taxproto.TaxSchemeD
+}
+
+// getTaxSource - Get the taxSource of a purchase order
+func (ps *PurchaseOrderHeaderService) getTaxSource(ctx context.Context, purchaseOrderHeader *orderproto.PurchaseOrderHeader, purchaseOrderLines []*orderproto.PurchaseOrderLine, userEmail string, requestID string) (*taxSource, error) {
+	itemIDs := []uint32{}
+	for _, purchaseOrderLine := range purchaseOrderLines {
+		itemIDs = append(itemIDs, purchaseOrderLine.PurchaseOrderLineD.ItemId)
+	}
+	items, err := ubl.GetItems(ctx, ps.DBService, itemIDs...)
+	if err != nil {
+		ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return nil, err
+	}
+	src := taxSource{Items: items, TaxCategories: make(map[uint32]*taxproto.TaxCategoryD), TaxSchemes: make(map[uint32]*taxproto.TaxSchemeD)}
+	for _, item := range items {
+		for id, ts := range item.TaxSchemes {
+			src.TaxSchemes[id] = ts
+		}
+	}
+	for _, allowanceCharge := range purchaseOrderHeader.AllowanceCharges {
+		taxCategoryID := allowanceCharge.AllowanceChargeD.TaxCategoryId
+		if _, ok := src.TaxCategories[taxCategoryID]; taxCategoryID == 0 || ok {
+			continue
+		}
+		tc, ts, err := ubl.GetTaxCategory(ctx, ps.DBService, taxCategoryID)
+		if err != nil {
+			ps.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+			return nil, err
+		}
+		src.TaxCategories[taxCategoryID] = tc
+		if ts != nil {
+			src.TaxSchemes[ts.Id] = ts
+		}
+	}
+	return &src, nil
+}
+
+// purchaseOrderTax - the tax of taxLines in their withholding tax
+// categories with withholding, else in the others, as taxcalc.Calculate
+// computes it with policy. ok is false when no line has such a category.
+func purchaseOrderTax(taxLines []taxLine, taxSchemes map[uint32]*taxproto.TaxSchemeD, withholding bool, policy rounding.Policy) (amount money.Amount, ok bool, err error) {
+	categories := make(map[uint32]*taxcalc.Category)
+	lines := []taxcalc.Line{}
+	for _, tl := range taxLines {
+		line := taxcalc.Line{Quantity: tl.Quantity, NetAmount: tl.Amount}
+		for _, tc := range tl.TaxCategories {
+			if tc == nil || tc.WithholdingIndicator != withholding {
+				continue
+			}
+			if categories[tc.Id] == nil {
+				categories[tc.Id] = taxcalc.FromProto(tc, taxSchemes[tc.TaxSchemeId])
+			}
+			line.Categories = append(line.Categories, categories[tc.Id])
+		}
+		if len(line.Categories) == 0 {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return money.Zero, false, nil
+	}
+
+	subTotals, err := taxcalc.Calculate(lines, policy)
+	if err != nil {
+		return money.Zero, false, err
+	}
+	amount = money.Zero
+	for _, subTotal := range subTotals {
+		amount = amount.Add(subTotal.TaxAmount)
+	}
+	return amount, true, nil
+}
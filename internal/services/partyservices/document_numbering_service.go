@@ -0,0 +1,498 @@
+package partyservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	partystruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/party/v1"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// DocumentNumberingService - For accessing DocumentNumbering services
+type DocumentNumberingService struct {
+	log               *zap.Logger
+	DBService         *common.DBService
+	RedisService      *common.RedisService
+	UserServiceClient partyproto.UserServiceClient
+	partyproto.UnimplementedDocumentNumberingServiceServer
+}
+
+// NewDocumentNumberingService - Create DocumentNumbering service
+func NewDocumentNumberingService(log *zap.Logger, dbOpt *common.DBService, redisOpt *common.RedisService, userServiceClient partyproto.UserServiceClient) *DocumentNumberingService {
+	return &DocumentNumberingService{
+		log:               log,
+		DBService:         dbOpt,
+		RedisService:      redisOpt,
+		UserServiceClient: userServiceClient,
+	}
+}
+
+const insertDocumentNumberSequenceSQL = `insert into document_number_sequences
+	  (
+  uuid4,
+  party_id,
+  document_type,
+  pattern,
+  fiscal_year_start_month,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at)
+  values (:uuid4,
+:party_id,
+:document_type,
+:pattern,
+:fiscal_year_start_month,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+const selectDocumentNumberSequencesSQL = `select
+  id,
+  uuid4,
+  party_id,
+  document_type,
+  pattern,
+  fiscal_year_start_month,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from document_number_sequences`
+
+// updateDocumentNumberSequenceSQL - update DocumentNumberSequenceSQL query
+const updateDocumentNumberSequenceSQL = `update document_number_sequences set
+  pattern = ?,
+  updated_by_user_id = ?,
+  updated_at = ? where uuid4 = ? and status_code = ?;`
+
+const insertVoidedDocumentNumberSQL = `insert into voided_document_numbers
+	  (
+  uuid4,
+  sequence_id,
+  party_id,
+  document_type,
+  fiscal_year,
+  document_number,
+  reason,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at)
+  values (:uuid4,
+:sequence_id,
+:party_id,
+:document_type,
+:fiscal_year,
+:document_number,
+:reason,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at);`
+
+const selectVoidedDocumentNumbersSQL = `select
+  id,
+  uuid4,
+  sequence_id,
+  party_id,
+  document_type,
+  fiscal_year,
+  document_number,
+  reason,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from voided_document_numbers`
+
+// CreateDocumentNumberSequence - Create DocumentNumberSequence
+func (ds *DocumentNumberingService) CreateDocumentNumberSequence(ctx context.Context, in *partyproto.CreateDocumentNumberSequenceRequest) (*partyproto.CreateDocumentNumberSequenceResponse, error) {
+	user, err := GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ds.UserServiceClient)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	doc, err := numbering.DocumentOf(in.DocumentType)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = numbering.ValidatePattern(in.Pattern)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = numbering.ValidateStartMonth(in.FiscalYearStartMonth)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	documentNumberSequenceD := partyproto.DocumentNumberSequenceD{}
+	documentNumberSequenceD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	documentNumberSequenceD.PartyId = in.PartyId
+	documentNumberSequenceD.DocumentType = doc.Type
+	documentNumberSequenceD.Pattern = in.Pattern
+	documentNumberSequenceD.FiscalYearStartMonth = in.FiscalYearStartMonth
+	if documentNumberSequenceD.FiscalYearStartMonth == 0 {
+		documentNumberSequenceD.FiscalYearStartMonth = 1
+	}
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = user.Id
+	crUpdUser.UpdatedByUserId = user.Id
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	documentNumberSequence := partyproto.DocumentNumberSequence{DocumentNumberSequenceD: &documentNumberSequenceD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+
+	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		documentNumberSequenceTmp := partystruct.DocumentNumberSequence{DocumentNumberSequenceD: &documentNumberSequenceD, CrUpdUser: &crUpdUser, CrUpdTime: &commonstruct.CrUpdTime{CreatedAt: ttime, UpdatedAt: ttime}}
+		res, err := tx.NamedExecContext(ctx, insertDocumentNumberSequenceSQL, documentNumberSequenceTmp)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		uID, err := res.LastInsertId()
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		documentNumberSequenceD.Id = uint32(uID)
+		documentNumberSequenceD.IdS, err = common.UUIDBytesToStr(documentNumberSequenceD.Uuid4)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		if in.NextNumber > 1 {
+			seq := numbering.Sequence{ID: documentNumberSequenceD.Id, Pattern: documentNumberSequenceD.Pattern, FiscalYearStartMonth: documentNumberSequenceD.FiscalYearStartMonth}
+			fiscalYear := int(in.FiscalYear)
+			if fiscalYear == 0 {
+				fiscalYear = numbering.FiscalYear(ttime, seq.FiscalYearStartMonth)
+			}
+			err = numbering.SetLastNumber(ctx, tx, &seq, fiscalYear, in.NextNumber-1)
+			if err != nil {
+				ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	documentNumberSequenceResponse := partyproto.CreateDocumentNumberSequenceResponse{}
+	documentNumberSequenceResponse.DocumentNumberSequence = &documentNumberSequence
+	return &documentNumberSequenceResponse, nil
+}
+
+// GetDocumentNumberSequences - Get DocumentNumberSequences
+func (ds *DocumentNumberingService) GetDocumentNumberSequences(ctx context.Context, in *partyproto.GetDocumentNumberSequencesRequest) (*partyproto.GetDocumentNumberSequencesResponse, error) {
+	limit := in.GetLimit()
+	nextCursor := in.GetNextCursor()
+	if limit == "" {
+		limit = ds.DBService.LimitSQLRows
+	}
+	query := "status_code = ?"
+	if nextCursor == "" {
+		query = query + " order by id desc " + " limit " + limit + ";"
+	} else {
+		nextCursor = common.DecodeCursor(nextCursor)
+		query = query + " " + "and" + " " + "id <= " + nextCursor + " order by id desc " + " limit " + limit + ";"
+	}
+
+	documentNumberSequences := []*partyproto.DocumentNumberSequence{}
+
+	nselectDocumentNumberSequencesSQL := selectDocumentNumberSequencesSQL + ` where ` + query
+
+	rows, err := ds.DBService.DB.QueryxContext(ctx, nselectDocumentNumberSequencesSQL, "active")
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	for rows.Next() {
+		documentNumberSequenceTmp := partystruct.DocumentNumberSequence{}
+		err = rows.StructScan(&documentNumberSequenceTmp)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+
+		documentNumberSequence, err := getDocumentNumberSequenceStruct(&documentNumberSequenceTmp)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		documentNumberSequences = append(documentNumberSequences, documentNumberSequence)
+	}
+
+	documentNumberSequencesResponse := partyproto.GetDocumentNumberSequencesResponse{}
+	if len(documentNumberSequences) != 0 {
+		next := documentNumberSequences[len(documentNumberSequences)-1].DocumentNumberSequenceD.Id
+		next--
+		nextc := common.EncodeCursor(next)
+		documentNumberSequencesResponse = partyproto.GetDocumentNumberSequencesResponse{DocumentNumberSequences: documentNumberSequences, NextCursor: nextc}
+	} else {
+		documentNumberSequencesResponse = partyproto.GetDocumentNumberSequencesResponse{DocumentNumberSequences: documentNumberSequences, NextCursor: "0"}
+	}
+	return &documentNumberSequencesResponse, nil
+}
+
+// GetDocumentNumberSequence - Get DocumentNumberSequence
+func (ds *DocumentNumberingService) GetDocumentNumberSequence(ctx context.Context, inReq *partyproto.GetDocumentNumberSequenceRequest) (*partyproto.GetDocumentNumberSequenceResponse, error) {
+	in := inReq.GetRequest
+	uuid4byte, err := common.UUIDStrToBytes(in.Id)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	nselectDocumentNumberSequencesSQL := selectDocumentNumberSequencesSQL + ` where uuid4 = ? and status_code = ?;`
+	row := ds.DBService.DB.QueryRowxContext(ctx, nselectDocumentNumberSequencesSQL, uuid4byte, "active")
+	documentNumberSequenceTmp := partystruct.DocumentNumberSequence{}
+	err = row.StructScan(&documentNumberSequenceTmp)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	documentNumberSequence, err := getDocumentNumberSequenceStruct(&documentNumberSequenceTmp)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	documentNumberSequenceResponse := partyproto.GetDocumentNumberSequenceResponse{}
+	documentNumberSequenceResponse.DocumentNumberSequence = documentNumberSequence
+	return &documentNumberSequenceResponse, nil
+}
+
+// getDocumentNumberSequenceStruct - Get DocumentNumberSequence
+func getDocumentNumberSequenceStruct(documentNumberSequenceTmp *partystruct.DocumentNumberSequence) (*partyproto.DocumentNumberSequence, error) {
+	crUpdTime := new(commonproto.CrUpdTime)
+	crUpdTime.CreatedAt = common.TimeToTimestamp(documentNumberSequenceTmp.CrUpdTime.CreatedAt)
+	crUpdTime.UpdatedAt = common.TimeToTimestamp(documentNumberSequenceTmp.CrUpdTime.UpdatedAt)
+
+	uuid4Str, err := common.UUIDBytesToStr(documentNumberSequenceTmp.DocumentNumberSequenceD.Uuid4)
+	if err != nil {
+		return nil, err
+	}
+	documentNumberSequenceTmp.DocumentNumberSequenceD.IdS = uuid4Str
+
+	documentNumberSequence := partyproto.DocumentNumberSequence{DocumentNumberSequenceD: documentNumberSequenceTmp.DocumentNumberSequenceD, CrUpdUser: documentNumberSequenceTmp.CrUpdUser, CrUpdTime: crUpdTime}
+	return &documentNumberSequence, nil
+}
+
+// UpdateDocumentNumberSequence - Update the pattern of a
+// DocumentNumberSequence, used for the numbers allocated from now on
+func (ds *DocumentNumberingService) UpdateDocumentNumberSequence(ctx context.Context, in *partyproto.UpdateDocumentNumberSequenceRequest) (*partyproto.UpdateDocumentNumberSequenceResponse, error) {
+	user, err := GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ds.UserServiceClient)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = numbering.ValidatePattern(in.Pattern)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	uuid4byte, err := common.UUIDStrToBytes(in.Id)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	tn := common.GetTimeDetails()
+	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, updateDocumentNumberSequenceSQL,
+			in.Pattern,
+			user.Id,
+			tn,
+			uuid4byte,
+			"active")
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &partyproto.UpdateDocumentNumberSequenceResponse{}, nil
+}
+
+// VoidDocumentNumber - Void a number allocated from the sequence of a
+// document type of a party that is not used by a document, keeping it with
+// the reason in the voided numbers
+func (ds *DocumentNumberingService) VoidDocumentNumber(ctx context.Context, in *partyproto.VoidDocumentNumberRequest) (*partyproto.VoidDocumentNumberResponse, error) {
+	user, err := GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ds.UserServiceClient)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	doc, err := numbering.DocumentOf(in.DocumentType)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	voidedDocumentNumberD := partyproto.VoidedDocumentNumberD{}
+	voidedDocumentNumberD.Uuid4, err = common.GetUUIDBytes()
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	voidedDocumentNumberD.PartyId = in.PartyId
+	voidedDocumentNumberD.DocumentType = doc.Type
+	voidedDocumentNumberD.DocumentNumber = in.DocumentNumber
+	voidedDocumentNumberD.Reason = in.Reason
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = user.Id
+	crUpdUser.UpdatedByUserId = user.Id
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	voidedDocumentNumber := partyproto.VoidedDocumentNumber{VoidedDocumentNumberD: &voidedDocumentNumberD, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}
+
+	err = ds.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		seq, err := numbering.LockSequence(ctx, tx, doc, in.PartyId)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		fiscalYear, err := numbering.CheckVoidable(ctx, tx, doc, in.PartyId, seq, in.DocumentNumber)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		voidedDocumentNumberD.SequenceId = seq.ID
+		voidedDocumentNumberD.FiscalYear = uint32(fiscalYear)
+
+		voidedDocumentNumberTmp := partystruct.VoidedDocumentNumber{VoidedDocumentNumberD: &voidedDocumentNumberD, CrUpdUser: &crUpdUser, CrUpdTime: &commonstruct.CrUpdTime{CreatedAt: ttime, UpdatedAt: ttime}}
+		res, err := tx.NamedExecContext(ctx, insertVoidedDocumentNumberSQL, voidedDocumentNumberTmp)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+
+		uID, err := res.LastInsertId()
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		voidedDocumentNumberD.Id = uint32(uID)
+		voidedDocumentNumberD.IdS, err = common.UUIDBytesToStr(voidedDocumentNumberD.Uuid4)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	voidDocumentNumberResponse := partyproto.VoidDocumentNumberResponse{}
+	voidDocumentNumberResponse.VoidedDocumentNumber = &voidedDocumentNumber
+	return &voidDocumentNumberResponse, nil
+}
+
+// GetVoidedDocumentNumbers - Get the VoidedDocumentNumbers of a party and
+// document type, of all when they are not given
+func (ds *DocumentNumberingService) GetVoidedDocumentNumbers(ctx context.Context, in *partyproto.GetVoidedDocumentNumbersRequest) (*partyproto.GetVoidedDocumentNumbersResponse, error) {
+	limit := in.GetLimit()
+	nextCursor := in.GetNextCursor()
+	if limit == "" {
+		limit = ds.DBService.LimitSQLRows
+	}
+	query := "status_code = ? and (? = 0 or party_id = ?) and (? = '' or document_type = ?)"
+	if nextCursor == "" {
+		query = query + " order by id desc " + " limit " + limit + ";"
+	} else {
+		nextCursor = common.DecodeCursor(nextCursor)
+		query = query + " " + "and" + " " + "id <= " + nextCursor + " order by id desc " + " limit " + limit + ";"
+	}
+
+	voidedDocumentNumbers := []*partyproto.VoidedDocumentNumber{}
+
+	nselectVoidedDocumentNumbersSQL := selectVoidedDocumentNumbersSQL + ` where ` + query
+
+	rows, err := ds.DBService.DB.QueryxContext(ctx, nselectVoidedDocumentNumbersSQL, "active", in.PartyId, in.PartyId, in.DocumentType, in.DocumentType)
+	if err != nil {
+		ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	for rows.Next() {
+		voidedDocumentNumberTmp := partystruct.VoidedDocumentNumber{}
+		err = rows.StructScan(&voidedDocumentNumberTmp)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+
+		uuid4Str, err := common.UUIDBytesToStr(voidedDocumentNumberTmp.VoidedDocumentNumberD.Uuid4)
+		if err != nil {
+			ds.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		voidedDocumentNumberTmp.VoidedDocumentNumberD.IdS = uuid4Str
+
+		crUpdTime := new(commonproto.CrUpdTime)
+		crUpdTime.CreatedAt = common.TimeToTimestamp(voidedDocumentNumberTmp.CrUpdTime.CreatedAt)
+		crUpdTime.UpdatedAt = common.TimeToTimestamp(voidedDocumentNumberTmp.CrUpdTime.UpdatedAt)
+
+		voidedDocumentNumbers = append(voidedDocumentNumbers, &partyproto.VoidedDocumentNumber{VoidedDocumentNumberD: voidedDocumentNumberTmp.VoidedDocumentNumberD, CrUpdUser: voidedDocumentNumberTmp.CrUpdUser, CrUpdTime: crUpdTime})
+	}
+
+	voidedDocumentNumbersResponse := partyproto.GetVoidedDocumentNumbersResponse{}
+	if len(voidedDocumentNumbers) != 0 {
+		next := voidedDocumentNumbers[len(voidedDocumentNumbers)-1].VoidedDocumentNumberD.Id
+		next--
+		nextc := common.EncodeCursor(next)
+		voidedDocumentNumbersResponse = partyproto.GetVoidedDocumentNumbersResponse{VoidedDocumentNumbers: voidedDocumentNumbers, NextCursor: nextc}
+	} else {
+		voidedDocumentNumbersResponse = partyproto.GetVoidedDocumentNumbersResponse{VoidedDocumentNumbers: voidedDocumentNumbers, NextCursor: "0"}
+	}
+	return &voidedDocumentNumbersResponse, nil
+}
@@ -0,0 +1,94 @@
+package partyservices
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/numbering"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/test"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentNumberingService_VoidDocumentNumber(t *testing.T) {
+	err := test.LoadSQL(logUser, dbService)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx := LoginUser()
+
+	documentNumberingService := NewDocumentNumberingService(log, dbService, redisService, userServiceClient)
+
+	form := partyproto.CreateDocumentNumberSequenceRequest{}
+	form.PartyId = uint32(5)
+	form.DocumentType = "invoice"
+	form.Pattern = "INV-{YYYY}-{seq:6}"
+	form.FiscalYearStartMonth = uint32(4)
+	form.FiscalYear = uint32(2022)
+	form.NextNumber = uint32(41)
+	form.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	form.UserEmail = "sprov300@gmail.com"
+	form.RequestId = "bks1m1g91jau4nkks2f0"
+
+	_, err = documentNumberingService.CreateDocumentNumberSequence(ctx, &form)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// assign - the number of an invoice of party 5 without a number of its own
+	assign := func(issueDate time.Time) (string, error) {
+		var documentNumber string
+		err := dbService.InsUpd(context.Background(), form.UserEmail, form.RequestId, func(tx *sqlx.Tx) error {
+			var err error
+			documentNumber, err = numbering.Assign(context.Background(), tx, numbering.Invoice, form.PartyId, "", issueDate)
+			return err
+		})
+		return documentNumber, err
+	}
+
+	documentNumber, err := assign(time.Date(2022, 6, 21, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "INV-2022-000041", documentNumber)
+	documentNumber, err = assign(time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "INV-2022-000042", documentNumber)
+	documentNumber, err = assign(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "INV-2023-000001", documentNumber)
+
+	vform := partyproto.VoidDocumentNumberRequest{}
+	vform.PartyId = uint32(5)
+	vform.DocumentType = "invoice"
+	vform.DocumentNumber = "INV-2022-000042"
+	vform.Reason = "printed twice"
+	vform.UserId = "auth0|673c75d516e8adb9e6ffc892"
+	vform.UserEmail = "sprov300@gmail.com"
+	vform.RequestId = "bks1m1g91jau4nkks2f0"
+
+	voidDocumentNumberResponse, err := documentNumberingService.VoidDocumentNumber(ctx, &vform)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2022), voidDocumentNumberResponse.VoidedDocumentNumber.VoidedDocumentNumberD.FiscalYear)
+
+	_, err = documentNumberingService.VoidDocumentNumber(ctx, &vform)
+	assert.True(t, errors.Is(err, numbering.ErrNumberUsed))
+
+	vform.DocumentNumber = "INV-2022-000043"
+	_, err = documentNumberingService.VoidDocumentNumber(ctx, &vform)
+	assert.True(t, errors.Is(err, numbering.ErrNotAllocated))
+
+	gform := partyproto.GetVoidedDocumentNumbersRequest{}
+	gform.PartyId = uint32(5)
+	gform.DocumentType = "invoice"
+	gform.UserEmail = "sprov300@gmail.com"
+	gform.RequestId = "bks1m1g91jau4nkks2f0"
+	voidedDocumentNumbersResponse, err := documentNumberingService.GetVoidedDocumentNumbers(ctx, &gform)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(voidedDocumentNumbersResponse.VoidedDocumentNumbers))
+	assert.Equal(t, "printed twice", voidedDocumentNumbersResponse.VoidedDocumentNumbers[0].VoidedDocumentNumberD.Reason)
+}
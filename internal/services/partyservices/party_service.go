@@ -67,9 +67,12 @@ func StartPartyServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DB
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	partyService := NewPartyService(log, dbService, redisService, uc)
 	financialinstitutionService := NewFinancialInstitutionService(log, dbService, redisService, uc)
+	documentNumberingService := NewDocumentNumberingService(log, dbService, redisService, uc)
 
 	lis, err := net.Listen("tcp", grpcServerOpt.GrpcPartyServerPort)
 	if err != nil {
@@ -80,6 +83,7 @@ func StartPartyServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DB
 	srv := grpc.NewServer(srvOpts...)
 	partyproto.RegisterPartyServiceServer(srv, partyService)
 	partyproto.RegisterFinancialInstitutionServiceServer(srv, financialinstitutionService)
+	partyproto.RegisterDocumentNumberingServiceServer(srv, documentNumberingService)
 
 	if err := srv.Serve(lis); err != nil {
 		log.Error("Error", zap.Error(err))
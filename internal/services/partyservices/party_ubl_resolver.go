@@ -0,0 +1,70 @@
+package partyservices
+
+import (
+	"context"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ubl"
+	"go.uber.org/zap"
+)
+
+// UBLResolver - resolves the parties, documents and items referenced by an
+// imported UBL document, creating unknown parties
+type UBLResolver struct {
+	dbService    *common.DBService
+	partyService *PartyService
+	userID       string
+	userEmail    string
+	requestID    string
+	defaultDate  string
+}
+
+// NewUBLResolver - UBLResolver for a document issued on issueDate, the
+// default registration date of the parties it creates
+func NewUBLResolver(log *zap.Logger, dbService *common.DBService, redisService *common.RedisService, userServiceClient partyproto.UserServiceClient, userID string, userEmail string, requestID string, issueDate string) *UBLResolver {
+	resolver := UBLResolver{
+		dbService:    dbService,
+		partyService: NewPartyService(log, dbService, redisService, userServiceClient),
+		userID:       userID,
+		userEmail:    userEmail,
+		requestID:    requestID,
+	}
+	if defaultDate, err := ubl.ParseDate(issueDate); err == nil {
+		resolver.defaultDate = defaultDate
+	}
+	return &resolver
+}
+
+// FindParty - Find Party by endpoint ID, VAT number or registration name
+func (ur *UBLResolver) FindParty(ctx context.Context, party *ubl.Party) (uint32, error) {
+	return ubl.FindParty(ctx, ur.dbService, party)
+}
+
+// CreateParty - Create Party from a UBL party
+func (ur *UBLResolver) CreateParty(ctx context.Context, party *ubl.Party) (uint32, error) {
+	form := ubl.PartyRequest(party, ur.defaultDate)
+	form.UserId = ur.userID
+	form.UserEmail = ur.userEmail
+	form.RequestId = ur.requestID
+	partyResponse, err := ur.partyService.CreateParty(ctx, form)
+	if err != nil {
+		return 0, err
+	}
+	return partyResponse.Party.PartyD.Id, nil
+}
+
+// FindReference - Find referenced document or line by business identifier
+func (ur *UBLResolver) FindReference(ctx context.Context, kind string, id string, parentID uint32) (uint32, error) {
+	return ubl.FindReference(ctx, ur.dbService, kind, id, parentID)
+}
+
+// FindItem - Find Item by identification or name
+func (ur *UBLResolver) FindItem(ctx context.Context, item *ubl.Item) (uint32, error) {
+	return ubl.FindItem(ctx, ur.dbService, item)
+}
+
+// FindTaxCategory - Find TaxCategory by code and percent
+func (ur *UBLResolver) FindTaxCategory(ctx context.Context, taxCategory *ubl.TaxCategory) (uint32, error) {
+	return ubl.FindTaxCategory(ctx, ur.dbService, taxCategory)
+}
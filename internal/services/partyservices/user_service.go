@@ -69,6 +69,8 @@ func StartUserServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DBO
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	userService := NewUserService(log, dbService, redisService, mailerService, jwtOpt, userOpt, serverOpt)
 
 	lis, err := net.Listen("tcp", grpcServerOpt.GrpcUserServerPort)
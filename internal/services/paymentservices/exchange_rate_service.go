@@ -0,0 +1,511 @@
+package paymentservices
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/exchangerate"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	paymentstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/payment/v1"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// ExchangeRateService - For accessing ExchangeRate services
+type ExchangeRateService struct {
+	log               *zap.Logger
+	DBService         *common.DBService
+	RedisService      *common.RedisService
+	UserServiceClient partyproto.UserServiceClient
+	paymentproto.UnimplementedExchangeRateServiceServer
+}
+
+// NewExchangeRateService - Create ExchangeRate service
+func NewExchangeRateService(log *zap.Logger, dbOpt *common.DBService, redisOpt *common.RedisService, userServiceClient partyproto.UserServiceClient) *ExchangeRateService {
+	return &ExchangeRateService{
+		log:               log,
+		DBService:         dbOpt,
+		RedisService:      redisOpt,
+		UserServiceClient: userServiceClient,
+	}
+}
+
+const selectExchangeRatesSQL = `select
+  id,
+  uuid4,
+  source_currency_code,
+  target_currency_code,
+  exchange_market_id,
+  calculation_rate,
+  rate_date,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from exchange_rates`
+
+const insertExchangeRateSQL = `insert into exchange_rates
+	  (
+uuid4,
+source_currency_code,
+target_currency_code,
+exchange_market_id,
+calculation_rate,
+rate_date,
+status_code,
+created_by_user_id,
+updated_by_user_id,
+created_at,
+updated_at)
+  values (:uuid4,
+:source_currency_code,
+:target_currency_code,
+:exchange_market_id,
+:calculation_rate,
+:rate_date,
+:status_code,
+:created_by_user_id,
+:updated_by_user_id,
+:created_at,
+:updated_at)`
+
+// importExchangeRateSQL - insert ExchangeRateSQL query that replaces the
+// rate of the same currencies, market and date
+const importExchangeRateSQL = insertExchangeRateSQL + `
+  on duplicate key update
+  calculation_rate = values(calculation_rate),
+  status_code = values(status_code),
+  updated_by_user_id = values(updated_by_user_id),
+  updated_at = values(updated_at);`
+
+// updateExchangeRateSQL - update ExchangeRateSQL query
+const updateExchangeRateSQL = `update exchange_rates set
+  calculation_rate = ?,
+  updated_at = ? where uuid4 = ?;`
+
+// deleteExchangeRateSQL - delete ExchangeRateSQL query
+const deleteExchangeRateSQL = `update exchange_rates set
+  status_code = ?,
+  updated_at = ? where uuid4 = ?;`
+
+// selectExchangeRateOnDateSQL - the most recent rate of the currencies on
+// or before a date and after its oldest allowed date, in a market or with
+// 0 in any
+const selectExchangeRateOnDateSQL = selectExchangeRatesSQL + ` where source_currency_code = ? and target_currency_code = ? and (? = 0 or exchange_market_id = ?) and rate_date <= ? and rate_date > ? and status_code = ? order by rate_date desc, exchange_market_id limit 1;`
+
+// CreateExchangeRate - Create ExchangeRate
+func (es *ExchangeRateService) CreateExchangeRate(ctx context.Context, in *paymentproto.CreateExchangeRateRequest) (*paymentproto.CreateExchangeRateResponse, error) {
+	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, es.UserServiceClient)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	rateDate, err := time.Parse(common.Layout, in.RateDate)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	rate := exchangerate.Rate{SourceCurrencyCode: strings.ToUpper(in.SourceCurrencyCode), TargetCurrencyCode: strings.ToUpper(in.TargetCurrencyCode), ExchangeMarketID: in.ExchangeMarketId, CalculationRate: in.CalculationRate, Date: rateDate}
+	err = rate.Validate()
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	exchangeRate, err := newExchangeRate(rate, user.Id)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = es.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		return es.insertExchangeRate(ctx, tx, insertExchangeRateSQL, exchangeRate, in.GetUserEmail(), in.GetRequestId())
+	})
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	exchangeRateResponse := paymentproto.CreateExchangeRateResponse{}
+	exchangeRateResponse.ExchangeRate = exchangeRate
+	return &exchangeRateResponse, nil
+}
+
+// newExchangeRate - the ExchangeRate of a Rate created by userID
+func newExchangeRate(rate exchangerate.Rate, userID string) (*paymentproto.ExchangeRate, error) {
+	ttime := common.GetTimeDetails()
+	tn := common.TimeToTimestamp(ttime)
+
+	exchangeRateD := paymentproto.ExchangeRateD{}
+	uuid4, err := common.GetUUIDBytes()
+	if err != nil {
+		return nil, err
+	}
+	exchangeRateD.Uuid4 = uuid4
+	exchangeRateD.SourceCurrencyCode = rate.SourceCurrencyCode
+	exchangeRateD.TargetCurrencyCode = rate.TargetCurrencyCode
+	exchangeRateD.ExchangeMarketId = rate.ExchangeMarketID
+	exchangeRateD.CalculationRate = rate.CalculationRate
+
+	exchangeRateT := paymentproto.ExchangeRateT{}
+	exchangeRateT.RateDate = common.TimeToTimestamp(rate.Date.UTC().Truncate(time.Second))
+
+	crUpdUser := commonproto.CrUpdUser{}
+	crUpdUser.StatusCode = "active"
+	crUpdUser.CreatedByUserId = userID
+	crUpdUser.UpdatedByUserId = userID
+
+	crUpdTime := commonproto.CrUpdTime{}
+	crUpdTime.CreatedAt = tn
+	crUpdTime.UpdatedAt = tn
+
+	return &paymentproto.ExchangeRate{ExchangeRateD: &exchangeRateD, ExchangeRateT: &exchangeRateT, CrUpdUser: &crUpdUser, CrUpdTime: &crUpdTime}, nil
+}
+
+// insertExchangeRate - Insert exchange rate details into database within tx
+func (es *ExchangeRateService) insertExchangeRate(ctx context.Context, tx *sqlx.Tx, insertExchangeRateSQL string, exchangeRate *paymentproto.ExchangeRate, userEmail string, requestID string) error {
+	exchangeRateTmp := es.crExchangeRateStruct(exchangeRate)
+	res, err := tx.NamedExecContext(ctx, insertExchangeRateSQL, exchangeRateTmp)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+
+	uID, err := res.LastInsertId()
+	if err != nil {
+		es.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	exchangeRate.ExchangeRateD.Id = uint32(uID)
+	uuid4Str, err := common.UUIDBytesToStr(exchangeRate.ExchangeRateD.Uuid4)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", userEmail), zap.String("reqid", requestID), zap.Error(err))
+		return err
+	}
+	exchangeRate.ExchangeRateD.IdS = uuid4Str
+	return nil
+}
+
+// crExchangeRateStruct - process ExchangeRate details
+func (es *ExchangeRateService) crExchangeRateStruct(exchangeRate *paymentproto.ExchangeRate) *paymentstruct.ExchangeRate {
+	exchangeRateT := new(paymentstruct.ExchangeRateT)
+	exchangeRateT.RateDate = common.TimestampToTime(exchangeRate.ExchangeRateT.RateDate)
+
+	crUpdTime := new(commonstruct.CrUpdTime)
+	crUpdTime.CreatedAt = common.TimestampToTime(exchangeRate.CrUpdTime.CreatedAt)
+	crUpdTime.UpdatedAt = common.TimestampToTime(exchangeRate.CrUpdTime.UpdatedAt)
+
+	return &paymentstruct.ExchangeRate{ExchangeRateD: exchangeRate.ExchangeRateD, ExchangeRateT: exchangeRateT, CrUpdUser: exchangeRate.CrUpdUser, CrUpdTime: crUpdTime}
+}
+
+// GetExchangeRates - Get ExchangeRates
+func (es *ExchangeRateService) GetExchangeRates(ctx context.Context, in *paymentproto.GetExchangeRatesRequest) (*paymentproto.GetExchangeRatesResponse, error) {
+	limit := in.GetLimit()
+	nextCursor := in.GetNextCursor()
+	if limit == "" {
+		limit = es.DBService.LimitSQLRows
+	}
+	query := "status_code = ?"
+	if nextCursor == "" {
+		query = query + " order by id desc " + " limit " + limit + ";"
+	} else {
+		nextCursor = common.DecodeCursor(nextCursor)
+		query = query + " " + "and" + " " + "id <= " + nextCursor + " order by id desc " + " limit " + limit + ";"
+	}
+
+	exchangeRates := []*paymentproto.ExchangeRate{}
+
+	nselectExchangeRatesSQL := selectExchangeRatesSQL + ` where ` + query
+
+	rows, err := es.DBService.DB.QueryxContext(ctx, nselectExchangeRatesSQL, "active")
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	for rows.Next() {
+		exchangeRateTmp := paymentstruct.ExchangeRate{}
+		err = rows.StructScan(&exchangeRateTmp)
+		if err != nil {
+			es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+
+		exchangeRate, err := getExchangeRateStruct(&exchangeRateTmp)
+		if err != nil {
+			es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			return nil, err
+		}
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+
+	exchangeRatesResponse := paymentproto.GetExchangeRatesResponse{}
+	if len(exchangeRates) != 0 {
+		next := exchangeRates[len(exchangeRates)-1].ExchangeRateD.Id
+		next--
+		nextc := common.EncodeCursor(next)
+		exchangeRatesResponse = paymentproto.GetExchangeRatesResponse{ExchangeRates: exchangeRates, NextCursor: nextc}
+	} else {
+		exchangeRatesResponse = paymentproto.GetExchangeRatesResponse{ExchangeRates: exchangeRates, NextCursor: "0"}
+	}
+	return &exchangeRatesResponse, nil
+}
+
+// GetExchangeRate - Get ExchangeRate
+func (es *ExchangeRateService) GetExchangeRate(ctx context.Context, inReq *paymentproto.GetExchangeRateRequest) (*paymentproto.GetExchangeRateResponse, error) {
+	in := inReq.GetRequest
+	uuid4byte, err := common.UUIDStrToBytes(in.Id)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	nselectExchangeRatesSQL := selectExchangeRatesSQL + ` where uuid4 = ? and status_code = ?;`
+	row := es.DBService.DB.QueryRowxContext(ctx, nselectExchangeRatesSQL, uuid4byte, "active")
+	exchangeRateTmp := paymentstruct.ExchangeRate{}
+	err = row.StructScan(&exchangeRateTmp)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	exchangeRate, err := getExchangeRateStruct(&exchangeRateTmp)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	exchangeRateResponse := paymentproto.GetExchangeRateResponse{}
+	exchangeRateResponse.ExchangeRate = exchangeRate
+	return &exchangeRateResponse, nil
+}
+
+// getExchangeRateStruct - Get ExchangeRate
+func getExchangeRateStruct(exchangeRateTmp *paymentstruct.ExchangeRate) (*paymentproto.ExchangeRate, error) {
+	exchangeRateT := new(paymentproto.ExchangeRateT)
+	exchangeRateT.RateDate = common.TimeToTimestamp(exchangeRateTmp.ExchangeRateT.RateDate)
+
+	crUpdTime := new(commonproto.CrUpdTime)
+	crUpdTime.CreatedAt = common.TimeToTimestamp(exchangeRateTmp.CrUpdTime.CreatedAt)
+	crUpdTime.UpdatedAt = common.TimeToTimestamp(exchangeRateTmp.CrUpdTime.UpdatedAt)
+
+	uuid4Str, err := common.UUIDBytesToStr(exchangeRateTmp.ExchangeRateD.Uuid4)
+	if err != nil {
+		return nil, err
+	}
+	exchangeRateTmp.ExchangeRateD.IdS = uuid4Str
+
+	exchangeRate := paymentproto.ExchangeRate{ExchangeRateD: exchangeRateTmp.ExchangeRateD, ExchangeRateT: exchangeRateT, CrUpdUser: exchangeRateTmp.CrUpdUser, CrUpdTime: crUpdTime}
+	return &exchangeRate, nil
+}
+
+// UpdateExchangeRate - Update ExchangeRate
+func (es *ExchangeRateService) UpdateExchangeRate(ctx context.Context, in *paymentproto.UpdateExchangeRateRequest) (*paymentproto.UpdateExchangeRateResponse, error) {
+	if in.CalculationRate <= 0 {
+		err := fmt.Errorf("exchangerate: rate %v is not positive", in.CalculationRate)
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	db := es.DBService.DB
+	tn := common.GetTimeDetails()
+
+	uuid4byte, err := common.UUIDStrToBytes(in.Id)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	stmt, err := db.PreparexContext(ctx, updateExchangeRateSQL)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = es.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
+			in.CalculationRate,
+			tn,
+			uuid4byte)
+		if err != nil {
+			es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			err1 := stmt.Close()
+			if err1 != nil {
+				es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err1))
+				return err1
+			}
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = stmt.Close()
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &paymentproto.UpdateExchangeRateResponse{}, nil
+}
+
+// DeleteExchangeRate - Delete ExchangeRate
+func (es *ExchangeRateService) DeleteExchangeRate(ctx context.Context, inReq *paymentproto.DeleteExchangeRateRequest) (*paymentproto.DeleteExchangeRateResponse, error) {
+	in := inReq.GetRequest
+	uuid4byte, err := common.UUIDStrToBytes(in.Id)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	db := es.DBService.DB
+	tn := common.GetTimeDetails()
+	stmt, err := db.PreparexContext(ctx, deleteExchangeRateSQL)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = es.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		_, err = tx.StmtxContext(ctx, stmt).ExecContext(ctx,
+			"inactive",
+			tn,
+			uuid4byte)
+		if err != nil {
+			es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+			err1 := stmt.Close()
+			if err1 != nil {
+				es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err1))
+				return err1
+			}
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = stmt.Close()
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &paymentproto.DeleteExchangeRateResponse{}, nil
+}
+
+// ImportExchangeRates - Import the ExchangeRates of a reference rates file,
+// replacing the rates of the same currencies, market and date
+func (es *ExchangeRateService) ImportExchangeRates(ctx context.Context, in *paymentproto.ImportExchangeRatesRequest) (*paymentproto.ImportExchangeRatesResponse, error) {
+	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, es.UserServiceClient)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	sourceCurrencyCode := in.SourceCurrencyCode
+	if sourceCurrencyCode == "" {
+		sourceCurrencyCode = "EUR"
+	}
+	var rates []exchangerate.Rate
+	switch strings.ToLower(in.Format) {
+	case "csv":
+		rates, err = exchangerate.ParseCSV(bytes.NewReader(in.Data), sourceCurrencyCode, in.ExchangeMarketId)
+	case "xml":
+		rates, err = exchangerate.ParseXML(bytes.NewReader(in.Data), sourceCurrencyCode, in.ExchangeMarketId)
+	default:
+		err = fmt.Errorf("exchangerate: format %q is not csv or xml", in.Format)
+	}
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	err = es.DBService.InsUpd(ctx, in.GetUserEmail(), in.GetRequestId(), func(tx *sqlx.Tx) error {
+		for _, rate := range rates {
+			exchangeRate, err := newExchangeRate(rate, user.Id)
+			if err != nil {
+				es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+			_, err = tx.NamedExecContext(ctx, importExchangeRateSQL, es.crExchangeRateStruct(exchangeRate))
+			if err != nil {
+				es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	return &paymentproto.ImportExchangeRatesResponse{RateCount: uint32(len(rates))}, nil
+}
+
+// ConvertAmount - Convert an amount with the exchange rate of a date
+func (es *ExchangeRateService) ConvertAmount(ctx context.Context, in *paymentproto.ConvertAmountRequest) (*paymentproto.ConvertAmountResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+	rateDate, err := time.Parse(common.Layout, in.RateDate)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	exchange, err := exchangerate.Find(ExchangeRateLookup(ctx, es.DBService), in.SourceCurrencyCode, in.TargetCurrencyCode, in.ExchangeMarketId, rateDate)
+	if err != nil {
+		es.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
+	amount, _ := money.Parse(in.Amount)
+	convertAmountResponse := paymentproto.ConvertAmountResponse{}
+	convertAmountResponse.Amount = exchange.Convert(amount).String()
+	convertAmountResponse.CurrencyCode = in.TargetCurrencyCode
+	convertAmountResponse.CalculationRate = exchange.CalculationRate
+	convertAmountResponse.MathematicOperatorCode = exchange.MathematicOperatorCode
+	convertAmountResponse.ExchangeMarketId = exchange.ExchangeMarketID
+	convertAmountResponse.RateDate = common.TimeToTimestamp(exchange.Date)
+	return &convertAmountResponse, nil
+}
+
+// GetExchangeRateOnDate - the most recent active rate from source to target
+// on or before date and at most exchangerate.MaxAge old, in marketID or,
+// with 0, in any market; nil when there is none
+func GetExchangeRateOnDate(ctx context.Context, dbService *common.DBService, source string, target string, marketID uint32, date time.Time) (*exchangerate.Rate, error) {
+	row := dbService.DB.QueryRowxContext(ctx, selectExchangeRateOnDateSQL, source, target, marketID, marketID, date, date.Add(-exchangerate.MaxAge), "active")
+	exchangeRateTmp := paymentstruct.ExchangeRate{}
+	err := row.StructScan(&exchangeRateTmp)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	d := exchangeRateTmp.ExchangeRateD
+	return &exchangerate.Rate{SourceCurrencyCode: d.SourceCurrencyCode, TargetCurrencyCode: d.TargetCurrencyCode, ExchangeMarketID: d.ExchangeMarketId, CalculationRate: d.CalculationRate, Date: exchangeRateTmp.ExchangeRateT.RateDate}, nil
+}
+
+// ExchangeRateLookup - the exchangerate.Lookup of the stored exchange rates
+func ExchangeRateLookup(ctx context.Context, dbService *common.DBService) exchangerate.Lookup {
+	return func(source string, target string, marketID uint32, date time.Time) (*exchangerate.Rate, error) {
+		return GetExchangeRateOnDate(ctx, dbService, source, target, marketID, date)
+	}
+}
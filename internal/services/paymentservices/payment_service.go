@@ -8,6 +8,7 @@ import (
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
 	"github.com/cloudfresco/sc-ubl/internal/config"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
 	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
@@ -66,8 +67,11 @@ func StartPaymentServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	paymentService := NewPaymentService(log, dbService, redisService, uc)
+	exchangeRateService := NewExchangeRateService(log, dbService, redisService, uc)
 
 	lis, err := net.Listen("tcp", grpcServerOpt.GrpcPaymentServerPort)
 	if err != nil {
@@ -77,6 +81,7 @@ func StartPaymentServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.
 
 	srv := grpc.NewServer(srvOpts...)
 	paymentproto.RegisterPaymentServiceServer(srv, paymentService)
+	paymentproto.RegisterExchangeRateServiceServer(srv, exchangeRateService)
 
 	if err := srv.Serve(lis); err != nil {
 		log.Error("Error", zap.Error(err))
@@ -89,6 +94,7 @@ const insertPaymentSQL = `insert into  payments
     uuid4,
     p_id,
     paid_amount,
+    currency_code,
     instruction_id,
     payment_mean_id,
     received_date,
@@ -101,6 +107,7 @@ const insertPaymentSQL = `insert into  payments
   values (:uuid4,
 :p_id,
 :paid_amount,
+:currency_code,
 :instruction_id,
 :payment_mean_id,
 :received_date,
@@ -118,6 +125,7 @@ const insertPaymentMandateSQL = `insert into payment_mandates
     mandate_type_code,
     maximum_payment_instructions_numeric,
     maximum_paid_amount,
+    currency_code,
     signature_id,
     payer_party_id,
     payer_financial_account_id,
@@ -136,6 +144,7 @@ const insertPaymentMandateSQL = `insert into payment_mandates
 :mandate_type_code,
 :maximum_payment_instructions_numeric,
 :maximum_paid_amount,
+:currency_code,
 :signature_id,
 :payer_party_id,
 :payer_financial_account_id,
@@ -202,6 +211,7 @@ const insertPaymentTermSQL = `insert into payment_terms
       amount,
       settlement_discount_amount,
       penalty_amount,
+      currency_code,
       payment_terms_details_uri,
       exchange_rate,
       payment_due_date,
@@ -212,6 +222,8 @@ const insertPaymentTermSQL = `insert into payment_terms
       penalty_period_end_date,
       validity_period_start_date,
       validity_period_end_date,
+      master_flag,
+      master_id,
       status_code,
       created_by_user_id,
       updated_by_user_id,
@@ -228,6 +240,7 @@ const insertPaymentTermSQL = `insert into payment_terms
 :amount,
 :settlement_discount_amount,
 :penalty_amount,
+:currency_code,
 :payment_terms_details_uri,
 :exchange_rate,
 :payment_due_date,
@@ -238,6 +251,8 @@ const insertPaymentTermSQL = `insert into payment_terms
 :penalty_period_end_date,
 :validity_period_start_date,
 :validity_period_end_date,
+:master_flag,
+:master_id,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -270,6 +285,10 @@ const insertPaymentMandateClauseContentSQL = `insert into payment_mandate_clause
 
 // CreatePayment - Create Payment
 func (ps *PaymentService) CreatePayment(ctx context.Context, in *paymentproto.CreatePaymentRequest) (*paymentproto.CreatePaymentResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ps.UserServiceClient)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -299,6 +318,7 @@ func (ps *PaymentService) CreatePayment(ctx context.Context, in *paymentproto.Cr
 	}
 	paymentD.PId = in.PId
 	paymentD.PaidAmount = in.PaidAmount
+	paymentD.CurrencyCode = in.CurrencyCode
 	paymentD.InstructionId = in.InstructionId
 	paymentD.PaymentMeanId = in.PaymentMeanId
 
@@ -381,6 +401,10 @@ func (ps *PaymentService) crPaymentStruct(ctx context.Context, payment *paymentp
 
 // CreatePaymentTerm - Create PaymentTerm
 func (ps *PaymentService) CreatePaymentTerm(ctx context.Context, in *paymentproto.CreatePaymentTermRequest) (*paymentproto.CreatePaymentTermResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ps.UserServiceClient)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -454,8 +478,11 @@ func (ps *PaymentService) CreatePaymentTerm(ctx context.Context, in *paymentprot
 	paymentTermD.Amount = in.Amount
 	paymentTermD.SettlementDiscountAmount = in.SettlementDiscountAmount
 	paymentTermD.PenaltyAmount = in.PenaltyAmount
+	paymentTermD.CurrencyCode = in.CurrencyCode
 	paymentTermD.PaymentTermsDetailsURI = in.PaymentTermsDetailsURI
 	paymentTermD.ExchangeRate = in.ExchangeRate
+	paymentTermD.MasterFlag = in.MasterFlag
+	paymentTermD.MasterId = in.MasterId
 
 	paymentTermT := paymentproto.PaymentTermT{}
 	paymentTermT.PaymentDueDate = common.TimeToTimestamp(paymentDueDate.UTC().Truncate(time.Second))
@@ -490,6 +517,22 @@ func (ps *PaymentService) CreatePaymentTerm(ctx context.Context, in *paymentprot
 	return &paymentTermResponse, nil
 }
 
+// CreateUBLPaymentTerms - Create the PaymentTerms of an imported document
+func (ps *PaymentService) CreateUBLPaymentTerms(ctx context.Context, paymentTerms []*paymentproto.CreatePaymentTermRequest, masterFlag string, masterID uint32, userID string, userEmail string, requestID string) error {
+	for _, paymentTerm := range paymentTerms {
+		paymentTerm.MasterFlag = masterFlag
+		paymentTerm.MasterId = masterID
+		paymentTerm.UserId = userID
+		paymentTerm.UserEmail = userEmail
+		paymentTerm.RequestId = requestID
+		_, err := ps.CreatePaymentTerm(ctx, paymentTerm)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // insertPaymentTerm - Insert PaymentTerm details into database
 func (ps *PaymentService) insertPaymentTerm(ctx context.Context, insertPaymentTermSQL string, paymentTerm *paymentproto.PaymentTerm, userEmail string, requestID string) error {
 	paymentTermTmp, err := ps.crPaymentTermStruct(ctx, paymentTerm, userEmail, requestID)
@@ -551,6 +594,10 @@ func (ps *PaymentService) crPaymentTermStruct(ctx context.Context, paymentTerm *
 
 // CreatePaymentMandate - Create PaymentMandate
 func (ps *PaymentService) CreatePaymentMandate(ctx context.Context, in *paymentproto.CreatePaymentMandateRequest) (*paymentproto.CreatePaymentMandateResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ps.UserServiceClient)
 	if err != nil {
 		ps.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -594,6 +641,7 @@ func (ps *PaymentService) CreatePaymentMandate(ctx context.Context, in *paymentp
 	paymentMandateD.MandateTypeCode = in.MandateTypeCode
 	paymentMandateD.MaximumPaymentInstructionsNumeric = in.MaximumPaymentInstructionsNumeric
 	paymentMandateD.MaximumPaidAmount = in.MaximumPaidAmount
+	paymentMandateD.CurrencyCode = in.CurrencyCode
 	paymentMandateD.SignatureId = in.SignatureId
 	paymentMandateD.PayerPartyId = in.PayerPartyId
 	paymentMandateD.PayerFinancialAccountId = in.PayerPartyId
@@ -7,9 +7,11 @@ import (
 
 	"github.com/cloudfresco/sc-ubl/internal/common"
 	"github.com/cloudfresco/sc-ubl/internal/config"
+	"github.com/cloudfresco/sc-ubl/internal/money"
 	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
 	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
 	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
 	partyservice "github.com/cloudfresco/sc-ubl/internal/services/partyservices"
 	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
 	taxstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/tax/v1"
@@ -65,6 +67,8 @@ func StartTaxServer(log *zap.Logger, isTest bool, pwd string, dbOpt *config.DBOp
 
 	srvOpts = append(srvOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(common.IdempotencyInterceptor(log, redisService), common.RecoveryInterceptor(log)))
+
 	uc := partyproto.NewUserServiceClient(userConn)
 	taxService := NewTaxService(log, dbService, redisService, uc)
 	lis, err := net.Listen("tcp", grpcServerOpt.GrpcTaxServerPort)
@@ -90,11 +94,14 @@ const selectTaxCategoriesSQL = `select
   percent,
   base_unit_measure,
   per_unit_amount,
+  currency_code,
   tax_exemption_reason_code,
   tax_exemption_reason,
   tier_range,
   tier_rate_percent,
   tax_scheme_id,
+  calculation_sequence_numeric,
+  withholding_indicator,
   status_code,
   created_by_user_id,
   updated_by_user_id,
@@ -109,11 +116,14 @@ tax_category_name,
 percent,
 base_unit_measure,
 per_unit_amount,
+currency_code,
 tax_exemption_reason_code,
 tax_exemption_reason,
 tier_range,
 tier_rate_percent,
 tax_scheme_id,
+calculation_sequence_numeric,
+withholding_indicator,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -125,11 +135,14 @@ updated_at)
 :percent,
 :base_unit_measure,
 :per_unit_amount,
+:currency_code,
 :tax_exemption_reason_code,
 :tax_exemption_reason,
 :tier_range,
 :tier_rate_percent,
 :tax_scheme_id,
+:calculation_sequence_numeric,
+:withholding_indicator,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -142,6 +155,7 @@ const updateTaxCategorySQL = `update tax_categories set
   percent = ?,
   base_unit_measure = ?,
   per_unit_amount = ?,
+  currency_code = ?,
   tax_exemption_reason_code = ?,
   tax_exemption_reason = ?,
   updated_at = ? where uuid4 = ?;`
@@ -153,6 +167,7 @@ const selectTaxSchemesSQL = `select
   tax_scheme_name,
   tax_type_code,
   currency_code,
+  rounding_level,
   status_code,
   created_by_user_id,
   updated_by_user_id,
@@ -166,6 +181,7 @@ ts_id,
 tax_scheme_name,
 tax_type_code,
 currency_code,
+rounding_level,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -176,6 +192,7 @@ updated_at)
 :tax_scheme_name,
 :tax_type_code,
 :currency_code,
+:rounding_level,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -187,6 +204,7 @@ const updateTaxSchemeSQL = `update tax_schemes set
   tax_scheme_name = ?,
   tax_type_code = ?,
   currency_code = ?,
+  rounding_level = ?,
   updated_at = ? where uuid4 = ?;`
 
 const insertTaxSchemeJurisdictionSQL = `insert into tax_scheme_jurisdictions
@@ -226,6 +244,8 @@ transaction_currency_tax_amount,
 percent,
 base_unit_measure,
 per_unit_amount,
+currency_code,
+transaction_currency_code,
 tier_range,
 tier_rate_percent,
 tax_category_id,
@@ -243,6 +263,8 @@ updated_at)
 :percent,
 :base_unit_measure,
 :per_unit_amount,
+:currency_code,
+:transaction_currency_code,
 :tier_range,
 :tier_rate_percent,
 :tax_category_id,
@@ -262,6 +284,8 @@ const updateTaxSubTotalSQL = `update tax_sub_totals set
   percent = ?,
   base_unit_measure = ?,
   per_unit_amount = ?,
+  currency_code = ?,
+  transaction_currency_code = ?,
   updated_at = ? where uuid4 = ?;`
 
 const selectTaxTotalsSQL = `select 
@@ -274,6 +298,8 @@ const selectTaxTotalsSQL = `select
   master_flag,
   master_id,
   tax_category_id,
+  currency_code,
+  withholding_indicator,
   status_code,
   created_by_user_id,
   updated_by_user_id,
@@ -289,6 +315,8 @@ tax_included_indicator,
 master_flag,
 master_id,
 tax_category_id,
+currency_code,
+withholding_indicator,
 status_code,
 created_by_user_id,
 updated_by_user_id,
@@ -302,6 +330,8 @@ updated_at)
 :master_flag,
 :master_id,
 :tax_category_id,
+:currency_code,
+:withholding_indicator,
 :status_code,
 :created_by_user_id,
 :updated_by_user_id,
@@ -420,6 +450,11 @@ func (ts *TaxService) getTaxSchemeStruct(ctx context.Context, in *commonproto.Ge
 
 // CreateTaxScheme - Create TaxScheme
 func (ts *TaxService) CreateTaxScheme(ctx context.Context, in *taxproto.CreateTaxSchemeRequest) (*taxproto.CreateTaxSchemeResponse, error) {
+	roundingLevel, err := rounding.ParseLevel(in.RoundingLevel)
+	if err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ts.UserServiceClient)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -439,6 +474,7 @@ func (ts *TaxService) CreateTaxScheme(ctx context.Context, in *taxproto.CreateTa
 	taxSchemeD.TaxSchemeName = in.TaxSchemeName
 	taxSchemeD.TaxTypeCode = in.TaxTypeCode
 	taxSchemeD.CurrencyCode = in.CurrencyCode
+	taxSchemeD.RoundingLevel = string(roundingLevel)
 
 	crUpdUser := commonproto.CrUpdUser{}
 	crUpdUser.StatusCode = "active"
@@ -516,6 +552,12 @@ func (ts *TaxService) UpdateTaxScheme(ctx context.Context, in *taxproto.UpdateTa
 	db := ts.DBService.DB
 	tn := common.GetTimeDetails()
 
+	roundingLevel, err := rounding.ParseLevel(in.RoundingLevel)
+	if err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4byte, err := common.UUIDStrToBytes(in.Id)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -533,6 +575,7 @@ func (ts *TaxService) UpdateTaxScheme(ctx context.Context, in *taxproto.UpdateTa
 			in.TaxSchemeName,
 			in.TaxTypeCode,
 			in.CurrencyCode,
+			string(roundingLevel),
 			tn,
 			uuid4byte)
 		if err != nil {
@@ -594,6 +637,11 @@ func (ts *TaxService) getTaxCategoryStruct(ctx context.Context, in *commonproto.
 	crUpdTime.CreatedAt = common.TimeToTimestamp(taxCategoryTmp.CrUpdTime.CreatedAt)
 	crUpdTime.UpdatedAt = common.TimeToTimestamp(taxCategoryTmp.CrUpdTime.UpdatedAt)
 
+	if err := money.Normalize(taxCategoryTmp.TaxCategoryD); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(taxCategoryTmp.TaxCategoryD.Uuid4)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -609,6 +657,10 @@ func (ts *TaxService) getTaxCategoryStruct(ctx context.Context, in *commonproto.
 
 // CreateTaxCategory - Create TaxCategory
 func (ts *TaxService) CreateTaxCategory(ctx context.Context, in *taxproto.CreateTaxCategoryRequest) (*taxproto.CreateTaxCategoryResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ts.UserServiceClient)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -643,11 +695,14 @@ func (ts *TaxService) CreateTaxCategory(ctx context.Context, in *taxproto.Create
 	taxCategoryD.Percent = in.Percent
 	taxCategoryD.BaseUnitMeasure = in.BaseUnitMeasure
 	taxCategoryD.PerUnitAmount = in.PerUnitAmount
+	taxCategoryD.CurrencyCode = in.CurrencyCode
 	taxCategoryD.TaxExemptionReasonCode = in.TaxExemptionReasonCode
 	taxCategoryD.TaxExemptionReason = in.TaxExemptionReason
 	taxCategoryD.TierRange = in.TierRange
 	taxCategoryD.TierRatePercent = in.TierRatePercent
 	taxCategoryD.TaxSchemeId = taxScheme.TaxSchemeD.Id
+	taxCategoryD.CalculationSequenceNumeric = in.CalculationSequenceNumeric
+	taxCategoryD.WithholdingIndicator = in.WithholdingIndicator
 
 	crUpdUser := commonproto.CrUpdUser{}
 	crUpdUser.StatusCode = "active"
@@ -721,6 +776,10 @@ func (ts *TaxService) crTaxCategoryStruct(ctx context.Context, taxCategory *taxp
 
 // UpdateTaxCategory - Update TaxCategory
 func (ts *TaxService) UpdateTaxCategory(ctx context.Context, in *taxproto.UpdateTaxCategoryRequest) (*taxproto.UpdateTaxCategoryResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	db := ts.DBService.DB
 	tn := common.GetTimeDetails()
 
@@ -742,6 +801,7 @@ func (ts *TaxService) UpdateTaxCategory(ctx context.Context, in *taxproto.Update
 			in.Percent,
 			in.BaseUnitMeasure,
 			in.PerUnitAmount,
+			in.CurrencyCode,
 			in.TaxExemptionReasonCode,
 			in.TaxExemptionReason,
 			tn,
@@ -999,6 +1059,11 @@ func (ts *TaxService) getTaxTotalStruct(ctx context.Context, in *commonproto.Get
 	crUpdTime.CreatedAt = common.TimeToTimestamp(taxTotalTmp.CrUpdTime.CreatedAt)
 	crUpdTime.UpdatedAt = common.TimeToTimestamp(taxTotalTmp.CrUpdTime.UpdatedAt)
 
+	if err := money.Normalize(taxTotalTmp.TaxTotalD); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
+
 	uuid4Str, err := common.UUIDBytesToStr(taxTotalTmp.TaxTotalD.Uuid4)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -1014,6 +1079,10 @@ func (ts *TaxService) getTaxTotalStruct(ctx context.Context, in *commonproto.Get
 
 // CreateTaxTotal - Create TaxTotal
 func (ts *TaxService) CreateTaxTotal(ctx context.Context, in *taxproto.CreateTaxTotalRequest) (*taxproto.CreateTaxTotalResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ts.UserServiceClient)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -1049,6 +1118,8 @@ func (ts *TaxService) CreateTaxTotal(ctx context.Context, in *taxproto.CreateTax
 	taxTotalD.MasterFlag = in.MasterFlag
 	taxTotalD.MasterId = in.MasterId
 	taxTotalD.TaxCategoryId = taxCategory.TaxCategoryD.Id
+	taxTotalD.CurrencyCode = in.CurrencyCode
+	taxTotalD.WithholdingIndicator = in.WithholdingIndicator
 
 	crUpdUser := commonproto.CrUpdUser{}
 	crUpdUser.StatusCode = "active"
@@ -1120,6 +1191,10 @@ func (ts *TaxService) crTaxTotalStruct(ctx context.Context, taxTotal *taxproto.T
 
 // UpdateTaxTotal - Update TaxTotal
 func (ts *TaxService) UpdateTaxTotal(ctx context.Context, in *taxproto.UpdateTaxTotalRequest) (*taxproto.UpdateTaxTotalResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	db := ts.DBService.DB
 	tn := common.GetTimeDetails()
 
@@ -1168,6 +1243,10 @@ func (ts *TaxService) UpdateTaxTotal(ctx context.Context, in *taxproto.UpdateTax
 
 // CreateTaxSubTotal - Create TaxSubTotal
 func (ts *TaxService) CreateTaxSubTotal(ctx context.Context, in *taxproto.CreateTaxSubTotalRequest) (*taxproto.CreateTaxSubTotalResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	user, err := partyservice.GetUserWithNewContext(ctx, in.UserId, in.UserEmail, in.RequestId, ts.UserServiceClient)
 	if err != nil {
 		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
@@ -1204,6 +1283,11 @@ func (ts *TaxService) CreateTaxSubTotal(ctx context.Context, in *taxproto.Create
 	taxSubTotalD.Percent = in.Percent
 	taxSubTotalD.BaseUnitMeasure = in.BaseUnitMeasure
 	taxSubTotalD.PerUnitAmount = in.PerUnitAmount
+	taxSubTotalD.CurrencyCode = in.CurrencyCode
+	if taxSubTotalD.CurrencyCode == "" {
+		taxSubTotalD.CurrencyCode = taxTotal.TaxTotalD.CurrencyCode
+	}
+	taxSubTotalD.TransactionCurrencyCode = in.TransactionCurrencyCode
 	taxSubTotalD.TierRange = in.TierRange
 	taxSubTotalD.TierRatePercent = in.TierRatePercent
 	taxSubTotalD.TaxCategoryId = in.TaxCategoryId
@@ -1283,6 +1367,10 @@ func (ts *TaxService) crTaxSubTotalStruct(ctx context.Context, taxSubTotal *taxp
 
 // UpdateTaxSubTotal - Update TaxSubTotal
 func (ts *TaxService) UpdateTaxSubTotal(ctx context.Context, in *taxproto.UpdateTaxSubTotalRequest) (*taxproto.UpdateTaxSubTotalResponse, error) {
+	if err := money.Normalize(in); err != nil {
+		ts.log.Error("Error", zap.String("user", in.GetUserEmail()), zap.String("reqid", in.GetRequestId()), zap.Error(err))
+		return nil, err
+	}
 	db := ts.DBService.DB
 	tn := common.GetTimeDetails()
 
@@ -1307,6 +1395,8 @@ func (ts *TaxService) UpdateTaxSubTotal(ctx context.Context, in *taxproto.Update
 			in.Percent,
 			in.BaseUnitMeasure,
 			in.PerUnitAmount,
+			in.CurrencyCode,
+			in.TransactionCurrencyCode,
 			tn,
 			uuid4byte)
 		if err != nil {
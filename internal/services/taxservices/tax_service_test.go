@@ -273,7 +273,7 @@ func TestTaxService_CreateTaxCategory(t *testing.T) {
 	taxCategory.TaxCategoryName = "TaxCategory"
 	taxCategory.Percent = float32(20)
 	taxCategory.BaseUnitMeasure = "EUR"
-	taxCategory.PerUnitAmount = float64(10)
+	taxCategory.PerUnitAmount = "10"
 	taxCategory.TaxExemptionReasonCode = ""
 	taxCategory.TaxExemptionReason = ""
 	taxCategory.TierRange = ""
@@ -310,7 +310,7 @@ func TestTaxService_CreateTaxCategory(t *testing.T) {
 		assert.NotNil(t, taxCategoryResp)
 		taxCategoryResult := taxCategoryResp.TaxCategory
 		assert.Equal(t, taxCategoryResult.TaxCategoryD.Percent, float32(20), "they should be equal")
-		assert.Equal(t, taxCategoryResult.TaxCategoryD.PerUnitAmount, float64(10), "they should be equal")
+		assert.Equal(t, taxCategoryResult.TaxCategoryD.PerUnitAmount, "10.00", "they should be equal")
 	}
 }
 
@@ -329,7 +329,7 @@ func TestTaxService_UpdateTaxCategory(t *testing.T) {
 	taxCategory.TaxCategoryName = "TaxCategory1"
 	taxCategory.Percent = float32(10)
 	taxCategory.BaseUnitMeasure = "EUR"
-	taxCategory.PerUnitAmount = float64(10)
+	taxCategory.PerUnitAmount = "10"
 	taxCategory.TaxExemptionReasonCode = ""
 	taxCategory.TaxExemptionReason = ""
 	taxCategory.Id = "7036e24c-0ec5-48ac-bc97-4c2cbe75a54c"
@@ -384,8 +384,8 @@ func TestTaxService_CreateTaxTotal(t *testing.T) {
 	taxService := NewTaxService(log, dbService, redisService, userServiceClient)
 
 	taxTotal := taxproto.CreateTaxTotalRequest{}
-	taxTotal.TaxAmount = float64(17.5)
-	taxTotal.RoundingAmount = float64(18)
+	taxTotal.TaxAmount = "17.5"
+	taxTotal.RoundingAmount = "18"
 	taxTotal.TaxEvidenceIndicator = false
 	taxTotal.TaxIncludedIndicator = false
 	taxTotal.MasterFlag = "CNL"
@@ -422,7 +422,7 @@ func TestTaxService_CreateTaxTotal(t *testing.T) {
 		}
 		assert.NotNil(t, taxTotalResp)
 		taxTotalResult := taxTotalResp.TaxTotal
-		assert.Equal(t, taxTotalResult.TaxTotalD.TaxAmount, float64(17.5), "they should be equal")
+		assert.Equal(t, taxTotalResult.TaxTotalD.TaxAmount, "17.50", "they should be equal")
 		assert.False(t, taxTotalResult.TaxTotalD.TaxEvidenceIndicator, "Its False")
 		assert.Equal(t, taxTotalResult.TaxTotalD.MasterFlag, "CNL", "they should be equal")
 	}
@@ -440,8 +440,8 @@ func TestTaxService_UpdateTaxTotal(t *testing.T) {
 	taxService := NewTaxService(log, dbService, redisService, userServiceClient)
 
 	taxTotal := taxproto.UpdateTaxTotalRequest{}
-	taxTotal.TaxAmount = float64(18.5)
-	taxTotal.RoundingAmount = float64(19)
+	taxTotal.TaxAmount = "18.5"
+	taxTotal.RoundingAmount = "19"
 	taxTotal.Id = "a62007af-a514-45a5-967d-270ad8c34f91"
 	taxTotal.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	taxTotal.UserEmail = "sprov300@gmail.com"
@@ -492,13 +492,13 @@ func TestTaxService_CreateTaxSubTotal(t *testing.T) {
 	taxService := NewTaxService(log, dbService, redisService, userServiceClient)
 
 	taxSubTotal := taxproto.CreateTaxSubTotalRequest{}
-	taxSubTotal.TaxableAmount = float64(100)
-	taxSubTotal.TaxAmount = float64(17.5)
+	taxSubTotal.TaxableAmount = "100"
+	taxSubTotal.TaxAmount = "17.5"
 	taxSubTotal.CalculationSequenceNumeric = uint32(0)
-	taxSubTotal.TransactionCurrencyTaxAmount = float64(0)
+	taxSubTotal.TransactionCurrencyTaxAmount = "0"
 	taxSubTotal.Percent = float32(10)
 	taxSubTotal.BaseUnitMeasure = "EUR"
-	taxSubTotal.PerUnitAmount = float64(10)
+	taxSubTotal.PerUnitAmount = "10"
 	taxSubTotal.TierRange = ""
 	taxSubTotal.TierRatePercent = float64(10)
 	taxSubTotal.TaxCategoryId = uint32(1)
@@ -533,8 +533,8 @@ func TestTaxService_CreateTaxSubTotal(t *testing.T) {
 		}
 		assert.NotNil(t, taxSubTotalResp)
 		taxSubTotalResult := taxSubTotalResp.TaxSubTotal
-		assert.Equal(t, taxSubTotalResult.TaxSubTotalD.TaxableAmount, float64(100), "they should be equal")
-		assert.Equal(t, taxSubTotalResult.TaxSubTotalD.TaxAmount, float64(17.5), "they should be equal")
+		assert.Equal(t, taxSubTotalResult.TaxSubTotalD.TaxableAmount, "100.00", "they should be equal")
+		assert.Equal(t, taxSubTotalResult.TaxSubTotalD.TaxAmount, "17.50", "they should be equal")
 	}
 }
 
@@ -550,13 +550,13 @@ func TestTaxService_UpdateTaxSubTotal(t *testing.T) {
 	taxService := NewTaxService(log, dbService, redisService, userServiceClient)
 
 	taxSubTotal := taxproto.UpdateTaxSubTotalRequest{}
-	taxSubTotal.TaxableAmount = float64(200)
-	taxSubTotal.TaxAmount = float64(18.5)
+	taxSubTotal.TaxableAmount = "200"
+	taxSubTotal.TaxAmount = "18.5"
 	taxSubTotal.CalculationSequenceNumeric = uint32(0)
-	taxSubTotal.TransactionCurrencyTaxAmount = float64(0)
+	taxSubTotal.TransactionCurrencyTaxAmount = "0"
 	taxSubTotal.Percent = float32(15)
 	taxSubTotal.BaseUnitMeasure = "EUR"
-	taxSubTotal.PerUnitAmount = float64(5)
+	taxSubTotal.PerUnitAmount = "5"
 	taxSubTotal.Id = "f7da0aaa-8068-495c-9176-b7681e651226"
 	taxSubTotal.UserId = "auth0|673c75d516e8adb9e6ffc892"
 	taxSubTotal.UserEmail = "sprov300@gmail.com"
@@ -34,3 +34,17 @@ type PartyLegalEntityT struct {
 	RegistrationDate           time.Time `protobuf:"bytes,1,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	RegistrationExpirationDate time.Time `protobuf:"bytes,2,opt,name=registration_expiration_date,json=registrationExpirationDate,proto3" json:"registration_expiration_date,omitempty"`
 }
+
+// AllowanceCharge - struct AllowanceCharge
+type AllowanceCharge struct {
+	*commonproto.AllowanceChargeD
+	*commonproto.CrUpdUser
+	*CrUpdTime
+}
+
+// DocumentVersion - struct DocumentVersion
+type DocumentVersion struct {
+	*commonproto.DocumentVersionD
+	*commonproto.CrUpdUser
+	*CrUpdTime
+}
@@ -45,3 +45,17 @@ type InvoiceLineT struct {
 	PriceValidityPeriodStartDate time.Time `protobuf:"bytes,4,opt,name=price_validity_period_start_date,json=priceValidityPeriodStartDate,proto3" json:"price_validity_period_start_date,omitempty"`
 	PriceValidityPeriodEndDate   time.Time `protobuf:"bytes,5,opt,name=price_validity_period_end_date,json=priceValidityPeriodEndDate,proto3" json:"price_validity_period_end_date,omitempty"`
 }
+
+// InvoicePrepayment - struct InvoicePrepayment
+type InvoicePrepayment struct {
+	*invoiceproto.InvoicePrepaymentD
+	*commonproto.CrUpdUser
+	*commonstruct.CrUpdTime
+}
+
+// DocumentStatusTransition - struct DocumentStatusTransition
+type DocumentStatusTransition struct {
+	*invoiceproto.DocumentStatusTransitionD
+	*commonproto.CrUpdUser
+	*commonstruct.CrUpdTime
+}
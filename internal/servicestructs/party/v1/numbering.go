@@ -0,0 +1,21 @@
+package v1
+
+import (
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+)
+
+// DocumentNumberSequence - struct DocumentNumberSequence
+type DocumentNumberSequence struct {
+	*partyproto.DocumentNumberSequenceD
+	*commonproto.CrUpdUser
+	*commonstruct.CrUpdTime
+}
+
+// VoidedDocumentNumber - struct VoidedDocumentNumber
+type VoidedDocumentNumber struct {
+	*partyproto.VoidedDocumentNumberD
+	*commonproto.CrUpdUser
+	*commonstruct.CrUpdTime
+}
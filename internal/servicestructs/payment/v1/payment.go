@@ -77,3 +77,16 @@ type PaymentMandateClauseContent struct {
 	*commonproto.CrUpdUser
 	*commonstruct.CrUpdTime
 }
+
+// ExchangeRate - struct ExchangeRate
+type ExchangeRate struct {
+	*paymentproto.ExchangeRateD
+	*ExchangeRateT
+	*commonproto.CrUpdUser
+	*commonstruct.CrUpdTime
+}
+
+// ExchangeRateT - struct ExchangeRateT
+type ExchangeRateT struct {
+	RateDate time.Time `protobuf:"bytes,1,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+}
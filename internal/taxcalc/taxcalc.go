@@ -0,0 +1,292 @@
+// Package taxcalc computes the tax breakdown of a document, a sub total per
+// tax category, from the net amounts and quantities of its lines and the tax
+// categories that apply to each line.
+//
+// A category taxes its lines in one of three ways:
+//
+//   - with a PerUnitAmount, that amount per BaseUnitMeasure of the quantity,
+//     such as 0.65 per litre of fuel or 0.10 per item as an environmental
+//     fee;
+//   - with a TierRange, each tier of the taxable amount at its own percent,
+//     as progressive excise duties are;
+//   - else its Percent of the taxable amount.
+//
+// Categories with a CalculationSequenceNumeric are applied in that order,
+// and the taxable amount of a line in such a category includes the tax of
+// the categories of the line with a lower one, so VAT is charged on an
+// excise duty. Categories without one are charged on the net amount only
+// and are not part of the taxable amount of other categories.
+//
+// Amounts are rounded with the rounding.Policy of the document currency.
+// The tax of a category with the rounding.Line level is rounded on each
+// line and summed up, else it is rounded once on the taxable amount of all
+// lines. Tiers always apply to the taxable amount of all lines.
+package taxcalc
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+)
+
+// Category - a tax category, as UBL TaxCategory
+//
+// BaseUnitMeasure is a unit code with an optional quantity before it, such
+// as "LTR" or "1000 LTR" for an amount per 1000 litres.
+//
+// TierRange lists the tiers of the taxable amount separated by ";", each
+// "lower-upper:percent" with upper left out for the last tier, such as
+// "0-1000:0;1000-5000:10;5000-:20". A tier without ":percent" is taxed at
+// TierRatePercent, the part of the taxable amount outside all tiers at
+// Percent.
+type Category struct {
+	ID                         uint32
+	Percent                    float64
+	BaseUnitMeasure            string
+	PerUnitAmount              money.Amount
+	TierRange                  string
+	TierRatePercent            float64
+	CalculationSequenceNumeric uint32
+	RoundingLevel              rounding.Level
+}
+
+// FromProto - the Category of a TaxCategoryD whose amounts are normalized,
+// its percentages rounded to six decimals, as they are stored as floats,
+// with the rounding level of its tax scheme, nil when it has none
+func FromProto(d *taxproto.TaxCategoryD, scheme *taxproto.TaxSchemeD) *Category {
+	c := Category{
+		ID:                         d.Id,
+		Percent:                    math.Round(float64(d.Percent)*1e6) / 1e6,
+		BaseUnitMeasure:            d.BaseUnitMeasure,
+		TierRange:                  d.TierRange,
+		TierRatePercent:            math.Round(float64(d.TierRatePercent)*1e6) / 1e6,
+		CalculationSequenceNumeric: d.CalculationSequenceNumeric,
+	}
+	c.PerUnitAmount, _ = money.Parse(d.PerUnitAmount)
+	c.RoundingLevel = rounding.Document
+	if scheme != nil {
+		c.RoundingLevel, _ = rounding.ParseLevel(scheme.RoundingLevel)
+	}
+	return &c
+}
+
+// Line - the quantity and net amount of a document line and the tax
+// categories that apply to it
+type Line struct {
+	Quantity   float64
+	NetAmount  money.Amount
+	Categories []*Category
+}
+
+// SubTotal - the taxable and tax amount of a tax category, as UBL
+// TaxSubTotal, and RoundingAmount, what rounding added to its tax
+type SubTotal struct {
+	Category       *Category
+	TaxableAmount  money.Amount
+	TaxAmount      money.Amount
+	RoundingAmount money.Amount
+}
+
+// tier - a tier of a TierRange, upper is 0 for the last one
+type tier struct {
+	lower   money.Amount
+	upper   money.Amount
+	percent float64
+}
+
+// hundred - the Amount 100
+var hundred = money.New(100, 0)
+
+// Calculate - a SubTotal per tax category of the lines, by calculation
+// sequence and then in the order the categories first appear, its amounts
+// rounded with policy
+func Calculate(lines []Line, policy rounding.Policy) ([]*SubTotal, error) {
+	type lineShare struct {
+		line     int
+		taxable  money.Amount
+		quantity money.Amount
+	}
+	type group struct {
+		subTotal *SubTotal
+		shares   []lineShare
+	}
+
+	groups := []*group{}
+	groupByID := make(map[uint32]*group)
+	for i, line := range lines {
+		for _, c := range line.Categories {
+			g := groupByID[c.ID]
+			if g == nil {
+				g = &group{subTotal: &SubTotal{Category: c}}
+				groupByID[c.ID] = g
+				groups = append(groups, g)
+			}
+			g.shares = append(g.shares, lineShare{line: i, quantity: money.FromFloat(line.Quantity)})
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].subTotal.Category.CalculationSequenceNumeric < groups[j].subTotal.Category.CalculationSequenceNumeric
+	})
+
+	// lineTaxes - the taxes of each line by calculation sequence, what the
+	// categories with a higher one add to its taxable amount
+	lineTaxes := make([]map[uint32]money.Amount, len(lines))
+	for i := range lineTaxes {
+		lineTaxes[i] = make(map[uint32]money.Amount)
+	}
+
+	subTotals := []*SubTotal{}
+	for _, g := range groups {
+		c := g.subTotal.Category
+		taxable, quantity := money.Zero, money.Zero
+		for k := range g.shares {
+			share := &g.shares[k]
+			share.taxable = lines[share.line].NetAmount
+			if c.CalculationSequenceNumeric > 0 {
+				for sequence, tax := range lineTaxes[share.line] {
+					if sequence < c.CalculationSequenceNumeric {
+						share.taxable = share.taxable.Add(tax)
+					}
+				}
+			}
+			taxable = taxable.Add(share.taxable)
+			quantity = quantity.Add(share.quantity)
+		}
+
+		if c.RoundingLevel == rounding.Line && c.TierRange == "" {
+			exact := money.Zero
+			for _, share := range g.shares {
+				lineTax, err := c.Tax(policy.Round(share.taxable), share.quantity)
+				if err != nil {
+					return nil, err
+				}
+				exact = exact.Add(lineTax)
+				g.subTotal.TaxableAmount = g.subTotal.TaxableAmount.Add(policy.Round(share.taxable))
+				g.subTotal.TaxAmount = g.subTotal.TaxAmount.Add(policy.Round(lineTax))
+				if c.CalculationSequenceNumeric > 0 {
+					lineTaxes[share.line][c.CalculationSequenceNumeric] = lineTaxes[share.line][c.CalculationSequenceNumeric].Add(policy.Round(lineTax))
+				}
+			}
+			g.subTotal.RoundingAmount = g.subTotal.TaxAmount.Sub(exact)
+			subTotals = append(subTotals, g.subTotal)
+			continue
+		}
+
+		g.subTotal.TaxableAmount = policy.Round(taxable)
+		exact, err := c.Tax(g.subTotal.TaxableAmount, quantity)
+		if err != nil {
+			return nil, err
+		}
+		tax := policy.Round(exact)
+		g.subTotal.TaxAmount = tax
+		g.subTotal.RoundingAmount = tax.Sub(exact)
+		subTotals = append(subTotals, g.subTotal)
+
+		if c.CalculationSequenceNumeric == 0 {
+			continue
+		}
+		// the tax of each line in proportion to its part of the taxable
+		// amount, or of the quantity for per unit amounts
+		for _, share := range g.shares {
+			part, whole := share.taxable, taxable
+			if c.PerUnitAmount != money.Zero {
+				part, whole = share.quantity, quantity
+			}
+			lineTaxes[share.line][c.CalculationSequenceNumeric] = lineTaxes[share.line][c.CalculationSequenceNumeric].Add(tax.Mul(part).Div(whole))
+		}
+	}
+	return subTotals, nil
+}
+
+// Tax - the tax of the category on a taxable amount and quantity, not
+// rounded
+func (c *Category) Tax(taxableAmount money.Amount, quantity money.Amount) (money.Amount, error) {
+	if c.PerUnitAmount != money.Zero {
+		baseQuantity, err := c.baseQuantity()
+		if err != nil {
+			return money.Zero, err
+		}
+		return quantity.Mul(c.PerUnitAmount).Div(baseQuantity), nil
+	}
+	if c.TierRange == "" {
+		return percentOf(taxableAmount, c.Percent), nil
+	}
+
+	tiers, err := c.tiers()
+	if err != nil {
+		return money.Zero, err
+	}
+	amount := taxableAmount.Abs()
+	tax, tiered := money.Zero, money.Zero
+	for _, t := range tiers {
+		if amount <= t.lower {
+			continue
+		}
+		top := amount
+		if t.upper != money.Zero && t.upper < top {
+			top = t.upper
+		}
+		tax = tax.Add(percentOf(top.Sub(t.lower), t.percent))
+		tiered = tiered.Add(top.Sub(t.lower))
+	}
+	tax = tax.Add(percentOf(amount.Sub(tiered), c.Percent))
+	if taxableAmount < 0 {
+		tax = tax.Neg()
+	}
+	return tax, nil
+}
+
+// baseQuantity - the quantity of the BaseUnitMeasure, 1 when it has none
+func (c *Category) baseQuantity() (money.Amount, error) {
+	fields := strings.Fields(c.BaseUnitMeasure)
+	if len(fields) < 2 {
+		return money.New(1, 0), nil
+	}
+	q, err := money.Parse(fields[0])
+	if err != nil || q <= 0 || len(fields) > 2 {
+		return money.Zero, fmt.Errorf("taxcalc: tax category %d: invalid base unit measure %q", c.ID, c.BaseUnitMeasure)
+	}
+	return q, nil
+}
+
+// tiers - the tiers of the TierRange in ascending order
+func (c *Category) tiers() ([]tier, error) {
+	invalid := fmt.Errorf("taxcalc: tax category %d: invalid tier range %q", c.ID, c.TierRange)
+	tiers := []tier{}
+	for _, s := range strings.Split(c.TierRange, ";") {
+		bounds, rate, hasRate := strings.Cut(strings.TrimSpace(s), ":")
+		lower, upper, ok := strings.Cut(bounds, "-")
+		if !ok {
+			return nil, invalid
+		}
+		t := tier{percent: c.TierRatePercent}
+		var err error
+		if t.lower, err = money.Parse(lower); err != nil || strings.TrimSpace(lower) == "" || t.lower < 0 {
+			return nil, invalid
+		}
+		if t.upper, err = money.Parse(upper); err != nil || strings.TrimSpace(upper) != "" && t.upper <= t.lower {
+			return nil, invalid
+		}
+		if hasRate {
+			if t.percent, err = strconv.ParseFloat(strings.TrimSpace(rate), 64); err != nil {
+				return nil, invalid
+			}
+		}
+		if n := len(tiers); n > 0 && (tiers[n-1].upper == money.Zero || t.lower < tiers[n-1].upper) {
+			return nil, invalid
+		}
+		tiers = append(tiers, t)
+	}
+	return tiers, nil
+}
+
+// percentOf - percent of an amount
+func percentOf(amount money.Amount, percent float64) money.Amount {
+	return amount.Mul(money.FromFloat(percent)).Div(hundred)
+}
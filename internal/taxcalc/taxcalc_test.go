@@ -0,0 +1,108 @@
+package taxcalc
+
+import (
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/rounding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategory_Tax(t *testing.T) {
+	progressive := "0-1000:0;1000-5000:10;5000-:20"
+	tests := []struct {
+		category      Category
+		taxableAmount string
+		quantity      string
+		want          string
+		wantErr       bool
+	}{
+		{category: Category{Percent: 20}, taxableAmount: "123.45", want: "24.69"},
+		{category: Category{BaseUnitMeasure: "LTR", PerUnitAmount: money.MustParse("0.65")}, taxableAmount: "70", quantity: "40", want: "26.00"},
+		{category: Category{BaseUnitMeasure: "1000 LTR", PerUnitAmount: money.MustParse("250")}, taxableAmount: "70", quantity: "40", want: "10.00"},
+		{category: Category{TierRange: progressive}, taxableAmount: "6000", want: "600.00"},
+		{category: Category{TierRange: progressive}, taxableAmount: "800", want: "0.00"},
+		{category: Category{TierRange: progressive}, taxableAmount: "-6000", want: "-600.00"},
+		{category: Category{Percent: 5, TierRange: "1000-", TierRatePercent: 10}, taxableAmount: "3000", want: "250.00"},
+		{category: Category{TierRange: "0-1000:0;500-2000:10"}, taxableAmount: "3000", wantErr: true},
+		{category: Category{TierRange: "1000:10"}, taxableAmount: "3000", wantErr: true},
+		{category: Category{TierRange: "0-1000:ten"}, taxableAmount: "3000", wantErr: true},
+		{category: Category{BaseUnitMeasure: "one LTR", PerUnitAmount: money.MustParse("0.65")}, quantity: "40", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := tt.category.Tax(money.MustParse(tt.taxableAmount), money.MustParse(tt.quantity))
+		if tt.wantErr {
+			assert.Error(t, err, tt.category.TierRange)
+			continue
+		}
+		assert.NoError(t, err, tt.category.TierRange)
+		assert.Equal(t, tt.want, got.String(), tt.category.TierRange)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	fee := &Category{ID: 1, Percent: 10}
+	excise := &Category{ID: 2, BaseUnitMeasure: "LTR", PerUnitAmount: money.MustParse("0.50"), CalculationSequenceNumeric: 1}
+	vat := &Category{ID: 3, Percent: 20, CalculationSequenceNumeric: 2}
+	lines := []Line{
+		{Quantity: 100, NetAmount: money.MustParse("150"), Categories: []*Category{vat, excise}},
+		{Quantity: 1, NetAmount: money.MustParse("50"), Categories: []*Category{vat, fee}},
+	}
+
+	subTotals, err := Calculate(lines, rounding.Default)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(subTotals), "they should be equal")
+	want := []struct {
+		category      *Category
+		taxableAmount string
+		taxAmount     string
+	}{
+		{category: fee, taxableAmount: "50.00", taxAmount: "5.00"},
+		{category: excise, taxableAmount: "150.00", taxAmount: "50.00"},
+		{category: vat, taxableAmount: "250.00", taxAmount: "50.00"},
+	}
+	for i, w := range want {
+		assert.Equal(t, w.category, subTotals[i].Category, "they should be equal")
+		assert.Equal(t, w.taxableAmount, subTotals[i].TaxableAmount.String(), "they should be equal")
+		assert.Equal(t, w.taxAmount, subTotals[i].TaxAmount.String(), "they should be equal")
+	}
+}
+
+func TestCalculate_Rounding(t *testing.T) {
+	tests := []struct {
+		level          rounding.Level
+		policy         rounding.Policy
+		netAmount      string
+		taxableAmount  string
+		taxAmount      string
+		roundingAmount string
+	}{
+		{level: rounding.Document, policy: rounding.Default, netAmount: "1.05", taxableAmount: "3.15", taxAmount: "0.32", roundingAmount: "0.005"},
+		{level: rounding.Line, policy: rounding.Default, netAmount: "1.05", taxableAmount: "3.15", taxAmount: "0.33", roundingAmount: "0.015"},
+		{level: rounding.Document, policy: rounding.Policy{Places: 2, Mode: rounding.HalfEven}, netAmount: "1.05", taxableAmount: "3.15", taxAmount: "0.32", roundingAmount: "0.005"},
+		{level: rounding.Line, policy: rounding.Policy{Places: 2, Mode: rounding.HalfEven}, netAmount: "1.05", taxableAmount: "3.15", taxAmount: "0.30", roundingAmount: "-0.015"},
+		{level: rounding.Document, policy: rounding.ForCurrency("JPY"), netAmount: "411.4", taxableAmount: "1234.00", taxAmount: "123.00", roundingAmount: "-0.40"},
+		{level: rounding.Line, policy: rounding.ForCurrency("JPY"), netAmount: "411.4", taxableAmount: "1233.00", taxAmount: "123.00", roundingAmount: "-0.30"},
+	}
+	for _, tt := range tests {
+		vat := &Category{ID: 1, Percent: 10, RoundingLevel: tt.level}
+		lines := []Line{}
+		for i := 0; i < 3; i++ {
+			lines = append(lines, Line{Quantity: 1, NetAmount: money.MustParse(tt.netAmount), Categories: []*Category{vat}})
+		}
+		subTotals, err := Calculate(lines, tt.policy)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.taxableAmount, subTotals[0].TaxableAmount.String(), tt.level)
+		assert.Equal(t, tt.taxAmount, subTotals[0].TaxAmount.String(), tt.level)
+		assert.Equal(t, tt.roundingAmount, subTotals[0].RoundingAmount.String(), tt.level)
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	d := &taxproto.TaxCategoryD{Id: 7, Percent: 15.5, BaseUnitMeasure: "LTR", PerUnitAmount: "0.65", TierRatePercent: 2.1, CalculationSequenceNumeric: 2}
+	c := FromProto(d, nil)
+	assert.Equal(t, Category{ID: 7, Percent: 15.5, BaseUnitMeasure: "LTR", PerUnitAmount: money.MustParse("0.65"), TierRatePercent: 2.1, CalculationSequenceNumeric: 2, RoundingLevel: rounding.Document}, *c)
+	c = FromProto(d, &taxproto.TaxSchemeD{RoundingLevel: "line"})
+	assert.Equal(t, rounding.Line, c.RoundingLevel)
+}
@@ -0,0 +1,393 @@
+package ubl
+
+// Aggregate components shared by UBL document types. Field order follows
+// the sequence defined in UBL-CommonAggregateComponents-2.3.xsd.
+
+// Period - cac:PeriodType
+type Period struct {
+	StartDate   string `xml:"cbc:StartDate,omitempty"`
+	EndDate     string `xml:"cbc:EndDate,omitempty"`
+	Description string `xml:"cbc:Description,omitempty"`
+}
+
+// Response - cac:ResponseType
+type Response struct {
+	ReferenceID  string   `xml:"cbc:ReferenceID,omitempty"`
+	ResponseCode string   `xml:"cbc:ResponseCode,omitempty"`
+	Description  []string `xml:"cbc:Description,omitempty"`
+}
+
+// Signature - cac:Signature
+type Signature struct {
+	ID string `xml:"cbc:ID"`
+}
+
+// OrderReference - cac:OrderReference
+type OrderReference struct {
+	ID           string `xml:"cbc:ID"`
+	SalesOrderID string `xml:"cbc:SalesOrderID,omitempty"`
+	IssueDate    string `xml:"cbc:IssueDate,omitempty"`
+}
+
+// DocumentReference - cac:DocumentReferenceType
+type DocumentReference struct {
+	ID               string `xml:"cbc:ID"`
+	UUID             string `xml:"cbc:UUID,omitempty"`
+	IssueDate        string `xml:"cbc:IssueDate,omitempty"`
+	DocumentTypeCode string `xml:"cbc:DocumentTypeCode,omitempty"`
+	DocumentType     string `xml:"cbc:DocumentType,omitempty"`
+}
+
+// BillingReference - cac:BillingReference
+type BillingReference struct {
+	InvoiceDocumentReference    *DocumentReference `xml:"cac:InvoiceDocumentReference,omitempty"`
+	CreditNoteDocumentReference *DocumentReference `xml:"cac:CreditNoteDocumentReference,omitempty"`
+	DebitNoteDocumentReference  *DocumentReference `xml:"cac:DebitNoteDocumentReference,omitempty"`
+}
+
+// TransactionConditions - cac:TransactionConditions
+type TransactionConditions struct {
+	ID          string   `xml:"cbc:ID,omitempty"`
+	ActionCode  string   `xml:"cbc:ActionCode,omitempty"`
+	Description []string `xml:"cbc:Description,omitempty"`
+}
+
+// LineReference - cac:LineReferenceType
+type LineReference struct {
+	LineID            string             `xml:"cbc:LineID"`
+	DocumentReference *DocumentReference `xml:"cac:DocumentReference,omitempty"`
+}
+
+// OrderLineReference - cac:OrderLineReference
+type OrderLineReference struct {
+	LineID         string          `xml:"cbc:LineID"`
+	OrderReference *OrderReference `xml:"cac:OrderReference,omitempty"`
+}
+
+// Shipment - cac:ShipmentType, identified by its cbc:ID only
+type Shipment struct {
+	ID string `xml:"cbc:ID"`
+}
+
+// SupplierParty - cac:SupplierPartyType
+type SupplierParty struct {
+	CustomerAssignedAccountID string `xml:"cbc:CustomerAssignedAccountID,omitempty"`
+	Party                     *Party `xml:"cac:Party,omitempty"`
+}
+
+// CustomerParty - cac:CustomerPartyType
+type CustomerParty struct {
+	SupplierAssignedAccountID string `xml:"cbc:SupplierAssignedAccountID,omitempty"`
+	Party                     *Party `xml:"cac:Party,omitempty"`
+}
+
+// Party - cac:PartyType
+type Party struct {
+	WebsiteURI          string                `xml:"cbc:WebsiteURI,omitempty"`
+	EndpointID          *Identifier           `xml:"cbc:EndpointID,omitempty"`
+	PartyIdentification []PartyIdentification `xml:"cac:PartyIdentification,omitempty"`
+	PartyName           []PartyName           `xml:"cac:PartyName,omitempty"`
+	PostalAddress       *Address              `xml:"cac:PostalAddress,omitempty"`
+	PartyTaxScheme      []PartyTaxScheme      `xml:"cac:PartyTaxScheme,omitempty"`
+	PartyLegalEntity    []PartyLegalEntity    `xml:"cac:PartyLegalEntity,omitempty"`
+}
+
+// PartyIdentification - cac:PartyIdentification
+type PartyIdentification struct {
+	ID Identifier `xml:"cbc:ID"`
+}
+
+// PartyName - cac:PartyName
+type PartyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+// Address - cac:AddressType
+type Address struct {
+	AddressTypeCode      string        `xml:"cbc:AddressTypeCode,omitempty"`
+	AddressFormatCode    string        `xml:"cbc:AddressFormatCode,omitempty"`
+	Postbox              string        `xml:"cbc:Postbox,omitempty"`
+	Floor                string        `xml:"cbc:Floor,omitempty"`
+	Room                 string        `xml:"cbc:Room,omitempty"`
+	StreetName           string        `xml:"cbc:StreetName,omitempty"`
+	AdditionalStreetName string        `xml:"cbc:AdditionalStreetName,omitempty"`
+	BlockName            string        `xml:"cbc:BlockName,omitempty"`
+	BuildingName         string        `xml:"cbc:BuildingName,omitempty"`
+	BuildingNumber       string        `xml:"cbc:BuildingNumber,omitempty"`
+	InhouseMail          string        `xml:"cbc:InhouseMail,omitempty"`
+	Department           string        `xml:"cbc:Department,omitempty"`
+	MarkAttention        string        `xml:"cbc:MarkAttention,omitempty"`
+	MarkCare             string        `xml:"cbc:MarkCare,omitempty"`
+	PlotIdentification   string        `xml:"cbc:PlotIdentification,omitempty"`
+	CitySubdivisionName  string        `xml:"cbc:CitySubdivisionName,omitempty"`
+	CityName             string        `xml:"cbc:CityName,omitempty"`
+	PostalZone           string        `xml:"cbc:PostalZone,omitempty"`
+	CountrySubentity     string        `xml:"cbc:CountrySubentity,omitempty"`
+	CountrySubentityCode string        `xml:"cbc:CountrySubentityCode,omitempty"`
+	Region               string        `xml:"cbc:Region,omitempty"`
+	District             string        `xml:"cbc:District,omitempty"`
+	TimezoneOffset       string        `xml:"cbc:TimezoneOffset,omitempty"`
+	AddressLine          []AddressLine `xml:"cac:AddressLine,omitempty"`
+	Country              *Country      `xml:"cac:Country,omitempty"`
+}
+
+// AddressLine - cac:AddressLine
+type AddressLine struct {
+	Line string `xml:"cbc:Line"`
+}
+
+// Country - cac:Country
+type Country struct {
+	IdentificationCode string `xml:"cbc:IdentificationCode,omitempty"`
+	Name               string `xml:"cbc:Name,omitempty"`
+}
+
+// PartyTaxScheme - cac:PartyTaxScheme
+type PartyTaxScheme struct {
+	RegistrationName    string     `xml:"cbc:RegistrationName,omitempty"`
+	CompanyID           string     `xml:"cbc:CompanyID,omitempty"`
+	TaxLevelCode        string     `xml:"cbc:TaxLevelCode,omitempty"`
+	ExemptionReasonCode string     `xml:"cbc:ExemptionReasonCode,omitempty"`
+	ExemptionReason     string     `xml:"cbc:ExemptionReason,omitempty"`
+	TaxScheme           *TaxScheme `xml:"cac:TaxScheme"`
+}
+
+// TaxScheme - cac:TaxScheme
+type TaxScheme struct {
+	ID           string `xml:"cbc:ID,omitempty"`
+	Name         string `xml:"cbc:Name,omitempty"`
+	TaxTypeCode  string `xml:"cbc:TaxTypeCode,omitempty"`
+	CurrencyCode string `xml:"cbc:CurrencyCode,omitempty"`
+}
+
+// PartyLegalEntity - cac:PartyLegalEntity
+type PartyLegalEntity struct {
+	RegistrationName             string                       `xml:"cbc:RegistrationName,omitempty"`
+	CompanyID                    string                       `xml:"cbc:CompanyID,omitempty"`
+	RegistrationDate             string                       `xml:"cbc:RegistrationDate,omitempty"`
+	RegistrationExpirationDate   string                       `xml:"cbc:RegistrationExpirationDate,omitempty"`
+	CompanyLegalFormCode         string                       `xml:"cbc:CompanyLegalFormCode,omitempty"`
+	CompanyLegalForm             string                       `xml:"cbc:CompanyLegalForm,omitempty"`
+	SoleProprietorshipIndicator  bool                         `xml:"cbc:SoleProprietorshipIndicator,omitempty"`
+	CompanyLiquidationStatusCode string                       `xml:"cbc:CompanyLiquidationStatusCode,omitempty"`
+	FullyPaidSharesIndicator     bool                         `xml:"cbc:FullyPaidSharesIndicator,omitempty"`
+	CorporateRegistrationScheme  *CorporateRegistrationScheme `xml:"cac:CorporateRegistrationScheme,omitempty"`
+}
+
+// CorporateRegistrationScheme - cac:CorporateRegistrationScheme
+type CorporateRegistrationScheme struct {
+	ID                            string `xml:"cbc:ID,omitempty"`
+	Name                          string `xml:"cbc:Name,omitempty"`
+	CorporateRegistrationTypeCode string `xml:"cbc:CorporateRegistrationTypeCode,omitempty"`
+}
+
+// PaymentTerms - cac:PaymentTerms
+type PaymentTerms struct {
+	ID                        string  `xml:"cbc:ID,omitempty"`
+	PrepaidPaymentReferenceID string  `xml:"cbc:PrepaidPaymentReferenceID,omitempty"`
+	Note                      string  `xml:"cbc:Note,omitempty"`
+	ReferenceEventCode        string  `xml:"cbc:ReferenceEventCode,omitempty"`
+	SettlementDiscountPercent string  `xml:"cbc:SettlementDiscountPercent,omitempty"`
+	PenaltySurchargePercent   string  `xml:"cbc:PenaltySurchargePercent,omitempty"`
+	PaymentPercent            string  `xml:"cbc:PaymentPercent,omitempty"`
+	Amount                    *Amount `xml:"cbc:Amount,omitempty"`
+	SettlementDiscountAmount  *Amount `xml:"cbc:SettlementDiscountAmount,omitempty"`
+	PenaltyAmount             *Amount `xml:"cbc:PenaltyAmount,omitempty"`
+	PaymentTermsDetailsURI    string  `xml:"cbc:PaymentTermsDetailsURI,omitempty"`
+	PaymentDueDate            string  `xml:"cbc:PaymentDueDate,omitempty"`
+	InstallmentDueDate        string  `xml:"cbc:InstallmentDueDate,omitempty"`
+	SettlementPeriod          *Period `xml:"cac:SettlementPeriod,omitempty"`
+	PenaltyPeriod             *Period `xml:"cac:PenaltyPeriod,omitempty"`
+	ValidityPeriod            *Period `xml:"cac:ValidityPeriod,omitempty"`
+}
+
+// ExchangeRate - cac:ExchangeRateType
+type ExchangeRate struct {
+	SourceCurrencyCode     string `xml:"cbc:SourceCurrencyCode"`
+	SourceCurrencyBaseRate string `xml:"cbc:SourceCurrencyBaseRate,omitempty"`
+	TargetCurrencyCode     string `xml:"cbc:TargetCurrencyCode"`
+	TargetCurrencyBaseRate string `xml:"cbc:TargetCurrencyBaseRate,omitempty"`
+	ExchangeMarketID       string `xml:"cbc:ExchangeMarketID,omitempty"`
+	CalculationRate        string `xml:"cbc:CalculationRate,omitempty"`
+	MathematicOperatorCode string `xml:"cbc:MathematicOperatorCode,omitempty"`
+	Date                   string `xml:"cbc:Date,omitempty"`
+}
+
+// AllowanceCharge - cac:AllowanceChargeType
+type AllowanceCharge struct {
+	ID                        string       `xml:"cbc:ID,omitempty"`
+	ChargeIndicator           bool         `xml:"cbc:ChargeIndicator"`
+	AllowanceChargeReasonCode string       `xml:"cbc:AllowanceChargeReasonCode,omitempty"`
+	AllowanceChargeReason     string       `xml:"cbc:AllowanceChargeReason,omitempty"`
+	MultiplierFactorNumeric   string       `xml:"cbc:MultiplierFactorNumeric,omitempty"`
+	PrepaidIndicator          bool         `xml:"cbc:PrepaidIndicator,omitempty"`
+	SequenceNumeric           string       `xml:"cbc:SequenceNumeric,omitempty"`
+	Amount                    *Amount      `xml:"cbc:Amount"`
+	BaseAmount                *Amount      `xml:"cbc:BaseAmount,omitempty"`
+	PerUnitAmount             *Amount      `xml:"cbc:PerUnitAmount,omitempty"`
+	TaxCategory               *TaxCategory `xml:"cac:TaxCategory,omitempty"`
+}
+
+// TaxTotal - cac:TaxTotalType
+type TaxTotal struct {
+	TaxAmount            *Amount       `xml:"cbc:TaxAmount"`
+	RoundingAmount       *Amount       `xml:"cbc:RoundingAmount,omitempty"`
+	TaxEvidenceIndicator bool          `xml:"cbc:TaxEvidenceIndicator,omitempty"`
+	TaxIncludedIndicator bool          `xml:"cbc:TaxIncludedIndicator,omitempty"`
+	TaxSubtotal          []TaxSubtotal `xml:"cac:TaxSubtotal,omitempty"`
+}
+
+// TaxSubtotal - cac:TaxSubtotal
+type TaxSubtotal struct {
+	TaxableAmount                *Amount      `xml:"cbc:TaxableAmount,omitempty"`
+	TaxAmount                    *Amount      `xml:"cbc:TaxAmount"`
+	CalculationSequenceNumeric   string       `xml:"cbc:CalculationSequenceNumeric,omitempty"`
+	TransactionCurrencyTaxAmount *Amount      `xml:"cbc:TransactionCurrencyTaxAmount,omitempty"`
+	Percent                      string       `xml:"cbc:Percent,omitempty"`
+	BaseUnitMeasure              *Quantity    `xml:"cbc:BaseUnitMeasure,omitempty"`
+	PerUnitAmount                *Amount      `xml:"cbc:PerUnitAmount,omitempty"`
+	TierRange                    string       `xml:"cbc:TierRange,omitempty"`
+	TierRatePercent              string       `xml:"cbc:TierRatePercent,omitempty"`
+	TaxCategory                  *TaxCategory `xml:"cac:TaxCategory"`
+}
+
+// TaxCategory - cac:TaxCategoryType
+type TaxCategory struct {
+	ID                     string     `xml:"cbc:ID,omitempty"`
+	Name                   string     `xml:"cbc:Name,omitempty"`
+	Percent                string     `xml:"cbc:Percent,omitempty"`
+	BaseUnitMeasure        *Quantity  `xml:"cbc:BaseUnitMeasure,omitempty"`
+	PerUnitAmount          *Amount    `xml:"cbc:PerUnitAmount,omitempty"`
+	TaxExemptionReasonCode string     `xml:"cbc:TaxExemptionReasonCode,omitempty"`
+	TaxExemptionReason     string     `xml:"cbc:TaxExemptionReason,omitempty"`
+	TierRange              string     `xml:"cbc:TierRange,omitempty"`
+	TierRatePercent        string     `xml:"cbc:TierRatePercent,omitempty"`
+	TaxScheme              *TaxScheme `xml:"cac:TaxScheme"`
+}
+
+// MonetaryTotal - cac:MonetaryTotalType
+type MonetaryTotal struct {
+	LineExtensionAmount       *Amount `xml:"cbc:LineExtensionAmount,omitempty"`
+	TaxExclusiveAmount        *Amount `xml:"cbc:TaxExclusiveAmount,omitempty"`
+	TaxInclusiveAmount        *Amount `xml:"cbc:TaxInclusiveAmount,omitempty"`
+	AllowanceTotalAmount      *Amount `xml:"cbc:AllowanceTotalAmount,omitempty"`
+	ChargeTotalAmount         *Amount `xml:"cbc:ChargeTotalAmount,omitempty"`
+	WithholdingTaxTotalAmount *Amount `xml:"cbc:WithholdingTaxTotalAmount,omitempty"`
+	PrepaidAmount             *Amount `xml:"cbc:PrepaidAmount,omitempty"`
+	PayableRoundingAmount     *Amount `xml:"cbc:PayableRoundingAmount,omitempty"`
+	PayableAmount             *Amount `xml:"cbc:PayableAmount"`
+	PayableAlternativeAmount  *Amount `xml:"cbc:PayableAlternativeAmount,omitempty"`
+}
+
+// Item - cac:ItemType
+type Item struct {
+	Description                     string              `xml:"cbc:Description,omitempty"`
+	PackQuantity                    *Quantity           `xml:"cbc:PackQuantity,omitempty"`
+	PackSizeNumeric                 string              `xml:"cbc:PackSizeNumeric,omitempty"`
+	CatalogueIndicator              bool                `xml:"cbc:CatalogueIndicator,omitempty"`
+	Name                            string              `xml:"cbc:Name,omitempty"`
+	HazardousRiskIndicator          bool                `xml:"cbc:HazardousRiskIndicator,omitempty"`
+	AdditionalInformation           string              `xml:"cbc:AdditionalInformation,omitempty"`
+	Keyword                         string              `xml:"cbc:Keyword,omitempty"`
+	BrandName                       string              `xml:"cbc:BrandName,omitempty"`
+	ModelName                       string              `xml:"cbc:ModelName,omitempty"`
+	BuyersItemIdentification        *ItemIdentification `xml:"cac:BuyersItemIdentification,omitempty"`
+	SellersItemIdentification       *ItemIdentification `xml:"cac:SellersItemIdentification,omitempty"`
+	ManufacturersItemIdentification *ItemIdentification `xml:"cac:ManufacturersItemIdentification,omitempty"`
+	StandardItemIdentification      *ItemIdentification `xml:"cac:StandardItemIdentification,omitempty"`
+	CatalogueItemIdentification     *ItemIdentification `xml:"cac:CatalogueItemIdentification,omitempty"`
+	AdditionalItemIdentification    *ItemIdentification `xml:"cac:AdditionalItemIdentification,omitempty"`
+	OriginCountry                   *Country            `xml:"cac:OriginCountry,omitempty"`
+	ClassifiedTaxCategory           []TaxCategory       `xml:"cac:ClassifiedTaxCategory,omitempty"`
+}
+
+// ItemIdentification - cac:ItemIdentificationType
+type ItemIdentification struct {
+	ID Identifier `xml:"cbc:ID"`
+}
+
+// Price - cac:Price
+type Price struct {
+	PriceAmount             *Amount   `xml:"cbc:PriceAmount"`
+	BaseQuantity            *Quantity `xml:"cbc:BaseQuantity,omitempty"`
+	PriceChangeReason       string    `xml:"cbc:PriceChangeReason,omitempty"`
+	PriceTypeCode           string    `xml:"cbc:PriceTypeCode,omitempty"`
+	PriceType               string    `xml:"cbc:PriceType,omitempty"`
+	OrderableUnitFactorRate string    `xml:"cbc:OrderableUnitFactorRate,omitempty"`
+	ValidityPeriod          *Period   `xml:"cac:ValidityPeriod,omitempty"`
+}
+
+// OrderLine - cac:OrderLine
+type OrderLine struct {
+	Note                   []string             `xml:"cbc:Note,omitempty"`
+	SubstitutionStatusCode string               `xml:"cbc:SubstitutionStatusCode,omitempty"`
+	LineItem               *LineItem            `xml:"cac:LineItem"`
+	OrderLineReference     []OrderLineReference `xml:"cac:OrderLineReference,omitempty"`
+}
+
+// LineItem - cac:LineItemType
+type LineItem struct {
+	ID                        string            `xml:"cbc:ID"`
+	SalesOrderID              string            `xml:"cbc:SalesOrderID,omitempty"`
+	UUID                      string            `xml:"cbc:UUID,omitempty"`
+	Note                      []string          `xml:"cbc:Note,omitempty"`
+	LineStatusCode            string            `xml:"cbc:LineStatusCode,omitempty"`
+	Quantity                  *Quantity         `xml:"cbc:Quantity,omitempty"`
+	LineExtensionAmount       *Amount           `xml:"cbc:LineExtensionAmount,omitempty"`
+	TotalTaxAmount            *Amount           `xml:"cbc:TotalTaxAmount,omitempty"`
+	MinimumQuantity           *Quantity         `xml:"cbc:MinimumQuantity,omitempty"`
+	MaximumQuantity           *Quantity         `xml:"cbc:MaximumQuantity,omitempty"`
+	MinimumBackorderQuantity  *Quantity         `xml:"cbc:MinimumBackorderQuantity,omitempty"`
+	MaximumBackorderQuantity  *Quantity         `xml:"cbc:MaximumBackorderQuantity,omitempty"`
+	InspectionMethodCode      string            `xml:"cbc:InspectionMethodCode,omitempty"`
+	PartialDeliveryIndicator  bool              `xml:"cbc:PartialDeliveryIndicator,omitempty"`
+	BackOrderAllowedIndicator bool              `xml:"cbc:BackOrderAllowedIndicator,omitempty"`
+	AccountingCostCode        string            `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost            string            `xml:"cbc:AccountingCost,omitempty"`
+	WarrantyInformation       []string          `xml:"cbc:WarrantyInformation,omitempty"`
+	OriginatorParty           *Party            `xml:"cac:OriginatorParty,omitempty"`
+	AllowanceCharge           []AllowanceCharge `xml:"cac:AllowanceCharge,omitempty"`
+	Price                     *Price            `xml:"cac:Price,omitempty"`
+	Item                      *Item             `xml:"cac:Item"`
+	TaxTotal                  []TaxTotal        `xml:"cac:TaxTotal,omitempty"`
+}
+
+// DespatchLine - cac:DespatchLine
+type DespatchLine struct {
+	ID                  string               `xml:"cbc:ID"`
+	UUID                string               `xml:"cbc:UUID,omitempty"`
+	Note                []string             `xml:"cbc:Note,omitempty"`
+	LineStatusCode      string               `xml:"cbc:LineStatusCode,omitempty"`
+	DeliveredQuantity   *Quantity            `xml:"cbc:DeliveredQuantity,omitempty"`
+	BackorderQuantity   *Quantity            `xml:"cbc:BackorderQuantity,omitempty"`
+	BackorderReason     []string             `xml:"cbc:BackorderReason,omitempty"`
+	OutstandingQuantity *Quantity            `xml:"cbc:OutstandingQuantity,omitempty"`
+	OutstandingReason   []string             `xml:"cbc:OutstandingReason,omitempty"`
+	OversupplyQuantity  *Quantity            `xml:"cbc:OversupplyQuantity,omitempty"`
+	OrderLineReference  []OrderLineReference `xml:"cac:OrderLineReference"`
+	Item                *Item                `xml:"cac:Item"`
+	Shipment            []Shipment           `xml:"cac:Shipment,omitempty"`
+}
+
+// ReceiptLine - cac:ReceiptLine
+type ReceiptLine struct {
+	ID                      string              `xml:"cbc:ID"`
+	UUID                    string              `xml:"cbc:UUID,omitempty"`
+	Note                    []string            `xml:"cbc:Note,omitempty"`
+	ReceivedQuantity        *Quantity           `xml:"cbc:ReceivedQuantity,omitempty"`
+	ShortQuantity           *Quantity           `xml:"cbc:ShortQuantity,omitempty"`
+	ShortageActionCode      string              `xml:"cbc:ShortageActionCode,omitempty"`
+	RejectedQuantity        *Quantity           `xml:"cbc:RejectedQuantity,omitempty"`
+	RejectReasonCode        string              `xml:"cbc:RejectReasonCode,omitempty"`
+	RejectReason            []string            `xml:"cbc:RejectReason,omitempty"`
+	RejectActionCode        string              `xml:"cbc:RejectActionCode,omitempty"`
+	QuantityDiscrepancyCode string              `xml:"cbc:QuantityDiscrepancyCode,omitempty"`
+	OversupplyQuantity      *Quantity           `xml:"cbc:OversupplyQuantity,omitempty"`
+	ReceivedDate            string              `xml:"cbc:ReceivedDate,omitempty"`
+	TimingComplaintCode     string              `xml:"cbc:TimingComplaintCode,omitempty"`
+	TimingComplaint         string              `xml:"cbc:TimingComplaint,omitempty"`
+	OrderLineReference      *OrderLineReference `xml:"cac:OrderLineReference,omitempty"`
+	DespatchLineReference   []LineReference     `xml:"cac:DespatchLineReference,omitempty"`
+	Item                    []Item              `xml:"cac:Item,omitempty"`
+	Shipment                []Shipment          `xml:"cac:Shipment,omitempty"`
+}
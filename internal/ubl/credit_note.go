@@ -0,0 +1,469 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+)
+
+// CreditNote - UBL 2.3 CreditNote document
+type CreditNote struct {
+	XMLName xml.Name `xml:"CreditNote"`
+	Namespaces
+	UBLVersionID                   string              `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID                string              `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                      string              `xml:"cbc:ProfileID,omitempty"`
+	ID                             string              `xml:"cbc:ID"`
+	UUID                           string              `xml:"cbc:UUID,omitempty"`
+	IssueDate                      string              `xml:"cbc:IssueDate"`
+	TaxPointDate                   string              `xml:"cbc:TaxPointDate,omitempty"`
+	CreditNoteTypeCode             string              `xml:"cbc:CreditNoteTypeCode,omitempty"`
+	Note                           []string            `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode           string              `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	TaxCurrencyCode                string              `xml:"cbc:TaxCurrencyCode,omitempty"`
+	PricingCurrencyCode            string              `xml:"cbc:PricingCurrencyCode,omitempty"`
+	PaymentCurrencyCode            string              `xml:"cbc:PaymentCurrencyCode,omitempty"`
+	PaymentAlternativeCurrencyCode string              `xml:"cbc:PaymentAlternativeCurrencyCode,omitempty"`
+	AccountingCostCode             string              `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost                 string              `xml:"cbc:AccountingCost,omitempty"`
+	LineCountNumeric               string              `xml:"cbc:LineCountNumeric,omitempty"`
+	BuyerReference                 string              `xml:"cbc:BuyerReference,omitempty"`
+	InvoicePeriod                  *Period             `xml:"cac:InvoicePeriod,omitempty"`
+	DiscrepancyResponse            []Response          `xml:"cac:DiscrepancyResponse,omitempty"`
+	OrderReference                 *OrderReference     `xml:"cac:OrderReference,omitempty"`
+	BillingReference               []BillingReference  `xml:"cac:BillingReference,omitempty"`
+	DespatchDocumentReference      []DocumentReference `xml:"cac:DespatchDocumentReference,omitempty"`
+	ReceiptDocumentReference       []DocumentReference `xml:"cac:ReceiptDocumentReference,omitempty"`
+	Signature                      []Signature         `xml:"cac:Signature,omitempty"`
+	AccountingSupplierParty        *SupplierParty      `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty        *CustomerParty      `xml:"cac:AccountingCustomerParty"`
+	PayeeParty                     *Party              `xml:"cac:PayeeParty,omitempty"`
+	BuyerCustomerParty             *CustomerParty      `xml:"cac:BuyerCustomerParty,omitempty"`
+	SellerSupplierParty            *SupplierParty      `xml:"cac:SellerSupplierParty,omitempty"`
+	TaxRepresentativeParty         *Party              `xml:"cac:TaxRepresentativeParty,omitempty"`
+	PaymentTerms                   []PaymentTerms      `xml:"cac:PaymentTerms,omitempty"`
+	TaxExchangeRate                *ExchangeRate       `xml:"cac:TaxExchangeRate,omitempty"`
+	PricingExchangeRate            *ExchangeRate       `xml:"cac:PricingExchangeRate,omitempty"`
+	PaymentExchangeRate            *ExchangeRate       `xml:"cac:PaymentExchangeRate,omitempty"`
+	PaymentAlternativeExchangeRate *ExchangeRate       `xml:"cac:PaymentAlternativeExchangeRate,omitempty"`
+	AllowanceCharge                []AllowanceCharge   `xml:"cac:AllowanceCharge,omitempty"`
+	TaxTotal                       []TaxTotal          `xml:"cac:TaxTotal,omitempty"`
+	LegalMonetaryTotal             *MonetaryTotal      `xml:"cac:LegalMonetaryTotal"`
+	CreditNoteLine                 []CreditNoteLine    `xml:"cac:CreditNoteLine"`
+}
+
+// CreditNoteLine - cac:CreditNoteLine
+type CreditNoteLine struct {
+	ID                    string               `xml:"cbc:ID"`
+	UUID                  string               `xml:"cbc:UUID,omitempty"`
+	Note                  []string             `xml:"cbc:Note,omitempty"`
+	CreditedQuantity      *Quantity            `xml:"cbc:CreditedQuantity,omitempty"`
+	LineExtensionAmount   *Amount              `xml:"cbc:LineExtensionAmount"`
+	TaxPointDate          string               `xml:"cbc:TaxPointDate,omitempty"`
+	AccountingCostCode    string               `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost        string               `xml:"cbc:AccountingCost,omitempty"`
+	PaymentPurposeCode    string               `xml:"cbc:PaymentPurposeCode,omitempty"`
+	FreeOfChargeIndicator bool                 `xml:"cbc:FreeOfChargeIndicator,omitempty"`
+	InvoicePeriod         *Period              `xml:"cac:InvoicePeriod,omitempty"`
+	OrderLineReference    []OrderLineReference `xml:"cac:OrderLineReference,omitempty"`
+	DiscrepancyResponse   []Response           `xml:"cac:DiscrepancyResponse,omitempty"`
+	DespatchLineReference []LineReference      `xml:"cac:DespatchLineReference,omitempty"`
+	ReceiptLineReference  []LineReference      `xml:"cac:ReceiptLineReference,omitempty"`
+	BillingReference      []BillingReference   `xml:"cac:BillingReference,omitempty"`
+	OriginatorParty       *Party               `xml:"cac:OriginatorParty,omitempty"`
+	TaxTotal              []TaxTotal           `xml:"cac:TaxTotal,omitempty"`
+	AllowanceCharge       []AllowanceCharge    `xml:"cac:AllowanceCharge,omitempty"`
+	Item                  *Item                `xml:"cac:Item"`
+	Price                 *Price               `xml:"cac:Price,omitempty"`
+}
+
+// CreditNoteSource - everything needed to build a UBL CreditNote
+type CreditNoteSource struct {
+	CreditNoteHeader *invoiceproto.CreditNoteHeader
+	CreditNoteLines  []*invoiceproto.CreditNoteLine
+	DocumentSource
+}
+
+// Master flags used by tax_totals and payment_terms rows of credit notes
+const (
+	MasterFlagCreditNoteHeader = "CNH"
+	MasterFlagCreditNoteLine   = "CNL"
+)
+
+// CreditNoteFromSource - build a UBL CreditNote. The UBL CreditNote has no
+// cbc:DueDate, due_date is written as cbc:PaymentDueDate of the payment terms.
+func CreditNoteFromSource(src *CreditNoteSource) (*CreditNote, error) {
+	if src == nil || src.CreditNoteHeader == nil || src.CreditNoteHeader.CreditNoteHeaderD == nil {
+		return nil, errors.New("ubl: credit note header is required")
+	}
+	hd := src.CreditNoteHeader.CreditNoteHeaderD
+	ht := src.CreditNoteHeader.CreditNoteHeaderT
+	if ht == nil {
+		ht = &invoiceproto.CreditNoteHeaderT{}
+	}
+	cur := hd.DocumentCurrencyCode
+
+	cn := CreditNote{Namespaces: NewNamespaces(NamespaceCreditNote)}
+	cn.UBLVersionID = Version
+	cn.ID = documentID(hd.CnhId, hd.IdS)
+	cn.UUID = hd.IdS
+	cn.IssueDate = FormatDate(ht.IssueDate)
+	cn.TaxPointDate = FormatDate(ht.TaxPointDate)
+	cn.CreditNoteTypeCode = hd.CreditNoteTypeCode
+	cn.Note = notes(hd.Note)
+	cn.DocumentCurrencyCode = hd.DocumentCurrencyCode
+	cn.TaxCurrencyCode = hd.TaxCurrencyCode
+	cn.PricingCurrencyCode = hd.PricingCurrencyCode
+	cn.PaymentCurrencyCode = hd.PaymentCurrencyCode
+	cn.PaymentAlternativeCurrencyCode = hd.PaymentAltCurrencyCode
+	cn.AccountingCostCode = hd.AccountingCostCode
+	cn.AccountingCost = hd.AccountingCost
+	cn.BuyerReference = hd.BuyerReference
+	if hd.LineCountNumeric != 0 {
+		cn.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	cn.InvoicePeriod = period(FormatDate(ht.InvoicePeriodStartDate), FormatDate(ht.InvoicePeriodEndDate))
+	cn.DiscrepancyResponse = discrepancyResponse(hd.DiscrepancyResponse)
+
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		cn.OrderReference = &OrderReference{ID: id}
+	}
+	cn.BillingReference = billingReference(src.reference(ReferenceInvoice, hd.BillingId))
+	if id := src.reference(ReferenceDespatch, hd.DespatchId); id != "" {
+		cn.DespatchDocumentReference = []DocumentReference{{ID: id}}
+	}
+	if id := src.reference(ReferenceReceipt, hd.ReceiptId); id != "" {
+		cn.ReceiptDocumentReference = []DocumentReference{{ID: id}}
+	}
+	if hd.Signature != "" {
+		cn.Signature = []Signature{{ID: hd.Signature}}
+	}
+
+	cn.AccountingSupplierParty = &SupplierParty{Party: src.party(hd.AccountingSupplierPartyId)}
+	cn.AccountingCustomerParty = &CustomerParty{Party: src.party(hd.AccountingCustomerPartyId)}
+	cn.PayeeParty = src.party(hd.PayeePartyId)
+	if p := src.party(hd.BuyerCustomerPartyId); p != nil {
+		cn.BuyerCustomerParty = &CustomerParty{Party: p}
+	}
+	if p := src.party(hd.SellerSupplierPartyId); p != nil {
+		cn.SellerSupplierParty = &SupplierParty{Party: p}
+	}
+	cn.TaxRepresentativeParty = src.party(hd.TaxRepresentativePartyId)
+
+	for _, pt := range src.PaymentTerms {
+		cn.PaymentTerms = append(cn.PaymentTerms, paymentTerms(pt, cur))
+	}
+	cn.PaymentTerms = duePaymentTerms(cn.PaymentTerms, FormatDate(ht.DueDate))
+
+	cn.TaxExchangeRate = exchangeRate(hd.TaxExSourceCurrencyCode, hd.TaxExSourceCurrencyBaseRate, hd.TaxExTargetCurrencyCode, hd.TaxExTargetCurrencyBaseRate, hd.TaxExExchangeMarketId, hd.TaxExCalculationRate, hd.TaxExMathematicOperatorCode, ht.TaxExDate)
+	cn.PricingExchangeRate = exchangeRate(hd.PricingExSourceCurrencyCode, hd.PricingExSourceCurrencyBaseRate, hd.PricingExTargetCurrencyCode, hd.PricingExTargetCurrencyBaseRate, hd.PricingExExchangeMarketId, hd.PricingExCalculationRate, hd.PricingExMathematicOperatorCode, ht.PricingExDate)
+	cn.PaymentExchangeRate = exchangeRate(hd.PaymentExSourceCurrencyCode, hd.PaymentExSourceCurrencyBaseRate, hd.PaymentExTargetCurrencyCode, hd.PaymentExTargetCurrencyBaseRate, hd.PaymentExExchangeMarketId, hd.PaymentExCalculationRate, hd.PaymentExMathematicOperatorCode, ht.PaymentExDate)
+	cn.PaymentAlternativeExchangeRate = exchangeRate(hd.PaymentAltExSourceCurrencyCode, hd.PaymentAltExSourceCurrencyBaseRate, hd.PaymentAltExTargetCurrencyCode, hd.PaymentAltExTargetCurrencyBaseRate, hd.PaymentAltExExchangeMarketId, hd.PaymentAltExCalculationRate, hd.PaymentAltExMathematicOperatorCode, ht.PaymentAltExDate)
+
+	cn.AllowanceCharge = allowanceCharges(src.AllowanceCharges, cur)
+	cn.TaxTotal = taxTotals(src.TaxTotals, cur)
+
+	cn.LegalMonetaryTotal = &MonetaryTotal{
+		LineExtensionAmount:       NewAmount(hd.LineExtensionAmount, cur),
+		TaxExclusiveAmount:        NewAmount(hd.TaxExclusiveAmount, cur),
+		TaxInclusiveAmount:        NewAmount(hd.TaxInclusiveAmount, cur),
+		AllowanceTotalAmount:      OptionalAmount(hd.AllowanceTotalAmount, cur),
+		ChargeTotalAmount:         OptionalAmount(hd.ChargeTotalAmount, cur),
+		WithholdingTaxTotalAmount: OptionalAmount(hd.WithholdingTaxTotalAmount, cur),
+		PrepaidAmount:             OptionalAmount(hd.PrepaidAmount, cur),
+		PayableRoundingAmount:     OptionalAmount(hd.PayableRoundingAmount, cur),
+		PayableAmount:             NewAmount(hd.PayableAmount, cur),
+		PayableAlternativeAmount:  OptionalAmount(hd.PayableAlternativeAmount, rowCurrency(hd.PayableAlternativeCurrencyCode, hd.PaymentAltCurrencyCode)),
+	}
+
+	for i, line := range src.CreditNoteLines {
+		cn.CreditNoteLine = append(cn.CreditNoteLine, src.creditNoteLine(i, line, cur))
+	}
+	if src.Profile == ProfilePeppolBIS3 {
+		src.peppolCreditNote(&cn)
+	}
+	return &cn, nil
+}
+
+// creditNoteLine - map a CreditNoteLine
+func (src *CreditNoteSource) creditNoteLine(i int, line *invoiceproto.CreditNoteLine, cur string) CreditNoteLine {
+	ld := line.CreditNoteLineD
+	cur = rowCurrency(ld.CurrencyCode, cur)
+	lt := line.CreditNoteLineT
+	if lt == nil {
+		lt = &invoiceproto.CreditNoteLineT{}
+	}
+	cl := CreditNoteLine{}
+	cl.ID = lineID(ld.CnlId, i)
+	cl.UUID = ld.IdS
+	cl.Note = notes(ld.Note)
+	cl.CreditedQuantity = &Quantity{Value: FormatDecimal(ld.CreditedQuantity), UnitCode: ld.UnitCode}
+	cl.LineExtensionAmount = NewAmount(ld.LineExtensionAmount, cur)
+	cl.TaxPointDate = FormatDate(lt.TaxPointDate)
+	cl.AccountingCostCode = ld.AccountingCostCode
+	cl.AccountingCost = ld.AccountingCost
+	cl.PaymentPurposeCode = ld.PaymentPurposeCode
+	cl.FreeOfChargeIndicator = ld.FreeOfChargeIndicator
+	cl.InvoicePeriod = period(FormatDate(lt.InvoicePeriodStartDate), FormatDate(lt.InvoicePeriodEndDate))
+	if id := src.reference(ReferenceOrderLine, ld.OrderLineId); id != "" {
+		cl.OrderLineReference = []OrderLineReference{{LineID: id}}
+	}
+	cl.DiscrepancyResponse = discrepancyResponse(ld.DiscrepancyResponse)
+	if id := src.reference(ReferenceDespatchLine, ld.DespatchLineId); id != "" {
+		cl.DespatchLineReference = []LineReference{{LineID: id}}
+	}
+	if id := src.reference(ReferenceReceiptLine, ld.ReceiptLineId); id != "" {
+		cl.ReceiptLineReference = []LineReference{{LineID: id}}
+	}
+	cl.BillingReference = billingReference(src.reference(ReferenceInvoice, ld.BillingId))
+	cl.OriginatorParty = src.party(ld.OriginatorPartyId)
+	cl.TaxTotal = taxTotals(src.LineTaxTotals[ld.Id], cur)
+	cl.AllowanceCharge = allowanceCharges(src.LineAllowanceCharges[ld.Id], cur)
+	cl.Item = item(src.Items[ld.ItemId])
+	cl.Price = price(ld.PriceAmount, ld.PriceBaseQuantity, ld.PriceChangeReason, ld.PriceTypeCode, ld.PriceType, ld.OrderableUnitFactorRate, FormatDate(lt.PriceValidityPeriodStartDate), FormatDate(lt.PriceValidityPeriodEndDate), cur)
+	return cl
+}
+
+// CreditNoteImport - Create requests for an imported UBL CreditNote
+type CreditNoteImport struct {
+	CreditNote   *invoiceproto.CreateCreditNoteHeaderRequest
+	PaymentTerms []*paymentproto.CreatePaymentTermRequest
+}
+
+// ImportCreditNote - map a UBL CreditNote onto a
+// CreateCreditNoteHeaderRequest. Elements that cannot be mapped are returned
+// as FieldErrors; parties are only created when there are none. due_date is
+// taken from the first cac:PaymentTerms/cbc:PaymentDueDate.
+func ImportCreditNote(ctx context.Context, cn *CreditNote, resolver Resolver) (*CreditNoteImport, error) {
+	const root = "/CreditNote"
+	im := importer{ctx: ctx, resolver: resolver, currency: cn.DocumentCurrencyCode}
+	in := invoiceproto.CreateCreditNoteHeaderRequest{}
+
+	if strings.TrimSpace(cn.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if cn.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", cn.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.CnhId = strings.TrimSpace(cn.ID)
+	in.TaxPointDate = im.date(root+"/cbc:TaxPointDate", cn.TaxPointDate)
+	in.CreditNoteTypeCode = cn.CreditNoteTypeCode
+	in.Note = strings.Join(cn.Note, "\n")
+	in.DocumentCurrencyCode = cn.DocumentCurrencyCode
+	in.TaxCurrencyCode = cn.TaxCurrencyCode
+	in.PricingCurrencyCode = cn.PricingCurrencyCode
+	in.PaymentCurrencyCode = cn.PaymentCurrencyCode
+	in.PaymentAltCurrencyCode = cn.PaymentAlternativeCurrencyCode
+	in.AccountingCostCode = cn.AccountingCostCode
+	in.AccountingCost = cn.AccountingCost
+	in.BuyerReference = cn.BuyerReference
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", cn.LineCountNumeric)
+	in.InvoicePeriodStartDate, in.InvoicePeriodEndDate = im.period(root+"/cac:InvoicePeriod", cn.InvoicePeriod)
+	in.DiscrepancyResponse = discrepancyReason(cn.DiscrepancyResponse)
+
+	if cn.OrderReference != nil {
+		in.OrderId = im.reference(root+"/cac:OrderReference/cbc:ID", ReferenceOrder, cn.OrderReference.ID, 0)
+	}
+	in.BillingId = im.billingReference(root+"/cac:BillingReference", cn.BillingReference)
+	for i, ref := range cn.DespatchDocumentReference {
+		path := fmt.Sprintf("%s/cac:DespatchDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one despatch advice can be referenced")
+			continue
+		}
+		in.DespatchId = im.reference(path+"/cbc:ID", ReferenceDespatch, ref.ID, 0)
+	}
+	for i, ref := range cn.ReceiptDocumentReference {
+		path := fmt.Sprintf("%s/cac:ReceiptDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one receipt advice can be referenced")
+			continue
+		}
+		in.ReceiptId = im.reference(path+"/cbc:ID", ReferenceReceipt, ref.ID, 0)
+	}
+	for i, sig := range cn.Signature {
+		if i > 0 {
+			im.fail(fmt.Sprintf("%s/cac:Signature[%d]", root, i+1), "only one signature can be imported")
+			continue
+		}
+		in.Signature = strings.TrimSpace(sig.ID)
+	}
+
+	if cn.AccountingSupplierParty == nil || cn.AccountingSupplierParty.Party == nil {
+		im.fail(root+"/cac:AccountingSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingSupplierParty/cac:Party", cn.AccountingSupplierParty.Party, &in.AccountingSupplierPartyId)
+	}
+	if cn.AccountingCustomerParty == nil || cn.AccountingCustomerParty.Party == nil {
+		im.fail(root+"/cac:AccountingCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingCustomerParty/cac:Party", cn.AccountingCustomerParty.Party, &in.AccountingCustomerPartyId)
+	}
+	im.party(root+"/cac:PayeeParty", cn.PayeeParty, &in.PayeePartyId)
+	if cn.BuyerCustomerParty != nil {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", cn.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if cn.SellerSupplierParty != nil {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", cn.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	im.party(root+"/cac:TaxRepresentativeParty", cn.TaxRepresentativeParty, &in.TaxRepresentativePartyId)
+
+	in.DueDate = in.IssueDate
+	paymentTerms := []*paymentproto.CreatePaymentTermRequest{}
+	for i := range cn.PaymentTerms {
+		path := fmt.Sprintf("%s/cac:PaymentTerms[%d]", root, i+1)
+		paymentTerm := im.paymentTerms(path, &cn.PaymentTerms[i])
+		if i == 0 && cn.PaymentTerms[i].PaymentDueDate != "" {
+			in.DueDate = paymentTerm.PaymentDueDate
+		}
+		if !dueDateOnly(&cn.PaymentTerms[i]) {
+			paymentTerms = append(paymentTerms, paymentTerm)
+		}
+	}
+
+	taxEx := im.exchangeRate(root+"/cac:TaxExchangeRate", cn.TaxExchangeRate)
+	in.TaxExSourceCurrencyCode = taxEx.SourceCurrencyCode
+	in.TaxExSourceCurrencyBaseRate = taxEx.SourceCurrencyBaseRate
+	in.TaxExTargetCurrencyCode = taxEx.TargetCurrencyCode
+	in.TaxExTargetCurrencyBaseRate = taxEx.TargetCurrencyBaseRate
+	in.TaxExExchangeMarketId = taxEx.ExchangeMarketID
+	in.TaxExCalculationRate = taxEx.CalculationRate
+	in.TaxExMathematicOperatorCode = taxEx.MathematicOperatorCode
+	in.TaxExDate = taxEx.Date
+
+	pricingEx := im.exchangeRate(root+"/cac:PricingExchangeRate", cn.PricingExchangeRate)
+	in.PricingExSourceCurrencyCode = pricingEx.SourceCurrencyCode
+	in.PricingExSourceCurrencyBaseRate = pricingEx.SourceCurrencyBaseRate
+	in.PricingExTargetCurrencyCode = pricingEx.TargetCurrencyCode
+	in.PricingExTargetCurrencyBaseRate = pricingEx.TargetCurrencyBaseRate
+	in.PricingExExchangeMarketId = pricingEx.ExchangeMarketID
+	in.PricingExCalculationRate = pricingEx.CalculationRate
+	in.PricingExMathematicOperatorCode = pricingEx.MathematicOperatorCode
+	in.PricingExDate = pricingEx.Date
+
+	paymentEx := im.exchangeRate(root+"/cac:PaymentExchangeRate", cn.PaymentExchangeRate)
+	in.PaymentExSourceCurrencyCode = paymentEx.SourceCurrencyCode
+	in.PaymentExSourceCurrencyBaseRate = paymentEx.SourceCurrencyBaseRate
+	in.PaymentExTargetCurrencyCode = paymentEx.TargetCurrencyCode
+	in.PaymentExTargetCurrencyBaseRate = paymentEx.TargetCurrencyBaseRate
+	in.PaymentExExchangeMarketId = paymentEx.ExchangeMarketID
+	in.PaymentExCalculationRate = paymentEx.CalculationRate
+	in.PaymentExMathematicOperatorCode = paymentEx.MathematicOperatorCode
+	in.PaymentExDate = paymentEx.Date
+
+	paymentAltEx := im.exchangeRate(root+"/cac:PaymentAlternativeExchangeRate", cn.PaymentAlternativeExchangeRate)
+	in.PaymentAltExSourceCurrencyCode = paymentAltEx.SourceCurrencyCode
+	in.PaymentAltExSourceCurrencyBaseRate = paymentAltEx.SourceCurrencyBaseRate
+	in.PaymentAltExTargetCurrencyCode = paymentAltEx.TargetCurrencyCode
+	in.PaymentAltExTargetCurrencyBaseRate = paymentAltEx.TargetCurrencyBaseRate
+	in.PaymentAltExExchangeMarketId = paymentAltEx.ExchangeMarketID
+	in.PaymentAltExCalculationRate = paymentAltEx.CalculationRate
+	in.PaymentAltExMathematicOperatorCode = paymentAltEx.MathematicOperatorCode
+	in.PaymentAltExDate = paymentAltEx.Date
+
+	if lmt := cn.LegalMonetaryTotal; lmt == nil || lmt.PayableAmount == nil {
+		im.fail(root+"/cac:LegalMonetaryTotal/cbc:PayableAmount", "is required")
+	} else {
+		path := root + "/cac:LegalMonetaryTotal"
+		in.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", lmt.LineExtensionAmount)
+		in.TaxExclusiveAmount = im.amount(path+"/cbc:TaxExclusiveAmount", lmt.TaxExclusiveAmount)
+		in.TaxInclusiveAmount = im.amount(path+"/cbc:TaxInclusiveAmount", lmt.TaxInclusiveAmount)
+		in.AllowanceTotalAmount = im.amount(path+"/cbc:AllowanceTotalAmount", lmt.AllowanceTotalAmount)
+		in.ChargeTotalAmount = im.amount(path+"/cbc:ChargeTotalAmount", lmt.ChargeTotalAmount)
+		in.WithholdingTaxTotalAmount = im.amount(path+"/cbc:WithholdingTaxTotalAmount", lmt.WithholdingTaxTotalAmount)
+		in.PrepaidAmount = im.amount(path+"/cbc:PrepaidAmount", lmt.PrepaidAmount)
+		in.PayableRoundingAmount = im.amount(path+"/cbc:PayableRoundingAmount", lmt.PayableRoundingAmount)
+		in.PayableAmount = im.amount(path+"/cbc:PayableAmount", lmt.PayableAmount)
+		if a := lmt.PayableAlternativeAmount; a != nil {
+			in.PayableAlternativeAmount = im.money(path+"/cbc:PayableAlternativeAmount", a.Value)
+			in.PayableAlternativeCurrencyCode = a.CurrencyID
+		}
+	}
+
+	in.AllowanceCharges = im.allowanceCharges(root, cn.AllowanceCharge)
+
+	if len(cn.CreditNoteLine) == 0 {
+		im.fail(root+"/cac:CreditNoteLine", "at least one credit note line is required")
+	}
+	for i := range cn.CreditNoteLine {
+		path := fmt.Sprintf("%s/cac:CreditNoteLine[%d]", root, i+1)
+		in.CreditNoteLines = append(in.CreditNoteLines, im.creditNoteLine(path, &cn.CreditNoteLine[i], &in))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &CreditNoteImport{CreditNote: &in, PaymentTerms: paymentTerms}, nil
+}
+
+// creditNoteLine - map a cac:CreditNoteLine onto a
+// CreateCreditNoteLineRequest, line references are searched within the
+// documents referenced by the header
+func (im *importer) creditNoteLine(path string, cl *CreditNoteLine, hd *invoiceproto.CreateCreditNoteHeaderRequest) *invoiceproto.CreateCreditNoteLineRequest {
+	line := invoiceproto.CreateCreditNoteLineRequest{}
+	line.CnlId = strings.TrimSpace(cl.ID)
+	line.Note = strings.Join(cl.Note, "\n")
+	line.CreditedQuantity = im.quantity(path+"/cbc:CreditedQuantity", cl.CreditedQuantity)
+	line.UnitCode = im.unitCode(path+"/cbc:CreditedQuantity", cl.CreditedQuantity)
+	line.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", cl.LineExtensionAmount)
+	line.TaxPointDate = im.date(path+"/cbc:TaxPointDate", cl.TaxPointDate)
+	line.AccountingCostCode = cl.AccountingCostCode
+	line.AccountingCost = cl.AccountingCost
+	line.PaymentPurposeCode = cl.PaymentPurposeCode
+	line.FreeOfChargeIndicator = cl.FreeOfChargeIndicator
+	line.InvoicePeriodStartDate, line.InvoicePeriodEndDate = im.period(path+"/cac:InvoicePeriod", cl.InvoicePeriod)
+	line.DiscrepancyResponse = discrepancyReason(cl.DiscrepancyResponse)
+
+	for i, ref := range cl.OrderLineReference {
+		refPath := fmt.Sprintf("%s/cac:OrderLineReference[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one order line can be referenced")
+			continue
+		}
+		orderID := hd.OrderId
+		if ref.OrderReference != nil {
+			orderID = im.reference(refPath+"/cac:OrderReference/cbc:ID", ReferenceOrder, ref.OrderReference.ID, 0)
+		}
+		line.OrderLineId = im.reference(refPath+"/cbc:LineID", ReferenceOrderLine, ref.LineID, orderID)
+	}
+	line.DespatchLineId = im.lineReference(path+"/cac:DespatchLineReference", cl.DespatchLineReference, ReferenceDespatch, ReferenceDespatchLine, hd.DespatchId)
+	line.ReceiptLineId = im.lineReference(path+"/cac:ReceiptLineReference", cl.ReceiptLineReference, ReferenceReceipt, ReferenceReceiptLine, hd.ReceiptId)
+	line.BillingId = im.billingReference(path+"/cac:BillingReference", cl.BillingReference)
+	im.party(path+"/cac:OriginatorParty", cl.OriginatorParty, &line.OriginatorPartyId)
+
+	if cl.Item == nil {
+		im.fail(path+"/cac:Item", "is required")
+	} else {
+		line.ItemId = im.item(path+"/cac:Item", cl.Item)
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(path+"/cac:Item", "no item matches its identifications or name %q", cl.Item.Name)
+		}
+	}
+
+	line.AllowanceCharges = im.allowanceCharges(path, cl.AllowanceCharge)
+
+	if p := cl.Price; p != nil {
+		line.PriceAmount = im.amount(path+"/cac:Price/cbc:PriceAmount", p.PriceAmount)
+		line.PriceBaseQuantity = im.quantity(path+"/cac:Price/cbc:BaseQuantity", p.BaseQuantity)
+		line.PriceChangeReason = p.PriceChangeReason
+		line.PriceTypeCode = p.PriceTypeCode
+		line.PriceType = p.PriceType
+		line.OrderableUnitFactorRate = im.decimal(path+"/cac:Price/cbc:OrderableUnitFactorRate", p.OrderableUnitFactorRate)
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.period(path+"/cac:Price/cac:ValidityPeriod", p.ValidityPeriod)
+	} else {
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.defaultDate, im.defaultDate
+	}
+	return &line
+}
@@ -0,0 +1,179 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCreditNoteSource() *CreditNoteSource {
+	creditNoteHeaderD := invoiceproto.CreditNoteHeaderD{}
+	creditNoteHeaderD.Id = 2
+	creditNoteHeaderD.IdS = "f021bf66-25c8-47a6-a8f7-6166c551a017"
+	creditNoteHeaderD.CnhId = "CN-1"
+	creditNoteHeaderD.CreditNoteTypeCode = "381"
+	creditNoteHeaderD.DocumentCurrencyCode = "EUR"
+	creditNoteHeaderD.DiscrepancyResponse = "Returned goods"
+	creditNoteHeaderD.BillingId = 1
+	creditNoteHeaderD.AccountingSupplierPartyId = 1
+	creditNoteHeaderD.AccountingCustomerPartyId = 2
+	creditNoteHeaderD.LineExtensionAmount = "1273"
+	creditNoteHeaderD.TaxExclusiveAmount = "1273"
+	creditNoteHeaderD.TaxInclusiveAmount = "1591.25"
+	creditNoteHeaderD.PayableAmount = "1591.25"
+
+	creditNoteHeaderT := invoiceproto.CreditNoteHeaderT{}
+	creditNoteHeaderT.IssueDate = testDate("2009-12-15")
+	creditNoteHeaderT.DueDate = testDate("2010-01-15")
+
+	creditNoteLineD := invoiceproto.CreditNoteLineD{}
+	creditNoteLineD.Id = 3
+	creditNoteLineD.CnlId = "1"
+	creditNoteLineD.CreditedQuantity = 1
+	creditNoteLineD.UnitCode = "C62"
+	creditNoteLineD.LineExtensionAmount = "1273"
+	creditNoteLineD.DiscrepancyResponse = "Damaged in transit"
+	creditNoteLineD.ItemId = 3
+	creditNoteLineD.PriceAmount = "1273"
+
+	src := CreditNoteSource{
+		CreditNoteHeader: &invoiceproto.CreditNoteHeader{CreditNoteHeaderD: &creditNoteHeaderD, CreditNoteHeaderT: &creditNoteHeaderT},
+		CreditNoteLines:  []*invoiceproto.CreditNoteLine{{CreditNoteLineD: &creditNoteLineD, CreditNoteLineT: &invoiceproto.CreditNoteLineT{}}},
+		DocumentSource:   testDocumentSource(),
+	}
+	src.References = map[string]map[uint32]string{ReferenceInvoice: {1: "TOSL108"}}
+	return &src
+}
+
+func TestCreditNoteFromSource(t *testing.T) {
+	cn, err := CreditNoteFromSource(testCreditNoteSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(cn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"`)
+	assert.Contains(t, xmlStr, `<cbc:CreditNoteTypeCode>381</cbc:CreditNoteTypeCode>`)
+	assert.Contains(t, xmlStr, `<cac:DiscrepancyResponse>`+"\n"+`    <cbc:Description>Returned goods</cbc:Description>`)
+	assert.Contains(t, xmlStr, `<cac:InvoiceDocumentReference>`+"\n"+`      <cbc:ID>TOSL108</cbc:ID>`)
+	assert.Contains(t, xmlStr, `<cbc:PaymentDueDate>2010-01-15</cbc:PaymentDueDate>`)
+	assert.Contains(t, xmlStr, `<cbc:CreditedQuantity unitCode="C62">1</cbc:CreditedQuantity>`)
+	assert.Contains(t, xmlStr, `<cbc:Description>Damaged in transit</cbc:Description>`)
+	assert.NotContains(t, xmlStr, `<cbc:DueDate>`)
+
+	// cbc/cac elements must follow the sequence of the CreditNote schema
+	order := []string{"<cbc:ID>CN-1", "<cbc:IssueDate>", "<cbc:CreditNoteTypeCode>", "<cbc:DocumentCurrencyCode>", "<cac:DiscrepancyResponse>", "<cac:BillingReference>", "<cac:AccountingSupplierParty>", "<cac:PaymentTerms>", "<cac:TaxTotal>", "<cac:LegalMonetaryTotal>", "<cac:CreditNoteLine>", "<cbc:CreditedQuantity ", "<cac:Item>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr, elem)
+		assert.Greater(t, i, last, "element %s out of order", elem)
+		last = i
+	}
+}
+
+func TestCreditNoteFromSourcePeppol(t *testing.T) {
+	src := testCreditNoteSource()
+	src.Profile = ProfilePeppolBIS3
+	cn, err := CreditNoteFromSource(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(cn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<cbc:CustomizationID>urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0</cbc:CustomizationID>`)
+	assert.Contains(t, xmlStr, `<cbc:ProfileID>urn:fdc:peppol.eu:2017:poacc:billing:01:1.0</cbc:ProfileID>`)
+	assert.Contains(t, xmlStr, `<cbc:EndpointID schemeID="0088">7300010000001</cbc:EndpointID>`)
+	assert.NotContains(t, xmlStr, `<cbc:UUID>`)
+}
+
+func TestImportCreditNote(t *testing.T) {
+	cn, err := CreditNoteFromSource(testCreditNoteSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(cn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := CreditNote{}
+	err = Unmarshal(out, NamespaceCreditNote, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties:    map[string]uint32{"7300010000001DK12345678": 7},
+		references: map[string]uint32{ReferenceInvoice + ":TOSL108": 1},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	creditNoteImport, err := ImportCreditNote(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := creditNoteImport.CreditNote
+	assert.Equal(t, "CN-1", in.CnhId)
+	assert.Equal(t, "381", in.CreditNoteTypeCode)
+	assert.Equal(t, "12/15/2009", in.IssueDate)
+	assert.Equal(t, "01/15/2010", in.DueDate)
+	assert.Equal(t, "Returned goods", in.DiscrepancyResponse)
+	assert.Equal(t, uint32(1), in.BillingId)
+	assert.Equal(t, uint32(7), in.AccountingSupplierPartyId)
+	assert.Equal(t, "1591.25", in.PayableAmount)
+	assert.Equal(t, 1, len(in.CreditNoteLines))
+	assert.Equal(t, float64(1), in.CreditNoteLines[0].CreditedQuantity)
+	assert.Equal(t, "Damaged in transit", in.CreditNoteLines[0].DiscrepancyResponse)
+	assert.Equal(t, uint32(5), in.CreditNoteLines[0].ItemId)
+	assert.Equal(t, 1, len(creditNoteImport.PaymentTerms))
+	assert.Equal(t, "Penalty percentage 10% from due date", creditNoteImport.PaymentTerms[0].Note)
+}
+
+func TestImportCreditNoteFieldErrors(t *testing.T) {
+	doc := `<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>CN-2</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:DiscrepancyResponse><cbc:ResponseCode>PC</cbc:ResponseCode></cac:DiscrepancyResponse>
+  <cac:BillingReference><cac:InvoiceDocumentReference><cbc:ID>INV-404</cbc:ID></cac:InvoiceDocumentReference></cac:BillingReference>
+  <cac:BillingReference><cac:CreditNoteDocumentReference><cbc:ID>CN-1</cbc:ID></cac:CreditNoteDocumentReference></cac:BillingReference>
+  <cac:AccountingSupplierParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cbc:EndpointID>7300010000002</cbc:EndpointID></cac:Party></cac:AccountingCustomerParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="EUR">10.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+</CreditNote>`
+	cn := CreditNote{}
+	err := Unmarshal([]byte(doc), NamespaceCreditNote, &cn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "PC", discrepancyReason(cn.DiscrepancyResponse))
+
+	resolver := testResolver{}
+	_, err = ImportCreditNote(context.Background(), &cn, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportCreditNote() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/CreditNote/cac:BillingReference[1]/cac:InvoiceDocumentReference/cbc:ID",
+		"/CreditNote/cac:BillingReference[2]",
+		"/CreditNote/cac:CreditNoteLine",
+	}, paths)
+	assert.Empty(t, resolver.created, "no party is created for a document with errors")
+}
@@ -0,0 +1,413 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+)
+
+// DebitNote - UBL 2.3 DebitNote document
+type DebitNote struct {
+	XMLName xml.Name `xml:"DebitNote"`
+	Namespaces
+	UBLVersionID                   string              `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID                string              `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                      string              `xml:"cbc:ProfileID,omitempty"`
+	ID                             string              `xml:"cbc:ID"`
+	UUID                           string              `xml:"cbc:UUID,omitempty"`
+	IssueDate                      string              `xml:"cbc:IssueDate"`
+	Note                           []string            `xml:"cbc:Note,omitempty"`
+	TaxPointDate                   string              `xml:"cbc:TaxPointDate,omitempty"`
+	DocumentCurrencyCode           string              `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	TaxCurrencyCode                string              `xml:"cbc:TaxCurrencyCode,omitempty"`
+	PricingCurrencyCode            string              `xml:"cbc:PricingCurrencyCode,omitempty"`
+	PaymentCurrencyCode            string              `xml:"cbc:PaymentCurrencyCode,omitempty"`
+	PaymentAlternativeCurrencyCode string              `xml:"cbc:PaymentAlternativeCurrencyCode,omitempty"`
+	AccountingCostCode             string              `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost                 string              `xml:"cbc:AccountingCost,omitempty"`
+	LineCountNumeric               string              `xml:"cbc:LineCountNumeric,omitempty"`
+	InvoicePeriod                  *Period             `xml:"cac:InvoicePeriod,omitempty"`
+	DiscrepancyResponse            []Response          `xml:"cac:DiscrepancyResponse,omitempty"`
+	OrderReference                 *OrderReference     `xml:"cac:OrderReference,omitempty"`
+	BillingReference               []BillingReference  `xml:"cac:BillingReference,omitempty"`
+	DespatchDocumentReference      []DocumentReference `xml:"cac:DespatchDocumentReference,omitempty"`
+	ReceiptDocumentReference       []DocumentReference `xml:"cac:ReceiptDocumentReference,omitempty"`
+	AccountingSupplierParty        *SupplierParty      `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty        *CustomerParty      `xml:"cac:AccountingCustomerParty"`
+	PayeeParty                     *Party              `xml:"cac:PayeeParty,omitempty"`
+	BuyerCustomerParty             *CustomerParty      `xml:"cac:BuyerCustomerParty,omitempty"`
+	SellerSupplierParty            *SupplierParty      `xml:"cac:SellerSupplierParty,omitempty"`
+	TaxRepresentativeParty         *Party              `xml:"cac:TaxRepresentativeParty,omitempty"`
+	AllowanceCharge                []AllowanceCharge   `xml:"cac:AllowanceCharge,omitempty"`
+	PaymentTerms                   []PaymentTerms      `xml:"cac:PaymentTerms,omitempty"`
+	TaxExchangeRate                *ExchangeRate       `xml:"cac:TaxExchangeRate,omitempty"`
+	PricingExchangeRate            *ExchangeRate       `xml:"cac:PricingExchangeRate,omitempty"`
+	PaymentExchangeRate            *ExchangeRate       `xml:"cac:PaymentExchangeRate,omitempty"`
+	PaymentAlternativeExchangeRate *ExchangeRate       `xml:"cac:PaymentAlternativeExchangeRate,omitempty"`
+	TaxTotal                       []TaxTotal          `xml:"cac:TaxTotal,omitempty"`
+	RequestedMonetaryTotal         *MonetaryTotal      `xml:"cac:RequestedMonetaryTotal"`
+	DebitNoteLine                  []DebitNoteLine     `xml:"cac:DebitNoteLine"`
+}
+
+// DebitNoteLine - cac:DebitNoteLine
+type DebitNoteLine struct {
+	ID                    string             `xml:"cbc:ID"`
+	UUID                  string             `xml:"cbc:UUID,omitempty"`
+	Note                  []string           `xml:"cbc:Note,omitempty"`
+	DebitedQuantity       *Quantity          `xml:"cbc:DebitedQuantity,omitempty"`
+	LineExtensionAmount   *Amount            `xml:"cbc:LineExtensionAmount"`
+	TaxPointDate          string             `xml:"cbc:TaxPointDate,omitempty"`
+	AccountingCostCode    string             `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost        string             `xml:"cbc:AccountingCost,omitempty"`
+	PaymentPurposeCode    string             `xml:"cbc:PaymentPurposeCode,omitempty"`
+	DiscrepancyResponse   []Response         `xml:"cac:DiscrepancyResponse,omitempty"`
+	DespatchLineReference []LineReference    `xml:"cac:DespatchLineReference,omitempty"`
+	ReceiptLineReference  []LineReference    `xml:"cac:ReceiptLineReference,omitempty"`
+	BillingReference      []BillingReference `xml:"cac:BillingReference,omitempty"`
+	TaxTotal              []TaxTotal         `xml:"cac:TaxTotal,omitempty"`
+	AllowanceCharge       []AllowanceCharge  `xml:"cac:AllowanceCharge,omitempty"`
+	Item                  *Item              `xml:"cac:Item"`
+	Price                 *Price             `xml:"cac:Price,omitempty"`
+}
+
+// DebitNoteSource - everything needed to build a UBL DebitNote
+type DebitNoteSource struct {
+	DebitNoteHeader *invoiceproto.DebitNoteHeader
+	DebitNoteLines  []*invoiceproto.DebitNoteLine
+	DocumentSource
+}
+
+// Master flags used by tax_totals and payment_terms rows of debit notes
+const (
+	MasterFlagDebitNoteHeader = "DNH"
+	MasterFlagDebitNoteLine   = "DNL"
+)
+
+// DebitNoteFromSource - build a UBL DebitNote
+func DebitNoteFromSource(src *DebitNoteSource) (*DebitNote, error) {
+	if src == nil || src.DebitNoteHeader == nil || src.DebitNoteHeader.DebitNoteHeaderD == nil {
+		return nil, errors.New("ubl: debit note header is required")
+	}
+	hd := src.DebitNoteHeader.DebitNoteHeaderD
+	ht := src.DebitNoteHeader.DebitNoteHeaderT
+	if ht == nil {
+		ht = &invoiceproto.DebitNoteHeaderT{}
+	}
+	cur := hd.DocumentCurrencyCode
+
+	dn := DebitNote{Namespaces: NewNamespaces(NamespaceDebitNote)}
+	dn.UBLVersionID = Version
+	dn.ID = documentID(hd.DnhId, hd.IdS)
+	dn.UUID = hd.IdS
+	dn.IssueDate = FormatDate(ht.IssueDate)
+	dn.Note = notes(hd.Note)
+	dn.TaxPointDate = FormatDate(ht.TaxPointDate)
+	dn.DocumentCurrencyCode = hd.DocumentCurrencyCode
+	dn.TaxCurrencyCode = hd.TaxCurrencyCode
+	dn.PricingCurrencyCode = hd.PricingCurrencyCode
+	dn.PaymentCurrencyCode = hd.PaymentCurrencyCode
+	dn.PaymentAlternativeCurrencyCode = hd.PaymentAltCurrencyCode
+	dn.AccountingCostCode = hd.AccountingCostCode
+	dn.AccountingCost = hd.AccountingCost
+	if hd.LineCountNumeric != 0 {
+		dn.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	dn.InvoicePeriod = period(FormatDate(ht.InvoicePeriodStartDate), FormatDate(ht.InvoicePeriodEndDate))
+	dn.DiscrepancyResponse = discrepancyResponse(hd.DiscrepancyResponse)
+
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		dn.OrderReference = &OrderReference{ID: id}
+	}
+	dn.BillingReference = billingReference(src.reference(ReferenceInvoice, hd.BillingId))
+	if id := src.reference(ReferenceDespatch, hd.DespatchId); id != "" {
+		dn.DespatchDocumentReference = []DocumentReference{{ID: id}}
+	}
+	if id := src.reference(ReferenceReceipt, hd.ReceiptId); id != "" {
+		dn.ReceiptDocumentReference = []DocumentReference{{ID: id}}
+	}
+
+	dn.AccountingSupplierParty = &SupplierParty{Party: src.party(hd.AccountingSupplierPartyId)}
+	dn.AccountingCustomerParty = &CustomerParty{Party: src.party(hd.AccountingCustomerPartyId)}
+	dn.PayeeParty = src.party(hd.PayeePartyId)
+	if p := src.party(hd.BuyerCustomerPartyId); p != nil {
+		dn.BuyerCustomerParty = &CustomerParty{Party: p}
+	}
+	if p := src.party(hd.SellerSupplierPartyId); p != nil {
+		dn.SellerSupplierParty = &SupplierParty{Party: p}
+	}
+	dn.TaxRepresentativeParty = src.party(hd.TaxRepresentativePartyId)
+	dn.AllowanceCharge = allowanceCharges(src.AllowanceCharges, cur)
+
+	for _, pt := range src.PaymentTerms {
+		dn.PaymentTerms = append(dn.PaymentTerms, paymentTerms(pt, cur))
+	}
+
+	dn.TaxExchangeRate = exchangeRate(hd.TaxExSourceCurrencyCode, hd.TaxExSourceCurrencyBaseRate, hd.TaxExTargetCurrencyCode, hd.TaxExTargetCurrencyBaseRate, hd.TaxExExchangeMarketId, hd.TaxExCalculationRate, hd.TaxExMathematicOperatorCode, ht.TaxExDate)
+	dn.PricingExchangeRate = exchangeRate(hd.PricingExSourceCurrencyCode, hd.PricingExSourceCurrencyBaseRate, hd.PricingExTargetCurrencyCode, hd.PricingExTargetCurrencyBaseRate, hd.PricingExExchangeMarketId, hd.PricingExCalculationRate, hd.PricingExMathematicOperatorCode, ht.PricingExDate)
+	dn.PaymentExchangeRate = exchangeRate(hd.PaymentExSourceCurrencyCode, hd.PaymentExSourceCurrencyBaseRate, hd.PaymentExTargetCurrencyCode, hd.PaymentExTargetCurrencyBaseRate, hd.PaymentExExchangeMarketId, hd.PaymentExCalculationRate, hd.PaymentExMathematicOperatorCode, ht.PaymentExDate)
+	dn.PaymentAlternativeExchangeRate = exchangeRate(hd.PaymentAltExSourceCurrencyCode, hd.PaymentAltExSourceCurrencyBaseRate, hd.PaymentAltExTargetCurrencyCode, hd.PaymentAltExTargetCurrencyBaseRate, hd.PaymentAltExExchangeMarketId, hd.PaymentAltExCalculationRate, hd.PaymentAltExMathematicOperatorCode, ht.PaymentAltExDate)
+
+	dn.TaxTotal = taxTotals(src.TaxTotals, cur)
+
+	dn.RequestedMonetaryTotal = &MonetaryTotal{
+		LineExtensionAmount:       NewAmount(hd.LineExtensionAmount, cur),
+		TaxExclusiveAmount:        NewAmount(hd.TaxExclusiveAmount, cur),
+		TaxInclusiveAmount:        NewAmount(hd.TaxInclusiveAmount, cur),
+		AllowanceTotalAmount:      OptionalAmount(hd.AllowanceTotalAmount, cur),
+		ChargeTotalAmount:         OptionalAmount(hd.ChargeTotalAmount, cur),
+		WithholdingTaxTotalAmount: OptionalAmount(hd.WithholdingTaxTotalAmount, cur),
+		PrepaidAmount:             OptionalAmount(hd.PrepaidAmount, cur),
+		PayableRoundingAmount:     OptionalAmount(hd.PayableRoundingAmount, cur),
+		PayableAmount:             NewAmount(hd.PayableAmount, cur),
+		PayableAlternativeAmount:  OptionalAmount(hd.PayableAlternativeAmount, rowCurrency(hd.PayableAlternativeCurrencyCode, hd.PaymentAltCurrencyCode)),
+	}
+
+	for i, line := range src.DebitNoteLines {
+		dn.DebitNoteLine = append(dn.DebitNoteLine, src.debitNoteLine(i, line, cur))
+	}
+	return &dn, nil
+}
+
+// debitNoteLine - map a DebitNoteLine
+func (src *DebitNoteSource) debitNoteLine(i int, line *invoiceproto.DebitNoteLine, cur string) DebitNoteLine {
+	ld := line.DebitNoteLineD
+	cur = rowCurrency(ld.CurrencyCode, cur)
+	lt := line.DebitNoteLineT
+	if lt == nil {
+		lt = &invoiceproto.DebitNoteLineT{}
+	}
+	dl := DebitNoteLine{}
+	dl.ID = lineID(ld.DnlId, i)
+	dl.UUID = ld.IdS
+	dl.Note = notes(ld.Note)
+	dl.DebitedQuantity = &Quantity{Value: FormatDecimal(ld.DebitedQuantity), UnitCode: ld.UnitCode}
+	dl.LineExtensionAmount = NewAmount(ld.LineExtensionAmount, cur)
+	dl.TaxPointDate = FormatDate(lt.TaxPointDate)
+	dl.AccountingCostCode = ld.AccountingCostCode
+	dl.AccountingCost = ld.AccountingCost
+	dl.PaymentPurposeCode = ld.PaymentPurposeCode
+	dl.DiscrepancyResponse = discrepancyResponse(ld.DiscrepancyResponse)
+	if id := src.reference(ReferenceDespatchLine, ld.DespatchLineId); id != "" {
+		dl.DespatchLineReference = []LineReference{{LineID: id}}
+	}
+	if id := src.reference(ReferenceReceiptLine, ld.ReceiptLineId); id != "" {
+		dl.ReceiptLineReference = []LineReference{{LineID: id}}
+	}
+	dl.BillingReference = billingReference(src.reference(ReferenceInvoice, ld.BillingId))
+	dl.TaxTotal = taxTotals(src.LineTaxTotals[ld.Id], cur)
+	dl.AllowanceCharge = allowanceCharges(src.LineAllowanceCharges[ld.Id], cur)
+	dl.Item = item(src.Items[ld.ItemId])
+	dl.Price = price(ld.PriceAmount, ld.PriceBaseQuantity, ld.PriceChangeReason, ld.PriceTypeCode, ld.PriceType, ld.OrderableUnitFactorRate, FormatDate(lt.PriceValidityPeriodStartDate), FormatDate(lt.PriceValidityPeriodEndDate), cur)
+	return dl
+}
+
+// DebitNoteImport - Create requests for an imported UBL DebitNote
+type DebitNoteImport struct {
+	DebitNote    *invoiceproto.CreateDebitNoteHeaderRequest
+	PaymentTerms []*paymentproto.CreatePaymentTermRequest
+}
+
+// ImportDebitNote - map a UBL DebitNote onto a
+// CreateDebitNoteHeaderRequest. Elements that cannot be mapped are returned
+// as FieldErrors; parties are only created when there are none.
+func ImportDebitNote(ctx context.Context, dn *DebitNote, resolver Resolver) (*DebitNoteImport, error) {
+	const root = "/DebitNote"
+	im := importer{ctx: ctx, resolver: resolver, currency: dn.DocumentCurrencyCode}
+	in := invoiceproto.CreateDebitNoteHeaderRequest{}
+
+	if strings.TrimSpace(dn.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if dn.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", dn.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.DnhId = strings.TrimSpace(dn.ID)
+	in.Note = strings.Join(dn.Note, "\n")
+	in.TaxPointDate = im.date(root+"/cbc:TaxPointDate", dn.TaxPointDate)
+	in.DocumentCurrencyCode = dn.DocumentCurrencyCode
+	in.TaxCurrencyCode = dn.TaxCurrencyCode
+	in.PricingCurrencyCode = dn.PricingCurrencyCode
+	in.PaymentCurrencyCode = dn.PaymentCurrencyCode
+	in.PaymentAltCurrencyCode = dn.PaymentAlternativeCurrencyCode
+	in.AccountingCostCode = dn.AccountingCostCode
+	in.AccountingCost = dn.AccountingCost
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", dn.LineCountNumeric)
+	in.InvoicePeriodStartDate, in.InvoicePeriodEndDate = im.period(root+"/cac:InvoicePeriod", dn.InvoicePeriod)
+	in.DiscrepancyResponse = discrepancyReason(dn.DiscrepancyResponse)
+
+	if dn.OrderReference != nil {
+		in.OrderId = im.reference(root+"/cac:OrderReference/cbc:ID", ReferenceOrder, dn.OrderReference.ID, 0)
+	}
+	in.BillingId = im.billingReference(root+"/cac:BillingReference", dn.BillingReference)
+	for i, ref := range dn.DespatchDocumentReference {
+		path := fmt.Sprintf("%s/cac:DespatchDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one despatch advice can be referenced")
+			continue
+		}
+		in.DespatchId = im.reference(path+"/cbc:ID", ReferenceDespatch, ref.ID, 0)
+	}
+	for i, ref := range dn.ReceiptDocumentReference {
+		path := fmt.Sprintf("%s/cac:ReceiptDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one receipt advice can be referenced")
+			continue
+		}
+		in.ReceiptId = im.reference(path+"/cbc:ID", ReferenceReceipt, ref.ID, 0)
+	}
+
+	if dn.AccountingSupplierParty == nil || dn.AccountingSupplierParty.Party == nil {
+		im.fail(root+"/cac:AccountingSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingSupplierParty/cac:Party", dn.AccountingSupplierParty.Party, &in.AccountingSupplierPartyId)
+	}
+	if dn.AccountingCustomerParty == nil || dn.AccountingCustomerParty.Party == nil {
+		im.fail(root+"/cac:AccountingCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingCustomerParty/cac:Party", dn.AccountingCustomerParty.Party, &in.AccountingCustomerPartyId)
+	}
+	im.party(root+"/cac:PayeeParty", dn.PayeeParty, &in.PayeePartyId)
+	if dn.BuyerCustomerParty != nil {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", dn.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if dn.SellerSupplierParty != nil {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", dn.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	im.party(root+"/cac:TaxRepresentativeParty", dn.TaxRepresentativeParty, &in.TaxRepresentativePartyId)
+
+	paymentTerms := []*paymentproto.CreatePaymentTermRequest{}
+	for i := range dn.PaymentTerms {
+		path := fmt.Sprintf("%s/cac:PaymentTerms[%d]", root, i+1)
+		paymentTerms = append(paymentTerms, im.paymentTerms(path, &dn.PaymentTerms[i]))
+	}
+
+	taxEx := im.exchangeRate(root+"/cac:TaxExchangeRate", dn.TaxExchangeRate)
+	in.TaxExSourceCurrencyCode = taxEx.SourceCurrencyCode
+	in.TaxExSourceCurrencyBaseRate = taxEx.SourceCurrencyBaseRate
+	in.TaxExTargetCurrencyCode = taxEx.TargetCurrencyCode
+	in.TaxExTargetCurrencyBaseRate = taxEx.TargetCurrencyBaseRate
+	in.TaxExExchangeMarketId = taxEx.ExchangeMarketID
+	in.TaxExCalculationRate = taxEx.CalculationRate
+	in.TaxExMathematicOperatorCode = taxEx.MathematicOperatorCode
+	in.TaxExDate = taxEx.Date
+
+	pricingEx := im.exchangeRate(root+"/cac:PricingExchangeRate", dn.PricingExchangeRate)
+	in.PricingExSourceCurrencyCode = pricingEx.SourceCurrencyCode
+	in.PricingExSourceCurrencyBaseRate = pricingEx.SourceCurrencyBaseRate
+	in.PricingExTargetCurrencyCode = pricingEx.TargetCurrencyCode
+	in.PricingExTargetCurrencyBaseRate = pricingEx.TargetCurrencyBaseRate
+	in.PricingExExchangeMarketId = pricingEx.ExchangeMarketID
+	in.PricingExCalculationRate = pricingEx.CalculationRate
+	in.PricingExMathematicOperatorCode = pricingEx.MathematicOperatorCode
+	in.PricingExDate = pricingEx.Date
+
+	paymentEx := im.exchangeRate(root+"/cac:PaymentExchangeRate", dn.PaymentExchangeRate)
+	in.PaymentExSourceCurrencyCode = paymentEx.SourceCurrencyCode
+	in.PaymentExSourceCurrencyBaseRate = paymentEx.SourceCurrencyBaseRate
+	in.PaymentExTargetCurrencyCode = paymentEx.TargetCurrencyCode
+	in.PaymentExTargetCurrencyBaseRate = paymentEx.TargetCurrencyBaseRate
+	in.PaymentExExchangeMarketId = paymentEx.ExchangeMarketID
+	in.PaymentExCalculationRate = paymentEx.CalculationRate
+	in.PaymentExMathematicOperatorCode = paymentEx.MathematicOperatorCode
+	in.PaymentExDate = paymentEx.Date
+
+	paymentAltEx := im.exchangeRate(root+"/cac:PaymentAlternativeExchangeRate", dn.PaymentAlternativeExchangeRate)
+	in.PaymentAltExSourceCurrencyCode = paymentAltEx.SourceCurrencyCode
+	in.PaymentAltExSourceCurrencyBaseRate = paymentAltEx.SourceCurrencyBaseRate
+	in.PaymentAltExTargetCurrencyCode = paymentAltEx.TargetCurrencyCode
+	in.PaymentAltExTargetCurrencyBaseRate = paymentAltEx.TargetCurrencyBaseRate
+	in.PaymentAltExExchangeMarketId = paymentAltEx.ExchangeMarketID
+	in.PaymentAltExCalculationRate = paymentAltEx.CalculationRate
+	in.PaymentAltExMathematicOperatorCode = paymentAltEx.MathematicOperatorCode
+	in.PaymentAltExDate = paymentAltEx.Date
+
+	if rmt := dn.RequestedMonetaryTotal; rmt == nil || rmt.PayableAmount == nil {
+		im.fail(root+"/cac:RequestedMonetaryTotal/cbc:PayableAmount", "is required")
+	} else {
+		path := root + "/cac:RequestedMonetaryTotal"
+		in.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", rmt.LineExtensionAmount)
+		in.TaxExclusiveAmount = im.amount(path+"/cbc:TaxExclusiveAmount", rmt.TaxExclusiveAmount)
+		in.TaxInclusiveAmount = im.amount(path+"/cbc:TaxInclusiveAmount", rmt.TaxInclusiveAmount)
+		in.AllowanceTotalAmount = im.amount(path+"/cbc:AllowanceTotalAmount", rmt.AllowanceTotalAmount)
+		in.ChargeTotalAmount = im.amount(path+"/cbc:ChargeTotalAmount", rmt.ChargeTotalAmount)
+		in.WithholdingTaxTotalAmount = im.amount(path+"/cbc:WithholdingTaxTotalAmount", rmt.WithholdingTaxTotalAmount)
+		in.PrepaidAmount = im.amount(path+"/cbc:PrepaidAmount", rmt.PrepaidAmount)
+		in.PayableRoundingAmount = im.amount(path+"/cbc:PayableRoundingAmount", rmt.PayableRoundingAmount)
+		in.PayableAmount = im.amount(path+"/cbc:PayableAmount", rmt.PayableAmount)
+		if a := rmt.PayableAlternativeAmount; a != nil {
+			in.PayableAlternativeAmount = im.money(path+"/cbc:PayableAlternativeAmount", a.Value)
+			in.PayableAlternativeCurrencyCode = a.CurrencyID
+		}
+	}
+
+	in.AllowanceCharges = im.allowanceCharges(root, dn.AllowanceCharge)
+
+	if len(dn.DebitNoteLine) == 0 {
+		im.fail(root+"/cac:DebitNoteLine", "at least one debit note line is required")
+	}
+	for i := range dn.DebitNoteLine {
+		path := fmt.Sprintf("%s/cac:DebitNoteLine[%d]", root, i+1)
+		in.DebitNoteLines = append(in.DebitNoteLines, im.debitNoteLine(path, &dn.DebitNoteLine[i], &in))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &DebitNoteImport{DebitNote: &in, PaymentTerms: paymentTerms}, nil
+}
+
+// debitNoteLine - map a cac:DebitNoteLine onto a
+// CreateDebitNoteLineRequest, line references are searched within the
+// documents referenced by the header
+func (im *importer) debitNoteLine(path string, dl *DebitNoteLine, hd *invoiceproto.CreateDebitNoteHeaderRequest) *invoiceproto.CreateDebitNoteLineRequest {
+	line := invoiceproto.CreateDebitNoteLineRequest{}
+	line.DnlId = strings.TrimSpace(dl.ID)
+	line.Note = strings.Join(dl.Note, "\n")
+	line.DebitedQuantity = im.quantity(path+"/cbc:DebitedQuantity", dl.DebitedQuantity)
+	line.UnitCode = im.unitCode(path+"/cbc:DebitedQuantity", dl.DebitedQuantity)
+	line.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", dl.LineExtensionAmount)
+	line.TaxPointDate = im.date(path+"/cbc:TaxPointDate", dl.TaxPointDate)
+	line.AccountingCostCode = dl.AccountingCostCode
+	line.AccountingCost = dl.AccountingCost
+	line.PaymentPurposeCode = dl.PaymentPurposeCode
+	line.DiscrepancyResponse = discrepancyReason(dl.DiscrepancyResponse)
+	line.DespatchLineId = im.lineReference(path+"/cac:DespatchLineReference", dl.DespatchLineReference, ReferenceDespatch, ReferenceDespatchLine, hd.DespatchId)
+	line.ReceiptLineId = im.lineReference(path+"/cac:ReceiptLineReference", dl.ReceiptLineReference, ReferenceReceipt, ReferenceReceiptLine, hd.ReceiptId)
+	line.BillingId = im.billingReference(path+"/cac:BillingReference", dl.BillingReference)
+
+	if dl.Item == nil {
+		im.fail(path+"/cac:Item", "is required")
+	} else {
+		line.ItemId = im.item(path+"/cac:Item", dl.Item)
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(path+"/cac:Item", "no item matches its identifications or name %q", dl.Item.Name)
+		}
+	}
+
+	line.AllowanceCharges = im.allowanceCharges(path, dl.AllowanceCharge)
+
+	if p := dl.Price; p != nil {
+		line.PriceAmount = im.amount(path+"/cac:Price/cbc:PriceAmount", p.PriceAmount)
+		line.PriceBaseQuantity = im.quantity(path+"/cac:Price/cbc:BaseQuantity", p.BaseQuantity)
+		line.PriceChangeReason = p.PriceChangeReason
+		line.PriceTypeCode = p.PriceTypeCode
+		line.PriceType = p.PriceType
+		line.OrderableUnitFactorRate = im.decimal(path+"/cac:Price/cbc:OrderableUnitFactorRate", p.OrderableUnitFactorRate)
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.period(path+"/cac:Price/cac:ValidityPeriod", p.ValidityPeriod)
+	} else {
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.defaultDate, im.defaultDate
+	}
+	return &line
+}
@@ -0,0 +1,146 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDebitNoteSource() *DebitNoteSource {
+	debitNoteHeaderD := invoiceproto.DebitNoteHeaderD{}
+	debitNoteHeaderD.Id = 1
+	debitNoteHeaderD.IdS = "930f8806-db24-4562-b8c7-72df75518355"
+	debitNoteHeaderD.DnhId = "DN-1"
+	debitNoteHeaderD.Note = "Ordered in our booth at the convention"
+	debitNoteHeaderD.DocumentCurrencyCode = "EUR"
+	debitNoteHeaderD.DiscrepancyResponse = "Price correction"
+	debitNoteHeaderD.BillingId = 1
+	debitNoteHeaderD.AccountingSupplierPartyId = 1
+	debitNoteHeaderD.AccountingCustomerPartyId = 2
+	debitNoteHeaderD.LineExtensionAmount = "1273"
+	debitNoteHeaderD.TaxExclusiveAmount = "1273"
+	debitNoteHeaderD.TaxInclusiveAmount = "1591.25"
+	debitNoteHeaderD.PayableAmount = "1591.25"
+
+	debitNoteHeaderT := invoiceproto.DebitNoteHeaderT{}
+	debitNoteHeaderT.IssueDate = testDate("2005-12-15")
+
+	debitNoteLineD := invoiceproto.DebitNoteLineD{}
+	debitNoteLineD.Id = 1
+	debitNoteLineD.DnlId = "1"
+	debitNoteLineD.DebitedQuantity = 1
+	debitNoteLineD.UnitCode = "C62"
+	debitNoteLineD.LineExtensionAmount = "1273"
+	debitNoteLineD.BillingId = 1
+	debitNoteLineD.ItemId = 3
+	debitNoteLineD.PriceAmount = "1273"
+
+	src := DebitNoteSource{
+		DebitNoteHeader: &invoiceproto.DebitNoteHeader{DebitNoteHeaderD: &debitNoteHeaderD, DebitNoteHeaderT: &debitNoteHeaderT},
+		DebitNoteLines:  []*invoiceproto.DebitNoteLine{{DebitNoteLineD: &debitNoteLineD, DebitNoteLineT: &invoiceproto.DebitNoteLineT{}}},
+		DocumentSource:  testDocumentSource(),
+	}
+	src.References = map[string]map[uint32]string{ReferenceInvoice: {1: "TOSL108"}}
+	return &src
+}
+
+func TestDebitNoteFromSource(t *testing.T) {
+	dn, err := DebitNoteFromSource(testDebitNoteSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(dn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<DebitNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:DebitNote-2"`)
+	assert.Contains(t, xmlStr, `<cbc:Description>Price correction</cbc:Description>`)
+	assert.Contains(t, xmlStr, `<cbc:DebitedQuantity unitCode="C62">1</cbc:DebitedQuantity>`)
+	assert.Contains(t, xmlStr, `<cac:RequestedMonetaryTotal>`)
+	assert.NotContains(t, xmlStr, `<cac:LegalMonetaryTotal>`)
+	assert.Equal(t, 2, strings.Count(xmlStr, `<cbc:ID>TOSL108</cbc:ID>`), "header and line billing references")
+
+	// cbc/cac elements must follow the sequence of the DebitNote schema
+	order := []string{"<cbc:ID>DN-1", "<cbc:IssueDate>", "<cbc:Note>", "<cbc:DocumentCurrencyCode>", "<cac:DiscrepancyResponse>", "<cac:BillingReference>", "<cac:AccountingSupplierParty>", "<cac:PaymentTerms>", "<cac:TaxTotal>", "<cac:RequestedMonetaryTotal>", "<cac:DebitNoteLine>", "<cbc:DebitedQuantity ", "<cac:BillingReference>", "<cac:Item>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr[last+1:], elem)
+		assert.GreaterOrEqual(t, i, 0, "element %s out of order", elem)
+		last += i + 1
+	}
+}
+
+func TestImportDebitNote(t *testing.T) {
+	dn, err := DebitNoteFromSource(testDebitNoteSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(dn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := DebitNote{}
+	err = Unmarshal(out, NamespaceDebitNote, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties:    map[string]uint32{"7300010000001DK12345678": 7},
+		references: map[string]uint32{ReferenceInvoice + ":TOSL108": 1},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	debitNoteImport, err := ImportDebitNote(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := debitNoteImport.DebitNote
+	assert.Equal(t, "DN-1", in.DnhId)
+	assert.Equal(t, "12/15/2005", in.IssueDate)
+	assert.Equal(t, "Price correction", in.DiscrepancyResponse)
+	assert.Equal(t, uint32(1), in.BillingId)
+	assert.Equal(t, uint32(7), in.AccountingSupplierPartyId)
+	assert.Equal(t, uint32(100), in.AccountingCustomerPartyId)
+	assert.Equal(t, "1591.25", in.PayableAmount)
+	assert.Equal(t, 1, len(in.DebitNoteLines))
+	assert.Equal(t, float64(1), in.DebitNoteLines[0].DebitedQuantity)
+	assert.Equal(t, uint32(1), in.DebitNoteLines[0].BillingId)
+	assert.Equal(t, uint32(5), in.DebitNoteLines[0].ItemId)
+	assert.Equal(t, 1, len(debitNoteImport.PaymentTerms))
+}
+
+func TestImportDebitNoteRequestedMonetaryTotal(t *testing.T) {
+	doc := `<DebitNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:DebitNote-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>DN-2</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cac:AccountingSupplierParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty><cac:Party><cbc:EndpointID>7300010000002</cbc:EndpointID></cac:Party></cac:AccountingCustomerParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount>10.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+  <cac:DebitNoteLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:DebitedQuantity unitCode="C62">1</cbc:DebitedQuantity>
+    <cbc:LineExtensionAmount>10.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item>
+  </cac:DebitNoteLine>
+</DebitNote>`
+	dn := DebitNote{}
+	err := Unmarshal([]byte(doc), NamespaceDebitNote, &dn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{items: map[string]uint32{"Labtop computer": 5}}
+	_, err = ImportDebitNote(context.Background(), &dn, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportDebitNote() error = %v, want FieldErrors", err)
+	}
+	assert.Equal(t, 1, len(fieldErrors))
+	assert.Equal(t, "/DebitNote/cac:RequestedMonetaryTotal/cbc:PayableAmount", fieldErrors[0].Path)
+}
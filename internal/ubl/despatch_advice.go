@@ -0,0 +1,262 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+)
+
+// DespatchAdvice - UBL 2.3 DespatchAdvice document
+type DespatchAdvice struct {
+	XMLName xml.Name `xml:"DespatchAdvice"`
+	Namespaces
+	UBLVersionID            string           `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID         string           `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID               string           `xml:"cbc:ProfileID,omitempty"`
+	ID                      string           `xml:"cbc:ID"`
+	UUID                    string           `xml:"cbc:UUID,omitempty"`
+	IssueDate               string           `xml:"cbc:IssueDate"`
+	DocumentStatusCode      string           `xml:"cbc:DocumentStatusCode,omitempty"`
+	DespatchAdviceTypeCode  string           `xml:"cbc:DespatchAdviceTypeCode,omitempty"`
+	Note                    []string         `xml:"cbc:Note,omitempty"`
+	LineCountNumeric        string           `xml:"cbc:LineCountNumeric,omitempty"`
+	OrderReference          []OrderReference `xml:"cac:OrderReference,omitempty"`
+	DespatchSupplierParty   *SupplierParty   `xml:"cac:DespatchSupplierParty"`
+	DeliveryCustomerParty   *CustomerParty   `xml:"cac:DeliveryCustomerParty"`
+	BuyerCustomerParty      *CustomerParty   `xml:"cac:BuyerCustomerParty,omitempty"`
+	SellerSupplierParty     *SupplierParty   `xml:"cac:SellerSupplierParty,omitempty"`
+	OriginatorCustomerParty *CustomerParty   `xml:"cac:OriginatorCustomerParty,omitempty"`
+	Shipment                *Shipment        `xml:"cac:Shipment,omitempty"`
+	DespatchLine            []DespatchLine   `xml:"cac:DespatchLine"`
+}
+
+// DespatchAdviceSource - everything needed to build a UBL DespatchAdvice
+type DespatchAdviceSource struct {
+	DespatchHeader *logisticsproto.DespatchHeader
+	DespatchLines  []*logisticsproto.DespatchLine
+	DocumentSource
+}
+
+// NotApplicable - cbc:LineID of a cac:OrderLineReference written for a line
+// that was not ordered, the reference being mandatory
+const NotApplicable = "NA"
+
+// DespatchAdviceFromSource - build a UBL DespatchAdvice
+func DespatchAdviceFromSource(src *DespatchAdviceSource) (*DespatchAdvice, error) {
+	if src == nil || src.DespatchHeader == nil || src.DespatchHeader.DespatchHeaderD == nil {
+		return nil, errors.New("ubl: despatch header is required")
+	}
+	hd := src.DespatchHeader.DespatchHeaderD
+	ht := src.DespatchHeader.DespatchHeaderT
+	if ht == nil {
+		ht = &logisticsproto.DespatchHeaderT{}
+	}
+
+	da := DespatchAdvice{Namespaces: NewNamespaces(NamespaceDespatchAdvice)}
+	da.UBLVersionID = Version
+	da.ID = documentID(hd.DesphId, hd.IdS)
+	da.UUID = hd.IdS
+	da.IssueDate = FormatDate(ht.IssueDate)
+	da.DocumentStatusCode = hd.DocumentStatusCode
+	da.DespatchAdviceTypeCode = hd.DespatchAdviceTypeCode
+	da.Note = notes(hd.Note)
+	if hd.LineCountNumeric != 0 {
+		da.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		da.OrderReference = []OrderReference{{ID: id}}
+	}
+
+	da.DespatchSupplierParty = &SupplierParty{Party: src.party(hd.DespatchSupplierPartyId)}
+	da.DeliveryCustomerParty = &CustomerParty{Party: src.party(hd.DeliveryCustomerPartyId)}
+	if p := src.party(hd.BuyerCustomerPartyId); p != nil {
+		da.BuyerCustomerParty = &CustomerParty{Party: p}
+	}
+	if p := src.party(hd.SellerSupplierPartyId); p != nil {
+		da.SellerSupplierParty = &SupplierParty{Party: p}
+	}
+	if p := src.party(hd.OriginatorCustomerPartyId); p != nil {
+		da.OriginatorCustomerParty = &CustomerParty{Party: p}
+	}
+	if id := src.reference(ReferenceShipment, hd.ShipmentId); id != "" {
+		da.Shipment = &Shipment{ID: id}
+	}
+
+	for i, line := range src.DespatchLines {
+		da.DespatchLine = append(da.DespatchLine, src.despatchLine(i, line))
+	}
+	return &da, nil
+}
+
+// despatchLine - map a DespatchLine. A line without an order line refers
+// to the NotApplicable order line.
+func (src *DespatchAdviceSource) despatchLine(i int, line *logisticsproto.DespatchLine) DespatchLine {
+	ld := line.DespatchLineD
+	dl := DespatchLine{}
+	dl.ID = lineID(documentID(ld.DesplId, ld.IdS), i)
+	dl.UUID = ld.IdS
+	dl.Note = notes(ld.Note)
+	dl.LineStatusCode = ld.LineStatusCode
+	dl.DeliveredQuantity = &Quantity{Value: FormatDecimal(ld.DeliveredQuantity)}
+	dl.BackorderQuantity = optionalQuantity(ld.BackorderQuantity)
+	dl.BackorderReason = notes(ld.BackorderReason)
+	dl.OutstandingQuantity = optionalQuantity(ld.OutstandingQuantity)
+	dl.OutstandingReason = notes(ld.OutstandingReason)
+	dl.OversupplyQuantity = optionalQuantity(ld.OversupplyQuantity)
+	orderLineID := src.reference(ReferenceOrderLine, ld.OrderLineId)
+	if orderLineID == "" {
+		orderLineID = NotApplicable
+	}
+	dl.OrderLineReference = []OrderLineReference{{LineID: orderLineID}}
+	dl.Item = item(src.Items[ld.ItemId])
+	if id := src.reference(ReferenceShipment, ld.ShipmentId); id != "" {
+		dl.Shipment = []Shipment{{ID: id}}
+	}
+	return dl
+}
+
+// ImportDespatchAdvice - map a UBL DespatchAdvice onto a
+// CreateDespatchHeaderRequest. Elements that cannot be mapped are returned
+// as FieldErrors; parties are only created when there are none.
+func ImportDespatchAdvice(ctx context.Context, da *DespatchAdvice, resolver Resolver) (*logisticsproto.CreateDespatchHeaderRequest, error) {
+	const root = "/DespatchAdvice"
+	im := importer{ctx: ctx, resolver: resolver}
+	in := logisticsproto.CreateDespatchHeaderRequest{}
+
+	if strings.TrimSpace(da.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if da.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", da.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.DesphId = strings.TrimSpace(da.ID)
+	in.DocumentStatusCode = da.DocumentStatusCode
+	in.DespatchAdviceTypeCode = da.DespatchAdviceTypeCode
+	in.Note = strings.Join(da.Note, "\n")
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", da.LineCountNumeric)
+	in.OrderId = im.orderReference(root+"/cac:OrderReference", da.OrderReference)
+
+	if da.DespatchSupplierParty == nil || da.DespatchSupplierParty.Party == nil {
+		im.fail(root+"/cac:DespatchSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:DespatchSupplierParty/cac:Party", da.DespatchSupplierParty.Party, &in.DespatchSupplierPartyId)
+	}
+	if da.DeliveryCustomerParty == nil || da.DeliveryCustomerParty.Party == nil {
+		im.fail(root+"/cac:DeliveryCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:DeliveryCustomerParty/cac:Party", da.DeliveryCustomerParty.Party, &in.DeliveryCustomerPartyId)
+	}
+	if da.BuyerCustomerParty != nil {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", da.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if da.SellerSupplierParty != nil {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", da.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	if da.OriginatorCustomerParty != nil {
+		im.party(root+"/cac:OriginatorCustomerParty/cac:Party", da.OriginatorCustomerParty.Party, &in.OriginatorCustomerPartyId)
+	}
+	if da.Shipment != nil {
+		in.ShipmentId = im.reference(root+"/cac:Shipment/cbc:ID", ReferenceShipment, da.Shipment.ID, 0)
+	}
+
+	if len(da.DespatchLine) == 0 {
+		im.fail(root+"/cac:DespatchLine", "at least one despatch line is required")
+	}
+	for i := range da.DespatchLine {
+		path := fmt.Sprintf("%s/cac:DespatchLine[%d]", root, i+1)
+		in.DespatchLines = append(in.DespatchLines, im.despatchLine(path, &da.DespatchLine[i], in.OrderId))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &in, nil
+}
+
+// despatchLine - map a cac:DespatchLine onto a CreateDespatchLineRequest
+func (im *importer) despatchLine(path string, dl *DespatchLine, orderID uint32) *logisticsproto.CreateDespatchLineRequest {
+	line := logisticsproto.CreateDespatchLineRequest{}
+	line.DesplId = strings.TrimSpace(dl.ID)
+	line.Note = strings.Join(dl.Note, "\n")
+	line.LineStatusCode = dl.LineStatusCode
+	line.DeliveredQuantity = im.quantity(path+"/cbc:DeliveredQuantity", dl.DeliveredQuantity)
+	line.BackorderQuantity = im.quantity(path+"/cbc:BackorderQuantity", dl.BackorderQuantity)
+	line.BackorderReason = strings.Join(dl.BackorderReason, "\n")
+	line.OutstandingQuantity = im.quantity(path+"/cbc:OutstandingQuantity", dl.OutstandingQuantity)
+	line.OutstandingReason = strings.Join(dl.OutstandingReason, "\n")
+	line.OversupplyQuantity = im.quantity(path+"/cbc:OversupplyQuantity", dl.OversupplyQuantity)
+	line.OrderLineId = im.orderLineReferences(path+"/cac:OrderLineReference", dl.OrderLineReference, orderID)
+
+	if dl.Item == nil {
+		im.fail(path+"/cac:Item", "is required")
+	} else {
+		line.ItemId = im.item(path+"/cac:Item", dl.Item)
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(path+"/cac:Item", "no item matches its identifications or name %q", dl.Item.Name)
+		}
+	}
+	line.ShipmentId = im.shipment(path+"/cac:Shipment", dl.Shipment)
+	return &line
+}
+
+// orderReference - id of the purchase order of a cac:OrderReference, only
+// one order can be referenced
+func (im *importer) orderReference(path string, refs []OrderReference) uint32 {
+	var orderID uint32
+	for i, ref := range refs {
+		refPath := fmt.Sprintf("%s[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one order can be referenced")
+			continue
+		}
+		orderID = im.reference(refPath+"/cbc:ID", ReferenceOrder, ref.ID, 0)
+	}
+	return orderID
+}
+
+// orderLineReferences - id of the purchase order line of a
+// cac:OrderLineReference, searched within its own order reference or the
+// order referenced by the header. NotApplicable refers to no line.
+func (im *importer) orderLineReferences(path string, refs []OrderLineReference, orderID uint32) uint32 {
+	var lineID uint32
+	for i, ref := range refs {
+		refPath := fmt.Sprintf("%s[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one order line can be referenced")
+			continue
+		}
+		if id := strings.TrimSpace(ref.LineID); id == "" || id == NotApplicable {
+			continue
+		}
+		if ref.OrderReference != nil {
+			orderID = im.reference(refPath+"/cac:OrderReference/cbc:ID", ReferenceOrder, ref.OrderReference.ID, 0)
+		}
+		lineID = im.reference(refPath+"/cbc:LineID", ReferenceOrderLine, ref.LineID, orderID)
+	}
+	return lineID
+}
+
+// shipment - id of the shipment of a line, only one can be referenced
+func (im *importer) shipment(path string, shipments []Shipment) uint32 {
+	var shipmentID uint32
+	for i, sh := range shipments {
+		shPath := fmt.Sprintf("%s[%d]", path, i+1)
+		if i > 0 {
+			im.fail(shPath, "only one shipment can be referenced")
+			continue
+		}
+		shipmentID = im.reference(shPath+"/cbc:ID", ReferenceShipment, sh.ID, 0)
+	}
+	return shipmentID
+}
@@ -0,0 +1,153 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDespatchAdviceSource() *DespatchAdviceSource {
+	despatchHeaderD := logisticsproto.DespatchHeaderD{}
+	despatchHeaderD.Id = 1
+	despatchHeaderD.IdS = "d4a4b3c2-2ad1-4bd8-9b3e-64e5ab4a4c8f"
+	despatchHeaderD.DesphId = "565899"
+	despatchHeaderD.DocumentStatusCode = "NoStatus"
+	despatchHeaderD.DespatchAdviceTypeCode = "delivery"
+	despatchHeaderD.Note = "Partial delivery"
+	despatchHeaderD.OrderId = 3
+	despatchHeaderD.DespatchSupplierPartyId = 1
+	despatchHeaderD.DeliveryCustomerPartyId = 2
+	despatchHeaderD.ShipmentId = 4
+
+	despatchLineD := logisticsproto.DespatchLineD{}
+	despatchLineD.Id = 1
+	despatchLineD.DesplId = "1"
+	despatchLineD.LineStatusCode = "NoStatus"
+	despatchLineD.DeliveredQuantity = 90
+	despatchLineD.BackorderQuantity = 10
+	despatchLineD.BackorderReason = "Out of stock"
+	despatchLineD.OutstandingQuantity = 10
+	despatchLineD.OutstandingReason = "Delivered next week"
+	despatchLineD.OrderLineId = 6
+	despatchLineD.ItemId = 3
+
+	documentSource := testDocumentSource()
+	documentSource.References[ReferenceOrderLine] = map[uint32]string{6: "1"}
+	documentSource.References[ReferenceShipment] = map[uint32]string{4: "SH-1"}
+
+	return &DespatchAdviceSource{
+		DespatchHeader: &logisticsproto.DespatchHeader{DespatchHeaderD: &despatchHeaderD, DespatchHeaderT: &logisticsproto.DespatchHeaderT{IssueDate: testDate("2005-06-20")}},
+		DespatchLines:  []*logisticsproto.DespatchLine{{DespatchLineD: &despatchLineD}, {DespatchLineD: &logisticsproto.DespatchLineD{Id: 2, DeliveredQuantity: 1, ItemId: 3}}},
+		DocumentSource: documentSource,
+	}
+}
+
+func TestDespatchAdviceFromSource(t *testing.T) {
+	da, err := DespatchAdviceFromSource(testDespatchAdviceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(da)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<DespatchAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:DespatchAdvice-2"`)
+	assert.Contains(t, xmlStr, `<cbc:DeliveredQuantity>90</cbc:DeliveredQuantity>`)
+	assert.Contains(t, xmlStr, `<cbc:BackorderReason>Out of stock</cbc:BackorderReason>`)
+	assert.Contains(t, xmlStr, `<cbc:OutstandingReason>Delivered next week</cbc:OutstandingReason>`)
+	assert.Contains(t, xmlStr, "<cac:OrderLineReference>\n      <cbc:LineID>NA</cbc:LineID>\n    </cac:OrderLineReference>", "the order line reference is mandatory")
+	assert.NotContains(t, xmlStr, `<cbc:OversupplyQuantity>`)
+
+	// cbc/cac elements must follow the sequence of the DespatchAdvice schema
+	order := []string{"<cbc:ID>565899", "<cbc:IssueDate>", "<cbc:DocumentStatusCode>", "<cbc:DespatchAdviceTypeCode>", "<cbc:Note>", "<cac:OrderReference>", "<cac:DespatchSupplierParty>", "<cac:DeliveryCustomerParty>", "<cac:Shipment>", "<cac:DespatchLine>", "<cbc:LineStatusCode>", "<cbc:DeliveredQuantity>", "<cbc:BackorderQuantity>", "<cbc:OutstandingQuantity>", "<cac:OrderLineReference>", "<cac:Item>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr, elem)
+		assert.Greater(t, i, last, "element %s out of order", elem)
+		last = i
+	}
+}
+
+func TestImportDespatchAdvice(t *testing.T) {
+	da, err := DespatchAdviceFromSource(testDespatchAdviceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(da)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := DespatchAdvice{}
+	err = Unmarshal(out, NamespaceDespatchAdvice, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties:    map[string]uint32{"7300010000001DK12345678": 7},
+		references: map[string]uint32{ReferenceOrder + ":123": 3, ReferenceOrderLine + ":1": 6, ReferenceShipment + ":SH-1": 4},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	in, err := ImportDespatchAdvice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "565899", in.DesphId)
+	assert.Equal(t, "06/20/2005", in.IssueDate)
+	assert.Equal(t, "delivery", in.DespatchAdviceTypeCode)
+	assert.Equal(t, uint32(3), in.OrderId)
+	assert.Equal(t, uint32(4), in.ShipmentId)
+	assert.Equal(t, uint32(7), in.DespatchSupplierPartyId)
+	assert.Equal(t, uint32(100), in.DeliveryCustomerPartyId)
+	assert.Equal(t, 2, len(in.DespatchLines))
+	line := in.DespatchLines[0]
+	assert.Equal(t, float64(90), line.DeliveredQuantity)
+	assert.Equal(t, float64(10), line.BackorderQuantity)
+	assert.Equal(t, "Out of stock", line.BackorderReason)
+	assert.Equal(t, "Delivered next week", line.OutstandingReason)
+	assert.Equal(t, uint32(6), line.OrderLineId)
+	assert.Equal(t, uint32(5), line.ItemId)
+	assert.Equal(t, uint32(0), in.DespatchLines[1].OrderLineId, "NA refers to no order line")
+}
+
+func TestImportDespatchAdviceFieldErrors(t *testing.T) {
+	doc := `<DespatchAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:DespatchAdvice-2" ` + testOrderNamespaces + `>
+  <cbc:ID>DA-2</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cac:DespatchSupplierParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:DespatchSupplierParty>
+  <cac:DespatchLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:DeliveredQuantity>many</cbc:DeliveredQuantity>
+    <cac:OrderLineReference><cbc:LineID>404</cbc:LineID></cac:OrderLineReference>
+    <cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item>
+  </cac:DespatchLine>
+</DespatchAdvice>`
+	da := DespatchAdvice{}
+	err := Unmarshal([]byte(doc), NamespaceDespatchAdvice, &da)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{items: map[string]uint32{"Labtop computer": 5}}
+	_, err = ImportDespatchAdvice(context.Background(), &da, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportDespatchAdvice() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/DespatchAdvice/cac:DeliveryCustomerParty/cac:Party",
+		"/DespatchAdvice/cac:DespatchLine[1]/cbc:DeliveredQuantity",
+		"/DespatchAdvice/cac:DespatchLine[1]/cac:OrderLineReference[1]/cbc:LineID",
+	}, paths)
+	assert.Empty(t, resolver.created, "no party is created for a document with errors")
+}
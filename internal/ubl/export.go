@@ -0,0 +1,437 @@
+package ubl
+
+import (
+	"strconv"
+	"strings"
+
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// documentID - business identifier of a document, its uuid when not set
+func documentID(id string, idS string) string {
+	if id != "" {
+		return id
+	}
+	return idS
+}
+
+// lineID - business identifier of a line, its position when not set
+func lineID(id string, i int) string {
+	if id != "" {
+		return id
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// formatPercent - format a float32 percent column without float64 noise
+func formatPercent(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// baseUnitMeasure - base_unit_measure columns hold the unit code of one unit
+func baseUnitMeasure(unitCode string) *Quantity {
+	return &Quantity{Value: "1", UnitCode: unitCode}
+}
+
+func notes(note string) []string {
+	if note == "" {
+		return nil
+	}
+	return []string{note}
+}
+
+// duePaymentTerms - cac:PaymentTerms carrying the due date of a document
+// type without cbc:DueDate, on the first payment terms or on payment terms
+// of their own when there are none
+func duePaymentTerms(terms []PaymentTerms, dueDate string) []PaymentTerms {
+	if dueDate == "" {
+		return terms
+	}
+	if len(terms) == 0 {
+		return []PaymentTerms{{PaymentDueDate: dueDate}}
+	}
+	if terms[0].PaymentDueDate == "" {
+		terms[0].PaymentDueDate = dueDate
+	}
+	return terms
+}
+
+// billingReference - cac:BillingReference to the invoice with the business
+// identifier id
+func billingReference(id string) []BillingReference {
+	if id == "" {
+		return nil
+	}
+	return []BillingReference{{InvoiceDocumentReference: &DocumentReference{ID: id}}}
+}
+
+// discrepancyResponse - cac:DiscrepancyResponse with the reason stored in
+// discrepancy_response
+func discrepancyResponse(description string) []Response {
+	if description == "" {
+		return nil
+	}
+	return []Response{{Description: []string{description}}}
+}
+
+func period(startDate string, endDate string) *Period {
+	if startDate == "" && endDate == "" {
+		return nil
+	}
+	return &Period{StartDate: startDate, EndDate: endDate}
+}
+
+// party - map a PartySource to cac:Party. The endpoint comes from
+// party_endpoint_id, the VAT number from tax_reference1 and the legal
+// registration from the party legal entity.
+func party(src *PartySource) *Party {
+	if src == nil || src.Party == nil || src.Party.PartyD == nil {
+		return nil
+	}
+	pd := src.Party.PartyD
+	p := Party{}
+	if pd.PartyEndpointId != "" {
+		p.EndpointID = &Identifier{Value: pd.PartyEndpointId, SchemeID: pd.PartyEndpointSchemeId}
+	}
+	for _, pi := range src.Party.PartyIdentifications {
+		if pi.PartyIdentification == "" {
+			continue
+		}
+		p.PartyIdentification = append(p.PartyIdentification, PartyIdentification{ID: Identifier{Value: pi.PartyIdentification, SchemeID: pi.PartyIdentificationSchemeId}})
+	}
+	if pd.PartyName != "" {
+		p.PartyName = []PartyName{{Name: pd.PartyName}}
+	}
+	p.PostalAddress = address(src.Address)
+
+	le := src.Party.PartyLegalEntityD
+	if pd.TaxReference1 != "" || src.TaxScheme != nil {
+		pts := PartyTaxScheme{CompanyID: pd.TaxReference1, TaxScheme: taxScheme(src.TaxScheme)}
+		if le != nil {
+			pts.RegistrationName = le.RegistrationName
+			pts.TaxLevelCode = le.TaxLevelCode
+			pts.ExemptionReasonCode = le.ExemptionReasonCode
+			pts.ExemptionReason = le.ExemptionReason
+		}
+		p.PartyTaxScheme = []PartyTaxScheme{pts}
+	}
+
+	if le != nil && (le.RegistrationName != "" || le.CompanyId != "") {
+		ple := PartyLegalEntity{
+			RegistrationName:             le.RegistrationName,
+			CompanyID:                    le.CompanyId,
+			CompanyLegalFormCode:         le.CompanyLegalFormCode,
+			CompanyLegalForm:             le.CompanyLegalForm,
+			SoleProprietorshipIndicator:  le.SoleProprietorshipIndicator,
+			CompanyLiquidationStatusCode: le.CompanyLiquidationStatusCode,
+			FullyPaidSharesIndicator:     le.FullyPaidSharesIndicator,
+		}
+		if lt := src.Party.PartyLegalEntityT; lt != nil {
+			ple.RegistrationDate = FormatDate(lt.RegistrationDate)
+			ple.RegistrationExpirationDate = FormatDate(lt.RegistrationExpirationDate)
+		}
+		if le.CorporateRegistrationId != "" || le.CorporateRegistrationName != "" || le.CorporateRegistrationTypeCode != "" {
+			ple.CorporateRegistrationScheme = &CorporateRegistrationScheme{ID: le.CorporateRegistrationId, Name: le.CorporateRegistrationName, CorporateRegistrationTypeCode: le.CorporateRegistrationTypeCode}
+		}
+		p.PartyLegalEntity = []PartyLegalEntity{ple}
+	}
+	return &p
+}
+
+func address(src *commonproto.Address) *Address {
+	if src == nil {
+		return nil
+	}
+	a := Address{
+		AddressTypeCode:      src.AddressTypeCode,
+		AddressFormatCode:    src.AddressFormatCode,
+		Postbox:              src.Postbox,
+		Floor:                src.Floor1,
+		Room:                 src.Room,
+		StreetName:           src.StreetName,
+		AdditionalStreetName: src.AdditionalStreetName,
+		BlockName:            src.BlockName,
+		BuildingName:         src.BuildingName,
+		BuildingNumber:       src.BuildingNumber,
+		InhouseMail:          src.InhouseMail,
+		Department:           src.Department,
+		MarkAttention:        src.MarkAttention,
+		MarkCare:             src.MarkCare,
+		PlotIdentification:   src.PlotIdentification,
+		CitySubdivisionName:  src.CitySubdivisionName,
+		CityName:             src.CityName,
+		PostalZone:           src.PostalZone,
+		CountrySubentity:     src.CountrySubentity,
+		CountrySubentityCode: src.CountrySubentityCode,
+		Region:               src.Region,
+		District:             src.District,
+		TimezoneOffset:       src.TimezoneOffset,
+	}
+	if src.CountryIdCode != "" || src.CountryName != "" {
+		a.Country = &Country{IdentificationCode: src.CountryIdCode, Name: src.CountryName}
+	}
+	return &a
+}
+
+// taxScheme - map a TaxScheme, VAT when the scheme is not known
+func taxScheme(src *taxproto.TaxSchemeD) *TaxScheme {
+	if src == nil {
+		return &TaxScheme{ID: "VAT"}
+	}
+	return &TaxScheme{ID: documentID(src.TsId, src.TaxSchemeName), Name: src.TaxSchemeName, TaxTypeCode: src.TaxTypeCode, CurrencyCode: src.CurrencyCode}
+}
+
+// taxCategory - map a TaxCategory and its TaxScheme
+func taxCategory(src *taxproto.TaxCategoryD, scheme *taxproto.TaxSchemeD, cur string) *TaxCategory {
+	if src == nil {
+		return &TaxCategory{TaxScheme: taxScheme(scheme)}
+	}
+	tc := TaxCategory{
+		ID:                     src.TcId,
+		Name:                   src.TaxCategoryName,
+		Percent:                formatPercent(src.Percent),
+		TaxExemptionReasonCode: src.TaxExemptionReasonCode,
+		TaxExemptionReason:     src.TaxExemptionReason,
+		TierRange:              src.TierRange,
+		TaxScheme:              taxScheme(scheme),
+	}
+	if src.BaseUnitMeasure != "" {
+		tc.BaseUnitMeasure = baseUnitMeasure(src.BaseUnitMeasure)
+	}
+	tc.PerUnitAmount = OptionalAmount(src.PerUnitAmount, rowCurrency(src.CurrencyCode, cur))
+	if src.TierRatePercent != 0 {
+		tc.TierRatePercent = formatPercent(src.TierRatePercent)
+	}
+	return &tc
+}
+
+// taxTotals - map TaxTotals and their TaxSubTotals, without the withholding
+// TaxTotals. A TaxTotal in another currency than cur is in the tax
+// currency, as are the TransactionCurrencyTaxAmounts of the TaxSubTotals.
+func taxTotals(src []*TaxTotalSource, cur string) []TaxTotal {
+	return mapTaxTotals(src, cur, false)
+}
+
+// withholdingTaxTotals - map the withholding TaxTotals and their
+// TaxSubTotals, cac:WithholdingTaxTotal of an invoice
+func withholdingTaxTotals(src []*TaxTotalSource, cur string) []TaxTotal {
+	return mapTaxTotals(src, cur, true)
+}
+
+// mapTaxTotals - map the TaxTotals whose withholding indicator is
+// withholding and their TaxSubTotals
+func mapTaxTotals(src []*TaxTotalSource, cur string, withholding bool) []TaxTotal {
+	taxCur := cur
+	for _, t := range src {
+		if t != nil && t.TaxTotal != nil && !strings.EqualFold(rowCurrency(t.TaxTotal.CurrencyCode, cur), cur) {
+			taxCur = t.TaxTotal.CurrencyCode
+		}
+	}
+	var tts []TaxTotal
+	for _, t := range src {
+		if t == nil || t.TaxTotal == nil || t.TaxTotal.WithholdingIndicator != withholding {
+			continue
+		}
+		ttCur := rowCurrency(t.TaxTotal.CurrencyCode, cur)
+		tt := TaxTotal{
+			TaxAmount:            NewAmount(t.TaxTotal.TaxAmount, ttCur),
+			RoundingAmount:       OptionalAmount(t.TaxTotal.RoundingAmount, ttCur),
+			TaxEvidenceIndicator: t.TaxTotal.TaxEvidenceIndicator,
+			TaxIncludedIndicator: t.TaxTotal.TaxIncludedIndicator,
+		}
+		for _, st := range t.TaxSubTotals {
+			if st == nil || st.TaxSubTotal == nil {
+				continue
+			}
+			sd := st.TaxSubTotal
+			stCur := rowCurrency(sd.CurrencyCode, ttCur)
+			ts := TaxSubtotal{
+				TaxableAmount:                NewAmount(sd.TaxableAmount, stCur),
+				TaxAmount:                    NewAmount(sd.TaxAmount, stCur),
+				TransactionCurrencyTaxAmount: OptionalAmount(sd.TransactionCurrencyTaxAmount, rowCurrency(sd.TransactionCurrencyCode, taxCur)),
+				PerUnitAmount:                OptionalAmount(sd.PerUnitAmount, stCur),
+				TierRange:                    sd.TierRange,
+				TaxCategory:                  taxCategory(st.TaxCategory, st.TaxScheme, stCur),
+			}
+			if sd.CalculationSequenceNumeric != 0 {
+				ts.CalculationSequenceNumeric = strconv.FormatUint(uint64(sd.CalculationSequenceNumeric), 10)
+			}
+			if sd.Percent != 0 {
+				ts.Percent = formatPercent(sd.Percent)
+			}
+			if sd.BaseUnitMeasure != "" {
+				ts.BaseUnitMeasure = baseUnitMeasure(sd.BaseUnitMeasure)
+			}
+			if sd.TierRatePercent != 0 {
+				ts.TierRatePercent = FormatDecimal(sd.TierRatePercent)
+			}
+			tt.TaxSubtotal = append(tt.TaxSubtotal, ts)
+		}
+		tts = append(tts, tt)
+	}
+	return tts
+}
+
+// allowanceCharges - map AllowanceCharges with their tax categories, a line
+// allowance or charge has no tax category of its own
+func allowanceCharges(src []*AllowanceChargeSource, cur string) []AllowanceCharge {
+	var acs []AllowanceCharge
+	for _, a := range src {
+		if a == nil || a.AllowanceCharge == nil {
+			continue
+		}
+		ad := a.AllowanceCharge
+		acCur := rowCurrency(ad.CurrencyCode, cur)
+		ac := AllowanceCharge{
+			ID:                        ad.AcId,
+			ChargeIndicator:           ad.ChargeIndicator,
+			AllowanceChargeReasonCode: ad.AllowanceChargeReasonCode,
+			AllowanceChargeReason:     ad.AllowanceChargeReason,
+			PrepaidIndicator:          ad.PrepaidIndicator,
+			Amount:                    NewAmount(ad.Amount, acCur),
+			BaseAmount:                OptionalAmount(ad.BaseAmount, acCur),
+			PerUnitAmount:             OptionalAmount(ad.PerUnitAmount, acCur),
+		}
+		if ad.MultiplierFactorNumeric != 0 {
+			ac.MultiplierFactorNumeric = FormatDecimal(ad.MultiplierFactorNumeric)
+		}
+		if ad.SequenceNumeric != 0 {
+			ac.SequenceNumeric = strconv.FormatUint(uint64(ad.SequenceNumeric), 10)
+		}
+		if a.TaxCategory != nil {
+			ac.TaxCategory = taxCategory(a.TaxCategory, a.TaxScheme, acCur)
+		}
+		acs = append(acs, ac)
+	}
+	return acs
+}
+
+// rowCurrency - the currency code of the amounts of a row, cur, the
+// currency of its document, for a row stored without one
+func rowCurrency(currencyCode string, cur string) string {
+	if currencyCode == "" {
+		return cur
+	}
+	return currencyCode
+}
+
+// paymentTerms - map a PaymentTerm
+func paymentTerms(src *paymentproto.PaymentTerm, cur string) PaymentTerms {
+	pt := PaymentTerms{}
+	if pd := src.PaymentTermD; pd != nil {
+		cur = rowCurrency(pd.CurrencyCode, cur)
+		pt.ID = pd.PtId
+		pt.PrepaidPaymentReferenceID = pd.PrepaidPaymentReferenceId
+		pt.Note = pd.Note
+		pt.ReferenceEventCode = pd.ReferenceEventCode
+		if pd.SettlementDiscountPercent != 0 {
+			pt.SettlementDiscountPercent = formatPercent(pd.SettlementDiscountPercent)
+		}
+		if pd.PenaltySurchargePercent != 0 {
+			pt.PenaltySurchargePercent = formatPercent(pd.PenaltySurchargePercent)
+		}
+		if pd.PaymentPercent != 0 {
+			pt.PaymentPercent = formatPercent(pd.PaymentPercent)
+		}
+		pt.Amount = OptionalAmount(pd.Amount, cur)
+		pt.SettlementDiscountAmount = OptionalAmount(pd.SettlementDiscountAmount, cur)
+		pt.PenaltyAmount = OptionalAmount(pd.PenaltyAmount, cur)
+		pt.PaymentTermsDetailsURI = pd.PaymentTermsDetailsURI
+	}
+	if tt := src.PaymentTermT; tt != nil {
+		pt.PaymentDueDate = FormatDate(tt.PaymentDueDate)
+		pt.InstallmentDueDate = FormatDate(tt.InstallmentDueDate)
+		pt.SettlementPeriod = period(FormatDate(tt.SettlementPeriodStartDate), FormatDate(tt.SettlementPeriodEndDate))
+		pt.PenaltyPeriod = period(FormatDate(tt.PenaltyPeriodStartDate), FormatDate(tt.PenaltyPeriodEndDate))
+		pt.ValidityPeriod = period(FormatDate(tt.ValidityPeriodStartDate), FormatDate(tt.ValidityPeriodEndDate))
+	}
+	return pt
+}
+
+// exchangeRate - map the *_ex_* columns of a header, nil when no source
+// and target currency are set
+func exchangeRate(sourceCurrencyCode string, sourceCurrencyBaseRate string, targetCurrencyCode string, targetCurrencyBaseRate string, exchangeMarketID uint32, calculationRate float64, mathematicOperatorCode string, date *timestamppb.Timestamp) *ExchangeRate {
+	if sourceCurrencyCode == "" || targetCurrencyCode == "" {
+		return nil
+	}
+	er := ExchangeRate{
+		SourceCurrencyCode:     sourceCurrencyCode,
+		SourceCurrencyBaseRate: sourceCurrencyBaseRate,
+		TargetCurrencyCode:     targetCurrencyCode,
+		TargetCurrencyBaseRate: targetCurrencyBaseRate,
+		MathematicOperatorCode: mathematicOperatorCode,
+		Date:                   FormatDate(date),
+	}
+	if exchangeMarketID != 0 {
+		er.ExchangeMarketID = strconv.FormatUint(uint64(exchangeMarketID), 10)
+	}
+	if calculationRate != 0 {
+		er.CalculationRate = FormatDecimal(calculationRate)
+	}
+	return &er
+}
+
+// item - map an Item, an empty cac:Item when the item is not known
+func item(src *ItemSource) *Item {
+	if src == nil || src.Item == nil {
+		return &Item{}
+	}
+	d := src.Item
+	it := Item{
+		Description:                     d.Description,
+		CatalogueIndicator:              d.CatalogueIndicator,
+		Name:                            d.ItemName,
+		HazardousRiskIndicator:          d.HazardousRiskIndicator,
+		AdditionalInformation:           d.AdditionalInformation,
+		Keyword:                         d.Keyword,
+		BrandName:                       d.BrandName,
+		ModelName:                       d.ModelName,
+		BuyersItemIdentification:        itemIdentification(d.BuyersItemIdentificationId),
+		SellersItemIdentification:       itemIdentification(d.SellersItemIdentificationId),
+		ManufacturersItemIdentification: itemIdentification(d.ManufacturersItemIdentificationId),
+		StandardItemIdentification:      itemIdentification(d.StandardItemIdentificationId),
+		CatalogueItemIdentification:     itemIdentification(d.CatalogueItemIdentificationId),
+		AdditionalItemIdentification:    itemIdentification(d.AdditionalItemIdentificationId),
+	}
+	if d.PackQuantity != 0 {
+		it.PackQuantity = &Quantity{Value: strconv.FormatInt(d.PackQuantity, 10)}
+	}
+	if d.PackSizeNumeric != 0 {
+		it.PackSizeNumeric = strconv.FormatInt(d.PackSizeNumeric, 10)
+	}
+	if d.OriginCountryIdCode != "" || d.OriginCountryName != "" {
+		it.OriginCountry = &Country{IdentificationCode: d.OriginCountryIdCode, Name: d.OriginCountryName}
+	}
+	if src.TaxCategory != nil {
+		it.ClassifiedTaxCategory = []TaxCategory{*taxCategory(src.TaxCategory, src.TaxScheme, "")}
+	}
+	return &it
+}
+
+func itemIdentification(id string) *ItemIdentification {
+	if id == "" {
+		return nil
+	}
+	return &ItemIdentification{ID: Identifier{Value: id}}
+}
+
+// price - map the price_* columns of a line
+func price(priceAmount string, baseQuantity float64, changeReason string, typeCode string, priceType string, orderableUnitFactorRate float64, validityStartDate string, validityEndDate string, cur string) *Price {
+	p := Price{
+		PriceAmount:       NewAmount(priceAmount, cur),
+		PriceChangeReason: changeReason,
+		PriceTypeCode:     typeCode,
+		PriceType:         priceType,
+		ValidityPeriod:    period(validityStartDate, validityEndDate),
+	}
+	if baseQuantity != 0 {
+		p.BaseQuantity = &Quantity{Value: FormatDecimal(baseQuantity)}
+	}
+	if orderableUnitFactorRate != 0 {
+		p.OrderableUnitFactorRate = FormatDecimal(orderableUnitFactorRate)
+	}
+	return &p
+}
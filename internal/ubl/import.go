@@ -0,0 +1,588 @@
+package ubl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+)
+
+// FieldError - an element of an imported document that could not be
+// mapped, located by its XPath
+type FieldError struct {
+	Path    string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// FieldErrors - every FieldError found in an imported document
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Resolver - look up the records an imported document refers to
+type Resolver interface {
+	// FindParty - id of a known party, 0 when unknown
+	FindParty(ctx context.Context, party *Party) (uint32, error)
+	// CreateParty - create a party that FindParty did not know
+	CreateParty(ctx context.Context, party *Party) (uint32, error)
+	// FindReference - id of the document or line of the given Reference*
+	// kind, 0 when unknown. Lines are searched within parentID when set.
+	FindReference(ctx context.Context, kind string, id string, parentID uint32) (uint32, error)
+	// FindItem - id of the item, 0 when unknown
+	FindItem(ctx context.Context, item *Item) (uint32, error)
+	// FindTaxCategory - id of the tax category, 0 when unknown
+	FindTaxCategory(ctx context.Context, taxCategory *TaxCategory) (uint32, error)
+}
+
+// importer - maps UBL values onto Create requests, collecting a FieldError
+// for every value that does not fit
+type importer struct {
+	ctx         context.Context
+	resolver    Resolver
+	currency    string
+	defaultDate string
+	errs        FieldErrors
+	err         error
+	// parties that still have to be created, by the field they fill in
+	newParties []newParty
+}
+
+type newParty struct {
+	party *Party
+	id    *uint32
+}
+
+func (im *importer) fail(path string, format string, args ...interface{}) {
+	im.errs = append(im.errs, &FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// result - the first resolver error, then the collected FieldErrors
+func (im *importer) result() error {
+	if im.err != nil {
+		return im.err
+	}
+	if len(im.errs) > 0 {
+		return im.errs
+	}
+	return nil
+}
+
+// date - a UBL date in common.Layout, the document issue date when empty
+func (im *importer) date(path string, s string) string {
+	d, err := ParseDate(s)
+	if err != nil {
+		im.fail(path, "invalid date %q", s)
+		return im.defaultDate
+	}
+	if d == "" {
+		return im.defaultDate
+	}
+	return d
+}
+
+func (im *importer) decimal(path string, s string) float64 {
+	v, err := ParseFloat(s)
+	if err != nil {
+		im.fail(path, "invalid decimal %q", s)
+	}
+	return v
+}
+
+// money - the canonical decimal string of an amount
+func (im *importer) money(path string, s string) string {
+	v, err := money.Parse(s)
+	if err != nil {
+		im.fail(path, "invalid decimal %q", s)
+	}
+	return v.String()
+}
+
+func (im *importer) amount(path string, a *Amount) string {
+	if a == nil {
+		return money.Zero.String()
+	}
+	if a.CurrencyID != "" && im.currency != "" && a.CurrencyID != im.currency {
+		im.fail(path, "currencyID %q differs from DocumentCurrencyCode %q", a.CurrencyID, im.currency)
+	}
+	return im.money(path, a.Value)
+}
+
+func (im *importer) quantity(path string, q *Quantity) float64 {
+	if q == nil {
+		return 0
+	}
+	return im.decimal(path, q.Value)
+}
+
+// unitCode - the unitCode of the quantity of a line, its unit of measure,
+// which a line must have (EN 16931 BR-23)
+func (im *importer) unitCode(path string, q *Quantity) string {
+	if q == nil || strings.TrimSpace(q.UnitCode) == "" {
+		im.fail(path+"/@unitCode", "is required")
+		return ""
+	}
+	return strings.TrimSpace(q.UnitCode)
+}
+
+// wholeQuantity - a quantity held in an integer column
+func (im *importer) wholeQuantity(path string, q *Quantity) uint32 {
+	v := im.quantity(path, q)
+	if v < 0 || v > math.MaxUint32 || v != math.Trunc(v) {
+		im.fail(path, "quantity %q is not a whole number", q.Value)
+		return 0
+	}
+	return uint32(v)
+}
+
+func (im *importer) numeric(path string, s string) uint32 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		im.fail(path, "invalid numeric %q", s)
+	}
+	return uint32(v)
+}
+
+// party - resolve a party by endpoint ID, VAT number or registration name.
+// Unknown parties are created once the whole document has mapped cleanly.
+func (im *importer) party(path string, p *Party, id *uint32) {
+	if p == nil || im.err != nil {
+		return
+	}
+	if p.EndpointValue() == "" && p.VATNumber() == "" && p.RegistrationName() == "" {
+		im.fail(path, "party has no EndpointID, VAT number or registration name")
+		return
+	}
+	partyID, err := im.resolver.FindParty(im.ctx, p)
+	if err != nil {
+		im.err = err
+		return
+	}
+	if partyID == 0 {
+		im.newParties = append(im.newParties, newParty{party: p, id: id})
+		return
+	}
+	*id = partyID
+}
+
+// createParties - create the parties FindParty did not know, a party that
+// fills several roles is created once
+func (im *importer) createParties() error {
+	created := make(map[string]uint32)
+	for _, np := range im.newParties {
+		key := np.party.EndpointValue() + "|" + np.party.VATNumber() + "|" + np.party.RegistrationName()
+		if partyID, ok := created[key]; ok {
+			*np.id = partyID
+			continue
+		}
+		partyID, err := im.resolver.CreateParty(im.ctx, np.party)
+		if err != nil {
+			return err
+		}
+		created[key] = partyID
+		*np.id = partyID
+	}
+	return nil
+}
+
+// reference - id of a referenced document or line, reporting unknown ones
+func (im *importer) reference(path string, kind string, id string, parentID uint32) uint32 {
+	id = strings.TrimSpace(id)
+	if id == "" || im.err != nil {
+		return 0
+	}
+	refID, err := im.resolver.FindReference(im.ctx, kind, id, parentID)
+	if err != nil {
+		im.err = err
+		return 0
+	}
+	if refID == 0 {
+		im.fail(path, "no %s with ID %q", strings.ReplaceAll(kind, "_", " "), id)
+	}
+	return refID
+}
+
+func (im *importer) item(path string, it *Item) uint32 {
+	if it == nil || im.err != nil {
+		return 0
+	}
+	itemID, err := im.resolver.FindItem(im.ctx, it)
+	if err != nil {
+		im.err = err
+		return 0
+	}
+	return itemID
+}
+
+func (im *importer) taxCategory(path string, tc *TaxCategory) uint32 {
+	if tc == nil {
+		im.fail(path, "is required")
+		return 0
+	}
+	if im.err != nil {
+		return 0
+	}
+	taxCategoryID, err := im.resolver.FindTaxCategory(im.ctx, tc)
+	if err != nil {
+		im.err = err
+		return 0
+	}
+	if taxCategoryID == 0 {
+		im.fail(path, "no tax category with ID %q and percent %q", tc.ID, tc.Percent)
+	}
+	return taxCategoryID
+}
+
+// taxSubTotals - CreateTaxSubTotalRequests for the cac:TaxSubtotal of the
+// cac:TaxTotal in the document currency, a cac:TaxTotal in the tax currency
+// is not mapped. tax_total_id is set once the document has been created.
+func (im *importer) taxSubTotals(root string, taxTotals []TaxTotal) []*taxproto.CreateTaxSubTotalRequest {
+	return im.subTotals(root+"/cac:TaxTotal", taxTotals)
+}
+
+// withholdingTaxSubTotals - CreateTaxSubTotalRequests for the
+// cac:TaxSubtotal of the cac:WithholdingTaxTotal of an invoice
+func (im *importer) withholdingTaxSubTotals(root string, taxTotals []TaxTotal) []*taxproto.CreateTaxSubTotalRequest {
+	return im.subTotals(root+"/cac:WithholdingTaxTotal", taxTotals)
+}
+
+// subTotals - CreateTaxSubTotalRequests for the cac:TaxSubtotal of the tax
+// totals at element in the document currency
+func (im *importer) subTotals(element string, taxTotals []TaxTotal) []*taxproto.CreateTaxSubTotalRequest {
+	taxSubTotals := []*taxproto.CreateTaxSubTotalRequest{}
+	for i, tt := range taxTotals {
+		if tt.TaxAmount != nil && tt.TaxAmount.CurrencyID != "" && im.currency != "" && tt.TaxAmount.CurrencyID != im.currency {
+			continue
+		}
+		for j, st := range tt.TaxSubtotal {
+			path := fmt.Sprintf("%s[%d]/cac:TaxSubtotal[%d]", element, i+1, j+1)
+			taxSubTotal := taxproto.CreateTaxSubTotalRequest{}
+			taxSubTotal.TaxableAmount = im.amount(path+"/cbc:TaxableAmount", st.TaxableAmount)
+			taxSubTotal.TaxAmount = im.amount(path+"/cbc:TaxAmount", st.TaxAmount)
+			taxSubTotal.CalculationSequenceNumeric = im.numeric(path+"/cbc:CalculationSequenceNumeric", st.CalculationSequenceNumeric)
+			if a := st.TransactionCurrencyTaxAmount; a != nil {
+				taxSubTotal.TransactionCurrencyTaxAmount = im.money(path+"/cbc:TransactionCurrencyTaxAmount", a.Value)
+			}
+			taxSubTotal.Percent = im.percent(path+"/cbc:Percent", st.Percent)
+			if strings.TrimSpace(st.Percent) == "" && st.TaxCategory != nil {
+				taxSubTotal.Percent = im.percent(path+"/cac:TaxCategory/cbc:Percent", st.TaxCategory.Percent)
+			}
+			taxSubTotal.PerUnitAmount = im.amount(path+"/cbc:PerUnitAmount", st.PerUnitAmount)
+			taxSubTotal.TierRange = st.TierRange
+			taxSubTotal.TierRatePercent = im.decimal(path+"/cbc:TierRatePercent", st.TierRatePercent)
+			taxSubTotal.TaxCategoryId = im.taxCategory(path+"/cac:TaxCategory", st.TaxCategory)
+			taxSubTotals = append(taxSubTotals, &taxSubTotal)
+		}
+	}
+	return taxSubTotals
+}
+
+// taxCurrencyTaxAmount - the tax amount of the cac:TaxTotal in the tax
+// currency, EN 16931 BT-111, empty when there is none
+func (im *importer) taxCurrencyTaxAmount(root string, taxTotals []TaxTotal) string {
+	for i, tt := range taxTotals {
+		if tt.TaxAmount != nil && tt.TaxAmount.CurrencyID != "" && im.currency != "" && tt.TaxAmount.CurrencyID != im.currency {
+			return im.money(fmt.Sprintf("%s/cac:TaxTotal[%d]/cbc:TaxAmount", root, i+1), tt.TaxAmount.Value)
+		}
+	}
+	return ""
+}
+
+// allowanceCharges - CreateAllowanceChargeRequests for the
+// cac:AllowanceCharge of a document or line. Line allowances and charges
+// have no tax category of their own.
+func (im *importer) allowanceCharges(root string, acs []AllowanceCharge) []*commonproto.CreateAllowanceChargeRequest {
+	allowanceCharges := []*commonproto.CreateAllowanceChargeRequest{}
+	for i, ac := range acs {
+		path := fmt.Sprintf("%s/cac:AllowanceCharge[%d]", root, i+1)
+		allowanceCharge := commonproto.CreateAllowanceChargeRequest{}
+		allowanceCharge.AcId = ac.ID
+		allowanceCharge.ChargeIndicator = ac.ChargeIndicator
+		allowanceCharge.AllowanceChargeReasonCode = ac.AllowanceChargeReasonCode
+		allowanceCharge.AllowanceChargeReason = ac.AllowanceChargeReason
+		allowanceCharge.MultiplierFactorNumeric = im.decimal(path+"/cbc:MultiplierFactorNumeric", ac.MultiplierFactorNumeric)
+		allowanceCharge.PrepaidIndicator = ac.PrepaidIndicator
+		allowanceCharge.SequenceNumeric = im.numeric(path+"/cbc:SequenceNumeric", ac.SequenceNumeric)
+		allowanceCharge.Amount = im.amount(path+"/cbc:Amount", ac.Amount)
+		allowanceCharge.BaseAmount = im.amount(path+"/cbc:BaseAmount", ac.BaseAmount)
+		allowanceCharge.PerUnitAmount = im.amount(path+"/cbc:PerUnitAmount", ac.PerUnitAmount)
+		if ac.TaxCategory != nil {
+			allowanceCharge.TaxCategoryId = im.taxCategory(path+"/cac:TaxCategory", ac.TaxCategory)
+		}
+		allowanceCharges = append(allowanceCharges, &allowanceCharge)
+	}
+	return allowanceCharges
+}
+
+// importExchangeRate - cac:ExchangeRateType fields of a Create request
+type importExchangeRate struct {
+	SourceCurrencyCode     string
+	SourceCurrencyBaseRate string
+	TargetCurrencyCode     string
+	TargetCurrencyBaseRate string
+	ExchangeMarketID       uint32
+	CalculationRate        float64
+	MathematicOperatorCode string
+	Date                   string
+}
+
+func (im *importer) exchangeRate(path string, er *ExchangeRate) importExchangeRate {
+	if er == nil {
+		return importExchangeRate{Date: im.defaultDate}
+	}
+	return importExchangeRate{
+		SourceCurrencyCode:     er.SourceCurrencyCode,
+		SourceCurrencyBaseRate: er.SourceCurrencyBaseRate,
+		TargetCurrencyCode:     er.TargetCurrencyCode,
+		TargetCurrencyBaseRate: er.TargetCurrencyBaseRate,
+		ExchangeMarketID:       im.numeric(path+"/cbc:ExchangeMarketID", er.ExchangeMarketID),
+		CalculationRate:        im.decimal(path+"/cbc:CalculationRate", er.CalculationRate),
+		MathematicOperatorCode: er.MathematicOperatorCode,
+		Date:                   im.date(path+"/cbc:Date", er.Date),
+	}
+}
+
+// lineReference - id of the line of a cac:LineReferenceType, searched within
+// its own document reference or the document referenced by the header
+func (im *importer) lineReference(path string, refs []LineReference, kind string, lineKind string, parentID uint32) uint32 {
+	var lineID uint32
+	for i, ref := range refs {
+		refPath := fmt.Sprintf("%s[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one line can be referenced")
+			continue
+		}
+		if ref.DocumentReference != nil {
+			parentID = im.reference(refPath+"/cac:DocumentReference/cbc:ID", kind, ref.DocumentReference.ID, 0)
+		}
+		lineID = im.reference(refPath+"/cbc:LineID", lineKind, ref.LineID, parentID)
+	}
+	return lineID
+}
+
+// billingReference - id of the invoice of a cac:BillingReference, only one
+// cac:InvoiceDocumentReference can be imported
+func (im *importer) billingReference(path string, refs []BillingReference) uint32 {
+	var billingID uint32
+	for i, ref := range refs {
+		refPath := fmt.Sprintf("%s[%d]", path, i+1)
+		switch {
+		case i > 0:
+			im.fail(refPath, "only one billing reference can be imported")
+		case ref.InvoiceDocumentReference == nil:
+			im.fail(refPath, "only an invoice document reference can be imported")
+		default:
+			billingID = im.reference(refPath+"/cac:InvoiceDocumentReference/cbc:ID", ReferenceInvoice, ref.InvoiceDocumentReference.ID, 0)
+		}
+	}
+	return billingID
+}
+
+// discrepancyReason - the descriptions of cac:DiscrepancyResponse, its
+// response codes when there are none
+func discrepancyReason(responses []Response) string {
+	reasons := []string{}
+	for _, r := range responses {
+		for _, d := range r.Description {
+			if d = strings.TrimSpace(d); d != "" {
+				reasons = append(reasons, d)
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		for _, r := range responses {
+			if code := strings.TrimSpace(r.ResponseCode); code != "" {
+				reasons = append(reasons, code)
+			}
+		}
+	}
+	return strings.Join(reasons, "\n")
+}
+
+func (im *importer) period(path string, p *Period) (string, string) {
+	if p == nil {
+		return im.defaultDate, im.defaultDate
+	}
+	return im.date(path+"/cbc:StartDate", p.StartDate), im.date(path+"/cbc:EndDate", p.EndDate)
+}
+
+func (im *importer) percent(path string, s string) float32 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+	if err != nil && strings.TrimSpace(s) != "" {
+		im.fail(path, "invalid percent %q", s)
+	}
+	return float32(v)
+}
+
+// paymentTerms - CreatePaymentTermRequest for a cac:PaymentTerms, master_flag
+// and master_id are set once the document has been created
+func (im *importer) paymentTerms(path string, pt *PaymentTerms) *paymentproto.CreatePaymentTermRequest {
+	in := paymentproto.CreatePaymentTermRequest{}
+	in.PtId = pt.ID
+	in.PrepaidPaymentReferenceId = pt.PrepaidPaymentReferenceID
+	in.Note = pt.Note
+	in.ReferenceEventCode = pt.ReferenceEventCode
+	in.SettlementDiscountPercent = im.percent(path+"/cbc:SettlementDiscountPercent", pt.SettlementDiscountPercent)
+	in.PenaltySurchargePercent = im.percent(path+"/cbc:PenaltySurchargePercent", pt.PenaltySurchargePercent)
+	in.PaymentPercent = im.percent(path+"/cbc:PaymentPercent", pt.PaymentPercent)
+	in.Amount = im.amount(path+"/cbc:Amount", pt.Amount)
+	in.SettlementDiscountAmount = im.amount(path+"/cbc:SettlementDiscountAmount", pt.SettlementDiscountAmount)
+	in.PenaltyAmount = im.amount(path+"/cbc:PenaltyAmount", pt.PenaltyAmount)
+	in.PaymentTermsDetailsURI = pt.PaymentTermsDetailsURI
+	in.PaymentDueDate = im.date(path+"/cbc:PaymentDueDate", pt.PaymentDueDate)
+	in.InstallmentDueDate = im.date(path+"/cbc:InstallmentDueDate", pt.InstallmentDueDate)
+	in.SettlementPeriodStartDate, in.SettlementPeriodEndDate = im.period(path+"/cac:SettlementPeriod", pt.SettlementPeriod)
+	in.PenaltyPeriodStartDate, in.PenaltyPeriodEndDate = im.period(path+"/cac:PenaltyPeriod", pt.PenaltyPeriod)
+	in.ValidityPeriodStartDate, in.ValidityPeriodEndDate = im.period(path+"/cac:ValidityPeriod", pt.ValidityPeriod)
+	return &in
+}
+
+// dueDateOnly - payment terms written by duePaymentTerms that only carry
+// the due date of the document, they are not stored as payment terms
+func dueDateOnly(pt *PaymentTerms) bool {
+	return *pt == PaymentTerms{PaymentDueDate: pt.PaymentDueDate}
+}
+
+// EndpointValue - value of cbc:EndpointID
+func (p *Party) EndpointValue() string {
+	if p.EndpointID == nil {
+		return ""
+	}
+	return strings.TrimSpace(p.EndpointID.Value)
+}
+
+// VATNumber - cbc:CompanyID of the first cac:PartyTaxScheme
+func (p *Party) VATNumber() string {
+	for _, pts := range p.PartyTaxScheme {
+		if id := strings.TrimSpace(pts.CompanyID); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// RegistrationName - legal registration name of the party, falling back to
+// the registration name of its tax scheme
+func (p *Party) RegistrationName() string {
+	for _, ple := range p.PartyLegalEntity {
+		if name := strings.TrimSpace(ple.RegistrationName); name != "" {
+			return name
+		}
+	}
+	for _, pts := range p.PartyTaxScheme {
+		if name := strings.TrimSpace(pts.RegistrationName); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// Name - first cac:PartyName, the registration name when there is none
+func (p *Party) Name() string {
+	for _, pn := range p.PartyName {
+		if name := strings.TrimSpace(pn.Name); name != "" {
+			return name
+		}
+	}
+	return p.RegistrationName()
+}
+
+// PartyRequest - CreatePartyRequest for a party of an imported document.
+// Registration dates default to defaultDate as CreateParty requires them.
+func PartyRequest(p *Party, defaultDate string) *partyproto.CreatePartyRequest {
+	in := partyproto.CreatePartyRequest{}
+	if p.EndpointID != nil {
+		in.PartyEndpointId = p.EndpointValue()
+		in.PartyEndpointSchemeId = p.EndpointID.SchemeID
+	}
+	in.PartyName = p.Name()
+	in.TaxReference1 = p.VATNumber()
+	in.RegistrationName = p.RegistrationName()
+	in.RegistrationDate = defaultDate
+	in.RegistrationExpirationDate = defaultDate
+
+	if len(p.PartyTaxScheme) > 0 {
+		pts := p.PartyTaxScheme[0]
+		in.TaxLevelCode = pts.TaxLevelCode
+		in.ExemptionReasonCode = pts.ExemptionReasonCode
+		in.ExemptionReason = pts.ExemptionReason
+		if pts.TaxScheme != nil {
+			in.TsId = pts.TaxScheme.ID
+			in.TaxSchemeName = pts.TaxScheme.Name
+			in.TaxTypeCode = pts.TaxScheme.TaxTypeCode
+			in.CurrencyCode = pts.TaxScheme.CurrencyCode
+		}
+	}
+
+	if len(p.PartyLegalEntity) > 0 {
+		ple := p.PartyLegalEntity[0]
+		in.CompanyId = ple.CompanyID
+		if d, err := ParseDate(ple.RegistrationDate); err == nil && d != "" {
+			in.RegistrationDate = d
+		}
+		if d, err := ParseDate(ple.RegistrationExpirationDate); err == nil && d != "" {
+			in.RegistrationExpirationDate = d
+		}
+		in.CompanyLegalFormCode = ple.CompanyLegalFormCode
+		in.CompanyLegalForm = ple.CompanyLegalForm
+		in.SoleProprietorshipIndicator = ple.SoleProprietorshipIndicator
+		in.CompanyLiquidationStatusCode = ple.CompanyLiquidationStatusCode
+		in.FullyPaidSharesIndicator = ple.FullyPaidSharesIndicator
+		if crs := ple.CorporateRegistrationScheme; crs != nil {
+			in.CorporateRegistrationId = crs.ID
+			in.CorporateRegistrationName = crs.Name
+			in.CorporateRegistrationTypeCode = crs.CorporateRegistrationTypeCode
+		}
+	}
+
+	if a := p.PostalAddress; a != nil {
+		in.AddressTypeCode = a.AddressTypeCode
+		in.AddressFormatCode = a.AddressFormatCode
+		in.Postbox = a.Postbox
+		in.Floor1 = a.Floor
+		in.Room = a.Room
+		in.StreetName = a.StreetName
+		in.AdditionalStreetName = a.AdditionalStreetName
+		in.BlockName = a.BlockName
+		in.BuildingName = a.BuildingName
+		in.BuildingNumber = a.BuildingNumber
+		in.InhouseMail = a.InhouseMail
+		in.Department = a.Department
+		in.MarkAttention = a.MarkAttention
+		in.MarkCare = a.MarkCare
+		in.PlotIdentification = a.PlotIdentification
+		in.CitySubdivisionName = a.CitySubdivisionName
+		in.CityName = a.CityName
+		in.PostalZone = a.PostalZone
+		in.CountrySubentity = a.CountrySubentity
+		in.CountrySubentityCode = a.CountrySubentityCode
+		in.Region = a.Region
+		in.District = a.District
+		in.TimezoneOffset = a.TimezoneOffset
+		if a.Country != nil {
+			in.CountryIdCode = a.Country.IdentificationCode
+			in.CountryName = a.Country.Name
+		}
+	}
+	return &in
+}
@@ -0,0 +1,450 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+)
+
+// Invoice - UBL 2.3 Invoice document
+type Invoice struct {
+	XMLName xml.Name `xml:"Invoice"`
+	Namespaces
+	UBLVersionID                   string              `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID                string              `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                      string              `xml:"cbc:ProfileID,omitempty"`
+	ID                             string              `xml:"cbc:ID"`
+	UUID                           string              `xml:"cbc:UUID,omitempty"`
+	IssueDate                      string              `xml:"cbc:IssueDate"`
+	DueDate                        string              `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode                string              `xml:"cbc:InvoiceTypeCode,omitempty"`
+	Note                           []string            `xml:"cbc:Note,omitempty"`
+	TaxPointDate                   string              `xml:"cbc:TaxPointDate,omitempty"`
+	DocumentCurrencyCode           string              `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	TaxCurrencyCode                string              `xml:"cbc:TaxCurrencyCode,omitempty"`
+	PricingCurrencyCode            string              `xml:"cbc:PricingCurrencyCode,omitempty"`
+	PaymentCurrencyCode            string              `xml:"cbc:PaymentCurrencyCode,omitempty"`
+	PaymentAlternativeCurrencyCode string              `xml:"cbc:PaymentAlternativeCurrencyCode,omitempty"`
+	AccountingCostCode             string              `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost                 string              `xml:"cbc:AccountingCost,omitempty"`
+	LineCountNumeric               string              `xml:"cbc:LineCountNumeric,omitempty"`
+	BuyerReference                 string              `xml:"cbc:BuyerReference,omitempty"`
+	InvoicePeriod                  *Period             `xml:"cac:InvoicePeriod,omitempty"`
+	OrderReference                 *OrderReference     `xml:"cac:OrderReference,omitempty"`
+	BillingReference               []BillingReference  `xml:"cac:BillingReference,omitempty"`
+	DespatchDocumentReference      []DocumentReference `xml:"cac:DespatchDocumentReference,omitempty"`
+	ReceiptDocumentReference       []DocumentReference `xml:"cac:ReceiptDocumentReference,omitempty"`
+	AccountingSupplierParty        *SupplierParty      `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty        *CustomerParty      `xml:"cac:AccountingCustomerParty"`
+	PayeeParty                     *Party              `xml:"cac:PayeeParty,omitempty"`
+	BuyerCustomerParty             *CustomerParty      `xml:"cac:BuyerCustomerParty,omitempty"`
+	SellerSupplierParty            *SupplierParty      `xml:"cac:SellerSupplierParty,omitempty"`
+	TaxRepresentativeParty         *Party              `xml:"cac:TaxRepresentativeParty,omitempty"`
+	PaymentTerms                   []PaymentTerms      `xml:"cac:PaymentTerms,omitempty"`
+	AllowanceCharge                []AllowanceCharge   `xml:"cac:AllowanceCharge,omitempty"`
+	TaxExchangeRate                *ExchangeRate       `xml:"cac:TaxExchangeRate,omitempty"`
+	PricingExchangeRate            *ExchangeRate       `xml:"cac:PricingExchangeRate,omitempty"`
+	PaymentExchangeRate            *ExchangeRate       `xml:"cac:PaymentExchangeRate,omitempty"`
+	PaymentAlternativeExchangeRate *ExchangeRate       `xml:"cac:PaymentAlternativeExchangeRate,omitempty"`
+	TaxTotal                       []TaxTotal          `xml:"cac:TaxTotal,omitempty"`
+	WithholdingTaxTotal            []TaxTotal          `xml:"cac:WithholdingTaxTotal,omitempty"`
+	LegalMonetaryTotal             *MonetaryTotal      `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLine                    []InvoiceLine       `xml:"cac:InvoiceLine"`
+}
+
+// InvoiceLine - cac:InvoiceLine
+type InvoiceLine struct {
+	ID                    string               `xml:"cbc:ID"`
+	UUID                  string               `xml:"cbc:UUID,omitempty"`
+	Note                  []string             `xml:"cbc:Note,omitempty"`
+	InvoicedQuantity      *Quantity            `xml:"cbc:InvoicedQuantity,omitempty"`
+	LineExtensionAmount   *Amount              `xml:"cbc:LineExtensionAmount"`
+	TaxPointDate          string               `xml:"cbc:TaxPointDate,omitempty"`
+	AccountingCostCode    string               `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost        string               `xml:"cbc:AccountingCost,omitempty"`
+	PaymentPurposeCode    string               `xml:"cbc:PaymentPurposeCode,omitempty"`
+	FreeOfChargeIndicator bool                 `xml:"cbc:FreeOfChargeIndicator,omitempty"`
+	InvoicePeriod         *Period              `xml:"cac:InvoicePeriod,omitempty"`
+	OrderLineReference    []OrderLineReference `xml:"cac:OrderLineReference,omitempty"`
+	DespatchLineReference []LineReference      `xml:"cac:DespatchLineReference,omitempty"`
+	ReceiptLineReference  []LineReference      `xml:"cac:ReceiptLineReference,omitempty"`
+	BillingReference      []BillingReference   `xml:"cac:BillingReference,omitempty"`
+	OriginatorParty       *Party               `xml:"cac:OriginatorParty,omitempty"`
+	AllowanceCharge       []AllowanceCharge    `xml:"cac:AllowanceCharge,omitempty"`
+	TaxTotal              []TaxTotal           `xml:"cac:TaxTotal,omitempty"`
+	Item                  *Item                `xml:"cac:Item"`
+	Price                 *Price               `xml:"cac:Price,omitempty"`
+}
+
+// InvoiceSource - everything needed to build a UBL Invoice
+type InvoiceSource struct {
+	InvoiceHeader *invoiceproto.InvoiceHeader
+	InvoiceLines  []*invoiceproto.InvoiceLine
+	DocumentSource
+}
+
+// Master flags used by tax_totals and payment_terms rows of invoices
+const (
+	MasterFlagInvoiceHeader = "IH"
+	MasterFlagInvoiceLine   = "IL"
+)
+
+// InvoiceFromSource - build a UBL Invoice
+func InvoiceFromSource(src *InvoiceSource) (*Invoice, error) {
+	if src == nil || src.InvoiceHeader == nil || src.InvoiceHeader.InvoiceHeaderD == nil {
+		return nil, errors.New("ubl: invoice header is required")
+	}
+	hd := src.InvoiceHeader.InvoiceHeaderD
+	ht := src.InvoiceHeader.InvoiceHeaderT
+	if ht == nil {
+		ht = &invoiceproto.InvoiceHeaderT{}
+	}
+	cur := hd.DocumentCurrencyCode
+
+	inv := Invoice{Namespaces: NewNamespaces(NamespaceInvoice)}
+	inv.UBLVersionID = Version
+	inv.ID = documentID(hd.IhId, hd.IdS)
+	inv.UUID = hd.IdS
+	inv.IssueDate = FormatDate(ht.IssueDate)
+	inv.DueDate = FormatDate(ht.DueDate)
+	inv.InvoiceTypeCode = hd.InvoiceTypeCode
+	inv.Note = notes(hd.Note)
+	inv.TaxPointDate = FormatDate(ht.TaxPointDate)
+	inv.DocumentCurrencyCode = hd.DocumentCurrencyCode
+	inv.TaxCurrencyCode = hd.TaxCurrencyCode
+	inv.PricingCurrencyCode = hd.PricingCurrencyCode
+	inv.PaymentCurrencyCode = hd.PaymentCurrencyCode
+	inv.PaymentAlternativeCurrencyCode = hd.PaymentAltCurrencyCode
+	inv.AccountingCostCode = hd.AccountingCostCode
+	inv.AccountingCost = hd.AccountingCost
+	inv.BuyerReference = hd.BuyerReference
+	if hd.LineCountNumeric != 0 {
+		inv.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	inv.InvoicePeriod = period(FormatDate(ht.InvoicePeriodStartDate), FormatDate(ht.InvoicePeriodEndDate))
+
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		inv.OrderReference = &OrderReference{ID: id}
+	}
+	inv.BillingReference = billingReference(src.reference(ReferenceInvoice, hd.BillingId))
+	if id := src.reference(ReferenceDespatch, hd.DespatchId); id != "" {
+		inv.DespatchDocumentReference = []DocumentReference{{ID: id}}
+	}
+	if id := src.reference(ReferenceReceipt, hd.ReceiptId); id != "" {
+		inv.ReceiptDocumentReference = []DocumentReference{{ID: id}}
+	}
+
+	inv.AccountingSupplierParty = &SupplierParty{Party: src.party(hd.AccountingSupplierPartyId)}
+	inv.AccountingCustomerParty = &CustomerParty{Party: src.party(hd.AccountingCustomerPartyId)}
+	inv.PayeeParty = src.party(hd.PayeePartyId)
+	if p := src.party(hd.BuyerCustomerPartyId); p != nil {
+		inv.BuyerCustomerParty = &CustomerParty{Party: p}
+	}
+	if p := src.party(hd.SellerSupplierPartyId); p != nil {
+		inv.SellerSupplierParty = &SupplierParty{Party: p}
+	}
+	inv.TaxRepresentativeParty = src.party(hd.TaxRepresentativePartyId)
+
+	for _, pt := range src.PaymentTerms {
+		inv.PaymentTerms = append(inv.PaymentTerms, paymentTerms(pt, cur))
+	}
+
+	inv.AllowanceCharge = allowanceCharges(src.AllowanceCharges, cur)
+
+	inv.TaxExchangeRate = exchangeRate(hd.TaxExSourceCurrencyCode, hd.TaxExSourceCurrencyBaseRate, hd.TaxExTargetCurrencyCode, hd.TaxExTargetCurrencyBaseRate, hd.TaxExExchangeMarketId, hd.TaxExCalculationRate, hd.TaxExMathematicOperatorCode, ht.TaxExDate)
+	inv.PricingExchangeRate = exchangeRate(hd.PricingExSourceCurrencyCode, hd.PricingExSourceCurrencyBaseRate, hd.PricingExTargetCurrencyCode, hd.PricingExTargetCurrencyBaseRate, hd.PricingExExchangeMarketId, hd.PricingExCalculationRate, hd.PricingExMathematicOperatorCode, ht.PricingExDate)
+	inv.PaymentExchangeRate = exchangeRate(hd.PaymentExSourceCurrencyCode, hd.PaymentExSourceCurrencyBaseRate, hd.PaymentExTargetCurrencyCode, hd.PaymentExTargetCurrencyBaseRate, hd.PaymentExExchangeMarketId, hd.PaymentExCalculationRate, hd.PaymentExMathematicOperatorCode, ht.PaymentExDate)
+	inv.PaymentAlternativeExchangeRate = exchangeRate(hd.PaymentAltExSourceCurrencyCode, hd.PaymentAltExSourceCurrencyBaseRate, hd.PaymentAltExTargetCurrencyCode, hd.PaymentAltExTargetCurrencyBaseRate, hd.PaymentAltExExchangeMarketId, hd.PaymentAltExCalculationRate, hd.PaymentAltExMathematicOperatorCode, ht.PaymentAltExDate)
+
+	inv.TaxTotal = taxTotals(src.TaxTotals, cur)
+	inv.WithholdingTaxTotal = withholdingTaxTotals(src.TaxTotals, cur)
+
+	inv.LegalMonetaryTotal = &MonetaryTotal{
+		LineExtensionAmount:       NewAmount(hd.LineExtensionAmount, cur),
+		TaxExclusiveAmount:        NewAmount(hd.TaxExclusiveAmount, cur),
+		TaxInclusiveAmount:        NewAmount(hd.TaxInclusiveAmount, cur),
+		AllowanceTotalAmount:      OptionalAmount(hd.AllowanceTotalAmount, cur),
+		ChargeTotalAmount:         OptionalAmount(hd.ChargeTotalAmount, cur),
+		WithholdingTaxTotalAmount: OptionalAmount(hd.WithholdingTaxTotalAmount, cur),
+		PrepaidAmount:             OptionalAmount(hd.PrepaidAmount, cur),
+		PayableRoundingAmount:     OptionalAmount(hd.PayableRoundingAmount, cur),
+		PayableAmount:             NewAmount(hd.PayableAmount, cur),
+		PayableAlternativeAmount:  OptionalAmount(hd.PayableAlternativeAmount, rowCurrency(hd.PayableAlternativeCurrencyCode, hd.PaymentAltCurrencyCode)),
+	}
+
+	for i, line := range src.InvoiceLines {
+		inv.InvoiceLine = append(inv.InvoiceLine, src.invoiceLine(i, line, cur))
+	}
+	if src.Profile == ProfilePeppolBIS3 {
+		src.peppolInvoice(&inv)
+	}
+	return &inv, nil
+}
+
+// invoiceLine - map an InvoiceLine
+func (src *InvoiceSource) invoiceLine(i int, line *invoiceproto.InvoiceLine, cur string) InvoiceLine {
+	ld := line.InvoiceLineD
+	cur = rowCurrency(ld.CurrencyCode, cur)
+	lt := line.InvoiceLineT
+	if lt == nil {
+		lt = &invoiceproto.InvoiceLineT{}
+	}
+	il := InvoiceLine{}
+	il.ID = lineID(ld.IlId, i)
+	il.UUID = ld.IdS
+	il.Note = notes(ld.Note)
+	il.InvoicedQuantity = &Quantity{Value: FormatDecimal(ld.InvoicedQuantity), UnitCode: ld.UnitCode}
+	il.LineExtensionAmount = NewAmount(ld.LineExtensionAmount, cur)
+	il.TaxPointDate = FormatDate(lt.TaxPointDate)
+	il.AccountingCostCode = ld.AccountingCostCode
+	il.AccountingCost = ld.AccountingCost
+	il.PaymentPurposeCode = ld.PaymentPurposeCode
+	il.FreeOfChargeIndicator = ld.FreeOfChargeIndicator
+	il.InvoicePeriod = period(FormatDate(lt.InvoicePeriodStartDate), FormatDate(lt.InvoicePeriodEndDate))
+	if id := src.reference(ReferenceOrderLine, ld.OrderLineId); id != "" {
+		il.OrderLineReference = []OrderLineReference{{LineID: id}}
+	}
+	if id := src.reference(ReferenceDespatchLine, ld.DespatchLineId); id != "" {
+		il.DespatchLineReference = []LineReference{{LineID: id}}
+	}
+	if id := src.reference(ReferenceReceiptLine, ld.ReceiptLineId); id != "" {
+		il.ReceiptLineReference = []LineReference{{LineID: id}}
+	}
+	il.BillingReference = billingReference(src.reference(ReferenceInvoice, ld.BillingId))
+	il.OriginatorParty = src.party(ld.OriginatorPartyId)
+	il.AllowanceCharge = allowanceCharges(src.LineAllowanceCharges[ld.Id], cur)
+	il.TaxTotal = taxTotals(src.LineTaxTotals[ld.Id], cur)
+	il.Item = item(src.Items[ld.ItemId])
+	il.Price = price(ld.PriceAmount, ld.PriceBaseQuantity, ld.PriceChangeReason, ld.PriceTypeCode, ld.PriceType, ld.OrderableUnitFactorRate, FormatDate(lt.PriceValidityPeriodStartDate), FormatDate(lt.PriceValidityPeriodEndDate), cur)
+	return il
+}
+
+// InvoiceImport - Create requests for an imported UBL Invoice
+type InvoiceImport struct {
+	Invoice      *invoiceproto.CreateInvoiceRequest
+	PaymentTerms []*paymentproto.CreatePaymentTermRequest
+}
+
+// ImportInvoice - map a UBL Invoice onto a CreateInvoiceRequest. Elements
+// that cannot be mapped are returned as FieldErrors; parties are only
+// created when there are none.
+func ImportInvoice(ctx context.Context, inv *Invoice, resolver Resolver) (*InvoiceImport, error) {
+	const root = "/Invoice"
+	im := importer{ctx: ctx, resolver: resolver, currency: inv.DocumentCurrencyCode}
+	in := invoiceproto.CreateInvoiceRequest{}
+
+	if strings.TrimSpace(inv.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if inv.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", inv.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.IhId = strings.TrimSpace(inv.ID)
+	in.DueDate = im.date(root+"/cbc:DueDate", inv.DueDate)
+	in.InvoiceTypeCode = inv.InvoiceTypeCode
+	in.Note = strings.Join(inv.Note, "\n")
+	in.TaxPointDate = im.date(root+"/cbc:TaxPointDate", inv.TaxPointDate)
+	in.DocumentCurrencyCode = inv.DocumentCurrencyCode
+	in.TaxCurrencyCode = inv.TaxCurrencyCode
+	in.PricingCurrencyCode = inv.PricingCurrencyCode
+	in.PaymentCurrencyCode = inv.PaymentCurrencyCode
+	in.PaymentAltCurrencyCode = inv.PaymentAlternativeCurrencyCode
+	in.AccountingCostCode = inv.AccountingCostCode
+	in.AccountingCost = inv.AccountingCost
+	in.BuyerReference = inv.BuyerReference
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", inv.LineCountNumeric)
+	in.InvoicePeriodStartDate, in.InvoicePeriodEndDate = im.period(root+"/cac:InvoicePeriod", inv.InvoicePeriod)
+
+	if inv.OrderReference != nil {
+		in.OrderId = im.reference(root+"/cac:OrderReference/cbc:ID", ReferenceOrder, inv.OrderReference.ID, 0)
+	}
+	in.BillingId = im.billingReference(root+"/cac:BillingReference", inv.BillingReference)
+	for i, ref := range inv.DespatchDocumentReference {
+		path := fmt.Sprintf("%s/cac:DespatchDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one despatch advice can be referenced")
+			continue
+		}
+		in.DespatchId = im.reference(path+"/cbc:ID", ReferenceDespatch, ref.ID, 0)
+	}
+	for i, ref := range inv.ReceiptDocumentReference {
+		path := fmt.Sprintf("%s/cac:ReceiptDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one receipt advice can be referenced")
+			continue
+		}
+		in.ReceiptId = im.reference(path+"/cbc:ID", ReferenceReceipt, ref.ID, 0)
+	}
+
+	if inv.AccountingSupplierParty == nil || inv.AccountingSupplierParty.Party == nil {
+		im.fail(root+"/cac:AccountingSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingSupplierParty/cac:Party", inv.AccountingSupplierParty.Party, &in.AccountingSupplierPartyId)
+	}
+	if inv.AccountingCustomerParty == nil || inv.AccountingCustomerParty.Party == nil {
+		im.fail(root+"/cac:AccountingCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:AccountingCustomerParty/cac:Party", inv.AccountingCustomerParty.Party, &in.AccountingCustomerPartyId)
+	}
+	im.party(root+"/cac:PayeeParty", inv.PayeeParty, &in.PayeePartyId)
+	if inv.BuyerCustomerParty != nil {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", inv.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if inv.SellerSupplierParty != nil {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", inv.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	im.party(root+"/cac:TaxRepresentativeParty", inv.TaxRepresentativeParty, &in.TaxRepresentativePartyId)
+
+	paymentTerms := []*paymentproto.CreatePaymentTermRequest{}
+	for i := range inv.PaymentTerms {
+		path := fmt.Sprintf("%s/cac:PaymentTerms[%d]", root, i+1)
+		paymentTerms = append(paymentTerms, im.paymentTerms(path, &inv.PaymentTerms[i]))
+	}
+
+	taxEx := im.exchangeRate(root+"/cac:TaxExchangeRate", inv.TaxExchangeRate)
+	in.TaxExSourceCurrencyCode = taxEx.SourceCurrencyCode
+	in.TaxExSourceCurrencyBaseRate = taxEx.SourceCurrencyBaseRate
+	in.TaxExTargetCurrencyCode = taxEx.TargetCurrencyCode
+	in.TaxExTargetCurrencyBaseRate = taxEx.TargetCurrencyBaseRate
+	in.TaxExExchangeMarketId = taxEx.ExchangeMarketID
+	in.TaxExCalculationRate = taxEx.CalculationRate
+	in.TaxExMathematicOperatorCode = taxEx.MathematicOperatorCode
+	in.TaxExDate = taxEx.Date
+
+	pricingEx := im.exchangeRate(root+"/cac:PricingExchangeRate", inv.PricingExchangeRate)
+	in.PricingExSourceCurrencyCode = pricingEx.SourceCurrencyCode
+	in.PricingExSourceCurrencyBaseRate = pricingEx.SourceCurrencyBaseRate
+	in.PricingExTargetCurrencyCode = pricingEx.TargetCurrencyCode
+	in.PricingExTargetCurrencyBaseRate = pricingEx.TargetCurrencyBaseRate
+	in.PricingExExchangeMarketId = pricingEx.ExchangeMarketID
+	in.PricingExCalculationRate = pricingEx.CalculationRate
+	in.PricingExMathematicOperatorCode = pricingEx.MathematicOperatorCode
+	in.PricingExDate = pricingEx.Date
+
+	paymentEx := im.exchangeRate(root+"/cac:PaymentExchangeRate", inv.PaymentExchangeRate)
+	in.PaymentExSourceCurrencyCode = paymentEx.SourceCurrencyCode
+	in.PaymentExSourceCurrencyBaseRate = paymentEx.SourceCurrencyBaseRate
+	in.PaymentExTargetCurrencyCode = paymentEx.TargetCurrencyCode
+	in.PaymentExTargetCurrencyBaseRate = paymentEx.TargetCurrencyBaseRate
+	in.PaymentExExchangeMarketId = paymentEx.ExchangeMarketID
+	in.PaymentExCalculationRate = paymentEx.CalculationRate
+	in.PaymentExMathematicOperatorCode = paymentEx.MathematicOperatorCode
+	in.PaymentExDate = paymentEx.Date
+
+	paymentAltEx := im.exchangeRate(root+"/cac:PaymentAlternativeExchangeRate", inv.PaymentAlternativeExchangeRate)
+	in.PaymentAltExSourceCurrencyCode = paymentAltEx.SourceCurrencyCode
+	in.PaymentAltExSourceCurrencyBaseRate = paymentAltEx.SourceCurrencyBaseRate
+	in.PaymentAltExTargetCurrencyCode = paymentAltEx.TargetCurrencyCode
+	in.PaymentAltExTargetCurrencyBaseRate = paymentAltEx.TargetCurrencyBaseRate
+	in.PaymentAltExExchangeMarketId = paymentAltEx.ExchangeMarketID
+	in.PaymentAltExCalculationRate = paymentAltEx.CalculationRate
+	in.PaymentAltExMathematicOperatorCode = paymentAltEx.MathematicOperatorCode
+	in.PaymentAltExDate = paymentAltEx.Date
+
+	if lmt := inv.LegalMonetaryTotal; lmt == nil || lmt.PayableAmount == nil {
+		im.fail(root+"/cac:LegalMonetaryTotal/cbc:PayableAmount", "is required")
+	} else {
+		path := root + "/cac:LegalMonetaryTotal"
+		in.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", lmt.LineExtensionAmount)
+		in.TaxExclusiveAmount = im.amount(path+"/cbc:TaxExclusiveAmount", lmt.TaxExclusiveAmount)
+		in.TaxInclusiveAmount = im.amount(path+"/cbc:TaxInclusiveAmount", lmt.TaxInclusiveAmount)
+		in.AllowanceTotalAmount = im.amount(path+"/cbc:AllowanceTotalAmount", lmt.AllowanceTotalAmount)
+		in.ChargeTotalAmount = im.amount(path+"/cbc:ChargeTotalAmount", lmt.ChargeTotalAmount)
+		in.WithholdingTaxTotalAmount = im.amount(path+"/cbc:WithholdingTaxTotalAmount", lmt.WithholdingTaxTotalAmount)
+		in.PrepaidAmount = im.amount(path+"/cbc:PrepaidAmount", lmt.PrepaidAmount)
+		in.PayableRoundingAmount = im.amount(path+"/cbc:PayableRoundingAmount", lmt.PayableRoundingAmount)
+		in.PayableAmount = im.amount(path+"/cbc:PayableAmount", lmt.PayableAmount)
+		if a := lmt.PayableAlternativeAmount; a != nil {
+			in.PayableAlternativeAmount = im.money(path+"/cbc:PayableAlternativeAmount", a.Value)
+			in.PayableAlternativeCurrencyCode = a.CurrencyID
+		}
+	}
+
+	in.AllowanceCharges = im.allowanceCharges(root, inv.AllowanceCharge)
+	in.TaxSubTotals = im.taxSubTotals(root, inv.TaxTotal)
+	in.TaxCurrencyTaxAmount = im.taxCurrencyTaxAmount(root, inv.TaxTotal)
+	in.WithholdingTaxSubTotals = im.withholdingTaxSubTotals(root, inv.WithholdingTaxTotal)
+
+	if len(inv.InvoiceLine) == 0 {
+		im.fail(root+"/cac:InvoiceLine", "at least one invoice line is required")
+	}
+	for i := range inv.InvoiceLine {
+		path := fmt.Sprintf("%s/cac:InvoiceLine[%d]", root, i+1)
+		in.InvoiceLines = append(in.InvoiceLines, im.invoiceLine(path, &inv.InvoiceLine[i], &in))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &InvoiceImport{Invoice: &in, PaymentTerms: paymentTerms}, nil
+}
+
+// invoiceLine - map a cac:InvoiceLine onto a CreateInvoiceLineRequest, line
+// references are searched within the documents referenced by the header
+func (im *importer) invoiceLine(path string, il *InvoiceLine, hd *invoiceproto.CreateInvoiceRequest) *invoiceproto.CreateInvoiceLineRequest {
+	line := invoiceproto.CreateInvoiceLineRequest{}
+	line.IlId = strings.TrimSpace(il.ID)
+	line.Note = strings.Join(il.Note, "\n")
+	line.InvoicedQuantity = im.quantity(path+"/cbc:InvoicedQuantity", il.InvoicedQuantity)
+	line.UnitCode = im.unitCode(path+"/cbc:InvoicedQuantity", il.InvoicedQuantity)
+	line.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", il.LineExtensionAmount)
+	line.TaxPointDate = im.date(path+"/cbc:TaxPointDate", il.TaxPointDate)
+	line.AccountingCostCode = il.AccountingCostCode
+	line.AccountingCost = il.AccountingCost
+	line.PaymentPurposeCode = il.PaymentPurposeCode
+	line.FreeOfChargeIndicator = il.FreeOfChargeIndicator
+	line.InvoicePeriodStartDate, line.InvoicePeriodEndDate = im.period(path+"/cac:InvoicePeriod", il.InvoicePeriod)
+
+	for i, ref := range il.OrderLineReference {
+		refPath := fmt.Sprintf("%s/cac:OrderLineReference[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one order line can be referenced")
+			continue
+		}
+		orderID := hd.OrderId
+		if ref.OrderReference != nil {
+			orderID = im.reference(refPath+"/cac:OrderReference/cbc:ID", ReferenceOrder, ref.OrderReference.ID, 0)
+		}
+		line.OrderLineId = im.reference(refPath+"/cbc:LineID", ReferenceOrderLine, ref.LineID, orderID)
+	}
+	line.DespatchLineId = im.lineReference(path+"/cac:DespatchLineReference", il.DespatchLineReference, ReferenceDespatch, ReferenceDespatchLine, hd.DespatchId)
+	line.ReceiptLineId = im.lineReference(path+"/cac:ReceiptLineReference", il.ReceiptLineReference, ReferenceReceipt, ReferenceReceiptLine, hd.ReceiptId)
+	line.BillingId = im.billingReference(path+"/cac:BillingReference", il.BillingReference)
+	im.party(path+"/cac:OriginatorParty", il.OriginatorParty, &line.OriginatorPartyId)
+
+	if il.Item == nil {
+		im.fail(path+"/cac:Item", "is required")
+	} else {
+		line.ItemId = im.item(path+"/cac:Item", il.Item)
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(path+"/cac:Item", "no item matches its identifications or name %q", il.Item.Name)
+		}
+	}
+
+	line.AllowanceCharges = im.allowanceCharges(path, il.AllowanceCharge)
+
+	if p := il.Price; p != nil {
+		line.PriceAmount = im.amount(path+"/cac:Price/cbc:PriceAmount", p.PriceAmount)
+		line.PriceBaseQuantity = im.quantity(path+"/cac:Price/cbc:BaseQuantity", p.BaseQuantity)
+		line.PriceChangeReason = p.PriceChangeReason
+		line.PriceTypeCode = p.PriceTypeCode
+		line.PriceType = p.PriceType
+		line.OrderableUnitFactorRate = im.decimal(path+"/cac:Price/cbc:OrderableUnitFactorRate", p.OrderableUnitFactorRate)
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.period(path+"/cac:Price/cac:ValidityPeriod", p.ValidityPeriod)
+	} else {
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.defaultDate, im.defaultDate
+	}
+	return &line
+}
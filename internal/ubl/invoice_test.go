@@ -0,0 +1,445 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	invoiceproto "github.com/cloudfresco/sc-ubl/internal/protogen/invoice/v1"
+	itemproto "github.com/cloudfresco/sc-ubl/internal/protogen/item/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testDate(s string) *timestamppb.Timestamp {
+	t, _ := time.Parse(DateLayout, s)
+	return common.TimeToTimestamp(t)
+}
+
+func testInvoiceSource() *InvoiceSource {
+	invoiceHeaderD := invoiceproto.InvoiceHeaderD{}
+	invoiceHeaderD.Id = 1
+	invoiceHeaderD.IdS = "834dd586-a1b8-4e3f-89cf-bfd25edd9a60"
+	invoiceHeaderD.IhId = "TOSL108"
+	invoiceHeaderD.InvoiceTypeCode = "380"
+	invoiceHeaderD.Note = "Ordered in our booth at the convention."
+	invoiceHeaderD.DocumentCurrencyCode = "EUR"
+	invoiceHeaderD.LineCountNumeric = 1
+	invoiceHeaderD.OrderId = 3
+	invoiceHeaderD.AccountingSupplierPartyId = 1
+	invoiceHeaderD.AccountingCustomerPartyId = 2
+	invoiceHeaderD.LineExtensionAmount = "1273"
+	invoiceHeaderD.TaxExclusiveAmount = "1273"
+	invoiceHeaderD.TaxInclusiveAmount = "1591.25"
+	invoiceHeaderD.PayableAmount = "1591.25"
+
+	invoiceHeaderT := invoiceproto.InvoiceHeaderT{}
+	invoiceHeaderT.IssueDate = testDate("2009-12-15")
+	invoiceHeaderT.DueDate = testDate("2010-01-15")
+
+	invoiceLineD := invoiceproto.InvoiceLineD{}
+	invoiceLineD.Id = 2
+	invoiceLineD.IlId = "1"
+	invoiceLineD.InvoicedQuantity = 1
+	invoiceLineD.UnitCode = "C62"
+	invoiceLineD.LineExtensionAmount = "1273"
+	invoiceLineD.ItemId = 3
+	invoiceLineD.PriceAmount = "1273"
+
+	return &InvoiceSource{
+		InvoiceHeader:  &invoiceproto.InvoiceHeader{InvoiceHeaderD: &invoiceHeaderD, InvoiceHeaderT: &invoiceHeaderT},
+		InvoiceLines:   []*invoiceproto.InvoiceLine{{InvoiceLineD: &invoiceLineD, InvoiceLineT: &invoiceproto.InvoiceLineT{}}},
+		DocumentSource: testDocumentSource(),
+	}
+}
+
+// testDocumentSource - parties 1 and 2, item 3 and the header TaxTotal of
+// a 1273.00 EUR line at 25% VAT
+func testDocumentSource() DocumentSource {
+	vat := &taxproto.TaxSchemeD{Id: 1, TsId: "VAT", TaxSchemeName: "VAT"}
+	standardRate := &taxproto.TaxCategoryD{Id: 1, TcId: "S", Percent: 25, TaxSchemeId: 1}
+
+	supplier := partyproto.Party{
+		PartyD:               &partyproto.PartyD{Id: 1, PartyName: "Salescompany ltd.", PartyEndpointId: "7300010000001", PartyEndpointSchemeId: "0088", TaxReference1: "DK12345678", AddressId: 1},
+		PartyLegalEntityD:    &commonproto.PartyLegalEntityD{RegistrationName: "The Sellercompany Incorporated", CompanyId: "5402697509"},
+		PartyIdentifications: []*partyproto.PartyIdentification{{PartyIdentification: "5790000436101", PartyIdentificationSchemeId: "0088"}},
+	}
+	customer := partyproto.Party{PartyD: &partyproto.PartyD{Id: 2, PartyName: "Buyercompany ltd"}, PartyLegalEntityD: &commonproto.PartyLegalEntityD{RegistrationName: "Buyercompany ltd"}}
+
+	return DocumentSource{
+		Parties: map[uint32]*PartySource{
+			1: {Party: &supplier, Address: &commonproto.Address{StreetName: "Main street", CityName: "Big city", PostalZone: "54321", CountryIdCode: "DK"}, TaxScheme: vat},
+			2: {Party: &customer},
+		},
+		Items: map[uint32]*ItemSource{
+			3: {Item: &itemproto.ItemD{Id: 3, ItemName: "Labtop computer", SellersItemIdentificationId: "JB007"}, TaxCategory: standardRate, TaxScheme: vat},
+		},
+		TaxTotals: []*TaxTotalSource{{
+			TaxTotal:     &taxproto.TaxTotalD{TaxAmount: "318.25"},
+			TaxSubTotals: []*TaxSubTotalSource{{TaxSubTotal: &taxproto.TaxSubTotalD{TaxableAmount: "1273", TaxAmount: "318.25", Percent: 25}, TaxCategory: standardRate, TaxScheme: vat}},
+		}},
+		PaymentTerms: []*paymentproto.PaymentTerm{{PaymentTermD: &paymentproto.PaymentTermD{Note: "Penalty percentage 10% from due date"}}},
+		References:   map[string]map[uint32]string{ReferenceOrder: {3: "123"}},
+	}
+}
+
+func TestInvoiceFromSource(t *testing.T) {
+	inv, err := InvoiceFromSource(testInvoiceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.True(t, strings.HasPrefix(xmlStr, `<?xml version="1.0" encoding="UTF-8"?>`), "xml declaration")
+	assert.Contains(t, xmlStr, `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">`)
+	assert.Contains(t, xmlStr, `<cbc:ID>TOSL108</cbc:ID>`)
+	assert.Contains(t, xmlStr, `<cbc:IssueDate>2009-12-15</cbc:IssueDate>`)
+	assert.Contains(t, xmlStr, `<cbc:EndpointID schemeID="0088">7300010000001</cbc:EndpointID>`)
+	assert.Contains(t, xmlStr, `<cbc:CompanyID>DK12345678</cbc:CompanyID>`)
+	assert.Contains(t, xmlStr, `<cbc:TaxAmount currencyID="EUR">318.25</cbc:TaxAmount>`)
+	assert.Contains(t, xmlStr, `<cbc:PayableAmount currencyID="EUR">1591.25</cbc:PayableAmount>`)
+	assert.Contains(t, xmlStr, `<cbc:InvoicedQuantity unitCode="C62">1</cbc:InvoicedQuantity>`)
+	assert.Contains(t, xmlStr, `<cbc:LineExtensionAmount currencyID="EUR">1273.00</cbc:LineExtensionAmount>`)
+	assert.Contains(t, xmlStr, `<cbc:Name>Labtop computer</cbc:Name>`)
+	assert.Contains(t, xmlStr, `<cbc:Note>Penalty percentage 10% from due date</cbc:Note>`)
+	assert.NotContains(t, xmlStr, `PayableRoundingAmount`)
+
+	// cbc/cac elements must follow the sequence of the Invoice schema
+	order := []string{"<cbc:UBLVersionID>", "<cbc:ID>TOSL108", "<cbc:IssueDate>", "<cbc:DueDate>", "<cbc:InvoiceTypeCode>", "<cbc:Note>", "<cbc:DocumentCurrencyCode>", "<cbc:LineCountNumeric>", "<cac:OrderReference>", "<cac:AccountingSupplierParty>", "<cac:AccountingCustomerParty>", "<cac:PaymentTerms>", "<cac:TaxTotal>", "<cac:LegalMonetaryTotal>", "<cac:InvoiceLine>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr, elem)
+		assert.Greater(t, i, last, "element %s out of order", elem)
+		last = i
+	}
+}
+
+func TestInvoiceFromSourcePeppol(t *testing.T) {
+	src := testInvoiceSource()
+	src.Profile = ProfilePeppolBIS3
+	src.InvoiceHeader.InvoiceHeaderD.BuyerReference = "abs1234"
+	src.Parties[2].Party.PartyIdentifications = []*partyproto.PartyIdentification{
+		{PartyIdentification: "BUYER-7", PartyIdentificationSchemeId: "ZZZ"},
+		{PartyIdentification: "987654321", PartyIdentificationSchemeId: "0192"},
+	}
+	inv, err := InvoiceFromSource(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<cbc:CustomizationID>urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0</cbc:CustomizationID>`)
+	assert.Contains(t, xmlStr, `<cbc:ProfileID>urn:fdc:peppol.eu:2017:poacc:billing:01:1.0</cbc:ProfileID>`)
+	assert.Contains(t, xmlStr, `<cbc:BuyerReference>abs1234</cbc:BuyerReference>`)
+	assert.Contains(t, xmlStr, `<cbc:EndpointID schemeID="0088">7300010000001</cbc:EndpointID>`, "party_endpoint_id is kept")
+	assert.Contains(t, xmlStr, `<cbc:EndpointID schemeID="0192">987654321</cbc:EndpointID>`, "first identification with an electronic address scheme")
+	assert.NotContains(t, xmlStr, `<cbc:UBLVersionID>`)
+	assert.NotContains(t, xmlStr, `<cbc:UUID>`)
+}
+
+func TestInvoiceTaxCurrency(t *testing.T) {
+	src := testInvoiceSource()
+	src.InvoiceHeader.InvoiceHeaderD.TaxCurrencyCode = "DKK"
+	src.TaxTotals[0].TaxTotal.CurrencyCode = "EUR"
+	src.TaxTotals[0].TaxSubTotals[0].TaxSubTotal.CurrencyCode = "EUR"
+	src.TaxTotals[0].TaxSubTotals[0].TaxSubTotal.TransactionCurrencyTaxAmount = "2367.23"
+	src.TaxTotals[0].TaxSubTotals[0].TaxSubTotal.TransactionCurrencyCode = "DKK"
+	src.TaxTotals = append(src.TaxTotals, &TaxTotalSource{TaxTotal: &taxproto.TaxTotalD{TaxAmount: "2367.23", CurrencyCode: "DKK"}})
+	inv, err := InvoiceFromSource(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+	assert.Contains(t, xmlStr, `<cbc:TaxCurrencyCode>DKK</cbc:TaxCurrencyCode>`)
+	assert.Contains(t, xmlStr, `<cbc:TaxAmount currencyID="EUR">318.25</cbc:TaxAmount>`)
+	assert.Contains(t, xmlStr, `<cbc:TransactionCurrencyTaxAmount currencyID="DKK">2367.23</cbc:TransactionCurrencyTaxAmount>`)
+	assert.Contains(t, xmlStr, "<cac:TaxTotal>\n    <cbc:TaxAmount currencyID=\"DKK\">2367.23</cbc:TaxAmount>\n  </cac:TaxTotal>")
+
+	parsed := Invoice{}
+	if err := Unmarshal(out, NamespaceInvoice, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{
+		parties:       map[string]uint32{"7300010000001DK12345678": 7},
+		references:    map[string]uint32{ReferenceOrder + ":123": 3},
+		items:         map[string]uint32{"Labtop computer": 5},
+		taxCategories: map[string]uint32{"S:25": 4},
+	}
+	invoiceImport, err := ImportInvoice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := invoiceImport.Invoice
+	assert.Equal(t, "DKK", in.TaxCurrencyCode)
+	assert.Equal(t, "2367.23", in.TaxCurrencyTaxAmount)
+	assert.Equal(t, 1, len(in.TaxSubTotals), "the tax total in the tax currency has no sub totals")
+	assert.Equal(t, "2367.23", in.TaxSubTotals[0].TransactionCurrencyTaxAmount)
+}
+
+func TestInvoicePayableAlternativeAmount(t *testing.T) {
+	src := testInvoiceSource()
+	src.InvoiceHeader.InvoiceHeaderD.PaymentAltCurrencyCode = "DKK"
+	src.InvoiceHeader.InvoiceHeaderD.PayableAlternativeAmount = "1750.00"
+	src.InvoiceHeader.InvoiceHeaderD.PayableAlternativeCurrencyCode = "USD"
+	inv, err := InvoiceFromSource(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(out), `<cbc:PayableAlternativeAmount currencyID="USD">1750.00</cbc:PayableAlternativeAmount>`, "the amount has its own currency code")
+
+	parsed := Invoice{}
+	if err := Unmarshal(out, NamespaceInvoice, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{
+		parties:       map[string]uint32{"7300010000001DK12345678": 7},
+		references:    map[string]uint32{ReferenceOrder + ":123": 3},
+		items:         map[string]uint32{"Labtop computer": 5},
+		taxCategories: map[string]uint32{"S:25": 4},
+	}
+	invoiceImport, err := ImportInvoice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1750.00", invoiceImport.Invoice.PayableAlternativeAmount)
+	assert.Equal(t, "USD", invoiceImport.Invoice.PayableAlternativeCurrencyCode)
+}
+
+func TestInvoiceWithholdingTaxTotal(t *testing.T) {
+	src := testInvoiceSource()
+	incomeTax := &taxproto.TaxSchemeD{Id: 2, TsId: "ISR", TaxSchemeName: "ISR"}
+	incomeTaxRate := &taxproto.TaxCategoryD{Id: 2, TcId: "S", Percent: 10, TaxSchemeId: 2, WithholdingIndicator: true}
+	src.TaxTotals = append(src.TaxTotals, &TaxTotalSource{
+		TaxTotal:     &taxproto.TaxTotalD{TaxAmount: "127.30", WithholdingIndicator: true},
+		TaxSubTotals: []*TaxSubTotalSource{{TaxSubTotal: &taxproto.TaxSubTotalD{TaxableAmount: "1273", TaxAmount: "127.30", Percent: 10}, TaxCategory: incomeTaxRate, TaxScheme: incomeTax}},
+	})
+	src.InvoiceHeader.InvoiceHeaderD.WithholdingTaxTotalAmount = "127.30"
+	src.InvoiceHeader.InvoiceHeaderD.PayableAmount = "1463.95"
+	inv, err := InvoiceFromSource(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(inv.TaxTotal), "the withheld tax is not a cac:TaxTotal")
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+	assert.Contains(t, xmlStr, "<cac:WithholdingTaxTotal>\n    <cbc:TaxAmount currencyID=\"EUR\">127.30</cbc:TaxAmount>")
+	assert.Contains(t, xmlStr, `<cbc:WithholdingTaxTotalAmount currencyID="EUR">127.30</cbc:WithholdingTaxTotalAmount>`)
+
+	parsed := Invoice{}
+	if err := Unmarshal(out, NamespaceInvoice, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{
+		parties:       map[string]uint32{"7300010000001DK12345678": 7},
+		references:    map[string]uint32{ReferenceOrder + ":123": 3},
+		items:         map[string]uint32{"Labtop computer": 5},
+		taxCategories: map[string]uint32{"S:25": 4, "S:10": 6},
+	}
+	invoiceImport, err := ImportInvoice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := invoiceImport.Invoice
+	assert.Equal(t, 1, len(in.TaxSubTotals), "they should be equal")
+	assert.Equal(t, 1, len(in.WithholdingTaxSubTotals), "they should be equal")
+	assert.Equal(t, uint32(6), in.WithholdingTaxSubTotals[0].TaxCategoryId)
+	assert.Equal(t, "127.30", in.WithholdingTaxSubTotals[0].TaxAmount)
+	assert.Equal(t, "127.30", in.WithholdingTaxTotalAmount)
+}
+
+func TestParseProfile(t *testing.T) {
+	profile, err := ParseProfile("")
+	assert.NoError(t, err)
+	assert.Equal(t, ProfileUBL, profile)
+
+	profile, err = ParseProfile("PEPPOL-BIS-3")
+	assert.NoError(t, err)
+	assert.Equal(t, ProfilePeppolBIS3, profile)
+
+	_, err = ParseProfile("xrechnung")
+	assert.Error(t, err)
+}
+
+func TestInvoiceFromSourceNoHeader(t *testing.T) {
+	_, err := InvoiceFromSource(&InvoiceSource{})
+	assert.Error(t, err)
+}
+
+func TestUnmarshalPrefixes(t *testing.T) {
+	doc := `<?xml version="1.0"?>
+<inv:Invoice xmlns:inv="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" xmlns:a="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:b="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <b:ID>INV-1</b:ID>
+  <b:IssueDate>2024-01-31</b:IssueDate>
+  <a:LegalMonetaryTotal><b:PayableAmount currencyID="EUR">10.00</b:PayableAmount></a:LegalMonetaryTotal>
+</inv:Invoice>`
+	inv := Invoice{}
+	err := Unmarshal([]byte(doc), NamespaceInvoice, &inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "INV-1", inv.ID)
+	assert.Equal(t, "2024-01-31", inv.IssueDate)
+	assert.Equal(t, &Amount{Value: "10.00", CurrencyID: "EUR"}, inv.LegalMonetaryTotal.PayableAmount)
+
+	err = Unmarshal([]byte(doc), NamespaceCreditNote, &inv)
+	assert.Error(t, err)
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "100.00", FormatAmount("100"))
+	assert.Equal(t, "17.50", FormatAmount("17.5"))
+	assert.Equal(t, "0.396", FormatAmount("0.396"))
+	assert.Equal(t, "-3.10", FormatAmount("-3.1"))
+}
+
+// testResolver - Resolver over fixed ids, unknown parties get ids from 100
+type testResolver struct {
+	parties       map[string]uint32
+	references    map[string]uint32
+	items         map[string]uint32
+	taxCategories map[string]uint32
+	created       []*Party
+}
+
+func (tr *testResolver) FindParty(ctx context.Context, party *Party) (uint32, error) {
+	return tr.parties[party.EndpointValue()+party.VATNumber()], nil
+}
+
+func (tr *testResolver) CreateParty(ctx context.Context, party *Party) (uint32, error) {
+	tr.created = append(tr.created, party)
+	return uint32(99 + len(tr.created)), nil
+}
+
+func (tr *testResolver) FindReference(ctx context.Context, kind string, id string, parentID uint32) (uint32, error) {
+	return tr.references[kind+":"+id], nil
+}
+
+func (tr *testResolver) FindItem(ctx context.Context, item *Item) (uint32, error) {
+	return tr.items[item.Name], nil
+}
+
+func (tr *testResolver) FindTaxCategory(ctx context.Context, taxCategory *TaxCategory) (uint32, error) {
+	return tr.taxCategories[taxCategory.ID+":"+taxCategory.Percent], nil
+}
+
+func TestImportInvoice(t *testing.T) {
+	inv, err := InvoiceFromSource(testInvoiceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := Invoice{}
+	err = Unmarshal(out, NamespaceInvoice, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties:       map[string]uint32{"7300010000001DK12345678": 7},
+		references:    map[string]uint32{ReferenceOrder + ":123": 3},
+		items:         map[string]uint32{"Labtop computer": 5},
+		taxCategories: map[string]uint32{"S:25": 4},
+	}
+	invoiceImport, err := ImportInvoice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := invoiceImport.Invoice
+	assert.Equal(t, "TOSL108", in.IhId)
+	assert.Equal(t, "12/15/2009", in.IssueDate)
+	assert.Equal(t, "01/15/2010", in.DueDate)
+	assert.Equal(t, "12/15/2009", in.TaxPointDate, "missing dates default to the issue date")
+	assert.Equal(t, "EUR", in.DocumentCurrencyCode)
+	assert.Equal(t, uint32(3), in.OrderId)
+	assert.Equal(t, uint32(7), in.AccountingSupplierPartyId)
+	assert.Equal(t, uint32(100), in.AccountingCustomerPartyId)
+	assert.Equal(t, 1, len(resolver.created))
+	assert.Equal(t, "1591.25", in.PayableAmount)
+	assert.Equal(t, 1, len(in.InvoiceLines))
+	assert.Equal(t, uint32(5), in.InvoiceLines[0].ItemId)
+	assert.Equal(t, "1273.00", in.InvoiceLines[0].PriceAmount)
+	assert.Equal(t, "C62", in.InvoiceLines[0].UnitCode)
+	assert.Equal(t, 1, len(in.TaxSubTotals))
+	assert.Equal(t, uint32(4), in.TaxSubTotals[0].TaxCategoryId)
+	assert.Equal(t, "1273.00", in.TaxSubTotals[0].TaxableAmount)
+	assert.Equal(t, "318.25", in.TaxSubTotals[0].TaxAmount)
+	assert.Equal(t, float32(25), in.TaxSubTotals[0].Percent)
+	assert.Equal(t, 1, len(invoiceImport.PaymentTerms))
+	assert.Equal(t, "Penalty percentage 10% from due date", invoiceImport.PaymentTerms[0].Note)
+}
+
+func TestImportInvoiceFieldErrors(t *testing.T) {
+	doc := `<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+  <cbc:ID>INV-2</cbc:ID>
+  <cbc:IssueDate>2024-13-01</cbc:IssueDate>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:OrderReference><cbc:ID>PO-404</cbc:ID></cac:OrderReference>
+  <cac:AccountingSupplierParty><cac:Party><cac:PartyName><cbc:Name>No identifiers</cbc:Name></cac:PartyName></cac:Party></cac:AccountingSupplierParty>
+  <cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="USD">10.00</cbc:PayableAmount></cac:LegalMonetaryTotal>
+  <cac:InvoiceLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:InvoicedQuantity>ten</cbc:InvoicedQuantity>
+    <cbc:LineExtensionAmount currencyID="EUR">10.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Unknown</cbc:Name></cac:Item>
+  </cac:InvoiceLine>
+</Invoice>`
+	inv := Invoice{}
+	err := Unmarshal([]byte(doc), NamespaceInvoice, &inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{}
+	_, err = ImportInvoice(context.Background(), &inv, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportInvoice() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/Invoice/cbc:IssueDate",
+		"/Invoice/cac:OrderReference/cbc:ID",
+		"/Invoice/cac:AccountingSupplierParty/cac:Party",
+		"/Invoice/cac:AccountingCustomerParty/cac:Party",
+		"/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount",
+		"/Invoice/cac:InvoiceLine[1]/cbc:InvoicedQuantity",
+		"/Invoice/cac:InvoiceLine[1]/cbc:InvoicedQuantity/@unitCode",
+		"/Invoice/cac:InvoiceLine[1]/cac:Item",
+	}, paths)
+	assert.Empty(t, resolver.created, "no party is created for a document with errors")
+}
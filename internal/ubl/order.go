@@ -0,0 +1,426 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+)
+
+// Order - UBL 2.3 Order document
+type Order struct {
+	XMLName xml.Name `xml:"Order"`
+	Namespaces
+	UBLVersionID                 string                  `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID              string                  `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                    string                  `xml:"cbc:ProfileID,omitempty"`
+	ID                           string                  `xml:"cbc:ID"`
+	SalesOrderID                 string                  `xml:"cbc:SalesOrderID,omitempty"`
+	UUID                         string                  `xml:"cbc:UUID,omitempty"`
+	IssueDate                    string                  `xml:"cbc:IssueDate"`
+	OrderTypeCode                string                  `xml:"cbc:OrderTypeCode,omitempty"`
+	Note                         []string                `xml:"cbc:Note,omitempty"`
+	RequestedInvoiceCurrencyCode string                  `xml:"cbc:RequestedInvoiceCurrencyCode,omitempty"`
+	DocumentCurrencyCode         string                  `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	PricingCurrencyCode          string                  `xml:"cbc:PricingCurrencyCode,omitempty"`
+	TaxCurrencyCode              string                  `xml:"cbc:TaxCurrencyCode,omitempty"`
+	AccountingCostCode           string                  `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost               string                  `xml:"cbc:AccountingCost,omitempty"`
+	LineCountNumeric             string                  `xml:"cbc:LineCountNumeric,omitempty"`
+	ValidityPeriod               *Period                 `xml:"cac:ValidityPeriod,omitempty"`
+	OrderDocumentReference       []DocumentReference     `xml:"cac:OrderDocumentReference,omitempty"`
+	BuyerCustomerParty           *CustomerParty          `xml:"cac:BuyerCustomerParty"`
+	SellerSupplierParty          *SupplierParty          `xml:"cac:SellerSupplierParty"`
+	OriginatorCustomerParty      *CustomerParty          `xml:"cac:OriginatorCustomerParty,omitempty"`
+	FreightForwarderParty        *Party                  `xml:"cac:FreightForwarderParty,omitempty"`
+	AccountingCustomerParty      *CustomerParty          `xml:"cac:AccountingCustomerParty,omitempty"`
+	PaymentTerms                 []PaymentTerms          `xml:"cac:PaymentTerms,omitempty"`
+	TransactionConditions        []TransactionConditions `xml:"cac:TransactionConditions,omitempty"`
+	AllowanceCharge              []AllowanceCharge       `xml:"cac:AllowanceCharge,omitempty"`
+	TaxExchangeRate              *ExchangeRate           `xml:"cac:TaxExchangeRate,omitempty"`
+	PricingExchangeRate          *ExchangeRate           `xml:"cac:PricingExchangeRate,omitempty"`
+	PaymentExchangeRate          *ExchangeRate           `xml:"cac:PaymentExchangeRate,omitempty"`
+	DestinationCountry           *Country                `xml:"cac:DestinationCountry,omitempty"`
+	TaxTotal                     []TaxTotal              `xml:"cac:TaxTotal,omitempty"`
+	AnticipatedMonetaryTotal     *MonetaryTotal          `xml:"cac:AnticipatedMonetaryTotal,omitempty"`
+	OrderLine                    []OrderLine             `xml:"cac:OrderLine"`
+}
+
+// OrderSource - everything needed to build a UBL Order
+type OrderSource struct {
+	PurchaseOrderHeader *orderproto.PurchaseOrderHeader
+	PurchaseOrderLines  []*orderproto.PurchaseOrderLine
+	DocumentSource
+}
+
+// Master flags used by tax_totals and payment_terms rows of purchase orders
+const (
+	MasterFlagPurchaseOrderHeader = "POH"
+	MasterFlagPurchaseOrderLine   = "POL"
+)
+
+// Values of line_status_code of purchase order lines, the UBL LineStatusCode
+// code list extended with the answers of an OrderResponse
+const (
+	LineStatusNoStatus  = "NoStatus"
+	LineStatusAdded     = "Added"
+	LineStatusCancelled = "Cancelled"
+	LineStatusRevised   = "Revised"
+	LineStatusAccepted  = "Accepted"
+	LineStatusRejected  = "Rejected"
+	LineStatusChanged   = "Changed"
+)
+
+// OrderFromSource - build a UBL Order
+func OrderFromSource(src *OrderSource) (*Order, error) {
+	if src == nil || src.PurchaseOrderHeader == nil || src.PurchaseOrderHeader.PurchaseOrderHeaderD == nil {
+		return nil, errors.New("ubl: purchase order header is required")
+	}
+	hd := src.PurchaseOrderHeader.PurchaseOrderHeaderD
+	ht := src.PurchaseOrderHeader.PurchaseOrderHeaderT
+	if ht == nil {
+		ht = &orderproto.PurchaseOrderHeaderT{}
+	}
+	cur := hd.DocumentCurrencyCode
+
+	ord := Order{Namespaces: NewNamespaces(NamespaceOrder)}
+	ord.UBLVersionID = Version
+	ord.ID = documentID(hd.PohId, hd.IdS)
+	ord.SalesOrderID = hd.SalesOrderId
+	ord.UUID = hd.IdS
+	ord.IssueDate = FormatDate(ht.IssueDate)
+	ord.OrderTypeCode = hd.OrderTypeCode
+	ord.Note = notes(hd.Note)
+	ord.RequestedInvoiceCurrencyCode = hd.RequestedInvoiceCurrencyCode
+	ord.DocumentCurrencyCode = hd.DocumentCurrencyCode
+	ord.PricingCurrencyCode = hd.PricingCurrencyCode
+	ord.TaxCurrencyCode = hd.TaxCurrencyCode
+	ord.AccountingCostCode = hd.AccountingCostCode
+	ord.AccountingCost = hd.AccountingCost
+	if hd.LineCountNumeric != 0 {
+		ord.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	ord.ValidityPeriod = period("", FormatDate(ht.ValidityPeriod))
+
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		ord.OrderDocumentReference = []DocumentReference{{ID: id}}
+	}
+
+	ord.BuyerCustomerParty = &CustomerParty{Party: src.party(hd.BuyerCustomerPartyId)}
+	ord.SellerSupplierParty = &SupplierParty{Party: src.party(hd.SellerSupplierPartyId)}
+	if p := src.party(hd.OriginatorCustomerPartyId); p != nil {
+		ord.OriginatorCustomerParty = &CustomerParty{Party: p}
+	}
+	ord.FreightForwarderParty = src.party(hd.FreightForwarderPartyId)
+	if p := src.party(hd.AccountingCustomerPartyId); p != nil {
+		ord.AccountingCustomerParty = &CustomerParty{Party: p}
+	}
+
+	for _, pt := range src.PaymentTerms {
+		ord.PaymentTerms = append(ord.PaymentTerms, paymentTerms(pt, cur))
+	}
+	if hd.TransactionConditions != "" {
+		ord.TransactionConditions = []TransactionConditions{{Description: []string{hd.TransactionConditions}}}
+	}
+	ord.AllowanceCharge = allowanceCharges(src.AllowanceCharges, cur)
+
+	ord.TaxExchangeRate = exchangeRate(hd.TaxExSourceCurrencyCode, hd.TaxExSourceCurrencyBaseRate, hd.TaxExTargetCurrencyCode, hd.TaxExTargetCurrencyBaseRate, hd.TaxExExchangeMarketId, hd.TaxExCalculationRate, hd.TaxExMathematicOperatorCode, ht.TaxExDate)
+	ord.PricingExchangeRate = exchangeRate(hd.PricingExSourceCurrencyCode, hd.PricingExSourceCurrencyBaseRate, hd.PricingExTargetCurrencyCode, hd.PricingExTargetCurrencyBaseRate, hd.PricingExExchangeMarketId, hd.PricingExCalculationRate, hd.PricingExMathematicOperatorCode, ht.PricingExDate)
+	ord.PaymentExchangeRate = exchangeRate(hd.PaymentExSourceCurrencyCode, hd.PaymentExSourceCurrencyBaseRate, hd.PaymentExTargetCurrencyCode, hd.PaymentExTargetCurrencyBaseRate, hd.PaymentExExchangeMarketId, hd.PaymentExCalculationRate, hd.PaymentExMathematicOperatorCode, ht.PaymentExDate)
+	if hd.DestinationCountry != "" {
+		ord.DestinationCountry = &Country{IdentificationCode: hd.DestinationCountry}
+	}
+
+	ord.TaxTotal = taxTotals(src.TaxTotals, cur)
+
+	ord.AnticipatedMonetaryTotal = &MonetaryTotal{
+		LineExtensionAmount:       NewAmount(hd.LineExtensionAmount, cur),
+		TaxExclusiveAmount:        OptionalAmount(hd.TaxExclusiveAmount, cur),
+		TaxInclusiveAmount:        OptionalAmount(hd.TaxInclusiveAmount, cur),
+		AllowanceTotalAmount:      OptionalAmount(hd.AllowanceTotalAmount, cur),
+		ChargeTotalAmount:         OptionalAmount(hd.ChargeTotalAmount, cur),
+		WithholdingTaxTotalAmount: OptionalAmount(hd.WithholdingTaxTotalAmount, cur),
+		PrepaidAmount:             OptionalAmount(hd.PrepaidAmount, cur),
+		PayableRoundingAmount:     OptionalAmount(hd.PayableRoundingAmount, cur),
+		PayableAmount:             NewAmount(hd.PayableAmount, cur),
+		PayableAlternativeAmount:  OptionalAmount(hd.PayableAlternativeAmount, rowCurrency(hd.PayableAlternativeCurrencyCode, cur)),
+	}
+
+	for i, line := range src.PurchaseOrderLines {
+		ord.OrderLine = append(ord.OrderLine, src.orderLine(i, line, cur))
+	}
+	return &ord, nil
+}
+
+// orderLine - map a PurchaseOrderLine. cbc:ID falls back to the line uuid
+// so that OrderResponse and OrderChange documents can refer to the line.
+func (src *OrderSource) orderLine(i int, line *orderproto.PurchaseOrderLine, cur string) OrderLine {
+	ld := line.PurchaseOrderLineD
+	cur = rowCurrency(ld.CurrencyCode, cur)
+	lt := line.PurchaseOrderLineT
+	if lt == nil {
+		lt = &orderproto.PurchaseOrderLineT{}
+	}
+	li := LineItem{}
+	li.ID = lineID(documentID(ld.PolId, ld.IdS), i)
+	li.SalesOrderID = ld.SalesOrderId
+	li.UUID = ld.IdS
+	li.LineStatusCode = ld.LineStatusCode
+	li.Quantity = &Quantity{Value: FormatDecimal(ld.Quantity), UnitCode: ld.UnitCode}
+	li.LineExtensionAmount = NewAmount(ld.LineExtensionAmount, cur)
+	li.TotalTaxAmount = OptionalAmount(ld.TotalTaxAmount, cur)
+	li.MinimumQuantity = optionalQuantity(ld.MinimumQuantity)
+	li.MaximumQuantity = optionalQuantity(ld.MaximumQuantity)
+	li.MinimumBackorderQuantity = optionalQuantity(ld.MinimumBackorderQuantity)
+	li.MaximumBackorderQuantity = optionalQuantity(ld.MaximumBackorderQuantity)
+	li.InspectionMethodCode = ld.InspectionMethodCode
+	li.PartialDeliveryIndicator = ld.PartialDeliveryIndicator
+	li.BackOrderAllowedIndicator = ld.BackOrderAllowedIndicator
+	li.AccountingCostCode = ld.AccountingCostCode
+	li.AccountingCost = ld.AccountingCost
+	li.WarrantyInformation = notes(ld.WarrantyInformation)
+	li.OriginatorParty = src.party(ld.OriginatorPartyId)
+	li.AllowanceCharge = allowanceCharges(src.LineAllowanceCharges[ld.Id], cur)
+	li.Price = price(ld.PriceAmount, ld.PriceBaseQuantity, ld.PriceChangeReason, ld.PriceTypeCode, ld.PriceType, ld.OrderableUnitFactorRate, FormatDate(lt.PriceValidityPeriodStartDate), FormatDate(lt.PriceValidityPeriodEndDate), cur)
+	li.Item = item(src.Items[ld.ItemId])
+	li.TaxTotal = taxTotals(src.LineTaxTotals[ld.Id], cur)
+
+	ol := OrderLine{}
+	ol.Note = notes(ld.Note)
+	ol.SubstitutionStatusCode = ld.SubstitutionStatusCode
+	ol.LineItem = &li
+	return ol
+}
+
+func optionalQuantity(v float64) *Quantity {
+	if v == 0 {
+		return nil
+	}
+	return &Quantity{Value: FormatDecimal(v)}
+}
+
+// OrderImport - Create requests for an imported UBL Order
+type OrderImport struct {
+	Order        *orderproto.CreatePurchaseOrderHeaderRequest
+	PaymentTerms []*paymentproto.CreatePaymentTermRequest
+}
+
+// ImportOrder - map a UBL Order onto a CreatePurchaseOrderHeaderRequest.
+// Elements that cannot be mapped are returned as FieldErrors; parties are
+// only created when there are none.
+func ImportOrder(ctx context.Context, ord *Order, resolver Resolver) (*OrderImport, error) {
+	const root = "/Order"
+	im := importer{ctx: ctx, resolver: resolver, currency: ord.DocumentCurrencyCode}
+	in := orderproto.CreatePurchaseOrderHeaderRequest{}
+
+	if strings.TrimSpace(ord.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if ord.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", ord.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.PohId = strings.TrimSpace(ord.ID)
+	in.SalesOrderId = ord.SalesOrderID
+	in.OrderTypeCode = ord.OrderTypeCode
+	in.Note = strings.Join(ord.Note, "\n")
+	in.RequestedInvoiceCurrencyCode = ord.RequestedInvoiceCurrencyCode
+	in.DocumentCurrencyCode = ord.DocumentCurrencyCode
+	in.PricingCurrencyCode = ord.PricingCurrencyCode
+	in.TaxCurrencyCode = ord.TaxCurrencyCode
+	in.AccountingCostCode = ord.AccountingCostCode
+	in.AccountingCost = ord.AccountingCost
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", ord.LineCountNumeric)
+	_, in.ValidityPeriod = im.period(root+"/cac:ValidityPeriod", ord.ValidityPeriod)
+
+	for i, ref := range ord.OrderDocumentReference {
+		path := fmt.Sprintf("%s/cac:OrderDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one order can be referenced")
+			continue
+		}
+		in.OrderId = im.reference(path+"/cbc:ID", ReferenceOrder, ref.ID, 0)
+	}
+
+	if ord.BuyerCustomerParty == nil || ord.BuyerCustomerParty.Party == nil {
+		im.fail(root+"/cac:BuyerCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", ord.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if ord.SellerSupplierParty == nil || ord.SellerSupplierParty.Party == nil {
+		im.fail(root+"/cac:SellerSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", ord.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	if ord.OriginatorCustomerParty != nil {
+		im.party(root+"/cac:OriginatorCustomerParty/cac:Party", ord.OriginatorCustomerParty.Party, &in.OriginatorCustomerPartyId)
+	}
+	im.party(root+"/cac:FreightForwarderParty", ord.FreightForwarderParty, &in.FreightForwarderPartyId)
+	if ord.AccountingCustomerParty != nil {
+		im.party(root+"/cac:AccountingCustomerParty/cac:Party", ord.AccountingCustomerParty.Party, &in.AccountingCustomerPartyId)
+	}
+
+	paymentTerms := []*paymentproto.CreatePaymentTermRequest{}
+	for i := range ord.PaymentTerms {
+		path := fmt.Sprintf("%s/cac:PaymentTerms[%d]", root, i+1)
+		paymentTerms = append(paymentTerms, im.paymentTerms(path, &ord.PaymentTerms[i]))
+	}
+	in.TransactionConditions = transactionConditions(ord.TransactionConditions)
+
+	taxEx := im.exchangeRate(root+"/cac:TaxExchangeRate", ord.TaxExchangeRate)
+	in.TaxExSourceCurrencyCode = taxEx.SourceCurrencyCode
+	in.TaxExSourceCurrencyBaseRate = taxEx.SourceCurrencyBaseRate
+	in.TaxExTargetCurrencyCode = taxEx.TargetCurrencyCode
+	in.TaxExTargetCurrencyBaseRate = taxEx.TargetCurrencyBaseRate
+	in.TaxExExchangeMarketId = taxEx.ExchangeMarketID
+	in.TaxExCalculationRate = taxEx.CalculationRate
+	in.TaxExMathematicOperatorCode = taxEx.MathematicOperatorCode
+	in.TaxExDate = taxEx.Date
+
+	pricingEx := im.exchangeRate(root+"/cac:PricingExchangeRate", ord.PricingExchangeRate)
+	in.PricingExSourceCurrencyCode = pricingEx.SourceCurrencyCode
+	in.PricingExSourceCurrencyBaseRate = pricingEx.SourceCurrencyBaseRate
+	in.PricingExTargetCurrencyCode = pricingEx.TargetCurrencyCode
+	in.PricingExTargetCurrencyBaseRate = pricingEx.TargetCurrencyBaseRate
+	in.PricingExExchangeMarketId = pricingEx.ExchangeMarketID
+	in.PricingExCalculationRate = pricingEx.CalculationRate
+	in.PricingExMathematicOperatorCode = pricingEx.MathematicOperatorCode
+	in.PricingExDate = pricingEx.Date
+
+	paymentEx := im.exchangeRate(root+"/cac:PaymentExchangeRate", ord.PaymentExchangeRate)
+	in.PaymentExSourceCurrencyCode = paymentEx.SourceCurrencyCode
+	in.PaymentExSourceCurrencyBaseRate = paymentEx.SourceCurrencyBaseRate
+	in.PaymentExTargetCurrencyCode = paymentEx.TargetCurrencyCode
+	in.PaymentExTargetCurrencyBaseRate = paymentEx.TargetCurrencyBaseRate
+	in.PaymentExExchangeMarketId = paymentEx.ExchangeMarketID
+	in.PaymentExCalculationRate = paymentEx.CalculationRate
+	in.PaymentExMathematicOperatorCode = paymentEx.MathematicOperatorCode
+	in.PaymentExDate = paymentEx.Date
+
+	if ord.DestinationCountry != nil {
+		in.DestinationCountry = ord.DestinationCountry.IdentificationCode
+	}
+
+	in.AllowanceCharges = im.allowanceCharges(root, ord.AllowanceCharge)
+	im.anticipatedMonetaryTotal(root+"/cac:AnticipatedMonetaryTotal", ord.AnticipatedMonetaryTotal, &in)
+
+	if len(ord.OrderLine) == 0 {
+		im.fail(root+"/cac:OrderLine", "at least one order line is required")
+	}
+	for i := range ord.OrderLine {
+		path := fmt.Sprintf("%s/cac:OrderLine[%d]", root, i+1)
+		in.PurchaseOrderLines = append(in.PurchaseOrderLines, im.orderLine(path, &ord.OrderLine[i]))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &OrderImport{Order: &in, PaymentTerms: paymentTerms}, nil
+}
+
+// transactionConditions - the descriptions of cac:TransactionConditions,
+// their action codes when there are none
+func transactionConditions(conditions []TransactionConditions) string {
+	descriptions := []string{}
+	for _, tc := range conditions {
+		for _, d := range tc.Description {
+			if d = strings.TrimSpace(d); d != "" {
+				descriptions = append(descriptions, d)
+			}
+		}
+		if len(tc.Description) == 0 && strings.TrimSpace(tc.ActionCode) != "" {
+			descriptions = append(descriptions, strings.TrimSpace(tc.ActionCode))
+		}
+	}
+	return strings.Join(descriptions, "\n")
+}
+
+// anticipatedMonetaryTotal - map cac:AnticipatedMonetaryTotal onto the
+// amounts of a purchase order header
+func (im *importer) anticipatedMonetaryTotal(path string, mt *MonetaryTotal, in *orderproto.CreatePurchaseOrderHeaderRequest) {
+	if mt == nil {
+		return
+	}
+	in.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", mt.LineExtensionAmount)
+	in.TaxExclusiveAmount = im.amount(path+"/cbc:TaxExclusiveAmount", mt.TaxExclusiveAmount)
+	in.TaxInclusiveAmount = im.amount(path+"/cbc:TaxInclusiveAmount", mt.TaxInclusiveAmount)
+	in.AllowanceTotalAmount = im.amount(path+"/cbc:AllowanceTotalAmount", mt.AllowanceTotalAmount)
+	in.ChargeTotalAmount = im.amount(path+"/cbc:ChargeTotalAmount", mt.ChargeTotalAmount)
+	in.WithholdingTaxTotalAmount = im.amount(path+"/cbc:WithholdingTaxTotalAmount", mt.WithholdingTaxTotalAmount)
+	in.PrepaidAmount = im.amount(path+"/cbc:PrepaidAmount", mt.PrepaidAmount)
+	in.PayableRoundingAmount = im.amount(path+"/cbc:PayableRoundingAmount", mt.PayableRoundingAmount)
+	in.PayableAmount = im.amount(path+"/cbc:PayableAmount", mt.PayableAmount)
+	if a := mt.PayableAlternativeAmount; a != nil {
+		in.PayableAlternativeAmount = im.money(path+"/cbc:PayableAlternativeAmount", a.Value)
+		in.PayableAlternativeCurrencyCode = a.CurrencyID
+	}
+}
+
+// orderLine - map a cac:OrderLine onto a CreatePurchaseOrderLineRequest
+func (im *importer) orderLine(path string, ol *OrderLine) *orderproto.CreatePurchaseOrderLineRequest {
+	line := orderproto.CreatePurchaseOrderLineRequest{}
+	line.Note = strings.Join(ol.Note, "\n")
+	line.SubstitutionStatusCode = ol.SubstitutionStatusCode
+	li := ol.LineItem
+	if li == nil {
+		im.fail(path+"/cac:LineItem", "is required")
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.defaultDate, im.defaultDate
+		return &line
+	}
+	path += "/cac:LineItem"
+
+	line.PolId = strings.TrimSpace(li.ID)
+	line.SalesOrderId = li.SalesOrderID
+	line.LineStatusCode = li.LineStatusCode
+	line.Quantity = im.quantity(path+"/cbc:Quantity", li.Quantity)
+	if li.Quantity != nil {
+		line.UnitCode = im.unitCode(path+"/cbc:Quantity", li.Quantity)
+	}
+	line.LineExtensionAmount = im.amount(path+"/cbc:LineExtensionAmount", li.LineExtensionAmount)
+	line.TotalTaxAmount = im.amount(path+"/cbc:TotalTaxAmount", li.TotalTaxAmount)
+	line.MinimumQuantity = im.quantity(path+"/cbc:MinimumQuantity", li.MinimumQuantity)
+	line.MaximumQuantity = im.quantity(path+"/cbc:MaximumQuantity", li.MaximumQuantity)
+	line.MinimumBackorderQuantity = im.quantity(path+"/cbc:MinimumBackorderQuantity", li.MinimumBackorderQuantity)
+	line.MaximumBackorderQuantity = im.quantity(path+"/cbc:MaximumBackorderQuantity", li.MaximumBackorderQuantity)
+	line.InspectionMethodCode = li.InspectionMethodCode
+	line.PartialDeliveryIndicator = li.PartialDeliveryIndicator
+	line.BackOrderAllowedIndicator = li.BackOrderAllowedIndicator
+	line.AccountingCostCode = li.AccountingCostCode
+	line.AccountingCost = li.AccountingCost
+	line.WarrantyInformation = strings.Join(li.WarrantyInformation, "\n")
+	im.party(path+"/cac:OriginatorParty", li.OriginatorParty, &line.OriginatorPartyId)
+
+	line.AllowanceCharges = im.allowanceCharges(path, li.AllowanceCharge)
+
+	if p := li.Price; p != nil {
+		line.PriceAmount = im.amount(path+"/cac:Price/cbc:PriceAmount", p.PriceAmount)
+		line.PriceBaseQuantity = im.quantity(path+"/cac:Price/cbc:BaseQuantity", p.BaseQuantity)
+		line.PriceChangeReason = p.PriceChangeReason
+		line.PriceTypeCode = p.PriceTypeCode
+		line.PriceType = p.PriceType
+		line.OrderableUnitFactorRate = im.decimal(path+"/cac:Price/cbc:OrderableUnitFactorRate", p.OrderableUnitFactorRate)
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.period(path+"/cac:Price/cac:ValidityPeriod", p.ValidityPeriod)
+	} else {
+		line.PriceValidityPeriodStartDate, line.PriceValidityPeriodEndDate = im.defaultDate, im.defaultDate
+	}
+
+	if li.Item == nil {
+		im.fail(path+"/cac:Item", "is required")
+	} else {
+		line.ItemId = im.item(path+"/cac:Item", li.Item)
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(path+"/cac:Item", "no item matches its identifications or name %q", li.Item.Name)
+		}
+	}
+	return &line
+}
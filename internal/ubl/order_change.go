@@ -0,0 +1,162 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+)
+
+// OrderChange - UBL 2.3 OrderChange document
+type OrderChange struct {
+	XMLName xml.Name `xml:"OrderChange"`
+	Namespaces
+	UBLVersionID                 string          `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID              string          `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                    string          `xml:"cbc:ProfileID,omitempty"`
+	ID                           string          `xml:"cbc:ID"`
+	SalesOrderID                 string          `xml:"cbc:SalesOrderID,omitempty"`
+	UUID                         string          `xml:"cbc:UUID,omitempty"`
+	IssueDate                    string          `xml:"cbc:IssueDate"`
+	SequenceNumberID             string          `xml:"cbc:SequenceNumberID"`
+	Note                         []string        `xml:"cbc:Note,omitempty"`
+	RequestedInvoiceCurrencyCode string          `xml:"cbc:RequestedInvoiceCurrencyCode,omitempty"`
+	DocumentCurrencyCode         string          `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	PricingCurrencyCode          string          `xml:"cbc:PricingCurrencyCode,omitempty"`
+	TaxCurrencyCode              string          `xml:"cbc:TaxCurrencyCode,omitempty"`
+	AccountingCostCode           string          `xml:"cbc:AccountingCostCode,omitempty"`
+	AccountingCost               string          `xml:"cbc:AccountingCost,omitempty"`
+	LineCountNumeric             string          `xml:"cbc:LineCountNumeric,omitempty"`
+	ValidityPeriod               *Period         `xml:"cac:ValidityPeriod,omitempty"`
+	OrderReference               *OrderReference `xml:"cac:OrderReference"`
+	BuyerCustomerParty           *CustomerParty  `xml:"cac:BuyerCustomerParty"`
+	SellerSupplierParty          *SupplierParty  `xml:"cac:SellerSupplierParty"`
+	TaxTotal                     []TaxTotal      `xml:"cac:TaxTotal,omitempty"`
+	AnticipatedMonetaryTotal     *MonetaryTotal  `xml:"cac:AnticipatedMonetaryTotal,omitempty"`
+	OrderLine                    []OrderLine     `xml:"cac:OrderLine"`
+}
+
+// changeLineStatus - line status of a cbc:LineStatusCode of an OrderChange
+// line, UNCL1229 codes or UBL LineStatusCode names
+func changeLineStatus(code string) (string, bool) {
+	switch strings.TrimSpace(code) {
+	case "", "4", LineStatusNoStatus:
+		return LineStatusNoStatus, true
+	case "1", LineStatusAdded:
+		return LineStatusAdded, true
+	case "2", LineStatusCancelled:
+		return LineStatusCancelled, true
+	case "3", LineStatusRevised:
+		return LineStatusRevised, true
+	}
+	return "", false
+}
+
+// OrderChangeImport - the changes an imported UBL OrderChange makes to a
+// purchase order. The header values replace those of the order, lines are
+// only changed when their LineStatusCode says so.
+type OrderChangeImport struct {
+	PurchaseOrderHeaderID uint32
+	SequenceNumberID      uint32
+	// PurchaseOrderHeader - note, currencies, accounting cost, line count and
+	// anticipated monetary total of the changed order
+	PurchaseOrderHeader *orderproto.CreatePurchaseOrderHeaderRequest
+	Lines               []*OrderLineChange
+}
+
+// OrderLineChange - an added, revised or cancelled purchase order line
+type OrderLineChange struct {
+	// PurchaseOrderLineID - 0 for added lines
+	PurchaseOrderLineID uint32
+	LineStatusCode      string
+	// PurchaseOrderLine - values of added and revised lines
+	PurchaseOrderLine *orderproto.CreatePurchaseOrderLineRequest
+}
+
+// ImportOrderChange - map a UBL OrderChange onto the purchase order it
+// changes. Parties of the order are kept.
+func ImportOrderChange(ctx context.Context, oc *OrderChange, resolver Resolver) (*OrderChangeImport, error) {
+	const root = "/OrderChange"
+	im := importer{ctx: ctx, resolver: resolver, currency: oc.DocumentCurrencyCode}
+	orderChangeImport := OrderChangeImport{}
+	in := orderproto.CreatePurchaseOrderHeaderRequest{}
+
+	if strings.TrimSpace(oc.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if oc.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	im.defaultDate = im.date(root+"/cbc:IssueDate", oc.IssueDate)
+	if strings.TrimSpace(oc.SequenceNumberID) == "" {
+		im.fail(root+"/cbc:SequenceNumberID", "is required")
+	}
+	orderChangeImport.SequenceNumberID = im.numeric(root+"/cbc:SequenceNumberID", oc.SequenceNumberID)
+
+	if oc.OrderReference == nil {
+		im.fail(root+"/cac:OrderReference", "is required")
+	} else {
+		orderChangeImport.PurchaseOrderHeaderID = im.reference(root+"/cac:OrderReference/cbc:ID", ReferenceOrder, oc.OrderReference.ID, 0)
+	}
+
+	in.SalesOrderId = oc.SalesOrderID
+	in.Note = strings.Join(oc.Note, "\n")
+	in.RequestedInvoiceCurrencyCode = oc.RequestedInvoiceCurrencyCode
+	in.DocumentCurrencyCode = oc.DocumentCurrencyCode
+	in.PricingCurrencyCode = oc.PricingCurrencyCode
+	in.TaxCurrencyCode = oc.TaxCurrencyCode
+	in.AccountingCostCode = oc.AccountingCostCode
+	in.AccountingCost = oc.AccountingCost
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", oc.LineCountNumeric)
+	im.anticipatedMonetaryTotal(root+"/cac:AnticipatedMonetaryTotal", oc.AnticipatedMonetaryTotal, &in)
+	orderChangeImport.PurchaseOrderHeader = &in
+
+	for i := range oc.OrderLine {
+		path := fmt.Sprintf("%s/cac:OrderLine[%d]", root, i+1)
+		ol := &oc.OrderLine[i]
+		if ol.LineItem == nil {
+			im.fail(path+"/cac:LineItem", "is required")
+			continue
+		}
+		status, ok := changeLineStatus(ol.LineItem.LineStatusCode)
+		if !ok {
+			im.fail(path+"/cac:LineItem/cbc:LineStatusCode", "unknown code %q, expected 1, 2, 3 or 4", ol.LineItem.LineStatusCode)
+			continue
+		}
+
+		lineChange := OrderLineChange{LineStatusCode: status}
+		switch status {
+		case LineStatusNoStatus:
+			continue
+		case LineStatusAdded:
+			if im.err == nil && strings.TrimSpace(ol.LineItem.ID) != "" {
+				lineID, err := im.resolver.FindReference(ctx, ReferenceOrderLine, strings.TrimSpace(ol.LineItem.ID), orderChangeImport.PurchaseOrderHeaderID)
+				if err != nil {
+					im.err = err
+				} else if lineID != 0 {
+					im.fail(path+"/cac:LineItem/cbc:ID", "order line %q already exists", ol.LineItem.ID)
+				}
+			}
+			lineChange.PurchaseOrderLine = im.orderLine(path, ol)
+		case LineStatusRevised:
+			lineChange.PurchaseOrderLineID = im.orderLineReference(path, ol, orderChangeImport.PurchaseOrderHeaderID)
+			lineChange.PurchaseOrderLine = im.orderLine(path, ol)
+		case LineStatusCancelled:
+			lineChange.PurchaseOrderLineID = im.orderLineReference(path, ol, orderChangeImport.PurchaseOrderHeaderID)
+		}
+		if lineChange.PurchaseOrderLine != nil {
+			lineChange.PurchaseOrderLine.LineStatusCode = status
+		}
+		orderChangeImport.Lines = append(orderChangeImport.Lines, &lineChange)
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &orderChangeImport, nil
+}
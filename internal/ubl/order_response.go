@@ -0,0 +1,180 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// OrderResponse - UBL 2.3 OrderResponse document
+type OrderResponse struct {
+	XMLName xml.Name `xml:"OrderResponse"`
+	Namespaces
+	UBLVersionID         string           `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID      string           `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID            string           `xml:"cbc:ProfileID,omitempty"`
+	ID                   string           `xml:"cbc:ID"`
+	SalesOrderID         string           `xml:"cbc:SalesOrderID,omitempty"`
+	UUID                 string           `xml:"cbc:UUID,omitempty"`
+	IssueDate            string           `xml:"cbc:IssueDate"`
+	OrderResponseCode    string           `xml:"cbc:OrderResponseCode,omitempty"`
+	Note                 []string         `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode string           `xml:"cbc:DocumentCurrencyCode,omitempty"`
+	OrderReference       []OrderReference `xml:"cac:OrderReference"`
+	SellerSupplierParty  *SupplierParty   `xml:"cac:SellerSupplierParty,omitempty"`
+	BuyerCustomerParty   *CustomerParty   `xml:"cac:BuyerCustomerParty,omitempty"`
+	OrderLine            []OrderLine      `xml:"cac:OrderLine,omitempty"`
+}
+
+// Values of cbc:OrderResponseCode, the UNCL4343 subset used for order
+// responses
+const (
+	OrderResponseAcknowledged          = "AB"
+	OrderResponseAccepted              = "AP"
+	OrderResponseRejected              = "RE"
+	OrderResponseConditionallyAccepted = "CA"
+)
+
+// OrderResponseLineStatus - line status of the lines an OrderResponse with
+// the given code does not list, empty when they keep their status
+func OrderResponseLineStatus(orderResponseCode string) string {
+	switch orderResponseCode {
+	case OrderResponseAccepted, OrderResponseConditionallyAccepted:
+		return LineStatusAccepted
+	case OrderResponseRejected:
+		return LineStatusRejected
+	}
+	return ""
+}
+
+// responseLineStatus - line status of a cbc:LineStatusCode of an
+// OrderResponse line, UNCL1229 codes or their line status names
+func responseLineStatus(code string) (string, bool) {
+	switch strings.TrimSpace(code) {
+	case "5", LineStatusAccepted:
+		return LineStatusAccepted, true
+	case "7", LineStatusRejected:
+		return LineStatusRejected, true
+	case "3", LineStatusChanged:
+		return LineStatusChanged, true
+	}
+	return "", false
+}
+
+// OrderResponseImport - the statuses an imported UBL OrderResponse sets on
+// a purchase order and its lines
+type OrderResponseImport struct {
+	PurchaseOrderHeaderID uint32
+	OrderResponseCode     string
+	SalesOrderID          string
+	// Lines - the lines listed by the response
+	Lines []*OrderLineStatus
+}
+
+// OrderLineStatus - the answer of an OrderResponse for one purchase order
+// line
+type OrderLineStatus struct {
+	PurchaseOrderLineID uint32
+	LineStatusCode      string
+	SalesOrderID        string
+}
+
+// ImportOrderResponse - resolve the purchase order and lines a UBL
+// OrderResponse answers. Lines are referenced by
+// cac:OrderLineReference/cbc:LineID, else by cac:LineItem/cbc:ID.
+func ImportOrderResponse(ctx context.Context, or *OrderResponse, resolver Resolver) (*OrderResponseImport, error) {
+	const root = "/OrderResponse"
+	im := importer{ctx: ctx, resolver: resolver}
+	orderResponseImport := OrderResponseImport{}
+
+	if strings.TrimSpace(or.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if or.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	im.date(root+"/cbc:IssueDate", or.IssueDate)
+	orderResponseImport.SalesOrderID = or.SalesOrderID
+
+	switch code := strings.TrimSpace(or.OrderResponseCode); code {
+	case OrderResponseAcknowledged, OrderResponseAccepted, OrderResponseRejected, OrderResponseConditionallyAccepted:
+		orderResponseImport.OrderResponseCode = code
+	case "":
+		if len(or.OrderLine) == 0 {
+			im.fail(root+"/cbc:OrderResponseCode", "is required when no order line is answered")
+		}
+		orderResponseImport.OrderResponseCode = OrderResponseConditionallyAccepted
+	default:
+		im.fail(root+"/cbc:OrderResponseCode", "unknown code %q, expected AB, AP, RE or CA", code)
+	}
+
+	if len(or.OrderReference) == 0 {
+		im.fail(root+"/cac:OrderReference", "is required")
+	}
+	for i, ref := range or.OrderReference {
+		path := fmt.Sprintf("%s/cac:OrderReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one order can be referenced")
+			continue
+		}
+		orderResponseImport.PurchaseOrderHeaderID = im.reference(path+"/cbc:ID", ReferenceOrder, ref.ID, 0)
+	}
+
+	defaultStatus := OrderResponseLineStatus(orderResponseImport.OrderResponseCode)
+	answered := make(map[uint32]bool)
+	for i := range or.OrderLine {
+		path := fmt.Sprintf("%s/cac:OrderLine[%d]", root, i+1)
+		ol := &or.OrderLine[i]
+		lineID := im.orderLineReference(path, ol, orderResponseImport.PurchaseOrderHeaderID)
+		if lineID != 0 && answered[lineID] {
+			im.fail(path, "order line is answered more than once")
+		}
+		answered[lineID] = true
+
+		lineStatus := OrderLineStatus{PurchaseOrderLineID: lineID}
+		if ol.LineItem != nil {
+			lineStatus.SalesOrderID = ol.LineItem.SalesOrderID
+			statusPath := path + "/cac:LineItem/cbc:LineStatusCode"
+			code := ol.LineItem.LineStatusCode
+			status, ok := responseLineStatus(code)
+			switch {
+			case strings.TrimSpace(code) == "" && defaultStatus != "":
+				status = defaultStatus
+			case strings.TrimSpace(code) == "":
+				im.fail(statusPath, "is required")
+			case !ok:
+				im.fail(statusPath, "unknown code %q, expected 3, 5 or 7", code)
+			case orderResponseImport.OrderResponseCode != OrderResponseConditionallyAccepted && defaultStatus != "" && status != defaultStatus:
+				im.fail(statusPath, "line status %s contradicts OrderResponseCode %s", status, orderResponseImport.OrderResponseCode)
+			}
+			lineStatus.LineStatusCode = status
+		}
+		orderResponseImport.Lines = append(orderResponseImport.Lines, &lineStatus)
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	return &orderResponseImport, nil
+}
+
+// orderLineReference - id of the purchase order line a cac:OrderLine of an
+// OrderResponse or OrderChange refers to
+func (im *importer) orderLineReference(path string, ol *OrderLine, orderID uint32) uint32 {
+	for i, ref := range ol.OrderLineReference {
+		refPath := fmt.Sprintf("%s/cac:OrderLineReference[%d]", path, i+1)
+		if i > 0 {
+			im.fail(refPath, "only one order line can be referenced")
+			continue
+		}
+		if strings.TrimSpace(ref.LineID) != "" {
+			return im.reference(refPath+"/cbc:LineID", ReferenceOrderLine, ref.LineID, orderID)
+		}
+	}
+	if ol.LineItem == nil || strings.TrimSpace(ol.LineItem.ID) == "" {
+		im.fail(path+"/cac:LineItem/cbc:ID", "is required")
+		return 0
+	}
+	return im.reference(path+"/cac:LineItem/cbc:ID", ReferenceOrderLine, ol.LineItem.ID, orderID)
+}
@@ -0,0 +1,319 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	orderproto "github.com/cloudfresco/sc-ubl/internal/protogen/order/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+const testOrderNamespaces = `xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"`
+
+func testOrderSource() *OrderSource {
+	purchaseOrderHeaderD := orderproto.PurchaseOrderHeaderD{}
+	purchaseOrderHeaderD.Id = 1
+	purchaseOrderHeaderD.IdS = "413a40b5-5f7b-40c5-bbaf-d6e025543fde"
+	purchaseOrderHeaderD.PohId = "34"
+	purchaseOrderHeaderD.SalesOrderId = "CON0095678"
+	purchaseOrderHeaderD.Note = "Information text for the whole order"
+	purchaseOrderHeaderD.DocumentCurrencyCode = "EUR"
+	purchaseOrderHeaderD.BuyerCustomerPartyId = 2
+	purchaseOrderHeaderD.SellerSupplierPartyId = 1
+	purchaseOrderHeaderD.TransactionConditions = "Order response required"
+	purchaseOrderHeaderD.DestinationCountry = "DK"
+	purchaseOrderHeaderD.LineExtensionAmount = "1273"
+	purchaseOrderHeaderD.TaxExclusiveAmount = "1273"
+	purchaseOrderHeaderD.TaxInclusiveAmount = "1591.25"
+	purchaseOrderHeaderD.PayableAmount = "1591.25"
+
+	purchaseOrderHeaderT := orderproto.PurchaseOrderHeaderT{}
+	purchaseOrderHeaderT.IssueDate = testDate("2005-06-20")
+	purchaseOrderHeaderT.ValidityPeriod = testDate("2005-06-30")
+
+	purchaseOrderLineD := orderproto.PurchaseOrderLineD{}
+	purchaseOrderLineD.Id = 1
+	purchaseOrderLineD.IdS = "188c398a-1ea5-4e41-9e53-fe2bf0e9b5a2"
+	purchaseOrderLineD.LineStatusCode = LineStatusNoStatus
+	purchaseOrderLineD.Quantity = 1
+	purchaseOrderLineD.UnitCode = "C62"
+	purchaseOrderLineD.LineExtensionAmount = "1273"
+	purchaseOrderLineD.PartialDeliveryIndicator = true
+	purchaseOrderLineD.ItemId = 3
+	purchaseOrderLineD.PriceAmount = "1273"
+
+	return &OrderSource{
+		PurchaseOrderHeader: &orderproto.PurchaseOrderHeader{PurchaseOrderHeaderD: &purchaseOrderHeaderD, PurchaseOrderHeaderT: &purchaseOrderHeaderT},
+		PurchaseOrderLines:  []*orderproto.PurchaseOrderLine{{PurchaseOrderLineD: &purchaseOrderLineD, PurchaseOrderLineT: &orderproto.PurchaseOrderLineT{}}},
+		DocumentSource:      testDocumentSource(),
+	}
+}
+
+func TestOrderFromSource(t *testing.T) {
+	ord, err := OrderFromSource(testOrderSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<Order xmlns="urn:oasis:names:specification:ubl:schema:xsd:Order-2"`)
+	assert.Contains(t, xmlStr, `<cbc:SalesOrderID>CON0095678</cbc:SalesOrderID>`)
+	assert.Contains(t, xmlStr, `<cbc:EndDate>2005-06-30</cbc:EndDate>`)
+	assert.Contains(t, xmlStr, `<cbc:Description>Order response required</cbc:Description>`)
+	assert.Contains(t, xmlStr, `<cbc:ID>188c398a-1ea5-4e41-9e53-fe2bf0e9b5a2</cbc:ID>`, "lines without pol_id are identified by their uuid")
+	assert.Contains(t, xmlStr, `<cbc:LineStatusCode>NoStatus</cbc:LineStatusCode>`)
+	assert.Contains(t, xmlStr, `<cbc:PartialDeliveryIndicator>true</cbc:PartialDeliveryIndicator>`)
+	assert.NotContains(t, xmlStr, `<cbc:MinimumQuantity>`)
+
+	// cbc/cac elements must follow the sequence of the Order schema
+	order := []string{"<cbc:ID>34", "<cbc:SalesOrderID>", "<cbc:IssueDate>", "<cbc:Note>", "<cbc:DocumentCurrencyCode>", "<cac:ValidityPeriod>", "<cac:BuyerCustomerParty>", "<cac:SellerSupplierParty>", "<cac:PaymentTerms>", "<cac:TransactionConditions>", "<cac:DestinationCountry>", "<cac:TaxTotal>", "<cac:AnticipatedMonetaryTotal>", "<cac:OrderLine>", "<cac:LineItem>", "<cbc:Quantity ", "<cac:Price>", "<cac:Item>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr, elem)
+		assert.Greater(t, i, last, "element %s out of order", elem)
+		last = i
+	}
+}
+
+func TestImportOrder(t *testing.T) {
+	ord, err := OrderFromSource(testOrderSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := Order{}
+	err = Unmarshal(out, NamespaceOrder, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties: map[string]uint32{"7300010000001DK12345678": 7},
+		items:   map[string]uint32{"Labtop computer": 5},
+	}
+	orderImport, err := ImportOrder(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := orderImport.Order
+	assert.Equal(t, "34", in.PohId)
+	assert.Equal(t, "CON0095678", in.SalesOrderId)
+	assert.Equal(t, "06/20/2005", in.IssueDate)
+	assert.Equal(t, "06/30/2005", in.ValidityPeriod)
+	assert.Equal(t, "06/20/2005", in.TaxExDate, "missing dates default to the issue date")
+	assert.Equal(t, uint32(7), in.SellerSupplierPartyId)
+	assert.Equal(t, uint32(100), in.BuyerCustomerPartyId)
+	assert.Equal(t, "Order response required", in.TransactionConditions)
+	assert.Equal(t, "DK", in.DestinationCountry)
+	assert.Equal(t, "1591.25", in.PayableAmount)
+	assert.Equal(t, 1, len(in.PurchaseOrderLines))
+	line := in.PurchaseOrderLines[0]
+	assert.Equal(t, "188c398a-1ea5-4e41-9e53-fe2bf0e9b5a2", line.PolId)
+	assert.Equal(t, LineStatusNoStatus, line.LineStatusCode)
+	assert.Equal(t, float64(1), line.Quantity)
+	assert.True(t, line.PartialDeliveryIndicator)
+	assert.Equal(t, uint32(5), line.ItemId)
+	assert.Equal(t, 1, len(orderImport.PaymentTerms))
+}
+
+func TestImportOrderFieldErrors(t *testing.T) {
+	doc := `<Order xmlns="urn:oasis:names:specification:ubl:schema:xsd:Order-2" ` + testOrderNamespaces + `>
+  <cbc:ID>PO-2</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cac:OrderDocumentReference><cbc:ID>PO-404</cbc:ID></cac:OrderDocumentReference>
+  <cac:SellerSupplierParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:SellerSupplierParty>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:Quantity unitCode="C62">one</cbc:Quantity><cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item></cac:LineItem></cac:OrderLine>
+</Order>`
+	ord := Order{}
+	err := Unmarshal([]byte(doc), NamespaceOrder, &ord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{items: map[string]uint32{"Labtop computer": 5}}
+	_, err = ImportOrder(context.Background(), &ord, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportOrder() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/Order/cac:OrderDocumentReference[1]/cbc:ID",
+		"/Order/cac:BuyerCustomerParty/cac:Party",
+		"/Order/cac:OrderLine[1]/cac:LineItem/cbc:Quantity",
+	}, paths)
+	assert.Empty(t, resolver.created, "no party is created for a document with errors")
+}
+
+func TestImportOrderResponse(t *testing.T) {
+	doc := `<OrderResponse xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderResponse-2" ` + testOrderNamespaces + `>
+  <cbc:ID>OR-1</cbc:ID>
+  <cbc:SalesOrderID>SO-9</cbc:SalesOrderID>
+  <cbc:IssueDate>2005-06-21</cbc:IssueDate>
+  <cbc:OrderResponseCode>CA</cbc:OrderResponseCode>
+  <cac:OrderReference><cbc:ID>34</cbc:ID></cac:OrderReference>
+  <cac:OrderLine>
+    <cac:LineItem><cbc:ID>2</cbc:ID><cbc:LineStatusCode>7</cbc:LineStatusCode><cac:Item/></cac:LineItem>
+  </cac:OrderLine>
+  <cac:OrderLine>
+    <cac:LineItem><cbc:ID>S1</cbc:ID><cbc:SalesOrderID>SO-9-1</cbc:SalesOrderID><cbc:LineStatusCode>3</cbc:LineStatusCode><cac:Item/></cac:LineItem>
+    <cac:OrderLineReference><cbc:LineID>1</cbc:LineID></cac:OrderLineReference>
+  </cac:OrderLine>
+</OrderResponse>`
+	or := OrderResponse{}
+	err := Unmarshal([]byte(doc), NamespaceOrderResponse, &or)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{references: map[string]uint32{
+		ReferenceOrder + ":34":    1,
+		ReferenceOrderLine + ":1": 11,
+		ReferenceOrderLine + ":2": 12,
+	}}
+	orderResponseImport, err := ImportOrderResponse(context.Background(), &or, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(1), orderResponseImport.PurchaseOrderHeaderID)
+	assert.Equal(t, OrderResponseConditionallyAccepted, orderResponseImport.OrderResponseCode)
+	assert.Equal(t, "SO-9", orderResponseImport.SalesOrderID)
+	assert.Equal(t, []*OrderLineStatus{
+		{PurchaseOrderLineID: 12, LineStatusCode: LineStatusRejected},
+		{PurchaseOrderLineID: 11, LineStatusCode: LineStatusChanged, SalesOrderID: "SO-9-1"},
+	}, orderResponseImport.Lines)
+	assert.Equal(t, LineStatusAccepted, OrderResponseLineStatus(orderResponseImport.OrderResponseCode), "unlisted lines of a conditional acceptance are accepted")
+	assert.Equal(t, "", OrderResponseLineStatus(OrderResponseAcknowledged))
+}
+
+func TestImportOrderResponseFieldErrors(t *testing.T) {
+	doc := `<OrderResponse xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderResponse-2" ` + testOrderNamespaces + `>
+  <cbc:ID>OR-2</cbc:ID>
+  <cbc:IssueDate>2005-06-21</cbc:IssueDate>
+  <cbc:OrderResponseCode>AP</cbc:OrderResponseCode>
+  <cac:OrderReference><cbc:ID>34</cbc:ID></cac:OrderReference>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:LineStatusCode>7</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:LineStatusCode>9</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>404</cbc:ID><cac:Item/></cac:LineItem></cac:OrderLine>
+</OrderResponse>`
+	or := OrderResponse{}
+	err := Unmarshal([]byte(doc), NamespaceOrderResponse, &or)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{references: map[string]uint32{ReferenceOrder + ":34": 1, ReferenceOrderLine + ":1": 11}}
+	_, err = ImportOrderResponse(context.Background(), &or, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportOrderResponse() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/OrderResponse/cac:OrderLine[1]/cac:LineItem/cbc:LineStatusCode",
+		"/OrderResponse/cac:OrderLine[2]",
+		"/OrderResponse/cac:OrderLine[2]/cac:LineItem/cbc:LineStatusCode",
+		"/OrderResponse/cac:OrderLine[3]/cac:LineItem/cbc:ID",
+	}, paths)
+}
+
+func TestImportOrderChange(t *testing.T) {
+	doc := `<OrderChange xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderChange-2" ` + testOrderNamespaces + `>
+  <cbc:ID>OC-1</cbc:ID>
+  <cbc:IssueDate>2005-06-22</cbc:IssueDate>
+  <cbc:SequenceNumberID>2</cbc:SequenceNumberID>
+  <cbc:Note>Second laptop</cbc:Note>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cbc:LineCountNumeric>2</cbc:LineCountNumeric>
+  <cac:OrderReference><cbc:ID>34</cbc:ID></cac:OrderReference>
+  <cac:BuyerCustomerParty><cac:Party><cbc:EndpointID>7300010000002</cbc:EndpointID></cac:Party></cac:BuyerCustomerParty>
+  <cac:SellerSupplierParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:SellerSupplierParty>
+  <cac:AnticipatedMonetaryTotal><cbc:LineExtensionAmount currencyID="EUR">2546.00</cbc:LineExtensionAmount><cbc:PayableAmount currencyID="EUR">3182.50</cbc:PayableAmount></cac:AnticipatedMonetaryTotal>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:LineStatusCode>NoStatus</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>2</cbc:ID><cbc:LineStatusCode>Revised</cbc:LineStatusCode><cbc:Quantity unitCode="C62">2</cbc:Quantity><cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>3</cbc:ID><cbc:LineStatusCode>2</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>4</cbc:ID><cbc:LineStatusCode>Added</cbc:LineStatusCode><cbc:Quantity unitCode="C62">1</cbc:Quantity><cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item></cac:LineItem></cac:OrderLine>
+</OrderChange>`
+	oc := OrderChange{}
+	err := Unmarshal([]byte(doc), NamespaceOrderChange, &oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{
+		references: map[string]uint32{ReferenceOrder + ":34": 1, ReferenceOrderLine + ":2": 12, ReferenceOrderLine + ":3": 13},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	orderChangeImport, err := ImportOrderChange(context.Background(), &oc, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(1), orderChangeImport.PurchaseOrderHeaderID)
+	assert.Equal(t, uint32(2), orderChangeImport.SequenceNumberID)
+	assert.Equal(t, "Second laptop", orderChangeImport.PurchaseOrderHeader.Note)
+	assert.Equal(t, uint32(2), orderChangeImport.PurchaseOrderHeader.LineCountNumeric)
+	assert.Equal(t, "3182.50", orderChangeImport.PurchaseOrderHeader.PayableAmount)
+	assert.Equal(t, 3, len(orderChangeImport.Lines), "lines without a status are unchanged")
+
+	revised := orderChangeImport.Lines[0]
+	assert.Equal(t, uint32(12), revised.PurchaseOrderLineID)
+	assert.Equal(t, LineStatusRevised, revised.LineStatusCode)
+	assert.Equal(t, float64(2), revised.PurchaseOrderLine.Quantity)
+	assert.Equal(t, uint32(5), revised.PurchaseOrderLine.ItemId)
+
+	cancelled := orderChangeImport.Lines[1]
+	assert.Equal(t, uint32(13), cancelled.PurchaseOrderLineID)
+	assert.Equal(t, LineStatusCancelled, cancelled.LineStatusCode)
+	assert.Nil(t, cancelled.PurchaseOrderLine)
+
+	added := orderChangeImport.Lines[2]
+	assert.Equal(t, uint32(0), added.PurchaseOrderLineID)
+	assert.Equal(t, "4", added.PurchaseOrderLine.PolId)
+	assert.Equal(t, LineStatusAdded, added.PurchaseOrderLine.LineStatusCode)
+	assert.Equal(t, "06/22/2005", added.PurchaseOrderLine.PriceValidityPeriodStartDate)
+}
+
+func TestImportOrderChangeFieldErrors(t *testing.T) {
+	doc := `<OrderChange xmlns="urn:oasis:names:specification:ubl:schema:xsd:OrderChange-2" ` + testOrderNamespaces + `>
+  <cbc:ID>OC-2</cbc:ID>
+  <cbc:IssueDate>2005-06-22</cbc:IssueDate>
+  <cac:OrderReference><cbc:ID>34</cbc:ID></cac:OrderReference>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:LineStatusCode>Added</cbc:LineStatusCode><cac:Item><cbc:Name>Labtop computer</cbc:Name></cac:Item></cac:LineItem></cac:OrderLine>
+  <cac:OrderLine><cac:LineItem><cbc:ID>1</cbc:ID><cbc:LineStatusCode>Disputed</cbc:LineStatusCode><cac:Item/></cac:LineItem></cac:OrderLine>
+</OrderChange>`
+	oc := OrderChange{}
+	err := Unmarshal([]byte(doc), NamespaceOrderChange, &oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{
+		references: map[string]uint32{ReferenceOrder + ":34": 1, ReferenceOrderLine + ":1": 11},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	_, err = ImportOrderChange(context.Background(), &oc, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportOrderChange() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/OrderChange/cbc:SequenceNumberID",
+		"/OrderChange/cac:OrderLine[1]/cac:LineItem/cbc:ID",
+		"/OrderChange/cac:OrderLine[2]/cac:LineItem/cbc:LineStatusCode",
+	}, paths)
+}
@@ -0,0 +1,108 @@
+package ubl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Profiles of the UBL output, selected by the profile parameter of the UBL
+// export endpoints
+const (
+	// ProfileUBL - plain UBL 2.3
+	ProfileUBL = "ubl"
+	// ProfilePeppolBIS3 - PEPPOL BIS Billing 3.0, for Invoice and CreditNote
+	ProfilePeppolBIS3 = "peppol-bis-3"
+)
+
+// PEPPOL BIS Billing 3.0 specification and business process identifiers
+const (
+	PeppolCustomizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	PeppolProfileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+)
+
+// ParseProfile - the profile named s, ProfileUBL when s is empty
+func ParseProfile(s string) (string, error) {
+	switch p := strings.ToLower(strings.TrimSpace(s)); p {
+	case "":
+		return ProfileUBL, nil
+	case ProfileUBL, ProfilePeppolBIS3:
+		return p, nil
+	}
+	return "", fmt.Errorf("ubl: unknown profile %q, want %q or %q", s, ProfileUBL, ProfilePeppolBIS3)
+}
+
+// easCodes - the Electronic Address Scheme code list used by PEPPOL for
+// the schemeID of cbc:EndpointID
+var easCodes = map[string]bool{}
+
+func init() {
+	for _, code := range strings.Fields(`0002 0007 0009 0037 0060 0088 0096 0097 0106 0130 0135 0142 0147 0151
+		0170 0183 0184 0188 0190 0191 0192 0193 0194 0195 0196 0198 0199 0200 0201 0202 0203 0204 0205 0208
+		0209 0210 0211 0212 0213 0215 0216 0217 0218 0219 0220 0221 0225 0230 0235 0240
+		9901 9910 9913 9914 9915 9918 9919 9920 9922 9923 9924 9925 9926 9927 9928 9929 9930 9931 9932 9933
+		9934 9935 9936 9937 9938 9939 9940 9941 9942 9943 9944 9945 9946 9947 9948 9949 9950 9951 9952 9953
+		9957 9959 AN AQ AS AU EM`) {
+		easCodes[code] = true
+	}
+}
+
+// IsEASCode - whether code is in the PEPPOL Electronic Address Scheme code
+// list
+func IsEASCode(code string) bool {
+	return easCodes[code]
+}
+
+// peppolEndpointID - cbc:EndpointID of a party under PEPPOL,
+// party_endpoint_id when set, otherwise the first party identification
+// whose scheme is an electronic address scheme
+func peppolEndpointID(src *PartySource) *Identifier {
+	if src == nil || src.Party == nil || src.Party.PartyD == nil {
+		return nil
+	}
+	if pd := src.Party.PartyD; pd.PartyEndpointId != "" {
+		return &Identifier{Value: pd.PartyEndpointId, SchemeID: pd.PartyEndpointSchemeId}
+	}
+	for _, pi := range src.Party.PartyIdentifications {
+		if pi.PartyIdentification != "" && IsEASCode(pi.PartyIdentificationSchemeId) {
+			return &Identifier{Value: pi.PartyIdentification, SchemeID: pi.PartyIdentificationSchemeId}
+		}
+	}
+	return nil
+}
+
+// peppolParty - set the PEPPOL endpoint of the party partyID on p
+func (src *DocumentSource) peppolParty(p *Party, partyID uint32) {
+	if p == nil {
+		return
+	}
+	p.EndpointID = peppolEndpointID(src.Parties[partyID])
+}
+
+// peppolInvoice - apply PEPPOL BIS Billing 3.0 to an Invoice. The UBL
+// version and the UUIDs are left out as PEPPOL warns about them.
+func (src *InvoiceSource) peppolInvoice(inv *Invoice) {
+	hd := src.InvoiceHeader.InvoiceHeaderD
+	inv.UBLVersionID = ""
+	inv.CustomizationID = PeppolCustomizationID
+	inv.ProfileID = PeppolProfileID
+	inv.UUID = ""
+	src.peppolParty(inv.AccountingSupplierParty.Party, hd.AccountingSupplierPartyId)
+	src.peppolParty(inv.AccountingCustomerParty.Party, hd.AccountingCustomerPartyId)
+	for i := range inv.InvoiceLine {
+		inv.InvoiceLine[i].UUID = ""
+	}
+}
+
+// peppolCreditNote - apply PEPPOL BIS Billing 3.0 to a CreditNote
+func (src *CreditNoteSource) peppolCreditNote(cn *CreditNote) {
+	hd := src.CreditNoteHeader.CreditNoteHeaderD
+	cn.UBLVersionID = ""
+	cn.CustomizationID = PeppolCustomizationID
+	cn.ProfileID = PeppolProfileID
+	cn.UUID = ""
+	src.peppolParty(cn.AccountingSupplierParty.Party, hd.AccountingSupplierPartyId)
+	src.peppolParty(cn.AccountingCustomerParty.Party, hd.AccountingCustomerPartyId)
+	for i := range cn.CreditNoteLine {
+		cn.CreditNoteLine[i].UUID = ""
+	}
+}
@@ -0,0 +1,242 @@
+package ubl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+)
+
+// ReceiptAdvice - UBL 2.3 ReceiptAdvice document
+type ReceiptAdvice struct {
+	XMLName xml.Name `xml:"ReceiptAdvice"`
+	Namespaces
+	UBLVersionID              string              `xml:"cbc:UBLVersionID,omitempty"`
+	CustomizationID           string              `xml:"cbc:CustomizationID,omitempty"`
+	ProfileID                 string              `xml:"cbc:ProfileID,omitempty"`
+	ID                        string              `xml:"cbc:ID"`
+	UUID                      string              `xml:"cbc:UUID,omitempty"`
+	IssueDate                 string              `xml:"cbc:IssueDate"`
+	DocumentStatusCode        string              `xml:"cbc:DocumentStatusCode,omitempty"`
+	ReceiptAdviceTypeCode     string              `xml:"cbc:ReceiptAdviceTypeCode,omitempty"`
+	Note                      []string            `xml:"cbc:Note,omitempty"`
+	LineCountNumeric          string              `xml:"cbc:LineCountNumeric,omitempty"`
+	OrderReference            []OrderReference    `xml:"cac:OrderReference,omitempty"`
+	DespatchDocumentReference []DocumentReference `xml:"cac:DespatchDocumentReference,omitempty"`
+	DeliveryCustomerParty     *CustomerParty      `xml:"cac:DeliveryCustomerParty"`
+	DespatchSupplierParty     *SupplierParty      `xml:"cac:DespatchSupplierParty"`
+	BuyerCustomerParty        *CustomerParty      `xml:"cac:BuyerCustomerParty,omitempty"`
+	SellerSupplierParty       *SupplierParty      `xml:"cac:SellerSupplierParty,omitempty"`
+	Shipment                  *Shipment           `xml:"cac:Shipment,omitempty"`
+	ReceiptLine               []ReceiptLine       `xml:"cac:ReceiptLine"`
+}
+
+// ReceiptAdviceSource - everything needed to build a UBL ReceiptAdvice
+type ReceiptAdviceSource struct {
+	ReceiptAdviceHeader *logisticsproto.ReceiptAdviceHeader
+	ReceiptAdviceLines  []*logisticsproto.ReceiptAdviceLine
+	DocumentSource
+}
+
+// ReceiptAdviceFromSource - build a UBL ReceiptAdvice
+func ReceiptAdviceFromSource(src *ReceiptAdviceSource) (*ReceiptAdvice, error) {
+	if src == nil || src.ReceiptAdviceHeader == nil || src.ReceiptAdviceHeader.ReceiptAdviceHeaderD == nil {
+		return nil, errors.New("ubl: receipt advice header is required")
+	}
+	hd := src.ReceiptAdviceHeader.ReceiptAdviceHeaderD
+	ht := src.ReceiptAdviceHeader.ReceiptAdviceHeaderT
+	if ht == nil {
+		ht = &logisticsproto.ReceiptAdviceHeaderT{}
+	}
+
+	ra := ReceiptAdvice{Namespaces: NewNamespaces(NamespaceReceiptAdvice)}
+	ra.UBLVersionID = Version
+	ra.ID = documentID(hd.RcpthId, hd.IdS)
+	ra.UUID = hd.IdS
+	ra.IssueDate = FormatDate(ht.IssueDate)
+	ra.DocumentStatusCode = hd.DocumentStatusCode
+	ra.ReceiptAdviceTypeCode = hd.ReceiptAdviceTypeCode
+	ra.Note = notes(hd.Note)
+	if hd.LineCountNumeric != 0 {
+		ra.LineCountNumeric = strconv.FormatUint(uint64(hd.LineCountNumeric), 10)
+	}
+	if id := src.reference(ReferenceOrder, hd.OrderId); id != "" {
+		ra.OrderReference = []OrderReference{{ID: id}}
+	}
+	if id := src.reference(ReferenceDespatch, hd.DespatchId); id != "" {
+		ra.DespatchDocumentReference = []DocumentReference{{ID: id}}
+	}
+
+	ra.DeliveryCustomerParty = &CustomerParty{Party: src.party(hd.DeliveryCustomerPartyId)}
+	ra.DespatchSupplierParty = &SupplierParty{Party: src.party(hd.DespatchSupplierPartyId)}
+	if p := src.party(hd.BuyerCustomerPartyId); p != nil {
+		ra.BuyerCustomerParty = &CustomerParty{Party: p}
+	}
+	if p := src.party(hd.SellerSupplierPartyId); p != nil {
+		ra.SellerSupplierParty = &SupplierParty{Party: p}
+	}
+	if id := src.reference(ReferenceShipment, hd.ShipmentId); id != "" {
+		ra.Shipment = &Shipment{ID: id}
+	}
+
+	for i, line := range src.ReceiptAdviceLines {
+		ra.ReceiptLine = append(ra.ReceiptLine, src.receiptLine(i, line))
+	}
+	return &ra, nil
+}
+
+// receiptLine - map a ReceiptAdviceLine
+func (src *ReceiptAdviceSource) receiptLine(i int, line *logisticsproto.ReceiptAdviceLine) ReceiptLine {
+	ld := line.ReceiptAdviceLineD
+	lt := line.ReceiptAdviceLineT
+	if lt == nil {
+		lt = &logisticsproto.ReceiptAdviceLineT{}
+	}
+	rl := ReceiptLine{}
+	rl.ID = lineID(documentID(ld.RcptlId, ld.IdS), i)
+	rl.UUID = ld.IdS
+	rl.Note = notes(ld.Note)
+	rl.ReceivedQuantity = &Quantity{Value: strconv.FormatUint(uint64(ld.ReceivedQuantity), 10)}
+	rl.ShortQuantity = optionalWholeQuantity(ld.ShortQuantity)
+	rl.ShortageActionCode = ld.ShortageActionCode
+	rl.RejectedQuantity = optionalWholeQuantity(ld.RejectedQuantity)
+	rl.RejectReasonCode = ld.RejectReasonCode
+	rl.RejectReason = notes(ld.RejectReason)
+	rl.RejectActionCode = ld.RejectActionCode
+	rl.QuantityDiscrepancyCode = ld.QuantityDiscrepancyCode
+	rl.OversupplyQuantity = optionalWholeQuantity(ld.OversupplyQuantity)
+	rl.ReceivedDate = FormatDate(lt.ReceivedDate)
+	rl.TimingComplaintCode = ld.TimingComplaintCode
+	rl.TimingComplaint = ld.TimingComplaint
+	if id := src.reference(ReferenceOrderLine, ld.OrderLineId); id != "" {
+		rl.OrderLineReference = &OrderLineReference{LineID: id}
+	}
+	if id := src.reference(ReferenceDespatchLine, ld.DespatchLineId); id != "" {
+		rl.DespatchLineReference = []LineReference{{LineID: id}}
+	}
+	if it := item(src.Items[ld.ItemId]); it != nil {
+		rl.Item = []Item{*it}
+	}
+	if id := src.reference(ReferenceShipment, ld.ShipmentId); id != "" {
+		rl.Shipment = []Shipment{{ID: id}}
+	}
+	return rl
+}
+
+func optionalWholeQuantity(v uint32) *Quantity {
+	if v == 0 {
+		return nil
+	}
+	return &Quantity{Value: strconv.FormatUint(uint64(v), 10)}
+}
+
+// ImportReceiptAdvice - map a UBL ReceiptAdvice onto a
+// CreateReceiptAdviceHeaderRequest. Elements that cannot be mapped are
+// returned as FieldErrors; parties are only created when there are none.
+func ImportReceiptAdvice(ctx context.Context, ra *ReceiptAdvice, resolver Resolver) (*logisticsproto.CreateReceiptAdviceHeaderRequest, error) {
+	const root = "/ReceiptAdvice"
+	im := importer{ctx: ctx, resolver: resolver}
+	in := logisticsproto.CreateReceiptAdviceHeaderRequest{}
+
+	if strings.TrimSpace(ra.ID) == "" {
+		im.fail(root+"/cbc:ID", "is required")
+	}
+	if ra.IssueDate == "" {
+		im.fail(root+"/cbc:IssueDate", "is required")
+	}
+	in.IssueDate = im.date(root+"/cbc:IssueDate", ra.IssueDate)
+	im.defaultDate = in.IssueDate
+
+	in.RcpthId = strings.TrimSpace(ra.ID)
+	in.DocumentStatusCode = ra.DocumentStatusCode
+	in.ReceiptAdviceTypeCode = ra.ReceiptAdviceTypeCode
+	in.Note = strings.Join(ra.Note, "\n")
+	in.LineCountNumeric = im.numeric(root+"/cbc:LineCountNumeric", ra.LineCountNumeric)
+	in.OrderId = im.orderReference(root+"/cac:OrderReference", ra.OrderReference)
+	for i, ref := range ra.DespatchDocumentReference {
+		path := fmt.Sprintf("%s/cac:DespatchDocumentReference[%d]", root, i+1)
+		if i > 0 {
+			im.fail(path, "only one despatch advice can be referenced")
+			continue
+		}
+		in.DespatchId = im.reference(path+"/cbc:ID", ReferenceDespatch, ref.ID, 0)
+	}
+
+	if ra.DeliveryCustomerParty == nil || ra.DeliveryCustomerParty.Party == nil {
+		im.fail(root+"/cac:DeliveryCustomerParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:DeliveryCustomerParty/cac:Party", ra.DeliveryCustomerParty.Party, &in.DeliveryCustomerPartyId)
+	}
+	if ra.DespatchSupplierParty == nil || ra.DespatchSupplierParty.Party == nil {
+		im.fail(root+"/cac:DespatchSupplierParty/cac:Party", "is required")
+	} else {
+		im.party(root+"/cac:DespatchSupplierParty/cac:Party", ra.DespatchSupplierParty.Party, &in.DespatchSupplierPartyId)
+	}
+	if ra.BuyerCustomerParty != nil {
+		im.party(root+"/cac:BuyerCustomerParty/cac:Party", ra.BuyerCustomerParty.Party, &in.BuyerCustomerPartyId)
+	}
+	if ra.SellerSupplierParty != nil {
+		im.party(root+"/cac:SellerSupplierParty/cac:Party", ra.SellerSupplierParty.Party, &in.SellerSupplierPartyId)
+	}
+	if ra.Shipment != nil {
+		in.ShipmentId = im.reference(root+"/cac:Shipment/cbc:ID", ReferenceShipment, ra.Shipment.ID, 0)
+	}
+
+	if len(ra.ReceiptLine) == 0 {
+		im.fail(root+"/cac:ReceiptLine", "at least one receipt line is required")
+	}
+	for i := range ra.ReceiptLine {
+		path := fmt.Sprintf("%s/cac:ReceiptLine[%d]", root, i+1)
+		in.ReceiptAdviceLines = append(in.ReceiptAdviceLines, im.receiptLine(path, &ra.ReceiptLine[i], in.OrderId, in.DespatchId))
+	}
+
+	if err := im.result(); err != nil {
+		return nil, err
+	}
+	if err := im.createParties(); err != nil {
+		return nil, err
+	}
+	return &in, nil
+}
+
+// receiptLine - map a cac:ReceiptLine onto a CreateReceiptAdviceLineRequest.
+// Quantities are held as whole units.
+func (im *importer) receiptLine(path string, rl *ReceiptLine, orderID uint32, despatchID uint32) *logisticsproto.CreateReceiptAdviceLineRequest {
+	line := logisticsproto.CreateReceiptAdviceLineRequest{}
+	line.RcptlId = strings.TrimSpace(rl.ID)
+	line.Note = strings.Join(rl.Note, "\n")
+	line.ReceivedQuantity = im.wholeQuantity(path+"/cbc:ReceivedQuantity", rl.ReceivedQuantity)
+	line.ShortQuantity = im.wholeQuantity(path+"/cbc:ShortQuantity", rl.ShortQuantity)
+	line.ShortageActionCode = rl.ShortageActionCode
+	line.RejectedQuantity = im.wholeQuantity(path+"/cbc:RejectedQuantity", rl.RejectedQuantity)
+	line.RejectReasonCode = rl.RejectReasonCode
+	line.RejectReason = strings.Join(rl.RejectReason, "\n")
+	line.RejectActionCode = rl.RejectActionCode
+	line.QuantityDiscrepancyCode = rl.QuantityDiscrepancyCode
+	line.OversupplyQuantity = im.wholeQuantity(path+"/cbc:OversupplyQuantity", rl.OversupplyQuantity)
+	line.ReceivedDate = im.date(path+"/cbc:ReceivedDate", rl.ReceivedDate)
+	line.TimingComplaintCode = rl.TimingComplaintCode
+	line.TimingComplaint = rl.TimingComplaint
+	if rl.OrderLineReference != nil {
+		line.OrderLineId = im.orderLineReferences(path+"/cac:OrderLineReference", []OrderLineReference{*rl.OrderLineReference}, orderID)
+	}
+	line.DespatchLineId = im.lineReference(path+"/cac:DespatchLineReference", rl.DespatchLineReference, ReferenceDespatch, ReferenceDespatchLine, despatchID)
+
+	for i := range rl.Item {
+		itemPath := fmt.Sprintf("%s/cac:Item[%d]", path, i+1)
+		if i > 0 {
+			im.fail(itemPath, "only one item can be received per line")
+			continue
+		}
+		line.ItemId = im.item(itemPath, &rl.Item[i])
+		if line.ItemId == 0 && im.err == nil {
+			im.fail(itemPath, "no item matches its identifications or name %q", rl.Item[i].Name)
+		}
+	}
+	line.ShipmentId = im.shipment(path+"/cac:Shipment", rl.Shipment)
+	return &line
+}
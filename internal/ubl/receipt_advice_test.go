@@ -0,0 +1,160 @@
+package ubl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	logisticsproto "github.com/cloudfresco/sc-ubl/internal/protogen/logistics/v1"
+	"github.com/cloudfresco/sc-ubl/internal/ublvalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+func testReceiptAdviceSource() *ReceiptAdviceSource {
+	receiptAdviceHeaderD := logisticsproto.ReceiptAdviceHeaderD{}
+	receiptAdviceHeaderD.Id = 1
+	receiptAdviceHeaderD.IdS = "0a4ae4b3-61a4-4e41-a1bb-0a4b7d0c7b14"
+	receiptAdviceHeaderD.RcpthId = "RA-1"
+	receiptAdviceHeaderD.DocumentStatusCode = "NoStatus"
+	receiptAdviceHeaderD.ReceiptAdviceTypeCode = "receipt"
+	receiptAdviceHeaderD.OrderId = 3
+	receiptAdviceHeaderD.DespatchId = 8
+	receiptAdviceHeaderD.DeliveryCustomerPartyId = 2
+	receiptAdviceHeaderD.DespatchSupplierPartyId = 1
+
+	receiptAdviceLineD := logisticsproto.ReceiptAdviceLineD{}
+	receiptAdviceLineD.Id = 1
+	receiptAdviceLineD.RcptlId = "1"
+	receiptAdviceLineD.ReceivedQuantity = 85
+	receiptAdviceLineD.ShortQuantity = 5
+	receiptAdviceLineD.ShortageActionCode = "backorder"
+	receiptAdviceLineD.RejectedQuantity = 2
+	receiptAdviceLineD.RejectReasonCode = "damaged"
+	receiptAdviceLineD.RejectReason = "Broken screen"
+	receiptAdviceLineD.RejectActionCode = "replace"
+	receiptAdviceLineD.OversupplyQuantity = 1
+	receiptAdviceLineD.OrderLineId = 6
+	receiptAdviceLineD.DespatchLineId = 9
+	receiptAdviceLineD.ItemId = 3
+
+	documentSource := testDocumentSource()
+	documentSource.References[ReferenceDespatch] = map[uint32]string{8: "565899"}
+	documentSource.References[ReferenceOrderLine] = map[uint32]string{6: "1"}
+	documentSource.References[ReferenceDespatchLine] = map[uint32]string{9: "1"}
+
+	return &ReceiptAdviceSource{
+		ReceiptAdviceHeader: &logisticsproto.ReceiptAdviceHeader{ReceiptAdviceHeaderD: &receiptAdviceHeaderD, ReceiptAdviceHeaderT: &logisticsproto.ReceiptAdviceHeaderT{IssueDate: testDate("2005-06-22")}},
+		ReceiptAdviceLines:  []*logisticsproto.ReceiptAdviceLine{{ReceiptAdviceLineD: &receiptAdviceLineD, ReceiptAdviceLineT: &logisticsproto.ReceiptAdviceLineT{ReceivedDate: testDate("2005-06-21")}}},
+		DocumentSource:      documentSource,
+	}
+}
+
+func TestReceiptAdviceFromSource(t *testing.T) {
+	ra, err := ReceiptAdviceFromSource(testReceiptAdviceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, ublvalidate.Validate(out), "document conforms to the UBL 2.3 schema")
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<ReceiptAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:ReceiptAdvice-2"`)
+	assert.Contains(t, xmlStr, `<cbc:ReceivedQuantity>85</cbc:ReceivedQuantity>`)
+	assert.Contains(t, xmlStr, `<cbc:RejectReason>Broken screen</cbc:RejectReason>`)
+	assert.Contains(t, xmlStr, "<cac:DespatchDocumentReference>\n    <cbc:ID>565899</cbc:ID>\n  </cac:DespatchDocumentReference>")
+	assert.Contains(t, xmlStr, `<cbc:ReceivedDate>2005-06-21</cbc:ReceivedDate>`)
+
+	// cbc/cac elements must follow the sequence of the ReceiptAdvice schema
+	order := []string{"<cbc:ID>RA-1", "<cbc:IssueDate>", "<cbc:DocumentStatusCode>", "<cbc:ReceiptAdviceTypeCode>", "<cac:OrderReference>", "<cac:DespatchDocumentReference>", "<cac:DeliveryCustomerParty>", "<cac:DespatchSupplierParty>", "<cac:ReceiptLine>", "<cbc:ReceivedQuantity>", "<cbc:ShortQuantity>", "<cbc:ShortageActionCode>", "<cbc:RejectedQuantity>", "<cbc:RejectReasonCode>", "<cbc:RejectReason>", "<cbc:RejectActionCode>", "<cbc:OversupplyQuantity>", "<cbc:ReceivedDate>", "<cac:OrderLineReference>", "<cac:DespatchLineReference>", "<cac:Item>"}
+	last := -1
+	for _, elem := range order {
+		i := strings.Index(xmlStr, elem)
+		assert.Greater(t, i, last, "element %s out of order", elem)
+		last = i
+	}
+}
+
+func TestImportReceiptAdvice(t *testing.T) {
+	ra, err := ReceiptAdviceFromSource(testReceiptAdviceSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(ra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := ReceiptAdvice{}
+	err = Unmarshal(out, NamespaceReceiptAdvice, &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := testResolver{
+		parties:    map[string]uint32{"7300010000001DK12345678": 7},
+		references: map[string]uint32{ReferenceOrder + ":123": 3, ReferenceDespatch + ":565899": 8, ReferenceOrderLine + ":1": 6, ReferenceDespatchLine + ":1": 9},
+		items:      map[string]uint32{"Labtop computer": 5},
+	}
+	in, err := ImportReceiptAdvice(context.Background(), &parsed, &resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "RA-1", in.RcpthId)
+	assert.Equal(t, "06/22/2005", in.IssueDate)
+	assert.Equal(t, "NoStatus", in.DocumentStatusCode)
+	assert.Equal(t, uint32(3), in.OrderId)
+	assert.Equal(t, uint32(8), in.DespatchId)
+	assert.Equal(t, uint32(100), in.DeliveryCustomerPartyId)
+	assert.Equal(t, uint32(7), in.DespatchSupplierPartyId)
+	assert.Equal(t, 1, len(in.ReceiptAdviceLines))
+	line := in.ReceiptAdviceLines[0]
+	assert.Equal(t, uint32(85), line.ReceivedQuantity)
+	assert.Equal(t, uint32(5), line.ShortQuantity)
+	assert.Equal(t, "backorder", line.ShortageActionCode)
+	assert.Equal(t, uint32(2), line.RejectedQuantity)
+	assert.Equal(t, "damaged", line.RejectReasonCode)
+	assert.Equal(t, "Broken screen", line.RejectReason)
+	assert.Equal(t, uint32(1), line.OversupplyQuantity)
+	assert.Equal(t, "06/21/2005", line.ReceivedDate)
+	assert.Equal(t, uint32(6), line.OrderLineId)
+	assert.Equal(t, uint32(9), line.DespatchLineId)
+	assert.Equal(t, uint32(5), line.ItemId)
+}
+
+func TestImportReceiptAdviceFieldErrors(t *testing.T) {
+	doc := `<ReceiptAdvice xmlns="urn:oasis:names:specification:ubl:schema:xsd:ReceiptAdvice-2" ` + testOrderNamespaces + `>
+  <cbc:ID>RA-2</cbc:ID>
+  <cbc:IssueDate>2024-01-31</cbc:IssueDate>
+  <cac:DespatchDocumentReference><cbc:ID>DA-404</cbc:ID></cac:DespatchDocumentReference>
+  <cac:DeliveryCustomerParty><cac:Party><cbc:EndpointID>7300010000001</cbc:EndpointID></cac:Party></cac:DeliveryCustomerParty>
+  <cac:DespatchSupplierParty><cac:Party><cbc:EndpointID>7300010000002</cbc:EndpointID></cac:Party></cac:DespatchSupplierParty>
+  <cac:ReceiptLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:ReceivedQuantity>2.5</cbc:ReceivedQuantity>
+    <cbc:ReceivedDate>2024-02-30</cbc:ReceivedDate>
+  </cac:ReceiptLine>
+</ReceiptAdvice>`
+	ra := ReceiptAdvice{}
+	err := Unmarshal([]byte(doc), NamespaceReceiptAdvice, &ra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := testResolver{}
+	_, err = ImportReceiptAdvice(context.Background(), &ra, &resolver)
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("ImportReceiptAdvice() error = %v, want FieldErrors", err)
+	}
+	paths := []string{}
+	for _, fe := range fieldErrors {
+		paths = append(paths, fe.Path)
+	}
+	assert.Equal(t, []string{
+		"/ReceiptAdvice/cac:DespatchDocumentReference[1]/cbc:ID",
+		"/ReceiptAdvice/cac:ReceiptLine[1]/cbc:ReceivedQuantity",
+		"/ReceiptAdvice/cac:ReceiptLine[1]/cbc:ReceivedDate",
+	}, paths)
+	assert.Empty(t, resolver.created, "no party is created for a document with errors")
+}
@@ -0,0 +1,870 @@
+package ubl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	itemproto "github.com/cloudfresco/sc-ubl/internal/protogen/item/v1"
+	partyproto "github.com/cloudfresco/sc-ubl/internal/protogen/party/v1"
+	paymentproto "github.com/cloudfresco/sc-ubl/internal/protogen/payment/v1"
+	taxproto "github.com/cloudfresco/sc-ubl/internal/protogen/tax/v1"
+	commonstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/common/v1"
+	itemstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/item/v1"
+	partystruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/party/v1"
+	paymentstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/payment/v1"
+	taxstruct "github.com/cloudfresco/sc-ubl/internal/servicestructs/tax/v1"
+)
+
+// PartySource - Party with the address and tax scheme it references
+type PartySource struct {
+	Party     *partyproto.Party
+	Address   *commonproto.Address
+	TaxScheme *taxproto.TaxSchemeD
+}
+
+// ItemSource - Item with its classified tax category. TaxCategories are all
+// the tax categories of the item, TaxCategory first and then those of
+// item_tax_categories.
+type ItemSource struct {
+	Item          *itemproto.ItemD
+	TaxCategory   *taxproto.TaxCategoryD
+	TaxScheme     *taxproto.TaxSchemeD
+	TaxCategories []*taxproto.TaxCategoryD
+	TaxSchemes    map[uint32]*taxproto.TaxSchemeD // of TaxCategories, by id
+}
+
+// TaxTotalSource - TaxTotal with its sub totals
+type TaxTotalSource struct {
+	TaxTotal     *taxproto.TaxTotalD
+	TaxSubTotals []*TaxSubTotalSource
+}
+
+// TaxSubTotalSource - TaxSubTotal with its tax category
+type TaxSubTotalSource struct {
+	TaxSubTotal *taxproto.TaxSubTotalD
+	TaxCategory *taxproto.TaxCategoryD
+	TaxScheme   *taxproto.TaxSchemeD
+}
+
+// AllowanceChargeSource - AllowanceCharge with its tax category
+type AllowanceChargeSource struct {
+	AllowanceCharge *commonproto.AllowanceChargeD
+	TaxCategory     *taxproto.TaxCategoryD
+	TaxScheme       *taxproto.TaxSchemeD
+}
+
+// DocumentSource - the parties, items, taxes, allowances and charges,
+// payment terms and references of a document, shared by every document type
+type DocumentSource struct {
+	// Parties keyed by party id
+	Parties map[uint32]*PartySource
+	// Items keyed by item id
+	Items map[uint32]*ItemSource
+	// TaxTotals of the document header
+	TaxTotals []*TaxTotalSource
+	// LineTaxTotals keyed by line id
+	LineTaxTotals map[uint32][]*TaxTotalSource
+	// AllowanceCharges of the document header
+	AllowanceCharges []*AllowanceChargeSource
+	// LineAllowanceCharges keyed by line id
+	LineAllowanceCharges map[uint32][]*AllowanceChargeSource
+	PaymentTerms         []*paymentproto.PaymentTerm
+	// References holds the business identifiers of referenced documents,
+	// keyed by Reference* kind and then by id
+	References map[string]map[uint32]string
+	// Profile of the output, one of the Profile* values, plain UBL when
+	// empty
+	Profile string
+}
+
+// DocumentKeys - ids of the rows a DocumentSource is loaded from
+type DocumentKeys struct {
+	HeaderMasterFlag string
+	HeaderID         uint32
+	LineMasterFlag   string
+	LineIDs          []uint32
+	PartyIDs         []uint32
+	ItemIDs          []uint32
+	// References - ids of referenced documents and lines by Reference* kind
+	References map[string][]uint32
+}
+
+// GetDocumentSource - Get the DocumentSource of a document
+func GetDocumentSource(ctx context.Context, dbService *common.DBService, userEmail string, requestID string, keys *DocumentKeys) (*DocumentSource, error) {
+	var err error
+	src := DocumentSource{}
+	src.Parties, err = GetParties(ctx, dbService, userEmail, requestID, keys.PartyIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	src.Items, err = GetItems(ctx, dbService, keys.ItemIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	src.TaxTotals, err = GetTaxTotals(ctx, dbService, keys.HeaderMasterFlag, keys.HeaderID)
+	if err != nil {
+		return nil, err
+	}
+	src.LineTaxTotals = make(map[uint32][]*TaxTotalSource)
+	for _, lineID := range keys.LineIDs {
+		src.LineTaxTotals[lineID], err = GetTaxTotals(ctx, dbService, keys.LineMasterFlag, lineID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	src.AllowanceCharges, err = getAllowanceChargeSources(ctx, dbService, keys.HeaderMasterFlag, keys.HeaderID)
+	if err != nil {
+		return nil, err
+	}
+	src.LineAllowanceCharges = make(map[uint32][]*AllowanceChargeSource)
+	for _, lineID := range keys.LineIDs {
+		src.LineAllowanceCharges[lineID], err = getAllowanceChargeSources(ctx, dbService, keys.LineMasterFlag, lineID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	src.PaymentTerms, err = GetPaymentTerms(ctx, dbService, keys.HeaderMasterFlag, keys.HeaderID)
+	if err != nil {
+		return nil, err
+	}
+
+	src.References = make(map[string]map[uint32]string)
+	for kind, ids := range keys.References {
+		src.References[kind] = make(map[uint32]string)
+		for _, id := range ids {
+			referenceID, err := GetReferenceID(ctx, dbService, kind, id)
+			if err != nil {
+				return nil, err
+			}
+			if referenceID != "" {
+				src.References[kind][id] = referenceID
+			}
+		}
+	}
+	return &src, nil
+}
+
+func (src *DocumentSource) party(partyID uint32) *Party {
+	if partyID == 0 {
+		return nil
+	}
+	return party(src.Parties[partyID])
+}
+
+func (src *DocumentSource) reference(kind string, id uint32) string {
+	if id == 0 {
+		return ""
+	}
+	return src.References[kind][id]
+}
+
+// Kinds of documents that can be referenced by GetReferenceID
+const (
+	ReferenceOrder        = "order"
+	ReferenceOrderLine    = "order_line"
+	ReferenceDespatch     = "despatch"
+	ReferenceDespatchLine = "despatch_line"
+	ReferenceReceipt      = "receipt"
+	ReferenceReceiptLine  = "receipt_line"
+	ReferenceInvoice      = "invoice"
+	ReferenceShipment     = "shipment"
+)
+
+var selectReferenceIDSQL = map[string]string{
+	ReferenceOrder:        `select poh_id, uuid4 from purchase_order_headers where id = ?;`,
+	ReferenceOrderLine:    `select pol_id, uuid4 from purchase_order_lines where id = ?;`,
+	ReferenceDespatch:     `select desph_id, uuid4 from despatch_headers where id = ?;`,
+	ReferenceDespatchLine: `select despl_id, uuid4 from despatch_lines where id = ?;`,
+	ReferenceReceipt:      `select rcpth_id, uuid4 from receipt_advice_headers where id = ?;`,
+	ReferenceReceiptLine:  `select rcptl_id, uuid4 from receipt_advice_lines where id = ?;`,
+	ReferenceInvoice:      `select ih_id, uuid4 from invoice_headers where id = ?;`,
+	ReferenceShipment:     `select sh_id, uuid4 from shipments where id = ?;`,
+}
+
+var selectReferenceByIDSQL = map[string]string{
+	ReferenceOrder:        `select id from purchase_order_headers where poh_id = ? and status_code = ? order by id limit 1;`,
+	ReferenceOrderLine:    `select id from purchase_order_lines where pol_id = ? and status_code = ? and (? = 0 or purchase_order_header_id = ?) order by id limit 1;`,
+	ReferenceDespatch:     `select id from despatch_headers where desph_id = ? and status_code = ? order by id limit 1;`,
+	ReferenceDespatchLine: `select id from despatch_lines where despl_id = ? and status_code = ? and (? = 0 or despatch_header_id = ?) order by id limit 1;`,
+	ReferenceReceipt:      `select id from receipt_advice_headers where rcpth_id = ? and status_code = ? order by id limit 1;`,
+	ReferenceReceiptLine:  `select id from receipt_advice_lines where rcptl_id = ? and status_code = ? and (? = 0 or receipt_advice_header_id = ?) order by id limit 1;`,
+	ReferenceInvoice:      `select id from invoice_headers where ih_id = ? and status_code = ? order by id limit 1;`,
+	ReferenceShipment:     `select id from shipments where sh_id = ? and status_code = ? order by id limit 1;`,
+}
+
+var selectReferenceByUUIDSQL = map[string]string{
+	ReferenceOrder:        `select id from purchase_order_headers where uuid4 = ? and status_code = ?;`,
+	ReferenceOrderLine:    `select id from purchase_order_lines where uuid4 = ? and status_code = ?;`,
+	ReferenceDespatch:     `select id from despatch_headers where uuid4 = ? and status_code = ?;`,
+	ReferenceDespatchLine: `select id from despatch_lines where uuid4 = ? and status_code = ?;`,
+	ReferenceReceipt:      `select id from receipt_advice_headers where uuid4 = ? and status_code = ?;`,
+	ReferenceReceiptLine:  `select id from receipt_advice_lines where uuid4 = ? and status_code = ?;`,
+	ReferenceInvoice:      `select id from invoice_headers where uuid4 = ? and status_code = ?;`,
+	ReferenceShipment:     `select id from shipments where uuid4 = ? and status_code = ?;`,
+}
+
+const selectPartyByEndpointSQL = `select id from parties where party_endpoint_id = ? and (? = '' or party_endpoint_scheme_id = ?) and status_code = ? order by id limit 1;`
+
+const selectPartyByTaxReferenceSQL = `select id from parties where tax_reference1 = ? and status_code = ? order by id limit 1;`
+
+const selectPartyByRegistrationNameSQL = `select id from parties where registration_name = ? and status_code = ? order by id limit 1;`
+
+const selectItemByIdentificationSQL = `select id from items where
+  ((sellers_item_identification_id = ? and ? != '') or
+  (buyers_item_identification_id = ? and ? != '') or
+  (standard_item_identification_id = ? and ? != '')) and status_code = ? order by id limit 1;`
+
+const selectItemByNameSQL = `select id from items where item_name = ? and status_code = ? order by id limit 1;`
+
+const selectItemTaxCategoryIDsSQL = `select tax_category_id from item_tax_categories where item_id = ? order by id;`
+
+const selectTaxCategoryByCodeSQL = `select id from tax_categories where tc_id = ? and percent = ? and status_code = ? order by id limit 1;`
+
+const selectPartySQL = `select
+      id,
+      uuid4,
+      party_endpoint_id,
+      party_endpoint_scheme_id,
+      party_name,
+      party_desc,
+      party_type,
+      level_p,
+      parent_id,
+      num_chd,
+      leaf,
+      tax_reference1,
+      tax_reference2,
+      public_key,
+      address_id,
+      registration_name,
+      company_id,
+      company_legal_form_code,
+      company_legal_form,
+      sole_proprietorship_indicator,
+      company_liquidation_status_code,
+      corporate_stock_amount,
+      fully_paid_shares_indicator,
+      corporate_registration_id,
+      corporate_registration_name,
+      corporate_registration_type_code,
+      tax_level_code,
+      exemption_reason_code,
+      exemption_reason,
+      tax_scheme_id,
+      registration_date,
+      registration_expiration_date,
+      status_code,
+      created_by_user_id,
+      updated_by_user_id,
+      created_at,
+      updated_at from parties where id = ? and status_code = ?;`
+
+const selectPartyIdentificationsSQL = `select
+      party_identification,
+      party_identification_scheme_id,
+      party_identification_scheme_name from party_identifications where party_id = ?;`
+
+const selectItemSQL = `select
+  id,
+  uuid4,
+  description,
+  pack_quantity,
+  pack_size_numeric,
+  catalogue_indicator,
+  item_name,
+  hazardous_risk_indicator,
+  additional_information,
+  keyword,
+  brand_name,
+  model_name,
+  buyers_item_identification_id,
+  sellers_item_identification_id,
+  manufacturers_item_identification_id,
+  standard_item_identification_id,
+  catalogue_item_identification_id,
+  additional_item_identification_id,
+  origin_country_id_code,
+  origin_country_name,
+  manufacturer_party_id,
+  information_content_provider_party_id,
+  tax_category_id,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from items where id = ? and status_code = ?;`
+
+const selectTaxCategorySQL = `select
+  id,
+  uuid4,
+  tc_id,
+  tax_category_name,
+  percent,
+  base_unit_measure,
+  per_unit_amount,
+  currency_code,
+  tax_exemption_reason_code,
+  coalesce(tax_exemption_reason, '') as tax_exemption_reason,
+  tier_range,
+  tier_rate_percent,
+  tax_scheme_id,
+  calculation_sequence_numeric,
+  withholding_indicator,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from tax_categories where id = ?;`
+
+const selectTaxSchemeSQL = `select
+  id,
+  uuid4,
+  ts_id,
+  tax_scheme_name,
+  tax_type_code,
+  currency_code,
+  rounding_level,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from tax_schemes where id = ?;`
+
+const selectTaxTotalsSQL = `select
+  id,
+  uuid4,
+  tax_amount,
+  rounding_amount,
+  tax_evidence_indicator,
+  tax_included_indicator,
+  master_flag,
+  master_id,
+  tax_category_id,
+  currency_code,
+  withholding_indicator,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from tax_totals where master_flag = ? and master_id = ? and status_code = ? order by id;`
+
+const selectTaxSubTotalsSQL = `select
+  id,
+  uuid4,
+  taxable_amount,
+  tax_amount,
+  calculation_sequence_numeric,
+  transaction_currency_tax_amount,
+  percent,
+  base_unit_measure,
+  per_unit_amount,
+  currency_code,
+  transaction_currency_code,
+  tier_range,
+  tier_rate_percent,
+  tax_category_id,
+  tax_total_id,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from tax_sub_totals where tax_total_id = ? and status_code = ? order by calculation_sequence_numeric, id;`
+
+const selectAllowanceChargesSQL = `select
+  id,
+  uuid4,
+  ac_id,
+  charge_indicator,
+  allowance_charge_reason_code,
+  allowance_charge_reason,
+  multiplier_factor_numeric,
+  prepaid_indicator,
+  sequence_numeric,
+  amount,
+  base_amount,
+  per_unit_amount,
+  currency_code,
+  tax_category_id,
+  tax_total_id,
+  master_flag,
+  master_id,
+  status_code,
+  created_by_user_id,
+  updated_by_user_id,
+  created_at,
+  updated_at from allowance_charges where master_flag = ? and master_id = ? and status_code = ? order by sequence_numeric, id;`
+
+const selectPaymentTermsSQL = `select
+      id,
+      uuid4,
+      pt_id,
+      prepaid_payment_reference_id,
+      note,
+      reference_event_code,
+      settlement_discount_percent,
+      penalty_surcharge_percent,
+      payment_percent,
+      amount,
+      settlement_discount_amount,
+      penalty_amount,
+      currency_code,
+      exchange_rate,
+      master_flag,
+      master_id,
+      payment_due_date,
+      installment_due_date,
+      settlement_period_start_date,
+      settlement_period_end_date,
+      penalty_period_start_date,
+      penalty_period_end_date,
+      validity_period_start_date,
+      validity_period_end_date,
+      status_code,
+      created_by_user_id,
+      updated_by_user_id,
+      created_at,
+      updated_at from payment_terms where master_flag = ? and master_id = ? and status_code = ? order by id;`
+
+// GetParties - Get the parties referenced by a document, zero and unknown
+// ids are skipped
+func GetParties(ctx context.Context, dbService *common.DBService, userEmail string, requestID string, partyIDs ...uint32) (map[uint32]*PartySource, error) {
+	parties := make(map[uint32]*PartySource)
+	for _, partyID := range partyIDs {
+		if partyID == 0 || parties[partyID] != nil {
+			continue
+		}
+		party, err := GetParty(ctx, dbService, partyID, userEmail, requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		parties[partyID] = party
+	}
+	return parties, nil
+}
+
+// GetParty - Get Party with its address, identifications and tax scheme,
+// read in the transaction of ctx when there is one
+func GetParty(ctx context.Context, dbService *common.DBService, partyID uint32, userEmail string, requestID string) (*PartySource, error) {
+	row := dbService.Queryer(ctx).QueryRowxContext(ctx, selectPartySQL, partyID, "active")
+	partyTmp := partystruct.Party{}
+	err := row.StructScan(&partyTmp)
+	if err != nil {
+		return nil, err
+	}
+
+	uuid4Str, err := common.UUIDBytesToStr(partyTmp.PartyD.Uuid4)
+	if err != nil {
+		return nil, err
+	}
+	partyTmp.PartyD.IdS = uuid4Str
+
+	partyLegalEntityT := new(commonproto.PartyLegalEntityT)
+	partyLegalEntityT.RegistrationDate = common.TimeToTimestamp(partyTmp.PartyLegalEntityT.RegistrationDate)
+	partyLegalEntityT.RegistrationExpirationDate = common.TimeToTimestamp(partyTmp.PartyLegalEntityT.RegistrationExpirationDate)
+
+	party := partyproto.Party{PartyD: partyTmp.PartyD, PartyLegalEntityD: partyTmp.PartyLegalEntityD, PartyLegalEntityT: partyLegalEntityT, CrUpdUser: partyTmp.CrUpdUser}
+
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectPartyIdentificationsSQL, partyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		partyIdentification := partyproto.PartyIdentification{}
+		err = rows.StructScan(&partyIdentification)
+		if err != nil {
+			return nil, err
+		}
+		party.PartyIdentifications = append(party.PartyIdentifications, &partyIdentification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	partySource := PartySource{Party: &party}
+	if party.PartyD.AddressId != 0 {
+		partySource.Address, err = common.GetAddress(ctx, dbService.Queryer(ctx), party.PartyD.AddressId, userEmail, requestID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+	if party.PartyLegalEntityD.TaxSchemeId != 0 {
+		partySource.TaxScheme, err = getTaxScheme(ctx, dbService, party.PartyLegalEntityD.TaxSchemeId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &partySource, nil
+}
+
+// GetItems - Get the items referenced by document lines, zero and unknown
+// ids are skipped
+func GetItems(ctx context.Context, dbService *common.DBService, itemIDs ...uint32) (map[uint32]*ItemSource, error) {
+	items := make(map[uint32]*ItemSource)
+	for _, itemID := range itemIDs {
+		if itemID == 0 || items[itemID] != nil {
+			continue
+		}
+		row := dbService.Queryer(ctx).QueryRowxContext(ctx, selectItemSQL, itemID, "active")
+		itemTmp := itemstruct.Item{}
+		err := row.StructScan(&itemTmp)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		item := ItemSource{Item: itemTmp.ItemD, TaxSchemes: make(map[uint32]*taxproto.TaxSchemeD)}
+		if item.Item.TaxCategoryId != 0 {
+			item.TaxCategory, item.TaxScheme, err = GetTaxCategory(ctx, dbService, item.Item.TaxCategoryId)
+			if err != nil {
+				return nil, err
+			}
+			if item.TaxCategory != nil {
+				item.TaxCategories = append(item.TaxCategories, item.TaxCategory)
+			}
+			if item.TaxScheme != nil {
+				item.TaxSchemes[item.TaxScheme.Id] = item.TaxScheme
+			}
+		}
+		taxCategoryIDs, err := getItemTaxCategoryIDs(ctx, dbService, itemID)
+		if err != nil {
+			return nil, err
+		}
+		for _, taxCategoryID := range taxCategoryIDs {
+			taxCategory, taxScheme, err := GetTaxCategory(ctx, dbService, taxCategoryID)
+			if err != nil {
+				return nil, err
+			}
+			if taxCategory != nil {
+				item.TaxCategories = append(item.TaxCategories, taxCategory)
+			}
+			if taxScheme != nil {
+				item.TaxSchemes[taxScheme.Id] = taxScheme
+			}
+		}
+		items[itemID] = &item
+	}
+	return items, nil
+}
+
+// getItemTaxCategoryIDs - ids of the item_tax_categories of an item
+func getItemTaxCategoryIDs(ctx context.Context, dbService *common.DBService, itemID uint32) ([]uint32, error) {
+	taxCategoryIDs := []uint32{}
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectItemTaxCategoryIDsSQL, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var taxCategoryID uint32
+		if err = rows.Scan(&taxCategoryID); err != nil {
+			return nil, err
+		}
+		taxCategoryIDs = append(taxCategoryIDs, taxCategoryID)
+	}
+	return taxCategoryIDs, rows.Err()
+}
+
+// GetTaxTotals - Get the TaxTotals and TaxSubTotals of a document or line
+func GetTaxTotals(ctx context.Context, dbService *common.DBService, masterFlag string, masterID uint32) ([]*TaxTotalSource, error) {
+	taxTotals := []*TaxTotalSource{}
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectTaxTotalsSQL, masterFlag, masterID, "active")
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		taxTotalTmp := taxstruct.TaxTotal{}
+		err = rows.StructScan(&taxTotalTmp)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		taxTotals = append(taxTotals, &TaxTotalSource{TaxTotal: taxTotalTmp.TaxTotalD})
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, taxTotal := range taxTotals {
+		rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectTaxSubTotalsSQL, taxTotal.TaxTotal.Id, "active")
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			taxSubTotalTmp := taxstruct.TaxSubTotal{}
+			err = rows.StructScan(&taxSubTotalTmp)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+			taxTotal.TaxSubTotals = append(taxTotal.TaxSubTotals, &TaxSubTotalSource{TaxSubTotal: taxSubTotalTmp.TaxSubTotalD})
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+		for _, taxSubTotal := range taxTotal.TaxSubTotals {
+			taxCategoryID := taxSubTotal.TaxSubTotal.TaxCategoryId
+			if taxCategoryID == 0 {
+				taxCategoryID = taxTotal.TaxTotal.TaxCategoryId
+			}
+			if taxCategoryID == 0 {
+				continue
+			}
+			taxSubTotal.TaxCategory, taxSubTotal.TaxScheme, err = GetTaxCategory(ctx, dbService, taxCategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return taxTotals, nil
+}
+
+// GetAllowanceCharges - Get the AllowanceCharges of a document or line
+func GetAllowanceCharges(ctx context.Context, dbService *common.DBService, masterFlag string, masterID uint32) ([]*commonproto.AllowanceCharge, error) {
+	allowanceCharges := []*commonproto.AllowanceCharge{}
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectAllowanceChargesSQL, masterFlag, masterID, "active")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		allowanceChargeTmp := commonstruct.AllowanceCharge{}
+		err = rows.StructScan(&allowanceChargeTmp)
+		if err != nil {
+			return nil, err
+		}
+		allowanceChargeTmp.AllowanceChargeD.IdS, err = common.UUIDBytesToStr(allowanceChargeTmp.AllowanceChargeD.Uuid4)
+		if err != nil {
+			return nil, err
+		}
+		crUpdTime := new(commonproto.CrUpdTime)
+		crUpdTime.CreatedAt = common.TimeToTimestamp(allowanceChargeTmp.CrUpdTime.CreatedAt)
+		crUpdTime.UpdatedAt = common.TimeToTimestamp(allowanceChargeTmp.CrUpdTime.UpdatedAt)
+		allowanceCharges = append(allowanceCharges, &commonproto.AllowanceCharge{AllowanceChargeD: allowanceChargeTmp.AllowanceChargeD, CrUpdUser: allowanceChargeTmp.CrUpdUser, CrUpdTime: crUpdTime})
+	}
+	return allowanceCharges, rows.Err()
+}
+
+// getAllowanceChargeSources - Get the AllowanceCharges of a document or
+// line with their tax categories
+func getAllowanceChargeSources(ctx context.Context, dbService *common.DBService, masterFlag string, masterID uint32) ([]*AllowanceChargeSource, error) {
+	allowanceCharges, err := GetAllowanceCharges(ctx, dbService, masterFlag, masterID)
+	if err != nil {
+		return nil, err
+	}
+	sources := []*AllowanceChargeSource{}
+	for _, allowanceCharge := range allowanceCharges {
+		source := AllowanceChargeSource{AllowanceCharge: allowanceCharge.AllowanceChargeD}
+		if taxCategoryID := allowanceCharge.AllowanceChargeD.TaxCategoryId; taxCategoryID != 0 {
+			source.TaxCategory, source.TaxScheme, err = GetTaxCategory(ctx, dbService, taxCategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		sources = append(sources, &source)
+	}
+	return sources, nil
+}
+
+// GetPaymentTerms - Get the PaymentTerms of a document
+func GetPaymentTerms(ctx context.Context, dbService *common.DBService, masterFlag string, masterID uint32) ([]*paymentproto.PaymentTerm, error) {
+	paymentTerms := []*paymentproto.PaymentTerm{}
+	rows, err := dbService.Queryer(ctx).QueryxContext(ctx, selectPaymentTermsSQL, masterFlag, masterID, "active")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		paymentTermTmp := paymentstruct.PaymentTerm{}
+		err = rows.StructScan(&paymentTermTmp)
+		if err != nil {
+			return nil, err
+		}
+		paymentTermT := new(paymentproto.PaymentTermT)
+		paymentTermT.PaymentDueDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.PaymentDueDate)
+		paymentTermT.InstallmentDueDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.InstallmentDueDate)
+		paymentTermT.SettlementPeriodStartDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.SettlementPeriodStartDate)
+		paymentTermT.SettlementPeriodEndDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.SettlementPeriodEndDate)
+		paymentTermT.PenaltyPeriodStartDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.PenaltyPeriodStartDate)
+		paymentTermT.PenaltyPeriodEndDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.PenaltyPeriodEndDate)
+		paymentTermT.ValidityPeriodStartDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.ValidityPeriodStartDate)
+		paymentTermT.ValidityPeriodEndDate = common.TimeToTimestamp(paymentTermTmp.PaymentTermT.ValidityPeriodEndDate)
+		paymentTerms = append(paymentTerms, &paymentproto.PaymentTerm{PaymentTermD: paymentTermTmp.PaymentTermD, PaymentTermT: paymentTermT, CrUpdUser: paymentTermTmp.CrUpdUser})
+	}
+	return paymentTerms, rows.Err()
+}
+
+// GetReferenceID - Get the business identifier of a referenced document or
+// line. Rows without one are identified by their uuid.
+func GetReferenceID(ctx context.Context, dbService *common.DBService, kind string, id uint32) (string, error) {
+	if id == 0 {
+		return "", nil
+	}
+	query, ok := selectReferenceIDSQL[kind]
+	if !ok {
+		return "", errors.New("ubl: unknown reference kind " + kind)
+	}
+	var referenceID string
+	var uuid4 []byte
+	err := dbService.Queryer(ctx).QueryRowxContext(ctx, query, id).Scan(&referenceID, &uuid4)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if referenceID != "" || len(uuid4) == 0 {
+		return referenceID, nil
+	}
+	return common.UUIDBytesToStr(uuid4)
+}
+
+// GetTaxCategory - Get TaxCategory with its TaxScheme, nil when unknown
+func GetTaxCategory(ctx context.Context, dbService *common.DBService, taxCategoryID uint32) (*taxproto.TaxCategoryD, *taxproto.TaxSchemeD, error) {
+	row := dbService.Queryer(ctx).QueryRowxContext(ctx, selectTaxCategorySQL, taxCategoryID)
+	taxCategoryTmp := taxstruct.TaxCategory{}
+	err := row.StructScan(&taxCategoryTmp)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	taxCategory := taxCategoryTmp.TaxCategoryD
+	if taxCategory.TaxSchemeId == 0 {
+		return taxCategory, nil, nil
+	}
+	taxScheme, err := getTaxScheme(ctx, dbService, taxCategory.TaxSchemeId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return taxCategory, taxScheme, nil
+}
+
+// getTaxScheme - Get TaxScheme By Primary key(Id), nil when unknown
+func getTaxScheme(ctx context.Context, dbService *common.DBService, taxSchemeID uint32) (*taxproto.TaxSchemeD, error) {
+	row := dbService.Queryer(ctx).QueryRowxContext(ctx, selectTaxSchemeSQL, taxSchemeID)
+	taxSchemeTmp := taxstruct.TaxScheme{}
+	err := row.StructScan(&taxSchemeTmp)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return taxSchemeTmp.TaxSchemeD, nil
+}
+
+// FindParty - id of the party with the endpoint ID, VAT number or
+// registration name of a UBL party, in that order. 0 when there is none.
+func FindParty(ctx context.Context, dbService *common.DBService, p *Party) (uint32, error) {
+	if endpointID := p.EndpointValue(); endpointID != "" {
+		schemeID := p.EndpointID.SchemeID
+		partyID, err := findID(ctx, dbService, selectPartyByEndpointSQL, endpointID, schemeID, schemeID, "active")
+		if partyID != 0 || err != nil {
+			return partyID, err
+		}
+	}
+	if vatNumber := p.VATNumber(); vatNumber != "" {
+		partyID, err := findID(ctx, dbService, selectPartyByTaxReferenceSQL, vatNumber, "active")
+		if partyID != 0 || err != nil {
+			return partyID, err
+		}
+	}
+	if registrationName := p.RegistrationName(); registrationName != "" {
+		return findID(ctx, dbService, selectPartyByRegistrationNameSQL, registrationName, "active")
+	}
+	return 0, nil
+}
+
+// FindReference - id of the document or line of the given kind with a
+// business identifier as written by GetReferenceID. Lines are searched
+// within parentID when it is set. 0 when there is none.
+func FindReference(ctx context.Context, dbService *common.DBService, kind string, id string, parentID uint32) (uint32, error) {
+	query, ok := selectReferenceByIDSQL[kind]
+	if !ok {
+		return 0, errors.New("ubl: unknown reference kind " + kind)
+	}
+	var refID uint32
+	var err error
+	if kind == ReferenceOrderLine || kind == ReferenceDespatchLine || kind == ReferenceReceiptLine {
+		refID, err = findID(ctx, dbService, query, id, "active", parentID, parentID)
+	} else {
+		refID, err = findID(ctx, dbService, query, id, "active")
+	}
+	if refID != 0 || err != nil {
+		return refID, err
+	}
+	uuid4byte, err := common.UUIDStrToBytes(id)
+	if err != nil {
+		// not a uuid either
+		return 0, nil
+	}
+	return findID(ctx, dbService, selectReferenceByUUIDSQL[kind], uuid4byte, "active")
+}
+
+// FindItem - id of the item with the sellers, buyers or standard item
+// identification of a UBL item, then by name. 0 when there is none.
+func FindItem(ctx context.Context, dbService *common.DBService, it *Item) (uint32, error) {
+	sellersID := itemIdentificationValue(it.SellersItemIdentification)
+	buyersID := itemIdentificationValue(it.BuyersItemIdentification)
+	standardID := itemIdentificationValue(it.StandardItemIdentification)
+	if sellersID != "" || buyersID != "" || standardID != "" {
+		itemID, err := findID(ctx, dbService, selectItemByIdentificationSQL, sellersID, sellersID, buyersID, buyersID, standardID, standardID, "active")
+		if itemID != 0 || err != nil {
+			return itemID, err
+		}
+	}
+	if name := strings.TrimSpace(it.Name); name != "" {
+		return findID(ctx, dbService, selectItemByNameSQL, name, "active")
+	}
+	return 0, nil
+}
+
+// FindTaxCategory - id of the tax category with the ID and percent of a UBL
+// tax category, 0 when there is none
+func FindTaxCategory(ctx context.Context, dbService *common.DBService, tc *TaxCategory) (uint32, error) {
+	code := strings.TrimSpace(tc.ID)
+	if code == "" {
+		return 0, nil
+	}
+	percent, err := ParseFloat(tc.Percent)
+	if err != nil {
+		return 0, nil
+	}
+	return findID(ctx, dbService, selectTaxCategoryByCodeSQL, code, percent, "active")
+}
+
+func itemIdentificationValue(ii *ItemIdentification) string {
+	if ii == nil {
+		return ""
+	}
+	return strings.TrimSpace(ii.ID.Value)
+}
+
+// findID - id selected by query, 0 when there is no row
+func findID(ctx context.Context, dbService *common.DBService, query string, args ...interface{}) (uint32, error) {
+	var id uint32
+	err := dbService.Queryer(ctx).QueryRowxContext(ctx, query, args...).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
@@ -0,0 +1,227 @@
+// Package ubl maps sc-ubl documents to and from OASIS UBL 2.3 XML
+package ubl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/cloudfresco/sc-ubl/internal/money"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UBL 2.3 namespaces
+const (
+	NamespaceCac = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	NamespaceCbc = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	NamespaceExt = "urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2"
+
+	NamespaceInvoice    = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	NamespaceCreditNote = "urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"
+	NamespaceDebitNote  = "urn:oasis:names:specification:ubl:schema:xsd:DebitNote-2"
+
+	NamespaceOrder         = "urn:oasis:names:specification:ubl:schema:xsd:Order-2"
+	NamespaceOrderResponse = "urn:oasis:names:specification:ubl:schema:xsd:OrderResponse-2"
+	NamespaceOrderChange   = "urn:oasis:names:specification:ubl:schema:xsd:OrderChange-2"
+
+	NamespaceDespatchAdvice = "urn:oasis:names:specification:ubl:schema:xsd:DespatchAdvice-2"
+	NamespaceReceiptAdvice  = "urn:oasis:names:specification:ubl:schema:xsd:ReceiptAdvice-2"
+)
+
+// Version - value of cbc:UBLVersionID
+const Version = "2.3"
+
+// UnitCodeOne - unitCode C62 of UN/ECE Recommendation 20, one, the unit of
+// measure of a line quantity counted in pieces
+const UnitCodeOne = "C62"
+
+// DateLayout - layout of cbc date elements
+const DateLayout = "2006-01-02"
+
+// Namespaces - xmlns attributes written on every document root
+type Namespaces struct {
+	Xmlns    string `xml:"xmlns,attr,omitempty"`
+	XmlnsCac string `xml:"xmlns:cac,attr,omitempty"`
+	XmlnsCbc string `xml:"xmlns:cbc,attr,omitempty"`
+}
+
+// NewNamespaces - namespaces for a document with the given root namespace
+func NewNamespaces(documentNamespace string) Namespaces {
+	return Namespaces{Xmlns: documentNamespace, XmlnsCac: NamespaceCac, XmlnsCbc: NamespaceCbc}
+}
+
+// Amount - cbc amount with currencyID
+type Amount struct {
+	Value      string `xml:",chardata"`
+	CurrencyID string `xml:"currencyID,attr,omitempty"`
+}
+
+// Quantity - cbc quantity with unitCode
+type Quantity struct {
+	Value    string `xml:",chardata"`
+	UnitCode string `xml:"unitCode,attr,omitempty"`
+}
+
+// Identifier - cbc identifier with schemeID
+type Identifier struct {
+	Value    string `xml:",chardata"`
+	SchemeID string `xml:"schemeID,attr,omitempty"`
+}
+
+// Marshal - encode a UBL document with an XML declaration
+func Marshal(doc interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// Unmarshal - decode a UBL document whose root element is in documentNamespace
+func Unmarshal(data []byte, documentNamespace string, doc interface{}) error {
+	dec := xml.NewTokenDecoder(&prefixReader{dec: xml.NewDecoder(bytes.NewReader(data)), documentNamespace: documentNamespace})
+	return dec.Decode(doc)
+}
+
+// RootElement - returns the namespace and local name of the document root
+func RootElement(data []byte) (xml.Name, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return xml.Name{}, errors.New("ubl: document has no root element")
+			}
+			return xml.Name{}, err
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			return se.Name, nil
+		}
+	}
+}
+
+// prefixReader rewrites namespaced tokens to the cac:/cbc:/ext: prefixed
+// local names used in the struct tags of this package, so decoding does not
+// depend on the prefixes chosen by the sender.
+type prefixReader struct {
+	dec               *xml.Decoder
+	documentNamespace string
+	rootSeen          bool
+}
+
+var prefixes = map[string]string{
+	NamespaceCac: "cac:",
+	NamespaceCbc: "cbc:",
+	NamespaceExt: "ext:",
+}
+
+func (p *prefixReader) Token() (xml.Token, error) {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case xml.StartElement:
+		if !p.rootSeen {
+			p.rootSeen = true
+			if t.Name.Space != p.documentNamespace {
+				return nil, fmt.Errorf("ubl: root element {%s}%s is not in namespace %s", t.Name.Space, t.Name.Local, p.documentNamespace)
+			}
+		}
+		t.Name = p.rename(t.Name)
+		attrs := make([]xml.Attr, 0, len(t.Attr))
+		for _, a := range t.Attr {
+			if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+				continue
+			}
+			attrs = append(attrs, a)
+		}
+		t.Attr = attrs
+		return t, nil
+	case xml.EndElement:
+		t.Name = p.rename(t.Name)
+		return t, nil
+	}
+	return xml.CopyToken(tok), nil
+}
+
+func (p *prefixReader) rename(n xml.Name) xml.Name {
+	if n.Space == p.documentNamespace {
+		return xml.Name{Local: n.Local}
+	}
+	if prefix, ok := prefixes[n.Space]; ok {
+		return xml.Name{Local: prefix + n.Local}
+	}
+	return n
+}
+
+// FormatAmount - format a decimal amount with at least two decimals, a
+// string that is not a decimal is returned as it is
+func FormatAmount(v string) string {
+	a, err := money.Parse(v)
+	if err != nil {
+		return v
+	}
+	return a.String()
+}
+
+// FormatDecimal - format a numeric value without trailing zeros
+func FormatDecimal(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// NewAmount - Amount in the given currency
+func NewAmount(v string, currencyID string) *Amount {
+	return &Amount{Value: FormatAmount(v), CurrencyID: currencyID}
+}
+
+// OptionalAmount - Amount in the given currency, nil when v is zero
+func OptionalAmount(v string, currencyID string) *Amount {
+	if a, err := money.Parse(v); err == nil && a == money.Zero {
+		return nil
+	}
+	return NewAmount(v, currencyID)
+}
+
+// FormatDate - format a timestamp as a UBL date, empty for unset values
+func FormatDate(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t := ts.AsTime()
+	if t.IsZero() || t.Unix() <= 0 {
+		return ""
+	}
+	return t.UTC().Format(DateLayout)
+}
+
+// ParseDate - parse a UBL date into the form expected by Create requests
+func ParseDate(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	t, err := time.Parse(DateLayout, strings.TrimSpace(s))
+	if err != nil {
+		return "", err
+	}
+	return t.Format(common.Layout), nil
+}
+
+// ParseFloat - parse a UBL decimal value, empty values are zero
+func ParseFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,603 @@
+package ublvalidate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// namespaceXSD - namespace of the XML Schema language and its built-in types
+const namespaceXSD = "http://www.w3.org/2001/XMLSchema"
+
+// qname - namespace qualified name of a schema component
+type qname struct {
+	space string
+	local string
+}
+
+func (q qname) String() string {
+	return "{" + q.space + "}" + q.local
+}
+
+// schemaSet - the global components of every schema reachable from the
+// document schemas
+type schemaSet struct {
+	// documents - global elements declared by the document schemas, the
+	// only elements accepted as a document root
+	documents map[qname]*elementDecl
+	elements  map[qname]*elementDecl
+	types     map[qname]*typeDef
+
+	files    map[string]bool
+	rawTypes map[qname]*rawType
+}
+
+// elementDecl - an element declaration; typ is nil for elements whose
+// content is not checked
+type elementDecl struct {
+	name qname
+	typ  *typeDef
+}
+
+// typeDef - a simple or complex type. Simple types and complex types with
+// simple content carry a builtin value type and facets; complex types with
+// element content carry a content model.
+type typeDef struct {
+	name    qname
+	builtin string
+	facets  facets
+	attrs   map[string]*attrDecl
+	content *group
+	mixed   bool
+}
+
+// simple - whether the type has a text value rather than child elements
+func (t *typeDef) simple() bool {
+	return t.builtin != ""
+}
+
+// attrDecl - an unqualified attribute of a complex type
+type attrDecl struct {
+	name     string
+	typ      *typeDef
+	required bool
+}
+
+// group - a sequence or choice of particles
+type group struct {
+	choice    bool
+	optional  bool
+	particles []*particle
+}
+
+// particle - an element or wildcard of a content model; max < 0 is
+// unbounded
+type particle struct {
+	elem *elementDecl
+	any  *wildcard
+	min  int
+	max  int
+}
+
+// wildcard - an xsd:any particle
+type wildcard struct {
+	namespaces      []string
+	targetNamespace string
+	processContents string
+}
+
+// facets - constraining facets of a simple type
+type facets struct {
+	enumeration []string
+	patterns    []*regexp.Regexp
+	length      int
+	minLength   int
+	maxLength   int
+}
+
+// schema files as read by encoding/xml
+
+type xsdSchema struct {
+	TargetNamespace     string        `xml:"targetNamespace,attr"`
+	ElementFormDefault  string        `xml:"elementFormDefault,attr"`
+	Attrs               []xml.Attr    `xml:",any,attr"`
+	Imports             []xsdImport   `xml:"import"`
+	Includes            []xsdImport   `xml:"include"`
+	Elements            []xsdParticle `xml:"element"`
+	ComplexTypes        []xsdComplex  `xml:"complexType"`
+	SimpleTypes         []xsdSimple   `xml:"simpleType"`
+	prefixes            map[string]string
+	qualifiedLocalElems bool
+}
+
+type xsdImport struct {
+	Namespace      string `xml:"namespace,attr"`
+	SchemaLocation string `xml:"schemaLocation,attr"`
+}
+
+// xsdParticle - an element, any or nested model group, in document order
+type xsdParticle struct {
+	XMLName         xml.Name
+	Name            string      `xml:"name,attr"`
+	Ref             string      `xml:"ref,attr"`
+	Type            string      `xml:"type,attr"`
+	MinOccurs       string      `xml:"minOccurs,attr"`
+	MaxOccurs       string      `xml:"maxOccurs,attr"`
+	Namespace       string      `xml:"namespace,attr"`
+	ProcessContents string      `xml:"processContents,attr"`
+	ComplexType     *xsdComplex `xml:"complexType"`
+	SimpleType      *xsdSimple  `xml:"simpleType"`
+}
+
+type xsdGroup struct {
+	MinOccurs string        `xml:"minOccurs,attr"`
+	MaxOccurs string        `xml:"maxOccurs,attr"`
+	Items     []xsdParticle `xml:",any"`
+}
+
+type xsdComplex struct {
+	Name           string         `xml:"name,attr"`
+	Mixed          bool           `xml:"mixed,attr"`
+	Sequence       *xsdGroup      `xml:"sequence"`
+	Choice         *xsdGroup      `xml:"choice"`
+	SimpleContent  *xsdSimpleBody `xml:"simpleContent"`
+	ComplexContent *struct{}      `xml:"complexContent"`
+	Attributes     []xsdAttribute `xml:"attribute"`
+}
+
+type xsdSimpleBody struct {
+	Extension   *xsdDerivation `xml:"extension"`
+	Restriction *xsdDerivation `xml:"restriction"`
+}
+
+type xsdDerivation struct {
+	Base       string         `xml:"base,attr"`
+	Attributes []xsdAttribute `xml:"attribute"`
+	Facets     []xsdFacet     `xml:",any"`
+}
+
+type xsdFacet struct {
+	XMLName xml.Name
+	Value   string `xml:"value,attr"`
+}
+
+type xsdSimple struct {
+	Name        string         `xml:"name,attr"`
+	Restriction *xsdDerivation `xml:"restriction"`
+}
+
+type xsdAttribute struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+	Use  string `xml:"use,attr"`
+}
+
+// rawType - a named type waiting to be resolved, with the schema that
+// declared it for prefix lookups
+type rawType struct {
+	schema  *xsdSchema
+	complex *xsdComplex
+	simple  *xsdSimple
+	busy    bool
+}
+
+// loadSchemaSet - load every schema in dir of fsys together with the
+// schemas they import or include
+func loadSchemaSet(fsys fs.FS, dir string) (*schemaSet, error) {
+	set := &schemaSet{
+		documents: map[qname]*elementDecl{},
+		elements:  map[qname]*elementDecl{},
+		types:     map[qname]*typeDef{},
+		files:     map[string]bool{},
+		rawTypes:  map[qname]*rawType{},
+	}
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	var docSchemas []*xsdSchema
+	var all []*xsdSchema
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".xsd") {
+			continue
+		}
+		schemas, err := set.read(fsys, path.Join(dir, entry.Name()), "")
+		if err != nil {
+			return nil, err
+		}
+		if len(schemas) > 0 {
+			docSchemas = append(docSchemas, schemas[0])
+		}
+		all = append(all, schemas...)
+	}
+
+	for _, s := range all {
+		for i := range s.ComplexTypes {
+			set.rawTypes[qname{s.TargetNamespace, s.ComplexTypes[i].Name}] = &rawType{schema: s, complex: &s.ComplexTypes[i]}
+		}
+		for i := range s.SimpleTypes {
+			set.rawTypes[qname{s.TargetNamespace, s.SimpleTypes[i].Name}] = &rawType{schema: s, simple: &s.SimpleTypes[i]}
+		}
+		for _, e := range s.Elements {
+			name := qname{s.TargetNamespace, e.Name}
+			set.elements[name] = &elementDecl{name: name}
+		}
+	}
+	for _, s := range all {
+		for _, e := range s.Elements {
+			if err := set.global(s, e); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, s := range docSchemas {
+		for _, e := range s.Elements {
+			name := qname{s.TargetNamespace, e.Name}
+			set.documents[name] = set.elements[name]
+		}
+	}
+	return set, nil
+}
+
+// read - parse the schema at file and, depth first, the schemas it imports
+// or includes. Files already read return nothing.
+func (set *schemaSet) read(fsys fs.FS, file string, chameleon string) ([]*xsdSchema, error) {
+	if set.files[file] {
+		return nil, nil
+	}
+	set.files[file] = true
+	data, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return nil, err
+	}
+	s := &xsdSchema{}
+	if err := xml.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if s.TargetNamespace == "" {
+		s.TargetNamespace = chameleon
+	}
+	s.qualifiedLocalElems = s.ElementFormDefault == "qualified"
+	s.prefixes = map[string]string{}
+	for _, a := range s.Attrs {
+		switch {
+		case a.Name.Space == "xmlns":
+			s.prefixes[a.Name.Local] = a.Value
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			s.prefixes[""] = a.Value
+		}
+	}
+	schemas := []*xsdSchema{s}
+	for _, imp := range s.Imports {
+		if imp.SchemaLocation == "" {
+			continue
+		}
+		more, err := set.read(fsys, path.Join(path.Dir(file), imp.SchemaLocation), "")
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, more...)
+	}
+	for _, inc := range s.Includes {
+		more, err := set.read(fsys, path.Join(path.Dir(file), inc.SchemaLocation), s.TargetNamespace)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, more...)
+	}
+	return schemas, nil
+}
+
+// resolveName - the qname a prefixed name in s refers to
+func (set *schemaSet) resolveName(s *xsdSchema, name string) (qname, error) {
+	prefix, local := "", name
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		prefix, local = name[:i], name[i+1:]
+	}
+	space, ok := s.prefixes[prefix]
+	if !ok {
+		if prefix != "" {
+			return qname{}, fmt.Errorf("schema %s: undeclared prefix %q in %q", s.TargetNamespace, prefix, name)
+		}
+		space = ""
+	}
+	return qname{space, local}, nil
+}
+
+// global - resolve the type of a global element declaration
+func (set *schemaSet) global(s *xsdSchema, e xsdParticle) error {
+	decl := set.elements[qname{s.TargetNamespace, e.Name}]
+	typ, err := set.elementType(s, e)
+	if err != nil {
+		return err
+	}
+	decl.typ = typ
+	return nil
+}
+
+// elementType - the type of an element declared by name
+func (set *schemaSet) elementType(s *xsdSchema, e xsdParticle) (*typeDef, error) {
+	switch {
+	case e.ComplexType != nil:
+		return set.complexType(s, qname{}, e.ComplexType)
+	case e.SimpleType != nil:
+		return set.simpleType(s, qname{}, e.SimpleType)
+	case e.Type != "":
+		name, err := set.resolveName(s, e.Type)
+		if err != nil {
+			return nil, err
+		}
+		return set.lookupType(name)
+	}
+	// no type: anyType, content is not checked
+	return nil, nil
+}
+
+// lookupType - the named type, resolving it on first use
+func (set *schemaSet) lookupType(name qname) (*typeDef, error) {
+	if t, ok := set.types[name]; ok {
+		return t, nil
+	}
+	if name.space == namespaceXSD {
+		if name.local == "anyType" {
+			return nil, nil
+		}
+		if !knownBuiltin(name.local) {
+			return nil, fmt.Errorf("unsupported built-in type %s", name)
+		}
+		t := &typeDef{name: name, builtin: name.local}
+		set.types[name] = t
+		return t, nil
+	}
+	raw, ok := set.rawTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("undefined type %s", name)
+	}
+	if raw.busy {
+		return nil, fmt.Errorf("type %s is derived from itself", name)
+	}
+	raw.busy = true
+	var t *typeDef
+	var err error
+	if raw.complex != nil {
+		t, err = set.complexType(raw.schema, name, raw.complex)
+	} else {
+		t, err = set.simpleType(raw.schema, name, raw.simple)
+	}
+	raw.busy = false
+	if err != nil {
+		return nil, err
+	}
+	set.types[name] = t
+	return t, nil
+}
+
+// simpleType - a simple type derived by restriction
+func (set *schemaSet) simpleType(s *xsdSchema, name qname, st *xsdSimple) (*typeDef, error) {
+	if st.Restriction == nil {
+		return nil, fmt.Errorf("simple type %s: only restriction is supported", name)
+	}
+	base, err := set.baseType(s, st.Restriction.Base)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil || !base.simple() {
+		return nil, fmt.Errorf("simple type %s: base %s is not simple", name, st.Restriction.Base)
+	}
+	t := &typeDef{name: name, builtin: base.builtin, facets: base.facets}
+	if err := t.facets.add(st.Restriction.Facets); err != nil {
+		return nil, fmt.Errorf("simple type %s: %w", name, err)
+	}
+	return t, nil
+}
+
+// complexType - a complex type with simple or element content
+func (set *schemaSet) complexType(s *xsdSchema, name qname, ct *xsdComplex) (*typeDef, error) {
+	if ct.ComplexContent != nil {
+		return nil, fmt.Errorf("complex type %s: complexContent is not supported", name)
+	}
+	t := &typeDef{name: name, attrs: map[string]*attrDecl{}, mixed: ct.Mixed}
+
+	if sc := ct.SimpleContent; sc != nil {
+		d := sc.Extension
+		if d == nil {
+			d = sc.Restriction
+		}
+		if d == nil {
+			return nil, fmt.Errorf("complex type %s: simpleContent needs an extension or restriction", name)
+		}
+		base, err := set.baseType(s, d.Base)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil || !base.simple() {
+			return nil, fmt.Errorf("complex type %s: base %s has no simple content", name, d.Base)
+		}
+		t.builtin = base.builtin
+		t.facets = base.facets
+		for k, a := range base.attrs {
+			t.attrs[k] = a
+		}
+		if sc.Restriction != nil {
+			if err := t.facets.add(d.Facets); err != nil {
+				return nil, fmt.Errorf("complex type %s: %w", name, err)
+			}
+		}
+		if err := set.attributes(s, t, d.Attributes); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+
+	if err := set.attributes(s, t, ct.Attributes); err != nil {
+		return nil, err
+	}
+	g, choice := ct.Sequence, false
+	if g == nil && ct.Choice != nil {
+		g, choice = ct.Choice, true
+	}
+	t.content = &group{choice: choice}
+	if g == nil {
+		return t, nil
+	}
+	if g.MaxOccurs != "" && g.MaxOccurs != "1" {
+		return nil, fmt.Errorf("complex type %s: repeated model groups are not supported", name)
+	}
+	t.content.optional = g.MinOccurs == "0"
+	for _, item := range g.Items {
+		p, err := set.particle(s, name, item)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			t.content.particles = append(t.content.particles, p)
+		}
+	}
+	return t, nil
+}
+
+// baseType - the type a derivation in s is based on
+func (set *schemaSet) baseType(s *xsdSchema, base string) (*typeDef, error) {
+	name, err := set.resolveName(s, base)
+	if err != nil {
+		return nil, err
+	}
+	return set.lookupType(name)
+}
+
+// attributes - add attribute declarations to t; a declaration of an
+// inherited attribute overrides it
+func (set *schemaSet) attributes(s *xsdSchema, t *typeDef, attrs []xsdAttribute) error {
+	for _, a := range attrs {
+		if a.Name == "" {
+			return fmt.Errorf("complex type %s: attribute references are not supported", t.name)
+		}
+		if a.Use == "prohibited" {
+			delete(t.attrs, a.Name)
+			continue
+		}
+		decl := &attrDecl{name: a.Name, required: a.Use == "required"}
+		if inherited, ok := t.attrs[a.Name]; ok {
+			decl.typ = inherited.typ
+		}
+		if a.Type != "" {
+			typ, err := set.baseType(s, a.Type)
+			if err != nil {
+				return err
+			}
+			decl.typ = typ
+		}
+		t.attrs[a.Name] = decl
+	}
+	return nil
+}
+
+// particle - the content model particle for an item of a model group
+func (set *schemaSet) particle(s *xsdSchema, owner qname, item xsdParticle) (*particle, error) {
+	min, max, err := occurs(item.MinOccurs, item.MaxOccurs)
+	if err != nil {
+		return nil, fmt.Errorf("complex type %s: %w", owner, err)
+	}
+	p := &particle{min: min, max: max}
+	switch item.XMLName.Local {
+	case "annotation":
+		return nil, nil
+	case "any":
+		ns := item.Namespace
+		if ns == "" {
+			ns = "##any"
+		}
+		pc := item.ProcessContents
+		if pc == "" {
+			pc = "strict"
+		}
+		p.any = &wildcard{namespaces: strings.Fields(ns), targetNamespace: s.TargetNamespace, processContents: pc}
+	case "element":
+		if item.Ref != "" {
+			name, err := set.resolveName(s, item.Ref)
+			if err != nil {
+				return nil, err
+			}
+			decl, ok := set.elements[name]
+			if !ok {
+				return nil, fmt.Errorf("complex type %s: undefined element %s", owner, name)
+			}
+			p.elem = decl
+			break
+		}
+		name := qname{"", item.Name}
+		if s.qualifiedLocalElems {
+			name.space = s.TargetNamespace
+		}
+		typ, err := set.elementType(s, item)
+		if err != nil {
+			return nil, err
+		}
+		p.elem = &elementDecl{name: name, typ: typ}
+	default:
+		return nil, fmt.Errorf("complex type %s: nested %s groups are not supported", owner, item.XMLName.Local)
+	}
+	return p, nil
+}
+
+// occurs - parse minOccurs and maxOccurs, both defaulting to 1
+func occurs(minOccurs string, maxOccurs string) (int, int, error) {
+	min, max := 1, 1
+	var err error
+	if minOccurs != "" {
+		if min, err = strconv.Atoi(minOccurs); err != nil {
+			return 0, 0, fmt.Errorf("invalid minOccurs %q", minOccurs)
+		}
+	}
+	switch maxOccurs {
+	case "":
+	case "unbounded":
+		max = -1
+	default:
+		if max, err = strconv.Atoi(maxOccurs); err != nil {
+			return 0, 0, fmt.Errorf("invalid maxOccurs %q", maxOccurs)
+		}
+	}
+	return min, max, nil
+}
+
+// add - add the facets of a restriction to f
+func (f *facets) add(list []xsdFacet) error {
+	var enumeration []string
+	for _, facet := range list {
+		switch facet.XMLName.Local {
+		case "annotation":
+		case "enumeration":
+			enumeration = append(enumeration, facet.Value)
+		case "pattern":
+			re, err := regexp.Compile("^(?:" + facet.Value + ")$")
+			if err != nil {
+				return fmt.Errorf("pattern %q: %w", facet.Value, err)
+			}
+			f.patterns = append(f.patterns[:len(f.patterns):len(f.patterns)], re)
+		case "length", "minLength", "maxLength":
+			n, err := strconv.Atoi(facet.Value)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q", facet.XMLName.Local, facet.Value)
+			}
+			switch facet.XMLName.Local {
+			case "length":
+				f.length = n
+			case "minLength":
+				f.minLength = n
+			default:
+				f.maxLength = n
+			}
+		default:
+			return fmt.Errorf("facet %s is not supported", facet.XMLName.Local)
+		}
+	}
+	if enumeration != nil {
+		f.enumeration = enumeration
+	}
+	return nil
+}
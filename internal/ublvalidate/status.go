@@ -0,0 +1,64 @@
+package ublvalidate
+
+import (
+	"errors"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	commonproto "github.com/cloudfresco/sc-ubl/internal/protogen/common/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// GRPCError - err as returned by a gRPC service; SchemaErrors become an
+// InvalidArgument status carrying a ValidationProblem detail per error, any
+// other error is returned unchanged
+func GRPCError(err error) error {
+	var schemaErrors SchemaErrors
+	if !errors.As(err, &schemaErrors) {
+		return err
+	}
+	st := status.New(codes.InvalidArgument, schemaErrors.Error())
+	st, detailErr := st.WithDetails(schemaErrors.protoDetails()...)
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// Problems - the schema problems carried by err, either SchemaErrors or a
+// status made by GRPCError; false when err is not a schema failure
+func Problems(err error) ([]common.Problem, bool) {
+	var schemaErrors SchemaErrors
+	if errors.As(err, &schemaErrors) {
+		return schemaErrors.Problems(), true
+	}
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return nil, false
+	}
+	var problems []common.Problem
+	for _, detail := range st.Details() {
+		if vp, ok := detail.(*commonproto.ValidationProblem); ok {
+			problems = append(problems, common.Problem{Path: vp.Path, Line: int(vp.Line), Rule: vp.Rule, Message: vp.Message})
+		}
+	}
+	return problems, len(problems) > 0
+}
+
+// ValidationProblems - SchemaErrors as ValidationProblem messages
+func (e SchemaErrors) ValidationProblems() []*commonproto.ValidationProblem {
+	problems := make([]*commonproto.ValidationProblem, 0, len(e))
+	for _, se := range e {
+		problems = append(problems, &commonproto.ValidationProblem{Path: se.Path, Line: int32(se.Line), Message: se.Message})
+	}
+	return problems
+}
+
+func (e SchemaErrors) protoDetails() []protoadapt.MessageV1 {
+	details := make([]protoadapt.MessageV1, 0, len(e))
+	for _, vp := range e.ValidationProblems() {
+		details = append(details, vp)
+	}
+	return details
+}
@@ -0,0 +1,167 @@
+// Package ublvalidate checks UBL 2.3 documents against the XML Schema set
+// bundled in xsd/, without network access.
+//
+// The bundle follows the OASIS os-UBL-2.3/xsd layout (maindoc/ and common/)
+// and covers the document types this service exchanges: Invoice,
+// CreditNote, DebitNote, Order, OrderResponse, OrderChange, DespatchAdvice
+// and ReceiptAdvice. Aggregates the service does not map are declared with
+// open content: they are accepted, and only the basic components in them
+// are checked.
+package ublvalidate
+
+import (
+	"bytes"
+	"embed"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+)
+
+//go:embed xsd
+var bundle embed.FS
+
+// maxErrors - validation stops after this many SchemaErrors
+const maxErrors = 100
+
+// SchemaError - a schema violation in a UBL document, located by its XPath
+// and the line it starts on
+type SchemaError struct {
+	Path    string
+	Line    int
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s (line %d): %s", e.Path, e.Line, e.Message)
+	}
+	return e.Path + ": " + e.Message
+}
+
+// SchemaErrors - every SchemaError found in a document
+type SchemaErrors []*SchemaError
+
+func (e SchemaErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, se := range e {
+		msgs = append(msgs, se.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Problems - SchemaErrors in the form returned to API clients
+func (e SchemaErrors) Problems() []common.Problem {
+	problems := make([]common.Problem, 0, len(e))
+	for _, se := range e {
+		problems = append(problems, common.Problem{Path: se.Path, Line: se.Line, Message: se.Message})
+	}
+	return problems
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *schemaSet
+	loadErr  error
+)
+
+// schemas - the bundled schema set, loaded on first use
+func schemas() (*schemaSet, error) {
+	loadOnce.Do(func() {
+		loaded, loadErr = loadSchemaSet(bundle, "xsd/maindoc")
+	})
+	return loaded, loadErr
+}
+
+// Validate - check doc against the bundled UBL 2.3 schemas. A document that
+// does not conform yields SchemaErrors; any other error means the schemas
+// could not be loaded.
+func Validate(doc []byte) error {
+	_, err := DocumentType(doc)
+	return err
+}
+
+// DocumentType - validate doc like Validate and return the local name of
+// its root element, e.g. "Invoice", when it is a known UBL document, also
+// when the document does not conform
+func DocumentType(doc []byte) (string, error) {
+	set, err := schemas()
+	if err != nil {
+		return "", err
+	}
+	root, err := parseInstance(doc)
+	if err != nil {
+		var se *SchemaError
+		if errors.As(err, &se) {
+			return "", SchemaErrors{se}
+		}
+		return "", err
+	}
+	v := validator{set: set}
+	decl := set.documents[qname{root.name.Space, root.name.Local}]
+	if decl == nil {
+		return "", SchemaErrors{{Path: "/", Line: root.line, Message: fmt.Sprintf("%s is not a UBL 2.3 document element", formatName(root.name))}}
+	}
+	v.element(root, decl.typ, "/"+v.step(root.name))
+	if len(v.errs) > 0 {
+		return root.name.Local, v.errs
+	}
+	return root.name.Local, nil
+}
+
+// node - an element of the document being validated
+type node struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*node
+	text     strings.Builder
+	line     int
+}
+
+// parseInstance - read doc into a tree of nodes; a document that is not
+// well-formed yields a *SchemaError
+func parseInstance(doc []byte) (*node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	var root *node
+	var stack []*node
+	for {
+		line, _ := dec.InputPos()
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			msg := err.Error()
+			var syn *xml.SyntaxError
+			if errors.As(err, &syn) {
+				line, msg = syn.Line, syn.Msg
+			}
+			return nil, &SchemaError{Path: "/", Line: line, Message: "document is not well-formed: " + msg}
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name, attrs: t.Attr, line: line}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else if root == nil {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, &SchemaError{Path: "/", Message: "document has no root element"}
+	}
+	return root, nil
+}
@@ -0,0 +1,199 @@
+package ublvalidate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudfresco/sc-ubl/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const testNamespaces = `xmlns:cac="urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2" xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"`
+
+// testInvoice - a minimal valid invoice with each pair of replace applied
+func testInvoice(replace ...string) []byte {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" ` + testNamespaces + `>
+  <cbc:UBLVersionID>2.3</cbc:UBLVersionID>
+  <cbc:ID>TOSL108</cbc:ID>
+  <cbc:IssueDate>2009-12-15</cbc:IssueDate>
+  <cbc:InvoiceTypeCode>380</cbc:InvoiceTypeCode>
+  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>
+  <cac:AccountingSupplierParty>
+    <cac:Party>
+      <cbc:EndpointID schemeID="0088">7300010000001</cbc:EndpointID>
+      <cac:PartyName><cbc:Name>Custom Cotter Pins</cbc:Name></cac:PartyName>
+    </cac:Party>
+  </cac:AccountingSupplierParty>
+  <cac:AccountingCustomerParty>
+    <cac:Party>
+      <cac:PartyName><cbc:Name>North American Veeblefetzer</cbc:Name></cac:PartyName>
+    </cac:Party>
+  </cac:AccountingCustomerParty>
+  <cac:Delivery><cbc:Quantity unitCode="C62">1</cbc:Quantity></cac:Delivery>
+  <cac:LegalMonetaryTotal>
+    <cbc:PayableAmount currencyID="EUR">100.00</cbc:PayableAmount>
+  </cac:LegalMonetaryTotal>
+  <cac:InvoiceLine>
+    <cbc:ID>1</cbc:ID>
+    <cbc:InvoicedQuantity unitCode="C62">1</cbc:InvoicedQuantity>
+    <cbc:LineExtensionAmount currencyID="EUR">100.00</cbc:LineExtensionAmount>
+    <cac:Item><cbc:Name>Pins</cbc:Name></cac:Item>
+  </cac:InvoiceLine>
+</Invoice>`
+	for i := 0; i+1 < len(replace); i += 2 {
+		doc = strings.Replace(doc, replace[i], replace[i+1], 1)
+	}
+	return []byte(doc)
+}
+
+func TestValidate(t *testing.T) {
+	docType, err := DocumentType(testInvoice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Invoice", docType)
+
+	docType, err = DocumentType(testInvoice("<cbc:ID>TOSL108</cbc:ID>", ""))
+	assert.Error(t, err)
+	assert.Equal(t, "Invoice", docType, "a known document that does not conform keeps its type")
+}
+
+func TestValidateSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     []byte
+		path    string
+		line    int
+		message string
+	}{
+		{
+			name:    "missing element",
+			doc:     testInvoice("<cbc:IssueDate>2009-12-15</cbc:IssueDate>", ""),
+			path:    "/Invoice",
+			line:    6,
+			message: "missing required element cbc:IssueDate",
+		},
+		{
+			name:    "out of order",
+			doc:     testInvoice("  <cbc:InvoiceTypeCode>380</cbc:InvoiceTypeCode>\n  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>", "  <cbc:DocumentCurrencyCode>EUR</cbc:DocumentCurrencyCode>\n  <cbc:InvoiceTypeCode>380</cbc:InvoiceTypeCode>"),
+			path:    "/Invoice/cbc:InvoiceTypeCode",
+			line:    7,
+			message: "element cbc:InvoiceTypeCode is out of order",
+		},
+		{
+			name:    "bad date",
+			doc:     testInvoice("2009-12-15</cbc:IssueDate>", "12/15/2009</cbc:IssueDate>"),
+			path:    "/Invoice/cbc:IssueDate",
+			line:    5,
+			message: `"12/15/2009" is not a valid xsd:date`,
+		},
+		{
+			name:    "bad decimal",
+			doc:     testInvoice(`"EUR">100.00</cbc:PayableAmount>`, `"EUR">1,00</cbc:PayableAmount>`),
+			path:    "/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount",
+			line:    21,
+			message: `"1,00" is not a valid xsd:decimal`,
+		},
+		{
+			name:    "missing currencyID",
+			doc:     testInvoice(`<cbc:PayableAmount currencyID="EUR">`, `<cbc:PayableAmount>`),
+			path:    "/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount",
+			line:    21,
+			message: "missing required attribute currencyID",
+		},
+		{
+			name:    "unknown attribute",
+			doc:     testInvoice(`schemeID="0088"`, `scheme="0088"`),
+			path:    "/Invoice/cac:AccountingSupplierParty/cac:Party/cbc:EndpointID",
+			line:    10,
+			message: "attribute scheme is not allowed",
+		},
+		{
+			name:    "unknown element",
+			doc:     testInvoice("<cac:Item>", "<cbc:Colour>red</cbc:Colour><cac:Item>"),
+			path:    "/Invoice/cac:InvoiceLine[1]/cbc:Colour",
+			line:    27,
+			message: "element cbc:Colour is not allowed here",
+		},
+		{
+			name:    "misplaced aggregate",
+			doc:     testInvoice("<cac:Item>", `<cac:LegalMonetaryTotal><cbc:PayableAmount currencyID="EUR">1</cbc:PayableAmount></cac:LegalMonetaryTotal><cac:Item>`),
+			path:    "/Invoice/cac:InvoiceLine[1]/cac:LegalMonetaryTotal",
+			line:    27,
+			message: "element cac:LegalMonetaryTotal is not allowed here",
+		},
+		{
+			name:    "undeclared aggregate",
+			doc:     testInvoice("  <cac:Delivery>", "  <cac:Colour><cbc:Name>red</cbc:Name></cac:Colour>\n  <cac:Delivery>"),
+			path:    "/Invoice/cac:Colour",
+			line:    19,
+			message: "element cac:Colour is not allowed here",
+		},
+		{
+			name:    "repeated element",
+			doc:     testInvoice("<cbc:ID>TOSL108</cbc:ID>", "<cbc:ID>TOSL108</cbc:ID><cbc:ID>TOSL109</cbc:ID>"),
+			path:    "/Invoice/cbc:ID",
+			line:    4,
+			message: "element cbc:ID occurs more than 1 times",
+		},
+		{
+			name:    "not well-formed",
+			doc:     testInvoice("</cac:Delivery>", "</cac:Deliveries>"),
+			path:    "/",
+			line:    19,
+			message: "document is not well-formed: element <Delivery> closed by </Deliveries>",
+		},
+		{
+			name:    "unknown document",
+			doc:     []byte(`<Catalogue xmlns="urn:oasis:names:specification:ubl:schema:xsd:Catalogue-2"/>`),
+			path:    "/",
+			line:    1,
+			message: "{urn:oasis:names:specification:ubl:schema:xsd:Catalogue-2}Catalogue is not a UBL 2.3 document element",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.doc)
+			schemaErrors, ok := err.(SchemaErrors)
+			if !ok {
+				t.Fatalf("Validate() error = %v, want SchemaErrors", err)
+			}
+			if !assert.Len(t, schemaErrors, 1, schemaErrors.Error()) {
+				return
+			}
+			assert.Equal(t, &SchemaError{Path: tt.path, Line: tt.line, Message: tt.message}, schemaErrors[0])
+		})
+	}
+}
+
+func TestValidateOpenContent(t *testing.T) {
+	// cac:Delivery is not mapped and accepts any content, but the basic
+	// components in it are still checked
+	err := Validate(testInvoice(`<cbc:Quantity unitCode="C62">1`, `<cbc:Quantity unitCode="C62">one`))
+	assert.EqualError(t, err, `/Invoice/cac:Delivery[1]/cbc:Quantity[1] (line 19): "one" is not a valid xsd:decimal`)
+}
+
+func TestGRPCError(t *testing.T) {
+	err := GRPCError(Validate(testInvoice(`<cbc:PayableAmount currencyID="EUR">`, `<cbc:PayableAmount>`)))
+	problems, ok := Problems(err)
+	assert.True(t, ok, "schema problems survive a gRPC status")
+	assert.Equal(t, []common.Problem{{Path: "/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount", Line: 21, Message: "missing required attribute currencyID"}}, problems)
+
+	_, ok = Problems(GRPCError(errors.New("connection refused")))
+	assert.False(t, ok)
+}
+
+func TestSchemaErrorsProblems(t *testing.T) {
+	err := Validate(testInvoice(`<cbc:PayableAmount currencyID="EUR">`, `<cbc:PayableAmount>`))
+	schemaErrors, ok := err.(SchemaErrors)
+	if !ok {
+		t.Fatalf("Validate() error = %v, want SchemaErrors", err)
+	}
+	problems := schemaErrors.Problems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "/Invoice/cac:LegalMonetaryTotal/cbc:PayableAmount", problems[0].Path)
+	assert.Equal(t, 21, problems[0].Line)
+	assert.Equal(t, "missing required attribute currencyID", problems[0].Message)
+}
@@ -0,0 +1,475 @@
+package ublvalidate
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// namespaceXSI - namespace of the xsi:type, xsi:schemaLocation attributes
+const namespaceXSI = "http://www.w3.org/2001/XMLSchema-instance"
+
+// prefixes - conventional prefixes used in the paths of SchemaErrors;
+// document elements are written without one
+var prefixes = map[string]string{
+	"urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2": "cac",
+	"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2":     "cbc",
+	"urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2": "ext",
+}
+
+// validator - collects the SchemaErrors of one document
+type validator struct {
+	set  *schemaSet
+	errs SchemaErrors
+}
+
+func (v *validator) fail(path string, line int, format string, args ...interface{}) {
+	if len(v.errs) < maxErrors {
+		v.errs = append(v.errs, &SchemaError{Path: path, Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// full - whether validation should stop
+func (v *validator) full() bool {
+	return len(v.errs) >= maxErrors
+}
+
+// step - the path step of an element name
+func (v *validator) step(name xml.Name) string {
+	if p, ok := prefixes[name.Space]; ok {
+		return p + ":" + name.Local
+	}
+	return name.Local
+}
+
+// formatName - an element name for messages
+func formatName(name xml.Name) string {
+	if p, ok := prefixes[name.Space]; ok {
+		return p + ":" + name.Local
+	}
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// element - check n against t; a nil t accepts any content
+func (v *validator) element(n *node, t *typeDef, path string) {
+	if t == nil || v.full() {
+		return
+	}
+	v.attributes(n, t, path)
+	if t.simple() {
+		if len(n.children) > 0 {
+			v.fail(path, n.children[0].line, "element %s is not allowed in a simple value", formatName(n.children[0].name))
+			return
+		}
+		if msg := checkValue(t.builtin, &t.facets, n.text.String()); msg != "" {
+			v.fail(path, n.line, "%s", msg)
+		}
+		return
+	}
+	if !t.mixed && strings.TrimSpace(n.text.String()) != "" {
+		v.fail(path, n.line, "text content is not allowed")
+	}
+	if t.content.choice {
+		v.choice(n, t.content, path)
+		return
+	}
+	v.sequence(n, t.content, path)
+}
+
+// attributes - check the attributes of n against those declared by t
+func (v *validator) attributes(n *node, t *typeDef, path string) {
+	seen := map[string]bool{}
+	for _, a := range n.attrs {
+		if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") || a.Name.Space == namespaceXSI {
+			continue
+		}
+		if a.Name.Space != "" {
+			v.fail(path, n.line, "attribute {%s}%s is not allowed", a.Name.Space, a.Name.Local)
+			continue
+		}
+		decl, ok := t.attrs[a.Name.Local]
+		if !ok {
+			v.fail(path, n.line, "attribute %s is not allowed", a.Name.Local)
+			continue
+		}
+		seen[a.Name.Local] = true
+		if decl.typ != nil && decl.typ.simple() {
+			if msg := checkValue(decl.typ.builtin, &decl.typ.facets, a.Value); msg != "" {
+				v.fail(path+"/@"+a.Name.Local, n.line, "%s", msg)
+			}
+		}
+	}
+	for name, decl := range t.attrs {
+		if decl.required && !seen[name] {
+			v.fail(path, n.line, "missing required attribute %s", name)
+		}
+	}
+}
+
+// sequence - match the children of n against the particles of g in order.
+// A child that fits no later particle is reported and skipped, so one
+// misplaced element does not hide the rest of the document.
+func (v *validator) sequence(n *node, g *group, path string) {
+	pi, count := 0, 0
+	counts := map[xml.Name]int{}
+	for _, c := range n.children {
+		if v.full() {
+			return
+		}
+		counts[c.name]++
+		j := -1
+		for k := pi; k < len(g.particles); k++ {
+			p := g.particles[k]
+			if k == pi && p.max >= 0 && count >= p.max {
+				continue
+			}
+			if p.matches(c.name) {
+				j = k
+				break
+			}
+		}
+		if j < 0 {
+			v.misplaced(c, g, pi, count, path, counts[c.name])
+			continue
+		}
+		for k := pi; k < j; k++ {
+			have := 0
+			if k == pi {
+				have = count
+			}
+			v.missing(g.particles[k], have, n, c.line, path)
+		}
+		if j != pi {
+			pi, count = j, 0
+		}
+		count++
+		p := g.particles[pi]
+		v.child(c, p, v.childPath(path, c.name, p.max, counts[c.name]))
+	}
+	if len(n.children) == 0 && g.optional {
+		return
+	}
+	for k := pi; k < len(g.particles); k++ {
+		have := 0
+		if k == pi {
+			have = count
+		}
+		v.missing(g.particles[k], have, n, 0, path)
+	}
+}
+
+// choice - the first child of n selects a branch of g that every child
+// must then match
+func (v *validator) choice(n *node, g *group, path string) {
+	if len(n.children) == 0 {
+		if !g.optional && len(g.particles) > 0 && g.required() {
+			v.fail(path, n.line, "missing one of %s", g.names())
+		}
+		return
+	}
+	var branch *particle
+	count := 0
+	counts := map[xml.Name]int{}
+	for _, c := range n.children {
+		if v.full() {
+			return
+		}
+		counts[c.name]++
+		if branch == nil {
+			for _, p := range g.particles {
+				if p.matches(c.name) {
+					branch = p
+					break
+				}
+			}
+			if branch == nil {
+				v.fail(v.childPath(path, c.name, 1, counts[c.name]), c.line, "element %s is not allowed here, expected one of %s", formatName(c.name), g.names())
+				continue
+			}
+		}
+		if !branch.matches(c.name) {
+			v.fail(v.childPath(path, c.name, 1, counts[c.name]), c.line, "element %s is not allowed here", formatName(c.name))
+			continue
+		}
+		count++
+		if branch.max >= 0 && count > branch.max {
+			v.fail(v.childPath(path, c.name, branch.max, counts[c.name]), c.line, "element %s occurs more than %d times", formatName(c.name), branch.max)
+			continue
+		}
+		v.child(c, branch, v.childPath(path, c.name, branch.max, counts[c.name]))
+	}
+	if branch != nil && count < branch.min {
+		v.fail(path, n.line, "element %s occurs %d times, at least %d required", branch.label(), count, branch.min)
+	}
+}
+
+// misplaced - report a child that fits no particle from pi on
+func (v *validator) misplaced(c *node, g *group, pi int, count int, path string, index int) {
+	for k := 0; k <= pi && k < len(g.particles); k++ {
+		p := g.particles[k]
+		if !p.matches(c.name) {
+			continue
+		}
+		childPath := v.childPath(path, c.name, p.max, index)
+		if k == pi {
+			v.fail(childPath, c.line, "element %s occurs more than %d times", formatName(c.name), p.max)
+		} else {
+			v.fail(childPath, c.line, "element %s is out of order", formatName(c.name))
+		}
+		v.child(c, p, childPath)
+		return
+	}
+	v.fail(v.childPath(path, c.name, 1, index), c.line, "element %s is not allowed here", formatName(c.name))
+}
+
+// missing - report particle p when it occurs fewer than min times
+func (v *validator) missing(p *particle, have int, n *node, line int, path string) {
+	if have >= p.min {
+		return
+	}
+	if line == 0 {
+		line = n.line
+	}
+	if have == 0 {
+		v.fail(path, line, "missing required element %s", p.label())
+		return
+	}
+	v.fail(path, line, "element %s occurs %d times, at least %d required", p.label(), have, p.min)
+}
+
+// child - validate a child matched by p
+func (v *validator) child(c *node, p *particle, path string) {
+	if p.elem != nil {
+		v.element(c, p.elem.typ, path)
+		return
+	}
+	if p.any.processContents == "skip" {
+		return
+	}
+	decl, ok := v.set.elements[qname{c.name.Space, c.name.Local}]
+	if !ok {
+		if p.any.processContents == "strict" {
+			v.fail(path, c.line, "element %s is not declared", formatName(c.name))
+		}
+		return
+	}
+	v.element(c, decl.typ, path)
+}
+
+// childPath - path of a child; repeatable elements get their position
+func (v *validator) childPath(path string, name xml.Name, max int, index int) string {
+	step := path + "/" + v.step(name)
+	if max != 1 {
+		step += "[" + strconv.Itoa(index) + "]"
+	}
+	return step
+}
+
+// matches - whether an element called name fits p
+func (p *particle) matches(name xml.Name) bool {
+	if p.elem != nil {
+		return p.elem.name.space == name.Space && p.elem.name.local == name.Local
+	}
+	for _, ns := range p.any.namespaces {
+		switch ns {
+		case "##any":
+			return true
+		case "##other":
+			if name.Space != p.any.targetNamespace && name.Space != "" {
+				return true
+			}
+		case "##targetNamespace":
+			if name.Space == p.any.targetNamespace {
+				return true
+			}
+		case "##local":
+			if name.Space == "" {
+				return true
+			}
+		default:
+			if name.Space == ns {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// label - the particle in messages
+func (p *particle) label() string {
+	if p.elem != nil {
+		return formatName(xml.Name{Space: p.elem.name.space, Local: p.elem.name.local})
+	}
+	return "element from " + strings.Join(p.any.namespaces, " ")
+}
+
+// required - whether every branch of a choice needs an element
+func (g *group) required() bool {
+	for _, p := range g.particles {
+		if p.min == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// names - the particles of g in messages
+func (g *group) names() string {
+	labels := make([]string, 0, len(g.particles))
+	for _, p := range g.particles {
+		labels = append(labels, p.label())
+	}
+	return strings.Join(labels, ", ")
+}
+
+// builtins - the XML Schema built-in types values are checked against
+var builtins = map[string]bool{
+	"string": true, "normalizedString": true, "token": true, "language": true, "anyURI": true, "NMTOKEN": true,
+	"decimal": true, "integer": true, "long": true, "int": true, "short": true, "byte": true,
+	"nonNegativeInteger": true, "positiveInteger": true, "nonPositiveInteger": true, "negativeInteger": true,
+	"unsignedLong": true, "unsignedInt": true, "unsignedShort": true, "unsignedByte": true,
+	"boolean": true, "date": true, "time": true, "dateTime": true, "base64Binary": true,
+}
+
+func knownBuiltin(name string) bool {
+	return builtins[name]
+}
+
+var (
+	decimalRe  = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	integerRe  = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	languageRe = regexp.MustCompile(`^[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*$`)
+	nmtokenRe  = regexp.MustCompile(`^[\p{L}\p{N}._:-]+$`)
+	timezoneRe = `(Z|[+-][0-9]{2}:[0-9]{2})?`
+	dateRe     = regexp.MustCompile(`^-?[0-9]{4,}-[0-9]{2}-[0-9]{2}` + timezoneRe + `$`)
+	timeRe     = regexp.MustCompile(`^[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?` + timezoneRe + `$`)
+	dateTimeRe = regexp.MustCompile(`^-?[0-9]{4,}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?` + timezoneRe + `$`)
+)
+
+// checkValue - a message describing why value is not a valid builtin
+// restricted by f, "" when it is valid
+func checkValue(builtin string, f *facets, value string) string {
+	if builtin != "string" {
+		value = strings.Join(strings.Fields(value), " ")
+	}
+	if msg := checkBuiltin(builtin, value); msg != "" {
+		return msg
+	}
+	n := utf8.RuneCountInString(value)
+	switch {
+	case f.length > 0 && n != f.length:
+		return fmt.Sprintf("%q must be %d characters long", value, f.length)
+	case f.minLength > 0 && n < f.minLength:
+		return fmt.Sprintf("%q is shorter than %d characters", value, f.minLength)
+	case f.maxLength > 0 && n > f.maxLength:
+		return fmt.Sprintf("%q is longer than %d characters", value, f.maxLength)
+	}
+	for _, re := range f.patterns {
+		if !re.MatchString(value) {
+			return fmt.Sprintf("%q does not match pattern %s", value, strings.TrimSuffix(strings.TrimPrefix(re.String(), "^(?:"), ")$"))
+		}
+	}
+	if f.enumeration != nil {
+		for _, e := range f.enumeration {
+			if e == value {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q is not one of %s", value, strings.Join(f.enumeration, ", "))
+	}
+	return ""
+}
+
+// checkBuiltin - a message describing why value is not a valid builtin,
+// "" when it is valid
+func checkBuiltin(builtin string, value string) string {
+	invalid := fmt.Sprintf("%q is not a valid xsd:%s", value, builtin)
+	switch builtin {
+	case "decimal":
+		if !decimalRe.MatchString(value) {
+			return invalid
+		}
+	case "integer", "long", "int", "short", "byte", "nonNegativeInteger", "positiveInteger", "nonPositiveInteger",
+		"negativeInteger", "unsignedLong", "unsignedInt", "unsignedShort", "unsignedByte":
+		if !integerRe.MatchString(value) {
+			return invalid
+		}
+		return checkInteger(builtin, value, invalid)
+	case "boolean":
+		switch value {
+		case "true", "false", "1", "0":
+		default:
+			return invalid
+		}
+	case "date":
+		if !dateRe.MatchString(value) {
+			return invalid
+		}
+		if _, err := time.Parse("2006-01-02", value[:10]); err != nil && !strings.HasPrefix(value, "-") {
+			return invalid
+		}
+	case "time":
+		if !timeRe.MatchString(value) {
+			return invalid
+		}
+		if _, err := time.Parse("15:04:05", value[:8]); err != nil {
+			return invalid
+		}
+	case "dateTime":
+		if !dateTimeRe.MatchString(value) {
+			return invalid
+		}
+		if _, err := time.Parse("2006-01-02T15:04:05", value[:19]); err != nil && !strings.HasPrefix(value, "-") {
+			return invalid
+		}
+	case "base64Binary":
+		if _, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(value, " ", "")); err != nil {
+			return invalid
+		}
+	case "language":
+		if !languageRe.MatchString(value) {
+			return invalid
+		}
+	case "NMTOKEN":
+		if !nmtokenRe.MatchString(value) {
+			return invalid
+		}
+	}
+	return ""
+}
+
+// checkInteger - range checks of the integer builtins
+func checkInteger(builtin string, value string, invalid string) string {
+	bits := map[string]int{"long": 64, "int": 32, "short": 16, "byte": 8,
+		"unsignedLong": 64, "unsignedInt": 32, "unsignedShort": 16, "unsignedByte": 8}
+	switch builtin {
+	case "long", "int", "short", "byte":
+		if _, err := strconv.ParseInt(value, 10, bits[builtin]); err != nil {
+			return invalid
+		}
+	case "unsignedLong", "unsignedInt", "unsignedShort", "unsignedByte":
+		if _, err := strconv.ParseUint(strings.TrimPrefix(value, "+"), 10, bits[builtin]); err != nil {
+			return invalid
+		}
+	case "nonNegativeInteger", "positiveInteger", "nonPositiveInteger", "negativeInteger":
+		digits := strings.TrimLeft(strings.TrimLeft(value, "+-"), "0")
+		negative := strings.HasPrefix(value, "-") && digits != ""
+		zero := digits == ""
+		switch {
+		case builtin == "nonNegativeInteger" && negative,
+			builtin == "positiveInteger" && (negative || zero),
+			builtin == "nonPositiveInteger" && !negative && !zero,
+			builtin == "negativeInteger" && !negative:
+			return invalid
+		}
+	}
+	return ""
+}
@@ -53,10 +53,15 @@ func StartInvoiceWorker(log *zap.Logger, isTest bool, pwd string, grpcServerOpt
 
 	h.RegisterWorkflow(invoiceworkflows.CreateCreditNoteHeaderWorkflow)
 	h.RegisterWorkflow(invoiceworkflows.UpdateCreditNoteHeaderWorkflow)
+	h.RegisterWorkflow(invoiceworkflows.ImportCreditNoteUBLWorkflow)
+	h.RegisterWorkflow(invoiceworkflows.ImportCreditNoteCIIWorkflow)
 	h.RegisterWorkflow(invoiceworkflows.CreateDebitNoteHeaderWorkflow)
 	h.RegisterWorkflow(invoiceworkflows.UpdateDebitNoteHeaderWorkflow)
+	h.RegisterWorkflow(invoiceworkflows.ImportDebitNoteUBLWorkflow)
 	h.RegisterWorkflow(invoiceworkflows.CreateInvoiceWorkflow)
 	h.RegisterWorkflow(invoiceworkflows.UpdateInvoiceWorkflow)
+	h.RegisterWorkflow(invoiceworkflows.ImportInvoiceUBLWorkflow)
+	h.RegisterWorkflow(invoiceworkflows.ImportInvoiceCIIWorkflow)
 	h.RegisterActivity(creditNoteHeaderActivities)
 	h.RegisterActivity(debitNoteHeaderActivities)
 	h.RegisterActivity(invoiceActivities)
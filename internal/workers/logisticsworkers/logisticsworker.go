@@ -56,8 +56,10 @@ func StartLogisticsWorker(log *zap.Logger, isTest bool, pwd string, grpcServerOp
 
 	h.RegisterWorkflow(logisticsworkflows.CreateReceiptAdviceHeaderWorkflow)
 	h.RegisterWorkflow(logisticsworkflows.UpdateReceiptAdviceHeaderWorkflow)
+	h.RegisterWorkflow(logisticsworkflows.ImportReceiptAdviceUBLWorkflow)
 	h.RegisterWorkflow(logisticsworkflows.CreateDespatchHeaderWorkflow)
 	h.RegisterWorkflow(logisticsworkflows.UpdateDespatchHeaderWorkflow)
+	h.RegisterWorkflow(logisticsworkflows.ImportDespatchUBLWorkflow)
 	h.RegisterWorkflow(logisticsworkflows.CreateConsignmentWorkflow)
 	h.RegisterWorkflow(logisticsworkflows.CreateShipmentWorkflow)
 	h.RegisterActivity(receiptAdviceHeaderActivities)
@@ -47,6 +47,9 @@ func StartOrderWorker(log *zap.Logger, isTest bool, pwd string, grpcServerOpt *c
 
 	h.RegisterWorkflow(orderworkflows.CreatePurchaseOrderHeaderWorkflow)
 	h.RegisterWorkflow(orderworkflows.UpdatePurchaseOrderHeaderWorkflow)
+	h.RegisterWorkflow(orderworkflows.ImportPurchaseOrderUBLWorkflow)
+	h.RegisterWorkflow(orderworkflows.ImportOrderResponseUBLWorkflow)
+	h.RegisterWorkflow(orderworkflows.ImportOrderChangeUBLWorkflow)
 	h.RegisterActivity(purchaseOrderHeaderActivities)
 
 	startWorkers(&h)
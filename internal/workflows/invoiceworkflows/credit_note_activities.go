@@ -38,3 +38,29 @@ func (ca *CreditNoteHeaderActivities) UpdateCreditNoteHeaderActivity(ctx context
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportCreditNoteUBLActivity - Import CreditNote UBL activity
+func (ca *CreditNoteHeaderActivities) ImportCreditNoteUBLActivity(ctx context.Context, form *invoiceproto.ImportCreditNoteUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportCreditNoteUBLResponse, error) {
+	creditNoteHeaderServiceClient := ca.CreditNoteHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	creditNote, err := creditNoteHeaderServiceClient.ImportCreditNoteUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return creditNote, nil
+}
+
+// ImportCreditNoteCIIActivity - Import CreditNote CII activity
+func (ca *CreditNoteHeaderActivities) ImportCreditNoteCIIActivity(ctx context.Context, form *invoiceproto.ImportCreditNoteCIIRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportCreditNoteCIIResponse, error) {
+	creditNoteHeaderServiceClient := ca.CreditNoteHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	creditNote, err := creditNoteHeaderServiceClient.ImportCreditNoteCII(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return creditNote, nil
+}
@@ -54,3 +54,43 @@ func UpdateCreditNoteHeaderWorkflow(ctx workflow.Context, form *invoiceproto.Upd
 	}
 	return resp, nil
 }
+
+// ImportCreditNoteUBLWorkflow - Import CreditNote UBL workflow
+func ImportCreditNoteUBLWorkflow(ctx workflow.Context, form *invoiceproto.ImportCreditNoteUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportCreditNoteUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var ca *CreditNoteHeaderActivities
+	var creditNote invoiceproto.ImportCreditNoteUBLResponse
+	err := workflow.ExecuteActivity(ctx, ca.ImportCreditNoteUBLActivity, form, tokenString, user, log).Get(ctx, &creditNote)
+	if err != nil {
+		logger.Error("Failed to ImportCreditNoteUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &creditNote, nil
+}
+
+// ImportCreditNoteCIIWorkflow - Import CreditNote CII workflow
+func ImportCreditNoteCIIWorkflow(ctx workflow.Context, form *invoiceproto.ImportCreditNoteCIIRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportCreditNoteCIIResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var ca *CreditNoteHeaderActivities
+	var creditNote invoiceproto.ImportCreditNoteCIIResponse
+	err := workflow.ExecuteActivity(ctx, ca.ImportCreditNoteCIIActivity, form, tokenString, user, log).Get(ctx, &creditNote)
+	if err != nil {
+		logger.Error("Failed to ImportCreditNoteCIIWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &creditNote, nil
+}
@@ -38,3 +38,16 @@ func (da *DebitNoteHeaderActivities) UpdateDebitNoteHeaderActivity(ctx context.C
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportDebitNoteUBLActivity - Import DebitNote UBL activity
+func (da *DebitNoteHeaderActivities) ImportDebitNoteUBLActivity(ctx context.Context, form *invoiceproto.ImportDebitNoteUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportDebitNoteUBLResponse, error) {
+	debitNoteHeaderServiceClient := da.DebitNoteHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	debitNote, err := debitNoteHeaderServiceClient.ImportDebitNoteUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return debitNote, nil
+}
@@ -49,3 +49,23 @@ func UpdateDebitNoteHeaderWorkflow(ctx workflow.Context, form *invoiceproto.Upda
 	}
 	return resp, nil
 }
+
+// ImportDebitNoteUBLWorkflow - Import DebitNote UBL workflow
+func ImportDebitNoteUBLWorkflow(ctx workflow.Context, form *invoiceproto.ImportDebitNoteUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportDebitNoteUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var da *DebitNoteHeaderActivities
+	var debitNote invoiceproto.ImportDebitNoteUBLResponse
+	err := workflow.ExecuteActivity(ctx, da.ImportDebitNoteUBLActivity, form, tokenString, user, log).Get(ctx, &debitNote)
+	if err != nil {
+		logger.Error("Failed to ImportDebitNoteUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &debitNote, nil
+}
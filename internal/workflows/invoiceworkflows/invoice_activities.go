@@ -38,3 +38,29 @@ func (ia *InvoiceActivities) UpdateInvoiceActivity(ctx context.Context, form *in
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportInvoiceUBLActivity - Import Invoice UBL activity
+func (ia *InvoiceActivities) ImportInvoiceUBLActivity(ctx context.Context, form *invoiceproto.ImportInvoiceUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportInvoiceUBLResponse, error) {
+	invoiceServiceClient := ia.InvoiceServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	invoice, err := invoiceServiceClient.ImportInvoiceUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// ImportInvoiceCIIActivity - Import Invoice CII activity
+func (ia *InvoiceActivities) ImportInvoiceCIIActivity(ctx context.Context, form *invoiceproto.ImportInvoiceCIIRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportInvoiceCIIResponse, error) {
+	invoiceServiceClient := ia.InvoiceServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	invoice, err := invoiceServiceClient.ImportInvoiceCII(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return invoice, nil
+}
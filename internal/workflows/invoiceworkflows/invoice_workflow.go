@@ -49,3 +49,43 @@ func UpdateInvoiceWorkflow(ctx workflow.Context, form *invoiceproto.UpdateInvoic
 	}
 	return resp, nil
 }
+
+// ImportInvoiceUBLWorkflow - Import Invoice UBL workflow
+func ImportInvoiceUBLWorkflow(ctx workflow.Context, form *invoiceproto.ImportInvoiceUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportInvoiceUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var ia *InvoiceActivities
+	var invoice invoiceproto.ImportInvoiceUBLResponse
+	err := workflow.ExecuteActivity(ctx, ia.ImportInvoiceUBLActivity, form, tokenString, user, log).Get(ctx, &invoice)
+	if err != nil {
+		logger.Error("Failed to ImportInvoiceUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// ImportInvoiceCIIWorkflow - Import Invoice CII workflow
+func ImportInvoiceCIIWorkflow(ctx workflow.Context, form *invoiceproto.ImportInvoiceCIIRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*invoiceproto.ImportInvoiceCIIResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var ia *InvoiceActivities
+	var invoice invoiceproto.ImportInvoiceCIIResponse
+	err := workflow.ExecuteActivity(ctx, ia.ImportInvoiceCIIActivity, form, tokenString, user, log).Get(ctx, &invoice)
+	if err != nil {
+		logger.Error("Failed to ImportInvoiceCIIWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &invoice, nil
+}
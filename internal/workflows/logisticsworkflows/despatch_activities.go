@@ -38,3 +38,16 @@ func (dh *DespatchHeaderActivities) UpdateDespatchHeaderActivity(ctx context.Con
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportDespatchUBLActivity - Import Despatch UBL activity
+func (dh *DespatchHeaderActivities) ImportDespatchUBLActivity(ctx context.Context, form *logisticsproto.ImportDespatchUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*logisticsproto.ImportDespatchUBLResponse, error) {
+	despatchHeaderServiceClient := dh.DespatchHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	despatchHeader, err := despatchHeaderServiceClient.ImportDespatchUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return despatchHeader, nil
+}
@@ -49,3 +49,23 @@ func UpdateDespatchHeaderWorkflow(ctx workflow.Context, form *logisticsproto.Upd
 	}
 	return resp, nil
 }
+
+// ImportDespatchUBLWorkflow - Import Despatch UBL workflow
+func ImportDespatchUBLWorkflow(ctx workflow.Context, form *logisticsproto.ImportDespatchUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*logisticsproto.ImportDespatchUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var dh *DespatchHeaderActivities
+	var despatchHeader logisticsproto.ImportDespatchUBLResponse
+	err := workflow.ExecuteActivity(ctx, dh.ImportDespatchUBLActivity, form, tokenString, user, log).Get(ctx, &despatchHeader)
+	if err != nil {
+		logger.Error("Failed to ImportDespatchUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &despatchHeader, nil
+}
@@ -38,3 +38,16 @@ func (rah *ReceiptAdviceHeaderActivities) UpdateReceiptAdviceHeaderActivity(ctx
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportReceiptAdviceUBLActivity - Import ReceiptAdvice UBL activity
+func (rah *ReceiptAdviceHeaderActivities) ImportReceiptAdviceUBLActivity(ctx context.Context, form *logisticsproto.ImportReceiptAdviceUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*logisticsproto.ImportReceiptAdviceUBLResponse, error) {
+	receiptAdviceHeaderServiceClient := rah.ReceiptAdviceHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	receiptAdviceHeader, err := receiptAdviceHeaderServiceClient.ImportReceiptAdviceUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return receiptAdviceHeader, nil
+}
@@ -54,3 +54,23 @@ func UpdateReceiptAdviceHeaderWorkflow(ctx workflow.Context, form *logisticsprot
 	}
 	return resp, nil
 }
+
+// ImportReceiptAdviceUBLWorkflow - Import ReceiptAdvice UBL workflow
+func ImportReceiptAdviceUBLWorkflow(ctx workflow.Context, form *logisticsproto.ImportReceiptAdviceUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*logisticsproto.ImportReceiptAdviceUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var rah *ReceiptAdviceHeaderActivities
+	var receiptAdviceHeader logisticsproto.ImportReceiptAdviceUBLResponse
+	err := workflow.ExecuteActivity(ctx, rah.ImportReceiptAdviceUBLActivity, form, tokenString, user, log).Get(ctx, &receiptAdviceHeader)
+	if err != nil {
+		logger.Error("Failed to ImportReceiptAdviceUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &receiptAdviceHeader, nil
+}
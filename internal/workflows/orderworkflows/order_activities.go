@@ -38,3 +38,42 @@ func (pc *PurchaseOrderHeaderActivities) UpdatePurchaseOrderHeaderActivity(ctx c
 	}
 	return "Updated Successfully", nil
 }
+
+// ImportPurchaseOrderUBLActivity - Import PurchaseOrder UBL activity
+func (pc *PurchaseOrderHeaderActivities) ImportPurchaseOrderUBLActivity(ctx context.Context, form *orderproto.ImportPurchaseOrderUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportPurchaseOrderUBLResponse, error) {
+	purchaseOrderHeaderServiceClient := pc.PurchaseOrderHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	purchaseOrderHeader, err := purchaseOrderHeaderServiceClient.ImportPurchaseOrderUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return purchaseOrderHeader, nil
+}
+
+// ImportOrderResponseUBLActivity - Import OrderResponse UBL activity
+func (pc *PurchaseOrderHeaderActivities) ImportOrderResponseUBLActivity(ctx context.Context, form *orderproto.ImportOrderResponseUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportOrderResponseUBLResponse, error) {
+	purchaseOrderHeaderServiceClient := pc.PurchaseOrderHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	purchaseOrder, err := purchaseOrderHeaderServiceClient.ImportOrderResponseUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return purchaseOrder, nil
+}
+
+// ImportOrderChangeUBLActivity - Import OrderChange UBL activity
+func (pc *PurchaseOrderHeaderActivities) ImportOrderChangeUBLActivity(ctx context.Context, form *orderproto.ImportOrderChangeUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportOrderChangeUBLResponse, error) {
+	purchaseOrderHeaderServiceClient := pc.PurchaseOrderHeaderServiceClient
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	ctxNew := metadata.NewOutgoingContext(ctx, md)
+	purchaseOrder, err := purchaseOrderHeaderServiceClient.ImportOrderChangeUBL(ctxNew, form)
+	if err != nil {
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return purchaseOrder, nil
+}
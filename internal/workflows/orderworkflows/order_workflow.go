@@ -54,3 +54,63 @@ func UpdatePurchaseOrderHeaderWorkflow(ctx workflow.Context, form *orderproto.Up
 	}
 	return resp, nil
 }
+
+// ImportPurchaseOrderUBLWorkflow - Import PurchaseOrder UBL workflow
+func ImportPurchaseOrderUBLWorkflow(ctx workflow.Context, form *orderproto.ImportPurchaseOrderUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportPurchaseOrderUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var pc *PurchaseOrderHeaderActivities
+	var purchaseOrderHeader orderproto.ImportPurchaseOrderUBLResponse
+	err := workflow.ExecuteActivity(ctx, pc.ImportPurchaseOrderUBLActivity, form, tokenString, user, log).Get(ctx, &purchaseOrderHeader)
+	if err != nil {
+		logger.Error("Failed to ImportPurchaseOrderUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &purchaseOrderHeader, nil
+}
+
+// ImportOrderResponseUBLWorkflow - Import OrderResponse UBL workflow
+func ImportOrderResponseUBLWorkflow(ctx workflow.Context, form *orderproto.ImportOrderResponseUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportOrderResponseUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var pc *PurchaseOrderHeaderActivities
+	var purchaseOrder orderproto.ImportOrderResponseUBLResponse
+	err := workflow.ExecuteActivity(ctx, pc.ImportOrderResponseUBLActivity, form, tokenString, user, log).Get(ctx, &purchaseOrder)
+	if err != nil {
+		logger.Error("Failed to ImportOrderResponseUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &purchaseOrder, nil
+}
+
+// ImportOrderChangeUBLWorkflow - Import OrderChange UBL workflow
+func ImportOrderChangeUBLWorkflow(ctx workflow.Context, form *orderproto.ImportOrderChangeUBLRequest, tokenString string, user *partyproto.GetAuthUserDetailsResponse, log *zap.Logger) (*orderproto.ImportOrderChangeUBLResponse, error) {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		HeartbeatTimeout:       time.Second * 20,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	logger := workflow.GetLogger(ctx)
+	var pc *PurchaseOrderHeaderActivities
+	var purchaseOrder orderproto.ImportOrderChangeUBLResponse
+	err := workflow.ExecuteActivity(ctx, pc.ImportOrderChangeUBLActivity, form, tokenString, user, log).Get(ctx, &purchaseOrder)
+	if err != nil {
+		logger.Error("Failed to ImportOrderChangeUBLWorkflow", zap.Error(err))
+		log.Error("Error", zap.String("user", user.Email), zap.String("reqid", user.RequestId), zap.Error(err))
+		return nil, err
+	}
+	return &purchaseOrder, nil
+}
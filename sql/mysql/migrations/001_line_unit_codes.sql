@@ -0,0 +1,20 @@
+-- The unit of measure of the quantity of a line, the unitCode of
+-- cbc:InvoicedQuantity, cbc:CreditedQuantity, cbc:DebitedQuantity and the
+-- cbc:Quantity of an order line, a UN/ECE Recommendation 20 code such as
+-- C62 (one), HUR (hour) or KGM (kilogram).
+--
+-- Existing lines get C62, the unit of what is counted in pieces.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/001_line_unit_codes.sql
+
+ALTER TABLE `invoice_lines`
+  ADD `unit_code` varchar(3) DEFAULT 'C62' AFTER `invoiced_quantity`;
+
+ALTER TABLE `credit_note_lines`
+  ADD `unit_code` varchar(3) DEFAULT 'C62' AFTER `credited_quantity`;
+
+ALTER TABLE `debit_note_lines`
+  ADD `unit_code` varchar(3) DEFAULT 'C62' AFTER `debited_quantity`;
+
+ALTER TABLE `purchase_order_lines`
+  ADD `unit_code` varchar(3) DEFAULT 'C62' AFTER `quantity`;
@@ -0,0 +1,249 @@
+-- Amounts of invoices, credit notes, debit notes, purchase orders, payments
+-- and taxes as exact decimals instead of double. Totals keep 4 decimal
+-- places, unit prices and per unit tax amounts 6. Existing values are
+-- rounded to that scale, which drops the float noise of earlier sums such
+-- as 1591.2499999999998.
+--
+-- The currency code of every amount. A row that holds amounts has the
+-- currency code of its amounts, instead of taking it from its document: the
+-- lines, allowances and charges, tax totals and prepayments of a document
+-- get the document currency, the tax sub totals the currency of their tax
+-- total and, for their transaction currency tax amount, the tax currency of
+-- the document. The payable alternative amount of a document has a currency
+-- code of its own.
+--
+-- Existing rows get the currency code of their document. Tax categories,
+-- payments and payment mandates that belong to no document keep an empty
+-- currency code until they are updated. Invoice prepayments, added by
+-- 007_withholding_taxes_prepayments.sql, have a currency code from the
+-- start.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/002_decimal_amounts.sql
+
+ALTER TABLE `credit_note_headers`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `credit_note_lines`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `price_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `debit_note_headers`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `debit_note_lines`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `price_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `invoice_headers`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `invoice_lines`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `price_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `payment_mandates`
+  MODIFY `maximum_paid_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `payment_terms`
+  MODIFY `amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `settlement_discount_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `penalty_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `payments`
+  MODIFY `paid_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `purchase_order_headers`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `purchase_order_lines`
+  MODIFY `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `total_tax_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `price_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `tax_categories`
+  MODIFY `per_unit_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `tax_sub_totals`
+  MODIFY `taxable_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `tax_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `transaction_currency_tax_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `per_unit_amount` decimal(19,6) DEFAULT 0.000000;
+
+ALTER TABLE `tax_totals`
+  MODIFY `tax_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `rounding_amount` decimal(19,4) DEFAULT 0.0000;
+
+ALTER TABLE `invoice_headers`
+  ADD `payable_alternative_currency_code` varchar(20) DEFAULT '' AFTER `payable_alternative_amount`;
+
+ALTER TABLE `credit_note_headers`
+  ADD `payable_alternative_currency_code` varchar(20) DEFAULT '' AFTER `payable_alternative_amount`;
+
+ALTER TABLE `debit_note_headers`
+  ADD `payable_alternative_currency_code` varchar(20) DEFAULT '' AFTER `payable_alternative_amount`;
+
+ALTER TABLE `purchase_order_headers`
+  ADD `payable_alternative_currency_code` varchar(20) DEFAULT '' AFTER `payable_alternative_amount`;
+
+ALTER TABLE `invoice_lines`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `price_amount`;
+
+ALTER TABLE `credit_note_lines`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `price_amount`;
+
+ALTER TABLE `debit_note_lines`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `price_amount`;
+
+ALTER TABLE `purchase_order_lines`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `price_amount`;
+
+ALTER TABLE `allowance_charges`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `per_unit_amount`;
+
+ALTER TABLE `tax_sub_totals`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `per_unit_amount`,
+  ADD `transaction_currency_code` varchar(3) DEFAULT '' AFTER `currency_code`;
+
+ALTER TABLE `tax_categories`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `per_unit_amount`;
+
+ALTER TABLE `payments`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `paid_amount`;
+
+ALTER TABLE `payment_terms`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `penalty_amount`;
+
+ALTER TABLE `payment_mandates`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `maximum_paid_amount`;
+
+UPDATE `invoice_headers` SET `payable_alternative_currency_code` = `payment_alt_currency_code`
+  WHERE `payable_alternative_amount` <> 0;
+
+UPDATE `credit_note_headers` SET `payable_alternative_currency_code` = `payment_alt_currency_code`
+  WHERE `payable_alternative_amount` <> 0;
+
+UPDATE `debit_note_headers` SET `payable_alternative_currency_code` = `payment_alt_currency_code`
+  WHERE `payable_alternative_amount` <> 0;
+
+UPDATE `purchase_order_lines` l JOIN `purchase_order_headers` h ON h.`id` = l.`purchase_order_header_id`
+  SET l.`currency_code` = h.`document_currency_code`;
+
+UPDATE `invoice_lines` l JOIN `invoice_headers` h ON h.`id` = l.`invoice_header_id`
+  SET l.`currency_code` = h.`document_currency_code`;
+
+UPDATE `credit_note_lines` l JOIN `credit_note_headers` h ON h.`id` = l.`credit_note_header_id`
+  SET l.`currency_code` = h.`document_currency_code`;
+
+UPDATE `debit_note_lines` l JOIN `debit_note_headers` h ON h.`id` = l.`debit_note_header_id`
+  SET l.`currency_code` = h.`document_currency_code`;
+
+UPDATE `allowance_charges` a JOIN `invoice_headers` h ON a.`master_flag` = 'IH' AND h.`id` = a.`master_id`
+  SET a.`currency_code` = h.`document_currency_code`;
+
+UPDATE `allowance_charges` a JOIN `invoice_lines` l ON a.`master_flag` = 'IL' AND l.`id` = a.`master_id`
+  SET a.`currency_code` = l.`currency_code`;
+
+UPDATE `allowance_charges` a JOIN `credit_note_headers` h ON a.`master_flag` = 'CNH' AND h.`id` = a.`master_id`
+  SET a.`currency_code` = h.`document_currency_code`;
+
+UPDATE `allowance_charges` a JOIN `credit_note_lines` l ON a.`master_flag` = 'CNL' AND l.`id` = a.`master_id`
+  SET a.`currency_code` = l.`currency_code`;
+
+UPDATE `allowance_charges` a JOIN `debit_note_headers` h ON a.`master_flag` = 'DNH' AND h.`id` = a.`master_id`
+  SET a.`currency_code` = h.`document_currency_code`;
+
+UPDATE `allowance_charges` a JOIN `debit_note_lines` l ON a.`master_flag` = 'DNL' AND l.`id` = a.`master_id`
+  SET a.`currency_code` = l.`currency_code`;
+
+UPDATE `allowance_charges` a JOIN `purchase_order_headers` h ON a.`master_flag` = 'POH' AND h.`id` = a.`master_id`
+  SET a.`currency_code` = h.`document_currency_code`;
+
+UPDATE `allowance_charges` a JOIN `purchase_order_lines` l ON a.`master_flag` = 'POL' AND l.`id` = a.`master_id`
+  SET a.`currency_code` = l.`currency_code`;
+
+UPDATE `tax_totals` t JOIN `invoice_headers` h ON t.`master_flag` = 'IH' AND h.`id` = t.`master_id`
+  SET t.`currency_code` = h.`document_currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `credit_note_headers` h ON t.`master_flag` = 'CNH' AND h.`id` = t.`master_id`
+  SET t.`currency_code` = h.`document_currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `debit_note_headers` h ON t.`master_flag` = 'DNH' AND h.`id` = t.`master_id`
+  SET t.`currency_code` = h.`document_currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `purchase_order_headers` h ON t.`master_flag` = 'POH' AND h.`id` = t.`master_id`
+  SET t.`currency_code` = h.`document_currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `invoice_lines` l ON t.`master_flag` = 'IL' AND l.`id` = t.`master_id`
+  SET t.`currency_code` = l.`currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `credit_note_lines` l ON t.`master_flag` = 'CNL' AND l.`id` = t.`master_id`
+  SET t.`currency_code` = l.`currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `debit_note_lines` l ON t.`master_flag` = 'DNL' AND l.`id` = t.`master_id`
+  SET t.`currency_code` = l.`currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_totals` t JOIN `purchase_order_lines` l ON t.`master_flag` = 'POL' AND l.`id` = t.`master_id`
+  SET t.`currency_code` = l.`currency_code` WHERE t.`currency_code` = '';
+
+UPDATE `tax_sub_totals` s JOIN `tax_totals` t ON t.`id` = s.`tax_total_id`
+  SET s.`currency_code` = t.`currency_code`;
+
+UPDATE `tax_sub_totals` s JOIN `tax_totals` t ON t.`id` = s.`tax_total_id`
+  JOIN `invoice_headers` h ON t.`master_flag` = 'IH' AND h.`id` = t.`master_id`
+  SET s.`transaction_currency_code` = h.`tax_currency_code` WHERE s.`transaction_currency_tax_amount` <> 0;
+
+UPDATE `tax_sub_totals` s JOIN `tax_totals` t ON t.`id` = s.`tax_total_id`
+  JOIN `credit_note_headers` h ON t.`master_flag` = 'CNH' AND h.`id` = t.`master_id`
+  SET s.`transaction_currency_code` = h.`tax_currency_code` WHERE s.`transaction_currency_tax_amount` <> 0;
+
+UPDATE `tax_sub_totals` s JOIN `tax_totals` t ON t.`id` = s.`tax_total_id`
+  JOIN `debit_note_headers` h ON t.`master_flag` = 'DNH' AND h.`id` = t.`master_id`
+  SET s.`transaction_currency_code` = h.`tax_currency_code` WHERE s.`transaction_currency_tax_amount` <> 0;
+
+UPDATE `payment_terms` p JOIN `invoice_headers` h ON p.`master_flag` = 'IH' AND h.`id` = p.`master_id`
+  SET p.`currency_code` = h.`document_currency_code`;
+
+UPDATE `payment_terms` p JOIN `credit_note_headers` h ON p.`master_flag` = 'CNH' AND h.`id` = p.`master_id`
+  SET p.`currency_code` = h.`document_currency_code`;
+
+UPDATE `payment_terms` p JOIN `debit_note_headers` h ON p.`master_flag` = 'DNH' AND h.`id` = p.`master_id`
+  SET p.`currency_code` = h.`document_currency_code`;
+
+UPDATE `payment_terms` p JOIN `purchase_order_headers` h ON p.`master_flag` = 'POH' AND h.`id` = p.`master_id`
+  SET p.`currency_code` = h.`document_currency_code`;
@@ -0,0 +1,15 @@
+-- The calculation sequence of tax categories, so compound taxes such as VAT
+-- on an excise duty apply in order, and the further tax categories of items
+-- beside items.tax_category_id.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/003_tax_calculation.sql
+
+ALTER TABLE `tax_categories`
+  ADD `calculation_sequence_numeric` int(10) unsigned DEFAULT 0 AFTER `tax_scheme_id`;
+
+CREATE TABLE `item_tax_categories` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `item_id` int(10) unsigned DEFAULT 0,
+  `tax_category_id` int(10) unsigned DEFAULT 0,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,18 @@
+-- Allowances and charges of invoices, credit notes, debit notes and
+-- purchase orders, at document and line level, with exact decimal amounts.
+-- multiplier_factor_numeric holds the percentage of the base amount, such
+-- as 2.5, so it is no longer a whole number.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/004_allowance_charges.sql
+
+ALTER TABLE `allowance_charges`
+  ADD `uuid4` binary(16) DEFAULT NULL AFTER `id`,
+  MODIFY `multiplier_factor_numeric` double DEFAULT 0,
+  MODIFY `amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `base_amount` decimal(19,4) DEFAULT 0.0000,
+  MODIFY `per_unit_amount` decimal(19,6) DEFAULT 0.000000,
+  ADD `status_code` varchar(50) DEFAULT 'active',
+  ADD `created_by_user_id` varchar(50) DEFAULT 'active',
+  ADD `updated_by_user_id` varchar(50) DEFAULT 'active',
+  ADD `created_at` datetime DEFAULT current_timestamp(),
+  ADD `updated_at` datetime DEFAULT current_timestamp();
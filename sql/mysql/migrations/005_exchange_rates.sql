@@ -0,0 +1,22 @@
+-- Exchange rates from a source to a target currency in an exchange market
+-- on a date, loaded from the reference rates of a central bank, from which
+-- the exchange rates of invoices and purchase orders are filled.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/005_exchange_rates.sql
+
+CREATE TABLE `exchange_rates` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `source_currency_code` varchar(20) DEFAULT '',
+  `target_currency_code` varchar(20) DEFAULT '',
+  `exchange_market_id` int(10) unsigned DEFAULT 0,
+  `calculation_rate` double DEFAULT 0,
+  `rate_date` datetime DEFAULT current_timestamp(),
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `exchange_rates_currencies_market_date` (`source_currency_code`,`target_currency_code`,`exchange_market_id`,`rate_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+-- The currency of a tax total. A document with a tax currency code other
+-- than its document currency code has a second tax total, without tax sub
+-- totals, with the total tax amount in the tax currency (EN 16931 BT-111).
+-- The tax total in the document currency has no currency code.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/006_tax_currency_tax_totals.sql
+
+ALTER TABLE `tax_totals`
+  ADD `currency_code` varchar(3) DEFAULT '' AFTER `tax_category_id`;
@@ -0,0 +1,30 @@
+-- Withholding taxes and the settlement of advance payment invoices. A tax
+-- category with the withholding indicator is withheld by the buyer, its tax
+-- is kept in a tax total of its own that reduces the payable amount of a
+-- document instead of adding to its tax inclusive amount. An invoice
+-- prepayment records the part of the payable amount of an advance payment
+-- invoice that an invoice settles as its prepaid amount.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/007_withholding_taxes_prepayments.sql
+
+ALTER TABLE `tax_categories`
+  ADD `withholding_indicator` tinyint(1) DEFAULT 0 AFTER `calculation_sequence_numeric`;
+
+ALTER TABLE `tax_totals`
+  ADD `withholding_indicator` tinyint(1) DEFAULT 0 AFTER `currency_code`;
+
+CREATE TABLE `invoice_prepayments` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `invoice_header_id` int(10) unsigned DEFAULT 0,
+  `prepayment_invoice_header_id` int(10) unsigned DEFAULT 0,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `currency_code` varchar(3) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `invoice_prepayments_prepayment_invoice_header_id` (`prepayment_invoice_header_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,8 @@
+-- Where the tax of the tax categories of a tax scheme is rounded: on the
+-- taxable amount of all lines of a document once, or on each line with the
+-- rounded line taxes summed up. Existing tax schemes round per document.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/008_tax_scheme_rounding_level.sql
+
+ALTER TABLE `tax_schemes`
+  ADD `rounding_level` varchar(20) DEFAULT 'document' AFTER `currency_code`;
@@ -0,0 +1,44 @@
+-- The lifecycle of invoices, credit notes and debit notes: draft, issued,
+-- sent, acknowledged, partially paid and paid, or disputed and cancelled.
+-- Only a draft can be changed. Each transition is kept in
+-- document_status_transitions. Documents stored before are taken as issued,
+-- they may have been sent.
+--
+-- document_status_code is a new column rather than new values of
+-- status_code, since status_code is the record status every table has:
+-- 'active' until the row is deleted, and what the reads, the lists and the
+-- unique numbers of documents go by. A cancelled document is still a
+-- record, its status_code stays 'active', so it is still listed and its
+-- number stays used (numbering.IsUsed) rather than given out again.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/009_document_status.sql
+
+ALTER TABLE `invoice_headers`
+  ADD `document_status_code` varchar(50) DEFAULT 'draft' AFTER `payment_alt_ex_date`;
+
+ALTER TABLE `credit_note_headers`
+  ADD `document_status_code` varchar(50) DEFAULT 'draft' AFTER `payment_alt_ex_date`;
+
+ALTER TABLE `debit_note_headers`
+  ADD `document_status_code` varchar(50) DEFAULT 'draft' AFTER `payment_alt_ex_date`;
+
+UPDATE `invoice_headers` SET `document_status_code` = 'issued';
+UPDATE `credit_note_headers` SET `document_status_code` = 'issued';
+UPDATE `debit_note_headers` SET `document_status_code` = 'issued';
+
+CREATE TABLE `document_status_transitions` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `master_flag` varchar(20) DEFAULT '',
+  `master_id` int(10) unsigned DEFAULT 0,
+  `from_status_code` varchar(50) DEFAULT '',
+  `to_status_code` varchar(50) DEFAULT '',
+  `reason` varchar(255) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `document_status_transitions_master` (`master_flag`,`master_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,31 @@
+-- An issued invoice, credit note, debit note or purchase order is not
+-- changed in place, it is revised: each revision is kept in
+-- document_versions with a snapshot of the header and lines and the
+-- version it follows. The first revision of a document also keeps the
+-- version it had before. Purchase orders get the draft and issued statuses
+-- of the document lifecycle; orders stored before are taken as issued.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/010_document_versions.sql
+
+ALTER TABLE `purchase_order_headers`
+  ADD `document_status_code` varchar(50) DEFAULT 'draft' AFTER `payment_ex_date`;
+
+UPDATE `purchase_order_headers` SET `document_status_code` = 'issued';
+
+CREATE TABLE `document_versions` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `master_flag` varchar(20) DEFAULT '',
+  `master_id` int(10) unsigned DEFAULT 0,
+  `version_number` int(10) unsigned DEFAULT 0,
+  `previous_version_id` int(10) unsigned DEFAULT 0,
+  `reason` varchar(255) DEFAULT '',
+  `snapshot` mediumtext,
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_versions_number` (`master_flag`,`master_id`,`version_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,8 @@
+-- The status of a receipt advice, as a despatch has. A receipt advice, as
+-- a despatch, is a draft while its status is empty or draft, and its lines
+-- can only be changed while it is a draft.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/011_receipt_advice_document_status.sql
+
+ALTER TABLE `receipt_advice_headers`
+  ADD `document_status_code` varchar(50) DEFAULT '' AFTER `rcpth_id`;
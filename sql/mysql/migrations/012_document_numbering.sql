@@ -0,0 +1,82 @@
+-- Gap-free numbering of invoices, credit notes, debit notes, purchase
+-- orders, despatch advices and receipt advices: a party has a sequence per
+-- document type with a pattern such as INV-{YYYY}-{seq:6}, and a counter
+-- per sequence and fiscal year of the last allocated number. A voided
+-- number is kept with its reason.
+--
+-- The number of a document is unique per party: a unique index on the
+-- party and the number of every active document with a number, so two
+-- documents that are numbered at the same time cannot get the same
+-- number. Duplicate numbers that are already in the tables must be
+-- resolved before the indexes are added.
+--
+-- mysql -u$SC_UBL_DBUSER -p$SC_UBL_DBPASS $SC_UBL_DBNAME < sql/mysql/migrations/012_document_numbering.sql
+
+CREATE TABLE `document_number_sequences` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `party_id` int(10) unsigned DEFAULT 0,
+  `document_type` varchar(50) DEFAULT '',
+  `pattern` varchar(100) DEFAULT '',
+  `fiscal_year_start_month` int(10) unsigned DEFAULT 1,
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_number_sequences_party_type` (`party_id`,`document_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `document_number_counters` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sequence_id` int(10) unsigned DEFAULT 0,
+  `fiscal_year` int(10) unsigned DEFAULT 0,
+  `last_number` int(10) unsigned DEFAULT 0,
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_number_counters_sequence_year` (`sequence_id`,`fiscal_year`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `voided_document_numbers` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `sequence_id` int(10) unsigned DEFAULT 0,
+  `party_id` int(10) unsigned DEFAULT 0,
+  `document_type` varchar(50) DEFAULT '',
+  `fiscal_year` int(10) unsigned DEFAULT 0,
+  `document_number` varchar(100) DEFAULT '',
+  `reason` varchar(255) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `voided_document_numbers_number` (`party_id`,`document_type`,`document_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE `invoice_headers`
+  ADD `ih_id_key` varchar(100) GENERATED ALWAYS AS (if(`ih_id` = '' or `status_code` <> 'active', NULL, `ih_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `invoice_headers_party_number` (`accounting_supplier_party_id`,`ih_id_key`);
+
+ALTER TABLE `credit_note_headers`
+  ADD `cnh_id_key` varchar(100) GENERATED ALWAYS AS (if(`cnh_id` = '' or `status_code` <> 'active', NULL, `cnh_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `credit_note_headers_party_number` (`accounting_supplier_party_id`,`cnh_id_key`);
+
+ALTER TABLE `debit_note_headers`
+  ADD `dnh_id_key` varchar(100) GENERATED ALWAYS AS (if(`dnh_id` = '' or `status_code` <> 'active', NULL, `dnh_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `debit_note_headers_party_number` (`accounting_supplier_party_id`,`dnh_id_key`);
+
+ALTER TABLE `purchase_order_headers`
+  ADD `poh_id_key` varchar(100) GENERATED ALWAYS AS (if(`poh_id` = '' or `status_code` <> 'active', NULL, `poh_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `purchase_order_headers_party_number` (`buyer_customer_party_id`,`poh_id_key`);
+
+ALTER TABLE `despatch_headers`
+  ADD `desph_id_key` varchar(100) GENERATED ALWAYS AS (if(`desph_id` = '' or `status_code` <> 'active', NULL, `desph_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `despatch_headers_party_number` (`despatch_supplier_party_id`,`desph_id_key`);
+
+ALTER TABLE `receipt_advice_headers`
+  ADD `rcpth_id_key` varchar(100) GENERATED ALWAYS AS (if(`rcpth_id` = '' or `status_code` <> 'active', NULL, `rcpth_id`)) VIRTUAL AFTER `status_code`,
+  ADD UNIQUE KEY `receipt_advice_headers_party_number` (`delivery_customer_party_id`,`rcpth_id_key`);
@@ -80,20 +80,27 @@ DROP TABLE IF EXISTS `allowance_charges`;
 /*!40101 SET character_set_client = utf8 */;
 CREATE TABLE `allowance_charges` (
   `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
   `ac_id` varchar(100) DEFAULT '',
   `charge_indicator` tinyint(1) DEFAULT 0,
   `allowance_charge_reason_code` varchar(100) DEFAULT '',
   `allowance_charge_reason` varchar(50) DEFAULT '',
-  `multiplier_factor_numeric` int(10) unsigned DEFAULT 0,
+  `multiplier_factor_numeric` double DEFAULT 0,
   `prepaid_indicator` tinyint(1) DEFAULT 0,
   `sequence_numeric` int(10) unsigned DEFAULT 0,
-  `amount` double DEFAULT 0,
-  `base_amount` double DEFAULT 0,
-  `per_unit_amount` double DEFAULT 0,
+  `amount` decimal(19,4) DEFAULT 0.0000,
+  `base_amount` decimal(19,4) DEFAULT 0.0000,
+  `per_unit_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `tax_category_id` int(10) unsigned DEFAULT 0,
   `tax_total_id` int(10) unsigned DEFAULT 0,
   `master_flag` varchar(20) DEFAULT '',
   `master_id` int(10) unsigned DEFAULT 0,
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
   PRIMARY KEY (`id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
@@ -285,6 +292,7 @@ CREATE TABLE `credit_note_headers` (
   `payment_alt_currency_code` varchar(20) DEFAULT '',
   `accounting_cost_code` varchar(50) DEFAULT '',
   `accounting_cost` varchar(100) DEFAULT '',
+  `buyer_reference` varchar(100) DEFAULT '',
   `line_count_numeric` int(10) unsigned DEFAULT 0,
   `discrepancy_response` varchar(50) DEFAULT '',
   `order_id` int(10) unsigned DEFAULT 0,
@@ -328,16 +336,17 @@ CREATE TABLE `credit_note_headers` (
   `payment_alt_ex_exchange_market_id` int(10) unsigned DEFAULT 0,
   `payment_alt_ex_calculation_rate` double DEFAULT 0,
   `payment_alt_ex_mathematic_operator_code` varchar(20) DEFAULT '',
-  `line_extension_amount` double DEFAULT 0,
-  `tax_exclusive_amount` double DEFAULT 0,
-  `tax_inclusive_amount` double DEFAULT 0,
-  `allowance_total_amount` double DEFAULT 0,
-  `charge_total_amount` double DEFAULT 0,
-  `withholding_tax_total_amount` double DEFAULT 0,
-  `prepaid_amount` double DEFAULT 0,
-  `payable_rounding_amount` double DEFAULT 0,
-  `payable_amount` double DEFAULT 0,
-  `payable_alternative_amount` double DEFAULT 0,
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_currency_code` varchar(20) DEFAULT '',
   `issue_date` datetime DEFAULT current_timestamp(),
   `due_date` datetime DEFAULT current_timestamp(),
   `tax_point_date` datetime DEFAULT current_timestamp(),
@@ -347,12 +356,15 @@ CREATE TABLE `credit_note_headers` (
   `pricing_ex_date` datetime DEFAULT current_timestamp(),
   `payment_ex_date` datetime DEFAULT current_timestamp(),
   `payment_alt_ex_date` datetime DEFAULT current_timestamp(),
+  `document_status_code` varchar(50) DEFAULT 'draft',
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `cnh_id_key` varchar(100) GENERATED ALWAYS AS (if(`cnh_id` = '' or `status_code` <> 'active', NULL, `cnh_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `credit_note_headers_party_number` (`accounting_supplier_party_id`,`cnh_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -378,7 +390,8 @@ CREATE TABLE `credit_note_lines` (
   `cnl_id` varchar(100) DEFAULT '',
   `note` varchar(100) DEFAULT '',
   `credited_quantity` double DEFAULT 0,
-  `line_extension_amount` double DEFAULT 0,
+  `unit_code` varchar(3) DEFAULT 'C62',
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
   `accounting_cost_code` varchar(50) DEFAULT '',
   `accounting_cost` varchar(100) DEFAULT '',
   `payment_purpose_code` varchar(10) DEFAULT '',
@@ -390,7 +403,8 @@ CREATE TABLE `credit_note_lines` (
   `billing_id` int(10) unsigned DEFAULT 0,
   `originator_party_id` int(10) unsigned DEFAULT 0,
   `item_id` int(10) unsigned DEFAULT 0,
-  `price_amount` double DEFAULT 0,
+  `price_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `price_base_quantity` double DEFAULT 0,
   `price_change_reason` varchar(100) DEFAULT '',
   `price_type_code` varchar(20) DEFAULT '',
@@ -482,16 +496,17 @@ CREATE TABLE `debit_note_headers` (
   `payment_alt_ex_exchange_market_id` int(10) unsigned DEFAULT 0,
   `payment_alt_ex_calculation_rate` double DEFAULT 0,
   `payment_alt_ex_mathematic_operator_code` varchar(20) DEFAULT '',
-  `line_extension_amount` double DEFAULT 0,
-  `tax_exclusive_amount` double DEFAULT 0,
-  `tax_inclusive_amount` double DEFAULT 0,
-  `allowance_total_amount` double DEFAULT 0,
-  `charge_total_amount` double DEFAULT 0,
-  `withholding_tax_total_amount` double DEFAULT 0,
-  `prepaid_amount` double DEFAULT 0,
-  `payable_rounding_amount` double DEFAULT 0,
-  `payable_amount` double DEFAULT 0,
-  `payable_alternative_amount` double DEFAULT 0,
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_currency_code` varchar(20) DEFAULT '',
   `issue_date` datetime DEFAULT current_timestamp(),
   `tax_point_date` datetime DEFAULT current_timestamp(),
   `invoice_period_start_date` datetime DEFAULT current_timestamp(),
@@ -500,12 +515,15 @@ CREATE TABLE `debit_note_headers` (
   `pricing_ex_date` datetime DEFAULT current_timestamp(),
   `payment_ex_date` datetime DEFAULT current_timestamp(),
   `payment_alt_ex_date` datetime DEFAULT current_timestamp(),
+  `document_status_code` varchar(50) DEFAULT 'draft',
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `dnh_id_key` varchar(100) GENERATED ALWAYS AS (if(`dnh_id` = '' or `status_code` <> 'active', NULL, `dnh_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `debit_note_headers_party_number` (`accounting_supplier_party_id`,`dnh_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -531,7 +549,8 @@ CREATE TABLE `debit_note_lines` (
   `dnl_id` varchar(100) DEFAULT '',
   `note` varchar(50) DEFAULT '',
   `debited_quantity` double DEFAULT 0,
-  `line_extension_amount` double DEFAULT 0,
+  `unit_code` varchar(3) DEFAULT 'C62',
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
   `accounting_cost_code` varchar(50) DEFAULT '',
   `accounting_cost` varchar(100) DEFAULT '',
   `payment_purpose_code` varchar(20) DEFAULT '',
@@ -540,7 +559,8 @@ CREATE TABLE `debit_note_lines` (
   `receipt_line_id` int(10) unsigned DEFAULT 0,
   `billing_id` int(10) unsigned DEFAULT 0,
   `item_id` int(10) unsigned DEFAULT 0,
-  `price_amount` double DEFAULT 0,
+  `price_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `price_base_quantity` double DEFAULT 0,
   `price_change_reason` varchar(100) DEFAULT '',
   `price_type_code` varchar(20) DEFAULT '',
@@ -687,7 +707,9 @@ CREATE TABLE `despatch_headers` (
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `desph_id_key` varchar(100) GENERATED ALWAYS AS (if(`desph_id` = '' or `status_code` <> 'active', NULL, `desph_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `despatch_headers_party_number` (`despatch_supplier_party_id`,`desph_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -780,6 +802,170 @@ LOCK TABLES `despatches` WRITE;
 /*!40000 ALTER TABLE `despatches` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `document_number_counters`
+--
+
+DROP TABLE IF EXISTS `document_number_counters`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `document_number_counters` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `sequence_id` int(10) unsigned DEFAULT 0,
+  `fiscal_year` int(10) unsigned DEFAULT 0,
+  `last_number` int(10) unsigned DEFAULT 0,
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_number_counters_sequence_year` (`sequence_id`,`fiscal_year`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `document_number_counters`
+--
+
+LOCK TABLES `document_number_counters` WRITE;
+/*!40000 ALTER TABLE `document_number_counters` DISABLE KEYS */;
+/*!40000 ALTER TABLE `document_number_counters` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `document_number_sequences`
+--
+
+DROP TABLE IF EXISTS `document_number_sequences`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `document_number_sequences` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `party_id` int(10) unsigned DEFAULT 0,
+  `document_type` varchar(50) DEFAULT '',
+  `pattern` varchar(100) DEFAULT '',
+  `fiscal_year_start_month` int(10) unsigned DEFAULT 1,
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_number_sequences_party_type` (`party_id`,`document_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `document_number_sequences`
+--
+
+LOCK TABLES `document_number_sequences` WRITE;
+/*!40000 ALTER TABLE `document_number_sequences` DISABLE KEYS */;
+/*!40000 ALTER TABLE `document_number_sequences` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `document_status_transitions`
+--
+
+DROP TABLE IF EXISTS `document_status_transitions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `document_status_transitions` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `master_flag` varchar(20) DEFAULT '',
+  `master_id` int(10) unsigned DEFAULT 0,
+  `from_status_code` varchar(50) DEFAULT '',
+  `to_status_code` varchar(50) DEFAULT '',
+  `reason` varchar(255) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `document_status_transitions_master` (`master_flag`,`master_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `document_status_transitions`
+--
+
+LOCK TABLES `document_status_transitions` WRITE;
+/*!40000 ALTER TABLE `document_status_transitions` DISABLE KEYS */;
+/*!40000 ALTER TABLE `document_status_transitions` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `document_versions`
+--
+
+DROP TABLE IF EXISTS `document_versions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `document_versions` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `master_flag` varchar(20) DEFAULT '',
+  `master_id` int(10) unsigned DEFAULT 0,
+  `version_number` int(10) unsigned DEFAULT 0,
+  `previous_version_id` int(10) unsigned DEFAULT 0,
+  `reason` varchar(255) DEFAULT '',
+  `snapshot` mediumtext,
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `document_versions_number` (`master_flag`,`master_id`,`version_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `document_versions`
+--
+
+LOCK TABLES `document_versions` WRITE;
+/*!40000 ALTER TABLE `document_versions` DISABLE KEYS */;
+/*!40000 ALTER TABLE `document_versions` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `exchange_rates`
+--
+
+DROP TABLE IF EXISTS `exchange_rates`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `exchange_rates` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `source_currency_code` varchar(20) DEFAULT '',
+  `target_currency_code` varchar(20) DEFAULT '',
+  `exchange_market_id` int(10) unsigned DEFAULT 0,
+  `calculation_rate` double DEFAULT 0,
+  `rate_date` datetime DEFAULT current_timestamp(),
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `exchange_rates_currencies_market_date` (`source_currency_code`,`target_currency_code`,`exchange_market_id`,`rate_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `exchange_rates`
+--
+
+LOCK TABLES `exchange_rates` WRITE;
+/*!40000 ALTER TABLE `exchange_rates` DISABLE KEYS */;
+/*!40000 ALTER TABLE `exchange_rates` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `financial_institution_branches`
 --
@@ -863,6 +1049,7 @@ CREATE TABLE `invoice_headers` (
   `payment_alt_currency_code` varchar(20) DEFAULT '',
   `accounting_cost_code` varchar(50) DEFAULT '',
   `accounting_cost` varchar(100) DEFAULT '',
+  `buyer_reference` varchar(100) DEFAULT '',
   `line_count_numeric` int(10) unsigned DEFAULT 0,
   `order_id` int(10) unsigned DEFAULT 0,
   `billing_id` int(10) unsigned DEFAULT 0,
@@ -904,16 +1091,17 @@ CREATE TABLE `invoice_headers` (
   `payment_alt_ex_exchange_market_id` int(10) unsigned DEFAULT 0,
   `payment_alt_ex_calculation_rate` double DEFAULT 0,
   `payment_alt_ex_mathematic_operator_code` varchar(20) DEFAULT '',
-  `line_extension_amount` double DEFAULT 0,
-  `tax_exclusive_amount` double DEFAULT 0,
-  `tax_inclusive_amount` double DEFAULT 0,
-  `allowance_total_amount` double DEFAULT 0,
-  `charge_total_amount` double DEFAULT 0,
-  `withholding_tax_total_amount` double DEFAULT 0,
-  `prepaid_amount` double DEFAULT 0,
-  `payable_rounding_amount` double DEFAULT 0,
-  `payable_amount` double DEFAULT 0,
-  `payable_alternative_amount` double DEFAULT 0,
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_currency_code` varchar(20) DEFAULT '',
   `issue_date` datetime DEFAULT current_timestamp(),
   `due_date` datetime DEFAULT current_timestamp(),
   `tax_point_date` datetime DEFAULT current_timestamp(),
@@ -923,12 +1111,15 @@ CREATE TABLE `invoice_headers` (
   `pricing_ex_date` datetime DEFAULT current_timestamp(),
   `payment_ex_date` datetime DEFAULT current_timestamp(),
   `payment_alt_ex_date` datetime DEFAULT current_timestamp(),
+  `document_status_code` varchar(50) DEFAULT 'draft',
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `ih_id_key` varchar(100) GENERATED ALWAYS AS (if(`ih_id` = '' or `status_code` <> 'active', NULL, `ih_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `invoice_headers_party_number` (`accounting_supplier_party_id`,`ih_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -954,7 +1145,8 @@ CREATE TABLE `invoice_lines` (
   `il_id` varchar(100) DEFAULT '',
   `note` varchar(50) DEFAULT '',
   `invoiced_quantity` double DEFAULT 0,
-  `line_extension_amount` double DEFAULT 0,
+  `unit_code` varchar(3) DEFAULT 'C62',
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
   `accounting_cost_code` varchar(50) DEFAULT '',
   `accounting_cost` varchar(100) DEFAULT '',
   `payment_purpose_code` varchar(50) DEFAULT '',
@@ -965,7 +1157,8 @@ CREATE TABLE `invoice_lines` (
   `billing_id` int(10) unsigned DEFAULT 0,
   `originator_party_id` int(10) unsigned DEFAULT 0,
   `item_id` int(10) unsigned DEFAULT 0,
-  `price_amount` double DEFAULT 0,
+  `price_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `price_base_quantity` double DEFAULT 0,
   `price_change_reason` varchar(100) DEFAULT '',
   `price_type_code` varchar(20) DEFAULT '',
@@ -996,6 +1189,39 @@ LOCK TABLES `invoice_lines` WRITE;
 /*!40000 ALTER TABLE `invoice_lines` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `invoice_prepayments`
+--
+
+DROP TABLE IF EXISTS `invoice_prepayments`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `invoice_prepayments` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `invoice_header_id` int(10) unsigned DEFAULT 0,
+  `prepayment_invoice_header_id` int(10) unsigned DEFAULT 0,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `currency_code` varchar(3) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `invoice_prepayments_prepayment_invoice_header_id` (`prepayment_invoice_header_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `invoice_prepayments`
+--
+
+LOCK TABLES `invoice_prepayments` WRITE;
+/*!40000 ALTER TABLE `invoice_prepayments` DISABLE KEYS */;
+/*!40000 ALTER TABLE `invoice_prepayments` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `item_certificates`
 --
@@ -1184,6 +1410,30 @@ LOCK TABLES `item_property_groups` WRITE;
 /*!40000 ALTER TABLE `item_property_groups` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `item_tax_categories`
+--
+
+DROP TABLE IF EXISTS `item_tax_categories`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `item_tax_categories` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `item_id` int(10) unsigned DEFAULT 0,
+  `tax_category_id` int(10) unsigned DEFAULT 0,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `item_tax_categories`
+--
+
+LOCK TABLES `item_tax_categories` WRITE;
+/*!40000 ALTER TABLE `item_tax_categories` DISABLE KEYS */;
+/*!40000 ALTER TABLE `item_tax_categories` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `items`
 --
@@ -1590,7 +1840,8 @@ CREATE TABLE `payment_mandates` (
   `pmd_id` varchar(100) DEFAULT '',
   `mandate_type_code` varchar(50) DEFAULT '',
   `maximum_payment_instructions_numeric` int(10) unsigned DEFAULT 0,
-  `maximum_paid_amount` double DEFAULT 0,
+  `maximum_paid_amount` decimal(19,4) DEFAULT 0.0000,
+  `currency_code` varchar(3) DEFAULT '',
   `signature_id` varchar(100) DEFAULT '',
   `payer_party_id` int(10) unsigned DEFAULT 0,
   `payer_financial_account_id` int(10) unsigned DEFAULT 0,
@@ -1674,9 +1925,10 @@ CREATE TABLE `payment_terms` (
   `settlement_discount_percent` double DEFAULT 0,
   `penalty_surcharge_percent` double DEFAULT 0,
   `payment_percent` double DEFAULT 0,
-  `amount` double DEFAULT 0,
-  `settlement_discount_amount` double DEFAULT 0,
-  `penalty_amount` double DEFAULT 0,
+  `amount` decimal(19,4) DEFAULT 0.0000,
+  `settlement_discount_amount` decimal(19,4) DEFAULT 0.0000,
+  `penalty_amount` decimal(19,4) DEFAULT 0.0000,
+  `currency_code` varchar(3) DEFAULT '',
   `payment_terms_details_uri` varchar(50) DEFAULT '',
   `payment_means_id` int(10) unsigned DEFAULT 0,
   `payment_due_date` datetime DEFAULT current_timestamp(),
@@ -1688,6 +1940,8 @@ CREATE TABLE `payment_terms` (
   `exchange_rate` double DEFAULT 0,
   `validity_period_start_date` datetime DEFAULT current_timestamp(),
   `validity_period_end_date` datetime DEFAULT current_timestamp(),
+  `master_flag` varchar(20) DEFAULT '',
+  `master_id` int(10) unsigned DEFAULT 0,
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
@@ -1717,7 +1971,8 @@ CREATE TABLE `payments` (
   `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
   `uuid4` binary(16) DEFAULT NULL,
   `p_id` varchar(100) DEFAULT '',
-  `paid_amount` double DEFAULT 0,
+  `paid_amount` decimal(19,4) DEFAULT 0.0000,
+  `currency_code` varchar(3) DEFAULT '',
   `instruction_id` varchar(100) DEFAULT '',
   `payment_mean_id` int(10) unsigned DEFAULT 0,
   `received_date` datetime DEFAULT current_timestamp(),
@@ -1792,27 +2047,33 @@ CREATE TABLE `purchase_order_headers` (
   `payment_ex_calculation_rate` double DEFAULT 0,
   `payment_ex_mathematic_operator_code` varchar(20) DEFAULT '',
   `destination_country` varchar(50) DEFAULT '',
-  `line_extension_amount` double DEFAULT 0,
-  `tax_exclusive_amount` double DEFAULT 0,
-  `tax_inclusive_amount` double DEFAULT 0,
-  `allowance_total_amount` double DEFAULT 0,
-  `charge_total_amount` double DEFAULT 0,
-  `withholding_tax_total_amount` double DEFAULT 0,
-  `prepaid_amount` double DEFAULT 0,
-  `payable_rounding_amount` double DEFAULT 0,
-  `payable_amount` double DEFAULT 0,
-  `payable_alternative_amount` double DEFAULT 0,
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_exclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_inclusive_amount` decimal(19,4) DEFAULT 0.0000,
+  `allowance_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `charge_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `withholding_tax_total_amount` decimal(19,4) DEFAULT 0.0000,
+  `prepaid_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_rounding_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_amount` decimal(19,4) DEFAULT 0.0000,
+  `payable_alternative_currency_code` varchar(20) DEFAULT '',
+  `order_response_code` varchar(10) DEFAULT '',
+  `sequence_number_id` int(10) unsigned DEFAULT 0,
   `issue_date` datetime DEFAULT current_timestamp(),
   `validity_period` datetime DEFAULT current_timestamp(),
   `tax_ex_date` datetime DEFAULT current_timestamp(),
   `pricing_ex_date` datetime DEFAULT current_timestamp(),
   `payment_ex_date` datetime DEFAULT current_timestamp(),
+  `document_status_code` varchar(50) DEFAULT 'draft',
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `poh_id_key` varchar(100) GENERATED ALWAYS AS (if(`poh_id` = '' or `status_code` <> 'active', NULL, `poh_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `purchase_order_headers_party_number` (`buyer_customer_party_id`,`poh_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -1841,8 +2102,9 @@ CREATE TABLE `purchase_order_lines` (
   `sales_order_id` varchar(100) DEFAULT '',
   `line_status_code` varchar(50) DEFAULT '',
   `quantity` double DEFAULT 0,
-  `line_extension_amount` double DEFAULT 0,
-  `total_tax_amount` double DEFAULT 0,
+  `unit_code` varchar(3) DEFAULT 'C62',
+  `line_extension_amount` decimal(19,4) DEFAULT 0.0000,
+  `total_tax_amount` decimal(19,4) DEFAULT 0.0000,
   `minimum_quantity` double DEFAULT 0,
   `maximum_quantity` double DEFAULT 0,
   `minimum_backorder_quantity` double DEFAULT 0,
@@ -1855,7 +2117,8 @@ CREATE TABLE `purchase_order_lines` (
   `warranty_information` varchar(50) DEFAULT '',
   `originator_party_id` int(10) unsigned DEFAULT 0,
   `item_id` int(10) unsigned DEFAULT 0,
-  `price_amount` double DEFAULT 0,
+  `price_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `price_base_quantity` double DEFAULT 0,
   `price_change_reason` varchar(100) DEFAULT '',
   `price_type_code` varchar(20) DEFAULT '',
@@ -1894,6 +2157,7 @@ CREATE TABLE `receipt_advice_headers` (
   `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
   `uuid4` binary(16) DEFAULT NULL,
   `rcpth_id` varchar(100) DEFAULT '',
+  `document_status_code` varchar(50) DEFAULT '',
   `receipt_advice_type_code` varchar(50) DEFAULT '',
   `note` varchar(50) DEFAULT '',
   `line_count_numeric` int(10) unsigned DEFAULT 0,
@@ -1910,7 +2174,9 @@ CREATE TABLE `receipt_advice_headers` (
   `updated_by_user_id` varchar(50) DEFAULT 'active',
   `created_at` datetime DEFAULT current_timestamp(),
   `updated_at` datetime DEFAULT current_timestamp(),
-  PRIMARY KEY (`id`)
+  `rcpth_id_key` varchar(100) GENERATED ALWAYS AS (if(`rcpth_id` = '' or `status_code` <> 'active', NULL, `rcpth_id`)) VIRTUAL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `receipt_advice_headers_party_number` (`delivery_customer_party_id`,`rcpth_id_key`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -2039,12 +2305,15 @@ CREATE TABLE `tax_categories` (
   `tax_category_name` varchar(100) DEFAULT '',
   `percent` double DEFAULT 0,
   `base_unit_measure` varchar(20) DEFAULT '',
-  `per_unit_amount` double DEFAULT 0,
+  `per_unit_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
   `tax_exemption_reason_code` varchar(50) DEFAULT '',
   `tax_exemption_reason` varchar(500) DEFAULT NULL,
   `tier_range` varchar(100) DEFAULT '',
   `tier_rate_percent` double DEFAULT 0,
   `tax_scheme_id` int(10) unsigned DEFAULT 0,
+  `calculation_sequence_numeric` int(10) unsigned DEFAULT 0,
+  `withholding_indicator` tinyint(1) DEFAULT 0,
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
@@ -2108,6 +2377,7 @@ CREATE TABLE `tax_schemes` (
   `tax_scheme_name` varchar(100) DEFAULT '',
   `tax_type_code` varchar(20) DEFAULT '',
   `currency_code` varchar(20) DEFAULT '',
+  `rounding_level` varchar(20) DEFAULT 'document',
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
@@ -2136,13 +2406,15 @@ DROP TABLE IF EXISTS `tax_sub_totals`;
 CREATE TABLE `tax_sub_totals` (
   `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
   `uuid4` binary(16) DEFAULT NULL,
-  `taxable_amount` double DEFAULT 0,
-  `tax_amount` double DEFAULT 0,
+  `taxable_amount` decimal(19,4) DEFAULT 0.0000,
+  `tax_amount` decimal(19,4) DEFAULT 0.0000,
   `calculation_sequence_numeric` int(10) unsigned DEFAULT 0,
-  `transaction_currency_tax_amount` double DEFAULT 0,
+  `transaction_currency_tax_amount` decimal(19,4) DEFAULT 0.0000,
   `percent` double DEFAULT 0,
   `base_unit_measure` varchar(20) DEFAULT '',
-  `per_unit_amount` double DEFAULT 0,
+  `per_unit_amount` decimal(19,6) DEFAULT 0.000000,
+  `currency_code` varchar(3) DEFAULT '',
+  `transaction_currency_code` varchar(3) DEFAULT '',
   `tier_range` varchar(50) DEFAULT '',
   `tier_rate_percent` double DEFAULT 0,
   `tax_category_id` int(10) unsigned DEFAULT 0,
@@ -2175,13 +2447,15 @@ DROP TABLE IF EXISTS `tax_totals`;
 CREATE TABLE `tax_totals` (
   `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
   `uuid4` binary(16) DEFAULT NULL,
-  `tax_amount` double DEFAULT 0,
-  `rounding_amount` double DEFAULT 0,
+  `tax_amount` decimal(19,4) DEFAULT 0.0000,
+  `rounding_amount` decimal(19,4) DEFAULT 0.0000,
   `tax_evidence_indicator` tinyint(1) DEFAULT 0,
   `tax_included_indicator` tinyint(1) DEFAULT 0,
   `master_flag` varchar(20) DEFAULT '',
   `master_id` int(10) unsigned DEFAULT 0,
   `tax_category_id` int(10) unsigned DEFAULT 0,
+  `currency_code` varchar(3) DEFAULT '',
+  `withholding_indicator` tinyint(1) DEFAULT 0,
   `status_code` varchar(50) DEFAULT 'active',
   `created_by_user_id` varchar(50) DEFAULT 'active',
   `updated_by_user_id` varchar(50) DEFAULT 'active',
@@ -2200,6 +2474,41 @@ LOCK TABLES `tax_totals` WRITE;
 /*!40000 ALTER TABLE `tax_totals` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `voided_document_numbers`
+--
+
+DROP TABLE IF EXISTS `voided_document_numbers`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `voided_document_numbers` (
+  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,
+  `uuid4` binary(16) DEFAULT NULL,
+  `sequence_id` int(10) unsigned DEFAULT 0,
+  `party_id` int(10) unsigned DEFAULT 0,
+  `document_type` varchar(50) DEFAULT '',
+  `fiscal_year` int(10) unsigned DEFAULT 0,
+  `document_number` varchar(100) DEFAULT '',
+  `reason` varchar(255) DEFAULT '',
+  `status_code` varchar(50) DEFAULT 'active',
+  `created_by_user_id` varchar(50) DEFAULT 'active',
+  `updated_by_user_id` varchar(50) DEFAULT 'active',
+  `created_at` datetime DEFAULT current_timestamp(),
+  `updated_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `voided_document_numbers_number` (`party_id`,`document_type`,`document_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `voided_document_numbers`
+--
+
+LOCK TABLES `voided_document_numbers` WRITE;
+/*!40000 ALTER TABLE `voided_document_numbers` DISABLE KEYS */;
+/*!40000 ALTER TABLE `voided_document_numbers` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `waybills`
 --
@@ -0,0 +1,12 @@
+INSERT INTO `items`
+	  (uuid4,
+description,
+item_name,
+sellers_item_identification_id,
+origin_country_id_code,
+tax_category_id,
+status_code,
+created_at,
+updated_at,
+created_by_user_id,
+updated_by_user_id) VALUES (UNHEX(REPLACE('0f2b9c3e-7d41-4a8e-b6c5-91d3e8a47f62','-','')),'Consulting services','Consulting','CS-01','BE',15,'active','2019-07-23 10:04:26','2019-07-23 10:04:26','auth0|673c75d516e8adb9e6ffc892','auth0|673c75d516e8adb9e6ffc892');
@@ -11,6 +11,11 @@ TRUNCATE delivery_terms;
 TRUNCATE despatch_headers;
 TRUNCATE despatch_lines;
 TRUNCATE despatches;
+TRUNCATE document_number_counters;
+TRUNCATE document_number_sequences;
+TRUNCATE document_status_transitions;
+TRUNCATE document_versions;
+TRUNCATE exchange_rates;
 TRUNCATE financial_institution_branches;
 TRUNCATE financial_institutions;
 TRUNCATE invoice_headers;
@@ -21,6 +26,7 @@ TRUNCATE item_dimensions;
 TRUNCATE item_instances;
 TRUNCATE item_properties;
 TRUNCATE item_property_groups;
+TRUNCATE item_tax_categories;
 TRUNCATE items;
 TRUNCATE locations;
 TRUNCATE parties;
@@ -46,4 +52,5 @@ TRUNCATE tax_scheme_jurisdictions;
 TRUNCATE tax_schemes;
 TRUNCATE tax_sub_totals;
 TRUNCATE tax_totals;
+TRUNCATE voided_document_numbers;
 TRUNCATE waybills;
@@ -153,3 +153,16 @@ created_at,
 updated_at,
 created_by_user_id,
 updated_by_user_id) VALUES (UNHEX(REPLACE('c903c68a-8450-448d-8a77-b771087a0d09','-','')),10,'','',14,'active','2019-07-23 10:04:26','2019-07-23 10:04:26','auth0|673c75d516e8adb9e6ffc892','auth0|673c75d516e8adb9e6ffc892');
+
+INSERT INTO `tax_categories`
+	  (uuid4,
+tc_id,
+percent,
+tax_exemption_reason_code,
+tax_exemption_reason,
+tax_scheme_id,
+status_code,
+created_at,
+updated_at,
+created_by_user_id,
+updated_by_user_id) VALUES (UNHEX(REPLACE('5b0b7a52-3a51-4f0e-9f3c-2e6d7c1a8f40','-','')),'O',0,'VATEX-EU-O','Not subject to VAT',1,'active','2019-07-23 10:04:26','2019-07-23 10:04:26','auth0|673c75d516e8adb9e6ffc892','auth0|673c75d516e8adb9e6ffc892');
@@ -156,6 +156,12 @@ func LoadSQL(log *zap.Logger, dbService *common.DBService) error {
 			return err
 		}
 
+		err = execSQLFile(ctx, log, dbService.MySQLTestFilePath+"/items.sql", dbService.DB)
+		if err != nil {
+			log.Error("Error", zap.Error(err))
+			return err
+		}
+
 		err = execSQLFile(ctx, log, dbService.MySQLTestFilePath+"/tax_subtotal.sql", dbService.DB)
 		if err != nil {
 			log.Error("Error", zap.Error(err))